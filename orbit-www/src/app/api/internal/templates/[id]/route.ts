@@ -0,0 +1,56 @@
+export const dynamic = 'force-dynamic'
+
+import { NextRequest, NextResponse } from 'next/server'
+import { getPayload } from 'payload'
+import configPromise from '@payload-config'
+import { validateInternalApiKey } from '@/lib/auth/internal-api-auth'
+
+
+/**
+ * GET /api/internal/templates/[id]
+ * Retrieves a template by ID.
+ * Used by the repository service to resolve templates for instantiation.
+ */
+export async function GET(
+  request: NextRequest,
+  { params }: { params: Promise<{ id: string }> }
+) {
+  const authError = validateInternalApiKey(request.headers.get('X-API-Key'))
+  if (authError) return authError
+
+  try {
+    const { id } = await params
+
+    const payload = await getPayload({ config: configPromise })
+
+    const template = await payload.findByID({
+      collection: 'templates',
+      id,
+      depth: 0,
+      overrideAccess: true,
+    })
+
+    if (!template) {
+      return NextResponse.json(
+        { error: 'Template not found', code: 'NOT_FOUND' },
+        { status: 404 }
+      )
+    }
+
+    return NextResponse.json(template)
+  } catch (error) {
+    console.error('[Internal API] Template get error:', error)
+
+    if (error instanceof Error && error.message.includes('not found')) {
+      return NextResponse.json(
+        { error: 'Template not found', code: 'NOT_FOUND' },
+        { status: 404 }
+      )
+    }
+
+    return NextResponse.json(
+      { error: 'Internal server error', code: 'INTERNAL_ERROR' },
+      { status: 500 }
+    )
+  }
+}
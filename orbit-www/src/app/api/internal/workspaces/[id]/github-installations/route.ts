@@ -0,0 +1,52 @@
+export const dynamic = 'force-dynamic'
+
+import { NextRequest, NextResponse } from 'next/server'
+import { getPayload } from 'payload'
+import configPromise from '@payload-config'
+import { validateInternalApiKey } from '@/lib/auth/internal-api-auth'
+
+
+/**
+ * GET /api/internal/workspaces/[id]/github-installations
+ *
+ * Returns the active GitHub App installations the workspace is allowed to
+ * use. Used by the repository service to list target orgs. The
+ * `installationToken` field is intentionally NEVER returned.
+ */
+export async function GET(
+  request: NextRequest,
+  { params }: { params: Promise<{ id: string }> },
+) {
+  const authError = validateInternalApiKey(request.headers.get('X-API-Key'))
+  if (authError) return authError
+
+  const { id } = await params
+  if (!id) return NextResponse.json({ error: 'workspace id required' }, { status: 400 })
+
+  try {
+    const payload = await getPayload({ config: configPromise })
+    const result = await payload.find({
+      collection: 'github-installations',
+      where: {
+        and: [
+          { allowedWorkspaces: { contains: id } },
+          { status: { equals: 'active' } },
+        ],
+      },
+      limit: 100,
+      depth: 0,
+      overrideAccess: true,
+    })
+
+    const docs = result.docs.map((installation) => ({
+      installationId: installation.installationId,
+      accountLogin: installation.accountLogin,
+      accountAvatarUrl: installation.accountAvatarUrl ?? '',
+    }))
+
+    return NextResponse.json({ docs })
+  } catch (err) {
+    console.error('[internal/workspaces/github-installations] error:', err)
+    return NextResponse.json({ error: 'Internal error' }, { status: 500 })
+  }
+}
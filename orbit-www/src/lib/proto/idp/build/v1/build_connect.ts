@@ -5,7 +5,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AnalyzeRepositoryRequest, AnalyzeRepositoryResponse, BuildImageRequest, BuildImageResponse, CheckQuotaRequest, CheckQuotaResponse, GetBuildProgressRequest, GetBuildProgressResponse, StartBuildWorkflowRequest, StartBuildWorkflowResponse, StreamBuildLogsRequest, StreamBuildLogsResponse, TrackImageRequest, TrackImageResponse } from "./build_pb.js";
+import { AnalyzeRepositoryRequest, AnalyzeRepositoryResponse, BuildImageRequest, BuildImageResponse, CancelBuildRequest, CancelBuildResponse, CheckQuotaRequest, CheckQuotaResponse, GetBuildProgressRequest, GetBuildProgressResponse, StartBuildWorkflowRequest, StartBuildWorkflowResponse, StreamBuildLogsRequest, StreamBuildLogsResponse, TrackImageRequest, TrackImageResponse } from "./build_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -49,6 +49,17 @@ export const BuildService = {
       O: StreamBuildLogsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Cancel an in-progress build
+     *
+     * @generated from rpc idp.build.v1.BuildService.CancelBuild
+     */
+    cancelBuild: {
+      name: "CancelBuild",
+      I: CancelBuildRequest,
+      O: CancelBuildResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Start a build workflow via Temporal
      *
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file idp/build/v1/build.proto.
  */
 export const file_idp_build_v1_build: GenFile = /*@__PURE__*/
-  fileDesc("ChhpZHAvYnVpbGQvdjEvYnVpbGQucHJvdG8SDGlkcC5idWlsZC52MSJVChhBbmFseXplUmVwb3NpdG9yeVJlcXVlc3QSEAoIcmVwb191cmwYASABKAkSCwoDcmVmGAIgASgJEhoKEmluc3RhbGxhdGlvbl90b2tlbhgDIAEoCSKHAQoZQW5hbHl6ZVJlcG9zaXRvcnlSZXNwb25zZRIQCghkZXRlY3RlZBgBIAEoCBIxCgZjb25maWcYAiABKAsyIS5pZHAuYnVpbGQudjEuRGV0ZWN0ZWRCdWlsZENvbmZpZxINCgVlcnJvchgDIAEoCRIWCg5kZXRlY3RlZF9maWxlcxgEIAMoCSK9AQoTRGV0ZWN0ZWRCdWlsZENvbmZpZxIQCghsYW5ndWFnZRgBIAEoCRIYChBsYW5ndWFnZV92ZXJzaW9uGAIgASgJEhEKCWZyYW1ld29yaxgDIAEoCRIVCg1idWlsZF9jb21tYW5kGAQgASgJEhUKDXN0YXJ0X2NvbW1hbmQYBSABKAkSOQoPcGFja2FnZV9tYW5hZ2VyGAYgASgLMiAuaWRwLmJ1aWxkLnYxLlBhY2thZ2VNYW5hZ2VySW5mbyKlAQoSUGFja2FnZU1hbmFnZXJJbmZvEhAKCGRldGVjdGVkGAEgASgIEgwKBG5hbWUYAiABKAkSDgoGc291cmNlGAMgASgJEhAKCGxvY2tmaWxlGAQgASgJEhkKEXJlcXVlc3RlZF92ZXJzaW9uGAUgASgJEhkKEXZlcnNpb25fc3VwcG9ydGVkGAYgASgIEhcKD3N1cHBvcnRlZF9yYW5nZRgHIAEoCSLRAwoRQnVpbGRJbWFnZVJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIOCgZhcHBfaWQYAiABKAkSEAoIcmVwb191cmwYAyABKAkSCwoDcmVmGAQgASgJEhoKEmluc3RhbGxhdGlvbl90b2tlbhgFIAEoCRIdChBsYW5ndWFnZV92ZXJzaW9uGAYgASgJSACIAQESGgoNYnVpbGRfY29tbWFuZBgHIAEoCUgBiAEBEhoKDXN0YXJ0X2NvbW1hbmQYCCABKAlIAogBARJACglidWlsZF9lbnYYCSADKAsyLS5pZHAuYnVpbGQudjEuQnVpbGRJbWFnZVJlcXVlc3QuQnVpbGRFbnZFbnRyeRIuCghyZWdpc3RyeRgKIAEoCzIcLmlkcC5idWlsZC52MS5SZWdpc3RyeUNvbmZpZxIRCglpbWFnZV90YWcYCyABKAkSFwoPcGFja2FnZV9tYW5hZ2VyGAwgASgJGi8KDUJ1aWxkRW52RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUITChFfbGFuZ3VhZ2VfdmVyc2lvbkIQCg5fYnVpbGRfY29tbWFuZEIQCg5fc3RhcnRfY29tbWFuZCKOAQoOUmVnaXN0cnlDb25maWcSKAoEdHlwZRgBIAEoDjIaLmlkcC5idWlsZC52MS5SZWdpc3RyeVR5cGUSCwoDdXJsGAIgASgJEhIKCnJlcG9zaXRvcnkYAyABKAkSDQoFdG9rZW4YBCABKAkSFQoIdXNlcm5hbWUYBSABKAlIAIgBAUILCglfdXNlcm5hbWUihQEKEkJ1aWxkSW1hZ2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhEKCWltYWdlX3VybBgCIAEoCRIUCgxpbWFnZV9kaWdlc3QYAyABKAkSDQoFZXJyb3IYBCABKAkSJgoFc3RlcHMYBSADKAsyFy5pZHAuYnVpbGQudjEuQnVpbGRTdGVwIm4KCUJ1aWxkU3RlcBIMCgRuYW1lGAEgASgJEi0KBnN0YXR1cxgCIAEoDjIdLmlkcC5idWlsZC52MS5CdWlsZFN0ZXBTdGF0dXMSDwoHbWVzc2FnZRgDIAEoCRITCgtkdXJhdGlvbl9tcxgEIAEoAyIsChZTdHJlYW1CdWlsZExvZ3NSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkiWgoXU3RyZWFtQnVpbGRMb2dzUmVzcG9uc2USEQoJdGltZXN0YW1wGAEgASgDEg0KBWxldmVsGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSDAoEc3RlcBgEIAEoCSLbAwoZU3RhcnRCdWlsZFdvcmtmbG93UmVxdWVzdBIOCgZhcHBfaWQYASABKAkSFAoMd29ya3NwYWNlX2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSEAoIcmVwb191cmwYBCABKAkSCwoDcmVmGAUgASgJEi4KCHJlZ2lzdHJ5GAYgASgLMhwuaWRwLmJ1aWxkLnYxLlJlZ2lzdHJ5Q29uZmlnEh0KEGxhbmd1YWdlX3ZlcnNpb24YByABKAlIAIgBARIaCg1idWlsZF9jb21tYW5kGAggASgJSAGIAQESGgoNc3RhcnRfY29tbWFuZBgJIAEoCUgCiAEBEkgKCWJ1aWxkX2VudhgKIAMoCzI1LmlkcC5idWlsZC52MS5TdGFydEJ1aWxkV29ya2Zsb3dSZXF1ZXN0LkJ1aWxkRW52RW50cnkSEQoJaW1hZ2VfdGFnGAsgASgJEhoKEmluc3RhbGxhdGlvbl90b2tlbhgMIAEoCRovCg1CdWlsZEVudkVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCEwoRX2xhbmd1YWdlX3ZlcnNpb25CEAoOX2J1aWxkX2NvbW1hbmRCEAoOX3N0YXJ0X2NvbW1hbmQiUQoaU3RhcnRCdWlsZFdvcmtmbG93UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBITCgt3b3JrZmxvd19pZBgCIAEoCRINCgVlcnJvchgDIAEoCSIuChdHZXRCdWlsZFByb2dyZXNzUmVxdWVzdBITCgt3b3JrZmxvd19pZBgBIAEoCSLxAQoYR2V0QnVpbGRQcm9ncmVzc1Jlc3BvbnNlEhQKDGN1cnJlbnRfc3RlcBgBIAEoCRITCgtzdGVwc190b3RhbBgCIAEoBRIVCg1zdGVwc19jdXJyZW50GAMgASgFEg8KB21lc3NhZ2UYBCABKAkSDgoGc3RhdHVzGAUgASgJEhEKCWltYWdlX3VybBgGIAEoCRIUCgxpbWFnZV9kaWdlc3QYByABKAkSDQoFZXJyb3IYCCABKAkSOgoPZGV0ZWN0ZWRfY29uZmlnGAkgASgLMiEuaWRwLmJ1aWxkLnYxLkRldGVjdGVkQnVpbGRDb25maWciTwoRQ2hlY2tRdW90YVJlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJEiQKHGluY29taW5nX2ltYWdlX3NpemVfZXN0aW1hdGUYAiABKAMipAEKEkNoZWNrUXVvdGFSZXNwb25zZRIZChFjbGVhbnVwX3BlcmZvcm1lZBgBIAEoCBIbChNjdXJyZW50X3VzYWdlX2J5dGVzGAIgASgDEhMKC3F1b3RhX2J5dGVzGAMgASgDEjIKDmNsZWFuZWRfaW1hZ2VzGAQgAygLMhouaWRwLmJ1aWxkLnYxLkNsZWFuZWRJbWFnZRINCgVlcnJvchgFIAEoCSJBCgxDbGVhbmVkSW1hZ2USEAoIYXBwX25hbWUYASABKAkSCwoDdGFnGAIgASgJEhIKCnNpemVfYnl0ZXMYAyABKAMilwEKEVRyYWNrSW1hZ2VSZXF1ZXN0EhQKDHdvcmtzcGFjZV9pZBgBIAEoCRIOCgZhcHBfaWQYAiABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRIUCgxyZWdpc3RyeV91cmwYBSABKAkSEgoKcmVwb3NpdG9yeRgGIAEoCRIVCg1yZWdpc3RyeV90eXBlGAcgASgJIlAKElRyYWNrSW1hZ2VSZXNwb25zZRISCgpzaXplX2J5dGVzGAEgASgDEhcKD25ld190b3RhbF91c2FnZRgCIAEoAxINCgVlcnJvchgDIAEoCSp1CgxSZWdpc3RyeVR5cGUSHQoZUkVHSVNUUllfVFlQRV9VTlNQRUNJRklFRBAAEhYKElJFR0lTVFJZX1RZUEVfR0hDUhABEhUKEVJFR0lTVFJZX1RZUEVfQUNSEAISFwoTUkVHSVNUUllfVFlQRV9PUkJJVBADKrEBCg9CdWlsZFN0ZXBTdGF0dXMSIQodQlVJTERfU1RFUF9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlCVUlMRF9TVEVQX1NUQVRVU19QRU5ESU5HEAESHQoZQlVJTERfU1RFUF9TVEFUVVNfUlVOTklORxACEh8KG0JVSUxEX1NURVBfU1RBVFVTX0NPTVBMRVRFRBADEhwKGEJVSUxEX1NURVBfU1RBVFVTX0ZBSUxFRBAEMp8FCgxCdWlsZFNlcnZpY2USZAoRQW5hbHl6ZVJlcG9zaXRvcnkSJi5pZHAuYnVpbGQudjEuQW5hbHl6ZVJlcG9zaXRvcnlSZXF1ZXN0GicuaWRwLmJ1aWxkLnYxLkFuYWx5emVSZXBvc2l0b3J5UmVzcG9uc2USTwoKQnVpbGRJbWFnZRIfLmlkcC5idWlsZC52MS5CdWlsZEltYWdlUmVxdWVzdBogLmlkcC5idWlsZC52MS5CdWlsZEltYWdlUmVzcG9uc2USYAoPU3RyZWFtQnVpbGRMb2dzEiQuaWRwLmJ1aWxkLnYxLlN0cmVhbUJ1aWxkTG9nc1JlcXVlc3QaJS5pZHAuYnVpbGQudjEuU3RyZWFtQnVpbGRMb2dzUmVzcG9uc2UwARJnChJTdGFydEJ1aWxkV29ya2Zsb3cSJy5pZHAuYnVpbGQudjEuU3RhcnRCdWlsZFdvcmtmbG93UmVxdWVzdBooLmlkcC5idWlsZC52MS5TdGFydEJ1aWxkV29ya2Zsb3dSZXNwb25zZRJhChBHZXRCdWlsZFByb2dyZXNzEiUuaWRwLmJ1aWxkLnYxLkdldEJ1aWxkUHJvZ3Jlc3NSZXF1ZXN0GiYuaWRwLmJ1aWxkLnYxLkdldEJ1aWxkUHJvZ3Jlc3NSZXNwb25zZRJZChRDaGVja1F1b3RhQW5kQ2xlYW51cBIfLmlkcC5idWlsZC52MS5DaGVja1F1b3RhUmVxdWVzdBogLmlkcC5idWlsZC52MS5DaGVja1F1b3RhUmVzcG9uc2USTwoKVHJhY2tJbWFnZRIfLmlkcC5idWlsZC52MS5UcmFja0ltYWdlUmVxdWVzdBogLmlkcC5idWlsZC52MS5UcmFja0ltYWdlUmVzcG9uc2VCQFo+Z2l0aHViLmNvbS9kcmV3cGF5bWVudC9vcmJpdC9wcm90by9nZW4vZ28vaWRwL2J1aWxkL3YxO2J1aWxkdjFiBnByb3RvMw");
+  fileDesc("ChhpZHAvYnVpbGQvdjEvYnVpbGQucHJvdG8SDGlkcC5idWlsZC52MSJVChhBbmFseXplUmVwb3NpdG9yeVJlcXVlc3QSEAoIcmVwb191cmwYASABKAkSCwoDcmVmGAIgASgJEhoKEmluc3RhbGxhdGlvbl90b2tlbhgDIAEoCSKHAQoZQW5hbHl6ZVJlcG9zaXRvcnlSZXNwb25zZRIQCghkZXRlY3RlZBgBIAEoCBIxCgZjb25maWcYAiABKAsyIS5pZHAuYnVpbGQudjEuRGV0ZWN0ZWRCdWlsZENvbmZpZxINCgVlcnJvchgDIAEoCRIWCg5kZXRlY3RlZF9maWxlcxgEIAMoCSK9AQoTRGV0ZWN0ZWRCdWlsZENvbmZpZxIQCghsYW5ndWFnZRgBIAEoCRIYChBsYW5ndWFnZV92ZXJzaW9uGAIgASgJEhEKCWZyYW1ld29yaxgDIAEoCRIVCg1idWlsZF9jb21tYW5kGAQgASgJEhUKDXN0YXJ0X2NvbW1hbmQYBSABKAkSOQoPcGFja2FnZV9tYW5hZ2VyGAYgASgLMiAuaWRwLmJ1aWxkLnYxLlBhY2thZ2VNYW5hZ2VySW5mbyKlAQoSUGFja2FnZU1hbmFnZXJJbmZvEhAKCGRldGVjdGVkGAEgASgIEgwKBG5hbWUYAiABKAkSDgoGc291cmNlGAMgASgJEhAKCGxvY2tmaWxlGAQgASgJEhkKEXJlcXVlc3RlZF92ZXJzaW9uGAUgASgJEhkKEXZlcnNpb25fc3VwcG9ydGVkGAYgASgIEhcKD3N1cHBvcnRlZF9yYW5nZRgHIAEoCSLRAwoRQnVpbGRJbWFnZVJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIOCgZhcHBfaWQYAiABKAkSEAoIcmVwb191cmwYAyABKAkSCwoDcmVmGAQgASgJEhoKEmluc3RhbGxhdGlvbl90b2tlbhgFIAEoCRIdChBsYW5ndWFnZV92ZXJzaW9uGAYgASgJSACIAQESGgoNYnVpbGRfY29tbWFuZBgHIAEoCUgBiAEBEhoKDXN0YXJ0X2NvbW1hbmQYCCABKAlIAogBARJACglidWlsZF9lbnYYCSADKAsyLS5pZHAuYnVpbGQudjEuQnVpbGRJbWFnZVJlcXVlc3QuQnVpbGRFbnZFbnRyeRIuCghyZWdpc3RyeRgKIAEoCzIcLmlkcC5idWlsZC52MS5SZWdpc3RyeUNvbmZpZxIRCglpbWFnZV90YWcYCyABKAkSFwoPcGFja2FnZV9tYW5hZ2VyGAwgASgJGi8KDUJ1aWxkRW52RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUITChFfbGFuZ3VhZ2VfdmVyc2lvbkIQCg5fYnVpbGRfY29tbWFuZEIQCg5fc3RhcnRfY29tbWFuZCKOAQoOUmVnaXN0cnlDb25maWcSKAoEdHlwZRgBIAEoDjIaLmlkcC5idWlsZC52MS5SZWdpc3RyeVR5cGUSCwoDdXJsGAIgASgJEhIKCnJlcG9zaXRvcnkYAyABKAkSDQoFdG9rZW4YBCABKAkSFQoIdXNlcm5hbWUYBSABKAlIAIgBAUILCglfdXNlcm5hbWUitAEKEkJ1aWxkSW1hZ2VSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhEKCWltYWdlX3VybBgCIAEoCRIUCgxpbWFnZV9kaWdlc3QYAyABKAkSDQoFZXJyb3IYBCABKAkSJgoFc3RlcHMYBSADKAsyFy5pZHAuYnVpbGQudjEuQnVpbGRTdGVwEi0KBnN0YXR1cxgGIAEoDjIdLmlkcC5idWlsZC52MS5CdWlsZFN0ZXBTdGF0dXMibgoJQnVpbGRTdGVwEgwKBG5hbWUYASABKAkSLQoGc3RhdHVzGAIgASgOMh0uaWRwLmJ1aWxkLnYxLkJ1aWxkU3RlcFN0YXR1cxIPCgdtZXNzYWdlGAMgASgJEhMKC2R1cmF0aW9uX21zGAQgASgDIkMKFlN0cmVhbUJ1aWxkTG9nc1JlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIVCg1mcm9tX3NlcXVlbmNlGAIgASgDIs0BChdTdHJlYW1CdWlsZExvZ3NSZXNwb25zZRIRCgl0aW1lc3RhbXAYASABKAMSDQoFbGV2ZWwYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIMCgRzdGVwGAQgASgJEhAKCHNlcXVlbmNlGAUgASgDEjAKBnJlc3VsdBgGIAEoCzIgLmlkcC5idWlsZC52MS5CdWlsZEltYWdlUmVzcG9uc2USLQoGc3RhdHVzGAcgASgOMh0uaWRwLmJ1aWxkLnYxLkJ1aWxkU3RlcFN0YXR1cyIoChJDYW5jZWxCdWlsZFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCSIoChNDYW5jZWxCdWlsZFJlc3BvbnNlEhEKCWNhbmNlbGxlZBgBIAEoCCLbAwoZU3RhcnRCdWlsZFdvcmtmbG93UmVxdWVzdBIOCgZhcHBfaWQYASABKAkSFAoMd29ya3NwYWNlX2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSEAoIcmVwb191cmwYBCABKAkSCwoDcmVmGAUgASgJEi4KCHJlZ2lzdHJ5GAYgASgLMhwuaWRwLmJ1aWxkLnYxLlJlZ2lzdHJ5Q29uZmlnEh0KEGxhbmd1YWdlX3ZlcnNpb24YByABKAlIAIgBARIaCg1idWlsZF9jb21tYW5kGAggASgJSAGIAQESGgoNc3RhcnRfY29tbWFuZBgJIAEoCUgCiAEBEkgKCWJ1aWxkX2VudhgKIAMoCzI1LmlkcC5idWlsZC52MS5TdGFydEJ1aWxkV29ya2Zsb3dSZXF1ZXN0LkJ1aWxkRW52RW50cnkSEQoJaW1hZ2VfdGFnGAsgASgJEhoKEmluc3RhbGxhdGlvbl90b2tlbhgMIAEoCRovCg1CdWlsZEVudkVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCEwoRX2xhbmd1YWdlX3ZlcnNpb25CEAoOX2J1aWxkX2NvbW1hbmRCEAoOX3N0YXJ0X2NvbW1hbmQiUQoaU3RhcnRCdWlsZFdvcmtmbG93UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBITCgt3b3JrZmxvd19pZBgCIAEoCRINCgVlcnJvchgDIAEoCSIuChdHZXRCdWlsZFByb2dyZXNzUmVxdWVzdBITCgt3b3JrZmxvd19pZBgBIAEoCSLxAQoYR2V0QnVpbGRQcm9ncmVzc1Jlc3BvbnNlEhQKDGN1cnJlbnRfc3RlcBgBIAEoCRITCgtzdGVwc190b3RhbBgCIAEoBRIVCg1zdGVwc19jdXJyZW50GAMgASgFEg8KB21lc3NhZ2UYBCABKAkSDgoGc3RhdHVzGAUgASgJEhEKCWltYWdlX3VybBgGIAEoCRIUCgxpbWFnZV9kaWdlc3QYByABKAkSDQoFZXJyb3IYCCABKAkSOgoPZGV0ZWN0ZWRfY29uZmlnGAkgASgLMiEuaWRwLmJ1aWxkLnYxLkRldGVjdGVkQnVpbGRDb25maWciTwoRQ2hlY2tRdW90YVJlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJEiQKHGluY29taW5nX2ltYWdlX3NpemVfZXN0aW1hdGUYAiABKAMipAEKEkNoZWNrUXVvdGFSZXNwb25zZRIZChFjbGVhbnVwX3BlcmZvcm1lZBgBIAEoCBIbChNjdXJyZW50X3VzYWdlX2J5dGVzGAIgASgDEhMKC3F1b3RhX2J5dGVzGAMgASgDEjIKDmNsZWFuZWRfaW1hZ2VzGAQgAygLMhouaWRwLmJ1aWxkLnYxLkNsZWFuZWRJbWFnZRINCgVlcnJvchgFIAEoCSJBCgxDbGVhbmVkSW1hZ2USEAoIYXBwX25hbWUYASABKAkSCwoDdGFnGAIgASgJEhIKCnNpemVfYnl0ZXMYAyABKAMilwEKEVRyYWNrSW1hZ2VSZXF1ZXN0EhQKDHdvcmtzcGFjZV9pZBgBIAEoCRIOCgZhcHBfaWQYAiABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRIUCgxyZWdpc3RyeV91cmwYBSABKAkSEgoKcmVwb3NpdG9yeRgGIAEoCRIVCg1yZWdpc3RyeV90eXBlGAcgASgJIlAKElRyYWNrSW1hZ2VSZXNwb25zZRISCgpzaXplX2J5dGVzGAEgASgDEhcKD25ld190b3RhbF91c2FnZRgCIAEoAxINCgVlcnJvchgDIAEoCSp1CgxSZWdpc3RyeVR5cGUSHQoZUkVHSVNUUllfVFlQRV9VTlNQRUNJRklFRBAAEhYKElJFR0lTVFJZX1RZUEVfR0hDUhABEhUKEVJFR0lTVFJZX1RZUEVfQUNSEAISFwoTUkVHSVNUUllfVFlQRV9PUkJJVBADKvABCg9CdWlsZFN0ZXBTdGF0dXMSIQodQlVJTERfU1RFUF9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlCVUlMRF9TVEVQX1NUQVRVU19QRU5ESU5HEAESHQoZQlVJTERfU1RFUF9TVEFUVVNfUlVOTklORxACEh8KG0JVSUxEX1NURVBfU1RBVFVTX0NPTVBMRVRFRBADEhwKGEJVSUxEX1NURVBfU1RBVFVTX0ZBSUxFRBAEEh8KG0JVSUxEX1NURVBfU1RBVFVTX0NBTkNFTExFRBAFEhwKGEJVSUxEX1NURVBfU1RBVFVTX1FVRVVFRBAGMvMFCgxCdWlsZFNlcnZpY2USZAoRQW5hbHl6ZVJlcG9zaXRvcnkSJi5pZHAuYnVpbGQudjEuQW5hbHl6ZVJlcG9zaXRvcnlSZXF1ZXN0GicuaWRwLmJ1aWxkLnYxLkFuYWx5emVSZXBvc2l0b3J5UmVzcG9uc2USTwoKQnVpbGRJbWFnZRIfLmlkcC5idWlsZC52MS5CdWlsZEltYWdlUmVxdWVzdBogLmlkcC5idWlsZC52MS5CdWlsZEltYWdlUmVzcG9uc2USYAoPU3RyZWFtQnVpbGRMb2dzEiQuaWRwLmJ1aWxkLnYxLlN0cmVhbUJ1aWxkTG9nc1JlcXVlc3QaJS5pZHAuYnVpbGQudjEuU3RyZWFtQnVpbGRMb2dzUmVzcG9uc2UwARJSCgtDYW5jZWxCdWlsZBIgLmlkcC5idWlsZC52MS5DYW5jZWxCdWlsZFJlcXVlc3QaIS5pZHAuYnVpbGQudjEuQ2FuY2VsQnVpbGRSZXNwb25zZRJnChJTdGFydEJ1aWxkV29ya2Zsb3cSJy5pZHAuYnVpbGQudjEuU3RhcnRCdWlsZFdvcmtmbG93UmVxdWVzdBooLmlkcC5idWlsZC52MS5TdGFydEJ1aWxkV29ya2Zsb3dSZXNwb25zZRJhChBHZXRCdWlsZFByb2dyZXNzEiUuaWRwLmJ1aWxkLnYxLkdldEJ1aWxkUHJvZ3Jlc3NSZXF1ZXN0GiYuaWRwLmJ1aWxkLnYxLkdldEJ1aWxkUHJvZ3Jlc3NSZXNwb25zZRJZChRDaGVja1F1b3RhQW5kQ2xlYW51cBIfLmlkcC5idWlsZC52MS5DaGVja1F1b3RhUmVxdWVzdBogLmlkcC5idWlsZC52MS5DaGVja1F1b3RhUmVzcG9uc2USTwoKVHJhY2tJbWFnZRIfLmlkcC5idWlsZC52MS5UcmFja0ltYWdlUmVxdWVzdBogLmlkcC5idWlsZC52MS5UcmFja0ltYWdlUmVzcG9uc2VCQFo+Z2l0aHViLmNvbS9kcmV3cGF5bWVudC9vcmJpdC9wcm90by9nZW4vZ28vaWRwL2J1aWxkL3YxO2J1aWxkdjFiBnByb3RvMw");
 
 /**
  * AnalyzeRepositoryRequest contains parameters for repository analysis
@@ -389,6 +389,13 @@ export type BuildImageResponse = Message<"idp.build.v1.BuildImageResponse"> & {
    * @generated from field: repeated idp.build.v1.BuildStep steps = 5;
    */
   steps: BuildStep[];
+
+  /**
+   * Terminal state: COMPLETED, FAILED or CANCELLED
+   *
+   * @generated from field: idp.build.v1.BuildStepStatus status = 6;
+   */
+  status: BuildStepStatus;
 };
 
 /**
@@ -442,6 +449,13 @@ export type StreamBuildLogsRequest = Message<"idp.build.v1.StreamBuildLogsReques
    * @generated from field: string request_id = 1;
    */
   requestId: string;
+
+  /**
+   * Resume after this sequence number (0 streams from the start)
+   *
+   * @generated from field: int64 from_sequence = 2;
+   */
+  fromSequence: bigint;
 };
 
 /**
@@ -452,12 +466,15 @@ export const StreamBuildLogsRequestSchema: GenMessage<StreamBuildLogsRequest> =
   messageDesc(file_idp_build_v1_build, 8);
 
 /**
- * StreamBuildLogsResponse represents a single log line
+ * StreamBuildLogsResponse represents a single log line, or the build result
+ * on the final message of the stream
  *
  * @generated from message idp.build.v1.StreamBuildLogsResponse
  */
 export type StreamBuildLogsResponse = Message<"idp.build.v1.StreamBuildLogsResponse"> & {
   /**
+   * Unix milliseconds
+   *
    * @generated from field: int64 timestamp = 1;
    */
   timestamp: bigint;
@@ -480,6 +497,27 @@ export type StreamBuildLogsResponse = Message<"idp.build.v1.StreamBuildLogsRespo
    * @generated from field: string step = 4;
    */
   step: string;
+
+  /**
+   * Increases by one per log line, starting at 1
+   *
+   * @generated from field: int64 sequence = 5;
+   */
+  sequence: bigint;
+
+  /**
+   * Set only on the final message once the build finishes
+   *
+   * @generated from field: idp.build.v1.BuildImageResponse result = 6;
+   */
+  result?: BuildImageResponse | undefined;
+
+  /**
+   * QUEUED while waiting for a build slot, then RUNNING; the terminal state on the final message
+   *
+   * @generated from field: idp.build.v1.BuildStepStatus status = 7;
+   */
+  status: BuildStepStatus;
 };
 
 /**
@@ -489,6 +527,44 @@ export type StreamBuildLogsResponse = Message<"idp.build.v1.StreamBuildLogsRespo
 export const StreamBuildLogsResponseSchema: GenMessage<StreamBuildLogsResponse> = /*@__PURE__*/
   messageDesc(file_idp_build_v1_build, 9);
 
+/**
+ * CancelBuildRequest identifies the build to cancel
+ *
+ * @generated from message idp.build.v1.CancelBuildRequest
+ */
+export type CancelBuildRequest = Message<"idp.build.v1.CancelBuildRequest"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+};
+
+/**
+ * Describes the message idp.build.v1.CancelBuildRequest.
+ * Use `create(CancelBuildRequestSchema)` to create a new message.
+ */
+export const CancelBuildRequestSchema: GenMessage<CancelBuildRequest> = /*@__PURE__*/
+  messageDesc(file_idp_build_v1_build, 10);
+
+/**
+ * CancelBuildResponse confirms the cancellation
+ *
+ * @generated from message idp.build.v1.CancelBuildResponse
+ */
+export type CancelBuildResponse = Message<"idp.build.v1.CancelBuildResponse"> & {
+  /**
+   * @generated from field: bool cancelled = 1;
+   */
+  cancelled: boolean;
+};
+
+/**
+ * Describes the message idp.build.v1.CancelBuildResponse.
+ * Use `create(CancelBuildResponseSchema)` to create a new message.
+ */
+export const CancelBuildResponseSchema: GenMessage<CancelBuildResponse> = /*@__PURE__*/
+  messageDesc(file_idp_build_v1_build, 11);
+
 /**
  * StartBuildWorkflowRequest initiates a Temporal build workflow
  *
@@ -579,7 +655,7 @@ export type StartBuildWorkflowRequest = Message<"idp.build.v1.StartBuildWorkflow
  * Use `create(StartBuildWorkflowRequestSchema)` to create a new message.
  */
 export const StartBuildWorkflowRequestSchema: GenMessage<StartBuildWorkflowRequest> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 10);
+  messageDesc(file_idp_build_v1_build, 12);
 
 /**
  * StartBuildWorkflowResponse contains the workflow ID
@@ -612,7 +688,7 @@ export type StartBuildWorkflowResponse = Message<"idp.build.v1.StartBuildWorkflo
  * Use `create(StartBuildWorkflowResponseSchema)` to create a new message.
  */
 export const StartBuildWorkflowResponseSchema: GenMessage<StartBuildWorkflowResponse> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 11);
+  messageDesc(file_idp_build_v1_build, 13);
 
 /**
  * GetBuildProgressRequest queries a build workflow's progress
@@ -633,7 +709,7 @@ export type GetBuildProgressRequest = Message<"idp.build.v1.GetBuildProgressRequ
  * Use `create(GetBuildProgressRequestSchema)` to create a new message.
  */
 export const GetBuildProgressRequestSchema: GenMessage<GetBuildProgressRequest> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 12);
+  messageDesc(file_idp_build_v1_build, 14);
 
 /**
  * GetBuildProgressResponse contains the current build progress
@@ -710,7 +786,7 @@ export type GetBuildProgressResponse = Message<"idp.build.v1.GetBuildProgressRes
  * Use `create(GetBuildProgressResponseSchema)` to create a new message.
  */
 export const GetBuildProgressResponseSchema: GenMessage<GetBuildProgressResponse> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 13);
+  messageDesc(file_idp_build_v1_build, 15);
 
 /**
  * Quota management messages
@@ -736,7 +812,7 @@ export type CheckQuotaRequest = Message<"idp.build.v1.CheckQuotaRequest"> & {
  * Use `create(CheckQuotaRequestSchema)` to create a new message.
  */
 export const CheckQuotaRequestSchema: GenMessage<CheckQuotaRequest> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 14);
+  messageDesc(file_idp_build_v1_build, 16);
 
 /**
  * @generated from message idp.build.v1.CheckQuotaResponse
@@ -773,7 +849,7 @@ export type CheckQuotaResponse = Message<"idp.build.v1.CheckQuotaResponse"> & {
  * Use `create(CheckQuotaResponseSchema)` to create a new message.
  */
 export const CheckQuotaResponseSchema: GenMessage<CheckQuotaResponse> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 15);
+  messageDesc(file_idp_build_v1_build, 17);
 
 /**
  * @generated from message idp.build.v1.CleanedImage
@@ -800,7 +876,7 @@ export type CleanedImage = Message<"idp.build.v1.CleanedImage"> & {
  * Use `create(CleanedImageSchema)` to create a new message.
  */
 export const CleanedImageSchema: GenMessage<CleanedImage> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 16);
+  messageDesc(file_idp_build_v1_build, 18);
 
 /**
  * @generated from message idp.build.v1.TrackImageRequest
@@ -849,7 +925,7 @@ export type TrackImageRequest = Message<"idp.build.v1.TrackImageRequest"> & {
  * Use `create(TrackImageRequestSchema)` to create a new message.
  */
 export const TrackImageRequestSchema: GenMessage<TrackImageRequest> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 17);
+  messageDesc(file_idp_build_v1_build, 19);
 
 /**
  * @generated from message idp.build.v1.TrackImageResponse
@@ -876,7 +952,7 @@ export type TrackImageResponse = Message<"idp.build.v1.TrackImageResponse"> & {
  * Use `create(TrackImageResponseSchema)` to create a new message.
  */
 export const TrackImageResponseSchema: GenMessage<TrackImageResponse> = /*@__PURE__*/
-  messageDesc(file_idp_build_v1_build, 18);
+  messageDesc(file_idp_build_v1_build, 20);
 
 /**
  * RegistryType enum
@@ -941,6 +1017,16 @@ export enum BuildStepStatus {
    * @generated from enum value: BUILD_STEP_STATUS_FAILED = 4;
    */
   FAILED = 4,
+
+  /**
+   * @generated from enum value: BUILD_STEP_STATUS_CANCELLED = 5;
+   */
+  CANCELLED = 5,
+
+  /**
+   * @generated from enum value: BUILD_STEP_STATUS_QUEUED = 6;
+   */
+  QUEUED = 6,
 }
 
 /**
@@ -985,6 +1071,16 @@ export const BuildService: GenService<{
     input: typeof StreamBuildLogsRequestSchema;
     output: typeof StreamBuildLogsResponseSchema;
   },
+  /**
+   * Cancel an in-progress build
+   *
+   * @generated from rpc idp.build.v1.BuildService.CancelBuild
+   */
+  cancelBuild: {
+    methodKind: "unary";
+    input: typeof CancelBuildRequestSchema;
+    output: typeof CancelBuildResponseSchema;
+  },
   /**
    * Start a build workflow via Temporal
    *
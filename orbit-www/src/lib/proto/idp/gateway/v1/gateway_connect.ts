@@ -5,7 +5,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeletePolicyRequest, DeletePolicyResponse, DeleteVirtualClusterRequest, DeleteVirtualClusterResponse, DescribeConsumerGroupRequest, DescribeConsumerGroupResponse, EmitClientActivityRequest, EmitClientActivityResponse, GetFullConfigRequest, GetFullConfigResponse, GetStatusRequest, GetStatusResponse, ListConsumerGroupsRequest, ListConsumerGroupsResponse, ListCredentialsRequest, ListCredentialsResponse, ListPoliciesRequest, ListPoliciesResponse, ListTopicACLsRequest, ListTopicACLsResponse, ListVirtualClustersRequest, ListVirtualClustersResponse, ResetConsumerGroupOffsetsRequest, ResetConsumerGroupOffsetsResponse, RevokeCredentialRequest, RevokeCredentialResponse, RevokeTopicACLRequest, RevokeTopicACLResponse, SetVirtualClusterReadOnlyRequest, SetVirtualClusterReadOnlyResponse, TopicConfigUpdatedRequest, TopicConfigUpdatedResponse, TopicCreatedRequest, TopicCreatedResponse, TopicDeletedRequest, TopicDeletedResponse, UpsertCredentialRequest, UpsertCredentialResponse, UpsertPolicyRequest, UpsertPolicyResponse, UpsertTopicACLRequest, UpsertTopicACLResponse, UpsertVirtualClusterRequest, UpsertVirtualClusterResponse } from "./gateway_pb.js";
+import { DeletePolicyRequest, DeletePolicyResponse, DeleteVirtualClusterRequest, DeleteVirtualClusterResponse, DescribeConsumerGroupRequest, DescribeConsumerGroupResponse, EmitClientActivityRequest, EmitClientActivityResponse, ExportConfigRequest, ExportConfigResponse, GetFullConfigRequest, GetFullConfigResponse, GetStatusRequest, GetStatusResponse, ImportConfigRequest, ImportConfigResponse, ListConsumerGroupsRequest, ListConsumerGroupsResponse, ListCredentialsRequest, ListCredentialsResponse, ListPoliciesRequest, ListPoliciesResponse, ListTopicACLsRequest, ListTopicACLsResponse, ListVirtualClustersRequest, ListVirtualClustersResponse, ResetConsumerGroupOffsetsRequest, ResetConsumerGroupOffsetsResponse, RevokeCredentialRequest, RevokeCredentialResponse, RevokeTopicACLRequest, RevokeTopicACLResponse, SetVirtualClusterReadOnlyRequest, SetVirtualClusterReadOnlyResponse, TopicConfigUpdatedRequest, TopicConfigUpdatedResponse, TopicCreatedRequest, TopicCreatedResponse, TopicDeletedRequest, TopicDeletedResponse, UpsertCredentialRequest, UpsertCredentialResponse, UpsertPolicyRequest, UpsertPolicyResponse, UpsertTopicACLRequest, UpsertTopicACLResponse, UpsertVirtualClusterRequest, UpsertVirtualClusterResponse } from "./gateway_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -190,6 +190,26 @@ export const BifrostAdminService = {
       O: ResetConsumerGroupOffsetsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Backup and migration
+     *
+     * @generated from rpc idp.gateway.v1.BifrostAdminService.ExportConfig
+     */
+    exportConfig: {
+      name: "ExportConfig",
+      I: ExportConfigRequest,
+      O: ExportConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc idp.gateway.v1.BifrostAdminService.ImportConfig
+     */
+    importConfig: {
+      name: "ImportConfig",
+      I: ImportConfigRequest,
+      O: ImportConfigResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file idp/gateway/v1/gateway.proto.
  */
 export const file_idp_gateway_v1_gateway: GenFile = /*@__PURE__*/
-  fileDesc("ChxpZHAvZ2F0ZXdheS92MS9nYXRld2F5LnByb3RvEg5pZHAuZ2F0ZXdheS52MSK1AgoUVmlydHVhbENsdXN0ZXJDb25maWcSCgoCaWQYASABKAkSFgoOYXBwbGljYXRpb25faWQYAiABKAkSGAoQYXBwbGljYXRpb25fc2x1ZxgDIAEoCRIWCg53b3Jrc3BhY2Vfc2x1ZxgEIAEoCRITCgtlbnZpcm9ubWVudBgFIAEoCRIUCgx0b3BpY19wcmVmaXgYBiABKAkSFAoMZ3JvdXBfcHJlZml4GAcgASgJEh0KFXRyYW5zYWN0aW9uX2lkX3ByZWZpeBgIIAEoCRIXCg9hZHZlcnRpc2VkX2hvc3QYCSABKAkSFwoPYWR2ZXJ0aXNlZF9wb3J0GAogASgFEiIKGnBoeXNpY2FsX2Jvb3RzdHJhcF9zZXJ2ZXJzGAsgASgJEhEKCXJlYWRfb25seRgMIAEoCCJTChtVcHNlcnRWaXJ0dWFsQ2x1c3RlclJlcXVlc3QSNAoGY29uZmlnGAEgASgLMiQuaWRwLmdhdGV3YXkudjEuVmlydHVhbENsdXN0ZXJDb25maWciLwocVXBzZXJ0VmlydHVhbENsdXN0ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjkKG0RlbGV0ZVZpcnR1YWxDbHVzdGVyUmVxdWVzdBIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkiLwocRGVsZXRlVmlydHVhbENsdXN0ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIlEKIFNldFZpcnR1YWxDbHVzdGVyUmVhZE9ubHlSZXF1ZXN0EhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgBIAEoCRIRCglyZWFkX29ubHkYAiABKAgiNAohU2V0VmlydHVhbENsdXN0ZXJSZWFkT25seVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiFgoUR2V0RnVsbENvbmZpZ1JlcXVlc3Qi9wEKFUdldEZ1bGxDb25maWdSZXNwb25zZRI+ChB2aXJ0dWFsX2NsdXN0ZXJzGAEgAygLMiQuaWRwLmdhdGV3YXkudjEuVmlydHVhbENsdXN0ZXJDb25maWcSNQoLY3JlZGVudGlhbHMYAiADKAsyIC5pZHAuZ2F0ZXdheS52MS5DcmVkZW50aWFsQ29uZmlnEi4KCHBvbGljaWVzGAMgAygLMhwuaWRwLmdhdGV3YXkudjEuUG9saWN5Q29uZmlnEjEKCnRvcGljX2FjbHMYBSADKAsyHS5pZHAuZ2F0ZXdheS52MS5Ub3BpY0FDTEVudHJ5SgQIBBAFIhIKEEdldFN0YXR1c1JlcXVlc3Qi3AEKEUdldFN0YXR1c1Jlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIaChJhY3RpdmVfY29ubmVjdGlvbnMYAiABKAUSHQoVdmlydHVhbF9jbHVzdGVyX2NvdW50GAMgASgFEkgKDHZlcnNpb25faW5mbxgEIAMoCzIyLmlkcC5nYXRld2F5LnYxLkdldFN0YXR1c1Jlc3BvbnNlLlZlcnNpb25JbmZvRW50cnkaMgoQVmVyc2lvbkluZm9FbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIhwKGkxpc3RWaXJ0dWFsQ2x1c3RlcnNSZXF1ZXN0Il0KG0xpc3RWaXJ0dWFsQ2x1c3RlcnNSZXNwb25zZRI+ChB2aXJ0dWFsX2NsdXN0ZXJzGAEgAygLMiQuaWRwLmdhdGV3YXkudjEuVmlydHVhbENsdXN0ZXJDb25maWciVwoQQ3VzdG9tUGVybWlzc2lvbhIVCg1yZXNvdXJjZV90eXBlGAEgASgJEhgKEHJlc291cmNlX3BhdHRlcm4YAiABKAkSEgoKb3BlcmF0aW9ucxgDIAMoCSLXAQoQQ3JlZGVudGlhbENvbmZpZxIKCgJpZBgBIAEoCRIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSFQoNcGFzc3dvcmRfaGFzaBgEIAEoCRI0Cgh0ZW1wbGF0ZRgFIAEoDjIiLmlkcC5nYXRld2F5LnYxLlBlcm1pc3Npb25UZW1wbGF0ZRI8ChJjdXN0b21fcGVybWlzc2lvbnMYBiADKAsyIC5pZHAuZ2F0ZXdheS52MS5DdXN0b21QZXJtaXNzaW9uIksKF1Vwc2VydENyZWRlbnRpYWxSZXF1ZXN0EjAKBmNvbmZpZxgBIAEoCzIgLmlkcC5nYXRld2F5LnYxLkNyZWRlbnRpYWxDb25maWciKwoYVXBzZXJ0Q3JlZGVudGlhbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMAoXUmV2b2tlQ3JlZGVudGlhbFJlcXVlc3QSFQoNY3JlZGVudGlhbF9pZBgBIAEoCSIrChhSZXZva2VDcmVkZW50aWFsUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI0ChZMaXN0Q3JlZGVudGlhbHNSZXF1ZXN0EhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgBIAEoCSJQChdMaXN0Q3JlZGVudGlhbHNSZXNwb25zZRI1CgtjcmVkZW50aWFscxgBIAMoCzIgLmlkcC5nYXRld2F5LnYxLkNyZWRlbnRpYWxDb25maWci7AEKDFBvbGljeUNvbmZpZxIKCgJpZBgBIAEoCRITCgtlbnZpcm9ubWVudBgCIAEoCRIWCg5tYXhfcGFydGl0aW9ucxgDIAEoBRIWCg5taW5fcGFydGl0aW9ucxgEIAEoBRIYChBtYXhfcmV0ZW50aW9uX21zGAUgASgDEh4KFm1pbl9yZXBsaWNhdGlvbl9mYWN0b3IYBiABKAUSIAoYYWxsb3dlZF9jbGVhbnVwX3BvbGljaWVzGAcgAygJEhYKDm5hbWluZ19wYXR0ZXJuGAggASgJEhcKD21heF9uYW1lX2xlbmd0aBgJIAEoBSJDChNVcHNlcnRQb2xpY3lSZXF1ZXN0EiwKBmNvbmZpZxgBIAEoCzIcLmlkcC5nYXRld2F5LnYxLlBvbGljeUNvbmZpZyInChRVcHNlcnRQb2xpY3lSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIigKE0RlbGV0ZVBvbGljeVJlcXVlc3QSEQoJcG9saWN5X2lkGAEgASgJIicKFERlbGV0ZVBvbGljeVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiKgoTTGlzdFBvbGljaWVzUmVxdWVzdBITCgtlbnZpcm9ubWVudBgBIAEoCSJGChRMaXN0UG9saWNpZXNSZXNwb25zZRIuCghwb2xpY2llcxgBIAMoCzIcLmlkcC5nYXRld2F5LnYxLlBvbGljeUNvbmZpZyKUAQoNVG9waWNBQ0xFbnRyeRIKCgJpZBgBIAEoCRIVCg1jcmVkZW50aWFsX2lkGAIgASgJEhsKE3RvcGljX3BoeXNpY2FsX25hbWUYAyABKAkSEwoLcGVybWlzc2lvbnMYBCADKAkSLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiRQoVVXBzZXJ0VG9waWNBQ0xSZXF1ZXN0EiwKBWVudHJ5GAEgASgLMh0uaWRwLmdhdGV3YXkudjEuVG9waWNBQ0xFbnRyeSIpChZVcHNlcnRUb3BpY0FDTFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiJwoVUmV2b2tlVG9waWNBQ0xSZXF1ZXN0Eg4KBmFjbF9pZBgBIAEoCSIpChZSZXZva2VUb3BpY0FDTFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiLQoUTGlzdFRvcGljQUNMc1JlcXVlc3QSFQoNY3JlZGVudGlhbF9pZBgBIAEoCSJHChVMaXN0VG9waWNBQ0xzUmVzcG9uc2USLgoHZW50cmllcxgBIAMoCzIdLmlkcC5nYXRld2F5LnYxLlRvcGljQUNMRW50cnkioAIKE1RvcGljQ3JlYXRlZFJlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhQKDHZpcnR1YWxfbmFtZRgCIAEoCRIVCg1waHlzaWNhbF9uYW1lGAMgASgJEhIKCnBhcnRpdGlvbnMYBCABKAUSGgoScmVwbGljYXRpb25fZmFjdG9yGAUgASgFEj8KBmNvbmZpZxgGIAMoCzIvLmlkcC5nYXRld2F5LnYxLlRvcGljQ3JlYXRlZFJlcXVlc3QuQ29uZmlnRW50cnkSIAoYY3JlYXRlZF9ieV9jcmVkZW50aWFsX2lkGAcgASgJGi0KC0NvbmZpZ0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiOQoUVG9waWNDcmVhdGVkUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIQCgh0b3BpY19pZBgCIAEoCSKAAQoTVG9waWNEZWxldGVkUmVxdWVzdBIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkSFAoMdmlydHVhbF9uYW1lGAIgASgJEhUKDXBoeXNpY2FsX25hbWUYAyABKAkSIAoYZGVsZXRlZF9ieV9jcmVkZW50aWFsX2lkGAQgASgJIicKFFRvcGljRGVsZXRlZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgi5QEKGVRvcGljQ29uZmlnVXBkYXRlZFJlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhQKDHZpcnR1YWxfbmFtZRgCIAEoCRJFCgZjb25maWcYAyADKAsyNS5pZHAuZ2F0ZXdheS52MS5Ub3BpY0NvbmZpZ1VwZGF0ZWRSZXF1ZXN0LkNvbmZpZ0VudHJ5EiAKGHVwZGF0ZWRfYnlfY3JlZGVudGlhbF9pZBgEIAEoCRotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIi0KGlRvcGljQ29uZmlnVXBkYXRlZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgicgoPUG9saWN5VmlvbGF0aW9uEg0KBWZpZWxkGAEgASgJEhIKCmNvbnN0cmFpbnQYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIUCgxhY3R1YWxfdmFsdWUYBCABKAkSFQoNYWxsb3dlZF92YWx1ZRgFIAEoCSKgAgoUQ2xpZW50QWN0aXZpdHlSZWNvcmQSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhoKEnNlcnZpY2VfYWNjb3VudF9pZBgCIAEoCRIaChJ0b3BpY192aXJ0dWFsX25hbWUYAyABKAkSEQoJZGlyZWN0aW9uGAQgASgJEhkKEWNvbnN1bWVyX2dyb3VwX2lkGAUgASgJEg0KBWJ5dGVzGAYgASgDEhUKDW1lc3NhZ2VfY291bnQYByABKAMSMAoMd2luZG93X3N0YXJ0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJSChlFbWl0Q2xpZW50QWN0aXZpdHlSZXF1ZXN0EjUKB3JlY29yZHMYASADKAsyJC5pZHAuZ2F0ZXdheS52MS5DbGllbnRBY3Rpdml0eVJlY29yZCJIChpFbWl0Q2xpZW50QWN0aXZpdHlSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhkKEXJlY29yZHNfcHJvY2Vzc2VkGAIgASgFIpQBChRDb25zdW1lckdyb3VwU3VtbWFyeRIQCghncm91cF9pZBgBIAEoCRIxCgVzdGF0ZRgCIAEoDjIiLmlkcC5nYXRld2F5LnYxLkNvbnN1bWVyR3JvdXBTdGF0ZRIUCgxtZW1iZXJfY291bnQYAyABKAUSDgoGdG9waWNzGAQgAygJEhEKCXRvdGFsX2xhZxgFIAEoAyJ+CgxQYXJ0aXRpb25MYWcSDQoFdG9waWMYASABKAkSEQoJcGFydGl0aW9uGAIgASgFEhYKDmN1cnJlbnRfb2Zmc2V0GAMgASgDEhIKCmVuZF9vZmZzZXQYBCABKAMSCwoDbGFnGAUgASgDEhMKC2NvbnN1bWVyX2lkGAYgASgJIsUBChNDb25zdW1lckdyb3VwRGV0YWlsEhAKCGdyb3VwX2lkGAEgASgJEjEKBXN0YXRlGAIgASgOMiIuaWRwLmdhdGV3YXkudjEuQ29uc3VtZXJHcm91cFN0YXRlEhQKDG1lbWJlcl9jb3VudBgDIAEoBRIOCgZ0b3BpY3MYBCADKAkSEQoJdG90YWxfbGFnGAUgASgDEjAKCnBhcnRpdGlvbnMYBiADKAsyHC5pZHAuZ2F0ZXdheS52MS5QYXJ0aXRpb25MYWciNwoZTGlzdENvbnN1bWVyR3JvdXBzUmVxdWVzdBIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkiYQoaTGlzdENvbnN1bWVyR3JvdXBzUmVzcG9uc2USNAoGZ3JvdXBzGAEgAygLMiQuaWRwLmdhdGV3YXkudjEuQ29uc3VtZXJHcm91cFN1bW1hcnkSDQoFZXJyb3IYAiABKAkiTAocRGVzY3JpYmVDb25zdW1lckdyb3VwUmVxdWVzdBIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkiYgodRGVzY3JpYmVDb25zdW1lckdyb3VwUmVzcG9uc2USMgoFZ3JvdXAYASABKAsyIy5pZHAuZ2F0ZXdheS52MS5Db25zdW1lckdyb3VwRGV0YWlsEg0KBWVycm9yGAIgASgJIqcBCiBSZXNldENvbnN1bWVyR3JvdXBPZmZzZXRzUmVxdWVzdBIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkSEAoIZ3JvdXBfaWQYAiABKAkSDQoFdG9waWMYAyABKAkSMwoKcmVzZXRfdHlwZRgEIAEoDjIfLmlkcC5nYXRld2F5LnYxLk9mZnNldFJlc2V0VHlwZRIRCgl0aW1lc3RhbXAYBSABKAMidgohUmVzZXRDb25zdW1lckdyb3VwT2Zmc2V0c1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkSMQoLbmV3X29mZnNldHMYAyADKAsyHC5pZHAuZ2F0ZXdheS52MS5QYXJ0aXRpb25MYWcqvAEKElBlcm1pc3Npb25UZW1wbGF0ZRIjCh9QRVJNSVNTSU9OX1RFTVBMQVRFX1VOU1BFQ0lGSUVEEAASIAocUEVSTUlTU0lPTl9URU1QTEFURV9QUk9EVUNFUhABEiAKHFBFUk1JU1NJT05fVEVNUExBVEVfQ09OU1VNRVIQAhIdChlQRVJNSVNTSU9OX1RFTVBMQVRFX0FETUlOEAMSHgoaUEVSTUlTU0lPTl9URU1QTEFURV9DVVNUT00QBCr3AQoSQ29uc3VtZXJHcm91cFN0YXRlEiQKIENPTlNVTUVSX0dST1VQX1NUQVRFX1VOU1BFQ0lGSUVEEAASHwobQ09OU1VNRVJfR1JPVVBfU1RBVEVfU1RBQkxFEAESLAooQ09OU1VNRVJfR1JPVVBfU1RBVEVfUFJFUEFSSU5HX1JFQkFMQU5DRRACEi0KKUNPTlNVTUVSX0dST1VQX1NUQVRFX0NPTVBMRVRJTkdfUkVCQUxBTkNFEAMSHgoaQ09OU1VNRVJfR1JPVVBfU1RBVEVfRU1QVFkQBBIdChlDT05TVU1FUl9HUk9VUF9TVEFURV9ERUFEEAUqkwEKD09mZnNldFJlc2V0VHlwZRIhCh1PRkZTRVRfUkVTRVRfVFlQRV9VTlNQRUNJRklFRBAAEh4KGk9GRlNFVF9SRVNFVF9UWVBFX0VBUkxJRVNUEAESHAoYT0ZGU0VUX1JFU0VUX1RZUEVfTEFURVNUEAISHwobT0ZGU0VUX1JFU0VUX1RZUEVfVElNRVNUQU1QEAMy5w4KE0JpZnJvc3RBZG1pblNlcnZpY2UScQoUVXBzZXJ0VmlydHVhbENsdXN0ZXISKy5pZHAuZ2F0ZXdheS52MS5VcHNlcnRWaXJ0dWFsQ2x1c3RlclJlcXVlc3QaLC5pZHAuZ2F0ZXdheS52MS5VcHNlcnRWaXJ0dWFsQ2x1c3RlclJlc3BvbnNlEnEKFERlbGV0ZVZpcnR1YWxDbHVzdGVyEisuaWRwLmdhdGV3YXkudjEuRGVsZXRlVmlydHVhbENsdXN0ZXJSZXF1ZXN0GiwuaWRwLmdhdGV3YXkudjEuRGVsZXRlVmlydHVhbENsdXN0ZXJSZXNwb25zZRKAAQoZU2V0VmlydHVhbENsdXN0ZXJSZWFkT25seRIwLmlkcC5nYXRld2F5LnYxLlNldFZpcnR1YWxDbHVzdGVyUmVhZE9ubHlSZXF1ZXN0GjEuaWRwLmdhdGV3YXkudjEuU2V0VmlydHVhbENsdXN0ZXJSZWFkT25seVJlc3BvbnNlEmUKEFVwc2VydENyZWRlbnRpYWwSJy5pZHAuZ2F0ZXdheS52MS5VcHNlcnRDcmVkZW50aWFsUmVxdWVzdBooLmlkcC5nYXRld2F5LnYxLlVwc2VydENyZWRlbnRpYWxSZXNwb25zZRJlChBSZXZva2VDcmVkZW50aWFsEicuaWRwLmdhdGV3YXkudjEuUmV2b2tlQ3JlZGVudGlhbFJlcXVlc3QaKC5pZHAuZ2F0ZXdheS52MS5SZXZva2VDcmVkZW50aWFsUmVzcG9uc2USYgoPTGlzdENyZWRlbnRpYWxzEiYuaWRwLmdhdGV3YXkudjEuTGlzdENyZWRlbnRpYWxzUmVxdWVzdBonLmlkcC5nYXRld2F5LnYxLkxpc3RDcmVkZW50aWFsc1Jlc3BvbnNlElwKDUdldEZ1bGxDb25maWcSJC5pZHAuZ2F0ZXdheS52MS5HZXRGdWxsQ29uZmlnUmVxdWVzdBolLmlkcC5nYXRld2F5LnYxLkdldEZ1bGxDb25maWdSZXNwb25zZRJQCglHZXRTdGF0dXMSIC5pZHAuZ2F0ZXdheS52MS5HZXRTdGF0dXNSZXF1ZXN0GiEuaWRwLmdhdGV3YXkudjEuR2V0U3RhdHVzUmVzcG9uc2USbgoTTGlzdFZpcnR1YWxDbHVzdGVycxIqLmlkcC5nYXRld2F5LnYxLkxpc3RWaXJ0dWFsQ2x1c3RlcnNSZXF1ZXN0GisuaWRwLmdhdGV3YXkudjEuTGlzdFZpcnR1YWxDbHVzdGVyc1Jlc3BvbnNlElkKDFVwc2VydFBvbGljeRIjLmlkcC5nYXRld2F5LnYxLlVwc2VydFBvbGljeVJlcXVlc3QaJC5pZHAuZ2F0ZXdheS52MS5VcHNlcnRQb2xpY3lSZXNwb25zZRJZCgxEZWxldGVQb2xpY3kSIy5pZHAuZ2F0ZXdheS52MS5EZWxldGVQb2xpY3lSZXF1ZXN0GiQuaWRwLmdhdGV3YXkudjEuRGVsZXRlUG9saWN5UmVzcG9uc2USWQoMTGlzdFBvbGljaWVzEiMuaWRwLmdhdGV3YXkudjEuTGlzdFBvbGljaWVzUmVxdWVzdBokLmlkcC5nYXRld2F5LnYxLkxpc3RQb2xpY2llc1Jlc3BvbnNlEl8KDlVwc2VydFRvcGljQUNMEiUuaWRwLmdhdGV3YXkudjEuVXBzZXJ0VG9waWNBQ0xSZXF1ZXN0GiYuaWRwLmdhdGV3YXkudjEuVXBzZXJ0VG9waWNBQ0xSZXNwb25zZRJfCg5SZXZva2VUb3BpY0FDTBIlLmlkcC5nYXRld2F5LnYxLlJldm9rZVRvcGljQUNMUmVxdWVzdBomLmlkcC5nYXRld2F5LnYxLlJldm9rZVRvcGljQUNMUmVzcG9uc2USXAoNTGlzdFRvcGljQUNMcxIkLmlkcC5nYXRld2F5LnYxLkxpc3RUb3BpY0FDTHNSZXF1ZXN0GiUuaWRwLmdhdGV3YXkudjEuTGlzdFRvcGljQUNMc1Jlc3BvbnNlEmsKEkxpc3RDb25zdW1lckdyb3VwcxIpLmlkcC5nYXRld2F5LnYxLkxpc3RDb25zdW1lckdyb3Vwc1JlcXVlc3QaKi5pZHAuZ2F0ZXdheS52MS5MaXN0Q29uc3VtZXJHcm91cHNSZXNwb25zZRJ0ChVEZXNjcmliZUNvbnN1bWVyR3JvdXASLC5pZHAuZ2F0ZXdheS52MS5EZXNjcmliZUNvbnN1bWVyR3JvdXBSZXF1ZXN0Gi0uaWRwLmdhdGV3YXkudjEuRGVzY3JpYmVDb25zdW1lckdyb3VwUmVzcG9uc2USgAEKGVJlc2V0Q29uc3VtZXJHcm91cE9mZnNldHMSMC5pZHAuZ2F0ZXdheS52MS5SZXNldENvbnN1bWVyR3JvdXBPZmZzZXRzUmVxdWVzdBoxLmlkcC5nYXRld2F5LnYxLlJlc2V0Q29uc3VtZXJHcm91cE9mZnNldHNSZXNwb25zZTKoAwoWQmlmcm9zdENhbGxiYWNrU2VydmljZRJZCgxUb3BpY0NyZWF0ZWQSIy5pZHAuZ2F0ZXdheS52MS5Ub3BpY0NyZWF0ZWRSZXF1ZXN0GiQuaWRwLmdhdGV3YXkudjEuVG9waWNDcmVhdGVkUmVzcG9uc2USWQoMVG9waWNEZWxldGVkEiMuaWRwLmdhdGV3YXkudjEuVG9waWNEZWxldGVkUmVxdWVzdBokLmlkcC5nYXRld2F5LnYxLlRvcGljRGVsZXRlZFJlc3BvbnNlEmsKElRvcGljQ29uZmlnVXBkYXRlZBIpLmlkcC5nYXRld2F5LnYxLlRvcGljQ29uZmlnVXBkYXRlZFJlcXVlc3QaKi5pZHAuZ2F0ZXdheS52MS5Ub3BpY0NvbmZpZ1VwZGF0ZWRSZXNwb25zZRJrChJFbWl0Q2xpZW50QWN0aXZpdHkSKS5pZHAuZ2F0ZXdheS52MS5FbWl0Q2xpZW50QWN0aXZpdHlSZXF1ZXN0GiouaWRwLmdhdGV3YXkudjEuRW1pdENsaWVudEFjdGl2aXR5UmVzcG9uc2VCXwoOaWRwLmdhdGV3YXkudjFCB0dhdGV3YXlQAFpCZ2l0aHViLmNvbS9kcmV3cGF5bWVudC9vcmJpdC9wcm90by9nZW4vZ28vaWRwL2dhdGV3YXkvdjE7Z2F0ZXdheXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChxpZHAvZ2F0ZXdheS92MS9nYXRld2F5LnByb3RvEg5pZHAuZ2F0ZXdheS52MSLFBAoUVmlydHVhbENsdXN0ZXJDb25maWcSCgoCaWQYASABKAkSFgoOYXBwbGljYXRpb25faWQYAiABKAkSGAoQYXBwbGljYXRpb25fc2x1ZxgDIAEoCRIWCg53b3Jrc3BhY2Vfc2x1ZxgEIAEoCRITCgtlbnZpcm9ubWVudBgFIAEoCRIUCgx0b3BpY19wcmVmaXgYBiABKAkSFAoMZ3JvdXBfcHJlZml4GAcgASgJEh0KFXRyYW5zYWN0aW9uX2lkX3ByZWZpeBgIIAEoCRIXCg9hZHZlcnRpc2VkX2hvc3QYCSABKAkSFwoPYWR2ZXJ0aXNlZF9wb3J0GAogASgFEiIKGnBoeXNpY2FsX2Jvb3RzdHJhcF9zZXJ2ZXJzGAsgASgJEhEKCXJlYWRfb25seRgMIAEoCBIcChRtYXhfcmVxdWVzdHNfcGVyX3NlYxgNIAEoBRIZChFtYXhfYnl0ZXNfcGVyX3NlYxgOIAEoAxIWCg5hbGxvd2VkX3RvcGljcxgPIAMoCRIVCg1kZW5pZWRfdG9waWNzGBAgAygJEmYKG3RvcGljX3Byb2R1Y2VfYnl0ZXNfcGVyX3NlYxgRIAMoCzJBLmlkcC5nYXRld2F5LnYxLlZpcnR1YWxDbHVzdGVyQ29uZmlnLlRvcGljUHJvZHVjZUJ5dGVzUGVyU2VjRW50cnkaPgocVG9waWNQcm9kdWNlQnl0ZXNQZXJTZWNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBIlMKG1Vwc2VydFZpcnR1YWxDbHVzdGVyUmVxdWVzdBI0CgZjb25maWcYASABKAsyJC5pZHAuZ2F0ZXdheS52MS5WaXJ0dWFsQ2x1c3RlckNvbmZpZyIvChxVcHNlcnRWaXJ0dWFsQ2x1c3RlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiOQobRGVsZXRlVmlydHVhbENsdXN0ZXJSZXF1ZXN0EhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgBIAEoCSIvChxEZWxldGVWaXJ0dWFsQ2x1c3RlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiUQogU2V0VmlydHVhbENsdXN0ZXJSZWFkT25seVJlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhEKCXJlYWRfb25seRgCIAEoCCI0CiFTZXRWaXJ0dWFsQ2x1c3RlclJlYWRPbmx5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIWChRHZXRGdWxsQ29uZmlnUmVxdWVzdCL3AQoVR2V0RnVsbENvbmZpZ1Jlc3BvbnNlEj4KEHZpcnR1YWxfY2x1c3RlcnMYASADKAsyJC5pZHAuZ2F0ZXdheS52MS5WaXJ0dWFsQ2x1c3RlckNvbmZpZxI1CgtjcmVkZW50aWFscxgCIAMoCzIgLmlkcC5nYXRld2F5LnYxLkNyZWRlbnRpYWxDb25maWcSLgoIcG9saWNpZXMYAyADKAsyHC5pZHAuZ2F0ZXdheS52MS5Qb2xpY3lDb25maWcSMQoKdG9waWNfYWNscxgFIAMoCzIdLmlkcC5nYXRld2F5LnYxLlRvcGljQUNMRW50cnlKBAgEEAUiEgoQR2V0U3RhdHVzUmVxdWVzdCKcAgoRR2V0U3RhdHVzUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEhoKEmFjdGl2ZV9jb25uZWN0aW9ucxgCIAEoBRIdChV2aXJ0dWFsX2NsdXN0ZXJfY291bnQYAyABKAUSSAoMdmVyc2lvbl9pbmZvGAQgAygLMjIuaWRwLmdhdGV3YXkudjEuR2V0U3RhdHVzUmVzcG9uc2UuVmVyc2lvbkluZm9FbnRyeRI+ChB2aXJ0dWFsX2NsdXN0ZXJzGAUgAygLMiQuaWRwLmdhdGV3YXkudjEuVmlydHVhbENsdXN0ZXJTdGF0dXMaMgoQVmVyc2lvbkluZm9FbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIoYBChRWaXJ0dWFsQ2x1c3RlclN0YXR1cxIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkSGQoRYmFja2VuZF9yZWFjaGFibGUYAiABKAgSEwoLdG9waWNfY291bnQYAyABKAUSEwoLZ3JvdXBfY291bnQYBCABKAUSDQoFZXJyb3IYBSABKAkiHAoaTGlzdFZpcnR1YWxDbHVzdGVyc1JlcXVlc3QiXQobTGlzdFZpcnR1YWxDbHVzdGVyc1Jlc3BvbnNlEj4KEHZpcnR1YWxfY2x1c3RlcnMYASADKAsyJC5pZHAuZ2F0ZXdheS52MS5WaXJ0dWFsQ2x1c3RlckNvbmZpZyJXChBDdXN0b21QZXJtaXNzaW9uEhUKDXJlc291cmNlX3R5cGUYASABKAkSGAoQcmVzb3VyY2VfcGF0dGVybhgCIAEoCRISCgpvcGVyYXRpb25zGAMgAygJIlsKD1NjcmFtQ3JlZGVudGlhbBIMCgRzYWx0GAEgASgMEhIKCml0ZXJhdGlvbnMYAiABKAUSEgoKc3RvcmVkX2tleRgDIAEoDBISCgpzZXJ2ZXJfa2V5GAQgASgMIrkCChBDcmVkZW50aWFsQ29uZmlnEgoKAmlkGAEgASgJEhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRIVCg1wYXNzd29yZF9oYXNoGAQgASgJEjQKCHRlbXBsYXRlGAUgASgOMiIuaWRwLmdhdGV3YXkudjEuUGVybWlzc2lvblRlbXBsYXRlEjwKEmN1c3RvbV9wZXJtaXNzaW9ucxgGIAMoCzIgLmlkcC5nYXRld2F5LnYxLkN1c3RvbVBlcm1pc3Npb24SMAoJbWVjaGFuaXNtGAcgASgOMh0uaWRwLmdhdGV3YXkudjEuU2FzbE1lY2hhbmlzbRIuCgVzY3JhbRgIIAEoCzIfLmlkcC5nYXRld2F5LnYxLlNjcmFtQ3JlZGVudGlhbCJLChdVcHNlcnRDcmVkZW50aWFsUmVxdWVzdBIwCgZjb25maWcYASABKAsyIC5pZHAuZ2F0ZXdheS52MS5DcmVkZW50aWFsQ29uZmlnIisKGFVwc2VydENyZWRlbnRpYWxSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIjAKF1Jldm9rZUNyZWRlbnRpYWxSZXF1ZXN0EhUKDWNyZWRlbnRpYWxfaWQYASABKAkiKwoYUmV2b2tlQ3JlZGVudGlhbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiNAoWTGlzdENyZWRlbnRpYWxzUmVxdWVzdBIaChJ2aXJ0dWFsX2NsdXN0ZXJfaWQYASABKAkiUAoXTGlzdENyZWRlbnRpYWxzUmVzcG9uc2USNQoLY3JlZGVudGlhbHMYASADKAsyIC5pZHAuZ2F0ZXdheS52MS5DcmVkZW50aWFsQ29uZmlnIuwBCgxQb2xpY3lDb25maWcSCgoCaWQYASABKAkSEwoLZW52aXJvbm1lbnQYAiABKAkSFgoObWF4X3BhcnRpdGlvbnMYAyABKAUSFgoObWluX3BhcnRpdGlvbnMYBCABKAUSGAoQbWF4X3JldGVudGlvbl9tcxgFIAEoAxIeChZtaW5fcmVwbGljYXRpb25fZmFjdG9yGAYgASgFEiAKGGFsbG93ZWRfY2xlYW51cF9wb2xpY2llcxgHIAMoCRIWCg5uYW1pbmdfcGF0dGVybhgIIAEoCRIXCg9tYXhfbmFtZV9sZW5ndGgYCSABKAUiQwoTVXBzZXJ0UG9saWN5UmVxdWVzdBIsCgZjb25maWcYASABKAsyHC5pZHAuZ2F0ZXdheS52MS5Qb2xpY3lDb25maWciJwoUVXBzZXJ0UG9saWN5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIoChNEZWxldGVQb2xpY3lSZXF1ZXN0EhEKCXBvbGljeV9pZBgBIAEoCSInChREZWxldGVQb2xpY3lSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIioKE0xpc3RQb2xpY2llc1JlcXVlc3QSEwoLZW52aXJvbm1lbnQYASABKAkiRgoUTGlzdFBvbGljaWVzUmVzcG9uc2USLgoIcG9saWNpZXMYASADKAsyHC5pZHAuZ2F0ZXdheS52MS5Qb2xpY3lDb25maWcilAEKDVRvcGljQUNMRW50cnkSCgoCaWQYASABKAkSFQoNY3JlZGVudGlhbF9pZBgCIAEoCRIbChN0b3BpY19waHlzaWNhbF9uYW1lGAMgASgJEhMKC3Blcm1pc3Npb25zGAQgAygJEi4KCmV4cGlyZXNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkUKFVVwc2VydFRvcGljQUNMUmVxdWVzdBIsCgVlbnRyeRgBIAEoCzIdLmlkcC5nYXRld2F5LnYxLlRvcGljQUNMRW50cnkiKQoWVXBzZXJ0VG9waWNBQ0xSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIicKFVJldm9rZVRvcGljQUNMUmVxdWVzdBIOCgZhY2xfaWQYASABKAkiKQoWUmV2b2tlVG9waWNBQ0xSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIi0KFExpc3RUb3BpY0FDTHNSZXF1ZXN0EhUKDWNyZWRlbnRpYWxfaWQYASABKAkiRwoVTGlzdFRvcGljQUNMc1Jlc3BvbnNlEi4KB2VudHJpZXMYASADKAsyHS5pZHAuZ2F0ZXdheS52MS5Ub3BpY0FDTEVudHJ5IqACChNUb3BpY0NyZWF0ZWRSZXF1ZXN0EhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgBIAEoCRIUCgx2aXJ0dWFsX25hbWUYAiABKAkSFQoNcGh5c2ljYWxfbmFtZRgDIAEoCRISCgpwYXJ0aXRpb25zGAQgASgFEhoKEnJlcGxpY2F0aW9uX2ZhY3RvchgFIAEoBRI/CgZjb25maWcYBiADKAsyLy5pZHAuZ2F0ZXdheS52MS5Ub3BpY0NyZWF0ZWRSZXF1ZXN0LkNvbmZpZ0VudHJ5EiAKGGNyZWF0ZWRfYnlfY3JlZGVudGlhbF9pZBgHIAEoCRotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjkKFFRvcGljQ3JlYXRlZFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEAoIdG9waWNfaWQYAiABKAkigAEKE1RvcGljRGVsZXRlZFJlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhQKDHZpcnR1YWxfbmFtZRgCIAEoCRIVCg1waHlzaWNhbF9uYW1lGAMgASgJEiAKGGRlbGV0ZWRfYnlfY3JlZGVudGlhbF9pZBgEIAEoCSInChRUb3BpY0RlbGV0ZWRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIuUBChlUb3BpY0NvbmZpZ1VwZGF0ZWRSZXF1ZXN0EhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgBIAEoCRIUCgx2aXJ0dWFsX25hbWUYAiABKAkSRQoGY29uZmlnGAMgAygLMjUuaWRwLmdhdGV3YXkudjEuVG9waWNDb25maWdVcGRhdGVkUmVxdWVzdC5Db25maWdFbnRyeRIgChh1cGRhdGVkX2J5X2NyZWRlbnRpYWxfaWQYBCABKAkaLQoLQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASItChpUb3BpY0NvbmZpZ1VwZGF0ZWRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIInIKD1BvbGljeVZpb2xhdGlvbhINCgVmaWVsZBgBIAEoCRISCgpjb25zdHJhaW50GAIgASgJEg8KB21lc3NhZ2UYAyABKAkSFAoMYWN0dWFsX3ZhbHVlGAQgASgJEhUKDWFsbG93ZWRfdmFsdWUYBSABKAkioAIKFENsaWVudEFjdGl2aXR5UmVjb3JkEhoKEnZpcnR1YWxfY2x1c3Rlcl9pZBgBIAEoCRIaChJzZXJ2aWNlX2FjY291bnRfaWQYAiABKAkSGgoSdG9waWNfdmlydHVhbF9uYW1lGAMgASgJEhEKCWRpcmVjdGlvbhgEIAEoCRIZChFjb25zdW1lcl9ncm91cF9pZBgFIAEoCRINCgVieXRlcxgGIAEoAxIVCg1tZXNzYWdlX2NvdW50GAcgASgDEjAKDHdpbmRvd19zdGFydBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKd2luZG93X2VuZBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUgoZRW1pdENsaWVudEFjdGl2aXR5UmVxdWVzdBI1CgdyZWNvcmRzGAEgAygLMiQuaWRwLmdhdGV3YXkudjEuQ2xpZW50QWN0aXZpdHlSZWNvcmQiSAoaRW1pdENsaWVudEFjdGl2aXR5UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIZChFyZWNvcmRzX3Byb2Nlc3NlZBgCIAEoBSKUAQoUQ29uc3VtZXJHcm91cFN1bW1hcnkSEAoIZ3JvdXBfaWQYASABKAkSMQoFc3RhdGUYAiABKA4yIi5pZHAuZ2F0ZXdheS52MS5Db25zdW1lckdyb3VwU3RhdGUSFAoMbWVtYmVyX2NvdW50GAMgASgFEg4KBnRvcGljcxgEIAMoCRIRCgl0b3RhbF9sYWcYBSABKAMifgoMUGFydGl0aW9uTGFnEg0KBXRvcGljGAEgASgJEhEKCXBhcnRpdGlvbhgCIAEoBRIWCg5jdXJyZW50X29mZnNldBgDIAEoAxISCgplbmRfb2Zmc2V0GAQgASgDEgsKA2xhZxgFIAEoAxITCgtjb25zdW1lcl9pZBgGIAEoCSLFAQoTQ29uc3VtZXJHcm91cERldGFpbBIQCghncm91cF9pZBgBIAEoCRIxCgVzdGF0ZRgCIAEoDjIiLmlkcC5nYXRld2F5LnYxLkNvbnN1bWVyR3JvdXBTdGF0ZRIUCgxtZW1iZXJfY291bnQYAyABKAUSDgoGdG9waWNzGAQgAygJEhEKCXRvdGFsX2xhZxgFIAEoAxIwCgpwYXJ0aXRpb25zGAYgAygLMhwuaWRwLmdhdGV3YXkudjEuUGFydGl0aW9uTGFnIjcKGUxpc3RDb25zdW1lckdyb3Vwc1JlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJImEKGkxpc3RDb25zdW1lckdyb3Vwc1Jlc3BvbnNlEjQKBmdyb3VwcxgBIAMoCzIkLmlkcC5nYXRld2F5LnYxLkNvbnN1bWVyR3JvdXBTdW1tYXJ5Eg0KBWVycm9yGAIgASgJIkwKHERlc2NyaWJlQ29uc3VtZXJHcm91cFJlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJImIKHURlc2NyaWJlQ29uc3VtZXJHcm91cFJlc3BvbnNlEjIKBWdyb3VwGAEgASgLMiMuaWRwLmdhdGV3YXkudjEuQ29uc3VtZXJHcm91cERldGFpbBINCgVlcnJvchgCIAEoCSKnAQogUmVzZXRDb25zdW1lckdyb3VwT2Zmc2V0c1JlcXVlc3QSGgoSdmlydHVhbF9jbHVzdGVyX2lkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEg0KBXRvcGljGAMgASgJEjMKCnJlc2V0X3R5cGUYBCABKA4yHy5pZHAuZ2F0ZXdheS52MS5PZmZzZXRSZXNldFR5cGUSEQoJdGltZXN0YW1wGAUgASgDInYKIVJlc2V0Q29uc3VtZXJHcm91cE9mZnNldHNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWVycm9yGAIgASgJEjEKC25ld19vZmZzZXRzGAMgAygLMhwuaWRwLmdhdGV3YXkudjEuUGFydGl0aW9uTGFnIi4KE0V4cG9ydENvbmZpZ1JlcXVlc3QSFwoPaW5jbHVkZV9zZWNyZXRzGAEgASgIIr4BChRFeHBvcnRDb25maWdSZXNwb25zZRI+ChB2aXJ0dWFsX2NsdXN0ZXJzGAEgAygLMiQuaWRwLmdhdGV3YXkudjEuVmlydHVhbENsdXN0ZXJDb25maWcSNQoLY3JlZGVudGlhbHMYAiADKAsyIC5pZHAuZ2F0ZXdheS52MS5DcmVkZW50aWFsQ29uZmlnEi8KC2V4cG9ydGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLLAQoTSW1wb3J0Q29uZmlnUmVxdWVzdBI+ChB2aXJ0dWFsX2NsdXN0ZXJzGAEgAygLMiQuaWRwLmdhdGV3YXkudjEuVmlydHVhbENsdXN0ZXJDb25maWcSNQoLY3JlZGVudGlhbHMYAiADKAsyIC5pZHAuZ2F0ZXdheS52MS5DcmVkZW50aWFsQ29uZmlnEj0KD2NvbmZsaWN0X3BvbGljeRgDIAEoDjIkLmlkcC5nYXRld2F5LnYxLkltcG9ydENvbmZsaWN0UG9saWN5IpYBChRJbXBvcnRDb25maWdSZXNwb25zZRIhChl2aXJ0dWFsX2NsdXN0ZXJzX2ltcG9ydGVkGAEgASgFEiAKGHZpcnR1YWxfY2x1c3RlcnNfc2tpcHBlZBgCIAEoBRIcChRjcmVkZW50aWFsc19pbXBvcnRlZBgDIAEoBRIbChNjcmVkZW50aWFsc19za2lwcGVkGAQgASgFKrwBChJQZXJtaXNzaW9uVGVtcGxhdGUSIwofUEVSTUlTU0lPTl9URU1QTEFURV9VTlNQRUNJRklFRBAAEiAKHFBFUk1JU1NJT05fVEVNUExBVEVfUFJPRFVDRVIQARIgChxQRVJNSVNTSU9OX1RFTVBMQVRFX0NPTlNVTUVSEAISHQoZUEVSTUlTU0lPTl9URU1QTEFURV9BRE1JThADEh4KGlBFUk1JU1NJT05fVEVNUExBVEVfQ1VTVE9NEAQqjQEKDVNhc2xNZWNoYW5pc20SHgoaU0FTTF9NRUNIQU5JU01fVU5TUEVDSUZJRUQQABIYChRTQVNMX01FQ0hBTklTTV9QTEFJThABEiAKHFNBU0xfTUVDSEFOSVNNX1NDUkFNX1NIQV8yNTYQAhIgChxTQVNMX01FQ0hBTklTTV9TQ1JBTV9TSEFfNTEyEAMq9wEKEkNvbnN1bWVyR3JvdXBTdGF0ZRIkCiBDT05TVU1FUl9HUk9VUF9TVEFURV9VTlNQRUNJRklFRBAAEh8KG0NPTlNVTUVSX0dST1VQX1NUQVRFX1NUQUJMRRABEiwKKENPTlNVTUVSX0dST1VQX1NUQVRFX1BSRVBBUklOR19SRUJBTEFOQ0UQAhItCilDT05TVU1FUl9HUk9VUF9TVEFURV9DT01QTEVUSU5HX1JFQkFMQU5DRRADEh4KGkNPTlNVTUVSX0dST1VQX1NUQVRFX0VNUFRZEAQSHQoZQ09OU1VNRVJfR1JPVVBfU1RBVEVfREVBRBAFKpMBCg9PZmZzZXRSZXNldFR5cGUSIQodT0ZGU0VUX1JFU0VUX1RZUEVfVU5TUEVDSUZJRUQQABIeChpPRkZTRVRfUkVTRVRfVFlQRV9FQVJMSUVTVBABEhwKGE9GRlNFVF9SRVNFVF9UWVBFX0xBVEVTVBACEh8KG09GRlNFVF9SRVNFVF9UWVBFX1RJTUVTVEFNUBADKqYBChRJbXBvcnRDb25mbGljdFBvbGljeRImCiJJTVBPUlRfQ09ORkxJQ1RfUE9MSUNZX1VOU1BFQ0lGSUVEEAASHwobSU1QT1JUX0NPTkZMSUNUX1BPTElDWV9GQUlMEAESHwobSU1QT1JUX0NPTkZMSUNUX1BPTElDWV9TS0lQEAISJAogSU1QT1JUX0NPTkZMSUNUX1BPTElDWV9PVkVSV1JJVEUQAzKdEAoTQmlmcm9zdEFkbWluU2VydmljZRJxChRVcHNlcnRWaXJ0dWFsQ2x1c3RlchIrLmlkcC5nYXRld2F5LnYxLlVwc2VydFZpcnR1YWxDbHVzdGVyUmVxdWVzdBosLmlkcC5nYXRld2F5LnYxLlVwc2VydFZpcnR1YWxDbHVzdGVyUmVzcG9uc2UScQoURGVsZXRlVmlydHVhbENsdXN0ZXISKy5pZHAuZ2F0ZXdheS52MS5EZWxldGVWaXJ0dWFsQ2x1c3RlclJlcXVlc3QaLC5pZHAuZ2F0ZXdheS52MS5EZWxldGVWaXJ0dWFsQ2x1c3RlclJlc3BvbnNlEoABChlTZXRWaXJ0dWFsQ2x1c3RlclJlYWRPbmx5EjAuaWRwLmdhdGV3YXkudjEuU2V0VmlydHVhbENsdXN0ZXJSZWFkT25seVJlcXVlc3QaMS5pZHAuZ2F0ZXdheS52MS5TZXRWaXJ0dWFsQ2x1c3RlclJlYWRPbmx5UmVzcG9uc2USZQoQVXBzZXJ0Q3JlZGVudGlhbBInLmlkcC5nYXRld2F5LnYxLlVwc2VydENyZWRlbnRpYWxSZXF1ZXN0GiguaWRwLmdhdGV3YXkudjEuVXBzZXJ0Q3JlZGVudGlhbFJlc3BvbnNlEmUKEFJldm9rZUNyZWRlbnRpYWwSJy5pZHAuZ2F0ZXdheS52MS5SZXZva2VDcmVkZW50aWFsUmVxdWVzdBooLmlkcC5nYXRld2F5LnYxLlJldm9rZUNyZWRlbnRpYWxSZXNwb25zZRJiCg9MaXN0Q3JlZGVudGlhbHMSJi5pZHAuZ2F0ZXdheS52MS5MaXN0Q3JlZGVudGlhbHNSZXF1ZXN0GicuaWRwLmdhdGV3YXkudjEuTGlzdENyZWRlbnRpYWxzUmVzcG9uc2USXAoNR2V0RnVsbENvbmZpZxIkLmlkcC5nYXRld2F5LnYxLkdldEZ1bGxDb25maWdSZXF1ZXN0GiUuaWRwLmdhdGV3YXkudjEuR2V0RnVsbENvbmZpZ1Jlc3BvbnNlElAKCUdldFN0YXR1cxIgLmlkcC5nYXRld2F5LnYxLkdldFN0YXR1c1JlcXVlc3QaIS5pZHAuZ2F0ZXdheS52MS5HZXRTdGF0dXNSZXNwb25zZRJuChNMaXN0VmlydHVhbENsdXN0ZXJzEiouaWRwLmdhdGV3YXkudjEuTGlzdFZpcnR1YWxDbHVzdGVyc1JlcXVlc3QaKy5pZHAuZ2F0ZXdheS52MS5MaXN0VmlydHVhbENsdXN0ZXJzUmVzcG9uc2USWQoMVXBzZXJ0UG9saWN5EiMuaWRwLmdhdGV3YXkudjEuVXBzZXJ0UG9saWN5UmVxdWVzdBokLmlkcC5nYXRld2F5LnYxLlVwc2VydFBvbGljeVJlc3BvbnNlElkKDERlbGV0ZVBvbGljeRIjLmlkcC5nYXRld2F5LnYxLkRlbGV0ZVBvbGljeVJlcXVlc3QaJC5pZHAuZ2F0ZXdheS52MS5EZWxldGVQb2xpY3lSZXNwb25zZRJZCgxMaXN0UG9saWNpZXMSIy5pZHAuZ2F0ZXdheS52MS5MaXN0UG9saWNpZXNSZXF1ZXN0GiQuaWRwLmdhdGV3YXkudjEuTGlzdFBvbGljaWVzUmVzcG9uc2USXwoOVXBzZXJ0VG9waWNBQ0wSJS5pZHAuZ2F0ZXdheS52MS5VcHNlcnRUb3BpY0FDTFJlcXVlc3QaJi5pZHAuZ2F0ZXdheS52MS5VcHNlcnRUb3BpY0FDTFJlc3BvbnNlEl8KDlJldm9rZVRvcGljQUNMEiUuaWRwLmdhdGV3YXkudjEuUmV2b2tlVG9waWNBQ0xSZXF1ZXN0GiYuaWRwLmdhdGV3YXkudjEuUmV2b2tlVG9waWNBQ0xSZXNwb25zZRJcCg1MaXN0VG9waWNBQ0xzEiQuaWRwLmdhdGV3YXkudjEuTGlzdFRvcGljQUNMc1JlcXVlc3QaJS5pZHAuZ2F0ZXdheS52MS5MaXN0VG9waWNBQ0xzUmVzcG9uc2USawoSTGlzdENvbnN1bWVyR3JvdXBzEikuaWRwLmdhdGV3YXkudjEuTGlzdENvbnN1bWVyR3JvdXBzUmVxdWVzdBoqLmlkcC5nYXRld2F5LnYxLkxpc3RDb25zdW1lckdyb3Vwc1Jlc3BvbnNlEnQKFURlc2NyaWJlQ29uc3VtZXJHcm91cBIsLmlkcC5nYXRld2F5LnYxLkRlc2NyaWJlQ29uc3VtZXJHcm91cFJlcXVlc3QaLS5pZHAuZ2F0ZXdheS52MS5EZXNjcmliZUNvbnN1bWVyR3JvdXBSZXNwb25zZRKAAQoZUmVzZXRDb25zdW1lckdyb3VwT2Zmc2V0cxIwLmlkcC5nYXRld2F5LnYxLlJlc2V0Q29uc3VtZXJHcm91cE9mZnNldHNSZXF1ZXN0GjEuaWRwLmdhdGV3YXkudjEuUmVzZXRDb25zdW1lckdyb3VwT2Zmc2V0c1Jlc3BvbnNlElkKDEV4cG9ydENvbmZpZxIjLmlkcC5nYXRld2F5LnYxLkV4cG9ydENvbmZpZ1JlcXVlc3QaJC5pZHAuZ2F0ZXdheS52MS5FeHBvcnRDb25maWdSZXNwb25zZRJZCgxJbXBvcnRDb25maWcSIy5pZHAuZ2F0ZXdheS52MS5JbXBvcnRDb25maWdSZXF1ZXN0GiQuaWRwLmdhdGV3YXkudjEuSW1wb3J0Q29uZmlnUmVzcG9uc2UyqAMKFkJpZnJvc3RDYWxsYmFja1NlcnZpY2USWQoMVG9waWNDcmVhdGVkEiMuaWRwLmdhdGV3YXkudjEuVG9waWNDcmVhdGVkUmVxdWVzdBokLmlkcC5nYXRld2F5LnYxLlRvcGljQ3JlYXRlZFJlc3BvbnNlElkKDFRvcGljRGVsZXRlZBIjLmlkcC5nYXRld2F5LnYxLlRvcGljRGVsZXRlZFJlcXVlc3QaJC5pZHAuZ2F0ZXdheS52MS5Ub3BpY0RlbGV0ZWRSZXNwb25zZRJrChJUb3BpY0NvbmZpZ1VwZGF0ZWQSKS5pZHAuZ2F0ZXdheS52MS5Ub3BpY0NvbmZpZ1VwZGF0ZWRSZXF1ZXN0GiouaWRwLmdhdGV3YXkudjEuVG9waWNDb25maWdVcGRhdGVkUmVzcG9uc2USawoSRW1pdENsaWVudEFjdGl2aXR5EikuaWRwLmdhdGV3YXkudjEuRW1pdENsaWVudEFjdGl2aXR5UmVxdWVzdBoqLmlkcC5nYXRld2F5LnYxLkVtaXRDbGllbnRBY3Rpdml0eVJlc3BvbnNlQl8KDmlkcC5nYXRld2F5LnYxQgdHYXRld2F5UABaQmdpdGh1Yi5jb20vZHJld3BheW1lbnQvb3JiaXQvcHJvdG8vZ2VuL2dvL2lkcC9nYXRld2F5L3YxO2dhdGV3YXl2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message idp.gateway.v1.VirtualClusterConfig
@@ -79,6 +79,41 @@ export type VirtualClusterConfig = Message<"idp.gateway.v1.VirtualClusterConfig"
    * @generated from field: bool read_only = 12;
    */
   readOnly: boolean;
+
+  /**
+   * 0 disables request rate limiting
+   *
+   * @generated from field: int32 max_requests_per_sec = 13;
+   */
+  maxRequestsPerSec: number;
+
+  /**
+   * 0 disables bandwidth limiting
+   *
+   * @generated from field: int64 max_bytes_per_sec = 14;
+   */
+  maxBytesPerSec: bigint;
+
+  /**
+   * Glob patterns; empty allows every topic
+   *
+   * @generated from field: repeated string allowed_topics = 15;
+   */
+  allowedTopics: string[];
+
+  /**
+   * Glob patterns; take precedence over allowed_topics
+   *
+   * @generated from field: repeated string denied_topics = 16;
+   */
+  deniedTopics: string[];
+
+  /**
+   * Topic glob pattern -> produce bytes/sec; the lowest matching budget applies
+   *
+   * @generated from field: map<string, int64> topic_produce_bytes_per_sec = 17;
+   */
+  topicProduceBytesPerSec: { [key: string]: bigint };
 };
 
 /**
@@ -276,6 +311,11 @@ export type GetStatusResponse = Message<"idp.gateway.v1.GetStatusResponse"> & {
    * @generated from field: map<string, string> version_info = 4;
    */
   versionInfo: { [key: string]: string };
+
+  /**
+   * @generated from field: repeated idp.gateway.v1.VirtualClusterStatus virtual_clusters = 5;
+   */
+  virtualClusters: VirtualClusterStatus[];
 };
 
 /**
@@ -285,6 +325,49 @@ export type GetStatusResponse = Message<"idp.gateway.v1.GetStatusResponse"> & {
 export const GetStatusResponseSchema: GenMessage<GetStatusResponse> = /*@__PURE__*/
   messageDesc(file_idp_gateway_v1_gateway, 10);
 
+/**
+ * @generated from message idp.gateway.v1.VirtualClusterStatus
+ */
+export type VirtualClusterStatus = Message<"idp.gateway.v1.VirtualClusterStatus"> & {
+  /**
+   * @generated from field: string virtual_cluster_id = 1;
+   */
+  virtualClusterId: string;
+
+  /**
+   * @generated from field: bool backend_reachable = 2;
+   */
+  backendReachable: boolean;
+
+  /**
+   * Topics under the virtual cluster's prefix
+   *
+   * @generated from field: int32 topic_count = 3;
+   */
+  topicCount: number;
+
+  /**
+   * Consumer groups under the virtual cluster's prefix
+   *
+   * @generated from field: int32 group_count = 4;
+   */
+  groupCount: number;
+
+  /**
+   * Why the backend could not be queried
+   *
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message idp.gateway.v1.VirtualClusterStatus.
+ * Use `create(VirtualClusterStatusSchema)` to create a new message.
+ */
+export const VirtualClusterStatusSchema: GenMessage<VirtualClusterStatus> = /*@__PURE__*/
+  messageDesc(file_idp_gateway_v1_gateway, 11);
+
 /**
  * @generated from message idp.gateway.v1.ListVirtualClustersRequest
  */
@@ -296,7 +379,7 @@ export type ListVirtualClustersRequest = Message<"idp.gateway.v1.ListVirtualClus
  * Use `create(ListVirtualClustersRequestSchema)` to create a new message.
  */
 export const ListVirtualClustersRequestSchema: GenMessage<ListVirtualClustersRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 11);
+  messageDesc(file_idp_gateway_v1_gateway, 12);
 
 /**
  * @generated from message idp.gateway.v1.ListVirtualClustersResponse
@@ -313,7 +396,7 @@ export type ListVirtualClustersResponse = Message<"idp.gateway.v1.ListVirtualClu
  * Use `create(ListVirtualClustersResponseSchema)` to create a new message.
  */
 export const ListVirtualClustersResponseSchema: GenMessage<ListVirtualClustersResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 12);
+  messageDesc(file_idp_gateway_v1_gateway, 13);
 
 /**
  * @generated from message idp.gateway.v1.CustomPermission
@@ -346,7 +429,42 @@ export type CustomPermission = Message<"idp.gateway.v1.CustomPermission"> & {
  * Use `create(CustomPermissionSchema)` to create a new message.
  */
 export const CustomPermissionSchema: GenMessage<CustomPermission> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 13);
+  messageDesc(file_idp_gateway_v1_gateway, 14);
+
+/**
+ * ScramCredential holds the salted SCRAM secrets (RFC 5802) derived from a
+ * credential's password, so the gateway never sees the password itself.
+ *
+ * @generated from message idp.gateway.v1.ScramCredential
+ */
+export type ScramCredential = Message<"idp.gateway.v1.ScramCredential"> & {
+  /**
+   * @generated from field: bytes salt = 1;
+   */
+  salt: Uint8Array;
+
+  /**
+   * @generated from field: int32 iterations = 2;
+   */
+  iterations: number;
+
+  /**
+   * @generated from field: bytes stored_key = 3;
+   */
+  storedKey: Uint8Array;
+
+  /**
+   * @generated from field: bytes server_key = 4;
+   */
+  serverKey: Uint8Array;
+};
+
+/**
+ * Describes the message idp.gateway.v1.ScramCredential.
+ * Use `create(ScramCredentialSchema)` to create a new message.
+ */
+export const ScramCredentialSchema: GenMessage<ScramCredential> = /*@__PURE__*/
+  messageDesc(file_idp_gateway_v1_gateway, 15);
 
 /**
  * @generated from message idp.gateway.v1.CredentialConfig
@@ -381,6 +499,18 @@ export type CredentialConfig = Message<"idp.gateway.v1.CredentialConfig"> & {
    * @generated from field: repeated idp.gateway.v1.CustomPermission custom_permissions = 6;
    */
   customPermissions: CustomPermission[];
+
+  /**
+   * @generated from field: idp.gateway.v1.SaslMechanism mechanism = 7;
+   */
+  mechanism: SaslMechanism;
+
+  /**
+   * Required for SCRAM mechanisms
+   *
+   * @generated from field: idp.gateway.v1.ScramCredential scram = 8;
+   */
+  scram?: ScramCredential | undefined;
 };
 
 /**
@@ -388,7 +518,7 @@ export type CredentialConfig = Message<"idp.gateway.v1.CredentialConfig"> & {
  * Use `create(CredentialConfigSchema)` to create a new message.
  */
 export const CredentialConfigSchema: GenMessage<CredentialConfig> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 14);
+  messageDesc(file_idp_gateway_v1_gateway, 16);
 
 /**
  * @generated from message idp.gateway.v1.UpsertCredentialRequest
@@ -405,7 +535,7 @@ export type UpsertCredentialRequest = Message<"idp.gateway.v1.UpsertCredentialRe
  * Use `create(UpsertCredentialRequestSchema)` to create a new message.
  */
 export const UpsertCredentialRequestSchema: GenMessage<UpsertCredentialRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 15);
+  messageDesc(file_idp_gateway_v1_gateway, 17);
 
 /**
  * @generated from message idp.gateway.v1.UpsertCredentialResponse
@@ -422,7 +552,7 @@ export type UpsertCredentialResponse = Message<"idp.gateway.v1.UpsertCredentialR
  * Use `create(UpsertCredentialResponseSchema)` to create a new message.
  */
 export const UpsertCredentialResponseSchema: GenMessage<UpsertCredentialResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 16);
+  messageDesc(file_idp_gateway_v1_gateway, 18);
 
 /**
  * @generated from message idp.gateway.v1.RevokeCredentialRequest
@@ -439,7 +569,7 @@ export type RevokeCredentialRequest = Message<"idp.gateway.v1.RevokeCredentialRe
  * Use `create(RevokeCredentialRequestSchema)` to create a new message.
  */
 export const RevokeCredentialRequestSchema: GenMessage<RevokeCredentialRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 17);
+  messageDesc(file_idp_gateway_v1_gateway, 19);
 
 /**
  * @generated from message idp.gateway.v1.RevokeCredentialResponse
@@ -456,7 +586,7 @@ export type RevokeCredentialResponse = Message<"idp.gateway.v1.RevokeCredentialR
  * Use `create(RevokeCredentialResponseSchema)` to create a new message.
  */
 export const RevokeCredentialResponseSchema: GenMessage<RevokeCredentialResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 18);
+  messageDesc(file_idp_gateway_v1_gateway, 20);
 
 /**
  * @generated from message idp.gateway.v1.ListCredentialsRequest
@@ -475,7 +605,7 @@ export type ListCredentialsRequest = Message<"idp.gateway.v1.ListCredentialsRequ
  * Use `create(ListCredentialsRequestSchema)` to create a new message.
  */
 export const ListCredentialsRequestSchema: GenMessage<ListCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 19);
+  messageDesc(file_idp_gateway_v1_gateway, 21);
 
 /**
  * @generated from message idp.gateway.v1.ListCredentialsResponse
@@ -492,7 +622,7 @@ export type ListCredentialsResponse = Message<"idp.gateway.v1.ListCredentialsRes
  * Use `create(ListCredentialsResponseSchema)` to create a new message.
  */
 export const ListCredentialsResponseSchema: GenMessage<ListCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 20);
+  messageDesc(file_idp_gateway_v1_gateway, 22);
 
 /**
  * @generated from message idp.gateway.v1.PolicyConfig
@@ -553,7 +683,7 @@ export type PolicyConfig = Message<"idp.gateway.v1.PolicyConfig"> & {
  * Use `create(PolicyConfigSchema)` to create a new message.
  */
 export const PolicyConfigSchema: GenMessage<PolicyConfig> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 21);
+  messageDesc(file_idp_gateway_v1_gateway, 23);
 
 /**
  * @generated from message idp.gateway.v1.UpsertPolicyRequest
@@ -570,7 +700,7 @@ export type UpsertPolicyRequest = Message<"idp.gateway.v1.UpsertPolicyRequest">
  * Use `create(UpsertPolicyRequestSchema)` to create a new message.
  */
 export const UpsertPolicyRequestSchema: GenMessage<UpsertPolicyRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 22);
+  messageDesc(file_idp_gateway_v1_gateway, 24);
 
 /**
  * @generated from message idp.gateway.v1.UpsertPolicyResponse
@@ -587,7 +717,7 @@ export type UpsertPolicyResponse = Message<"idp.gateway.v1.UpsertPolicyResponse"
  * Use `create(UpsertPolicyResponseSchema)` to create a new message.
  */
 export const UpsertPolicyResponseSchema: GenMessage<UpsertPolicyResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 23);
+  messageDesc(file_idp_gateway_v1_gateway, 25);
 
 /**
  * @generated from message idp.gateway.v1.DeletePolicyRequest
@@ -604,7 +734,7 @@ export type DeletePolicyRequest = Message<"idp.gateway.v1.DeletePolicyRequest">
  * Use `create(DeletePolicyRequestSchema)` to create a new message.
  */
 export const DeletePolicyRequestSchema: GenMessage<DeletePolicyRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 24);
+  messageDesc(file_idp_gateway_v1_gateway, 26);
 
 /**
  * @generated from message idp.gateway.v1.DeletePolicyResponse
@@ -621,7 +751,7 @@ export type DeletePolicyResponse = Message<"idp.gateway.v1.DeletePolicyResponse"
  * Use `create(DeletePolicyResponseSchema)` to create a new message.
  */
 export const DeletePolicyResponseSchema: GenMessage<DeletePolicyResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 25);
+  messageDesc(file_idp_gateway_v1_gateway, 27);
 
 /**
  * @generated from message idp.gateway.v1.ListPoliciesRequest
@@ -640,7 +770,7 @@ export type ListPoliciesRequest = Message<"idp.gateway.v1.ListPoliciesRequest">
  * Use `create(ListPoliciesRequestSchema)` to create a new message.
  */
 export const ListPoliciesRequestSchema: GenMessage<ListPoliciesRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 26);
+  messageDesc(file_idp_gateway_v1_gateway, 28);
 
 /**
  * @generated from message idp.gateway.v1.ListPoliciesResponse
@@ -657,7 +787,7 @@ export type ListPoliciesResponse = Message<"idp.gateway.v1.ListPoliciesResponse"
  * Use `create(ListPoliciesResponseSchema)` to create a new message.
  */
 export const ListPoliciesResponseSchema: GenMessage<ListPoliciesResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 27);
+  messageDesc(file_idp_gateway_v1_gateway, 29);
 
 /**
  * @generated from message idp.gateway.v1.TopicACLEntry
@@ -702,7 +832,7 @@ export type TopicACLEntry = Message<"idp.gateway.v1.TopicACLEntry"> & {
  * Use `create(TopicACLEntrySchema)` to create a new message.
  */
 export const TopicACLEntrySchema: GenMessage<TopicACLEntry> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 28);
+  messageDesc(file_idp_gateway_v1_gateway, 30);
 
 /**
  * @generated from message idp.gateway.v1.UpsertTopicACLRequest
@@ -719,7 +849,7 @@ export type UpsertTopicACLRequest = Message<"idp.gateway.v1.UpsertTopicACLReques
  * Use `create(UpsertTopicACLRequestSchema)` to create a new message.
  */
 export const UpsertTopicACLRequestSchema: GenMessage<UpsertTopicACLRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 29);
+  messageDesc(file_idp_gateway_v1_gateway, 31);
 
 /**
  * @generated from message idp.gateway.v1.UpsertTopicACLResponse
@@ -736,7 +866,7 @@ export type UpsertTopicACLResponse = Message<"idp.gateway.v1.UpsertTopicACLRespo
  * Use `create(UpsertTopicACLResponseSchema)` to create a new message.
  */
 export const UpsertTopicACLResponseSchema: GenMessage<UpsertTopicACLResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 30);
+  messageDesc(file_idp_gateway_v1_gateway, 32);
 
 /**
  * @generated from message idp.gateway.v1.RevokeTopicACLRequest
@@ -753,7 +883,7 @@ export type RevokeTopicACLRequest = Message<"idp.gateway.v1.RevokeTopicACLReques
  * Use `create(RevokeTopicACLRequestSchema)` to create a new message.
  */
 export const RevokeTopicACLRequestSchema: GenMessage<RevokeTopicACLRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 31);
+  messageDesc(file_idp_gateway_v1_gateway, 33);
 
 /**
  * @generated from message idp.gateway.v1.RevokeTopicACLResponse
@@ -770,7 +900,7 @@ export type RevokeTopicACLResponse = Message<"idp.gateway.v1.RevokeTopicACLRespo
  * Use `create(RevokeTopicACLResponseSchema)` to create a new message.
  */
 export const RevokeTopicACLResponseSchema: GenMessage<RevokeTopicACLResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 32);
+  messageDesc(file_idp_gateway_v1_gateway, 34);
 
 /**
  * @generated from message idp.gateway.v1.ListTopicACLsRequest
@@ -789,7 +919,7 @@ export type ListTopicACLsRequest = Message<"idp.gateway.v1.ListTopicACLsRequest"
  * Use `create(ListTopicACLsRequestSchema)` to create a new message.
  */
 export const ListTopicACLsRequestSchema: GenMessage<ListTopicACLsRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 33);
+  messageDesc(file_idp_gateway_v1_gateway, 35);
 
 /**
  * @generated from message idp.gateway.v1.ListTopicACLsResponse
@@ -806,7 +936,7 @@ export type ListTopicACLsResponse = Message<"idp.gateway.v1.ListTopicACLsRespons
  * Use `create(ListTopicACLsResponseSchema)` to create a new message.
  */
 export const ListTopicACLsResponseSchema: GenMessage<ListTopicACLsResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 34);
+  messageDesc(file_idp_gateway_v1_gateway, 36);
 
 /**
  * @generated from message idp.gateway.v1.TopicCreatedRequest
@@ -857,7 +987,7 @@ export type TopicCreatedRequest = Message<"idp.gateway.v1.TopicCreatedRequest">
  * Use `create(TopicCreatedRequestSchema)` to create a new message.
  */
 export const TopicCreatedRequestSchema: GenMessage<TopicCreatedRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 35);
+  messageDesc(file_idp_gateway_v1_gateway, 37);
 
 /**
  * @generated from message idp.gateway.v1.TopicCreatedResponse
@@ -881,7 +1011,7 @@ export type TopicCreatedResponse = Message<"idp.gateway.v1.TopicCreatedResponse"
  * Use `create(TopicCreatedResponseSchema)` to create a new message.
  */
 export const TopicCreatedResponseSchema: GenMessage<TopicCreatedResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 36);
+  messageDesc(file_idp_gateway_v1_gateway, 38);
 
 /**
  * @generated from message idp.gateway.v1.TopicDeletedRequest
@@ -913,7 +1043,7 @@ export type TopicDeletedRequest = Message<"idp.gateway.v1.TopicDeletedRequest">
  * Use `create(TopicDeletedRequestSchema)` to create a new message.
  */
 export const TopicDeletedRequestSchema: GenMessage<TopicDeletedRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 37);
+  messageDesc(file_idp_gateway_v1_gateway, 39);
 
 /**
  * @generated from message idp.gateway.v1.TopicDeletedResponse
@@ -930,7 +1060,7 @@ export type TopicDeletedResponse = Message<"idp.gateway.v1.TopicDeletedResponse"
  * Use `create(TopicDeletedResponseSchema)` to create a new message.
  */
 export const TopicDeletedResponseSchema: GenMessage<TopicDeletedResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 38);
+  messageDesc(file_idp_gateway_v1_gateway, 40);
 
 /**
  * @generated from message idp.gateway.v1.TopicConfigUpdatedRequest
@@ -962,7 +1092,7 @@ export type TopicConfigUpdatedRequest = Message<"idp.gateway.v1.TopicConfigUpdat
  * Use `create(TopicConfigUpdatedRequestSchema)` to create a new message.
  */
 export const TopicConfigUpdatedRequestSchema: GenMessage<TopicConfigUpdatedRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 39);
+  messageDesc(file_idp_gateway_v1_gateway, 41);
 
 /**
  * @generated from message idp.gateway.v1.TopicConfigUpdatedResponse
@@ -979,7 +1109,7 @@ export type TopicConfigUpdatedResponse = Message<"idp.gateway.v1.TopicConfigUpda
  * Use `create(TopicConfigUpdatedResponseSchema)` to create a new message.
  */
 export const TopicConfigUpdatedResponseSchema: GenMessage<TopicConfigUpdatedResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 40);
+  messageDesc(file_idp_gateway_v1_gateway, 42);
 
 /**
  * @generated from message idp.gateway.v1.PolicyViolation
@@ -1022,7 +1152,7 @@ export type PolicyViolation = Message<"idp.gateway.v1.PolicyViolation"> & {
  * Use `create(PolicyViolationSchema)` to create a new message.
  */
 export const PolicyViolationSchema: GenMessage<PolicyViolation> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 41);
+  messageDesc(file_idp_gateway_v1_gateway, 43);
 
 /**
  * Single client activity record from Bifrost gateway
@@ -1099,7 +1229,7 @@ export type ClientActivityRecord = Message<"idp.gateway.v1.ClientActivityRecord"
  * Use `create(ClientActivityRecordSchema)` to create a new message.
  */
 export const ClientActivityRecordSchema: GenMessage<ClientActivityRecord> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 42);
+  messageDesc(file_idp_gateway_v1_gateway, 44);
 
 /**
  * Request to emit client activity batch to Orbit
@@ -1118,7 +1248,7 @@ export type EmitClientActivityRequest = Message<"idp.gateway.v1.EmitClientActivi
  * Use `create(EmitClientActivityRequestSchema)` to create a new message.
  */
 export const EmitClientActivityRequestSchema: GenMessage<EmitClientActivityRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 43);
+  messageDesc(file_idp_gateway_v1_gateway, 45);
 
 /**
  * Response from client activity emission
@@ -1142,7 +1272,7 @@ export type EmitClientActivityResponse = Message<"idp.gateway.v1.EmitClientActiv
  * Use `create(EmitClientActivityResponseSchema)` to create a new message.
  */
 export const EmitClientActivityResponseSchema: GenMessage<EmitClientActivityResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 44);
+  messageDesc(file_idp_gateway_v1_gateway, 46);
 
 /**
  * @generated from message idp.gateway.v1.ConsumerGroupSummary
@@ -1183,7 +1313,7 @@ export type ConsumerGroupSummary = Message<"idp.gateway.v1.ConsumerGroupSummary"
  * Use `create(ConsumerGroupSummarySchema)` to create a new message.
  */
 export const ConsumerGroupSummarySchema: GenMessage<ConsumerGroupSummary> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 45);
+  messageDesc(file_idp_gateway_v1_gateway, 47);
 
 /**
  * @generated from message idp.gateway.v1.PartitionLag
@@ -1229,7 +1359,7 @@ export type PartitionLag = Message<"idp.gateway.v1.PartitionLag"> & {
  * Use `create(PartitionLagSchema)` to create a new message.
  */
 export const PartitionLagSchema: GenMessage<PartitionLag> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 46);
+  messageDesc(file_idp_gateway_v1_gateway, 48);
 
 /**
  * @generated from message idp.gateway.v1.ConsumerGroupDetail
@@ -1271,7 +1401,7 @@ export type ConsumerGroupDetail = Message<"idp.gateway.v1.ConsumerGroupDetail">
  * Use `create(ConsumerGroupDetailSchema)` to create a new message.
  */
 export const ConsumerGroupDetailSchema: GenMessage<ConsumerGroupDetail> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 47);
+  messageDesc(file_idp_gateway_v1_gateway, 49);
 
 /**
  * @generated from message idp.gateway.v1.ListConsumerGroupsRequest
@@ -1288,7 +1418,7 @@ export type ListConsumerGroupsRequest = Message<"idp.gateway.v1.ListConsumerGrou
  * Use `create(ListConsumerGroupsRequestSchema)` to create a new message.
  */
 export const ListConsumerGroupsRequestSchema: GenMessage<ListConsumerGroupsRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 48);
+  messageDesc(file_idp_gateway_v1_gateway, 50);
 
 /**
  * @generated from message idp.gateway.v1.ListConsumerGroupsResponse
@@ -1310,7 +1440,7 @@ export type ListConsumerGroupsResponse = Message<"idp.gateway.v1.ListConsumerGro
  * Use `create(ListConsumerGroupsResponseSchema)` to create a new message.
  */
 export const ListConsumerGroupsResponseSchema: GenMessage<ListConsumerGroupsResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 49);
+  messageDesc(file_idp_gateway_v1_gateway, 51);
 
 /**
  * @generated from message idp.gateway.v1.DescribeConsumerGroupRequest
@@ -1334,7 +1464,7 @@ export type DescribeConsumerGroupRequest = Message<"idp.gateway.v1.DescribeConsu
  * Use `create(DescribeConsumerGroupRequestSchema)` to create a new message.
  */
 export const DescribeConsumerGroupRequestSchema: GenMessage<DescribeConsumerGroupRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 50);
+  messageDesc(file_idp_gateway_v1_gateway, 52);
 
 /**
  * @generated from message idp.gateway.v1.DescribeConsumerGroupResponse
@@ -1356,7 +1486,7 @@ export type DescribeConsumerGroupResponse = Message<"idp.gateway.v1.DescribeCons
  * Use `create(DescribeConsumerGroupResponseSchema)` to create a new message.
  */
 export const DescribeConsumerGroupResponseSchema: GenMessage<DescribeConsumerGroupResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 51);
+  messageDesc(file_idp_gateway_v1_gateway, 53);
 
 /**
  * @generated from message idp.gateway.v1.ResetConsumerGroupOffsetsRequest
@@ -1399,7 +1529,7 @@ export type ResetConsumerGroupOffsetsRequest = Message<"idp.gateway.v1.ResetCons
  * Use `create(ResetConsumerGroupOffsetsRequestSchema)` to create a new message.
  */
 export const ResetConsumerGroupOffsetsRequestSchema: GenMessage<ResetConsumerGroupOffsetsRequest> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 52);
+  messageDesc(file_idp_gateway_v1_gateway, 54);
 
 /**
  * @generated from message idp.gateway.v1.ResetConsumerGroupOffsetsResponse
@@ -1428,7 +1558,112 @@ export type ResetConsumerGroupOffsetsResponse = Message<"idp.gateway.v1.ResetCon
  * Use `create(ResetConsumerGroupOffsetsResponseSchema)` to create a new message.
  */
 export const ResetConsumerGroupOffsetsResponseSchema: GenMessage<ResetConsumerGroupOffsetsResponse> = /*@__PURE__*/
-  messageDesc(file_idp_gateway_v1_gateway, 53);
+  messageDesc(file_idp_gateway_v1_gateway, 55);
+
+/**
+ * @generated from message idp.gateway.v1.ExportConfigRequest
+ */
+export type ExportConfigRequest = Message<"idp.gateway.v1.ExportConfigRequest"> & {
+  /**
+   * Include password hashes and SCRAM secrets
+   *
+   * @generated from field: bool include_secrets = 1;
+   */
+  includeSecrets: boolean;
+};
+
+/**
+ * Describes the message idp.gateway.v1.ExportConfigRequest.
+ * Use `create(ExportConfigRequestSchema)` to create a new message.
+ */
+export const ExportConfigRequestSchema: GenMessage<ExportConfigRequest> = /*@__PURE__*/
+  messageDesc(file_idp_gateway_v1_gateway, 56);
+
+/**
+ * @generated from message idp.gateway.v1.ExportConfigResponse
+ */
+export type ExportConfigResponse = Message<"idp.gateway.v1.ExportConfigResponse"> & {
+  /**
+   * @generated from field: repeated idp.gateway.v1.VirtualClusterConfig virtual_clusters = 1;
+   */
+  virtualClusters: VirtualClusterConfig[];
+
+  /**
+   * @generated from field: repeated idp.gateway.v1.CredentialConfig credentials = 2;
+   */
+  credentials: CredentialConfig[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp exported_at = 3;
+   */
+  exportedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message idp.gateway.v1.ExportConfigResponse.
+ * Use `create(ExportConfigResponseSchema)` to create a new message.
+ */
+export const ExportConfigResponseSchema: GenMessage<ExportConfigResponse> = /*@__PURE__*/
+  messageDesc(file_idp_gateway_v1_gateway, 57);
+
+/**
+ * @generated from message idp.gateway.v1.ImportConfigRequest
+ */
+export type ImportConfigRequest = Message<"idp.gateway.v1.ImportConfigRequest"> & {
+  /**
+   * @generated from field: repeated idp.gateway.v1.VirtualClusterConfig virtual_clusters = 1;
+   */
+  virtualClusters: VirtualClusterConfig[];
+
+  /**
+   * @generated from field: repeated idp.gateway.v1.CredentialConfig credentials = 2;
+   */
+  credentials: CredentialConfig[];
+
+  /**
+   * @generated from field: idp.gateway.v1.ImportConflictPolicy conflict_policy = 3;
+   */
+  conflictPolicy: ImportConflictPolicy;
+};
+
+/**
+ * Describes the message idp.gateway.v1.ImportConfigRequest.
+ * Use `create(ImportConfigRequestSchema)` to create a new message.
+ */
+export const ImportConfigRequestSchema: GenMessage<ImportConfigRequest> = /*@__PURE__*/
+  messageDesc(file_idp_gateway_v1_gateway, 58);
+
+/**
+ * @generated from message idp.gateway.v1.ImportConfigResponse
+ */
+export type ImportConfigResponse = Message<"idp.gateway.v1.ImportConfigResponse"> & {
+  /**
+   * @generated from field: int32 virtual_clusters_imported = 1;
+   */
+  virtualClustersImported: number;
+
+  /**
+   * @generated from field: int32 virtual_clusters_skipped = 2;
+   */
+  virtualClustersSkipped: number;
+
+  /**
+   * @generated from field: int32 credentials_imported = 3;
+   */
+  credentialsImported: number;
+
+  /**
+   * @generated from field: int32 credentials_skipped = 4;
+   */
+  credentialsSkipped: number;
+};
+
+/**
+ * Describes the message idp.gateway.v1.ImportConfigResponse.
+ * Use `create(ImportConfigResponseSchema)` to create a new message.
+ */
+export const ImportConfigResponseSchema: GenMessage<ImportConfigResponse> = /*@__PURE__*/
+  messageDesc(file_idp_gateway_v1_gateway, 59);
 
 /**
  * @generated from enum idp.gateway.v1.PermissionTemplate
@@ -1466,6 +1701,41 @@ export enum PermissionTemplate {
 export const PermissionTemplateSchema: GenEnum<PermissionTemplate> = /*@__PURE__*/
   enumDesc(file_idp_gateway_v1_gateway, 0);
 
+/**
+ * SaslMechanism is the SASL mechanism a credential authenticates with.
+ *
+ * @generated from enum idp.gateway.v1.SaslMechanism
+ */
+export enum SaslMechanism {
+  /**
+   * Treated as PLAIN
+   *
+   * @generated from enum value: SASL_MECHANISM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SASL_MECHANISM_PLAIN = 1;
+   */
+  PLAIN = 1,
+
+  /**
+   * @generated from enum value: SASL_MECHANISM_SCRAM_SHA_256 = 2;
+   */
+  SCRAM_SHA_256 = 2,
+
+  /**
+   * @generated from enum value: SASL_MECHANISM_SCRAM_SHA_512 = 3;
+   */
+  SCRAM_SHA_512 = 3,
+}
+
+/**
+ * Describes the enum idp.gateway.v1.SaslMechanism.
+ */
+export const SaslMechanismSchema: GenEnum<SaslMechanism> = /*@__PURE__*/
+  enumDesc(file_idp_gateway_v1_gateway, 1);
+
 /**
  * @generated from enum idp.gateway.v1.ConsumerGroupState
  */
@@ -1505,7 +1775,7 @@ export enum ConsumerGroupState {
  * Describes the enum idp.gateway.v1.ConsumerGroupState.
  */
 export const ConsumerGroupStateSchema: GenEnum<ConsumerGroupState> = /*@__PURE__*/
-  enumDesc(file_idp_gateway_v1_gateway, 1);
+  enumDesc(file_idp_gateway_v1_gateway, 2);
 
 /**
  * @generated from enum idp.gateway.v1.OffsetResetType
@@ -1536,7 +1806,49 @@ export enum OffsetResetType {
  * Describes the enum idp.gateway.v1.OffsetResetType.
  */
 export const OffsetResetTypeSchema: GenEnum<OffsetResetType> = /*@__PURE__*/
-  enumDesc(file_idp_gateway_v1_gateway, 2);
+  enumDesc(file_idp_gateway_v1_gateway, 3);
+
+/**
+ * ImportConflictPolicy decides what happens when an imported virtual cluster
+ * or credential ID already exists on the gateway.
+ *
+ * @generated from enum idp.gateway.v1.ImportConflictPolicy
+ */
+export enum ImportConflictPolicy {
+  /**
+   * Treated as FAIL
+   *
+   * @generated from enum value: IMPORT_CONFLICT_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Reject the whole batch
+   *
+   * @generated from enum value: IMPORT_CONFLICT_POLICY_FAIL = 1;
+   */
+  FAIL = 1,
+
+  /**
+   * Keep the existing entry
+   *
+   * @generated from enum value: IMPORT_CONFLICT_POLICY_SKIP = 2;
+   */
+  SKIP = 2,
+
+  /**
+   * Replace the existing entry
+   *
+   * @generated from enum value: IMPORT_CONFLICT_POLICY_OVERWRITE = 3;
+   */
+  OVERWRITE = 3,
+}
+
+/**
+ * Describes the enum idp.gateway.v1.ImportConflictPolicy.
+ */
+export const ImportConflictPolicySchema: GenEnum<ImportConflictPolicy> = /*@__PURE__*/
+  enumDesc(file_idp_gateway_v1_gateway, 4);
 
 /**
  * @generated from service idp.gateway.v1.BifrostAdminService
@@ -1700,6 +2012,24 @@ export const BifrostAdminService: GenService<{
     input: typeof ResetConsumerGroupOffsetsRequestSchema;
     output: typeof ResetConsumerGroupOffsetsResponseSchema;
   },
+  /**
+   * Backup and migration
+   *
+   * @generated from rpc idp.gateway.v1.BifrostAdminService.ExportConfig
+   */
+  exportConfig: {
+    methodKind: "unary";
+    input: typeof ExportConfigRequestSchema;
+    output: typeof ExportConfigResponseSchema;
+  },
+  /**
+   * @generated from rpc idp.gateway.v1.BifrostAdminService.ImportConfig
+   */
+  importConfig: {
+    methodKind: "unary";
+    input: typeof ImportConfigRequestSchema;
+    output: typeof ImportConfigResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_idp_gateway_v1_gateway, 0);
 
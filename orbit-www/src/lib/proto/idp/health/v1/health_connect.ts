@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeleteScheduleRequest, DeleteScheduleResponse, ManageScheduleRequest, ManageScheduleResponse } from "./health_pb.js";
+import { CheckRequest, CheckResponse, DeleteScheduleRequest, DeleteScheduleResponse, ManageScheduleRequest, ManageScheduleResponse } from "./health_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -34,6 +34,17 @@ export const HealthService = {
       O: DeleteScheduleResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Check reports whether the service can reach the dependencies it needs to serve requests
+     *
+     * @generated from rpc idp.health.v1.HealthService.Check
+     */
+    check: {
+      name: "Check",
+      I: CheckRequest,
+      O: CheckResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
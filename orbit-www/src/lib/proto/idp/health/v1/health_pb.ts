@@ -2,15 +2,15 @@
 // @generated from file idp/health/v1/health.proto (package idp.health.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file idp/health/v1/health.proto.
  */
 export const file_idp_health_v1_health: GenFile = /*@__PURE__*/
-  fileDesc("ChppZHAvaGVhbHRoL3YxL2hlYWx0aC5wcm90bxINaWRwLmhlYWx0aC52MSJnCgxIZWFsdGhDb25maWcSCwoDdXJsGAEgASgJEg4KBm1ldGhvZBgCIAEoCRIXCg9leHBlY3RlZF9zdGF0dXMYAyABKAUSEAoIaW50ZXJ2YWwYBCABKAUSDwoHdGltZW91dBgFIAEoBSJbChVNYW5hZ2VTY2hlZHVsZVJlcXVlc3QSDgoGYXBwX2lkGAEgASgJEjIKDWhlYWx0aF9jb25maWcYAiABKAsyGy5pZHAuaGVhbHRoLnYxLkhlYWx0aENvbmZpZyJNChZNYW5hZ2VTY2hlZHVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEwoLc2NoZWR1bGVfaWQYAiABKAkSDQoFZXJyb3IYAyABKAkiJwoVRGVsZXRlU2NoZWR1bGVSZXF1ZXN0Eg4KBmFwcF9pZBgBIAEoCSI4ChZEZWxldGVTY2hlZHVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkyzQEKDUhlYWx0aFNlcnZpY2USXQoOTWFuYWdlU2NoZWR1bGUSJC5pZHAuaGVhbHRoLnYxLk1hbmFnZVNjaGVkdWxlUmVxdWVzdBolLmlkcC5oZWFsdGgudjEuTWFuYWdlU2NoZWR1bGVSZXNwb25zZRJdCg5EZWxldGVTY2hlZHVsZRIkLmlkcC5oZWFsdGgudjEuRGVsZXRlU2NoZWR1bGVSZXF1ZXN0GiUuaWRwLmhlYWx0aC52MS5EZWxldGVTY2hlZHVsZVJlc3BvbnNlQkJaQGdpdGh1Yi5jb20vZHJld3BheW1lbnQvb3JiaXQvcHJvdG8vZ2VuL2dvL2lkcC9oZWFsdGgvdjE7aGVhbHRodjFiBnByb3RvMw");
+  fileDesc("ChppZHAvaGVhbHRoL3YxL2hlYWx0aC5wcm90bxINaWRwLmhlYWx0aC52MSJnCgxIZWFsdGhDb25maWcSCwoDdXJsGAEgASgJEg4KBm1ldGhvZBgCIAEoCRIXCg9leHBlY3RlZF9zdGF0dXMYAyABKAUSEAoIaW50ZXJ2YWwYBCABKAUSDwoHdGltZW91dBgFIAEoBSJbChVNYW5hZ2VTY2hlZHVsZVJlcXVlc3QSDgoGYXBwX2lkGAEgASgJEjIKDWhlYWx0aF9jb25maWcYAiABKAsyGy5pZHAuaGVhbHRoLnYxLkhlYWx0aENvbmZpZyJNChZNYW5hZ2VTY2hlZHVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSEwoLc2NoZWR1bGVfaWQYAiABKAkSDQoFZXJyb3IYAyABKAkiJwoVRGVsZXRlU2NoZWR1bGVSZXF1ZXN0Eg4KBmFwcF9pZBgBIAEoCSI4ChZEZWxldGVTY2hlZHVsZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiDgoMQ2hlY2tSZXF1ZXN0Ik4KDUNoZWNrUmVzcG9uc2USLAoGc3RhdHVzGAEgASgOMhwuaWRwLmhlYWx0aC52MS5TZXJ2aW5nU3RhdHVzEg8KB21lc3NhZ2UYAiABKAkqawoNU2VydmluZ1N0YXR1cxIeChpTRVJWSU5HX1NUQVRVU19VTlNQRUNJRklFRBAAEhoKFlNFUlZJTkdfU1RBVFVTX1NFUlZJTkcQARIeChpTRVJWSU5HX1NUQVRVU19OT1RfU0VSVklORxACMpECCg1IZWFsdGhTZXJ2aWNlEl0KDk1hbmFnZVNjaGVkdWxlEiQuaWRwLmhlYWx0aC52MS5NYW5hZ2VTY2hlZHVsZVJlcXVlc3QaJS5pZHAuaGVhbHRoLnYxLk1hbmFnZVNjaGVkdWxlUmVzcG9uc2USXQoORGVsZXRlU2NoZWR1bGUSJC5pZHAuaGVhbHRoLnYxLkRlbGV0ZVNjaGVkdWxlUmVxdWVzdBolLmlkcC5oZWFsdGgudjEuRGVsZXRlU2NoZWR1bGVSZXNwb25zZRJCCgVDaGVjaxIbLmlkcC5oZWFsdGgudjEuQ2hlY2tSZXF1ZXN0GhwuaWRwLmhlYWx0aC52MS5DaGVja1Jlc3BvbnNlQkJaQGdpdGh1Yi5jb20vZHJld3BheW1lbnQvb3JiaXQvcHJvdG8vZ2VuL2dvL2lkcC9oZWFsdGgvdjE7aGVhbHRodjFiBnByb3RvMw");
 
 /**
  * @generated from message idp.health.v1.HealthConfig
@@ -137,6 +137,69 @@ export type DeleteScheduleResponse = Message<"idp.health.v1.DeleteScheduleRespon
 export const DeleteScheduleResponseSchema: GenMessage<DeleteScheduleResponse> = /*@__PURE__*/
   messageDesc(file_idp_health_v1_health, 4);
 
+/**
+ * @generated from message idp.health.v1.CheckRequest
+ */
+export type CheckRequest = Message<"idp.health.v1.CheckRequest"> & {
+};
+
+/**
+ * Describes the message idp.health.v1.CheckRequest.
+ * Use `create(CheckRequestSchema)` to create a new message.
+ */
+export const CheckRequestSchema: GenMessage<CheckRequest> = /*@__PURE__*/
+  messageDesc(file_idp_health_v1_health, 5);
+
+/**
+ * @generated from message idp.health.v1.CheckResponse
+ */
+export type CheckResponse = Message<"idp.health.v1.CheckResponse"> & {
+  /**
+   * @generated from field: idp.health.v1.ServingStatus status = 1;
+   */
+  status: ServingStatus;
+
+  /**
+   * Why the service is not serving
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message idp.health.v1.CheckResponse.
+ * Use `create(CheckResponseSchema)` to create a new message.
+ */
+export const CheckResponseSchema: GenMessage<CheckResponse> = /*@__PURE__*/
+  messageDesc(file_idp_health_v1_health, 6);
+
+/**
+ * @generated from enum idp.health.v1.ServingStatus
+ */
+export enum ServingStatus {
+  /**
+   * @generated from enum value: SERVING_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SERVING_STATUS_SERVING = 1;
+   */
+  SERVING = 1,
+
+  /**
+   * @generated from enum value: SERVING_STATUS_NOT_SERVING = 2;
+   */
+  NOT_SERVING = 2,
+}
+
+/**
+ * Describes the enum idp.health.v1.ServingStatus.
+ */
+export const ServingStatusSchema: GenEnum<ServingStatus> = /*@__PURE__*/
+  enumDesc(file_idp_health_v1_health, 0);
+
 /**
  * @generated from service idp.health.v1.HealthService
  */
@@ -161,6 +224,16 @@ export const HealthService: GenService<{
     input: typeof DeleteScheduleRequestSchema;
     output: typeof DeleteScheduleResponseSchema;
   },
+  /**
+   * Check reports whether the service can reach the dependencies it needs to serve requests
+   *
+   * @generated from rpc idp.health.v1.HealthService.Check
+   */
+  check: {
+    methodKind: "unary";
+    input: typeof CheckRequestSchema;
+    output: typeof CheckResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_idp_health_v1_health, 0);
 
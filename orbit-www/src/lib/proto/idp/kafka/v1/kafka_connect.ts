@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ApproveTopicAccessRequest, ApproveTopicAccessResponse, ApproveTopicRequest, ApproveTopicResponse, CheckSchemaCompatibilityRequest, CheckSchemaCompatibilityResponse, CreateEnvironmentMappingRequest, CreateEnvironmentMappingResponse, CreateServiceAccountRequest, CreateServiceAccountResponse, CreateTopicDirectRequest, CreateTopicDirectResponse, CreateTopicRequest, CreateTopicResponse, DeleteClusterRequest, DeleteClusterResponse, DeleteEnvironmentMappingRequest, DeleteEnvironmentMappingResponse, DeleteTopicByNameRequest, DeleteTopicByNameResponse, DeleteTopicRequest, DeleteTopicResponse, DiscoverTopicsRequest, DiscoverTopicsResponse, GetSchemaRequest, GetSchemaResponse, GetTopicLineageRequest, GetTopicLineageResponse, GetTopicMetricsRequest, GetTopicMetricsResponse, GetTopicRequest, GetTopicResponse, ListClustersRequest, ListClustersResponse, ListEnvironmentMappingsRequest, ListEnvironmentMappingsResponse, ListProvidersRequest, ListProvidersResponse, ListSchemasRequest, ListSchemasResponse, ListServiceAccountsRequest, ListServiceAccountsResponse, ListTopicSharesRequest, ListTopicSharesResponse, ListTopicsRequest, ListTopicsResponse, RegisterClusterRequest, RegisterClusterResponse, RegisterSchemaRequest, RegisterSchemaResponse, RequestTopicAccessRequest, RequestTopicAccessResponse, RevokeServiceAccountRequest, RevokeServiceAccountResponse, RevokeTopicAccessRequest, RevokeTopicAccessResponse, UpdateTopicRequest, UpdateTopicResponse, ValidateClusterConnectionRequest, ValidateClusterConnectionResponse, ValidateClusterRequest, ValidateClusterResponse } from "./kafka_pb.js";
+import { ApproveTopicAccessRequest, ApproveTopicAccessResponse, ApproveTopicRequest, ApproveTopicResponse, CheckSchemaCompatibilityRequest, CheckSchemaCompatibilityResponse, CreateEnvironmentMappingRequest, CreateEnvironmentMappingResponse, CreateServiceAccountRequest, CreateServiceAccountResponse, CreateTopicDirectRequest, CreateTopicDirectResponse, CreateTopicRequest, CreateTopicResponse, DeleteClusterRequest, DeleteClusterResponse, DeleteEnvironmentMappingRequest, DeleteEnvironmentMappingResponse, DeleteTopicByNameRequest, DeleteTopicByNameResponse, DeleteTopicRequest, DeleteTopicResponse, DescribeTopicRequest, DescribeTopicResponse, DiscoverTopicsRequest, DiscoverTopicsResponse, GetSchemaRequest, GetSchemaResponse, GetTopicLineageRequest, GetTopicLineageResponse, GetTopicMetricsRequest, GetTopicMetricsResponse, GetTopicRequest, GetTopicResponse, ListClustersRequest, ListClustersResponse, ListEnvironmentMappingsRequest, ListEnvironmentMappingsResponse, ListProvidersRequest, ListProvidersResponse, ListSchemasRequest, ListSchemasResponse, ListServiceAccountsRequest, ListServiceAccountsResponse, ListTopicSharesRequest, ListTopicSharesResponse, ListTopicsRequest, ListTopicsResponse, RegisterClusterRequest, RegisterClusterResponse, RegisterSchemaRequest, RegisterSchemaResponse, RequestTopicAccessRequest, RequestTopicAccessResponse, RevokeServiceAccountRequest, RevokeServiceAccountResponse, RevokeTopicAccessRequest, RevokeTopicAccessResponse, UpdateTopicRequest, UpdateTopicResponse, ValidateClusterConnectionRequest, ValidateClusterConnectionResponse, ValidateClusterRequest, ValidateClusterResponse } from "./kafka_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -126,6 +126,15 @@ export const KafkaService = {
       O: GetTopicResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc idp.kafka.v1.KafkaService.DescribeTopic
+     */
+    describeTopic: {
+      name: "DescribeTopic",
+      I: DescribeTopicRequest,
+      O: DescribeTopicResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc idp.kafka.v1.KafkaService.ListTopics
      */
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file idp/kafka/v1/kafka.proto.
  */
 export const file_idp_kafka_v1_kafka: GenFile = /*@__PURE__*/
-  fileDesc("ChhpZHAva2Fma2EvdjEva2Fma2EucHJvdG8SDGlkcC5rYWZrYS52MSLcAQoNS2Fma2FQcm92aWRlchIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRIUCgxhZGFwdGVyX3R5cGUYBCABKAkSHgoWcmVxdWlyZWRfY29uZmlnX2ZpZWxkcxgFIAMoCRI4CgxjYXBhYmlsaXRpZXMYBiABKAsyIi5pZHAua2Fma2EudjEuUHJvdmlkZXJDYXBhYmlsaXRpZXMSGQoRZG9jdW1lbnRhdGlvbl91cmwYByABKAkSEAoIaWNvbl91cmwYCCABKAkibgoUUHJvdmlkZXJDYXBhYmlsaXRpZXMSFwoPc2NoZW1hX3JlZ2lzdHJ5GAEgASgIEhQKDHRyYW5zYWN0aW9ucxgCIAEoCBISCgpxdW90YXNfYXBpGAMgASgIEhMKC21ldHJpY3NfYXBpGAQgASgIIpwDCgxLYWZrYUNsdXN0ZXISCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtwcm92aWRlcl9pZBgDIAEoCRJLChFjb25uZWN0aW9uX2NvbmZpZxgEIAMoCzIwLmlkcC5rYWZrYS52MS5LYWZrYUNsdXN0ZXIuQ29ubmVjdGlvbkNvbmZpZ0VudHJ5EkAKEXZhbGlkYXRpb25fc3RhdHVzGAUgASgOMiUuaWRwLmthZmthLnYxLkNsdXN0ZXJWYWxpZGF0aW9uU3RhdHVzEjUKEWxhc3RfdmFsaWRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBo3ChVDb25uZWN0aW9uQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASL2AQoXS2Fma2FFbnZpcm9ubWVudE1hcHBpbmcSCgoCaWQYASABKAkSEwoLZW52aXJvbm1lbnQYAiABKAkSEgoKY2x1c3Rlcl9pZBgDIAEoCRJMCgxyb3V0aW5nX3J1bGUYBCADKAsyNi5pZHAua2Fma2EudjEuS2Fma2FFbnZpcm9ubWVudE1hcHBpbmcuUm91dGluZ1J1bGVFbnRyeRIQCghwcmlvcml0eRgFIAEoBRISCgppc19kZWZhdWx0GAYgASgIGjIKEFJvdXRpbmdSdWxlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLbAQoOU2NoZW1hUmVnaXN0cnkSCgoCaWQYASABKAkSCwoDdXJsGAIgASgJEh8KF3N1YmplY3RfbmFtaW5nX3RlbXBsYXRlGAMgASgJEkAKFWRlZmF1bHRfY29tcGF0aWJpbGl0eRgEIAEoDjIhLmlkcC5rYWZrYS52MS5TY2hlbWFDb21wYXRpYmlsaXR5Ek0KFWVudmlyb25tZW50X292ZXJyaWRlcxgFIAMoCzIuLmlkcC5rYWZrYS52MS5FbnZpcm9ubWVudENvbXBhdGliaWxpdHlPdmVycmlkZSJxCiBFbnZpcm9ubWVudENvbXBhdGliaWxpdHlPdmVycmlkZRITCgtlbnZpcm9ubWVudBgBIAEoCRI4Cg1jb21wYXRpYmlsaXR5GAIgASgOMiEuaWRwLmthZmthLnYxLlNjaGVtYUNvbXBhdGliaWxpdHki0wQKCkthZmthVG9waWMSCgoCaWQYASABKAkSFAoMd29ya3NwYWNlX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSEwoLZW52aXJvbm1lbnQYBCABKAkSEgoKY2x1c3Rlcl9pZBgFIAEoCRISCgpwYXJ0aXRpb25zGAYgASgFEhoKEnJlcGxpY2F0aW9uX2ZhY3RvchgHIAEoBRIUCgxyZXRlbnRpb25fbXMYCCABKAMSFgoOY2xlYW51cF9wb2xpY3kYCSABKAkSEwoLY29tcHJlc3Npb24YCiABKAkSNAoGY29uZmlnGAsgAygLMiQuaWRwLmthZmthLnYxLkthZmthVG9waWMuQ29uZmlnRW50cnkSKQoGc3RhdHVzGAwgASgOMhkuaWRwLmthZmthLnYxLlRvcGljU3RhdHVzEhMKC3dvcmtmbG93X2lkGA0gASgJEhkKEWFwcHJvdmFsX3JlcXVpcmVkGA4gASgIEhMKC2FwcHJvdmVkX2J5GA8gASgJEi8KC2FwcHJvdmVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgtkZXNjcmlwdGlvbhgTIAEoCRotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIusCCgtLYWZrYVNjaGVtYRIKCgJpZBgBIAEoCRIUCgx3b3Jrc3BhY2VfaWQYAiABKAkSEAoIdG9waWNfaWQYAyABKAkSDAoEdHlwZRgEIAEoCRIPCgdzdWJqZWN0GAUgASgJEioKBmZvcm1hdBgGIAEoDjIaLmlkcC5rYWZrYS52MS5TY2hlbWFGb3JtYXQSDwoHY29udGVudBgHIAEoCRIPCgd2ZXJzaW9uGAggASgFEhEKCXNjaGVtYV9pZBgJIAEoBRI4Cg1jb21wYXRpYmlsaXR5GAogASgOMiEuaWRwLmthZmthLnYxLlNjaGVtYUNvbXBhdGliaWxpdHkSDgoGc3RhdHVzGAsgASgJEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIskBChNLYWZrYVNlcnZpY2VBY2NvdW50EgoKAmlkGAEgASgJEhQKDHdvcmtzcGFjZV9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEi4KBHR5cGUYBCABKA4yIC5pZHAua2Fma2EudjEuU2VydmljZUFjY291bnRUeXBlEg4KBnN0YXR1cxgFIAEoCRISCgpjcmVhdGVkX2J5GAYgASgJEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIrsDCg9LYWZrYVRvcGljU2hhcmUSCgoCaWQYASABKAkSEAoIdG9waWNfaWQYAiABKAkSGAoQc2hhcmVkX3dpdGhfdHlwZRgDIAEoCRIgChhzaGFyZWRfd2l0aF93b3Jrc3BhY2VfaWQYBCABKAkSGwoTc2hhcmVkX3dpdGhfdXNlcl9pZBgFIAEoCRIxCgpwZXJtaXNzaW9uGAYgASgOMh0uaWRwLmthZmthLnYxLlNoYXJlUGVybWlzc2lvbhIpCgZzdGF0dXMYByABKA4yGS5pZHAua2Fma2EudjEuU2hhcmVTdGF0dXMSFAoMcmVxdWVzdGVkX2J5GAggASgJEjAKDHJlcXVlc3RlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNanVzdGlmaWNhdGlvbhgKIAEoCRITCgthcHByb3ZlZF9ieRgLIAEoCRIvCgthcHByb3ZlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi0gIKEEthZmthVG9waWNQb2xpY3kSCgoCaWQYASABKAkSKAoFc2NvcGUYAiABKA4yGS5pZHAua2Fma2EudjEuUG9saWN5U2NvcGUSFAoMd29ya3NwYWNlX2lkGAMgASgJEhMKC2Vudmlyb25tZW50GAQgASgJEhYKDm5hbWluZ19wYXR0ZXJuGAUgASgJEh0KFWF1dG9fYXBwcm92ZV9wYXR0ZXJucxgGIAMoCRI3ChBwYXJ0aXRpb25fbGltaXRzGAcgASgLMh0uaWRwLmthZmthLnYxLlBhcnRpdGlvbkxpbWl0cxI3ChByZXRlbnRpb25fbGltaXRzGAggASgLMh0uaWRwLmthZmthLnYxLlJldGVudGlvbkxpbWl0cxIWCg5yZXF1aXJlX3NjaGVtYRgJIAEoCBIcChRyZXF1aXJlX2FwcHJvdmFsX2ZvchgKIAMoCSIrCg9QYXJ0aXRpb25MaW1pdHMSCwoDbWluGAEgASgFEgsKA21heBgCIAEoBSIxCg9SZXRlbnRpb25MaW1pdHMSDgoGbWluX21zGAEgASgDEg4KBm1heF9tcxgCIAEoAyKDAwoVS2Fma2FUb3BpY1NoYXJlUG9saWN5EgoKAmlkGAEgASgJEhQKDHdvcmtzcGFjZV9pZBgCIAEoCRItCgVzY29wZRgDIAEoDjIeLmlkcC5rYWZrYS52MS5TaGFyZVBvbGljeVNjb3BlEhUKDXRvcGljX3BhdHRlcm4YBCABKAkSEAoIdG9waWNfaWQYBSABKAkSEwoLZW52aXJvbm1lbnQYBiABKAkSMQoKdmlzaWJpbGl0eRgHIAEoDjIdLmlkcC5rYWZrYS52MS5Ub3BpY1Zpc2liaWxpdHkSNQoMYXV0b19hcHByb3ZlGAggASgLMh8uaWRwLmthZmthLnYxLkF1dG9BcHByb3ZlQ29uZmlnEjkKEmRlZmF1bHRfcGVybWlzc2lvbhgJIAEoDjIdLmlkcC5rYWZrYS52MS5TaGFyZVBlcm1pc3Npb24SHQoVcmVxdWlyZV9qdXN0aWZpY2F0aW9uGAogASgIEhcKD2FjY2Vzc190dGxfZGF5cxgLIAEoBSKUAQoRQXV0b0FwcHJvdmVDb25maWcSFAoMZW52aXJvbm1lbnRzGAEgAygJEjIKC3Blcm1pc3Npb25zGAIgAygOMh0uaWRwLmthZmthLnYxLlNoYXJlUGVybWlzc2lvbhIbChN3b3Jrc3BhY2Vfd2hpdGVsaXN0GAMgAygJEhgKEHNhbWVfdGVuYW50X29ubHkYBCABKAgi4AEKEUthZmthVXNhZ2VNZXRyaWNzEgoKAmlkGAEgASgJEhAKCHRvcGljX2lkGAIgASgJEg4KBnBlcmlvZBgDIAEoCRITCgtwZXJpb2RfdHlwZRgEIAEoCRIQCghieXRlc19pbhgFIAEoAxIRCglieXRlc19vdXQYBiABKAMSGAoQbWVzc2FnZV9jb3VudF9pbhgHIAEoAxIZChFtZXNzYWdlX2NvdW50X291dBgIIAEoAxIVCg1zdG9yYWdlX2J5dGVzGAkgASgDEhcKD3BhcnRpdGlvbl9jb3VudBgKIAEoBSKBAgoSS2Fma2FDb25zdW1lckdyb3VwEgoKAmlkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhIKCmNsdXN0ZXJfaWQYAyABKAkSEQoJdG9waWNfaWRzGAQgAygJEhoKEnNlcnZpY2VfYWNjb3VudF9pZBgFIAEoCRIUCgx3b3Jrc3BhY2VfaWQYBiABKAkSEwoLY3VycmVudF9sYWcYByABKAMSLQoJbGFzdF9zZWVuGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3VwZGF0ZWQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIvABChNLYWZrYUNsaWVudEFjdGl2aXR5EgoKAmlkGAEgASgJEhEKCWNsaWVudF9pZBgCIAEoCRIaChJzZXJ2aWNlX2FjY291bnRfaWQYAyABKAkSFAoMd29ya3NwYWNlX2lkGAQgASgJEhAKCHRvcGljX2lkGAUgASgJEhEKCWRpcmVjdGlvbhgGIAEoCRIZChFjb25zdW1lcl9ncm91cF9pZBgHIAEoCRIZChFieXRlc190cmFuc2ZlcnJlZBgIIAEoAxItCglsYXN0X3NlZW4YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhYKFExpc3RQcm92aWRlcnNSZXF1ZXN0IkcKFUxpc3RQcm92aWRlcnNSZXNwb25zZRIuCglwcm92aWRlcnMYASADKAsyGy5pZHAua2Fma2EudjEuS2Fma2FQcm92aWRlciLLAgoWUmVnaXN0ZXJDbHVzdGVyUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC3Byb3ZpZGVyX2lkGAIgASgJElUKEWNvbm5lY3Rpb25fY29uZmlnGAMgAygLMjouaWRwLmthZmthLnYxLlJlZ2lzdGVyQ2x1c3RlclJlcXVlc3QuQ29ubmVjdGlvbkNvbmZpZ0VudHJ5EkoKC2NyZWRlbnRpYWxzGAQgAygLMjUuaWRwLmthZmthLnYxLlJlZ2lzdGVyQ2x1c3RlclJlcXVlc3QuQ3JlZGVudGlhbHNFbnRyeRo3ChVDb25uZWN0aW9uQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARoyChBDcmVkZW50aWFsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiVQoXUmVnaXN0ZXJDbHVzdGVyUmVzcG9uc2USKwoHY2x1c3RlchgBIAEoCzIaLmlkcC5rYWZrYS52MS5LYWZrYUNsdXN0ZXISDQoFZXJyb3IYAiABKAkiLAoWVmFsaWRhdGVDbHVzdGVyUmVxdWVzdBISCgpjbHVzdGVyX2lkGAEgASgJIjcKF1ZhbGlkYXRlQ2x1c3RlclJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg0KBWVycm9yGAIgASgJIsYCCiBWYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVxdWVzdBJfChFjb25uZWN0aW9uX2NvbmZpZxgBIAMoCzJELmlkcC5rYWZrYS52MS5WYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVxdWVzdC5Db25uZWN0aW9uQ29uZmlnRW50cnkSVAoLY3JlZGVudGlhbHMYAiADKAsyPy5pZHAua2Fma2EudjEuVmFsaWRhdGVDbHVzdGVyQ29ubmVjdGlvblJlcXVlc3QuQ3JlZGVudGlhbHNFbnRyeRo3ChVDb25uZWN0aW9uQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARoyChBDcmVkZW50aWFsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiQQohVmFsaWRhdGVDbHVzdGVyQ29ubmVjdGlvblJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg0KBWVycm9yGAIgASgJIhUKE0xpc3RDbHVzdGVyc1JlcXVlc3QiRAoUTGlzdENsdXN0ZXJzUmVzcG9uc2USLAoIY2x1c3RlcnMYASADKAsyGi5pZHAua2Fma2EudjEuS2Fma2FDbHVzdGVyIioKFERlbGV0ZUNsdXN0ZXJSZXF1ZXN0EhIKCmNsdXN0ZXJfaWQYASABKAkiNwoVRGVsZXRlQ2x1c3RlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAki+gEKH0NyZWF0ZUVudmlyb25tZW50TWFwcGluZ1JlcXVlc3QSEwoLZW52aXJvbm1lbnQYASABKAkSEgoKY2x1c3Rlcl9pZBgCIAEoCRJUCgxyb3V0aW5nX3J1bGUYAyADKAsyPi5pZHAua2Fma2EudjEuQ3JlYXRlRW52aXJvbm1lbnRNYXBwaW5nUmVxdWVzdC5Sb3V0aW5nUnVsZUVudHJ5EhAKCHByaW9yaXR5GAQgASgFEhIKCmlzX2RlZmF1bHQYBSABKAgaMgoQUm91dGluZ1J1bGVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBImkKIENyZWF0ZUVudmlyb25tZW50TWFwcGluZ1Jlc3BvbnNlEjYKB21hcHBpbmcYASABKAsyJS5pZHAua2Fma2EudjEuS2Fma2FFbnZpcm9ubWVudE1hcHBpbmcSDQoFZXJyb3IYAiABKAkiNQoeTGlzdEVudmlyb25tZW50TWFwcGluZ3NSZXF1ZXN0EhMKC2Vudmlyb25tZW50GAEgASgJIloKH0xpc3RFbnZpcm9ubWVudE1hcHBpbmdzUmVzcG9uc2USNwoIbWFwcGluZ3MYASADKAsyJS5pZHAua2Fma2EudjEuS2Fma2FFbnZpcm9ubWVudE1hcHBpbmciNQofRGVsZXRlRW52aXJvbm1lbnRNYXBwaW5nUmVxdWVzdBISCgptYXBwaW5nX2lkGAEgASgJIkIKIERlbGV0ZUVudmlyb25tZW50TWFwcGluZ1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAki7QIKEkNyZWF0ZVRvcGljUmVxdWVzdBIUCgx3b3Jrc3BhY2VfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtlbnZpcm9ubWVudBgDIAEoCRISCgpwYXJ0aXRpb25zGAQgASgFEhoKEnJlcGxpY2F0aW9uX2ZhY3RvchgFIAEoBRIUCgxyZXRlbnRpb25fbXMYBiABKAMSFgoOY2xlYW51cF9wb2xpY3kYByABKAkSEwoLY29tcHJlc3Npb24YCCABKAkSPAoGY29uZmlnGAkgAygLMiwuaWRwLmthZmthLnYxLkNyZWF0ZVRvcGljUmVxdWVzdC5Db25maWdFbnRyeRITCgtkZXNjcmlwdGlvbhgKIAEoCRIpCgZzY2hlbWEYCyABKAsyGS5pZHAua2Fma2EudjEuS2Fma2FTY2hlbWEaLQoLQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJiChNDcmVhdGVUb3BpY1Jlc3BvbnNlEicKBXRvcGljGAEgASgLMhguaWRwLmthZmthLnYxLkthZmthVG9waWMSEwoLd29ya2Zsb3dfaWQYAiABKAkSDQoFZXJyb3IYAyABKAki5QMKGENyZWF0ZVRvcGljRGlyZWN0UmVxdWVzdBISCgp0b3BpY19uYW1lGAEgASgJEhIKCnBhcnRpdGlvbnMYAiABKAUSGgoScmVwbGljYXRpb25fZmFjdG9yGAMgASgFEkIKBmNvbmZpZxgEIAMoCzIyLmlkcC5rYWZrYS52MS5DcmVhdGVUb3BpY0RpcmVjdFJlcXVlc3QuQ29uZmlnRW50cnkSVwoRY29ubmVjdGlvbl9jb25maWcYBSADKAsyPC5pZHAua2Fma2EudjEuQ3JlYXRlVG9waWNEaXJlY3RSZXF1ZXN0LkNvbm5lY3Rpb25Db25maWdFbnRyeRJMCgtjcmVkZW50aWFscxgGIAMoCzI3LmlkcC5rYWZrYS52MS5DcmVhdGVUb3BpY0RpcmVjdFJlcXVlc3QuQ3JlZGVudGlhbHNFbnRyeRotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjcKFUNvbm5lY3Rpb25Db25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI7ChlDcmVhdGVUb3BpY0RpcmVjdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiIwoPR2V0VG9waWNSZXF1ZXN0EhAKCHRvcGljX2lkGAEgASgJIkoKEEdldFRvcGljUmVzcG9uc2USJwoFdG9waWMYASABKAsyGC5pZHAua2Fma2EudjEuS2Fma2FUb3BpYxINCgVlcnJvchgCIAEoCSKIAQoRTGlzdFRvcGljc1JlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJEhMKC2Vudmlyb25tZW50GAIgASgJEikKBnN0YXR1cxgDIAEoDjIZLmlkcC5rYWZrYS52MS5Ub3BpY1N0YXR1cxINCgVsaW1pdBgEIAEoBRIOCgZvZmZzZXQYBSABKAUiTQoSTGlzdFRvcGljc1Jlc3BvbnNlEigKBnRvcGljcxgBIAMoCzIYLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljEg0KBXRvdGFsGAIgASgFIpECChJVcGRhdGVUb3BpY1JlcXVlc3QSEAoIdG9waWNfaWQYASABKAkSFwoKcGFydGl0aW9ucxgCIAEoBUgAiAEBEhkKDHJldGVudGlvbl9tcxgDIAEoA0gBiAEBEjwKBmNvbmZpZxgEIAMoCzIsLmlkcC5rYWZrYS52MS5VcGRhdGVUb3BpY1JlcXVlc3QuQ29uZmlnRW50cnkSGAoLZGVzY3JpcHRpb24YBSABKAlIAogBARotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg0KC19wYXJ0aXRpb25zQg8KDV9yZXRlbnRpb25fbXNCDgoMX2Rlc2NyaXB0aW9uIk0KE1VwZGF0ZVRvcGljUmVzcG9uc2USJwoFdG9waWMYASABKAsyGC5pZHAua2Fma2EudjEuS2Fma2FUb3BpYxINCgVlcnJvchgCIAEoCSImChJEZWxldGVUb3BpY1JlcXVlc3QSEAoIdG9waWNfaWQYASABKAkiSgoTRGVsZXRlVG9waWNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhMKC3dvcmtmbG93X2lkGAIgASgJEg0KBWVycm9yGAMgASgJIsICChhEZWxldGVUb3BpY0J5TmFtZVJlcXVlc3QSEgoKdG9waWNfbmFtZRgBIAEoCRJXChFjb25uZWN0aW9uX2NvbmZpZxgCIAMoCzI8LmlkcC5rYWZrYS52MS5EZWxldGVUb3BpY0J5TmFtZVJlcXVlc3QuQ29ubmVjdGlvbkNvbmZpZ0VudHJ5EkwKC2NyZWRlbnRpYWxzGAMgAygLMjcuaWRwLmthZmthLnYxLkRlbGV0ZVRvcGljQnlOYW1lUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjcKFUNvbm5lY3Rpb25Db25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI7ChlEZWxldGVUb3BpY0J5TmFtZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiPAoTQXBwcm92ZVRvcGljUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRITCgthcHByb3ZlZF9ieRgCIAEoCSJjChRBcHByb3ZlVG9waWNSZXNwb25zZRInCgV0b3BpYxgBIAEoCzIYLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljEhMKC3dvcmtmbG93X2lkGAIgASgJEg0KBWVycm9yGAMgASgJIq4BChVSZWdpc3RlclNjaGVtYVJlcXVlc3QSEAoIdG9waWNfaWQYASABKAkSDAoEdHlwZRgCIAEoCRIqCgZmb3JtYXQYAyABKA4yGi5pZHAua2Fma2EudjEuU2NoZW1hRm9ybWF0Eg8KB2NvbnRlbnQYBCABKAkSOAoNY29tcGF0aWJpbGl0eRgFIAEoDjIhLmlkcC5rYWZrYS52MS5TY2hlbWFDb21wYXRpYmlsaXR5IlIKFlJlZ2lzdGVyU2NoZW1hUmVzcG9uc2USKQoGc2NoZW1hGAEgASgLMhkuaWRwLmthZmthLnYxLkthZmthU2NoZW1hEg0KBWVycm9yGAIgASgJIiUKEEdldFNjaGVtYVJlcXVlc3QSEQoJc2NoZW1hX2lkGAEgASgJIk0KEUdldFNjaGVtYVJlc3BvbnNlEikKBnNjaGVtYRgBIAEoCzIZLmlkcC5rYWZrYS52MS5LYWZrYVNjaGVtYRINCgVlcnJvchgCIAEoCSImChJMaXN0U2NoZW1hc1JlcXVlc3QSEAoIdG9waWNfaWQYASABKAkiQQoTTGlzdFNjaGVtYXNSZXNwb25zZRIqCgdzY2hlbWFzGAEgAygLMhkuaWRwLmthZmthLnYxLkthZmthU2NoZW1hIn4KH0NoZWNrU2NoZW1hQ29tcGF0aWJpbGl0eVJlcXVlc3QSEAoIdG9waWNfaWQYASABKAkSDAoEdHlwZRgCIAEoCRIqCgZmb3JtYXQYAyABKA4yGi5pZHAua2Fma2EudjEuU2NoZW1hRm9ybWF0Eg8KB2NvbnRlbnQYBCABKAkiRQogQ2hlY2tTY2hlbWFDb21wYXRpYmlsaXR5UmVzcG9uc2USEgoKY29tcGF0aWJsZRgBIAEoCBINCgVlcnJvchgCIAEoCSJxChtDcmVhdGVTZXJ2aWNlQWNjb3VudFJlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSLgoEdHlwZRgDIAEoDjIgLmlkcC5rYWZrYS52MS5TZXJ2aWNlQWNjb3VudFR5cGUijgEKHENyZWF0ZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USOgoPc2VydmljZV9hY2NvdW50GAEgASgLMiEuaWRwLmthZmthLnYxLkthZmthU2VydmljZUFjY291bnQSDwoHYXBpX2tleRgCIAEoCRISCgphcGlfc2VjcmV0GAMgASgJEg0KBWVycm9yGAQgASgJIjIKGkxpc3RTZXJ2aWNlQWNjb3VudHNSZXF1ZXN0EhQKDHdvcmtzcGFjZV9pZBgBIAEoCSJaChtMaXN0U2VydmljZUFjY291bnRzUmVzcG9uc2USOwoQc2VydmljZV9hY2NvdW50cxgBIAMoCzIhLmlkcC5rYWZrYS52MS5LYWZrYVNlcnZpY2VBY2NvdW50IjkKG1Jldm9rZVNlcnZpY2VBY2NvdW50UmVxdWVzdBIaChJzZXJ2aWNlX2FjY291bnRfaWQYASABKAkiPgocUmV2b2tlU2VydmljZUFjY291bnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWVycm9yGAIgASgJIpgBChlSZXF1ZXN0VG9waWNBY2Nlc3NSZXF1ZXN0EhAKCHRvcGljX2lkGAEgASgJEh8KF3JlcXVlc3Rpbmdfd29ya3NwYWNlX2lkGAIgASgJEjEKCnBlcm1pc3Npb24YAyABKA4yHS5pZHAua2Fma2EudjEuU2hhcmVQZXJtaXNzaW9uEhUKDWp1c3RpZmljYXRpb24YBCABKAkiWQoaUmVxdWVzdFRvcGljQWNjZXNzUmVzcG9uc2USLAoFc2hhcmUYASABKAsyHS5pZHAua2Fma2EudjEuS2Fma2FUb3BpY1NoYXJlEg0KBWVycm9yGAIgASgJIkIKGUFwcHJvdmVUb3BpY0FjY2Vzc1JlcXVlc3QSEAoIc2hhcmVfaWQYASABKAkSEwoLYXBwcm92ZWRfYnkYAiABKAkiWQoaQXBwcm92ZVRvcGljQWNjZXNzUmVzcG9uc2USLAoFc2hhcmUYASABKAsyHS5pZHAua2Fma2EudjEuS2Fma2FUb3BpY1NoYXJlEg0KBWVycm9yGAIgASgJIiwKGFJldm9rZVRvcGljQWNjZXNzUmVxdWVzdBIQCghzaGFyZV9pZBgBIAEoCSI7ChlSZXZva2VUb3BpY0FjY2Vzc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiawoWTGlzdFRvcGljU2hhcmVzUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRIUCgx3b3Jrc3BhY2VfaWQYAiABKAkSKQoGc3RhdHVzGAMgASgOMhkuaWRwLmthZmthLnYxLlNoYXJlU3RhdHVzIkgKF0xpc3RUb3BpY1NoYXJlc1Jlc3BvbnNlEi0KBnNoYXJlcxgBIAMoCzIdLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljU2hhcmUirwEKFURpc2NvdmVyVG9waWNzUmVxdWVzdBIfChdyZXF1ZXN0aW5nX3dvcmtzcGFjZV9pZBgBIAEoCRITCgtlbnZpcm9ubWVudBgCIAEoCRIOCgZzZWFyY2gYAyABKAkSMQoNc2NoZW1hX2Zvcm1hdBgEIAEoDjIaLmlkcC5rYWZrYS52MS5TY2hlbWFGb3JtYXQSDQoFbGltaXQYBSABKAUSDgoGb2Zmc2V0GAYgASgFIlgKFkRpc2NvdmVyVG9waWNzUmVzcG9uc2USLwoGdG9waWNzGAEgAygLMh8uaWRwLmthZmthLnYxLkRpc2NvdmVyYWJsZVRvcGljEg0KBXRvdGFsGAIgASgFIrkBChFEaXNjb3ZlcmFibGVUb3BpYxInCgV0b3BpYxgBIAEoCzIYLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljEh0KFW93bmluZ193b3Jrc3BhY2VfbmFtZRgCIAEoCRIxCgp2aXNpYmlsaXR5GAMgASgOMh0uaWRwLmthZmthLnYxLlRvcGljVmlzaWJpbGl0eRIVCg1hY2Nlc3Nfc3RhdHVzGAQgASgJEhIKCmhhc19zY2hlbWEYBSABKAgiUAoWR2V0VG9waWNNZXRyaWNzUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRITCgtwZXJpb2RfdHlwZRgCIAEoCRIPCgdwZXJpb2RzGAMgASgFIksKF0dldFRvcGljTWV0cmljc1Jlc3BvbnNlEjAKB21ldHJpY3MYASADKAsyHy5pZHAua2Fma2EudjEuS2Fma2FVc2FnZU1ldHJpY3MiKgoWR2V0VG9waWNMaW5lYWdlUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCSJ1ChdHZXRUb3BpY0xpbmVhZ2VSZXNwb25zZRIsCglwcm9kdWNlcnMYASADKAsyGS5pZHAua2Fma2EudjEuTGluZWFnZU5vZGUSLAoJY29uc3VtZXJzGAIgAygLMhkuaWRwLmthZmthLnYxLkxpbmVhZ2VOb2RlItIBCgtMaW5lYWdlTm9kZRIUCgx3b3Jrc3BhY2VfaWQYASABKAkSFgoOd29ya3NwYWNlX25hbWUYAiABKAkSGgoSc2VydmljZV9hY2NvdW50X2lkGAMgASgJEhwKFHNlcnZpY2VfYWNjb3VudF9uYW1lGAQgASgJEhEKCWNsaWVudF9pZBgFIAEoCRIZChFieXRlc190cmFuc2ZlcnJlZBgGIAEoAxItCglsYXN0X3NlZW4YByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wKsABCgxQcm92aWRlclR5cGUSHQoZUFJPVklERVJfVFlQRV9VTlNQRUNJRklFRBAAEh4KGlBST1ZJREVSX1RZUEVfQVBBQ0hFX0tBRktBEAESIQodUFJPVklERVJfVFlQRV9DT05GTFVFTlRfQ0xPVUQQAhIZChVQUk9WSURFUl9UWVBFX0FXU19NU0sQAxIaChZQUk9WSURFUl9UWVBFX1JFRFBBTkRBEAQSFwoTUFJPVklERVJfVFlQRV9BSVZFThAFKrcBChdDbHVzdGVyVmFsaWRhdGlvblN0YXR1cxIpCiVDTFVTVEVSX1ZBTElEQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASJQohQ0xVU1RFUl9WQUxJREFUSU9OX1NUQVRVU19QRU5ESU5HEAESIwofQ0xVU1RFUl9WQUxJREFUSU9OX1NUQVRVU19WQUxJRBACEiUKIUNMVVNURVJfVkFMSURBVElPTl9TVEFUVVNfSU5WQUxJRBADKroBCgtUb3BpY1N0YXR1cxIcChhUT1BJQ19TVEFUVVNfVU5TUEVDSUZJRUQQABIhCh1UT1BJQ19TVEFUVVNfUEVORElOR19BUFBST1ZBTBABEh0KGVRPUElDX1NUQVRVU19QUk9WSVNJT05JTkcQAhIXChNUT1BJQ19TVEFUVVNfQUNUSVZFEAMSFwoTVE9QSUNfU1RBVFVTX0ZBSUxFRBAEEhkKFVRPUElDX1NUQVRVU19ERUxFVElORxAFKnkKDFNjaGVtYUZvcm1hdBIdChlTQ0hFTUFfRk9STUFUX1VOU1BFQ0lGSUVEEAASFgoSU0NIRU1BX0ZPUk1BVF9BVlJPEAESGgoWU0NIRU1BX0ZPUk1BVF9QUk9UT0JVRhACEhYKElNDSEVNQV9GT1JNQVRfSlNPThADKr4BChNTY2hlbWFDb21wYXRpYmlsaXR5EiQKIFNDSEVNQV9DT01QQVRJQklMSVRZX1VOU1BFQ0lGSUVEEAASIQodU0NIRU1BX0NPTVBBVElCSUxJVFlfQkFDS1dBUkQQARIgChxTQ0hFTUFfQ09NUEFUSUJJTElUWV9GT1JXQVJEEAISHQoZU0NIRU1BX0NPTVBBVElCSUxJVFlfRlVMTBADEh0KGVNDSEVNQV9DT01QQVRJQklMSVRZX05PTkUQBCrMAQoSU2VydmljZUFjY291bnRUeXBlEiQKIFNFUlZJQ0VfQUNDT1VOVF9UWVBFX1VOU1BFQ0lGSUVEEAASIQodU0VSVklDRV9BQ0NPVU5UX1RZUEVfUFJPRFVDRVIQARIhCh1TRVJWSUNFX0FDQ09VTlRfVFlQRV9DT05TVU1FUhACEioKJlNFUlZJQ0VfQUNDT1VOVF9UWVBFX1BST0RVQ0VSX0NPTlNVTUVSEAMSHgoaU0VSVklDRV9BQ0NPVU5UX1RZUEVfQURNSU4QBCqdAQoLU2hhcmVTdGF0dXMSHAoYU0hBUkVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocU0hBUkVfU1RBVFVTX1BFTkRJTkdfUkVRVUVTVBABEhkKFVNIQVJFX1NUQVRVU19BUFBST1ZFRBACEhkKFVNIQVJFX1NUQVRVU19SRUpFQ1RFRBADEhgKFFNIQVJFX1NUQVRVU19SRVZPS0VEEAQqiwEKD1NoYXJlUGVybWlzc2lvbhIgChxTSEFSRV9QRVJNSVNTSU9OX1VOU1BFQ0lGSUVEEAASGQoVU0hBUkVfUEVSTUlTU0lPTl9SRUFEEAESGgoWU0hBUkVfUEVSTUlTU0lPTl9XUklURRACEh8KG1NIQVJFX1BFUk1JU1NJT05fUkVBRF9XUklURRADKpEBCg9Ub3BpY1Zpc2liaWxpdHkSIAocVE9QSUNfVklTSUJJTElUWV9VTlNQRUNJRklFRBAAEhwKGFRPUElDX1ZJU0lCSUxJVFlfUFJJVkFURRABEiEKHVRPUElDX1ZJU0lCSUxJVFlfRElTQ09WRVJBQkxFEAISGwoXVE9QSUNfVklTSUJJTElUWV9QVUJMSUMQAypiCgtQb2xpY3lTY29wZRIcChhQT0xJQ1lfU0NPUEVfVU5TUEVDSUZJRUQQABIZChVQT0xJQ1lfU0NPUEVfUExBVEZPUk0QARIaChZQT0xJQ1lfU0NPUEVfV09SS1NQQUNFEAIqpgEKEFNoYXJlUG9saWN5U2NvcGUSIgoeU0hBUkVfUE9MSUNZX1NDT1BFX1VOU1BFQ0lGSUVEEAASIQodU0hBUkVfUE9MSUNZX1NDT1BFX0FMTF9UT1BJQ1MQARIkCiBTSEFSRV9QT0xJQ1lfU0NPUEVfVE9QSUNfUEFUVEVSThACEiUKIVNIQVJFX1BPTElDWV9TQ09QRV9TUEVDSUZJQ19UT1BJQxADMvkXCgxLYWZrYVNlcnZpY2USWAoNTGlzdFByb3ZpZGVycxIiLmlkcC5rYWZrYS52MS5MaXN0UHJvdmlkZXJzUmVxdWVzdBojLmlkcC5rYWZrYS52MS5MaXN0UHJvdmlkZXJzUmVzcG9uc2USXgoPUmVnaXN0ZXJDbHVzdGVyEiQuaWRwLmthZmthLnYxLlJlZ2lzdGVyQ2x1c3RlclJlcXVlc3QaJS5pZHAua2Fma2EudjEuUmVnaXN0ZXJDbHVzdGVyUmVzcG9uc2USXgoPVmFsaWRhdGVDbHVzdGVyEiQuaWRwLmthZmthLnYxLlZhbGlkYXRlQ2x1c3RlclJlcXVlc3QaJS5pZHAua2Fma2EudjEuVmFsaWRhdGVDbHVzdGVyUmVzcG9uc2USfAoZVmFsaWRhdGVDbHVzdGVyQ29ubmVjdGlvbhIuLmlkcC5rYWZrYS52MS5WYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVxdWVzdBovLmlkcC5rYWZrYS52MS5WYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVzcG9uc2USVQoMTGlzdENsdXN0ZXJzEiEuaWRwLmthZmthLnYxLkxpc3RDbHVzdGVyc1JlcXVlc3QaIi5pZHAua2Fma2EudjEuTGlzdENsdXN0ZXJzUmVzcG9uc2USWAoNRGVsZXRlQ2x1c3RlchIiLmlkcC5rYWZrYS52MS5EZWxldGVDbHVzdGVyUmVxdWVzdBojLmlkcC5rYWZrYS52MS5EZWxldGVDbHVzdGVyUmVzcG9uc2USeQoYQ3JlYXRlRW52aXJvbm1lbnRNYXBwaW5nEi0uaWRwLmthZmthLnYxLkNyZWF0ZUVudmlyb25tZW50TWFwcGluZ1JlcXVlc3QaLi5pZHAua2Fma2EudjEuQ3JlYXRlRW52aXJvbm1lbnRNYXBwaW5nUmVzcG9uc2USdgoXTGlzdEVudmlyb25tZW50TWFwcGluZ3MSLC5pZHAua2Fma2EudjEuTGlzdEVudmlyb25tZW50TWFwcGluZ3NSZXF1ZXN0Gi0uaWRwLmthZmthLnYxLkxpc3RFbnZpcm9ubWVudE1hcHBpbmdzUmVzcG9uc2USeQoYRGVsZXRlRW52aXJvbm1lbnRNYXBwaW5nEi0uaWRwLmthZmthLnYxLkRlbGV0ZUVudmlyb25tZW50TWFwcGluZ1JlcXVlc3QaLi5pZHAua2Fma2EudjEuRGVsZXRlRW52aXJvbm1lbnRNYXBwaW5nUmVzcG9uc2USUgoLQ3JlYXRlVG9waWMSIC5pZHAua2Fma2EudjEuQ3JlYXRlVG9waWNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLkNyZWF0ZVRvcGljUmVzcG9uc2USZAoRQ3JlYXRlVG9waWNEaXJlY3QSJi5pZHAua2Fma2EudjEuQ3JlYXRlVG9waWNEaXJlY3RSZXF1ZXN0GicuaWRwLmthZmthLnYxLkNyZWF0ZVRvcGljRGlyZWN0UmVzcG9uc2USSQoIR2V0VG9waWMSHS5pZHAua2Fma2EudjEuR2V0VG9waWNSZXF1ZXN0Gh4uaWRwLmthZmthLnYxLkdldFRvcGljUmVzcG9uc2USTwoKTGlzdFRvcGljcxIfLmlkcC5rYWZrYS52MS5MaXN0VG9waWNzUmVxdWVzdBogLmlkcC5rYWZrYS52MS5MaXN0VG9waWNzUmVzcG9uc2USUgoLVXBkYXRlVG9waWMSIC5pZHAua2Fma2EudjEuVXBkYXRlVG9waWNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLlVwZGF0ZVRvcGljUmVzcG9uc2USUgoLRGVsZXRlVG9waWMSIC5pZHAua2Fma2EudjEuRGVsZXRlVG9waWNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLkRlbGV0ZVRvcGljUmVzcG9uc2USZAoRRGVsZXRlVG9waWNCeU5hbWUSJi5pZHAua2Fma2EudjEuRGVsZXRlVG9waWNCeU5hbWVSZXF1ZXN0GicuaWRwLmthZmthLnYxLkRlbGV0ZVRvcGljQnlOYW1lUmVzcG9uc2USVQoMQXBwcm92ZVRvcGljEiEuaWRwLmthZmthLnYxLkFwcHJvdmVUb3BpY1JlcXVlc3QaIi5pZHAua2Fma2EudjEuQXBwcm92ZVRvcGljUmVzcG9uc2USWwoOUmVnaXN0ZXJTY2hlbWESIy5pZHAua2Fma2EudjEuUmVnaXN0ZXJTY2hlbWFSZXF1ZXN0GiQuaWRwLmthZmthLnYxLlJlZ2lzdGVyU2NoZW1hUmVzcG9uc2USTAoJR2V0U2NoZW1hEh4uaWRwLmthZmthLnYxLkdldFNjaGVtYVJlcXVlc3QaHy5pZHAua2Fma2EudjEuR2V0U2NoZW1hUmVzcG9uc2USUgoLTGlzdFNjaGVtYXMSIC5pZHAua2Fma2EudjEuTGlzdFNjaGVtYXNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLkxpc3RTY2hlbWFzUmVzcG9uc2USeQoYQ2hlY2tTY2hlbWFDb21wYXRpYmlsaXR5Ei0uaWRwLmthZmthLnYxLkNoZWNrU2NoZW1hQ29tcGF0aWJpbGl0eVJlcXVlc3QaLi5pZHAua2Fma2EudjEuQ2hlY2tTY2hlbWFDb21wYXRpYmlsaXR5UmVzcG9uc2USbQoUQ3JlYXRlU2VydmljZUFjY291bnQSKS5pZHAua2Fma2EudjEuQ3JlYXRlU2VydmljZUFjY291bnRSZXF1ZXN0GiouaWRwLmthZmthLnYxLkNyZWF0ZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USagoTTGlzdFNlcnZpY2VBY2NvdW50cxIoLmlkcC5rYWZrYS52MS5MaXN0U2VydmljZUFjY291bnRzUmVxdWVzdBopLmlkcC5rYWZrYS52MS5MaXN0U2VydmljZUFjY291bnRzUmVzcG9uc2USbQoUUmV2b2tlU2VydmljZUFjY291bnQSKS5pZHAua2Fma2EudjEuUmV2b2tlU2VydmljZUFjY291bnRSZXF1ZXN0GiouaWRwLmthZmthLnYxLlJldm9rZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USZwoSUmVxdWVzdFRvcGljQWNjZXNzEicuaWRwLmthZmthLnYxLlJlcXVlc3RUb3BpY0FjY2Vzc1JlcXVlc3QaKC5pZHAua2Fma2EudjEuUmVxdWVzdFRvcGljQWNjZXNzUmVzcG9uc2USZwoSQXBwcm92ZVRvcGljQWNjZXNzEicuaWRwLmthZmthLnYxLkFwcHJvdmVUb3BpY0FjY2Vzc1JlcXVlc3QaKC5pZHAua2Fma2EudjEuQXBwcm92ZVRvcGljQWNjZXNzUmVzcG9uc2USZAoRUmV2b2tlVG9waWNBY2Nlc3MSJi5pZHAua2Fma2EudjEuUmV2b2tlVG9waWNBY2Nlc3NSZXF1ZXN0GicuaWRwLmthZmthLnYxLlJldm9rZVRvcGljQWNjZXNzUmVzcG9uc2USXgoPTGlzdFRvcGljU2hhcmVzEiQuaWRwLmthZmthLnYxLkxpc3RUb3BpY1NoYXJlc1JlcXVlc3QaJS5pZHAua2Fma2EudjEuTGlzdFRvcGljU2hhcmVzUmVzcG9uc2USWwoORGlzY292ZXJUb3BpY3MSIy5pZHAua2Fma2EudjEuRGlzY292ZXJUb3BpY3NSZXF1ZXN0GiQuaWRwLmthZmthLnYxLkRpc2NvdmVyVG9waWNzUmVzcG9uc2USXgoPR2V0VG9waWNNZXRyaWNzEiQuaWRwLmthZmthLnYxLkdldFRvcGljTWV0cmljc1JlcXVlc3QaJS5pZHAua2Fma2EudjEuR2V0VG9waWNNZXRyaWNzUmVzcG9uc2USXgoPR2V0VG9waWNMaW5lYWdlEiQuaWRwLmthZmthLnYxLkdldFRvcGljTGluZWFnZVJlcXVlc3QaJS5pZHAua2Fma2EudjEuR2V0VG9waWNMaW5lYWdlUmVzcG9uc2VCQFo+Z2l0aHViLmNvbS9kcmV3cGF5bWVudC9vcmJpdC9wcm90by9nZW4vZ28vaWRwL2thZmthL3YxO2thZmthdjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChhpZHAva2Fma2EvdjEva2Fma2EucHJvdG8SDGlkcC5rYWZrYS52MSLcAQoNS2Fma2FQcm92aWRlchIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRIUCgxhZGFwdGVyX3R5cGUYBCABKAkSHgoWcmVxdWlyZWRfY29uZmlnX2ZpZWxkcxgFIAMoCRI4CgxjYXBhYmlsaXRpZXMYBiABKAsyIi5pZHAua2Fma2EudjEuUHJvdmlkZXJDYXBhYmlsaXRpZXMSGQoRZG9jdW1lbnRhdGlvbl91cmwYByABKAkSEAoIaWNvbl91cmwYCCABKAkibgoUUHJvdmlkZXJDYXBhYmlsaXRpZXMSFwoPc2NoZW1hX3JlZ2lzdHJ5GAEgASgIEhQKDHRyYW5zYWN0aW9ucxgCIAEoCBISCgpxdW90YXNfYXBpGAMgASgIEhMKC21ldHJpY3NfYXBpGAQgASgIIpwDCgxLYWZrYUNsdXN0ZXISCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtwcm92aWRlcl9pZBgDIAEoCRJLChFjb25uZWN0aW9uX2NvbmZpZxgEIAMoCzIwLmlkcC5rYWZrYS52MS5LYWZrYUNsdXN0ZXIuQ29ubmVjdGlvbkNvbmZpZ0VudHJ5EkAKEXZhbGlkYXRpb25fc3RhdHVzGAUgASgOMiUuaWRwLmthZmthLnYxLkNsdXN0ZXJWYWxpZGF0aW9uU3RhdHVzEjUKEWxhc3RfdmFsaWRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBo3ChVDb25uZWN0aW9uQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASL2AQoXS2Fma2FFbnZpcm9ubWVudE1hcHBpbmcSCgoCaWQYASABKAkSEwoLZW52aXJvbm1lbnQYAiABKAkSEgoKY2x1c3Rlcl9pZBgDIAEoCRJMCgxyb3V0aW5nX3J1bGUYBCADKAsyNi5pZHAua2Fma2EudjEuS2Fma2FFbnZpcm9ubWVudE1hcHBpbmcuUm91dGluZ1J1bGVFbnRyeRIQCghwcmlvcml0eRgFIAEoBRISCgppc19kZWZhdWx0GAYgASgIGjIKEFJvdXRpbmdSdWxlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLbAQoOU2NoZW1hUmVnaXN0cnkSCgoCaWQYASABKAkSCwoDdXJsGAIgASgJEh8KF3N1YmplY3RfbmFtaW5nX3RlbXBsYXRlGAMgASgJEkAKFWRlZmF1bHRfY29tcGF0aWJpbGl0eRgEIAEoDjIhLmlkcC5rYWZrYS52MS5TY2hlbWFDb21wYXRpYmlsaXR5Ek0KFWVudmlyb25tZW50X292ZXJyaWRlcxgFIAMoCzIuLmlkcC5rYWZrYS52MS5FbnZpcm9ubWVudENvbXBhdGliaWxpdHlPdmVycmlkZSJxCiBFbnZpcm9ubWVudENvbXBhdGliaWxpdHlPdmVycmlkZRITCgtlbnZpcm9ubWVudBgBIAEoCRI4Cg1jb21wYXRpYmlsaXR5GAIgASgOMiEuaWRwLmthZmthLnYxLlNjaGVtYUNvbXBhdGliaWxpdHki0wQKCkthZmthVG9waWMSCgoCaWQYASABKAkSFAoMd29ya3NwYWNlX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSEwoLZW52aXJvbm1lbnQYBCABKAkSEgoKY2x1c3Rlcl9pZBgFIAEoCRISCgpwYXJ0aXRpb25zGAYgASgFEhoKEnJlcGxpY2F0aW9uX2ZhY3RvchgHIAEoBRIUCgxyZXRlbnRpb25fbXMYCCABKAMSFgoOY2xlYW51cF9wb2xpY3kYCSABKAkSEwoLY29tcHJlc3Npb24YCiABKAkSNAoGY29uZmlnGAsgAygLMiQuaWRwLmthZmthLnYxLkthZmthVG9waWMuQ29uZmlnRW50cnkSKQoGc3RhdHVzGAwgASgOMhkuaWRwLmthZmthLnYxLlRvcGljU3RhdHVzEhMKC3dvcmtmbG93X2lkGA0gASgJEhkKEWFwcHJvdmFsX3JlcXVpcmVkGA4gASgIEhMKC2FwcHJvdmVkX2J5GA8gASgJEi8KC2FwcHJvdmVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgtkZXNjcmlwdGlvbhgTIAEoCRotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIusCCgtLYWZrYVNjaGVtYRIKCgJpZBgBIAEoCRIUCgx3b3Jrc3BhY2VfaWQYAiABKAkSEAoIdG9waWNfaWQYAyABKAkSDAoEdHlwZRgEIAEoCRIPCgdzdWJqZWN0GAUgASgJEioKBmZvcm1hdBgGIAEoDjIaLmlkcC5rYWZrYS52MS5TY2hlbWFGb3JtYXQSDwoHY29udGVudBgHIAEoCRIPCgd2ZXJzaW9uGAggASgFEhEKCXNjaGVtYV9pZBgJIAEoBRI4Cg1jb21wYXRpYmlsaXR5GAogASgOMiEuaWRwLmthZmthLnYxLlNjaGVtYUNvbXBhdGliaWxpdHkSDgoGc3RhdHVzGAsgASgJEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIskBChNLYWZrYVNlcnZpY2VBY2NvdW50EgoKAmlkGAEgASgJEhQKDHdvcmtzcGFjZV9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEi4KBHR5cGUYBCABKA4yIC5pZHAua2Fma2EudjEuU2VydmljZUFjY291bnRUeXBlEg4KBnN0YXR1cxgFIAEoCRISCgpjcmVhdGVkX2J5GAYgASgJEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIrsDCg9LYWZrYVRvcGljU2hhcmUSCgoCaWQYASABKAkSEAoIdG9waWNfaWQYAiABKAkSGAoQc2hhcmVkX3dpdGhfdHlwZRgDIAEoCRIgChhzaGFyZWRfd2l0aF93b3Jrc3BhY2VfaWQYBCABKAkSGwoTc2hhcmVkX3dpdGhfdXNlcl9pZBgFIAEoCRIxCgpwZXJtaXNzaW9uGAYgASgOMh0uaWRwLmthZmthLnYxLlNoYXJlUGVybWlzc2lvbhIpCgZzdGF0dXMYByABKA4yGS5pZHAua2Fma2EudjEuU2hhcmVTdGF0dXMSFAoMcmVxdWVzdGVkX2J5GAggASgJEjAKDHJlcXVlc3RlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNanVzdGlmaWNhdGlvbhgKIAEoCRITCgthcHByb3ZlZF9ieRgLIAEoCRIvCgthcHByb3ZlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi0gIKEEthZmthVG9waWNQb2xpY3kSCgoCaWQYASABKAkSKAoFc2NvcGUYAiABKA4yGS5pZHAua2Fma2EudjEuUG9saWN5U2NvcGUSFAoMd29ya3NwYWNlX2lkGAMgASgJEhMKC2Vudmlyb25tZW50GAQgASgJEhYKDm5hbWluZ19wYXR0ZXJuGAUgASgJEh0KFWF1dG9fYXBwcm92ZV9wYXR0ZXJucxgGIAMoCRI3ChBwYXJ0aXRpb25fbGltaXRzGAcgASgLMh0uaWRwLmthZmthLnYxLlBhcnRpdGlvbkxpbWl0cxI3ChByZXRlbnRpb25fbGltaXRzGAggASgLMh0uaWRwLmthZmthLnYxLlJldGVudGlvbkxpbWl0cxIWCg5yZXF1aXJlX3NjaGVtYRgJIAEoCBIcChRyZXF1aXJlX2FwcHJvdmFsX2ZvchgKIAMoCSIrCg9QYXJ0aXRpb25MaW1pdHMSCwoDbWluGAEgASgFEgsKA21heBgCIAEoBSIxCg9SZXRlbnRpb25MaW1pdHMSDgoGbWluX21zGAEgASgDEg4KBm1heF9tcxgCIAEoAyKDAwoVS2Fma2FUb3BpY1NoYXJlUG9saWN5EgoKAmlkGAEgASgJEhQKDHdvcmtzcGFjZV9pZBgCIAEoCRItCgVzY29wZRgDIAEoDjIeLmlkcC5rYWZrYS52MS5TaGFyZVBvbGljeVNjb3BlEhUKDXRvcGljX3BhdHRlcm4YBCABKAkSEAoIdG9waWNfaWQYBSABKAkSEwoLZW52aXJvbm1lbnQYBiABKAkSMQoKdmlzaWJpbGl0eRgHIAEoDjIdLmlkcC5rYWZrYS52MS5Ub3BpY1Zpc2liaWxpdHkSNQoMYXV0b19hcHByb3ZlGAggASgLMh8uaWRwLmthZmthLnYxLkF1dG9BcHByb3ZlQ29uZmlnEjkKEmRlZmF1bHRfcGVybWlzc2lvbhgJIAEoDjIdLmlkcC5rYWZrYS52MS5TaGFyZVBlcm1pc3Npb24SHQoVcmVxdWlyZV9qdXN0aWZpY2F0aW9uGAogASgIEhcKD2FjY2Vzc190dGxfZGF5cxgLIAEoBSKUAQoRQXV0b0FwcHJvdmVDb25maWcSFAoMZW52aXJvbm1lbnRzGAEgAygJEjIKC3Blcm1pc3Npb25zGAIgAygOMh0uaWRwLmthZmthLnYxLlNoYXJlUGVybWlzc2lvbhIbChN3b3Jrc3BhY2Vfd2hpdGVsaXN0GAMgAygJEhgKEHNhbWVfdGVuYW50X29ubHkYBCABKAgi4AEKEUthZmthVXNhZ2VNZXRyaWNzEgoKAmlkGAEgASgJEhAKCHRvcGljX2lkGAIgASgJEg4KBnBlcmlvZBgDIAEoCRITCgtwZXJpb2RfdHlwZRgEIAEoCRIQCghieXRlc19pbhgFIAEoAxIRCglieXRlc19vdXQYBiABKAMSGAoQbWVzc2FnZV9jb3VudF9pbhgHIAEoAxIZChFtZXNzYWdlX2NvdW50X291dBgIIAEoAxIVCg1zdG9yYWdlX2J5dGVzGAkgASgDEhcKD3BhcnRpdGlvbl9jb3VudBgKIAEoBSKBAgoSS2Fma2FDb25zdW1lckdyb3VwEgoKAmlkGAEgASgJEhAKCGdyb3VwX2lkGAIgASgJEhIKCmNsdXN0ZXJfaWQYAyABKAkSEQoJdG9waWNfaWRzGAQgAygJEhoKEnNlcnZpY2VfYWNjb3VudF9pZBgFIAEoCRIUCgx3b3Jrc3BhY2VfaWQYBiABKAkSEwoLY3VycmVudF9sYWcYByABKAMSLQoJbGFzdF9zZWVuGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3VwZGF0ZWQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIvABChNLYWZrYUNsaWVudEFjdGl2aXR5EgoKAmlkGAEgASgJEhEKCWNsaWVudF9pZBgCIAEoCRIaChJzZXJ2aWNlX2FjY291bnRfaWQYAyABKAkSFAoMd29ya3NwYWNlX2lkGAQgASgJEhAKCHRvcGljX2lkGAUgASgJEhEKCWRpcmVjdGlvbhgGIAEoCRIZChFjb25zdW1lcl9ncm91cF9pZBgHIAEoCRIZChFieXRlc190cmFuc2ZlcnJlZBgIIAEoAxItCglsYXN0X3NlZW4YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhYKFExpc3RQcm92aWRlcnNSZXF1ZXN0IkcKFUxpc3RQcm92aWRlcnNSZXNwb25zZRIuCglwcm92aWRlcnMYASADKAsyGy5pZHAua2Fma2EudjEuS2Fma2FQcm92aWRlciLLAgoWUmVnaXN0ZXJDbHVzdGVyUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC3Byb3ZpZGVyX2lkGAIgASgJElUKEWNvbm5lY3Rpb25fY29uZmlnGAMgAygLMjouaWRwLmthZmthLnYxLlJlZ2lzdGVyQ2x1c3RlclJlcXVlc3QuQ29ubmVjdGlvbkNvbmZpZ0VudHJ5EkoKC2NyZWRlbnRpYWxzGAQgAygLMjUuaWRwLmthZmthLnYxLlJlZ2lzdGVyQ2x1c3RlclJlcXVlc3QuQ3JlZGVudGlhbHNFbnRyeRo3ChVDb25uZWN0aW9uQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARoyChBDcmVkZW50aWFsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiVQoXUmVnaXN0ZXJDbHVzdGVyUmVzcG9uc2USKwoHY2x1c3RlchgBIAEoCzIaLmlkcC5rYWZrYS52MS5LYWZrYUNsdXN0ZXISDQoFZXJyb3IYAiABKAkiLAoWVmFsaWRhdGVDbHVzdGVyUmVxdWVzdBISCgpjbHVzdGVyX2lkGAEgASgJIjcKF1ZhbGlkYXRlQ2x1c3RlclJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg0KBWVycm9yGAIgASgJIsYCCiBWYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVxdWVzdBJfChFjb25uZWN0aW9uX2NvbmZpZxgBIAMoCzJELmlkcC5rYWZrYS52MS5WYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVxdWVzdC5Db25uZWN0aW9uQ29uZmlnRW50cnkSVAoLY3JlZGVudGlhbHMYAiADKAsyPy5pZHAua2Fma2EudjEuVmFsaWRhdGVDbHVzdGVyQ29ubmVjdGlvblJlcXVlc3QuQ3JlZGVudGlhbHNFbnRyeRo3ChVDb25uZWN0aW9uQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARoyChBDcmVkZW50aWFsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiQQohVmFsaWRhdGVDbHVzdGVyQ29ubmVjdGlvblJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEg0KBWVycm9yGAIgASgJIhUKE0xpc3RDbHVzdGVyc1JlcXVlc3QiRAoUTGlzdENsdXN0ZXJzUmVzcG9uc2USLAoIY2x1c3RlcnMYASADKAsyGi5pZHAua2Fma2EudjEuS2Fma2FDbHVzdGVyIioKFERlbGV0ZUNsdXN0ZXJSZXF1ZXN0EhIKCmNsdXN0ZXJfaWQYASABKAkiNwoVRGVsZXRlQ2x1c3RlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAki+gEKH0NyZWF0ZUVudmlyb25tZW50TWFwcGluZ1JlcXVlc3QSEwoLZW52aXJvbm1lbnQYASABKAkSEgoKY2x1c3Rlcl9pZBgCIAEoCRJUCgxyb3V0aW5nX3J1bGUYAyADKAsyPi5pZHAua2Fma2EudjEuQ3JlYXRlRW52aXJvbm1lbnRNYXBwaW5nUmVxdWVzdC5Sb3V0aW5nUnVsZUVudHJ5EhAKCHByaW9yaXR5GAQgASgFEhIKCmlzX2RlZmF1bHQYBSABKAgaMgoQUm91dGluZ1J1bGVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBImkKIENyZWF0ZUVudmlyb25tZW50TWFwcGluZ1Jlc3BvbnNlEjYKB21hcHBpbmcYASABKAsyJS5pZHAua2Fma2EudjEuS2Fma2FFbnZpcm9ubWVudE1hcHBpbmcSDQoFZXJyb3IYAiABKAkiNQoeTGlzdEVudmlyb25tZW50TWFwcGluZ3NSZXF1ZXN0EhMKC2Vudmlyb25tZW50GAEgASgJIloKH0xpc3RFbnZpcm9ubWVudE1hcHBpbmdzUmVzcG9uc2USNwoIbWFwcGluZ3MYASADKAsyJS5pZHAua2Fma2EudjEuS2Fma2FFbnZpcm9ubWVudE1hcHBpbmciNQofRGVsZXRlRW52aXJvbm1lbnRNYXBwaW5nUmVxdWVzdBISCgptYXBwaW5nX2lkGAEgASgJIkIKIERlbGV0ZUVudmlyb25tZW50TWFwcGluZ1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAki7QIKEkNyZWF0ZVRvcGljUmVxdWVzdBIUCgx3b3Jrc3BhY2VfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtlbnZpcm9ubWVudBgDIAEoCRISCgpwYXJ0aXRpb25zGAQgASgFEhoKEnJlcGxpY2F0aW9uX2ZhY3RvchgFIAEoBRIUCgxyZXRlbnRpb25fbXMYBiABKAMSFgoOY2xlYW51cF9wb2xpY3kYByABKAkSEwoLY29tcHJlc3Npb24YCCABKAkSPAoGY29uZmlnGAkgAygLMiwuaWRwLmthZmthLnYxLkNyZWF0ZVRvcGljUmVxdWVzdC5Db25maWdFbnRyeRITCgtkZXNjcmlwdGlvbhgKIAEoCRIpCgZzY2hlbWEYCyABKAsyGS5pZHAua2Fma2EudjEuS2Fma2FTY2hlbWEaLQoLQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJiChNDcmVhdGVUb3BpY1Jlc3BvbnNlEicKBXRvcGljGAEgASgLMhguaWRwLmthZmthLnYxLkthZmthVG9waWMSEwoLd29ya2Zsb3dfaWQYAiABKAkSDQoFZXJyb3IYAyABKAki5QMKGENyZWF0ZVRvcGljRGlyZWN0UmVxdWVzdBISCgp0b3BpY19uYW1lGAEgASgJEhIKCnBhcnRpdGlvbnMYAiABKAUSGgoScmVwbGljYXRpb25fZmFjdG9yGAMgASgFEkIKBmNvbmZpZxgEIAMoCzIyLmlkcC5rYWZrYS52MS5DcmVhdGVUb3BpY0RpcmVjdFJlcXVlc3QuQ29uZmlnRW50cnkSVwoRY29ubmVjdGlvbl9jb25maWcYBSADKAsyPC5pZHAua2Fma2EudjEuQ3JlYXRlVG9waWNEaXJlY3RSZXF1ZXN0LkNvbm5lY3Rpb25Db25maWdFbnRyeRJMCgtjcmVkZW50aWFscxgGIAMoCzI3LmlkcC5rYWZrYS52MS5DcmVhdGVUb3BpY0RpcmVjdFJlcXVlc3QuQ3JlZGVudGlhbHNFbnRyeRotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjcKFUNvbm5lY3Rpb25Db25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI7ChlDcmVhdGVUb3BpY0RpcmVjdFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiIwoPR2V0VG9waWNSZXF1ZXN0EhAKCHRvcGljX2lkGAEgASgJIkoKEEdldFRvcGljUmVzcG9uc2USJwoFdG9waWMYASABKAsyGC5pZHAua2Fma2EudjEuS2Fma2FUb3BpYxINCgVlcnJvchgCIAEoCSKIAQoRTGlzdFRvcGljc1JlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJEhMKC2Vudmlyb25tZW50GAIgASgJEikKBnN0YXR1cxgDIAEoDjIZLmlkcC5rYWZrYS52MS5Ub3BpY1N0YXR1cxINCgVsaW1pdBgEIAEoBRIOCgZvZmZzZXQYBSABKAUiTQoSTGlzdFRvcGljc1Jlc3BvbnNlEigKBnRvcGljcxgBIAMoCzIYLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljEg0KBXRvdGFsGAIgASgFIpECChJVcGRhdGVUb3BpY1JlcXVlc3QSEAoIdG9waWNfaWQYASABKAkSFwoKcGFydGl0aW9ucxgCIAEoBUgAiAEBEhkKDHJldGVudGlvbl9tcxgDIAEoA0gBiAEBEjwKBmNvbmZpZxgEIAMoCzIsLmlkcC5rYWZrYS52MS5VcGRhdGVUb3BpY1JlcXVlc3QuQ29uZmlnRW50cnkSGAoLZGVzY3JpcHRpb24YBSABKAlIAogBARotCgtDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg0KC19wYXJ0aXRpb25zQg8KDV9yZXRlbnRpb25fbXNCDgoMX2Rlc2NyaXB0aW9uIk0KE1VwZGF0ZVRvcGljUmVzcG9uc2USJwoFdG9waWMYASABKAsyGC5pZHAua2Fma2EudjEuS2Fma2FUb3BpYxINCgVlcnJvchgCIAEoCSImChJEZWxldGVUb3BpY1JlcXVlc3QSEAoIdG9waWNfaWQYASABKAkiSgoTRGVsZXRlVG9waWNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhMKC3dvcmtmbG93X2lkGAIgASgJEg0KBWVycm9yGAMgASgJIsICChhEZWxldGVUb3BpY0J5TmFtZVJlcXVlc3QSEgoKdG9waWNfbmFtZRgBIAEoCRJXChFjb25uZWN0aW9uX2NvbmZpZxgCIAMoCzI8LmlkcC5rYWZrYS52MS5EZWxldGVUb3BpY0J5TmFtZVJlcXVlc3QuQ29ubmVjdGlvbkNvbmZpZ0VudHJ5EkwKC2NyZWRlbnRpYWxzGAMgAygLMjcuaWRwLmthZmthLnYxLkRlbGV0ZVRvcGljQnlOYW1lUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjcKFUNvbm5lY3Rpb25Db25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI7ChlEZWxldGVUb3BpY0J5TmFtZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiPAoTQXBwcm92ZVRvcGljUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRITCgthcHByb3ZlZF9ieRgCIAEoCSJjChRBcHByb3ZlVG9waWNSZXNwb25zZRInCgV0b3BpYxgBIAEoCzIYLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljEhMKC3dvcmtmbG93X2lkGAIgASgJEg0KBWVycm9yGAMgASgJIq4BChVSZWdpc3RlclNjaGVtYVJlcXVlc3QSEAoIdG9waWNfaWQYASABKAkSDAoEdHlwZRgCIAEoCRIqCgZmb3JtYXQYAyABKA4yGi5pZHAua2Fma2EudjEuU2NoZW1hRm9ybWF0Eg8KB2NvbnRlbnQYBCABKAkSOAoNY29tcGF0aWJpbGl0eRgFIAEoDjIhLmlkcC5rYWZrYS52MS5TY2hlbWFDb21wYXRpYmlsaXR5IlIKFlJlZ2lzdGVyU2NoZW1hUmVzcG9uc2USKQoGc2NoZW1hGAEgASgLMhkuaWRwLmthZmthLnYxLkthZmthU2NoZW1hEg0KBWVycm9yGAIgASgJIiUKEEdldFNjaGVtYVJlcXVlc3QSEQoJc2NoZW1hX2lkGAEgASgJIk0KEUdldFNjaGVtYVJlc3BvbnNlEikKBnNjaGVtYRgBIAEoCzIZLmlkcC5rYWZrYS52MS5LYWZrYVNjaGVtYRINCgVlcnJvchgCIAEoCSImChJMaXN0U2NoZW1hc1JlcXVlc3QSEAoIdG9waWNfaWQYASABKAkiQQoTTGlzdFNjaGVtYXNSZXNwb25zZRIqCgdzY2hlbWFzGAEgAygLMhkuaWRwLmthZmthLnYxLkthZmthU2NoZW1hIn4KH0NoZWNrU2NoZW1hQ29tcGF0aWJpbGl0eVJlcXVlc3QSEAoIdG9waWNfaWQYASABKAkSDAoEdHlwZRgCIAEoCRIqCgZmb3JtYXQYAyABKA4yGi5pZHAua2Fma2EudjEuU2NoZW1hRm9ybWF0Eg8KB2NvbnRlbnQYBCABKAkiRQogQ2hlY2tTY2hlbWFDb21wYXRpYmlsaXR5UmVzcG9uc2USEgoKY29tcGF0aWJsZRgBIAEoCBINCgVlcnJvchgCIAEoCSJxChtDcmVhdGVTZXJ2aWNlQWNjb3VudFJlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSLgoEdHlwZRgDIAEoDjIgLmlkcC5rYWZrYS52MS5TZXJ2aWNlQWNjb3VudFR5cGUijgEKHENyZWF0ZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USOgoPc2VydmljZV9hY2NvdW50GAEgASgLMiEuaWRwLmthZmthLnYxLkthZmthU2VydmljZUFjY291bnQSDwoHYXBpX2tleRgCIAEoCRISCgphcGlfc2VjcmV0GAMgASgJEg0KBWVycm9yGAQgASgJIjIKGkxpc3RTZXJ2aWNlQWNjb3VudHNSZXF1ZXN0EhQKDHdvcmtzcGFjZV9pZBgBIAEoCSJaChtMaXN0U2VydmljZUFjY291bnRzUmVzcG9uc2USOwoQc2VydmljZV9hY2NvdW50cxgBIAMoCzIhLmlkcC5rYWZrYS52MS5LYWZrYVNlcnZpY2VBY2NvdW50IjkKG1Jldm9rZVNlcnZpY2VBY2NvdW50UmVxdWVzdBIaChJzZXJ2aWNlX2FjY291bnRfaWQYASABKAkiPgocUmV2b2tlU2VydmljZUFjY291bnRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg0KBWVycm9yGAIgASgJIpgBChlSZXF1ZXN0VG9waWNBY2Nlc3NSZXF1ZXN0EhAKCHRvcGljX2lkGAEgASgJEh8KF3JlcXVlc3Rpbmdfd29ya3NwYWNlX2lkGAIgASgJEjEKCnBlcm1pc3Npb24YAyABKA4yHS5pZHAua2Fma2EudjEuU2hhcmVQZXJtaXNzaW9uEhUKDWp1c3RpZmljYXRpb24YBCABKAkiWQoaUmVxdWVzdFRvcGljQWNjZXNzUmVzcG9uc2USLAoFc2hhcmUYASABKAsyHS5pZHAua2Fma2EudjEuS2Fma2FUb3BpY1NoYXJlEg0KBWVycm9yGAIgASgJIkIKGUFwcHJvdmVUb3BpY0FjY2Vzc1JlcXVlc3QSEAoIc2hhcmVfaWQYASABKAkSEwoLYXBwcm92ZWRfYnkYAiABKAkiWQoaQXBwcm92ZVRvcGljQWNjZXNzUmVzcG9uc2USLAoFc2hhcmUYASABKAsyHS5pZHAua2Fma2EudjEuS2Fma2FUb3BpY1NoYXJlEg0KBWVycm9yGAIgASgJIiwKGFJldm9rZVRvcGljQWNjZXNzUmVxdWVzdBIQCghzaGFyZV9pZBgBIAEoCSI7ChlSZXZva2VUb3BpY0FjY2Vzc1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDQoFZXJyb3IYAiABKAkiawoWTGlzdFRvcGljU2hhcmVzUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRIUCgx3b3Jrc3BhY2VfaWQYAiABKAkSKQoGc3RhdHVzGAMgASgOMhkuaWRwLmthZmthLnYxLlNoYXJlU3RhdHVzIkgKF0xpc3RUb3BpY1NoYXJlc1Jlc3BvbnNlEi0KBnNoYXJlcxgBIAMoCzIdLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljU2hhcmUirwEKFURpc2NvdmVyVG9waWNzUmVxdWVzdBIfChdyZXF1ZXN0aW5nX3dvcmtzcGFjZV9pZBgBIAEoCRITCgtlbnZpcm9ubWVudBgCIAEoCRIOCgZzZWFyY2gYAyABKAkSMQoNc2NoZW1hX2Zvcm1hdBgEIAEoDjIaLmlkcC5rYWZrYS52MS5TY2hlbWFGb3JtYXQSDQoFbGltaXQYBSABKAUSDgoGb2Zmc2V0GAYgASgFIlgKFkRpc2NvdmVyVG9waWNzUmVzcG9uc2USLwoGdG9waWNzGAEgAygLMh8uaWRwLmthZmthLnYxLkRpc2NvdmVyYWJsZVRvcGljEg0KBXRvdGFsGAIgASgFIrkBChFEaXNjb3ZlcmFibGVUb3BpYxInCgV0b3BpYxgBIAEoCzIYLmlkcC5rYWZrYS52MS5LYWZrYVRvcGljEh0KFW93bmluZ193b3Jrc3BhY2VfbmFtZRgCIAEoCRIxCgp2aXNpYmlsaXR5GAMgASgOMh0uaWRwLmthZmthLnYxLlRvcGljVmlzaWJpbGl0eRIVCg1hY2Nlc3Nfc3RhdHVzGAQgASgJEhIKCmhhc19zY2hlbWEYBSABKAgiUAoWR2V0VG9waWNNZXRyaWNzUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRITCgtwZXJpb2RfdHlwZRgCIAEoCRIPCgdwZXJpb2RzGAMgASgFIksKF0dldFRvcGljTWV0cmljc1Jlc3BvbnNlEjAKB21ldHJpY3MYASADKAsyHy5pZHAua2Fma2EudjEuS2Fma2FVc2FnZU1ldHJpY3MiKgoWR2V0VG9waWNMaW5lYWdlUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCSJ1ChdHZXRUb3BpY0xpbmVhZ2VSZXNwb25zZRIsCglwcm9kdWNlcnMYASADKAsyGS5pZHAua2Fma2EudjEuTGluZWFnZU5vZGUSLAoJY29uc3VtZXJzGAIgAygLMhkuaWRwLmthZmthLnYxLkxpbmVhZ2VOb2RlItIBCgtMaW5lYWdlTm9kZRIUCgx3b3Jrc3BhY2VfaWQYASABKAkSFgoOd29ya3NwYWNlX25hbWUYAiABKAkSGgoSc2VydmljZV9hY2NvdW50X2lkGAMgASgJEhwKFHNlcnZpY2VfYWNjb3VudF9uYW1lGAQgASgJEhEKCWNsaWVudF9pZBgFIAEoCRIZChFieXRlc190cmFuc2ZlcnJlZBgGIAEoAxItCglsYXN0X3NlZW4YByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqYBChREZXNjcmliZVRvcGljUmVxdWVzdBIQCgh0b3BpY19pZBgBIAEoCRJICgtjcmVkZW50aWFscxgCIAMoCzIzLmlkcC5rYWZrYS52MS5EZXNjcmliZVRvcGljUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASK/AQoVRGVzY3JpYmVUb3BpY1Jlc3BvbnNlEicKBXRvcGljGAEgASgLMhguaWRwLmthZmthLnYxLkthZmthVG9waWMSNAoMYnJva2VyX3N0YXRlGAIgASgLMh4uaWRwLmthZmthLnYxLlRvcGljQnJva2VyU3RhdGUSDwoHZHJpZnRlZBgDIAEoCBInCgVkcmlmdBgEIAMoCzIYLmlkcC5rYWZrYS52MS5Ub3BpY0RyaWZ0Eg0KBWVycm9yGAUgASgJIvoBChBUb3BpY0Jyb2tlclN0YXRlEg4KBmV4aXN0cxgBIAEoCBISCgpwYXJ0aXRpb25zGAIgASgFEhoKEnJlcGxpY2F0aW9uX2ZhY3RvchgDIAEoBRI6CgZjb25maWcYBCADKAsyKi5pZHAua2Fma2EudjEuVG9waWNCcm9rZXJTdGF0ZS5Db25maWdFbnRyeRI7ChBwYXJ0aXRpb25fc3RhdGVzGAUgAygLMiEuaWRwLmthZmthLnYxLlRvcGljUGFydGl0aW9uU3RhdGUaLQoLQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJXChNUb3BpY1BhcnRpdGlvblN0YXRlEhEKCXBhcnRpdGlvbhgBIAEoBRIOCgZsZWFkZXIYAiABKAUSEAoIcmVwbGljYXMYAyADKAUSCwoDaXNyGAQgAygFIj0KClRvcGljRHJpZnQSDQoFZmllbGQYASABKAkSEAoIZGVjbGFyZWQYAiABKAkSDgoGYWN0dWFsGAMgASgJKsABCgxQcm92aWRlclR5cGUSHQoZUFJPVklERVJfVFlQRV9VTlNQRUNJRklFRBAAEh4KGlBST1ZJREVSX1RZUEVfQVBBQ0hFX0tBRktBEAESIQodUFJPVklERVJfVFlQRV9DT05GTFVFTlRfQ0xPVUQQAhIZChVQUk9WSURFUl9UWVBFX0FXU19NU0sQAxIaChZQUk9WSURFUl9UWVBFX1JFRFBBTkRBEAQSFwoTUFJPVklERVJfVFlQRV9BSVZFThAFKrcBChdDbHVzdGVyVmFsaWRhdGlvblN0YXR1cxIpCiVDTFVTVEVSX1ZBTElEQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASJQohQ0xVU1RFUl9WQUxJREFUSU9OX1NUQVRVU19QRU5ESU5HEAESIwofQ0xVU1RFUl9WQUxJREFUSU9OX1NUQVRVU19WQUxJRBACEiUKIUNMVVNURVJfVkFMSURBVElPTl9TVEFUVVNfSU5WQUxJRBADKroBCgtUb3BpY1N0YXR1cxIcChhUT1BJQ19TVEFUVVNfVU5TUEVDSUZJRUQQABIhCh1UT1BJQ19TVEFUVVNfUEVORElOR19BUFBST1ZBTBABEh0KGVRPUElDX1NUQVRVU19QUk9WSVNJT05JTkcQAhIXChNUT1BJQ19TVEFUVVNfQUNUSVZFEAMSFwoTVE9QSUNfU1RBVFVTX0ZBSUxFRBAEEhkKFVRPUElDX1NUQVRVU19ERUxFVElORxAFKnkKDFNjaGVtYUZvcm1hdBIdChlTQ0hFTUFfRk9STUFUX1VOU1BFQ0lGSUVEEAASFgoSU0NIRU1BX0ZPUk1BVF9BVlJPEAESGgoWU0NIRU1BX0ZPUk1BVF9QUk9UT0JVRhACEhYKElNDSEVNQV9GT1JNQVRfSlNPThADKr4BChNTY2hlbWFDb21wYXRpYmlsaXR5EiQKIFNDSEVNQV9DT01QQVRJQklMSVRZX1VOU1BFQ0lGSUVEEAASIQodU0NIRU1BX0NPTVBBVElCSUxJVFlfQkFDS1dBUkQQARIgChxTQ0hFTUFfQ09NUEFUSUJJTElUWV9GT1JXQVJEEAISHQoZU0NIRU1BX0NPTVBBVElCSUxJVFlfRlVMTBADEh0KGVNDSEVNQV9DT01QQVRJQklMSVRZX05PTkUQBCrMAQoSU2VydmljZUFjY291bnRUeXBlEiQKIFNFUlZJQ0VfQUNDT1VOVF9UWVBFX1VOU1BFQ0lGSUVEEAASIQodU0VSVklDRV9BQ0NPVU5UX1RZUEVfUFJPRFVDRVIQARIhCh1TRVJWSUNFX0FDQ09VTlRfVFlQRV9DT05TVU1FUhACEioKJlNFUlZJQ0VfQUNDT1VOVF9UWVBFX1BST0RVQ0VSX0NPTlNVTUVSEAMSHgoaU0VSVklDRV9BQ0NPVU5UX1RZUEVfQURNSU4QBCqdAQoLU2hhcmVTdGF0dXMSHAoYU0hBUkVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocU0hBUkVfU1RBVFVTX1BFTkRJTkdfUkVRVUVTVBABEhkKFVNIQVJFX1NUQVRVU19BUFBST1ZFRBACEhkKFVNIQVJFX1NUQVRVU19SRUpFQ1RFRBADEhgKFFNIQVJFX1NUQVRVU19SRVZPS0VEEAQqiwEKD1NoYXJlUGVybWlzc2lvbhIgChxTSEFSRV9QRVJNSVNTSU9OX1VOU1BFQ0lGSUVEEAASGQoVU0hBUkVfUEVSTUlTU0lPTl9SRUFEEAESGgoWU0hBUkVfUEVSTUlTU0lPTl9XUklURRACEh8KG1NIQVJFX1BFUk1JU1NJT05fUkVBRF9XUklURRADKpEBCg9Ub3BpY1Zpc2liaWxpdHkSIAocVE9QSUNfVklTSUJJTElUWV9VTlNQRUNJRklFRBAAEhwKGFRPUElDX1ZJU0lCSUxJVFlfUFJJVkFURRABEiEKHVRPUElDX1ZJU0lCSUxJVFlfRElTQ09WRVJBQkxFEAISGwoXVE9QSUNfVklTSUJJTElUWV9QVUJMSUMQAypiCgtQb2xpY3lTY29wZRIcChhQT0xJQ1lfU0NPUEVfVU5TUEVDSUZJRUQQABIZChVQT0xJQ1lfU0NPUEVfUExBVEZPUk0QARIaChZQT0xJQ1lfU0NPUEVfV09SS1NQQUNFEAIqpgEKEFNoYXJlUG9saWN5U2NvcGUSIgoeU0hBUkVfUE9MSUNZX1NDT1BFX1VOU1BFQ0lGSUVEEAASIQodU0hBUkVfUE9MSUNZX1NDT1BFX0FMTF9UT1BJQ1MQARIkCiBTSEFSRV9QT0xJQ1lfU0NPUEVfVE9QSUNfUEFUVEVSThACEiUKIVNIQVJFX1BPTElDWV9TQ09QRV9TUEVDSUZJQ19UT1BJQxADMtMYCgxLYWZrYVNlcnZpY2USWAoNTGlzdFByb3ZpZGVycxIiLmlkcC5rYWZrYS52MS5MaXN0UHJvdmlkZXJzUmVxdWVzdBojLmlkcC5rYWZrYS52MS5MaXN0UHJvdmlkZXJzUmVzcG9uc2USXgoPUmVnaXN0ZXJDbHVzdGVyEiQuaWRwLmthZmthLnYxLlJlZ2lzdGVyQ2x1c3RlclJlcXVlc3QaJS5pZHAua2Fma2EudjEuUmVnaXN0ZXJDbHVzdGVyUmVzcG9uc2USXgoPVmFsaWRhdGVDbHVzdGVyEiQuaWRwLmthZmthLnYxLlZhbGlkYXRlQ2x1c3RlclJlcXVlc3QaJS5pZHAua2Fma2EudjEuVmFsaWRhdGVDbHVzdGVyUmVzcG9uc2USfAoZVmFsaWRhdGVDbHVzdGVyQ29ubmVjdGlvbhIuLmlkcC5rYWZrYS52MS5WYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVxdWVzdBovLmlkcC5rYWZrYS52MS5WYWxpZGF0ZUNsdXN0ZXJDb25uZWN0aW9uUmVzcG9uc2USVQoMTGlzdENsdXN0ZXJzEiEuaWRwLmthZmthLnYxLkxpc3RDbHVzdGVyc1JlcXVlc3QaIi5pZHAua2Fma2EudjEuTGlzdENsdXN0ZXJzUmVzcG9uc2USWAoNRGVsZXRlQ2x1c3RlchIiLmlkcC5rYWZrYS52MS5EZWxldGVDbHVzdGVyUmVxdWVzdBojLmlkcC5rYWZrYS52MS5EZWxldGVDbHVzdGVyUmVzcG9uc2USeQoYQ3JlYXRlRW52aXJvbm1lbnRNYXBwaW5nEi0uaWRwLmthZmthLnYxLkNyZWF0ZUVudmlyb25tZW50TWFwcGluZ1JlcXVlc3QaLi5pZHAua2Fma2EudjEuQ3JlYXRlRW52aXJvbm1lbnRNYXBwaW5nUmVzcG9uc2USdgoXTGlzdEVudmlyb25tZW50TWFwcGluZ3MSLC5pZHAua2Fma2EudjEuTGlzdEVudmlyb25tZW50TWFwcGluZ3NSZXF1ZXN0Gi0uaWRwLmthZmthLnYxLkxpc3RFbnZpcm9ubWVudE1hcHBpbmdzUmVzcG9uc2USeQoYRGVsZXRlRW52aXJvbm1lbnRNYXBwaW5nEi0uaWRwLmthZmthLnYxLkRlbGV0ZUVudmlyb25tZW50TWFwcGluZ1JlcXVlc3QaLi5pZHAua2Fma2EudjEuRGVsZXRlRW52aXJvbm1lbnRNYXBwaW5nUmVzcG9uc2USUgoLQ3JlYXRlVG9waWMSIC5pZHAua2Fma2EudjEuQ3JlYXRlVG9waWNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLkNyZWF0ZVRvcGljUmVzcG9uc2USZAoRQ3JlYXRlVG9waWNEaXJlY3QSJi5pZHAua2Fma2EudjEuQ3JlYXRlVG9waWNEaXJlY3RSZXF1ZXN0GicuaWRwLmthZmthLnYxLkNyZWF0ZVRvcGljRGlyZWN0UmVzcG9uc2USSQoIR2V0VG9waWMSHS5pZHAua2Fma2EudjEuR2V0VG9waWNSZXF1ZXN0Gh4uaWRwLmthZmthLnYxLkdldFRvcGljUmVzcG9uc2USWAoNRGVzY3JpYmVUb3BpYxIiLmlkcC5rYWZrYS52MS5EZXNjcmliZVRvcGljUmVxdWVzdBojLmlkcC5rYWZrYS52MS5EZXNjcmliZVRvcGljUmVzcG9uc2USTwoKTGlzdFRvcGljcxIfLmlkcC5rYWZrYS52MS5MaXN0VG9waWNzUmVxdWVzdBogLmlkcC5rYWZrYS52MS5MaXN0VG9waWNzUmVzcG9uc2USUgoLVXBkYXRlVG9waWMSIC5pZHAua2Fma2EudjEuVXBkYXRlVG9waWNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLlVwZGF0ZVRvcGljUmVzcG9uc2USUgoLRGVsZXRlVG9waWMSIC5pZHAua2Fma2EudjEuRGVsZXRlVG9waWNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLkRlbGV0ZVRvcGljUmVzcG9uc2USZAoRRGVsZXRlVG9waWNCeU5hbWUSJi5pZHAua2Fma2EudjEuRGVsZXRlVG9waWNCeU5hbWVSZXF1ZXN0GicuaWRwLmthZmthLnYxLkRlbGV0ZVRvcGljQnlOYW1lUmVzcG9uc2USVQoMQXBwcm92ZVRvcGljEiEuaWRwLmthZmthLnYxLkFwcHJvdmVUb3BpY1JlcXVlc3QaIi5pZHAua2Fma2EudjEuQXBwcm92ZVRvcGljUmVzcG9uc2USWwoOUmVnaXN0ZXJTY2hlbWESIy5pZHAua2Fma2EudjEuUmVnaXN0ZXJTY2hlbWFSZXF1ZXN0GiQuaWRwLmthZmthLnYxLlJlZ2lzdGVyU2NoZW1hUmVzcG9uc2USTAoJR2V0U2NoZW1hEh4uaWRwLmthZmthLnYxLkdldFNjaGVtYVJlcXVlc3QaHy5pZHAua2Fma2EudjEuR2V0U2NoZW1hUmVzcG9uc2USUgoLTGlzdFNjaGVtYXMSIC5pZHAua2Fma2EudjEuTGlzdFNjaGVtYXNSZXF1ZXN0GiEuaWRwLmthZmthLnYxLkxpc3RTY2hlbWFzUmVzcG9uc2USeQoYQ2hlY2tTY2hlbWFDb21wYXRpYmlsaXR5Ei0uaWRwLmthZmthLnYxLkNoZWNrU2NoZW1hQ29tcGF0aWJpbGl0eVJlcXVlc3QaLi5pZHAua2Fma2EudjEuQ2hlY2tTY2hlbWFDb21wYXRpYmlsaXR5UmVzcG9uc2USbQoUQ3JlYXRlU2VydmljZUFjY291bnQSKS5pZHAua2Fma2EudjEuQ3JlYXRlU2VydmljZUFjY291bnRSZXF1ZXN0GiouaWRwLmthZmthLnYxLkNyZWF0ZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USagoTTGlzdFNlcnZpY2VBY2NvdW50cxIoLmlkcC5rYWZrYS52MS5MaXN0U2VydmljZUFjY291bnRzUmVxdWVzdBopLmlkcC5rYWZrYS52MS5MaXN0U2VydmljZUFjY291bnRzUmVzcG9uc2USbQoUUmV2b2tlU2VydmljZUFjY291bnQSKS5pZHAua2Fma2EudjEuUmV2b2tlU2VydmljZUFjY291bnRSZXF1ZXN0GiouaWRwLmthZmthLnYxLlJldm9rZVNlcnZpY2VBY2NvdW50UmVzcG9uc2USZwoSUmVxdWVzdFRvcGljQWNjZXNzEicuaWRwLmthZmthLnYxLlJlcXVlc3RUb3BpY0FjY2Vzc1JlcXVlc3QaKC5pZHAua2Fma2EudjEuUmVxdWVzdFRvcGljQWNjZXNzUmVzcG9uc2USZwoSQXBwcm92ZVRvcGljQWNjZXNzEicuaWRwLmthZmthLnYxLkFwcHJvdmVUb3BpY0FjY2Vzc1JlcXVlc3QaKC5pZHAua2Fma2EudjEuQXBwcm92ZVRvcGljQWNjZXNzUmVzcG9uc2USZAoRUmV2b2tlVG9waWNBY2Nlc3MSJi5pZHAua2Fma2EudjEuUmV2b2tlVG9waWNBY2Nlc3NSZXF1ZXN0GicuaWRwLmthZmthLnYxLlJldm9rZVRvcGljQWNjZXNzUmVzcG9uc2USXgoPTGlzdFRvcGljU2hhcmVzEiQuaWRwLmthZmthLnYxLkxpc3RUb3BpY1NoYXJlc1JlcXVlc3QaJS5pZHAua2Fma2EudjEuTGlzdFRvcGljU2hhcmVzUmVzcG9uc2USWwoORGlzY292ZXJUb3BpY3MSIy5pZHAua2Fma2EudjEuRGlzY292ZXJUb3BpY3NSZXF1ZXN0GiQuaWRwLmthZmthLnYxLkRpc2NvdmVyVG9waWNzUmVzcG9uc2USXgoPR2V0VG9waWNNZXRyaWNzEiQuaWRwLmthZmthLnYxLkdldFRvcGljTWV0cmljc1JlcXVlc3QaJS5pZHAua2Fma2EudjEuR2V0VG9waWNNZXRyaWNzUmVzcG9uc2USXgoPR2V0VG9waWNMaW5lYWdlEiQuaWRwLmthZmthLnYxLkdldFRvcGljTGluZWFnZVJlcXVlc3QaJS5pZHAua2Fma2EudjEuR2V0VG9waWNMaW5lYWdlUmVzcG9uc2VCQFo+Z2l0aHViLmNvbS9kcmV3cGF5bWVudC9vcmJpdC9wcm90by9nZW4vZ28vaWRwL2thZmthL3YxO2thZmthdjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message idp.kafka.v1.KafkaProvider
@@ -2554,6 +2554,177 @@ export type LineageNode = Message<"idp.kafka.v1.LineageNode"> & {
 export const LineageNodeSchema: GenMessage<LineageNode> = /*@__PURE__*/
   messageDesc(file_idp_kafka_v1_kafka, 81);
 
+/**
+ * Live topic state
+ * DescribeTopic returns a topic's declared configuration alongside its live
+ * state on the cluster, flagging any drift between the two
+ *
+ * @generated from message idp.kafka.v1.DescribeTopicRequest
+ */
+export type DescribeTopicRequest = Message<"idp.kafka.v1.DescribeTopicRequest"> & {
+  /**
+   * @generated from field: string topic_id = 1;
+   */
+  topicId: string;
+
+  /**
+   * Cluster credentials
+   *
+   * @generated from field: map<string, string> credentials = 2;
+   */
+  credentials: { [key: string]: string };
+};
+
+/**
+ * Describes the message idp.kafka.v1.DescribeTopicRequest.
+ * Use `create(DescribeTopicRequestSchema)` to create a new message.
+ */
+export const DescribeTopicRequestSchema: GenMessage<DescribeTopicRequest> = /*@__PURE__*/
+  messageDesc(file_idp_kafka_v1_kafka, 82);
+
+/**
+ * @generated from message idp.kafka.v1.DescribeTopicResponse
+ */
+export type DescribeTopicResponse = Message<"idp.kafka.v1.DescribeTopicResponse"> & {
+  /**
+   * Declared state
+   *
+   * @generated from field: idp.kafka.v1.KafkaTopic topic = 1;
+   */
+  topic?: KafkaTopic | undefined;
+
+  /**
+   * Live state, unset until provisioned
+   *
+   * @generated from field: idp.kafka.v1.TopicBrokerState broker_state = 2;
+   */
+  brokerState?: TopicBrokerState | undefined;
+
+  /**
+   * @generated from field: bool drifted = 3;
+   */
+  drifted: boolean;
+
+  /**
+   * @generated from field: repeated idp.kafka.v1.TopicDrift drift = 4;
+   */
+  drift: TopicDrift[];
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message idp.kafka.v1.DescribeTopicResponse.
+ * Use `create(DescribeTopicResponseSchema)` to create a new message.
+ */
+export const DescribeTopicResponseSchema: GenMessage<DescribeTopicResponse> = /*@__PURE__*/
+  messageDesc(file_idp_kafka_v1_kafka, 83);
+
+/**
+ * @generated from message idp.kafka.v1.TopicBrokerState
+ */
+export type TopicBrokerState = Message<"idp.kafka.v1.TopicBrokerState"> & {
+  /**
+   * @generated from field: bool exists = 1;
+   */
+  exists: boolean;
+
+  /**
+   * @generated from field: int32 partitions = 2;
+   */
+  partitions: number;
+
+  /**
+   * @generated from field: int32 replication_factor = 3;
+   */
+  replicationFactor: number;
+
+  /**
+   * @generated from field: map<string, string> config = 4;
+   */
+  config: { [key: string]: string };
+
+  /**
+   * @generated from field: repeated idp.kafka.v1.TopicPartitionState partition_states = 5;
+   */
+  partitionStates: TopicPartitionState[];
+};
+
+/**
+ * Describes the message idp.kafka.v1.TopicBrokerState.
+ * Use `create(TopicBrokerStateSchema)` to create a new message.
+ */
+export const TopicBrokerStateSchema: GenMessage<TopicBrokerState> = /*@__PURE__*/
+  messageDesc(file_idp_kafka_v1_kafka, 84);
+
+/**
+ * @generated from message idp.kafka.v1.TopicPartitionState
+ */
+export type TopicPartitionState = Message<"idp.kafka.v1.TopicPartitionState"> & {
+  /**
+   * @generated from field: int32 partition = 1;
+   */
+  partition: number;
+
+  /**
+   * Broker ID, -1 when the partition has no leader
+   *
+   * @generated from field: int32 leader = 2;
+   */
+  leader: number;
+
+  /**
+   * @generated from field: repeated int32 replicas = 3;
+   */
+  replicas: number[];
+
+  /**
+   * @generated from field: repeated int32 isr = 4;
+   */
+  isr: number[];
+};
+
+/**
+ * Describes the message idp.kafka.v1.TopicPartitionState.
+ * Use `create(TopicPartitionStateSchema)` to create a new message.
+ */
+export const TopicPartitionStateSchema: GenMessage<TopicPartitionState> = /*@__PURE__*/
+  messageDesc(file_idp_kafka_v1_kafka, 85);
+
+/**
+ * TopicDrift is a setting whose live value differs from the declared one
+ *
+ * @generated from message idp.kafka.v1.TopicDrift
+ */
+export type TopicDrift = Message<"idp.kafka.v1.TopicDrift"> & {
+  /**
+   * e.g. "partitions", "retention.ms"
+   *
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string declared = 2;
+   */
+  declared: string;
+
+  /**
+   * @generated from field: string actual = 3;
+   */
+  actual: string;
+};
+
+/**
+ * Describes the message idp.kafka.v1.TopicDrift.
+ * Use `create(TopicDriftSchema)` to create a new message.
+ */
+export const TopicDriftSchema: GenMessage<TopicDrift> = /*@__PURE__*/
+  messageDesc(file_idp_kafka_v1_kafka, 86);
+
 /**
  * @generated from enum idp.kafka.v1.ProviderType
  */
@@ -3031,6 +3202,14 @@ export const KafkaService: GenService<{
     input: typeof GetTopicRequestSchema;
     output: typeof GetTopicResponseSchema;
   },
+  /**
+   * @generated from rpc idp.kafka.v1.KafkaService.DescribeTopic
+   */
+  describeTopic: {
+    methodKind: "unary";
+    input: typeof DescribeTopicRequestSchema;
+    output: typeof DescribeTopicResponseSchema;
+  },
   /**
    * @generated from rpc idp.kafka.v1.KafkaService.ListTopics
    */
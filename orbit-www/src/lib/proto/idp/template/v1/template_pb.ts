@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file idp/template/v1/template.proto.
  */
 export const file_idp_template_v1_template: GenFile = /*@__PURE__*/
-  fileDesc("Ch5pZHAvdGVtcGxhdGUvdjEvdGVtcGxhdGUucHJvdG8SD2lkcC50ZW1wbGF0ZS52MSK3AwoZU3RhcnRJbnN0YW50aWF0aW9uUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCRIUCgx3b3Jrc3BhY2VfaWQYAiABKAkSEgoKdGFyZ2V0X29yZxgDIAEoCRIXCg9yZXBvc2l0b3J5X25hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSEgoKaXNfcHJpdmF0ZRgGIAEoCBJMCgl2YXJpYWJsZXMYByADKAsyOS5pZHAudGVtcGxhdGUudjEuU3RhcnRJbnN0YW50aWF0aW9uUmVxdWVzdC5WYXJpYWJsZXNFbnRyeRIPCgd1c2VyX2lkGAggASgJEhcKD3NvdXJjZV9yZXBvX3VybBgJIAEoCRIaChJpc19naXRodWJfdGVtcGxhdGUYCiABKAgSGQoRc291cmNlX3JlcG9fb3duZXIYCyABKAkSGAoQc291cmNlX3JlcG9fbmFtZRgMIAEoCRIeChZnaXRodWJfaW5zdGFsbGF0aW9uX2lkGA0gASgJGjAKDlZhcmlhYmxlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiMQoaU3RhcnRJbnN0YW50aWF0aW9uUmVzcG9uc2USEwoLd29ya2Zsb3dfaWQYASABKAkiKQoSR2V0UHJvZ3Jlc3NSZXF1ZXN0EhMKC3dvcmtmbG93X2lkGAEgASgJItUBChNHZXRQcm9ncmVzc1Jlc3BvbnNlEhMKC3dvcmtmbG93X2lkGAEgASgJEi8KBnN0YXR1cxgCIAEoDjIfLmlkcC50ZW1wbGF0ZS52MS5Xb3JrZmxvd1N0YXR1cxIUCgxjdXJyZW50X3N0ZXAYAyABKAkSGAoQcHJvZ3Jlc3NfcGVyY2VudBgEIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAUgASgJEhcKD3Jlc3VsdF9yZXBvX3VybBgGIAEoCRIYChByZXN1bHRfcmVwb19uYW1lGAcgASgJIiQKDUNhbmNlbFJlcXVlc3QSEwoLd29ya2Zsb3dfaWQYASABKAkiIQoOQ2FuY2VsUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIwChhMaXN0QXZhaWxhYmxlT3Jnc1JlcXVlc3QSFAoMd29ya3NwYWNlX2lkGAEgASgJIkYKCUdpdEh1Yk9yZxIMCgRuYW1lGAEgASgJEhIKCmF2YXRhcl91cmwYAiABKAkSFwoPaW5zdGFsbGF0aW9uX2lkGAMgASgJIkUKGUxpc3RBdmFpbGFibGVPcmdzUmVzcG9uc2USKAoEb3JncxgBIAMoCzIaLmlkcC50ZW1wbGF0ZS52MS5HaXRIdWJPcmcqxQEKDldvcmtmbG93U3RhdHVzEh8KG1dPUktGTE9XX1NUQVRVU19VTlNQRUNJRklFRBAAEhsKF1dPUktGTE9XX1NUQVRVU19QRU5ESU5HEAESGwoXV09SS0ZMT1dfU1RBVFVTX1JVTk5JTkcQAhIdChlXT1JLRkxPV19TVEFUVVNfQ09NUExFVEVEEAMSGgoWV09SS0ZMT1dfU1RBVFVTX0ZBSUxFRBAEEh0KGVdPUktGTE9XX1NUQVRVU19DQU5DRUxMRUQQBTKrAwoPVGVtcGxhdGVTZXJ2aWNlEm0KElN0YXJ0SW5zdGFudGlhdGlvbhIqLmlkcC50ZW1wbGF0ZS52MS5TdGFydEluc3RhbnRpYXRpb25SZXF1ZXN0GisuaWRwLnRlbXBsYXRlLnYxLlN0YXJ0SW5zdGFudGlhdGlvblJlc3BvbnNlEmUKGEdldEluc3RhbnRpYXRpb25Qcm9ncmVzcxIjLmlkcC50ZW1wbGF0ZS52MS5HZXRQcm9ncmVzc1JlcXVlc3QaJC5pZHAudGVtcGxhdGUudjEuR2V0UHJvZ3Jlc3NSZXNwb25zZRJWChNDYW5jZWxJbnN0YW50aWF0aW9uEh4uaWRwLnRlbXBsYXRlLnYxLkNhbmNlbFJlcXVlc3QaHy5pZHAudGVtcGxhdGUudjEuQ2FuY2VsUmVzcG9uc2USagoRTGlzdEF2YWlsYWJsZU9yZ3MSKS5pZHAudGVtcGxhdGUudjEuTGlzdEF2YWlsYWJsZU9yZ3NSZXF1ZXN0GiouaWRwLnRlbXBsYXRlLnYxLkxpc3RBdmFpbGFibGVPcmdzUmVzcG9uc2VCRlpEZ2l0aHViLmNvbS9kcmV3cGF5bWVudC9vcmJpdC9wcm90by9nZW4vZ28vaWRwL3RlbXBsYXRlL3YxO3RlbXBsYXRldjFiBnByb3RvMw");
+  fileDesc("Ch5pZHAvdGVtcGxhdGUvdjEvdGVtcGxhdGUucHJvdG8SD2lkcC50ZW1wbGF0ZS52MSLQAwoZU3RhcnRJbnN0YW50aWF0aW9uUmVxdWVzdBITCgt0ZW1wbGF0ZV9pZBgBIAEoCRIUCgx3b3Jrc3BhY2VfaWQYAiABKAkSEgoKdGFyZ2V0X29yZxgDIAEoCRIXCg9yZXBvc2l0b3J5X25hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSEgoKaXNfcHJpdmF0ZRgGIAEoCBJMCgl2YXJpYWJsZXMYByADKAsyOS5pZHAudGVtcGxhdGUudjEuU3RhcnRJbnN0YW50aWF0aW9uUmVxdWVzdC5WYXJpYWJsZXNFbnRyeRIPCgd1c2VyX2lkGAggASgJEhcKD3NvdXJjZV9yZXBvX3VybBgJIAEoCRIaChJpc19naXRodWJfdGVtcGxhdGUYCiABKAgSGQoRc291cmNlX3JlcG9fb3duZXIYCyABKAkSGAoQc291cmNlX3JlcG9fbmFtZRgMIAEoCRIeChZnaXRodWJfaW5zdGFsbGF0aW9uX2lkGA0gASgJEhcKD2lkZW1wb3RlbmN5X2tleRgOIAEoCRowCg5WYXJpYWJsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjEKGlN0YXJ0SW5zdGFudGlhdGlvblJlc3BvbnNlEhMKC3dvcmtmbG93X2lkGAEgASgJIikKEkdldFByb2dyZXNzUmVxdWVzdBITCgt3b3JrZmxvd19pZBgBIAEoCSLVAQoTR2V0UHJvZ3Jlc3NSZXNwb25zZRITCgt3b3JrZmxvd19pZBgBIAEoCRIvCgZzdGF0dXMYAiABKA4yHy5pZHAudGVtcGxhdGUudjEuV29ya2Zsb3dTdGF0dXMSFAoMY3VycmVudF9zdGVwGAMgASgJEhgKEHByb2dyZXNzX3BlcmNlbnQYBCABKAUSFQoNZXJyb3JfbWVzc2FnZRgFIAEoCRIXCg9yZXN1bHRfcmVwb191cmwYBiABKAkSGAoQcmVzdWx0X3JlcG9fbmFtZRgHIAEoCSIkCg1DYW5jZWxSZXF1ZXN0EhMKC3dvcmtmbG93X2lkGAEgASgJIiEKDkNhbmNlbFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiMAoYTGlzdEF2YWlsYWJsZU9yZ3NSZXF1ZXN0EhQKDHdvcmtzcGFjZV9pZBgBIAEoCSJGCglHaXRIdWJPcmcSDAoEbmFtZRgBIAEoCRISCgphdmF0YXJfdXJsGAIgASgJEhcKD2luc3RhbGxhdGlvbl9pZBgDIAEoCSJFChlMaXN0QXZhaWxhYmxlT3Jnc1Jlc3BvbnNlEigKBG9yZ3MYASADKAsyGi5pZHAudGVtcGxhdGUudjEuR2l0SHViT3JnKsUBCg5Xb3JrZmxvd1N0YXR1cxIfChtXT1JLRkxPV19TVEFUVVNfVU5TUEVDSUZJRUQQABIbChdXT1JLRkxPV19TVEFUVVNfUEVORElORxABEhsKF1dPUktGTE9XX1NUQVRVU19SVU5OSU5HEAISHQoZV09SS0ZMT1dfU1RBVFVTX0NPTVBMRVRFRBADEhoKFldPUktGTE9XX1NUQVRVU19GQUlMRUQQBBIdChlXT1JLRkxPV19TVEFUVVNfQ0FOQ0VMTEVEEAUyqwMKD1RlbXBsYXRlU2VydmljZRJtChJTdGFydEluc3RhbnRpYXRpb24SKi5pZHAudGVtcGxhdGUudjEuU3RhcnRJbnN0YW50aWF0aW9uUmVxdWVzdBorLmlkcC50ZW1wbGF0ZS52MS5TdGFydEluc3RhbnRpYXRpb25SZXNwb25zZRJlChhHZXRJbnN0YW50aWF0aW9uUHJvZ3Jlc3MSIy5pZHAudGVtcGxhdGUudjEuR2V0UHJvZ3Jlc3NSZXF1ZXN0GiQuaWRwLnRlbXBsYXRlLnYxLkdldFByb2dyZXNzUmVzcG9uc2USVgoTQ2FuY2VsSW5zdGFudGlhdGlvbhIeLmlkcC50ZW1wbGF0ZS52MS5DYW5jZWxSZXF1ZXN0Gh8uaWRwLnRlbXBsYXRlLnYxLkNhbmNlbFJlc3BvbnNlEmoKEUxpc3RBdmFpbGFibGVPcmdzEikuaWRwLnRlbXBsYXRlLnYxLkxpc3RBdmFpbGFibGVPcmdzUmVxdWVzdBoqLmlkcC50ZW1wbGF0ZS52MS5MaXN0QXZhaWxhYmxlT3Jnc1Jlc3BvbnNlQkZaRGdpdGh1Yi5jb20vZHJld3BheW1lbnQvb3JiaXQvcHJvdG8vZ2VuL2dvL2lkcC90ZW1wbGF0ZS92MTt0ZW1wbGF0ZXYxYgZwcm90bzM");
 
 /**
  * @generated from message idp.template.v1.StartInstantiationRequest
@@ -94,6 +94,14 @@ export type StartInstantiationRequest = Message<"idp.template.v1.StartInstantiat
    * @generated from field: string github_installation_id = 13;
    */
   githubInstallationId: string;
+
+  /**
+   * Caller-chosen key that makes retries of the same instantiation start a
+   * single workflow. Empty starts a new workflow on every call.
+   *
+   * @generated from field: string idempotency_key = 14;
+   */
+  idempotencyKey: string;
 };
 
 /**
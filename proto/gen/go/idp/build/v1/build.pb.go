@@ -85,6 +85,8 @@ const (
 	BuildStepStatus_BUILD_STEP_STATUS_RUNNING     BuildStepStatus = 2
 	BuildStepStatus_BUILD_STEP_STATUS_COMPLETED   BuildStepStatus = 3
 	BuildStepStatus_BUILD_STEP_STATUS_FAILED      BuildStepStatus = 4
+	BuildStepStatus_BUILD_STEP_STATUS_CANCELLED   BuildStepStatus = 5
+	BuildStepStatus_BUILD_STEP_STATUS_QUEUED      BuildStepStatus = 6
 )
 
 // Enum value maps for BuildStepStatus.
@@ -95,6 +97,8 @@ var (
 		2: "BUILD_STEP_STATUS_RUNNING",
 		3: "BUILD_STEP_STATUS_COMPLETED",
 		4: "BUILD_STEP_STATUS_FAILED",
+		5: "BUILD_STEP_STATUS_CANCELLED",
+		6: "BUILD_STEP_STATUS_QUEUED",
 	}
 	BuildStepStatus_value = map[string]int32{
 		"BUILD_STEP_STATUS_UNSPECIFIED": 0,
@@ -102,6 +106,8 @@ var (
 		"BUILD_STEP_STATUS_RUNNING":     2,
 		"BUILD_STEP_STATUS_COMPLETED":   3,
 		"BUILD_STEP_STATUS_FAILED":      4,
+		"BUILD_STEP_STATUS_CANCELLED":   5,
+		"BUILD_STEP_STATUS_QUEUED":      6,
 	}
 )
 
@@ -656,10 +662,11 @@ func (x *RegistryConfig) GetUsername() string {
 type BuildImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`          // Full image URL (e.g., ghcr.io/org/app:tag)
-	ImageDigest   string                 `protobuf:"bytes,3,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"` // Image digest (sha256:...)
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                // Error message if failed
-	Steps         []*BuildStep           `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`                                // Build steps for progress tracking
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                // Full image URL (e.g., ghcr.io/org/app:tag)
+	ImageDigest   string                 `protobuf:"bytes,3,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`       // Image digest (sha256:...)
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                      // Error message if failed
+	Steps         []*BuildStep           `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`                                      // Build steps for progress tracking
+	Status        BuildStepStatus        `protobuf:"varint,6,opt,name=status,proto3,enum=idp.build.v1.BuildStepStatus" json:"status,omitempty"` // Terminal state: COMPLETED, FAILED or CANCELLED
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -729,6 +736,13 @@ func (x *BuildImageResponse) GetSteps() []*BuildStep {
 	return nil
 }
 
+func (x *BuildImageResponse) GetStatus() BuildStepStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BuildStepStatus_BUILD_STEP_STATUS_UNSPECIFIED
+}
+
 // BuildStep represents a step in the build process
 type BuildStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -802,6 +816,7 @@ func (x *BuildStep) GetDurationMs() int64 {
 type StreamBuildLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	FromSequence  int64                  `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"` // Resume after this sequence number (0 streams from the start)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -843,13 +858,24 @@ func (x *StreamBuildLogsRequest) GetRequestId() string {
 	return ""
 }
 
-// StreamBuildLogsResponse represents a single log line
+func (x *StreamBuildLogsRequest) GetFromSequence() int64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+// StreamBuildLogsResponse represents a single log line, or the build result
+// on the final message of the stream
 type StreamBuildLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"` // "info", "warn", "error"
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix milliseconds
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`          // "info", "warn", "error"
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Step          string                 `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"` // Which build step this belongs to
+	Step          string                 `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`                                        // Which build step this belongs to
+	Sequence      int64                  `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`                               // Increases by one per log line, starting at 1
+	Result        *BuildImageResponse    `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`                                    // Set only on the final message once the build finishes
+	Status        BuildStepStatus        `protobuf:"varint,7,opt,name=status,proto3,enum=idp.build.v1.BuildStepStatus" json:"status,omitempty"` // QUEUED while waiting for a build slot, then RUNNING; the terminal state on the final message
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -912,6 +938,117 @@ func (x *StreamBuildLogsResponse) GetStep() string {
 	return ""
 }
 
+func (x *StreamBuildLogsResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *StreamBuildLogsResponse) GetResult() *BuildImageResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *StreamBuildLogsResponse) GetStatus() BuildStepStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BuildStepStatus_BUILD_STEP_STATUS_UNSPECIFIED
+}
+
+// CancelBuildRequest identifies the build to cancel
+type CancelBuildRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBuildRequest) Reset() {
+	*x = CancelBuildRequest{}
+	mi := &file_idp_build_v1_build_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBuildRequest) ProtoMessage() {}
+
+func (x *CancelBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_build_v1_build_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBuildRequest.ProtoReflect.Descriptor instead.
+func (*CancelBuildRequest) Descriptor() ([]byte, []int) {
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBuildRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// CancelBuildResponse confirms the cancellation
+type CancelBuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     bool                   `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBuildResponse) Reset() {
+	*x = CancelBuildResponse{}
+	mi := &file_idp_build_v1_build_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBuildResponse) ProtoMessage() {}
+
+func (x *CancelBuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_build_v1_build_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBuildResponse.ProtoReflect.Descriptor instead.
+func (*CancelBuildResponse) Descriptor() ([]byte, []int) {
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelBuildResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
 // StartBuildWorkflowRequest initiates a Temporal build workflow
 type StartBuildWorkflowRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -934,7 +1071,7 @@ type StartBuildWorkflowRequest struct {
 
 func (x *StartBuildWorkflowRequest) Reset() {
 	*x = StartBuildWorkflowRequest{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[10]
+	mi := &file_idp_build_v1_build_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -946,7 +1083,7 @@ func (x *StartBuildWorkflowRequest) String() string {
 func (*StartBuildWorkflowRequest) ProtoMessage() {}
 
 func (x *StartBuildWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[10]
+	mi := &file_idp_build_v1_build_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1096,7 @@ func (x *StartBuildWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartBuildWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*StartBuildWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{10}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StartBuildWorkflowRequest) GetAppId() string {
@@ -1058,7 +1195,7 @@ type StartBuildWorkflowResponse struct {
 
 func (x *StartBuildWorkflowResponse) Reset() {
 	*x = StartBuildWorkflowResponse{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[11]
+	mi := &file_idp_build_v1_build_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1207,7 @@ func (x *StartBuildWorkflowResponse) String() string {
 func (*StartBuildWorkflowResponse) ProtoMessage() {}
 
 func (x *StartBuildWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[11]
+	mi := &file_idp_build_v1_build_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1220,7 @@ func (x *StartBuildWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartBuildWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*StartBuildWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{11}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StartBuildWorkflowResponse) GetSuccess() bool {
@@ -1117,7 +1254,7 @@ type GetBuildProgressRequest struct {
 
 func (x *GetBuildProgressRequest) Reset() {
 	*x = GetBuildProgressRequest{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[12]
+	mi := &file_idp_build_v1_build_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1129,7 +1266,7 @@ func (x *GetBuildProgressRequest) String() string {
 func (*GetBuildProgressRequest) ProtoMessage() {}
 
 func (x *GetBuildProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[12]
+	mi := &file_idp_build_v1_build_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1279,7 @@ func (x *GetBuildProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildProgressRequest.ProtoReflect.Descriptor instead.
 func (*GetBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{12}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetBuildProgressRequest) GetWorkflowId() string {
@@ -1170,7 +1307,7 @@ type GetBuildProgressResponse struct {
 
 func (x *GetBuildProgressResponse) Reset() {
 	*x = GetBuildProgressResponse{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[13]
+	mi := &file_idp_build_v1_build_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1182,7 +1319,7 @@ func (x *GetBuildProgressResponse) String() string {
 func (*GetBuildProgressResponse) ProtoMessage() {}
 
 func (x *GetBuildProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[13]
+	mi := &file_idp_build_v1_build_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1332,7 @@ func (x *GetBuildProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuildProgressResponse.ProtoReflect.Descriptor instead.
 func (*GetBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{13}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetBuildProgressResponse) GetCurrentStep() string {
@@ -1272,7 +1409,7 @@ type CheckQuotaRequest struct {
 
 func (x *CheckQuotaRequest) Reset() {
 	*x = CheckQuotaRequest{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[14]
+	mi := &file_idp_build_v1_build_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1284,7 +1421,7 @@ func (x *CheckQuotaRequest) String() string {
 func (*CheckQuotaRequest) ProtoMessage() {}
 
 func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[14]
+	mi := &file_idp_build_v1_build_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,7 +1434,7 @@ func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
 func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{14}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CheckQuotaRequest) GetWorkspaceId() string {
@@ -1327,7 +1464,7 @@ type CheckQuotaResponse struct {
 
 func (x *CheckQuotaResponse) Reset() {
 	*x = CheckQuotaResponse{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[15]
+	mi := &file_idp_build_v1_build_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1339,7 +1476,7 @@ func (x *CheckQuotaResponse) String() string {
 func (*CheckQuotaResponse) ProtoMessage() {}
 
 func (x *CheckQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[15]
+	mi := &file_idp_build_v1_build_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,7 +1489,7 @@ func (x *CheckQuotaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckQuotaResponse.ProtoReflect.Descriptor instead.
 func (*CheckQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{15}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CheckQuotaResponse) GetCleanupPerformed() bool {
@@ -1401,7 +1538,7 @@ type CleanedImage struct {
 
 func (x *CleanedImage) Reset() {
 	*x = CleanedImage{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[16]
+	mi := &file_idp_build_v1_build_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1413,7 +1550,7 @@ func (x *CleanedImage) String() string {
 func (*CleanedImage) ProtoMessage() {}
 
 func (x *CleanedImage) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[16]
+	mi := &file_idp_build_v1_build_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +1563,7 @@ func (x *CleanedImage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanedImage.ProtoReflect.Descriptor instead.
 func (*CleanedImage) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{16}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CleanedImage) GetAppName() string {
@@ -1465,7 +1602,7 @@ type TrackImageRequest struct {
 
 func (x *TrackImageRequest) Reset() {
 	*x = TrackImageRequest{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[17]
+	mi := &file_idp_build_v1_build_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1477,7 +1614,7 @@ func (x *TrackImageRequest) String() string {
 func (*TrackImageRequest) ProtoMessage() {}
 
 func (x *TrackImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[17]
+	mi := &file_idp_build_v1_build_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +1627,7 @@ func (x *TrackImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackImageRequest.ProtoReflect.Descriptor instead.
 func (*TrackImageRequest) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{17}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TrackImageRequest) GetWorkspaceId() string {
@@ -1553,7 +1690,7 @@ type TrackImageResponse struct {
 
 func (x *TrackImageResponse) Reset() {
 	*x = TrackImageResponse{}
-	mi := &file_idp_build_v1_build_proto_msgTypes[18]
+	mi := &file_idp_build_v1_build_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1565,7 +1702,7 @@ func (x *TrackImageResponse) String() string {
 func (*TrackImageResponse) ProtoMessage() {}
 
 func (x *TrackImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_build_v1_build_proto_msgTypes[18]
+	mi := &file_idp_build_v1_build_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,7 +1715,7 @@ func (x *TrackImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackImageResponse.ProtoReflect.Descriptor instead.
 func (*TrackImageResponse) Descriptor() ([]byte, []int) {
-	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{18}
+	return file_idp_build_v1_build_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TrackImageResponse) GetSizeBytes() int64 {
@@ -1660,27 +1797,37 @@ const file_idp_build_v1_build_proto_rawDesc = "" +
 	"repository\x12\x14\n" +
 	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1f\n" +
 	"\busername\x18\x05 \x01(\tH\x00R\busername\x88\x01\x01B\v\n" +
-	"\t_username\"\xb3\x01\n" +
+	"\t_username\"\xea\x01\n" +
 	"\x12BuildImageResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12!\n" +
 	"\fimage_digest\x18\x03 \x01(\tR\vimageDigest\x12\x14\n" +
 	"\x05error\x18\x04 \x01(\tR\x05error\x12-\n" +
-	"\x05steps\x18\x05 \x03(\v2\x17.idp.build.v1.BuildStepR\x05steps\"\x91\x01\n" +
+	"\x05steps\x18\x05 \x03(\v2\x17.idp.build.v1.BuildStepR\x05steps\x125\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1d.idp.build.v1.BuildStepStatusR\x06status\"\x91\x01\n" +
 	"\tBuildStep\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1d.idp.build.v1.BuildStepStatusR\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
 	"\vduration_ms\x18\x04 \x01(\x03R\n" +
-	"durationMs\"7\n" +
+	"durationMs\"\\\n" +
 	"\x16StreamBuildLogsRequest\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"{\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12#\n" +
+	"\rfrom_sequence\x18\x02 \x01(\x03R\ffromSequence\"\x88\x02\n" +
 	"\x17StreamBuildLogsResponse\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x14\n" +
 	"\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
-	"\x04step\x18\x04 \x01(\tR\x04step\"\xef\x04\n" +
+	"\x04step\x18\x04 \x01(\tR\x04step\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\x03R\bsequence\x128\n" +
+	"\x06result\x18\x06 \x01(\v2 .idp.build.v1.BuildImageResponseR\x06result\x125\n" +
+	"\x06status\x18\a \x01(\x0e2\x1d.idp.build.v1.BuildStepStatusR\x06status\"3\n" +
+	"\x12CancelBuildRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"3\n" +
+	"\x13CancelBuildResponse\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\bR\tcancelled\"\xef\x04\n" +
 	"\x19StartBuildWorkflowRequest\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12!\n" +
 	"\fworkspace_id\x18\x02 \x01(\tR\vworkspaceId\x12\x17\n" +
@@ -1754,18 +1901,21 @@ const file_idp_build_v1_build_proto_rawDesc = "" +
 	"\x19REGISTRY_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12REGISTRY_TYPE_GHCR\x10\x01\x12\x15\n" +
 	"\x11REGISTRY_TYPE_ACR\x10\x02\x12\x17\n" +
-	"\x13REGISTRY_TYPE_ORBIT\x10\x03*\xb1\x01\n" +
+	"\x13REGISTRY_TYPE_ORBIT\x10\x03*\xf0\x01\n" +
 	"\x0fBuildStepStatus\x12!\n" +
 	"\x1dBUILD_STEP_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19BUILD_STEP_STATUS_PENDING\x10\x01\x12\x1d\n" +
 	"\x19BUILD_STEP_STATUS_RUNNING\x10\x02\x12\x1f\n" +
 	"\x1bBUILD_STEP_STATUS_COMPLETED\x10\x03\x12\x1c\n" +
-	"\x18BUILD_STEP_STATUS_FAILED\x10\x042\x9f\x05\n" +
+	"\x18BUILD_STEP_STATUS_FAILED\x10\x04\x12\x1f\n" +
+	"\x1bBUILD_STEP_STATUS_CANCELLED\x10\x05\x12\x1c\n" +
+	"\x18BUILD_STEP_STATUS_QUEUED\x10\x062\xf3\x05\n" +
 	"\fBuildService\x12d\n" +
 	"\x11AnalyzeRepository\x12&.idp.build.v1.AnalyzeRepositoryRequest\x1a'.idp.build.v1.AnalyzeRepositoryResponse\x12O\n" +
 	"\n" +
 	"BuildImage\x12\x1f.idp.build.v1.BuildImageRequest\x1a .idp.build.v1.BuildImageResponse\x12`\n" +
-	"\x0fStreamBuildLogs\x12$.idp.build.v1.StreamBuildLogsRequest\x1a%.idp.build.v1.StreamBuildLogsResponse0\x01\x12g\n" +
+	"\x0fStreamBuildLogs\x12$.idp.build.v1.StreamBuildLogsRequest\x1a%.idp.build.v1.StreamBuildLogsResponse0\x01\x12R\n" +
+	"\vCancelBuild\x12 .idp.build.v1.CancelBuildRequest\x1a!.idp.build.v1.CancelBuildResponse\x12g\n" +
 	"\x12StartBuildWorkflow\x12'.idp.build.v1.StartBuildWorkflowRequest\x1a(.idp.build.v1.StartBuildWorkflowResponse\x12a\n" +
 	"\x10GetBuildProgress\x12%.idp.build.v1.GetBuildProgressRequest\x1a&.idp.build.v1.GetBuildProgressResponse\x12Y\n" +
 	"\x14CheckQuotaAndCleanup\x12\x1f.idp.build.v1.CheckQuotaRequest\x1a .idp.build.v1.CheckQuotaResponse\x12O\n" +
@@ -1785,7 +1935,7 @@ func file_idp_build_v1_build_proto_rawDescGZIP() []byte {
 }
 
 var file_idp_build_v1_build_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_idp_build_v1_build_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_idp_build_v1_build_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_idp_build_v1_build_proto_goTypes = []any{
 	(RegistryType)(0),                  // 0: idp.build.v1.RegistryType
 	(BuildStepStatus)(0),               // 1: idp.build.v1.BuildStepStatus
@@ -1799,49 +1949,56 @@ var file_idp_build_v1_build_proto_goTypes = []any{
 	(*BuildStep)(nil),                  // 9: idp.build.v1.BuildStep
 	(*StreamBuildLogsRequest)(nil),     // 10: idp.build.v1.StreamBuildLogsRequest
 	(*StreamBuildLogsResponse)(nil),    // 11: idp.build.v1.StreamBuildLogsResponse
-	(*StartBuildWorkflowRequest)(nil),  // 12: idp.build.v1.StartBuildWorkflowRequest
-	(*StartBuildWorkflowResponse)(nil), // 13: idp.build.v1.StartBuildWorkflowResponse
-	(*GetBuildProgressRequest)(nil),    // 14: idp.build.v1.GetBuildProgressRequest
-	(*GetBuildProgressResponse)(nil),   // 15: idp.build.v1.GetBuildProgressResponse
-	(*CheckQuotaRequest)(nil),          // 16: idp.build.v1.CheckQuotaRequest
-	(*CheckQuotaResponse)(nil),         // 17: idp.build.v1.CheckQuotaResponse
-	(*CleanedImage)(nil),               // 18: idp.build.v1.CleanedImage
-	(*TrackImageRequest)(nil),          // 19: idp.build.v1.TrackImageRequest
-	(*TrackImageResponse)(nil),         // 20: idp.build.v1.TrackImageResponse
-	nil,                                // 21: idp.build.v1.BuildImageRequest.BuildEnvEntry
-	nil,                                // 22: idp.build.v1.StartBuildWorkflowRequest.BuildEnvEntry
+	(*CancelBuildRequest)(nil),         // 12: idp.build.v1.CancelBuildRequest
+	(*CancelBuildResponse)(nil),        // 13: idp.build.v1.CancelBuildResponse
+	(*StartBuildWorkflowRequest)(nil),  // 14: idp.build.v1.StartBuildWorkflowRequest
+	(*StartBuildWorkflowResponse)(nil), // 15: idp.build.v1.StartBuildWorkflowResponse
+	(*GetBuildProgressRequest)(nil),    // 16: idp.build.v1.GetBuildProgressRequest
+	(*GetBuildProgressResponse)(nil),   // 17: idp.build.v1.GetBuildProgressResponse
+	(*CheckQuotaRequest)(nil),          // 18: idp.build.v1.CheckQuotaRequest
+	(*CheckQuotaResponse)(nil),         // 19: idp.build.v1.CheckQuotaResponse
+	(*CleanedImage)(nil),               // 20: idp.build.v1.CleanedImage
+	(*TrackImageRequest)(nil),          // 21: idp.build.v1.TrackImageRequest
+	(*TrackImageResponse)(nil),         // 22: idp.build.v1.TrackImageResponse
+	nil,                                // 23: idp.build.v1.BuildImageRequest.BuildEnvEntry
+	nil,                                // 24: idp.build.v1.StartBuildWorkflowRequest.BuildEnvEntry
 }
 var file_idp_build_v1_build_proto_depIdxs = []int32{
 	4,  // 0: idp.build.v1.AnalyzeRepositoryResponse.config:type_name -> idp.build.v1.DetectedBuildConfig
 	5,  // 1: idp.build.v1.DetectedBuildConfig.package_manager:type_name -> idp.build.v1.PackageManagerInfo
-	21, // 2: idp.build.v1.BuildImageRequest.build_env:type_name -> idp.build.v1.BuildImageRequest.BuildEnvEntry
+	23, // 2: idp.build.v1.BuildImageRequest.build_env:type_name -> idp.build.v1.BuildImageRequest.BuildEnvEntry
 	7,  // 3: idp.build.v1.BuildImageRequest.registry:type_name -> idp.build.v1.RegistryConfig
 	0,  // 4: idp.build.v1.RegistryConfig.type:type_name -> idp.build.v1.RegistryType
 	9,  // 5: idp.build.v1.BuildImageResponse.steps:type_name -> idp.build.v1.BuildStep
-	1,  // 6: idp.build.v1.BuildStep.status:type_name -> idp.build.v1.BuildStepStatus
-	7,  // 7: idp.build.v1.StartBuildWorkflowRequest.registry:type_name -> idp.build.v1.RegistryConfig
-	22, // 8: idp.build.v1.StartBuildWorkflowRequest.build_env:type_name -> idp.build.v1.StartBuildWorkflowRequest.BuildEnvEntry
-	4,  // 9: idp.build.v1.GetBuildProgressResponse.detected_config:type_name -> idp.build.v1.DetectedBuildConfig
-	18, // 10: idp.build.v1.CheckQuotaResponse.cleaned_images:type_name -> idp.build.v1.CleanedImage
-	2,  // 11: idp.build.v1.BuildService.AnalyzeRepository:input_type -> idp.build.v1.AnalyzeRepositoryRequest
-	6,  // 12: idp.build.v1.BuildService.BuildImage:input_type -> idp.build.v1.BuildImageRequest
-	10, // 13: idp.build.v1.BuildService.StreamBuildLogs:input_type -> idp.build.v1.StreamBuildLogsRequest
-	12, // 14: idp.build.v1.BuildService.StartBuildWorkflow:input_type -> idp.build.v1.StartBuildWorkflowRequest
-	14, // 15: idp.build.v1.BuildService.GetBuildProgress:input_type -> idp.build.v1.GetBuildProgressRequest
-	16, // 16: idp.build.v1.BuildService.CheckQuotaAndCleanup:input_type -> idp.build.v1.CheckQuotaRequest
-	19, // 17: idp.build.v1.BuildService.TrackImage:input_type -> idp.build.v1.TrackImageRequest
-	3,  // 18: idp.build.v1.BuildService.AnalyzeRepository:output_type -> idp.build.v1.AnalyzeRepositoryResponse
-	8,  // 19: idp.build.v1.BuildService.BuildImage:output_type -> idp.build.v1.BuildImageResponse
-	11, // 20: idp.build.v1.BuildService.StreamBuildLogs:output_type -> idp.build.v1.StreamBuildLogsResponse
-	13, // 21: idp.build.v1.BuildService.StartBuildWorkflow:output_type -> idp.build.v1.StartBuildWorkflowResponse
-	15, // 22: idp.build.v1.BuildService.GetBuildProgress:output_type -> idp.build.v1.GetBuildProgressResponse
-	17, // 23: idp.build.v1.BuildService.CheckQuotaAndCleanup:output_type -> idp.build.v1.CheckQuotaResponse
-	20, // 24: idp.build.v1.BuildService.TrackImage:output_type -> idp.build.v1.TrackImageResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	1,  // 6: idp.build.v1.BuildImageResponse.status:type_name -> idp.build.v1.BuildStepStatus
+	1,  // 7: idp.build.v1.BuildStep.status:type_name -> idp.build.v1.BuildStepStatus
+	8,  // 8: idp.build.v1.StreamBuildLogsResponse.result:type_name -> idp.build.v1.BuildImageResponse
+	1,  // 9: idp.build.v1.StreamBuildLogsResponse.status:type_name -> idp.build.v1.BuildStepStatus
+	7,  // 10: idp.build.v1.StartBuildWorkflowRequest.registry:type_name -> idp.build.v1.RegistryConfig
+	24, // 11: idp.build.v1.StartBuildWorkflowRequest.build_env:type_name -> idp.build.v1.StartBuildWorkflowRequest.BuildEnvEntry
+	4,  // 12: idp.build.v1.GetBuildProgressResponse.detected_config:type_name -> idp.build.v1.DetectedBuildConfig
+	20, // 13: idp.build.v1.CheckQuotaResponse.cleaned_images:type_name -> idp.build.v1.CleanedImage
+	2,  // 14: idp.build.v1.BuildService.AnalyzeRepository:input_type -> idp.build.v1.AnalyzeRepositoryRequest
+	6,  // 15: idp.build.v1.BuildService.BuildImage:input_type -> idp.build.v1.BuildImageRequest
+	10, // 16: idp.build.v1.BuildService.StreamBuildLogs:input_type -> idp.build.v1.StreamBuildLogsRequest
+	12, // 17: idp.build.v1.BuildService.CancelBuild:input_type -> idp.build.v1.CancelBuildRequest
+	14, // 18: idp.build.v1.BuildService.StartBuildWorkflow:input_type -> idp.build.v1.StartBuildWorkflowRequest
+	16, // 19: idp.build.v1.BuildService.GetBuildProgress:input_type -> idp.build.v1.GetBuildProgressRequest
+	18, // 20: idp.build.v1.BuildService.CheckQuotaAndCleanup:input_type -> idp.build.v1.CheckQuotaRequest
+	21, // 21: idp.build.v1.BuildService.TrackImage:input_type -> idp.build.v1.TrackImageRequest
+	3,  // 22: idp.build.v1.BuildService.AnalyzeRepository:output_type -> idp.build.v1.AnalyzeRepositoryResponse
+	8,  // 23: idp.build.v1.BuildService.BuildImage:output_type -> idp.build.v1.BuildImageResponse
+	11, // 24: idp.build.v1.BuildService.StreamBuildLogs:output_type -> idp.build.v1.StreamBuildLogsResponse
+	13, // 25: idp.build.v1.BuildService.CancelBuild:output_type -> idp.build.v1.CancelBuildResponse
+	15, // 26: idp.build.v1.BuildService.StartBuildWorkflow:output_type -> idp.build.v1.StartBuildWorkflowResponse
+	17, // 27: idp.build.v1.BuildService.GetBuildProgress:output_type -> idp.build.v1.GetBuildProgressResponse
+	19, // 28: idp.build.v1.BuildService.CheckQuotaAndCleanup:output_type -> idp.build.v1.CheckQuotaResponse
+	22, // 29: idp.build.v1.BuildService.TrackImage:output_type -> idp.build.v1.TrackImageResponse
+	22, // [22:30] is the sub-list for method output_type
+	14, // [14:22] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_idp_build_v1_build_proto_init() }
@@ -1851,14 +2008,14 @@ func file_idp_build_v1_build_proto_init() {
 	}
 	file_idp_build_v1_build_proto_msgTypes[4].OneofWrappers = []any{}
 	file_idp_build_v1_build_proto_msgTypes[5].OneofWrappers = []any{}
-	file_idp_build_v1_build_proto_msgTypes[10].OneofWrappers = []any{}
+	file_idp_build_v1_build_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idp_build_v1_build_proto_rawDesc), len(file_idp_build_v1_build_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   21,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 	BuildService_AnalyzeRepository_FullMethodName    = "/idp.build.v1.BuildService/AnalyzeRepository"
 	BuildService_BuildImage_FullMethodName           = "/idp.build.v1.BuildService/BuildImage"
 	BuildService_StreamBuildLogs_FullMethodName      = "/idp.build.v1.BuildService/StreamBuildLogs"
+	BuildService_CancelBuild_FullMethodName          = "/idp.build.v1.BuildService/CancelBuild"
 	BuildService_StartBuildWorkflow_FullMethodName   = "/idp.build.v1.BuildService/StartBuildWorkflow"
 	BuildService_GetBuildProgress_FullMethodName     = "/idp.build.v1.BuildService/GetBuildProgress"
 	BuildService_CheckQuotaAndCleanup_FullMethodName = "/idp.build.v1.BuildService/CheckQuotaAndCleanup"
@@ -42,6 +43,8 @@ type BuildServiceClient interface {
 	BuildImage(ctx context.Context, in *BuildImageRequest, opts ...grpc.CallOption) (*BuildImageResponse, error)
 	// Stream build logs in real-time
 	StreamBuildLogs(ctx context.Context, in *StreamBuildLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamBuildLogsResponse], error)
+	// Cancel an in-progress build
+	CancelBuild(ctx context.Context, in *CancelBuildRequest, opts ...grpc.CallOption) (*CancelBuildResponse, error)
 	// Start a build workflow via Temporal
 	StartBuildWorkflow(ctx context.Context, in *StartBuildWorkflowRequest, opts ...grpc.CallOption) (*StartBuildWorkflowResponse, error)
 	// Get the progress of a build workflow
@@ -98,6 +101,16 @@ func (c *buildServiceClient) StreamBuildLogs(ctx context.Context, in *StreamBuil
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BuildService_StreamBuildLogsClient = grpc.ServerStreamingClient[StreamBuildLogsResponse]
 
+func (c *buildServiceClient) CancelBuild(ctx context.Context, in *CancelBuildRequest, opts ...grpc.CallOption) (*CancelBuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBuildResponse)
+	err := c.cc.Invoke(ctx, BuildService_CancelBuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *buildServiceClient) StartBuildWorkflow(ctx context.Context, in *StartBuildWorkflowRequest, opts ...grpc.CallOption) (*StartBuildWorkflowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartBuildWorkflowResponse)
@@ -150,6 +163,8 @@ type BuildServiceServer interface {
 	BuildImage(context.Context, *BuildImageRequest) (*BuildImageResponse, error)
 	// Stream build logs in real-time
 	StreamBuildLogs(*StreamBuildLogsRequest, grpc.ServerStreamingServer[StreamBuildLogsResponse]) error
+	// Cancel an in-progress build
+	CancelBuild(context.Context, *CancelBuildRequest) (*CancelBuildResponse, error)
 	// Start a build workflow via Temporal
 	StartBuildWorkflow(context.Context, *StartBuildWorkflowRequest) (*StartBuildWorkflowResponse, error)
 	// Get the progress of a build workflow
@@ -176,6 +191,9 @@ func (UnimplementedBuildServiceServer) BuildImage(context.Context, *BuildImageRe
 func (UnimplementedBuildServiceServer) StreamBuildLogs(*StreamBuildLogsRequest, grpc.ServerStreamingServer[StreamBuildLogsResponse]) error {
 	return status.Error(codes.Unimplemented, "method StreamBuildLogs not implemented")
 }
+func (UnimplementedBuildServiceServer) CancelBuild(context.Context, *CancelBuildRequest) (*CancelBuildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelBuild not implemented")
+}
 func (UnimplementedBuildServiceServer) StartBuildWorkflow(context.Context, *StartBuildWorkflowRequest) (*StartBuildWorkflowResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StartBuildWorkflow not implemented")
 }
@@ -256,6 +274,24 @@ func _BuildService_StreamBuildLogs_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BuildService_StreamBuildLogsServer = grpc.ServerStreamingServer[StreamBuildLogsResponse]
 
+func _BuildService_CancelBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildServiceServer).CancelBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuildService_CancelBuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildServiceServer).CancelBuild(ctx, req.(*CancelBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BuildService_StartBuildWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartBuildWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +379,10 @@ var BuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BuildImage",
 			Handler:    _BuildService_BuildImage_Handler,
 		},
+		{
+			MethodName: "CancelBuild",
+			Handler:    _BuildService_CancelBuild_Handler,
+		},
 		{
 			MethodName: "StartBuildWorkflow",
 			Handler:    _BuildService_StartBuildWorkflow_Handler,
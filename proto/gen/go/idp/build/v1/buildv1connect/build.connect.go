@@ -43,6 +43,9 @@ const (
 	// BuildServiceStreamBuildLogsProcedure is the fully-qualified name of the BuildService's
 	// StreamBuildLogs RPC.
 	BuildServiceStreamBuildLogsProcedure = "/idp.build.v1.BuildService/StreamBuildLogs"
+	// BuildServiceCancelBuildProcedure is the fully-qualified name of the BuildService's CancelBuild
+	// RPC.
+	BuildServiceCancelBuildProcedure = "/idp.build.v1.BuildService/CancelBuild"
 	// BuildServiceStartBuildWorkflowProcedure is the fully-qualified name of the BuildService's
 	// StartBuildWorkflow RPC.
 	BuildServiceStartBuildWorkflowProcedure = "/idp.build.v1.BuildService/StartBuildWorkflow"
@@ -64,6 +67,8 @@ type BuildServiceClient interface {
 	BuildImage(context.Context, *connect.Request[v1.BuildImageRequest]) (*connect.Response[v1.BuildImageResponse], error)
 	// Stream build logs in real-time
 	StreamBuildLogs(context.Context, *connect.Request[v1.StreamBuildLogsRequest]) (*connect.ServerStreamForClient[v1.StreamBuildLogsResponse], error)
+	// Cancel an in-progress build
+	CancelBuild(context.Context, *connect.Request[v1.CancelBuildRequest]) (*connect.Response[v1.CancelBuildResponse], error)
 	// Start a build workflow via Temporal
 	StartBuildWorkflow(context.Context, *connect.Request[v1.StartBuildWorkflowRequest]) (*connect.Response[v1.StartBuildWorkflowResponse], error)
 	// Get the progress of a build workflow
@@ -102,6 +107,12 @@ func NewBuildServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(buildServiceMethods.ByName("StreamBuildLogs")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelBuild: connect.NewClient[v1.CancelBuildRequest, v1.CancelBuildResponse](
+			httpClient,
+			baseURL+BuildServiceCancelBuildProcedure,
+			connect.WithSchema(buildServiceMethods.ByName("CancelBuild")),
+			connect.WithClientOptions(opts...),
+		),
 		startBuildWorkflow: connect.NewClient[v1.StartBuildWorkflowRequest, v1.StartBuildWorkflowResponse](
 			httpClient,
 			baseURL+BuildServiceStartBuildWorkflowProcedure,
@@ -134,6 +145,7 @@ type buildServiceClient struct {
 	analyzeRepository    *connect.Client[v1.AnalyzeRepositoryRequest, v1.AnalyzeRepositoryResponse]
 	buildImage           *connect.Client[v1.BuildImageRequest, v1.BuildImageResponse]
 	streamBuildLogs      *connect.Client[v1.StreamBuildLogsRequest, v1.StreamBuildLogsResponse]
+	cancelBuild          *connect.Client[v1.CancelBuildRequest, v1.CancelBuildResponse]
 	startBuildWorkflow   *connect.Client[v1.StartBuildWorkflowRequest, v1.StartBuildWorkflowResponse]
 	getBuildProgress     *connect.Client[v1.GetBuildProgressRequest, v1.GetBuildProgressResponse]
 	checkQuotaAndCleanup *connect.Client[v1.CheckQuotaRequest, v1.CheckQuotaResponse]
@@ -155,6 +167,11 @@ func (c *buildServiceClient) StreamBuildLogs(ctx context.Context, req *connect.R
 	return c.streamBuildLogs.CallServerStream(ctx, req)
 }
 
+// CancelBuild calls idp.build.v1.BuildService.CancelBuild.
+func (c *buildServiceClient) CancelBuild(ctx context.Context, req *connect.Request[v1.CancelBuildRequest]) (*connect.Response[v1.CancelBuildResponse], error) {
+	return c.cancelBuild.CallUnary(ctx, req)
+}
+
 // StartBuildWorkflow calls idp.build.v1.BuildService.StartBuildWorkflow.
 func (c *buildServiceClient) StartBuildWorkflow(ctx context.Context, req *connect.Request[v1.StartBuildWorkflowRequest]) (*connect.Response[v1.StartBuildWorkflowResponse], error) {
 	return c.startBuildWorkflow.CallUnary(ctx, req)
@@ -183,6 +200,8 @@ type BuildServiceHandler interface {
 	BuildImage(context.Context, *connect.Request[v1.BuildImageRequest]) (*connect.Response[v1.BuildImageResponse], error)
 	// Stream build logs in real-time
 	StreamBuildLogs(context.Context, *connect.Request[v1.StreamBuildLogsRequest], *connect.ServerStream[v1.StreamBuildLogsResponse]) error
+	// Cancel an in-progress build
+	CancelBuild(context.Context, *connect.Request[v1.CancelBuildRequest]) (*connect.Response[v1.CancelBuildResponse], error)
 	// Start a build workflow via Temporal
 	StartBuildWorkflow(context.Context, *connect.Request[v1.StartBuildWorkflowRequest]) (*connect.Response[v1.StartBuildWorkflowResponse], error)
 	// Get the progress of a build workflow
@@ -217,6 +236,12 @@ func NewBuildServiceHandler(svc BuildServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(buildServiceMethods.ByName("StreamBuildLogs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	buildServiceCancelBuildHandler := connect.NewUnaryHandler(
+		BuildServiceCancelBuildProcedure,
+		svc.CancelBuild,
+		connect.WithSchema(buildServiceMethods.ByName("CancelBuild")),
+		connect.WithHandlerOptions(opts...),
+	)
 	buildServiceStartBuildWorkflowHandler := connect.NewUnaryHandler(
 		BuildServiceStartBuildWorkflowProcedure,
 		svc.StartBuildWorkflow,
@@ -249,6 +274,8 @@ func NewBuildServiceHandler(svc BuildServiceHandler, opts ...connect.HandlerOpti
 			buildServiceBuildImageHandler.ServeHTTP(w, r)
 		case BuildServiceStreamBuildLogsProcedure:
 			buildServiceStreamBuildLogsHandler.ServeHTTP(w, r)
+		case BuildServiceCancelBuildProcedure:
+			buildServiceCancelBuildHandler.ServeHTTP(w, r)
 		case BuildServiceStartBuildWorkflowProcedure:
 			buildServiceStartBuildWorkflowHandler.ServeHTTP(w, r)
 		case BuildServiceGetBuildProgressProcedure:
@@ -278,6 +305,10 @@ func (UnimplementedBuildServiceHandler) StreamBuildLogs(context.Context, *connec
 	return connect.NewError(connect.CodeUnimplemented, errors.New("idp.build.v1.BuildService.StreamBuildLogs is not implemented"))
 }
 
+func (UnimplementedBuildServiceHandler) CancelBuild(context.Context, *connect.Request[v1.CancelBuildRequest]) (*connect.Response[v1.CancelBuildResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.build.v1.BuildService.CancelBuild is not implemented"))
+}
+
 func (UnimplementedBuildServiceHandler) StartBuildWorkflow(context.Context, *connect.Request[v1.StartBuildWorkflowRequest]) (*connect.Response[v1.StartBuildWorkflowResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.build.v1.BuildService.StartBuildWorkflow is not implemented"))
 }
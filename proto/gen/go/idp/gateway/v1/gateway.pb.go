@@ -79,6 +79,59 @@ func (PermissionTemplate) EnumDescriptor() ([]byte, []int) {
 	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{0}
 }
 
+// SaslMechanism is the SASL mechanism a credential authenticates with.
+type SaslMechanism int32
+
+const (
+	SaslMechanism_SASL_MECHANISM_UNSPECIFIED   SaslMechanism = 0 // Treated as PLAIN
+	SaslMechanism_SASL_MECHANISM_PLAIN         SaslMechanism = 1
+	SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256 SaslMechanism = 2
+	SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512 SaslMechanism = 3
+)
+
+// Enum value maps for SaslMechanism.
+var (
+	SaslMechanism_name = map[int32]string{
+		0: "SASL_MECHANISM_UNSPECIFIED",
+		1: "SASL_MECHANISM_PLAIN",
+		2: "SASL_MECHANISM_SCRAM_SHA_256",
+		3: "SASL_MECHANISM_SCRAM_SHA_512",
+	}
+	SaslMechanism_value = map[string]int32{
+		"SASL_MECHANISM_UNSPECIFIED":   0,
+		"SASL_MECHANISM_PLAIN":         1,
+		"SASL_MECHANISM_SCRAM_SHA_256": 2,
+		"SASL_MECHANISM_SCRAM_SHA_512": 3,
+	}
+)
+
+func (x SaslMechanism) Enum() *SaslMechanism {
+	p := new(SaslMechanism)
+	*p = x
+	return p
+}
+
+func (x SaslMechanism) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SaslMechanism) Descriptor() protoreflect.EnumDescriptor {
+	return file_idp_gateway_v1_gateway_proto_enumTypes[1].Descriptor()
+}
+
+func (SaslMechanism) Type() protoreflect.EnumType {
+	return &file_idp_gateway_v1_gateway_proto_enumTypes[1]
+}
+
+func (x SaslMechanism) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SaslMechanism.Descriptor instead.
+func (SaslMechanism) EnumDescriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
+}
+
 type ConsumerGroupState int32
 
 const (
@@ -121,11 +174,11 @@ func (x ConsumerGroupState) String() string {
 }
 
 func (ConsumerGroupState) Descriptor() protoreflect.EnumDescriptor {
-	return file_idp_gateway_v1_gateway_proto_enumTypes[1].Descriptor()
+	return file_idp_gateway_v1_gateway_proto_enumTypes[2].Descriptor()
 }
 
 func (ConsumerGroupState) Type() protoreflect.EnumType {
-	return &file_idp_gateway_v1_gateway_proto_enumTypes[1]
+	return &file_idp_gateway_v1_gateway_proto_enumTypes[2]
 }
 
 func (x ConsumerGroupState) Number() protoreflect.EnumNumber {
@@ -134,7 +187,7 @@ func (x ConsumerGroupState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConsumerGroupState.Descriptor instead.
 func (ConsumerGroupState) EnumDescriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
 }
 
 type OffsetResetType int32
@@ -173,11 +226,11 @@ func (x OffsetResetType) String() string {
 }
 
 func (OffsetResetType) Descriptor() protoreflect.EnumDescriptor {
-	return file_idp_gateway_v1_gateway_proto_enumTypes[2].Descriptor()
+	return file_idp_gateway_v1_gateway_proto_enumTypes[3].Descriptor()
 }
 
 func (OffsetResetType) Type() protoreflect.EnumType {
-	return &file_idp_gateway_v1_gateway_proto_enumTypes[2]
+	return &file_idp_gateway_v1_gateway_proto_enumTypes[3]
 }
 
 func (x OffsetResetType) Number() protoreflect.EnumNumber {
@@ -186,7 +239,61 @@ func (x OffsetResetType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OffsetResetType.Descriptor instead.
 func (OffsetResetType) EnumDescriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+// ImportConflictPolicy decides what happens when an imported virtual cluster
+// or credential ID already exists on the gateway.
+type ImportConflictPolicy int32
+
+const (
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED ImportConflictPolicy = 0 // Treated as FAIL
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL        ImportConflictPolicy = 1 // Reject the whole batch
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP        ImportConflictPolicy = 2 // Keep the existing entry
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE   ImportConflictPolicy = 3 // Replace the existing entry
+)
+
+// Enum value maps for ImportConflictPolicy.
+var (
+	ImportConflictPolicy_name = map[int32]string{
+		0: "IMPORT_CONFLICT_POLICY_UNSPECIFIED",
+		1: "IMPORT_CONFLICT_POLICY_FAIL",
+		2: "IMPORT_CONFLICT_POLICY_SKIP",
+		3: "IMPORT_CONFLICT_POLICY_OVERWRITE",
+	}
+	ImportConflictPolicy_value = map[string]int32{
+		"IMPORT_CONFLICT_POLICY_UNSPECIFIED": 0,
+		"IMPORT_CONFLICT_POLICY_FAIL":        1,
+		"IMPORT_CONFLICT_POLICY_SKIP":        2,
+		"IMPORT_CONFLICT_POLICY_OVERWRITE":   3,
+	}
+)
+
+func (x ImportConflictPolicy) Enum() *ImportConflictPolicy {
+	p := new(ImportConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ImportConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_idp_gateway_v1_gateway_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportConflictPolicy) Type() protoreflect.EnumType {
+	return &file_idp_gateway_v1_gateway_proto_enumTypes[4]
+}
+
+func (x ImportConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportConflictPolicy.Descriptor instead.
+func (ImportConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
 }
 
 type VirtualClusterConfig struct {
@@ -203,6 +310,11 @@ type VirtualClusterConfig struct {
 	AdvertisedPort           int32                  `protobuf:"varint,10,opt,name=advertised_port,json=advertisedPort,proto3" json:"advertised_port,omitempty"`
 	PhysicalBootstrapServers string                 `protobuf:"bytes,11,opt,name=physical_bootstrap_servers,json=physicalBootstrapServers,proto3" json:"physical_bootstrap_servers,omitempty"`
 	ReadOnly                 bool                   `protobuf:"varint,12,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	MaxRequestsPerSec        int32                  `protobuf:"varint,13,opt,name=max_requests_per_sec,json=maxRequestsPerSec,proto3" json:"max_requests_per_sec,omitempty"`                                                                                               // 0 disables request rate limiting
+	MaxBytesPerSec           int64                  `protobuf:"varint,14,opt,name=max_bytes_per_sec,json=maxBytesPerSec,proto3" json:"max_bytes_per_sec,omitempty"`                                                                                                        // 0 disables bandwidth limiting
+	AllowedTopics            []string               `protobuf:"bytes,15,rep,name=allowed_topics,json=allowedTopics,proto3" json:"allowed_topics,omitempty"`                                                                                                                // Glob patterns; empty allows every topic
+	DeniedTopics             []string               `protobuf:"bytes,16,rep,name=denied_topics,json=deniedTopics,proto3" json:"denied_topics,omitempty"`                                                                                                                   // Glob patterns; take precedence over allowed_topics
+	TopicProduceBytesPerSec  map[string]int64       `protobuf:"bytes,17,rep,name=topic_produce_bytes_per_sec,json=topicProduceBytesPerSec,proto3" json:"topic_produce_bytes_per_sec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Topic glob pattern -> produce bytes/sec; the lowest matching budget applies
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -321,6 +433,41 @@ func (x *VirtualClusterConfig) GetReadOnly() bool {
 	return false
 }
 
+func (x *VirtualClusterConfig) GetMaxRequestsPerSec() int32 {
+	if x != nil {
+		return x.MaxRequestsPerSec
+	}
+	return 0
+}
+
+func (x *VirtualClusterConfig) GetMaxBytesPerSec() int64 {
+	if x != nil {
+		return x.MaxBytesPerSec
+	}
+	return 0
+}
+
+func (x *VirtualClusterConfig) GetAllowedTopics() []string {
+	if x != nil {
+		return x.AllowedTopics
+	}
+	return nil
+}
+
+func (x *VirtualClusterConfig) GetDeniedTopics() []string {
+	if x != nil {
+		return x.DeniedTopics
+	}
+	return nil
+}
+
+func (x *VirtualClusterConfig) GetTopicProduceBytesPerSec() map[string]int64 {
+	if x != nil {
+		return x.TopicProduceBytesPerSec
+	}
+	return nil
+}
+
 type UpsertVirtualClusterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        *VirtualClusterConfig  `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
@@ -734,11 +881,12 @@ func (*GetStatusRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetStatusResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Status              string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ActiveConnections   int32                  `protobuf:"varint,2,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
-	VirtualClusterCount int32                  `protobuf:"varint,3,opt,name=virtual_cluster_count,json=virtualClusterCount,proto3" json:"virtual_cluster_count,omitempty"`
-	VersionInfo         map[string]string      `protobuf:"bytes,4,rep,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Status              string                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveConnections   int32                   `protobuf:"varint,2,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	VirtualClusterCount int32                   `protobuf:"varint,3,opt,name=virtual_cluster_count,json=virtualClusterCount,proto3" json:"virtual_cluster_count,omitempty"`
+	VersionInfo         map[string]string       `protobuf:"bytes,4,rep,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	VirtualClusters     []*VirtualClusterStatus `protobuf:"bytes,5,rep,name=virtual_clusters,json=virtualClusters,proto3" json:"virtual_clusters,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -801,6 +949,89 @@ func (x *GetStatusResponse) GetVersionInfo() map[string]string {
 	return nil
 }
 
+func (x *GetStatusResponse) GetVirtualClusters() []*VirtualClusterStatus {
+	if x != nil {
+		return x.VirtualClusters
+	}
+	return nil
+}
+
+type VirtualClusterStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VirtualClusterId string                 `protobuf:"bytes,1,opt,name=virtual_cluster_id,json=virtualClusterId,proto3" json:"virtual_cluster_id,omitempty"`
+	BackendReachable bool                   `protobuf:"varint,2,opt,name=backend_reachable,json=backendReachable,proto3" json:"backend_reachable,omitempty"`
+	TopicCount       int32                  `protobuf:"varint,3,opt,name=topic_count,json=topicCount,proto3" json:"topic_count,omitempty"` // Topics under the virtual cluster's prefix
+	GroupCount       int32                  `protobuf:"varint,4,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"` // Consumer groups under the virtual cluster's prefix
+	Error            string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                              // Why the backend could not be queried
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VirtualClusterStatus) Reset() {
+	*x = VirtualClusterStatus{}
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualClusterStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualClusterStatus) ProtoMessage() {}
+
+func (x *VirtualClusterStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualClusterStatus.ProtoReflect.Descriptor instead.
+func (*VirtualClusterStatus) Descriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VirtualClusterStatus) GetVirtualClusterId() string {
+	if x != nil {
+		return x.VirtualClusterId
+	}
+	return ""
+}
+
+func (x *VirtualClusterStatus) GetBackendReachable() bool {
+	if x != nil {
+		return x.BackendReachable
+	}
+	return false
+}
+
+func (x *VirtualClusterStatus) GetTopicCount() int32 {
+	if x != nil {
+		return x.TopicCount
+	}
+	return 0
+}
+
+func (x *VirtualClusterStatus) GetGroupCount() int32 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+func (x *VirtualClusterStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type ListVirtualClustersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -809,7 +1040,7 @@ type ListVirtualClustersRequest struct {
 
 func (x *ListVirtualClustersRequest) Reset() {
 	*x = ListVirtualClustersRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[11]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -821,7 +1052,7 @@ func (x *ListVirtualClustersRequest) String() string {
 func (*ListVirtualClustersRequest) ProtoMessage() {}
 
 func (x *ListVirtualClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[11]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1065,7 @@ func (x *ListVirtualClustersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVirtualClustersRequest.ProtoReflect.Descriptor instead.
 func (*ListVirtualClustersRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
 }
 
 type ListVirtualClustersResponse struct {
@@ -846,7 +1077,7 @@ type ListVirtualClustersResponse struct {
 
 func (x *ListVirtualClustersResponse) Reset() {
 	*x = ListVirtualClustersResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[12]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1089,7 @@ func (x *ListVirtualClustersResponse) String() string {
 func (*ListVirtualClustersResponse) ProtoMessage() {}
 
 func (x *ListVirtualClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[12]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1102,7 @@ func (x *ListVirtualClustersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVirtualClustersResponse.ProtoReflect.Descriptor instead.
 func (*ListVirtualClustersResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListVirtualClustersResponse) GetVirtualClusters() []*VirtualClusterConfig {
@@ -892,7 +1123,7 @@ type CustomPermission struct {
 
 func (x *CustomPermission) Reset() {
 	*x = CustomPermission{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[13]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -904,7 +1135,7 @@ func (x *CustomPermission) String() string {
 func (*CustomPermission) ProtoMessage() {}
 
 func (x *CustomPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[13]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1148,7 @@ func (x *CustomPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomPermission.ProtoReflect.Descriptor instead.
 func (*CustomPermission) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CustomPermission) GetResourceType() string {
@@ -941,6 +1172,76 @@ func (x *CustomPermission) GetOperations() []string {
 	return nil
 }
 
+// ScramCredential holds the salted SCRAM secrets (RFC 5802) derived from a
+// credential's password, so the gateway never sees the password itself.
+type ScramCredential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Salt          []byte                 `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	Iterations    int32                  `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	StoredKey     []byte                 `protobuf:"bytes,3,opt,name=stored_key,json=storedKey,proto3" json:"stored_key,omitempty"`
+	ServerKey     []byte                 `protobuf:"bytes,4,opt,name=server_key,json=serverKey,proto3" json:"server_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScramCredential) Reset() {
+	*x = ScramCredential{}
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScramCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScramCredential) ProtoMessage() {}
+
+func (x *ScramCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScramCredential.ProtoReflect.Descriptor instead.
+func (*ScramCredential) Descriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScramCredential) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *ScramCredential) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *ScramCredential) GetStoredKey() []byte {
+	if x != nil {
+		return x.StoredKey
+	}
+	return nil
+}
+
+func (x *ScramCredential) GetServerKey() []byte {
+	if x != nil {
+		return x.ServerKey
+	}
+	return nil
+}
+
 type CredentialConfig struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -949,13 +1250,15 @@ type CredentialConfig struct {
 	PasswordHash      string                 `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
 	Template          PermissionTemplate     `protobuf:"varint,5,opt,name=template,proto3,enum=idp.gateway.v1.PermissionTemplate" json:"template,omitempty"`
 	CustomPermissions []*CustomPermission    `protobuf:"bytes,6,rep,name=custom_permissions,json=customPermissions,proto3" json:"custom_permissions,omitempty"`
+	Mechanism         SaslMechanism          `protobuf:"varint,7,opt,name=mechanism,proto3,enum=idp.gateway.v1.SaslMechanism" json:"mechanism,omitempty"`
+	Scram             *ScramCredential       `protobuf:"bytes,8,opt,name=scram,proto3" json:"scram,omitempty"` // Required for SCRAM mechanisms
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CredentialConfig) Reset() {
 	*x = CredentialConfig{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[14]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -967,7 +1270,7 @@ func (x *CredentialConfig) String() string {
 func (*CredentialConfig) ProtoMessage() {}
 
 func (x *CredentialConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[14]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1283,7 @@ func (x *CredentialConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CredentialConfig.ProtoReflect.Descriptor instead.
 func (*CredentialConfig) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CredentialConfig) GetId() string {
@@ -1025,6 +1328,20 @@ func (x *CredentialConfig) GetCustomPermissions() []*CustomPermission {
 	return nil
 }
 
+func (x *CredentialConfig) GetMechanism() SaslMechanism {
+	if x != nil {
+		return x.Mechanism
+	}
+	return SaslMechanism_SASL_MECHANISM_UNSPECIFIED
+}
+
+func (x *CredentialConfig) GetScram() *ScramCredential {
+	if x != nil {
+		return x.Scram
+	}
+	return nil
+}
+
 type UpsertCredentialRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        *CredentialConfig      `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
@@ -1034,7 +1351,7 @@ type UpsertCredentialRequest struct {
 
 func (x *UpsertCredentialRequest) Reset() {
 	*x = UpsertCredentialRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[15]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1363,7 @@ func (x *UpsertCredentialRequest) String() string {
 func (*UpsertCredentialRequest) ProtoMessage() {}
 
 func (x *UpsertCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[15]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1376,7 @@ func (x *UpsertCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertCredentialRequest.ProtoReflect.Descriptor instead.
 func (*UpsertCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpsertCredentialRequest) GetConfig() *CredentialConfig {
@@ -1078,7 +1395,7 @@ type UpsertCredentialResponse struct {
 
 func (x *UpsertCredentialResponse) Reset() {
 	*x = UpsertCredentialResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[16]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1090,7 +1407,7 @@ func (x *UpsertCredentialResponse) String() string {
 func (*UpsertCredentialResponse) ProtoMessage() {}
 
 func (x *UpsertCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[16]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1420,7 @@ func (x *UpsertCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertCredentialResponse.ProtoReflect.Descriptor instead.
 func (*UpsertCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{16}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpsertCredentialResponse) GetSuccess() bool {
@@ -1122,7 +1439,7 @@ type RevokeCredentialRequest struct {
 
 func (x *RevokeCredentialRequest) Reset() {
 	*x = RevokeCredentialRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[17]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1134,7 +1451,7 @@ func (x *RevokeCredentialRequest) String() string {
 func (*RevokeCredentialRequest) ProtoMessage() {}
 
 func (x *RevokeCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[17]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1464,7 @@ func (x *RevokeCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeCredentialRequest.ProtoReflect.Descriptor instead.
 func (*RevokeCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{17}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RevokeCredentialRequest) GetCredentialId() string {
@@ -1166,7 +1483,7 @@ type RevokeCredentialResponse struct {
 
 func (x *RevokeCredentialResponse) Reset() {
 	*x = RevokeCredentialResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[18]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1178,7 +1495,7 @@ func (x *RevokeCredentialResponse) String() string {
 func (*RevokeCredentialResponse) ProtoMessage() {}
 
 func (x *RevokeCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[18]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,7 +1508,7 @@ func (x *RevokeCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeCredentialResponse.ProtoReflect.Descriptor instead.
 func (*RevokeCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{18}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RevokeCredentialResponse) GetSuccess() bool {
@@ -1210,7 +1527,7 @@ type ListCredentialsRequest struct {
 
 func (x *ListCredentialsRequest) Reset() {
 	*x = ListCredentialsRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[19]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1222,7 +1539,7 @@ func (x *ListCredentialsRequest) String() string {
 func (*ListCredentialsRequest) ProtoMessage() {}
 
 func (x *ListCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[19]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,7 +1552,7 @@ func (x *ListCredentialsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCredentialsRequest.ProtoReflect.Descriptor instead.
 func (*ListCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{19}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListCredentialsRequest) GetVirtualClusterId() string {
@@ -1254,7 +1571,7 @@ type ListCredentialsResponse struct {
 
 func (x *ListCredentialsResponse) Reset() {
 	*x = ListCredentialsResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[20]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1266,7 +1583,7 @@ func (x *ListCredentialsResponse) String() string {
 func (*ListCredentialsResponse) ProtoMessage() {}
 
 func (x *ListCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[20]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,7 +1596,7 @@ func (x *ListCredentialsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCredentialsResponse.ProtoReflect.Descriptor instead.
 func (*ListCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{20}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListCredentialsResponse) GetCredentials() []*CredentialConfig {
@@ -1306,7 +1623,7 @@ type PolicyConfig struct {
 
 func (x *PolicyConfig) Reset() {
 	*x = PolicyConfig{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[21]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +1635,7 @@ func (x *PolicyConfig) String() string {
 func (*PolicyConfig) ProtoMessage() {}
 
 func (x *PolicyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[21]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +1648,7 @@ func (x *PolicyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyConfig.ProtoReflect.Descriptor instead.
 func (*PolicyConfig) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{21}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PolicyConfig) GetId() string {
@@ -1406,7 +1723,7 @@ type UpsertPolicyRequest struct {
 
 func (x *UpsertPolicyRequest) Reset() {
 	*x = UpsertPolicyRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[22]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +1735,7 @@ func (x *UpsertPolicyRequest) String() string {
 func (*UpsertPolicyRequest) ProtoMessage() {}
 
 func (x *UpsertPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[22]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1748,7 @@ func (x *UpsertPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertPolicyRequest.ProtoReflect.Descriptor instead.
 func (*UpsertPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{22}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpsertPolicyRequest) GetConfig() *PolicyConfig {
@@ -1450,7 +1767,7 @@ type UpsertPolicyResponse struct {
 
 func (x *UpsertPolicyResponse) Reset() {
 	*x = UpsertPolicyResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[23]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1462,7 +1779,7 @@ func (x *UpsertPolicyResponse) String() string {
 func (*UpsertPolicyResponse) ProtoMessage() {}
 
 func (x *UpsertPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[23]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +1792,7 @@ func (x *UpsertPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertPolicyResponse.ProtoReflect.Descriptor instead.
 func (*UpsertPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{23}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpsertPolicyResponse) GetSuccess() bool {
@@ -1494,7 +1811,7 @@ type DeletePolicyRequest struct {
 
 func (x *DeletePolicyRequest) Reset() {
 	*x = DeletePolicyRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[24]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +1823,7 @@ func (x *DeletePolicyRequest) String() string {
 func (*DeletePolicyRequest) ProtoMessage() {}
 
 func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[24]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1836,7 @@ func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePolicyRequest.ProtoReflect.Descriptor instead.
 func (*DeletePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{24}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeletePolicyRequest) GetPolicyId() string {
@@ -1538,7 +1855,7 @@ type DeletePolicyResponse struct {
 
 func (x *DeletePolicyResponse) Reset() {
 	*x = DeletePolicyResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[25]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1550,7 +1867,7 @@ func (x *DeletePolicyResponse) String() string {
 func (*DeletePolicyResponse) ProtoMessage() {}
 
 func (x *DeletePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[25]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,7 +1880,7 @@ func (x *DeletePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePolicyResponse.ProtoReflect.Descriptor instead.
 func (*DeletePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{25}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeletePolicyResponse) GetSuccess() bool {
@@ -1582,7 +1899,7 @@ type ListPoliciesRequest struct {
 
 func (x *ListPoliciesRequest) Reset() {
 	*x = ListPoliciesRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[26]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1594,7 +1911,7 @@ func (x *ListPoliciesRequest) String() string {
 func (*ListPoliciesRequest) ProtoMessage() {}
 
 func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[26]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +1924,7 @@ func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoliciesRequest.ProtoReflect.Descriptor instead.
 func (*ListPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{26}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListPoliciesRequest) GetEnvironment() string {
@@ -1626,7 +1943,7 @@ type ListPoliciesResponse struct {
 
 func (x *ListPoliciesResponse) Reset() {
 	*x = ListPoliciesResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[27]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1638,7 +1955,7 @@ func (x *ListPoliciesResponse) String() string {
 func (*ListPoliciesResponse) ProtoMessage() {}
 
 func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[27]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +1968,7 @@ func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
 func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{27}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ListPoliciesResponse) GetPolicies() []*PolicyConfig {
@@ -1674,7 +1991,7 @@ type TopicACLEntry struct {
 
 func (x *TopicACLEntry) Reset() {
 	*x = TopicACLEntry{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[28]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1686,7 +2003,7 @@ func (x *TopicACLEntry) String() string {
 func (*TopicACLEntry) ProtoMessage() {}
 
 func (x *TopicACLEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[28]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2016,7 @@ func (x *TopicACLEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicACLEntry.ProtoReflect.Descriptor instead.
 func (*TopicACLEntry) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{28}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TopicACLEntry) GetId() string {
@@ -1746,7 +2063,7 @@ type UpsertTopicACLRequest struct {
 
 func (x *UpsertTopicACLRequest) Reset() {
 	*x = UpsertTopicACLRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[29]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1758,7 +2075,7 @@ func (x *UpsertTopicACLRequest) String() string {
 func (*UpsertTopicACLRequest) ProtoMessage() {}
 
 func (x *UpsertTopicACLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[29]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,7 +2088,7 @@ func (x *UpsertTopicACLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertTopicACLRequest.ProtoReflect.Descriptor instead.
 func (*UpsertTopicACLRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{29}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpsertTopicACLRequest) GetEntry() *TopicACLEntry {
@@ -1790,7 +2107,7 @@ type UpsertTopicACLResponse struct {
 
 func (x *UpsertTopicACLResponse) Reset() {
 	*x = UpsertTopicACLResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[30]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1802,7 +2119,7 @@ func (x *UpsertTopicACLResponse) String() string {
 func (*UpsertTopicACLResponse) ProtoMessage() {}
 
 func (x *UpsertTopicACLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[30]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1815,7 +2132,7 @@ func (x *UpsertTopicACLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertTopicACLResponse.ProtoReflect.Descriptor instead.
 func (*UpsertTopicACLResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{30}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpsertTopicACLResponse) GetSuccess() bool {
@@ -1834,7 +2151,7 @@ type RevokeTopicACLRequest struct {
 
 func (x *RevokeTopicACLRequest) Reset() {
 	*x = RevokeTopicACLRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[31]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1846,7 +2163,7 @@ func (x *RevokeTopicACLRequest) String() string {
 func (*RevokeTopicACLRequest) ProtoMessage() {}
 
 func (x *RevokeTopicACLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[31]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,7 +2176,7 @@ func (x *RevokeTopicACLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeTopicACLRequest.ProtoReflect.Descriptor instead.
 func (*RevokeTopicACLRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{31}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RevokeTopicACLRequest) GetAclId() string {
@@ -1878,7 +2195,7 @@ type RevokeTopicACLResponse struct {
 
 func (x *RevokeTopicACLResponse) Reset() {
 	*x = RevokeTopicACLResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[32]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +2207,7 @@ func (x *RevokeTopicACLResponse) String() string {
 func (*RevokeTopicACLResponse) ProtoMessage() {}
 
 func (x *RevokeTopicACLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[32]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2220,7 @@ func (x *RevokeTopicACLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeTopicACLResponse.ProtoReflect.Descriptor instead.
 func (*RevokeTopicACLResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{32}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RevokeTopicACLResponse) GetSuccess() bool {
@@ -1922,7 +2239,7 @@ type ListTopicACLsRequest struct {
 
 func (x *ListTopicACLsRequest) Reset() {
 	*x = ListTopicACLsRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[33]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1934,7 +2251,7 @@ func (x *ListTopicACLsRequest) String() string {
 func (*ListTopicACLsRequest) ProtoMessage() {}
 
 func (x *ListTopicACLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[33]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +2264,7 @@ func (x *ListTopicACLsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTopicACLsRequest.ProtoReflect.Descriptor instead.
 func (*ListTopicACLsRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{33}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListTopicACLsRequest) GetCredentialId() string {
@@ -1966,7 +2283,7 @@ type ListTopicACLsResponse struct {
 
 func (x *ListTopicACLsResponse) Reset() {
 	*x = ListTopicACLsResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[34]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1978,7 +2295,7 @@ func (x *ListTopicACLsResponse) String() string {
 func (*ListTopicACLsResponse) ProtoMessage() {}
 
 func (x *ListTopicACLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[34]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,7 +2308,7 @@ func (x *ListTopicACLsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTopicACLsResponse.ProtoReflect.Descriptor instead.
 func (*ListTopicACLsResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{34}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListTopicACLsResponse) GetEntries() []*TopicACLEntry {
@@ -2016,7 +2333,7 @@ type TopicCreatedRequest struct {
 
 func (x *TopicCreatedRequest) Reset() {
 	*x = TopicCreatedRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[35]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2028,7 +2345,7 @@ func (x *TopicCreatedRequest) String() string {
 func (*TopicCreatedRequest) ProtoMessage() {}
 
 func (x *TopicCreatedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[35]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2358,7 @@ func (x *TopicCreatedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicCreatedRequest.ProtoReflect.Descriptor instead.
 func (*TopicCreatedRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{35}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TopicCreatedRequest) GetVirtualClusterId() string {
@@ -2103,7 +2420,7 @@ type TopicCreatedResponse struct {
 
 func (x *TopicCreatedResponse) Reset() {
 	*x = TopicCreatedResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[36]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2115,7 +2432,7 @@ func (x *TopicCreatedResponse) String() string {
 func (*TopicCreatedResponse) ProtoMessage() {}
 
 func (x *TopicCreatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[36]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,7 +2445,7 @@ func (x *TopicCreatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicCreatedResponse.ProtoReflect.Descriptor instead.
 func (*TopicCreatedResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{36}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *TopicCreatedResponse) GetSuccess() bool {
@@ -2157,7 +2474,7 @@ type TopicDeletedRequest struct {
 
 func (x *TopicDeletedRequest) Reset() {
 	*x = TopicDeletedRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[37]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2169,7 +2486,7 @@ func (x *TopicDeletedRequest) String() string {
 func (*TopicDeletedRequest) ProtoMessage() {}
 
 func (x *TopicDeletedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[37]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2182,7 +2499,7 @@ func (x *TopicDeletedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicDeletedRequest.ProtoReflect.Descriptor instead.
 func (*TopicDeletedRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{37}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *TopicDeletedRequest) GetVirtualClusterId() string {
@@ -2222,7 +2539,7 @@ type TopicDeletedResponse struct {
 
 func (x *TopicDeletedResponse) Reset() {
 	*x = TopicDeletedResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[38]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2234,7 +2551,7 @@ func (x *TopicDeletedResponse) String() string {
 func (*TopicDeletedResponse) ProtoMessage() {}
 
 func (x *TopicDeletedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[38]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,7 +2564,7 @@ func (x *TopicDeletedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicDeletedResponse.ProtoReflect.Descriptor instead.
 func (*TopicDeletedResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{38}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *TopicDeletedResponse) GetSuccess() bool {
@@ -2269,7 +2586,7 @@ type TopicConfigUpdatedRequest struct {
 
 func (x *TopicConfigUpdatedRequest) Reset() {
 	*x = TopicConfigUpdatedRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[39]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2281,7 +2598,7 @@ func (x *TopicConfigUpdatedRequest) String() string {
 func (*TopicConfigUpdatedRequest) ProtoMessage() {}
 
 func (x *TopicConfigUpdatedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[39]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,7 +2611,7 @@ func (x *TopicConfigUpdatedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicConfigUpdatedRequest.ProtoReflect.Descriptor instead.
 func (*TopicConfigUpdatedRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{39}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *TopicConfigUpdatedRequest) GetVirtualClusterId() string {
@@ -2334,7 +2651,7 @@ type TopicConfigUpdatedResponse struct {
 
 func (x *TopicConfigUpdatedResponse) Reset() {
 	*x = TopicConfigUpdatedResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[40]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2346,7 +2663,7 @@ func (x *TopicConfigUpdatedResponse) String() string {
 func (*TopicConfigUpdatedResponse) ProtoMessage() {}
 
 func (x *TopicConfigUpdatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[40]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2359,7 +2676,7 @@ func (x *TopicConfigUpdatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicConfigUpdatedResponse.ProtoReflect.Descriptor instead.
 func (*TopicConfigUpdatedResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{40}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *TopicConfigUpdatedResponse) GetSuccess() bool {
@@ -2382,7 +2699,7 @@ type PolicyViolation struct {
 
 func (x *PolicyViolation) Reset() {
 	*x = PolicyViolation{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[41]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2394,7 +2711,7 @@ func (x *PolicyViolation) String() string {
 func (*PolicyViolation) ProtoMessage() {}
 
 func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[41]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,7 +2724,7 @@ func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
 func (*PolicyViolation) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{41}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *PolicyViolation) GetField() string {
@@ -2463,7 +2780,7 @@ type ClientActivityRecord struct {
 
 func (x *ClientActivityRecord) Reset() {
 	*x = ClientActivityRecord{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[42]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2475,7 +2792,7 @@ func (x *ClientActivityRecord) String() string {
 func (*ClientActivityRecord) ProtoMessage() {}
 
 func (x *ClientActivityRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[42]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2488,7 +2805,7 @@ func (x *ClientActivityRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientActivityRecord.ProtoReflect.Descriptor instead.
 func (*ClientActivityRecord) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{42}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ClientActivityRecord) GetVirtualClusterId() string {
@@ -2564,7 +2881,7 @@ type EmitClientActivityRequest struct {
 
 func (x *EmitClientActivityRequest) Reset() {
 	*x = EmitClientActivityRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[43]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2576,7 +2893,7 @@ func (x *EmitClientActivityRequest) String() string {
 func (*EmitClientActivityRequest) ProtoMessage() {}
 
 func (x *EmitClientActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[43]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,7 +2906,7 @@ func (x *EmitClientActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitClientActivityRequest.ProtoReflect.Descriptor instead.
 func (*EmitClientActivityRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{43}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *EmitClientActivityRequest) GetRecords() []*ClientActivityRecord {
@@ -2610,7 +2927,7 @@ type EmitClientActivityResponse struct {
 
 func (x *EmitClientActivityResponse) Reset() {
 	*x = EmitClientActivityResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[44]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2622,7 +2939,7 @@ func (x *EmitClientActivityResponse) String() string {
 func (*EmitClientActivityResponse) ProtoMessage() {}
 
 func (x *EmitClientActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[44]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2635,7 +2952,7 @@ func (x *EmitClientActivityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitClientActivityResponse.ProtoReflect.Descriptor instead.
 func (*EmitClientActivityResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{44}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *EmitClientActivityResponse) GetSuccess() bool {
@@ -2665,7 +2982,7 @@ type ConsumerGroupSummary struct {
 
 func (x *ConsumerGroupSummary) Reset() {
 	*x = ConsumerGroupSummary{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[45]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2677,7 +2994,7 @@ func (x *ConsumerGroupSummary) String() string {
 func (*ConsumerGroupSummary) ProtoMessage() {}
 
 func (x *ConsumerGroupSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[45]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,7 +3007,7 @@ func (x *ConsumerGroupSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsumerGroupSummary.ProtoReflect.Descriptor instead.
 func (*ConsumerGroupSummary) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{45}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ConsumerGroupSummary) GetGroupId() string {
@@ -2742,7 +3059,7 @@ type PartitionLag struct {
 
 func (x *PartitionLag) Reset() {
 	*x = PartitionLag{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[46]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2754,7 +3071,7 @@ func (x *PartitionLag) String() string {
 func (*PartitionLag) ProtoMessage() {}
 
 func (x *PartitionLag) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[46]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2767,7 +3084,7 @@ func (x *PartitionLag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartitionLag.ProtoReflect.Descriptor instead.
 func (*PartitionLag) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{46}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *PartitionLag) GetTopic() string {
@@ -2826,7 +3143,7 @@ type ConsumerGroupDetail struct {
 
 func (x *ConsumerGroupDetail) Reset() {
 	*x = ConsumerGroupDetail{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[47]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2838,7 +3155,7 @@ func (x *ConsumerGroupDetail) String() string {
 func (*ConsumerGroupDetail) ProtoMessage() {}
 
 func (x *ConsumerGroupDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[47]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,7 +3168,7 @@ func (x *ConsumerGroupDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsumerGroupDetail.ProtoReflect.Descriptor instead.
 func (*ConsumerGroupDetail) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{47}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ConsumerGroupDetail) GetGroupId() string {
@@ -2905,7 +3222,7 @@ type ListConsumerGroupsRequest struct {
 
 func (x *ListConsumerGroupsRequest) Reset() {
 	*x = ListConsumerGroupsRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[48]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2917,7 +3234,7 @@ func (x *ListConsumerGroupsRequest) String() string {
 func (*ListConsumerGroupsRequest) ProtoMessage() {}
 
 func (x *ListConsumerGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[48]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2930,7 +3247,7 @@ func (x *ListConsumerGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConsumerGroupsRequest.ProtoReflect.Descriptor instead.
 func (*ListConsumerGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{48}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListConsumerGroupsRequest) GetVirtualClusterId() string {
@@ -2950,7 +3267,7 @@ type ListConsumerGroupsResponse struct {
 
 func (x *ListConsumerGroupsResponse) Reset() {
 	*x = ListConsumerGroupsResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[49]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2962,7 +3279,7 @@ func (x *ListConsumerGroupsResponse) String() string {
 func (*ListConsumerGroupsResponse) ProtoMessage() {}
 
 func (x *ListConsumerGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[49]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2975,7 +3292,7 @@ func (x *ListConsumerGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConsumerGroupsResponse.ProtoReflect.Descriptor instead.
 func (*ListConsumerGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{49}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListConsumerGroupsResponse) GetGroups() []*ConsumerGroupSummary {
@@ -3002,7 +3319,7 @@ type DescribeConsumerGroupRequest struct {
 
 func (x *DescribeConsumerGroupRequest) Reset() {
 	*x = DescribeConsumerGroupRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[50]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +3331,7 @@ func (x *DescribeConsumerGroupRequest) String() string {
 func (*DescribeConsumerGroupRequest) ProtoMessage() {}
 
 func (x *DescribeConsumerGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[50]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,7 +3344,7 @@ func (x *DescribeConsumerGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeConsumerGroupRequest.ProtoReflect.Descriptor instead.
 func (*DescribeConsumerGroupRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{50}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *DescribeConsumerGroupRequest) GetVirtualClusterId() string {
@@ -3054,7 +3371,7 @@ type DescribeConsumerGroupResponse struct {
 
 func (x *DescribeConsumerGroupResponse) Reset() {
 	*x = DescribeConsumerGroupResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[51]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3066,7 +3383,7 @@ func (x *DescribeConsumerGroupResponse) String() string {
 func (*DescribeConsumerGroupResponse) ProtoMessage() {}
 
 func (x *DescribeConsumerGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[51]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3079,7 +3396,7 @@ func (x *DescribeConsumerGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeConsumerGroupResponse.ProtoReflect.Descriptor instead.
 func (*DescribeConsumerGroupResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{51}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DescribeConsumerGroupResponse) GetGroup() *ConsumerGroupDetail {
@@ -3109,7 +3426,7 @@ type ResetConsumerGroupOffsetsRequest struct {
 
 func (x *ResetConsumerGroupOffsetsRequest) Reset() {
 	*x = ResetConsumerGroupOffsetsRequest{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[52]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3121,7 +3438,7 @@ func (x *ResetConsumerGroupOffsetsRequest) String() string {
 func (*ResetConsumerGroupOffsetsRequest) ProtoMessage() {}
 
 func (x *ResetConsumerGroupOffsetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[52]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +3451,7 @@ func (x *ResetConsumerGroupOffsetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetConsumerGroupOffsetsRequest.ProtoReflect.Descriptor instead.
 func (*ResetConsumerGroupOffsetsRequest) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{52}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ResetConsumerGroupOffsetsRequest) GetVirtualClusterId() string {
@@ -3183,7 +3500,7 @@ type ResetConsumerGroupOffsetsResponse struct {
 
 func (x *ResetConsumerGroupOffsetsResponse) Reset() {
 	*x = ResetConsumerGroupOffsetsResponse{}
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[53]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3195,7 +3512,7 @@ func (x *ResetConsumerGroupOffsetsResponse) String() string {
 func (*ResetConsumerGroupOffsetsResponse) ProtoMessage() {}
 
 func (x *ResetConsumerGroupOffsetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[53]
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3208,7 +3525,7 @@ func (x *ResetConsumerGroupOffsetsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResetConsumerGroupOffsetsResponse.ProtoReflect.Descriptor instead.
 func (*ResetConsumerGroupOffsetsResponse) Descriptor() ([]byte, []int) {
-	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{53}
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ResetConsumerGroupOffsetsResponse) GetSuccess() bool {
@@ -3232,11 +3549,243 @@ func (x *ResetConsumerGroupOffsetsResponse) GetNewOffsets() []*PartitionLag {
 	return nil
 }
 
+type ExportConfigRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeSecrets bool                   `protobuf:"varint,1,opt,name=include_secrets,json=includeSecrets,proto3" json:"include_secrets,omitempty"` // Include password hashes and SCRAM secrets
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportConfigRequest) Reset() {
+	*x = ExportConfigRequest{}
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigRequest) ProtoMessage() {}
+
+func (x *ExportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExportConfigRequest) GetIncludeSecrets() bool {
+	if x != nil {
+		return x.IncludeSecrets
+	}
+	return false
+}
+
+type ExportConfigResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	VirtualClusters []*VirtualClusterConfig `protobuf:"bytes,1,rep,name=virtual_clusters,json=virtualClusters,proto3" json:"virtual_clusters,omitempty"`
+	Credentials     []*CredentialConfig     `protobuf:"bytes,2,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	ExportedAt      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportConfigResponse) Reset() {
+	*x = ExportConfigResponse{}
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigResponse) ProtoMessage() {}
+
+func (x *ExportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportConfigResponse) GetVirtualClusters() []*VirtualClusterConfig {
+	if x != nil {
+		return x.VirtualClusters
+	}
+	return nil
+}
+
+func (x *ExportConfigResponse) GetCredentials() []*CredentialConfig {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ExportConfigResponse) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+type ImportConfigRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	VirtualClusters []*VirtualClusterConfig `protobuf:"bytes,1,rep,name=virtual_clusters,json=virtualClusters,proto3" json:"virtual_clusters,omitempty"`
+	Credentials     []*CredentialConfig     `protobuf:"bytes,2,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	ConflictPolicy  ImportConflictPolicy    `protobuf:"varint,3,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=idp.gateway.v1.ImportConflictPolicy" json:"conflict_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportConfigRequest) Reset() {
+	*x = ImportConfigRequest{}
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigRequest) ProtoMessage() {}
+
+func (x *ImportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ImportConfigRequest) GetVirtualClusters() []*VirtualClusterConfig {
+	if x != nil {
+		return x.VirtualClusters
+	}
+	return nil
+}
+
+func (x *ImportConfigRequest) GetCredentials() []*CredentialConfig {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ImportConfigRequest) GetConflictPolicy() ImportConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED
+}
+
+type ImportConfigResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	VirtualClustersImported int32                  `protobuf:"varint,1,opt,name=virtual_clusters_imported,json=virtualClustersImported,proto3" json:"virtual_clusters_imported,omitempty"`
+	VirtualClustersSkipped  int32                  `protobuf:"varint,2,opt,name=virtual_clusters_skipped,json=virtualClustersSkipped,proto3" json:"virtual_clusters_skipped,omitempty"`
+	CredentialsImported     int32                  `protobuf:"varint,3,opt,name=credentials_imported,json=credentialsImported,proto3" json:"credentials_imported,omitempty"`
+	CredentialsSkipped      int32                  `protobuf:"varint,4,opt,name=credentials_skipped,json=credentialsSkipped,proto3" json:"credentials_skipped,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ImportConfigResponse) Reset() {
+	*x = ImportConfigResponse{}
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigResponse) ProtoMessage() {}
+
+func (x *ImportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_gateway_v1_gateway_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ImportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_idp_gateway_v1_gateway_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportConfigResponse) GetVirtualClustersImported() int32 {
+	if x != nil {
+		return x.VirtualClustersImported
+	}
+	return 0
+}
+
+func (x *ImportConfigResponse) GetVirtualClustersSkipped() int32 {
+	if x != nil {
+		return x.VirtualClustersSkipped
+	}
+	return 0
+}
+
+func (x *ImportConfigResponse) GetCredentialsImported() int32 {
+	if x != nil {
+		return x.CredentialsImported
+	}
+	return 0
+}
+
+func (x *ImportConfigResponse) GetCredentialsSkipped() int32 {
+	if x != nil {
+		return x.CredentialsSkipped
+	}
+	return 0
+}
+
 var File_idp_gateway_v1_gateway_proto protoreflect.FileDescriptor
 
 const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\n" +
-	"\x1cidp/gateway/v1/gateway.proto\x12\x0eidp.gateway.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x03\n" +
+	"\x1cidp/gateway/v1/gateway.proto\x12\x0eidp.gateway.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x06\n" +
 	"\x14VirtualClusterConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12)\n" +
@@ -3250,7 +3799,15 @@ const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x0fadvertised_port\x18\n" +
 	" \x01(\x05R\x0eadvertisedPort\x12<\n" +
 	"\x1aphysical_bootstrap_servers\x18\v \x01(\tR\x18physicalBootstrapServers\x12\x1b\n" +
-	"\tread_only\x18\f \x01(\bR\breadOnly\"[\n" +
+	"\tread_only\x18\f \x01(\bR\breadOnly\x12/\n" +
+	"\x14max_requests_per_sec\x18\r \x01(\x05R\x11maxRequestsPerSec\x12)\n" +
+	"\x11max_bytes_per_sec\x18\x0e \x01(\x03R\x0emaxBytesPerSec\x12%\n" +
+	"\x0eallowed_topics\x18\x0f \x03(\tR\rallowedTopics\x12#\n" +
+	"\rdenied_topics\x18\x10 \x03(\tR\fdeniedTopics\x12\x7f\n" +
+	"\x1btopic_produce_bytes_per_sec\x18\x11 \x03(\v2A.idp.gateway.v1.VirtualClusterConfig.TopicProduceBytesPerSecEntryR\x17topicProduceBytesPerSec\x1aJ\n" +
+	"\x1cTopicProduceBytesPerSecEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"[\n" +
 	"\x1bUpsertVirtualClusterRequest\x12<\n" +
 	"\x06config\x18\x01 \x01(\v2$.idp.gateway.v1.VirtualClusterConfigR\x06config\"8\n" +
 	"\x1cUpsertVirtualClusterResponse\x12\x18\n" +
@@ -3271,15 +3828,24 @@ const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\bpolicies\x18\x03 \x03(\v2\x1c.idp.gateway.v1.PolicyConfigR\bpolicies\x12<\n" +
 	"\n" +
 	"topic_acls\x18\x05 \x03(\v2\x1d.idp.gateway.v1.TopicACLEntryR\ttopicAclsJ\x04\b\x04\x10\x05\"\x12\n" +
-	"\x10GetStatusRequest\"\xa5\x02\n" +
+	"\x10GetStatusRequest\"\xf6\x02\n" +
 	"\x11GetStatusResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12-\n" +
 	"\x12active_connections\x18\x02 \x01(\x05R\x11activeConnections\x122\n" +
 	"\x15virtual_cluster_count\x18\x03 \x01(\x05R\x13virtualClusterCount\x12U\n" +
-	"\fversion_info\x18\x04 \x03(\v22.idp.gateway.v1.GetStatusResponse.VersionInfoEntryR\vversionInfo\x1a>\n" +
+	"\fversion_info\x18\x04 \x03(\v22.idp.gateway.v1.GetStatusResponse.VersionInfoEntryR\vversionInfo\x12O\n" +
+	"\x10virtual_clusters\x18\x05 \x03(\v2$.idp.gateway.v1.VirtualClusterStatusR\x0fvirtualClusters\x1a>\n" +
 	"\x10VersionInfoEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1c\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc9\x01\n" +
+	"\x14VirtualClusterStatus\x12,\n" +
+	"\x12virtual_cluster_id\x18\x01 \x01(\tR\x10virtualClusterId\x12+\n" +
+	"\x11backend_reachable\x18\x02 \x01(\bR\x10backendReachable\x12\x1f\n" +
+	"\vtopic_count\x18\x03 \x01(\x05R\n" +
+	"topicCount\x12\x1f\n" +
+	"\vgroup_count\x18\x04 \x01(\x05R\n" +
+	"groupCount\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x1c\n" +
 	"\x1aListVirtualClustersRequest\"n\n" +
 	"\x1bListVirtualClustersResponse\x12O\n" +
 	"\x10virtual_clusters\x18\x01 \x03(\v2$.idp.gateway.v1.VirtualClusterConfigR\x0fvirtualClusters\"\x82\x01\n" +
@@ -3288,14 +3854,25 @@ const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x10resource_pattern\x18\x02 \x01(\tR\x0fresourcePattern\x12\x1e\n" +
 	"\n" +
 	"operations\x18\x03 \x03(\tR\n" +
-	"operations\"\xa2\x02\n" +
+	"operations\"\x83\x01\n" +
+	"\x0fScramCredential\x12\x12\n" +
+	"\x04salt\x18\x01 \x01(\fR\x04salt\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x02 \x01(\x05R\n" +
+	"iterations\x12\x1d\n" +
+	"\n" +
+	"stored_key\x18\x03 \x01(\fR\tstoredKey\x12\x1d\n" +
+	"\n" +
+	"server_key\x18\x04 \x01(\fR\tserverKey\"\x96\x03\n" +
 	"\x10CredentialConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
 	"\x12virtual_cluster_id\x18\x02 \x01(\tR\x10virtualClusterId\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12#\n" +
 	"\rpassword_hash\x18\x04 \x01(\tR\fpasswordHash\x12>\n" +
 	"\btemplate\x18\x05 \x01(\x0e2\".idp.gateway.v1.PermissionTemplateR\btemplate\x12O\n" +
-	"\x12custom_permissions\x18\x06 \x03(\v2 .idp.gateway.v1.CustomPermissionR\x11customPermissions\"S\n" +
+	"\x12custom_permissions\x18\x06 \x03(\v2 .idp.gateway.v1.CustomPermissionR\x11customPermissions\x12;\n" +
+	"\tmechanism\x18\a \x01(\x0e2\x1d.idp.gateway.v1.SaslMechanismR\tmechanism\x125\n" +
+	"\x05scram\x18\b \x01(\v2\x1f.idp.gateway.v1.ScramCredentialR\x05scram\"S\n" +
 	"\x17UpsertCredentialRequest\x128\n" +
 	"\x06config\x18\x01 \x01(\v2 .idp.gateway.v1.CredentialConfigR\x06config\"4\n" +
 	"\x18UpsertCredentialResponse\x12\x18\n" +
@@ -3452,13 +4029,34 @@ const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12=\n" +
 	"\vnew_offsets\x18\x03 \x03(\v2\x1c.idp.gateway.v1.PartitionLagR\n" +
-	"newOffsets*\xbc\x01\n" +
+	"newOffsets\">\n" +
+	"\x13ExportConfigRequest\x12'\n" +
+	"\x0finclude_secrets\x18\x01 \x01(\bR\x0eincludeSecrets\"\xe8\x01\n" +
+	"\x14ExportConfigResponse\x12O\n" +
+	"\x10virtual_clusters\x18\x01 \x03(\v2$.idp.gateway.v1.VirtualClusterConfigR\x0fvirtualClusters\x12B\n" +
+	"\vcredentials\x18\x02 \x03(\v2 .idp.gateway.v1.CredentialConfigR\vcredentials\x12;\n" +
+	"\vexported_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\"\xf9\x01\n" +
+	"\x13ImportConfigRequest\x12O\n" +
+	"\x10virtual_clusters\x18\x01 \x03(\v2$.idp.gateway.v1.VirtualClusterConfigR\x0fvirtualClusters\x12B\n" +
+	"\vcredentials\x18\x02 \x03(\v2 .idp.gateway.v1.CredentialConfigR\vcredentials\x12M\n" +
+	"\x0fconflict_policy\x18\x03 \x01(\x0e2$.idp.gateway.v1.ImportConflictPolicyR\x0econflictPolicy\"\xf0\x01\n" +
+	"\x14ImportConfigResponse\x12:\n" +
+	"\x19virtual_clusters_imported\x18\x01 \x01(\x05R\x17virtualClustersImported\x128\n" +
+	"\x18virtual_clusters_skipped\x18\x02 \x01(\x05R\x16virtualClustersSkipped\x121\n" +
+	"\x14credentials_imported\x18\x03 \x01(\x05R\x13credentialsImported\x12/\n" +
+	"\x13credentials_skipped\x18\x04 \x01(\x05R\x12credentialsSkipped*\xbc\x01\n" +
 	"\x12PermissionTemplate\x12#\n" +
 	"\x1fPERMISSION_TEMPLATE_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cPERMISSION_TEMPLATE_PRODUCER\x10\x01\x12 \n" +
 	"\x1cPERMISSION_TEMPLATE_CONSUMER\x10\x02\x12\x1d\n" +
 	"\x19PERMISSION_TEMPLATE_ADMIN\x10\x03\x12\x1e\n" +
-	"\x1aPERMISSION_TEMPLATE_CUSTOM\x10\x04*\xf7\x01\n" +
+	"\x1aPERMISSION_TEMPLATE_CUSTOM\x10\x04*\x8d\x01\n" +
+	"\rSaslMechanism\x12\x1e\n" +
+	"\x1aSASL_MECHANISM_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SASL_MECHANISM_PLAIN\x10\x01\x12 \n" +
+	"\x1cSASL_MECHANISM_SCRAM_SHA_256\x10\x02\x12 \n" +
+	"\x1cSASL_MECHANISM_SCRAM_SHA_512\x10\x03*\xf7\x01\n" +
 	"\x12ConsumerGroupState\x12$\n" +
 	" CONSUMER_GROUP_STATE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bCONSUMER_GROUP_STATE_STABLE\x10\x01\x12,\n" +
@@ -3470,7 +4068,12 @@ const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\x1dOFFSET_RESET_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aOFFSET_RESET_TYPE_EARLIEST\x10\x01\x12\x1c\n" +
 	"\x18OFFSET_RESET_TYPE_LATEST\x10\x02\x12\x1f\n" +
-	"\x1bOFFSET_RESET_TYPE_TIMESTAMP\x10\x032\xe7\x0e\n" +
+	"\x1bOFFSET_RESET_TYPE_TIMESTAMP\x10\x03*\xa6\x01\n" +
+	"\x14ImportConflictPolicy\x12&\n" +
+	"\"IMPORT_CONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bIMPORT_CONFLICT_POLICY_FAIL\x10\x01\x12\x1f\n" +
+	"\x1bIMPORT_CONFLICT_POLICY_SKIP\x10\x02\x12$\n" +
+	" IMPORT_CONFLICT_POLICY_OVERWRITE\x10\x032\x9d\x10\n" +
 	"\x13BifrostAdminService\x12q\n" +
 	"\x14UpsertVirtualCluster\x12+.idp.gateway.v1.UpsertVirtualClusterRequest\x1a,.idp.gateway.v1.UpsertVirtualClusterResponse\x12q\n" +
 	"\x14DeleteVirtualCluster\x12+.idp.gateway.v1.DeleteVirtualClusterRequest\x1a,.idp.gateway.v1.DeleteVirtualClusterResponse\x12\x80\x01\n" +
@@ -3489,7 +4092,9 @@ const file_idp_gateway_v1_gateway_proto_rawDesc = "" +
 	"\rListTopicACLs\x12$.idp.gateway.v1.ListTopicACLsRequest\x1a%.idp.gateway.v1.ListTopicACLsResponse\x12k\n" +
 	"\x12ListConsumerGroups\x12).idp.gateway.v1.ListConsumerGroupsRequest\x1a*.idp.gateway.v1.ListConsumerGroupsResponse\x12t\n" +
 	"\x15DescribeConsumerGroup\x12,.idp.gateway.v1.DescribeConsumerGroupRequest\x1a-.idp.gateway.v1.DescribeConsumerGroupResponse\x12\x80\x01\n" +
-	"\x19ResetConsumerGroupOffsets\x120.idp.gateway.v1.ResetConsumerGroupOffsetsRequest\x1a1.idp.gateway.v1.ResetConsumerGroupOffsetsResponse2\xa8\x03\n" +
+	"\x19ResetConsumerGroupOffsets\x120.idp.gateway.v1.ResetConsumerGroupOffsetsRequest\x1a1.idp.gateway.v1.ResetConsumerGroupOffsetsResponse\x12Y\n" +
+	"\fExportConfig\x12#.idp.gateway.v1.ExportConfigRequest\x1a$.idp.gateway.v1.ExportConfigResponse\x12Y\n" +
+	"\fImportConfig\x12#.idp.gateway.v1.ImportConfigRequest\x1a$.idp.gateway.v1.ImportConfigResponse2\xa8\x03\n" +
 	"\x16BifrostCallbackService\x12Y\n" +
 	"\fTopicCreated\x12#.idp.gateway.v1.TopicCreatedRequest\x1a$.idp.gateway.v1.TopicCreatedResponse\x12Y\n" +
 	"\fTopicDeleted\x12#.idp.gateway.v1.TopicDeletedRequest\x1a$.idp.gateway.v1.TopicDeletedResponse\x12k\n" +
@@ -3509,149 +4114,172 @@ func file_idp_gateway_v1_gateway_proto_rawDescGZIP() []byte {
 	return file_idp_gateway_v1_gateway_proto_rawDescData
 }
 
-var file_idp_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_idp_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_idp_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_idp_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_idp_gateway_v1_gateway_proto_goTypes = []any{
 	(PermissionTemplate)(0),                   // 0: idp.gateway.v1.PermissionTemplate
-	(ConsumerGroupState)(0),                   // 1: idp.gateway.v1.ConsumerGroupState
-	(OffsetResetType)(0),                      // 2: idp.gateway.v1.OffsetResetType
-	(*VirtualClusterConfig)(nil),              // 3: idp.gateway.v1.VirtualClusterConfig
-	(*UpsertVirtualClusterRequest)(nil),       // 4: idp.gateway.v1.UpsertVirtualClusterRequest
-	(*UpsertVirtualClusterResponse)(nil),      // 5: idp.gateway.v1.UpsertVirtualClusterResponse
-	(*DeleteVirtualClusterRequest)(nil),       // 6: idp.gateway.v1.DeleteVirtualClusterRequest
-	(*DeleteVirtualClusterResponse)(nil),      // 7: idp.gateway.v1.DeleteVirtualClusterResponse
-	(*SetVirtualClusterReadOnlyRequest)(nil),  // 8: idp.gateway.v1.SetVirtualClusterReadOnlyRequest
-	(*SetVirtualClusterReadOnlyResponse)(nil), // 9: idp.gateway.v1.SetVirtualClusterReadOnlyResponse
-	(*GetFullConfigRequest)(nil),              // 10: idp.gateway.v1.GetFullConfigRequest
-	(*GetFullConfigResponse)(nil),             // 11: idp.gateway.v1.GetFullConfigResponse
-	(*GetStatusRequest)(nil),                  // 12: idp.gateway.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),                 // 13: idp.gateway.v1.GetStatusResponse
-	(*ListVirtualClustersRequest)(nil),        // 14: idp.gateway.v1.ListVirtualClustersRequest
-	(*ListVirtualClustersResponse)(nil),       // 15: idp.gateway.v1.ListVirtualClustersResponse
-	(*CustomPermission)(nil),                  // 16: idp.gateway.v1.CustomPermission
-	(*CredentialConfig)(nil),                  // 17: idp.gateway.v1.CredentialConfig
-	(*UpsertCredentialRequest)(nil),           // 18: idp.gateway.v1.UpsertCredentialRequest
-	(*UpsertCredentialResponse)(nil),          // 19: idp.gateway.v1.UpsertCredentialResponse
-	(*RevokeCredentialRequest)(nil),           // 20: idp.gateway.v1.RevokeCredentialRequest
-	(*RevokeCredentialResponse)(nil),          // 21: idp.gateway.v1.RevokeCredentialResponse
-	(*ListCredentialsRequest)(nil),            // 22: idp.gateway.v1.ListCredentialsRequest
-	(*ListCredentialsResponse)(nil),           // 23: idp.gateway.v1.ListCredentialsResponse
-	(*PolicyConfig)(nil),                      // 24: idp.gateway.v1.PolicyConfig
-	(*UpsertPolicyRequest)(nil),               // 25: idp.gateway.v1.UpsertPolicyRequest
-	(*UpsertPolicyResponse)(nil),              // 26: idp.gateway.v1.UpsertPolicyResponse
-	(*DeletePolicyRequest)(nil),               // 27: idp.gateway.v1.DeletePolicyRequest
-	(*DeletePolicyResponse)(nil),              // 28: idp.gateway.v1.DeletePolicyResponse
-	(*ListPoliciesRequest)(nil),               // 29: idp.gateway.v1.ListPoliciesRequest
-	(*ListPoliciesResponse)(nil),              // 30: idp.gateway.v1.ListPoliciesResponse
-	(*TopicACLEntry)(nil),                     // 31: idp.gateway.v1.TopicACLEntry
-	(*UpsertTopicACLRequest)(nil),             // 32: idp.gateway.v1.UpsertTopicACLRequest
-	(*UpsertTopicACLResponse)(nil),            // 33: idp.gateway.v1.UpsertTopicACLResponse
-	(*RevokeTopicACLRequest)(nil),             // 34: idp.gateway.v1.RevokeTopicACLRequest
-	(*RevokeTopicACLResponse)(nil),            // 35: idp.gateway.v1.RevokeTopicACLResponse
-	(*ListTopicACLsRequest)(nil),              // 36: idp.gateway.v1.ListTopicACLsRequest
-	(*ListTopicACLsResponse)(nil),             // 37: idp.gateway.v1.ListTopicACLsResponse
-	(*TopicCreatedRequest)(nil),               // 38: idp.gateway.v1.TopicCreatedRequest
-	(*TopicCreatedResponse)(nil),              // 39: idp.gateway.v1.TopicCreatedResponse
-	(*TopicDeletedRequest)(nil),               // 40: idp.gateway.v1.TopicDeletedRequest
-	(*TopicDeletedResponse)(nil),              // 41: idp.gateway.v1.TopicDeletedResponse
-	(*TopicConfigUpdatedRequest)(nil),         // 42: idp.gateway.v1.TopicConfigUpdatedRequest
-	(*TopicConfigUpdatedResponse)(nil),        // 43: idp.gateway.v1.TopicConfigUpdatedResponse
-	(*PolicyViolation)(nil),                   // 44: idp.gateway.v1.PolicyViolation
-	(*ClientActivityRecord)(nil),              // 45: idp.gateway.v1.ClientActivityRecord
-	(*EmitClientActivityRequest)(nil),         // 46: idp.gateway.v1.EmitClientActivityRequest
-	(*EmitClientActivityResponse)(nil),        // 47: idp.gateway.v1.EmitClientActivityResponse
-	(*ConsumerGroupSummary)(nil),              // 48: idp.gateway.v1.ConsumerGroupSummary
-	(*PartitionLag)(nil),                      // 49: idp.gateway.v1.PartitionLag
-	(*ConsumerGroupDetail)(nil),               // 50: idp.gateway.v1.ConsumerGroupDetail
-	(*ListConsumerGroupsRequest)(nil),         // 51: idp.gateway.v1.ListConsumerGroupsRequest
-	(*ListConsumerGroupsResponse)(nil),        // 52: idp.gateway.v1.ListConsumerGroupsResponse
-	(*DescribeConsumerGroupRequest)(nil),      // 53: idp.gateway.v1.DescribeConsumerGroupRequest
-	(*DescribeConsumerGroupResponse)(nil),     // 54: idp.gateway.v1.DescribeConsumerGroupResponse
-	(*ResetConsumerGroupOffsetsRequest)(nil),  // 55: idp.gateway.v1.ResetConsumerGroupOffsetsRequest
-	(*ResetConsumerGroupOffsetsResponse)(nil), // 56: idp.gateway.v1.ResetConsumerGroupOffsetsResponse
-	nil,                           // 57: idp.gateway.v1.GetStatusResponse.VersionInfoEntry
-	nil,                           // 58: idp.gateway.v1.TopicCreatedRequest.ConfigEntry
-	nil,                           // 59: idp.gateway.v1.TopicConfigUpdatedRequest.ConfigEntry
-	(*timestamppb.Timestamp)(nil), // 60: google.protobuf.Timestamp
+	(SaslMechanism)(0),                        // 1: idp.gateway.v1.SaslMechanism
+	(ConsumerGroupState)(0),                   // 2: idp.gateway.v1.ConsumerGroupState
+	(OffsetResetType)(0),                      // 3: idp.gateway.v1.OffsetResetType
+	(ImportConflictPolicy)(0),                 // 4: idp.gateway.v1.ImportConflictPolicy
+	(*VirtualClusterConfig)(nil),              // 5: idp.gateway.v1.VirtualClusterConfig
+	(*UpsertVirtualClusterRequest)(nil),       // 6: idp.gateway.v1.UpsertVirtualClusterRequest
+	(*UpsertVirtualClusterResponse)(nil),      // 7: idp.gateway.v1.UpsertVirtualClusterResponse
+	(*DeleteVirtualClusterRequest)(nil),       // 8: idp.gateway.v1.DeleteVirtualClusterRequest
+	(*DeleteVirtualClusterResponse)(nil),      // 9: idp.gateway.v1.DeleteVirtualClusterResponse
+	(*SetVirtualClusterReadOnlyRequest)(nil),  // 10: idp.gateway.v1.SetVirtualClusterReadOnlyRequest
+	(*SetVirtualClusterReadOnlyResponse)(nil), // 11: idp.gateway.v1.SetVirtualClusterReadOnlyResponse
+	(*GetFullConfigRequest)(nil),              // 12: idp.gateway.v1.GetFullConfigRequest
+	(*GetFullConfigResponse)(nil),             // 13: idp.gateway.v1.GetFullConfigResponse
+	(*GetStatusRequest)(nil),                  // 14: idp.gateway.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),                 // 15: idp.gateway.v1.GetStatusResponse
+	(*VirtualClusterStatus)(nil),              // 16: idp.gateway.v1.VirtualClusterStatus
+	(*ListVirtualClustersRequest)(nil),        // 17: idp.gateway.v1.ListVirtualClustersRequest
+	(*ListVirtualClustersResponse)(nil),       // 18: idp.gateway.v1.ListVirtualClustersResponse
+	(*CustomPermission)(nil),                  // 19: idp.gateway.v1.CustomPermission
+	(*ScramCredential)(nil),                   // 20: idp.gateway.v1.ScramCredential
+	(*CredentialConfig)(nil),                  // 21: idp.gateway.v1.CredentialConfig
+	(*UpsertCredentialRequest)(nil),           // 22: idp.gateway.v1.UpsertCredentialRequest
+	(*UpsertCredentialResponse)(nil),          // 23: idp.gateway.v1.UpsertCredentialResponse
+	(*RevokeCredentialRequest)(nil),           // 24: idp.gateway.v1.RevokeCredentialRequest
+	(*RevokeCredentialResponse)(nil),          // 25: idp.gateway.v1.RevokeCredentialResponse
+	(*ListCredentialsRequest)(nil),            // 26: idp.gateway.v1.ListCredentialsRequest
+	(*ListCredentialsResponse)(nil),           // 27: idp.gateway.v1.ListCredentialsResponse
+	(*PolicyConfig)(nil),                      // 28: idp.gateway.v1.PolicyConfig
+	(*UpsertPolicyRequest)(nil),               // 29: idp.gateway.v1.UpsertPolicyRequest
+	(*UpsertPolicyResponse)(nil),              // 30: idp.gateway.v1.UpsertPolicyResponse
+	(*DeletePolicyRequest)(nil),               // 31: idp.gateway.v1.DeletePolicyRequest
+	(*DeletePolicyResponse)(nil),              // 32: idp.gateway.v1.DeletePolicyResponse
+	(*ListPoliciesRequest)(nil),               // 33: idp.gateway.v1.ListPoliciesRequest
+	(*ListPoliciesResponse)(nil),              // 34: idp.gateway.v1.ListPoliciesResponse
+	(*TopicACLEntry)(nil),                     // 35: idp.gateway.v1.TopicACLEntry
+	(*UpsertTopicACLRequest)(nil),             // 36: idp.gateway.v1.UpsertTopicACLRequest
+	(*UpsertTopicACLResponse)(nil),            // 37: idp.gateway.v1.UpsertTopicACLResponse
+	(*RevokeTopicACLRequest)(nil),             // 38: idp.gateway.v1.RevokeTopicACLRequest
+	(*RevokeTopicACLResponse)(nil),            // 39: idp.gateway.v1.RevokeTopicACLResponse
+	(*ListTopicACLsRequest)(nil),              // 40: idp.gateway.v1.ListTopicACLsRequest
+	(*ListTopicACLsResponse)(nil),             // 41: idp.gateway.v1.ListTopicACLsResponse
+	(*TopicCreatedRequest)(nil),               // 42: idp.gateway.v1.TopicCreatedRequest
+	(*TopicCreatedResponse)(nil),              // 43: idp.gateway.v1.TopicCreatedResponse
+	(*TopicDeletedRequest)(nil),               // 44: idp.gateway.v1.TopicDeletedRequest
+	(*TopicDeletedResponse)(nil),              // 45: idp.gateway.v1.TopicDeletedResponse
+	(*TopicConfigUpdatedRequest)(nil),         // 46: idp.gateway.v1.TopicConfigUpdatedRequest
+	(*TopicConfigUpdatedResponse)(nil),        // 47: idp.gateway.v1.TopicConfigUpdatedResponse
+	(*PolicyViolation)(nil),                   // 48: idp.gateway.v1.PolicyViolation
+	(*ClientActivityRecord)(nil),              // 49: idp.gateway.v1.ClientActivityRecord
+	(*EmitClientActivityRequest)(nil),         // 50: idp.gateway.v1.EmitClientActivityRequest
+	(*EmitClientActivityResponse)(nil),        // 51: idp.gateway.v1.EmitClientActivityResponse
+	(*ConsumerGroupSummary)(nil),              // 52: idp.gateway.v1.ConsumerGroupSummary
+	(*PartitionLag)(nil),                      // 53: idp.gateway.v1.PartitionLag
+	(*ConsumerGroupDetail)(nil),               // 54: idp.gateway.v1.ConsumerGroupDetail
+	(*ListConsumerGroupsRequest)(nil),         // 55: idp.gateway.v1.ListConsumerGroupsRequest
+	(*ListConsumerGroupsResponse)(nil),        // 56: idp.gateway.v1.ListConsumerGroupsResponse
+	(*DescribeConsumerGroupRequest)(nil),      // 57: idp.gateway.v1.DescribeConsumerGroupRequest
+	(*DescribeConsumerGroupResponse)(nil),     // 58: idp.gateway.v1.DescribeConsumerGroupResponse
+	(*ResetConsumerGroupOffsetsRequest)(nil),  // 59: idp.gateway.v1.ResetConsumerGroupOffsetsRequest
+	(*ResetConsumerGroupOffsetsResponse)(nil), // 60: idp.gateway.v1.ResetConsumerGroupOffsetsResponse
+	(*ExportConfigRequest)(nil),               // 61: idp.gateway.v1.ExportConfigRequest
+	(*ExportConfigResponse)(nil),              // 62: idp.gateway.v1.ExportConfigResponse
+	(*ImportConfigRequest)(nil),               // 63: idp.gateway.v1.ImportConfigRequest
+	(*ImportConfigResponse)(nil),              // 64: idp.gateway.v1.ImportConfigResponse
+	nil,                                       // 65: idp.gateway.v1.VirtualClusterConfig.TopicProduceBytesPerSecEntry
+	nil,                                       // 66: idp.gateway.v1.GetStatusResponse.VersionInfoEntry
+	nil,                                       // 67: idp.gateway.v1.TopicCreatedRequest.ConfigEntry
+	nil,                                       // 68: idp.gateway.v1.TopicConfigUpdatedRequest.ConfigEntry
+	(*timestamppb.Timestamp)(nil),             // 69: google.protobuf.Timestamp
 }
 var file_idp_gateway_v1_gateway_proto_depIdxs = []int32{
-	3,  // 0: idp.gateway.v1.UpsertVirtualClusterRequest.config:type_name -> idp.gateway.v1.VirtualClusterConfig
-	3,  // 1: idp.gateway.v1.GetFullConfigResponse.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterConfig
-	17, // 2: idp.gateway.v1.GetFullConfigResponse.credentials:type_name -> idp.gateway.v1.CredentialConfig
-	24, // 3: idp.gateway.v1.GetFullConfigResponse.policies:type_name -> idp.gateway.v1.PolicyConfig
-	31, // 4: idp.gateway.v1.GetFullConfigResponse.topic_acls:type_name -> idp.gateway.v1.TopicACLEntry
-	57, // 5: idp.gateway.v1.GetStatusResponse.version_info:type_name -> idp.gateway.v1.GetStatusResponse.VersionInfoEntry
-	3,  // 6: idp.gateway.v1.ListVirtualClustersResponse.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterConfig
-	0,  // 7: idp.gateway.v1.CredentialConfig.template:type_name -> idp.gateway.v1.PermissionTemplate
-	16, // 8: idp.gateway.v1.CredentialConfig.custom_permissions:type_name -> idp.gateway.v1.CustomPermission
-	17, // 9: idp.gateway.v1.UpsertCredentialRequest.config:type_name -> idp.gateway.v1.CredentialConfig
-	17, // 10: idp.gateway.v1.ListCredentialsResponse.credentials:type_name -> idp.gateway.v1.CredentialConfig
-	24, // 11: idp.gateway.v1.UpsertPolicyRequest.config:type_name -> idp.gateway.v1.PolicyConfig
-	24, // 12: idp.gateway.v1.ListPoliciesResponse.policies:type_name -> idp.gateway.v1.PolicyConfig
-	60, // 13: idp.gateway.v1.TopicACLEntry.expires_at:type_name -> google.protobuf.Timestamp
-	31, // 14: idp.gateway.v1.UpsertTopicACLRequest.entry:type_name -> idp.gateway.v1.TopicACLEntry
-	31, // 15: idp.gateway.v1.ListTopicACLsResponse.entries:type_name -> idp.gateway.v1.TopicACLEntry
-	58, // 16: idp.gateway.v1.TopicCreatedRequest.config:type_name -> idp.gateway.v1.TopicCreatedRequest.ConfigEntry
-	59, // 17: idp.gateway.v1.TopicConfigUpdatedRequest.config:type_name -> idp.gateway.v1.TopicConfigUpdatedRequest.ConfigEntry
-	60, // 18: idp.gateway.v1.ClientActivityRecord.window_start:type_name -> google.protobuf.Timestamp
-	60, // 19: idp.gateway.v1.ClientActivityRecord.window_end:type_name -> google.protobuf.Timestamp
-	45, // 20: idp.gateway.v1.EmitClientActivityRequest.records:type_name -> idp.gateway.v1.ClientActivityRecord
-	1,  // 21: idp.gateway.v1.ConsumerGroupSummary.state:type_name -> idp.gateway.v1.ConsumerGroupState
-	1,  // 22: idp.gateway.v1.ConsumerGroupDetail.state:type_name -> idp.gateway.v1.ConsumerGroupState
-	49, // 23: idp.gateway.v1.ConsumerGroupDetail.partitions:type_name -> idp.gateway.v1.PartitionLag
-	48, // 24: idp.gateway.v1.ListConsumerGroupsResponse.groups:type_name -> idp.gateway.v1.ConsumerGroupSummary
-	50, // 25: idp.gateway.v1.DescribeConsumerGroupResponse.group:type_name -> idp.gateway.v1.ConsumerGroupDetail
-	2,  // 26: idp.gateway.v1.ResetConsumerGroupOffsetsRequest.reset_type:type_name -> idp.gateway.v1.OffsetResetType
-	49, // 27: idp.gateway.v1.ResetConsumerGroupOffsetsResponse.new_offsets:type_name -> idp.gateway.v1.PartitionLag
-	4,  // 28: idp.gateway.v1.BifrostAdminService.UpsertVirtualCluster:input_type -> idp.gateway.v1.UpsertVirtualClusterRequest
-	6,  // 29: idp.gateway.v1.BifrostAdminService.DeleteVirtualCluster:input_type -> idp.gateway.v1.DeleteVirtualClusterRequest
-	8,  // 30: idp.gateway.v1.BifrostAdminService.SetVirtualClusterReadOnly:input_type -> idp.gateway.v1.SetVirtualClusterReadOnlyRequest
-	18, // 31: idp.gateway.v1.BifrostAdminService.UpsertCredential:input_type -> idp.gateway.v1.UpsertCredentialRequest
-	20, // 32: idp.gateway.v1.BifrostAdminService.RevokeCredential:input_type -> idp.gateway.v1.RevokeCredentialRequest
-	22, // 33: idp.gateway.v1.BifrostAdminService.ListCredentials:input_type -> idp.gateway.v1.ListCredentialsRequest
-	10, // 34: idp.gateway.v1.BifrostAdminService.GetFullConfig:input_type -> idp.gateway.v1.GetFullConfigRequest
-	12, // 35: idp.gateway.v1.BifrostAdminService.GetStatus:input_type -> idp.gateway.v1.GetStatusRequest
-	14, // 36: idp.gateway.v1.BifrostAdminService.ListVirtualClusters:input_type -> idp.gateway.v1.ListVirtualClustersRequest
-	25, // 37: idp.gateway.v1.BifrostAdminService.UpsertPolicy:input_type -> idp.gateway.v1.UpsertPolicyRequest
-	27, // 38: idp.gateway.v1.BifrostAdminService.DeletePolicy:input_type -> idp.gateway.v1.DeletePolicyRequest
-	29, // 39: idp.gateway.v1.BifrostAdminService.ListPolicies:input_type -> idp.gateway.v1.ListPoliciesRequest
-	32, // 40: idp.gateway.v1.BifrostAdminService.UpsertTopicACL:input_type -> idp.gateway.v1.UpsertTopicACLRequest
-	34, // 41: idp.gateway.v1.BifrostAdminService.RevokeTopicACL:input_type -> idp.gateway.v1.RevokeTopicACLRequest
-	36, // 42: idp.gateway.v1.BifrostAdminService.ListTopicACLs:input_type -> idp.gateway.v1.ListTopicACLsRequest
-	51, // 43: idp.gateway.v1.BifrostAdminService.ListConsumerGroups:input_type -> idp.gateway.v1.ListConsumerGroupsRequest
-	53, // 44: idp.gateway.v1.BifrostAdminService.DescribeConsumerGroup:input_type -> idp.gateway.v1.DescribeConsumerGroupRequest
-	55, // 45: idp.gateway.v1.BifrostAdminService.ResetConsumerGroupOffsets:input_type -> idp.gateway.v1.ResetConsumerGroupOffsetsRequest
-	38, // 46: idp.gateway.v1.BifrostCallbackService.TopicCreated:input_type -> idp.gateway.v1.TopicCreatedRequest
-	40, // 47: idp.gateway.v1.BifrostCallbackService.TopicDeleted:input_type -> idp.gateway.v1.TopicDeletedRequest
-	42, // 48: idp.gateway.v1.BifrostCallbackService.TopicConfigUpdated:input_type -> idp.gateway.v1.TopicConfigUpdatedRequest
-	46, // 49: idp.gateway.v1.BifrostCallbackService.EmitClientActivity:input_type -> idp.gateway.v1.EmitClientActivityRequest
-	5,  // 50: idp.gateway.v1.BifrostAdminService.UpsertVirtualCluster:output_type -> idp.gateway.v1.UpsertVirtualClusterResponse
-	7,  // 51: idp.gateway.v1.BifrostAdminService.DeleteVirtualCluster:output_type -> idp.gateway.v1.DeleteVirtualClusterResponse
-	9,  // 52: idp.gateway.v1.BifrostAdminService.SetVirtualClusterReadOnly:output_type -> idp.gateway.v1.SetVirtualClusterReadOnlyResponse
-	19, // 53: idp.gateway.v1.BifrostAdminService.UpsertCredential:output_type -> idp.gateway.v1.UpsertCredentialResponse
-	21, // 54: idp.gateway.v1.BifrostAdminService.RevokeCredential:output_type -> idp.gateway.v1.RevokeCredentialResponse
-	23, // 55: idp.gateway.v1.BifrostAdminService.ListCredentials:output_type -> idp.gateway.v1.ListCredentialsResponse
-	11, // 56: idp.gateway.v1.BifrostAdminService.GetFullConfig:output_type -> idp.gateway.v1.GetFullConfigResponse
-	13, // 57: idp.gateway.v1.BifrostAdminService.GetStatus:output_type -> idp.gateway.v1.GetStatusResponse
-	15, // 58: idp.gateway.v1.BifrostAdminService.ListVirtualClusters:output_type -> idp.gateway.v1.ListVirtualClustersResponse
-	26, // 59: idp.gateway.v1.BifrostAdminService.UpsertPolicy:output_type -> idp.gateway.v1.UpsertPolicyResponse
-	28, // 60: idp.gateway.v1.BifrostAdminService.DeletePolicy:output_type -> idp.gateway.v1.DeletePolicyResponse
-	30, // 61: idp.gateway.v1.BifrostAdminService.ListPolicies:output_type -> idp.gateway.v1.ListPoliciesResponse
-	33, // 62: idp.gateway.v1.BifrostAdminService.UpsertTopicACL:output_type -> idp.gateway.v1.UpsertTopicACLResponse
-	35, // 63: idp.gateway.v1.BifrostAdminService.RevokeTopicACL:output_type -> idp.gateway.v1.RevokeTopicACLResponse
-	37, // 64: idp.gateway.v1.BifrostAdminService.ListTopicACLs:output_type -> idp.gateway.v1.ListTopicACLsResponse
-	52, // 65: idp.gateway.v1.BifrostAdminService.ListConsumerGroups:output_type -> idp.gateway.v1.ListConsumerGroupsResponse
-	54, // 66: idp.gateway.v1.BifrostAdminService.DescribeConsumerGroup:output_type -> idp.gateway.v1.DescribeConsumerGroupResponse
-	56, // 67: idp.gateway.v1.BifrostAdminService.ResetConsumerGroupOffsets:output_type -> idp.gateway.v1.ResetConsumerGroupOffsetsResponse
-	39, // 68: idp.gateway.v1.BifrostCallbackService.TopicCreated:output_type -> idp.gateway.v1.TopicCreatedResponse
-	41, // 69: idp.gateway.v1.BifrostCallbackService.TopicDeleted:output_type -> idp.gateway.v1.TopicDeletedResponse
-	43, // 70: idp.gateway.v1.BifrostCallbackService.TopicConfigUpdated:output_type -> idp.gateway.v1.TopicConfigUpdatedResponse
-	47, // 71: idp.gateway.v1.BifrostCallbackService.EmitClientActivity:output_type -> idp.gateway.v1.EmitClientActivityResponse
-	50, // [50:72] is the sub-list for method output_type
-	28, // [28:50] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	65, // 0: idp.gateway.v1.VirtualClusterConfig.topic_produce_bytes_per_sec:type_name -> idp.gateway.v1.VirtualClusterConfig.TopicProduceBytesPerSecEntry
+	5,  // 1: idp.gateway.v1.UpsertVirtualClusterRequest.config:type_name -> idp.gateway.v1.VirtualClusterConfig
+	5,  // 2: idp.gateway.v1.GetFullConfigResponse.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterConfig
+	21, // 3: idp.gateway.v1.GetFullConfigResponse.credentials:type_name -> idp.gateway.v1.CredentialConfig
+	28, // 4: idp.gateway.v1.GetFullConfigResponse.policies:type_name -> idp.gateway.v1.PolicyConfig
+	35, // 5: idp.gateway.v1.GetFullConfigResponse.topic_acls:type_name -> idp.gateway.v1.TopicACLEntry
+	66, // 6: idp.gateway.v1.GetStatusResponse.version_info:type_name -> idp.gateway.v1.GetStatusResponse.VersionInfoEntry
+	16, // 7: idp.gateway.v1.GetStatusResponse.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterStatus
+	5,  // 8: idp.gateway.v1.ListVirtualClustersResponse.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterConfig
+	0,  // 9: idp.gateway.v1.CredentialConfig.template:type_name -> idp.gateway.v1.PermissionTemplate
+	19, // 10: idp.gateway.v1.CredentialConfig.custom_permissions:type_name -> idp.gateway.v1.CustomPermission
+	1,  // 11: idp.gateway.v1.CredentialConfig.mechanism:type_name -> idp.gateway.v1.SaslMechanism
+	20, // 12: idp.gateway.v1.CredentialConfig.scram:type_name -> idp.gateway.v1.ScramCredential
+	21, // 13: idp.gateway.v1.UpsertCredentialRequest.config:type_name -> idp.gateway.v1.CredentialConfig
+	21, // 14: idp.gateway.v1.ListCredentialsResponse.credentials:type_name -> idp.gateway.v1.CredentialConfig
+	28, // 15: idp.gateway.v1.UpsertPolicyRequest.config:type_name -> idp.gateway.v1.PolicyConfig
+	28, // 16: idp.gateway.v1.ListPoliciesResponse.policies:type_name -> idp.gateway.v1.PolicyConfig
+	69, // 17: idp.gateway.v1.TopicACLEntry.expires_at:type_name -> google.protobuf.Timestamp
+	35, // 18: idp.gateway.v1.UpsertTopicACLRequest.entry:type_name -> idp.gateway.v1.TopicACLEntry
+	35, // 19: idp.gateway.v1.ListTopicACLsResponse.entries:type_name -> idp.gateway.v1.TopicACLEntry
+	67, // 20: idp.gateway.v1.TopicCreatedRequest.config:type_name -> idp.gateway.v1.TopicCreatedRequest.ConfigEntry
+	68, // 21: idp.gateway.v1.TopicConfigUpdatedRequest.config:type_name -> idp.gateway.v1.TopicConfigUpdatedRequest.ConfigEntry
+	69, // 22: idp.gateway.v1.ClientActivityRecord.window_start:type_name -> google.protobuf.Timestamp
+	69, // 23: idp.gateway.v1.ClientActivityRecord.window_end:type_name -> google.protobuf.Timestamp
+	49, // 24: idp.gateway.v1.EmitClientActivityRequest.records:type_name -> idp.gateway.v1.ClientActivityRecord
+	2,  // 25: idp.gateway.v1.ConsumerGroupSummary.state:type_name -> idp.gateway.v1.ConsumerGroupState
+	2,  // 26: idp.gateway.v1.ConsumerGroupDetail.state:type_name -> idp.gateway.v1.ConsumerGroupState
+	53, // 27: idp.gateway.v1.ConsumerGroupDetail.partitions:type_name -> idp.gateway.v1.PartitionLag
+	52, // 28: idp.gateway.v1.ListConsumerGroupsResponse.groups:type_name -> idp.gateway.v1.ConsumerGroupSummary
+	54, // 29: idp.gateway.v1.DescribeConsumerGroupResponse.group:type_name -> idp.gateway.v1.ConsumerGroupDetail
+	3,  // 30: idp.gateway.v1.ResetConsumerGroupOffsetsRequest.reset_type:type_name -> idp.gateway.v1.OffsetResetType
+	53, // 31: idp.gateway.v1.ResetConsumerGroupOffsetsResponse.new_offsets:type_name -> idp.gateway.v1.PartitionLag
+	5,  // 32: idp.gateway.v1.ExportConfigResponse.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterConfig
+	21, // 33: idp.gateway.v1.ExportConfigResponse.credentials:type_name -> idp.gateway.v1.CredentialConfig
+	69, // 34: idp.gateway.v1.ExportConfigResponse.exported_at:type_name -> google.protobuf.Timestamp
+	5,  // 35: idp.gateway.v1.ImportConfigRequest.virtual_clusters:type_name -> idp.gateway.v1.VirtualClusterConfig
+	21, // 36: idp.gateway.v1.ImportConfigRequest.credentials:type_name -> idp.gateway.v1.CredentialConfig
+	4,  // 37: idp.gateway.v1.ImportConfigRequest.conflict_policy:type_name -> idp.gateway.v1.ImportConflictPolicy
+	6,  // 38: idp.gateway.v1.BifrostAdminService.UpsertVirtualCluster:input_type -> idp.gateway.v1.UpsertVirtualClusterRequest
+	8,  // 39: idp.gateway.v1.BifrostAdminService.DeleteVirtualCluster:input_type -> idp.gateway.v1.DeleteVirtualClusterRequest
+	10, // 40: idp.gateway.v1.BifrostAdminService.SetVirtualClusterReadOnly:input_type -> idp.gateway.v1.SetVirtualClusterReadOnlyRequest
+	22, // 41: idp.gateway.v1.BifrostAdminService.UpsertCredential:input_type -> idp.gateway.v1.UpsertCredentialRequest
+	24, // 42: idp.gateway.v1.BifrostAdminService.RevokeCredential:input_type -> idp.gateway.v1.RevokeCredentialRequest
+	26, // 43: idp.gateway.v1.BifrostAdminService.ListCredentials:input_type -> idp.gateway.v1.ListCredentialsRequest
+	12, // 44: idp.gateway.v1.BifrostAdminService.GetFullConfig:input_type -> idp.gateway.v1.GetFullConfigRequest
+	14, // 45: idp.gateway.v1.BifrostAdminService.GetStatus:input_type -> idp.gateway.v1.GetStatusRequest
+	17, // 46: idp.gateway.v1.BifrostAdminService.ListVirtualClusters:input_type -> idp.gateway.v1.ListVirtualClustersRequest
+	29, // 47: idp.gateway.v1.BifrostAdminService.UpsertPolicy:input_type -> idp.gateway.v1.UpsertPolicyRequest
+	31, // 48: idp.gateway.v1.BifrostAdminService.DeletePolicy:input_type -> idp.gateway.v1.DeletePolicyRequest
+	33, // 49: idp.gateway.v1.BifrostAdminService.ListPolicies:input_type -> idp.gateway.v1.ListPoliciesRequest
+	36, // 50: idp.gateway.v1.BifrostAdminService.UpsertTopicACL:input_type -> idp.gateway.v1.UpsertTopicACLRequest
+	38, // 51: idp.gateway.v1.BifrostAdminService.RevokeTopicACL:input_type -> idp.gateway.v1.RevokeTopicACLRequest
+	40, // 52: idp.gateway.v1.BifrostAdminService.ListTopicACLs:input_type -> idp.gateway.v1.ListTopicACLsRequest
+	55, // 53: idp.gateway.v1.BifrostAdminService.ListConsumerGroups:input_type -> idp.gateway.v1.ListConsumerGroupsRequest
+	57, // 54: idp.gateway.v1.BifrostAdminService.DescribeConsumerGroup:input_type -> idp.gateway.v1.DescribeConsumerGroupRequest
+	59, // 55: idp.gateway.v1.BifrostAdminService.ResetConsumerGroupOffsets:input_type -> idp.gateway.v1.ResetConsumerGroupOffsetsRequest
+	61, // 56: idp.gateway.v1.BifrostAdminService.ExportConfig:input_type -> idp.gateway.v1.ExportConfigRequest
+	63, // 57: idp.gateway.v1.BifrostAdminService.ImportConfig:input_type -> idp.gateway.v1.ImportConfigRequest
+	42, // 58: idp.gateway.v1.BifrostCallbackService.TopicCreated:input_type -> idp.gateway.v1.TopicCreatedRequest
+	44, // 59: idp.gateway.v1.BifrostCallbackService.TopicDeleted:input_type -> idp.gateway.v1.TopicDeletedRequest
+	46, // 60: idp.gateway.v1.BifrostCallbackService.TopicConfigUpdated:input_type -> idp.gateway.v1.TopicConfigUpdatedRequest
+	50, // 61: idp.gateway.v1.BifrostCallbackService.EmitClientActivity:input_type -> idp.gateway.v1.EmitClientActivityRequest
+	7,  // 62: idp.gateway.v1.BifrostAdminService.UpsertVirtualCluster:output_type -> idp.gateway.v1.UpsertVirtualClusterResponse
+	9,  // 63: idp.gateway.v1.BifrostAdminService.DeleteVirtualCluster:output_type -> idp.gateway.v1.DeleteVirtualClusterResponse
+	11, // 64: idp.gateway.v1.BifrostAdminService.SetVirtualClusterReadOnly:output_type -> idp.gateway.v1.SetVirtualClusterReadOnlyResponse
+	23, // 65: idp.gateway.v1.BifrostAdminService.UpsertCredential:output_type -> idp.gateway.v1.UpsertCredentialResponse
+	25, // 66: idp.gateway.v1.BifrostAdminService.RevokeCredential:output_type -> idp.gateway.v1.RevokeCredentialResponse
+	27, // 67: idp.gateway.v1.BifrostAdminService.ListCredentials:output_type -> idp.gateway.v1.ListCredentialsResponse
+	13, // 68: idp.gateway.v1.BifrostAdminService.GetFullConfig:output_type -> idp.gateway.v1.GetFullConfigResponse
+	15, // 69: idp.gateway.v1.BifrostAdminService.GetStatus:output_type -> idp.gateway.v1.GetStatusResponse
+	18, // 70: idp.gateway.v1.BifrostAdminService.ListVirtualClusters:output_type -> idp.gateway.v1.ListVirtualClustersResponse
+	30, // 71: idp.gateway.v1.BifrostAdminService.UpsertPolicy:output_type -> idp.gateway.v1.UpsertPolicyResponse
+	32, // 72: idp.gateway.v1.BifrostAdminService.DeletePolicy:output_type -> idp.gateway.v1.DeletePolicyResponse
+	34, // 73: idp.gateway.v1.BifrostAdminService.ListPolicies:output_type -> idp.gateway.v1.ListPoliciesResponse
+	37, // 74: idp.gateway.v1.BifrostAdminService.UpsertTopicACL:output_type -> idp.gateway.v1.UpsertTopicACLResponse
+	39, // 75: idp.gateway.v1.BifrostAdminService.RevokeTopicACL:output_type -> idp.gateway.v1.RevokeTopicACLResponse
+	41, // 76: idp.gateway.v1.BifrostAdminService.ListTopicACLs:output_type -> idp.gateway.v1.ListTopicACLsResponse
+	56, // 77: idp.gateway.v1.BifrostAdminService.ListConsumerGroups:output_type -> idp.gateway.v1.ListConsumerGroupsResponse
+	58, // 78: idp.gateway.v1.BifrostAdminService.DescribeConsumerGroup:output_type -> idp.gateway.v1.DescribeConsumerGroupResponse
+	60, // 79: idp.gateway.v1.BifrostAdminService.ResetConsumerGroupOffsets:output_type -> idp.gateway.v1.ResetConsumerGroupOffsetsResponse
+	62, // 80: idp.gateway.v1.BifrostAdminService.ExportConfig:output_type -> idp.gateway.v1.ExportConfigResponse
+	64, // 81: idp.gateway.v1.BifrostAdminService.ImportConfig:output_type -> idp.gateway.v1.ImportConfigResponse
+	43, // 82: idp.gateway.v1.BifrostCallbackService.TopicCreated:output_type -> idp.gateway.v1.TopicCreatedResponse
+	45, // 83: idp.gateway.v1.BifrostCallbackService.TopicDeleted:output_type -> idp.gateway.v1.TopicDeletedResponse
+	47, // 84: idp.gateway.v1.BifrostCallbackService.TopicConfigUpdated:output_type -> idp.gateway.v1.TopicConfigUpdatedResponse
+	51, // 85: idp.gateway.v1.BifrostCallbackService.EmitClientActivity:output_type -> idp.gateway.v1.EmitClientActivityResponse
+	62, // [62:86] is the sub-list for method output_type
+	38, // [38:62] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_idp_gateway_v1_gateway_proto_init() }
@@ -3664,8 +4292,8 @@ func file_idp_gateway_v1_gateway_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idp_gateway_v1_gateway_proto_rawDesc), len(file_idp_gateway_v1_gateway_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   57,
+			NumEnums:      5,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -39,6 +39,8 @@ const (
 	BifrostAdminService_ListConsumerGroups_FullMethodName        = "/idp.gateway.v1.BifrostAdminService/ListConsumerGroups"
 	BifrostAdminService_DescribeConsumerGroup_FullMethodName     = "/idp.gateway.v1.BifrostAdminService/DescribeConsumerGroup"
 	BifrostAdminService_ResetConsumerGroupOffsets_FullMethodName = "/idp.gateway.v1.BifrostAdminService/ResetConsumerGroupOffsets"
+	BifrostAdminService_ExportConfig_FullMethodName              = "/idp.gateway.v1.BifrostAdminService/ExportConfig"
+	BifrostAdminService_ImportConfig_FullMethodName              = "/idp.gateway.v1.BifrostAdminService/ImportConfig"
 )
 
 // BifrostAdminServiceClient is the client API for BifrostAdminService service.
@@ -70,6 +72,8 @@ type BifrostAdminServiceClient interface {
 	ListConsumerGroups(ctx context.Context, in *ListConsumerGroupsRequest, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error)
 	DescribeConsumerGroup(ctx context.Context, in *DescribeConsumerGroupRequest, opts ...grpc.CallOption) (*DescribeConsumerGroupResponse, error)
 	ResetConsumerGroupOffsets(ctx context.Context, in *ResetConsumerGroupOffsetsRequest, opts ...grpc.CallOption) (*ResetConsumerGroupOffsetsResponse, error)
+	ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error)
+	ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error)
 }
 
 type bifrostAdminServiceClient struct {
@@ -260,6 +264,26 @@ func (c *bifrostAdminServiceClient) ResetConsumerGroupOffsets(ctx context.Contex
 	return out, nil
 }
 
+func (c *bifrostAdminServiceClient) ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ExportConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportConfigResponse)
+	err := c.cc.Invoke(ctx, BifrostAdminService_ExportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bifrostAdminServiceClient) ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ImportConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportConfigResponse)
+	err := c.cc.Invoke(ctx, BifrostAdminService_ImportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BifrostAdminServiceServer is the server API for BifrostAdminService service.
 // All implementations must embed UnimplementedBifrostAdminServiceServer
 // for forward compatibility.
@@ -289,6 +313,8 @@ type BifrostAdminServiceServer interface {
 	ListConsumerGroups(context.Context, *ListConsumerGroupsRequest) (*ListConsumerGroupsResponse, error)
 	DescribeConsumerGroup(context.Context, *DescribeConsumerGroupRequest) (*DescribeConsumerGroupResponse, error)
 	ResetConsumerGroupOffsets(context.Context, *ResetConsumerGroupOffsetsRequest) (*ResetConsumerGroupOffsetsResponse, error)
+	ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error)
+	ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error)
 	mustEmbedUnimplementedBifrostAdminServiceServer()
 }
 
@@ -353,6 +379,12 @@ func (UnimplementedBifrostAdminServiceServer) DescribeConsumerGroup(context.Cont
 func (UnimplementedBifrostAdminServiceServer) ResetConsumerGroupOffsets(context.Context, *ResetConsumerGroupOffsetsRequest) (*ResetConsumerGroupOffsetsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetConsumerGroupOffsets not implemented")
 }
+func (UnimplementedBifrostAdminServiceServer) ExportConfig(context.Context, *ExportConfigRequest) (*ExportConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportConfig not implemented")
+}
+func (UnimplementedBifrostAdminServiceServer) ImportConfig(context.Context, *ImportConfigRequest) (*ImportConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportConfig not implemented")
+}
 func (UnimplementedBifrostAdminServiceServer) mustEmbedUnimplementedBifrostAdminServiceServer() {}
 func (UnimplementedBifrostAdminServiceServer) testEmbeddedByValue()                             {}
 
@@ -698,6 +730,42 @@ func _BifrostAdminService_ResetConsumerGroupOffsets_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BifrostAdminService_ExportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BifrostAdminServiceServer).ExportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BifrostAdminService_ExportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BifrostAdminServiceServer).ExportConfig(ctx, req.(*ExportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BifrostAdminService_ImportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BifrostAdminServiceServer).ImportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BifrostAdminService_ImportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BifrostAdminServiceServer).ImportConfig(ctx, req.(*ImportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BifrostAdminService_ServiceDesc is the grpc.ServiceDesc for BifrostAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -777,6 +845,14 @@ var BifrostAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetConsumerGroupOffsets",
 			Handler:    _BifrostAdminService_ResetConsumerGroupOffsets_Handler,
 		},
+		{
+			MethodName: "ExportConfig",
+			Handler:    _BifrostAdminService_ExportConfig_Handler,
+		},
+		{
+			MethodName: "ImportConfig",
+			Handler:    _BifrostAdminService_ImportConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "idp/gateway/v1/gateway.proto",
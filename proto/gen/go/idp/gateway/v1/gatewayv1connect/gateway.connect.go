@@ -91,6 +91,12 @@ const (
 	// BifrostAdminServiceResetConsumerGroupOffsetsProcedure is the fully-qualified name of the
 	// BifrostAdminService's ResetConsumerGroupOffsets RPC.
 	BifrostAdminServiceResetConsumerGroupOffsetsProcedure = "/idp.gateway.v1.BifrostAdminService/ResetConsumerGroupOffsets"
+	// BifrostAdminServiceExportConfigProcedure is the fully-qualified name of the BifrostAdminService's
+	// ExportConfig RPC.
+	BifrostAdminServiceExportConfigProcedure = "/idp.gateway.v1.BifrostAdminService/ExportConfig"
+	// BifrostAdminServiceImportConfigProcedure is the fully-qualified name of the BifrostAdminService's
+	// ImportConfig RPC.
+	BifrostAdminServiceImportConfigProcedure = "/idp.gateway.v1.BifrostAdminService/ImportConfig"
 	// BifrostCallbackServiceTopicCreatedProcedure is the fully-qualified name of the
 	// BifrostCallbackService's TopicCreated RPC.
 	BifrostCallbackServiceTopicCreatedProcedure = "/idp.gateway.v1.BifrostCallbackService/TopicCreated"
@@ -132,6 +138,8 @@ type BifrostAdminServiceClient interface {
 	ListConsumerGroups(context.Context, *connect.Request[v1.ListConsumerGroupsRequest]) (*connect.Response[v1.ListConsumerGroupsResponse], error)
 	DescribeConsumerGroup(context.Context, *connect.Request[v1.DescribeConsumerGroupRequest]) (*connect.Response[v1.DescribeConsumerGroupResponse], error)
 	ResetConsumerGroupOffsets(context.Context, *connect.Request[v1.ResetConsumerGroupOffsetsRequest]) (*connect.Response[v1.ResetConsumerGroupOffsetsResponse], error)
+	ExportConfig(context.Context, *connect.Request[v1.ExportConfigRequest]) (*connect.Response[v1.ExportConfigResponse], error)
+	ImportConfig(context.Context, *connect.Request[v1.ImportConfigRequest]) (*connect.Response[v1.ImportConfigResponse], error)
 }
 
 // NewBifrostAdminServiceClient constructs a client for the idp.gateway.v1.BifrostAdminService
@@ -253,6 +261,18 @@ func NewBifrostAdminServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(bifrostAdminServiceMethods.ByName("ResetConsumerGroupOffsets")),
 			connect.WithClientOptions(opts...),
 		),
+		exportConfig: connect.NewClient[v1.ExportConfigRequest, v1.ExportConfigResponse](
+			httpClient,
+			baseURL+BifrostAdminServiceExportConfigProcedure,
+			connect.WithSchema(bifrostAdminServiceMethods.ByName("ExportConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		importConfig: connect.NewClient[v1.ImportConfigRequest, v1.ImportConfigResponse](
+			httpClient,
+			baseURL+BifrostAdminServiceImportConfigProcedure,
+			connect.WithSchema(bifrostAdminServiceMethods.ByName("ImportConfig")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -276,6 +296,8 @@ type bifrostAdminServiceClient struct {
 	listConsumerGroups        *connect.Client[v1.ListConsumerGroupsRequest, v1.ListConsumerGroupsResponse]
 	describeConsumerGroup     *connect.Client[v1.DescribeConsumerGroupRequest, v1.DescribeConsumerGroupResponse]
 	resetConsumerGroupOffsets *connect.Client[v1.ResetConsumerGroupOffsetsRequest, v1.ResetConsumerGroupOffsetsResponse]
+	exportConfig              *connect.Client[v1.ExportConfigRequest, v1.ExportConfigResponse]
+	importConfig              *connect.Client[v1.ImportConfigRequest, v1.ImportConfigResponse]
 }
 
 // UpsertVirtualCluster calls idp.gateway.v1.BifrostAdminService.UpsertVirtualCluster.
@@ -368,6 +390,16 @@ func (c *bifrostAdminServiceClient) ResetConsumerGroupOffsets(ctx context.Contex
 	return c.resetConsumerGroupOffsets.CallUnary(ctx, req)
 }
 
+// ExportConfig calls idp.gateway.v1.BifrostAdminService.ExportConfig.
+func (c *bifrostAdminServiceClient) ExportConfig(ctx context.Context, req *connect.Request[v1.ExportConfigRequest]) (*connect.Response[v1.ExportConfigResponse], error) {
+	return c.exportConfig.CallUnary(ctx, req)
+}
+
+// ImportConfig calls idp.gateway.v1.BifrostAdminService.ImportConfig.
+func (c *bifrostAdminServiceClient) ImportConfig(ctx context.Context, req *connect.Request[v1.ImportConfigRequest]) (*connect.Response[v1.ImportConfigResponse], error) {
+	return c.importConfig.CallUnary(ctx, req)
+}
+
 // BifrostAdminServiceHandler is an implementation of the idp.gateway.v1.BifrostAdminService
 // service.
 type BifrostAdminServiceHandler interface {
@@ -396,6 +428,8 @@ type BifrostAdminServiceHandler interface {
 	ListConsumerGroups(context.Context, *connect.Request[v1.ListConsumerGroupsRequest]) (*connect.Response[v1.ListConsumerGroupsResponse], error)
 	DescribeConsumerGroup(context.Context, *connect.Request[v1.DescribeConsumerGroupRequest]) (*connect.Response[v1.DescribeConsumerGroupResponse], error)
 	ResetConsumerGroupOffsets(context.Context, *connect.Request[v1.ResetConsumerGroupOffsetsRequest]) (*connect.Response[v1.ResetConsumerGroupOffsetsResponse], error)
+	ExportConfig(context.Context, *connect.Request[v1.ExportConfigRequest]) (*connect.Response[v1.ExportConfigResponse], error)
+	ImportConfig(context.Context, *connect.Request[v1.ImportConfigRequest]) (*connect.Response[v1.ImportConfigResponse], error)
 }
 
 // NewBifrostAdminServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -513,6 +547,18 @@ func NewBifrostAdminServiceHandler(svc BifrostAdminServiceHandler, opts ...conne
 		connect.WithSchema(bifrostAdminServiceMethods.ByName("ResetConsumerGroupOffsets")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bifrostAdminServiceExportConfigHandler := connect.NewUnaryHandler(
+		BifrostAdminServiceExportConfigProcedure,
+		svc.ExportConfig,
+		connect.WithSchema(bifrostAdminServiceMethods.ByName("ExportConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bifrostAdminServiceImportConfigHandler := connect.NewUnaryHandler(
+		BifrostAdminServiceImportConfigProcedure,
+		svc.ImportConfig,
+		connect.WithSchema(bifrostAdminServiceMethods.ByName("ImportConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/idp.gateway.v1.BifrostAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BifrostAdminServiceUpsertVirtualClusterProcedure:
@@ -551,6 +597,10 @@ func NewBifrostAdminServiceHandler(svc BifrostAdminServiceHandler, opts ...conne
 			bifrostAdminServiceDescribeConsumerGroupHandler.ServeHTTP(w, r)
 		case BifrostAdminServiceResetConsumerGroupOffsetsProcedure:
 			bifrostAdminServiceResetConsumerGroupOffsetsHandler.ServeHTTP(w, r)
+		case BifrostAdminServiceExportConfigProcedure:
+			bifrostAdminServiceExportConfigHandler.ServeHTTP(w, r)
+		case BifrostAdminServiceImportConfigProcedure:
+			bifrostAdminServiceImportConfigHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -632,6 +682,14 @@ func (UnimplementedBifrostAdminServiceHandler) ResetConsumerGroupOffsets(context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.gateway.v1.BifrostAdminService.ResetConsumerGroupOffsets is not implemented"))
 }
 
+func (UnimplementedBifrostAdminServiceHandler) ExportConfig(context.Context, *connect.Request[v1.ExportConfigRequest]) (*connect.Response[v1.ExportConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.gateway.v1.BifrostAdminService.ExportConfig is not implemented"))
+}
+
+func (UnimplementedBifrostAdminServiceHandler) ImportConfig(context.Context, *connect.Request[v1.ImportConfigRequest]) (*connect.Response[v1.ImportConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.gateway.v1.BifrostAdminService.ImportConfig is not implemented"))
+}
+
 // BifrostCallbackServiceClient is a client for the idp.gateway.v1.BifrostCallbackService service.
 type BifrostCallbackServiceClient interface {
 	// Topic sync (passthrough creates)
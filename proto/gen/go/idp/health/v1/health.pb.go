@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ServingStatus int32
+
+const (
+	ServingStatus_SERVING_STATUS_UNSPECIFIED ServingStatus = 0
+	ServingStatus_SERVING_STATUS_SERVING     ServingStatus = 1
+	ServingStatus_SERVING_STATUS_NOT_SERVING ServingStatus = 2
+)
+
+// Enum value maps for ServingStatus.
+var (
+	ServingStatus_name = map[int32]string{
+		0: "SERVING_STATUS_UNSPECIFIED",
+		1: "SERVING_STATUS_SERVING",
+		2: "SERVING_STATUS_NOT_SERVING",
+	}
+	ServingStatus_value = map[string]int32{
+		"SERVING_STATUS_UNSPECIFIED": 0,
+		"SERVING_STATUS_SERVING":     1,
+		"SERVING_STATUS_NOT_SERVING": 2,
+	}
+)
+
+func (x ServingStatus) Enum() *ServingStatus {
+	p := new(ServingStatus)
+	*p = x
+	return p
+}
+
+func (x ServingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_idp_health_v1_health_proto_enumTypes[0].Descriptor()
+}
+
+func (ServingStatus) Type() protoreflect.EnumType {
+	return &file_idp_health_v1_health_proto_enumTypes[0]
+}
+
+func (x ServingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServingStatus.Descriptor instead.
+func (ServingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_idp_health_v1_health_proto_rawDescGZIP(), []int{0}
+}
+
 type HealthConfig struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Url            string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -305,6 +354,94 @@ func (x *DeleteScheduleResponse) GetError() string {
 	return ""
 }
 
+type CheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_idp_health_v1_health_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_health_v1_health_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_idp_health_v1_health_proto_rawDescGZIP(), []int{5}
+}
+
+type CheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ServingStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=idp.health.v1.ServingStatus" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Why the service is not serving
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	mi := &file_idp_health_v1_health_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_health_v1_health_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_idp_health_v1_health_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckResponse) GetStatus() ServingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ServingStatus_SERVING_STATUS_UNSPECIFIED
+}
+
+func (x *CheckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_idp_health_v1_health_proto protoreflect.FileDescriptor
 
 const file_idp_health_v1_health_proto_rawDesc = "" +
@@ -328,10 +465,19 @@ const file_idp_health_v1_health_proto_rawDesc = "" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\"H\n" +
 	"\x16DeleteScheduleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error2\xcd\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x0e\n" +
+	"\fCheckRequest\"_\n" +
+	"\rCheckResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1c.idp.health.v1.ServingStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*k\n" +
+	"\rServingStatus\x12\x1e\n" +
+	"\x1aSERVING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SERVING_STATUS_SERVING\x10\x01\x12\x1e\n" +
+	"\x1aSERVING_STATUS_NOT_SERVING\x10\x022\x91\x02\n" +
 	"\rHealthService\x12]\n" +
 	"\x0eManageSchedule\x12$.idp.health.v1.ManageScheduleRequest\x1a%.idp.health.v1.ManageScheduleResponse\x12]\n" +
-	"\x0eDeleteSchedule\x12$.idp.health.v1.DeleteScheduleRequest\x1a%.idp.health.v1.DeleteScheduleResponseBBZ@github.com/drewpayment/orbit/proto/gen/go/idp/health/v1;healthv1b\x06proto3"
+	"\x0eDeleteSchedule\x12$.idp.health.v1.DeleteScheduleRequest\x1a%.idp.health.v1.DeleteScheduleResponse\x12B\n" +
+	"\x05Check\x12\x1b.idp.health.v1.CheckRequest\x1a\x1c.idp.health.v1.CheckResponseBBZ@github.com/drewpayment/orbit/proto/gen/go/idp/health/v1;healthv1b\x06proto3"
 
 var (
 	file_idp_health_v1_health_proto_rawDescOnce sync.Once
@@ -345,25 +491,32 @@ func file_idp_health_v1_health_proto_rawDescGZIP() []byte {
 	return file_idp_health_v1_health_proto_rawDescData
 }
 
-var file_idp_health_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_idp_health_v1_health_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_idp_health_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_idp_health_v1_health_proto_goTypes = []any{
-	(*HealthConfig)(nil),           // 0: idp.health.v1.HealthConfig
-	(*ManageScheduleRequest)(nil),  // 1: idp.health.v1.ManageScheduleRequest
-	(*ManageScheduleResponse)(nil), // 2: idp.health.v1.ManageScheduleResponse
-	(*DeleteScheduleRequest)(nil),  // 3: idp.health.v1.DeleteScheduleRequest
-	(*DeleteScheduleResponse)(nil), // 4: idp.health.v1.DeleteScheduleResponse
+	(ServingStatus)(0),             // 0: idp.health.v1.ServingStatus
+	(*HealthConfig)(nil),           // 1: idp.health.v1.HealthConfig
+	(*ManageScheduleRequest)(nil),  // 2: idp.health.v1.ManageScheduleRequest
+	(*ManageScheduleResponse)(nil), // 3: idp.health.v1.ManageScheduleResponse
+	(*DeleteScheduleRequest)(nil),  // 4: idp.health.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil), // 5: idp.health.v1.DeleteScheduleResponse
+	(*CheckRequest)(nil),           // 6: idp.health.v1.CheckRequest
+	(*CheckResponse)(nil),          // 7: idp.health.v1.CheckResponse
 }
 var file_idp_health_v1_health_proto_depIdxs = []int32{
-	0, // 0: idp.health.v1.ManageScheduleRequest.health_config:type_name -> idp.health.v1.HealthConfig
-	1, // 1: idp.health.v1.HealthService.ManageSchedule:input_type -> idp.health.v1.ManageScheduleRequest
-	3, // 2: idp.health.v1.HealthService.DeleteSchedule:input_type -> idp.health.v1.DeleteScheduleRequest
-	2, // 3: idp.health.v1.HealthService.ManageSchedule:output_type -> idp.health.v1.ManageScheduleResponse
-	4, // 4: idp.health.v1.HealthService.DeleteSchedule:output_type -> idp.health.v1.DeleteScheduleResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: idp.health.v1.ManageScheduleRequest.health_config:type_name -> idp.health.v1.HealthConfig
+	0, // 1: idp.health.v1.CheckResponse.status:type_name -> idp.health.v1.ServingStatus
+	2, // 2: idp.health.v1.HealthService.ManageSchedule:input_type -> idp.health.v1.ManageScheduleRequest
+	4, // 3: idp.health.v1.HealthService.DeleteSchedule:input_type -> idp.health.v1.DeleteScheduleRequest
+	6, // 4: idp.health.v1.HealthService.Check:input_type -> idp.health.v1.CheckRequest
+	3, // 5: idp.health.v1.HealthService.ManageSchedule:output_type -> idp.health.v1.ManageScheduleResponse
+	5, // 6: idp.health.v1.HealthService.DeleteSchedule:output_type -> idp.health.v1.DeleteScheduleResponse
+	7, // 7: idp.health.v1.HealthService.Check:output_type -> idp.health.v1.CheckResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_idp_health_v1_health_proto_init() }
@@ -376,13 +529,14 @@ func file_idp_health_v1_health_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idp_health_v1_health_proto_rawDesc), len(file_idp_health_v1_health_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_idp_health_v1_health_proto_goTypes,
 		DependencyIndexes: file_idp_health_v1_health_proto_depIdxs,
+		EnumInfos:         file_idp_health_v1_health_proto_enumTypes,
 		MessageInfos:      file_idp_health_v1_health_proto_msgTypes,
 	}.Build()
 	File_idp_health_v1_health_proto = out.File
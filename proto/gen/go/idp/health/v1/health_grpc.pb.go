@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	HealthService_ManageSchedule_FullMethodName = "/idp.health.v1.HealthService/ManageSchedule"
 	HealthService_DeleteSchedule_FullMethodName = "/idp.health.v1.HealthService/DeleteSchedule"
+	HealthService_Check_FullMethodName          = "/idp.health.v1.HealthService/Check"
 )
 
 // HealthServiceClient is the client API for HealthService service.
@@ -31,6 +32,8 @@ type HealthServiceClient interface {
 	ManageSchedule(ctx context.Context, in *ManageScheduleRequest, opts ...grpc.CallOption) (*ManageScheduleResponse, error)
 	// DeleteSchedule removes a health check schedule for an app
 	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// Check reports whether the service can reach the dependencies it needs to serve requests
+	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 }
 
 type healthServiceClient struct {
@@ -61,6 +64,16 @@ func (c *healthServiceClient) DeleteSchedule(ctx context.Context, in *DeleteSche
 	return out, nil
 }
 
+func (c *healthServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, HealthService_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthServiceServer is the server API for HealthService service.
 // All implementations must embed UnimplementedHealthServiceServer
 // for forward compatibility.
@@ -69,6 +82,8 @@ type HealthServiceServer interface {
 	ManageSchedule(context.Context, *ManageScheduleRequest) (*ManageScheduleResponse, error)
 	// DeleteSchedule removes a health check schedule for an app
 	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// Check reports whether the service can reach the dependencies it needs to serve requests
+	Check(context.Context, *CheckRequest) (*CheckResponse, error)
 	mustEmbedUnimplementedHealthServiceServer()
 }
 
@@ -85,6 +100,9 @@ func (UnimplementedHealthServiceServer) ManageSchedule(context.Context, *ManageS
 func (UnimplementedHealthServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSchedule not implemented")
 }
+func (UnimplementedHealthServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Check not implemented")
+}
 func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
 func (UnimplementedHealthServiceServer) testEmbeddedByValue()                       {}
 
@@ -142,6 +160,24 @@ func _HealthService_DeleteSchedule_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HealthService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HealthService_ServiceDesc is the grpc.ServiceDesc for HealthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +193,10 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSchedule",
 			Handler:    _HealthService_DeleteSchedule_Handler,
 		},
+		{
+			MethodName: "Check",
+			Handler:    _HealthService_Check_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "idp/health/v1/health.proto",
@@ -39,6 +39,8 @@ const (
 	// HealthServiceDeleteScheduleProcedure is the fully-qualified name of the HealthService's
 	// DeleteSchedule RPC.
 	HealthServiceDeleteScheduleProcedure = "/idp.health.v1.HealthService/DeleteSchedule"
+	// HealthServiceCheckProcedure is the fully-qualified name of the HealthService's Check RPC.
+	HealthServiceCheckProcedure = "/idp.health.v1.HealthService/Check"
 )
 
 // HealthServiceClient is a client for the idp.health.v1.HealthService service.
@@ -47,6 +49,8 @@ type HealthServiceClient interface {
 	ManageSchedule(context.Context, *connect.Request[v1.ManageScheduleRequest]) (*connect.Response[v1.ManageScheduleResponse], error)
 	// DeleteSchedule removes a health check schedule for an app
 	DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error)
+	// Check reports whether the service can reach the dependencies it needs to serve requests
+	Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error)
 }
 
 // NewHealthServiceClient constructs a client for the idp.health.v1.HealthService service. By
@@ -72,6 +76,12 @@ func NewHealthServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(healthServiceMethods.ByName("DeleteSchedule")),
 			connect.WithClientOptions(opts...),
 		),
+		check: connect.NewClient[v1.CheckRequest, v1.CheckResponse](
+			httpClient,
+			baseURL+HealthServiceCheckProcedure,
+			connect.WithSchema(healthServiceMethods.ByName("Check")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -79,6 +89,7 @@ func NewHealthServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type healthServiceClient struct {
 	manageSchedule *connect.Client[v1.ManageScheduleRequest, v1.ManageScheduleResponse]
 	deleteSchedule *connect.Client[v1.DeleteScheduleRequest, v1.DeleteScheduleResponse]
+	check          *connect.Client[v1.CheckRequest, v1.CheckResponse]
 }
 
 // ManageSchedule calls idp.health.v1.HealthService.ManageSchedule.
@@ -91,12 +102,19 @@ func (c *healthServiceClient) DeleteSchedule(ctx context.Context, req *connect.R
 	return c.deleteSchedule.CallUnary(ctx, req)
 }
 
+// Check calls idp.health.v1.HealthService.Check.
+func (c *healthServiceClient) Check(ctx context.Context, req *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error) {
+	return c.check.CallUnary(ctx, req)
+}
+
 // HealthServiceHandler is an implementation of the idp.health.v1.HealthService service.
 type HealthServiceHandler interface {
 	// ManageSchedule creates, updates, or deletes a health check schedule for an app
 	ManageSchedule(context.Context, *connect.Request[v1.ManageScheduleRequest]) (*connect.Response[v1.ManageScheduleResponse], error)
 	// DeleteSchedule removes a health check schedule for an app
 	DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error)
+	// Check reports whether the service can reach the dependencies it needs to serve requests
+	Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error)
 }
 
 // NewHealthServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -118,12 +136,20 @@ func NewHealthServiceHandler(svc HealthServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(healthServiceMethods.ByName("DeleteSchedule")),
 		connect.WithHandlerOptions(opts...),
 	)
+	healthServiceCheckHandler := connect.NewUnaryHandler(
+		HealthServiceCheckProcedure,
+		svc.Check,
+		connect.WithSchema(healthServiceMethods.ByName("Check")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/idp.health.v1.HealthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case HealthServiceManageScheduleProcedure:
 			healthServiceManageScheduleHandler.ServeHTTP(w, r)
 		case HealthServiceDeleteScheduleProcedure:
 			healthServiceDeleteScheduleHandler.ServeHTTP(w, r)
+		case HealthServiceCheckProcedure:
+			healthServiceCheckHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -140,3 +166,7 @@ func (UnimplementedHealthServiceHandler) ManageSchedule(context.Context, *connec
 func (UnimplementedHealthServiceHandler) DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.DeleteScheduleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.health.v1.HealthService.DeleteSchedule is not implemented"))
 }
+
+func (UnimplementedHealthServiceHandler) Check(context.Context, *connect.Request[v1.CheckRequest]) (*connect.Response[v1.CheckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.health.v1.HealthService.Check is not implemented"))
+}
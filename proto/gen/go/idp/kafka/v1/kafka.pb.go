@@ -5972,6 +5972,342 @@ func (x *LineageNode) GetLastSeen() *timestamppb.Timestamp {
 	return nil
 }
 
+// Live topic state
+// DescribeTopic returns a topic's declared configuration alongside its live
+// state on the cluster, flagging any drift between the two
+type DescribeTopicRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicId       string                 `protobuf:"bytes,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Credentials   map[string]string      `protobuf:"bytes,2,rep,name=credentials,proto3" json:"credentials,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Cluster credentials
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeTopicRequest) Reset() {
+	*x = DescribeTopicRequest{}
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeTopicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTopicRequest) ProtoMessage() {}
+
+func (x *DescribeTopicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTopicRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTopicRequest) Descriptor() ([]byte, []int) {
+	return file_idp_kafka_v1_kafka_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DescribeTopicRequest) GetTopicId() string {
+	if x != nil {
+		return x.TopicId
+	}
+	return ""
+}
+
+func (x *DescribeTopicRequest) GetCredentials() map[string]string {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type DescribeTopicResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         *KafkaTopic            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`                                // Declared state
+	BrokerState   *TopicBrokerState      `protobuf:"bytes,2,opt,name=broker_state,json=brokerState,proto3" json:"broker_state,omitempty"` // Live state, unset until provisioned
+	Drifted       bool                   `protobuf:"varint,3,opt,name=drifted,proto3" json:"drifted,omitempty"`
+	Drift         []*TopicDrift          `protobuf:"bytes,4,rep,name=drift,proto3" json:"drift,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeTopicResponse) Reset() {
+	*x = DescribeTopicResponse{}
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTopicResponse) ProtoMessage() {}
+
+func (x *DescribeTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTopicResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTopicResponse) Descriptor() ([]byte, []int) {
+	return file_idp_kafka_v1_kafka_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DescribeTopicResponse) GetTopic() *KafkaTopic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *DescribeTopicResponse) GetBrokerState() *TopicBrokerState {
+	if x != nil {
+		return x.BrokerState
+	}
+	return nil
+}
+
+func (x *DescribeTopicResponse) GetDrifted() bool {
+	if x != nil {
+		return x.Drifted
+	}
+	return false
+}
+
+func (x *DescribeTopicResponse) GetDrift() []*TopicDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *DescribeTopicResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TopicBrokerState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exists            bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Partitions        int32                  `protobuf:"varint,2,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	ReplicationFactor int32                  `protobuf:"varint,3,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	Config            map[string]string      `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PartitionStates   []*TopicPartitionState `protobuf:"bytes,5,rep,name=partition_states,json=partitionStates,proto3" json:"partition_states,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TopicBrokerState) Reset() {
+	*x = TopicBrokerState{}
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicBrokerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicBrokerState) ProtoMessage() {}
+
+func (x *TopicBrokerState) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicBrokerState.ProtoReflect.Descriptor instead.
+func (*TopicBrokerState) Descriptor() ([]byte, []int) {
+	return file_idp_kafka_v1_kafka_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TopicBrokerState) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *TopicBrokerState) GetPartitions() int32 {
+	if x != nil {
+		return x.Partitions
+	}
+	return 0
+}
+
+func (x *TopicBrokerState) GetReplicationFactor() int32 {
+	if x != nil {
+		return x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *TopicBrokerState) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *TopicBrokerState) GetPartitionStates() []*TopicPartitionState {
+	if x != nil {
+		return x.PartitionStates
+	}
+	return nil
+}
+
+type TopicPartitionState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partition     int32                  `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Leader        int32                  `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"` // Broker ID, -1 when the partition has no leader
+	Replicas      []int32                `protobuf:"varint,3,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
+	Isr           []int32                `protobuf:"varint,4,rep,packed,name=isr,proto3" json:"isr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicPartitionState) Reset() {
+	*x = TopicPartitionState{}
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicPartitionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicPartitionState) ProtoMessage() {}
+
+func (x *TopicPartitionState) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicPartitionState.ProtoReflect.Descriptor instead.
+func (*TopicPartitionState) Descriptor() ([]byte, []int) {
+	return file_idp_kafka_v1_kafka_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TopicPartitionState) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *TopicPartitionState) GetLeader() int32 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *TopicPartitionState) GetReplicas() []int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *TopicPartitionState) GetIsr() []int32 {
+	if x != nil {
+		return x.Isr
+	}
+	return nil
+}
+
+// TopicDrift is a setting whose live value differs from the declared one
+type TopicDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // e.g. "partitions", "retention.ms"
+	Declared      string                 `protobuf:"bytes,2,opt,name=declared,proto3" json:"declared,omitempty"`
+	Actual        string                 `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicDrift) Reset() {
+	*x = TopicDrift{}
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicDrift) ProtoMessage() {}
+
+func (x *TopicDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_idp_kafka_v1_kafka_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicDrift.ProtoReflect.Descriptor instead.
+func (*TopicDrift) Descriptor() ([]byte, []int) {
+	return file_idp_kafka_v1_kafka_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TopicDrift) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TopicDrift) GetDeclared() string {
+	if x != nil {
+		return x.Declared
+	}
+	return ""
+}
+
+func (x *TopicDrift) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
 var File_idp_kafka_v1_kafka_proto protoreflect.FileDescriptor
 
 const file_idp_kafka_v1_kafka_proto_rawDesc = "" +
@@ -6470,7 +6806,40 @@ const file_idp_kafka_v1_kafka_proto_rawDesc = "" +
 	"\x14service_account_name\x18\x04 \x01(\tR\x12serviceAccountName\x12\x1b\n" +
 	"\tclient_id\x18\x05 \x01(\tR\bclientId\x12+\n" +
 	"\x11bytes_transferred\x18\x06 \x01(\x03R\x10bytesTransferred\x127\n" +
-	"\tlast_seen\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen*\xc0\x01\n" +
+	"\tlast_seen\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\"\xc8\x01\n" +
+	"\x14DescribeTopicRequest\x12\x19\n" +
+	"\btopic_id\x18\x01 \x01(\tR\atopicId\x12U\n" +
+	"\vcredentials\x18\x02 \x03(\v23.idp.kafka.v1.DescribeTopicRequest.CredentialsEntryR\vcredentials\x1a>\n" +
+	"\x10CredentialsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xea\x01\n" +
+	"\x15DescribeTopicResponse\x12.\n" +
+	"\x05topic\x18\x01 \x01(\v2\x18.idp.kafka.v1.KafkaTopicR\x05topic\x12A\n" +
+	"\fbroker_state\x18\x02 \x01(\v2\x1e.idp.kafka.v1.TopicBrokerStateR\vbrokerState\x12\x18\n" +
+	"\adrifted\x18\x03 \x01(\bR\adrifted\x12.\n" +
+	"\x05drift\x18\x04 \x03(\v2\x18.idp.kafka.v1.TopicDriftR\x05drift\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xc6\x02\n" +
+	"\x10TopicBrokerState\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\x12\x1e\n" +
+	"\n" +
+	"partitions\x18\x02 \x01(\x05R\n" +
+	"partitions\x12-\n" +
+	"\x12replication_factor\x18\x03 \x01(\x05R\x11replicationFactor\x12B\n" +
+	"\x06config\x18\x04 \x03(\v2*.idp.kafka.v1.TopicBrokerState.ConfigEntryR\x06config\x12L\n" +
+	"\x10partition_states\x18\x05 \x03(\v2!.idp.kafka.v1.TopicPartitionStateR\x0fpartitionStates\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"y\n" +
+	"\x13TopicPartitionState\x12\x1c\n" +
+	"\tpartition\x18\x01 \x01(\x05R\tpartition\x12\x16\n" +
+	"\x06leader\x18\x02 \x01(\x05R\x06leader\x12\x1a\n" +
+	"\breplicas\x18\x03 \x03(\x05R\breplicas\x12\x10\n" +
+	"\x03isr\x18\x04 \x03(\x05R\x03isr\"V\n" +
+	"\n" +
+	"TopicDrift\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\bdeclared\x18\x02 \x01(\tR\bdeclared\x12\x16\n" +
+	"\x06actual\x18\x03 \x01(\tR\x06actual*\xc0\x01\n" +
 	"\fProviderType\x12\x1d\n" +
 	"\x19PROVIDER_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aPROVIDER_TYPE_APACHE_KAFKA\x10\x01\x12!\n" +
@@ -6531,7 +6900,7 @@ const file_idp_kafka_v1_kafka_proto_rawDesc = "" +
 	"\x1eSHARE_POLICY_SCOPE_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dSHARE_POLICY_SCOPE_ALL_TOPICS\x10\x01\x12$\n" +
 	" SHARE_POLICY_SCOPE_TOPIC_PATTERN\x10\x02\x12%\n" +
-	"!SHARE_POLICY_SCOPE_SPECIFIC_TOPIC\x10\x032\xf9\x17\n" +
+	"!SHARE_POLICY_SCOPE_SPECIFIC_TOPIC\x10\x032\xd3\x18\n" +
 	"\fKafkaService\x12X\n" +
 	"\rListProviders\x12\".idp.kafka.v1.ListProvidersRequest\x1a#.idp.kafka.v1.ListProvidersResponse\x12^\n" +
 	"\x0fRegisterCluster\x12$.idp.kafka.v1.RegisterClusterRequest\x1a%.idp.kafka.v1.RegisterClusterResponse\x12^\n" +
@@ -6544,7 +6913,8 @@ const file_idp_kafka_v1_kafka_proto_rawDesc = "" +
 	"\x18DeleteEnvironmentMapping\x12-.idp.kafka.v1.DeleteEnvironmentMappingRequest\x1a..idp.kafka.v1.DeleteEnvironmentMappingResponse\x12R\n" +
 	"\vCreateTopic\x12 .idp.kafka.v1.CreateTopicRequest\x1a!.idp.kafka.v1.CreateTopicResponse\x12d\n" +
 	"\x11CreateTopicDirect\x12&.idp.kafka.v1.CreateTopicDirectRequest\x1a'.idp.kafka.v1.CreateTopicDirectResponse\x12I\n" +
-	"\bGetTopic\x12\x1d.idp.kafka.v1.GetTopicRequest\x1a\x1e.idp.kafka.v1.GetTopicResponse\x12O\n" +
+	"\bGetTopic\x12\x1d.idp.kafka.v1.GetTopicRequest\x1a\x1e.idp.kafka.v1.GetTopicResponse\x12X\n" +
+	"\rDescribeTopic\x12\".idp.kafka.v1.DescribeTopicRequest\x1a#.idp.kafka.v1.DescribeTopicResponse\x12O\n" +
 	"\n" +
 	"ListTopics\x12\x1f.idp.kafka.v1.ListTopicsRequest\x1a .idp.kafka.v1.ListTopicsResponse\x12R\n" +
 	"\vUpdateTopic\x12 .idp.kafka.v1.UpdateTopicRequest\x1a!.idp.kafka.v1.UpdateTopicResponse\x12R\n" +
@@ -6579,7 +6949,7 @@ func file_idp_kafka_v1_kafka_proto_rawDescGZIP() []byte {
 }
 
 var file_idp_kafka_v1_kafka_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_idp_kafka_v1_kafka_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_idp_kafka_v1_kafka_proto_msgTypes = make([]protoimpl.MessageInfo, 104)
 var file_idp_kafka_v1_kafka_proto_goTypes = []any{
 	(ProviderType)(0),                         // 0: idp.kafka.v1.ProviderType
 	(ClusterValidationStatus)(0),              // 1: idp.kafka.v1.ClusterValidationStatus
@@ -6674,50 +7044,57 @@ var file_idp_kafka_v1_kafka_proto_goTypes = []any{
 	(*GetTopicLineageRequest)(nil),            // 90: idp.kafka.v1.GetTopicLineageRequest
 	(*GetTopicLineageResponse)(nil),           // 91: idp.kafka.v1.GetTopicLineageResponse
 	(*LineageNode)(nil),                       // 92: idp.kafka.v1.LineageNode
-	nil,                                       // 93: idp.kafka.v1.KafkaCluster.ConnectionConfigEntry
-	nil,                                       // 94: idp.kafka.v1.KafkaEnvironmentMapping.RoutingRuleEntry
-	nil,                                       // 95: idp.kafka.v1.KafkaTopic.ConfigEntry
-	nil,                                       // 96: idp.kafka.v1.RegisterClusterRequest.ConnectionConfigEntry
-	nil,                                       // 97: idp.kafka.v1.RegisterClusterRequest.CredentialsEntry
-	nil,                                       // 98: idp.kafka.v1.ValidateClusterConnectionRequest.ConnectionConfigEntry
-	nil,                                       // 99: idp.kafka.v1.ValidateClusterConnectionRequest.CredentialsEntry
-	nil,                                       // 100: idp.kafka.v1.CreateEnvironmentMappingRequest.RoutingRuleEntry
-	nil,                                       // 101: idp.kafka.v1.CreateTopicRequest.ConfigEntry
-	nil,                                       // 102: idp.kafka.v1.CreateTopicDirectRequest.ConfigEntry
-	nil,                                       // 103: idp.kafka.v1.CreateTopicDirectRequest.ConnectionConfigEntry
-	nil,                                       // 104: idp.kafka.v1.CreateTopicDirectRequest.CredentialsEntry
-	nil,                                       // 105: idp.kafka.v1.UpdateTopicRequest.ConfigEntry
-	nil,                                       // 106: idp.kafka.v1.DeleteTopicByNameRequest.ConnectionConfigEntry
-	nil,                                       // 107: idp.kafka.v1.DeleteTopicByNameRequest.CredentialsEntry
-	(*timestamppb.Timestamp)(nil),             // 108: google.protobuf.Timestamp
+	(*DescribeTopicRequest)(nil),              // 93: idp.kafka.v1.DescribeTopicRequest
+	(*DescribeTopicResponse)(nil),             // 94: idp.kafka.v1.DescribeTopicResponse
+	(*TopicBrokerState)(nil),                  // 95: idp.kafka.v1.TopicBrokerState
+	(*TopicPartitionState)(nil),               // 96: idp.kafka.v1.TopicPartitionState
+	(*TopicDrift)(nil),                        // 97: idp.kafka.v1.TopicDrift
+	nil,                                       // 98: idp.kafka.v1.KafkaCluster.ConnectionConfigEntry
+	nil,                                       // 99: idp.kafka.v1.KafkaEnvironmentMapping.RoutingRuleEntry
+	nil,                                       // 100: idp.kafka.v1.KafkaTopic.ConfigEntry
+	nil,                                       // 101: idp.kafka.v1.RegisterClusterRequest.ConnectionConfigEntry
+	nil,                                       // 102: idp.kafka.v1.RegisterClusterRequest.CredentialsEntry
+	nil,                                       // 103: idp.kafka.v1.ValidateClusterConnectionRequest.ConnectionConfigEntry
+	nil,                                       // 104: idp.kafka.v1.ValidateClusterConnectionRequest.CredentialsEntry
+	nil,                                       // 105: idp.kafka.v1.CreateEnvironmentMappingRequest.RoutingRuleEntry
+	nil,                                       // 106: idp.kafka.v1.CreateTopicRequest.ConfigEntry
+	nil,                                       // 107: idp.kafka.v1.CreateTopicDirectRequest.ConfigEntry
+	nil,                                       // 108: idp.kafka.v1.CreateTopicDirectRequest.ConnectionConfigEntry
+	nil,                                       // 109: idp.kafka.v1.CreateTopicDirectRequest.CredentialsEntry
+	nil,                                       // 110: idp.kafka.v1.UpdateTopicRequest.ConfigEntry
+	nil,                                       // 111: idp.kafka.v1.DeleteTopicByNameRequest.ConnectionConfigEntry
+	nil,                                       // 112: idp.kafka.v1.DeleteTopicByNameRequest.CredentialsEntry
+	nil,                                       // 113: idp.kafka.v1.DescribeTopicRequest.CredentialsEntry
+	nil,                                       // 114: idp.kafka.v1.TopicBrokerState.ConfigEntry
+	(*timestamppb.Timestamp)(nil),             // 115: google.protobuf.Timestamp
 }
 var file_idp_kafka_v1_kafka_proto_depIdxs = []int32{
 	12,  // 0: idp.kafka.v1.KafkaProvider.capabilities:type_name -> idp.kafka.v1.ProviderCapabilities
-	93,  // 1: idp.kafka.v1.KafkaCluster.connection_config:type_name -> idp.kafka.v1.KafkaCluster.ConnectionConfigEntry
+	98,  // 1: idp.kafka.v1.KafkaCluster.connection_config:type_name -> idp.kafka.v1.KafkaCluster.ConnectionConfigEntry
 	1,   // 2: idp.kafka.v1.KafkaCluster.validation_status:type_name -> idp.kafka.v1.ClusterValidationStatus
-	108, // 3: idp.kafka.v1.KafkaCluster.last_validated_at:type_name -> google.protobuf.Timestamp
-	108, // 4: idp.kafka.v1.KafkaCluster.created_at:type_name -> google.protobuf.Timestamp
-	108, // 5: idp.kafka.v1.KafkaCluster.updated_at:type_name -> google.protobuf.Timestamp
-	94,  // 6: idp.kafka.v1.KafkaEnvironmentMapping.routing_rule:type_name -> idp.kafka.v1.KafkaEnvironmentMapping.RoutingRuleEntry
+	115, // 3: idp.kafka.v1.KafkaCluster.last_validated_at:type_name -> google.protobuf.Timestamp
+	115, // 4: idp.kafka.v1.KafkaCluster.created_at:type_name -> google.protobuf.Timestamp
+	115, // 5: idp.kafka.v1.KafkaCluster.updated_at:type_name -> google.protobuf.Timestamp
+	99,  // 6: idp.kafka.v1.KafkaEnvironmentMapping.routing_rule:type_name -> idp.kafka.v1.KafkaEnvironmentMapping.RoutingRuleEntry
 	4,   // 7: idp.kafka.v1.SchemaRegistry.default_compatibility:type_name -> idp.kafka.v1.SchemaCompatibility
 	16,  // 8: idp.kafka.v1.SchemaRegistry.environment_overrides:type_name -> idp.kafka.v1.EnvironmentCompatibilityOverride
 	4,   // 9: idp.kafka.v1.EnvironmentCompatibilityOverride.compatibility:type_name -> idp.kafka.v1.SchemaCompatibility
-	95,  // 10: idp.kafka.v1.KafkaTopic.config:type_name -> idp.kafka.v1.KafkaTopic.ConfigEntry
+	100, // 10: idp.kafka.v1.KafkaTopic.config:type_name -> idp.kafka.v1.KafkaTopic.ConfigEntry
 	2,   // 11: idp.kafka.v1.KafkaTopic.status:type_name -> idp.kafka.v1.TopicStatus
-	108, // 12: idp.kafka.v1.KafkaTopic.approved_at:type_name -> google.protobuf.Timestamp
-	108, // 13: idp.kafka.v1.KafkaTopic.created_at:type_name -> google.protobuf.Timestamp
-	108, // 14: idp.kafka.v1.KafkaTopic.updated_at:type_name -> google.protobuf.Timestamp
+	115, // 12: idp.kafka.v1.KafkaTopic.approved_at:type_name -> google.protobuf.Timestamp
+	115, // 13: idp.kafka.v1.KafkaTopic.created_at:type_name -> google.protobuf.Timestamp
+	115, // 14: idp.kafka.v1.KafkaTopic.updated_at:type_name -> google.protobuf.Timestamp
 	3,   // 15: idp.kafka.v1.KafkaSchema.format:type_name -> idp.kafka.v1.SchemaFormat
 	4,   // 16: idp.kafka.v1.KafkaSchema.compatibility:type_name -> idp.kafka.v1.SchemaCompatibility
-	108, // 17: idp.kafka.v1.KafkaSchema.created_at:type_name -> google.protobuf.Timestamp
-	108, // 18: idp.kafka.v1.KafkaSchema.updated_at:type_name -> google.protobuf.Timestamp
+	115, // 17: idp.kafka.v1.KafkaSchema.created_at:type_name -> google.protobuf.Timestamp
+	115, // 18: idp.kafka.v1.KafkaSchema.updated_at:type_name -> google.protobuf.Timestamp
 	5,   // 19: idp.kafka.v1.KafkaServiceAccount.type:type_name -> idp.kafka.v1.ServiceAccountType
-	108, // 20: idp.kafka.v1.KafkaServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	115, // 20: idp.kafka.v1.KafkaServiceAccount.created_at:type_name -> google.protobuf.Timestamp
 	7,   // 21: idp.kafka.v1.KafkaTopicShare.permission:type_name -> idp.kafka.v1.SharePermission
 	6,   // 22: idp.kafka.v1.KafkaTopicShare.status:type_name -> idp.kafka.v1.ShareStatus
-	108, // 23: idp.kafka.v1.KafkaTopicShare.requested_at:type_name -> google.protobuf.Timestamp
-	108, // 24: idp.kafka.v1.KafkaTopicShare.approved_at:type_name -> google.protobuf.Timestamp
-	108, // 25: idp.kafka.v1.KafkaTopicShare.expires_at:type_name -> google.protobuf.Timestamp
+	115, // 23: idp.kafka.v1.KafkaTopicShare.requested_at:type_name -> google.protobuf.Timestamp
+	115, // 24: idp.kafka.v1.KafkaTopicShare.approved_at:type_name -> google.protobuf.Timestamp
+	115, // 25: idp.kafka.v1.KafkaTopicShare.expires_at:type_name -> google.protobuf.Timestamp
 	9,   // 26: idp.kafka.v1.KafkaTopicPolicy.scope:type_name -> idp.kafka.v1.PolicyScope
 	22,  // 27: idp.kafka.v1.KafkaTopicPolicy.partition_limits:type_name -> idp.kafka.v1.PartitionLimits
 	23,  // 28: idp.kafka.v1.KafkaTopicPolicy.retention_limits:type_name -> idp.kafka.v1.RetentionLimits
@@ -6726,32 +7103,32 @@ var file_idp_kafka_v1_kafka_proto_depIdxs = []int32{
 	25,  // 31: idp.kafka.v1.KafkaTopicSharePolicy.auto_approve:type_name -> idp.kafka.v1.AutoApproveConfig
 	7,   // 32: idp.kafka.v1.KafkaTopicSharePolicy.default_permission:type_name -> idp.kafka.v1.SharePermission
 	7,   // 33: idp.kafka.v1.AutoApproveConfig.permissions:type_name -> idp.kafka.v1.SharePermission
-	108, // 34: idp.kafka.v1.KafkaConsumerGroup.last_seen:type_name -> google.protobuf.Timestamp
-	108, // 35: idp.kafka.v1.KafkaConsumerGroup.last_updated:type_name -> google.protobuf.Timestamp
-	108, // 36: idp.kafka.v1.KafkaClientActivity.last_seen:type_name -> google.protobuf.Timestamp
+	115, // 34: idp.kafka.v1.KafkaConsumerGroup.last_seen:type_name -> google.protobuf.Timestamp
+	115, // 35: idp.kafka.v1.KafkaConsumerGroup.last_updated:type_name -> google.protobuf.Timestamp
+	115, // 36: idp.kafka.v1.KafkaClientActivity.last_seen:type_name -> google.protobuf.Timestamp
 	11,  // 37: idp.kafka.v1.ListProvidersResponse.providers:type_name -> idp.kafka.v1.KafkaProvider
-	96,  // 38: idp.kafka.v1.RegisterClusterRequest.connection_config:type_name -> idp.kafka.v1.RegisterClusterRequest.ConnectionConfigEntry
-	97,  // 39: idp.kafka.v1.RegisterClusterRequest.credentials:type_name -> idp.kafka.v1.RegisterClusterRequest.CredentialsEntry
+	101, // 38: idp.kafka.v1.RegisterClusterRequest.connection_config:type_name -> idp.kafka.v1.RegisterClusterRequest.ConnectionConfigEntry
+	102, // 39: idp.kafka.v1.RegisterClusterRequest.credentials:type_name -> idp.kafka.v1.RegisterClusterRequest.CredentialsEntry
 	13,  // 40: idp.kafka.v1.RegisterClusterResponse.cluster:type_name -> idp.kafka.v1.KafkaCluster
-	98,  // 41: idp.kafka.v1.ValidateClusterConnectionRequest.connection_config:type_name -> idp.kafka.v1.ValidateClusterConnectionRequest.ConnectionConfigEntry
-	99,  // 42: idp.kafka.v1.ValidateClusterConnectionRequest.credentials:type_name -> idp.kafka.v1.ValidateClusterConnectionRequest.CredentialsEntry
+	103, // 41: idp.kafka.v1.ValidateClusterConnectionRequest.connection_config:type_name -> idp.kafka.v1.ValidateClusterConnectionRequest.ConnectionConfigEntry
+	104, // 42: idp.kafka.v1.ValidateClusterConnectionRequest.credentials:type_name -> idp.kafka.v1.ValidateClusterConnectionRequest.CredentialsEntry
 	13,  // 43: idp.kafka.v1.ListClustersResponse.clusters:type_name -> idp.kafka.v1.KafkaCluster
-	100, // 44: idp.kafka.v1.CreateEnvironmentMappingRequest.routing_rule:type_name -> idp.kafka.v1.CreateEnvironmentMappingRequest.RoutingRuleEntry
+	105, // 44: idp.kafka.v1.CreateEnvironmentMappingRequest.routing_rule:type_name -> idp.kafka.v1.CreateEnvironmentMappingRequest.RoutingRuleEntry
 	14,  // 45: idp.kafka.v1.CreateEnvironmentMappingResponse.mapping:type_name -> idp.kafka.v1.KafkaEnvironmentMapping
 	14,  // 46: idp.kafka.v1.ListEnvironmentMappingsResponse.mappings:type_name -> idp.kafka.v1.KafkaEnvironmentMapping
-	101, // 47: idp.kafka.v1.CreateTopicRequest.config:type_name -> idp.kafka.v1.CreateTopicRequest.ConfigEntry
+	106, // 47: idp.kafka.v1.CreateTopicRequest.config:type_name -> idp.kafka.v1.CreateTopicRequest.ConfigEntry
 	18,  // 48: idp.kafka.v1.CreateTopicRequest.schema:type_name -> idp.kafka.v1.KafkaSchema
 	17,  // 49: idp.kafka.v1.CreateTopicResponse.topic:type_name -> idp.kafka.v1.KafkaTopic
-	102, // 50: idp.kafka.v1.CreateTopicDirectRequest.config:type_name -> idp.kafka.v1.CreateTopicDirectRequest.ConfigEntry
-	103, // 51: idp.kafka.v1.CreateTopicDirectRequest.connection_config:type_name -> idp.kafka.v1.CreateTopicDirectRequest.ConnectionConfigEntry
-	104, // 52: idp.kafka.v1.CreateTopicDirectRequest.credentials:type_name -> idp.kafka.v1.CreateTopicDirectRequest.CredentialsEntry
+	107, // 50: idp.kafka.v1.CreateTopicDirectRequest.config:type_name -> idp.kafka.v1.CreateTopicDirectRequest.ConfigEntry
+	108, // 51: idp.kafka.v1.CreateTopicDirectRequest.connection_config:type_name -> idp.kafka.v1.CreateTopicDirectRequest.ConnectionConfigEntry
+	109, // 52: idp.kafka.v1.CreateTopicDirectRequest.credentials:type_name -> idp.kafka.v1.CreateTopicDirectRequest.CredentialsEntry
 	17,  // 53: idp.kafka.v1.GetTopicResponse.topic:type_name -> idp.kafka.v1.KafkaTopic
 	2,   // 54: idp.kafka.v1.ListTopicsRequest.status:type_name -> idp.kafka.v1.TopicStatus
 	17,  // 55: idp.kafka.v1.ListTopicsResponse.topics:type_name -> idp.kafka.v1.KafkaTopic
-	105, // 56: idp.kafka.v1.UpdateTopicRequest.config:type_name -> idp.kafka.v1.UpdateTopicRequest.ConfigEntry
+	110, // 56: idp.kafka.v1.UpdateTopicRequest.config:type_name -> idp.kafka.v1.UpdateTopicRequest.ConfigEntry
 	17,  // 57: idp.kafka.v1.UpdateTopicResponse.topic:type_name -> idp.kafka.v1.KafkaTopic
-	106, // 58: idp.kafka.v1.DeleteTopicByNameRequest.connection_config:type_name -> idp.kafka.v1.DeleteTopicByNameRequest.ConnectionConfigEntry
-	107, // 59: idp.kafka.v1.DeleteTopicByNameRequest.credentials:type_name -> idp.kafka.v1.DeleteTopicByNameRequest.CredentialsEntry
+	111, // 58: idp.kafka.v1.DeleteTopicByNameRequest.connection_config:type_name -> idp.kafka.v1.DeleteTopicByNameRequest.ConnectionConfigEntry
+	112, // 59: idp.kafka.v1.DeleteTopicByNameRequest.credentials:type_name -> idp.kafka.v1.DeleteTopicByNameRequest.CredentialsEntry
 	17,  // 60: idp.kafka.v1.ApproveTopicResponse.topic:type_name -> idp.kafka.v1.KafkaTopic
 	3,   // 61: idp.kafka.v1.RegisterSchemaRequest.format:type_name -> idp.kafka.v1.SchemaFormat
 	4,   // 62: idp.kafka.v1.RegisterSchemaRequest.compatibility:type_name -> idp.kafka.v1.SchemaCompatibility
@@ -6774,74 +7151,82 @@ var file_idp_kafka_v1_kafka_proto_depIdxs = []int32{
 	26,  // 79: idp.kafka.v1.GetTopicMetricsResponse.metrics:type_name -> idp.kafka.v1.KafkaUsageMetrics
 	92,  // 80: idp.kafka.v1.GetTopicLineageResponse.producers:type_name -> idp.kafka.v1.LineageNode
 	92,  // 81: idp.kafka.v1.GetTopicLineageResponse.consumers:type_name -> idp.kafka.v1.LineageNode
-	108, // 82: idp.kafka.v1.LineageNode.last_seen:type_name -> google.protobuf.Timestamp
-	29,  // 83: idp.kafka.v1.KafkaService.ListProviders:input_type -> idp.kafka.v1.ListProvidersRequest
-	31,  // 84: idp.kafka.v1.KafkaService.RegisterCluster:input_type -> idp.kafka.v1.RegisterClusterRequest
-	33,  // 85: idp.kafka.v1.KafkaService.ValidateCluster:input_type -> idp.kafka.v1.ValidateClusterRequest
-	35,  // 86: idp.kafka.v1.KafkaService.ValidateClusterConnection:input_type -> idp.kafka.v1.ValidateClusterConnectionRequest
-	37,  // 87: idp.kafka.v1.KafkaService.ListClusters:input_type -> idp.kafka.v1.ListClustersRequest
-	39,  // 88: idp.kafka.v1.KafkaService.DeleteCluster:input_type -> idp.kafka.v1.DeleteClusterRequest
-	41,  // 89: idp.kafka.v1.KafkaService.CreateEnvironmentMapping:input_type -> idp.kafka.v1.CreateEnvironmentMappingRequest
-	43,  // 90: idp.kafka.v1.KafkaService.ListEnvironmentMappings:input_type -> idp.kafka.v1.ListEnvironmentMappingsRequest
-	45,  // 91: idp.kafka.v1.KafkaService.DeleteEnvironmentMapping:input_type -> idp.kafka.v1.DeleteEnvironmentMappingRequest
-	47,  // 92: idp.kafka.v1.KafkaService.CreateTopic:input_type -> idp.kafka.v1.CreateTopicRequest
-	49,  // 93: idp.kafka.v1.KafkaService.CreateTopicDirect:input_type -> idp.kafka.v1.CreateTopicDirectRequest
-	51,  // 94: idp.kafka.v1.KafkaService.GetTopic:input_type -> idp.kafka.v1.GetTopicRequest
-	53,  // 95: idp.kafka.v1.KafkaService.ListTopics:input_type -> idp.kafka.v1.ListTopicsRequest
-	55,  // 96: idp.kafka.v1.KafkaService.UpdateTopic:input_type -> idp.kafka.v1.UpdateTopicRequest
-	57,  // 97: idp.kafka.v1.KafkaService.DeleteTopic:input_type -> idp.kafka.v1.DeleteTopicRequest
-	59,  // 98: idp.kafka.v1.KafkaService.DeleteTopicByName:input_type -> idp.kafka.v1.DeleteTopicByNameRequest
-	61,  // 99: idp.kafka.v1.KafkaService.ApproveTopic:input_type -> idp.kafka.v1.ApproveTopicRequest
-	63,  // 100: idp.kafka.v1.KafkaService.RegisterSchema:input_type -> idp.kafka.v1.RegisterSchemaRequest
-	65,  // 101: idp.kafka.v1.KafkaService.GetSchema:input_type -> idp.kafka.v1.GetSchemaRequest
-	67,  // 102: idp.kafka.v1.KafkaService.ListSchemas:input_type -> idp.kafka.v1.ListSchemasRequest
-	69,  // 103: idp.kafka.v1.KafkaService.CheckSchemaCompatibility:input_type -> idp.kafka.v1.CheckSchemaCompatibilityRequest
-	71,  // 104: idp.kafka.v1.KafkaService.CreateServiceAccount:input_type -> idp.kafka.v1.CreateServiceAccountRequest
-	73,  // 105: idp.kafka.v1.KafkaService.ListServiceAccounts:input_type -> idp.kafka.v1.ListServiceAccountsRequest
-	75,  // 106: idp.kafka.v1.KafkaService.RevokeServiceAccount:input_type -> idp.kafka.v1.RevokeServiceAccountRequest
-	77,  // 107: idp.kafka.v1.KafkaService.RequestTopicAccess:input_type -> idp.kafka.v1.RequestTopicAccessRequest
-	79,  // 108: idp.kafka.v1.KafkaService.ApproveTopicAccess:input_type -> idp.kafka.v1.ApproveTopicAccessRequest
-	81,  // 109: idp.kafka.v1.KafkaService.RevokeTopicAccess:input_type -> idp.kafka.v1.RevokeTopicAccessRequest
-	83,  // 110: idp.kafka.v1.KafkaService.ListTopicShares:input_type -> idp.kafka.v1.ListTopicSharesRequest
-	85,  // 111: idp.kafka.v1.KafkaService.DiscoverTopics:input_type -> idp.kafka.v1.DiscoverTopicsRequest
-	88,  // 112: idp.kafka.v1.KafkaService.GetTopicMetrics:input_type -> idp.kafka.v1.GetTopicMetricsRequest
-	90,  // 113: idp.kafka.v1.KafkaService.GetTopicLineage:input_type -> idp.kafka.v1.GetTopicLineageRequest
-	30,  // 114: idp.kafka.v1.KafkaService.ListProviders:output_type -> idp.kafka.v1.ListProvidersResponse
-	32,  // 115: idp.kafka.v1.KafkaService.RegisterCluster:output_type -> idp.kafka.v1.RegisterClusterResponse
-	34,  // 116: idp.kafka.v1.KafkaService.ValidateCluster:output_type -> idp.kafka.v1.ValidateClusterResponse
-	36,  // 117: idp.kafka.v1.KafkaService.ValidateClusterConnection:output_type -> idp.kafka.v1.ValidateClusterConnectionResponse
-	38,  // 118: idp.kafka.v1.KafkaService.ListClusters:output_type -> idp.kafka.v1.ListClustersResponse
-	40,  // 119: idp.kafka.v1.KafkaService.DeleteCluster:output_type -> idp.kafka.v1.DeleteClusterResponse
-	42,  // 120: idp.kafka.v1.KafkaService.CreateEnvironmentMapping:output_type -> idp.kafka.v1.CreateEnvironmentMappingResponse
-	44,  // 121: idp.kafka.v1.KafkaService.ListEnvironmentMappings:output_type -> idp.kafka.v1.ListEnvironmentMappingsResponse
-	46,  // 122: idp.kafka.v1.KafkaService.DeleteEnvironmentMapping:output_type -> idp.kafka.v1.DeleteEnvironmentMappingResponse
-	48,  // 123: idp.kafka.v1.KafkaService.CreateTopic:output_type -> idp.kafka.v1.CreateTopicResponse
-	50,  // 124: idp.kafka.v1.KafkaService.CreateTopicDirect:output_type -> idp.kafka.v1.CreateTopicDirectResponse
-	52,  // 125: idp.kafka.v1.KafkaService.GetTopic:output_type -> idp.kafka.v1.GetTopicResponse
-	54,  // 126: idp.kafka.v1.KafkaService.ListTopics:output_type -> idp.kafka.v1.ListTopicsResponse
-	56,  // 127: idp.kafka.v1.KafkaService.UpdateTopic:output_type -> idp.kafka.v1.UpdateTopicResponse
-	58,  // 128: idp.kafka.v1.KafkaService.DeleteTopic:output_type -> idp.kafka.v1.DeleteTopicResponse
-	60,  // 129: idp.kafka.v1.KafkaService.DeleteTopicByName:output_type -> idp.kafka.v1.DeleteTopicByNameResponse
-	62,  // 130: idp.kafka.v1.KafkaService.ApproveTopic:output_type -> idp.kafka.v1.ApproveTopicResponse
-	64,  // 131: idp.kafka.v1.KafkaService.RegisterSchema:output_type -> idp.kafka.v1.RegisterSchemaResponse
-	66,  // 132: idp.kafka.v1.KafkaService.GetSchema:output_type -> idp.kafka.v1.GetSchemaResponse
-	68,  // 133: idp.kafka.v1.KafkaService.ListSchemas:output_type -> idp.kafka.v1.ListSchemasResponse
-	70,  // 134: idp.kafka.v1.KafkaService.CheckSchemaCompatibility:output_type -> idp.kafka.v1.CheckSchemaCompatibilityResponse
-	72,  // 135: idp.kafka.v1.KafkaService.CreateServiceAccount:output_type -> idp.kafka.v1.CreateServiceAccountResponse
-	74,  // 136: idp.kafka.v1.KafkaService.ListServiceAccounts:output_type -> idp.kafka.v1.ListServiceAccountsResponse
-	76,  // 137: idp.kafka.v1.KafkaService.RevokeServiceAccount:output_type -> idp.kafka.v1.RevokeServiceAccountResponse
-	78,  // 138: idp.kafka.v1.KafkaService.RequestTopicAccess:output_type -> idp.kafka.v1.RequestTopicAccessResponse
-	80,  // 139: idp.kafka.v1.KafkaService.ApproveTopicAccess:output_type -> idp.kafka.v1.ApproveTopicAccessResponse
-	82,  // 140: idp.kafka.v1.KafkaService.RevokeTopicAccess:output_type -> idp.kafka.v1.RevokeTopicAccessResponse
-	84,  // 141: idp.kafka.v1.KafkaService.ListTopicShares:output_type -> idp.kafka.v1.ListTopicSharesResponse
-	86,  // 142: idp.kafka.v1.KafkaService.DiscoverTopics:output_type -> idp.kafka.v1.DiscoverTopicsResponse
-	89,  // 143: idp.kafka.v1.KafkaService.GetTopicMetrics:output_type -> idp.kafka.v1.GetTopicMetricsResponse
-	91,  // 144: idp.kafka.v1.KafkaService.GetTopicLineage:output_type -> idp.kafka.v1.GetTopicLineageResponse
-	114, // [114:145] is the sub-list for method output_type
-	83,  // [83:114] is the sub-list for method input_type
-	83,  // [83:83] is the sub-list for extension type_name
-	83,  // [83:83] is the sub-list for extension extendee
-	0,   // [0:83] is the sub-list for field type_name
+	115, // 82: idp.kafka.v1.LineageNode.last_seen:type_name -> google.protobuf.Timestamp
+	113, // 83: idp.kafka.v1.DescribeTopicRequest.credentials:type_name -> idp.kafka.v1.DescribeTopicRequest.CredentialsEntry
+	17,  // 84: idp.kafka.v1.DescribeTopicResponse.topic:type_name -> idp.kafka.v1.KafkaTopic
+	95,  // 85: idp.kafka.v1.DescribeTopicResponse.broker_state:type_name -> idp.kafka.v1.TopicBrokerState
+	97,  // 86: idp.kafka.v1.DescribeTopicResponse.drift:type_name -> idp.kafka.v1.TopicDrift
+	114, // 87: idp.kafka.v1.TopicBrokerState.config:type_name -> idp.kafka.v1.TopicBrokerState.ConfigEntry
+	96,  // 88: idp.kafka.v1.TopicBrokerState.partition_states:type_name -> idp.kafka.v1.TopicPartitionState
+	29,  // 89: idp.kafka.v1.KafkaService.ListProviders:input_type -> idp.kafka.v1.ListProvidersRequest
+	31,  // 90: idp.kafka.v1.KafkaService.RegisterCluster:input_type -> idp.kafka.v1.RegisterClusterRequest
+	33,  // 91: idp.kafka.v1.KafkaService.ValidateCluster:input_type -> idp.kafka.v1.ValidateClusterRequest
+	35,  // 92: idp.kafka.v1.KafkaService.ValidateClusterConnection:input_type -> idp.kafka.v1.ValidateClusterConnectionRequest
+	37,  // 93: idp.kafka.v1.KafkaService.ListClusters:input_type -> idp.kafka.v1.ListClustersRequest
+	39,  // 94: idp.kafka.v1.KafkaService.DeleteCluster:input_type -> idp.kafka.v1.DeleteClusterRequest
+	41,  // 95: idp.kafka.v1.KafkaService.CreateEnvironmentMapping:input_type -> idp.kafka.v1.CreateEnvironmentMappingRequest
+	43,  // 96: idp.kafka.v1.KafkaService.ListEnvironmentMappings:input_type -> idp.kafka.v1.ListEnvironmentMappingsRequest
+	45,  // 97: idp.kafka.v1.KafkaService.DeleteEnvironmentMapping:input_type -> idp.kafka.v1.DeleteEnvironmentMappingRequest
+	47,  // 98: idp.kafka.v1.KafkaService.CreateTopic:input_type -> idp.kafka.v1.CreateTopicRequest
+	49,  // 99: idp.kafka.v1.KafkaService.CreateTopicDirect:input_type -> idp.kafka.v1.CreateTopicDirectRequest
+	51,  // 100: idp.kafka.v1.KafkaService.GetTopic:input_type -> idp.kafka.v1.GetTopicRequest
+	93,  // 101: idp.kafka.v1.KafkaService.DescribeTopic:input_type -> idp.kafka.v1.DescribeTopicRequest
+	53,  // 102: idp.kafka.v1.KafkaService.ListTopics:input_type -> idp.kafka.v1.ListTopicsRequest
+	55,  // 103: idp.kafka.v1.KafkaService.UpdateTopic:input_type -> idp.kafka.v1.UpdateTopicRequest
+	57,  // 104: idp.kafka.v1.KafkaService.DeleteTopic:input_type -> idp.kafka.v1.DeleteTopicRequest
+	59,  // 105: idp.kafka.v1.KafkaService.DeleteTopicByName:input_type -> idp.kafka.v1.DeleteTopicByNameRequest
+	61,  // 106: idp.kafka.v1.KafkaService.ApproveTopic:input_type -> idp.kafka.v1.ApproveTopicRequest
+	63,  // 107: idp.kafka.v1.KafkaService.RegisterSchema:input_type -> idp.kafka.v1.RegisterSchemaRequest
+	65,  // 108: idp.kafka.v1.KafkaService.GetSchema:input_type -> idp.kafka.v1.GetSchemaRequest
+	67,  // 109: idp.kafka.v1.KafkaService.ListSchemas:input_type -> idp.kafka.v1.ListSchemasRequest
+	69,  // 110: idp.kafka.v1.KafkaService.CheckSchemaCompatibility:input_type -> idp.kafka.v1.CheckSchemaCompatibilityRequest
+	71,  // 111: idp.kafka.v1.KafkaService.CreateServiceAccount:input_type -> idp.kafka.v1.CreateServiceAccountRequest
+	73,  // 112: idp.kafka.v1.KafkaService.ListServiceAccounts:input_type -> idp.kafka.v1.ListServiceAccountsRequest
+	75,  // 113: idp.kafka.v1.KafkaService.RevokeServiceAccount:input_type -> idp.kafka.v1.RevokeServiceAccountRequest
+	77,  // 114: idp.kafka.v1.KafkaService.RequestTopicAccess:input_type -> idp.kafka.v1.RequestTopicAccessRequest
+	79,  // 115: idp.kafka.v1.KafkaService.ApproveTopicAccess:input_type -> idp.kafka.v1.ApproveTopicAccessRequest
+	81,  // 116: idp.kafka.v1.KafkaService.RevokeTopicAccess:input_type -> idp.kafka.v1.RevokeTopicAccessRequest
+	83,  // 117: idp.kafka.v1.KafkaService.ListTopicShares:input_type -> idp.kafka.v1.ListTopicSharesRequest
+	85,  // 118: idp.kafka.v1.KafkaService.DiscoverTopics:input_type -> idp.kafka.v1.DiscoverTopicsRequest
+	88,  // 119: idp.kafka.v1.KafkaService.GetTopicMetrics:input_type -> idp.kafka.v1.GetTopicMetricsRequest
+	90,  // 120: idp.kafka.v1.KafkaService.GetTopicLineage:input_type -> idp.kafka.v1.GetTopicLineageRequest
+	30,  // 121: idp.kafka.v1.KafkaService.ListProviders:output_type -> idp.kafka.v1.ListProvidersResponse
+	32,  // 122: idp.kafka.v1.KafkaService.RegisterCluster:output_type -> idp.kafka.v1.RegisterClusterResponse
+	34,  // 123: idp.kafka.v1.KafkaService.ValidateCluster:output_type -> idp.kafka.v1.ValidateClusterResponse
+	36,  // 124: idp.kafka.v1.KafkaService.ValidateClusterConnection:output_type -> idp.kafka.v1.ValidateClusterConnectionResponse
+	38,  // 125: idp.kafka.v1.KafkaService.ListClusters:output_type -> idp.kafka.v1.ListClustersResponse
+	40,  // 126: idp.kafka.v1.KafkaService.DeleteCluster:output_type -> idp.kafka.v1.DeleteClusterResponse
+	42,  // 127: idp.kafka.v1.KafkaService.CreateEnvironmentMapping:output_type -> idp.kafka.v1.CreateEnvironmentMappingResponse
+	44,  // 128: idp.kafka.v1.KafkaService.ListEnvironmentMappings:output_type -> idp.kafka.v1.ListEnvironmentMappingsResponse
+	46,  // 129: idp.kafka.v1.KafkaService.DeleteEnvironmentMapping:output_type -> idp.kafka.v1.DeleteEnvironmentMappingResponse
+	48,  // 130: idp.kafka.v1.KafkaService.CreateTopic:output_type -> idp.kafka.v1.CreateTopicResponse
+	50,  // 131: idp.kafka.v1.KafkaService.CreateTopicDirect:output_type -> idp.kafka.v1.CreateTopicDirectResponse
+	52,  // 132: idp.kafka.v1.KafkaService.GetTopic:output_type -> idp.kafka.v1.GetTopicResponse
+	94,  // 133: idp.kafka.v1.KafkaService.DescribeTopic:output_type -> idp.kafka.v1.DescribeTopicResponse
+	54,  // 134: idp.kafka.v1.KafkaService.ListTopics:output_type -> idp.kafka.v1.ListTopicsResponse
+	56,  // 135: idp.kafka.v1.KafkaService.UpdateTopic:output_type -> idp.kafka.v1.UpdateTopicResponse
+	58,  // 136: idp.kafka.v1.KafkaService.DeleteTopic:output_type -> idp.kafka.v1.DeleteTopicResponse
+	60,  // 137: idp.kafka.v1.KafkaService.DeleteTopicByName:output_type -> idp.kafka.v1.DeleteTopicByNameResponse
+	62,  // 138: idp.kafka.v1.KafkaService.ApproveTopic:output_type -> idp.kafka.v1.ApproveTopicResponse
+	64,  // 139: idp.kafka.v1.KafkaService.RegisterSchema:output_type -> idp.kafka.v1.RegisterSchemaResponse
+	66,  // 140: idp.kafka.v1.KafkaService.GetSchema:output_type -> idp.kafka.v1.GetSchemaResponse
+	68,  // 141: idp.kafka.v1.KafkaService.ListSchemas:output_type -> idp.kafka.v1.ListSchemasResponse
+	70,  // 142: idp.kafka.v1.KafkaService.CheckSchemaCompatibility:output_type -> idp.kafka.v1.CheckSchemaCompatibilityResponse
+	72,  // 143: idp.kafka.v1.KafkaService.CreateServiceAccount:output_type -> idp.kafka.v1.CreateServiceAccountResponse
+	74,  // 144: idp.kafka.v1.KafkaService.ListServiceAccounts:output_type -> idp.kafka.v1.ListServiceAccountsResponse
+	76,  // 145: idp.kafka.v1.KafkaService.RevokeServiceAccount:output_type -> idp.kafka.v1.RevokeServiceAccountResponse
+	78,  // 146: idp.kafka.v1.KafkaService.RequestTopicAccess:output_type -> idp.kafka.v1.RequestTopicAccessResponse
+	80,  // 147: idp.kafka.v1.KafkaService.ApproveTopicAccess:output_type -> idp.kafka.v1.ApproveTopicAccessResponse
+	82,  // 148: idp.kafka.v1.KafkaService.RevokeTopicAccess:output_type -> idp.kafka.v1.RevokeTopicAccessResponse
+	84,  // 149: idp.kafka.v1.KafkaService.ListTopicShares:output_type -> idp.kafka.v1.ListTopicSharesResponse
+	86,  // 150: idp.kafka.v1.KafkaService.DiscoverTopics:output_type -> idp.kafka.v1.DiscoverTopicsResponse
+	89,  // 151: idp.kafka.v1.KafkaService.GetTopicMetrics:output_type -> idp.kafka.v1.GetTopicMetricsResponse
+	91,  // 152: idp.kafka.v1.KafkaService.GetTopicLineage:output_type -> idp.kafka.v1.GetTopicLineageResponse
+	121, // [121:153] is the sub-list for method output_type
+	89,  // [89:121] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_idp_kafka_v1_kafka_proto_init() }
@@ -6856,7 +7241,7 @@ func file_idp_kafka_v1_kafka_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idp_kafka_v1_kafka_proto_rawDesc), len(file_idp_kafka_v1_kafka_proto_rawDesc)),
 			NumEnums:      11,
-			NumMessages:   97,
+			NumMessages:   104,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
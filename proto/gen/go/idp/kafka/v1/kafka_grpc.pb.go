@@ -31,6 +31,7 @@ const (
 	KafkaService_CreateTopic_FullMethodName               = "/idp.kafka.v1.KafkaService/CreateTopic"
 	KafkaService_CreateTopicDirect_FullMethodName         = "/idp.kafka.v1.KafkaService/CreateTopicDirect"
 	KafkaService_GetTopic_FullMethodName                  = "/idp.kafka.v1.KafkaService/GetTopic"
+	KafkaService_DescribeTopic_FullMethodName             = "/idp.kafka.v1.KafkaService/DescribeTopic"
 	KafkaService_ListTopics_FullMethodName                = "/idp.kafka.v1.KafkaService/ListTopics"
 	KafkaService_UpdateTopic_FullMethodName               = "/idp.kafka.v1.KafkaService/UpdateTopic"
 	KafkaService_DeleteTopic_FullMethodName               = "/idp.kafka.v1.KafkaService/DeleteTopic"
@@ -71,6 +72,7 @@ type KafkaServiceClient interface {
 	CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error)
 	CreateTopicDirect(ctx context.Context, in *CreateTopicDirectRequest, opts ...grpc.CallOption) (*CreateTopicDirectResponse, error)
 	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
+	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
 	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
 	UpdateTopic(ctx context.Context, in *UpdateTopicRequest, opts ...grpc.CallOption) (*UpdateTopicResponse, error)
 	DeleteTopic(ctx context.Context, in *DeleteTopicRequest, opts ...grpc.CallOption) (*DeleteTopicResponse, error)
@@ -225,6 +227,16 @@ func (c *kafkaServiceClient) GetTopic(ctx context.Context, in *GetTopicRequest,
 	return out, nil
 }
 
+func (c *kafkaServiceClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeTopicResponse)
+	err := c.cc.Invoke(ctx, KafkaService_DescribeTopic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kafkaServiceClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTopicsResponse)
@@ -434,6 +446,7 @@ type KafkaServiceServer interface {
 	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
 	CreateTopicDirect(context.Context, *CreateTopicDirectRequest) (*CreateTopicDirectResponse, error)
 	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
+	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
 	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
 	UpdateTopic(context.Context, *UpdateTopicRequest) (*UpdateTopicResponse, error)
 	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error)
@@ -504,6 +517,9 @@ func (UnimplementedKafkaServiceServer) CreateTopicDirect(context.Context, *Creat
 func (UnimplementedKafkaServiceServer) GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTopic not implemented")
 }
+func (UnimplementedKafkaServiceServer) DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeTopic not implemented")
+}
 func (UnimplementedKafkaServiceServer) ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTopics not implemented")
 }
@@ -798,6 +814,24 @@ func _KafkaService_GetTopic_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaService_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaServiceServer).DescribeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaService_DescribeTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaServiceServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KafkaService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTopicsRequest)
 	if err := dec(in); err != nil {
@@ -1195,6 +1229,10 @@ var KafkaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopic",
 			Handler:    _KafkaService_GetTopic_Handler,
 		},
+		{
+			MethodName: "DescribeTopic",
+			Handler:    _KafkaService_DescribeTopic_Handler,
+		},
 		{
 			MethodName: "ListTopics",
 			Handler:    _KafkaService_ListTopics_Handler,
@@ -68,6 +68,9 @@ const (
 	KafkaServiceCreateTopicDirectProcedure = "/idp.kafka.v1.KafkaService/CreateTopicDirect"
 	// KafkaServiceGetTopicProcedure is the fully-qualified name of the KafkaService's GetTopic RPC.
 	KafkaServiceGetTopicProcedure = "/idp.kafka.v1.KafkaService/GetTopic"
+	// KafkaServiceDescribeTopicProcedure is the fully-qualified name of the KafkaService's
+	// DescribeTopic RPC.
+	KafkaServiceDescribeTopicProcedure = "/idp.kafka.v1.KafkaService/DescribeTopic"
 	// KafkaServiceListTopicsProcedure is the fully-qualified name of the KafkaService's ListTopics RPC.
 	KafkaServiceListTopicsProcedure = "/idp.kafka.v1.KafkaService/ListTopics"
 	// KafkaServiceUpdateTopicProcedure is the fully-qualified name of the KafkaService's UpdateTopic
@@ -142,6 +145,7 @@ type KafkaServiceClient interface {
 	CreateTopic(context.Context, *connect.Request[v1.CreateTopicRequest]) (*connect.Response[v1.CreateTopicResponse], error)
 	CreateTopicDirect(context.Context, *connect.Request[v1.CreateTopicDirectRequest]) (*connect.Response[v1.CreateTopicDirectResponse], error)
 	GetTopic(context.Context, *connect.Request[v1.GetTopicRequest]) (*connect.Response[v1.GetTopicResponse], error)
+	DescribeTopic(context.Context, *connect.Request[v1.DescribeTopicRequest]) (*connect.Response[v1.DescribeTopicResponse], error)
 	ListTopics(context.Context, *connect.Request[v1.ListTopicsRequest]) (*connect.Response[v1.ListTopicsResponse], error)
 	UpdateTopic(context.Context, *connect.Request[v1.UpdateTopicRequest]) (*connect.Response[v1.UpdateTopicResponse], error)
 	DeleteTopic(context.Context, *connect.Request[v1.DeleteTopicRequest]) (*connect.Response[v1.DeleteTopicResponse], error)
@@ -251,6 +255,12 @@ func NewKafkaServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kafkaServiceMethods.ByName("GetTopic")),
 			connect.WithClientOptions(opts...),
 		),
+		describeTopic: connect.NewClient[v1.DescribeTopicRequest, v1.DescribeTopicResponse](
+			httpClient,
+			baseURL+KafkaServiceDescribeTopicProcedure,
+			connect.WithSchema(kafkaServiceMethods.ByName("DescribeTopic")),
+			connect.WithClientOptions(opts...),
+		),
 		listTopics: connect.NewClient[v1.ListTopicsRequest, v1.ListTopicsResponse](
 			httpClient,
 			baseURL+KafkaServiceListTopicsProcedure,
@@ -382,6 +392,7 @@ type kafkaServiceClient struct {
 	createTopic               *connect.Client[v1.CreateTopicRequest, v1.CreateTopicResponse]
 	createTopicDirect         *connect.Client[v1.CreateTopicDirectRequest, v1.CreateTopicDirectResponse]
 	getTopic                  *connect.Client[v1.GetTopicRequest, v1.GetTopicResponse]
+	describeTopic             *connect.Client[v1.DescribeTopicRequest, v1.DescribeTopicResponse]
 	listTopics                *connect.Client[v1.ListTopicsRequest, v1.ListTopicsResponse]
 	updateTopic               *connect.Client[v1.UpdateTopicRequest, v1.UpdateTopicResponse]
 	deleteTopic               *connect.Client[v1.DeleteTopicRequest, v1.DeleteTopicResponse]
@@ -463,6 +474,11 @@ func (c *kafkaServiceClient) GetTopic(ctx context.Context, req *connect.Request[
 	return c.getTopic.CallUnary(ctx, req)
 }
 
+// DescribeTopic calls idp.kafka.v1.KafkaService.DescribeTopic.
+func (c *kafkaServiceClient) DescribeTopic(ctx context.Context, req *connect.Request[v1.DescribeTopicRequest]) (*connect.Response[v1.DescribeTopicResponse], error) {
+	return c.describeTopic.CallUnary(ctx, req)
+}
+
 // ListTopics calls idp.kafka.v1.KafkaService.ListTopics.
 func (c *kafkaServiceClient) ListTopics(ctx context.Context, req *connect.Request[v1.ListTopicsRequest]) (*connect.Response[v1.ListTopicsResponse], error) {
 	return c.listTopics.CallUnary(ctx, req)
@@ -575,6 +591,7 @@ type KafkaServiceHandler interface {
 	CreateTopic(context.Context, *connect.Request[v1.CreateTopicRequest]) (*connect.Response[v1.CreateTopicResponse], error)
 	CreateTopicDirect(context.Context, *connect.Request[v1.CreateTopicDirectRequest]) (*connect.Response[v1.CreateTopicDirectResponse], error)
 	GetTopic(context.Context, *connect.Request[v1.GetTopicRequest]) (*connect.Response[v1.GetTopicResponse], error)
+	DescribeTopic(context.Context, *connect.Request[v1.DescribeTopicRequest]) (*connect.Response[v1.DescribeTopicResponse], error)
 	ListTopics(context.Context, *connect.Request[v1.ListTopicsRequest]) (*connect.Response[v1.ListTopicsResponse], error)
 	UpdateTopic(context.Context, *connect.Request[v1.UpdateTopicRequest]) (*connect.Response[v1.UpdateTopicResponse], error)
 	DeleteTopic(context.Context, *connect.Request[v1.DeleteTopicRequest]) (*connect.Response[v1.DeleteTopicResponse], error)
@@ -680,6 +697,12 @@ func NewKafkaServiceHandler(svc KafkaServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kafkaServiceMethods.ByName("GetTopic")),
 		connect.WithHandlerOptions(opts...),
 	)
+	kafkaServiceDescribeTopicHandler := connect.NewUnaryHandler(
+		KafkaServiceDescribeTopicProcedure,
+		svc.DescribeTopic,
+		connect.WithSchema(kafkaServiceMethods.ByName("DescribeTopic")),
+		connect.WithHandlerOptions(opts...),
+	)
 	kafkaServiceListTopicsHandler := connect.NewUnaryHandler(
 		KafkaServiceListTopicsProcedure,
 		svc.ListTopics,
@@ -820,6 +843,8 @@ func NewKafkaServiceHandler(svc KafkaServiceHandler, opts ...connect.HandlerOpti
 			kafkaServiceCreateTopicDirectHandler.ServeHTTP(w, r)
 		case KafkaServiceGetTopicProcedure:
 			kafkaServiceGetTopicHandler.ServeHTTP(w, r)
+		case KafkaServiceDescribeTopicProcedure:
+			kafkaServiceDescribeTopicHandler.ServeHTTP(w, r)
 		case KafkaServiceListTopicsProcedure:
 			kafkaServiceListTopicsHandler.ServeHTTP(w, r)
 		case KafkaServiceUpdateTopicProcedure:
@@ -915,6 +940,10 @@ func (UnimplementedKafkaServiceHandler) GetTopic(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.kafka.v1.KafkaService.GetTopic is not implemented"))
 }
 
+func (UnimplementedKafkaServiceHandler) DescribeTopic(context.Context, *connect.Request[v1.DescribeTopicRequest]) (*connect.Response[v1.DescribeTopicResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.kafka.v1.KafkaService.DescribeTopic is not implemented"))
+}
+
 func (UnimplementedKafkaServiceHandler) ListTopics(context.Context, *connect.Request[v1.ListTopicsRequest]) (*connect.Response[v1.ListTopicsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("idp.kafka.v1.KafkaService.ListTopics is not implemented"))
 }
@@ -96,8 +96,11 @@ type StartInstantiationRequest struct {
 	SourceRepoName   string `protobuf:"bytes,12,opt,name=source_repo_name,json=sourceRepoName,proto3" json:"source_repo_name,omitempty"`        // Name of template repo (parsed from URL)
 	// GitHub installation for authentication
 	GithubInstallationId string `protobuf:"bytes,13,opt,name=github_installation_id,json=githubInstallationId,proto3" json:"github_installation_id,omitempty"` // ID of the GitHub App installation to use
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Caller-chosen key that makes retries of the same instantiation start a
+	// single workflow. Empty starts a new workflow on every call.
+	IdempotencyKey string `protobuf:"bytes,14,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StartInstantiationRequest) Reset() {
@@ -221,6 +224,13 @@ func (x *StartInstantiationRequest) GetGithubInstallationId() string {
 	return ""
 }
 
+func (x *StartInstantiationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type StartInstantiationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -641,7 +651,7 @@ var File_idp_template_v1_template_proto protoreflect.FileDescriptor
 
 const file_idp_template_v1_template_proto_rawDesc = "" +
 	"\n" +
-	"\x1eidp/template/v1/template.proto\x12\x0fidp.template.v1\"\xfa\x04\n" +
+	"\x1eidp/template/v1/template.proto\x12\x0fidp.template.v1\"\xa3\x05\n" +
 	"\x19StartInstantiationRequest\x12\x1f\n" +
 	"\vtemplate_id\x18\x01 \x01(\tR\n" +
 	"templateId\x12!\n" +
@@ -659,7 +669,8 @@ const file_idp_template_v1_template_proto_rawDesc = "" +
 	" \x01(\bR\x10isGithubTemplate\x12*\n" +
 	"\x11source_repo_owner\x18\v \x01(\tR\x0fsourceRepoOwner\x12(\n" +
 	"\x10source_repo_name\x18\f \x01(\tR\x0esourceRepoName\x124\n" +
-	"\x16github_installation_id\x18\r \x01(\tR\x14githubInstallationId\x1a<\n" +
+	"\x16github_installation_id\x18\r \x01(\tR\x14githubInstallationId\x12'\n" +
+	"\x0fidempotency_key\x18\x0e \x01(\tR\x0eidempotencyKey\x1a<\n" +
 	"\x0eVariablesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"=\n" +
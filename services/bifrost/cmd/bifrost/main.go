@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -89,17 +90,59 @@ func main() {
 		}
 	}()
 
+	// Terminate TLS on the Kafka listener when a certificate is configured
+	var listenerTLS *proxy.ListenerTLSConfig
+	if cfg.TLSCertFile != "" {
+		clientAuth, err := proxy.ParseClientAuthType(cfg.TLSClientAuth)
+		if err != nil {
+			logrus.Fatalf("Invalid BIFROST_TLS_CLIENT_AUTH: %v", err)
+		}
+		listenerTLS = &proxy.ListenerTLSConfig{
+			CertFile:     cfg.TLSCertFile,
+			KeyFile:      cfg.TLSKeyFile,
+			ClientAuth:   clientAuth,
+			ClientCAFile: cfg.TLSClientCAFile,
+		}
+		if cfg.TLSClientCertRulesFile != "" {
+			if listenerTLS.ClientCertRules, err = auth.LoadClientCertRules(cfg.TLSClientCertRulesFile); err != nil {
+				logrus.Fatalf("Invalid BIFROST_TLS_CLIENT_CERT_RULES_FILE: %v", err)
+			}
+		}
+	}
+
 	// Start Kafka proxy
 	kafkaProxy := proxy.NewBifrostProxy(
 		":"+strconv.Itoa(cfg.ProxyPort),
 		saslHandler,
 		vcStore,
 		collector,
+		listenerTLS,
 	)
+	kafkaProxy.SetSessionLifetime(time.Duration(cfg.SessionLifetimeMs) * time.Millisecond)
+	if err := kafkaProxy.SetSASLMechanisms(cfg.SASLMechanisms); err != nil {
+		logrus.Fatalf("Invalid BIFROST_SASL_MECHANISMS: %v", err)
+	}
+	kafkaProxy.SetIdleTimeout(time.Duration(cfg.IdleTimeoutMs) * time.Millisecond)
+	kafkaProxy.SetMaxConnectionLifetime(time.Duration(cfg.MaxConnectionLifetimeMs) * time.Millisecond)
+	unknownVersionPolicy, err := proxy.ParseUnknownVersionPolicy(cfg.UnknownVersionPolicy)
+	if err != nil {
+		logrus.Fatalf("Invalid BIFROST_UNKNOWN_VERSION_POLICY: %v", err)
+	}
+	if unknownVersionPolicy == proxy.UnknownVersionForward {
+		logrus.Warn("BIFROST_UNKNOWN_VERSION_POLICY=forward only applies to virtual clusters without prefixes; prefixed virtual clusters still reject unknown versions")
+	}
+	kafkaProxy.SetUnknownVersionPolicy(unknownVersionPolicy)
 	if err := kafkaProxy.Start(); err != nil {
 		errChan <- fmt.Errorf("proxy failed to start: %w", err)
 	}
 
+	// Export per-tenant consumer lag
+	var lagCollector *admin.LagCollector
+	if cfg.ConsumerLagIntervalMs > 0 {
+		lagCollector = admin.NewLagCollector(vcStore, collector, time.Duration(cfg.ConsumerLagIntervalMs)*time.Millisecond)
+		lagCollector.Start()
+	}
+
 	// Wait for shutdown signal or server error
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -122,6 +165,10 @@ func main() {
 	// Stop Kafka proxy first (stops accepting connections)
 	kafkaProxy.Stop()
 
+	if lagCollector != nil {
+		lagCollector.Stop()
+	}
+
 	// Shutdown metrics server gracefully
 	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
 		logrus.Errorf("Metrics server shutdown error: %v", err)
@@ -140,6 +187,40 @@ type Config struct {
 	MetricsPort  int
 	KafkaBrokers string
 	LogLevel     string
+	// SessionLifetimeMs is the SASL session lifetime before clients must
+	// re-authenticate (0 = sessions never expire)
+	SessionLifetimeMs int
+	// SASLMechanisms lists the SASL mechanisms offered to clients
+	// (empty = PLAIN, SCRAM-SHA-256 and SCRAM-SHA-512)
+	SASLMechanisms []string
+	// IdleTimeoutMs closes client connections idle for this long
+	// (0 = never)
+	IdleTimeoutMs int
+	// MaxConnectionLifetimeMs closes client connections open for this long
+	// so they reconnect (0 = never)
+	MaxConnectionLifetimeMs int
+	// UnknownVersionPolicy handles requests of a version Bifrost has no
+	// schema for: reject or forward (empty = reject). Forwarded requests skip
+	// the topic policy, produce quotas and tenant prefixing, so forward only
+	// applies to virtual clusters without prefixes; connections to prefixed
+	// virtual clusters reject them under either policy.
+	UnknownVersionPolicy string
+	// ConsumerLagIntervalMs is how often consumer lag is collected
+	// (0 = disabled)
+	ConsumerLagIntervalMs int
+	// TLSCertFile and TLSKeyFile enable TLS on the Kafka listener
+	// (empty = plaintext)
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSClientAuth is the client certificate mode: none, request, require,
+	// verify-if-given or require-and-verify (empty = none)
+	TLSClientAuth string
+	// TLSClientCAFile verifies client certificates
+	TLSClientCAFile string
+	// TLSClientCertRulesFile is a JSON file of rules mapping verified
+	// client certificates to virtual clusters, so those clients skip SASL
+	// (empty = SASL only)
+	TLSClientCertRulesFile string
 }
 
 func loadConfig() *Config {
@@ -149,6 +230,20 @@ func loadConfig() *Config {
 		MetricsPort:  getEnvInt("BIFROST_METRICS_PORT", 8080),
 		KafkaBrokers: getEnv("KAFKA_BOOTSTRAP_SERVERS", "redpanda:9092"),
 		LogLevel:     getEnv("BIFROST_LOG_LEVEL", "info"),
+
+		SessionLifetimeMs:       getEnvInt("BIFROST_SASL_SESSION_LIFETIME_MS", 0),
+		SASLMechanisms:          getEnvList("BIFROST_SASL_MECHANISMS"),
+		IdleTimeoutMs:           getEnvInt("BIFROST_CONNECTION_IDLE_TIMEOUT_MS", 0),
+		MaxConnectionLifetimeMs: getEnvInt("BIFROST_CONNECTION_MAX_LIFETIME_MS", 0),
+		UnknownVersionPolicy:    getEnv("BIFROST_UNKNOWN_VERSION_POLICY", ""),
+		ConsumerLagIntervalMs:   getEnvInt("BIFROST_CONSUMER_LAG_INTERVAL_MS", 30000),
+
+		TLSCertFile:     getEnv("BIFROST_TLS_CERT_FILE", ""),
+		TLSKeyFile:      getEnv("BIFROST_TLS_KEY_FILE", ""),
+		TLSClientAuth:   getEnv("BIFROST_TLS_CLIENT_AUTH", ""),
+		TLSClientCAFile: getEnv("BIFROST_TLS_CLIENT_CA_FILE", ""),
+
+		TLSClientCertRulesFile: getEnv("BIFROST_TLS_CLIENT_CERT_RULES_FILE", ""),
 	}
 }
 
@@ -159,6 +254,18 @@ func getEnv(key, defaultVal string) string {
 	return defaultVal
 }
 
+// getEnvList splits a comma-separated environment variable, dropping empty
+// entries
+func getEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 func getEnvInt(key string, defaultVal int) int {
 	if val := os.Getenv(key); val != "" {
 		if i, err := strconv.Atoi(val); err == nil {
@@ -18,6 +18,7 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"flag"
@@ -29,8 +30,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
 	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sasl"
 	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	xdgscram "github.com/xdg-go/scram"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -167,8 +173,56 @@ func runTests(ctx context.Context, testCtx *TestContext) error {
 		return fmt.Errorf("multi-tenant isolation test: %w", err)
 	}
 
-	// Phase 6: Cleanup
-	log.Println("--- Phase 6: Cleanup ---")
+	// Phase 6: Delete committed offsets through Bifrost
+	log.Println("--- Phase 6: Delete Committed Offsets ---")
+	if err := testDeleteOffsets(ctx, testCtx); err != nil {
+		return fmt.Errorf("delete offsets test: %w", err)
+	}
+
+	// Phase 7: Delete a consumer group through Bifrost
+	log.Println("--- Phase 7: Delete Consumer Group ---")
+	if err := testDeleteGroup(ctx, testCtx); err != nil {
+		return fmt.Errorf("delete group test: %w", err)
+	}
+
+	// Phase 8: Add partitions through Bifrost
+	log.Println("--- Phase 8: Create Partitions ---")
+	if err := testCreatePartitions(ctx, testCtx); err != nil {
+		return fmt.Errorf("create partitions test: %w", err)
+	}
+
+	// Phase 9: Initialize a transactional producer through Bifrost
+	log.Println("--- Phase 9: Transactional Producer Initialization ---")
+	if err := testTransactionalProducerInit(ctx, testCtx); err != nil {
+		return fmt.Errorf("transactional producer init test: %w", err)
+	}
+
+	// Phase 10: Consume-transform-produce inside a transaction through Bifrost
+	log.Println("--- Phase 10: Transactional Consume-Transform-Produce ---")
+	if err := testTransactionalConsumeTransformProduce(ctx, testCtx); err != nil {
+		return fmt.Errorf("transactional consume-transform-produce test: %w", err)
+	}
+
+	// Phase 11: Describe and alter topic configs through Bifrost
+	log.Println("--- Phase 11: Topic Configs ---")
+	if err := testTopicConfigs(ctx, testCtx); err != nil {
+		return fmt.Errorf("topic configs test: %w", err)
+	}
+
+	// Phase 12: Authenticate with SCRAM credentials through Bifrost
+	log.Println("--- Phase 12: SCRAM Authentication ---")
+	if err := testScramAuthentication(ctx, testCtx); err != nil {
+		return fmt.Errorf("scram authentication test: %w", err)
+	}
+
+	// Phase 13: Delete topic through Bifrost
+	log.Println("--- Phase 13: Delete Test Topic ---")
+	if err := testDeleteTopic(ctx, testCtx); err != nil {
+		return fmt.Errorf("delete topic test: %w", err)
+	}
+
+	// Phase 14: Cleanup
+	log.Println("--- Phase 14: Cleanup ---")
 	if err := cleanup(ctx, testCtx); err != nil {
 		log.Printf("Warning: cleanup failed (non-fatal): %v", err)
 	}
@@ -305,36 +359,30 @@ func createTestTopic(ctx context.Context, testCtx *TestContext) error {
 			// Ignore "topic already exists" error
 			if strings.Contains(result.Err.Error(), "TOPIC_ALREADY_EXISTS") {
 				log.Printf("  Topic already exists (OK)")
-				return nil
+				continue
 			}
 			return fmt.Errorf("create topic %s: %w", result.Topic, result.Err)
 		}
 		log.Printf("  Topic created: %s", result.Topic)
 	}
 
-	// NOTE: CreateTopics request modifier is not yet implemented, so topic is created
-	// without the prefix. For now, we'll create the prefixed topic directly in Redpanda
-	// to test the consumer group APIs properly.
+	// Verify the topic landed in Redpanda with the tenant prefix
 	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
 	physicalTopic := testCtx.Config.TopicPrefix + testCtx.TopicName
 
-	log.Printf("Creating prefixed topic '%s' directly in Redpanda (CreateTopics modifier not yet implemented)...", physicalTopic)
+	log.Printf("Verifying prefixed topic '%s' exists in Redpanda...", physicalTopic)
 
-	physicalResp, err := redpandaAdmin.CreateTopics(ctx, 1, 1, nil, physicalTopic)
+	topics, err := redpandaAdmin.ListTopics(ctx)
 	if err != nil {
-		return fmt.Errorf("create physical topic: %w", err)
+		return fmt.Errorf("list physical topics: %w", err)
 	}
-	for _, result := range physicalResp {
-		if result.Err != nil {
-			if strings.Contains(result.Err.Error(), "TOPIC_ALREADY_EXISTS") {
-				log.Printf("  Physical topic already exists (OK)")
-			} else {
-				return fmt.Errorf("create physical topic %s: %w", result.Topic, result.Err)
-			}
-		} else {
-			log.Printf("  Physical topic created: %s", result.Topic)
-		}
+	if !topics.Has(physicalTopic) {
+		return fmt.Errorf("FAIL: topic '%s' not found in Redpanda after CreateTopics via Bifrost", physicalTopic)
+	}
+	if topics.Has(testCtx.TopicName) {
+		return fmt.Errorf("FAIL: unprefixed topic '%s' was created in Redpanda", testCtx.TopicName)
 	}
+	log.Printf("  Found prefixed topic '%s' in Redpanda", physicalTopic)
 
 	return nil
 }
@@ -494,6 +542,10 @@ func testConsumerGroupAPIs(ctx context.Context, testCtx *TestContext) error {
 		if g.Group != testCtx.GroupName {
 			return fmt.Errorf("FAIL: DescribeGroups returned wrong group name: got '%s', want '%s'", g.Group, testCtx.GroupName)
 		}
+		// A Dead group means the broker looked up an unprefixed group_id that doesn't exist
+		if g.State == "Dead" {
+			return fmt.Errorf("FAIL: DescribeGroups through Bifrost returned a dead group - request was not prefixed to '%s'", physicalGroup)
+		}
 	}
 
 	// Test 4: DescribeGroups directly from Redpanda - verify physical name
@@ -537,6 +589,35 @@ func testMultiTenantIsolation(ctx context.Context, testCtx *TestContext) error {
 		}
 	}
 
+	// Seed a group owned by another tenant directly on Redpanda so the group
+	// filter has something to hide
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
+	foreignGroup := "othervc-" + testCtx.GroupName
+	var offsets kadm.Offsets
+	offsets.Add(kadm.Offset{Topic: testCtx.Config.TopicPrefix + testCtx.TopicName, Partition: 0, At: 0, LeaderEpoch: -1})
+	commits, err := redpandaAdmin.CommitOffsets(ctx, foreignGroup, offsets)
+	if err != nil {
+		return fmt.Errorf("seed foreign group '%s': %w", foreignGroup, err)
+	}
+	var commitErr error
+	commits.EachError(func(o kadm.OffsetResponse) { commitErr = o.Err })
+	if commitErr != nil {
+		return fmt.Errorf("seed foreign group '%s': %w", foreignGroup, commitErr)
+	}
+	defer func() {
+		if _, err := redpandaAdmin.DeleteGroups(ctx, foreignGroup); err != nil {
+			log.Printf("  Warning: delete foreign group '%s' failed: %v", foreignGroup, err)
+		}
+	}()
+
+	redpandaGroups, err := redpandaAdmin.ListGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("list groups from redpanda: %w", err)
+	}
+	if _, ok := redpandaGroups[foreignGroup]; !ok {
+		return fmt.Errorf("FAIL: foreign group '%s' not visible on Redpanda", foreignGroup)
+	}
+
 	// List all groups through Bifrost - should only see this tenant's groups
 	groups, err := bifrostAdmin.ListGroups(ctx)
 	if err != nil {
@@ -548,32 +629,555 @@ func testMultiTenantIsolation(ctx context.Context, testCtx *TestContext) error {
 		if strings.HasPrefix(g.Group, testCtx.Config.GroupPrefix) {
 			return fmt.Errorf("FAIL: Group '%s' still has prefix - isolation breach!", g.Group)
 		}
+		// Every visible group must map back to one of this tenant's physical groups
+		if _, ok := redpandaGroups[testCtx.Config.GroupPrefix+g.Group]; !ok {
+			return fmt.Errorf("FAIL: Group '%s' does not belong to this tenant - isolation breach!", g.Group)
+		}
+	}
+	if _, ok := groups[foreignGroup]; ok {
+		return fmt.Errorf("FAIL: Foreign group '%s' leaked through Bifrost - isolation breach!", foreignGroup)
+	}
+	if _, ok := redpandaGroups[testCtx.Config.GroupPrefix+testCtx.GroupName]; ok {
+		if _, ok := groups[testCtx.GroupName]; !ok {
+			return fmt.Errorf("FAIL: Group '%s' missing from ListGroups through Bifrost", testCtx.GroupName)
+		}
 	}
 
 	log.Printf("  Multi-tenant isolation verified!")
 	return nil
 }
 
-func cleanup(ctx context.Context, testCtx *TestContext) error {
-	log.Printf("Cleaning up test resources...")
+func testDeleteOffsets(ctx context.Context, testCtx *TestContext) error {
+	bifrostAdmin := kadm.NewClient(testCtx.BifrostClient)
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
 
-	// Delete the test topic via Bifrost
-	if testCtx.BifrostClient != nil {
-		admin := kadm.NewClient(testCtx.BifrostClient)
-		resp, err := admin.DeleteTopics(ctx, testCtx.TopicName)
-		if err != nil {
-			log.Printf("  Warning: delete topic via bifrost failed: %v", err)
-		} else {
-			for _, r := range resp {
-				if r.Err != nil {
-					log.Printf("  Warning: delete topic %s: %v", r.Topic, r.Err)
-				} else {
-					log.Printf("  Deleted topic: %s", r.Topic)
+	physicalGroup := testCtx.Config.GroupPrefix + testCtx.GroupName
+	physicalTopic := testCtx.Config.TopicPrefix + testCtx.TopicName
+
+	// The consumer phase committed offsets on the prefixed group
+	committed, err := redpandaAdmin.FetchOffsets(ctx, physicalGroup)
+	if err != nil {
+		return fmt.Errorf("fetch offsets from redpanda: %w", err)
+	}
+	partitions := committed.Partitions()[physicalTopic]
+	if len(partitions) == 0 {
+		return fmt.Errorf("FAIL: no committed offsets for '%s' on group '%s'", physicalTopic, physicalGroup)
+	}
+
+	toDelete := make(kadm.TopicsSet)
+	for p := range partitions {
+		toDelete.Add(testCtx.TopicName, p)
+	}
+
+	log.Printf("Deleting %d committed offset(s) for group '%s' through Bifrost...", len(partitions), testCtx.GroupName)
+	deleted, err := bifrostAdmin.DeleteOffsets(ctx, testCtx.GroupName, toDelete)
+	if err != nil {
+		return fmt.Errorf("delete offsets through bifrost: %w", err)
+	}
+	if err := deleted.Error(); err != nil {
+		return fmt.Errorf("FAIL: delete offsets through bifrost: %w", err)
+	}
+	for p := range partitions {
+		// Responses are keyed by topic name, so this also checks unprefixing
+		if _, ok := deleted.Lookup(testCtx.TopicName, p); !ok {
+			return fmt.Errorf("FAIL: DeleteOffsets response missing unprefixed topic '%s' partition %d", testCtx.TopicName, p)
+		}
+	}
+
+	log.Printf("Verifying offsets were removed from prefixed group '%s' in Redpanda...", physicalGroup)
+	remaining, err := redpandaAdmin.FetchOffsets(ctx, physicalGroup)
+	if err != nil {
+		return fmt.Errorf("fetch offsets from redpanda: %w", err)
+	}
+	for p := range partitions {
+		if o, ok := remaining.Lookup(physicalTopic, p); ok && o.Err == nil && o.At >= 0 {
+			return fmt.Errorf("FAIL: offset %d still committed for '%s' partition %d on group '%s'", o.At, physicalTopic, p, physicalGroup)
+		}
+	}
+
+	log.Printf("  Committed offsets deleted from prefixed group")
+	return nil
+}
+
+func testDeleteGroup(ctx context.Context, testCtx *TestContext) error {
+	bifrostAdmin := kadm.NewClient(testCtx.BifrostClient)
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
+
+	groupName := testCtx.GroupName + "-delete"
+	physicalGroup := testCtx.Config.GroupPrefix + groupName
+
+	// Committing an offset through Bifrost creates the (prefixed) group on the broker
+	log.Printf("Creating group '%s' via Bifrost...", groupName)
+	var offsets kadm.Offsets
+	offsets.Add(kadm.Offset{Topic: testCtx.TopicName, Partition: 0, At: 0, LeaderEpoch: -1})
+	commits, err := bifrostAdmin.CommitOffsets(ctx, groupName, offsets)
+	if err != nil {
+		return fmt.Errorf("commit offsets through bifrost: %w", err)
+	}
+	if err := commits.Error(); err != nil {
+		return fmt.Errorf("commit offsets through bifrost: %w", err)
+	}
+
+	redpandaGroups, err := redpandaAdmin.ListGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("list groups from redpanda: %w", err)
+	}
+	if _, ok := redpandaGroups[physicalGroup]; !ok {
+		return fmt.Errorf("FAIL: prefixed group '%s' not created on Redpanda", physicalGroup)
+	}
+
+	log.Printf("Deleting group '%s' via Bifrost...", groupName)
+	deleted, err := bifrostAdmin.DeleteGroups(ctx, groupName)
+	if err != nil {
+		return fmt.Errorf("delete groups through bifrost: %w", err)
+	}
+	// Responses are keyed by group id, so this also checks unprefixing
+	result, ok := deleted[groupName]
+	if !ok {
+		return fmt.Errorf("FAIL: DeleteGroups response missing unprefixed group '%s'", groupName)
+	}
+	if result.Err != nil {
+		return fmt.Errorf("FAIL: delete group '%s' through bifrost: %w", groupName, result.Err)
+	}
+
+	log.Printf("Verifying prefixed group '%s' was removed from Redpanda...", physicalGroup)
+	redpandaGroups, err = redpandaAdmin.ListGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("list groups from redpanda: %w", err)
+	}
+	if _, ok := redpandaGroups[physicalGroup]; ok {
+		return fmt.Errorf("FAIL: group '%s' still exists in Redpanda after DeleteGroups via Bifrost", physicalGroup)
+	}
+
+	log.Printf("  Prefixed group '%s' removed from Redpanda", physicalGroup)
+	return nil
+}
+
+func testCreatePartitions(ctx context.Context, testCtx *TestContext) error {
+	bifrostAdmin := kadm.NewClient(testCtx.BifrostClient)
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
+	physicalTopic := testCtx.Config.TopicPrefix + testCtx.TopicName
+
+	before, err := redpandaAdmin.ListTopics(ctx, physicalTopic)
+	if err != nil {
+		return fmt.Errorf("list physical topics: %w", err)
+	}
+	if !before.Has(physicalTopic) {
+		return fmt.Errorf("FAIL: topic '%s' not found in Redpanda", physicalTopic)
+	}
+	partitionsBefore := len(before[physicalTopic].Partitions)
+
+	log.Printf("Adding a partition to topic '%s' via Bifrost (currently %d)...", testCtx.TopicName, partitionsBefore)
+	resp, err := bifrostAdmin.CreatePartitions(ctx, 1, testCtx.TopicName)
+	if err != nil {
+		return fmt.Errorf("create partitions through bifrost: %w", err)
+	}
+	// Responses are keyed by topic name, so this also checks unprefixing
+	result, ok := resp[testCtx.TopicName]
+	if !ok {
+		return fmt.Errorf("FAIL: CreatePartitions response missing unprefixed topic '%s'", testCtx.TopicName)
+	}
+	if result.Err != nil {
+		return fmt.Errorf("FAIL: create partitions for '%s' through bifrost: %w", testCtx.TopicName, result.Err)
+	}
+
+	log.Printf("Verifying prefixed topic '%s' grew in Redpanda...", physicalTopic)
+	after, err := redpandaAdmin.ListTopics(ctx, physicalTopic)
+	if err != nil {
+		return fmt.Errorf("list physical topics: %w", err)
+	}
+	if partitionsAfter := len(after[physicalTopic].Partitions); partitionsAfter != partitionsBefore+1 {
+		return fmt.Errorf("FAIL: topic '%s' has %d partitions after CreatePartitions via Bifrost, want %d", physicalTopic, partitionsAfter, partitionsBefore+1)
+	}
+
+	log.Printf("  Prefixed topic '%s' now has %d partitions", physicalTopic, partitionsBefore+1)
+	return nil
+}
+
+func testTransactionalProducerInit(ctx context.Context, testCtx *TestContext) error {
+	txnID := testCtx.TopicName + "-txn"
+	// The test virtual cluster reuses the topic prefix for transactional IDs
+	physicalTxnID := testCtx.Config.TopicPrefix + txnID
+
+	txnClient, err := kgo.NewClient(
+		kgo.SeedBrokers(testCtx.Config.BifrostProxyAddr),
+		kgo.SASL(plain.Auth{
+			User: testCtx.Username,
+			Pass: testCtx.Password,
+		}.AsMechanism()),
+		kgo.ClientID("bifrost-test-txn-client"),
+		kgo.TransactionalID(txnID),
+		kgo.DialTimeout(10*time.Second),
+	)
+	if err != nil {
+		return fmt.Errorf("create transactional client: %w", err)
+	}
+	defer txnClient.Close()
+
+	// franz-go routes a transactional InitProducerId through FindCoordinator
+	// (key_type=TRANSACTION) first, so this exercises both rewrites together
+	log.Printf("Initializing transactional producer '%s' via Bifrost...", txnID)
+	req := kmsg.NewPtrInitProducerIDRequest()
+	req.TransactionalID = &txnID
+	req.TransactionTimeoutMillis = 60000
+	resp, err := req.RequestWith(ctx, txnClient)
+	if err != nil {
+		return fmt.Errorf("init producer id through bifrost: %w", err)
+	}
+	if err := kerr.ErrorForCode(resp.ErrorCode); err != nil {
+		return fmt.Errorf("FAIL: init producer id through bifrost: %w", err)
+	}
+	log.Printf("  Producer ID %d, epoch %d", resp.ProducerID, resp.ProducerEpoch)
+
+	// Re-initializing the prefixed id directly must fence the same producer
+	log.Printf("Verifying prefixed transactional ID '%s' on Redpanda...", physicalTxnID)
+	direct := kmsg.NewPtrInitProducerIDRequest()
+	direct.TransactionalID = &physicalTxnID
+	direct.TransactionTimeoutMillis = 60000
+	directResp, err := direct.RequestWith(ctx, testCtx.RedpandaClient)
+	if err != nil {
+		return fmt.Errorf("init producer id on redpanda: %w", err)
+	}
+	if err := kerr.ErrorForCode(directResp.ErrorCode); err != nil {
+		return fmt.Errorf("init producer id on redpanda: %w", err)
+	}
+	if directResp.ProducerID != resp.ProducerID || directResp.ProducerEpoch <= resp.ProducerEpoch {
+		return fmt.Errorf("FAIL: '%s' on Redpanda is producer %d epoch %d, expected producer %d with a bumped epoch",
+			physicalTxnID, directResp.ProducerID, directResp.ProducerEpoch, resp.ProducerID)
+	}
+
+	log.Printf("  Transactional ID was initialized with the tenant prefix")
+	return nil
+}
+
+func testTransactionalConsumeTransformProduce(ctx context.Context, testCtx *TestContext) error {
+	bifrostAdmin := kadm.NewClient(testCtx.BifrostClient)
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
+
+	outputTopic := testCtx.TopicName + "-transformed"
+	groupName := testCtx.GroupName + "-txn"
+	txnID := testCtx.TopicName + "-ctp"
+	physicalOutputTopic := testCtx.Config.TopicPrefix + outputTopic
+	physicalGroup := testCtx.Config.GroupPrefix + groupName
+
+	log.Printf("Creating output topic '%s' via Bifrost...", outputTopic)
+	resp, err := bifrostAdmin.CreateTopics(ctx, 1, 1, nil, outputTopic)
+	if err != nil {
+		return fmt.Errorf("create output topic: %w", err)
+	}
+	if err := resp.Error(); err != nil && !strings.Contains(err.Error(), "TOPIC_ALREADY_EXISTS") {
+		return fmt.Errorf("create output topic: %w", err)
+	}
+	defer func() {
+		if _, err := bifrostAdmin.DeleteTopics(ctx, outputTopic); err != nil {
+			log.Printf("  Warning: delete output topic: %v", err)
+		}
+	}()
+
+	// Every request in the loop carries a tenant identifier: AddPartitionsToTxn
+	// (transactional_id, topics), AddOffsetsToTxn and TxnOffsetCommit
+	// (transactional_id, group_id, topics) and EndTxn (transactional_id)
+	sess, err := kgo.NewGroupTransactSession(
+		kgo.SeedBrokers(testCtx.Config.BifrostProxyAddr),
+		kgo.SASL(plain.Auth{
+			User: testCtx.Username,
+			Pass: testCtx.Password,
+		}.AsMechanism()),
+		kgo.ClientID("bifrost-test-ctp-client"),
+		kgo.TransactionalID(txnID),
+		kgo.ConsumerGroup(groupName),
+		kgo.ConsumeTopics(testCtx.TopicName),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+		kgo.DialTimeout(10*time.Second),
+	)
+	if err != nil {
+		return fmt.Errorf("create transactional session: %w", err)
+	}
+	defer sess.Close()
+
+	log.Printf("Transforming '%s' into '%s' with group '%s'...", testCtx.TopicName, outputTopic, groupName)
+
+	// The produce phase wrote 10 records to the input topic
+	transformed := 0
+	loopCtx, loopCancel := context.WithTimeout(ctx, 30*time.Second)
+	defer loopCancel()
+
+	for transformed < 10 {
+		fetches := sess.PollFetches(loopCtx)
+		if err := loopCtx.Err(); err != nil {
+			return fmt.Errorf("transform timeout: only transformed %d/10 records", transformed)
+		}
+		for _, e := range fetches.Errors() {
+			log.Printf("  Fetch error: topic=%s partition=%d: %v", e.Topic, e.Partition, e.Err)
+		}
+		if fetches.NumRecords() == 0 {
+			continue
+		}
+
+		if err := sess.Begin(); err != nil {
+			return fmt.Errorf("begin transaction: %w", err)
+		}
+		fetches.EachRecord(func(r *kgo.Record) {
+			sess.Produce(loopCtx, &kgo.Record{
+				Topic: outputTopic,
+				Key:   r.Key,
+				Value: []byte(strings.ToUpper(string(r.Value))),
+			}, func(_ *kgo.Record, err error) {
+				if err != nil {
+					log.Printf("  Warning: transactional produce error: %v", err)
 				}
+			})
+		})
+
+		committed, err := sess.End(loopCtx, kgo.TryCommit)
+		if err != nil {
+			return fmt.Errorf("end transaction: %w", err)
+		}
+		if !committed {
+			return fmt.Errorf("FAIL: transaction through bifrost was aborted")
+		}
+		transformed += fetches.NumRecords()
+	}
+	log.Printf("  Committed %d transformed records", transformed)
+
+	// The group's offsets were committed by TxnOffsetCommit on the prefixed group
+	log.Printf("Verifying prefixed group '%s' has transactional offsets in Redpanda...", physicalGroup)
+	offsets, err := redpandaAdmin.FetchOffsets(ctx, physicalGroup)
+	if err != nil {
+		return fmt.Errorf("fetch physical offsets: %w", err)
+	}
+	if err := offsets.Error(); err != nil {
+		return fmt.Errorf("fetch physical offsets: %w", err)
+	}
+	if _, ok := offsets.Offsets()[testCtx.Config.TopicPrefix+testCtx.TopicName]; !ok {
+		return fmt.Errorf("FAIL: group '%s' has no committed offsets for the prefixed input topic", physicalGroup)
+	}
+
+	// The committed (last stable) offset only advances once EndTxn commits the output
+	log.Printf("Verifying prefixed output topic '%s' has committed records...", physicalOutputTopic)
+	listed, err := redpandaAdmin.ListCommittedOffsets(ctx, physicalOutputTopic)
+	if err != nil {
+		return fmt.Errorf("list committed offsets: %w", err)
+	}
+	if err := listed.Error(); err != nil {
+		return fmt.Errorf("list committed offsets: %w", err)
+	}
+	var stable int64
+	listed.Each(func(o kadm.ListedOffset) {
+		stable += o.Offset
+	})
+	if stable < int64(transformed) {
+		return fmt.Errorf("FAIL: output topic '%s' has %d committed offsets, expected at least %d",
+			physicalOutputTopic, stable, transformed)
+	}
+
+	log.Printf("  Transactional offsets and output landed under the tenant prefix")
+	return nil
+}
+
+func testTopicConfigs(ctx context.Context, testCtx *TestContext) error {
+	bifrostAdmin := kadm.NewClient(testCtx.BifrostClient)
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
+	physicalTopic := testCtx.Config.TopicPrefix + testCtx.TopicName
+
+	// AlterTopicConfigsState issues AlterConfigs; AlterTopicConfigs would use
+	// IncrementalAlterConfigs instead
+	retention := "3600000"
+	log.Printf("Setting retention.ms=%s on '%s' via Bifrost...", retention, testCtx.TopicName)
+	alterResp, err := bifrostAdmin.AlterTopicConfigsState(ctx, []kadm.AlterConfig{
+		{Name: "retention.ms", Value: &retention},
+	}, testCtx.TopicName)
+	if err != nil {
+		return fmt.Errorf("alter topic configs: %w", err)
+	}
+	for _, r := range alterResp {
+		if r.Err != nil {
+			return fmt.Errorf("alter topic configs %s: %w", r.Name, r.Err)
+		}
+		if r.Name != testCtx.TopicName {
+			return fmt.Errorf("FAIL: alter configs response returned resource '%s', expected '%s'", r.Name, testCtx.TopicName)
+		}
+	}
+
+	topicConfigValue := func(configs kadm.ResourceConfigs, topic, key string) (string, error) {
+		rc, err := configs.On(topic, nil)
+		if err != nil {
+			return "", fmt.Errorf("topic '%s' missing from describe configs response: %w", topic, err)
+		}
+		if rc.Err != nil {
+			return "", fmt.Errorf("describe configs %s: %w", topic, rc.Err)
+		}
+		for _, c := range rc.Configs {
+			if c.Key == key && c.Value != nil {
+				return *c.Value, nil
+			}
+		}
+		return "", fmt.Errorf("config '%s' missing for topic '%s'", key, topic)
+	}
+
+	log.Printf("Describing '%s' configs via Bifrost...", testCtx.TopicName)
+	described, err := bifrostAdmin.DescribeTopicConfigs(ctx, testCtx.TopicName)
+	if err != nil {
+		return fmt.Errorf("describe topic configs: %w", err)
+	}
+	value, err := topicConfigValue(described, testCtx.TopicName, "retention.ms")
+	if err != nil {
+		return fmt.Errorf("FAIL: %w", err)
+	}
+	if value != retention {
+		return fmt.Errorf("FAIL: retention.ms via Bifrost is %s, expected %s", value, retention)
+	}
+	log.Printf("  retention.ms=%s on '%s'", value, testCtx.TopicName)
+
+	log.Printf("Verifying retention.ms on prefixed topic '%s' in Redpanda...", physicalTopic)
+	physical, err := redpandaAdmin.DescribeTopicConfigs(ctx, physicalTopic)
+	if err != nil {
+		return fmt.Errorf("describe physical topic configs: %w", err)
+	}
+	value, err = topicConfigValue(physical, physicalTopic, "retention.ms")
+	if err != nil {
+		return fmt.Errorf("FAIL: %w", err)
+	}
+	if value != retention {
+		return fmt.Errorf("FAIL: retention.ms on '%s' is %s, expected %s", physicalTopic, value, retention)
+	}
+
+	log.Printf("  Config change landed on the prefixed topic")
+	return nil
+}
+
+// testScramAuthentication provisions SCRAM-SHA-256 and SCRAM-SHA-512
+// credentials for the test virtual cluster and verifies that a franz-go SCRAM
+// client can authenticate through Bifrost and sees the same tenant view.
+func testScramAuthentication(ctx context.Context, testCtx *TestContext) error {
+	mechanisms := []struct {
+		name      string
+		mechanism gatewayv1.SaslMechanism
+		hash      xdgscram.HashGeneratorFcn
+		sasl      func(scram.Auth) sasl.Mechanism
+	}{
+		{"SCRAM-SHA-256", gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256, xdgscram.SHA256, scram.Auth.AsSha256Mechanism},
+		{"SCRAM-SHA-512", gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512, xdgscram.SHA512, scram.Auth.AsSha512Mechanism},
+	}
+
+	for _, m := range mechanisms {
+		suffix := strings.ToLower(strings.TrimPrefix(m.name, "SCRAM-"))
+		credentialID := fmt.Sprintf("%s-%s", testCtx.CredentialID, suffix)
+		username := fmt.Sprintf("%s-%s", testCtx.Username, suffix)
+
+		log.Printf("Creating %s credential %s...", m.name, credentialID)
+		scramCred, err := scramCredential(m.hash, testCtx.Password)
+		if err != nil {
+			return fmt.Errorf("derive %s credential: %w", m.name, err)
+		}
+		_, err = testCtx.AdminClient.UpsertCredential(ctx, &gatewayv1.UpsertCredentialRequest{
+			Config: &gatewayv1.CredentialConfig{
+				Id:               credentialID,
+				VirtualClusterId: testCtx.VirtualClusterID,
+				Username:         username,
+				Template:         gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_ADMIN,
+				Mechanism:        m.mechanism,
+				Scram:            scramCred,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("create %s credential: %w", m.name, err)
+		}
+
+		err = func() error {
+			defer testCtx.AdminClient.RevokeCredential(ctx, &gatewayv1.RevokeCredentialRequest{CredentialId: credentialID})
+
+			client, err := kgo.NewClient(
+				kgo.SeedBrokers(testCtx.Config.BifrostProxyAddr),
+				kgo.SASL(m.sasl(scram.Auth{User: username, Pass: testCtx.Password})),
+				kgo.ClientID("bifrost-scram-test-client"),
+				kgo.DialTimeout(10*time.Second),
+			)
+			if err != nil {
+				return fmt.Errorf("create %s client: %w", m.name, err)
+			}
+			defer client.Close()
+
+			topics, err := kadm.NewClient(client).ListTopics(ctx)
+			if err != nil {
+				return fmt.Errorf("list topics with %s: %w", m.name, err)
 			}
+			if !topics.Has(testCtx.TopicName) {
+				return fmt.Errorf("%s client does not see topic %s, got %v", m.name, testCtx.TopicName, topics.Names())
+			}
+			log.Printf("  ✓ %s client authenticated and sees topic %s", m.name, testCtx.TopicName)
+
+			// A wrong password must be rejected during the SCRAM exchange
+			badClient, err := kgo.NewClient(
+				kgo.SeedBrokers(testCtx.Config.BifrostProxyAddr),
+				kgo.SASL(m.sasl(scram.Auth{User: username, Pass: "wrong-" + testCtx.Password})),
+				kgo.ClientID("bifrost-scram-test-client"),
+				kgo.DialTimeout(10*time.Second),
+			)
+			if err != nil {
+				return fmt.Errorf("create %s client: %w", m.name, err)
+			}
+			defer badClient.Close()
+
+			pingCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			if err := badClient.Ping(pingCtx); err == nil {
+				return fmt.Errorf("%s client with wrong password was not rejected", m.name)
+			}
+			log.Printf("  ✓ %s client with wrong password rejected", m.name)
+			return nil
+		}()
+		if err != nil {
+			return err
 		}
 	}
 
+	return nil
+}
+
+func testDeleteTopic(ctx context.Context, testCtx *TestContext) error {
+	bifrostAdmin := kadm.NewClient(testCtx.BifrostClient)
+	redpandaAdmin := kadm.NewClient(testCtx.RedpandaClient)
+	physicalTopic := testCtx.Config.TopicPrefix + testCtx.TopicName
+
+	log.Printf("Deleting topic '%s' via Bifrost...", testCtx.TopicName)
+
+	resp, err := bifrostAdmin.DeleteTopics(ctx, testCtx.TopicName)
+	if err != nil {
+		return fmt.Errorf("delete topic: %w", err)
+	}
+	for _, r := range resp {
+		if r.Err != nil {
+			return fmt.Errorf("delete topic %s: %w", r.Topic, r.Err)
+		}
+		log.Printf("  Deleted topic: %s", r.Topic)
+	}
+
+	// Deletion is asynchronous on the broker, so poll until the physical topic is gone
+	log.Printf("Verifying prefixed topic '%s' was removed from Redpanda...", physicalTopic)
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		topics, err := redpandaAdmin.ListTopics(ctx)
+		if err != nil {
+			return fmt.Errorf("list physical topics: %w", err)
+		}
+		if !topics.Has(physicalTopic) {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("FAIL: topic '%s' still exists in Redpanda after DeleteTopics via Bifrost", physicalTopic)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	log.Printf("  Prefixed topic '%s' removed from Redpanda", physicalTopic)
+
+	return nil
+}
+
+func cleanup(ctx context.Context, testCtx *TestContext) error {
+	log.Printf("Cleaning up test resources...")
+
 	// Revoke credential
 	if testCtx.AdminClient != nil && testCtx.CredentialID != "" {
 		_, err := testCtx.AdminClient.RevokeCredential(ctx, &gatewayv1.RevokeCredentialRequest{
@@ -606,3 +1210,24 @@ func hashPassword(password string) string {
 	hash := sha256.Sum256([]byte(password))
 	return hex.EncodeToString(hash[:])
 }
+
+// scramCredential derives the salted SCRAM secrets for password with a random
+// salt, as the control plane does when creating a SCRAM credential.
+func scramCredential(hash xdgscram.HashGeneratorFcn, password string) (*gatewayv1.ScramCredential, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	client, err := hash.NewClient("", password, "")
+	if err != nil {
+		return nil, err
+	}
+	kf := xdgscram.KeyFactors{Salt: string(salt), Iters: 4096}
+	stored := client.GetStoredCredentials(kf)
+	return &gatewayv1.ScramCredential{
+		Salt:       salt,
+		Iterations: int32(kf.Iters),
+		StoredKey:  stored.StoredKey,
+		ServerKey:  stored.ServerKey,
+	}, nil
+}
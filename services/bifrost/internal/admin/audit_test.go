@@ -0,0 +1,236 @@
+// services/bifrost/internal/admin/audit_test.go
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+)
+
+// recordingAuditSink keeps the audit records written to it.
+type recordingAuditSink struct {
+	records []AuditRecord
+	err     error
+}
+
+func (s *recordingAuditSink) WriteAuditRecord(record AuditRecord) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func newAuditedTestService() (*Service, *recordingAuditSink) {
+	svc := NewService(config.NewVirtualClusterStore(), auth.NewCredentialStore())
+	sink := &recordingAuditSink{}
+	svc.SetAuditSink(sink)
+	return svc, sink
+}
+
+func actorContext(actor string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auditActorMetadataKey, actor))
+}
+
+// summaryFields decodes an audit summary, or returns nil if there is none.
+func summaryFields(t *testing.T, summary json.RawMessage) map[string]interface{} {
+	t.Helper()
+	if summary == nil {
+		return nil
+	}
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(summary, &fields))
+	return fields
+}
+
+func TestService_AuditsMutations(t *testing.T) {
+	vc := &gatewayv1.VirtualClusterConfig{Id: "vc-1", TopicPrefix: "tenant-a:"}
+	cred := &gatewayv1.CredentialConfig{
+		Id:               "cred-1",
+		VirtualClusterId: "vc-1",
+		Username:         "alice",
+		PasswordHash:     "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8",
+	}
+
+	tt := []struct {
+		name     string
+		setup    func(svc *Service)
+		call     func(ctx context.Context, svc *Service) error
+		action   string
+		targetID string
+		before   map[string]interface{}
+		after    map[string]interface{}
+	}{
+		{
+			name: "UpsertVirtualCluster creates",
+			call: func(ctx context.Context, svc *Service) error {
+				_, err := svc.UpsertVirtualCluster(ctx, &gatewayv1.UpsertVirtualClusterRequest{Config: vc})
+				return err
+			},
+			action:   AuditActionUpsertVirtualCluster,
+			targetID: "vc-1",
+			after:    map[string]interface{}{"id": "vc-1", "topic_prefix": "tenant-a:"},
+		},
+		{
+			name:  "UpsertVirtualCluster updates",
+			setup: func(svc *Service) { svc.vcStore.Upsert(vc) },
+			call: func(ctx context.Context, svc *Service) error {
+				_, err := svc.UpsertVirtualCluster(ctx, &gatewayv1.UpsertVirtualClusterRequest{
+					Config: &gatewayv1.VirtualClusterConfig{Id: "vc-1", TopicPrefix: "tenant-b:"},
+				})
+				return err
+			},
+			action:   AuditActionUpsertVirtualCluster,
+			targetID: "vc-1",
+			before:   map[string]interface{}{"id": "vc-1", "topic_prefix": "tenant-a:"},
+			after:    map[string]interface{}{"id": "vc-1", "topic_prefix": "tenant-b:"},
+		},
+		{
+			name:  "DeleteVirtualCluster",
+			setup: func(svc *Service) { svc.vcStore.Upsert(vc) },
+			call: func(ctx context.Context, svc *Service) error {
+				_, err := svc.DeleteVirtualCluster(ctx, &gatewayv1.DeleteVirtualClusterRequest{VirtualClusterId: "vc-1"})
+				return err
+			},
+			action:   AuditActionDeleteVirtualCluster,
+			targetID: "vc-1",
+			before:   map[string]interface{}{"id": "vc-1", "topic_prefix": "tenant-a:"},
+		},
+		{
+			name:  "SetVirtualClusterReadOnly",
+			setup: func(svc *Service) { svc.vcStore.Upsert(vc) },
+			call: func(ctx context.Context, svc *Service) error {
+				_, err := svc.SetVirtualClusterReadOnly(ctx, &gatewayv1.SetVirtualClusterReadOnlyRequest{VirtualClusterId: "vc-1", ReadOnly: true})
+				return err
+			},
+			action:   AuditActionSetVirtualClusterReadOnly,
+			targetID: "vc-1",
+			before:   map[string]interface{}{"id": "vc-1", "topic_prefix": "tenant-a:"},
+			after:    map[string]interface{}{"id": "vc-1", "topic_prefix": "tenant-a:", "read_only": true},
+		},
+		{
+			name: "UpsertCredential redacts the password hash",
+			call: func(ctx context.Context, svc *Service) error {
+				_, err := svc.UpsertCredential(ctx, &gatewayv1.UpsertCredentialRequest{Config: cred})
+				return err
+			},
+			action:   AuditActionUpsertCredential,
+			targetID: "cred-1",
+			after: map[string]interface{}{
+				"id":                 "cred-1",
+				"virtual_cluster_id": "vc-1",
+				"username":           "alice",
+				"password_hash":      redactedValue,
+			},
+		},
+		{
+			name:  "RevokeCredential",
+			setup: func(svc *Service) { svc.credStore.Upsert(cred) },
+			call: func(ctx context.Context, svc *Service) error {
+				_, err := svc.RevokeCredential(ctx, &gatewayv1.RevokeCredentialRequest{CredentialId: "cred-1"})
+				return err
+			},
+			action:   AuditActionRevokeCredential,
+			targetID: "cred-1",
+			before: map[string]interface{}{
+				"id":                 "cred-1",
+				"virtual_cluster_id": "vc-1",
+				"username":           "alice",
+				"password_hash":      redactedValue,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, sink := newAuditedTestService()
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+
+			start := time.Now()
+			require.NoError(t, tc.call(actorContext("jane@example.com"), svc))
+
+			require.Len(t, sink.records, 1)
+			record := sink.records[0]
+			assert.Equal(t, "jane@example.com", record.Actor)
+			assert.Equal(t, tc.action, record.Action)
+			assert.Equal(t, tc.targetID, record.TargetID)
+			assert.Equal(t, tc.before, summaryFields(t, record.Before))
+			assert.Equal(t, tc.after, summaryFields(t, record.After))
+			assert.False(t, record.Timestamp.Before(start.Truncate(time.Second)))
+			assert.NotContains(t, string(record.Before)+string(record.After), cred.PasswordHash)
+		})
+	}
+}
+
+func TestService_AuditRedactsScramKeys(t *testing.T) {
+	svc, sink := newAuditedTestService()
+
+	_, err := svc.UpsertCredential(context.Background(), &gatewayv1.UpsertCredentialRequest{
+		Config: &gatewayv1.CredentialConfig{
+			Id:        "cred-1",
+			Username:  "alice",
+			Mechanism: gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256,
+			Scram: &gatewayv1.ScramCredential{
+				Salt:       []byte("salt"),
+				Iterations: 4096,
+				StoredKey:  []byte("stored-key"),
+				ServerKey:  []byte("server-key"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sink.records, 1)
+	record := sink.records[0]
+	assert.Equal(t, unknownAuditActor, record.Actor, "no actor metadata")
+	scram := summaryFields(t, record.After)["scram"].(map[string]interface{})
+	assert.Equal(t, float64(4096), scram["iterations"])
+	assert.NotContains(t, scram, "stored_key")
+	assert.NotContains(t, scram, "server_key")
+}
+
+func TestService_RejectedMutationIsNotAudited(t *testing.T) {
+	svc, sink := newAuditedTestService()
+
+	_, err := svc.UpsertVirtualCluster(context.Background(), &gatewayv1.UpsertVirtualClusterRequest{})
+	require.Error(t, err)
+	_, err = svc.SetVirtualClusterReadOnly(context.Background(), &gatewayv1.SetVirtualClusterReadOnlyRequest{VirtualClusterId: "missing"})
+	require.Error(t, err)
+
+	assert.Empty(t, sink.records)
+}
+
+func TestService_AuditSinkFailureDoesNotFailCall(t *testing.T) {
+	svc, sink := newAuditedTestService()
+	sink.err = errors.New("disk full")
+
+	resp, err := svc.DeleteVirtualCluster(context.Background(), &gatewayv1.DeleteVirtualClusterRequest{VirtualClusterId: "vc-1"})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Len(t, sink.records, 1)
+}
+
+func TestJSONAuditSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONAuditSink(&buf)
+
+	require.NoError(t, sink.WriteAuditRecord(AuditRecord{
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Actor:     "jane@example.com",
+		Action:    AuditActionDeleteVirtualCluster,
+		TargetID:  "vc-1",
+		Before:    auditSummary(&gatewayv1.VirtualClusterConfig{Id: "vc-1"}),
+	}))
+
+	assert.Equal(t, `{"timestamp":"2026-01-02T03:04:05Z","actor":"jane@example.com","action":"DeleteVirtualCluster","target_id":"vc-1","before":{"id":"vc-1"}}`+"\n", buf.String())
+}
@@ -0,0 +1,171 @@
+// services/bifrost/internal/admin/backend_status.go
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+)
+
+const (
+	// backendStatusTTL is how long a physical cluster's topic and group
+	// listings are reused before GetStatus queries the cluster again.
+	backendStatusTTL = 15 * time.Second
+
+	// backendStatusTimeout bounds how long GetStatus waits on a cluster.
+	backendStatusTimeout = 5 * time.Second
+)
+
+var errNoBootstrapServers = errors.New("no physical bootstrap servers configured")
+
+// backendStatusClient is the part of KafkaAdminClient used to report the
+// status of virtual clusters.
+type backendStatusClient interface {
+	ListTopicNames(ctx context.Context) ([]string, error)
+	ListGroupNames(ctx context.Context) ([]string, error)
+	Close()
+}
+
+// clusterListing is the topics and groups of one physical cluster, or the
+// error querying it.
+type clusterListing struct {
+	topics    []string
+	groups    []string
+	err       error
+	fetchedAt time.Time
+}
+
+// backendStatusCache caches listings per physical cluster so virtual clusters
+// sharing a backend share one query, and repeated GetStatus calls within
+// backendStatusTTL query nothing. Failures are cached too, so an unreachable
+// backend doesn't slow down every call.
+type backendStatusCache struct {
+	newClient func(bootstrapServers string) (backendStatusClient, error)
+	now       func() time.Time
+
+	mu       sync.Mutex
+	listings map[string]*clusterListing
+}
+
+func newBackendStatusCache() *backendStatusCache {
+	return &backendStatusCache{
+		newClient: func(bootstrapServers string) (backendStatusClient, error) {
+			return NewKafkaAdminClient(bootstrapServers)
+		},
+		now:      time.Now,
+		listings: make(map[string]*clusterListing),
+	}
+}
+
+// virtualClusterStatuses reports the topic and group counts of each virtual
+// cluster, querying the physical clusters whose cached listings expired.
+func (c *backendStatusCache) virtualClusterStatuses(ctx context.Context, vcs []*gatewayv1.VirtualClusterConfig) []*gatewayv1.VirtualClusterStatus {
+	listings := c.listingsFor(ctx, vcs)
+
+	statuses := make([]*gatewayv1.VirtualClusterStatus, 0, len(vcs))
+	for _, vc := range vcs {
+		status := &gatewayv1.VirtualClusterStatus{VirtualClusterId: vc.Id}
+		listing := listings[vc.PhysicalBootstrapServers]
+		if listing.err != nil {
+			status.Error = listing.err.Error()
+		} else {
+			status.BackendReachable = true
+			status.TopicCount = countWithPrefix(listing.topics, vc.TopicPrefix)
+			status.GroupCount = countWithPrefix(listing.groups, vc.GroupPrefix)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// listingsFor returns a current listing of every physical cluster of vcs,
+// refreshing stale ones concurrently.
+func (c *backendStatusCache) listingsFor(ctx context.Context, vcs []*gatewayv1.VirtualClusterConfig) map[string]*clusterListing {
+	now := c.now()
+	listings := make(map[string]*clusterListing)
+	var stale []string
+
+	c.mu.Lock()
+	for _, vc := range vcs {
+		servers := vc.PhysicalBootstrapServers
+		if _, ok := listings[servers]; ok {
+			continue
+		}
+		listing, ok := c.listings[servers]
+		if ok && now.Sub(listing.fetchedAt) < backendStatusTTL {
+			listings[servers] = listing
+			continue
+		}
+		listings[servers] = nil
+		stale = append(stale, servers)
+	}
+	c.mu.Unlock()
+
+	if len(stale) == 0 {
+		return listings
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, backendStatusTimeout)
+	defer cancel()
+	fetched := make([]*clusterListing, len(stale))
+	var wg sync.WaitGroup
+	for i, servers := range stale {
+		wg.Add(1)
+		go func(i int, servers string) {
+			defer wg.Done()
+			fetched[i] = c.fetch(ctx, servers)
+		}(i, servers)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, servers := range stale {
+		c.listings[servers] = fetched[i]
+		listings[servers] = fetched[i]
+	}
+	// Forget clusters no virtual cluster uses anymore
+	for servers := range c.listings {
+		if _, ok := listings[servers]; !ok {
+			delete(c.listings, servers)
+		}
+	}
+	return listings
+}
+
+// fetch lists the topics and groups of the cluster at bootstrapServers.
+func (c *backendStatusCache) fetch(ctx context.Context, bootstrapServers string) *clusterListing {
+	listing := &clusterListing{fetchedAt: c.now()}
+	if bootstrapServers == "" {
+		listing.err = errNoBootstrapServers
+		return listing
+	}
+	client, err := c.newClient(bootstrapServers)
+	if err != nil {
+		listing.err = err
+		return listing
+	}
+	defer client.Close()
+
+	if listing.topics, listing.err = client.ListTopicNames(ctx); listing.err != nil {
+		return listing
+	}
+	listing.groups, listing.err = client.ListGroupNames(ctx)
+	return listing
+}
+
+// countWithPrefix counts the names starting with prefix; an empty prefix
+// counts every name.
+func countWithPrefix(names []string, prefix string) int32 {
+	var n int32
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			n++
+		}
+	}
+	return n
+}
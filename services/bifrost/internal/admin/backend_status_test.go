@@ -0,0 +1,131 @@
+// services/bifrost/internal/admin/backend_status_test.go
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+)
+
+// fakeStatusBackend serves fixed topic and group names per physical cluster
+// and counts the connections made to it.
+type fakeStatusBackend struct {
+	mu       sync.Mutex
+	clusters map[string]*fakeStatusClient
+	dials    int
+}
+
+type fakeStatusClient struct {
+	topics []string
+	groups []string
+	err    error
+}
+
+func (f *fakeStatusClient) ListTopicNames(ctx context.Context) ([]string, error) {
+	return f.topics, f.err
+}
+
+func (f *fakeStatusClient) ListGroupNames(ctx context.Context) ([]string, error) {
+	return f.groups, f.err
+}
+
+func (f *fakeStatusClient) Close() {}
+
+func (b *fakeStatusBackend) newClient(bootstrapServers string) (backendStatusClient, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.dials++
+	client, ok := b.clusters[bootstrapServers]
+	if !ok {
+		return nil, errors.New("unknown cluster " + bootstrapServers)
+	}
+	return client, nil
+}
+
+func TestService_GetStatus_VirtualClusterDetail(t *testing.T) {
+	vcStore := config.NewVirtualClusterStore()
+	svc := NewService(vcStore, auth.NewCredentialStore())
+
+	backend := &fakeStatusBackend{clusters: map[string]*fakeStatusClient{
+		"kafka-1:9092": {
+			topics: []string{"tenant-a:orders", "tenant-a:payments", "tenant-b:orders"},
+			groups: []string{"tenant-a:billing", "tenant-b:billing", "tenant-b:audit"},
+		},
+		"kafka-2:9092": {err: errors.New("connection refused")},
+	}}
+	now := time.Now()
+	svc.backendStatus.newClient = backend.newClient
+	svc.backendStatus.now = func() time.Time { return now }
+
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-a", TopicPrefix: "tenant-a:", GroupPrefix: "tenant-a:", PhysicalBootstrapServers: "kafka-1:9092"})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-b", TopicPrefix: "tenant-b:", GroupPrefix: "tenant-b:", PhysicalBootstrapServers: "kafka-1:9092"})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-c", TopicPrefix: "tenant-c:", GroupPrefix: "tenant-c:", PhysicalBootstrapServers: "kafka-2:9092"})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-d", PhysicalBootstrapServers: "kafka-1:9092"})
+
+	resp, err := svc.GetStatus(context.Background(), &gatewayv1.GetStatusRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, resp.VirtualClusters, 4)
+	statuses := make(map[string]*gatewayv1.VirtualClusterStatus)
+	for _, status := range resp.VirtualClusters {
+		statuses[status.VirtualClusterId] = status
+	}
+
+	assert.True(t, statuses["vc-a"].BackendReachable)
+	assert.Equal(t, int32(2), statuses["vc-a"].TopicCount)
+	assert.Equal(t, int32(1), statuses["vc-a"].GroupCount)
+
+	assert.True(t, statuses["vc-b"].BackendReachable)
+	assert.Equal(t, int32(1), statuses["vc-b"].TopicCount)
+	assert.Equal(t, int32(2), statuses["vc-b"].GroupCount)
+
+	assert.False(t, statuses["vc-c"].BackendReachable)
+	assert.Equal(t, "connection refused", statuses["vc-c"].Error)
+	assert.Zero(t, statuses["vc-c"].TopicCount)
+
+	// Without prefixes the whole cluster belongs to the virtual cluster
+	assert.Equal(t, int32(3), statuses["vc-d"].TopicCount)
+	assert.Equal(t, int32(3), statuses["vc-d"].GroupCount)
+
+	assert.Equal(t, 2, backend.dials, "one query per physical cluster")
+
+	// Within the TTL the cached listings are reused, failures included
+	_, err = svc.GetStatus(context.Background(), &gatewayv1.GetStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, backend.dials)
+
+	// Once it expires the backends are queried again
+	backend.clusters["kafka-1:9092"].topics = append(backend.clusters["kafka-1:9092"].topics, "tenant-a:refunds")
+	now = now.Add(backendStatusTTL)
+	resp, err = svc.GetStatus(context.Background(), &gatewayv1.GetStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 4, backend.dials)
+	assert.Equal(t, "vc-a", resp.VirtualClusters[0].VirtualClusterId, "sorted by id")
+	assert.Equal(t, int32(3), resp.VirtualClusters[0].TopicCount)
+}
+
+func TestService_GetStatus_NoBootstrapServers(t *testing.T) {
+	vcStore := config.NewVirtualClusterStore()
+	svc := NewService(vcStore, auth.NewCredentialStore())
+	backend := &fakeStatusBackend{}
+	svc.backendStatus.newClient = backend.newClient
+
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-1"})
+
+	resp, err := svc.GetStatus(context.Background(), &gatewayv1.GetStatusRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, resp.VirtualClusters, 1)
+	assert.False(t, resp.VirtualClusters[0].BackendReachable)
+	assert.Equal(t, errNoBootstrapServers.Error(), resp.VirtualClusters[0].Error)
+	assert.Zero(t, backend.dials)
+}
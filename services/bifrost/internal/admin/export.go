@@ -0,0 +1,197 @@
+// services/bifrost/internal/admin/export.go
+package admin
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+)
+
+// ExportConfig snapshots all virtual clusters and credentials for backup or
+// migration to another gateway. Password hashes and SCRAM secrets are only
+// included when requested.
+func (s *Service) ExportConfig(ctx context.Context, req *gatewayv1.ExportConfigRequest) (*gatewayv1.ExportConfigResponse, error) {
+	vcs := s.vcStore.List()
+	sort.Slice(vcs, func(i, j int) bool {
+		return vcs[i].Id < vcs[j].Id
+	})
+
+	creds := s.credStore.List()
+	sort.Slice(creds, func(i, j int) bool {
+		return creds[i].Id < creds[j].Id
+	})
+	if !req.IncludeSecrets {
+		for i, cred := range creds {
+			creds[i] = withoutSecrets(cred)
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"actor":            auditActor(ctx),
+		"include_secrets":  req.IncludeSecrets,
+		"virtual_clusters": len(vcs),
+		"credentials":      len(creds),
+	}).Info("Exporting configuration")
+
+	return &gatewayv1.ExportConfigResponse{
+		VirtualClusters: vcs,
+		Credentials:     creds,
+		ExportedAt:      timestamppb.Now(),
+	}, nil
+}
+
+// withoutSecrets returns a copy of cred without its password hash and SCRAM
+// secrets.
+func withoutSecrets(cred *gatewayv1.CredentialConfig) *gatewayv1.CredentialConfig {
+	stripped := proto.Clone(cred).(*gatewayv1.CredentialConfig)
+	stripped.PasswordHash = ""
+	stripped.Scram = nil
+	return stripped
+}
+
+// importPlan is the part of an import batch that will be written once the
+// whole batch has been validated.
+type importPlan struct {
+	virtualClusters        []*gatewayv1.VirtualClusterConfig
+	credentials            []*gatewayv1.CredentialConfig
+	virtualClustersSkipped int
+	credentialsSkipped     int
+}
+
+// ImportConfig upserts a batch of virtual clusters and credentials, such as
+// one produced by ExportConfig. The batch is validated as a whole before
+// anything is written, so an invalid entry or a conflict rejected by the
+// conflict policy leaves the gateway unchanged.
+func (s *Service) ImportConfig(ctx context.Context, req *gatewayv1.ImportConfigRequest) (*gatewayv1.ImportConfigResponse, error) {
+	policy := req.ConflictPolicy
+	switch policy {
+	case gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED:
+		policy = gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL
+	case gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL,
+		gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP,
+		gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown conflict policy %s", policy)
+	}
+
+	s.importMu.Lock()
+	defer s.importMu.Unlock()
+
+	plan, err := s.planImport(req, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"conflict_policy":          policy.String(),
+		"virtual_clusters":         len(plan.virtualClusters),
+		"virtual_clusters_skipped": plan.virtualClustersSkipped,
+		"credentials":              len(plan.credentials),
+		"credentials_skipped":      plan.credentialsSkipped,
+	}).Info("Importing configuration")
+
+	// Virtual clusters first, so imported credentials never reference a
+	// virtual cluster the gateway does not know yet
+	for _, vc := range plan.virtualClusters {
+		before, _ := s.vcStore.Get(vc.Id)
+		s.vcStore.Upsert(vc)
+		s.audit(ctx, AuditActionImportConfig, vc.Id, before, vc)
+	}
+	for _, cred := range plan.credentials {
+		before, _ := s.credStore.Get(cred.Id)
+		s.credStore.Upsert(cred)
+		s.audit(ctx, AuditActionImportConfig, cred.Id, before, cred)
+	}
+
+	return &gatewayv1.ImportConfigResponse{
+		VirtualClustersImported: int32(len(plan.virtualClusters)),
+		VirtualClustersSkipped:  int32(plan.virtualClustersSkipped),
+		CredentialsImported:     int32(len(plan.credentials)),
+		CredentialsSkipped:      int32(plan.credentialsSkipped),
+	}, nil
+}
+
+// planImport validates the batch against itself and the current stores and
+// decides which entries to write under policy.
+func (s *Service) planImport(req *gatewayv1.ImportConfigRequest, policy gatewayv1.ImportConflictPolicy) (*importPlan, error) {
+	plan := &importPlan{}
+
+	vcIDs := make(map[string]bool, len(req.VirtualClusters))
+	for i, vc := range req.VirtualClusters {
+		if vc == nil || vc.Id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "virtual_clusters[%d]: id is required", i)
+		}
+		if vcIDs[vc.Id] {
+			return nil, status.Errorf(codes.InvalidArgument, "virtual cluster %s appears more than once", vc.Id)
+		}
+		vcIDs[vc.Id] = true
+
+		if _, exists := s.vcStore.Get(vc.Id); exists {
+			switch policy {
+			case gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL:
+				return nil, status.Errorf(codes.AlreadyExists, "virtual cluster %s already exists", vc.Id)
+			case gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP:
+				plan.virtualClustersSkipped++
+				continue
+			}
+		}
+		plan.virtualClusters = append(plan.virtualClusters, vc)
+	}
+
+	credIDs := make(map[string]bool, len(req.Credentials))
+	usernames := make(map[string]bool, len(req.Credentials))
+	for i, cred := range req.Credentials {
+		if cred == nil || cred.Id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "credentials[%d]: id is required", i)
+		}
+		if cred.Username == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "credential %s: username is required", cred.Id)
+		}
+		if credIDs[cred.Id] {
+			return nil, status.Errorf(codes.InvalidArgument, "credential %s appears more than once", cred.Id)
+		}
+		credIDs[cred.Id] = true
+		if usernames[cred.Username] {
+			return nil, status.Errorf(codes.InvalidArgument, "username %s appears more than once", cred.Username)
+		}
+		usernames[cred.Username] = true
+
+		if !vcIDs[cred.VirtualClusterId] {
+			if _, ok := s.vcStore.Get(cred.VirtualClusterId); !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "credential %s references unknown virtual cluster %q", cred.Id, cred.VirtualClusterId)
+			}
+		}
+		if err := validateScramCredential(cred); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "credential %s: %v", cred.Id, err)
+		}
+		if !auth.IsScramMechanism(cred.Mechanism) && cred.PasswordHash == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "credential %s: password hash is required, export with include_secrets to migrate credentials", cred.Id)
+		}
+
+		if _, exists := s.credStore.Get(cred.Id); exists {
+			switch policy {
+			case gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL:
+				return nil, status.Errorf(codes.AlreadyExists, "credential %s already exists", cred.Id)
+			case gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP:
+				plan.credentialsSkipped++
+				continue
+			}
+		}
+		// Usernames identify credentials at authentication, so one owned by
+		// another credential is a conflict no policy can resolve
+		if owner, ok := s.credStore.GetByUsername(cred.Username); ok && owner.Id != cred.Id {
+			return nil, status.Errorf(codes.AlreadyExists, "username %s is already used by credential %s", cred.Username, owner.Id)
+		}
+		plan.credentials = append(plan.credentials, cred)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,273 @@
+// services/bifrost/internal/admin/export_test.go
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+)
+
+// seedExportTestService stores two virtual clusters with a PLAIN and a SCRAM
+// credential.
+func seedExportTestService(t *testing.T) *Service {
+	t.Helper()
+	svc, _ := newAuditedTestService()
+	svc.vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-a",
+		TopicPrefix:              "a-",
+		GroupPrefix:              "a-",
+		AdvertisedHost:           "a.kafka.example.com",
+		AdvertisedPort:           9092,
+		PhysicalBootstrapServers: "kafka:9092",
+	})
+	svc.vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:          "vc-b",
+		TopicPrefix: "b-",
+		ReadOnly:    true,
+	})
+	svc.credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-plain",
+		VirtualClusterId: "vc-a",
+		Username:         "alice",
+		PasswordHash:     "hash",
+		Template:         gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER,
+	})
+	svc.credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-scram",
+		VirtualClusterId: "vc-b",
+		Username:         "bob",
+		Mechanism:        gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256,
+		Scram: &gatewayv1.ScramCredential{
+			Salt:       []byte("salt"),
+			Iterations: 4096,
+			StoredKey:  []byte("stored-key"),
+			ServerKey:  []byte("server-key"),
+		},
+	})
+	return svc
+}
+
+func TestService_ExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := seedExportTestService(t)
+
+	exported, err := source.ExportConfig(ctx, &gatewayv1.ExportConfigRequest{IncludeSecrets: true})
+	require.NoError(t, err)
+	require.Len(t, exported.VirtualClusters, 2)
+	require.Len(t, exported.Credentials, 2)
+	assert.NotNil(t, exported.ExportedAt)
+
+	target, _ := newAuditedTestService()
+	resp, err := target.ImportConfig(ctx, &gatewayv1.ImportConfigRequest{
+		VirtualClusters: exported.VirtualClusters,
+		Credentials:     exported.Credentials,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.VirtualClustersImported)
+	assert.Equal(t, int32(2), resp.CredentialsImported)
+
+	reexported, err := target.ExportConfig(ctx, &gatewayv1.ExportConfigRequest{IncludeSecrets: true})
+	require.NoError(t, err)
+	reexported.ExportedAt = exported.ExportedAt
+	assert.True(t, proto.Equal(exported, reexported), "expected %v, got %v", exported, reexported)
+
+	// The imported credentials authenticate like the originals
+	cred, ok := target.credStore.GetByUsername("bob")
+	require.True(t, ok)
+	assert.Equal(t, "vc-b", cred.VirtualClusterId)
+}
+
+func TestService_ExportConfig_OmitsSecretsByDefault(t *testing.T) {
+	svc := seedExportTestService(t)
+
+	exported, err := svc.ExportConfig(context.Background(), &gatewayv1.ExportConfigRequest{})
+	require.NoError(t, err)
+	require.Len(t, exported.Credentials, 2)
+	for _, cred := range exported.Credentials {
+		assert.Empty(t, cred.PasswordHash)
+		assert.Nil(t, cred.Scram)
+		assert.NotEmpty(t, cred.Username)
+	}
+
+	// The stored credentials keep their secrets
+	stored, ok := svc.credStore.Get("cred-plain")
+	require.True(t, ok)
+	assert.Equal(t, "hash", stored.PasswordHash)
+}
+
+func TestService_ImportConfig_RequiresSecrets(t *testing.T) {
+	ctx := context.Background()
+	exported, err := seedExportTestService(t).ExportConfig(ctx, &gatewayv1.ExportConfigRequest{})
+	require.NoError(t, err)
+
+	target, _ := newAuditedTestService()
+	_, err = target.ImportConfig(ctx, &gatewayv1.ImportConfigRequest{
+		VirtualClusters: exported.VirtualClusters,
+		Credentials:     exported.Credentials,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, target.vcStore.List(), "a rejected batch must not be partially applied")
+}
+
+func TestService_ImportConfig_ConflictPolicies(t *testing.T) {
+	ctx := context.Background()
+	incoming := func() *gatewayv1.ImportConfigRequest {
+		return &gatewayv1.ImportConfigRequest{
+			VirtualClusters: []*gatewayv1.VirtualClusterConfig{
+				{Id: "vc-a", TopicPrefix: "imported-"},
+				{Id: "vc-new", TopicPrefix: "new-"},
+			},
+			Credentials: []*gatewayv1.CredentialConfig{
+				{Id: "cred-plain", VirtualClusterId: "vc-a", Username: "alice", PasswordHash: "imported-hash"},
+				{Id: "cred-new", VirtualClusterId: "vc-new", Username: "carol", PasswordHash: "hash"},
+			},
+		}
+	}
+
+	t.Run("unspecified fails the whole batch", func(t *testing.T) {
+		svc := seedExportTestService(t)
+		_, err := svc.ImportConfig(ctx, incoming())
+		require.Error(t, err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, ok := svc.vcStore.Get("vc-new")
+		assert.False(t, ok)
+		_, ok = svc.credStore.Get("cred-new")
+		assert.False(t, ok)
+	})
+
+	t.Run("skip keeps existing entries", func(t *testing.T) {
+		svc := seedExportTestService(t)
+		req := incoming()
+		req.ConflictPolicy = gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP
+		resp, err := svc.ImportConfig(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, &gatewayv1.ImportConfigResponse{
+			VirtualClustersImported: 1,
+			VirtualClustersSkipped:  1,
+			CredentialsImported:     1,
+			CredentialsSkipped:      1,
+		}, resp)
+
+		vc, _ := svc.vcStore.Get("vc-a")
+		assert.Equal(t, "a-", vc.TopicPrefix)
+		cred, _ := svc.credStore.Get("cred-plain")
+		assert.Equal(t, "hash", cred.PasswordHash)
+		_, ok := svc.credStore.Get("cred-new")
+		assert.True(t, ok)
+	})
+
+	t.Run("overwrite replaces existing entries", func(t *testing.T) {
+		svc := seedExportTestService(t)
+		req := incoming()
+		req.ConflictPolicy = gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE
+		resp, err := svc.ImportConfig(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.VirtualClustersImported)
+		assert.Equal(t, int32(2), resp.CredentialsImported)
+
+		vc, _ := svc.vcStore.Get("vc-a")
+		assert.Equal(t, "imported-", vc.TopicPrefix)
+		cred, _ := svc.credStore.Get("cred-plain")
+		assert.Equal(t, "imported-hash", cred.PasswordHash)
+	})
+}
+
+func TestService_ImportConfig_RejectsInvalidBatch(t *testing.T) {
+	plain := func(id, vcID, username string) *gatewayv1.CredentialConfig {
+		return &gatewayv1.CredentialConfig{Id: id, VirtualClusterId: vcID, Username: username, PasswordHash: "hash"}
+	}
+	tests := map[string]struct {
+		req  *gatewayv1.ImportConfigRequest
+		code codes.Code
+	}{
+		"unknown virtual cluster reference": {
+			req: &gatewayv1.ImportConfigRequest{
+				VirtualClusters: []*gatewayv1.VirtualClusterConfig{{Id: "vc-new"}},
+				Credentials:     []*gatewayv1.CredentialConfig{plain("cred-new", "vc-missing", "carol")},
+			},
+			code: codes.InvalidArgument,
+		},
+		"missing virtual cluster id": {
+			req: &gatewayv1.ImportConfigRequest{
+				VirtualClusters: []*gatewayv1.VirtualClusterConfig{{Id: "vc-new"}, {}},
+			},
+			code: codes.InvalidArgument,
+		},
+		"duplicate credential id": {
+			req: &gatewayv1.ImportConfigRequest{
+				Credentials: []*gatewayv1.CredentialConfig{plain("cred-new", "vc-a", "carol"), plain("cred-new", "vc-a", "dave")},
+			},
+			code: codes.InvalidArgument,
+		},
+		"duplicate username": {
+			req: &gatewayv1.ImportConfigRequest{
+				Credentials: []*gatewayv1.CredentialConfig{plain("cred-new", "vc-a", "carol"), plain("cred-other", "vc-a", "carol")},
+			},
+			code: codes.InvalidArgument,
+		},
+		"scram without secrets": {
+			req: &gatewayv1.ImportConfigRequest{
+				Credentials: []*gatewayv1.CredentialConfig{{
+					Id:               "cred-new",
+					VirtualClusterId: "vc-a",
+					Username:         "carol",
+					Mechanism:        gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512,
+				}},
+			},
+			code: codes.InvalidArgument,
+		},
+		"username owned by another credential": {
+			req: &gatewayv1.ImportConfigRequest{
+				Credentials:    []*gatewayv1.CredentialConfig{plain("cred-new", "vc-a", "alice")},
+				ConflictPolicy: gatewayv1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE,
+			},
+			code: codes.AlreadyExists,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			svc := seedExportTestService(t)
+			before, err := svc.ExportConfig(context.Background(), &gatewayv1.ExportConfigRequest{IncludeSecrets: true})
+			require.NoError(t, err)
+
+			_, err = svc.ImportConfig(context.Background(), tc.req)
+			require.Error(t, err)
+			assert.Equal(t, tc.code, status.Code(err))
+
+			after, err := svc.ExportConfig(context.Background(), &gatewayv1.ExportConfigRequest{IncludeSecrets: true})
+			require.NoError(t, err)
+			after.ExportedAt = before.ExportedAt
+			assert.True(t, proto.Equal(before, after), "a rejected batch must leave the stores unchanged")
+		})
+	}
+}
+
+func TestService_ImportConfig_AuditsEachEntry(t *testing.T) {
+	svc, sink := newAuditedTestService()
+	_, err := svc.ImportConfig(actorContext("admin@example.com"), &gatewayv1.ImportConfigRequest{
+		VirtualClusters: []*gatewayv1.VirtualClusterConfig{{Id: "vc-new"}},
+		Credentials: []*gatewayv1.CredentialConfig{
+			{Id: "cred-new", VirtualClusterId: "vc-new", Username: "carol", PasswordHash: "hash"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sink.records, 2)
+	for _, record := range sink.records {
+		assert.Equal(t, AuditActionImportConfig, record.Action)
+		assert.Equal(t, "admin@example.com", record.Actor)
+	}
+	assert.Equal(t, "vc-new", sink.records[0].TargetID)
+	assert.Equal(t, "cred-new", sink.records[1].TargetID)
+	assert.NotContains(t, string(sink.records[1].After), `"hash"`)
+}
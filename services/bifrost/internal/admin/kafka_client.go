@@ -16,6 +16,15 @@ type KafkaAdminClient struct {
 	admin  *kadm.Client
 }
 
+// groupLagClient is the part of KafkaAdminClient used to compute consumer
+// group lag.
+type groupLagClient interface {
+	ListGroups(ctx context.Context) (kadm.DescribedGroups, error)
+	FetchGroupOffsets(ctx context.Context, groupID string) (kadm.OffsetResponses, error)
+	FetchEndOffsets(ctx context.Context, topics ...string) (kadm.ListedOffsets, error)
+	Close()
+}
+
 // NewKafkaAdminClient creates a client connected to the physical Kafka cluster.
 func NewKafkaAdminClient(bootstrapServers string) (*KafkaAdminClient, error) {
 	client, err := kgo.NewClient(
@@ -62,6 +71,25 @@ func (k *KafkaAdminClient) ListGroups(ctx context.Context) (kadm.DescribedGroups
 	return described, nil
 }
 
+// ListGroupNames returns the names of all consumer groups on the cluster
+// without describing them.
+func (k *KafkaAdminClient) ListGroupNames(ctx context.Context) ([]string, error) {
+	listed, err := k.admin.ListGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list groups: %w", err)
+	}
+	return listed.Groups(), nil
+}
+
+// ListTopicNames returns the names of all non-internal topics on the cluster.
+func (k *KafkaAdminClient) ListTopicNames(ctx context.Context) ([]string, error) {
+	topics, err := k.admin.ListTopics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list topics: %w", err)
+	}
+	return topics.Names(), nil
+}
+
 // DescribeGroup returns detailed info about a specific group.
 func (k *KafkaAdminClient) DescribeGroup(ctx context.Context, groupID string) (kadm.DescribedGroup, error) {
 	described, err := k.admin.DescribeGroups(ctx, groupID)
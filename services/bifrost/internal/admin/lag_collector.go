@@ -0,0 +1,157 @@
+// services/bifrost/internal/admin/lag_collector.go
+package admin
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+)
+
+// LagCollector periodically computes the lag of every consumer group of every
+// virtual cluster and exports it as the bifrost_consumer_lag gauge. Groups and
+// topics are reported by the names the virtual cluster's clients use.
+type LagCollector struct {
+	vcStore  *config.VirtualClusterStore
+	metrics  *metrics.Collector
+	interval time.Duration
+
+	// newClient connects to a virtual cluster's physical cluster
+	newClient func(bootstrapServers string) (groupLagClient, error)
+
+	// reported holds the virtual clusters with lag currently exported
+	reported map[string]struct{}
+
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewLagCollector creates a collector that refreshes consumer lag every
+// interval once started.
+func NewLagCollector(vcStore *config.VirtualClusterStore, metricsCollector *metrics.Collector, interval time.Duration) *LagCollector {
+	return &LagCollector{
+		vcStore:  vcStore,
+		metrics:  metricsCollector,
+		interval: interval,
+		newClient: func(bootstrapServers string) (groupLagClient, error) {
+			return NewKafkaAdminClient(bootstrapServers)
+		},
+		reported: make(map[string]struct{}),
+		shutdown: make(chan struct{}),
+	}
+}
+
+// Start begins collecting consumer lag in the background.
+func (c *LagCollector) Start() {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), c.interval)
+			c.collect(ctx)
+			cancel()
+
+			select {
+			case <-c.shutdown:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops collecting and waits for an in-progress collection to finish.
+func (c *LagCollector) Stop() {
+	close(c.shutdown)
+	c.wg.Wait()
+}
+
+// collect refreshes the consumer lag of every virtual cluster. A virtual
+// cluster whose cluster can't be queried keeps its previously reported lag.
+func (c *LagCollector) collect(ctx context.Context) {
+	current := make(map[string]struct{})
+	for _, vc := range c.vcStore.List() {
+		current[vc.Id] = struct{}{}
+		lags, err := c.virtualClusterLag(ctx, vc)
+		if err != nil {
+			logrus.WithError(err).WithField("virtual_cluster_id", vc.Id).Warn("Failed to collect consumer lag")
+			continue
+		}
+		c.metrics.SetConsumerLag(vc.Id, lags)
+		c.reported[vc.Id] = struct{}{}
+	}
+
+	// Stop reporting deleted virtual clusters
+	for id := range c.reported {
+		if _, ok := current[id]; !ok {
+			c.metrics.SetConsumerLag(id, nil)
+			delete(c.reported, id)
+		}
+	}
+}
+
+// virtualClusterLag returns the partition lag of the consumer groups of vc.
+func (c *LagCollector) virtualClusterLag(ctx context.Context, vc *gatewayv1.VirtualClusterConfig) ([]metrics.ConsumerLag, error) {
+	client, err := c.newClient(vc.PhysicalBootstrapServers)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	groups, err := client.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lags []metrics.ConsumerLag
+	for _, group := range groups {
+		// Only groups belonging to this virtual cluster
+		if vc.GroupPrefix != "" && !strings.HasPrefix(group.Group, vc.GroupPrefix) {
+			continue
+		}
+		if group.State == "Dead" {
+			continue
+		}
+
+		// Empty groups have no assignments, so use their committed offsets
+		physicalTopics := GetSubscribedTopics(group)
+		if len(physicalTopics) == 0 {
+			offsets, err := client.FetchGroupOffsets(ctx, group.Group)
+			if err != nil {
+				logrus.WithError(err).WithField("group", group.Group).Warn("Failed to fetch offsets for empty group")
+				continue
+			}
+			physicalTopics = GetTopicsFromOffsets(offsets)
+		}
+		topics := physicalTopics[:0]
+		for _, topic := range physicalTopics {
+			if vc.TopicPrefix == "" || strings.HasPrefix(topic, vc.TopicPrefix) {
+				topics = append(topics, topic)
+			}
+		}
+
+		partitionLags, _, err := getPartitionLags(ctx, client, group.Group, topics, vc.TopicPrefix)
+		if err != nil {
+			logrus.WithError(err).WithField("group", group.Group).Warn("Failed to get partition lags")
+			continue
+		}
+		virtualGroupID := strings.TrimPrefix(group.Group, vc.GroupPrefix)
+		for _, partitionLag := range partitionLags {
+			lags = append(lags, metrics.ConsumerLag{
+				Group:     virtualGroupID,
+				Topic:     partitionLag.Topic,
+				Partition: partitionLag.Partition,
+				Lag:       partitionLag.Lag,
+			})
+		}
+	}
+	return lags, nil
+}
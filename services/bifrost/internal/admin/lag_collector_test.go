@@ -0,0 +1,130 @@
+// services/bifrost/internal/admin/lag_collector_test.go
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+)
+
+// fakeLagClient serves fixed groups and offsets of one physical cluster.
+type fakeLagClient struct {
+	groups    kadm.DescribedGroups
+	committed map[string]kadm.OffsetResponses // by group
+	end       kadm.ListedOffsets
+}
+
+func (f *fakeLagClient) ListGroups(ctx context.Context) (kadm.DescribedGroups, error) {
+	return f.groups, nil
+}
+
+func (f *fakeLagClient) FetchGroupOffsets(ctx context.Context, groupID string) (kadm.OffsetResponses, error) {
+	return f.committed[groupID], nil
+}
+
+func (f *fakeLagClient) FetchEndOffsets(ctx context.Context, topics ...string) (kadm.ListedOffsets, error) {
+	listed := make(kadm.ListedOffsets)
+	for _, topic := range topics {
+		if partitions, ok := f.end[topic]; ok {
+			listed[topic] = partitions
+		}
+	}
+	return listed, nil
+}
+
+func (f *fakeLagClient) Close() {}
+
+func committedOffsets(topic string, offsets ...int64) kadm.OffsetResponses {
+	partitions := make(map[int32]kadm.OffsetResponse)
+	for i, at := range offsets {
+		partitions[int32(i)] = kadm.OffsetResponse{Offset: kadm.Offset{Topic: topic, Partition: int32(i), At: at}}
+	}
+	return kadm.OffsetResponses{topic: partitions}
+}
+
+func endOffsets(topic string, offsets ...int64) map[int32]kadm.ListedOffset {
+	partitions := make(map[int32]kadm.ListedOffset)
+	for i, offset := range offsets {
+		partitions[int32(i)] = kadm.ListedOffset{Topic: topic, Partition: int32(i), Offset: offset}
+	}
+	return partitions
+}
+
+func TestLagCollector_Collect(t *testing.T) {
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-1",
+		TopicPrefix:              "tenant-a:",
+		GroupPrefix:              "tenant-a:",
+		PhysicalBootstrapServers: "kafka-1:9092",
+	})
+	client := &fakeLagClient{
+		groups: kadm.DescribedGroups{
+			"tenant-a:billing": {Group: "tenant-a:billing", State: "Empty"},
+			"tenant-a:gone":    {Group: "tenant-a:gone", State: "Dead"},
+			"tenant-b:billing": {Group: "tenant-b:billing", State: "Empty"},
+		},
+		committed: map[string]kadm.OffsetResponses{
+			"tenant-a:billing": committedOffsets("tenant-a:orders", 5, 10),
+			"tenant-a:gone":    committedOffsets("tenant-a:orders", 0, 0),
+			"tenant-b:billing": committedOffsets("tenant-b:orders", 0),
+		},
+		end: kadm.ListedOffsets{
+			"tenant-a:orders": endOffsets("tenant-a:orders", 8, 10),
+			"tenant-b:orders": endOffsets("tenant-b:orders", 100),
+		},
+	}
+
+	m := metrics.NewCollector()
+	c := NewLagCollector(vcStore, m, time.Minute)
+	c.newClient = func(bootstrapServers string) (groupLagClient, error) {
+		assert.Equal(t, "kafka-1:9092", bootstrapServers)
+		return client, nil
+	}
+
+	c.collect(context.Background())
+
+	expected := `
+		# HELP bifrost_consumer_lag Messages between a consumer group's committed offset and the end of a partition
+		# TYPE bifrost_consumer_lag gauge
+		bifrost_consumer_lag{group="billing",partition="0",topic="orders",virtual_cluster_id="vc-1"} 3
+		bifrost_consumer_lag{group="billing",partition="1",topic="orders",virtual_cluster_id="vc-1"} 0
+	`
+	require.NoError(t, testutil.CollectAndCompare(m, strings.NewReader(expected), "bifrost_consumer_lag"))
+
+	// Deleted virtual clusters are no longer reported
+	vcStore.Delete("vc-1")
+	c.collect(context.Background())
+	assert.Zero(t, testutil.CollectAndCount(m, "bifrost_consumer_lag"))
+}
+
+func TestLagCollector_UnreachableClusterKeepsLag(t *testing.T) {
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-1", PhysicalBootstrapServers: "kafka-1:9092"})
+	client := &fakeLagClient{
+		groups:    kadm.DescribedGroups{"billing": {Group: "billing", State: "Empty"}},
+		committed: map[string]kadm.OffsetResponses{"billing": committedOffsets("orders", 1)},
+		end:       kadm.ListedOffsets{"orders": endOffsets("orders", 4)},
+	}
+
+	m := metrics.NewCollector()
+	c := NewLagCollector(vcStore, m, time.Minute)
+	c.newClient = func(string) (groupLagClient, error) { return client, nil }
+	c.collect(context.Background())
+	require.Equal(t, 1, testutil.CollectAndCount(m, "bifrost_consumer_lag"))
+
+	c.newClient = func(string) (groupLagClient, error) { return nil, errors.New("connection refused") }
+	c.collect(context.Background())
+	assert.Equal(t, 1, testutil.CollectAndCount(m, "bifrost_consumer_lag"))
+}
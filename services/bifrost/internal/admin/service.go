@@ -3,8 +3,11 @@ package admin
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/twmb/franz-go/pkg/kadm"
@@ -26,16 +29,33 @@ type Service struct {
 
 	vcStore   *config.VirtualClusterStore
 	credStore *auth.CredentialStore
+	auditSink AuditSink
+
+	// backendStatus caches the backend queries behind GetStatus
+	backendStatus *backendStatusCache
+
+	// importMu serializes ImportConfig so each batch is validated and
+	// applied against the same store contents
+	importMu sync.Mutex
 }
 
-// NewService creates a new admin service with the given stores.
+// NewService creates a new admin service with the given stores. Changes are
+// audited as JSON on stdout until SetAuditSink is called.
 func NewService(vcStore *config.VirtualClusterStore, credStore *auth.CredentialStore) *Service {
 	return &Service{
 		vcStore:   vcStore,
 		credStore: credStore,
+		auditSink: NewJSONAuditSink(os.Stdout),
+
+		backendStatus: newBackendStatusCache(),
 	}
 }
 
+// SetAuditSink sets where the audit trail of mutating calls is written.
+func (s *Service) SetAuditSink(sink AuditSink) {
+	s.auditSink = sink
+}
+
 // UpsertVirtualCluster adds or updates a virtual cluster configuration.
 func (s *Service) UpsertVirtualCluster(ctx context.Context, req *gatewayv1.UpsertVirtualClusterRequest) (*gatewayv1.UpsertVirtualClusterResponse, error) {
 	if req.Config == nil {
@@ -47,7 +67,9 @@ func (s *Service) UpsertVirtualCluster(ctx context.Context, req *gatewayv1.Upser
 		"topic_prefix":       req.Config.TopicPrefix,
 	}).Info("Upserting virtual cluster")
 
+	before, _ := s.vcStore.Get(req.Config.Id)
 	s.vcStore.Upsert(req.Config)
+	s.audit(ctx, AuditActionUpsertVirtualCluster, req.Config.Id, before, req.Config)
 
 	return &gatewayv1.UpsertVirtualClusterResponse{Success: true}, nil
 }
@@ -56,7 +78,9 @@ func (s *Service) UpsertVirtualCluster(ctx context.Context, req *gatewayv1.Upser
 func (s *Service) DeleteVirtualCluster(ctx context.Context, req *gatewayv1.DeleteVirtualClusterRequest) (*gatewayv1.DeleteVirtualClusterResponse, error) {
 	logrus.WithField("virtual_cluster_id", req.VirtualClusterId).Info("Deleting virtual cluster")
 
+	before, _ := s.vcStore.Get(req.VirtualClusterId)
 	s.vcStore.Delete(req.VirtualClusterId)
+	s.audit(ctx, AuditActionDeleteVirtualCluster, req.VirtualClusterId, before, nil)
 
 	return &gatewayv1.DeleteVirtualClusterResponse{Success: true}, nil
 }
@@ -79,6 +103,7 @@ func (s *Service) SetVirtualClusterReadOnly(ctx context.Context, req *gatewayv1.
 	updatedVC := proto.Clone(vc).(*gatewayv1.VirtualClusterConfig)
 	updatedVC.ReadOnly = req.ReadOnly
 	s.vcStore.Upsert(updatedVC)
+	s.audit(ctx, AuditActionSetVirtualClusterReadOnly, req.VirtualClusterId, vc, updatedVC)
 
 	return &gatewayv1.SetVirtualClusterReadOnlyResponse{Success: true}, nil
 }
@@ -97,23 +122,44 @@ func (s *Service) UpsertCredential(ctx context.Context, req *gatewayv1.UpsertCre
 	if req.Config == nil {
 		return nil, status.Error(codes.InvalidArgument, "config is required")
 	}
+	if err := validateScramCredential(req.Config); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	logrus.WithFields(logrus.Fields{
 		"credential_id":      req.Config.Id,
 		"virtual_cluster_id": req.Config.VirtualClusterId,
 		"username":           req.Config.Username,
+		"mechanism":          req.Config.Mechanism,
 	}).Info("Upserting credential")
 
+	before, _ := s.credStore.Get(req.Config.Id)
 	s.credStore.Upsert(req.Config)
+	s.audit(ctx, AuditActionUpsertCredential, req.Config.Id, before, req.Config)
 
 	return &gatewayv1.UpsertCredentialResponse{Success: true}, nil
 }
 
+// validateScramCredential checks that a credential using a SCRAM mechanism
+// carries the salted secrets needed to authenticate it.
+func validateScramCredential(cred *gatewayv1.CredentialConfig) error {
+	if !auth.IsScramMechanism(cred.Mechanism) {
+		return nil
+	}
+	scram := cred.Scram
+	if scram == nil || len(scram.Salt) == 0 || scram.Iterations <= 0 || len(scram.StoredKey) == 0 || len(scram.ServerKey) == 0 {
+		return fmt.Errorf("scram credentials are required for mechanism %s", cred.Mechanism)
+	}
+	return nil
+}
+
 // RevokeCredential removes a credential.
 func (s *Service) RevokeCredential(ctx context.Context, req *gatewayv1.RevokeCredentialRequest) (*gatewayv1.RevokeCredentialResponse, error) {
 	logrus.WithField("credential_id", req.CredentialId).Info("Revoking credential")
 
+	before, _ := s.credStore.Get(req.CredentialId)
 	s.credStore.Delete(req.CredentialId)
+	s.audit(ctx, AuditActionRevokeCredential, req.CredentialId, before, nil)
 
 	return &gatewayv1.RevokeCredentialResponse{Success: true}, nil
 }
@@ -133,8 +179,15 @@ func (s *Service) ListCredentials(ctx context.Context, req *gatewayv1.ListCreden
 	}, nil
 }
 
-// GetStatus returns the current status of the Bifrost gateway.
+// GetStatus returns the current status of the Bifrost gateway, including the
+// topic and group counts of each virtual cluster and whether its backend is
+// reachable. Backend counts may be up to backendStatusTTL old.
 func (s *Service) GetStatus(ctx context.Context, req *gatewayv1.GetStatusRequest) (*gatewayv1.GetStatusResponse, error) {
+	vcs := s.vcStore.List()
+	sort.Slice(vcs, func(i, j int) bool {
+		return vcs[i].Id < vcs[j].Id
+	})
+
 	return &gatewayv1.GetStatusResponse{
 		Status:              "healthy",
 		ActiveConnections:   0, // TODO: Track active connections when proxy is integrated
@@ -142,6 +195,7 @@ func (s *Service) GetStatus(ctx context.Context, req *gatewayv1.GetStatusRequest
 		VersionInfo: map[string]string{
 			"version": "0.1.0",
 		},
+		VirtualClusters: s.backendStatus.virtualClusterStatuses(ctx, vcs),
 	}, nil
 }
 
@@ -325,7 +379,7 @@ func (s *Service) DescribeConsumerGroup(ctx context.Context, req *gatewayv1.Desc
 	}
 
 	// Get partition-level lag
-	partitionLags, totalLag, err := s.getPartitionLags(ctx, kafkaClient, physicalGroupID, physicalTopics, vc.TopicPrefix)
+	partitionLags, totalLag, err := getPartitionLags(ctx, kafkaClient, physicalGroupID, physicalTopics, vc.TopicPrefix)
 	if err != nil {
 		logrus.WithError(err).WithField("group", physicalGroupID).Warn("Failed to get partition lags")
 	}
@@ -431,6 +485,8 @@ func (s *Service) ResetConsumerGroupOffsets(ctx context.Context, req *gatewayv1.
 		}, nil
 	}
 
+	s.audit(ctx, AuditActionResetConsumerGroupOffsets, req.VirtualClusterId+"/"+req.GroupId, nil, req)
+
 	// Build response with new offsets
 	var newOffsets []*gatewayv1.PartitionLag
 	for _, offset := range targetOffsets[physicalTopic] {
@@ -491,7 +547,7 @@ func (s *Service) calculateGroupLag(ctx context.Context, client *KafkaAdminClien
 }
 
 // getPartitionLags returns partition-level lag information.
-func (s *Service) getPartitionLags(ctx context.Context, client *KafkaAdminClient, groupID string, topics []string, topicPrefix string) ([]*gatewayv1.PartitionLag, int64, error) {
+func getPartitionLags(ctx context.Context, client groupLagClient, groupID string, topics []string, topicPrefix string) ([]*gatewayv1.PartitionLag, int64, error) {
 	if len(topics) == 0 {
 		return nil, 0, nil
 	}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/config"
@@ -125,6 +127,57 @@ func TestService_UpsertCredential_NilConfig(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestService_UpsertCredential_ScramMechanism(t *testing.T) {
+	vcStore := config.NewVirtualClusterStore()
+	credStore := auth.NewCredentialStore()
+	svc := NewService(vcStore, credStore)
+
+	ctx := context.Background()
+	req := &gatewayv1.UpsertCredentialRequest{
+		Config: &gatewayv1.CredentialConfig{
+			Id:        "cred-123",
+			Username:  "testuser",
+			Mechanism: gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512,
+			Scram: &gatewayv1.ScramCredential{
+				Salt:       []byte("salt"),
+				Iterations: 4096,
+				StoredKey:  []byte("stored-key"),
+				ServerKey:  []byte("server-key"),
+			},
+		},
+	}
+
+	resp, err := svc.UpsertCredential(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	cred, ok := credStore.Get("cred-123")
+	require.True(t, ok)
+	assert.Equal(t, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512, cred.Mechanism)
+}
+
+func TestService_UpsertCredential_ScramWithoutSecrets(t *testing.T) {
+	vcStore := config.NewVirtualClusterStore()
+	credStore := auth.NewCredentialStore()
+	svc := NewService(vcStore, credStore)
+
+	ctx := context.Background()
+	req := &gatewayv1.UpsertCredentialRequest{
+		Config: &gatewayv1.CredentialConfig{
+			Id:        "cred-123",
+			Username:  "testuser",
+			Mechanism: gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256,
+		},
+	}
+
+	_, err := svc.UpsertCredential(ctx, req)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, ok := credStore.Get("cred-123")
+	assert.False(t, ok)
+}
+
 func TestService_RevokeCredential(t *testing.T) {
 	vcStore := config.NewVirtualClusterStore()
 	credStore := auth.NewCredentialStore()
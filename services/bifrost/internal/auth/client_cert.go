@@ -0,0 +1,161 @@
+// services/bifrost/internal/auth/client_cert.go
+package auth
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+)
+
+// ErrNoCertificateMapping indicates no rule maps a client certificate to a
+// virtual cluster.
+var ErrNoCertificateMapping = errors.New("no virtual cluster mapping for client certificate")
+
+// ClientCertRule maps client certificates to a virtual cluster. A rule
+// matches a certificate when every pattern it sets matches; patterns are
+// regular expressions that must match the whole value.
+type ClientCertRule struct {
+	// SubjectPattern is matched against the certificate subject in
+	// RFC 2253 form, e.g. "CN=orders,O=Acme"
+	SubjectPattern string `json:"subject_pattern,omitempty"`
+	// SANPattern is matched against each DNS name, email address, URI and
+	// IP address in the certificate's subject alternative names
+	SANPattern string `json:"san_pattern,omitempty"`
+
+	VirtualClusterID string `json:"virtual_cluster_id"`
+	// PermissionTemplate is "producer", "consumer" or "admin"
+	PermissionTemplate string `json:"permission_template"`
+}
+
+// LoadClientCertRules reads a JSON array of rules from path.
+func LoadClientCertRules(path string) ([]ClientCertRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read client certificate rules: %w", err)
+	}
+	var rules []ClientCertRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parse client certificate rules: %w", err)
+	}
+	return rules, nil
+}
+
+type clientCertMatcher struct {
+	subject          *regexp.Regexp
+	san              *regexp.Regexp
+	virtualClusterID string
+	template         gatewayv1.PermissionTemplate
+}
+
+// ClientCertMapper resolves verified client certificates to a connection
+// context using the first rule that matches.
+type ClientCertMapper struct {
+	matchers []clientCertMatcher
+	vcStore  *config.VirtualClusterStore
+}
+
+// NewClientCertMapper compiles rules into a mapper resolving virtual
+// clusters from vcStore.
+func NewClientCertMapper(rules []ClientCertRule, vcStore *config.VirtualClusterStore) (*ClientCertMapper, error) {
+	m := &ClientCertMapper{vcStore: vcStore}
+	for i, rule := range rules {
+		if rule.SubjectPattern == "" && rule.SANPattern == "" {
+			return nil, fmt.Errorf("rule %d: subject_pattern or san_pattern is required", i)
+		}
+		if rule.VirtualClusterID == "" {
+			return nil, fmt.Errorf("rule %d: virtual_cluster_id is required", i)
+		}
+		template, err := parsePermissionTemplate(rule.PermissionTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+
+		matcher := clientCertMatcher{virtualClusterID: rule.VirtualClusterID, template: template}
+		if matcher.subject, err = compileFullMatch(rule.SubjectPattern); err != nil {
+			return nil, fmt.Errorf("rule %d: subject_pattern: %w", i, err)
+		}
+		if matcher.san, err = compileFullMatch(rule.SANPattern); err != nil {
+			return nil, fmt.Errorf("rule %d: san_pattern: %w", i, err)
+		}
+		m.matchers = append(m.matchers, matcher)
+	}
+	return m, nil
+}
+
+// Authenticate returns the connection context for a verified client
+// certificate, or ErrNoCertificateMapping when no rule matches it.
+func (m *ClientCertMapper) Authenticate(cert *x509.Certificate) (*ConnectionContext, error) {
+	subject := cert.Subject.String()
+	sans := certificateSANs(cert)
+
+	for _, matcher := range m.matchers {
+		if matcher.subject != nil && !matcher.subject.MatchString(subject) {
+			continue
+		}
+		if matcher.san != nil && !anyMatch(matcher.san, sans) {
+			continue
+		}
+
+		vc, ok := m.vcStore.Get(matcher.virtualClusterID)
+		if !ok {
+			return nil, ErrInvalidCluster
+		}
+		ctx := newConnectionContext(vc, subject, matcher.template)
+		ctx.ClientCertificate = true
+		return ctx, nil
+	}
+	return nil, ErrNoCertificateMapping
+}
+
+// parsePermissionTemplate accepts the templates a certificate can be
+// granted. CUSTOM needs per-credential permissions, so it is not one.
+func parsePermissionTemplate(name string) (gatewayv1.PermissionTemplate, error) {
+	switch strings.ToLower(name) {
+	case "producer":
+		return gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER, nil
+	case "consumer":
+		return gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER, nil
+	case "admin":
+		return gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_ADMIN, nil
+	default:
+		return gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_UNSPECIFIED, fmt.Errorf("unsupported permission_template %q", name)
+	}
+}
+
+// compileFullMatch compiles pattern anchored at both ends. An empty pattern
+// returns nil.
+func compileFullMatch(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func certificateSANs(cert *x509.Certificate) []string {
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.EmailAddresses)+len(cert.URIs)+len(cert.IPAddresses))
+	sans = append(sans, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	return sans
+}
+
+func anyMatch(re *regexp.Regexp, values []string) bool {
+	for _, value := range values {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
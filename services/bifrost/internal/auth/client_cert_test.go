@@ -0,0 +1,142 @@
+// services/bifrost/internal/auth/client_cert_test.go
+package auth
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+)
+
+func newClientCertTestStore() *config.VirtualClusterStore {
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-orders",
+		TopicPrefix:              "orders-",
+		GroupPrefix:              "orders-",
+		PhysicalBootstrapServers: "kafka:9092",
+	})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:          "vc-billing",
+		TopicPrefix: "billing-",
+	})
+	return vcStore
+}
+
+func TestClientCertMapper_Authenticate(t *testing.T) {
+	mapper, err := NewClientCertMapper([]ClientCertRule{
+		{SubjectPattern: "CN=orders-[a-z]+,O=Acme", VirtualClusterID: "vc-orders", PermissionTemplate: "producer"},
+		{SANPattern: `spiffe://acme\.dev/billing/.*`, VirtualClusterID: "vc-billing", PermissionTemplate: "consumer"},
+	}, newClientCertTestStore())
+	require.NoError(t, err)
+
+	t.Run("subject", func(t *testing.T) {
+		ctx, err := mapper.Authenticate(&x509.Certificate{
+			Subject: pkix.Name{CommonName: "orders-api", Organization: []string{"Acme"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "vc-orders", ctx.VirtualClusterID)
+		assert.Equal(t, "orders-", ctx.TopicPrefix)
+		assert.Equal(t, "kafka:9092", ctx.BootstrapServers)
+		assert.Equal(t, gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER, ctx.PermissionTemplate)
+		assert.Equal(t, "CN=orders-api,O=Acme", ctx.Username)
+		assert.True(t, ctx.ClientCertificate)
+	})
+
+	t.Run("subject alternative name", func(t *testing.T) {
+		spiffeID, _ := url.Parse("spiffe://acme.dev/billing/worker")
+		ctx, err := mapper.Authenticate(&x509.Certificate{
+			Subject: pkix.Name{CommonName: "worker"},
+			URIs:    []*url.URL{spiffeID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "vc-billing", ctx.VirtualClusterID)
+		assert.Equal(t, gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER, ctx.PermissionTemplate)
+	})
+
+	t.Run("patterns match the whole value", func(t *testing.T) {
+		_, err := mapper.Authenticate(&x509.Certificate{
+			Subject: pkix.Name{CommonName: "orders-api", Organization: []string{"Acme Evil"}},
+		})
+		assert.ErrorIs(t, err, ErrNoCertificateMapping)
+	})
+
+	t.Run("no mapping", func(t *testing.T) {
+		_, err := mapper.Authenticate(&x509.Certificate{
+			Subject:  pkix.Name{CommonName: "inventory"},
+			DNSNames: []string{"inventory.acme.dev"},
+		})
+		assert.ErrorIs(t, err, ErrNoCertificateMapping)
+	})
+}
+
+func TestClientCertMapper_UnknownVirtualCluster(t *testing.T) {
+	mapper, err := NewClientCertMapper([]ClientCertRule{
+		{SubjectPattern: ".*", VirtualClusterID: "vc-missing", PermissionTemplate: "admin"},
+	}, newClientCertTestStore())
+	require.NoError(t, err)
+
+	_, err = mapper.Authenticate(&x509.Certificate{Subject: pkix.Name{CommonName: "anyone"}})
+	assert.ErrorIs(t, err, ErrInvalidCluster)
+}
+
+func TestNewClientCertMapper_InvalidRules(t *testing.T) {
+	tests := map[string]ClientCertRule{
+		"no pattern":          {VirtualClusterID: "vc-orders", PermissionTemplate: "producer"},
+		"no virtual cluster":  {SubjectPattern: ".*", PermissionTemplate: "producer"},
+		"custom template":     {SubjectPattern: ".*", VirtualClusterID: "vc-orders", PermissionTemplate: "custom"},
+		"invalid subject":     {SubjectPattern: "(", VirtualClusterID: "vc-orders", PermissionTemplate: "producer"},
+		"invalid san pattern": {SANPattern: "[", VirtualClusterID: "vc-orders", PermissionTemplate: "producer"},
+	}
+	for name, rule := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewClientCertMapper([]ClientCertRule{rule}, newClientCertTestStore())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadClientCertRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"subject_pattern": "CN=orders-api", "virtual_cluster_id": "vc-orders", "permission_template": "producer"}
+	]`), 0o600))
+
+	rules, err := LoadClientCertRules(path)
+	require.NoError(t, err)
+	assert.Equal(t, []ClientCertRule{{
+		SubjectPattern:     "CN=orders-api",
+		VirtualClusterID:   "vc-orders",
+		PermissionTemplate: "producer",
+	}}, rules)
+}
+
+func TestSASLHandler_ValidateSession_ClientCertificate(t *testing.T) {
+	vcStore := newClientCertTestStore()
+	handler := NewSASLHandler(NewCredentialStore(), vcStore)
+	mapper, err := NewClientCertMapper([]ClientCertRule{
+		{SubjectPattern: "CN=orders-api", VirtualClusterID: "vc-orders", PermissionTemplate: "producer"},
+	}, vcStore)
+	require.NoError(t, err)
+
+	ctx, err := mapper.Authenticate(&x509.Certificate{Subject: pkix.Name{CommonName: "orders-api"}})
+	require.NoError(t, err)
+
+	// Certificate sessions have no credential to revoke
+	vc, err := handler.ValidateSession(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "vc-orders", vc.Id)
+
+	// They end with their virtual cluster
+	vcStore.Delete("vc-orders")
+	_, err = handler.ValidateSession(ctx)
+	assert.ErrorIs(t, err, ErrInvalidCluster)
+}
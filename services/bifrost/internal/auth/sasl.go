@@ -4,6 +4,7 @@ package auth
 import (
 	"errors"
 
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
 	"github.com/drewpayment/orbit/services/bifrost/internal/config"
 )
 
@@ -14,6 +15,9 @@ var (
 	ErrUnknownUser = errors.New("unknown user")
 	// ErrInvalidCluster indicates the virtual cluster was not found.
 	ErrInvalidCluster = errors.New("virtual cluster not found")
+	// ErrCredentialRevoked indicates the credential a connection authenticated
+	// with was revoked or moved to another virtual cluster.
+	ErrCredentialRevoked = errors.New("credential revoked")
 )
 
 // ConnectionContext holds authenticated connection state.
@@ -30,9 +34,14 @@ type ConnectionContext struct {
 	BootstrapServers string
 	AdvertisedHost   string
 	AdvertisedPort   int32
+	// PermissionTemplate limits the operations the connection may perform
+	PermissionTemplate gatewayv1.PermissionTemplate
+	// ClientCertificate is set when the connection authenticated with a
+	// client certificate rather than SASL credentials
+	ClientCertificate bool
 }
 
-// SASLHandler handles SASL/PLAIN authentication.
+// SASLHandler handles SASL/PLAIN and SASL/SCRAM authentication.
 type SASLHandler struct {
 	credStore *CredentialStore
 	vcStore   *config.VirtualClusterStore
@@ -63,21 +72,59 @@ func (h *SASLHandler) Authenticate(username, password string) (*ConnectionContex
 		return nil, ErrAuthFailed
 	}
 
-	// Get virtual cluster for this credential
+	// SCRAM credentials must not fall back to a cleartext PLAIN exchange
+	if IsScramMechanism(cred.Mechanism) {
+		return nil, ErrAuthFailed
+	}
+
+	return h.connectionContext(cred)
+}
+
+// ValidateSession checks that the credential an open connection authenticated
+// with is still valid, refreshes ctx.PermissionTemplate from it and returns
+// the current config of its virtual cluster. It is called for every request,
+// so admin changes apply to live connections.
+// WARNING: Returns a direct reference to internal storage. Do not mutate.
+func (h *SASLHandler) ValidateSession(ctx *ConnectionContext) (*gatewayv1.VirtualClusterConfig, error) {
+	// Certificate-authenticated connections have no stored credential
+	if !ctx.ClientCertificate {
+		cred, ok := h.credStore.Get(ctx.CredentialID)
+		if !ok || cred.VirtualClusterId != ctx.VirtualClusterID {
+			return nil, ErrCredentialRevoked
+		}
+		ctx.PermissionTemplate = cred.Template
+	}
+	vc, ok := h.vcStore.Get(ctx.VirtualClusterID)
+	if !ok {
+		return nil, ErrInvalidCluster
+	}
+	return vc, nil
+}
+
+// connectionContext resolves the virtual cluster for an authenticated
+// credential and returns its rewriting context.
+func (h *SASLHandler) connectionContext(cred *gatewayv1.CredentialConfig) (*ConnectionContext, error) {
 	vc, ok := h.vcStore.Get(cred.VirtualClusterId)
 	if !ok {
 		return nil, ErrInvalidCluster
 	}
 
+	ctx := newConnectionContext(vc, cred.Username, cred.Template)
+	ctx.CredentialID = cred.Id
+	return ctx, nil
+}
+
+// newConnectionContext returns the rewriting context for a connection to vc.
+func newConnectionContext(vc *gatewayv1.VirtualClusterConfig, username string, template gatewayv1.PermissionTemplate) *ConnectionContext {
 	return &ConnectionContext{
-		CredentialID:     cred.Id,
-		VirtualClusterID: vc.Id,
-		Username:         username,
-		TopicPrefix:      vc.TopicPrefix,
-		GroupPrefix:      vc.GroupPrefix,
-		TxnIDPrefix:      vc.TransactionIdPrefix,
-		BootstrapServers: vc.PhysicalBootstrapServers,
-		AdvertisedHost:   vc.AdvertisedHost,
-		AdvertisedPort:   vc.AdvertisedPort,
-	}, nil
+		VirtualClusterID:   vc.Id,
+		Username:           username,
+		TopicPrefix:        vc.TopicPrefix,
+		GroupPrefix:        vc.GroupPrefix,
+		TxnIDPrefix:        vc.TransactionIdPrefix,
+		BootstrapServers:   vc.PhysicalBootstrapServers,
+		AdvertisedHost:     vc.AdvertisedHost,
+		AdvertisedPort:     vc.AdvertisedPort,
+		PermissionTemplate: template,
+	}
 }
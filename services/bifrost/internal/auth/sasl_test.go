@@ -121,3 +121,51 @@ func TestSASLHandler_Authenticate_FullContext(t *testing.T) {
 	assert.Equal(t, "payments-dev-", ctx.TxnIDPrefix)
 	assert.Equal(t, "kafka-1:9092,kafka-2:9092", ctx.BootstrapServers)
 }
+
+func TestSASLHandler_ValidateSession(t *testing.T) {
+	credStore := NewCredentialStore()
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-123", TopicPrefix: "test-"})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-456", TopicPrefix: "other-"})
+	credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-123",
+		VirtualClusterId: "vc-123",
+		Username:         "testuser",
+		PasswordHash:     "fcf730b6d95236ecd3c9fc2d92d7b6b2bb061514961aec041d6c7a7192f592e4",
+	})
+	handler := NewSASLHandler(credStore, vcStore)
+
+	ctx, err := handler.Authenticate("testuser", "secret123")
+	require.NoError(t, err)
+
+	// Prefix changes are visible to the open session
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-123", TopicPrefix: "renamed-"})
+	vc, err := handler.ValidateSession(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed-", vc.TopicPrefix)
+
+	// Permission template changes are visible to the open session
+	credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-123",
+		VirtualClusterId: "vc-123",
+		Username:         "testuser",
+		Template:         gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER,
+	})
+	_, err = handler.ValidateSession(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER, ctx.PermissionTemplate)
+
+	// Moving the credential to another virtual cluster ends the session
+	credStore.Upsert(&gatewayv1.CredentialConfig{Id: "cred-123", VirtualClusterId: "vc-456", Username: "testuser"})
+	_, err = handler.ValidateSession(ctx)
+	assert.ErrorIs(t, err, ErrCredentialRevoked)
+
+	credStore.Delete("cred-123")
+	_, err = handler.ValidateSession(ctx)
+	assert.ErrorIs(t, err, ErrCredentialRevoked)
+
+	credStore.Upsert(&gatewayv1.CredentialConfig{Id: "cred-123", VirtualClusterId: "vc-123", Username: "testuser"})
+	vcStore.Delete("vc-123")
+	_, err = handler.ValidateSession(ctx)
+	assert.ErrorIs(t, err, ErrInvalidCluster)
+}
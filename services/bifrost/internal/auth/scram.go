@@ -0,0 +1,117 @@
+// services/bifrost/internal/auth/scram.go
+package auth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/xdg-go/scram"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+)
+
+// SASL mechanism names as negotiated in SaslHandshake.
+const (
+	MechanismPlain       = "PLAIN"
+	MechanismScramSHA256 = "SCRAM-SHA-256"
+	MechanismScramSHA512 = "SCRAM-SHA-512"
+)
+
+// ErrUnsupportedMechanism indicates a SCRAM conversation was requested for a
+// mechanism Bifrost does not implement.
+var ErrUnsupportedMechanism = errors.New("unsupported SASL mechanism")
+
+// scramMechanisms maps SASL mechanism names to the credential mechanism and
+// hash function they authenticate with.
+var scramMechanisms = map[string]struct {
+	mechanism gatewayv1.SaslMechanism
+	hash      scram.HashGeneratorFcn
+}{
+	MechanismScramSHA256: {gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256, scram.SHA256},
+	MechanismScramSHA512: {gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512, scram.SHA512},
+}
+
+// IsScramMechanism reports whether m is a SCRAM credential mechanism.
+func IsScramMechanism(m gatewayv1.SaslMechanism) bool {
+	return m == gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256 ||
+		m == gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512
+}
+
+// ScramConversation is the server side of a single SCRAM authentication
+// exchange. A new conversation must be created for each attempt.
+type ScramConversation struct {
+	handler *SASLHandler
+	conv    *scram.ServerConversation
+}
+
+// NewScramConversation starts a SCRAM exchange for the given mechanism
+// (SCRAM-SHA-256 or SCRAM-SHA-512). Only credentials stored with the same
+// mechanism and SCRAM secrets can complete it.
+func (h *SASLHandler) NewScramConversation(mechanism string) (*ScramConversation, error) {
+	m, ok := scramMechanisms[mechanism]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMechanism, mechanism)
+	}
+
+	server, err := m.hash.NewServer(func(username string) (scram.StoredCredentials, error) {
+		cred, ok := h.credStore.GetByUsername(username)
+		if !ok {
+			return scram.StoredCredentials{}, ErrUnknownUser
+		}
+		if cred.Mechanism != m.mechanism || cred.Scram == nil {
+			return scram.StoredCredentials{}, ErrAuthFailed
+		}
+		return scram.StoredCredentials{
+			KeyFactors: scram.KeyFactors{
+				Salt:  string(cred.Scram.Salt),
+				Iters: int(cred.Scram.Iterations),
+			},
+			StoredKey: cred.Scram.StoredKey,
+			ServerKey: cred.Scram.ServerKey,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScramConversation{handler: h, conv: server.NewConversation()}, nil
+}
+
+// Step consumes a client message and returns the server's reply.
+// Unknown users surface as ErrUnknownUser; every other failure, including an
+// invalid proof, is reported as ErrAuthFailed.
+func (c *ScramConversation) Step(clientMsg string) (string, error) {
+	serverMsg, err := c.conv.Step(clientMsg)
+	if err != nil {
+		if errors.Is(err, ErrUnknownUser) || errors.Is(err, ErrAuthFailed) {
+			return "", err
+		}
+		return "", fmt.Errorf("%w: %v", ErrAuthFailed, err)
+	}
+	return serverMsg, nil
+}
+
+// Done reports whether the conversation has completed or failed.
+func (c *ScramConversation) Done() bool {
+	return c.conv.Done()
+}
+
+// Username returns the username the client authenticated as. It is only
+// meaningful after the first step succeeded.
+func (c *ScramConversation) Username() string {
+	return c.conv.Username()
+}
+
+// Context returns the connection context for a successfully completed
+// conversation.
+func (c *ScramConversation) Context() (*ConnectionContext, error) {
+	if !c.conv.Done() || !c.conv.Valid() {
+		return nil, ErrAuthFailed
+	}
+
+	cred, ok := c.handler.credStore.GetByUsername(c.conv.Username())
+	if !ok {
+		return nil, ErrUnknownUser
+	}
+	return c.handler.connectionContext(cred)
+}
@@ -0,0 +1,140 @@
+// services/bifrost/internal/auth/scram_test.go
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xdg-go/scram"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+)
+
+// scramCredential derives the SCRAM secrets for password the way the control
+// plane does when it creates a SCRAM credential.
+func scramCredential(t *testing.T, hash scram.HashGeneratorFcn, password string) *gatewayv1.ScramCredential {
+	t.Helper()
+	client, err := hash.NewClient("", password, "")
+	require.NoError(t, err)
+	kf := scram.KeyFactors{Salt: "test-salt", Iters: 4096}
+	stored := client.GetStoredCredentials(kf)
+	return &gatewayv1.ScramCredential{
+		Salt:       []byte(kf.Salt),
+		Iterations: int32(kf.Iters),
+		StoredKey:  stored.StoredKey,
+		ServerKey:  stored.ServerKey,
+	}
+}
+
+func newScramTestHandler(t *testing.T, mechanism gatewayv1.SaslMechanism, hash scram.HashGeneratorFcn) *SASLHandler {
+	t.Helper()
+	credStore := NewCredentialStore()
+	vcStore := config.NewVirtualClusterStore()
+
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:          "vc-123",
+		TopicPrefix: "test-",
+	})
+	credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-123",
+		VirtualClusterId: "vc-123",
+		Username:         "testuser",
+		Mechanism:        mechanism,
+		Scram:            scramCredential(t, hash, "secret123"),
+	})
+
+	return NewSASLHandler(credStore, vcStore)
+}
+
+// runScram drives a full client/server SCRAM exchange and returns the final
+// server error, if any.
+func runScram(t *testing.T, handler *SASLHandler, mechanism string, hash scram.HashGeneratorFcn, username, password string) (*ScramConversation, error) {
+	t.Helper()
+	client, err := hash.NewClient(username, password, "")
+	require.NoError(t, err)
+	clientConv := client.NewConversation()
+
+	serverConv, err := handler.NewScramConversation(mechanism)
+	require.NoError(t, err)
+
+	clientMsg, err := clientConv.Step("")
+	require.NoError(t, err)
+	for !serverConv.Done() {
+		serverMsg, err := serverConv.Step(clientMsg)
+		if err != nil {
+			return serverConv, err
+		}
+		clientMsg, err = clientConv.Step(serverMsg)
+		require.NoError(t, err)
+	}
+	assert.True(t, clientConv.Valid(), "client should verify the server signature")
+	return serverConv, nil
+}
+
+func TestSASLHandler_Scram(t *testing.T) {
+	tests := []struct {
+		name      string
+		mechanism string
+		stored    gatewayv1.SaslMechanism
+		hash      scram.HashGeneratorFcn
+	}{
+		{"SCRAM-SHA-256", MechanismScramSHA256, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256, scram.SHA256},
+		{"SCRAM-SHA-512", MechanismScramSHA512, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512, scram.SHA512},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newScramTestHandler(t, tc.stored, tc.hash)
+
+			conv, err := runScram(t, handler, tc.mechanism, tc.hash, "testuser", "secret123")
+			require.NoError(t, err)
+
+			ctx, err := conv.Context()
+			require.NoError(t, err)
+			assert.Equal(t, "cred-123", ctx.CredentialID)
+			assert.Equal(t, "vc-123", ctx.VirtualClusterID)
+			assert.Equal(t, "testuser", ctx.Username)
+			assert.Equal(t, "test-", ctx.TopicPrefix)
+		})
+	}
+}
+
+func TestSASLHandler_Scram_InvalidPassword(t *testing.T) {
+	handler := newScramTestHandler(t, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256, scram.SHA256)
+
+	conv, err := runScram(t, handler, MechanismScramSHA256, scram.SHA256, "testuser", "wrongpassword")
+	assert.ErrorIs(t, err, ErrAuthFailed)
+
+	_, err = conv.Context()
+	assert.ErrorIs(t, err, ErrAuthFailed)
+}
+
+func TestSASLHandler_Scram_UnknownUser(t *testing.T) {
+	handler := newScramTestHandler(t, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256, scram.SHA256)
+
+	_, err := runScram(t, handler, MechanismScramSHA256, scram.SHA256, "unknownuser", "secret123")
+	assert.ErrorIs(t, err, ErrUnknownUser)
+}
+
+func TestSASLHandler_Scram_MechanismMismatch(t *testing.T) {
+	// Credential was provisioned for SHA-512; a SHA-256 exchange must fail
+	handler := newScramTestHandler(t, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512, scram.SHA512)
+
+	_, err := runScram(t, handler, MechanismScramSHA256, scram.SHA256, "testuser", "secret123")
+	assert.ErrorIs(t, err, ErrAuthFailed)
+}
+
+func TestSASLHandler_Scram_UnsupportedMechanism(t *testing.T) {
+	handler := NewSASLHandler(NewCredentialStore(), config.NewVirtualClusterStore())
+
+	_, err := handler.NewScramConversation("SCRAM-SHA-1")
+	assert.ErrorIs(t, err, ErrUnsupportedMechanism)
+}
+
+func TestSASLHandler_Authenticate_RejectsPlainForScramCredential(t *testing.T) {
+	handler := newScramTestHandler(t, gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256, scram.SHA256)
+
+	_, err := handler.Authenticate("testuser", "secret123")
+	assert.ErrorIs(t, err, ErrAuthFailed)
+}
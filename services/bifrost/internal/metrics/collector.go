@@ -11,10 +11,16 @@ import (
 type Collector struct {
 	connectionsActive *prometheus.GaugeVec
 	connectionsTotal  *prometheus.CounterVec
+	connectionsReaped *prometheus.CounterVec
 	bytesTotal        *prometheus.CounterVec
 	requestsTotal     *prometheus.CounterVec
 	requestDuration   *prometheus.HistogramVec
 	authTotal         *prometheus.CounterVec
+	throttledTotal    *prometheus.CounterVec
+	throttleSeconds   *prometheus.CounterVec
+	requestLatency    *prometheus.HistogramVec
+	protocolErrors    *prometheus.CounterVec
+	consumerLag       *prometheus.GaugeVec
 }
 
 // NewCollector creates a new metrics collector.
@@ -34,6 +40,13 @@ func NewCollector() *Collector {
 			},
 			[]string{"virtual_cluster"},
 		),
+		connectionsReaped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bifrost_connections_reaped_total",
+				Help: "Client connections closed by the proxy for idling or exceeding their maximum lifetime",
+			},
+			[]string{"virtual_cluster", "reason"}, // "idle" or "max_lifetime"
+		),
 		bytesTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "bifrost_bytes_total",
@@ -63,6 +76,42 @@ func NewCollector() *Collector {
 			},
 			[]string{"result"}, // "success" or "failure"
 		),
+		throttledTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bifrost_throttled_requests_total",
+				Help: "Total requests delayed by per-virtual-cluster rate limits",
+			},
+			[]string{"virtual_cluster"},
+		),
+		throttleSeconds: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bifrost_throttle_seconds_total",
+				Help: "Total time requests were delayed by per-virtual-cluster rate limits",
+			},
+			[]string{"virtual_cluster"},
+		),
+		requestLatency: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "bifrost_request_latency_seconds",
+				Help:    "Time from reading a client request to receiving the broker response",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"virtual_cluster_id", "api_key", "api_version"},
+		),
+		protocolErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bifrost_protocol_errors_total",
+				Help: "Request and response modifier failures to decode, rewrite or encode a message",
+			},
+			[]string{"virtual_cluster_id", "api_key", "direction"},
+		),
+		consumerLag: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "bifrost_consumer_lag",
+				Help: "Messages between a consumer group's committed offset and the end of a partition",
+			},
+			[]string{"virtual_cluster_id", "group", "topic", "partition"},
+		),
 	}
 }
 
@@ -70,20 +119,32 @@ func NewCollector() *Collector {
 func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.connectionsActive.Describe(ch)
 	c.connectionsTotal.Describe(ch)
+	c.connectionsReaped.Describe(ch)
 	c.bytesTotal.Describe(ch)
 	c.requestsTotal.Describe(ch)
 	c.requestDuration.Describe(ch)
 	c.authTotal.Describe(ch)
+	c.throttledTotal.Describe(ch)
+	c.throttleSeconds.Describe(ch)
+	c.requestLatency.Describe(ch)
+	c.protocolErrors.Describe(ch)
+	c.consumerLag.Describe(ch)
 }
 
 // Collect implements prometheus.Collector.
 func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.connectionsActive.Collect(ch)
 	c.connectionsTotal.Collect(ch)
+	c.connectionsReaped.Collect(ch)
 	c.bytesTotal.Collect(ch)
 	c.requestsTotal.Collect(ch)
 	c.requestDuration.Collect(ch)
 	c.authTotal.Collect(ch)
+	c.throttledTotal.Collect(ch)
+	c.throttleSeconds.Collect(ch)
+	c.requestLatency.Collect(ch)
+	c.protocolErrors.Collect(ch)
+	c.consumerLag.Collect(ch)
 }
 
 // RecordConnection records a connection event.
@@ -96,6 +157,12 @@ func (c *Collector) RecordConnection(virtualCluster string, opened bool) {
 	}
 }
 
+// RecordConnectionReaped records a connection closed by the proxy. reason is
+// "idle" or "max_lifetime".
+func (c *Collector) RecordConnectionReaped(virtualCluster, reason string) {
+	c.connectionsReaped.WithLabelValues(virtualCluster, reason).Inc()
+}
+
 // RecordBytes records bytes transferred.
 func (c *Collector) RecordBytes(virtualCluster, direction string, bytes int64) {
 	c.bytesTotal.WithLabelValues(virtualCluster, direction).Add(float64(bytes))
@@ -108,6 +175,17 @@ func (c *Collector) RecordRequest(virtualCluster string, apiKey int16, durationS
 	c.requestDuration.WithLabelValues(virtualCluster, apiKeyStr).Observe(durationSeconds)
 }
 
+// RecordRequestLatency records the proxy latency of a Kafka API request.
+func (c *Collector) RecordRequestLatency(virtualClusterID string, apiKey, apiVersion int16, latencySeconds float64) {
+	c.requestLatency.WithLabelValues(virtualClusterID, strconv.Itoa(int(apiKey)), strconv.Itoa(int(apiVersion))).Observe(latencySeconds)
+}
+
+// RecordProtocolError records a failed request or response modification.
+// direction is "request" or "response".
+func (c *Collector) RecordProtocolError(virtualClusterID string, apiKey int16, direction string) {
+	c.protocolErrors.WithLabelValues(virtualClusterID, strconv.Itoa(int(apiKey)), direction).Inc()
+}
+
 // RecordAuth records an authentication attempt.
 func (c *Collector) RecordAuth(success bool) {
 	result := "failure"
@@ -116,3 +194,27 @@ func (c *Collector) RecordAuth(success bool) {
 	}
 	c.authTotal.WithLabelValues(result).Inc()
 }
+
+// RecordThrottle records a request delayed by a virtual cluster's rate limit.
+func (c *Collector) RecordThrottle(virtualCluster string, delaySeconds float64) {
+	c.throttledTotal.WithLabelValues(virtualCluster).Inc()
+	c.throttleSeconds.WithLabelValues(virtualCluster).Add(delaySeconds)
+}
+
+// ConsumerLag is a consumer group's lag on one partition, named as clients of
+// the virtual cluster see them.
+type ConsumerLag struct {
+	Group     string
+	Topic     string
+	Partition int32
+	Lag       int64
+}
+
+// SetConsumerLag replaces the consumer lag reported for a virtual cluster, so
+// groups and partitions missing from lags are no longer reported.
+func (c *Collector) SetConsumerLag(virtualClusterID string, lags []ConsumerLag) {
+	c.consumerLag.DeletePartialMatch(prometheus.Labels{"virtual_cluster_id": virtualClusterID})
+	for _, l := range lags {
+		c.consumerLag.WithLabelValues(virtualClusterID, l.Group, l.Topic, strconv.Itoa(int(l.Partition))).Set(float64(l.Lag))
+	}
+}
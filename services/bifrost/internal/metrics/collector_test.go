@@ -32,6 +32,22 @@ func TestCollector_RecordConnection(t *testing.T) {
 	assert.Equal(t, float64(2), totalCount)
 }
 
+func TestCollector_RecordConnectionReaped(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := NewCollector()
+	reg.MustRegister(c)
+
+	c.RecordConnectionReaped("vc-123", "idle")
+	c.RecordConnectionReaped("vc-123", "idle")
+	c.RecordConnectionReaped("vc-123", "max_lifetime")
+
+	idle := testutil.ToFloat64(c.connectionsReaped.WithLabelValues("vc-123", "idle"))
+	assert.Equal(t, float64(2), idle)
+
+	maxLifetime := testutil.ToFloat64(c.connectionsReaped.WithLabelValues("vc-123", "max_lifetime"))
+	assert.Equal(t, float64(1), maxLifetime)
+}
+
 func TestCollector_RecordBytes(t *testing.T) {
 	reg := prometheus.NewRegistry()
 	c := NewCollector()
@@ -88,6 +104,80 @@ func TestCollector_RecordAuth(t *testing.T) {
 	assert.Equal(t, float64(1), failureCount)
 }
 
+func TestCollector_RecordThrottle(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := NewCollector()
+	reg.MustRegister(c)
+
+	c.RecordThrottle("vc-123", 0.25)
+	c.RecordThrottle("vc-123", 0.5)
+
+	throttled := testutil.ToFloat64(c.throttledTotal.WithLabelValues("vc-123"))
+	assert.Equal(t, float64(2), throttled)
+
+	seconds := testutil.ToFloat64(c.throttleSeconds.WithLabelValues("vc-123"))
+	assert.Equal(t, 0.75, seconds)
+
+	// Other virtual clusters are unaffected
+	other := testutil.ToFloat64(c.throttledTotal.WithLabelValues("vc-456"))
+	assert.Equal(t, float64(0), other)
+}
+
+func TestCollector_RecordRequestLatency(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := NewCollector()
+	reg.MustRegister(c)
+
+	c.RecordRequestLatency("vc-123", 3, 12, 0.002) // Metadata v12
+	c.RecordRequestLatency("vc-123", 3, 12, 0.004)
+	c.RecordRequestLatency("vc-123", 1, 11, 0.5) // Fetch v11
+
+	// One series per (virtual cluster, api key, api version)
+	count, err := testutil.GatherAndCount(reg, "bifrost_request_latency_seconds")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestCollector_RecordProtocolError(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := NewCollector()
+	reg.MustRegister(c)
+
+	c.RecordProtocolError("vc-123", 3, "response")
+	c.RecordProtocolError("vc-123", 3, "response")
+	c.RecordProtocolError("vc-123", 0, "request")
+
+	metadata := testutil.ToFloat64(c.protocolErrors.WithLabelValues("vc-123", "3", "response"))
+	assert.Equal(t, float64(2), metadata)
+
+	produce := testutil.ToFloat64(c.protocolErrors.WithLabelValues("vc-123", "0", "request"))
+	assert.Equal(t, float64(1), produce)
+}
+
+func TestCollector_SetConsumerLag(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	c := NewCollector()
+	reg.MustRegister(c)
+
+	c.SetConsumerLag("vc-123", []ConsumerLag{
+		{Group: "billing", Topic: "orders", Partition: 0, Lag: 3},
+		{Group: "billing", Topic: "orders", Partition: 1, Lag: 0},
+	})
+	c.SetConsumerLag("vc-456", []ConsumerLag{{Group: "audit", Topic: "events", Partition: 0, Lag: 7}})
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(c.consumerLag.WithLabelValues("vc-123", "billing", "orders", "0")))
+
+	// Replacing a virtual cluster's lag drops partitions no longer reported
+	// and leaves other virtual clusters alone
+	c.SetConsumerLag("vc-123", []ConsumerLag{{Group: "billing", Topic: "orders", Partition: 0, Lag: 1}})
+
+	count, err := testutil.GatherAndCount(reg, "bifrost_consumer_lag")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.consumerLag.WithLabelValues("vc-123", "billing", "orders", "0")))
+	assert.Equal(t, float64(7), testutil.ToFloat64(c.consumerLag.WithLabelValues("vc-456", "audit", "events", "0")))
+}
+
 func TestCollector_DescribeAndCollect(t *testing.T) {
 	c := NewCollector()
 
@@ -97,7 +187,7 @@ func TestCollector_DescribeAndCollect(t *testing.T) {
 	c.RecordRequest("vc-test", 0, 0.001)
 
 	// Test Describe
-	descCh := make(chan *prometheus.Desc, 10)
+	descCh := make(chan *prometheus.Desc, 20)
 	c.Describe(descCh)
 	close(descCh)
 
@@ -105,8 +195,8 @@ func TestCollector_DescribeAndCollect(t *testing.T) {
 	for range descCh {
 		descCount++
 	}
-	// Should have 6 metric types described
-	assert.Equal(t, 6, descCount)
+	// Should have 12 metric types described
+	assert.Equal(t, 12, descCount)
 
 	// Test Collect
 	metricCh := make(chan prometheus.Metric, 20)
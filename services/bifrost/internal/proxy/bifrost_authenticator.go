@@ -2,6 +2,7 @@
 package proxy
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
@@ -14,6 +15,12 @@ type SASLAuthenticator interface {
 	Authenticate(username, password string) (*auth.ConnectionContext, error)
 }
 
+// SCRAMAuthenticator is implemented by SASL handlers that also support
+// SCRAM-SHA-256/512. Handlers without it only offer PLAIN.
+type SCRAMAuthenticator interface {
+	NewScramConversation(mechanism string) (*auth.ScramConversation, error)
+}
+
 // BifrostAuthenticator adapts our SASLHandler to the PasswordAuthenticator interface
 // used by the vendored kafka-proxy code. It captures the ConnectionContext from
 // successful authentication for later use in request/response rewriting.
@@ -26,6 +33,9 @@ type BifrostAuthenticator struct {
 // Compile-time check that BifrostAuthenticator implements PasswordAuthenticator
 var _ apis.PasswordAuthenticator = (*BifrostAuthenticator)(nil)
 
+// Compile-time check that BifrostAuthenticator implements ScramVerifier
+var _ ScramVerifier = (*BifrostAuthenticator)(nil)
+
 // NewBifrostAuthenticator creates an authenticator that wraps our SASLHandler.
 func NewBifrostAuthenticator(handler SASLAuthenticator) *BifrostAuthenticator {
 	return &BifrostAuthenticator{
@@ -61,6 +71,52 @@ func (a *BifrostAuthenticator) Authenticate(username, password string) (bool, in
 	return true, 0, nil
 }
 
+// SupportsScram reports whether the wrapped handler can authenticate SCRAM
+// mechanisms.
+func (a *BifrostAuthenticator) SupportsScram() bool {
+	_, ok := a.handler.(SCRAMAuthenticator)
+	return ok
+}
+
+// NewScramConversation implements ScramVerifier.
+func (a *BifrostAuthenticator) NewScramConversation(mechanism string) (*auth.ScramConversation, error) {
+	scramHandler, ok := a.handler.(SCRAMAuthenticator)
+	if !ok {
+		return nil, auth.ErrUnsupportedMechanism
+	}
+	return scramHandler.NewScramConversation(mechanism)
+}
+
+// VerifyScram implements ScramVerifier. On success the conversation's
+// ConnectionContext is captured exactly as for PLAIN authentication.
+func (a *BifrostAuthenticator) VerifyScram(conv *auth.ScramConversation) (bool, int32, error) {
+	ctx, err := conv.Context()
+	if err != nil {
+		return scramFailure(err)
+	}
+
+	a.mu.Lock()
+	a.ctx = ctx
+	a.mu.Unlock()
+
+	return true, 0, nil
+}
+
+// scramFailure maps a SCRAM context error to the status codes used by
+// Authenticate.
+func scramFailure(err error) (bool, int32, error) {
+	switch {
+	case errors.Is(err, auth.ErrAuthFailed):
+		return false, 1, nil
+	case errors.Is(err, auth.ErrUnknownUser):
+		return false, 2, nil
+	case errors.Is(err, auth.ErrInvalidCluster):
+		return false, 3, nil
+	default:
+		return false, 0, err
+	}
+}
+
 // GetContext returns the ConnectionContext from the last successful authentication.
 // Returns nil if no successful auth has occurred.
 func (a *BifrostAuthenticator) GetContext() *auth.ConnectionContext {
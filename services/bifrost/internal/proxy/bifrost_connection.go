@@ -2,8 +2,13 @@
 package proxy
 
 import (
+	"errors"
 	"net"
+	"time"
 
+	"github.com/sirupsen/logrus"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
 )
@@ -35,6 +40,35 @@ func NewBifrostConnection(
 	}
 }
 
+var (
+	// errUpstreamChanged is returned when a virtual cluster is pointed at
+	// different bootstrap servers than an open connection is proxying to.
+	errUpstreamChanged = errors.New("virtual cluster upstream changed, reconnect required")
+	// errRewritingModeChanged is returned when a virtual cluster gains its
+	// first prefix or loses its last one. Whether a connection rewrites
+	// requests at all is decided when it is opened.
+	errRewritingModeChanged = errors.New("virtual cluster prefixes added or removed, reconnect required")
+)
+
+// applyVirtualCluster brings the connection up to date with its virtual
+// cluster's current config. Changed prefixes apply to subsequent requests;
+// changes that cannot be applied to an open connection return an error so it
+// is closed and the client reconnects.
+func (c *BifrostConnection) applyVirtualCluster(vc *gatewayv1.VirtualClusterConfig) error {
+	if vc.PhysicalBootstrapServers != c.ctx.BootstrapServers {
+		return errUpstreamChanged
+	}
+	wasPassthrough := c.rewriter.IsPassthrough()
+	if !c.rewriter.SetPrefixes(vc.TopicPrefix, vc.GroupPrefix, vc.TransactionIdPrefix) {
+		return nil
+	}
+	if c.rewriter.IsPassthrough() != wasPassthrough {
+		return errRewritingModeChanged
+	}
+	logrus.Infof("Connection %s: applied updated prefixes of virtual cluster %s", c.id, vc.Id)
+	return nil
+}
+
 // ID returns the connection identifier.
 func (c *BifrostConnection) ID() string {
 	return c.id
@@ -64,3 +98,19 @@ func (c *BifrostConnection) BootstrapServers() string {
 func (c *BifrostConnection) ClientConn() net.Conn {
 	return c.clientConn
 }
+
+var _ RequestMetrics = (*BifrostConnection)(nil)
+
+// RecordLatency implements RequestMetrics.
+func (c *BifrostConnection) RecordLatency(apiKey, apiVersion int16, latency time.Duration) {
+	if c.metrics != nil {
+		c.metrics.RecordRequestLatency(c.ctx.VirtualClusterID, apiKey, apiVersion, latency.Seconds())
+	}
+}
+
+// RecordModifierError implements RequestMetrics.
+func (c *BifrostConnection) RecordModifierError(apiKey int16, direction string) {
+	if c.metrics != nil {
+		c.metrics.RecordProtocolError(c.ctx.VirtualClusterID, apiKey, direction)
+	}
+}
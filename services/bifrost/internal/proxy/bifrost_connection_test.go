@@ -2,11 +2,18 @@
 package proxy
 
 import (
+	"bytes"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,3 +101,101 @@ func TestBifrostConnection_ClientConn(t *testing.T) {
 	// Should return the original client connection
 	assert.Equal(t, clientConn, conn.ClientConn())
 }
+
+func TestBifrostConnection_RecordsRequestMetrics(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	collector := metrics.NewCollector()
+	reg := prometheus.NewRegistry()
+	require.NoError(t, reg.Register(collector))
+	conn := NewBifrostConnection("conn-1", clientConn, &auth.ConnectionContext{VirtualClusterID: "vc-123"}, collector)
+
+	openRequests := make(chan protocol.RequestKeyVersion, 1)
+	requestCtx := &RequestsLoopContext{
+		openRequestsChannel:        openRequests,
+		nextRequestHandlerChannel:  make(chan RequestHandler, 1),
+		nextResponseHandlerChannel: make(chan ResponseHandler, 1),
+		timeout:                    time.Second,
+		buf:                        make([]byte, 1024),
+		requestMetrics:             conn,
+	}
+
+	// ApiVersions v0 request, correlation id 7, client id "c"
+	request := []byte{0, 0, 0, 11, 0, 18, 0, 0, 0, 0, 0, 7, 0, 1, 'c'}
+	upstream := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+	_, err := defaultRequestHandler.handleRequest(upstream, &TestDeadlineReaderWriter{reader: bytes.NewBuffer(request)}, requestCtx)
+	require.NoError(t, err)
+	assert.Equal(t, request, upstream.Bytes())
+
+	// ApiVersions v0 response: error_code 0, empty api_keys array
+	response := []byte{0, 0, 0, 10, 0, 0, 0, 7, 0, 0, 0, 0, 0, 0}
+	responseCtx := &ResponsesLoopContext{
+		openRequestsChannel: openRequests,
+		timeout:             time.Second,
+		buf:                 make([]byte, 1024),
+		requestMetrics:      conn,
+	}
+	client := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+	_, err = defaultResponseHandler.handleResponse(client, &TestDeadlineReader{Buffer: bytes.NewBuffer(response)}, responseCtx)
+	require.NoError(t, err)
+	assert.Equal(t, response, client.Bytes())
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	var latency *dto.MetricFamily
+	for _, mf := range families {
+		if mf.GetName() == "bifrost_request_latency_seconds" {
+			latency = mf
+		}
+	}
+	require.NotNil(t, latency, "latency histogram should be registered")
+	require.Len(t, latency.GetMetric(), 1)
+
+	m := latency.GetMetric()[0]
+	assert.Equal(t, uint64(1), m.GetHistogram().GetSampleCount())
+	labels := map[string]string{}
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	assert.Equal(t, map[string]string{"virtual_cluster_id": "vc-123", "api_key": "18", "api_version": "0"}, labels)
+}
+
+func TestBifrostConnection_RecordsModifierErrors(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	collector := metrics.NewCollector()
+	reg := prometheus.NewRegistry()
+	require.NoError(t, reg.Register(collector))
+	conn := NewBifrostConnection("conn-1", clientConn, &auth.ConnectionContext{VirtualClusterID: "vc-123"}, collector)
+
+	openRequests := make(chan protocol.RequestKeyVersion, 1)
+	openRequests <- protocol.RequestKeyVersion{ApiKey: 3, ApiVersion: 1, ReceivedAt: time.Now()}
+
+	// Metadata v1 response whose body is truncated, so the modifier fails to decode it
+	response := []byte{0, 0, 0, 8, 0, 0, 0, 7, 0, 0, 0, 5}
+	responseCtx := &ResponsesLoopContext{
+		openRequestsChannel: openRequests,
+		timeout:             time.Second,
+		buf:                 make([]byte, 1024),
+		responseModifierConfig: &protocol.ResponseModifierConfig{
+			NetAddressMappingFunc: func(host string, port int32, nodeId int32) (string, int32, error) {
+				return host, port, nil
+			},
+		},
+		requestMetrics: conn,
+	}
+	client := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+	_, err := defaultResponseHandler.handleResponse(client, &TestDeadlineReader{Buffer: bytes.NewBuffer(response)}, responseCtx)
+	require.Error(t, err)
+
+	expected := `
+		# HELP bifrost_protocol_errors_total Request and response modifier failures to decode, rewrite or encode a message
+		# TYPE bifrost_protocol_errors_total counter
+		bifrost_protocol_errors_total{api_key="3",direction="response",virtual_cluster_id="vc-123"} 1
+	`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "bifrost_protocol_errors_total"))
+}
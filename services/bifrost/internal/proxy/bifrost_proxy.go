@@ -2,6 +2,8 @@
 package proxy
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -9,8 +11,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/config"
 	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
@@ -23,6 +27,33 @@ type BifrostProxy struct {
 	saslHandler *auth.SASLHandler
 	vcStore     *config.VirtualClusterStore
 	metrics     *metrics.Collector
+	rateLimiter *VirtualClusterRateLimiter
+	topicQuotas *TopicProduceQuotas
+	topicIDs    *TopicIDRegistry
+
+	// tlsConfig enables TLS termination on the listener. Nil listens in
+	// plaintext.
+	tlsConfig *ListenerTLSConfig
+	// certMapper authenticates clients by their verified certificate. Nil
+	// unless tlsConfig has client certificate rules.
+	certMapper *auth.ClientCertMapper
+
+	// sessionLifetime bounds how long a SASL session is valid before the
+	// client must re-authenticate. Zero disables session expiry.
+	sessionLifetime time.Duration
+
+	// saslMechanisms limits the SASL mechanisms offered to clients. Empty
+	// offers every supported mechanism.
+	saslMechanisms []string
+
+	// idleTimeout and maxConnectionLifetime bound how long client
+	// connections stay open. Zero disables either.
+	idleTimeout           time.Duration
+	maxConnectionLifetime time.Duration
+
+	// unknownVersionPolicy handles requests of versions Bifrost has no
+	// schema for.
+	unknownVersionPolicy UnknownVersionPolicy
 
 	listener        net.Listener
 	connCount       int64 // Total connections ever created (for unique IDs)
@@ -32,31 +63,103 @@ type BifrostProxy struct {
 	wg       sync.WaitGroup
 }
 
-// NewBifrostProxy creates a new multi-tenant Kafka proxy.
+// NewBifrostProxy creates a new multi-tenant Kafka proxy. With a non-nil
+// tlsConfig the listener terminates TLS before the SASL handshake, for
+// clients using SASL_SSL; with nil it listens in plaintext.
 func NewBifrostProxy(
 	listenAddr string,
 	saslHandler *auth.SASLHandler,
 	vcStore *config.VirtualClusterStore,
 	metricsCollector *metrics.Collector,
+	tlsConfig *ListenerTLSConfig,
 ) *BifrostProxy {
 	return &BifrostProxy{
 		listenAddr:  listenAddr,
 		saslHandler: saslHandler,
 		vcStore:     vcStore,
 		metrics:     metricsCollector,
-		shutdown:    make(chan struct{}),
+		rateLimiter: NewVirtualClusterRateLimiter(vcStore, metricsCollector),
+		topicQuotas: NewTopicProduceQuotas(vcStore),
+		topicIDs:    NewTopicIDRegistry(),
+		tlsConfig:   tlsConfig,
+		// Forwarding would skip topic and group prefixing
+		unknownVersionPolicy: UnknownVersionReject,
+		shutdown:             make(chan struct{}),
 	}
 }
 
+// SetSessionLifetime sets the SASL session lifetime advertised to clients.
+// Clients re-authenticate on the open connection before it elapses; connections
+// that don't are closed. Must be called before Start.
+func (p *BifrostProxy) SetSessionLifetime(d time.Duration) {
+	p.sessionLifetime = d
+}
+
+// SetSASLMechanisms limits the SASL mechanisms advertised in SaslHandshake
+// responses, for example to turn off PLAIN so clients must use SCRAM. Clients
+// requesting any other mechanism are rejected with UNSUPPORTED_SASL_MECHANISM.
+// An empty list offers every supported mechanism. Must be called before Start.
+func (p *BifrostProxy) SetSASLMechanisms(mechanisms []string) error {
+	for _, mechanism := range mechanisms {
+		switch mechanism {
+		case SASLPlain, SASLSCRAM256, SASLSCRAM512:
+		default:
+			return fmt.Errorf("unsupported SASL mechanism %q", mechanism)
+		}
+	}
+	p.saslMechanisms = mechanisms
+	return nil
+}
+
+// SetIdleTimeout closes client connections that send no request for d while
+// no response is outstanding. Must be called before Start.
+func (p *BifrostProxy) SetIdleTimeout(d time.Duration) {
+	p.idleTimeout = d
+}
+
+// SetMaxConnectionLifetime closes client connections between requests once
+// they have been open for d, so clients reconnect and pick up configuration
+// that only applies to new connections. Must be called before Start.
+func (p *BifrostProxy) SetMaxConnectionLifetime(d time.Duration) {
+	p.maxConnectionLifetime = d
+}
+
+// SetUnknownVersionPolicy sets how requests of an API version Bifrost has no
+// schema for are handled. The default, UnknownVersionReject, answers them with
+// UNSUPPORTED_VERSION; UnknownVersionForward passes them to the broker
+// unmodified on connections without prefixes and rejects them on rewriting
+// connections. Must be called before Start.
+func (p *BifrostProxy) SetUnknownVersionPolicy(policy UnknownVersionPolicy) {
+	p.unknownVersionPolicy = policy
+}
+
 // Start begins accepting connections.
 func (p *BifrostProxy) Start() error {
-	var err error
-	p.listener, err = net.Listen("tcp", p.listenAddr)
+	var serverTLSConfig *tls.Config
+	if p.tlsConfig != nil {
+		var err error
+		if serverTLSConfig, err = p.tlsConfig.serverConfig(); err != nil {
+			return fmt.Errorf("failed to configure listener TLS: %w", err)
+		}
+		if len(p.tlsConfig.ClientCertRules) > 0 {
+			if p.certMapper, err = auth.NewClientCertMapper(p.tlsConfig.ClientCertRules, p.vcStore); err != nil {
+				return fmt.Errorf("failed to configure listener TLS: %w", err)
+			}
+		}
+	}
+
+	listener, err := net.Listen("tcp", p.listenAddr)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", p.listenAddr, err)
 	}
 
-	logrus.Infof("Kafka proxy listening on %s", p.listenAddr)
+	if serverTLSConfig != nil {
+		p.listener = tls.NewListener(listener, serverTLSConfig)
+		logrus.Infof("Kafka proxy listening on %s (TLS)", p.listenAddr)
+	} else {
+		p.listener = listener
+		logrus.Infof("Kafka proxy listening on %s", p.listenAddr)
+	}
 
 	p.wg.Add(1)
 	go p.acceptLoop()
@@ -105,28 +208,49 @@ func (p *BifrostProxy) handleConnection(clientConn net.Conn) {
 	connID := fmt.Sprintf("%s-%d", clientConn.RemoteAddr(), atomic.AddInt64(&p.connCount, 1))
 	logrus.Debugf("New connection: %s", connID)
 
-	// Phase 1: SASL Authentication
+	// Phase 1: Authentication
 	// ---------------------------------
-	// Create authenticator that will capture ConnectionContext
-	authenticator := NewBifrostAuthenticator(p.saslHandler)
-
-	// Create LocalSasl for authentication
-	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second)
-
-	// Perform SASL handshake directly on client connection
-	// This reads SaslHandshake and SaslAuthenticate requests and responds
-	if err := p.performSASLAuth(clientConn, localSasl); err != nil {
-		logrus.Warnf("Connection %s: SASL auth failed: %v", connID, err)
-		p.metrics.RecordAuth(false)
-		return
+	// A verified client certificate matching a rule authenticates the
+	// connection; anything else authenticates with SASL
+	var ctx *auth.ConnectionContext
+	if tlsConn, ok := clientConn.(*tls.Conn); ok {
+		if err := completeTLSHandshake(tlsConn); err != nil {
+			logrus.Warnf("Connection %s: TLS handshake failed: %v", connID, err)
+			return
+		}
+		if chains := tlsConn.ConnectionState().VerifiedChains; p.certMapper != nil && len(chains) > 0 {
+			var err error
+			if ctx, err = p.certMapper.Authenticate(chains[0][0]); err != nil {
+				logrus.Warnf("Connection %s: client certificate auth failed for %s: %v", connID, chains[0][0].Subject, err)
+				p.metrics.RecordAuth(false)
+				return
+			}
+			p.metrics.RecordAuth(true)
+		}
 	}
-	p.metrics.RecordAuth(true)
 
-	// Get connection context from successful auth
-	ctx := authenticator.GetContext()
 	if ctx == nil {
-		logrus.Errorf("Connection %s: auth succeeded but no context", connID)
-		return
+		// Create authenticator that will capture ConnectionContext
+		authenticator := NewBifrostAuthenticator(p.saslHandler)
+
+		// Create LocalSasl for authentication
+		localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, p.sessionLifetime, p.saslMechanisms)
+
+		// Perform SASL handshake directly on client connection
+		// This reads SaslHandshake and SaslAuthenticate requests and responds
+		if err := p.performSASLAuth(clientConn, localSasl); err != nil {
+			logrus.Warnf("Connection %s: SASL auth failed: %v", connID, err)
+			p.metrics.RecordAuth(false)
+			return
+		}
+		p.metrics.RecordAuth(true)
+
+		// Get connection context from successful auth
+		ctx = authenticator.GetContext()
+		if ctx == nil {
+			logrus.Errorf("Connection %s: auth succeeded but no context", connID)
+			return
+		}
 	}
 
 	// Phase 2: Upstream Connection and Proxying
@@ -154,21 +278,6 @@ func (p *BifrostProxy) handleConnection(clientConn net.Conn) {
 	// Create BifrostConnection for state management
 	bifrostConn := NewBifrostConnection(connID, clientConn, ctx, p.metrics)
 
-	// Create rewriter functions based on virtual cluster configuration
-	// Topic prefixer: adds tenant prefix to outgoing topics
-	topicPrefixer := func(topic string) string {
-		return bifrostConn.rewriter.PrefixTopic(topic)
-	}
-	// Topic unprefixer: removes tenant prefix from incoming topics
-	topicUnprefixer := func(topic string) string {
-		unprefixed, _ := bifrostConn.rewriter.UnprefixTopic(topic)
-		return unprefixed
-	}
-	// Topic filter: only include topics belonging to this tenant
-	topicFilter := func(topic string) bool {
-		return bifrostConn.rewriter.TopicBelongsToTenant(topic)
-	}
-
 	// Address mapper - maps internal broker addresses to the advertised address
 	// This ensures clients connect back through Bifrost, not directly to the broker
 	advertisedMapper := func(host string, port int32, nodeId int32) (string, int32, error) {
@@ -181,34 +290,55 @@ func (p *BifrostProxy) handleConnection(clientConn net.Conn) {
 		return host, port, nil
 	}
 
-	// Group unprefixer: removes tenant prefix from incoming group IDs
-	groupUnprefixer := func(group string) string {
-		unprefixed, _ := bifrostConn.rewriter.UnprefixGroup(group)
-		return unprefixed
+	// Decided once per connection: a VC without prefixes skips request and
+	// topic/group response decoding entirely
+	requestModifierConfig, responseModifierConfig := newModifierConfigs(bifrostConn.rewriter, advertisedMapper)
+	// Fetch v13+ and Metadata v10+ address topics by ID, resolved against the
+	// names Metadata responses reported for the same upstream cluster. IDs
+	// are recorded on passthrough connections too, for the topic policy.
+	responseModifierConfig.TopicIDObserver = func(topicID uuid.UUID, topic string) {
+		if topicID == uuid.Nil {
+			p.topicIDs.Forget(ctx.BootstrapServers, topic)
+			return
+		}
+		p.topicIDs.Record(ctx.BootstrapServers, topicID, topic)
 	}
-	// Group filter: only include groups belonging to this tenant
-	groupFilter := func(group string) bool {
-		return bifrostConn.rewriter.GroupBelongsToTenant(group)
+	if responseModifierConfig.TopicFilter != nil {
+		responseModifierConfig.TopicIDResolver = func(topicID uuid.UUID) (string, bool) {
+			return p.topicIDs.Lookup(ctx.BootstrapServers, topicID)
+		}
 	}
-
-	// Create response modifier config with topic and group rewriting
-	responseModifierConfig := &protocol.ResponseModifierConfig{
-		NetAddressMappingFunc: advertisedMapper,
-		TopicUnprefixer:       topicUnprefixer,
-		TopicFilter:           topicFilter,
-		GroupUnprefixer:       groupUnprefixer,
-		GroupFilter:           groupFilter,
+	// Looked up per call so topic policy changes apply to open connections
+	topicPolicy := func() *TopicPolicy {
+		vc, ok := p.vcStore.Get(ctx.VirtualClusterID)
+		if !ok {
+			return nil
+		}
+		return NewTopicPolicy(vc)
+	}
+	if tenantFilter := responseModifierConfig.TopicFilter; tenantFilter != nil {
+		// Hide denied topics from Metadata responses listing every topic and
+		// from Fetch responses that identify topics by ID
+		responseModifierConfig.TopicFilter = func(topic string) bool {
+			if !tenantFilter(topic) {
+				return false
+			}
+			policy := topicPolicy()
+			if policy == nil {
+				return true
+			}
+			unprefixed, _ := bifrostConn.rewriter.UnprefixTopic(topic)
+			return policy.Allows(unprefixed)
+		}
+	}
+	if bifrostConn.rewriter.IsPassthrough() {
+		logrus.Debugf("Connection %s: no prefixes configured, forwarding without rewriting", connID)
 	}
 
-	// Create request modifier config with topic prefixing
-	requestModifierConfig := &protocol.RequestModifierConfig{
-		TopicPrefixer: topicPrefixer,
-		GroupPrefixer: func(group string) string {
-			return bifrostConn.rewriter.PrefixGroup(group)
-		},
-		TxnIDPrefixer: func(txnID string) string {
-			return bifrostConn.rewriter.PrefixTransactionID(txnID)
-		},
+	// Certificate-authenticated clients have no SASL session to renew
+	sessionLifetime := p.sessionLifetime
+	if ctx.ClientCertificate {
+		sessionLifetime = 0
 	}
 
 	proc := newProcessor(ProcessorConfig{
@@ -219,6 +349,49 @@ func (p *BifrostProxy) handleConnection(clientConn net.Conn) {
 		NetAddressMappingFunc:  advertisedMapper,
 		ResponseModifierConfig: responseModifierConfig,
 		RequestModifierConfig:  requestModifierConfig,
+		// Mid-session SaslHandshake/SaslAuthenticate are answered locally
+		Reauthenticator: NewSaslReauthenticator(p.saslHandler, ctx, 30*time.Second, sessionLifetime, p.saslMechanisms),
+		// Requests are delayed, not dropped, once the VC exceeds its budget
+		RequestThrottle: func(requestBytes int) {
+			p.rateLimiter.Wait(ctx.VirtualClusterID, requestBytes)
+		},
+		RequestMetrics: bifrostConn,
+		// Looked up per request so SetVirtualClusterReadOnly applies to open connections
+		ReadOnly: func() bool {
+			vc, ok := p.vcStore.Get(ctx.VirtualClusterID)
+			return ok && vc.ReadOnly
+		},
+		// Kept current by ValidateSession, which runs first on every request
+		PermissionTemplate: func() gatewayv1.PermissionTemplate {
+			return ctx.PermissionTemplate
+		},
+		TopicPolicy: topicPolicy,
+		// Topics addressed by ID are checked under their client-facing name
+		TopicIDResolver: func(topicID uuid.UUID) (string, bool) {
+			topic, ok := p.topicIDs.Lookup(ctx.BootstrapServers, topicID)
+			if !ok {
+				return "", false
+			}
+			return bifrostConn.rewriter.UnprefixTopic(topic)
+		},
+		// Over-quota topics are throttled through the Produce response
+		ProduceQuota: func(topicBytes map[string]int) time.Duration {
+			return p.topicQuotas.ThrottleTime(ctx.VirtualClusterID, topicBytes)
+		},
+		// Revoked credentials and prefix changes apply to open connections
+		ValidateSession: func() error {
+			vc, err := p.saslHandler.ValidateSession(ctx)
+			if err == nil {
+				err = bifrostConn.applyVirtualCluster(vc)
+			}
+			if err != nil {
+				logrus.Infof("Connection %s: closing: %v", connID, err)
+			}
+			return err
+		},
+		UnknownVersionPolicy: p.unknownVersionPolicy,
+		IdleTimeout:          p.idleTimeout,
+		MaxLifetime:          p.maxConnectionLifetime,
 	}, ctx.BootstrapServers)
 
 	// Run proxy loops
@@ -227,7 +400,14 @@ func (p *BifrostProxy) handleConnection(clientConn net.Conn) {
 	done := make(chan struct{})
 	go func() {
 		_, err := proc.RequestsLoop(brokerConn, clientConn)
-		if err != nil && err != io.EOF {
+		switch {
+		case errors.Is(err, errConnectionIdle):
+			logrus.Infof("Connection %s: closing after %s idle", connID, p.idleTimeout)
+			p.metrics.RecordConnectionReaped(ctx.VirtualClusterID, "idle")
+		case errors.Is(err, errConnectionMaxLifetime):
+			logrus.Infof("Connection %s: closing after reaching maximum lifetime %s", connID, p.maxConnectionLifetime)
+			p.metrics.RecordConnectionReaped(ctx.VirtualClusterID, "max_lifetime")
+		case err != nil && err != io.EOF:
 			logrus.Debugf("Connection %s: requests loop ended: %v", connID, err)
 		}
 		// Close broker connection to unblock ResponsesLoop
@@ -341,12 +521,25 @@ func (p *BifrostProxy) handleApiVersionsLocal(conn net.Conn, reqKV *protocol.Req
 		{ApiKey: 18, MinVersion: 0, MaxVersion: 3}, // ApiVersions (Redpanda max)
 		{ApiKey: 19, MinVersion: 0, MaxVersion: 6}, // CreateTopics (Redpanda max)
 		{ApiKey: 20, MinVersion: 0, MaxVersion: 4}, // DeleteTopics (Redpanda max)
+		{ApiKey: 22, MinVersion: 0, MaxVersion: 4}, // InitProducerId (Redpanda max)
+		{ApiKey: 24, MinVersion: 0, MaxVersion: 3}, // AddPartitionsToTxn (Redpanda max)
+		{ApiKey: 25, MinVersion: 0, MaxVersion: 3}, // AddOffsetsToTxn (Redpanda max)
+		{ApiKey: 26, MinVersion: 0, MaxVersion: 3}, // EndTxn (Redpanda max)
+		{ApiKey: 28, MinVersion: 0, MaxVersion: 3}, // TxnOffsetCommit (Redpanda max)
+		{ApiKey: 29, MinVersion: 0, MaxVersion: 3}, // DescribeAcls
+		{ApiKey: 30, MinVersion: 0, MaxVersion: 3}, // CreateAcls
+		{ApiKey: 31, MinVersion: 0, MaxVersion: 3}, // DeleteAcls
+		{ApiKey: 32, MinVersion: 0, MaxVersion: 4}, // DescribeConfigs (Redpanda max)
+		{ApiKey: 33, MinVersion: 0, MaxVersion: 2}, // AlterConfigs (Redpanda max)
 		{ApiKey: 36, MinVersion: 0, MaxVersion: 2}, // SaslAuthenticate
+		{ApiKey: 37, MinVersion: 0, MaxVersion: 3}, // CreatePartitions (Redpanda max)
+		{ApiKey: 42, MinVersion: 0, MaxVersion: 2}, // DeleteGroups (Redpanda max)
+		{ApiKey: 47, MinVersion: 0, MaxVersion: 0}, // OffsetDelete
 	}
 
 	response := &protocol.ApiVersionsResponse{
 		Err:         protocol.ErrNoError,
-		ApiVersions: apiVersions,
+		ApiVersions: protocol.ClampApiVersions(apiVersions),
 		ThrottleMs:  0,
 	}
 
@@ -393,6 +586,52 @@ func (p *BifrostProxy) handleApiVersionsLocal(conn net.Conn, reqKV *protocol.Req
 	return nil
 }
 
+// newModifierConfigs builds the request and response modifier configs for a
+// connection. When the rewriter is a passthrough, the request config is nil and
+// the response config only maps broker addresses, so Produce, Fetch and the
+// other topic/group APIs are forwarded byte for byte.
+func newModifierConfigs(rewriter *Rewriter, addressMapper func(host string, port int32, nodeId int32) (string, int32, error)) (*protocol.RequestModifierConfig, *protocol.ResponseModifierConfig) {
+	// Metadata, FindCoordinator, DescribeCluster and the Produce/Fetch leader
+	// hints still need broker addresses mapped so clients connect back
+	// through Bifrost
+	responseModifierConfig := &protocol.ResponseModifierConfig{
+		NetAddressMappingFunc: addressMapper,
+	}
+	if rewriter.IsPassthrough() {
+		return nil, responseModifierConfig
+	}
+
+	// Topic unprefixer: removes tenant prefix from incoming topics
+	responseModifierConfig.TopicUnprefixer = func(topic string) string {
+		unprefixed, _ := rewriter.UnprefixTopic(topic)
+		return unprefixed
+	}
+	// Topic filter: only include topics belonging to this tenant
+	responseModifierConfig.TopicFilter = rewriter.TopicBelongsToTenant
+	// Group unprefixer: removes tenant prefix from incoming group IDs
+	responseModifierConfig.GroupUnprefixer = func(group string) string {
+		unprefixed, _ := rewriter.UnprefixGroup(group)
+		return unprefixed
+	}
+	// Group filter: only include groups belonging to this tenant
+	responseModifierConfig.GroupFilter = rewriter.GroupBelongsToTenant
+	// Transaction ID unprefixer and filter: used for transactional ID ACLs
+	responseModifierConfig.TxnIDUnprefixer = func(txnID string) string {
+		unprefixed, _ := rewriter.UnprefixTransactionID(txnID)
+		return unprefixed
+	}
+	responseModifierConfig.TxnIDFilter = rewriter.TransactionIDBelongsToTenant
+
+	// Request config adds the tenant prefix to outgoing topics, groups and
+	// transaction IDs
+	requestModifierConfig := &protocol.RequestModifierConfig{
+		TopicPrefixer: rewriter.PrefixTopic,
+		GroupPrefixer: rewriter.PrefixGroup,
+		TxnIDPrefixer: rewriter.PrefixTransactionID,
+	}
+	return requestModifierConfig, responseModifierConfig
+}
+
 // sendUpstreamApiVersions sends an ApiVersions request to the upstream broker
 // and reads the response. This is required because Kafka brokers expect
 // ApiVersions as the first message on a new connection.
@@ -1,17 +1,26 @@
 package proxy
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
 	"net"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
 
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/config"
 	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
 )
 
 func newTestProxy(t *testing.T) *BifrostProxy {
@@ -21,7 +30,7 @@ func newTestProxy(t *testing.T) *BifrostProxy {
 	collector := metrics.NewCollector()
 
 	// Use port 0 to let OS assign an available port
-	return NewBifrostProxy(":0", saslHandler, vcStore, collector)
+	return NewBifrostProxy(":0", saslHandler, vcStore, collector, nil)
 }
 
 func TestBifrostProxy_StartStop(t *testing.T) {
@@ -175,7 +184,7 @@ func TestBifrostProxy_StartFailsOnBadAddress(t *testing.T) {
 	collector := metrics.NewCollector()
 
 	// Use an invalid address that cannot be bound
-	proxy := NewBifrostProxy("invalid-address:999999", saslHandler, vcStore, collector)
+	proxy := NewBifrostProxy("invalid-address:999999", saslHandler, vcStore, collector, nil)
 
 	err := proxy.Start()
 	assert.Error(t, err)
@@ -188,7 +197,7 @@ func TestBifrostProxy_NewBifrostProxy(t *testing.T) {
 	saslHandler := auth.NewSASLHandler(credStore, vcStore)
 	collector := metrics.NewCollector()
 
-	proxy := NewBifrostProxy(":9999", saslHandler, vcStore, collector)
+	proxy := NewBifrostProxy(":9999", saslHandler, vcStore, collector, nil)
 
 	assert.Equal(t, ":9999", proxy.listenAddr)
 	assert.Equal(t, saslHandler, proxy.saslHandler)
@@ -206,3 +215,1029 @@ func TestBifrostProxy_StopWithoutStart(t *testing.T) {
 		proxy.Stop()
 	})
 }
+
+// fakeBroker serves a single upstream connection. It answers the ApiVersions
+// handshake Bifrost sends on connect and passes every other request (starting
+// at the api key) to handle, which returns the response body without the
+// correlation id. A nil response ends the connection.
+func fakeBroker(t *testing.T, handle func(apiKey int16, req []byte) []byte) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			req, err := readFrame(conn)
+			if err != nil {
+				return
+			}
+			apiKey := int16(binary.BigEndian.Uint16(req[0:2]))
+
+			var body []byte
+			if apiKey == 18 {
+				// ApiVersions - Bifrost does not inspect its own upstream
+				// ApiVersions, but clients authenticated by certificate
+				// forward theirs
+				body = fakeApiVersionsResponse(int16(binary.BigEndian.Uint16(req[2:4])))
+			} else if body = handle(apiKey, req); body == nil {
+				return
+			}
+			if err := writeFrame(conn, append(append([]byte{}, req[4:8]...), body...)); err != nil {
+				return
+			}
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// fakeApiVersionsResponse lists every API key at its latest version.
+func fakeApiVersionsResponse(version int16) []byte {
+	resp := kmsg.NewPtrApiVersionsResponse()
+	resp.Version = version
+	for key := int16(0); key <= kmsg.MaxKey; key++ {
+		req := kmsg.RequestForKey(key)
+		if req == nil {
+			continue
+		}
+		apiKey := kmsg.NewApiVersionsResponseApiKey()
+		apiKey.ApiKey = key
+		apiKey.MaxVersion = req.MaxVersion()
+		resp.ApiKeys = append(resp.ApiKeys, apiKey)
+	}
+	return resp.AppendTo(nil)
+}
+
+func readFrame(r io.Reader) ([]byte, error) {
+	lenBuf := make([]byte, 4)
+	if _, err := io.ReadFull(r, lenBuf); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, binary.BigEndian.Uint32(lenBuf))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func writeFrame(w io.Writer, body []byte) error {
+	frame := binary.BigEndian.AppendUint32(nil, uint32(len(body)))
+	_, err := w.Write(append(frame, body...))
+	return err
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+func newBrokerBackedTestProxy(t *testing.T, brokerAddr string, sessionLifetime time.Duration) string {
+	t.Helper()
+	addr, _, _ := newBrokerBackedTestProxyWithStores(t, brokerAddr, sessionLifetime)
+	return addr
+}
+
+// newBrokerBackedTestProxyWithStores is newBrokerBackedTestProxy, also
+// returning the stores the admin API would update.
+func newBrokerBackedTestProxyWithStores(t *testing.T, brokerAddr string, sessionLifetime time.Duration) (string, *config.VirtualClusterStore, *auth.CredentialStore) {
+	t.Helper()
+	p, vcStore, credStore := newConfiguredTestProxy(t, brokerAddr, func(p *BifrostProxy) {
+		p.SetSessionLifetime(sessionLifetime)
+	})
+	return p.listener.Addr().String(), vcStore, credStore
+}
+
+// newConfiguredTestProxy starts the proxy of newBrokerBackedTestProxyWithStores
+// after passing it to configure.
+func newConfiguredTestProxy(t *testing.T, brokerAddr string, configure func(p *BifrostProxy)) (*BifrostProxy, *config.VirtualClusterStore, *auth.CredentialStore) {
+	t.Helper()
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-1",
+		TopicPrefix:              "tenant-a:",
+		GroupPrefix:              "tenant-a:",
+		TransactionIdPrefix:      "tenant-a:",
+		PhysicalBootstrapServers: brokerAddr,
+	})
+	hash := sha256.Sum256([]byte("secret"))
+	credStore := auth.NewCredentialStore()
+	credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-1",
+		VirtualClusterId: "vc-1",
+		Username:         "alice",
+		PasswordHash:     hex.EncodeToString(hash[:]),
+	})
+
+	p := NewBifrostProxy("127.0.0.1:0", auth.NewSASLHandler(credStore, vcStore), vcStore, metrics.NewCollector(), nil)
+	configure(p)
+	require.NoError(t, p.Start())
+	t.Cleanup(p.Stop)
+	return p, vcStore, credStore
+}
+
+func TestBifrostProxy_RejectsDisabledSASLMechanism(t *testing.T) {
+	brokerAddr := fakeBroker(t, func(int16, []byte) []byte {
+		t.Error("rejected client reached the broker")
+		return nil
+	})
+	p, _, _ := newConfiguredTestProxy(t, brokerAddr, func(p *BifrostProxy) {
+		require.NoError(t, p.SetSASLMechanisms([]string{SASLSCRAM256, SASLSCRAM512}))
+	})
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	handshake, err := protocol.Encode(&protocol.Request{
+		CorrelationID: 1,
+		ClientID:      "test-client",
+		Body:          &protocol.SaslHandshakeRequestV0orV1{Version: 1, Mechanism: SASLPlain},
+	})
+	require.NoError(t, err)
+	require.NoError(t, writeFrame(conn, handshake))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	handshakeResp := &protocol.SaslHandshakeResponseV0orV1{}
+	require.NoError(t, protocol.Decode(resp[4:], handshakeResp))
+	assert.Equal(t, protocol.ErrUnsupportedSASLMechanism, handshakeResp.Err)
+	assert.Equal(t, []string{SASLSCRAM256, SASLSCRAM512}, handshakeResp.EnabledMechanisms)
+
+	_, err = readFrame(conn)
+	assert.Error(t, err, "the connection should be closed")
+}
+
+func TestBifrostProxy_SetSASLMechanismsRejectsUnknownMechanism(t *testing.T) {
+	p := newTestProxy(t)
+	assert.Error(t, p.SetSASLMechanisms([]string{"GSSAPI"}))
+	assert.NoError(t, p.SetSASLMechanisms(nil))
+}
+
+func TestBifrostProxy_DescribeGroupsHitsPrefixedGroup(t *testing.T) {
+	const physicalGroup = "tenant-a:orders-consumers"
+
+	requested := make(chan string, 1)
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 15 {
+			return nil
+		}
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		off += 4                                           // groups length (single group)
+		groupLen := int(binary.BigEndian.Uint16(req[off:]))
+		group := string(req[off+2 : off+2+groupLen])
+		requested <- group
+
+		// The broker only knows the physical (prefixed) group; anything else is Dead
+		state := "Dead"
+		if group == physicalGroup {
+			state = "Stable"
+		}
+		var resp []byte
+		resp = append(resp, 0, 0, 0, 1) // groups
+		resp = append(resp, 0, 0)       // error_code
+		resp = appendString(resp, group)
+		resp = appendString(resp, state)
+		resp = appendString(resp, "consumer")
+		resp = appendString(resp, "range")
+		return append(resp, 0, 0, 0, 0) // members
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	// DescribeGroups v0 for the virtual group name
+	var req []byte
+	req = append(req, 0, 15, 0, 0) // api key, api version
+	req = append(req, 0, 0, 0, 3)  // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0, 0, 0, 1)
+	req = appendString(req, "orders-consumers")
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, physicalGroup, <-requested)
+
+	// correlation_id(4) + groups length(4) + error_code(2)
+	off := 10
+	groupLen := int(binary.BigEndian.Uint16(resp[off:]))
+	assert.Equal(t, "orders-consumers", string(resp[off+2:off+2+groupLen]))
+	off += 2 + groupLen
+	stateLen := int(binary.BigEndian.Uint16(resp[off:]))
+	assert.Equal(t, "Stable", string(resp[off+2:off+2+stateLen]))
+}
+
+func TestBifrostProxy_ListGroupsHidesForeignGroups(t *testing.T) {
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 16 {
+			return nil
+		}
+		var resp []byte
+		resp = append(resp, 0, 0)       // error_code
+		resp = append(resp, 0, 0, 0, 3) // groups
+		for _, group := range []string{"tenant-a:orders-consumers", "tenant-b:payments-consumers", "legacy-consumers"} {
+			resp = appendString(resp, group)
+			resp = appendString(resp, "consumer")
+		}
+		return resp
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	// ListGroups v0
+	var req []byte
+	req = append(req, 0, 16, 0, 0) // api key, api version
+	req = append(req, 0, 0, 0, 3)  // correlation_id
+	req = appendString(req, "test-client")
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+
+	// correlation_id(4) + error_code(2)
+	off := 6
+	require.Equal(t, uint32(1), binary.BigEndian.Uint32(resp[off:]), "only the tenant's group should be listed")
+	off += 4
+	groupLen := int(binary.BigEndian.Uint16(resp[off:]))
+	assert.Equal(t, "orders-consumers", string(resp[off+2:off+2+groupLen]))
+}
+
+func TestBifrostProxy_DeleteGroupsTargetsPrefixedGroup(t *testing.T) {
+	requested := make(chan string, 1)
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 42 {
+			return nil
+		}
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		off += 4                                           // groups_names length (single group)
+		groupLen := int(binary.BigEndian.Uint16(req[off:]))
+		group := string(req[off+2 : off+2+groupLen])
+		requested <- group
+
+		var resp []byte
+		resp = append(resp, 0, 0, 0, 0) // throttle_time_ms
+		resp = append(resp, 0, 0, 0, 1) // results
+		resp = appendString(resp, group)
+		return append(resp, 0, 0) // error_code
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	// DeleteGroups v1 for the virtual group name
+	var req []byte
+	req = append(req, 0, 42, 0, 1) // api key, api version
+	req = append(req, 0, 0, 0, 3)  // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0, 0, 0, 1)
+	req = appendString(req, "orders-consumers")
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a:orders-consumers", <-requested)
+
+	// correlation_id(4) + throttle_time_ms(4) + results length(4)
+	off := 12
+	groupLen := int(binary.BigEndian.Uint16(resp[off:]))
+	assert.Equal(t, "orders-consumers", string(resp[off+2:off+2+groupLen]))
+	assert.Equal(t, uint16(0), binary.BigEndian.Uint16(resp[off+2+groupLen:]))
+}
+
+func TestBifrostProxy_InitProducerIdPrefixesTransactionalId(t *testing.T) {
+	requested := make(chan *string, 2)
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 22 {
+			return nil
+		}
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		if idLen := int16(binary.BigEndian.Uint16(req[off:])); idLen < 0 {
+			requested <- nil
+		} else {
+			txnID := string(req[off+2 : off+2+int(idLen)])
+			requested <- &txnID
+		}
+
+		var resp []byte
+		resp = append(resp, 0, 0, 0, 0)             // throttle_time_ms
+		resp = append(resp, 0, 0)                   // error_code
+		resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 9) // producer_id
+		return append(resp, 0, 0)                   // producer_epoch
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	initProducerId := func(correlationID byte, txnID []byte) {
+		var req []byte
+		req = append(req, 0, 22, 0, 1)            // api key, api version
+		req = append(req, 0, 0, 0, correlationID) // correlation_id
+		req = appendString(req, "test-client")
+		req = append(req, txnID...)
+		req = append(req, 0, 0, 0xea, 0x60) // transaction_timeout_ms
+		require.NoError(t, writeFrame(conn, req))
+
+		resp, err := readFrame(conn)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(correlationID), binary.BigEndian.Uint32(resp[:4]))
+	}
+
+	// Transactional producer
+	initProducerId(3, appendString(nil, "orders-txn"))
+	txnID := <-requested
+	require.NotNil(t, txnID)
+	assert.Equal(t, "tenant-a:orders-txn", *txnID)
+
+	// Idempotent producer: a null transactional_id must stay null
+	initProducerId(4, []byte{0xff, 0xff})
+	assert.Nil(t, <-requested)
+}
+
+func TestBifrostProxy_TopicAclAppliesToPrefixedTopic(t *testing.T) {
+	// The fake broker keeps the ACLs it is asked to create, plus one of
+	// another tenant
+	var mu sync.Mutex
+	foreign := kmsg.NewDescribeACLsResponseResource()
+	foreign.ResourceType = kmsg.ACLResourceTypeTopic
+	foreign.ResourceName = "tenant-b:orders"
+	foreign.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral
+	stored := []kmsg.DescribeACLsResponseResource{foreign}
+	describeFilters := make(chan *string, 1)
+
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		version := int16(binary.BigEndian.Uint16(req[2:4]))
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch apiKey {
+		case 30:
+			create := kmsg.NewPtrCreateACLsRequest()
+			create.Version = version
+			require.NoError(t, create.ReadFrom(req[off:]))
+			resp := kmsg.NewPtrCreateACLsResponse()
+			resp.Version = version
+			for _, c := range create.Creations {
+				resource := kmsg.NewDescribeACLsResponseResource()
+				resource.ResourceType = c.ResourceType
+				resource.ResourceName = c.ResourceName
+				resource.ResourcePatternType = c.ResourcePatternType
+				acl := kmsg.NewDescribeACLsResponseResourceACL()
+				acl.Principal, acl.Host = c.Principal, c.Host
+				acl.Operation, acl.PermissionType = c.Operation, c.PermissionType
+				resource.ACLs = append(resource.ACLs, acl)
+				stored = append(stored, resource)
+				resp.Results = append(resp.Results, kmsg.NewCreateACLsResponseResult())
+			}
+			return resp.AppendTo(nil)
+		case 29:
+			describe := kmsg.NewPtrDescribeACLsRequest()
+			describe.Version = version
+			require.NoError(t, describe.ReadFrom(req[off:]))
+			describeFilters <- describe.ResourceName
+			resp := kmsg.NewPtrDescribeACLsResponse()
+			resp.Version = version
+			resp.Resources = stored
+			return resp.AppendTo(nil)
+		default:
+			return nil
+		}
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	roundTrip := func(correlationID int32, req kmsg.Request) []byte {
+		frame := binary.BigEndian.AppendUint16(nil, uint16(req.Key()))
+		frame = binary.BigEndian.AppendUint16(frame, uint16(req.GetVersion()))
+		frame = binary.BigEndian.AppendUint32(frame, uint32(correlationID))
+		frame = appendString(frame, "test-client")
+		require.NoError(t, writeFrame(conn, req.AppendTo(frame)))
+
+		resp, err := readFrame(conn)
+		require.NoError(t, err)
+		require.Equal(t, uint32(correlationID), binary.BigEndian.Uint32(resp[:4]))
+		return resp[4:]
+	}
+
+	// Allow alice to write to the virtual topic "orders"
+	create := kmsg.NewPtrCreateACLsRequest()
+	create.Version = 1
+	creation := kmsg.NewCreateACLsRequestCreation()
+	creation.ResourceType = kmsg.ACLResourceTypeTopic
+	creation.ResourceName = "orders"
+	creation.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral
+	creation.Principal = "User:alice"
+	creation.Host = "*"
+	creation.Operation = kmsg.ACLOperationWrite
+	creation.PermissionType = kmsg.ACLPermissionTypeAllow
+	create.Creations = append(create.Creations, creation)
+	createResp := kmsg.NewPtrCreateACLsResponse()
+	createResp.Version = 1
+	require.NoError(t, createResp.ReadFrom(roundTrip(3, create)))
+	require.Len(t, createResp.Results, 1)
+	assert.Zero(t, createResp.Results[0].ErrorCode)
+
+	// The broker holds the ACL on the physical topic
+	mu.Lock()
+	require.Len(t, stored, 2)
+	assert.Equal(t, "tenant-a:orders", stored[1].ResourceName)
+	mu.Unlock()
+
+	// Describing it finds the prefixed ACL and reports the virtual name only
+	describe := kmsg.NewPtrDescribeACLsRequest()
+	describe.Version = 1
+	describe.ResourceType = kmsg.ACLResourceTypeTopic
+	describe.ResourcePatternType = kmsg.ACLResourcePatternTypeAny
+	describe.Operation = kmsg.ACLOperationAny
+	describe.PermissionType = kmsg.ACLPermissionTypeAny
+	describeResp := kmsg.NewPtrDescribeACLsResponse()
+	describeResp.Version = 1
+	require.NoError(t, describeResp.ReadFrom(roundTrip(4, describe)))
+	assert.Nil(t, <-describeFilters)
+	require.Len(t, describeResp.Resources, 1, "another tenant's ACL must be hidden")
+	assert.Equal(t, "orders", describeResp.Resources[0].ResourceName)
+	require.Len(t, describeResp.Resources[0].ACLs, 1)
+	assert.Equal(t, kmsg.ACLOperationWrite, describeResp.Resources[0].ACLs[0].Operation)
+}
+
+func TestBifrostProxy_PassthroughVirtualClusterReachesItsBackend(t *testing.T) {
+	received := make(chan []byte, 1)
+	passthroughBroker := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 0 {
+			return nil
+		}
+		received <- req
+
+		var resp []byte
+		resp = append(resp, 0, 0, 0, 1) // responses
+		resp = appendString(resp, "orders")
+		resp = append(resp, 0, 0, 0, 1)                                     // partitions
+		resp = append(resp, 0, 0, 0, 0)                                     // index
+		resp = append(resp, 0, 0)                                           // error_code
+		resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 42)                        // base_offset
+		resp = append(resp, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff) // log_append_time_ms
+		return append(resp, 0, 0, 0, 0)                                     // throttle_time_ms
+	})
+	prefixedBroker := fakeBroker(t, func(int16, []byte) []byte {
+		t.Error("passthrough client reached another virtual cluster's backend")
+		return nil
+	})
+
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-prefixed",
+		TopicPrefix:              "tenant-a:",
+		GroupPrefix:              "tenant-a:",
+		TransactionIdPrefix:      "tenant-a:",
+		PhysicalBootstrapServers: prefixedBroker,
+	})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-passthrough",
+		PhysicalBootstrapServers: passthroughBroker,
+	})
+	hash := sha256.Sum256([]byte("secret"))
+	credStore := auth.NewCredentialStore()
+	credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-1",
+		VirtualClusterId: "vc-passthrough",
+		Username:         "bob",
+		PasswordHash:     hex.EncodeToString(hash[:]),
+	})
+	p := NewBifrostProxy("127.0.0.1:0", auth.NewSASLHandler(credStore, vcStore), vcStore, metrics.NewCollector(), nil)
+	require.NoError(t, p.Start())
+	t.Cleanup(p.Stop)
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "bob", "secret")
+	require.Zero(t, authResp.Err)
+
+	// Produce v3 with acks=-1
+	var req []byte
+	req = append(req, 0, 0, 0, 3) // api key, api version
+	req = append(req, 0, 0, 0, 3) // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0xff, 0xff)       // transactional_id: null
+	req = append(req, 0xff, 0xff)       // acks
+	req = append(req, 0, 0, 0x75, 0x30) // timeout_ms
+	req = append(req, 0, 0, 0, 1)       // topic_data
+	req = appendString(req, "orders")
+	req = append(req, 0, 0, 0, 1)             // partition_data
+	req = append(req, 0, 0, 0, 0)             // index
+	req = append(req, 0, 0, 0, 4, 1, 2, 3, 4) // records
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, req, <-received, "request is forwarded byte for byte")
+
+	// correlation_id(4) + responses length(4)
+	off := 8
+	topicLen := int(binary.BigEndian.Uint16(resp[off:]))
+	assert.Equal(t, "orders", string(resp[off+2:off+2+topicLen]))
+}
+
+func appendCompactString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)+1))
+	return append(b, s...)
+}
+
+func TestBifrostProxy_FetchV13ResolvesTopicIDs(t *testing.T) {
+	ownTopic := uuid.New()     // tenant-a:orders
+	foreignTopic := uuid.New() // tenant-b:payments
+	unseenTopic := uuid.New()  // never listed in a Metadata response
+	records := []byte{1, 2, 3, 4}
+
+	fetched := make(chan []byte, 1)
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		switch apiKey {
+		case 3:
+			// Metadata v12
+			var resp []byte
+			resp = append(resp, 0)          // header tagged fields
+			resp = append(resp, 0, 0, 0, 0) // throttle_time_ms
+			resp = append(resp, 2)          // brokers
+			resp = append(resp, 0, 0, 0, 1) // node_id
+			resp = appendCompactString(resp, "broker-1")
+			resp = append(resp, 0, 0, 0x23, 0x84) // port
+			resp = append(resp, 0, 0)             // rack: null, tagged fields
+			resp = append(resp, 0)                // cluster_id: null
+			resp = append(resp, 0, 0, 0, 1)       // controller_id
+			resp = append(resp, 3)                // topics
+			for _, topic := range []struct {
+				name string
+				id   uuid.UUID
+			}{{"tenant-a:orders", ownTopic}, {"tenant-b:payments", foreignTopic}} {
+				resp = append(resp, 0, 0) // error_code
+				resp = appendCompactString(resp, topic.name)
+				resp = append(resp, topic.id[:]...)
+				resp = append(resp, 0)          // is_internal
+				resp = append(resp, 1)          // partitions
+				resp = append(resp, 0, 0, 0, 0) // topic_authorized_operations
+				resp = append(resp, 0)          // tagged fields
+			}
+			return append(resp, 0) // tagged fields
+		case 1:
+			// Fetch v13
+			fetched <- req
+			var resp []byte
+			resp = append(resp, 0)          // header tagged fields
+			resp = append(resp, 0, 0, 0, 0) // throttle_time_ms
+			resp = append(resp, 0, 0)       // error_code
+			resp = append(resp, 0, 0, 0, 7) // session_id
+			resp = append(resp, 4)          // responses
+			for _, id := range []uuid.UUID{ownTopic, foreignTopic, unseenTopic} {
+				resp = append(resp, id[:]...)
+				resp = append(resp, 2)                       // partitions
+				resp = append(resp, 0, 0, 0, 0)              // partition_index
+				resp = append(resp, 0, 0)                    // error_code
+				resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 42) // high_watermark
+				resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 42) // last_stable_offset
+				resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 0)  // log_start_offset
+				resp = append(resp, 1)                       // aborted_transactions
+				resp = append(resp, 0xff, 0xff, 0xff, 0xff)  // preferred_read_replica
+				resp = append(resp, byte(len(records)+1))    // records
+				resp = append(resp, records...)
+				resp = append(resp, 0, 0) // partition and topic tagged fields
+			}
+			return append(resp, 0) // tagged fields
+		}
+		return nil
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	// Metadata v12 for all topics
+	var req []byte
+	req = append(req, 0, 3, 0, 12) // api key, api version
+	req = append(req, 0, 0, 0, 3)  // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0)       // header tagged fields
+	req = append(req, 0)       // topics: null
+	req = append(req, 0, 0, 0) // allow_auto_topic_creation, include_topic_authorized_operations, tagged fields
+	require.NoError(t, writeFrame(conn, req))
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	require.Contains(t, string(resp), "orders")
+	require.NotContains(t, string(resp), "payments", "other tenants' topics are hidden")
+
+	// Fetch v13 by topic ID
+	req = nil
+	req = append(req, 0, 1, 0, 13) // api key, api version
+	req = append(req, 0, 0, 0, 4)  // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0)                      // header tagged fields
+	req = append(req, 0xff, 0xff, 0xff, 0xff) // replica_id
+	req = append(req, 0, 0, 0x01, 0xf4)       // max_wait_ms
+	req = append(req, 0, 0, 0, 1)             // min_bytes
+	req = append(req, 0, 0x10, 0, 0)          // max_bytes
+	req = append(req, 0)                      // isolation_level
+	req = append(req, 0, 0, 0, 0)             // session_id
+	req = append(req, 0xff, 0xff, 0xff, 0xff) // session_epoch
+	req = append(req, 4)                      // topics
+	for _, id := range []uuid.UUID{ownTopic, foreignTopic, unseenTopic} {
+		req = append(req, id[:]...)
+		req = append(req, 2)                      // partitions
+		req = append(req, 0, 0, 0, 0)             // partition
+		req = append(req, 0xff, 0xff, 0xff, 0xff) // current_leader_epoch
+		req = append(req, 0, 0, 0, 0, 0, 0, 0, 0) // fetch_offset
+		req = append(req, 0xff, 0xff, 0xff, 0xff) // last_fetched_epoch
+		req = append(req, 0, 0, 0, 0, 0, 0, 0, 0) // log_start_offset
+		req = append(req, 0, 0x10, 0, 0)          // partition_max_bytes
+		req = append(req, 0, 0)                   // partition and topic tagged fields
+	}
+	req = append(req, 1, 1, 0) // forgotten_topics_data, rack_id, tagged fields
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err = readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, req, <-fetched, "topic IDs need no prefixing")
+
+	// correlation_id(4) + header tagged fields(1) + throttle_time_ms(4) +
+	// error_code(2) + session_id(4) + responses length(1)
+	off := 16
+	type partition struct {
+		errorCode     int16
+		highWatermark int64
+		records       []byte
+	}
+	got := make(map[uuid.UUID]partition)
+	for i := 0; i < 3; i++ {
+		id, err := uuid.FromBytes(resp[off : off+16])
+		require.NoError(t, err)
+		off += 16 + 1 + 4 // topic_id, partitions length, partition_index
+		p := partition{
+			errorCode:     int16(binary.BigEndian.Uint16(resp[off:])),
+			highWatermark: int64(binary.BigEndian.Uint64(resp[off+2:])),
+		}
+		off += 2 + 8 + 8 + 8 + 1 + 4 // error_code, offsets, aborted_transactions, preferred_read_replica
+		recordsLen := int(resp[off]) - 1
+		p.records = resp[off+1 : off+1+recordsLen]
+		off += 1 + recordsLen + 2
+		got[id] = p
+	}
+
+	assert.Equal(t, partition{errorCode: 0, highWatermark: 42, records: records}, got[ownTopic])
+	assert.Equal(t, partition{errorCode: 100, highWatermark: -1, records: []byte{}}, got[foreignTopic],
+		"another tenant's topic is answered with UNKNOWN_TOPIC_ID")
+	assert.Equal(t, partition{errorCode: 100, highWatermark: -1, records: []byte{}}, got[unseenTopic],
+		"unresolved topic IDs are answered with UNKNOWN_TOPIC_ID")
+}
+
+func TestBifrostProxy_DescribeClusterAdvertisesVirtualClusterAddress(t *testing.T) {
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 60 {
+			return nil
+		}
+		// DescribeCluster v1
+		var resp []byte
+		resp = append(resp, 0)          // header tagged fields
+		resp = append(resp, 0, 0, 0, 0) // throttle_time_ms
+		resp = append(resp, 0, 0)       // error_code
+		resp = append(resp, 0)          // error_message: null
+		resp = append(resp, 1)          // endpoint_type: brokers
+		resp = appendCompactString(resp, "cluster-1")
+		resp = append(resp, 0, 0, 0, 1) // controller_id
+		resp = append(resp, 3)          // brokers
+		for i, host := range []string{"kafka-0.internal", "kafka-1.internal"} {
+			resp = append(resp, 0, 0, 0, byte(i)) // broker_id
+			resp = appendCompactString(resp, host)
+			resp = append(resp, 0, 0, 0x23, 0x84) // port
+			resp = append(resp, 0, 0)             // rack: null, tagged fields
+		}
+		resp = append(resp, 0x80, 0, 0, 0) // cluster_authorized_operations
+		return append(resp, 0)             // tagged fields
+	})
+	p, vcStore, _ := newConfiguredTestProxy(t, brokerAddr, func(*BifrostProxy) {})
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-1",
+		TopicPrefix:              "tenant-a:",
+		GroupPrefix:              "tenant-a:",
+		TransactionIdPrefix:      "tenant-a:",
+		PhysicalBootstrapServers: brokerAddr,
+		AdvertisedHost:           "tenant-a.kafka.example.com",
+		AdvertisedPort:           19092,
+	})
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	var req []byte
+	req = append(req, 0, 60, 0, 1) // api key, api version
+	req = append(req, 0, 0, 0, 3)  // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0)       // header tagged fields
+	req = append(req, 0, 1, 0) // include_cluster_authorized_operations, endpoint_type, tagged fields
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+
+	// correlation_id(4) + header tagged fields(1) + throttle_time_ms(4) +
+	// error_code(2) + error_message(1) + endpoint_type(1)
+	off := 13
+	clusterIDLen := int(resp[off]) - 1
+	assert.Equal(t, "cluster-1", string(resp[off+1:off+1+clusterIDLen]))
+	off += 1 + clusterIDLen + 4 // cluster_id, controller_id
+	require.Equal(t, byte(3), resp[off], "brokers")
+	off++
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, uint32(i), binary.BigEndian.Uint32(resp[off:]))
+		off += 4
+		hostLen := int(resp[off]) - 1
+		assert.Equal(t, "tenant-a.kafka.example.com", string(resp[off+1:off+1+hostLen]))
+		off += 1 + hostLen
+		assert.Equal(t, uint32(19092), binary.BigEndian.Uint32(resp[off:]))
+		off += 4 + 2 // port, rack, tagged fields
+	}
+	assert.Equal(t, uint32(0x80000000), binary.BigEndian.Uint32(resp[off:]))
+}
+
+func TestBifrostProxy_DeleteRecordsTruncatesPrefixedTopic(t *testing.T) {
+	// The fake broker keeps a log per physical topic; each produced records
+	// byte stands in for one record
+	type partitionLog struct{ low, high int64 }
+	var mu sync.Mutex
+	logs := make(map[string]*partitionLog)
+
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		switch apiKey {
+		case 0:
+			// Produce v3
+			off += 2 + 2 + 4 + 4 // transactional_id (null), acks, timeout_ms, topic_data length
+			topicLen := int(binary.BigEndian.Uint16(req[off:]))
+			topic := string(req[off+2 : off+2+topicLen])
+			off += 2 + topicLen + 4 + 4 // name, partition_data length, index
+			records := int64(binary.BigEndian.Uint32(req[off:]))
+
+			mu.Lock()
+			log, ok := logs[topic]
+			if !ok {
+				log = &partitionLog{}
+				logs[topic] = log
+			}
+			baseOffset := log.high
+			log.high += records
+			mu.Unlock()
+
+			var resp []byte
+			resp = append(resp, 0, 0, 0, 1) // responses
+			resp = appendString(resp, topic)
+			resp = append(resp, 0, 0, 0, 1) // partitions
+			resp = append(resp, 0, 0, 0, 0) // index
+			resp = append(resp, 0, 0)       // error_code
+			resp = binary.BigEndian.AppendUint64(resp, uint64(baseOffset))
+			resp = append(resp, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff) // log_append_time_ms
+			return append(resp, 0, 0, 0, 0)                                     // throttle_time_ms
+		case 21:
+			// DeleteRecords v2
+			off += 1 + 1 // header tagged fields, topics length (single topic)
+			topicLen := int(req[off]) - 1
+			topic := string(req[off+1 : off+1+topicLen])
+			off += 1 + topicLen + 1 + 4 // name, partitions length, partition_index
+			offset := int64(binary.BigEndian.Uint64(req[off:]))
+
+			var errorCode byte
+			var low int64 = -1
+			mu.Lock()
+			if log, ok := logs[topic]; !ok {
+				errorCode = 3 // UNKNOWN_TOPIC_OR_PARTITION
+			} else if offset > log.high {
+				errorCode = 1 // OFFSET_OUT_OF_RANGE
+			} else {
+				log.low = offset
+				low = offset
+			}
+			mu.Unlock()
+
+			var resp []byte
+			resp = append(resp, 0)          // header tagged fields
+			resp = append(resp, 0, 0, 0, 0) // throttle_time_ms
+			resp = append(resp, 2)          // topics
+			resp = appendCompactString(resp, topic)
+			resp = append(resp, 2)          // partitions
+			resp = append(resp, 0, 0, 0, 0) // partition_index
+			resp = binary.BigEndian.AppendUint64(resp, uint64(low))
+			resp = append(resp, 0, errorCode)
+			return append(resp, 0, 0, 0) // partition, topic and response tagged fields
+		}
+		return nil
+	})
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	// Produce five records to the virtual topic
+	var req []byte
+	req = append(req, 0, 0, 0, 3) // api key, api version
+	req = append(req, 0, 0, 0, 3) // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0xff, 0xff)       // transactional_id: null
+	req = append(req, 0xff, 0xff)       // acks
+	req = append(req, 0, 0, 0x75, 0x30) // timeout_ms
+	req = append(req, 0, 0, 0, 1)       // topic_data
+	req = appendString(req, "orders")
+	req = append(req, 0, 0, 0, 1)                // partition_data
+	req = append(req, 0, 0, 0, 0)                // index
+	req = append(req, 0, 0, 0, 5, 1, 2, 3, 4, 5) // records
+	require.NoError(t, writeFrame(conn, req))
+	_, err = readFrame(conn)
+	require.NoError(t, err)
+
+	// DeleteRecords v2 up to offset 3
+	req = nil
+	req = append(req, 0, 21, 0, 2) // api key, api version
+	req = append(req, 0, 0, 0, 4)  // correlation_id
+	req = appendString(req, "test-client")
+	req = append(req, 0) // header tagged fields
+	req = append(req, 2) // topics
+	req = appendCompactString(req, "orders")
+	req = append(req, 2)                      // partitions
+	req = append(req, 0, 0, 0, 0)             // partition_index
+	req = append(req, 0, 0, 0, 0, 0, 0, 0, 3) // offset
+	req = append(req, 0, 0)                   // partition and topic tagged fields
+	req = append(req, 0, 0, 0x75, 0x30)       // timeout_ms
+	req = append(req, 0)                      // tagged fields
+	require.NoError(t, writeFrame(conn, req))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+
+	mu.Lock()
+	require.Contains(t, logs, "tenant-a:orders")
+	assert.Equal(t, partitionLog{low: 3, high: 5}, *logs["tenant-a:orders"], "low watermark moved on the prefixed topic")
+	assert.NotContains(t, logs, "orders")
+	mu.Unlock()
+
+	// correlation_id(4) + header tagged fields(1) + throttle_time_ms(4) + topics length(1)
+	off := 10
+	topicLen := int(resp[off]) - 1
+	assert.Equal(t, "orders", string(resp[off+1:off+1+topicLen]))
+	off += 1 + topicLen + 1 + 4 // name, partitions length, partition_index
+	assert.Equal(t, uint64(3), binary.BigEndian.Uint64(resp[off:]), "low_watermark")
+	assert.Equal(t, uint16(0), binary.BigEndian.Uint16(resp[off+8:]), "error_code")
+}
+
+// initProducerIdBroker answers InitProducerId requests and reports the
+// transactional IDs it receives.
+func initProducerIdBroker(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	requested := make(chan string, 4)
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 22 {
+			return nil
+		}
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		idLen := int(binary.BigEndian.Uint16(req[off:]))
+		requested <- string(req[off+2 : off+2+idLen])
+
+		var resp []byte
+		resp = append(resp, 0, 0, 0, 0)             // throttle_time_ms
+		resp = append(resp, 0, 0)                   // error_code
+		resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 9) // producer_id
+		return append(resp, 0, 0)                   // producer_epoch
+	})
+	return brokerAddr, requested
+}
+
+// writeInitProducerId sends an InitProducerId v1 request for txnID.
+func writeInitProducerId(t *testing.T, conn net.Conn, correlationID byte, txnID string) {
+	t.Helper()
+	var req []byte
+	req = append(req, 0, 22, 0, 1)            // api key, api version
+	req = append(req, 0, 0, 0, correlationID) // correlation_id
+	req = appendString(req, "test-client")
+	req = appendString(req, txnID)
+	req = append(req, 0, 0, 0xea, 0x60) // transaction_timeout_ms
+	require.NoError(t, writeFrame(conn, req))
+}
+
+func TestBifrostProxy_RevokedCredentialRejectsNextRequest(t *testing.T) {
+	brokerAddr, requested := initProducerIdBroker(t)
+	proxyAddr, _, credStore := newBrokerBackedTestProxyWithStores(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	writeInitProducerId(t, conn, 2, "orders-txn")
+	_, err = readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a:orders-txn", <-requested)
+
+	// Revoked mid-session: the next request is not forwarded and the
+	// connection is closed, as a broker does when a session is no longer valid
+	credStore.Delete("cred-1")
+	writeInitProducerId(t, conn, 3, "orders-txn")
+	_, err = readFrame(conn)
+	assert.Error(t, err, "connection should be closed")
+	assert.Empty(t, requested, "request must not reach the broker")
+
+	// Reconnecting with the revoked credential fails authentication
+	conn2, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn2.Close()
+	require.NoError(t, conn2.SetDeadline(time.Now().Add(5*time.Second)))
+	assert.NotZero(t, saslAuthenticate(t, conn2, 1, "alice", "secret").Err)
+}
+
+func TestBifrostProxy_PrefixChangeAppliesToOpenConnection(t *testing.T) {
+	brokerAddr, requested := initProducerIdBroker(t)
+	proxyAddr, vcStore, _ := newBrokerBackedTestProxyWithStores(t, brokerAddr, 0)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	writeInitProducerId(t, conn, 2, "orders-txn")
+	_, err = readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a:orders-txn", <-requested)
+
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{
+		Id:                       "vc-1",
+		TopicPrefix:              "tenant-b:",
+		GroupPrefix:              "tenant-b:",
+		TransactionIdPrefix:      "tenant-b:",
+		PhysicalBootstrapServers: brokerAddr,
+	})
+	writeInitProducerId(t, conn, 3, "orders-txn")
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), binary.BigEndian.Uint32(resp[:4]))
+	assert.Equal(t, "tenant-b:orders-txn", <-requested)
+}
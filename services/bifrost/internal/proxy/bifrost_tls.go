@@ -0,0 +1,110 @@
+// services/bifrost/internal/proxy/bifrost_tls.go
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+)
+
+// tlsHandshakeTimeout bounds how long a client has to complete the TLS
+// handshake after connecting.
+const tlsHandshakeTimeout = 30 * time.Second
+
+// ListenerTLSConfig enables TLS termination on the Kafka listener, so
+// clients connect with SSL or SASL_SSL instead of plaintext.
+type ListenerTLSConfig struct {
+	// CertFile and KeyFile hold the PEM encoded server certificate chain
+	// and private key.
+	CertFile string
+	KeyFile  string
+
+	// ClientAuth is the client certificate policy. The zero value does not
+	// ask clients for a certificate.
+	ClientAuth tls.ClientAuthType
+
+	// ClientCAFile holds the PEM encoded CAs client certificates are
+	// verified against. Required when ClientAuth verifies certificates.
+	ClientCAFile string
+
+	// ClientCertRules enables mTLS authentication: a client presenting a
+	// verified certificate is mapped to a virtual cluster by these rules
+	// instead of authenticating with SASL. Requires ClientAuth to verify
+	// certificates.
+	ClientCertRules []auth.ClientCertRule
+}
+
+// clientAuthTypes maps the client-auth modes accepted by
+// ParseClientAuthType to their tls.ClientAuthType.
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"none":               tls.NoClientCert,
+	"request":            tls.RequestClientCert,
+	"require":            tls.RequireAnyClientCert,
+	"verify-if-given":    tls.VerifyClientCertIfGiven,
+	"require-and-verify": tls.RequireAndVerifyClientCert,
+}
+
+// ParseClientAuthType parses a client-auth mode: "none", "request",
+// "require", "verify-if-given" or "require-and-verify". An empty mode is
+// "none".
+func ParseClientAuthType(mode string) (tls.ClientAuthType, error) {
+	if mode == "" {
+		return tls.NoClientCert, nil
+	}
+	clientAuth, ok := clientAuthTypes[mode]
+	if !ok {
+		return tls.NoClientCert, fmt.Errorf("unknown TLS client auth mode %q", mode)
+	}
+	return clientAuth, nil
+}
+
+// serverConfig loads the certificates and returns the tls.Config the
+// listener terminates TLS with.
+func (c *ListenerTLSConfig) serverConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   c.ClientAuth,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", c.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+	} else if c.verifiesClientCerts() {
+		return nil, fmt.Errorf("client CA file is required for client auth mode %s", c.ClientAuth)
+	}
+	if len(c.ClientCertRules) > 0 && !c.verifiesClientCerts() {
+		return nil, fmt.Errorf("client certificate rules require a verifying client auth mode, got %s", c.ClientAuth)
+	}
+
+	return tlsConfig, nil
+}
+
+func (c *ListenerTLSConfig) verifiesClientCerts() bool {
+	return c.ClientAuth == tls.VerifyClientCertIfGiven || c.ClientAuth == tls.RequireAndVerifyClientCert
+}
+
+// completeTLSHandshake runs the TLS handshake up front, so a failed
+// handshake is reported as such rather than as a failed SASL exchange.
+func completeTLSHandshake(conn *tls.Conn) error {
+	ctx, cancel := context.WithTimeout(context.Background(), tlsHandshakeTimeout)
+	defer cancel()
+	return conn.HandshakeContext(ctx)
+}
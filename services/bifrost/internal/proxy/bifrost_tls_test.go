@@ -0,0 +1,250 @@
+// services/bifrost/internal/proxy/bifrost_tls_test.go
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+)
+
+// metadataBroker is a fake broker answering Metadata with a single broker.
+func metadataBroker(t *testing.T) string {
+	t.Helper()
+	return fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 3 {
+			t.Errorf("unexpected api key %d", apiKey)
+			return nil
+		}
+		resp := kmsg.NewPtrMetadataResponse()
+		resp.Version = int16(binary.BigEndian.Uint16(req[2:4]))
+		broker := kmsg.NewMetadataResponseBroker()
+		broker.Host = "127.0.0.1"
+		broker.Port = 9092
+		resp.Brokers = append(resp.Brokers, broker)
+		var body []byte
+		if resp.IsFlexible() {
+			// Empty response header tagged fields
+			body = append(body, 0)
+		}
+		return resp.AppendTo(body)
+	})
+}
+
+func clientTLSConfig(t *testing.T, bundle *CertsBundle) *tls.Config {
+	t.Helper()
+	caPEM, err := os.ReadFile(bundle.CACert.Name())
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(caPEM))
+	return &tls.Config{RootCAs: pool, ServerName: "localhost"}
+}
+
+func TestBifrostProxy_SASLSSLClientAuthenticatesThroughTLS(t *testing.T) {
+	bundle := NewCertsBundle()
+	defer bundle.Close()
+
+	p, _, _ := newConfiguredTestProxy(t, metadataBroker(t), func(p *BifrostProxy) {
+		p.tlsConfig = &ListenerTLSConfig{
+			CertFile: bundle.ServerCert.Name(),
+			KeyFile:  bundle.ServerKey.Name(),
+		}
+	})
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(p.listener.Addr().String()),
+		kgo.DialTLSConfig(clientTLSConfig(t, bundle)),
+		kgo.SASL(plain.Auth{User: "alice", Pass: "secret"}.AsMechanism()),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Ping(ctx))
+}
+
+func TestBifrostProxy_TLSListenerRejectsPlaintextClient(t *testing.T) {
+	bundle := NewCertsBundle()
+	defer bundle.Close()
+
+	brokerAddr := fakeBroker(t, func(int16, []byte) []byte {
+		t.Error("plaintext client reached the broker")
+		return nil
+	})
+	p, _, _ := newConfiguredTestProxy(t, brokerAddr, func(p *BifrostProxy) {
+		p.tlsConfig = &ListenerTLSConfig{
+			CertFile: bundle.ServerCert.Name(),
+			KeyFile:  bundle.ServerKey.Name(),
+		}
+	})
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	// A Kafka request is not a TLS ClientHello, so the proxy hangs up
+	require.NoError(t, writeFrame(conn, []byte{0, 18, 0, 0, 0, 0, 0, 1, 0, 0}))
+	_, err = readFrame(conn)
+	assert.Error(t, err)
+}
+
+func TestBifrostProxy_StartFailsOnInvalidTLSConfig(t *testing.T) {
+	bundle := NewCertsBundle()
+	defer bundle.Close()
+
+	tests := map[string]*ListenerTLSConfig{
+		"missing certificate": {
+			CertFile: bundle.ServerCert.Name() + "-missing",
+			KeyFile:  bundle.ServerKey.Name(),
+		},
+		"verification without client CA": {
+			CertFile:   bundle.ServerCert.Name(),
+			KeyFile:    bundle.ServerKey.Name(),
+			ClientAuth: tls.RequireAndVerifyClientCert,
+		},
+	}
+
+	for name, tlsConfig := range tests {
+		t.Run(name, func(t *testing.T) {
+			vcStore := config.NewVirtualClusterStore()
+			saslHandler := auth.NewSASLHandler(auth.NewCredentialStore(), vcStore)
+			p := NewBifrostProxy("127.0.0.1:0", saslHandler, vcStore, metrics.NewCollector(), tlsConfig)
+
+			err := p.Start()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "failed to configure listener TLS")
+			assert.Nil(t, p.listener)
+		})
+	}
+}
+
+func TestParseClientAuthType(t *testing.T) {
+	tests := map[string]tls.ClientAuthType{
+		"":                   tls.NoClientCert,
+		"none":               tls.NoClientCert,
+		"request":            tls.RequestClientCert,
+		"require":            tls.RequireAnyClientCert,
+		"verify-if-given":    tls.VerifyClientCertIfGiven,
+		"require-and-verify": tls.RequireAndVerifyClientCert,
+	}
+	for mode, expected := range tests {
+		clientAuth, err := ParseClientAuthType(mode)
+		require.NoError(t, err, mode)
+		assert.Equal(t, expected, clientAuth, mode)
+	}
+
+	_, err := ParseClientAuthType("optional")
+	assert.Error(t, err)
+}
+
+// newMTLSTestProxy starts a proxy verifying client certificates against the
+// bundle CA and mapping them with rules.
+func newMTLSTestProxy(t *testing.T, bundle *CertsBundle, brokerAddr string, rules []auth.ClientCertRule) *BifrostProxy {
+	t.Helper()
+	p, _, _ := newConfiguredTestProxy(t, brokerAddr, func(p *BifrostProxy) {
+		p.tlsConfig = &ListenerTLSConfig{
+			CertFile:        bundle.ServerCert.Name(),
+			KeyFile:         bundle.ServerKey.Name(),
+			ClientAuth:      tls.VerifyClientCertIfGiven,
+			ClientCAFile:    bundle.CACert.Name(),
+			ClientCertRules: rules,
+		}
+	})
+	return p
+}
+
+func clientCertTLSConfig(t *testing.T, bundle *CertsBundle) *tls.Config {
+	t.Helper()
+	cert, err := tls.LoadX509KeyPair(bundle.ClientCert.Name(), bundle.ClientKey.Name())
+	require.NoError(t, err)
+	tlsConfig := clientTLSConfig(t, bundle)
+	tlsConfig.Certificates = []tls.Certificate{cert}
+	return tlsConfig
+}
+
+func TestBifrostProxy_ClientCertificateMapsToVirtualCluster(t *testing.T) {
+	bundle := NewCertsBundleWithSubject(pkix.Name{CommonName: "orders-service", Organization: []string{"Acme"}})
+	defer bundle.Close()
+
+	p := newMTLSTestProxy(t, bundle, metadataBroker(t), []auth.ClientCertRule{{
+		SubjectPattern:     "CN=orders-service,O=Acme",
+		VirtualClusterID:   "vc-1",
+		PermissionTemplate: "producer",
+	}})
+
+	// No SASL: the certificate alone authenticates the client
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(p.listener.Addr().String()),
+		kgo.DialTLSConfig(clientCertTLSConfig(t, bundle)),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Ping(ctx))
+}
+
+func TestBifrostProxy_RejectsUnmappedClientCertificate(t *testing.T) {
+	bundle := NewCertsBundleWithSubject(pkix.Name{CommonName: "billing-service", Organization: []string{"Acme"}})
+	defer bundle.Close()
+
+	brokerAddr := fakeBroker(t, func(int16, []byte) []byte {
+		t.Error("unmapped client reached the broker")
+		return nil
+	})
+	p := newMTLSTestProxy(t, bundle, brokerAddr, []auth.ClientCertRule{{
+		SubjectPattern:     "CN=orders-service,O=Acme",
+		VirtualClusterID:   "vc-1",
+		PermissionTemplate: "producer",
+	}})
+
+	conn, err := tls.Dial("tcp", p.listener.Addr().String(), clientCertTLSConfig(t, bundle))
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	// The proxy hangs up rather than falling back to SASL
+	require.NoError(t, writeFrame(conn, []byte{0, 18, 0, 0, 0, 0, 0, 1, 0, 0}))
+	_, err = readFrame(conn)
+	assert.Error(t, err)
+}
+
+func TestBifrostProxy_ClientCertRulesRequireVerification(t *testing.T) {
+	bundle := NewCertsBundle()
+	defer bundle.Close()
+
+	vcStore := config.NewVirtualClusterStore()
+	saslHandler := auth.NewSASLHandler(auth.NewCredentialStore(), vcStore)
+	p := NewBifrostProxy("127.0.0.1:0", saslHandler, vcStore, metrics.NewCollector(), &ListenerTLSConfig{
+		CertFile:   bundle.ServerCert.Name(),
+		KeyFile:    bundle.ServerKey.Name(),
+		ClientAuth: tls.RequireAnyClientCert,
+		ClientCertRules: []auth.ClientCertRule{{
+			SubjectPattern:     ".*",
+			VirtualClusterID:   "vc-1",
+			PermissionTemplate: "admin",
+		}},
+	})
+
+	err := p.Start()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "verifying client auth mode")
+}
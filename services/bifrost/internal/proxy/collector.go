@@ -31,6 +31,11 @@ var (
 		[]string{"broker"}, nil,
 	)
 
+	proxyUnknownVersionRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{Name: "proxy_unknown_version_requests_total",
+			Help: "Total number of requests of a version without a schema"},
+		[]string{"broker", "api_key", "api_version"})
+
 	proxyLocalAuthTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{Name: "proxy_local_auth_total",
 			Help: "Total number of local auth requests sent"},
@@ -42,6 +47,7 @@ func init() {
 	prometheus.MustRegister(proxyRequestsTotal)
 	prometheus.MustRegister(proxyRequestsBytes)
 	prometheus.MustRegister(proxyResponsesBytes)
+	prometheus.MustRegister(proxyUnknownVersionRequestsTotal)
 	prometheus.MustRegister(proxyLocalAuthTotal)
 }
 
@@ -0,0 +1,65 @@
+// services/bifrost/internal/proxy/connection_timeouts.go
+package proxy
+
+import (
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+var (
+	errConnectionIdle        = errors.New("connection idle timeout elapsed")
+	errConnectionMaxLifetime = errors.New("connection maximum lifetime elapsed")
+)
+
+// pendingResponsePollInterval is how often awaitRequest rechecks the idle
+// timeout and maximum lifetime while broker responses are still outstanding.
+const pendingResponsePollInterval = 100 * time.Millisecond
+
+// awaitRequest reads the size, api key and version of the next client request
+// into buf. Once the idle timeout or the maximum lifetime elapses while no
+// broker response is outstanding, it returns errConnectionIdle or
+// errConnectionMaxLifetime instead, so the connection is closed between
+// requests rather than in the middle of one.
+func (ctx *RequestsLoopContext) awaitRequest(src DeadlineReader, buf []byte) error {
+	if ctx.idleTimeout <= 0 && ctx.closeAt.IsZero() {
+		_, err := io.ReadFull(src, buf)
+		return err
+	}
+
+	idleSince := time.Now()
+	for {
+		now := time.Now()
+		var deadline time.Time
+		if len(ctx.openRequestsChannel) > 0 {
+			// a response is still on its way, so the connection is not idle
+			idleSince = now
+			deadline = now.Add(pendingResponsePollInterval)
+		} else {
+			if !ctx.closeAt.IsZero() && !now.Before(ctx.closeAt) {
+				return errConnectionMaxLifetime
+			}
+			if ctx.idleTimeout > 0 {
+				if now.Sub(idleSince) >= ctx.idleTimeout {
+					return errConnectionIdle
+				}
+				deadline = idleSince.Add(ctx.idleTimeout)
+			}
+			if !ctx.closeAt.IsZero() && (deadline.IsZero() || ctx.closeAt.Before(deadline)) {
+				deadline = ctx.closeAt
+			}
+		}
+
+		if err := src.SetReadDeadline(deadline); err != nil {
+			return err
+		}
+		n, err := io.ReadFull(src, buf)
+		if err == nil {
+			return src.SetReadDeadline(time.Time{})
+		}
+		if n > 0 || !errors.Is(err, os.ErrDeadlineExceeded) {
+			return err
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// services/bifrost/internal/proxy/connection_timeouts_test.go
+package proxy
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+func TestBifrostProxy_IdleConnectionClosed(t *testing.T) {
+	brokerAddr, produced := fakeProduceBroker(t)
+	p, _, _ := newConfiguredTestProxy(t, brokerAddr, func(p *BifrostProxy) {
+		p.SetIdleTimeout(200 * time.Millisecond)
+	})
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Equal(t, protocol.ErrNoError, authResp.Err)
+
+	// Requests arriving within the idle timeout keep the connection open
+	for i := int32(0); i < 4; i++ {
+		time.Sleep(100 * time.Millisecond)
+		_, err = produce(conn, 3+i, "orders")
+		require.NoError(t, err)
+		<-produced
+	}
+
+	start := time.Now()
+	_, err = readFrame(conn)
+	assert.Error(t, err, "idle connection should be closed")
+	assert.Less(t, time.Since(start), time.Second)
+
+	expected := `
+		# HELP bifrost_connections_reaped_total Client connections closed by the proxy for idling or exceeding their maximum lifetime
+		# TYPE bifrost_connections_reaped_total counter
+		bifrost_connections_reaped_total{reason="idle",virtual_cluster="vc-1"} 1
+	`
+	assert.NoError(t, testutil.CollectAndCompare(p.metrics, strings.NewReader(expected), "bifrost_connections_reaped_total"))
+}
+
+func TestBifrostProxy_MaxConnectionLifetimeClosesConnection(t *testing.T) {
+	brokerAddr, produced := fakeProduceBroker(t)
+	p, _, _ := newConfiguredTestProxy(t, brokerAddr, func(p *BifrostProxy) {
+		p.SetMaxConnectionLifetime(300 * time.Millisecond)
+	})
+
+	conn, err := net.Dial("tcp", p.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Equal(t, protocol.ErrNoError, authResp.Err)
+
+	_, err = produce(conn, 3, "orders")
+	require.NoError(t, err)
+	<-produced
+
+	_, err = readFrame(conn)
+	assert.Error(t, err, "connection should be closed once its lifetime elapses")
+
+	expected := `
+		# HELP bifrost_connections_reaped_total Client connections closed by the proxy for idling or exceeding their maximum lifetime
+		# TYPE bifrost_connections_reaped_total counter
+		bifrost_connections_reaped_total{reason="max_lifetime",virtual_cluster="vc-1"} 1
+	`
+	assert.NoError(t, testutil.CollectAndCompare(p.metrics, strings.NewReader(expected), "bifrost_connections_reaped_total"))
+}
+
+func TestAwaitRequest_PendingResponseIsNotIdle(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	openRequests := make(chan protocol.RequestKeyVersion, 1)
+	openRequests <- protocol.RequestKeyVersion{ApiKey: 1}
+	ctx := &RequestsLoopContext{openRequestsChannel: openRequests, idleTimeout: 50 * time.Millisecond}
+
+	go func() {
+		// the broker answers after the idle timeout has passed
+		time.Sleep(200 * time.Millisecond)
+		<-openRequests
+	}()
+
+	start := time.Now()
+	err := ctx.awaitRequest(server, make([]byte, 8))
+	assert.ErrorIs(t, err, errConnectionIdle)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond, "connection must not be closed while a response is outstanding")
+}
+
+func TestAwaitRequest_MaxLifetimeElapsed(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	ctx := &RequestsLoopContext{
+		openRequestsChannel: make(chan protocol.RequestKeyVersion, 1),
+		closeAt:             time.Now().Add(-time.Second),
+	}
+	assert.ErrorIs(t, ctx.awaitRequest(server, make([]byte, 8)), errConnectionMaxLifetime)
+}
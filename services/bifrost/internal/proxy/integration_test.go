@@ -71,7 +71,7 @@ func TestBifrostProxy_FullFlow_Integration(t *testing.T) {
 	saslHandler := auth.NewSASLHandler(credStore, vcStore)
 	collector := metrics.NewCollector()
 
-	proxy := NewBifrostProxy("127.0.0.1:0", saslHandler, vcStore, collector)
+	proxy := NewBifrostProxy("127.0.0.1:0", saslHandler, vcStore, collector, nil)
 	err = proxy.Start()
 	require.NoError(t, err)
 	defer proxy.Stop()
@@ -0,0 +1,93 @@
+// services/bifrost/internal/proxy/permission_template.go
+package proxy
+
+import (
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+const permissionTemplateErrorMessage = "operation is not permitted by the credential's permission template"
+
+// templateCommonApiKeys are allowed for every restricted template: version
+// negotiation, (re)authentication, cluster discovery and client telemetry.
+var templateCommonApiKeys = []int16{
+	3,  // Metadata
+	10, // FindCoordinator
+	17, // SaslHandshake
+	18, // ApiVersions
+	36, // SaslAuthenticate
+	60, // DescribeCluster
+	71, // GetTelemetrySubscriptions
+	72, // PushTelemetry
+}
+
+// templateAllowedApiKeys are the client-facing API keys each restricted
+// permission template may send, in addition to templateCommonApiKeys.
+// Templates missing from the map are unrestricted: ADMIN by definition,
+// UNSPECIFIED so credentials created before templates were enforced keep
+// working, and CUSTOM because its per-resource permissions are not enforced
+// by API key.
+var templateAllowedApiKeys = map[gatewayv1.PermissionTemplate]map[int16]struct{}{
+	gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER: newApiKeySet(
+		0,  // Produce
+		22, // InitProducerId
+		24, // AddPartitionsToTxn
+		25, // AddOffsetsToTxn
+		26, // EndTxn
+		28, // TxnOffsetCommit
+	),
+	gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER: newApiKeySet(
+		1,  // Fetch
+		2,  // ListOffsets
+		8,  // OffsetCommit
+		9,  // OffsetFetch
+		11, // JoinGroup
+		12, // Heartbeat
+		13, // LeaveGroup
+		14, // SyncGroup
+		15, // DescribeGroups
+		16, // ListGroups
+		23, // OffsetForLeaderEpoch
+		68, // ConsumerGroupHeartbeat
+		69, // ConsumerGroupDescribe
+	),
+}
+
+func newApiKeySet(apiKeys ...int16) map[int16]struct{} {
+	set := make(map[int16]struct{}, len(apiKeys)+len(templateCommonApiKeys))
+	for _, apiKey := range templateCommonApiKeys {
+		set[apiKey] = struct{}{}
+	}
+	for _, apiKey := range apiKeys {
+		set[apiKey] = struct{}{}
+	}
+	return set
+}
+
+// templateAllowsApiKey reports whether a connection authenticated with
+// template may send requests with apiKey.
+func templateAllowsApiKey(template gatewayv1.PermissionTemplate, apiKey int16) bool {
+	allowed, restricted := templateAllowedApiKeys[template]
+	if !restricted {
+		return true
+	}
+	_, ok := allowed[apiKey]
+	return ok
+}
+
+// templateDeniedError returns the error code a broker reports when an ACL
+// denies apiKey: topic, group and transactional ID operations fail with
+// their resource's authorization error, everything else with
+// CLUSTER_AUTHORIZATION_FAILED.
+func templateDeniedError(apiKey int16) protocol.KError {
+	switch apiKey {
+	case 0, 1, 2, 19, 20, 21, 23, 37: // Produce, Fetch, ListOffsets, CreateTopics, DeleteTopics, DeleteRecords, OffsetForLeaderEpoch, CreatePartitions
+		return protocol.ErrTopicAuthorizationFailed
+	case 8, 9, 11, 12, 13, 14, 15, 42, 47, 68, 69: // OffsetCommit, OffsetFetch, group membership, DescribeGroups, DeleteGroups, OffsetDelete, ConsumerGroupHeartbeat, ConsumerGroupDescribe
+		return protocol.ErrGroupAuthorizationFailed
+	case 24, 25, 26, 28: // AddPartitionsToTxn, AddOffsetsToTxn, EndTxn, TxnOffsetCommit
+		return protocol.ErrTransactionalIDAuthorizationFailed
+	default:
+		return protocol.ErrClusterAuthorizationFailed
+	}
+}
@@ -0,0 +1,196 @@
+// services/bifrost/internal/proxy/permission_template_test.go
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+func TestTemplateAllowsApiKey(t *testing.T) {
+	const (
+		producer = gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER
+		consumer = gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER
+		admin    = gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_ADMIN
+	)
+
+	// API key -> templates permitted to send it
+	matrix := []struct {
+		name    string
+		apiKey  int16
+		allowed []gatewayv1.PermissionTemplate
+	}{
+		{"Produce", 0, []gatewayv1.PermissionTemplate{producer, admin}},
+		{"Fetch", 1, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"ListOffsets", 2, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"Metadata", 3, []gatewayv1.PermissionTemplate{producer, consumer, admin}},
+		{"OffsetCommit", 8, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"OffsetFetch", 9, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"FindCoordinator", 10, []gatewayv1.PermissionTemplate{producer, consumer, admin}},
+		{"JoinGroup", 11, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"Heartbeat", 12, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"LeaveGroup", 13, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"SyncGroup", 14, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"DescribeGroups", 15, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"ListGroups", 16, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"SaslHandshake", 17, []gatewayv1.PermissionTemplate{producer, consumer, admin}},
+		{"ApiVersions", 18, []gatewayv1.PermissionTemplate{producer, consumer, admin}},
+		{"CreateTopics", 19, []gatewayv1.PermissionTemplate{admin}},
+		{"DeleteTopics", 20, []gatewayv1.PermissionTemplate{admin}},
+		{"DeleteRecords", 21, []gatewayv1.PermissionTemplate{admin}},
+		{"InitProducerId", 22, []gatewayv1.PermissionTemplate{producer, admin}},
+		{"OffsetForLeaderEpoch", 23, []gatewayv1.PermissionTemplate{consumer, admin}},
+		{"AddPartitionsToTxn", 24, []gatewayv1.PermissionTemplate{producer, admin}},
+		{"AddOffsetsToTxn", 25, []gatewayv1.PermissionTemplate{producer, admin}},
+		{"EndTxn", 26, []gatewayv1.PermissionTemplate{producer, admin}},
+		{"TxnOffsetCommit", 28, []gatewayv1.PermissionTemplate{producer, admin}},
+		{"DescribeAcls", 29, []gatewayv1.PermissionTemplate{admin}},
+		{"CreateAcls", 30, []gatewayv1.PermissionTemplate{admin}},
+		{"DeleteAcls", 31, []gatewayv1.PermissionTemplate{admin}},
+		{"DescribeConfigs", 32, []gatewayv1.PermissionTemplate{admin}},
+		{"AlterConfigs", 33, []gatewayv1.PermissionTemplate{admin}},
+		{"SaslAuthenticate", 36, []gatewayv1.PermissionTemplate{producer, consumer, admin}},
+		{"CreatePartitions", 37, []gatewayv1.PermissionTemplate{admin}},
+		{"DeleteGroups", 42, []gatewayv1.PermissionTemplate{admin}},
+		{"IncrementalAlterConfigs", 44, []gatewayv1.PermissionTemplate{admin}},
+		{"OffsetDelete", 47, []gatewayv1.PermissionTemplate{admin}},
+		{"DescribeCluster", 60, []gatewayv1.PermissionTemplate{producer, consumer, admin}},
+		{"ConsumerGroupHeartbeat", 68, []gatewayv1.PermissionTemplate{consumer, admin}},
+	}
+
+	for _, tc := range matrix {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, template := range []gatewayv1.PermissionTemplate{producer, consumer, admin} {
+				assert.Equal(t, templatesContain(tc.allowed, template), templateAllowsApiKey(template, tc.apiKey), template.String())
+			}
+			// Templates without an allow-list are unrestricted
+			assert.True(t, templateAllowsApiKey(gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_UNSPECIFIED, tc.apiKey))
+			assert.True(t, templateAllowsApiKey(gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CUSTOM, tc.apiKey))
+		})
+	}
+}
+
+func templatesContain(templates []gatewayv1.PermissionTemplate, template gatewayv1.PermissionTemplate) bool {
+	for _, t := range templates {
+		if t == template {
+			return true
+		}
+	}
+	return false
+}
+
+func newPermissionTemplateTestContext(template gatewayv1.PermissionTemplate) *RequestsLoopContext {
+	ctx := newReadOnlyTestContext(false)
+	ctx.permissionTemplate = func() gatewayv1.PermissionTemplate { return template }
+	return ctx
+}
+
+func TestHandleRequest_PermissionTemplate(t *testing.T) {
+	const emptyArray = int32(0)
+
+	tt := []struct {
+		name     string
+		template gatewayv1.PermissionTemplate
+		request  []byte
+		// allowed requests are forwarded to the broker; denied ones are
+		// answered locally with errorCode
+		allowed   bool
+		errorCode protocol.KError
+	}{
+		{
+			name:      "consumer Produce",
+			template:  gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER,
+			request:   kafkaRequest(0, 3, int16(-1), int16(-1), int32(30000), int32(1), "orders", int32(1), int32(0), int32(0)),
+			errorCode: protocol.ErrTopicAuthorizationFailed,
+		},
+		{
+			name:      "consumer CreateTopics",
+			template:  gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER,
+			request:   kafkaRequest(19, 1, int32(1), "orders", int32(1), int16(1), emptyArray, emptyArray, int32(30000), false),
+			errorCode: protocol.ErrTopicAuthorizationFailed,
+		},
+		{
+			name:      "consumer InitProducerId",
+			template:  gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER,
+			request:   kafkaRequest(22, 1, int16(-1), int32(60000)),
+			errorCode: protocol.ErrClusterAuthorizationFailed,
+		},
+		{
+			name:     "consumer Fetch",
+			template: gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_CONSUMER,
+			request:  kafkaRequest(1, 4),
+			allowed:  true,
+		},
+		{
+			name:      "producer OffsetCommit",
+			template:  gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER,
+			request:   kafkaRequest(8, 2, "group", int32(1), "member", int64(-1), int32(1), "orders", int32(1), int32(0), int64(5), int16(-1)),
+			errorCode: protocol.ErrGroupAuthorizationFailed,
+		},
+		{
+			name:      "producer DeleteTopics",
+			template:  gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER,
+			request:   kafkaRequest(20, 1, int32(1), "orders", int32(30000)),
+			errorCode: protocol.ErrTopicAuthorizationFailed,
+		},
+		{
+			name:     "producer Produce",
+			template: gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER,
+			request:  kafkaRequest(0, 3, int16(-1), int16(-1), int32(30000), int32(1), "orders", int32(1), int32(0), int32(0)),
+			allowed:  true,
+		},
+		{
+			name:     "admin CreateTopics",
+			template: gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_ADMIN,
+			request:  kafkaRequest(19, 1, emptyArray, int32(30000), false),
+			allowed:  true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newPermissionTemplateTestContext(tc.template)
+			client := &readOnlyTestClient{reader: bytes.NewBuffer(tc.request)}
+			broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+			_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+			require.NoError(t, err)
+
+			if tc.allowed {
+				assert.Equal(t, tc.request, broker.Bytes(), "request should be forwarded unchanged")
+				assert.Zero(t, client.written.Len())
+				return
+			}
+			assert.Zero(t, broker.Len(), "request must not reach the broker")
+			response := client.written.Bytes()
+			require.Greater(t, len(response), 8)
+			assert.Equal(t, uint32(42), binary.BigEndian.Uint32(response[4:8]), "correlation id")
+			assert.True(t, containsErrorCode(response[8:], tc.errorCode), "response should carry error code %d", tc.errorCode)
+			assert.Empty(t, ctx.openRequestsChannel, "no broker response is awaited")
+		})
+	}
+}
+
+func TestHandleRequest_PermissionTemplate_UnanswerableClosesConnection(t *testing.T) {
+	ctx := newPermissionTemplateTestContext(gatewayv1.PermissionTemplate_PERMISSION_TEMPLATE_PRODUCER)
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(kafkaRequest(30, 1, int32(0)))} // CreateAcls
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	assert.Error(t, err)
+	assert.Zero(t, broker.Len())
+	assert.Zero(t, client.written.Len())
+}
+
+// containsErrorCode reports whether body holds kerr as a big-endian int16.
+func containsErrorCode(body []byte, kerr protocol.KError) bool {
+	code := make([]byte, 2)
+	binary.BigEndian.PutUint16(code, uint16(kerr))
+	return bytes.Contains(body, code)
+}
@@ -2,8 +2,11 @@ package proxy
 
 import (
 	"errors"
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
 	"github.com/drewpayment/orbit/services/bifrost/internal/kafkaconfig"
 	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+	"github.com/google/uuid"
+	"sync"
 	"time"
 )
 
@@ -49,6 +52,78 @@ type ProcessorConfig struct {
 
 	// RequestModifierConfig provides request modification options.
 	RequestModifierConfig *protocol.RequestModifierConfig
+
+	// Reauthenticator answers mid-session SASL re-authentication locally and
+	// enforces the session lifetime. Nil forwards SASL requests upstream.
+	Reauthenticator *SaslReauthenticator
+
+	// RequestThrottle delays forwarding of each client request (of the given
+	// size in bytes) until its virtual cluster's rate budget admits it.
+	// Nil disables throttling.
+	RequestThrottle func(requestBytes int)
+
+	// RequestMetrics receives per-request latency and modifier failures.
+	// Nil disables them.
+	RequestMetrics RequestMetrics
+
+	// ReadOnly reports whether the connection's virtual cluster currently
+	// rejects mutating requests. It is checked on every request so toggling
+	// the flag takes effect on open connections. Nil allows all requests.
+	ReadOnly func() bool
+
+	// PermissionTemplate returns the permission template of the connection's
+	// credential. It is checked on every request; API keys the template does
+	// not allow are rejected with the matching authorization error. Nil
+	// allows all requests.
+	PermissionTemplate func() gatewayv1.PermissionTemplate
+
+	// TopicPolicy returns the topic allow/deny list of the connection's
+	// virtual cluster, or nil if it allows every topic. Produce, Fetch and
+	// Metadata requests naming a topic it denies are rejected with
+	// TOPIC_AUTHORIZATION_FAILED. Nil allows all topics.
+	TopicPolicy func() *TopicPolicy
+
+	// TopicIDResolver returns the client-facing name of a topic the client
+	// addresses by ID (Fetch v13+, Metadata v10+), or false if the ID is
+	// unknown or not the tenant's. The topic policy denies IDs it cannot
+	// resolve. Nil resolves no IDs.
+	TopicIDResolver func(topicID uuid.UUID) (string, bool)
+
+	// ProduceQuota accounts the record bytes of a Produce request per
+	// client-facing topic and returns how long the client should back off,
+	// which is reported as the response's throttle_time_ms. Nil disables
+	// produce quotas.
+	ProduceQuota func(topicBytes map[string]int) time.Duration
+
+	// UnknownVersionPolicy handles requests of a version Bifrost has no
+	// schema for. The zero value forwards them unmodified when
+	// RequestModifierConfig is nil and rejects them otherwise.
+	UnknownVersionPolicy UnknownVersionPolicy
+
+	// ValidateSession is called before every client request. A non-nil error,
+	// such as for a revoked credential, closes the connection without
+	// forwarding the request. Nil skips the check.
+	ValidateSession func() error
+
+	// IdleTimeout closes the connection once no request has been read from
+	// the client and no response has been outstanding for this long. Zero
+	// keeps idle connections open.
+	IdleTimeout time.Duration
+
+	// MaxLifetime closes the connection between requests once it has been
+	// open this long, so the client reconnects and picks up fresh
+	// configuration. Zero keeps connections open indefinitely.
+	MaxLifetime time.Duration
+}
+
+// RequestMetrics receives per-request measurements from the processor.
+type RequestMetrics interface {
+	// RecordLatency records the time from reading a client request to
+	// receiving the broker's response to it.
+	RecordLatency(apiKey, apiVersion int16, latency time.Duration)
+	// RecordModifierError records a request or response modifier that failed
+	// to decode, rewrite or encode a message. direction is "request" or "response".
+	RecordModifierError(apiKey int16, direction string)
 }
 
 type processor struct {
@@ -74,6 +149,22 @@ type processor struct {
 	// Extended config for Bifrost
 	responseModifierConfig *protocol.ResponseModifierConfig
 	requestModifierConfig  *protocol.RequestModifierConfig
+	reauthenticator        *SaslReauthenticator
+	requestThrottle        func(requestBytes int)
+	requestMetrics         RequestMetrics
+	readOnly               func() bool
+	permissionTemplate     func() gatewayv1.PermissionTemplate
+	topicPolicy            func() *TopicPolicy
+	topicIDResolver        func(topicID uuid.UUID) (string, bool)
+	produceQuota           func(topicBytes map[string]int) time.Duration
+	unknownVersionPolicy   UnknownVersionPolicy
+	validateSession        func() error
+	idleTimeout            time.Duration
+	maxLifetime            time.Duration
+
+	// clientWriteLock serializes responses written to the client by the
+	// responses loop with responses generated locally by the requests loop.
+	clientWriteLock sync.Locker
 }
 
 func newProcessor(cfg ProcessorConfig, brokerAddress string) *processor {
@@ -97,6 +188,10 @@ func newProcessor(cfg ProcessorConfig, brokerAddress string) *processor {
 	if readTimeout <= 0 {
 		readTimeout = defaultReadTimeout
 	}
+	var clientWriteLock sync.Locker = &sync.Mutex{}
+	if cfg.Reauthenticator != nil {
+		clientWriteLock = &cfg.Reauthenticator.writeMu
+	}
 	nextRequestHandlerChannel := make(chan RequestHandler, 1)
 	nextResponseHandlerChannel := make(chan ResponseHandler, maxOpenRequests+1)
 
@@ -120,6 +215,19 @@ func newProcessor(cfg ProcessorConfig, brokerAddress string) *processor {
 		producerAcks0Disabled:      cfg.ProducerAcks0Disabled,
 		responseModifierConfig:     cfg.ResponseModifierConfig,
 		requestModifierConfig:      cfg.RequestModifierConfig,
+		reauthenticator:            cfg.Reauthenticator,
+		requestThrottle:            cfg.RequestThrottle,
+		requestMetrics:             cfg.RequestMetrics,
+		readOnly:                   cfg.ReadOnly,
+		permissionTemplate:         cfg.PermissionTemplate,
+		topicPolicy:                cfg.TopicPolicy,
+		topicIDResolver:            cfg.TopicIDResolver,
+		produceQuota:               cfg.ProduceQuota,
+		unknownVersionPolicy:       cfg.UnknownVersionPolicy,
+		validateSession:            cfg.ValidateSession,
+		idleTimeout:                cfg.IdleTimeout,
+		maxLifetime:                cfg.MaxLifetime,
+		clientWriteLock:            clientWriteLock,
 	}
 }
 
@@ -146,6 +254,21 @@ func (p *processor) RequestsLoop(dst DeadlineWriter, src DeadlineReaderWriter) (
 		localSaslDone:              false, // sequential processing - mutex is required
 		producerAcks0Disabled:      p.producerAcks0Disabled,
 		requestModifierConfig:      p.requestModifierConfig,
+		reauthenticator:            p.reauthenticator,
+		requestThrottle:            p.requestThrottle,
+		requestMetrics:             p.requestMetrics,
+		readOnly:                   p.readOnly,
+		permissionTemplate:         p.permissionTemplate,
+		topicPolicy:                p.topicPolicy,
+		topicIDResolver:            p.topicIDResolver,
+		produceQuota:               p.produceQuota,
+		unknownVersionPolicy:       p.unknownVersionPolicy,
+		validateSession:            p.validateSession,
+		idleTimeout:                p.idleTimeout,
+		clientWriteLock:            p.clientWriteLock,
+	}
+	if p.maxLifetime > 0 {
+		ctx.closeAt = time.Now().Add(p.maxLifetime)
 	}
 
 	return ctx.requestsLoop(dst, src)
@@ -167,6 +290,35 @@ type RequestsLoopContext struct {
 	producerAcks0Disabled bool
 
 	requestModifierConfig *protocol.RequestModifierConfig
+
+	reauthenticator *SaslReauthenticator
+
+	requestThrottle func(requestBytes int)
+
+	requestMetrics RequestMetrics
+
+	readOnly func() bool
+
+	permissionTemplate func() gatewayv1.PermissionTemplate
+
+	topicPolicy func() *TopicPolicy
+
+	topicIDResolver func(topicID uuid.UUID) (string, bool)
+
+	produceQuota func(topicBytes map[string]int) time.Duration
+
+	unknownVersionPolicy UnknownVersionPolicy
+
+	validateSession func() error
+
+	// idleTimeout and closeAt bound how long the loop waits for the next
+	// request; see awaitRequest. Zero values disable them.
+	idleTimeout time.Duration
+	closeAt     time.Time
+
+	// clientWriteLock, if set, is held while a locally generated response is
+	// written to the client
+	clientWriteLock sync.Locker
 }
 
 // used by local authentication
@@ -237,6 +389,8 @@ func (p *processor) ResponsesLoop(dst DeadlineWriter, src DeadlineReader) (readE
 		brokerAddress:              p.brokerAddress,
 		buf:                        make([]byte, p.responseBufferSize),
 		responseModifierConfig:     p.responseModifierConfig,
+		requestMetrics:             p.requestMetrics,
+		clientWriteLock:            p.clientWriteLock,
 	}
 	return ctx.responsesLoop(dst, src)
 }
@@ -251,6 +405,11 @@ type ResponsesLoopContext struct {
 
 	// Extended config for Bifrost response modification
 	responseModifierConfig *protocol.ResponseModifierConfig
+
+	// clientWriteLock, if set, is held while a response is written to the client
+	clientWriteLock sync.Locker
+
+	requestMetrics RequestMetrics
 }
 
 type ResponseHandler interface {
@@ -30,7 +30,7 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 
 	keyVersionBuf := make([]byte, 8) // Size => int32 + ApiKey => int16 + ApiVersion => int16
 
-	if _, err = io.ReadFull(src, keyVersionBuf); err != nil {
+	if err = ctx.awaitRequest(src, keyVersionBuf); err != nil {
 		return true, err
 	}
 
@@ -38,6 +38,7 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 	if err = protocol.Decode(keyVersionBuf, requestKeyVersion); err != nil {
 		return true, err
 	}
+	requestKeyVersion.ReceivedAt = time.Now()
 	logrus.Debugf("Kafka request key %v, version %v, length %v", requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, requestKeyVersion.Length)
 
 	if requestKeyVersion.ApiKey < minRequestApiKey || requestKeyVersion.ApiKey > maxRequestApiKey {
@@ -51,6 +52,28 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 		return true, fmt.Errorf("api key %d is forbidden", requestKeyVersion.ApiKey)
 	}
 
+	if ctx.reauthenticator != nil {
+		if requestKeyVersion.ApiKey == apiKeySaslHandshake {
+			if err = ctx.reauthenticator.reauthenticate(src, keyVersionBuf, requestKeyVersion.ApiVersion); err != nil {
+				return true, err
+			}
+			if err = src.SetDeadline(time.Time{}); err != nil {
+				return false, err
+			}
+			// handled locally, so no response handler is enqueued
+			return false, ctx.putNextRequestHandler(defaultRequestHandler)
+		}
+		if ctx.reauthenticator.expired() {
+			return true, errSaslSessionExpired
+		}
+	}
+
+	if ctx.validateSession != nil {
+		if err = ctx.validateSession(); err != nil {
+			return true, err
+		}
+	}
+
 	if ctx.localSasl != nil && ctx.localSasl.enabled {
 		if ctx.localSaslDone {
 			if requestKeyVersion.ApiKey == apiKeySaslHandshake {
@@ -85,6 +108,44 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 		}
 	}
 
+	// Requests Bifrost has no schema for cannot be checked or rewritten below
+	unknownVersion := !protocol.HasSchema(requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion)
+	if unknownVersion {
+		var handled bool
+		if handled, readErr, err = handleUnknownVersion(src, requestKeyVersion, ctx); handled || err != nil {
+			return readErr, err
+		}
+	}
+
+	if ctx.readOnly != nil && ctx.readOnly() {
+		if _, ok := readOnlyDeniedApiKeys[requestKeyVersion.ApiKey]; ok {
+			return rejectReadOnlyRequest(src, requestKeyVersion, ctx)
+		}
+	}
+
+	if ctx.permissionTemplate != nil && !templateAllowsApiKey(ctx.permissionTemplate(), requestKeyVersion.ApiKey) {
+		return rejectRequest(src, requestKeyVersion, ctx, templateDeniedError(requestKeyVersion.ApiKey), permissionTemplateErrorMessage)
+	}
+
+	if ctx.topicPolicy != nil && !unknownVersion && protocol.ListsRequestTopics(requestKeyVersion.ApiKey) {
+		if policy := ctx.topicPolicy(); policy != nil {
+			var handled bool
+			if src, handled, readErr, err = enforceTopicPolicy(src, requestKeyVersion, ctx, policy); handled || err != nil {
+				return readErr, err
+			}
+		}
+	}
+
+	if ctx.produceQuota != nil && !unknownVersion && requestKeyVersion.ApiKey == apiKeyProduce {
+		if src, readErr, err = throttleProduce(src, requestKeyVersion, ctx); err != nil {
+			return readErr, err
+		}
+	}
+
+	if ctx.requestThrottle != nil {
+		ctx.requestThrottle(int(requestKeyVersion.Length) + 4)
+	}
+
 	mustReply, readBytes, err := handler.mustReply(requestKeyVersion, src, ctx)
 	if err != nil {
 		return true, err
@@ -109,7 +170,7 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 
 	// Get request modifier if config is available
 	var requestModifier protocol.RequestModifier
-	if ctx.requestModifierConfig != nil {
+	if ctx.requestModifierConfig != nil && !unknownVersion {
 		requestModifier, err = protocol.GetRequestModifier(requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, *ctx.requestModifierConfig)
 		if err != nil {
 			logrus.Warnf("Failed to get request modifier for key=%d version=%d: %v", requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, err)
@@ -127,8 +188,11 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 			return true, protocol.PacketDecodingError{Info: fmt.Sprintf("request of length %d too large", requestKeyVersion.Length)}
 		}
 
-		// Build full request body: readBytes + remaining
-		fullBody := make([]byte, len(readBytes)+remainingLen)
+		// Build full request body: readBytes + remaining. The modified body may
+		// alias it, so it is released only once the request has been written.
+		body := protocol.AcquireBuffer(len(readBytes) + remainingLen)
+		defer body.Release()
+		fullBody := body.B
 		copy(fullBody, readBytes)
 		if _, err = io.ReadFull(src, fullBody[len(readBytes):]); err != nil {
 			return true, err
@@ -138,6 +202,9 @@ func (handler *DefaultRequestHandler) handleRequest(dst DeadlineWriter, src Dead
 		modifiedBody, err := requestModifier.Apply(fullBody)
 		if err != nil {
 			logrus.Warnf("Failed to apply request modifier: %v, forwarding unmodified", err)
+			if ctx.requestMetrics != nil {
+				ctx.requestMetrics.RecordModifierError(requestKeyVersion.ApiKey, "request")
+			}
 			modifiedBody = fullBody
 		}
 
@@ -258,12 +325,22 @@ func (handler *DefaultResponseHandler) handleResponse(dst DeadlineWriter, src De
 		return true, err
 	}
 
+	// The lock is taken before the open request is dequeued so that a locally generated
+	// response never overtakes a broker response that is still being written.
+	if ctx.clientWriteLock != nil {
+		ctx.clientWriteLock.Lock()
+		defer ctx.clientWriteLock.Unlock()
+	}
+
 	// Read the inFlightRequests channel after header is read. Otherwise the channel would block and socket EOF from remote would not be received.
 	requestKeyVersion, err := receiveRequestKeyVersion(ctx.openRequestsChannel, openRequestReceiveTimeout)
 	if err != nil {
 		return true, err
 	}
 	proxyResponsesBytes.WithLabelValues(ctx.brokerAddress).Add(float64(responseHeader.Length + 4))
+	if ctx.requestMetrics != nil && !requestKeyVersion.ReceivedAt.IsZero() {
+		ctx.requestMetrics.RecordLatency(requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, time.Since(requestKeyVersion.ReceivedAt))
+	}
 	logrus.Debugf("Kafka response key %v, version %v, length %v", requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, responseHeader.Length)
 
 	responseDeadline := time.Now().Add(ctx.timeout)
@@ -287,24 +364,40 @@ func (handler *DefaultResponseHandler) handleResponse(dst DeadlineWriter, src De
 
 	// Get response modifier - use extended config if available
 	var responseModifier protocol.ResponseModifier
-	if ctx.responseModifierConfig != nil {
+	switch {
+	case !protocol.HasSchema(requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion):
+		// forwarded under UnknownVersionForward, so passed back unmodified too
+	case ctx.responseModifierConfig != nil:
 		responseModifier, err = protocol.GetResponseModifierWithConfig(requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, *ctx.responseModifierConfig)
-	} else {
+	default:
 		responseModifier, err = protocol.GetResponseModifier(requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, ctx.netAddressMappingFunc)
 	}
 	if err != nil {
 		return true, err
 	}
+	if requestKeyVersion.ThrottleTimeMs > 0 {
+		responseModifier, err = protocol.WithThrottleTime(responseModifier, requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion, requestKeyVersion.ThrottleTimeMs)
+		if err != nil {
+			return true, err
+		}
+	}
 	if responseModifier != nil {
 		if responseHeader.Length > protocol.MaxResponseSize {
 			return true, protocol.PacketDecodingError{Info: fmt.Sprintf("message of length %d too large", responseHeader.Length)}
 		}
-		resp := make([]byte, int(responseHeader.Length-readResponsesHeaderLength))
+		// The modified response may alias resp, so it is released only once the
+		// response has been written
+		respBuf := protocol.AcquireBuffer(int(responseHeader.Length - readResponsesHeaderLength))
+		defer respBuf.Release()
+		resp := respBuf.B
 		if _, err = io.ReadFull(src, resp); err != nil {
 			return true, err
 		}
 		newResponseBuf, err := responseModifier.Apply(resp)
 		if err != nil {
+			if ctx.requestMetrics != nil {
+				ctx.requestMetrics.RecordModifierError(requestKeyVersion.ApiKey, "response")
+			}
 			return true, err
 		}
 		// add 4 bytes (CorrelationId) to the length
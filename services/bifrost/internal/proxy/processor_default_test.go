@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -314,3 +315,69 @@ func (w *TestDeadlineReaderWriter) Read(p []byte) (n int, err error) {
 func (w *TestDeadlineReaderWriter) Write(p []byte) (n int, err error) {
 	return w.reader.Write(p)
 }
+
+// BenchmarkHandleRequest_Produce compares a prefixed VC, a VC without prefixes
+// that still decodes every request (the behaviour before the passthrough
+// fast-path), and the passthrough path taken for VCs without prefixes
+func BenchmarkHandleRequest_Produce(b *testing.B) {
+	// Produce v3, kafka-client 0.11.0.2, acks=1
+	input, err := hex.DecodeString("000000c2000000030000000500144b61666b614578616d706c6550726f6475636572ffff00010000753000000001000f746573742d6e6f2d6865616465727300000001000000000000007b00000000000000000000006fffffffff0231f7fe0e000000000000000001734a66bef6000001734a66bef6ffffffffffffffffffffffffffff000000017a00000010000001734a66be5f2e48656c6c6f204d6f6d203135393436383131313432303702146865616465722d6b6579186865616465722d76616c7565")
+	if err != nil {
+		b.Fatal(err)
+	}
+	mapper := func(host string, port int32, nodeId int32) (string, int32, error) { return host, port, nil }
+
+	prefixed, _ := newModifierConfigs(NewRewriter(&auth.ConnectionContext{
+		TopicPrefix: "tenant-a:",
+		GroupPrefix: "tenant-a:",
+		TxnIDPrefix: "tenant-a:",
+	}), mapper)
+	unprefixed := NewRewriter(&auth.ConnectionContext{})
+	decoded := &protocol.RequestModifierConfig{
+		TopicPrefixer: unprefixed.PrefixTopic,
+		GroupPrefixer: unprefixed.PrefixGroup,
+		TxnIDPrefixer: unprefixed.PrefixTransactionID,
+	}
+	passthrough, _ := newModifierConfigs(unprefixed, mapper)
+
+	for _, bc := range []struct {
+		name string
+		cfg  *protocol.RequestModifierConfig
+	}{
+		{name: "prefixed", cfg: prefixed},
+		{name: "no-prefix-decoded", cfg: decoded},
+		{name: "passthrough", cfg: passthrough},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			openRequests := make(chan protocol.RequestKeyVersion, 1)
+			nextRequestHandlers := make(chan RequestHandler, 1)
+			nextResponseHandlers := make(chan ResponseHandler, 1)
+			ctx := &RequestsLoopContext{
+				openRequestsChannel:        openRequests,
+				nextRequestHandlerChannel:  nextRequestHandlers,
+				nextResponseHandlerChannel: nextResponseHandlers,
+				timeout:                    time.Second,
+				buf:                        make([]byte, defaultRequestBufferSize),
+				localSasl:                  &LocalSasl{},
+				requestModifierConfig:      bc.cfg,
+			}
+			dst := &TestDeadlineWriter{Buffer: bytes.NewBuffer(make([]byte, 0, 2*len(input)))}
+			src := &TestDeadlineReaderWriter{reader: new(bytes.Buffer)}
+
+			b.ReportAllocs()
+			b.SetBytes(int64(len(input)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				src.reader.Reset()
+				src.reader.Write(input)
+				dst.Reset()
+				if _, err := defaultRequestHandler.handleRequest(dst, src, ctx); err != nil {
+					b.Fatal(err)
+				}
+				<-openRequests
+				<-nextRequestHandlers
+				<-nextResponseHandlers
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// services/bifrost/internal/proxy/produce_quota.go
+package proxy
+
+import (
+	"math"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+// maxTopicQuotaBuckets bounds the token buckets a TopicProduceQuotas keeps.
+// Idle buckets are dropped without loss; past the bound, evicting a busy
+// topic's bucket only resets its budget to a full second.
+const maxTopicQuotaBuckets = 65536
+
+// TopicProduceQuotas applies a produce byte budget per (virtual cluster,
+// topic), so one busy topic cannot starve the others of a tenant. Budgets
+// come from VirtualClusterConfig.TopicProduceBytesPerSec, keyed by glob
+// patterns over client-facing topic names. Unlike VirtualClusterRateLimiter,
+// requests over budget are forwarded immediately; the Produce response then
+// carries a throttle_time_ms that well-behaved clients wait out before
+// producing again, as with a broker quota.
+type TopicProduceQuotas struct {
+	vcStore *config.VirtualClusterStore
+
+	mu         sync.Mutex
+	buckets    map[topicQuotaKey]*topicBucket
+	maxBuckets int
+	lastSweep  time.Time
+}
+
+// topicBucket is the token bucket of one topic.
+type topicBucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+type topicQuotaKey struct {
+	vcID  string
+	topic string
+}
+
+// NewTopicProduceQuotas creates produce quotas that read per-topic budgets
+// from vcStore.
+func NewTopicProduceQuotas(vcStore *config.VirtualClusterStore) *TopicProduceQuotas {
+	return &TopicProduceQuotas{
+		vcStore:    vcStore,
+		buckets:    make(map[topicQuotaKey]*topicBucket),
+		maxBuckets: maxTopicQuotaBuckets,
+	}
+}
+
+// ThrottleTime accounts a Produce request carrying topicBytes record bytes per
+// topic against the virtual cluster's topic budgets and returns how long the
+// client should back off. It is zero while every topic is within budget.
+func (q *TopicProduceQuotas) ThrottleTime(vcID string, topicBytes map[string]int) time.Duration {
+	return q.reserve(vcID, topicBytes, time.Now())
+}
+
+func (q *TopicProduceQuotas) reserve(vcID string, topicBytes map[string]int, now time.Time) time.Duration {
+	vc, ok := q.vcStore.Get(vcID)
+	if !ok || len(vc.GetTopicProduceBytesPerSec()) == 0 {
+		return 0
+	}
+
+	var throttle time.Duration
+	for topic, n := range topicBytes {
+		bytesPerSec := topicProduceBytesPerSec(vc, topic)
+		if bytesPerSec <= 0 {
+			continue
+		}
+		bucket := q.bucketFor(topicQuotaKey{vcID: vcID, topic: topic}, bytesPerSec, now)
+		// A batch larger than the burst could never be admitted, so it
+		// consumes a full second of budget instead.
+		if burst := bucket.Burst(); n > burst {
+			n = burst
+		}
+		if d := bucket.ReserveN(now, n).DelayFrom(now); d > throttle {
+			throttle = d
+		}
+	}
+	return throttle
+}
+
+// bucketFor returns the token bucket for a topic, creating it or applying an
+// updated budget as needed. Each bucket holds one second of budget. Idle
+// buckets are dropped along the way, and arbitrary ones once the bound is
+// reached.
+func (q *TopicProduceQuotas) bucketFor(key topicQuotaKey, bytesPerSec int64, now time.Time) *rate.Limiter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if now.Sub(q.lastSweep) >= bucketIdleTimeout {
+		q.lastSweep = now
+		for k, bucket := range q.buckets {
+			if bucketIdle(bucket.limiter, bucket.lastUsed, now) {
+				delete(q.buckets, k)
+			}
+		}
+	}
+
+	bucket, ok := q.buckets[key]
+	if !ok {
+		for k := range q.buckets {
+			if len(q.buckets) < q.maxBuckets {
+				break
+			}
+			delete(q.buckets, k)
+		}
+		bucket = &topicBucket{limiter: newBucket(bytesPerSec)}
+		q.buckets[key] = bucket
+	} else {
+		updateBucket(bucket.limiter, bytesPerSec)
+	}
+	bucket.lastUsed = now
+	return bucket.limiter
+}
+
+// topicProduceBytesPerSec returns the produce budget of topic on vc, or zero
+// if it has none. When several patterns match, the lowest budget applies;
+// malformed patterns match nothing.
+func topicProduceBytesPerSec(vc *gatewayv1.VirtualClusterConfig, topic string) int64 {
+	var budget int64
+	for pattern, bytesPerSec := range vc.GetTopicProduceBytesPerSec() {
+		if bytesPerSec <= 0 {
+			continue
+		}
+		if matched, err := path.Match(pattern, topic); matched && err == nil && (budget == 0 || bytesPerSec < budget) {
+			budget = bytesPerSec
+		}
+	}
+	return budget
+}
+
+// throttleProduce reads a Produce request, accounts its record bytes against
+// the topic produce quotas and records the resulting throttle time on
+// requestKeyVersion for the response. The returned reader replays the request
+// for forwarding.
+func throttleProduce(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext) (next DeadlineReaderWriter, readErr bool, err error) {
+	prefetched, readErr, err := prefetchRequest(src, requestKeyVersion, ctx)
+	if err != nil {
+		return nil, readErr, err
+	}
+
+	topicBytes, err := protocol.ProduceTopicBytes(requestKeyVersion.ApiVersion, prefetched.body)
+	if err != nil {
+		// Produce requests Bifrost cannot decode are not accounted, as for
+		// the topic policy; the broker rejects malformed requests itself
+		logrus.Warnf("Failed to read topics of produce request version %d: %v", requestKeyVersion.ApiVersion, err)
+		return prefetched, false, nil
+	}
+	if throttle := ctx.produceQuota(topicBytes); throttle > 0 {
+		requestKeyVersion.ThrottleTimeMs = throttleTimeMs(throttle)
+		logrus.Debugf("Produce request over topic quota, throttling client for %dms", requestKeyVersion.ThrottleTimeMs)
+	}
+	return prefetched, false, nil
+}
+
+// throttleTimeMs converts a throttle delay to whole milliseconds, rounding up
+// so that short delays are not reported as no throttling at all.
+func throttleTimeMs(d time.Duration) int32 {
+	ms := (d + time.Millisecond - 1) / time.Millisecond
+	if ms > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(ms)
+}
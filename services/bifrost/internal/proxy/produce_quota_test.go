@@ -0,0 +1,214 @@
+// services/bifrost/internal/proxy/produce_quota_test.go
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"google.golang.org/protobuf/proto"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+func TestTopicProduceQuotas_ThrottlesOnlyBusyTopic(t *testing.T) {
+	vcStore := newRateLimiterTestStore(&gatewayv1.VirtualClusterConfig{
+		Id:                      "vc-a",
+		TopicProduceBytesPerSec: map[string]int64{"orders": 1000},
+	})
+	quotas := NewTopicProduceQuotas(vcStore)
+	now := time.Now()
+
+	assert.Zero(t, quotas.reserve("vc-a", map[string]int{"orders": 1000}, now))
+	assert.InDelta(t, 0.5, quotas.reserve("vc-a", map[string]int{"orders": 500}, now).Seconds(), 0.01)
+
+	// Topics without a budget and other virtual clusters are not throttled
+	assert.Zero(t, quotas.reserve("vc-a", map[string]int{"payments": 1 << 20}, now))
+	assert.Zero(t, quotas.reserve("vc-b", map[string]int{"orders": 1 << 20}, now))
+
+	// A request is throttled for its most delayed topic, and batches larger
+	// than the burst cost a full second of budget
+	throttle := quotas.reserve("vc-a", map[string]int{"orders": 5000, "payments": 10}, now)
+	assert.InDelta(t, 1.5, throttle.Seconds(), 0.01)
+}
+
+func TestTopicProduceQuotas_AppliesUpdatedBudget(t *testing.T) {
+	vc := &gatewayv1.VirtualClusterConfig{
+		Id:                      "vc-a",
+		TopicProduceBytesPerSec: map[string]int64{"orders": 1000},
+	}
+	vcStore := newRateLimiterTestStore(vc)
+	quotas := NewTopicProduceQuotas(vcStore)
+	now := time.Now()
+
+	assert.Zero(t, quotas.reserve("vc-a", map[string]int{"orders": 1000}, now))
+	assert.NotZero(t, quotas.reserve("vc-a", map[string]int{"orders": 1000}, now))
+
+	// Removing the budget stops throttling immediately
+	vc = proto.Clone(vc).(*gatewayv1.VirtualClusterConfig)
+	vc.TopicProduceBytesPerSec = nil
+	vcStore.Upsert(vc)
+	assert.Zero(t, quotas.reserve("vc-a", map[string]int{"orders": 1 << 20}, now))
+}
+
+func TestTopicProduceQuotas_BoundsBuckets(t *testing.T) {
+	vcStore := newRateLimiterTestStore(&gatewayv1.VirtualClusterConfig{
+		Id:                      "vc-a",
+		TopicProduceBytesPerSec: map[string]int64{"*": 1000},
+	})
+	quotas := NewTopicProduceQuotas(vcStore)
+	now := time.Now()
+
+	quotas.reserve("vc-a", map[string]int{"orders": 1000, "refunds": 1000}, now)
+	// payments runs far over budget, so its bucket is still refilling later
+	for i := 0; i < 1000; i++ {
+		quotas.reserve("vc-a", map[string]int{"payments": 1000}, now)
+	}
+	require.Len(t, quotas.buckets, 3)
+
+	later := now.Add(2 * bucketIdleTimeout)
+	quotas.reserve("vc-a", map[string]int{"orders": 1}, later)
+	assert.Len(t, quotas.buckets, 2)
+	assert.NotContains(t, quotas.buckets, topicQuotaKey{vcID: "vc-a", topic: "refunds"}, "an idle, refilled bucket is dropped")
+	assert.Positive(t, quotas.reserve("vc-a", map[string]int{"payments": 1}, later), "a bucket still paying off its debt is kept")
+
+	// Past the bound, buckets are evicted to make room
+	quotas.maxBuckets = 2
+	quotas.reserve("vc-a", map[string]int{"invoices": 1, "shipments": 1}, later)
+	assert.Len(t, quotas.buckets, 2)
+}
+
+func TestTopicProduceBytesPerSec(t *testing.T) {
+	vc := &gatewayv1.VirtualClusterConfig{
+		TopicProduceBytesPerSec: map[string]int64{
+			"orders-*":  5000,
+			"orders-eu": 2000,
+			"payments":  0,
+			"[":         1,
+		},
+	}
+
+	assert.Equal(t, int64(5000), topicProduceBytesPerSec(vc, "orders-us"))
+	assert.Equal(t, int64(2000), topicProduceBytesPerSec(vc, "orders-eu"), "the lowest matching budget applies")
+	assert.Zero(t, topicProduceBytesPerSec(vc, "payments"), "zero disables the quota")
+	assert.Zero(t, topicProduceBytesPerSec(vc, "audit"))
+}
+
+func TestThrottleTimeMs(t *testing.T) {
+	assert.Equal(t, int32(0), throttleTimeMs(0))
+	assert.Equal(t, int32(1), throttleTimeMs(time.Microsecond))
+	assert.Equal(t, int32(1500), throttleTimeMs(1500*time.Millisecond))
+	assert.Equal(t, int32(1<<31-1), throttleTimeMs(1000*time.Hour))
+}
+
+func TestHandleRequest_ProduceQuota(t *testing.T) {
+	ctx := newReadOnlyTestContext(false)
+	openRequests := make(chan protocol.RequestKeyVersion, 1)
+	ctx.openRequestsChannel = openRequests
+	// The topic policy reads the request first; the quota reuses it
+	ctx.topicPolicy = func() *TopicPolicy {
+		return NewTopicPolicy(&gatewayv1.VirtualClusterConfig{AllowedTopics: []string{"orders"}})
+	}
+	var accounted map[string]int
+	ctx.produceQuota = func(topicBytes map[string]int) time.Duration {
+		accounted = topicBytes
+		return 2 * time.Second
+	}
+	request := kafkaRequest(0, 2, int16(-1), int32(30000), int32(1), "orders", int32(1), int32(3), int32(4), int32(0x01020304))
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, request, broker.Bytes(), "request should be forwarded unchanged")
+	assert.Equal(t, map[string]int{"orders": 4}, accounted)
+	require.Len(t, openRequests, 1)
+	assert.Equal(t, int32(2000), (<-openRequests).ThrottleTimeMs)
+}
+
+func TestBifrostProxy_ProduceOverTopicQuotaIsThrottled(t *testing.T) {
+	brokerAddr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 0 {
+			return nil
+		}
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		produce := kmsg.NewPtrProduceRequest()
+		produce.Version = int16(binary.BigEndian.Uint16(req[2:4]))
+		require.NoError(t, produce.ReadFrom(req[off:]))
+
+		// The broker itself never throttles
+		resp := kmsg.NewPtrProduceResponse()
+		resp.Version = produce.Version
+		for _, topic := range produce.Topics {
+			topicResp := kmsg.NewProduceResponseTopic()
+			topicResp.Topic = topic.Topic
+			for _, partition := range topic.Partitions {
+				partitionResp := kmsg.NewProduceResponseTopicPartition()
+				partitionResp.Partition = partition.Partition
+				topicResp.Partitions = append(topicResp.Partitions, partitionResp)
+			}
+			resp.Topics = append(resp.Topics, topicResp)
+		}
+		return resp.AppendTo(nil)
+	})
+	proxyAddr, vcStore, _ := newBrokerBackedTestProxyWithStores(t, brokerAddr, 0)
+	vc, ok := vcStore.Get("vc-1")
+	require.True(t, ok)
+	vc = proto.Clone(vc).(*gatewayv1.VirtualClusterConfig)
+	vc.TopicProduceBytesPerSec = map[string]int64{"orders": 1000}
+	vcStore.Upsert(vc)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Zero(t, authResp.Err)
+
+	produce := func(correlationID int32, topic string, records int) *kmsg.ProduceResponse {
+		req := kmsg.NewPtrProduceRequest()
+		req.Version = 7
+		req.Acks = -1
+		req.TimeoutMillis = 30000
+		reqTopic := kmsg.NewProduceRequestTopic()
+		reqTopic.Topic = topic
+		partition := kmsg.NewProduceRequestTopicPartition()
+		partition.Records = make([]byte, records)
+		reqTopic.Partitions = append(reqTopic.Partitions, partition)
+		req.Topics = append(req.Topics, reqTopic)
+
+		frame := binary.BigEndian.AppendUint16(nil, uint16(req.Key()))
+		frame = binary.BigEndian.AppendUint16(frame, uint16(req.Version))
+		frame = binary.BigEndian.AppendUint32(frame, uint32(correlationID))
+		frame = appendString(frame, "test-client")
+		require.NoError(t, writeFrame(conn, req.AppendTo(frame)))
+
+		respFrame, err := readFrame(conn)
+		require.NoError(t, err)
+		require.Equal(t, uint32(correlationID), binary.BigEndian.Uint32(respFrame[:4]))
+		resp := kmsg.NewPtrProduceResponse()
+		resp.Version = req.Version
+		require.NoError(t, resp.ReadFrom(respFrame[4:]))
+		require.Len(t, resp.Topics, 1)
+		assert.Equal(t, topic, resp.Topics[0].Topic)
+		return resp
+	}
+
+	// The first second of budget is available immediately
+	assert.Zero(t, produce(3, "orders", 1000).ThrottleMillis)
+
+	// Exceeding it asks the client to back off for the overdraft
+	throttled := produce(4, "orders", 1000).ThrottleMillis
+	assert.InDelta(t, 1000, throttled, 100)
+
+	// Other topics of the tenant are unaffected
+	assert.Zero(t, produce(5, "payments", 1<<16).ThrottleMillis)
+}
@@ -0,0 +1,624 @@
+package protocol
+
+import (
+	"fmt"
+)
+
+// ACL resource types. Cluster, delegation token and user resources are not
+// tenant-scoped and are left untouched.
+const (
+	aclResourceTypeUnknown         = int8(0)
+	aclResourceTypeAny             = int8(1)
+	aclResourceTypeTopic           = int8(2)
+	aclResourceTypeGroup           = int8(3)
+	aclResourceTypeTransactionalID = int8(5)
+)
+
+// ACL pattern types
+const (
+	aclPatternTypeAny      = int8(1)
+	aclPatternTypeMatch    = int8(2)
+	aclPatternTypeLiteral  = int8(3)
+	aclPatternTypePrefixed = int8(4)
+)
+
+// aclWildcard is the LITERAL resource name matching every resource of a type.
+// Within a virtual cluster it is rewritten to a PREFIXED pattern on the
+// tenant prefix, so it matches the tenant's resources only.
+const aclWildcard = "*"
+
+// aclFields names the resource fields of an ACL binding or binding filter.
+type aclFields struct {
+	resourceType string
+	resourceName string
+	patternType  string
+}
+
+var (
+	aclBindingFields = aclFields{"resource_type", "resource_name", "resource_pattern_type"}
+	aclFilterFields  = aclFields{"resource_type_filter", "resource_name_filter", "pattern_type_filter"}
+	aclResultFields  = aclFields{"resource_type", "resource_name", "pattern_type"}
+)
+
+// aclPrefixer returns the prefixer for an ACL resource type, or nil if
+// resources of that type are not rewritten.
+func aclPrefixer(resourceType int8, cfg RequestModifierConfig) func(string) string {
+	var prefixer func(string) string
+	switch resourceType {
+	case aclResourceTypeTopic:
+		prefixer = cfg.TopicPrefixer
+	case aclResourceTypeGroup:
+		prefixer = cfg.GroupPrefixer
+	case aclResourceTypeTransactionalID:
+		prefixer = cfg.TxnIDPrefixer
+	}
+	// An empty prefix leaves names, including the wildcard, unchanged
+	if prefixer == nil || prefixer("") == "" {
+		return nil
+	}
+	return prefixer
+}
+
+// prefixAclBinding prefixes the resource name of a CreateAcls creation. A
+// LITERAL wildcard becomes a PREFIXED pattern on the tenant prefix; v0 has no
+// pattern type, so its wildcard is prefixed like any other name and matches
+// nothing.
+func prefixAclBinding(binding *Struct, cfg RequestModifierConfig) error {
+	resourceType, _ := binding.Get(aclBindingFields.resourceType).(int8)
+	prefixer := aclPrefixer(resourceType, cfg)
+	if prefixer == nil {
+		return nil
+	}
+	name, ok := binding.Get(aclBindingFields.resourceName).(string)
+	if !ok {
+		return nil
+	}
+
+	if patternType, ok := binding.Get(aclBindingFields.patternType).(int8); ok && patternType == aclPatternTypeLiteral && name == aclWildcard {
+		if err := binding.Replace(aclBindingFields.patternType, aclPatternTypePrefixed); err != nil {
+			return err
+		}
+		return binding.Replace(aclBindingFields.resourceName, prefixer(""))
+	}
+	return binding.Replace(aclBindingFields.resourceName, prefixer(name))
+}
+
+// prefixAclFilter prefixes the resource name of a DescribeAcls or DeleteAcls
+// filter. Filters without a name, on every resource type or matching by
+// MATCH can select ACLs of other tenants, which no filter can exclude. When
+// deleting, such filters are given the UNKNOWN resource type so the broker
+// rejects them; when describing, the response is filtered instead.
+func prefixAclFilter(filter *Struct, cfg RequestModifierConfig, deleting bool) error {
+	resourceType, _ := filter.Get(aclFilterFields.resourceType).(int8)
+	name, _ := filter.Get(aclFilterFields.resourceName).(*string)
+	patternType, hasPatternType := filter.Get(aclFilterFields.patternType).(int8)
+
+	prefixer := aclPrefixer(resourceType, cfg)
+	if deleting && isTenantScoped(cfg) {
+		unscoped := resourceType == aclResourceTypeAny ||
+			(prefixer != nil && (name == nil || patternType == aclPatternTypeMatch))
+		if unscoped {
+			return filter.Replace(aclFilterFields.resourceType, aclResourceTypeUnknown)
+		}
+	}
+	if prefixer == nil || name == nil {
+		return nil
+	}
+
+	if hasPatternType && *name == aclWildcard && patternType != aclPatternTypePrefixed {
+		// ANY and MATCH on the tenant prefix select the rewritten wildcard
+		if patternType == aclPatternTypeLiteral {
+			if err := filter.Replace(aclFilterFields.patternType, aclPatternTypePrefixed); err != nil {
+				return err
+			}
+		}
+		prefixed := prefixer("")
+		return filter.Replace(aclFilterFields.resourceName, &prefixed)
+	}
+	prefixed := prefixer(*name)
+	return filter.Replace(aclFilterFields.resourceName, &prefixed)
+}
+
+// isTenantScoped reports whether cfg rewrites names of any ACL resource type.
+func isTenantScoped(cfg RequestModifierConfig) bool {
+	for _, resourceType := range []int8{aclResourceTypeTopic, aclResourceTypeGroup, aclResourceTypeTransactionalID} {
+		if aclPrefixer(resourceType, cfg) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// aclRequestModifier prefixes resource names in CreateAcls, DescribeAcls and
+// DeleteAcls requests
+type aclRequestModifier struct {
+	apiKey int16
+	schema Schema
+	cfg    RequestModifierConfig
+}
+
+func (m *aclRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode acl request key %d: %w", m.apiKey, err)
+	}
+
+	if err := m.modify(decoded); err != nil {
+		return nil, fmt.Errorf("modify acl request key %d: %w", m.apiKey, err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func (m *aclRequestModifier) modify(decoded *Struct) error {
+	switch m.apiKey {
+	case apiKeyDescribeAcls:
+		// The filter fields are at the top level of DescribeAcls
+		return prefixAclFilter(decoded, m.cfg, false)
+	case apiKeyCreateAcls:
+		creations, _ := decoded.Get("creations").([]interface{})
+		for _, element := range creations {
+			if creation, ok := element.(*Struct); ok {
+				if err := prefixAclBinding(creation, m.cfg); err != nil {
+					return err
+				}
+			}
+		}
+	case apiKeyDeleteAcls:
+		filters, _ := decoded.Get("filters").([]interface{})
+		for _, element := range filters {
+			if filter, ok := element.(*Struct); ok {
+				if err := prefixAclFilter(filter, m.cfg, true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func newAclRequestModifier(apiKey, apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if !isTenantScoped(cfg) {
+		return nil, nil
+	}
+	var schemas []Schema
+	switch apiKey {
+	case apiKeyDescribeAcls:
+		schemas = describeAclsRequestSchemas
+	case apiKeyCreateAcls:
+		schemas = createAclsRequestSchemas
+	case apiKeyDeleteAcls:
+		schemas = deleteAclsRequestSchemas
+	}
+	if apiVersion < 0 || int(apiVersion) >= len(schemas) {
+		return nil, fmt.Errorf("unsupported acl request key %d version %d", apiKey, apiVersion)
+	}
+	return &aclRequestModifier{apiKey: apiKey, schema: schemas[apiVersion], cfg: cfg}, nil
+}
+
+// aclUnprefixer returns the unprefixer and tenant filter for an ACL resource
+// type. A nil unprefixer means resources of that type are not rewritten.
+func aclUnprefixer(resourceType int8, cfg ResponseModifierConfig) (func(string) string, func(string) bool) {
+	switch resourceType {
+	case aclResourceTypeTopic:
+		if cfg.TopicUnprefixer != nil {
+			return cfg.TopicUnprefixer, cfg.TopicFilter
+		}
+	case aclResourceTypeGroup:
+		if cfg.GroupUnprefixer != nil {
+			return cfg.GroupUnprefixer, cfg.GroupFilter
+		}
+	case aclResourceTypeTransactionalID:
+		if cfg.TxnIDUnprefixer != nil {
+			return cfg.TxnIDUnprefixer, cfg.TxnIDFilter
+		}
+	}
+	return nil, nil
+}
+
+// unprefixAclResources unprefixes the resource names of the ACL results in
+// the named array and drops results outside the tenant's namespace. A
+// PREFIXED pattern on the bare tenant prefix is reported as the LITERAL
+// wildcard it was created from.
+func unprefixAclResources(decoded *Struct, arrayName string, cfg ResponseModifierConfig) error {
+	resources, ok := decoded.Get(arrayName).([]interface{})
+	if !ok {
+		return nil
+	}
+
+	kept := make([]interface{}, 0, len(resources))
+	for _, element := range resources {
+		resource, ok := element.(*Struct)
+		if !ok {
+			continue
+		}
+		resourceType, _ := resource.Get(aclResultFields.resourceType).(int8)
+		unprefixer, filter := aclUnprefixer(resourceType, cfg)
+		name, ok := resource.Get(aclResultFields.resourceName).(string)
+		if unprefixer == nil || !ok {
+			kept = append(kept, element)
+			continue
+		}
+		if filter != nil && !filter(name) {
+			continue
+		}
+
+		unprefixed := unprefixer(name)
+		if patternType, ok := resource.Get(aclResultFields.patternType).(int8); ok && patternType == aclPatternTypePrefixed && unprefixed == "" && name != "" {
+			if err := resource.Replace(aclResultFields.patternType, aclPatternTypeLiteral); err != nil {
+				return err
+			}
+			unprefixed = aclWildcard
+		}
+		if unprefixed != name {
+			if err := resource.Replace(aclResultFields.resourceName, unprefixed); err != nil {
+				return err
+			}
+		}
+		kept = append(kept, element)
+	}
+	return decoded.Replace(arrayName, kept)
+}
+
+// modifyDescribeAclsResponse unprefixes and filters resources in DescribeAcls responses.
+func modifyDescribeAclsResponse(decoded *Struct, cfg ResponseModifierConfig) error {
+	return unprefixAclResources(decoded, "resources", cfg)
+}
+
+// modifyDeleteAclsResponse unprefixes and filters the deleted ACLs of each
+// filter result in DeleteAcls responses.
+func modifyDeleteAclsResponse(decoded *Struct, cfg ResponseModifierConfig) error {
+	results, _ := decoded.Get("filter_results").([]interface{})
+	for _, element := range results {
+		if result, ok := element.(*Struct); ok {
+			if err := unprefixAclResources(result, "matching_acls", cfg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newAclResponseModifier(apiKey, apiVersion int16, cfg ResponseModifierConfig) (ResponseModifier, error) {
+	if cfg.TopicUnprefixer == nil && cfg.GroupUnprefixer == nil && cfg.TxnIDUnprefixer == nil {
+		return nil, nil
+	}
+	switch apiKey {
+	case apiKeyDescribeAcls:
+		return newResponseModifier(apiKey, apiVersion, cfg, describeAclsResponseSchemaVersions, modifyDescribeAclsResponse)
+	case apiKeyDeleteAcls:
+		return newResponseModifier(apiKey, apiVersion, cfg, deleteAclsResponseSchemaVersions, modifyDeleteAclsResponse)
+	default:
+		// CreateAcls responses carry no resource names
+		return nil, nil
+	}
+}
+
+var (
+	describeAclsRequestSchemas         = createDescribeAclsRequestSchemas()
+	createAclsRequestSchemas           = createCreateAclsRequestSchemas()
+	deleteAclsRequestSchemas           = createDeleteAclsRequestSchemas()
+	describeAclsResponseSchemaVersions = createDescribeAclsResponseSchemaVersions()
+	deleteAclsResponseSchemaVersions   = createDeleteAclsResponseSchemaVersions()
+)
+
+func createDescribeAclsRequestSchemas() []Schema {
+	describeAclsV0 := NewSchema("describe_acls_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "resource_name_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "principal_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "host_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	// v1 adds pattern_type_filter
+	describeAclsV1 := NewSchema("describe_acls_request_v1",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "resource_name_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "pattern_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "principal_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "host_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	// v2+ flexible; v3 only adds the USER resource type
+	describeAclsV2 := NewSchema("describe_acls_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "resource_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "resource_name_filter", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "pattern_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "principal_filter", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "host_filter", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		describeAclsV0, // v0
+		describeAclsV1, // v1
+		describeAclsV2, // v2
+		describeAclsV2, // v3
+	}
+}
+
+func createCreateAclsRequestSchemas() []Schema {
+	creationV0 := NewSchema("create_acls_creation_v0",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Mfield{Name: "principal", Ty: TypeStr},
+		&Mfield{Name: "host", Ty: TypeStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	createAclsV0 := NewSchema("create_acls_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "creations", Ty: creationV0},
+	)
+
+	// v1 adds resource_pattern_type
+	creationV1 := NewSchema("create_acls_creation_v1",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Mfield{Name: "resource_pattern_type", Ty: TypeInt8},
+		&Mfield{Name: "principal", Ty: TypeStr},
+		&Mfield{Name: "host", Ty: TypeStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	createAclsV1 := NewSchema("create_acls_request_v1",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "creations", Ty: creationV1},
+	)
+
+	// v2+ flexible
+	creationV2 := NewSchema("create_acls_creation_v2",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&Mfield{Name: "resource_pattern_type", Ty: TypeInt8},
+		&Mfield{Name: "principal", Ty: TypeCompactStr},
+		&Mfield{Name: "host", Ty: TypeCompactStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+		&SchemaTaggedFields{Name: "creation_tagged_fields"},
+	)
+
+	createAclsV2 := NewSchema("create_acls_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "creations", Ty: creationV2},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		createAclsV0, // v0
+		createAclsV1, // v1
+		createAclsV2, // v2
+		createAclsV2, // v3
+	}
+}
+
+func createDeleteAclsRequestSchemas() []Schema {
+	filterV0 := NewSchema("delete_acls_filter_v0",
+		&Mfield{Name: "resource_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "resource_name_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "principal_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "host_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	deleteAclsV0 := NewSchema("delete_acls_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "filters", Ty: filterV0},
+	)
+
+	// v1 adds pattern_type_filter
+	filterV1 := NewSchema("delete_acls_filter_v1",
+		&Mfield{Name: "resource_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "resource_name_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "pattern_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "principal_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "host_filter", Ty: TypeNullableStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	deleteAclsV1 := NewSchema("delete_acls_request_v1",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "filters", Ty: filterV1},
+	)
+
+	// v2+ flexible
+	filterV2 := NewSchema("delete_acls_filter_v2",
+		&Mfield{Name: "resource_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "resource_name_filter", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "pattern_type_filter", Ty: TypeInt8},
+		&Mfield{Name: "principal_filter", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "host_filter", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+		&SchemaTaggedFields{Name: "filter_tagged_fields"},
+	)
+
+	deleteAclsV2 := NewSchema("delete_acls_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "filters", Ty: filterV2},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteAclsV0, // v0
+		deleteAclsV1, // v1
+		deleteAclsV2, // v2
+		deleteAclsV2, // v3
+	}
+}
+
+func createDescribeAclsResponseSchemaVersions() []Schema {
+	aclV0 := NewSchema("describe_acls_acl_v0",
+		&Mfield{Name: "principal", Ty: TypeStr},
+		&Mfield{Name: "host", Ty: TypeStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	resourceV0 := NewSchema("describe_acls_resource_v0",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Array{Name: "acls", Ty: aclV0},
+	)
+
+	describeAclsV0 := NewSchema("describe_acls_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Array{Name: "resources", Ty: resourceV0},
+	)
+
+	// v1 adds pattern_type
+	resourceV1 := NewSchema("describe_acls_resource_v1",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Mfield{Name: "pattern_type", Ty: TypeInt8},
+		&Array{Name: "acls", Ty: aclV0},
+	)
+
+	describeAclsV1 := NewSchema("describe_acls_response_v1",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Array{Name: "resources", Ty: resourceV1},
+	)
+
+	// v2+ flexible
+	aclV2 := NewSchema("describe_acls_acl_v2",
+		&Mfield{Name: "principal", Ty: TypeCompactStr},
+		&Mfield{Name: "host", Ty: TypeCompactStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+		&SchemaTaggedFields{Name: "acl_tagged_fields"},
+	)
+
+	resourceV2 := NewSchema("describe_acls_resource_v2",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&Mfield{Name: "pattern_type", Ty: TypeInt8},
+		&CompactArray{Name: "acls", Ty: aclV2},
+		&SchemaTaggedFields{Name: "resource_tagged_fields"},
+	)
+
+	describeAclsV2 := NewSchema("describe_acls_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&CompactArray{Name: "resources", Ty: resourceV2},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		describeAclsV0, // v0
+		describeAclsV1, // v1
+		describeAclsV2, // v2
+		describeAclsV2, // v3
+	}
+}
+
+func createDeleteAclsResponseSchemaVersions() []Schema {
+	matchingAclV0 := NewSchema("delete_acls_matching_acl_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Mfield{Name: "principal", Ty: TypeStr},
+		&Mfield{Name: "host", Ty: TypeStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	filterResultV0 := NewSchema("delete_acls_filter_result_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Array{Name: "matching_acls", Ty: matchingAclV0},
+	)
+
+	deleteAclsV0 := NewSchema("delete_acls_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "filter_results", Ty: filterResultV0},
+	)
+
+	// v1 adds pattern_type
+	matchingAclV1 := NewSchema("delete_acls_matching_acl_v1",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Mfield{Name: "pattern_type", Ty: TypeInt8},
+		&Mfield{Name: "principal", Ty: TypeStr},
+		&Mfield{Name: "host", Ty: TypeStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+	)
+
+	filterResultV1 := NewSchema("delete_acls_filter_result_v1",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Array{Name: "matching_acls", Ty: matchingAclV1},
+	)
+
+	deleteAclsV1 := NewSchema("delete_acls_response_v1",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "filter_results", Ty: filterResultV1},
+	)
+
+	// v2+ flexible
+	matchingAclV2 := NewSchema("delete_acls_matching_acl_v2",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&Mfield{Name: "pattern_type", Ty: TypeInt8},
+		&Mfield{Name: "principal", Ty: TypeCompactStr},
+		&Mfield{Name: "host", Ty: TypeCompactStr},
+		&Mfield{Name: "operation", Ty: TypeInt8},
+		&Mfield{Name: "permission_type", Ty: TypeInt8},
+		&SchemaTaggedFields{Name: "matching_acl_tagged_fields"},
+	)
+
+	filterResultV2 := NewSchema("delete_acls_filter_result_v2",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&CompactArray{Name: "matching_acls", Ty: matchingAclV2},
+		&SchemaTaggedFields{Name: "filter_result_tagged_fields"},
+	)
+
+	deleteAclsV2 := NewSchema("delete_acls_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "filter_results", Ty: filterResultV2},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteAclsV0, // v0
+		deleteAclsV1, // v1
+		deleteAclsV2, // v2
+		deleteAclsV2, // v3
+	}
+}
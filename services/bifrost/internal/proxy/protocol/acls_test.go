@@ -0,0 +1,325 @@
+package protocol
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// aclTestRequestConfig prefixes topics, groups and transactional IDs with
+// different prefixes, so tests catch a name rewritten with the wrong one.
+var aclTestRequestConfig = RequestModifierConfig{
+	TopicPrefixer: func(topic string) string { return "t:" + topic },
+	GroupPrefixer: func(group string) string { return "g:" + group },
+	TxnIDPrefixer: func(txnID string) string { return "x:" + txnID },
+}
+
+var aclTestResponseConfig = ResponseModifierConfig{
+	TopicUnprefixer: func(topic string) string { return strings.TrimPrefix(topic, "t:") },
+	TopicFilter:     func(topic string) bool { return strings.HasPrefix(topic, "t:") },
+	GroupUnprefixer: func(group string) string { return strings.TrimPrefix(group, "g:") },
+	GroupFilter:     func(group string) bool { return strings.HasPrefix(group, "g:") },
+	TxnIDUnprefixer: func(txnID string) string { return strings.TrimPrefix(txnID, "x:") },
+	TxnIDFilter:     func(txnID string) bool { return strings.HasPrefix(txnID, "x:") },
+}
+
+// encodeAclTestRequest returns req as a request modifier sees it: the
+// header from the correlation id on, followed by the body.
+func encodeAclTestRequest(req kmsg.Request) []byte {
+	b := []byte{0, 0, 0, 7, 0, 4, 't', 'e', 's', 't'}
+	if req.IsFlexible() {
+		b = append(b, 0) // header tagged fields
+	}
+	return req.AppendTo(b)
+}
+
+// decodeAclTestRequest strips the header encodeAclTestRequest added and
+// decodes the body into req.
+func decodeAclTestRequest(t *testing.T, b []byte, req kmsg.Request) {
+	t.Helper()
+	headerLen := 10
+	if req.IsFlexible() {
+		headerLen++
+	}
+	require.NoError(t, req.ReadFrom(b[headerLen:]))
+}
+
+func stringPtr(s string) *string { return &s }
+
+func TestAclRequestModifier_CreateAcls(t *testing.T) {
+	for version := int16(0); version <= 3; version++ {
+		req := kmsg.NewPtrCreateACLsRequest()
+		req.Version = version
+		for _, c := range []struct {
+			resourceType kmsg.ACLResourceType
+			name         string
+			pattern      kmsg.ACLResourcePatternType
+		}{
+			{kmsg.ACLResourceTypeTopic, "orders", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeTopic, "*", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeGroup, "app-", kmsg.ACLResourcePatternTypePrefixed},
+			{kmsg.ACLResourceTypeTransactionalId, "orders-txn", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeCluster, "kafka-cluster", kmsg.ACLResourcePatternTypeLiteral},
+		} {
+			creation := kmsg.NewCreateACLsRequestCreation()
+			creation.ResourceType = c.resourceType
+			creation.ResourceName = c.name
+			creation.ResourcePatternType = c.pattern
+			creation.Principal = "User:alice"
+			creation.Host = "*"
+			creation.Operation = kmsg.ACLOperationWrite
+			creation.PermissionType = kmsg.ACLPermissionTypeAllow
+			req.Creations = append(req.Creations, creation)
+		}
+
+		mod, err := GetRequestModifier(apiKeyCreateAcls, version, aclTestRequestConfig)
+		require.NoError(t, err)
+		require.NotNil(t, mod)
+		result, err := mod.Apply(encodeAclTestRequest(req))
+		require.NoError(t, err, "version %d", version)
+
+		modified := kmsg.NewPtrCreateACLsRequest()
+		modified.Version = version
+		decodeAclTestRequest(t, result, modified)
+		require.Len(t, modified.Creations, 5)
+		assert.Equal(t, "t:orders", modified.Creations[0].ResourceName, "version %d", version)
+		if version >= 1 {
+			// The wildcard becomes a prefixed pattern on the tenant prefix
+			assert.Equal(t, "t:", modified.Creations[1].ResourceName)
+			assert.Equal(t, kmsg.ACLResourcePatternTypePrefixed, modified.Creations[1].ResourcePatternType)
+			assert.Equal(t, kmsg.ACLResourcePatternTypePrefixed, modified.Creations[2].ResourcePatternType)
+		} else {
+			assert.Equal(t, "t:*", modified.Creations[1].ResourceName)
+		}
+		assert.Equal(t, "g:app-", modified.Creations[2].ResourceName, "version %d", version)
+		assert.Equal(t, "x:orders-txn", modified.Creations[3].ResourceName, "version %d", version)
+		assert.Equal(t, "kafka-cluster", modified.Creations[4].ResourceName, "version %d", version)
+		assert.Equal(t, "User:alice", modified.Creations[0].Principal)
+	}
+
+	_, err := GetRequestModifier(apiKeyCreateAcls, 4, aclTestRequestConfig)
+	assert.Error(t, err)
+}
+
+func TestAclRequestModifier_DescribeAcls(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType kmsg.ACLResourceType
+		filterName   *string
+		pattern      kmsg.ACLResourcePatternType
+		expectedName *string
+		expectedType kmsg.ACLResourcePatternType
+	}{
+		{"literal topic", kmsg.ACLResourceTypeTopic, stringPtr("orders"), kmsg.ACLResourcePatternTypeLiteral, stringPtr("t:orders"), kmsg.ACLResourcePatternTypeLiteral},
+		{"literal wildcard", kmsg.ACLResourceTypeTopic, stringPtr("*"), kmsg.ACLResourcePatternTypeLiteral, stringPtr("t:"), kmsg.ACLResourcePatternTypePrefixed},
+		{"any wildcard", kmsg.ACLResourceTypeTopic, stringPtr("*"), kmsg.ACLResourcePatternTypeAny, stringPtr("t:"), kmsg.ACLResourcePatternTypeAny},
+		{"match group", kmsg.ACLResourceTypeGroup, stringPtr("app"), kmsg.ACLResourcePatternTypeMatch, stringPtr("g:app"), kmsg.ACLResourcePatternTypeMatch},
+		// Unscoped filters are answered from the filtered response
+		{"any topic name", kmsg.ACLResourceTypeTopic, nil, kmsg.ACLResourcePatternTypeAny, nil, kmsg.ACLResourcePatternTypeAny},
+		{"cluster", kmsg.ACLResourceTypeCluster, stringPtr("kafka-cluster"), kmsg.ACLResourcePatternTypeLiteral, stringPtr("kafka-cluster"), kmsg.ACLResourcePatternTypeLiteral},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for version := int16(1); version <= 3; version++ {
+				req := kmsg.NewPtrDescribeACLsRequest()
+				req.Version = version
+				req.ResourceType = tc.resourceType
+				req.ResourceName = tc.filterName
+				req.ResourcePatternType = tc.pattern
+				req.Operation = kmsg.ACLOperationAny
+				req.PermissionType = kmsg.ACLPermissionTypeAny
+
+				mod, err := GetRequestModifier(apiKeyDescribeAcls, version, aclTestRequestConfig)
+				require.NoError(t, err)
+				result, err := mod.Apply(encodeAclTestRequest(req))
+				require.NoError(t, err, "version %d", version)
+
+				modified := kmsg.NewPtrDescribeACLsRequest()
+				modified.Version = version
+				decodeAclTestRequest(t, result, modified)
+				assert.Equal(t, tc.resourceType, modified.ResourceType, "version %d", version)
+				assert.Equal(t, tc.expectedName, modified.ResourceName, "version %d", version)
+				assert.Equal(t, tc.expectedType, modified.ResourcePatternType, "version %d", version)
+			}
+		})
+	}
+}
+
+func TestAclRequestModifier_DeleteAcls(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType kmsg.ACLResourceType
+		filterName   *string
+		pattern      kmsg.ACLResourcePatternType
+		// the UNKNOWN resource type marks a filter the broker must reject
+		expectedResourceType kmsg.ACLResourceType
+		expectedName         *string
+		expectedPattern      kmsg.ACLResourcePatternType
+	}{
+		{"literal topic", kmsg.ACLResourceTypeTopic, stringPtr("orders"), kmsg.ACLResourcePatternTypeLiteral, kmsg.ACLResourceTypeTopic, stringPtr("t:orders"), kmsg.ACLResourcePatternTypeLiteral},
+		{"prefixed transactional id", kmsg.ACLResourceTypeTransactionalId, stringPtr("orders-"), kmsg.ACLResourcePatternTypePrefixed, kmsg.ACLResourceTypeTransactionalId, stringPtr("x:orders-"), kmsg.ACLResourcePatternTypePrefixed},
+		{"literal wildcard", kmsg.ACLResourceTypeGroup, stringPtr("*"), kmsg.ACLResourcePatternTypeLiteral, kmsg.ACLResourceTypeGroup, stringPtr("g:"), kmsg.ACLResourcePatternTypePrefixed},
+		{"any topic name", kmsg.ACLResourceTypeTopic, nil, kmsg.ACLResourcePatternTypeAny, kmsg.ACLResourceTypeUnknown, nil, kmsg.ACLResourcePatternTypeAny},
+		{"any resource type", kmsg.ACLResourceTypeAny, stringPtr("orders"), kmsg.ACLResourcePatternTypeLiteral, kmsg.ACLResourceTypeUnknown, stringPtr("orders"), kmsg.ACLResourcePatternTypeLiteral},
+		{"match topic", kmsg.ACLResourceTypeTopic, stringPtr("orders"), kmsg.ACLResourcePatternTypeMatch, kmsg.ACLResourceTypeUnknown, stringPtr("orders"), kmsg.ACLResourcePatternTypeMatch},
+		{"cluster", kmsg.ACLResourceTypeCluster, nil, kmsg.ACLResourcePatternTypeAny, kmsg.ACLResourceTypeCluster, nil, kmsg.ACLResourcePatternTypeAny},
+	}
+
+	for version := int16(1); version <= 3; version++ {
+		req := kmsg.NewPtrDeleteACLsRequest()
+		req.Version = version
+		for _, tc := range tests {
+			filter := kmsg.NewDeleteACLsRequestFilter()
+			filter.ResourceType = tc.resourceType
+			filter.ResourceName = tc.filterName
+			filter.ResourcePatternType = tc.pattern
+			filter.Operation = kmsg.ACLOperationAny
+			filter.PermissionType = kmsg.ACLPermissionTypeAny
+			req.Filters = append(req.Filters, filter)
+		}
+
+		mod, err := GetRequestModifier(apiKeyDeleteAcls, version, aclTestRequestConfig)
+		require.NoError(t, err)
+		result, err := mod.Apply(encodeAclTestRequest(req))
+		require.NoError(t, err, "version %d", version)
+
+		modified := kmsg.NewPtrDeleteACLsRequest()
+		modified.Version = version
+		decodeAclTestRequest(t, result, modified)
+		require.Len(t, modified.Filters, len(tests))
+		for i, tc := range tests {
+			filter := modified.Filters[i]
+			assert.Equal(t, tc.expectedResourceType, filter.ResourceType, "%s version %d", tc.name, version)
+			assert.Equal(t, tc.expectedName, filter.ResourceName, "%s version %d", tc.name, version)
+			assert.Equal(t, tc.expectedPattern, filter.ResourcePatternType, "%s version %d", tc.name, version)
+		}
+	}
+}
+
+func TestAclRequestModifier_NoPrefixes(t *testing.T) {
+	for _, apiKey := range []int16{apiKeyDescribeAcls, apiKeyCreateAcls, apiKeyDeleteAcls} {
+		mod, err := GetRequestModifier(apiKey, 1, RequestModifierConfig{})
+		require.NoError(t, err)
+		assert.Nil(t, mod)
+
+		// Prefixers returning names unchanged leave ACLs alone as well
+		identity := func(name string) string { return name }
+		mod, err = GetRequestModifier(apiKey, 1, RequestModifierConfig{TopicPrefixer: identity, GroupPrefixer: identity})
+		require.NoError(t, err)
+		assert.Nil(t, mod)
+	}
+}
+
+func TestAclResponseModifier_DescribeAcls(t *testing.T) {
+	for version := int16(1); version <= 3; version++ {
+		resp := kmsg.NewPtrDescribeACLsResponse()
+		resp.Version = version
+		for _, r := range []struct {
+			resourceType kmsg.ACLResourceType
+			name         string
+			pattern      kmsg.ACLResourcePatternType
+		}{
+			{kmsg.ACLResourceTypeTopic, "t:orders", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeTopic, "t:", kmsg.ACLResourcePatternTypePrefixed},
+			{kmsg.ACLResourceTypeTopic, "other:orders", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeTopic, "*", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeGroup, "g:app", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeGroup, "t:app", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeCluster, "kafka-cluster", kmsg.ACLResourcePatternTypeLiteral},
+		} {
+			resource := kmsg.NewDescribeACLsResponseResource()
+			resource.ResourceType = r.resourceType
+			resource.ResourceName = r.name
+			resource.ResourcePatternType = r.pattern
+			acl := kmsg.NewDescribeACLsResponseResourceACL()
+			acl.Principal = "User:alice"
+			acl.Host = "*"
+			acl.Operation = kmsg.ACLOperationRead
+			acl.PermissionType = kmsg.ACLPermissionTypeAllow
+			resource.ACLs = append(resource.ACLs, acl)
+			resp.Resources = append(resp.Resources, resource)
+		}
+
+		mod, err := GetResponseModifierWithConfig(apiKeyDescribeAcls, version, aclTestResponseConfig)
+		require.NoError(t, err)
+		require.NotNil(t, mod)
+		result, err := mod.Apply(resp.AppendTo(nil))
+		require.NoError(t, err, "version %d", version)
+
+		modified := kmsg.NewPtrDescribeACLsResponse()
+		modified.Version = version
+		require.NoError(t, modified.ReadFrom(result))
+
+		type resource struct {
+			resourceType kmsg.ACLResourceType
+			name         string
+			pattern      kmsg.ACLResourcePatternType
+		}
+		var got []resource
+		for _, r := range modified.Resources {
+			got = append(got, resource{r.ResourceType, r.ResourceName, r.ResourcePatternType})
+			assert.Len(t, r.ACLs, 1)
+		}
+		// Other tenants' and global ACLs are hidden; the tenant wildcard is
+		// reported as the LITERAL "*" it was created from
+		assert.Equal(t, []resource{
+			{kmsg.ACLResourceTypeTopic, "orders", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeTopic, "*", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeGroup, "app", kmsg.ACLResourcePatternTypeLiteral},
+			{kmsg.ACLResourceTypeCluster, "kafka-cluster", kmsg.ACLResourcePatternTypeLiteral},
+		}, got, "version %d", version)
+	}
+}
+
+func TestAclResponseModifier_DeleteAcls(t *testing.T) {
+	for version := int16(0); version <= 3; version++ {
+		resp := kmsg.NewPtrDeleteACLsResponse()
+		resp.Version = version
+		result := kmsg.NewDeleteACLsResponseResult()
+		for _, name := range []string{"x:orders-txn", "orders-txn"} {
+			acl := kmsg.NewDeleteACLsResponseResultMatchingACL()
+			acl.ResourceType = kmsg.ACLResourceTypeTransactionalId
+			acl.ResourceName = name
+			acl.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral
+			acl.Principal = "User:alice"
+			acl.Host = "*"
+			result.MatchingACLs = append(result.MatchingACLs, acl)
+		}
+		resp.Results = append(resp.Results, result)
+
+		mod, err := GetResponseModifierWithConfig(apiKeyDeleteAcls, version, aclTestResponseConfig)
+		require.NoError(t, err)
+		require.NotNil(t, mod)
+		modifiedBytes, err := mod.Apply(resp.AppendTo(nil))
+		require.NoError(t, err, "version %d", version)
+
+		modified := kmsg.NewPtrDeleteACLsResponse()
+		modified.Version = version
+		require.NoError(t, modified.ReadFrom(modifiedBytes))
+		require.Len(t, modified.Results, 1)
+		require.Len(t, modified.Results[0].MatchingACLs, 1, "version %d", version)
+		assert.Equal(t, "orders-txn", modified.Results[0].MatchingACLs[0].ResourceName)
+		assert.Equal(t, "User:alice", modified.Results[0].MatchingACLs[0].Principal)
+	}
+}
+
+func TestAclResponseModifier_CreateAclsAndNoPrefixes(t *testing.T) {
+	mod, err := GetResponseModifierWithConfig(apiKeyCreateAcls, 1, aclTestResponseConfig)
+	require.NoError(t, err)
+	assert.Nil(t, mod, "CreateAcls responses carry no resource names")
+
+	mod, err = GetResponseModifierWithConfig(apiKeyDescribeAcls, 1, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestSupportedApiVersions_Acls(t *testing.T) {
+	supported := supportedApiVersions()
+	assert.Equal(t, int16(3), supported[apiKeyDescribeAcls])
+	assert.Equal(t, int16(3), supported[apiKeyCreateAcls])
+	assert.Equal(t, int16(3), supported[apiKeyDeleteAcls])
+}
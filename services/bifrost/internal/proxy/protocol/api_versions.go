@@ -1,5 +1,7 @@
 package protocol
 
+import "sync"
+
 // ApiVersionsRequest is the request for ApiVersions API (key=18).
 // For our use case in pre-auth phase, we only need to decode enough to get the correlation ID.
 type ApiVersionsRequest struct {
@@ -126,3 +128,87 @@ func (r *ApiVersionsResponse) decode(pd packetDecoder) error {
 
 	return nil
 }
+
+// ClampApiVersions intersects the advertised version range of every API key
+// Bifrost rewrites with the versions it has schemas for, so clients downshift
+// to a version Bifrost can decode. Keys with no overlapping version are
+// dropped; keys Bifrost passes through untouched are returned unchanged.
+func ClampApiVersions(keys []ApiVersionsResponseKey) []ApiVersionsResponseKey {
+	supported := supportedApiVersions()
+	clamped := make([]ApiVersionsResponseKey, 0, len(keys))
+	for _, k := range keys {
+		maxVersion, ok := supported[k.ApiKey]
+		if !ok {
+			clamped = append(clamped, k)
+			continue
+		}
+		if k.MaxVersion > maxVersion {
+			k.MaxVersion = maxVersion
+		}
+		if k.MinVersion > k.MaxVersion {
+			continue
+		}
+		clamped = append(clamped, k)
+	}
+	return clamped
+}
+
+var (
+	supportedApiVersionsOnce sync.Once
+	supportedApiVersionsMap  map[int16]int16
+)
+
+// supportedApiVersions returns the highest version Bifrost can rewrite per API
+// key. It is derived from the request and response schema tables so that adding
+// a schema version automatically widens what is advertised to clients. The
+// table is built lazily because request schemas are populated in init().
+func supportedApiVersions() map[int16]int16 {
+	supportedApiVersionsOnce.Do(func() {
+		schemasByKey := map[int16][][]Schema{
+			apiKeyProduce:              {produceRequestSchemas, produceResponseSchemaVersions},
+			apiKeyFetch:                {fetchRequestSchemas, fetchResponseSchemaVersions},
+			apiKeyListOffsets:          {listOffsetsRequestSchemas, listOffsetsResponseSchemaVersions},
+			apiKeyMetadata:             {metadataRequestSchemas, metadataResponseSchemaVersions},
+			apiKeyOffsetCommit:         {offsetCommitRequestSchemas, offsetCommitResponseSchemaVersions},
+			apiKeyOffsetFetch:          {offsetFetchRequestSchemas, offsetFetchResponseSchemaVersions},
+			apiKeyFindCoordinator:      {findCoordinatorRequestSchemas, findCoordinatorResponseSchemaVersions},
+			apiKeyJoinGroup:            {joinGroupRequestSchemas},
+			apiKeyHeartbeat:            {heartbeatRequestSchemas},
+			apiKeyLeaveGroup:           {leaveGroupRequestSchemas},
+			apiKeySyncGroup:            {syncGroupRequestSchemas},
+			apiKeyDescribeGroups:       {describeGroupsRequestSchemas, describeGroupsResponseSchemas},
+			apiKeyListGroups:           {listGroupsResponseSchemas},
+			apiKeyApiVersions:          {apiVersionsResponseSchemas},
+			apiKeyCreateTopics:         {createTopicsRequestSchemas, createTopicsResponseSchemaVersions},
+			apiKeyDeleteTopics:         {deleteTopicsRequestSchemas, deleteTopicsResponseSchemaVersions},
+			apiKeyDeleteRecords:        {deleteRecordsRequestSchemas, deleteRecordsResponseSchemaVersions},
+			apiKeyInitProducerId:       {initProducerIdRequestSchemas, initProducerIdResponseSchemaVersions},
+			apiKeyOffsetForLeaderEpoch: {offsetForLeaderEpochRequestSchemas, offsetForLeaderEpochResponseSchemaVersions},
+			apiKeyAddPartitionsToTxn:   {addPartitionsToTxnRequestSchemas, addPartitionsToTxnResponseSchemaVersions},
+			apiKeyAddOffsetsToTxn:      {addOffsetsToTxnRequestSchemas, addOffsetsToTxnResponseSchemaVersions},
+			apiKeyEndTxn:               {endTxnRequestSchemas, endTxnResponseSchemaVersions},
+			apiKeyTxnOffsetCommit:      {txnOffsetCommitRequestSchemas, txnOffsetCommitResponseSchemaVersions},
+			apiKeyDescribeAcls:         {describeAclsRequestSchemas, describeAclsResponseSchemaVersions},
+			apiKeyCreateAcls:           {createAclsRequestSchemas},
+			apiKeyDeleteAcls:           {deleteAclsRequestSchemas, deleteAclsResponseSchemaVersions},
+			apiKeyDescribeConfigs:      {describeConfigsRequestSchemas, describeConfigsResponseSchemaVersions},
+			apiKeyAlterConfigs:         {alterConfigsRequestSchemas, alterConfigsResponseSchemaVersions},
+			apiKeyCreatePartitions:     {createPartitionsRequestSchemas, createPartitionsResponseSchemaVersions},
+			apiKeyDeleteGroups:         {deleteGroupsRequestSchemas, deleteGroupsResponseSchemas},
+			apiKeyOffsetDelete:         {offsetDeleteRequestSchemas, offsetDeleteResponseSchemaVersions},
+			apiKeyDescribeCluster:      {describeClusterResponseSchemaVersions},
+		}
+
+		supportedApiVersionsMap = make(map[int16]int16, len(schemasByKey))
+		for apiKey, tables := range schemasByKey {
+			maxVersion := len(tables[0]) - 1
+			for _, schemas := range tables[1:] {
+				if len(schemas)-1 < maxVersion {
+					maxVersion = len(schemas) - 1
+				}
+			}
+			supportedApiVersionsMap[apiKey] = int16(maxVersion)
+		}
+	})
+	return supportedApiVersionsMap
+}
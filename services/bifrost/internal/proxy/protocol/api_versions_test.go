@@ -0,0 +1,47 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampApiVersions(t *testing.T) {
+	fetchMax := int16(len(fetchRequestSchemas) - 1)
+
+	clamped := ClampApiVersions([]ApiVersionsResponseKey{
+		{ApiKey: apiKeyFetch, MinVersion: 4, MaxVersion: fetchMax + 5},
+		{ApiKey: apiKeyOffsetDelete, MinVersion: 0, MaxVersion: 0},
+		{ApiKey: apiKeyDescribeGroups, MinVersion: 10, MaxVersion: 12},
+		{ApiKey: 36, MinVersion: 0, MaxVersion: 2}, // SaslAuthenticate is passed through
+	})
+
+	assert.Equal(t, []ApiVersionsResponseKey{
+		{ApiKey: apiKeyFetch, MinVersion: 4, MaxVersion: fetchMax},
+		{ApiKey: apiKeyOffsetDelete, MinVersion: 0, MaxVersion: 0},
+		{ApiKey: 36, MinVersion: 0, MaxVersion: 2},
+	}, clamped)
+}
+
+func TestSupportedApiVersions_DerivedFromSchemas(t *testing.T) {
+	supported := supportedApiVersions()
+
+	// Request and response tables differ in length; the shorter one wins
+	assert.Equal(t, int16(len(metadataRequestSchemas)-1), supported[apiKeyMetadata])
+	assert.Equal(t, int16(len(findCoordinatorRequestSchemas)-1), supported[apiKeyFindCoordinator])
+	assert.Equal(t, int16(len(listGroupsResponseSchemas)-1), supported[apiKeyListGroups])
+	assert.Equal(t, int16(len(deleteGroupsRequestSchemas)-1), supported[apiKeyDeleteGroups])
+
+	for apiKey, maxVersion := range supported {
+		_, err := GetRequestModifier(apiKey, maxVersion, RequestModifierConfig{
+			TopicPrefixer: func(topic string) string { return topic },
+			GroupPrefixer: func(group string) string { return group },
+		})
+		assert.NoError(t, err, "api key %d v%d", apiKey, maxVersion)
+		_, err = GetResponseModifierWithConfig(apiKey, maxVersion, ResponseModifierConfig{
+			TopicUnprefixer: func(topic string) string { return topic },
+			GroupUnprefixer: func(group string) string { return group },
+		})
+		assert.NoError(t, err, "api key %d v%d", apiKey, maxVersion)
+	}
+}
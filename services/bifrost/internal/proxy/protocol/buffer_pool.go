@@ -0,0 +1,70 @@
+package protocol
+
+import "sync"
+
+// maxPooledBufferSize caps the buffers kept for reuse so one oversized
+// message doesn't pin its memory in the pool
+const maxPooledBufferSize = 1 << 20
+
+// Buffer is a pooled byte buffer for reading and rewriting messages. Obtain
+// one with AcquireBuffer and hand it back with Release once nothing refers to
+// B; decoded values alias the bytes they were decoded from.
+type Buffer struct {
+	B []byte
+}
+
+var bufferPool = sync.Pool{New: func() any { return new(Buffer) }}
+
+// AcquireBuffer returns a pooled buffer whose B has length n
+func AcquireBuffer(n int) *Buffer {
+	buf := bufferPool.Get().(*Buffer)
+	if cap(buf.B) < n {
+		buf.B = make([]byte, n)
+	}
+	buf.B = buf.B[:n]
+	return buf
+}
+
+// Release returns the buffer to the pool. The buffer must not be used
+// afterwards.
+func (b *Buffer) Release() {
+	if cap(b.B) > maxPooledBufferSize {
+		b.B = nil
+	} else {
+		b.B = b.B[:0]
+	}
+	bufferPool.Put(b)
+}
+
+// encoders is the scratch state for one EncodeSchema call
+type encoders struct {
+	prep prepEncoder
+	real realEncoder
+}
+
+var (
+	decoderPool  = sync.Pool{New: func() any { return new(realDecoder) }}
+	encodersPool = sync.Pool{New: func() any { return new(encoders) }}
+)
+
+func acquireDecoder(raw []byte) *realDecoder {
+	rd := decoderPool.Get().(*realDecoder)
+	rd.raw = raw
+	return rd
+}
+
+// releaseDecoder resets the decoder so the pool doesn't retain the message
+func releaseDecoder(rd *realDecoder) {
+	*rd = realDecoder{}
+	decoderPool.Put(rd)
+}
+
+func acquireEncoders() *encoders {
+	return encodersPool.Get().(*encoders)
+}
+
+// releaseEncoders resets the encoders so the pool doesn't retain the message
+func releaseEncoders(enc *encoders) {
+	*enc = encoders{}
+	encodersPool.Put(enc)
+}
@@ -0,0 +1,182 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendTestString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+func appendTestBytes(b, data []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(data)))
+	return append(b, data...)
+}
+
+// produceRequestV3 builds a Produce v3 request body with one partition per
+// topic carrying the given records
+func produceRequestV3(txnID string, topics []string, records []byte) []byte {
+	var b []byte
+	b = binary.BigEndian.AppendUint32(b, 1) // correlation_id
+	b = appendTestString(b, "bench-client")
+	b = appendTestString(b, txnID)
+	b = binary.BigEndian.AppendUint16(b, 0xffff) // acks: -1
+	b = binary.BigEndian.AppendUint32(b, 30000)  // timeout_ms
+	b = binary.BigEndian.AppendUint32(b, uint32(len(topics)))
+	for _, topic := range topics {
+		b = appendTestString(b, topic)
+		b = binary.BigEndian.AppendUint32(b, 1) // partition_data
+		b = binary.BigEndian.AppendUint32(b, 0) // index
+		b = appendTestBytes(b, records)
+	}
+	return b
+}
+
+// fetchResponseV1 builds a Fetch v1 response body with one partition per
+// topic carrying the given records
+func fetchResponseV1(topics []string, records []byte) []byte {
+	var b []byte
+	b = binary.BigEndian.AppendUint32(b, 0) // throttle_time_ms
+	b = binary.BigEndian.AppendUint32(b, uint32(len(topics)))
+	for _, topic := range topics {
+		b = appendTestString(b, topic)
+		b = binary.BigEndian.AppendUint32(b, 1)   // partitions
+		b = binary.BigEndian.AppendUint32(b, 0)   // partition_index
+		b = binary.BigEndian.AppendUint16(b, 0)   // error_code
+		b = binary.BigEndian.AppendUint64(b, 100) // high_watermark
+		b = appendTestBytes(b, records)
+	}
+	return b
+}
+
+func benchTopics(prefix string, n int) []string {
+	topics := make([]string, n)
+	for i := range topics {
+		topics[i] = fmt.Sprintf("%sorders-%d", prefix, i)
+	}
+	return topics
+}
+
+func TestBufferPool_AcquireRelease(t *testing.T) {
+	buf := AcquireBuffer(128)
+	require.Len(t, buf.B, 128)
+	copy(buf.B, bytes.Repeat([]byte{0xaa}, 128))
+	buf.Release()
+
+	reused := AcquireBuffer(64)
+	assert.Len(t, reused.B, 64)
+	reused.Release()
+
+	// Oversized buffers are not kept
+	huge := AcquireBuffer(maxPooledBufferSize + 1)
+	assert.Len(t, huge.B, maxPooledBufferSize+1)
+	huge.Release()
+	assert.Nil(t, huge.B)
+}
+
+func TestPooledCodec_ConcurrentModifiersDoNotShareState(t *testing.T) {
+	reqCfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+	respCfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string { return topic[len("tenant:"):] },
+	}
+	produceMod, err := GetRequestModifier(apiKeyProduce, 3, reqCfg)
+	require.NoError(t, err)
+	fetchMod, err := GetResponseModifierWithConfig(apiKeyFetch, 1, respCfg)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	for worker := 0; worker < 16; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			records := bytes.Repeat([]byte{byte(worker)}, 64+worker*7)
+			topics := benchTopics(fmt.Sprintf("w%d-", worker), 1+worker%4)
+			tenantTopics := make([]string, len(topics))
+			for i, topic := range topics {
+				tenantTopics[i] = "tenant:" + topic
+			}
+
+			for i := 0; i < 200; i++ {
+				txnID := fmt.Sprintf("txn-%d-%d", worker, i)
+				req := AcquireBuffer(0)
+				req.B = append(req.B, produceRequestV3(txnID, topics, records)...)
+				got, err := produceMod.Apply(req.B)
+				if err != nil {
+					errs <- err
+					return
+				}
+				want := produceRequestV3("tenant:"+txnID, tenantTopics, records)
+				if !bytes.Equal(want, got) {
+					errs <- fmt.Errorf("worker %d: produce request %d corrupted", worker, i)
+					return
+				}
+				req.Release()
+
+				resp := AcquireBuffer(0)
+				resp.B = append(resp.B, fetchResponseV1(tenantTopics, records)...)
+				got, err = fetchMod.Apply(resp.B)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(fetchResponseV1(topics, records), got) {
+					errs <- fmt.Errorf("worker %d: fetch response %d corrupted", worker, i)
+					return
+				}
+				resp.Release()
+			}
+		}(worker)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func BenchmarkProduceRequestModifier(b *testing.B) {
+	mod, err := GetRequestModifier(apiKeyProduce, 3, RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	})
+	require.NoError(b, err)
+	request := produceRequestV3("orders-txn", benchTopics("", 10), bytes.Repeat([]byte{1}, 1024))
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(request)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mod.Apply(request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFetchResponseModifier(b *testing.B) {
+	mod, err := GetResponseModifierWithConfig(apiKeyFetch, 1, ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string { return topic[len("tenant:"):] },
+	})
+	require.NoError(b, err)
+	response := fetchResponseV1(benchTopics("tenant:", 10), bytes.Repeat([]byte{1}, 1024))
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(response)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mod.Apply(response); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package protocol
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// errorResponseSpec describes how to answer a request of one API key with an
+// error instead of forwarding it to the broker.
+type errorResponseSpec struct {
+	requestSchemas  []Schema
+	responseSchemas []Schema
+	// arrays maps each response array, by its dotted path, to the field of
+	// the matching request element whose entries it answers. Fields renamed
+	// across versions are listed as alternatives separated by "|".
+	arrays map[string]string
+}
+
+// errorResponseKeyFields identify the topic, partition, group or resource an
+// entry answers and are copied from the request.
+var errorResponseKeyFields = map[string]struct{}{
+	"name":            {},
+	"topic":           {},
+	"index":           {},
+	"partition_index": {},
+	"topic_id":        {},
+	"group_id":        {},
+	"resource_type":   {},
+	"resource_name":   {},
+}
+
+// errorResponseKeyAliases name the request field a key field is copied from
+// when the request calls it differently.
+var errorResponseKeyAliases = map[string]string{
+	"partition_index": "partition",
+}
+
+// errorResponseDefaults are the values brokers return for fields that have no
+// meaning when a request fails.
+var errorResponseDefaults = map[string]interface{}{
+	"base_offset":                   int64(-1),
+	"log_append_time_ms":            int64(-1),
+	"log_start_offset":              int64(-1),
+	"producer_id":                   int64(-1),
+	"producer_epoch":                int16(-1),
+	"num_partitions":                int32(-1),
+	"replication_factor":            int16(-1),
+	"high_watermark":                int64(-1),
+	"last_stable_offset":            int64(-1),
+	"preferred_read_replica":        int32(-1),
+	"controller_id":                 int32(-1),
+	"topic_authorized_operations":   int32(math.MinInt32),
+	"cluster_authorized_operations": int32(math.MinInt32),
+}
+
+func getErrorResponseSpec(apiKey int16) (errorResponseSpec, bool) {
+	switch apiKey {
+	case apiKeyProduce:
+		return errorResponseSpec{produceRequestSchemas, produceResponseSchemaVersions, map[string]string{
+			"responses":                     "topic_data",
+			"responses.partition_responses": "partition_data",
+		}}, true
+	case apiKeyFetch:
+		return errorResponseSpec{fetchRequestSchemas, fetchResponseSchemaVersions, map[string]string{
+			"responses":            "topics",
+			"responses.partitions": "partitions",
+		}}, true
+	case apiKeyMetadata:
+		// Topics are answered without partitions or brokers
+		return errorResponseSpec{metadataRequestSchemas, metadataResponseSchemaVersions, map[string]string{
+			"topic_metadata": "topics",
+		}}, true
+	case apiKeyOffsetCommit:
+		return errorResponseSpec{offsetCommitRequestSchemas, offsetCommitResponseSchemaVersions, map[string]string{
+			"topics":            "topics",
+			"topics.partitions": "partitions",
+		}}, true
+	case apiKeyCreateTopics:
+		return errorResponseSpec{createTopicsRequestSchemas, createTopicsResponseSchemaVersions, map[string]string{
+			"topics": "topics",
+		}}, true
+	case apiKeyDeleteTopics:
+		// v0-v5 list topic names, v6+ topic structs
+		return errorResponseSpec{deleteTopicsRequestSchemas, deleteTopicsResponseSchemaVersions, map[string]string{
+			"responses": "topic_names|topics",
+		}}, true
+	case apiKeyInitProducerId:
+		return errorResponseSpec{initProducerIdRequestSchemas, initProducerIdResponseSchemaVersions, nil}, true
+	case apiKeyAddPartitionsToTxn:
+		return errorResponseSpec{addPartitionsToTxnRequestSchemas, addPartitionsToTxnResponseSchemaVersions, map[string]string{
+			"results":         "topics",
+			"results.results": "partitions",
+		}}, true
+	case apiKeyAddOffsetsToTxn:
+		return errorResponseSpec{addOffsetsToTxnRequestSchemas, addOffsetsToTxnResponseSchemaVersions, nil}, true
+	case apiKeyEndTxn:
+		return errorResponseSpec{endTxnRequestSchemas, endTxnResponseSchemaVersions, nil}, true
+	case apiKeyTxnOffsetCommit:
+		return errorResponseSpec{txnOffsetCommitRequestSchemas, txnOffsetCommitResponseSchemaVersions, map[string]string{
+			"topics":            "topics",
+			"topics.partitions": "partitions",
+		}}, true
+	case apiKeyAlterConfigs:
+		return errorResponseSpec{alterConfigsRequestSchemas, alterConfigsResponseSchemaVersions, map[string]string{
+			"responses": "resources",
+		}}, true
+	case apiKeyCreatePartitions:
+		return errorResponseSpec{createPartitionsRequestSchemas, createPartitionsResponseSchemaVersions, map[string]string{
+			"results": "topics",
+		}}, true
+	case apiKeyDeleteGroups:
+		return errorResponseSpec{deleteGroupsRequestSchemas, deleteGroupsResponseSchemas, map[string]string{
+			"results": "groups_names",
+		}}, true
+	case apiKeyOffsetDelete:
+		return errorResponseSpec{offsetDeleteRequestSchemas, offsetDeleteResponseSchemaVersions, map[string]string{
+			"topics":            "topics",
+			"topics.partitions": "partitions",
+		}}, true
+	default:
+		return errorResponseSpec{}, false
+	}
+}
+
+// CanBuildErrorResponse reports whether NewErrorResponse supports the given
+// API key and version.
+func CanBuildErrorResponse(apiKey, apiVersion int16) bool {
+	spec, ok := getErrorResponseSpec(apiKey)
+	if !ok {
+		return false
+	}
+	return apiVersion >= 0 && int(apiVersion) < len(spec.requestSchemas) && int(apiVersion) < len(spec.responseSchemas)
+}
+
+// NewErrorResponse builds the response a broker would send when rejecting the
+// request with kerr. request holds the request after api key and version,
+// starting at the correlation id. Every topic, partition, group or resource in
+// the request gets an entry carrying the error code. The returned bytes are a
+// complete response including size and header; nil means the client expects
+// no response (Produce with acks=0).
+func NewErrorResponse(apiKey, apiVersion int16, request []byte, kerr KError, message string) ([]byte, error) {
+	spec, ok := getErrorResponseSpec(apiKey)
+	if !ok || !CanBuildErrorResponse(apiKey, apiVersion) {
+		return nil, fmt.Errorf("no error response for api key %d version %d", apiKey, apiVersion)
+	}
+
+	decoded, err := DecodeSchema(request, spec.requestSchemas[apiVersion])
+	if err != nil {
+		return nil, fmt.Errorf("decode request key %d version %d: %w", apiKey, apiVersion, err)
+	}
+	if apiKey == apiKeyProduce {
+		if acks, ok := decoded.Get("acks").(int16); ok && acks == 0 {
+			return nil, nil
+		}
+	}
+	correlationID, ok := decoded.Get("correlation_id").(int32)
+	if !ok {
+		return nil, fmt.Errorf("request key %d version %d has no correlation id", apiKey, apiVersion)
+	}
+
+	b := errorResponseBuilder{
+		arrays: spec.arrays,
+		// Fetch clients skip every partition once the top-level error is set
+		entryErrorsOnly: apiKey == apiKeyFetch,
+		errorCode:       int16(kerr),
+		message:         message,
+	}
+	responseSchema := spec.responseSchemas[apiVersion]
+	response, err := b.buildStruct(responseSchema, "", decoded, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build response key %d version %d: %w", apiKey, apiVersion, err)
+	}
+	body, err := EncodeSchema(response, responseSchema)
+	if err != nil {
+		return nil, fmt.Errorf("encode response key %d version %d: %w", apiKey, apiVersion, err)
+	}
+
+	// Flexible response headers carry an empty tagged fields section
+	var headerTaggedFields []byte
+	requestKeyVersion := &RequestKeyVersion{ApiKey: apiKey, ApiVersion: apiVersion}
+	if requestKeyVersion.ResponseHeaderVersion() >= 1 {
+		headerTaggedFields = []byte{0}
+	}
+	header, err := Encode(&ResponseHeader{
+		Length:        int32(4 + len(headerTaggedFields) + len(body)),
+		CorrelationID: correlationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	frame := make([]byte, 0, len(header)+len(headerTaggedFields)+len(body))
+	frame = append(frame, header...)
+	frame = append(frame, headerTaggedFields...)
+	return append(frame, body...), nil
+}
+
+type errorResponseBuilder struct {
+	arrays          map[string]string
+	entryErrorsOnly bool
+	errorCode       int16
+	message         string
+}
+
+// buildStruct fills a response struct for the request element req. key is
+// set instead of req when the request lists bare values (topic names,
+// partition indexes) rather than structs.
+func (b *errorResponseBuilder) buildStruct(s Schema, path string, req *Struct, key interface{}) (*Struct, error) {
+	fields := s.GetFields()
+	values := make([]interface{}, len(fields))
+	for i, bf := range fields {
+		def := bf.GetDef()
+		name := def.GetName()
+		switch f := def.(type) {
+		case *SchemaTaggedFields:
+			values[i] = []rawTaggedField{}
+		case *Array, *CompactArray, *NullableArray, *CompactNullableArray:
+			elements, err := b.buildArray(f.GetSchema(), joinErrorResponsePath(path, name), req)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = elements
+		case *Mfield:
+			if path == "" && name == "error_code" && b.entryErrorsOnly {
+				values[i] = int16(0)
+				continue
+			}
+			value, err := b.fieldValue(f, req, key)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		default:
+			return nil, fmt.Errorf("field %s: unsupported type %T", name, def)
+		}
+	}
+	return &Struct{Schema: s, Values: values}, nil
+}
+
+func (b *errorResponseBuilder) buildArray(elementSchema Schema, path string, req *Struct) ([]interface{}, error) {
+	elements := make([]interface{}, 0)
+	requestFields, ok := b.arrays[path]
+	if !ok || req == nil || elementSchema == nil || len(elementSchema.GetFields()) == 0 {
+		return elements, nil
+	}
+
+	var requestElements []interface{}
+	for _, field := range strings.Split(requestFields, "|") {
+		if v, ok := req.Get(field).([]interface{}); ok {
+			requestElements = v
+			break
+		}
+	}
+	for _, requestElement := range requestElements {
+		var (
+			element *Struct
+			err     error
+		)
+		if nested, ok := requestElement.(*Struct); ok {
+			element, err = b.buildStruct(elementSchema, path, nested, nil)
+		} else {
+			element, err = b.buildStruct(elementSchema, path, nil, requestElement)
+		}
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+	return elements, nil
+}
+
+func (b *errorResponseBuilder) fieldValue(f *Mfield, req *Struct, key interface{}) (interface{}, error) {
+	zero, err := zeroValue(f.Ty)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %w", f.Name, err)
+	}
+
+	switch f.Name {
+	case "error_code":
+		return b.errorCode, nil
+	case "error_message":
+		if _, nullable := zero.(*string); nullable && b.message != "" {
+			message := b.message
+			return &message, nil
+		}
+		return zero, nil
+	}
+
+	if _, ok := errorResponseKeyFields[f.Name]; ok {
+		if req != nil {
+			if v, ok := keyValue(req.Get(f.Name), zero); ok {
+				return v, nil
+			}
+			if alias, ok := errorResponseKeyAliases[f.Name]; ok {
+				if v, ok := keyValue(req.Get(alias), zero); ok {
+					return v, nil
+				}
+			}
+		} else if v, ok := keyValue(key, zero); ok {
+			return v, nil
+		}
+	}
+	if v, ok := errorResponseDefaults[f.Name]; ok && sameType(v, zero) {
+		return v, nil
+	}
+	return zero, nil
+}
+
+func zeroValue(ty Schema) (interface{}, error) {
+	switch ty.(type) {
+	case *Bool:
+		return false, nil
+	case *Int8:
+		return int8(0), nil
+	case *Int16:
+		return int16(0), nil
+	case *Int32:
+		return int32(0), nil
+	case *Int64:
+		return int64(0), nil
+	case *Str, *CompactStr:
+		return "", nil
+	case *NullableStr, *CompactNullableStr:
+		return (*string)(nil), nil
+	case *Bytes, *CompactBytes:
+		return []byte{}, nil
+	case *Uuid:
+		return uuid.UUID{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", ty.GetName())
+	}
+}
+
+// keyValue converts a key copied from the request to the response field's
+// type. Names may be nullable on one side only.
+func keyValue(v, zero interface{}) (interface{}, bool) {
+	if sameType(v, zero) {
+		return v, true
+	}
+	switch zero.(type) {
+	case string:
+		if p, ok := v.(*string); ok && p != nil {
+			return *p, true
+		}
+	case *string:
+		if s, ok := v.(string); ok {
+			return &s, true
+		}
+	}
+	return nil, false
+}
+
+func sameType(v, zero interface{}) bool {
+	if v == nil {
+		return false
+	}
+	return reflect.TypeOf(v) == reflect.TypeOf(zero)
+}
+
+func joinErrorResponsePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
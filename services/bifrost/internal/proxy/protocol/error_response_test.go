@@ -0,0 +1,196 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeErrorResponse checks the frame header of a locally built response and
+// decodes its body with the response schema.
+func decodeErrorResponse(t *testing.T, frame []byte, apiKey, apiVersion int16, schemas []Schema) *Struct {
+	t.Helper()
+	require.GreaterOrEqual(t, len(frame), 8)
+	assert.Equal(t, uint32(len(frame)-4), binary.BigEndian.Uint32(frame[0:4]))
+	assert.Equal(t, uint32(7), binary.BigEndian.Uint32(frame[4:8]))
+
+	body := frame[8:]
+	if (&RequestKeyVersion{ApiKey: apiKey, ApiVersion: apiVersion}).ResponseHeaderVersion() >= 1 {
+		require.Equal(t, byte(0), body[0], "empty header tagged fields")
+		body = body[1:]
+	}
+	decoded, err := DecodeSchema(body, schemas[apiVersion])
+	require.NoError(t, err)
+	return decoded
+}
+
+func TestNewErrorResponse_ProduceV9(t *testing.T) {
+	schema := produceRequestSchemas[9]
+	partitionSchema := schema.GetFieldsByName()["topic_data"].def.GetSchema().GetFieldsByName()["partition_data"].def.GetSchema()
+	topicSchema := schema.GetFieldsByName()["topic_data"].def.GetSchema()
+
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), (*string)(nil), []rawTaggedField{}, (*string)(nil), int16(-1), int32(30000),
+		[]interface{}{
+			&Struct{Schema: topicSchema, Values: []interface{}{"orders", []interface{}{
+				&Struct{Schema: partitionSchema, Values: []interface{}{int32(0), []byte{}, []rawTaggedField{}}},
+				&Struct{Schema: partitionSchema, Values: []interface{}{int32(2), []byte{}, []rawTaggedField{}}},
+			}, []rawTaggedField{}}},
+		},
+		[]rawTaggedField{},
+	}}, schema)
+	require.NoError(t, err)
+
+	frame, err := NewErrorResponse(apiKeyProduce, 9, request, ErrClusterAuthorizationFailed, "read-only")
+	require.NoError(t, err)
+	decoded := decodeErrorResponse(t, frame, apiKeyProduce, 9, produceResponseSchemaVersions)
+
+	responses := decoded.Get("responses").([]interface{})
+	require.Len(t, responses, 1)
+	topic := responses[0].(*Struct)
+	assert.Equal(t, "orders", topic.Get("name"))
+
+	partitions := topic.Get("partition_responses").([]interface{})
+	require.Len(t, partitions, 2)
+	for i, index := range []int32{0, 2} {
+		partition := partitions[i].(*Struct)
+		assert.Equal(t, index, partition.Get("index"))
+		assert.Equal(t, int16(ErrClusterAuthorizationFailed), partition.Get("error_code"))
+		assert.Equal(t, int64(-1), partition.Get("base_offset"))
+		assert.Empty(t, partition.Get("record_errors"))
+		assert.Equal(t, "read-only", *partition.Get("error_message").(*string))
+	}
+}
+
+func TestNewErrorResponse_DeleteTopicsByName(t *testing.T) {
+	schema := deleteTopicsRequestSchemas[4]
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), (*string)(nil), []rawTaggedField{}, []interface{}{"a", "b"}, int32(30000), []rawTaggedField{},
+	}}, schema)
+	require.NoError(t, err)
+
+	frame, err := NewErrorResponse(apiKeyDeleteTopics, 4, request, ErrClusterAuthorizationFailed, "")
+	require.NoError(t, err)
+	decoded := decodeErrorResponse(t, frame, apiKeyDeleteTopics, 4, deleteTopicsResponseSchemaVersions)
+
+	responses := decoded.Get("responses").([]interface{})
+	require.Len(t, responses, 2)
+	assert.Equal(t, "a", responses[0].(*Struct).Get("name"))
+	assert.Equal(t, "b", responses[1].(*Struct).Get("name"))
+	assert.Equal(t, int16(ErrClusterAuthorizationFailed), responses[1].(*Struct).Get("error_code"))
+}
+
+func TestNewErrorResponse_DeleteTopicsByID(t *testing.T) {
+	schema := deleteTopicsRequestSchemas[6]
+	topicSchema := schema.GetFieldsByName()["topics"].def.GetSchema()
+	topicID := uuid.New()
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), (*string)(nil), []rawTaggedField{},
+		[]interface{}{&Struct{Schema: topicSchema, Values: []interface{}{(*string)(nil), topicID, []rawTaggedField{}}}},
+		int32(30000), []rawTaggedField{},
+	}}, schema)
+	require.NoError(t, err)
+
+	frame, err := NewErrorResponse(apiKeyDeleteTopics, 6, request, ErrClusterAuthorizationFailed, "")
+	require.NoError(t, err)
+	decoded := decodeErrorResponse(t, frame, apiKeyDeleteTopics, 6, deleteTopicsResponseSchemaVersions)
+
+	responses := decoded.Get("responses").([]interface{})
+	require.Len(t, responses, 1)
+	assert.Equal(t, topicID, responses[0].(*Struct).Get("topic_id"))
+	assert.Nil(t, responses[0].(*Struct).Get("name").(*string))
+}
+
+func TestNewErrorResponse_InitProducerId(t *testing.T) {
+	schema := initProducerIdRequestSchemas[4]
+	txnID := "txn"
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), (*string)(nil), []rawTaggedField{}, &txnID, int32(60000), int64(12), int16(3), []rawTaggedField{},
+	}}, schema)
+	require.NoError(t, err)
+
+	frame, err := NewErrorResponse(apiKeyInitProducerId, 4, request, ErrClusterAuthorizationFailed, "")
+	require.NoError(t, err)
+	decoded := decodeErrorResponse(t, frame, apiKeyInitProducerId, 4, initProducerIdResponseSchemaVersions)
+
+	assert.Equal(t, int16(ErrClusterAuthorizationFailed), decoded.Get("error_code"))
+	assert.Equal(t, int64(-1), decoded.Get("producer_id"), "the request's producer id must not be echoed")
+	assert.Equal(t, int16(-1), decoded.Get("producer_epoch"))
+}
+
+func TestNewErrorResponse_FetchV12(t *testing.T) {
+	schema := fetchRequestSchemas[12]
+	topicSchema := schema.GetFieldsByName()["topics"].def.GetSchema()
+	partitionSchema := topicSchema.GetFieldsByName()["partitions"].def.GetSchema()
+
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), (*string)(nil), []rawTaggedField{},
+		int32(-1), int32(500), int32(1), int32(1 << 20), int8(0), int32(0), int32(-1),
+		[]interface{}{
+			&Struct{Schema: topicSchema, Values: []interface{}{"payments", []interface{}{
+				&Struct{Schema: partitionSchema, Values: []interface{}{int32(4), int32(-1), int64(10), int32(-1), int64(-1), int32(1 << 20), []rawTaggedField{}}},
+			}, []rawTaggedField{}}},
+		},
+		[]interface{}{}, "", []rawTaggedField{},
+	}}, schema)
+	require.NoError(t, err)
+
+	frame, err := NewErrorResponse(apiKeyFetch, 12, request, ErrTopicAuthorizationFailed, "")
+	require.NoError(t, err)
+	decoded := decodeErrorResponse(t, frame, apiKeyFetch, 12, fetchResponseSchemaVersions)
+
+	assert.Equal(t, int16(0), decoded.Get("error_code"), "only partitions carry the error")
+	responses := decoded.Get("responses").([]interface{})
+	require.Len(t, responses, 1)
+	topic := responses[0].(*Struct)
+	assert.Equal(t, "payments", topic.Get("topic"))
+
+	partitions := topic.Get("partitions").([]interface{})
+	require.Len(t, partitions, 1)
+	partition := partitions[0].(*Struct)
+	assert.Equal(t, int32(4), partition.Get("partition_index"))
+	assert.Equal(t, int16(ErrTopicAuthorizationFailed), partition.Get("error_code"))
+	assert.Equal(t, int64(-1), partition.Get("high_watermark"))
+	assert.Equal(t, int32(-1), partition.Get("preferred_read_replica"))
+	assert.Empty(t, partition.Get("records"))
+}
+
+func TestNewErrorResponse_MetadataV10(t *testing.T) {
+	schema := metadataRequestSchemas[10]
+	topicSchema := schema.GetFieldsByName()["topics"].def.GetSchema()
+	name := "payments"
+
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), (*string)(nil), []rawTaggedField{},
+		[]interface{}{
+			&Struct{Schema: topicSchema, Values: []interface{}{uuid.UUID{}, &name, []rawTaggedField{}}},
+		},
+		true, false, false, []rawTaggedField{},
+	}}, schema)
+	require.NoError(t, err)
+
+	frame, err := NewErrorResponse(apiKeyMetadata, 10, request, ErrTopicAuthorizationFailed, "")
+	require.NoError(t, err)
+	decoded := decodeErrorResponse(t, frame, apiKeyMetadata, 10, metadataResponseSchemaVersions)
+
+	assert.Empty(t, decoded.Get("brokers"))
+	assert.Equal(t, int32(-1), decoded.Get("controller_id"))
+	topics := decoded.Get("topic_metadata").([]interface{})
+	require.Len(t, topics, 1)
+	topic := topics[0].(*Struct)
+	assert.Equal(t, "payments", topic.Get("name"), "nullable request name is copied")
+	assert.Equal(t, int16(ErrTopicAuthorizationFailed), topic.Get("error_code"))
+	assert.Empty(t, topic.Get("partition_metadata"))
+}
+
+func TestNewErrorResponse_Unsupported(t *testing.T) {
+	assert.False(t, CanBuildErrorResponse(21, 0), "DeleteRecords")
+	assert.False(t, CanBuildErrorResponse(apiKeyProduce, 99))
+	assert.True(t, CanBuildErrorResponse(apiKeyEndTxn, 3))
+
+	_, err := NewErrorResponse(21, 0, []byte{0, 0, 0, 7, 255, 255}, ErrClusterAuthorizationFailed, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// errRequestInvariant is returned by modifiers whose rewritten request fails
+// checkRequestInvariants.
+var errRequestInvariant = errors.New("request modifier broke an invariant")
+
+// checkInvariants enables self-checks of the modify pipeline that are too
+// costly for every production request, such as decoding each rewritten
+// request a second time. It is set in builds with the bifrost_invariants tag
+// and by this package's tests.
+var checkInvariants = false
+
+var (
+	requestSchemasOnce  sync.Once
+	requestSchemasByKey map[int16][]Schema
+)
+
+// requestSchemas returns the request schemas, by version, of an API key with
+// a request modifier. Like supportedApiVersions, the table is built lazily
+// because request schemas are populated in init().
+func requestSchemas(apiKey int16) []Schema {
+	requestSchemasOnce.Do(func() {
+		requestSchemasByKey = map[int16][]Schema{
+			apiKeyProduce:              produceRequestSchemas,
+			apiKeyFetch:                fetchRequestSchemas,
+			apiKeyListOffsets:          listOffsetsRequestSchemas,
+			apiKeyMetadata:             metadataRequestSchemas,
+			apiKeyOffsetCommit:         offsetCommitRequestSchemas,
+			apiKeyOffsetFetch:          offsetFetchRequestSchemas,
+			apiKeyFindCoordinator:      findCoordinatorRequestSchemas,
+			apiKeyJoinGroup:            joinGroupRequestSchemas,
+			apiKeyHeartbeat:            heartbeatRequestSchemas,
+			apiKeyLeaveGroup:           leaveGroupRequestSchemas,
+			apiKeySyncGroup:            syncGroupRequestSchemas,
+			apiKeyDescribeGroups:       describeGroupsRequestSchemas,
+			apiKeyCreateTopics:         createTopicsRequestSchemas,
+			apiKeyDeleteTopics:         deleteTopicsRequestSchemas,
+			apiKeyDeleteRecords:        deleteRecordsRequestSchemas,
+			apiKeyInitProducerId:       initProducerIdRequestSchemas,
+			apiKeyOffsetForLeaderEpoch: offsetForLeaderEpochRequestSchemas,
+			apiKeyAddPartitionsToTxn:   addPartitionsToTxnRequestSchemas,
+			apiKeyAddOffsetsToTxn:      addOffsetsToTxnRequestSchemas,
+			apiKeyEndTxn:               endTxnRequestSchemas,
+			apiKeyTxnOffsetCommit:      txnOffsetCommitRequestSchemas,
+			apiKeyDescribeAcls:         describeAclsRequestSchemas,
+			apiKeyCreateAcls:           createAclsRequestSchemas,
+			apiKeyDeleteAcls:           deleteAclsRequestSchemas,
+			apiKeyDescribeConfigs:      describeConfigsRequestSchemas,
+			apiKeyAlterConfigs:         alterConfigsRequestSchemas,
+			apiKeyCreatePartitions:     createPartitionsRequestSchemas,
+			apiKeyDeleteGroups:         deleteGroupsRequestSchemas,
+			apiKeyOffsetDelete:         offsetDeleteRequestSchemas,
+		}
+	})
+	return requestSchemasByKey[apiKey]
+}
+
+// invariantCheckingRequestModifier verifies every request its modifier
+// rewrites with checkRequestInvariants.
+type invariantCheckingRequestModifier struct {
+	apiKey, apiVersion int16
+	modifier           RequestModifier
+}
+
+func (m *invariantCheckingRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	// The modifier may rewrite requestBytes in place
+	original := bytes.Clone(requestBytes)
+	modified, err := m.modifier.Apply(requestBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkRequestInvariants(m.apiKey, m.apiVersion, original, modified); err != nil {
+		return nil, err
+	}
+	return modified, nil
+}
+
+// checkRequestInvariants verifies that a rewritten request keeps the
+// correlation id and client id of the original, which the client matches
+// responses by, and that the request schema decodes it to its last byte, so
+// the length Bifrost frames it with is right. Both requests hold the request
+// after api key and version.
+func checkRequestInvariants(apiKey, apiVersion int16, original, modified []byte) error {
+	originalHeader, err := requestHeaderPrefix(original)
+	if err != nil {
+		return fmt.Errorf("original request key %d version %d: %w", apiKey, apiVersion, err)
+	}
+	modifiedHeader, err := requestHeaderPrefix(modified)
+	if err != nil {
+		return fmt.Errorf("%w: request key %d version %d: %v", errRequestInvariant, apiKey, apiVersion, err)
+	}
+	if !bytes.Equal(originalHeader, modifiedHeader) {
+		return fmt.Errorf("%w: request key %d version %d changed the correlation id or client id", errRequestInvariant, apiKey, apiVersion)
+	}
+
+	schemas := requestSchemas(apiKey)
+	if apiVersion < 0 || int(apiVersion) >= len(schemas) {
+		return nil
+	}
+	if _, err = DecodeSchema(modified, schemas[apiVersion]); err != nil {
+		return fmt.Errorf("%w: request key %d version %d is malformed: %v", errRequestInvariant, apiKey, apiVersion, err)
+	}
+	return nil
+}
+
+// requestHeaderPrefix returns the correlation id and client id at the start
+// of request.
+func requestHeaderPrefix(request []byte) ([]byte, error) {
+	rd := &realDecoder{raw: request}
+	if _, err := rd.getInt32(); err != nil {
+		return nil, fmt.Errorf("decode correlation id: %w", err)
+	}
+	if _, err := rd.getNullableString(); err != nil {
+		return nil, fmt.Errorf("decode client id: %w", err)
+	}
+	return request[:rd.off], nil
+}
@@ -0,0 +1,7 @@
+//go:build bifrost_invariants
+
+package protocol
+
+func init() {
+	checkInvariants = true
+}
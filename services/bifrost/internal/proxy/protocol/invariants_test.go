@@ -0,0 +1,214 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// Every modifier the package tests obtain from GetRequestModifier checks
+	// its output
+	checkInvariants = true
+}
+
+func TestCheckRequestInvariants(t *testing.T) {
+	schema := heartbeatRequestSchemas[0]
+	request, err := EncodeSchema(&Struct{Schema: schema, Values: []interface{}{
+		int32(7), stringPtr("client"), "group", int32(1), "member",
+	}}, schema)
+	require.NoError(t, err)
+
+	assert.NoError(t, checkRequestInvariants(apiKeyHeartbeat, 0, request, request))
+
+	changedID := append([]byte(nil), request...)
+	binary.BigEndian.PutUint32(changedID, 8)
+	assert.ErrorIs(t, checkRequestInvariants(apiKeyHeartbeat, 0, request, changedID), errRequestInvariant)
+
+	changedClient := append([]byte(nil), request...)
+	changedClient[6] = 'C'
+	assert.ErrorIs(t, checkRequestInvariants(apiKeyHeartbeat, 0, request, changedClient), errRequestInvariant)
+
+	assert.ErrorIs(t, checkRequestInvariants(apiKeyHeartbeat, 0, request, append(request, 0)), errRequestInvariant)
+	assert.ErrorIs(t, checkRequestInvariants(apiKeyHeartbeat, 0, request, request[:3]), errRequestInvariant)
+}
+
+func TestGetRequestModifier_ChecksInvariants(t *testing.T) {
+	modifier, err := GetRequestModifier(apiKeyHeartbeat, 0, RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant-a:" + group },
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &invariantCheckingRequestModifier{}, modifier)
+}
+
+// fuzzSource hands out the bytes of a fuzz input as generator choices,
+// returning zero once they run out so every input yields a valid request.
+type fuzzSource struct {
+	data []byte
+}
+
+func (s *fuzzSource) byte() byte {
+	if len(s.data) == 0 {
+		return 0
+	}
+	b := s.data[0]
+	s.data = s.data[1:]
+	return b
+}
+
+func (s *fuzzSource) intn(n int) int {
+	return int(s.byte()) % n
+}
+
+func (s *fuzzSource) int32() int32 {
+	return int32(uint32(s.byte())<<24 | uint32(s.byte())<<16 | uint32(s.byte())<<8 | uint32(s.byte()))
+}
+
+func (s *fuzzSource) string() string {
+	const alphabet = "abcdefgh-_.:*"
+	b := make([]byte, s.intn(8))
+	for i := range b {
+		b[i] = alphabet[s.intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+// generateStruct builds a random value of schema from src, bounding arrays to
+// a few elements.
+func generateStruct(schema Schema, src *fuzzSource) *Struct {
+	fields := schema.GetFields()
+	values := make([]interface{}, len(fields))
+	for i, bf := range fields {
+		values[i] = generateValue(bf.GetDef(), src)
+	}
+	return &Struct{Schema: schema, Values: values}
+}
+
+func generateValue(def interface{}, src *fuzzSource) interface{} {
+	switch f := def.(type) {
+	case *Mfield:
+		return generateValue(f.Ty, src)
+	case SchemaTaggedFields, *SchemaTaggedFields:
+		tagged := []rawTaggedField{}
+		for i := 0; i < src.intn(3); i++ {
+			tagged = append(tagged, rawTaggedField{tag: int64(100 + i), data: []byte(src.string())})
+		}
+		return tagged
+	case *Array:
+		return generateElements(f.Ty, src)
+	case *CompactArray:
+		return generateElements(f.Ty, src)
+	case *NullableArray:
+		if src.intn(4) == 0 {
+			return nil
+		}
+		return generateElements(f.Ty, src)
+	case *CompactNullableArray:
+		if src.intn(4) == 0 {
+			return nil
+		}
+		return generateElements(f.Ty, src)
+	case *Bool:
+		return src.intn(2) == 1
+	case *Int8:
+		return int8(src.byte())
+	case *Int16:
+		return int16(src.int32())
+	case *Int32:
+		return src.int32()
+	case *Int64:
+		return int64(src.int32())<<32 | int64(uint32(src.int32()))
+	case *Str, *CompactStr:
+		return src.string()
+	case *NullableStr, *CompactNullableStr:
+		if src.intn(4) == 0 {
+			return (*string)(nil)
+		}
+		return stringPtr(src.string())
+	case *Bytes, *CompactBytes:
+		return []byte(src.string())
+	case *Uuid:
+		var id uuid.UUID
+		for i := range id {
+			id[i] = src.byte()
+		}
+		return id
+	case Schema:
+		return generateStruct(f, src)
+	default:
+		panic("unsupported field type")
+	}
+}
+
+func generateElements(elementDef interface{}, src *fuzzSource) []interface{} {
+	elements := make([]interface{}, src.intn(4))
+	for i := range elements {
+		elements[i] = generateValue(elementDef, src)
+	}
+	return elements
+}
+
+// FuzzRequestModifiers feeds random requests that are valid for their schema
+// through the request modifiers and checks that the correlation id and client
+// id survive and the rewritten request is well framed.
+func FuzzRequestModifiers(f *testing.F) {
+	requestSchemas(apiKeyProduce) // builds requestSchemasByKey
+	apiKeys := make([]int16, 0, len(requestSchemasByKey))
+	for apiKey := range requestSchemasByKey {
+		apiKeys = append(apiKeys, apiKey)
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i] < apiKeys[j] })
+
+	// One seed per API key and version, with a few elements per array
+	for i, apiKey := range apiKeys {
+		for version := range requestSchemas(apiKey) {
+			f.Add([]byte{byte(i), byte(version), 0, 0, 0, 42, 3, 'c', 'l', 'i', 2, 2, 'o', 'r', 'd', 1, 7, 5, 3})
+		}
+	}
+
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant-a:" + topic },
+		GroupPrefixer: func(group string) string { return "tenant-a:" + group },
+		TxnIDPrefixer: func(txnID string) string { return "tenant-a:" + txnID },
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		src := &fuzzSource{data: data}
+		apiKey := apiKeys[src.intn(len(apiKeys))]
+		schemas := requestSchemas(apiKey)
+		apiVersion := int16(src.intn(len(schemas)))
+		schema := schemas[apiVersion]
+
+		correlationID := src.int32()
+		var clientID *string
+		if src.intn(4) != 0 {
+			clientID = stringPtr(src.string())
+		}
+		request := generateStruct(schema, src)
+		require.NoError(t, request.Replace("correlation_id", correlationID))
+		require.NoError(t, request.Replace("client_id", clientID))
+		requestBytes, err := EncodeSchema(request, schema)
+		require.NoError(t, err)
+
+		modifier, err := GetRequestModifier(apiKey, apiVersion, cfg)
+		require.NoError(t, err)
+		if modifier == nil {
+			return
+		}
+		modified, err := modifier.Apply(requestBytes)
+		if err != nil {
+			// Rejected requests are forwarded unmodified, so only invariant
+			// violations are failures
+			require.NotErrorIs(t, err, errRequestInvariant, "key %d version %d", apiKey, apiVersion)
+			return
+		}
+
+		decoded, err := DecodeSchema(modified, schema)
+		require.NoError(t, err, "key %d version %d", apiKey, apiVersion)
+		assert.Equal(t, correlationID, decoded.Get("correlation_id"), "key %d version %d", apiKey, apiVersion)
+		assert.Equal(t, clientID, decoded.Get("client_id"), "key %d version %d", apiKey, apiVersion)
+	})
+}
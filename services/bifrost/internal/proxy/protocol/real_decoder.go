@@ -9,6 +9,7 @@ import (
 var errInvalidArrayLength = PacketDecodingError{"invalid array length"}
 var errInvalidStringLength = PacketDecodingError{"invalid string length"}
 var errVarintOverflow = PacketDecodingError{"varint overflow"}
+var errNonMinimalVarint = PacketDecodingError{"non-minimal varint"}
 var errInvalidBool = PacketDecodingError{"invalid bool"}
 var errInvalidByteSliceLength = PacketDecodingError{"invalid byte slice length"}
 var errInvalidCompactLength = PacketDecodingError{"invalid compact length"}
@@ -71,6 +72,13 @@ func (rd *realDecoder) getVarint() (int64, error) {
 		rd.off -= n
 		return -1, errVarintOverflow
 	}
+	// A varint padded with zero continuation groups would be re-encoded
+	// shorter than it was read, so a rewritten request would no longer be
+	// the size its fields were decoded from
+	if n > 1 && rd.raw[rd.off+n-1] == 0 {
+		rd.off += n
+		return -1, errNonMinimalVarint
+	}
 	rd.off += n
 	return int64(tmp), nil
 }
@@ -124,6 +132,13 @@ func (rd *realDecoder) getVarintBytes() ([]byte, error) {
 	if tmp == -1 {
 		return nil, nil
 	}
+	switch {
+	case tmp < 0:
+		return nil, errInvalidByteSliceLength
+	case tmp > int64(rd.remaining()):
+		rd.off = len(rd.raw)
+		return nil, ErrInsufficientData
+	}
 	return rd.getRawBytes(int(tmp))
 }
 
@@ -287,21 +302,24 @@ func (rd *realDecoder) remaining() int {
 	return len(rd.raw) - rd.off
 }
 
+// getCompactLength reads a compact length prefix. The length is checked
+// against the remaining payload before it is converted to an int, so a
+// crafted prefix can neither wrap around nor size an allocation.
 func (rd *realDecoder) getCompactLength() (int, error) {
 	length, err := rd.getVarint()
 	if err != nil {
 		return 0, err
 	}
-	n := int(length - 1)
 
 	switch {
-	case n < 0:
+	case length < 1:
+		// Zero is null, negative is a varint above math.MaxInt64
 		return 0, errInvalidCompactLength
-	case n > rd.remaining():
+	case length-1 > int64(rd.remaining()):
 		rd.off = len(rd.raw)
 		return 0, ErrInsufficientData
 	}
-	return n, nil
+	return int(length - 1), nil
 }
 
 func (rd *realDecoder) getCompactNullableLength() (int, error) {
@@ -309,16 +327,15 @@ func (rd *realDecoder) getCompactNullableLength() (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	n := int(length - 1)
 
 	switch {
-	case n < -1:
+	case length < 0:
 		return 0, errInvalidCompactNullableLength
-	case n > rd.remaining():
+	case length-1 > int64(rd.remaining()):
 		rd.off = len(rd.raw)
 		return 0, ErrInsufficientData
 	}
-	return n, nil
+	return int(length - 1), nil
 }
 
 func (rd *realDecoder) getCompactString() (string, error) {
@@ -343,6 +360,8 @@ func (rd *realDecoder) getCompactNullableString() (*string, error) {
 	return &tmpStr, nil
 }
 
+// getCompactArrayLength bounds an element count by the remaining payload,
+// as every element takes at least one byte.
 func (rd *realDecoder) getCompactArrayLength() (int, error) {
 	return rd.getCompactLength()
 }
@@ -63,6 +63,82 @@ func TestGetInt64Array(t *testing.T) {
 	}
 }
 
+func TestGetVarint(t *testing.T) {
+	tt := []struct {
+		name  string
+		raw   string
+		value int64
+		err   error
+	}{
+		{name: "zero", raw: "00", value: 0},
+		{name: "one byte", raw: "7F", value: 127},
+		{name: "two bytes", raw: "AC02", value: 300},
+		{name: "insufficient data", raw: "AC", err: ErrInsufficientData},
+		{name: "overflow", raw: "FFFFFFFFFFFFFFFFFF02", err: errVarintOverflow},
+		{name: "non-minimal zero", raw: "8000", err: errNonMinimalVarint},
+		{name: "non-minimal", raw: "AC8200", err: errNonMinimalVarint},
+	}
+	for _, tc := range tt {
+		rd := realDecoder{
+			raw: mustHexDecodeString(tc.raw),
+		}
+		value, err := rd.getVarint()
+		if err != nil || tc.err != nil {
+			assert.Equal(t, tc.err, err, tc.name)
+		} else {
+			assert.Equal(t, tc.value, value, tc.name)
+		}
+	}
+}
+
+func TestSchemaTaggedFields_DecodeHugeCount(t *testing.T) {
+	// A count of 2^62 tagged fields must not be allocated
+	rd := &realDecoder{raw: mustHexDecodeString("80808080808080804000")}
+	_, err := SchemaTaggedFields{Name: "tagged_fields"}.decode(rd)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+}
+
+func TestGetCompactLength(t *testing.T) {
+	tt := []struct {
+		name     string
+		raw      string
+		length   int
+		err      error
+		nullable bool
+	}{
+		{name: "empty", raw: "01", length: 0},
+		{name: "3 bytes", raw: "04 616263", length: 3},
+		{name: "null", raw: "00", err: errInvalidCompactLength},
+		{name: "truncated", raw: "04 6162", err: ErrInsufficientData},
+		{name: "missing prefix", raw: "", err: ErrInsufficientData},
+		{name: "truncated prefix", raw: "FF", err: ErrInsufficientData},
+		{name: "max uint32", raw: "FFFFFFFF0F 00", err: ErrInsufficientData},
+		{name: "max int64", raw: "FFFFFFFFFFFFFFFF7F 00", err: ErrInsufficientData},
+		{name: "above max int64", raw: "FFFFFFFFFFFFFFFFFF01 00", err: errInvalidCompactLength},
+		{name: "nullable null", raw: "00", length: -1, nullable: true},
+		{name: "nullable truncated", raw: "04 6162", err: ErrInsufficientData, nullable: true},
+		{name: "nullable max int64", raw: "FFFFFFFFFFFFFFFF7F 00", err: ErrInsufficientData, nullable: true},
+		{name: "nullable above max int64", raw: "FFFFFFFFFFFFFFFFFF01 00", err: errInvalidCompactNullableLength, nullable: true},
+	}
+	for _, tc := range tt {
+		rd := realDecoder{
+			raw: mustHexDecodeString(tc.raw),
+		}
+		var length int
+		var err error
+		if tc.nullable {
+			length, err = rd.getCompactNullableArrayLength()
+		} else {
+			length, err = rd.getCompactArrayLength()
+		}
+		if err != nil || tc.err != nil {
+			assert.Equal(t, tc.err, err, tc.name)
+		} else {
+			assert.Equal(t, tc.length, length, tc.name)
+		}
+	}
+}
+
 func mustHexDecodeString(s string) []byte {
 	s = strings.ReplaceAll(s, " ", "")
 	raw, err := hex.DecodeString(s)
@@ -1,11 +1,23 @@
 package protocol
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type RequestKeyVersion struct {
 	Length     int32
 	ApiKey     int16
 	ApiVersion int16
+
+	// ReceivedAt is when the proxy read the request header. It is not part of
+	// the wire format and is only used to measure request latency.
+	ReceivedAt time.Time
+
+	// ThrottleTimeMs is the minimum throttle_time_ms the proxy reports in the
+	// response, for produce quotas it enforces itself. It is not part of the
+	// wire format; zero leaves the broker's throttle time unchanged.
+	ThrottleTimeMs int32
 }
 
 func (r *RequestKeyVersion) decode(pd packetDecoder) (err error) {
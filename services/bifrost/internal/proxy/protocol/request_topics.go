@@ -0,0 +1,108 @@
+package protocol
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// requestTopicArrays names, per API key, the request array listing the topics
+// the request addresses.
+var requestTopicArrays = map[int16]string{
+	apiKeyProduce:  "topic_data",
+	apiKeyFetch:    "topics",
+	apiKeyMetadata: "topics",
+}
+
+// ListsRequestTopics reports whether RequestTopics can extract topic names
+// from requests of the given API key.
+func ListsRequestTopics(apiKey int16) bool {
+	_, ok := requestTopicArrays[apiKey]
+	return ok
+}
+
+// RequestTopics returns the client-facing topic names a Produce, Fetch or
+// Metadata request addresses, and the IDs of topics it addresses only by ID
+// (Fetch v13+, Metadata v10+). request holds the request after api key and
+// version, starting at the correlation id. Nothing is returned for a Metadata
+// request for all topics.
+func RequestTopics(apiKey, apiVersion int16, request []byte) ([]string, []uuid.UUID, error) {
+	arrayName, ok := requestTopicArrays[apiKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("api key %d does not list topics", apiKey)
+	}
+
+	var (
+		schema Schema
+		err    error
+	)
+	switch apiKey {
+	case apiKeyProduce:
+		schema, err = getProduceRequestSchema(apiVersion)
+	case apiKeyFetch:
+		schema, err = getFetchRequestSchema(apiVersion)
+	case apiKeyMetadata:
+		schema, err = getMetadataRequestSchema(apiVersion)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decoded, err := DecodeSchema(request, schema)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode request key %d version %d: %w", apiKey, apiVersion, err)
+	}
+
+	elements, _ := decoded.Get(arrayName).([]interface{})
+	topics := make([]string, 0, len(elements))
+	var topicIDs []uuid.UUID
+	for _, element := range elements {
+		switch e := element.(type) {
+		case string:
+			// Metadata v0-v8 lists bare topic names
+			topics = append(topics, e)
+		case *Struct:
+			if name := getTopicNameFromStruct(e); name != "" {
+				topics = append(topics, name)
+			} else if topicID, ok := e.Get("topic_id").(uuid.UUID); ok && topicID != uuid.Nil {
+				topicIDs = append(topicIDs, topicID)
+			}
+		}
+	}
+	return topics, topicIDs, nil
+}
+
+// ProduceTopicBytes returns the size of the record batches a Produce request
+// carries for each client-facing topic name. request holds the request after
+// api key and version, starting at the correlation id.
+func ProduceTopicBytes(apiVersion int16, request []byte) (map[string]int, error) {
+	schema, err := getProduceRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := DecodeSchema(request, schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode produce request version %d: %w", apiVersion, err)
+	}
+
+	topicBytes := make(map[string]int)
+	topics, _ := decoded.Get("topic_data").([]interface{})
+	for _, element := range topics {
+		topic, ok := element.(*Struct)
+		if !ok {
+			continue
+		}
+		name := getTopicNameFromStruct(topic)
+		if name == "" {
+			continue
+		}
+		partitions, _ := topic.Get("partition_data").([]interface{})
+		for _, p := range partitions {
+			if partition, ok := p.(*Struct); ok {
+				records, _ := partition.Get("records").([]byte)
+				topicBytes[name] += len(records)
+			}
+		}
+	}
+	return topicBytes, nil
+}
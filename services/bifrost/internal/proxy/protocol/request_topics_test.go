@@ -0,0 +1,99 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestTopics(t *testing.T) {
+	// correlation id, null client id
+	header := []byte{0, 0, 0, 7, 0xff, 0xff}
+
+	t.Run("Produce", func(t *testing.T) {
+		request := append([]byte{}, header...)
+		request = binary.BigEndian.AppendUint16(request, 1)     // acks
+		request = binary.BigEndian.AppendUint32(request, 30000) // timeout_ms
+		request = binary.BigEndian.AppendUint32(request, 2)
+		for _, topic := range []string{"orders", "payments"} {
+			request = appendTestString(request, topic)
+			request = binary.BigEndian.AppendUint32(request, 0) // partition_data
+		}
+		topics, topicIDs, err := RequestTopics(apiKeyProduce, 2, request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"orders", "payments"}, topics)
+		assert.Empty(t, topicIDs)
+	})
+
+	t.Run("Metadata by name", func(t *testing.T) {
+		request := append([]byte{}, header...)
+		request = binary.BigEndian.AppendUint32(request, 1)
+		request = appendTestString(request, "orders")
+		topics, topicIDs, err := RequestTopics(apiKeyMetadata, 1, request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"orders"}, topics)
+		assert.Empty(t, topicIDs)
+	})
+
+	t.Run("Metadata for all topics", func(t *testing.T) {
+		request := append(append([]byte{}, header...), 0xff, 0xff, 0xff, 0xff)
+		topics, topicIDs, err := RequestTopics(apiKeyMetadata, 1, request)
+		require.NoError(t, err)
+		assert.Empty(t, topics)
+		assert.Empty(t, topicIDs)
+	})
+
+	t.Run("Metadata by ID", func(t *testing.T) {
+		ordersID := uuid.New()
+		request := append(append([]byte{}, header...), 0) // header tagged fields
+		request = append(request, 3)                      // topics
+		request = append(request, ordersID[:]...)
+		request = append(request, 0, 0) // null name, tagged fields
+		request = append(request, make([]byte, 16)...)
+		request = append(request, 7)
+		request = append(request, "orders"...)
+		request = append(request, 0)
+		request = append(request, 0, 0, 0) // allow_auto_topic_creation, include_topic_authorized_operations, tagged fields
+		topics, topicIDs, err := RequestTopics(apiKeyMetadata, 12, request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"orders"}, topics)
+		assert.Equal(t, []uuid.UUID{ordersID}, topicIDs)
+	})
+
+	t.Run("unsupported API key", func(t *testing.T) {
+		assert.False(t, ListsRequestTopics(apiKeyCreateTopics))
+		_, _, err := RequestTopics(apiKeyCreateTopics, 0, header)
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated request", func(t *testing.T) {
+		_, _, err := RequestTopics(apiKeyProduce, 2, header)
+		assert.Error(t, err)
+	})
+}
+
+func TestProduceTopicBytes(t *testing.T) {
+	// correlation id, null client id, acks, timeout_ms
+	request := []byte{0, 0, 0, 7, 0xff, 0xff, 0xff, 0xff, 0, 0, 0x75, 0x30}
+	request = binary.BigEndian.AppendUint32(request, 2)
+	request = appendTestString(request, "orders")
+	request = binary.BigEndian.AppendUint32(request, 2)
+	for partition, size := range []int{100, 50} {
+		request = binary.BigEndian.AppendUint32(request, uint32(partition))
+		request = appendTestBytes(request, make([]byte, size))
+	}
+	request = appendTestString(request, "payments")
+	request = binary.BigEndian.AppendUint32(request, 1)
+	request = binary.BigEndian.AppendUint32(request, 0)
+	request = binary.BigEndian.AppendUint32(request, 0xffffffff) // null records
+
+	topicBytes, err := ProduceTopicBytes(2, request)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"orders": 150, "payments": 0}, topicBytes)
+
+	_, err = ProduceTopicBytes(2, request[:20])
+	assert.Error(t, err)
+}
@@ -32,6 +32,14 @@ type RequestModifierConfig struct {
 // GetRequestModifier returns a RequestModifier for the given API key and version.
 // Returns nil if no modification is needed for this request type.
 func GetRequestModifier(apiKey int16, apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	modifier, err := newRequestModifier(apiKey, apiVersion, cfg)
+	if err != nil || modifier == nil || !checkInvariants {
+		return modifier, err
+	}
+	return &invariantCheckingRequestModifier{apiKey: apiKey, apiVersion: apiVersion, modifier: modifier}, nil
+}
+
+func newRequestModifier(apiKey int16, apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
 	switch apiKey {
 	case apiKeyProduce:
 		return newProduceRequestModifier(apiVersion, cfg)
@@ -64,6 +72,32 @@ func GetRequestModifier(apiKey int16, apiVersion int16, cfg RequestModifierConfi
 		return newCreateTopicsRequestModifier(apiVersion, cfg)
 	case apiKeyDeleteTopics:
 		return newDeleteTopicsRequestModifier(apiVersion, cfg)
+	case apiKeyDeleteRecords:
+		return newDeleteRecordsRequestModifier(apiVersion, cfg)
+	case apiKeyInitProducerId:
+		return newInitProducerIdRequestModifier(apiVersion, cfg)
+	case apiKeyOffsetForLeaderEpoch:
+		return newOffsetForLeaderEpochRequestModifier(apiVersion, cfg)
+	case apiKeyAddPartitionsToTxn:
+		return newAddPartitionsToTxnRequestModifier(apiVersion, cfg)
+	case apiKeyAddOffsetsToTxn:
+		return newAddOffsetsToTxnRequestModifier(apiVersion, cfg)
+	case apiKeyEndTxn:
+		return newEndTxnRequestModifier(apiVersion, cfg)
+	case apiKeyTxnOffsetCommit:
+		return newTxnOffsetCommitRequestModifier(apiVersion, cfg)
+	case apiKeyDescribeAcls, apiKeyCreateAcls, apiKeyDeleteAcls:
+		return newAclRequestModifier(apiKey, apiVersion, cfg)
+	case apiKeyDescribeConfigs:
+		return newDescribeConfigsRequestModifier(apiVersion, cfg)
+	case apiKeyAlterConfigs:
+		return newAlterConfigsRequestModifier(apiVersion, cfg)
+	case apiKeyCreatePartitions:
+		return newCreatePartitionsRequestModifier(apiVersion, cfg)
+	case apiKeyDeleteGroups:
+		return newDeleteGroupsRequestModifier(apiVersion, cfg)
+	case apiKeyOffsetDelete:
+		return newOffsetDeleteRequestModifier(apiVersion, cfg)
 	default:
 		// No modification needed for this API
 		return nil, nil
@@ -72,19 +106,35 @@ func GetRequestModifier(apiKey int16, apiVersion int16, cfg RequestModifierConfi
 
 // API key constants (additional ones not in responses.go)
 const (
-	apiKeyProduce        = int16(0)
-	apiKeyFetch          = int16(1)
-	apiKeyListOffsets    = int16(2)
-	apiKeyOffsetCommit   = int16(8)
-	apiKeyOffsetFetch    = int16(9)
-	apiKeyJoinGroup      = int16(11)
-	apiKeyHeartbeat      = int16(12)
-	apiKeyLeaveGroup     = int16(13)
-	apiKeySyncGroup      = int16(14)
-	apiKeyDescribeGroups = int16(15)
-	apiKeyListGroups     = int16(16)
-	apiKeyCreateTopics   = int16(19)
-	apiKeyDeleteTopics   = int16(20)
+	apiKeyProduce              = int16(0)
+	apiKeyFetch                = int16(1)
+	apiKeyListOffsets          = int16(2)
+	apiKeyOffsetCommit         = int16(8)
+	apiKeyOffsetFetch          = int16(9)
+	apiKeyJoinGroup            = int16(11)
+	apiKeyHeartbeat            = int16(12)
+	apiKeyLeaveGroup           = int16(13)
+	apiKeySyncGroup            = int16(14)
+	apiKeyDescribeGroups       = int16(15)
+	apiKeyListGroups           = int16(16)
+	apiKeyApiVersions          = int16(18)
+	apiKeyCreateTopics         = int16(19)
+	apiKeyDeleteTopics         = int16(20)
+	apiKeyDeleteRecords        = int16(21)
+	apiKeyInitProducerId       = int16(22)
+	apiKeyOffsetForLeaderEpoch = int16(23)
+	apiKeyAddPartitionsToTxn   = int16(24)
+	apiKeyAddOffsetsToTxn      = int16(25)
+	apiKeyEndTxn               = int16(26)
+	apiKeyTxnOffsetCommit      = int16(28)
+	apiKeyDescribeAcls         = int16(29)
+	apiKeyCreateAcls           = int16(30)
+	apiKeyDeleteAcls           = int16(31)
+	apiKeyDescribeConfigs      = int16(32)
+	apiKeyAlterConfigs         = int16(33)
+	apiKeyCreatePartitions     = int16(37)
+	apiKeyDeleteGroups         = int16(42)
+	apiKeyOffsetDelete         = int16(47)
 )
 
 // Placeholder modifiers - Phase 1 implementations
@@ -101,6 +151,7 @@ func newProduceRequestModifier(apiVersion int16, cfg RequestModifierConfig) (Req
 	return &produceRequestModifier{
 		schema:        schema,
 		topicPrefixer: cfg.TopicPrefixer,
+		txnIDPrefixer: cfg.TxnIDPrefixer,
 	}, nil
 }
 
@@ -267,16 +318,207 @@ func newCreateTopicsRequestModifier(apiVersion int16, cfg RequestModifierConfig)
 	if cfg.TopicPrefixer == nil {
 		return nil, nil
 	}
-	// TODO: Implement create topics request rewriting
-	return nil, nil
+	schema, err := getCreateTopicsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &createTopicsRequestModifier{
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
 }
 
 func newDeleteTopicsRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
 	if cfg.TopicPrefixer == nil {
 		return nil, nil
 	}
-	// TODO: Implement delete topics request rewriting
-	return nil, nil
+	schema, err := getDeleteTopicsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &deleteTopicsRequestModifier{
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newInitProducerIdRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TxnIDPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getInitProducerIdRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &initProducerIdRequestModifier{
+		schema:        schema,
+		txnIDPrefixer: cfg.TxnIDPrefixer,
+	}, nil
+}
+
+func newAddPartitionsToTxnRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TxnIDPrefixer == nil && cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getAddPartitionsToTxnRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionRequestModifier{
+		name:          "add partitions to txn",
+		schema:        schema,
+		txnIDPrefixer: cfg.TxnIDPrefixer,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newAddOffsetsToTxnRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TxnIDPrefixer == nil && cfg.GroupPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getAddOffsetsToTxnRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionRequestModifier{
+		name:          "add offsets to txn",
+		schema:        schema,
+		txnIDPrefixer: cfg.TxnIDPrefixer,
+		groupPrefixer: cfg.GroupPrefixer,
+	}, nil
+}
+
+func newEndTxnRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TxnIDPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getEndTxnRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionRequestModifier{
+		name:          "end txn",
+		schema:        schema,
+		txnIDPrefixer: cfg.TxnIDPrefixer,
+	}, nil
+}
+
+func newTxnOffsetCommitRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TxnIDPrefixer == nil && cfg.GroupPrefixer == nil && cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getTxnOffsetCommitRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionRequestModifier{
+		name:          "txn offset commit",
+		schema:        schema,
+		txnIDPrefixer: cfg.TxnIDPrefixer,
+		groupPrefixer: cfg.GroupPrefixer,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newDescribeConfigsRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getDescribeConfigsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &configResourcesRequestModifier{
+		name:          "describe configs",
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newAlterConfigsRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getAlterConfigsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &configResourcesRequestModifier{
+		name:          "alter configs",
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newCreatePartitionsRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getCreatePartitionsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &createPartitionsRequestModifier{
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newDeleteRecordsRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getDeleteRecordsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &deleteRecordsRequestModifier{
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newOffsetForLeaderEpochRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getOffsetForLeaderEpochRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &offsetForLeaderEpochRequestModifier{
+		schema:        schema,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
+}
+
+func newDeleteGroupsRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.GroupPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getDeleteGroupsRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &deleteGroupsRequestModifier{
+		schema:        schema,
+		groupPrefixer: cfg.GroupPrefixer,
+	}, nil
+}
+
+func newOffsetDeleteRequestModifier(apiVersion int16, cfg RequestModifierConfig) (RequestModifier, error) {
+	if cfg.GroupPrefixer == nil && cfg.TopicPrefixer == nil {
+		return nil, nil
+	}
+	schema, err := getOffsetDeleteRequestSchema(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &offsetDeleteRequestModifier{
+		schema:        schema,
+		groupPrefixer: cfg.GroupPrefixer,
+		topicPrefixer: cfg.TopicPrefixer,
+	}, nil
 }
 
 // joinGroupRequestModifier prefixes group_id in JoinGroup requests
@@ -407,222 +649,1419 @@ func createJoinGroupRequestSchemas() []Schema {
 		joinGroupV8, // v8
 		joinGroupV8, // v9
 	}
-}
+}
+
+func getJoinGroupRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(joinGroupRequestSchemas) {
+		return nil, fmt.Errorf("unsupported JoinGroup request version %d", apiVersion)
+	}
+	return joinGroupRequestSchemas[apiVersion], nil
+}
+
+// syncGroupRequestModifier prefixes group_id in SyncGroup requests
+type syncGroupRequestModifier struct {
+	schema        Schema
+	groupPrefixer GroupPrefixer
+}
+
+func (m *syncGroupRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode sync group request: %w", err)
+	}
+
+	if err := modifySyncGroupRequest(decoded, m.groupPrefixer); err != nil {
+		return nil, fmt.Errorf("modify sync group request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func modifySyncGroupRequest(decoded *Struct, prefixer GroupPrefixer) error {
+	groupId := decoded.Get("group_id")
+	if groupId == nil {
+		return nil
+	}
+	if gid, ok := groupId.(string); ok && gid != "" {
+		return decoded.Replace("group_id", prefixer(gid))
+	}
+	return nil
+}
+
+var syncGroupRequestSchemas []Schema
+
+func init() {
+	syncGroupRequestSchemas = createSyncGroupRequestSchemas()
+}
+
+func createSyncGroupRequestSchemas() []Schema {
+	assignmentV0 := NewSchema("sync_group_assignment_v0",
+		&Mfield{Name: "member_id", Ty: TypeStr},
+		&Mfield{Name: "assignment", Ty: TypeBytes},
+	)
+
+	// v0-v2: non-flexible
+	syncGroupV0 := NewSchema("sync_group_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "group_id", Ty: TypeStr},
+		&Mfield{Name: "generation_id", Ty: TypeInt32},
+		&Mfield{Name: "member_id", Ty: TypeStr},
+		&Array{Name: "assignments", Ty: assignmentV0},
+	)
+
+	// v3 adds group_instance_id
+	syncGroupV3 := NewSchema("sync_group_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "group_id", Ty: TypeStr},
+		&Mfield{Name: "generation_id", Ty: TypeInt32},
+		&Mfield{Name: "member_id", Ty: TypeStr},
+		&Mfield{Name: "group_instance_id", Ty: TypeNullableStr},
+		&Array{Name: "assignments", Ty: assignmentV0},
+	)
+
+	// v4+ flexible
+	assignmentV4 := NewSchema("sync_group_assignment_v4",
+		&Mfield{Name: "member_id", Ty: TypeCompactStr},
+		&Mfield{Name: "assignment", Ty: TypeCompactBytes},
+		&SchemaTaggedFields{Name: "assignment_tagged_fields"},
+	)
+
+	syncGroupV4 := NewSchema("sync_group_request_v4",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "group_id", Ty: TypeCompactStr},
+		&Mfield{Name: "generation_id", Ty: TypeInt32},
+		&Mfield{Name: "member_id", Ty: TypeCompactStr},
+		&Mfield{Name: "group_instance_id", Ty: TypeCompactNullableStr},
+		&CompactArray{Name: "assignments", Ty: assignmentV4},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	// v5 adds protocol_type and protocol_name
+	syncGroupV5 := NewSchema("sync_group_request_v5",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "group_id", Ty: TypeCompactStr},
+		&Mfield{Name: "generation_id", Ty: TypeInt32},
+		&Mfield{Name: "member_id", Ty: TypeCompactStr},
+		&Mfield{Name: "group_instance_id", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "protocol_type", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "protocol_name", Ty: TypeCompactNullableStr},
+		&CompactArray{Name: "assignments", Ty: assignmentV4},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		syncGroupV0, // v0
+		syncGroupV0, // v1
+		syncGroupV0, // v2
+		syncGroupV3, // v3
+		syncGroupV4, // v4
+		syncGroupV5, // v5
+	}
+}
+
+func getSyncGroupRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(syncGroupRequestSchemas) {
+		return nil, fmt.Errorf("unsupported SyncGroup request version %d", apiVersion)
+	}
+	return syncGroupRequestSchemas[apiVersion], nil
+}
+
+// describeGroupsRequestModifier prefixes group_ids in DescribeGroups requests
+type describeGroupsRequestModifier struct {
+	schema        Schema
+	groupPrefixer GroupPrefixer
+}
+
+func (m *describeGroupsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode describe groups request: %w", err)
+	}
+
+	if err := modifyDescribeGroupsRequest(decoded, m.groupPrefixer); err != nil {
+		return nil, fmt.Errorf("modify describe groups request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func modifyDescribeGroupsRequest(decoded *Struct, prefixer GroupPrefixer) error {
+	if prefixer == nil {
+		return nil
+	}
+
+	groups := decoded.Get("groups")
+	if groups == nil {
+		return nil
+	}
+
+	groupsArray, ok := groups.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	newGroups := make([]interface{}, len(groupsArray))
+	for i, g := range groupsArray {
+		if groupStr, ok := g.(string); ok && groupStr != "" {
+			newGroups[i] = prefixer(groupStr)
+		} else {
+			newGroups[i] = g
+		}
+	}
+
+	return decoded.Replace("groups", newGroups)
+}
+
+var describeGroupsRequestSchemas []Schema
+
+func init() {
+	describeGroupsRequestSchemas = createDescribeGroupsRequestSchemas()
+}
+
+func createDescribeGroupsRequestSchemas() []Schema {
+	// v0-v2: array of group strings
+	describeGroupsV0 := NewSchema("describe_groups_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "groups", Ty: TypeStr},
+	)
+
+	// v3 adds include_authorized_operations
+	describeGroupsV3 := NewSchema("describe_groups_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "groups", Ty: TypeStr},
+		&Mfield{Name: "include_authorized_operations", Ty: TypeBool},
+	)
+
+	// v5+ flexible
+	describeGroupsV5 := NewSchema("describe_groups_request_v5",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "groups", Ty: TypeCompactStr},
+		&Mfield{Name: "include_authorized_operations", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		describeGroupsV0, // v0
+		describeGroupsV0, // v1
+		describeGroupsV0, // v2
+		describeGroupsV3, // v3
+		describeGroupsV3, // v4
+		describeGroupsV5, // v5
+	}
+}
+
+func getDescribeGroupsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(describeGroupsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported DescribeGroups request version %d", apiVersion)
+	}
+	return describeGroupsRequestSchemas[apiVersion], nil
+}
+
+// createTopicsRequestModifier prefixes topic names in CreateTopics requests
+type createTopicsRequestModifier struct {
+	schema        Schema
+	topicPrefixer TopicPrefixer
+}
+
+func (m *createTopicsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode create topics request: %w", err)
+	}
+
+	if err := modifyCreateTopicsRequest(decoded, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify create topics request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func modifyCreateTopicsRequest(decoded *Struct, prefixer TopicPrefixer) error {
+	topicsArray, ok := decoded.Get("topics").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	// Only the topic name is rewritten; assignments, configs and validate_only
+	// are left untouched so they re-encode byte for byte
+	for _, topicElement := range topicsArray {
+		topic, ok := topicElement.(*Struct)
+		if !ok {
+			continue
+		}
+		name, ok := topic.Get("name").(string)
+		if !ok || name == "" {
+			continue
+		}
+		if err := topic.Replace("name", prefixer(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var createTopicsRequestSchemas []Schema
+
+func init() {
+	createTopicsRequestSchemas = createCreateTopicsRequestSchemas()
+}
+
+func createCreateTopicsRequestSchemas() []Schema {
+	assignmentV0 := NewSchema("create_topics_assignment_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Array{Name: "broker_ids", Ty: TypeInt32},
+	)
+
+	configV0 := NewSchema("create_topics_config_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "value", Ty: TypeNullableStr},
+	)
+
+	topicV0 := NewSchema("create_topics_topic_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "num_partitions", Ty: TypeInt32},
+		&Mfield{Name: "replication_factor", Ty: TypeInt16},
+		&Array{Name: "assignments", Ty: assignmentV0},
+		&Array{Name: "configs", Ty: configV0},
+	)
+
+	createTopicsV0 := NewSchema("create_topics_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topics", Ty: topicV0},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+	)
+
+	// v1-v4 add validate_only
+	createTopicsV1 := NewSchema("create_topics_request_v1",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topics", Ty: topicV0},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&Mfield{Name: "validate_only", Ty: TypeBool},
+	)
+
+	// v5+ flexible
+	assignmentV5 := NewSchema("create_topics_assignment_v5",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&CompactArray{Name: "broker_ids", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "assignment_tagged_fields"},
+	)
+
+	configV5 := NewSchema("create_topics_config_v5",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "value", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "config_tagged_fields"},
+	)
+
+	topicV5 := NewSchema("create_topics_topic_v5",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "num_partitions", Ty: TypeInt32},
+		&Mfield{Name: "replication_factor", Ty: TypeInt16},
+		&CompactArray{Name: "assignments", Ty: assignmentV5},
+		&CompactArray{Name: "configs", Ty: configV5},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	createTopicsV5 := NewSchema("create_topics_request_v5",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "topics", Ty: topicV5},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&Mfield{Name: "validate_only", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		createTopicsV0, // v0
+		createTopicsV1, // v1
+		createTopicsV1, // v2
+		createTopicsV1, // v3
+		createTopicsV1, // v4
+		createTopicsV5, // v5
+		createTopicsV5, // v6
+		createTopicsV5, // v7
+	}
+}
+
+func getCreateTopicsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(createTopicsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported CreateTopics request version %d", apiVersion)
+	}
+	return createTopicsRequestSchemas[apiVersion], nil
+}
+
+// deleteTopicsRequestModifier prefixes topic names in DeleteTopics requests
+type deleteTopicsRequestModifier struct {
+	schema        Schema
+	topicPrefixer TopicPrefixer
+}
+
+func (m *deleteTopicsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode delete topics request: %w", err)
+	}
+
+	if err := modifyDeleteTopicsRequest(decoded, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify delete topics request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func modifyDeleteTopicsRequest(decoded *Struct, prefixer TopicPrefixer) error {
+	// v0-v5: topic_names is an array of strings
+	if topicNames, ok := decoded.Get("topic_names").([]interface{}); ok {
+		newTopicNames := make([]interface{}, len(topicNames))
+		for i, t := range topicNames {
+			if name, ok := t.(string); ok && name != "" {
+				newTopicNames[i] = prefixer(name)
+			} else {
+				newTopicNames[i] = t
+			}
+		}
+		return decoded.Replace("topic_names", newTopicNames)
+	}
+
+	// v6+: topics is an array of structs with a nullable name and a topic_id
+	topics, ok := decoded.Get("topics").([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, topicElement := range topics {
+		topic, ok := topicElement.(*Struct)
+		if !ok {
+			continue
+		}
+		// Deletions by topic_id only carry a null name and are left untouched
+		name, ok := topic.Get("name").(*string)
+		if !ok || name == nil || *name == "" {
+			continue
+		}
+		prefixedName := prefixer(*name)
+		if err := topic.Replace("name", &prefixedName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var deleteTopicsRequestSchemas []Schema
+
+func init() {
+	deleteTopicsRequestSchemas = createDeleteTopicsRequestSchemas()
+}
+
+func createDeleteTopicsRequestSchemas() []Schema {
+	// v0-v3: array of topic name strings
+	deleteTopicsV0 := NewSchema("delete_topics_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topic_names", Ty: TypeStr},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+	)
+
+	// v4-v5 flexible
+	deleteTopicsV4 := NewSchema("delete_topics_request_v4",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "topic_names", Ty: TypeCompactStr},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	// v6+ identifies topics by name and/or topic_id
+	topicV6 := NewSchema("delete_topics_topic_v6",
+		&Mfield{Name: "name", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "topic_id", Ty: TypeUuid},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	deleteTopicsV6 := NewSchema("delete_topics_request_v6",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "topics", Ty: topicV6},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteTopicsV0, // v0
+		deleteTopicsV0, // v1
+		deleteTopicsV0, // v2
+		deleteTopicsV0, // v3
+		deleteTopicsV4, // v4
+		deleteTopicsV4, // v5
+		deleteTopicsV6, // v6
+	}
+}
+
+func getDeleteTopicsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(deleteTopicsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported DeleteTopics request version %d", apiVersion)
+	}
+	return deleteTopicsRequestSchemas[apiVersion], nil
+}
+
+// initProducerIdRequestModifier prefixes transactional_id in InitProducerId requests
+type initProducerIdRequestModifier struct {
+	schema        Schema
+	txnIDPrefixer TxnIDPrefixer
+}
+
+func (m *initProducerIdRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode init producer id request: %w", err)
+	}
+
+	if err := modifyTransactionalIdRequest(decoded, m.txnIDPrefixer); err != nil {
+		return nil, fmt.Errorf("modify init producer id request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+// modifyTransactionalIdRequest prefixes the transactional_id field, which is
+// nullable in InitProducerId and Produce. Idempotent (non-transactional)
+// producers send a null id, which is left as is. The prefix matches the one
+// applied to FindCoordinator transaction keys so every transactional request
+// reaches the coordinator state the producer just looked up.
+func modifyTransactionalIdRequest(decoded *Struct, prefixer TxnIDPrefixer) error {
+	switch txnID := decoded.Get("transactional_id").(type) {
+	case string:
+		if txnID != "" {
+			return decoded.Replace("transactional_id", prefixer(txnID))
+		}
+	case *string:
+		if txnID != nil && *txnID != "" {
+			prefixedID := prefixer(*txnID)
+			return decoded.Replace("transactional_id", &prefixedID)
+		}
+	}
+	return nil
+}
+
+var initProducerIdRequestSchemas []Schema
+
+func init() {
+	initProducerIdRequestSchemas = createInitProducerIdRequestSchemas()
+}
+
+func createInitProducerIdRequestSchemas() []Schema {
+	// v0-v1
+	initProducerIdV0 := NewSchema("init_producer_id_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transactional_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transaction_timeout_ms", Ty: TypeInt32},
+	)
+
+	// v2 flexible
+	initProducerIdV2 := NewSchema("init_producer_id_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "transactional_id", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "transaction_timeout_ms", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	// v3+ adds producer_id and producer_epoch for epoch bumps
+	initProducerIdV3 := NewSchema("init_producer_id_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "transactional_id", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "transaction_timeout_ms", Ty: TypeInt32},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		initProducerIdV0, // v0
+		initProducerIdV0, // v1
+		initProducerIdV2, // v2
+		initProducerIdV3, // v3
+		initProducerIdV3, // v4
+	}
+}
+
+func getInitProducerIdRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(initProducerIdRequestSchemas) {
+		return nil, fmt.Errorf("unsupported InitProducerId request version %d", apiVersion)
+	}
+	return initProducerIdRequestSchemas[apiVersion], nil
+}
+
+// transactionRequestModifier prefixes transactional_id and, where present,
+// group_id and topics[].name in the transaction APIs (AddPartitionsToTxn,
+// AddOffsetsToTxn, EndTxn and TxnOffsetCommit)
+type transactionRequestModifier struct {
+	name          string
+	schema        Schema
+	txnIDPrefixer TxnIDPrefixer
+	groupPrefixer GroupPrefixer
+	topicPrefixer TopicPrefixer
+}
+
+func (m *transactionRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s request: %w", m.name, err)
+	}
+
+	if m.txnIDPrefixer != nil {
+		if err := modifyTransactionalIdRequest(decoded, m.txnIDPrefixer); err != nil {
+			return nil, fmt.Errorf("modify %s request: %w", m.name, err)
+		}
+	}
+
+	// group_id and topics[].name share OffsetCommit's layout
+	if err := modifyOffsetCommitRequest(decoded, m.groupPrefixer, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify %s request: %w", m.name, err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+var (
+	addPartitionsToTxnRequestSchemas []Schema
+	addOffsetsToTxnRequestSchemas    []Schema
+	endTxnRequestSchemas             []Schema
+	txnOffsetCommitRequestSchemas    []Schema
+)
+
+func init() {
+	addPartitionsToTxnRequestSchemas = createAddPartitionsToTxnRequestSchemas()
+	addOffsetsToTxnRequestSchemas = createAddOffsetsToTxnRequestSchemas()
+	endTxnRequestSchemas = createEndTxnRequestSchemas()
+	txnOffsetCommitRequestSchemas = createTxnOffsetCommitRequestSchemas()
+}
+
+func createAddPartitionsToTxnRequestSchemas() []Schema {
+	topicV0 := NewSchema("add_partitions_to_txn_topic_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: TypeInt32},
+	)
+
+	// v0-v2
+	addPartitionsToTxnV0 := NewSchema("add_partitions_to_txn_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transactional_id", Ty: TypeStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v3 flexible. v4+ batches transactions and is only sent broker to broker.
+	topicV3 := NewSchema("add_partitions_to_txn_topic_v3",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	addPartitionsToTxnV3 := NewSchema("add_partitions_to_txn_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "transactional_id", Ty: TypeCompactStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&CompactArray{Name: "topics", Ty: topicV3},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		addPartitionsToTxnV0, // v0
+		addPartitionsToTxnV0, // v1
+		addPartitionsToTxnV0, // v2
+		addPartitionsToTxnV3, // v3
+	}
+}
+
+func getAddPartitionsToTxnRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(addPartitionsToTxnRequestSchemas) {
+		return nil, fmt.Errorf("unsupported AddPartitionsToTxn request version %d", apiVersion)
+	}
+	return addPartitionsToTxnRequestSchemas[apiVersion], nil
+}
+
+func createAddOffsetsToTxnRequestSchemas() []Schema {
+	// v0-v2
+	addOffsetsToTxnV0 := NewSchema("add_offsets_to_txn_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transactional_id", Ty: TypeStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Mfield{Name: "group_id", Ty: TypeStr},
+	)
+
+	// v3+ flexible
+	addOffsetsToTxnV3 := NewSchema("add_offsets_to_txn_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "transactional_id", Ty: TypeCompactStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Mfield{Name: "group_id", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		addOffsetsToTxnV0, // v0
+		addOffsetsToTxnV0, // v1
+		addOffsetsToTxnV0, // v2
+		addOffsetsToTxnV3, // v3
+	}
+}
+
+func getAddOffsetsToTxnRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(addOffsetsToTxnRequestSchemas) {
+		return nil, fmt.Errorf("unsupported AddOffsetsToTxn request version %d", apiVersion)
+	}
+	return addOffsetsToTxnRequestSchemas[apiVersion], nil
+}
+
+func createEndTxnRequestSchemas() []Schema {
+	// v0-v2
+	endTxnV0 := NewSchema("end_txn_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transactional_id", Ty: TypeStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Mfield{Name: "committed", Ty: TypeBool},
+	)
+
+	// v3+ flexible
+	endTxnV3 := NewSchema("end_txn_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "transactional_id", Ty: TypeCompactStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Mfield{Name: "committed", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		endTxnV0, // v0
+		endTxnV0, // v1
+		endTxnV0, // v2
+		endTxnV3, // v3
+	}
+}
+
+func getEndTxnRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(endTxnRequestSchemas) {
+		return nil, fmt.Errorf("unsupported EndTxn request version %d", apiVersion)
+	}
+	return endTxnRequestSchemas[apiVersion], nil
+}
+
+func createTxnOffsetCommitRequestSchemas() []Schema {
+	partitionV0 := NewSchema("txn_offset_commit_partition_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "committed_offset", Ty: TypeInt64},
+		&Mfield{Name: "committed_metadata", Ty: TypeNullableStr},
+	)
+
+	topicV0 := NewSchema("txn_offset_commit_topic_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0-v1
+	txnOffsetCommitV0 := NewSchema("txn_offset_commit_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transactional_id", Ty: TypeStr},
+		&Mfield{Name: "group_id", Ty: TypeStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v2 adds committed_leader_epoch
+	partitionV2 := NewSchema("txn_offset_commit_partition_v2",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "committed_offset", Ty: TypeInt64},
+		&Mfield{Name: "committed_leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "committed_metadata", Ty: TypeNullableStr},
+	)
+
+	topicV2 := NewSchema("txn_offset_commit_topic_v2",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV2},
+	)
+
+	txnOffsetCommitV2 := NewSchema("txn_offset_commit_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "transactional_id", Ty: TypeStr},
+		&Mfield{Name: "group_id", Ty: TypeStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Array{Name: "topics", Ty: topicV2},
+	)
+
+	// v3+ flexible, adds group membership fields
+	partitionV3 := NewSchema("txn_offset_commit_partition_v3",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "committed_offset", Ty: TypeInt64},
+		&Mfield{Name: "committed_leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "committed_metadata", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
+	)
+
+	topicV3 := NewSchema("txn_offset_commit_topic_v3",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: partitionV3},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	txnOffsetCommitV3 := NewSchema("txn_offset_commit_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "transactional_id", Ty: TypeCompactStr},
+		&Mfield{Name: "group_id", Ty: TypeCompactStr},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&Mfield{Name: "generation_id", Ty: TypeInt32},
+		&Mfield{Name: "member_id", Ty: TypeCompactStr},
+		&Mfield{Name: "group_instance_id", Ty: TypeCompactNullableStr},
+		&CompactArray{Name: "topics", Ty: topicV3},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		txnOffsetCommitV0, // v0
+		txnOffsetCommitV0, // v1
+		txnOffsetCommitV2, // v2
+		txnOffsetCommitV3, // v3
+	}
+}
+
+func getTxnOffsetCommitRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(txnOffsetCommitRequestSchemas) {
+		return nil, fmt.Errorf("unsupported TxnOffsetCommit request version %d", apiVersion)
+	}
+	return txnOffsetCommitRequestSchemas[apiVersion], nil
+}
+
+// createPartitionsRequestModifier prefixes topic names in CreatePartitions requests
+type createPartitionsRequestModifier struct {
+	schema        Schema
+	topicPrefixer TopicPrefixer
+}
+
+func (m *createPartitionsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode create partitions request: %w", err)
+	}
+
+	// CreatePartitions uses the same topics[].name layout as CreateTopics
+	if err := modifyCreateTopicsRequest(decoded, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify create partitions request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+var createPartitionsRequestSchemas []Schema
+
+func init() {
+	createPartitionsRequestSchemas = createCreatePartitionsRequestSchemas()
+}
+
+func createCreatePartitionsRequestSchemas() []Schema {
+	assignmentV0 := NewSchema("create_partitions_assignment_v0",
+		&Array{Name: "broker_ids", Ty: TypeInt32},
+	)
+
+	topicV0 := NewSchema("create_partitions_topic_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "count", Ty: TypeInt32},
+		&NullableArray{Name: "assignments", Ty: assignmentV0},
+	)
+
+	// v0-v1
+	createPartitionsV0 := NewSchema("create_partitions_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topics", Ty: topicV0},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&Mfield{Name: "validate_only", Ty: TypeBool},
+	)
+
+	// v2+ flexible
+	assignmentV2 := NewSchema("create_partitions_assignment_v2",
+		&CompactArray{Name: "broker_ids", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "assignment_tagged_fields"},
+	)
+
+	topicV2 := NewSchema("create_partitions_topic_v2",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "count", Ty: TypeInt32},
+		&CompactNullableArray{Name: "assignments", Ty: assignmentV2},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	createPartitionsV2 := NewSchema("create_partitions_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "topics", Ty: topicV2},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&Mfield{Name: "validate_only", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		createPartitionsV0, // v0
+		createPartitionsV0, // v1
+		createPartitionsV2, // v2
+		createPartitionsV2, // v3
+	}
+}
+
+func getCreatePartitionsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(createPartitionsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported CreatePartitions request version %d", apiVersion)
+	}
+	return createPartitionsRequestSchemas[apiVersion], nil
+}
+
+// deleteRecordsRequestModifier prefixes topic names in DeleteRecords requests
+type deleteRecordsRequestModifier struct {
+	schema        Schema
+	topicPrefixer TopicPrefixer
+}
+
+func (m *deleteRecordsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode delete records request: %w", err)
+	}
+
+	// DeleteRecords uses the same topics[].name layout as CreateTopics
+	if err := modifyCreateTopicsRequest(decoded, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify delete records request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+var deleteRecordsRequestSchemas []Schema
+
+func init() {
+	deleteRecordsRequestSchemas = createDeleteRecordsRequestSchemas()
+}
+
+func createDeleteRecordsRequestSchemas() []Schema {
+	partitionV0 := NewSchema("delete_records_partition_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "offset", Ty: TypeInt64},
+	)
+
+	topicV0 := NewSchema("delete_records_topic_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0-v1
+	deleteRecordsV0 := NewSchema("delete_records_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topics", Ty: topicV0},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+	)
+
+	// v2+ flexible
+	partitionV2 := NewSchema("delete_records_partition_v2",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "offset", Ty: TypeInt64},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
+	)
+
+	topicV2 := NewSchema("delete_records_topic_v2",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: partitionV2},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	deleteRecordsV2 := NewSchema("delete_records_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "topics", Ty: topicV2},
+		&Mfield{Name: "timeout_ms", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteRecordsV0, // v0
+		deleteRecordsV0, // v1
+		deleteRecordsV2, // v2
+	}
+}
+
+func getDeleteRecordsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(deleteRecordsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported DeleteRecords request version %d", apiVersion)
+	}
+	return deleteRecordsRequestSchemas[apiVersion], nil
+}
+
+// offsetForLeaderEpochRequestModifier prefixes topic names in
+// OffsetForLeaderEpoch requests
+type offsetForLeaderEpochRequestModifier struct {
+	schema        Schema
+	topicPrefixer TopicPrefixer
+}
+
+func (m *offsetForLeaderEpochRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode offset for leader epoch request: %w", err)
+	}
+
+	// OffsetForLeaderEpoch uses the same topics[].topic layout as Fetch v0-v12;
+	// the leader epochs are left untouched so they re-encode byte for byte
+	if err := modifyFetchRequest(decoded, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify offset for leader epoch request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+var offsetForLeaderEpochRequestSchemas []Schema
+
+func init() {
+	offsetForLeaderEpochRequestSchemas = createOffsetForLeaderEpochRequestSchemas()
+}
+
+func createOffsetForLeaderEpochRequestSchemas() []Schema {
+	partitionV0 := NewSchema("offset_for_leader_epoch_partition_v0",
+		&Mfield{Name: "partition", Ty: TypeInt32},
+		&Mfield{Name: "leader_epoch", Ty: TypeInt32},
+	)
+
+	topicV0 := NewSchema("offset_for_leader_epoch_topic_v0",
+		&Mfield{Name: "topic", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0-v1
+	offsetForLeaderEpochV0 := NewSchema("offset_for_leader_epoch_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v2 adds current_leader_epoch
+	partitionV2 := NewSchema("offset_for_leader_epoch_partition_v2",
+		&Mfield{Name: "partition", Ty: TypeInt32},
+		&Mfield{Name: "current_leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "leader_epoch", Ty: TypeInt32},
+	)
+
+	topicV2 := NewSchema("offset_for_leader_epoch_topic_v2",
+		&Mfield{Name: "topic", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV2},
+	)
+
+	offsetForLeaderEpochV2 := NewSchema("offset_for_leader_epoch_request_v2",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "topics", Ty: topicV2},
+	)
+
+	// v3 adds replica_id
+	offsetForLeaderEpochV3 := NewSchema("offset_for_leader_epoch_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Mfield{Name: "replica_id", Ty: TypeInt32},
+		&Array{Name: "topics", Ty: topicV2},
+	)
+
+	// v4+ flexible
+	partitionV4 := NewSchema("offset_for_leader_epoch_partition_v4",
+		&Mfield{Name: "partition", Ty: TypeInt32},
+		&Mfield{Name: "current_leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "leader_epoch", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
+	)
+
+	topicV4 := NewSchema("offset_for_leader_epoch_topic_v4",
+		&Mfield{Name: "topic", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: partitionV4},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	offsetForLeaderEpochV4 := NewSchema("offset_for_leader_epoch_request_v4",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "replica_id", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV4},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	return []Schema{
+		offsetForLeaderEpochV0, // v0
+		offsetForLeaderEpochV0, // v1
+		offsetForLeaderEpochV2, // v2
+		offsetForLeaderEpochV3, // v3
+		offsetForLeaderEpochV4, // v4
+	}
+}
+
+func getOffsetForLeaderEpochRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(offsetForLeaderEpochRequestSchemas) {
+		return nil, fmt.Errorf("unsupported OffsetForLeaderEpoch request version %d", apiVersion)
+	}
+	return offsetForLeaderEpochRequestSchemas[apiVersion], nil
+}
+
+// configResourceTypeTopic is the resource_type of topic resources in the
+// config APIs. Broker (4) and broker logger (8) resources are left untouched.
+const configResourceTypeTopic = int8(2)
+
+// renameTopicConfigResources applies rename to the resource_name of every
+// topic resource in the named array of a DescribeConfigs or AlterConfigs
+// request or response.
+func renameTopicConfigResources(decoded *Struct, arrayName string, rename func(string) string) error {
+	resources, ok := decoded.Get(arrayName).([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, resourceElement := range resources {
+		resource, ok := resourceElement.(*Struct)
+		if !ok {
+			continue
+		}
+		if resourceType, ok := resource.Get("resource_type").(int8); !ok || resourceType != configResourceTypeTopic {
+			continue
+		}
+		name, ok := resource.Get("resource_name").(string)
+		if !ok || name == "" {
+			continue
+		}
+		if renamed := rename(name); renamed != name {
+			if err := resource.Replace("resource_name", renamed); err != nil {
+				return err
+			}
+		}
+	}
 
-func getJoinGroupRequestSchema(apiVersion int16) (Schema, error) {
-	if apiVersion < 0 || int(apiVersion) >= len(joinGroupRequestSchemas) {
-		return nil, fmt.Errorf("unsupported JoinGroup request version %d", apiVersion)
-	}
-	return joinGroupRequestSchemas[apiVersion], nil
+	return nil
 }
 
-// syncGroupRequestModifier prefixes group_id in SyncGroup requests
-type syncGroupRequestModifier struct {
+// configResourcesRequestModifier prefixes topic resource names in
+// DescribeConfigs and AlterConfigs requests
+type configResourcesRequestModifier struct {
+	name          string
 	schema        Schema
-	groupPrefixer GroupPrefixer
+	topicPrefixer TopicPrefixer
 }
 
-func (m *syncGroupRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+func (m *configResourcesRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
 	decoded, err := DecodeSchema(requestBytes, m.schema)
 	if err != nil {
-		return nil, fmt.Errorf("decode sync group request: %w", err)
+		return nil, fmt.Errorf("decode %s request: %w", m.name, err)
 	}
 
-	if err := modifySyncGroupRequest(decoded, m.groupPrefixer); err != nil {
-		return nil, fmt.Errorf("modify sync group request: %w", err)
+	if err := renameTopicConfigResources(decoded, "resources", m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify %s request: %w", m.name, err)
 	}
 
 	return EncodeSchema(decoded, m.schema)
 }
 
-func modifySyncGroupRequest(decoded *Struct, prefixer GroupPrefixer) error {
-	groupId := decoded.Get("group_id")
-	if groupId == nil {
-		return nil
-	}
-	if gid, ok := groupId.(string); ok && gid != "" {
-		return decoded.Replace("group_id", prefixer(gid))
-	}
-	return nil
-}
-
-var syncGroupRequestSchemas []Schema
+var (
+	describeConfigsRequestSchemas []Schema
+	alterConfigsRequestSchemas    []Schema
+)
 
 func init() {
-	syncGroupRequestSchemas = createSyncGroupRequestSchemas()
+	describeConfigsRequestSchemas = createDescribeConfigsRequestSchemas()
+	alterConfigsRequestSchemas = createAlterConfigsRequestSchemas()
 }
 
-func createSyncGroupRequestSchemas() []Schema {
-	assignmentV0 := NewSchema("sync_group_assignment_v0",
-		&Mfield{Name: "member_id", Ty: TypeStr},
-		&Mfield{Name: "assignment", Ty: TypeBytes},
+func createDescribeConfigsRequestSchemas() []Schema {
+	resourceV0 := NewSchema("describe_configs_resource_v0",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&NullableArray{Name: "configuration_keys", Ty: TypeStr},
 	)
 
-	// v0-v2: non-flexible
-	syncGroupV0 := NewSchema("sync_group_request_v0",
+	describeConfigsV0 := NewSchema("describe_configs_request_v0",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
-		&Mfield{Name: "group_id", Ty: TypeStr},
-		&Mfield{Name: "generation_id", Ty: TypeInt32},
-		&Mfield{Name: "member_id", Ty: TypeStr},
-		&Array{Name: "assignments", Ty: assignmentV0},
+		&Array{Name: "resources", Ty: resourceV0},
 	)
 
-	// v3 adds group_instance_id
-	syncGroupV3 := NewSchema("sync_group_request_v3",
+	// v1-v2 add include_synonyms
+	describeConfigsV1 := NewSchema("describe_configs_request_v1",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
-		&Mfield{Name: "group_id", Ty: TypeStr},
-		&Mfield{Name: "generation_id", Ty: TypeInt32},
-		&Mfield{Name: "member_id", Ty: TypeStr},
-		&Mfield{Name: "group_instance_id", Ty: TypeNullableStr},
-		&Array{Name: "assignments", Ty: assignmentV0},
+		&Array{Name: "resources", Ty: resourceV0},
+		&Mfield{Name: "include_synonyms", Ty: TypeBool},
+	)
+
+	// v3 adds include_documentation
+	describeConfigsV3 := NewSchema("describe_configs_request_v3",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "resources", Ty: resourceV0},
+		&Mfield{Name: "include_synonyms", Ty: TypeBool},
+		&Mfield{Name: "include_documentation", Ty: TypeBool},
 	)
 
 	// v4+ flexible
-	assignmentV4 := NewSchema("sync_group_assignment_v4",
-		&Mfield{Name: "member_id", Ty: TypeCompactStr},
-		&Mfield{Name: "assignment", Ty: TypeCompactBytes},
-		&SchemaTaggedFields{Name: "assignment_tagged_fields"},
+	resourceV4 := NewSchema("describe_configs_resource_v4",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&CompactNullableArray{Name: "configuration_keys", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "resource_tagged_fields"},
 	)
 
-	syncGroupV4 := NewSchema("sync_group_request_v4",
+	describeConfigsV4 := NewSchema("describe_configs_request_v4",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
 		&SchemaTaggedFields{Name: "header_tagged_fields"},
-		&Mfield{Name: "group_id", Ty: TypeCompactStr},
-		&Mfield{Name: "generation_id", Ty: TypeInt32},
-		&Mfield{Name: "member_id", Ty: TypeCompactStr},
-		&Mfield{Name: "group_instance_id", Ty: TypeCompactNullableStr},
-		&CompactArray{Name: "assignments", Ty: assignmentV4},
+		&CompactArray{Name: "resources", Ty: resourceV4},
+		&Mfield{Name: "include_synonyms", Ty: TypeBool},
+		&Mfield{Name: "include_documentation", Ty: TypeBool},
 		&SchemaTaggedFields{Name: "request_tagged_fields"},
 	)
 
-	// v5 adds protocol_type and protocol_name
-	syncGroupV5 := NewSchema("sync_group_request_v5",
+	return []Schema{
+		describeConfigsV0, // v0
+		describeConfigsV1, // v1
+		describeConfigsV1, // v2
+		describeConfigsV3, // v3
+		describeConfigsV4, // v4
+	}
+}
+
+func getDescribeConfigsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(describeConfigsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported DescribeConfigs request version %d", apiVersion)
+	}
+	return describeConfigsRequestSchemas[apiVersion], nil
+}
+
+func createAlterConfigsRequestSchemas() []Schema {
+	configV0 := NewSchema("alter_configs_config_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "value", Ty: TypeNullableStr},
+	)
+
+	resourceV0 := NewSchema("alter_configs_resource_v0",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Array{Name: "configs", Ty: configV0},
+	)
+
+	// v0-v1
+	alterConfigsV0 := NewSchema("alter_configs_request_v0",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&Array{Name: "resources", Ty: resourceV0},
+		&Mfield{Name: "validate_only", Ty: TypeBool},
+	)
+
+	// v2+ flexible
+	configV2 := NewSchema("alter_configs_config_v2",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "value", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "config_tagged_fields"},
+	)
+
+	resourceV2 := NewSchema("alter_configs_resource_v2",
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&CompactArray{Name: "configs", Ty: configV2},
+		&SchemaTaggedFields{Name: "resource_tagged_fields"},
+	)
+
+	alterConfigsV2 := NewSchema("alter_configs_request_v2",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
 		&SchemaTaggedFields{Name: "header_tagged_fields"},
-		&Mfield{Name: "group_id", Ty: TypeCompactStr},
-		&Mfield{Name: "generation_id", Ty: TypeInt32},
-		&Mfield{Name: "member_id", Ty: TypeCompactStr},
-		&Mfield{Name: "group_instance_id", Ty: TypeCompactNullableStr},
-		&Mfield{Name: "protocol_type", Ty: TypeCompactNullableStr},
-		&Mfield{Name: "protocol_name", Ty: TypeCompactNullableStr},
-		&CompactArray{Name: "assignments", Ty: assignmentV4},
+		&CompactArray{Name: "resources", Ty: resourceV2},
+		&Mfield{Name: "validate_only", Ty: TypeBool},
 		&SchemaTaggedFields{Name: "request_tagged_fields"},
 	)
 
 	return []Schema{
-		syncGroupV0, // v0
-		syncGroupV0, // v1
-		syncGroupV0, // v2
-		syncGroupV3, // v3
-		syncGroupV4, // v4
-		syncGroupV5, // v5
+		alterConfigsV0, // v0
+		alterConfigsV0, // v1
+		alterConfigsV2, // v2
 	}
 }
 
-func getSyncGroupRequestSchema(apiVersion int16) (Schema, error) {
-	if apiVersion < 0 || int(apiVersion) >= len(syncGroupRequestSchemas) {
-		return nil, fmt.Errorf("unsupported SyncGroup request version %d", apiVersion)
+func getAlterConfigsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(alterConfigsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported AlterConfigs request version %d", apiVersion)
 	}
-	return syncGroupRequestSchemas[apiVersion], nil
+	return alterConfigsRequestSchemas[apiVersion], nil
 }
 
-// describeGroupsRequestModifier prefixes group_ids in DescribeGroups requests
-type describeGroupsRequestModifier struct {
+// deleteGroupsRequestModifier prefixes groups_names in DeleteGroups requests
+type deleteGroupsRequestModifier struct {
 	schema        Schema
 	groupPrefixer GroupPrefixer
 }
 
-func (m *describeGroupsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+func (m *deleteGroupsRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
 	decoded, err := DecodeSchema(requestBytes, m.schema)
 	if err != nil {
-		return nil, fmt.Errorf("decode describe groups request: %w", err)
+		return nil, fmt.Errorf("decode delete groups request: %w", err)
 	}
 
-	if err := modifyDescribeGroupsRequest(decoded, m.groupPrefixer); err != nil {
-		return nil, fmt.Errorf("modify describe groups request: %w", err)
+	if err := modifyDeleteGroupsRequest(decoded, m.groupPrefixer); err != nil {
+		return nil, fmt.Errorf("modify delete groups request: %w", err)
 	}
 
 	return EncodeSchema(decoded, m.schema)
 }
 
-func modifyDescribeGroupsRequest(decoded *Struct, prefixer GroupPrefixer) error {
-	if prefixer == nil {
-		return nil
-	}
-
-	groups := decoded.Get("groups")
-	if groups == nil {
-		return nil
-	}
-
-	groupsArray, ok := groups.([]interface{})
+func modifyDeleteGroupsRequest(decoded *Struct, prefixer GroupPrefixer) error {
+	groupsNames, ok := decoded.Get("groups_names").([]interface{})
 	if !ok {
 		return nil
 	}
 
-	newGroups := make([]interface{}, len(groupsArray))
-	for i, g := range groupsArray {
+	newGroupsNames := make([]interface{}, len(groupsNames))
+	for i, g := range groupsNames {
 		if groupStr, ok := g.(string); ok && groupStr != "" {
-			newGroups[i] = prefixer(groupStr)
+			newGroupsNames[i] = prefixer(groupStr)
 		} else {
-			newGroups[i] = g
+			newGroupsNames[i] = g
 		}
 	}
 
-	return decoded.Replace("groups", newGroups)
+	return decoded.Replace("groups_names", newGroupsNames)
 }
 
-var describeGroupsRequestSchemas []Schema
+var deleteGroupsRequestSchemas []Schema
 
 func init() {
-	describeGroupsRequestSchemas = createDescribeGroupsRequestSchemas()
+	deleteGroupsRequestSchemas = createDeleteGroupsRequestSchemas()
 }
 
-func createDescribeGroupsRequestSchemas() []Schema {
-	// v0-v2: array of group strings
-	describeGroupsV0 := NewSchema("describe_groups_request_v0",
+func createDeleteGroupsRequestSchemas() []Schema {
+	// v0-v1: array of group id strings
+	deleteGroupsV0 := NewSchema("delete_groups_request_v0",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
-		&Array{Name: "groups", Ty: TypeStr},
+		&Array{Name: "groups_names", Ty: TypeStr},
 	)
 
-	// v3 adds include_authorized_operations
-	describeGroupsV3 := NewSchema("describe_groups_request_v3",
+	// v2+ flexible
+	deleteGroupsV2 := NewSchema("delete_groups_request_v2",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
-		&Array{Name: "groups", Ty: TypeStr},
-		&Mfield{Name: "include_authorized_operations", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactArray{Name: "groups_names", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
 	)
 
-	// v5+ flexible
-	describeGroupsV5 := NewSchema("describe_groups_request_v5",
+	return []Schema{
+		deleteGroupsV0, // v0
+		deleteGroupsV0, // v1
+		deleteGroupsV2, // v2
+	}
+}
+
+func getDeleteGroupsRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(deleteGroupsRequestSchemas) {
+		return nil, fmt.Errorf("unsupported DeleteGroups request version %d", apiVersion)
+	}
+	return deleteGroupsRequestSchemas[apiVersion], nil
+}
+
+// offsetDeleteRequestModifier prefixes group_id and topics in OffsetDelete requests
+type offsetDeleteRequestModifier struct {
+	schema        Schema
+	groupPrefixer GroupPrefixer
+	topicPrefixer TopicPrefixer
+}
+
+func (m *offsetDeleteRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(requestBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode offset delete request: %w", err)
+	}
+
+	// OffsetDelete uses the same group_id + topics[].name layout as OffsetCommit
+	if err := modifyOffsetCommitRequest(decoded, m.groupPrefixer, m.topicPrefixer); err != nil {
+		return nil, fmt.Errorf("modify offset delete request: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+var offsetDeleteRequestSchemas []Schema
+
+func init() {
+	offsetDeleteRequestSchemas = createOffsetDeleteRequestSchemas()
+}
+
+func createOffsetDeleteRequestSchemas() []Schema {
+	partitionV0 := NewSchema("offset_delete_partition_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+	)
+
+	topicV0 := NewSchema("offset_delete_topic_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0: correlation_id, client_id, group_id, topics[] (no flexible versions)
+	offsetDeleteV0 := NewSchema("offset_delete_request_v0",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
-		&SchemaTaggedFields{Name: "header_tagged_fields"},
-		&CompactArray{Name: "groups", Ty: TypeCompactStr},
-		&Mfield{Name: "include_authorized_operations", Ty: TypeBool},
-		&SchemaTaggedFields{Name: "request_tagged_fields"},
+		&Mfield{Name: "group_id", Ty: TypeStr},
+		&Array{Name: "topics", Ty: topicV0},
 	)
 
 	return []Schema{
-		describeGroupsV0, // v0
-		describeGroupsV0, // v1
-		describeGroupsV0, // v2
-		describeGroupsV3, // v3
-		describeGroupsV3, // v4
-		describeGroupsV5, // v5
+		offsetDeleteV0, // v0
 	}
 }
 
-func getDescribeGroupsRequestSchema(apiVersion int16) (Schema, error) {
-	if apiVersion < 0 || int(apiVersion) >= len(describeGroupsRequestSchemas) {
-		return nil, fmt.Errorf("unsupported DescribeGroups request version %d", apiVersion)
+func getOffsetDeleteRequestSchema(apiVersion int16) (Schema, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(offsetDeleteRequestSchemas) {
+		return nil, fmt.Errorf("unsupported OffsetDelete request version %d", apiVersion)
 	}
-	return describeGroupsRequestSchemas[apiVersion], nil
+	return offsetDeleteRequestSchemas[apiVersion], nil
 }
 
 // offsetCommitRequestModifier prefixes group_id and topics in OffsetCommit requests
@@ -1542,27 +2981,52 @@ func createMetadataRequestSchemas() []Schema {
 
 	// Metadata v9+: uses compact arrays, compact strings, and tagged fields
 	// Request header v2: CorrelationID + ClientID (NULLABLE_STRING) + TAG_BUFFER
+	// A null topics array requests all topics
 	topicV9 := NewSchema("topic_v9",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	metadataRequestV9 := NewSchema("metadata_request_v9",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactNullableArray{Name: "topics", Ty: topicV9},
+		&Mfield{Name: "allow_auto_topic_creation", Ty: TypeBool},
+		&Mfield{Name: "include_cluster_authorized_operations", Ty: TypeBool},
+		&Mfield{Name: "include_topic_authorized_operations", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	// Metadata v10: adds topic_id, topic names become nullable
+	topicV10 := NewSchema("topic_v10",
 		&Mfield{Name: "topic_id", Ty: TypeUuid},
 		&Mfield{Name: "name", Ty: TypeCompactNullableStr},
 		&SchemaTaggedFields{Name: "topic_tagged_fields"},
 	)
 
-	metadataRequestV9 := NewSchema("metadata_request_v9",
+	metadataRequestV10 := NewSchema("metadata_request_v10",
 		&Mfield{Name: "correlation_id", Ty: TypeInt32},
 		&Mfield{Name: "client_id", Ty: TypeNullableStr},
 		&SchemaTaggedFields{Name: "header_tagged_fields"},
-		&CompactArray{Name: "topics", Ty: topicV9},
+		&CompactNullableArray{Name: "topics", Ty: topicV10},
 		&Mfield{Name: "allow_auto_topic_creation", Ty: TypeBool},
 		&Mfield{Name: "include_cluster_authorized_operations", Ty: TypeBool},
 		&Mfield{Name: "include_topic_authorized_operations", Ty: TypeBool},
 		&SchemaTaggedFields{Name: "request_tagged_fields"},
 	)
 
-	// v10-v12 are similar to v9 with minor changes
-	metadataRequestV10 := metadataRequestV9
-	metadataRequestV11 := metadataRequestV9
-	metadataRequestV12 := metadataRequestV9
+	// Metadata v11+: removes include_cluster_authorized_operations
+	metadataRequestV11 := NewSchema("metadata_request_v11",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&CompactNullableArray{Name: "topics", Ty: topicV10},
+		&Mfield{Name: "allow_auto_topic_creation", Ty: TypeBool},
+		&Mfield{Name: "include_topic_authorized_operations", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+	metadataRequestV12 := metadataRequestV11
 
 	return []Schema{
 		metadataRequestV0, // v0
@@ -1592,6 +3056,7 @@ func getMetadataRequestSchema(apiVersion int16) (Schema, error) {
 type produceRequestModifier struct {
 	schema        Schema
 	topicPrefixer TopicPrefixer
+	txnIDPrefixer TxnIDPrefixer
 }
 
 func (m *produceRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
@@ -1604,6 +3069,13 @@ func (m *produceRequestModifier) Apply(requestBytes []byte) ([]byte, error) {
 		return nil, fmt.Errorf("modify produce request: %w", err)
 	}
 
+	// Transactional produces carry the producer's transactional_id (v3+)
+	if m.txnIDPrefixer != nil {
+		if err := modifyTransactionalIdRequest(decoded, m.txnIDPrefixer); err != nil {
+			return nil, fmt.Errorf("modify produce request: %w", err)
+		}
+	}
+
 	return EncodeSchema(decoded, m.schema)
 }
 
@@ -1957,10 +3429,9 @@ func modifyFetchRequest(decoded *Struct, prefixer TopicPrefixer) error {
 		if !ok {
 			continue
 		}
-		// Try "topic" field (v0-v12) then "topic_id" for UUID-based (v13+)
+		// v13+ identifies topics by topic_id, which needs no prefixing
 		nameField := topic.Get("topic")
 		if nameField == nil {
-			// For v13+, topic name might be empty if using topic_id
 			continue
 		}
 		var topicName string
@@ -2165,6 +3636,53 @@ func createFetchRequestSchemas() []Schema {
 		&SchemaTaggedFields{Name: "request_tagged_fields"},
 	)
 
+	// Fetch v13+ identifies topics by topic_id instead of name
+	topicV13 := NewSchema("fetch_topic_v13",
+		&Mfield{Name: "topic_id", Ty: TypeUuid},
+		&CompactArray{Name: "partitions", Ty: partitionV12},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	forgottenTopicV13 := NewSchema("forgotten_topic_v13",
+		&Mfield{Name: "topic_id", Ty: TypeUuid},
+		&CompactArray{Name: "partitions", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "forgotten_topic_tagged_fields"},
+	)
+
+	fetchV13 := NewSchema("fetch_request_v13",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "replica_id", Ty: TypeInt32},
+		&Mfield{Name: "max_wait_ms", Ty: TypeInt32},
+		&Mfield{Name: "min_bytes", Ty: TypeInt32},
+		&Mfield{Name: "max_bytes", Ty: TypeInt32},
+		&Mfield{Name: "isolation_level", Ty: TypeInt8},
+		&Mfield{Name: "session_id", Ty: TypeInt32},
+		&Mfield{Name: "session_epoch", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV13},
+		&CompactArray{Name: "forgotten_topics_data", Ty: forgottenTopicV13},
+		&Mfield{Name: "rack_id", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
+	// Fetch v15 moves replica_id into the replica_state tagged field
+	fetchV15 := NewSchema("fetch_request_v15",
+		&Mfield{Name: "correlation_id", Ty: TypeInt32},
+		&Mfield{Name: "client_id", Ty: TypeNullableStr},
+		&SchemaTaggedFields{Name: "header_tagged_fields"},
+		&Mfield{Name: "max_wait_ms", Ty: TypeInt32},
+		&Mfield{Name: "min_bytes", Ty: TypeInt32},
+		&Mfield{Name: "max_bytes", Ty: TypeInt32},
+		&Mfield{Name: "isolation_level", Ty: TypeInt8},
+		&Mfield{Name: "session_id", Ty: TypeInt32},
+		&Mfield{Name: "session_epoch", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV13},
+		&CompactArray{Name: "forgotten_topics_data", Ty: forgottenTopicV13},
+		&Mfield{Name: "rack_id", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "request_tagged_fields"},
+	)
+
 	return []Schema{
 		fetchV0,  // v0
 		fetchV0,  // v1
@@ -2179,10 +3697,10 @@ func createFetchRequestSchemas() []Schema {
 		fetchV9,  // v10
 		fetchV11, // v11
 		fetchV12, // v12
-		fetchV12, // v13 (uses topic_id but we still need topic field)
-		fetchV12, // v14
-		fetchV12, // v15
-		fetchV12, // v16
+		fetchV13, // v13
+		fetchV13, // v14
+		fetchV15, // v15
+		fetchV15, // v16
 	}
 }
 
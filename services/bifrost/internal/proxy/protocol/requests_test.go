@@ -3,6 +3,7 @@ package protocol
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -958,3 +959,1232 @@ func TestDescribeGroupsRequestModifier_InvalidVersion(t *testing.T) {
 	_, err = GetRequestModifier(apiKeyDescribeGroups, -1, cfg)
 	assert.Error(t, err)
 }
+
+// buildCreateTopicsRequest encodes a CreateTopics request with one topic that
+// carries a replica assignment and two config entries (one with a null value)
+func buildCreateTopicsRequest(version int16, topic string, validateOnly bool) []byte {
+	flexible := version >= 5
+
+	putInt16 := func(b []byte, v int16) []byte { return append(b, byte(v>>8), byte(v)) }
+	putInt32 := func(b []byte, v int32) []byte { return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) }
+	putArrayLen := func(b []byte, n int) []byte {
+		if flexible {
+			return append(b, byte(n+1))
+		}
+		return putInt32(b, int32(n))
+	}
+	putStr := func(b []byte, s string) []byte {
+		if flexible {
+			return append(append(b, byte(len(s)+1)), s...)
+		}
+		return append(putInt16(b, int16(len(s))), s...)
+	}
+	putNullStr := func(b []byte) []byte {
+		if flexible {
+			return append(b, 0)
+		}
+		return putInt16(b, -1)
+	}
+	putTags := func(b []byte) []byte {
+		if flexible {
+			return append(b, 0)
+		}
+		return b
+	}
+
+	var b []byte
+	b = putInt32(b, 7)
+	b = append(putInt16(b, int16(len("test-client"))), "test-client"...)
+	b = putTags(b)
+
+	b = putArrayLen(b, 1)
+	b = putStr(b, topic)
+	b = putInt32(b, 3) // num_partitions
+	b = putInt16(b, 1) // replication_factor
+
+	b = putArrayLen(b, 1) // assignments
+	b = putInt32(b, 0)
+	b = putArrayLen(b, 1)
+	b = putInt32(b, 1)
+	b = putTags(b)
+
+	b = putArrayLen(b, 2) // configs
+	b = putStr(b, "cleanup.policy")
+	b = putStr(b, "compact")
+	b = putTags(b)
+	b = putStr(b, "retention.ms")
+	b = putNullStr(b)
+	b = putTags(b)
+	b = putTags(b) // topic tagged fields
+
+	b = putInt32(b, 30000) // timeout_ms
+	if version >= 1 {
+		if validateOnly {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	}
+	return putTags(b)
+}
+
+func TestCreateTopicsRequestModifier_RoundTripAllVersions(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	for version := int16(0); version <= 7; version++ {
+		mod, err := GetRequestModifier(apiKeyCreateTopics, version, cfg)
+		require.NoError(t, err, "version %d", version)
+		require.NotNil(t, mod, "version %d should return modifier", version)
+
+		validateOnly := version >= 1
+		result, err := mod.Apply(buildCreateTopicsRequest(version, "orders", validateOnly))
+		require.NoError(t, err, "version %d", version)
+
+		// Everything but the topic name must survive re-encoding unchanged
+		assert.Equal(t, buildCreateTopicsRequest(version, "tenant:orders", validateOnly), result, "version %d", version)
+
+		schema, err := getCreateTopicsRequestSchema(version)
+		require.NoError(t, err)
+		decoded, err := DecodeSchema(result, schema)
+		require.NoError(t, err, "version %d", version)
+
+		topics := decoded.Get("topics").([]interface{})
+		require.Len(t, topics, 1)
+		topic := topics[0].(*Struct)
+		assert.Equal(t, "tenant:orders", topic.Get("name"))
+		assert.Equal(t, int32(3), topic.Get("num_partitions"))
+
+		configs := topic.Get("configs").([]interface{})
+		require.Len(t, configs, 2, "version %d", version)
+		assert.Equal(t, "cleanup.policy", configs[0].(*Struct).Get("name"))
+		assert.Equal(t, "retention.ms", configs[1].(*Struct).Get("name"))
+
+		if version >= 1 {
+			assert.Equal(t, true, decoded.Get("validate_only"), "version %d", version)
+		}
+	}
+}
+
+func TestCreateTopicsRequestModifier_PreservesValidateOnlyFalse(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyCreateTopics, 5, cfg)
+	require.NoError(t, err)
+
+	result, err := mod.Apply(buildCreateTopicsRequest(5, "orders", false))
+	require.NoError(t, err)
+	assert.Equal(t, buildCreateTopicsRequest(5, "tenant:orders", false), result)
+}
+
+func TestCreateTopicsRequestModifier_ReturnsNilWithoutPrefixer(t *testing.T) {
+	mod, err := GetRequestModifier(apiKeyCreateTopics, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestCreateTopicsRequestModifier_InvalidVersion(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	_, err := GetRequestModifier(apiKeyCreateTopics, 8, cfg)
+	assert.Error(t, err)
+
+	_, err = GetRequestModifier(apiKeyCreateTopics, -1, cfg)
+	assert.Error(t, err)
+}
+
+func TestDeleteTopicsRequestModifier_V0_PrefixesTopicNames(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	build := func(topics ...string) []byte {
+		var b []byte
+		b = append(b, 0, 0, 0, 1) // correlation_id
+		b = append(b, 0xff, 0xff) // client_id: null
+		b = append(b, 0, 0, 0, byte(len(topics)))
+		for _, topic := range topics {
+			b = append(b, 0, byte(len(topic)))
+			b = append(b, topic...)
+		}
+		return append(b, 0, 0, 0x75, 0x30) // timeout_ms
+	}
+
+	for version := int16(0); version <= 3; version++ {
+		mod, err := GetRequestModifier(apiKeyDeleteTopics, version, cfg)
+		require.NoError(t, err, "version %d", version)
+		require.NotNil(t, mod, "version %d", version)
+
+		result, err := mod.Apply(build("orders", "payments"))
+		require.NoError(t, err, "version %d", version)
+		assert.Equal(t, build("tenant:orders", "tenant:payments"), result, "version %d", version)
+	}
+}
+
+func TestDeleteTopicsRequestModifier_V4_PrefixesCompactTopicNames(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	build := func(topic string) []byte {
+		var b []byte
+		b = append(b, 0, 0, 0, 1) // correlation_id
+		b = append(b, 0xff, 0xff) // client_id: null
+		b = append(b, 0)          // header tagged fields
+		b = append(b, 2)          // compact array: 1 element
+		b = append(b, byte(len(topic)+1))
+		b = append(b, topic...)
+		b = append(b, 0, 0, 0x75, 0x30) // timeout_ms
+		return append(b, 0)             // request tagged fields
+	}
+
+	for version := int16(4); version <= 5; version++ {
+		mod, err := GetRequestModifier(apiKeyDeleteTopics, version, cfg)
+		require.NoError(t, err, "version %d", version)
+
+		result, err := mod.Apply(build("orders"))
+		require.NoError(t, err, "version %d", version)
+		assert.Equal(t, build("tenant:orders"), result, "version %d", version)
+	}
+}
+
+func TestDeleteTopicsRequestModifier_V6_PrefixesNamesAndKeepsTopicIds(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	namedID := uuid.New()
+	idOnly := uuid.New()
+
+	build := func(name string) []byte {
+		var b []byte
+		b = append(b, 0, 0, 0, 1) // correlation_id
+		b = append(b, 0xff, 0xff) // client_id: null
+		b = append(b, 0)          // header tagged fields
+		b = append(b, 3)          // compact array: 2 elements
+		// topic with name and id
+		b = append(b, byte(len(name)+1))
+		b = append(b, name...)
+		b = append(b, namedID[:]...)
+		b = append(b, 0)
+		// topic with id only (null name)
+		b = append(b, 0)
+		b = append(b, idOnly[:]...)
+		b = append(b, 0)
+		b = append(b, 0, 0, 0x75, 0x30) // timeout_ms
+		return append(b, 0)             // request tagged fields
+	}
+
+	mod, err := GetRequestModifier(apiKeyDeleteTopics, 6, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	result, err := mod.Apply(build("orders"))
+	require.NoError(t, err)
+	assert.Equal(t, build("tenant:orders"), result)
+
+	schema, err := getDeleteTopicsRequestSchema(6)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 2)
+	assert.Equal(t, namedID, topics[0].(*Struct).Get("topic_id"))
+	assert.Nil(t, topics[1].(*Struct).Get("name").(*string))
+	assert.Equal(t, idOnly, topics[1].(*Struct).Get("topic_id"))
+}
+
+func TestDeleteTopicsRequestModifier_InvalidVersion(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	_, err := GetRequestModifier(apiKeyDeleteTopics, 7, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyDeleteTopics, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestOffsetDeleteRequestModifier_PrefixesGroupIdAndTopics(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyOffsetDelete, 0, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// OffsetDelete v0: correlation_id, client_id, group_id, topics[name, partitions[partition_index]]
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	groupId := "my-group"
+	requestBytes = append(requestBytes, 0, byte(len(groupId)))
+	requestBytes = append(requestBytes, []byte(groupId)...)
+	// topics array: 2 elements
+	requestBytes = append(requestBytes, 0, 0, 0, 2)
+	for _, topic := range []string{"orders", "payments"} {
+		requestBytes = append(requestBytes, 0, byte(len(topic)))
+		requestBytes = append(requestBytes, []byte(topic)...)
+		// partitions array: 1 element, partition_index: 0
+		requestBytes = append(requestBytes, 0, 0, 0, 1)
+		requestBytes = append(requestBytes, 0, 0, 0, 0)
+	}
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getOffsetDeleteRequestSchema(0)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:my-group", decoded.Get("group_id"))
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 2)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+	assert.Equal(t, "tenant:payments", topics[1].(*Struct).Get("name"))
+	partitions := topics[1].(*Struct).Get("partitions").([]interface{})
+	require.Len(t, partitions, 1)
+	assert.Equal(t, int32(0), partitions[0].(*Struct).Get("partition_index"))
+}
+
+func TestOffsetDeleteRequestModifier_InvalidVersion(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+	}
+
+	_, err := GetRequestModifier(apiKeyOffsetDelete, 1, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyOffsetDelete, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestDeleteGroupsRequestModifier_V0_PrefixesGroupNames(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+	}
+
+	mod, err := GetRequestModifier(apiKeyDeleteGroups, 0, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// DeleteGroups v0: correlation_id, client_id, groups_names[]
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 2)
+	for _, group := range []string{"orders", "payments"} {
+		requestBytes = append(requestBytes, 0, byte(len(group)))
+		requestBytes = append(requestBytes, []byte(group)...)
+	}
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getDeleteGroupsRequestSchema(0)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"tenant:orders", "tenant:payments"}, decoded.Get("groups_names"))
+}
+
+func TestDeleteGroupsRequestModifier_V2_PrefixesCompactGroupNames(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+	}
+
+	mod, err := GetRequestModifier(apiKeyDeleteGroups, 2, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// DeleteGroups v2: correlation_id, client_id, header tags, groups_names (compact), request tags
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0) // header tagged fields
+	requestBytes = append(requestBytes, 2) // compact array length + 1
+	group := "orders"
+	requestBytes = append(requestBytes, byte(len(group)+1))
+	requestBytes = append(requestBytes, []byte(group)...)
+	requestBytes = append(requestBytes, 0) // request tagged fields
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getDeleteGroupsRequestSchema(2)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"tenant:orders"}, decoded.Get("groups_names"))
+}
+
+func TestDeleteGroupsRequestModifier_InvalidVersion(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+	}
+
+	_, err := GetRequestModifier(apiKeyDeleteGroups, 3, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyDeleteGroups, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestCreatePartitionsRequestModifier_V0_PrefixesTopicsAndKeepsNullAssignments(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyCreatePartitions, 1, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// CreatePartitions v1: correlation_id, client_id, topics[name, count, assignments], timeout_ms, validate_only
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	topicName := "orders"
+	requestBytes = append(requestBytes, 0, byte(len(topicName)))
+	requestBytes = append(requestBytes, []byte(topicName)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 6)             // count
+	requestBytes = append(requestBytes, 0xff, 0xff, 0xff, 0xff) // assignments: null
+	requestBytes = append(requestBytes, 0, 0, 0x75, 0x30)       // timeout_ms
+	requestBytes = append(requestBytes, 0)                      // validate_only
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	expected := append([]byte{}, requestBytes[:4+2+len(clientId)+4]...)
+	expected = append(expected, 0, byte(len("tenant:orders")))
+	expected = append(expected, "tenant:orders"...)
+	expected = append(expected, requestBytes[4+2+len(clientId)+4+2+len(topicName):]...)
+	assert.Equal(t, expected, result, "only the topic name should change; null assignments must stay null")
+}
+
+func TestCreatePartitionsRequestModifier_V2_PrefixesCompactTopics(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyCreatePartitions, 3, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// CreatePartitions v3 (flexible)
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0) // header tagged fields
+	requestBytes = append(requestBytes, 3) // topics: 2 elements
+	for _, topic := range []string{"orders", "payments"} {
+		requestBytes = append(requestBytes, byte(len(topic)+1))
+		requestBytes = append(requestBytes, []byte(topic)...)
+		requestBytes = append(requestBytes, 0, 0, 0, 4) // count
+		// assignments: 1 element with broker_ids [1, 2]
+		requestBytes = append(requestBytes, 2, 3, 0, 0, 0, 1, 0, 0, 0, 2, 0)
+		requestBytes = append(requestBytes, 0) // topic tagged fields
+	}
+	requestBytes = append(requestBytes, 0, 0, 0x75, 0x30) // timeout_ms
+	requestBytes = append(requestBytes, 1)                // validate_only
+	requestBytes = append(requestBytes, 0)                // request tagged fields
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getCreatePartitionsRequestSchema(3)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 2)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+	assert.Equal(t, "tenant:payments", topics[1].(*Struct).Get("name"))
+	assert.Equal(t, int32(4), topics[1].(*Struct).Get("count"))
+	assignments := topics[1].(*Struct).Get("assignments").([]interface{})
+	require.Len(t, assignments, 1)
+	assert.Equal(t, []interface{}{int32(1), int32(2)}, assignments[0].(*Struct).Get("broker_ids"))
+	assert.Equal(t, true, decoded.Get("validate_only"))
+}
+
+func TestCreatePartitionsRequestModifier_InvalidVersion(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	_, err := GetRequestModifier(apiKeyCreatePartitions, 4, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyCreatePartitions, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestInitProducerIdRequestModifier_V0_PrefixesTransactionalId(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyInitProducerId, 0, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// InitProducerId v0: correlation_id, client_id, transactional_id, transaction_timeout_ms
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, 0, byte(len(txnID)))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0, 0, 0xea, 0x60) // transaction_timeout_ms
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getInitProducerIdRequestSchema(0)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+	require.NotNil(t, decoded.Get("transactional_id"))
+	assert.Equal(t, "tenant:orders-txn", *decoded.Get("transactional_id").(*string))
+	assert.Equal(t, int32(60000), decoded.Get("transaction_timeout_ms"))
+}
+
+func TestInitProducerIdRequestModifier_LeavesNullTransactionalId(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyInitProducerId, 1, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// Idempotent producers send a null transactional_id
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0xff, 0xff)
+	requestBytes = append(requestBytes, 0xff, 0xff, 0xff, 0xff)
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+	assert.Equal(t, requestBytes, result)
+}
+
+func TestInitProducerIdRequestModifier_V3_Flexible(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyInitProducerId, 4, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// InitProducerId v4: header tags, compact transactional_id, timeout, producer_id, producer_epoch, tags
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, byte(len(txnID)+1))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0, 0, 0xea, 0x60)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	requestBytes = append(requestBytes, 0)
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getInitProducerIdRequestSchema(4)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:orders-txn", *decoded.Get("transactional_id").(*string))
+	assert.Equal(t, int64(42), decoded.Get("producer_id"))
+	assert.Equal(t, int16(3), decoded.Get("producer_epoch"))
+
+	_, err = GetRequestModifier(apiKeyInitProducerId, 5, cfg)
+	assert.Error(t, err)
+}
+
+func TestAddPartitionsToTxnRequestModifier_V0_PrefixesTransactionalIdAndTopics(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyAddPartitionsToTxn, 0, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// AddPartitionsToTxn v0: correlation_id, client_id, transactional_id, producer_id,
+	// producer_epoch, topics[name, partitions[]]
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, 0, byte(len(txnID)))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	// topics array: 2 elements
+	requestBytes = append(requestBytes, 0, 0, 0, 2)
+	for _, topic := range []string{"orders", "payments"} {
+		requestBytes = append(requestBytes, 0, byte(len(topic)))
+		requestBytes = append(requestBytes, []byte(topic)...)
+		// partitions array: [0, 1]
+		requestBytes = append(requestBytes, 0, 0, 0, 2)
+		requestBytes = append(requestBytes, 0, 0, 0, 0)
+		requestBytes = append(requestBytes, 0, 0, 0, 1)
+	}
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getAddPartitionsToTxnRequestSchema(0)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", decoded.Get("transactional_id"))
+	assert.Equal(t, int64(42), decoded.Get("producer_id"))
+	assert.Equal(t, int16(3), decoded.Get("producer_epoch"))
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 2)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+	assert.Equal(t, "tenant:payments", topics[1].(*Struct).Get("name"))
+	assert.Equal(t, []interface{}{int32(0), int32(1)}, topics[1].(*Struct).Get("partitions"))
+}
+
+func TestAddPartitionsToTxnRequestModifier_V3_Flexible(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyAddPartitionsToTxn, 3, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0) // header tagged fields
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, byte(len(txnID)+1))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	// topics compact array: 1 element
+	requestBytes = append(requestBytes, 2)
+	topic := "orders"
+	requestBytes = append(requestBytes, byte(len(topic)+1))
+	requestBytes = append(requestBytes, []byte(topic)...)
+	requestBytes = append(requestBytes, 2, 0, 0, 0, 5) // partitions: [5]
+	requestBytes = append(requestBytes, 0)             // topic tagged fields
+	requestBytes = append(requestBytes, 0)             // request tagged fields
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getAddPartitionsToTxnRequestSchema(3)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", decoded.Get("transactional_id"))
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 1)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+
+	_, err = GetRequestModifier(apiKeyAddPartitionsToTxn, 4, cfg)
+	assert.Error(t, err)
+}
+
+func TestAddOffsetsToTxnRequestModifier_PrefixesTransactionalIdAndGroupId(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyAddOffsetsToTxn, 0, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// AddOffsetsToTxn v0: correlation_id, client_id, transactional_id, producer_id, producer_epoch, group_id
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, 0, byte(len(txnID)))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	groupId := "my-group"
+	requestBytes = append(requestBytes, 0, byte(len(groupId)))
+	requestBytes = append(requestBytes, []byte(groupId)...)
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getAddOffsetsToTxnRequestSchema(0)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", decoded.Get("transactional_id"))
+	assert.Equal(t, "tenant:my-group", decoded.Get("group_id"))
+}
+
+func TestEndTxnRequestModifier_V3_PrefixesTransactionalId(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyEndTxn, 3, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// EndTxn v3: header tags, compact transactional_id, producer_id, producer_epoch, committed, tags
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, byte(len(txnID)+1))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	requestBytes = append(requestBytes, 1)                       // committed
+	requestBytes = append(requestBytes, 0)
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getEndTxnRequestSchema(3)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", decoded.Get("transactional_id"))
+	assert.Equal(t, true, decoded.Get("committed"))
+
+	mod, err = GetRequestModifier(apiKeyEndTxn, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestTxnOffsetCommitRequestModifier_V2_PrefixesAllIdentifiers(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyTxnOffsetCommit, 2, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// TxnOffsetCommit v2: correlation_id, client_id, transactional_id, group_id, producer_id,
+	// producer_epoch, topics[name, partitions[partition_index, committed_offset,
+	// committed_leader_epoch, committed_metadata]]
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, 0, byte(len(txnID)))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	groupId := "my-group"
+	requestBytes = append(requestBytes, 0, byte(len(groupId)))
+	requestBytes = append(requestBytes, []byte(groupId)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	// topics array: 1 element
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	topic := "orders"
+	requestBytes = append(requestBytes, 0, byte(len(topic)))
+	requestBytes = append(requestBytes, []byte(topic)...)
+	// partitions array: 1 element
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	requestBytes = append(requestBytes, 0, 0, 0, 0)             // partition_index
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 9) // committed_offset
+	requestBytes = append(requestBytes, 0xff, 0xff, 0xff, 0xff) // committed_leader_epoch
+	requestBytes = append(requestBytes, 0xff, 0xff)             // committed_metadata: null
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getTxnOffsetCommitRequestSchema(2)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", decoded.Get("transactional_id"))
+	assert.Equal(t, "tenant:my-group", decoded.Get("group_id"))
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 1)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+	partitions := topics[0].(*Struct).Get("partitions").([]interface{})
+	require.Len(t, partitions, 1)
+	assert.Equal(t, int64(9), partitions[0].(*Struct).Get("committed_offset"))
+	assert.Equal(t, int32(-1), partitions[0].(*Struct).Get("committed_leader_epoch"))
+}
+
+func TestTxnOffsetCommitRequestModifier_V3_Flexible(t *testing.T) {
+	cfg := RequestModifierConfig{
+		GroupPrefixer: func(group string) string { return "tenant:" + group },
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyTxnOffsetCommit, 3, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0) // header tagged fields
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, byte(len(txnID)+1))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	groupId := "my-group"
+	requestBytes = append(requestBytes, byte(len(groupId)+1))
+	requestBytes = append(requestBytes, []byte(groupId)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 42) // producer_id
+	requestBytes = append(requestBytes, 0, 3)                    // producer_epoch
+	requestBytes = append(requestBytes, 0, 0, 0, 7)              // generation_id
+	memberId := "member-1"
+	requestBytes = append(requestBytes, byte(len(memberId)+1))
+	requestBytes = append(requestBytes, []byte(memberId)...)
+	requestBytes = append(requestBytes, 0) // group_instance_id: null
+	// topics compact array: 1 element
+	requestBytes = append(requestBytes, 2)
+	topic := "orders"
+	requestBytes = append(requestBytes, byte(len(topic)+1))
+	requestBytes = append(requestBytes, []byte(topic)...)
+	// partitions compact array: 1 element
+	requestBytes = append(requestBytes, 2)
+	requestBytes = append(requestBytes, 0, 0, 0, 0)             // partition_index
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 9) // committed_offset
+	requestBytes = append(requestBytes, 0, 0, 0, 1)             // committed_leader_epoch
+	requestBytes = append(requestBytes, 1)                      // committed_metadata: ""
+	requestBytes = append(requestBytes, 0)                      // partition tagged fields
+	requestBytes = append(requestBytes, 0)                      // topic tagged fields
+	requestBytes = append(requestBytes, 0)                      // request tagged fields
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getTxnOffsetCommitRequestSchema(3)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", decoded.Get("transactional_id"))
+	assert.Equal(t, "tenant:my-group", decoded.Get("group_id"))
+	assert.Equal(t, int32(7), decoded.Get("generation_id"))
+	assert.Equal(t, "member-1", decoded.Get("member_id"))
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 1)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+
+	_, err = GetRequestModifier(apiKeyTxnOffsetCommit, 4, cfg)
+	assert.Error(t, err)
+}
+
+func TestProduceRequestModifier_V3_PrefixesTransactionalId(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+		TxnIDPrefixer: func(txnID string) string { return "tenant:" + txnID },
+	}
+
+	mod, err := GetRequestModifier(apiKeyProduce, 3, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// Produce v3: correlation_id, client_id, transactional_id, acks, timeout_ms,
+	// topic_data[name, partition_data[index, records]]
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	txnID := "orders-txn"
+	requestBytes = append(requestBytes, 0, byte(len(txnID)))
+	requestBytes = append(requestBytes, []byte(txnID)...)
+	requestBytes = append(requestBytes, 0xff, 0xff)       // acks: -1
+	requestBytes = append(requestBytes, 0, 0, 0x75, 0x30) // timeout_ms
+	// topic_data array: 1 element
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	topic := "orders"
+	requestBytes = append(requestBytes, 0, byte(len(topic)))
+	requestBytes = append(requestBytes, []byte(topic)...)
+	// partition_data array: 1 element with empty records
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	requestBytes = append(requestBytes, 0, 0, 0, 0)
+	requestBytes = append(requestBytes, 0, 0, 0, 0)
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getProduceRequestSchema(3)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:orders-txn", *decoded.Get("transactional_id").(*string))
+	topics := decoded.Get("topic_data").([]interface{})
+	require.Len(t, topics, 1)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+}
+
+func TestDescribeConfigsRequestModifier_V1_PrefixesOnlyTopicResources(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyDescribeConfigs, 1, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// DescribeConfigs v1: correlation_id, client_id,
+	// resources[resource_type, resource_name, configuration_keys], include_synonyms
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	// resources array: 2 elements
+	requestBytes = append(requestBytes, 0, 0, 0, 2)
+	// topic resource with configuration_keys ["retention.ms"]
+	requestBytes = append(requestBytes, 2)
+	topic := "orders"
+	requestBytes = append(requestBytes, 0, byte(len(topic)))
+	requestBytes = append(requestBytes, []byte(topic)...)
+	configKey := "retention.ms"
+	requestBytes = append(requestBytes, 0, 0, 0, 1, 0, byte(len(configKey)))
+	requestBytes = append(requestBytes, []byte(configKey)...)
+	// broker resource "1" with null configuration_keys
+	requestBytes = append(requestBytes, 4)
+	requestBytes = append(requestBytes, 0, 1, '1')
+	requestBytes = append(requestBytes, 0xff, 0xff, 0xff, 0xff)
+	requestBytes = append(requestBytes, 1) // include_synonyms
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getDescribeConfigsRequestSchema(1)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	resources := decoded.Get("resources").([]interface{})
+	require.Len(t, resources, 2)
+	assert.Equal(t, "tenant:orders", resources[0].(*Struct).Get("resource_name"))
+	assert.Equal(t, []interface{}{"retention.ms"}, resources[0].(*Struct).Get("configuration_keys"))
+	assert.Equal(t, "1", resources[1].(*Struct).Get("resource_name"))
+	assert.Nil(t, resources[1].(*Struct).Get("configuration_keys"))
+	assert.Equal(t, true, decoded.Get("include_synonyms"))
+}
+
+func TestDescribeConfigsRequestModifier_V4_Flexible(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyDescribeConfigs, 4, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0) // header tagged fields
+	// resources compact array: 1 element
+	requestBytes = append(requestBytes, 2)
+	requestBytes = append(requestBytes, 2)
+	topic := "orders"
+	requestBytes = append(requestBytes, byte(len(topic)+1))
+	requestBytes = append(requestBytes, []byte(topic)...)
+	requestBytes = append(requestBytes, 0)    // configuration_keys: null
+	requestBytes = append(requestBytes, 0)    // resource tagged fields
+	requestBytes = append(requestBytes, 0, 1) // include_synonyms, include_documentation
+	requestBytes = append(requestBytes, 0)    // request tagged fields
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getDescribeConfigsRequestSchema(4)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	resources := decoded.Get("resources").([]interface{})
+	require.Len(t, resources, 1)
+	assert.Equal(t, "tenant:orders", resources[0].(*Struct).Get("resource_name"))
+	assert.Equal(t, true, decoded.Get("include_documentation"))
+
+	_, err = GetRequestModifier(apiKeyDescribeConfigs, 5, cfg)
+	assert.Error(t, err)
+}
+
+func TestAlterConfigsRequestModifier_PrefixesTopicResources(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	for _, version := range []int16{0, 1, 2} {
+		mod, err := GetRequestModifier(apiKeyAlterConfigs, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 2
+		var requestBytes []byte
+		requestBytes = append(requestBytes, 0, 0, 0, 1)
+		clientId := "test-client"
+		requestBytes = append(requestBytes, 0, byte(len(clientId)))
+		requestBytes = append(requestBytes, []byte(clientId)...)
+		topic, configName, configValue := "orders", "retention.ms", "60000"
+		if flexible {
+			requestBytes = append(requestBytes, 0) // header tagged fields
+			requestBytes = append(requestBytes, 2, 2, byte(len(topic)+1))
+			requestBytes = append(requestBytes, []byte(topic)...)
+			requestBytes = append(requestBytes, 2, byte(len(configName)+1))
+			requestBytes = append(requestBytes, []byte(configName)...)
+			requestBytes = append(requestBytes, byte(len(configValue)+1))
+			requestBytes = append(requestBytes, []byte(configValue)...)
+			requestBytes = append(requestBytes, 0, 0) // config and resource tagged fields
+			requestBytes = append(requestBytes, 0, 0) // validate_only, request tagged fields
+		} else {
+			requestBytes = append(requestBytes, 0, 0, 0, 1, 2, 0, byte(len(topic)))
+			requestBytes = append(requestBytes, []byte(topic)...)
+			requestBytes = append(requestBytes, 0, 0, 0, 1, 0, byte(len(configName)))
+			requestBytes = append(requestBytes, []byte(configName)...)
+			requestBytes = append(requestBytes, 0, byte(len(configValue)))
+			requestBytes = append(requestBytes, []byte(configValue)...)
+			requestBytes = append(requestBytes, 0) // validate_only
+		}
+
+		result, err := mod.Apply(requestBytes)
+		require.NoError(t, err)
+
+		schema, err := getAlterConfigsRequestSchema(version)
+		require.NoError(t, err)
+		decoded, err := DecodeSchema(result, schema)
+		require.NoError(t, err)
+
+		resources := decoded.Get("resources").([]interface{})
+		require.Len(t, resources, 1)
+		assert.Equal(t, "tenant:orders", resources[0].(*Struct).Get("resource_name"), "version %d", version)
+		configs := resources[0].(*Struct).Get("configs").([]interface{})
+		require.Len(t, configs, 1)
+		assert.Equal(t, "retention.ms", configs[0].(*Struct).Get("name"), "version %d", version)
+	}
+
+	_, err := GetRequestModifier(apiKeyAlterConfigs, 3, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyAlterConfigs, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestDeleteRecordsRequestModifier_V1_PrefixesTopics(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyDeleteRecords, 1, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// DeleteRecords v1: correlation_id, client_id, topics[name, partitions[partition_index, offset]], timeout_ms
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	topicName := "orders"
+	requestBytes = append(requestBytes, 0, byte(len(topicName)))
+	requestBytes = append(requestBytes, []byte(topicName)...)
+	requestBytes = append(requestBytes, 0, 0, 0, 1)                   // partitions
+	requestBytes = append(requestBytes, 0, 0, 0, 2)                   // partition_index
+	requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0x01, 0xf4) // offset: 500
+	requestBytes = append(requestBytes, 0, 0, 0x75, 0x30)             // timeout_ms
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	expected := append([]byte{}, requestBytes[:4+2+len(clientId)+4]...)
+	expected = append(expected, 0, byte(len("tenant:orders")))
+	expected = append(expected, "tenant:orders"...)
+	expected = append(expected, requestBytes[4+2+len(clientId)+4+2+len(topicName):]...)
+	assert.Equal(t, expected, result, "only the topic name should change")
+}
+
+func TestDeleteRecordsRequestModifier_V2_PrefixesCompactTopics(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	mod, err := GetRequestModifier(apiKeyDeleteRecords, 2, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// DeleteRecords v2 (flexible)
+	var requestBytes []byte
+	requestBytes = append(requestBytes, 0, 0, 0, 1)
+	clientId := "test-client"
+	requestBytes = append(requestBytes, 0, byte(len(clientId)))
+	requestBytes = append(requestBytes, []byte(clientId)...)
+	requestBytes = append(requestBytes, 0) // header tagged fields
+	requestBytes = append(requestBytes, 3) // topics: 2 elements
+	for _, topic := range []string{"orders", "payments"} {
+		requestBytes = append(requestBytes, byte(len(topic)+1))
+		requestBytes = append(requestBytes, []byte(topic)...)
+		requestBytes = append(requestBytes, 2)                         // partitions: 1 element
+		requestBytes = append(requestBytes, 0, 0, 0, 0)                // partition_index
+		requestBytes = append(requestBytes, 0, 0, 0, 0, 0, 0, 0, 0x2a) // offset: 42
+		requestBytes = append(requestBytes, 0)                         // partition tagged fields
+		requestBytes = append(requestBytes, 0)                         // topic tagged fields
+	}
+	requestBytes = append(requestBytes, 0, 0, 0x75, 0x30) // timeout_ms
+	requestBytes = append(requestBytes, 0)                // request tagged fields
+
+	result, err := mod.Apply(requestBytes)
+	require.NoError(t, err)
+
+	schema, err := getDeleteRecordsRequestSchema(2)
+	require.NoError(t, err)
+	decoded, err := DecodeSchema(result, schema)
+	require.NoError(t, err)
+
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 2)
+	assert.Equal(t, "tenant:orders", topics[0].(*Struct).Get("name"))
+	assert.Equal(t, "tenant:payments", topics[1].(*Struct).Get("name"))
+	partitions := topics[1].(*Struct).Get("partitions").([]interface{})
+	require.Len(t, partitions, 1)
+	assert.Equal(t, int64(42), partitions[0].(*Struct).Get("offset"))
+	assert.Equal(t, int32(30000), decoded.Get("timeout_ms"))
+}
+
+func TestDeleteRecordsRequestModifier_InvalidVersion(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	_, err := GetRequestModifier(apiKeyDeleteRecords, 3, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyDeleteRecords, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+// buildOffsetForLeaderEpochRequest encodes an OffsetForLeaderEpoch request
+// for one partition of the given topic
+func buildOffsetForLeaderEpochRequest(version int16, topic string) []byte {
+	flexible := version >= 4
+	var b []byte
+	b = append(b, 0, 0, 0, 1) // correlation_id
+	b = append(b, 0, 11)
+	b = append(b, "test-client"...)
+	if flexible {
+		b = append(b, 0) // header tagged fields
+	}
+	if version >= 3 {
+		b = append(b, 0xff, 0xff, 0xff, 0xfe) // replica_id: -2 (consumer)
+	}
+	if flexible {
+		b = append(b, 2, byte(len(topic)+1))
+	} else {
+		b = append(b, 0, 0, 0, 1, 0, byte(len(topic)))
+	}
+	b = append(b, topic...)
+	if flexible {
+		b = append(b, 2)
+	} else {
+		b = append(b, 0, 0, 0, 1)
+	}
+	b = append(b, 0, 0, 0, 3) // partition
+	if version >= 2 {
+		b = append(b, 0, 0, 0, 9) // current_leader_epoch
+	}
+	b = append(b, 0, 0, 0, 7) // leader_epoch
+	if flexible {
+		b = append(b, 0, 0, 0) // partition, topic and request tagged fields
+	}
+	return b
+}
+
+func TestOffsetForLeaderEpochRequestModifier_RoundTrip(t *testing.T) {
+	cfg := RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant:" + topic },
+	}
+
+	for version := int16(0); version <= 4; version++ {
+		mod, err := GetRequestModifier(apiKeyOffsetForLeaderEpoch, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod)
+
+		result, err := mod.Apply(buildOffsetForLeaderEpochRequest(version, "orders"))
+		require.NoError(t, err, "v%d", version)
+		assert.Equal(t, buildOffsetForLeaderEpochRequest(version, "tenant:orders"), result,
+			"v%d: only the topic name should change", version)
+	}
+
+	_, err := GetRequestModifier(apiKeyOffsetForLeaderEpoch, 5, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetRequestModifier(apiKeyOffsetForLeaderEpoch, 0, RequestModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
@@ -4,18 +4,27 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/drewpayment/orbit/services/bifrost/internal/kafkaconfig"
 )
 
 const (
 	apiKeyMetadata        = 3
 	apiKeyFindCoordinator = 10
+	apiKeyDescribeCluster = 60
 
 	brokersKeyName = "brokers"
 	hostKeyName    = "host"
 	portKeyName    = "port"
 	nodeKeyName    = "node_id"
 
+	brokerIdKeyName = "broker_id"
+
+	responseTaggedFieldsKeyName = "response_tagged_fields"
+	nodeEndpointsKeyName        = "node_endpoints"
+	nodeEndpointsTag            = 0
+
 	coordinatorKeyName  = "coordinator"
 	coordinatorsKeyName = "coordinators"
 )
@@ -23,6 +32,8 @@ const (
 var (
 	metadataResponseSchemaVersions        = createMetadataResponseSchemaVersions()
 	findCoordinatorResponseSchemaVersions = createFindCoordinatorResponseSchemaVersions()
+	describeClusterResponseSchemaVersions = createDescribeClusterResponseSchemaVersions()
+	nodeEndpointsSchema                   = createNodeEndpointsSchema()
 )
 
 func createMetadataResponseSchemaVersions() []Schema {
@@ -325,6 +336,56 @@ func createFindCoordinatorResponseSchemaVersions() []Schema {
 	return []Schema{findCoordinatorResponseV0, findCoordinatorResponseV1, findCoordinatorResponseV2, findCoordinatorResponseV3, findCoordinatorResponseV4, findCoordinatorResponseV5, findCoordinatorResponseV6}
 }
 
+func createDescribeClusterResponseSchemaVersions() []Schema {
+	describeClusterBrokerV0 := NewSchema("describe_cluster_broker_v0",
+		&Mfield{Name: brokerIdKeyName, Ty: TypeInt32},
+		&Mfield{Name: hostKeyName, Ty: TypeCompactStr},
+		&Mfield{Name: portKeyName, Ty: TypeInt32},
+		&Mfield{Name: "rack", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{"broker_tagged_fields"},
+	)
+
+	describeClusterResponseV0 := NewSchema("describe_cluster_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "cluster_id", Ty: TypeCompactStr},
+		&Mfield{Name: "controller_id", Ty: TypeInt32},
+		&CompactArray{Name: brokersKeyName, Ty: describeClusterBrokerV0},
+		&Mfield{Name: "cluster_authorized_operations", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	describeClusterResponseV1 := NewSchema("describe_cluster_response_v1",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "endpoint_type", Ty: TypeInt8},
+		&Mfield{Name: "cluster_id", Ty: TypeCompactStr},
+		&Mfield{Name: "controller_id", Ty: TypeInt32},
+		&CompactArray{Name: brokersKeyName, Ty: describeClusterBrokerV0},
+		&Mfield{Name: "cluster_authorized_operations", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{describeClusterResponseV0, describeClusterResponseV1}
+}
+
+// createNodeEndpointsSchema returns the schema of the node_endpoints tagged
+// field value
+func createNodeEndpointsSchema() Schema {
+	nodeEndpoint := NewSchema("node_endpoint",
+		&Mfield{Name: nodeKeyName, Ty: TypeInt32},
+		&Mfield{Name: hostKeyName, Ty: TypeCompactStr},
+		&Mfield{Name: portKeyName, Ty: TypeInt32},
+		&Mfield{Name: "rack", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{"node_endpoint_tagged_fields"},
+	)
+	return NewSchema("node_endpoints",
+		&CompactArray{Name: nodeEndpointsKeyName, Ty: nodeEndpoint},
+	)
+}
+
 func modifyMetadataResponse(decodedStruct *Struct, fn config.NetAddressMappingFunc) error {
 	if decodedStruct == nil {
 		return errors.New("decoded struct must not be nil")
@@ -453,44 +514,13 @@ func modifyMetadataResponseWithConfig(decodedStruct *Struct, cfg ResponseModifie
 		if !ok {
 			return errors.New("brokers list not found")
 		}
-		for _, brokerElement := range brokersArray {
-			broker := brokerElement.(*Struct)
-			host, ok := broker.Get(hostKeyName).(string)
-			if !ok {
-				return errors.New("broker.host not found")
-			}
-			port, ok := broker.Get(portKeyName).(int32)
-			if !ok {
-				return errors.New("broker.port not found")
-			}
-			nodeId, ok := broker.Get(nodeKeyName).(int32)
-			if !ok {
-				return errors.New("broker.node_id not found")
-			}
-
-			if host == "" && port <= 0 {
-				continue
-			}
-
-			newHost, newPort, err := cfg.NetAddressMappingFunc(host, port, nodeId)
-			if err != nil {
-				return err
-			}
-			if host != newHost {
-				if err := broker.Replace(hostKeyName, newHost); err != nil {
-					return err
-				}
-			}
-			if port != newPort {
-				if err := broker.Replace(portKeyName, newPort); err != nil {
-					return err
-				}
-			}
+		if err := mapBrokerAddresses(brokersArray, nodeKeyName, cfg.NetAddressMappingFunc); err != nil {
+			return err
 		}
 	}
 
 	// Handle topic rewriting (unprefixing and filtering)
-	if cfg.TopicUnprefixer != nil || cfg.TopicFilter != nil {
+	if cfg.TopicUnprefixer != nil || cfg.TopicFilter != nil || cfg.TopicIDObserver != nil {
 		if err := modifyTopicsInMetadataResponse(decodedStruct, cfg); err != nil {
 			return err
 		}
@@ -523,6 +553,16 @@ func modifyTopicsInMetadataResponse(decodedStruct *Struct, cfg ResponseModifierC
 		// Get topic name - different field names for different versions
 		// v0-v7: "topic", v8+: "name"
 		topicName := getTopicNameFromStruct(topic)
+		if cfg.TopicIDObserver != nil && topicName != "" {
+			// v10+ carries topic_id; record it before filtering so Fetch v13+
+			// responses can be correlated with the physical name
+			if topicID, ok := topic.Get("topic_id").(uuid.UUID); ok && topicID != uuid.Nil {
+				cfg.TopicIDObserver(topicID, topicName)
+			} else if errorCode, _ := topic.Get("error_code").(int16); errorCode == int16(ErrUnknownTopicOrPartition) {
+				// The topic was deleted, so its ID no longer resolves
+				cfg.TopicIDObserver(uuid.Nil, topicName)
+			}
+		}
 		if topicName == "" {
 			if cfg.TopicFilter == nil {
 				continue // No filter, keep all topics
@@ -633,6 +673,114 @@ func modifyFindCoordinatorResponseWithConfig(decodedStruct *Struct, cfg Response
 	return modifyCoordinator(decodedStruct, cfg.NetAddressMappingFunc)
 }
 
+// modifyDescribeClusterResponse maps the brokers[] addresses of DescribeCluster
+// responses the same way Metadata brokers are mapped.
+func modifyDescribeClusterResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	if decodedStruct == nil {
+		return errors.New("decoded struct must not be nil")
+	}
+	if cfg.NetAddressMappingFunc == nil {
+		return nil // No address mapping needed
+	}
+
+	brokersArray, ok := decodedStruct.Get(brokersKeyName).([]interface{})
+	if !ok {
+		return errors.New("brokers list not found")
+	}
+	return mapBrokerAddresses(brokersArray, brokerIdKeyName, cfg.NetAddressMappingFunc)
+}
+
+// mapBrokerAddresses maps the host and port of each broker struct in brokers.
+// idKeyName names the field holding the broker's node ID.
+func mapBrokerAddresses(brokers []interface{}, idKeyName string, fn config.NetAddressMappingFunc) error {
+	for _, brokerElement := range brokers {
+		broker, ok := brokerElement.(*Struct)
+		if !ok {
+			return errors.New("broker is not a struct")
+		}
+		host, ok := broker.Get(hostKeyName).(string)
+		if !ok {
+			return errors.New("broker.host not found")
+		}
+		port, ok := broker.Get(portKeyName).(int32)
+		if !ok {
+			return errors.New("broker.port not found")
+		}
+		nodeId, ok := broker.Get(idKeyName).(int32)
+		if !ok {
+			return fmt.Errorf("broker.%s not found", idKeyName)
+		}
+
+		if host == "" && port <= 0 {
+			continue
+		}
+
+		newHost, newPort, err := fn(host, port, nodeId)
+		if err != nil {
+			return err
+		}
+		if host != newHost {
+			if err := broker.Replace(hostKeyName, newHost); err != nil {
+				return err
+			}
+		}
+		if port != newPort {
+			if err := broker.Replace(portKeyName, newPort); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mapNodeEndpoints maps the node_endpoints leader hints that Produce v10+ and
+// Fetch v16+ responses carry in their top-level tagged fields, so clients
+// retrying after NOT_LEADER_OR_FOLLOWER connect back through Bifrost.
+func mapNodeEndpoints(decodedStruct *Struct, fn config.NetAddressMappingFunc) error {
+	if fn == nil {
+		return nil
+	}
+	taggedFields, ok := decodedStruct.Get(responseTaggedFieldsKeyName).([]rawTaggedField)
+	if !ok || len(taggedFields) == 0 {
+		return nil
+	}
+
+	mapped := make([]rawTaggedField, len(taggedFields))
+	copy(mapped, taggedFields)
+	for i, field := range mapped {
+		if field.tag != nodeEndpointsTag {
+			continue
+		}
+		endpoints, err := DecodeSchema(field.data, nodeEndpointsSchema)
+		if err != nil {
+			return fmt.Errorf("decode node_endpoints: %w", err)
+		}
+		endpointsArray, ok := endpoints.Get(nodeEndpointsKeyName).([]interface{})
+		if !ok {
+			return errors.New("node_endpoints list not found")
+		}
+		if err := mapBrokerAddresses(endpointsArray, nodeKeyName, fn); err != nil {
+			return err
+		}
+		if mapped[i].data, err = EncodeSchema(endpoints, nodeEndpointsSchema); err != nil {
+			return fmt.Errorf("encode node_endpoints: %w", err)
+		}
+	}
+	return decodedStruct.Replace(responseTaggedFieldsKeyName, mapped)
+}
+
+// hasNodeEndpoints reports whether responses of this version can carry
+// node_endpoints, which need address mapping even when no topics are rewritten
+func hasNodeEndpoints(apiKey, apiVersion int16) bool {
+	switch apiKey {
+	case apiKeyProduce:
+		return apiVersion >= 10
+	case apiKeyFetch:
+		return apiVersion >= 16
+	}
+	return false
+}
+
 type ResponseModifier interface {
 	Apply(resp []byte) ([]byte, error)
 }
@@ -644,6 +792,14 @@ type TopicUnprefixer func(topic string) string
 // Returns true if the topic belongs to the tenant and should be included.
 type TopicFilter func(topic string) bool
 
+// TopicIDObserver is given the physical (prefixed) topic name for each topic ID
+// seen in Metadata responses, and uuid.Nil for topics they report as unknown.
+type TopicIDObserver func(topicID uuid.UUID, topic string)
+
+// TopicIDResolver returns the physical (prefixed) topic name for a topic ID,
+// or false if the ID has not been seen in a Metadata response.
+type TopicIDResolver func(topicID uuid.UUID) (string, bool)
+
 // GroupUnprefixer removes the tenant prefix from consumer group IDs in responses.
 type GroupUnprefixer func(groupId string) string
 
@@ -651,13 +807,25 @@ type GroupUnprefixer func(groupId string) string
 // Returns true if the group belongs to the tenant and should be included.
 type GroupFilter func(groupId string) bool
 
+// TxnIDUnprefixer is a function that removes the tenant prefix from
+// transactional IDs.
+type TxnIDUnprefixer func(txnID string) string
+
+// TxnIDFilter determines whether a transactional ID should be included in
+// responses. Returns true if the ID belongs to the tenant.
+type TxnIDFilter func(txnID string) bool
+
 // ResponseModifierConfig holds functions for response modification.
 type ResponseModifierConfig struct {
 	NetAddressMappingFunc config.NetAddressMappingFunc
 	TopicUnprefixer       TopicUnprefixer
 	TopicFilter           TopicFilter
+	TopicIDObserver       TopicIDObserver
+	TopicIDResolver       TopicIDResolver
 	GroupUnprefixer       GroupUnprefixer
 	GroupFilter           GroupFilter
+	TxnIDUnprefixer       TxnIDUnprefixer
+	TxnIDFilter           TxnIDFilter
 }
 
 type modifyResponseFunc func(decodedStruct *Struct, cfg ResponseModifierConfig) error
@@ -697,8 +865,10 @@ func GetResponseModifierWithConfig(apiKey int16, apiVersion int16, cfg ResponseM
 		return newResponseModifier(apiKey, apiVersion, cfg, metadataResponseSchemaVersions, modifyMetadataResponseWithConfig)
 	case apiKeyFindCoordinator:
 		return newResponseModifier(apiKey, apiVersion, cfg, findCoordinatorResponseSchemaVersions, modifyFindCoordinatorResponseWithConfig)
+	case apiKeyDescribeCluster:
+		return newResponseModifier(apiKey, apiVersion, cfg, describeClusterResponseSchemaVersions, modifyDescribeClusterResponse)
 	case apiKeyProduce:
-		if cfg.TopicUnprefixer == nil {
+		if cfg.TopicUnprefixer == nil && (cfg.NetAddressMappingFunc == nil || !hasNodeEndpoints(apiKey, apiVersion)) {
 			return nil, nil
 		}
 		return newResponseModifier(apiKey, apiVersion, cfg, produceResponseSchemaVersions, modifyProduceResponse)
@@ -708,7 +878,7 @@ func GetResponseModifierWithConfig(apiKey int16, apiVersion int16, cfg ResponseM
 		}
 		return newResponseModifier(apiKey, apiVersion, cfg, listOffsetsResponseSchemaVersions, modifyListOffsetsResponse)
 	case apiKeyFetch:
-		if cfg.TopicUnprefixer == nil {
+		if cfg.TopicUnprefixer == nil && (cfg.NetAddressMappingFunc == nil || !hasNodeEndpoints(apiKey, apiVersion)) {
 			return nil, nil
 		}
 		return newResponseModifier(apiKey, apiVersion, cfg, fetchResponseSchemaVersions, modifyFetchResponse)
@@ -726,6 +896,67 @@ func GetResponseModifierWithConfig(apiKey int16, apiVersion int16, cfg ResponseM
 		return newDescribeGroupsResponseModifier(apiVersion, cfg)
 	case apiKeyListGroups:
 		return newListGroupsResponseModifier(apiVersion, cfg)
+	case apiKeyApiVersions:
+		return newApiVersionsResponseModifier(apiVersion)
+	case apiKeyAddPartitionsToTxn:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		// AddPartitionsToTxn responses share CreatePartitions' results[].name layout
+		return newResponseModifier(apiKey, apiVersion, cfg, addPartitionsToTxnResponseSchemaVersions, modifyCreatePartitionsResponse)
+	case apiKeyTxnOffsetCommit:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		// TxnOffsetCommit responses share OffsetCommit's topics[].name layout
+		return newResponseModifier(apiKey, apiVersion, cfg, txnOffsetCommitResponseSchemaVersions, modifyOffsetCommitResponse)
+	case apiKeyDescribeAcls, apiKeyCreateAcls, apiKeyDeleteAcls:
+		return newAclResponseModifier(apiKey, apiVersion, cfg)
+	case apiKeyDescribeConfigs:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		return newResponseModifier(apiKey, apiVersion, cfg, describeConfigsResponseSchemaVersions, modifyDescribeConfigsResponse)
+	case apiKeyAlterConfigs:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		return newResponseModifier(apiKey, apiVersion, cfg, alterConfigsResponseSchemaVersions, modifyAlterConfigsResponse)
+	case apiKeyCreateTopics:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		return newResponseModifier(apiKey, apiVersion, cfg, createTopicsResponseSchemaVersions, modifyCreateTopicsResponse)
+	case apiKeyDeleteTopics:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		return newResponseModifier(apiKey, apiVersion, cfg, deleteTopicsResponseSchemaVersions, modifyDeleteTopicsResponse)
+	case apiKeyCreatePartitions:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		return newResponseModifier(apiKey, apiVersion, cfg, createPartitionsResponseSchemaVersions, modifyCreatePartitionsResponse)
+	case apiKeyOffsetForLeaderEpoch:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		// OffsetForLeaderEpoch responses share ListOffsets' topics[] layout
+		return newResponseModifier(apiKey, apiVersion, cfg, offsetForLeaderEpochResponseSchemaVersions, modifyListOffsetsResponse)
+	case apiKeyDeleteRecords:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		// DeleteRecords responses share OffsetCommit's topics[].name layout
+		return newResponseModifier(apiKey, apiVersion, cfg, deleteRecordsResponseSchemaVersions, modifyOffsetCommitResponse)
+	case apiKeyDeleteGroups:
+		return newDeleteGroupsResponseModifier(apiVersion, cfg)
+	case apiKeyOffsetDelete:
+		if cfg.TopicUnprefixer == nil {
+			return nil, nil
+		}
+		// OffsetDelete responses share OffsetCommit's topics[].name layout
+		return newResponseModifier(apiKey, apiVersion, cfg, offsetDeleteResponseSchemaVersions, modifyOffsetCommitResponse)
 	default:
 		return nil, nil
 	}
@@ -887,8 +1118,12 @@ func createProduceResponseSchemaVersions() []Schema {
 	}
 }
 
-// modifyProduceResponse unprefixes topic names in Produce responses.
+// modifyProduceResponse maps node_endpoints and unprefixes topic names in
+// Produce responses.
 func modifyProduceResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	if err := mapNodeEndpoints(decodedStruct, cfg.NetAddressMappingFunc); err != nil {
+		return err
+	}
 	if cfg.TopicUnprefixer == nil {
 		return nil
 	}
@@ -1200,7 +1435,9 @@ func createFetchResponseSchemaVersions() []Schema {
 		&SchemaTaggedFields{Name: "response_tagged_fields"},
 	)
 
-	// v13+ adds topic_id
+	// v13+ identifies topics by topic_id only. diverging_epoch, current_leader,
+	// snapshot_id and node_endpoints are all tagged fields, so the layout is
+	// unchanged through v16.
 	topicV13 := NewSchema("fetch_topic_v13",
 		&Mfield{Name: "topic_id", Ty: TypeUuid},
 		&CompactArray{Name: "partitions", Ty: partitionV12},
@@ -1215,57 +1452,6 @@ func createFetchResponseSchemaVersions() []Schema {
 		&SchemaTaggedFields{Name: "response_tagged_fields"},
 	)
 
-	// v16 adds diverging_epoch and current_leader to partition
-	// and Node struct for current_leader
-	currentLeaderV16 := NewSchema("current_leader_v16",
-		&Mfield{Name: "leader_id", Ty: TypeInt32},
-		&Mfield{Name: "leader_epoch", Ty: TypeInt32},
-		&SchemaTaggedFields{Name: "leader_tagged_fields"},
-	)
-
-	// Snapshot info for v16
-	snapshotV16 := NewSchema("snapshot_v16",
-		&Mfield{Name: "end_offset", Ty: TypeInt64},
-		&Mfield{Name: "epoch", Ty: TypeInt32},
-		&SchemaTaggedFields{Name: "snapshot_tagged_fields"},
-	)
-
-	// Epoch info for diverging epoch
-	epochV16 := NewSchema("epoch_v16",
-		&Mfield{Name: "epoch", Ty: TypeInt32},
-		&Mfield{Name: "end_offset", Ty: TypeInt64},
-		&SchemaTaggedFields{Name: "epoch_tagged_fields"},
-	)
-
-	partitionV16 := NewSchema("fetch_partition_v16",
-		&Mfield{Name: "partition_index", Ty: TypeInt32},
-		&Mfield{Name: "error_code", Ty: TypeInt16},
-		&Mfield{Name: "high_watermark", Ty: TypeInt64},
-		&Mfield{Name: "last_stable_offset", Ty: TypeInt64},
-		&Mfield{Name: "log_start_offset", Ty: TypeInt64},
-		&Mfield{Name: "diverging_epoch", Ty: epochV16},
-		&Mfield{Name: "current_leader", Ty: currentLeaderV16},
-		&Mfield{Name: "snapshot_id", Ty: snapshotV16},
-		&CompactArray{Name: "aborted_transactions", Ty: abortedTxnV12},
-		&Mfield{Name: "preferred_read_replica", Ty: TypeInt32},
-		&Mfield{Name: "records", Ty: TypeCompactBytes},
-		&SchemaTaggedFields{Name: "partition_tagged_fields"},
-	)
-
-	topicV16 := NewSchema("fetch_topic_v16",
-		&Mfield{Name: "topic_id", Ty: TypeUuid},
-		&CompactArray{Name: "partitions", Ty: partitionV16},
-		&SchemaTaggedFields{Name: "topic_tagged_fields"},
-	)
-
-	fetchV16 := NewSchema("fetch_response_v16",
-		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
-		&Mfield{Name: "error_code", Ty: TypeInt16},
-		&Mfield{Name: "session_id", Ty: TypeInt32},
-		&CompactArray{Name: "responses", Ty: topicV16},
-		&SchemaTaggedFields{Name: "response_tagged_fields"},
-	)
-
 	return []Schema{
 		fetchV0,  // v0
 		fetchV1,  // v1
@@ -1283,12 +1469,16 @@ func createFetchResponseSchemaVersions() []Schema {
 		fetchV13, // v13
 		fetchV13, // v14
 		fetchV13, // v15
-		fetchV16, // v16
+		fetchV13, // v16 (node_endpoints is a tagged field)
 	}
 }
 
-// modifyFetchResponse unprefixes topic names in Fetch responses.
+// modifyFetchResponse maps node_endpoints and unprefixes topic names in Fetch
+// responses.
 func modifyFetchResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	if err := mapNodeEndpoints(decodedStruct, cfg.NetAddressMappingFunc); err != nil {
+		return err
+	}
 	if cfg.TopicUnprefixer == nil {
 		return nil
 	}
@@ -1312,7 +1502,9 @@ func modifyFetchResponse(decodedStruct *Struct, cfg ResponseModifierConfig) erro
 		// For v13+, the topic name is not present in the response
 		topicName := topic.Get("topic")
 		if topicName == nil {
-			// v13+ uses topic_id instead - no topic name to rewrite
+			if err := restrictFetchTopicByID(topic, cfg); err != nil {
+				return err
+			}
 			continue
 		}
 
@@ -1329,6 +1521,50 @@ func modifyFetchResponse(decodedStruct *Struct, cfg ResponseModifierConfig) erro
 	return nil
 }
 
+// errUnknownTopicID is the UNKNOWN_TOPIC_ID error code
+const errUnknownTopicID = int16(100)
+
+// restrictFetchTopicByID withholds a Fetch v13+ topic that does not resolve to
+// one of the tenant's topics, answering each partition the way a broker
+// answers a topic ID it does not know. Topic IDs that have not been seen in a
+// Metadata response are withheld too, since they cannot be attributed to the
+// tenant; the client refreshes its metadata and retries, which records them.
+func restrictFetchTopicByID(topic *Struct, cfg ResponseModifierConfig) error {
+	if cfg.TopicFilter == nil {
+		return nil
+	}
+	topicID, ok := topic.Get("topic_id").(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	if cfg.TopicIDResolver != nil {
+		if name, ok := cfg.TopicIDResolver(topicID); ok && cfg.TopicFilter(name) {
+			return nil
+		}
+	}
+
+	partitions, _ := topic.Get("partitions").([]interface{})
+	for _, partitionElement := range partitions {
+		partition, ok := partitionElement.(*Struct)
+		if !ok {
+			continue
+		}
+		for field, value := range map[string]interface{}{
+			"error_code":           errUnknownTopicID,
+			"high_watermark":       int64(-1),
+			"last_stable_offset":   int64(-1),
+			"log_start_offset":     int64(-1),
+			"aborted_transactions": []interface{}{},
+			"records":              []byte{},
+		} {
+			if err := partition.Replace(field, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // OffsetCommit response schemas
 var offsetCommitResponseSchemaVersions = createOffsetCommitResponseSchemaVersions()
 
@@ -1427,53 +1663,198 @@ func modifyOffsetCommitResponse(decodedStruct *Struct, cfg ResponseModifierConfi
 	return nil
 }
 
-// OffsetFetch response schemas
-var offsetFetchResponseSchemaVersions = createOffsetFetchResponseSchemaVersions()
+// OffsetDelete response schemas
+var offsetDeleteResponseSchemaVersions = createOffsetDeleteResponseSchemaVersions()
 
-func createOffsetFetchResponseSchemaVersions() []Schema {
-	// Partition for v0-v1
-	partitionV0 := NewSchema("offset_fetch_partition_response_v0",
+func createOffsetDeleteResponseSchemaVersions() []Schema {
+	partitionV0 := NewSchema("offset_delete_partition_response_v0",
 		&Mfield{Name: "partition_index", Ty: TypeInt32},
-		&Mfield{Name: "committed_offset", Ty: TypeInt64},
-		&Mfield{Name: "metadata", Ty: TypeNullableStr},
 		&Mfield{Name: "error_code", Ty: TypeInt16},
 	)
 
-	topicV0 := NewSchema("offset_fetch_topic_response_v0",
+	topicV0 := NewSchema("offset_delete_topic_response_v0",
 		&Mfield{Name: "name", Ty: TypeStr},
 		&Array{Name: "partitions", Ty: partitionV0},
 	)
 
-	// v0-v1
-	offsetFetchV0 := NewSchema("offset_fetch_response_v0",
+	offsetDeleteV0 := NewSchema("offset_delete_response_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
 		&Array{Name: "topics", Ty: topicV0},
 	)
 
-	// v2 adds error_code at top level
-	offsetFetchV2 := NewSchema("offset_fetch_response_v2",
-		&Array{Name: "topics", Ty: topicV0},
+	return []Schema{
+		offsetDeleteV0, // v0
+	}
+}
+
+// DeleteRecords response schemas
+var deleteRecordsResponseSchemaVersions = createDeleteRecordsResponseSchemaVersions()
+
+func createDeleteRecordsResponseSchemaVersions() []Schema {
+	partitionV0 := NewSchema("delete_records_partition_result_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "low_watermark", Ty: TypeInt64},
 		&Mfield{Name: "error_code", Ty: TypeInt16},
 	)
 
-	// v3+ adds throttle_time_ms
-	offsetFetchV3 := NewSchema("offset_fetch_response_v3",
+	topicV0 := NewSchema("delete_records_topic_result_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0-v1
+	deleteRecordsV0 := NewSchema("delete_records_response_v0",
 		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
 		&Array{Name: "topics", Ty: topicV0},
-		&Mfield{Name: "error_code", Ty: TypeInt16},
 	)
 
-	// v5 adds committed_leader_epoch to partition
-	partitionV5 := NewSchema("offset_fetch_partition_response_v5",
+	// v2+ flexible
+	partitionV2 := NewSchema("delete_records_partition_result_v2",
 		&Mfield{Name: "partition_index", Ty: TypeInt32},
-		&Mfield{Name: "committed_offset", Ty: TypeInt64},
-		&Mfield{Name: "committed_leader_epoch", Ty: TypeInt32},
-		&Mfield{Name: "metadata", Ty: TypeNullableStr},
+		&Mfield{Name: "low_watermark", Ty: TypeInt64},
 		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
 	)
 
-	topicV5 := NewSchema("offset_fetch_topic_response_v5",
-		&Mfield{Name: "name", Ty: TypeStr},
-		&Array{Name: "partitions", Ty: partitionV5},
+	topicV2 := NewSchema("delete_records_topic_result_v2",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: partitionV2},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	deleteRecordsV2 := NewSchema("delete_records_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV2},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteRecordsV0, // v0
+		deleteRecordsV0, // v1
+		deleteRecordsV2, // v2
+	}
+}
+
+// OffsetForLeaderEpoch response schemas
+var offsetForLeaderEpochResponseSchemaVersions = createOffsetForLeaderEpochResponseSchemaVersions()
+
+func createOffsetForLeaderEpochResponseSchemaVersions() []Schema {
+	partitionV0 := NewSchema("offset_for_leader_epoch_partition_result_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "partition", Ty: TypeInt32},
+		&Mfield{Name: "end_offset", Ty: TypeInt64},
+	)
+
+	topicV0 := NewSchema("offset_for_leader_epoch_topic_result_v0",
+		&Mfield{Name: "topic", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	offsetForLeaderEpochV0 := NewSchema("offset_for_leader_epoch_response_v0",
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v1 adds leader_epoch
+	partitionV1 := NewSchema("offset_for_leader_epoch_partition_result_v1",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "partition", Ty: TypeInt32},
+		&Mfield{Name: "leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "end_offset", Ty: TypeInt64},
+	)
+
+	topicV1 := NewSchema("offset_for_leader_epoch_topic_result_v1",
+		&Mfield{Name: "topic", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV1},
+	)
+
+	offsetForLeaderEpochV1 := NewSchema("offset_for_leader_epoch_response_v1",
+		&Array{Name: "topics", Ty: topicV1},
+	)
+
+	// v2-v3 add throttle_time_ms
+	offsetForLeaderEpochV2 := NewSchema("offset_for_leader_epoch_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "topics", Ty: topicV1},
+	)
+
+	// v4+ flexible
+	partitionV4 := NewSchema("offset_for_leader_epoch_partition_result_v4",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "partition", Ty: TypeInt32},
+		&Mfield{Name: "leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "end_offset", Ty: TypeInt64},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
+	)
+
+	topicV4 := NewSchema("offset_for_leader_epoch_topic_result_v4",
+		&Mfield{Name: "topic", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: partitionV4},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	offsetForLeaderEpochV4 := NewSchema("offset_for_leader_epoch_response_v4",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV4},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		offsetForLeaderEpochV0, // v0
+		offsetForLeaderEpochV1, // v1
+		offsetForLeaderEpochV2, // v2
+		offsetForLeaderEpochV2, // v3
+		offsetForLeaderEpochV4, // v4
+	}
+}
+
+// OffsetFetch response schemas
+var offsetFetchResponseSchemaVersions = createOffsetFetchResponseSchemaVersions()
+
+func createOffsetFetchResponseSchemaVersions() []Schema {
+	// Partition for v0-v1
+	partitionV0 := NewSchema("offset_fetch_partition_response_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "committed_offset", Ty: TypeInt64},
+		&Mfield{Name: "metadata", Ty: TypeNullableStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	topicV0 := NewSchema("offset_fetch_topic_response_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0-v1
+	offsetFetchV0 := NewSchema("offset_fetch_response_v0",
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v2 adds error_code at top level
+	offsetFetchV2 := NewSchema("offset_fetch_response_v2",
+		&Array{Name: "topics", Ty: topicV0},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	// v3+ adds throttle_time_ms
+	offsetFetchV3 := NewSchema("offset_fetch_response_v3",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "topics", Ty: topicV0},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	// v5 adds committed_leader_epoch to partition
+	partitionV5 := NewSchema("offset_fetch_partition_response_v5",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "committed_offset", Ty: TypeInt64},
+		&Mfield{Name: "committed_leader_epoch", Ty: TypeInt32},
+		&Mfield{Name: "metadata", Ty: TypeNullableStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	topicV5 := NewSchema("offset_fetch_topic_response_v5",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV5},
 	)
 
 	offsetFetchV5 := NewSchema("offset_fetch_response_v5",
@@ -2004,3 +2385,797 @@ func newListGroupsResponseModifier(apiVersion int16, cfg ResponseModifierConfig)
 		groupFilter:     cfg.GroupFilter,
 	}, nil
 }
+
+// AddPartitionsToTxn response schemas
+var addPartitionsToTxnResponseSchemaVersions = createAddPartitionsToTxnResponseSchemaVersions()
+
+func createAddPartitionsToTxnResponseSchemaVersions() []Schema {
+	partitionV0 := NewSchema("add_partitions_to_txn_partition_result_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	resultV0 := NewSchema("add_partitions_to_txn_topic_result_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "results", Ty: partitionV0},
+	)
+
+	// v0-v2
+	addPartitionsToTxnV0 := NewSchema("add_partitions_to_txn_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "results", Ty: resultV0},
+	)
+
+	// v3 flexible
+	partitionV3 := NewSchema("add_partitions_to_txn_partition_result_v3",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
+	)
+
+	resultV3 := NewSchema("add_partitions_to_txn_topic_result_v3",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&CompactArray{Name: "results", Ty: partitionV3},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	addPartitionsToTxnV3 := NewSchema("add_partitions_to_txn_response_v3",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "results", Ty: resultV3},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		addPartitionsToTxnV0, // v0
+		addPartitionsToTxnV0, // v1
+		addPartitionsToTxnV0, // v2
+		addPartitionsToTxnV3, // v3
+	}
+}
+
+// TxnOffsetCommit response schemas
+var txnOffsetCommitResponseSchemaVersions = createTxnOffsetCommitResponseSchemaVersions()
+
+func createTxnOffsetCommitResponseSchemaVersions() []Schema {
+	partitionV0 := NewSchema("txn_offset_commit_partition_response_v0",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	topicV0 := NewSchema("txn_offset_commit_topic_response_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Array{Name: "partitions", Ty: partitionV0},
+	)
+
+	// v0-v2
+	txnOffsetCommitV0 := NewSchema("txn_offset_commit_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v3+ flexible
+	partitionV3 := NewSchema("txn_offset_commit_partition_response_v3",
+		&Mfield{Name: "partition_index", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "partition_tagged_fields"},
+	)
+
+	topicV3 := NewSchema("txn_offset_commit_topic_response_v3",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&CompactArray{Name: "partitions", Ty: partitionV3},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	txnOffsetCommitV3 := NewSchema("txn_offset_commit_response_v3",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV3},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		txnOffsetCommitV0, // v0
+		txnOffsetCommitV0, // v1
+		txnOffsetCommitV0, // v2
+		txnOffsetCommitV3, // v3
+	}
+}
+
+// DescribeConfigs response schemas
+var describeConfigsResponseSchemaVersions = createDescribeConfigsResponseSchemaVersions()
+
+func createDescribeConfigsResponseSchemaVersions() []Schema {
+	configV0 := NewSchema("describe_configs_config_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "value", Ty: TypeNullableStr},
+		&Mfield{Name: "read_only", Ty: TypeBool},
+		&Mfield{Name: "is_default", Ty: TypeBool},
+		&Mfield{Name: "is_sensitive", Ty: TypeBool},
+	)
+
+	resultV0 := NewSchema("describe_configs_result_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Array{Name: "configs", Ty: configV0},
+	)
+
+	describeConfigsV0 := NewSchema("describe_configs_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "results", Ty: resultV0},
+	)
+
+	// v1-v2 replace is_default with config_source and add synonyms
+	synonymV1 := NewSchema("describe_configs_synonym_v1",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "value", Ty: TypeNullableStr},
+		&Mfield{Name: "source", Ty: TypeInt8},
+	)
+
+	configV1 := NewSchema("describe_configs_config_v1",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "value", Ty: TypeNullableStr},
+		&Mfield{Name: "read_only", Ty: TypeBool},
+		&Mfield{Name: "config_source", Ty: TypeInt8},
+		&Mfield{Name: "is_sensitive", Ty: TypeBool},
+		&Array{Name: "synonyms", Ty: synonymV1},
+	)
+
+	resultV1 := NewSchema("describe_configs_result_v1",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Array{Name: "configs", Ty: configV1},
+	)
+
+	describeConfigsV1 := NewSchema("describe_configs_response_v1",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "results", Ty: resultV1},
+	)
+
+	// v3 adds config_type and documentation
+	configV3 := NewSchema("describe_configs_config_v3",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "value", Ty: TypeNullableStr},
+		&Mfield{Name: "read_only", Ty: TypeBool},
+		&Mfield{Name: "config_source", Ty: TypeInt8},
+		&Mfield{Name: "is_sensitive", Ty: TypeBool},
+		&Array{Name: "synonyms", Ty: synonymV1},
+		&Mfield{Name: "config_type", Ty: TypeInt8},
+		&Mfield{Name: "documentation", Ty: TypeNullableStr},
+	)
+
+	resultV3 := NewSchema("describe_configs_result_v3",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+		&Array{Name: "configs", Ty: configV3},
+	)
+
+	describeConfigsV3 := NewSchema("describe_configs_response_v3",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "results", Ty: resultV3},
+	)
+
+	// v4+ flexible
+	synonymV4 := NewSchema("describe_configs_synonym_v4",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "value", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "source", Ty: TypeInt8},
+		&SchemaTaggedFields{Name: "synonym_tagged_fields"},
+	)
+
+	configV4 := NewSchema("describe_configs_config_v4",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "value", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "read_only", Ty: TypeBool},
+		&Mfield{Name: "config_source", Ty: TypeInt8},
+		&Mfield{Name: "is_sensitive", Ty: TypeBool},
+		&CompactArray{Name: "synonyms", Ty: synonymV4},
+		&Mfield{Name: "config_type", Ty: TypeInt8},
+		&Mfield{Name: "documentation", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "config_tagged_fields"},
+	)
+
+	resultV4 := NewSchema("describe_configs_result_v4",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&CompactArray{Name: "configs", Ty: configV4},
+		&SchemaTaggedFields{Name: "result_tagged_fields"},
+	)
+
+	describeConfigsV4 := NewSchema("describe_configs_response_v4",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "results", Ty: resultV4},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		describeConfigsV0, // v0
+		describeConfigsV1, // v1
+		describeConfigsV1, // v2
+		describeConfigsV3, // v3
+		describeConfigsV4, // v4
+	}
+}
+
+// modifyDescribeConfigsResponse unprefixes topic resource names in DescribeConfigs responses.
+func modifyDescribeConfigsResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	return renameTopicConfigResources(decodedStruct, "results", cfg.TopicUnprefixer)
+}
+
+// AlterConfigs response schemas
+var alterConfigsResponseSchemaVersions = createAlterConfigsResponseSchemaVersions()
+
+func createAlterConfigsResponseSchemaVersions() []Schema {
+	resourceV0 := NewSchema("alter_configs_resource_response_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeStr},
+	)
+
+	// v0-v1
+	alterConfigsV0 := NewSchema("alter_configs_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "responses", Ty: resourceV0},
+	)
+
+	// v2+ flexible
+	resourceV2 := NewSchema("alter_configs_resource_response_v2",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "resource_type", Ty: TypeInt8},
+		&Mfield{Name: "resource_name", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "resource_tagged_fields"},
+	)
+
+	alterConfigsV2 := NewSchema("alter_configs_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "responses", Ty: resourceV2},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		alterConfigsV0, // v0
+		alterConfigsV0, // v1
+		alterConfigsV2, // v2
+	}
+}
+
+// modifyAlterConfigsResponse unprefixes topic resource names in AlterConfigs responses.
+func modifyAlterConfigsResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	return renameTopicConfigResources(decodedStruct, "responses", cfg.TopicUnprefixer)
+}
+
+// CreatePartitions response schemas
+var createPartitionsResponseSchemaVersions = createCreatePartitionsResponseSchemaVersions()
+
+func createCreatePartitionsResponseSchemaVersions() []Schema {
+	resultV0 := NewSchema("create_partitions_result_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+	)
+
+	// v0-v1
+	createPartitionsV0 := NewSchema("create_partitions_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "results", Ty: resultV0},
+	)
+
+	// v2+ flexible
+	resultV2 := NewSchema("create_partitions_result_v2",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "result_tagged_fields"},
+	)
+
+	createPartitionsV2 := NewSchema("create_partitions_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "results", Ty: resultV2},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		createPartitionsV0, // v0
+		createPartitionsV0, // v1
+		createPartitionsV2, // v2
+		createPartitionsV2, // v3
+	}
+}
+
+// modifyCreatePartitionsResponse unprefixes topic names in CreatePartitions responses.
+func modifyCreatePartitionsResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	results, ok := decodedStruct.Get("results").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, resultElement := range results {
+		result, ok := resultElement.(*Struct)
+		if !ok {
+			continue
+		}
+		name, ok := result.Get("name").(string)
+		if !ok || name == "" {
+			continue
+		}
+		if unprefixedName := cfg.TopicUnprefixer(name); unprefixedName != name {
+			if err := result.Replace("name", unprefixedName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteGroups response schemas
+var deleteGroupsResponseSchemas = createDeleteGroupsResponseSchemas()
+
+func createDeleteGroupsResponseSchemas() []Schema {
+	resultV0 := NewSchema("delete_groups_result_v0",
+		&Mfield{Name: "group_id", Ty: TypeStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	// v0-v1
+	deleteGroupsV0 := NewSchema("delete_groups_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "results", Ty: resultV0},
+	)
+
+	// v2+ flexible
+	resultV2 := NewSchema("delete_groups_result_v2",
+		&Mfield{Name: "group_id", Ty: TypeCompactStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "result_tagged_fields"},
+	)
+
+	deleteGroupsV2 := NewSchema("delete_groups_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "results", Ty: resultV2},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteGroupsV0, // v0
+		deleteGroupsV0, // v1
+		deleteGroupsV2, // v2
+	}
+}
+
+// deleteGroupsResponseModifier unprefixes group_ids in DeleteGroups responses
+type deleteGroupsResponseModifier struct {
+	schema          Schema
+	groupUnprefixer GroupUnprefixer
+}
+
+func (m *deleteGroupsResponseModifier) Apply(responseBytes []byte) ([]byte, error) {
+	decoded, err := DecodeSchema(responseBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode delete groups response: %w", err)
+	}
+
+	if err := modifyDeleteGroupsResponse(decoded, m.groupUnprefixer); err != nil {
+		return nil, fmt.Errorf("modify delete groups response: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func modifyDeleteGroupsResponse(decoded *Struct, groupUnprefixer GroupUnprefixer) error {
+	results, ok := decoded.Get("results").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, resultElement := range results {
+		result, ok := resultElement.(*Struct)
+		if !ok {
+			continue
+		}
+		gid, ok := result.Get("group_id").(string)
+		if !ok || gid == "" {
+			continue
+		}
+		if unprefixedId := groupUnprefixer(gid); unprefixedId != gid {
+			if err := result.Replace("group_id", unprefixedId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func newDeleteGroupsResponseModifier(apiVersion int16, cfg ResponseModifierConfig) (ResponseModifier, error) {
+	if cfg.GroupUnprefixer == nil {
+		return nil, nil
+	}
+	if apiVersion < 0 || int(apiVersion) >= len(deleteGroupsResponseSchemas) {
+		return nil, fmt.Errorf("unsupported DeleteGroups response version %d", apiVersion)
+	}
+	return &deleteGroupsResponseModifier{
+		schema:          deleteGroupsResponseSchemas[apiVersion],
+		groupUnprefixer: cfg.GroupUnprefixer,
+	}, nil
+}
+
+// ApiVersions response schemas
+var apiVersionsResponseSchemas = createApiVersionsResponseSchemas()
+
+func createApiVersionsResponseSchemas() []Schema {
+	apiKeyV0 := NewSchema("api_versions_api_key_v0",
+		&Mfield{Name: "api_key", Ty: TypeInt16},
+		&Mfield{Name: "min_version", Ty: TypeInt16},
+		&Mfield{Name: "max_version", Ty: TypeInt16},
+	)
+
+	// v0
+	apiVersionsV0 := NewSchema("api_versions_response_v0",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Array{Name: "api_keys", Ty: apiKeyV0},
+	)
+
+	// v1-v2 add throttle_time_ms
+	apiVersionsV1 := NewSchema("api_versions_response_v1",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Array{Name: "api_keys", Ty: apiKeyV0},
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+	)
+
+	// v3+ flexible; supported/finalized features travel as tagged fields
+	apiKeyV3 := NewSchema("api_versions_api_key_v3",
+		&Mfield{Name: "api_key", Ty: TypeInt16},
+		&Mfield{Name: "min_version", Ty: TypeInt16},
+		&Mfield{Name: "max_version", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "api_key_tagged_fields"},
+	)
+
+	apiVersionsV3 := NewSchema("api_versions_response_v3",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&CompactArray{Name: "api_keys", Ty: apiKeyV3},
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		apiVersionsV0, // v0
+		apiVersionsV1, // v1
+		apiVersionsV1, // v2
+		apiVersionsV3, // v3
+		apiVersionsV3, // v4
+	}
+}
+
+// apiVersionsResponseModifier clamps the broker's advertised API versions to
+// those Bifrost can rewrite
+type apiVersionsResponseModifier struct {
+	schema Schema
+}
+
+func (m *apiVersionsResponseModifier) Apply(responseBytes []byte) ([]byte, error) {
+	// Error responses (e.g. UNSUPPORTED_VERSION) are always encoded as v0 and
+	// only list the ApiVersions key, so they are passed through untouched
+	if len(responseBytes) >= 2 && (responseBytes[0] != 0 || responseBytes[1] != 0) {
+		return responseBytes, nil
+	}
+
+	decoded, err := DecodeSchema(responseBytes, m.schema)
+	if err != nil {
+		return nil, fmt.Errorf("decode api versions response: %w", err)
+	}
+
+	if err := modifyApiVersionsResponse(decoded); err != nil {
+		return nil, fmt.Errorf("modify api versions response: %w", err)
+	}
+
+	return EncodeSchema(decoded, m.schema)
+}
+
+func modifyApiVersionsResponse(decoded *Struct) error {
+	apiKeys, ok := decoded.Get("api_keys").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	supported := supportedApiVersions()
+	clamped := make([]interface{}, 0, len(apiKeys))
+	for _, apiKeyElement := range apiKeys {
+		apiKey, ok := apiKeyElement.(*Struct)
+		if !ok {
+			clamped = append(clamped, apiKeyElement)
+			continue
+		}
+		key, _ := apiKey.Get("api_key").(int16)
+		maxSupported, ok := supported[key]
+		if !ok {
+			clamped = append(clamped, apiKey)
+			continue
+		}
+		minVersion, _ := apiKey.Get("min_version").(int16)
+		maxVersion, _ := apiKey.Get("max_version").(int16)
+		if minVersion > maxSupported {
+			// No version in common; hide the API rather than let clients use it
+			continue
+		}
+		if maxVersion > maxSupported {
+			if err := apiKey.Replace("max_version", maxSupported); err != nil {
+				return err
+			}
+		}
+		clamped = append(clamped, apiKey)
+	}
+
+	return decoded.Replace("api_keys", clamped)
+}
+
+func newApiVersionsResponseModifier(apiVersion int16) (ResponseModifier, error) {
+	if apiVersion < 0 || int(apiVersion) >= len(apiVersionsResponseSchemas) {
+		// Newer ApiVersions versions are passed through rather than failing the connection
+		return nil, nil
+	}
+	return &apiVersionsResponseModifier{
+		schema: apiVersionsResponseSchemas[apiVersion],
+	}, nil
+}
+
+// CreateTopics response schemas
+var createTopicsResponseSchemaVersions = createCreateTopicsResponseSchemaVersions()
+
+func createCreateTopicsResponseSchemaVersions() []Schema {
+	topicV0 := NewSchema("create_topics_topic_result_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	createTopicsV0 := NewSchema("create_topics_response_v0",
+		&Array{Name: "topics", Ty: topicV0},
+	)
+
+	// v1 adds error_message
+	topicV1 := NewSchema("create_topics_topic_result_v1",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeNullableStr},
+	)
+
+	createTopicsV1 := NewSchema("create_topics_response_v1",
+		&Array{Name: "topics", Ty: topicV1},
+	)
+
+	// v2-v4 add throttle_time_ms
+	createTopicsV2 := NewSchema("create_topics_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "topics", Ty: topicV1},
+	)
+
+	// v5+ flexible, returns the created topic's partitions, replication and configs
+	configV5 := NewSchema("create_topics_config_result_v5",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "value", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "read_only", Ty: TypeBool},
+		&Mfield{Name: "config_source", Ty: TypeInt8},
+		&Mfield{Name: "is_sensitive", Ty: TypeBool},
+		&SchemaTaggedFields{Name: "config_tagged_fields"},
+	)
+
+	topicV5 := NewSchema("create_topics_topic_result_v5",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "num_partitions", Ty: TypeInt32},
+		&Mfield{Name: "replication_factor", Ty: TypeInt16},
+		&CompactNullableArray{Name: "configs", Ty: configV5},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	createTopicsV5 := NewSchema("create_topics_response_v5",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV5},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	// v7 adds topic_id
+	topicV7 := NewSchema("create_topics_topic_result_v7",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "topic_id", Ty: TypeUuid},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "num_partitions", Ty: TypeInt32},
+		&Mfield{Name: "replication_factor", Ty: TypeInt16},
+		&CompactNullableArray{Name: "configs", Ty: configV5},
+		&SchemaTaggedFields{Name: "topic_tagged_fields"},
+	)
+
+	createTopicsV7 := NewSchema("create_topics_response_v7",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "topics", Ty: topicV7},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		createTopicsV0, // v0
+		createTopicsV1, // v1
+		createTopicsV2, // v2
+		createTopicsV2, // v3
+		createTopicsV2, // v4
+		createTopicsV5, // v5
+		createTopicsV5, // v6
+		createTopicsV7, // v7
+	}
+}
+
+// modifyCreateTopicsResponse removes the tenant prefix from topics[].name
+func modifyCreateTopicsResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	return unprefixTopicNames(decodedStruct, "topics", cfg)
+}
+
+// unprefixTopicNames removes the tenant prefix from the name field of each
+// element of the named array
+func unprefixTopicNames(decodedStruct *Struct, arrayName string, cfg ResponseModifierConfig) error {
+	elements, ok := decodedStruct.Get(arrayName).([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, element := range elements {
+		topic, ok := element.(*Struct)
+		if !ok {
+			continue
+		}
+		name := getTopicNameFromStruct(topic)
+		if name == "" {
+			continue
+		}
+		if unprefixedName := cfg.TopicUnprefixer(name); unprefixedName != name {
+			if err := setTopicNameInStruct(topic, unprefixedName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteTopics response schemas
+var deleteTopicsResponseSchemaVersions = createDeleteTopicsResponseSchemaVersions()
+
+func createDeleteTopicsResponseSchemaVersions() []Schema {
+	resultV0 := NewSchema("delete_topics_result_v0",
+		&Mfield{Name: "name", Ty: TypeStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	deleteTopicsV0 := NewSchema("delete_topics_response_v0",
+		&Array{Name: "responses", Ty: resultV0},
+	)
+
+	// v1-v3 add throttle_time_ms
+	deleteTopicsV1 := NewSchema("delete_topics_response_v1",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Array{Name: "responses", Ty: resultV0},
+	)
+
+	// v4 flexible
+	resultV4 := NewSchema("delete_topics_result_v4",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "result_tagged_fields"},
+	)
+
+	deleteTopicsV4 := NewSchema("delete_topics_response_v4",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "responses", Ty: resultV4},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	// v5 adds error_message
+	resultV5 := NewSchema("delete_topics_result_v5",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "result_tagged_fields"},
+	)
+
+	deleteTopicsV5 := NewSchema("delete_topics_response_v5",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "responses", Ty: resultV5},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	// v6 makes name nullable and adds topic_id
+	resultV6 := NewSchema("delete_topics_result_v6",
+		&Mfield{Name: "name", Ty: TypeCompactNullableStr},
+		&Mfield{Name: "topic_id", Ty: TypeUuid},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "error_message", Ty: TypeCompactNullableStr},
+		&SchemaTaggedFields{Name: "result_tagged_fields"},
+	)
+
+	deleteTopicsV6 := NewSchema("delete_topics_response_v6",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&CompactArray{Name: "responses", Ty: resultV6},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		deleteTopicsV0, // v0
+		deleteTopicsV1, // v1
+		deleteTopicsV1, // v2
+		deleteTopicsV1, // v3
+		deleteTopicsV4, // v4
+		deleteTopicsV5, // v5
+		deleteTopicsV6, // v6
+	}
+}
+
+// modifyDeleteTopicsResponse removes the tenant prefix from responses[].name
+func modifyDeleteTopicsResponse(decodedStruct *Struct, cfg ResponseModifierConfig) error {
+	return unprefixTopicNames(decodedStruct, "responses", cfg)
+}
+
+// InitProducerId response schemas
+var initProducerIdResponseSchemaVersions = createInitProducerIdResponseSchemaVersions()
+
+func createInitProducerIdResponseSchemaVersions() []Schema {
+	// v0-v1
+	initProducerIdV0 := NewSchema("init_producer_id_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+	)
+
+	// v2+ flexible
+	initProducerIdV2 := NewSchema("init_producer_id_response_v2",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "producer_id", Ty: TypeInt64},
+		&Mfield{Name: "producer_epoch", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		initProducerIdV0, // v0
+		initProducerIdV0, // v1
+		initProducerIdV2, // v2
+		initProducerIdV2, // v3
+		initProducerIdV2, // v4
+	}
+}
+
+// AddOffsetsToTxn and EndTxn responses only carry an error code
+var (
+	addOffsetsToTxnResponseSchemaVersions = createTxnErrorResponseSchemaVersions("add_offsets_to_txn")
+	endTxnResponseSchemaVersions          = createTxnErrorResponseSchemaVersions("end_txn")
+)
+
+func createTxnErrorResponseSchemaVersions(name string) []Schema {
+	// v0-v2
+	responseV0 := NewSchema(name+"_response_v0",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+	)
+
+	// v3 flexible
+	responseV3 := NewSchema(name+"_response_v3",
+		&Mfield{Name: "throttle_time_ms", Ty: TypeInt32},
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&SchemaTaggedFields{Name: "response_tagged_fields"},
+	)
+
+	return []Schema{
+		responseV0, // v0
+		responseV0, // v1
+		responseV0, // v2
+		responseV3, // v3
+	}
+}
@@ -0,0 +1,157 @@
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	leakTestInternalHost   = "redpanda-0.redpanda.internal"
+	leakTestAdvertisedHost = "tenant-a.kafka.example.com"
+)
+
+// leakTestMapper maps every broker to the advertised address, as Bifrost
+// does for a virtual cluster
+func leakTestMapper(host string, port int32, nodeId int32) (string, int32, error) {
+	return leakTestAdvertisedHost, 19092, nil
+}
+
+// fillResponse builds a response for schema with one element in every array,
+// the internal address in every host/port field and filler everywhere else
+func fillResponse(t *testing.T, s Schema) *Struct {
+	t.Helper()
+	st := &Struct{Schema: s}
+	for _, field := range s.GetFields() {
+		st.Values = append(st.Values, fillField(t, field.def))
+	}
+	return st
+}
+
+func fillField(t *testing.T, field Field) interface{} {
+	t.Helper()
+	switch f := field.(type) {
+	case *Mfield:
+		return fillValue(t, f.Name, f.Ty)
+	case *Array:
+		return []interface{}{fillValue(t, f.Name, f.Ty)}
+	case *NullableArray:
+		return []interface{}{fillValue(t, f.Name, f.Ty)}
+	case *CompactArray:
+		return []interface{}{fillValue(t, f.Name, f.Ty)}
+	case *CompactNullableArray:
+		return []interface{}{fillValue(t, f.Name, f.Ty)}
+	case *SchemaTaggedFields:
+		return []rawTaggedField{}
+	case SchemaTaggedFields:
+		return []rawTaggedField{}
+	}
+	t.Fatalf("unsupported field %T", field)
+	return nil
+}
+
+func fillValue(t *testing.T, name string, ty EncoderDecoder) interface{} {
+	t.Helper()
+	switch ty {
+	case TypeBool:
+		return false
+	case TypeInt8:
+		return int8(0)
+	case TypeInt16:
+		return int16(0)
+	case TypeInt32:
+		if name == portKeyName {
+			return int32(9092)
+		}
+		return int32(1)
+	case TypeInt64:
+		return int64(0)
+	case TypeStr, TypeCompactStr:
+		if name == hostKeyName {
+			return leakTestInternalHost
+		}
+		return "filler"
+	case TypeNullableStr, TypeCompactNullableStr:
+		value := "filler"
+		return &value
+	case TypeBytes, TypeCompactBytes:
+		return []byte{}
+	case TypeUuid:
+		return uuid.New()
+	}
+	if s, ok := ty.(Schema); ok && s.GetFields() != nil {
+		return fillResponse(t, s)
+	}
+	t.Fatalf("unsupported type %T for field %s", ty, name)
+	return nil
+}
+
+// withNodeEndpoints adds a node_endpoints leader hint for the internal
+// address to the response's top-level tagged fields
+func withNodeEndpoints(t *testing.T, st *Struct) {
+	t.Helper()
+	endpoints, err := EncodeSchema(fillResponse(t, nodeEndpointsSchema), nodeEndpointsSchema)
+	require.NoError(t, err)
+	require.NoError(t, st.Replace(responseTaggedFieldsKeyName, []rawTaggedField{{tag: nodeEndpointsTag, data: endpoints}}))
+}
+
+// TestResponseModifiers_DoNotLeakInternalAddresses plants the internal broker
+// address in every response that carries broker coordinates and fails if it
+// survives mapping anywhere in the rewritten bytes.
+func TestResponseModifiers_DoNotLeakInternalAddresses(t *testing.T) {
+	type testCase struct {
+		apiKey        int16
+		schemas       []Schema
+		versions      []int16
+		nodeEndpoints bool
+	}
+	allVersions := func(schemas []Schema) []int16 {
+		versions := make([]int16, len(schemas))
+		for i := range schemas {
+			versions[i] = int16(i)
+		}
+		return versions
+	}
+	tests := map[string]testCase{
+		"Metadata":        {apiKey: apiKeyMetadata, schemas: metadataResponseSchemaVersions, versions: allVersions(metadataResponseSchemaVersions)},
+		"FindCoordinator": {apiKey: apiKeyFindCoordinator, schemas: findCoordinatorResponseSchemaVersions, versions: allVersions(findCoordinatorResponseSchemaVersions)},
+		"DescribeCluster": {apiKey: apiKeyDescribeCluster, schemas: describeClusterResponseSchemaVersions, versions: allVersions(describeClusterResponseSchemaVersions)},
+		"Produce":         {apiKey: apiKeyProduce, schemas: produceResponseSchemaVersions, versions: []int16{10, 11}, nodeEndpoints: true},
+		"Fetch":           {apiKey: apiKeyFetch, schemas: fetchResponseSchemaVersions, versions: []int16{16}, nodeEndpoints: true},
+	}
+
+	for name, tc := range tests {
+		for _, version := range tc.versions {
+			t.Run(fmt.Sprintf("%s v%d", name, version), func(t *testing.T) {
+				schema := tc.schemas[version]
+				response := fillResponse(t, schema)
+				if tc.nodeEndpoints {
+					withNodeEndpoints(t, response)
+				}
+				raw, err := EncodeSchema(response, schema)
+				require.NoError(t, err)
+				require.True(t, bytes.Contains(raw, []byte(leakTestInternalHost)), "the internal address must be planted")
+
+				// Only address mapping is configured, as for a passthrough virtual cluster
+				modifier, err := GetResponseModifierWithConfig(tc.apiKey, version, ResponseModifierConfig{NetAddressMappingFunc: leakTestMapper})
+				require.NoError(t, err)
+				require.NotNil(t, modifier, "responses carrying broker addresses must be modified")
+
+				mapped, err := modifier.Apply(raw)
+				require.NoError(t, err)
+				assert.False(t, bytes.Contains(mapped, []byte(leakTestInternalHost)), "internal address leaked through mapping")
+				assert.True(t, bytes.Contains(mapped, []byte(leakTestAdvertisedHost)))
+			})
+		}
+	}
+}
+
+func TestProduceResponseModifier_SkipsVersionsWithoutNodeEndpoints(t *testing.T) {
+	modifier, err := GetResponseModifierWithConfig(apiKeyProduce, 9, ResponseModifierConfig{NetAddressMappingFunc: leakTestMapper})
+	require.NoError(t, err)
+	assert.Nil(t, modifier)
+}
@@ -4176,3 +4176,744 @@ func TestListGroupsResponseModifier_V1_WithThrottleTime(t *testing.T) {
 	resultGroup1 := string(result[offset+2 : offset+2+group1Len])
 	assert.Equal(t, "test-group", resultGroup1)
 }
+
+// buildListGroupsResponse encodes a ListGroups response of the given version
+// listing groups with protocol type "consumer", state "Stable" and type "classic"
+func buildListGroupsResponse(version int16, groups []string) []byte {
+	flexible := version >= 3
+	appendStr := func(buf []byte, s string) []byte {
+		if flexible {
+			buf = append(buf, byte(len(s)+1))
+		} else {
+			buf = append(buf, byte(len(s)>>8), byte(len(s)))
+		}
+		return append(buf, s...)
+	}
+
+	var resp []byte
+	if version >= 1 {
+		resp = append(resp, 0, 0, 0, 7) // throttle_time_ms
+	}
+	resp = append(resp, 0, 0) // error_code
+	if flexible {
+		resp = append(resp, byte(len(groups)+1))
+	} else {
+		resp = append(resp, 0, 0, 0, byte(len(groups)))
+	}
+	for _, group := range groups {
+		resp = appendStr(resp, group)
+		resp = appendStr(resp, "consumer")
+		if version >= 4 {
+			resp = appendStr(resp, "Stable")
+		}
+		if version >= 5 {
+			resp = appendStr(resp, "classic")
+		}
+		if flexible {
+			resp = append(resp, 0) // group_tagged_fields
+		}
+	}
+	if flexible {
+		resp = append(resp, 0) // response_tagged_fields
+	}
+	return resp
+}
+
+func TestListGroupsResponseModifier_AllVersions(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		GroupUnprefixer: func(group string) string {
+			return strings.TrimPrefix(group, "tenant:")
+		},
+		GroupFilter: func(group string) bool {
+			return strings.HasPrefix(group, "tenant:")
+		},
+	}
+
+	for version := int16(0); version <= 5; version++ {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			mod, err := GetResponseModifierWithConfig(apiKeyListGroups, version, cfg)
+			require.NoError(t, err)
+			require.NotNil(t, mod)
+
+			resp := buildListGroupsResponse(version, []string{"tenant:orders", "other:payments", "unprefixed", "tenant:billing"})
+			result, err := mod.Apply(resp)
+			require.NoError(t, err)
+
+			assert.Equal(t, buildListGroupsResponse(version, []string{"orders", "billing"}), result)
+		})
+	}
+}
+
+func TestListGroupsResponseModifier_InvalidVersion(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		GroupFilter: func(group string) bool { return true },
+	}
+
+	_, err := GetResponseModifierWithConfig(apiKeyListGroups, 6, cfg)
+	assert.Error(t, err)
+}
+
+func TestOffsetDeleteResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyOffsetDelete, 0, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// OffsetDelete v0 response: error_code, throttle_time_ms, topics[name, partitions[partition_index, error_code]]
+	var responseBytes []byte
+	responseBytes = append(responseBytes, 0, 0)          // error_code
+	responseBytes = append(responseBytes, 0, 0, 0, 0x0a) // throttle_time_ms: 10
+	responseBytes = append(responseBytes, 0, 0, 0, 1)    // topics array: 1 element
+	topicName := "tenant:my-topic"
+	responseBytes = append(responseBytes, 0, byte(len(topicName)))
+	responseBytes = append(responseBytes, []byte(topicName)...)
+	responseBytes = append(responseBytes, 0, 0, 0, 1) // partitions array: 1 element
+	responseBytes = append(responseBytes, 0, 0, 0, 3) // partition_index: 3
+	responseBytes = append(responseBytes, 0, 0x56)    // error_code: GROUP_SUBSCRIBED_TO_TOPIC
+
+	result, err := mod.Apply(responseBytes)
+	require.NoError(t, err)
+
+	decoded, err := DecodeSchema(result, offsetDeleteResponseSchemaVersions[0])
+	require.NoError(t, err)
+	assert.Equal(t, int16(0), decoded.Get("error_code"))
+	assert.Equal(t, int32(10), decoded.Get("throttle_time_ms"))
+
+	topics := decoded.Get("topics").([]interface{})
+	require.Len(t, topics, 1)
+	assert.Equal(t, "my-topic", topics[0].(*Struct).Get("name"))
+	partitions := topics[0].(*Struct).Get("partitions").([]interface{})
+	require.Len(t, partitions, 1)
+	assert.Equal(t, int32(3), partitions[0].(*Struct).Get("partition_index"))
+	assert.Equal(t, int16(0x56), partitions[0].(*Struct).Get("error_code"))
+
+	mod, err = GetResponseModifierWithConfig(apiKeyOffsetDelete, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestDeleteRecordsResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	for _, version := range []int16{1, 2} {
+		mod, err := GetResponseModifierWithConfig(apiKeyDeleteRecords, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod)
+
+		// DeleteRecords response: throttle_time_ms, topics[name, partitions[partition_index, low_watermark, error_code]]
+		flexible := version >= 2
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		topicName := "tenant:orders"
+		if flexible {
+			responseBytes = append(responseBytes, 2) // topics: 1 element
+			responseBytes = append(responseBytes, byte(len(topicName)+1))
+			responseBytes = append(responseBytes, topicName...)
+			responseBytes = append(responseBytes, 2) // partitions: 1 element
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1)
+			responseBytes = append(responseBytes, 0, byte(len(topicName)))
+			responseBytes = append(responseBytes, topicName...)
+			responseBytes = append(responseBytes, 0, 0, 0, 1)
+		}
+		responseBytes = append(responseBytes, 0, 0, 0, 2)                   // partition_index
+		responseBytes = append(responseBytes, 0, 0, 0, 0, 0, 0, 0x01, 0xf4) // low_watermark: 500
+		responseBytes = append(responseBytes, 0, 0)                         // error_code
+		if flexible {
+			responseBytes = append(responseBytes, 0, 0, 0) // partition, topic and response tagged fields
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, deleteRecordsResponseSchemaVersions[version])
+		require.NoError(t, err)
+		topics := decoded.Get("topics").([]interface{})
+		require.Len(t, topics, 1)
+		assert.Equal(t, "orders", topics[0].(*Struct).Get("name"), "v%d", version)
+		partitions := topics[0].(*Struct).Get("partitions").([]interface{})
+		require.Len(t, partitions, 1)
+		assert.Equal(t, int64(500), partitions[0].(*Struct).Get("low_watermark"))
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyDeleteRecords, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+// buildOffsetForLeaderEpochResponse encodes an OffsetForLeaderEpoch response
+// for one partition of the given topic
+func buildOffsetForLeaderEpochResponse(version int16, topic string) []byte {
+	flexible := version >= 4
+	var b []byte
+	if version >= 2 {
+		b = append(b, 0, 0, 0, 0) // throttle_time_ms
+	}
+	if flexible {
+		b = append(b, 2, byte(len(topic)+1))
+	} else {
+		b = append(b, 0, 0, 0, 1, 0, byte(len(topic)))
+	}
+	b = append(b, topic...)
+	if flexible {
+		b = append(b, 2)
+	} else {
+		b = append(b, 0, 0, 0, 1)
+	}
+	b = append(b, 0, 0)       // error_code
+	b = append(b, 0, 0, 0, 3) // partition
+	if version >= 1 {
+		b = append(b, 0, 0, 0, 7) // leader_epoch
+	}
+	b = append(b, 0, 0, 0, 0, 0, 0, 0x04, 0xd2) // end_offset: 1234
+	if flexible {
+		b = append(b, 0, 0, 0) // partition, topic and response tagged fields
+	}
+	return b
+}
+
+func TestOffsetForLeaderEpochResponseModifier_RoundTrip(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	for version := int16(0); version <= 4; version++ {
+		mod, err := GetResponseModifierWithConfig(apiKeyOffsetForLeaderEpoch, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod)
+
+		result, err := mod.Apply(buildOffsetForLeaderEpochResponse(version, "tenant:orders"))
+		require.NoError(t, err, "v%d", version)
+		assert.Equal(t, buildOffsetForLeaderEpochResponse(version, "orders"), result,
+			"v%d: only the topic name should change", version)
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyOffsetForLeaderEpoch, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestDeleteGroupsResponseModifier_UnprefixesGroupIds(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		GroupUnprefixer: func(group string) string {
+			return strings.TrimPrefix(group, "tenant:")
+		},
+	}
+
+	for _, version := range []int16{0, 1, 2} {
+		mod, err := GetResponseModifierWithConfig(apiKeyDeleteGroups, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 2
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		if flexible {
+			responseBytes = append(responseBytes, 2)
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1)
+		}
+		groupId := "tenant:orders"
+		if flexible {
+			responseBytes = append(responseBytes, byte(len(groupId)+1))
+		} else {
+			responseBytes = append(responseBytes, 0, byte(len(groupId)))
+		}
+		responseBytes = append(responseBytes, []byte(groupId)...)
+		responseBytes = append(responseBytes, 0, 0x45) // error_code: NON_EMPTY_GROUP
+		if flexible {
+			responseBytes = append(responseBytes, 0, 0) // result and response tagged fields
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, deleteGroupsResponseSchemas[version])
+		require.NoError(t, err)
+		results := decoded.Get("results").([]interface{})
+		require.Len(t, results, 1)
+		assert.Equal(t, "orders", results[0].(*Struct).Get("group_id"), "version %d", version)
+		assert.Equal(t, int16(0x45), results[0].(*Struct).Get("error_code"), "version %d", version)
+	}
+
+	_, err := GetResponseModifierWithConfig(apiKeyDeleteGroups, 3, cfg)
+	assert.Error(t, err)
+
+	mod, err := GetResponseModifierWithConfig(apiKeyDeleteGroups, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestApiVersionsResponseModifier_ClampsUnsupportedVersions(t *testing.T) {
+	supported := supportedApiVersions()
+
+	for _, version := range []int16{0, 1, 3} {
+		mod, err := GetResponseModifierWithConfig(apiKeyApiVersions, version, ResponseModifierConfig{})
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 3
+		entries := [][3]int16{
+			{apiKeyFetch, 0, 17},     // newer than Bifrost's Fetch schemas
+			{apiKeyProduce, 3, 7},    // within range, untouched
+			{apiKeyListGroups, 9, 9}, // nothing in common, dropped
+			{36, 0, 2},               // SaslAuthenticate is not rewritten
+		}
+
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0) // error_code
+		if flexible {
+			responseBytes = append(responseBytes, byte(len(entries)+1))
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, byte(len(entries)))
+		}
+		for _, e := range entries {
+			for _, v := range e {
+				responseBytes = append(responseBytes, byte(v>>8), byte(v))
+			}
+			if flexible {
+				responseBytes = append(responseBytes, 0)
+			}
+		}
+		if version >= 1 {
+			responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		}
+		if flexible {
+			// response tagged fields: finalized_features_epoch (tag 1)
+			responseBytes = append(responseBytes, 1, 1, 8, 0, 0, 0, 0, 0, 0, 0, 7)
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, apiVersionsResponseSchemas[version])
+		require.NoError(t, err)
+		apiKeys := decoded.Get("api_keys").([]interface{})
+		require.Len(t, apiKeys, 3, "version %d", version)
+
+		assert.Equal(t, apiKeyFetch, apiKeys[0].(*Struct).Get("api_key"))
+		assert.Equal(t, supported[apiKeyFetch], apiKeys[0].(*Struct).Get("max_version"))
+		assert.Equal(t, apiKeyProduce, apiKeys[1].(*Struct).Get("api_key"))
+		assert.Equal(t, int16(3), apiKeys[1].(*Struct).Get("min_version"))
+		assert.Equal(t, int16(7), apiKeys[1].(*Struct).Get("max_version"))
+		assert.Equal(t, int16(36), apiKeys[2].(*Struct).Get("api_key"))
+		assert.Equal(t, int16(2), apiKeys[2].(*Struct).Get("max_version"))
+
+		if flexible {
+			assert.Equal(t, responseBytes[len(responseBytes)-11:], result[len(result)-11:], "tagged fields must be preserved")
+		}
+	}
+}
+
+func TestApiVersionsResponseModifier_PassesThroughErrors(t *testing.T) {
+	mod, err := GetResponseModifierWithConfig(apiKeyApiVersions, 3, ResponseModifierConfig{})
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	// UNSUPPORTED_VERSION is always answered with a v0 body, even for v3+ requests
+	responseBytes := []byte{0, 35, 0, 0, 0, 1, 0, 18, 0, 0, 0, 3}
+	result, err := mod.Apply(responseBytes)
+	require.NoError(t, err)
+	assert.Equal(t, responseBytes, result)
+
+	mod, err = GetResponseModifierWithConfig(apiKeyApiVersions, 9, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestCreatePartitionsResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	for _, version := range []int16{0, 1, 2, 3} {
+		mod, err := GetResponseModifierWithConfig(apiKeyCreatePartitions, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 2
+		topicName := "tenant:orders"
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		if flexible {
+			responseBytes = append(responseBytes, 2, byte(len(topicName)+1))
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, byte(len(topicName)))
+		}
+		responseBytes = append(responseBytes, []byte(topicName)...)
+		responseBytes = append(responseBytes, 0, 37) // error_code: INVALID_PARTITIONS
+		if flexible {
+			responseBytes = append(responseBytes, 0)    // error_message: null
+			responseBytes = append(responseBytes, 0, 0) // result and response tagged fields
+		} else {
+			responseBytes = append(responseBytes, 0xff, 0xff) // error_message: null
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, createPartitionsResponseSchemaVersions[version])
+		require.NoError(t, err)
+		results := decoded.Get("results").([]interface{})
+		require.Len(t, results, 1)
+		assert.Equal(t, "orders", results[0].(*Struct).Get("name"), "version %d", version)
+		assert.Equal(t, int16(37), results[0].(*Struct).Get("error_code"), "version %d", version)
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyCreatePartitions, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestCreateTopicsResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+	topicID := uuid.MustParse("0f6b2c4e-8a3d-4c1b-9e7f-123456789abc")
+
+	for version := int16(0); version <= 7; version++ {
+		mod, err := GetResponseModifierWithConfig(apiKeyCreateTopics, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 5
+		topicName := "tenant:orders"
+		var responseBytes []byte
+		if version >= 2 {
+			responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		}
+		if flexible {
+			responseBytes = append(responseBytes, 2, byte(len(topicName)+1))
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, byte(len(topicName)))
+		}
+		responseBytes = append(responseBytes, []byte(topicName)...)
+		if version >= 7 {
+			responseBytes = append(responseBytes, topicID[:]...)
+		}
+		responseBytes = append(responseBytes, 0, 36) // error_code: TOPIC_ALREADY_EXISTS
+		if version >= 1 {
+			if flexible {
+				responseBytes = append(responseBytes, 0) // error_message: null
+			} else {
+				responseBytes = append(responseBytes, 0xff, 0xff) // error_message: null
+			}
+		}
+		if flexible {
+			responseBytes = append(responseBytes, 0, 0, 0, 3) // num_partitions
+			responseBytes = append(responseBytes, 0, 1)       // replication_factor
+			responseBytes = append(responseBytes, 0)          // configs: null
+			responseBytes = append(responseBytes, 0, 0)       // topic and response tagged fields
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, createTopicsResponseSchemaVersions[version])
+		require.NoError(t, err)
+		topics := decoded.Get("topics").([]interface{})
+		require.Len(t, topics, 1)
+		topic := topics[0].(*Struct)
+		assert.Equal(t, "orders", topic.Get("name"), "version %d", version)
+		assert.Equal(t, int16(36), topic.Get("error_code"), "version %d", version)
+		if flexible {
+			assert.Equal(t, int32(3), topic.Get("num_partitions"), "version %d", version)
+			assert.Equal(t, int16(1), topic.Get("replication_factor"), "version %d", version)
+		}
+		if version >= 7 {
+			assert.Equal(t, topicID, topic.Get("topic_id"), "version %d", version)
+		}
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyCreateTopics, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestDeleteTopicsResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+	topicID := uuid.MustParse("0f6b2c4e-8a3d-4c1b-9e7f-123456789abc")
+
+	for version := int16(0); version <= 6; version++ {
+		mod, err := GetResponseModifierWithConfig(apiKeyDeleteTopics, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 4
+		topicName := "tenant:orders"
+		var responseBytes []byte
+		if version >= 1 {
+			responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		}
+		if flexible {
+			responseBytes = append(responseBytes, 2, byte(len(topicName)+1))
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, byte(len(topicName)))
+		}
+		responseBytes = append(responseBytes, []byte(topicName)...)
+		if version >= 6 {
+			responseBytes = append(responseBytes, topicID[:]...)
+		}
+		responseBytes = append(responseBytes, 0, 41) // error_code: NOT_CONTROLLER
+		if version >= 5 {
+			responseBytes = append(responseBytes, 0) // error_message: null
+		}
+		if flexible {
+			responseBytes = append(responseBytes, 0, 0) // result and response tagged fields
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, deleteTopicsResponseSchemaVersions[version])
+		require.NoError(t, err)
+		responses := decoded.Get("responses").([]interface{})
+		require.Len(t, responses, 1)
+		response := responses[0].(*Struct)
+		assert.Equal(t, int16(41), response.Get("error_code"), "version %d", version)
+		if version >= 6 {
+			assert.Equal(t, stringPtr("orders"), response.Get("name"), "version %d", version)
+			assert.Equal(t, topicID, response.Get("topic_id"), "version %d", version)
+		} else {
+			assert.Equal(t, "orders", response.Get("name"), "version %d", version)
+		}
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyDeleteTopics, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestAddPartitionsToTxnResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	for _, version := range []int16{0, 1, 2, 3} {
+		mod, err := GetResponseModifierWithConfig(apiKeyAddPartitionsToTxn, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 3
+		topicName := "tenant:orders"
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		if flexible {
+			responseBytes = append(responseBytes, 2, byte(len(topicName)+1))
+			responseBytes = append(responseBytes, []byte(topicName)...)
+			responseBytes = append(responseBytes, 2, 0, 0, 0, 0, 0, 0) // results: [{0, NONE}]
+			responseBytes = append(responseBytes, 0, 0, 0)             // partition, topic and response tagged fields
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, byte(len(topicName)))
+			responseBytes = append(responseBytes, []byte(topicName)...)
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0) // results: [{0, NONE}]
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, addPartitionsToTxnResponseSchemaVersions[version])
+		require.NoError(t, err)
+		results := decoded.Get("results").([]interface{})
+		require.Len(t, results, 1)
+		assert.Equal(t, "orders", results[0].(*Struct).Get("name"), "version %d", version)
+	}
+
+	_, err := GetResponseModifierWithConfig(apiKeyAddPartitionsToTxn, 4, cfg)
+	assert.Error(t, err)
+}
+
+func TestTxnOffsetCommitResponseModifier_UnprefixesTopics(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	for _, version := range []int16{0, 1, 2, 3} {
+		mod, err := GetResponseModifierWithConfig(apiKeyTxnOffsetCommit, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 3
+		topicName := "tenant:orders"
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		if flexible {
+			responseBytes = append(responseBytes, 2, byte(len(topicName)+1))
+			responseBytes = append(responseBytes, []byte(topicName)...)
+			responseBytes = append(responseBytes, 2, 0, 0, 0, 0, 0, 0) // partitions: [{0, NONE}]
+			responseBytes = append(responseBytes, 0, 0, 0)             // partition, topic and response tagged fields
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, byte(len(topicName)))
+			responseBytes = append(responseBytes, []byte(topicName)...)
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0) // partitions: [{0, NONE}]
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, txnOffsetCommitResponseSchemaVersions[version])
+		require.NoError(t, err)
+		topics := decoded.Get("topics").([]interface{})
+		require.Len(t, topics, 1)
+		assert.Equal(t, "orders", topics[0].(*Struct).Get("name"), "version %d", version)
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyTxnOffsetCommit, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
+
+func TestDescribeConfigsResponseModifier_UnprefixesOnlyTopicResources(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyDescribeConfigs, 1, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	appendResult := func(dst []byte, resourceType byte, name string) []byte {
+		dst = append(dst, 0, 0)       // error_code
+		dst = append(dst, 0xff, 0xff) // error_message: null
+		dst = append(dst, resourceType)
+		dst = append(dst, 0, byte(len(name)))
+		dst = append(dst, []byte(name)...)
+		// configs array: 1 element
+		configName, configValue := "retention.ms", "60000"
+		dst = append(dst, 0, 0, 0, 1, 0, byte(len(configName)))
+		dst = append(dst, []byte(configName)...)
+		dst = append(dst, 0, byte(len(configValue)))
+		dst = append(dst, []byte(configValue)...)
+		dst = append(dst, 0, 1, 0)    // read_only, config_source, is_sensitive
+		dst = append(dst, 0, 0, 0, 0) // synonyms: empty
+		return dst
+	}
+
+	var responseBytes []byte
+	responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+	responseBytes = append(responseBytes, 0, 0, 0, 2) // results array: 2 elements
+	responseBytes = appendResult(responseBytes, 2, "tenant:orders")
+	// A broker resource that happens to look prefixed must be left alone
+	responseBytes = appendResult(responseBytes, 4, "tenant:1")
+
+	result, err := mod.Apply(responseBytes)
+	require.NoError(t, err)
+
+	decoded, err := DecodeSchema(result, describeConfigsResponseSchemaVersions[1])
+	require.NoError(t, err)
+	results := decoded.Get("results").([]interface{})
+	require.Len(t, results, 2)
+	assert.Equal(t, "orders", results[0].(*Struct).Get("resource_name"))
+	assert.Equal(t, "tenant:1", results[1].(*Struct).Get("resource_name"))
+	configs := results[0].(*Struct).Get("configs").([]interface{})
+	require.Len(t, configs, 1)
+	assert.Equal(t, int8(1), configs[0].(*Struct).Get("config_source"))
+}
+
+func TestDescribeConfigsResponseModifier_V4_Flexible(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyDescribeConfigs, 4, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, mod)
+
+	var responseBytes []byte
+	responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+	responseBytes = append(responseBytes, 2)          // results compact array: 1 element
+	responseBytes = append(responseBytes, 0, 0)       // error_code
+	responseBytes = append(responseBytes, 0)          // error_message: null
+	responseBytes = append(responseBytes, 2)          // resource_type: topic
+	topicName := "tenant:orders"
+	responseBytes = append(responseBytes, byte(len(topicName)+1))
+	responseBytes = append(responseBytes, []byte(topicName)...)
+	// configs compact array: 1 element
+	configName := "cleanup.policy"
+	responseBytes = append(responseBytes, 2, byte(len(configName)+1))
+	responseBytes = append(responseBytes, []byte(configName)...)
+	responseBytes = append(responseBytes, 0)       // value: null
+	responseBytes = append(responseBytes, 0, 5, 0) // read_only, config_source, is_sensitive
+	responseBytes = append(responseBytes, 1)       // synonyms: empty
+	responseBytes = append(responseBytes, 2, 0)    // config_type, documentation: null
+	responseBytes = append(responseBytes, 0, 0, 0) // config, result and response tagged fields
+
+	result, err := mod.Apply(responseBytes)
+	require.NoError(t, err)
+
+	decoded, err := DecodeSchema(result, describeConfigsResponseSchemaVersions[4])
+	require.NoError(t, err)
+	results := decoded.Get("results").([]interface{})
+	require.Len(t, results, 1)
+	assert.Equal(t, "orders", results[0].(*Struct).Get("resource_name"))
+
+	_, err = GetResponseModifierWithConfig(apiKeyDescribeConfigs, 5, cfg)
+	assert.Error(t, err)
+}
+
+func TestAlterConfigsResponseModifier_UnprefixesTopicResources(t *testing.T) {
+	cfg := ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string {
+			return strings.TrimPrefix(topic, "tenant:")
+		},
+	}
+
+	for _, version := range []int16{0, 1, 2} {
+		mod, err := GetResponseModifierWithConfig(apiKeyAlterConfigs, version, cfg)
+		require.NoError(t, err)
+		require.NotNil(t, mod, "version %d", version)
+
+		flexible := version >= 2
+		topicName := "tenant:orders"
+		var responseBytes []byte
+		responseBytes = append(responseBytes, 0, 0, 0, 0) // throttle_time_ms
+		if flexible {
+			responseBytes = append(responseBytes, 2, 0, 0, 0, 2, byte(len(topicName)+1))
+			responseBytes = append(responseBytes, []byte(topicName)...)
+			responseBytes = append(responseBytes, 0, 0) // resource and response tagged fields
+		} else {
+			responseBytes = append(responseBytes, 0, 0, 0, 1, 0, 0, 0xff, 0xff, 2, 0, byte(len(topicName)))
+			responseBytes = append(responseBytes, []byte(topicName)...)
+		}
+
+		result, err := mod.Apply(responseBytes)
+		require.NoError(t, err)
+
+		decoded, err := DecodeSchema(result, alterConfigsResponseSchemaVersions[version])
+		require.NoError(t, err)
+		responses := decoded.Get("responses").([]interface{})
+		require.Len(t, responses, 1)
+		assert.Equal(t, "orders", responses[0].(*Struct).Get("resource_name"), "version %d", version)
+	}
+
+	mod, err := GetResponseModifierWithConfig(apiKeyAlterConfigs, 0, ResponseModifierConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, mod)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -13,8 +14,8 @@ func TestGetResponseModifierWithConfig_ReturnsNilForUnhandledApis(t *testing.T)
 		TopicUnprefixer: func(topic string) string { return strings.TrimPrefix(topic, "tenant:") },
 	}
 
-	// ApiVersions (18) should return nil
-	mod, err := GetResponseModifierWithConfig(18, 0, cfg)
+	// SaslHandshake (17) should return nil
+	mod, err := GetResponseModifierWithConfig(17, 0, cfg)
 	require.NoError(t, err)
 	assert.Nil(t, mod)
 
@@ -330,3 +331,35 @@ func TestModifyMetadataResponseWithConfig_BrokerAddressOnly(t *testing.T) {
 	topicStruct := topics[0].(*Struct)
 	assert.Equal(t, "my-topic", topicStruct.Get("topic"))
 }
+
+func TestModifyTopicsInMetadataResponse_ObservesTopicIDs(t *testing.T) {
+	topicMetadataV10 := NewSchema("topic_metadata_v10",
+		&Mfield{Name: "error_code", Ty: TypeInt16},
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&Mfield{Name: "topic_id", Ty: TypeUuid},
+	)
+	metadataResponseV10 := NewSchema("metadata_response_v10",
+		&CompactArray{Name: "topic_metadata", Ty: topicMetadataV10},
+	)
+
+	ordersID := uuid.New()
+	decoded := &Struct{
+		Schema: metadataResponseV10,
+		Values: []interface{}{[]interface{}{
+			&Struct{Schema: topicMetadataV10, Values: []interface{}{int16(0), "tenant-a:orders", ordersID}},
+			&Struct{Schema: topicMetadataV10, Values: []interface{}{int16(ErrUnknownTopicOrPartition), "tenant-a:deleted", uuid.Nil}},
+			&Struct{Schema: topicMetadataV10, Values: []interface{}{int16(ErrTopicAuthorizationFailed), "tenant-a:denied", uuid.Nil}},
+		}},
+	}
+
+	observed := map[string]uuid.UUID{}
+	cfg := ResponseModifierConfig{
+		TopicIDObserver: func(topicID uuid.UUID, topic string) { observed[topic] = topicID },
+	}
+	require.NoError(t, modifyTopicsInMetadataResponse(decoded, cfg))
+
+	assert.Equal(t, map[string]uuid.UUID{
+		"tenant-a:orders":  ordersID,
+		"tenant-a:deleted": uuid.Nil,
+	}, observed, "unknown topics are reported with a nil ID")
+}
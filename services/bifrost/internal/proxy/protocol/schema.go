@@ -380,9 +380,7 @@ func (f *CompactNullableStr) decode(pd packetDecoder) (interface{}, error) {
 
 func (f *CompactNullableStr) encode(pe packetEncoder, value interface{}) error {
 	if value == nil {
-		if err := pe.putCompactNullableString(nil); err != nil {
-			return err
-		}
+		return pe.putCompactNullableString(nil)
 	}
 
 	in, ok := value.(*string)
@@ -446,8 +444,9 @@ func encodeArrayElements(in []interface{}, elementEncode func(pe packetEncoder,
 }
 
 func decodeArrayElements(n int, elementDecode func(pd packetDecoder) (interface{}, error), pd packetDecoder) (interface{}, error) {
-	// We could allocate the capacity at once, but in case of malformed payload we could allocate too much memory.
-	result := make([]interface{}, 0)
+	// A malformed length must not allocate too much memory, but every element
+	// takes at least one byte, so the remaining payload bounds the capacity.
+	result := make([]interface{}, 0, max(0, min(n, pd.remaining())))
 
 	for i := 0; i < n; i++ {
 		elem, err := elementDecode(pd)
@@ -466,6 +465,9 @@ type rawTaggedField struct {
 	data []byte
 }
 
+// noTaggedFields is the decoded value of an empty tagged field section
+var noTaggedFields interface{} = []rawTaggedField{}
+
 type SchemaTaggedFields struct {
 	Name string
 }
@@ -476,12 +478,17 @@ func (f SchemaTaggedFields) decode(pd packetDecoder) (interface{}, error) {
 		return nil, err
 	}
 	if numTaggedFields == 0 {
-		result := make([]rawTaggedField, 0)
-		return result, nil
+		// Shared and never mutated, so the common case doesn't allocate
+		return noTaggedFields, nil
 	}
 	if numTaggedFields < 0 {
 		return nil, errors.Errorf("Negative number of tagged fields %d", numTaggedFields)
 	}
+	// Every tagged field takes at least a tag and a length byte, so a count
+	// beyond the remaining payload is malformed and must not be allocated.
+	if numTaggedFields > int64(pd.remaining()/2) {
+		return nil, ErrInsufficientData
+	}
 	result := make([]rawTaggedField, numTaggedFields)
 	for i := 0; i < int(numTaggedFields); i++ {
 		result[i].tag, err = pd.getVarint()
@@ -556,6 +563,47 @@ func (f *Array) GetSchema() Schema {
 	return f.Ty
 }
 
+// Nullable Array
+
+type NullableArray struct {
+	Name string
+	Ty   Schema
+}
+
+func (f *NullableArray) decode(pd packetDecoder) (interface{}, error) {
+	n, err := pd.getArrayLength()
+	if err != nil {
+		return nil, err
+	}
+	if n == -1 {
+		return nil, nil
+	}
+	return decodeArrayElements(n, f.Ty.decode, pd)
+}
+
+func (f *NullableArray) encode(pe packetEncoder, value interface{}) error {
+	if value == nil {
+		return pe.putArrayLength(-1)
+	}
+	in, ok := value.([]interface{})
+	if !ok {
+		return SchemaEncodingError{fmt.Sprintf("value %T not a []interface{}", value)}
+	}
+	err := pe.putArrayLength(len(in))
+	if err != nil {
+		return err
+	}
+	return encodeArrayElements(in, f.Ty.encode, pe)
+}
+
+func (f *NullableArray) GetName() string {
+	return f.Name
+}
+
+func (f *NullableArray) GetSchema() Schema {
+	return f.Ty
+}
+
 // Compact Array
 
 type CompactArray struct {
@@ -632,6 +680,11 @@ func (f *CompactNullableArray) GetName() string {
 	return f.Name
 }
 
+func (f *CompactNullableArray) GetSchema() Schema {
+	schema, _ := f.Ty.(Schema)
+	return schema
+}
+
 type Struct struct {
 	Schema Schema
 	Values []interface{}
@@ -724,7 +777,7 @@ func (s *schema) encode(pe packetEncoder, value interface{}) error {
 }
 
 func (s *schema) decode(pd packetDecoder) (interface{}, error) {
-	values := make([]interface{}, 0)
+	values := make([]interface{}, 0, len(s.fields))
 
 	for _, field := range s.GetFields() {
 		value, err := field.def.decode(pd)
@@ -750,8 +803,9 @@ func DecodeSchema(buf []byte, schema Schema) (*Struct, error) {
 	if buf == nil {
 		return nil, nil
 	}
-	helper := realDecoder{raw: buf}
-	v, err := schema.decode(&helper)
+	helper := acquireDecoder(buf)
+	defer releaseDecoder(helper)
+	v, err := schema.decode(helper)
 	if err != nil {
 		return nil, err
 	}
@@ -773,23 +827,25 @@ func EncodeSchema(s *Struct, schema Schema) ([]byte, error) {
 		return nil, nil
 	}
 
-	var prepEnc prepEncoder
-	var realEnc realEncoder
+	enc := acquireEncoders()
+	defer releaseEncoders(enc)
 
-	err := schema.encode(&prepEnc, s)
+	err := schema.encode(&enc.prep, s)
 	if err != nil {
 		return nil, err
 	}
 
-	if prepEnc.length < 0 || prepEnc.length > int(MaxRequestSize) {
-		return nil, SchemaEncodingError{fmt.Sprintf("invalid request size (%d)", prepEnc.length)}
+	if enc.prep.length < 0 || enc.prep.length > int(MaxRequestSize) {
+		return nil, SchemaEncodingError{fmt.Sprintf("invalid request size (%d)", enc.prep.length)}
 	}
 
-	realEnc.raw = make([]byte, prepEnc.length)
-	err = schema.encode(&realEnc, s)
+	// The encoded message is returned to the caller, so it is never pooled
+	out := make([]byte, enc.prep.length)
+	enc.real.raw = out
+	err = schema.encode(&enc.real, s)
 	if err != nil {
 		return nil, err
 	}
 
-	return realEnc.raw, nil
+	return out, nil
 }
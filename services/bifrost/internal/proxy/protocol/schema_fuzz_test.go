@@ -0,0 +1,43 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fuzzDecodeApiKeys are the request schemas FuzzDecodeSchema exercises: the
+// requests clients send most and the ones with the deepest nesting.
+var fuzzDecodeApiKeys = map[int16]struct{}{
+	apiKeyProduce:   {},
+	apiKeyFetch:     {},
+	apiKeyMetadata:  {},
+	apiKeyJoinGroup: {},
+}
+
+// FuzzDecodeSchema decodes arbitrary bytes as a request of the given API key
+// and version. Decoding must never panic, and a request that decodes must
+// re-encode to as many bytes as it was read from. The seed corpus in
+// testdata/fuzz/FuzzDecodeSchema holds requests captured from a franz-go
+// client at several protocol versions.
+func FuzzDecodeSchema(f *testing.F) {
+	f.Fuzz(func(t *testing.T, apiKey, apiVersion int16, data []byte) {
+		if _, ok := fuzzDecodeApiKeys[apiKey]; !ok {
+			return
+		}
+		schemas := requestSchemas(apiKey)
+		if apiVersion < 0 || int(apiVersion) >= len(schemas) {
+			return
+		}
+		schema := schemas[apiVersion]
+
+		decoded, err := DecodeSchema(data, schema)
+		if err != nil || decoded == nil {
+			return
+		}
+		encoded, err := EncodeSchema(decoded, schema)
+		require.NoError(t, err)
+		assert.Len(t, encoded, len(data))
+	})
+}
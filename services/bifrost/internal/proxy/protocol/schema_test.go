@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"errors"
 	"github.com/google/uuid"
 	"testing"
 )
@@ -169,3 +170,49 @@ func TestEncodeDecodeSchema(t *testing.T) {
 		t.Fatalf("Got bad schema for TypeUuid field")
 	}
 }
+
+func TestDecodeSchema_MalformedCompactLengths(t *testing.T) {
+	elementSchema := NewSchema("element",
+		&Mfield{Name: "name", Ty: TypeCompactStr},
+		&SchemaTaggedFields{Name: "element_tagged_fields"},
+	)
+	arraySchema := NewSchema("compact_array",
+		&CompactArray{Name: "elements", Ty: elementSchema},
+	)
+	nullableArraySchema := NewSchema("compact_nullable_array",
+		&CompactNullableArray{Name: "elements", Ty: elementSchema},
+	)
+	bytesSchema := NewSchema("compact_bytes",
+		&Mfield{Name: "data", Ty: TypeCompactBytes},
+	)
+
+	tt := []struct {
+		name   string
+		schema Schema
+		raw    string
+	}{
+		{name: "array truncated after prefix", schema: arraySchema, raw: "03"},
+		{name: "array truncated in element", schema: arraySchema, raw: "03 02 61 00 04 61"},
+		{name: "array of 2^32 elements", schema: arraySchema, raw: "FFFFFFFF0F 02 61 00"},
+		{name: "array of 2^63 elements", schema: arraySchema, raw: "FFFFFFFFFFFFFFFF7F 02 61 00"},
+		{name: "array length above max int64", schema: arraySchema, raw: "FFFFFFFFFFFFFFFFFF01 02 61 00"},
+		{name: "array length overflows varint", schema: arraySchema, raw: "FFFFFFFFFFFFFFFFFFFF01"},
+		{name: "nullable array of 2^32 elements", schema: nullableArraySchema, raw: "FFFFFFFF0F 02 61 00"},
+		{name: "nullable array length above max int64", schema: nullableArraySchema, raw: "FFFFFFFFFFFFFFFFFF01 02 61 00"},
+		{name: "string of 2^32 bytes", schema: arraySchema, raw: "02 FFFFFFFF0F 61 00"},
+		{name: "string length above max int64", schema: arraySchema, raw: "02 FFFFFFFFFFFFFFFFFF01 61 00"},
+		{name: "bytes of 2^32 bytes", schema: bytesSchema, raw: "FFFFFFFF0F 61"},
+		{name: "tagged field count of 2^32", schema: arraySchema, raw: "02 02 61 FFFFFFFF0F"},
+	}
+	for _, tc := range tt {
+		decoded, err := DecodeSchema(mustHexDecodeString(tc.raw), tc.schema)
+		if err == nil {
+			t.Errorf("%s: decoded %v, expected an error", tc.name, decoded)
+			continue
+		}
+		var decodingErr PacketDecodingError
+		if !errors.Is(err, ErrInsufficientData) && !errors.As(err, &decodingErr) {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package protocol
+
+import "errors"
+
+// throttleTimeModifier applies another response modifier, if any, and then
+// raises the response's throttle_time_ms to at least throttleTimeMs.
+type throttleTimeModifier struct {
+	next           ResponseModifier
+	schema         Schema
+	throttleTimeMs int32
+}
+
+// WithThrottleTime returns a ResponseModifier that applies modifier (which may
+// be nil) and then raises the response's throttle_time_ms to throttleTimeMs
+// unless the broker already asked for a longer one, so that clients back off
+// as they would for a broker quota. Only Produce v1+ responses are throttled;
+// for anything else modifier is returned as is.
+func WithThrottleTime(modifier ResponseModifier, apiKey, apiVersion int16, throttleTimeMs int32) (ResponseModifier, error) {
+	if throttleTimeMs <= 0 || apiKey != apiKeyProduce || apiVersion < 1 {
+		return modifier, nil
+	}
+	schema, err := getResponseSchema(apiKey, apiVersion, produceResponseSchemaVersions)
+	if err != nil {
+		return nil, err
+	}
+	return &throttleTimeModifier{next: modifier, schema: schema, throttleTimeMs: throttleTimeMs}, nil
+}
+
+func (m *throttleTimeModifier) Apply(resp []byte) ([]byte, error) {
+	if m.next != nil {
+		var err error
+		if resp, err = m.next.Apply(resp); err != nil {
+			return nil, err
+		}
+	}
+	decoded, err := DecodeSchema(resp, m.schema)
+	if err != nil {
+		return nil, err
+	}
+	throttleTimeMs, ok := decoded.Get("throttle_time_ms").(int32)
+	if !ok {
+		return nil, errors.New("throttle_time_ms not found in response")
+	}
+	if throttleTimeMs >= m.throttleTimeMs {
+		return resp, nil
+	}
+	if err := decoded.Replace("throttle_time_ms", m.throttleTimeMs); err != nil {
+		return nil, err
+	}
+	return EncodeSchema(decoded, m.schema)
+}
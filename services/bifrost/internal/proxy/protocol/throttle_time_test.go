@@ -0,0 +1,77 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func produceResponse(version int16, topic string, throttleMs int32) []byte {
+	resp := kmsg.NewPtrProduceResponse()
+	resp.Version = version
+	resp.ThrottleMillis = throttleMs
+	topicResp := kmsg.NewProduceResponseTopic()
+	topicResp.Topic = topic
+	topicResp.Partitions = append(topicResp.Partitions, kmsg.NewProduceResponseTopicPartition())
+	resp.Topics = append(resp.Topics, topicResp)
+	return resp.AppendTo(nil)
+}
+
+func decodeProduceResponse(t *testing.T, version int16, body []byte) *kmsg.ProduceResponse {
+	t.Helper()
+	resp := kmsg.NewPtrProduceResponse()
+	resp.Version = version
+	require.NoError(t, resp.ReadFrom(body))
+	return resp
+}
+
+func TestWithThrottleTime(t *testing.T) {
+	for _, version := range []int16{1, 7, 9, 11} {
+		modifier, err := WithThrottleTime(nil, apiKeyProduce, version, 1500)
+		require.NoError(t, err)
+		require.NotNil(t, modifier)
+
+		out, err := modifier.Apply(produceResponse(version, "orders", 0))
+		require.NoError(t, err)
+		resp := decodeProduceResponse(t, version, out)
+		assert.Equal(t, int32(1500), resp.ThrottleMillis, "version %d", version)
+		assert.Equal(t, "orders", resp.Topics[0].Topic, "version %d", version)
+
+		// A longer throttle from the broker is kept
+		out, err = modifier.Apply(produceResponse(version, "orders", 3000))
+		require.NoError(t, err)
+		assert.Equal(t, int32(3000), decodeProduceResponse(t, version, out).ThrottleMillis, "version %d", version)
+	}
+}
+
+func TestWithThrottleTime_AppliesWrappedModifier(t *testing.T) {
+	inner, err := GetResponseModifierWithConfig(apiKeyProduce, 7, ResponseModifierConfig{
+		TopicUnprefixer: func(topic string) string { return topic[len("tenant-a:"):] },
+	})
+	require.NoError(t, err)
+	modifier, err := WithThrottleTime(inner, apiKeyProduce, 7, 250)
+	require.NoError(t, err)
+
+	out, err := modifier.Apply(produceResponse(7, "tenant-a:orders", 0))
+	require.NoError(t, err)
+	resp := decodeProduceResponse(t, 7, out)
+	assert.Equal(t, int32(250), resp.ThrottleMillis)
+	assert.Equal(t, "orders", resp.Topics[0].Topic)
+}
+
+func TestWithThrottleTime_Unthrottled(t *testing.T) {
+	inner := &responseModifier{}
+
+	// Produce v0 has no throttle_time_ms, other API keys are not throttled and
+	// no throttle leaves the response alone
+	for _, tc := range []struct{ apiKey, apiVersion int16 }{{apiKeyProduce, 0}, {apiKeyFetch, 11}} {
+		modifier, err := WithThrottleTime(inner, tc.apiKey, tc.apiVersion, 1000)
+		require.NoError(t, err)
+		assert.Same(t, inner, modifier)
+	}
+	modifier, err := WithThrottleTime(nil, apiKeyProduce, 7, 0)
+	require.NoError(t, err)
+	assert.Nil(t, modifier)
+}
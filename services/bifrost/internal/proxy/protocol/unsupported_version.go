@@ -0,0 +1,161 @@
+package protocol
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// HasSchema reports whether Bifrost has the schemas to rewrite version
+// apiVersion of apiKey. API keys Bifrost passes through untouched report true
+// for every version, as does ApiVersions, whose newer versions are passed
+// through unclamped.
+func HasSchema(apiKey, apiVersion int16) bool {
+	if apiKey == apiKeyApiVersions {
+		return true
+	}
+	maxVersion, ok := supportedApiVersions()[apiKey]
+	if !ok {
+		return true
+	}
+	return apiVersion >= 0 && apiVersion <= maxVersion
+}
+
+// unsupportedVersionKeyFields identify the topic, partition, group or resource
+// an entry answers and are copied from the request, as errorResponseKeyFields
+// are for NewErrorResponse.
+var unsupportedVersionKeyFields = map[string]struct{}{
+	"Name":         {},
+	"Topic":        {},
+	"TopicID":      {},
+	"Partition":    {},
+	"Group":        {},
+	"ResourceType": {},
+	"ResourceName": {},
+}
+
+// NewUnsupportedVersionResponse builds an UNSUPPORTED_VERSION response to a
+// request version Bifrost has no schema for. request holds the request after
+// api key and version, starting at the correlation id. Lacking schemas, the
+// request is decoded with kmsg, so versions newer than kmsg knows cannot be
+// answered and return an error; brokers close the connection in that case
+// too. Every topic, partition, group or resource the request lists gets an
+// entry carrying the error code, where the response array is named like the
+// request array. nil means the client expects no response (Produce
+// with acks=0).
+func NewUnsupportedVersionResponse(apiKey, apiVersion int16, request []byte) ([]byte, error) {
+	req := kmsg.RequestForKey(apiKey)
+	if req == nil || apiVersion < 0 || apiVersion > req.MaxVersion() {
+		return nil, fmt.Errorf("no unsupported version response for api key %d version %d", apiKey, apiVersion)
+	}
+	req.SetVersion(apiVersion)
+
+	rd := &realDecoder{raw: request}
+	correlationID, err := rd.getInt32()
+	if err != nil {
+		return nil, fmt.Errorf("request key %d version %d has no correlation id", apiKey, apiVersion)
+	}
+	if _, err = rd.getNullableString(); err != nil {
+		return nil, fmt.Errorf("decode client id: %w", err)
+	}
+	if req.IsFlexible() {
+		var taggedFields TaggedFields
+		if err = taggedFields.decode(rd); err != nil {
+			return nil, fmt.Errorf("decode request header tagged fields: %w", err)
+		}
+	}
+	if err = req.ReadFrom(request[rd.off:]); err != nil {
+		return nil, fmt.Errorf("decode request key %d version %d: %w", apiKey, apiVersion, err)
+	}
+	if produce, ok := req.(*kmsg.ProduceRequest); ok && produce.Acks == 0 {
+		return nil, nil
+	}
+
+	resp := req.ResponseKind()
+	fillUnsupportedVersion(reflect.ValueOf(resp).Elem(), reflect.ValueOf(req).Elem(), false)
+	body := resp.AppendTo(nil)
+
+	// Flexible response headers carry an empty tagged fields section, except
+	// for ApiVersions
+	var headerTaggedFields []byte
+	if resp.IsFlexible() && apiKey != apiKeyApiVersions {
+		headerTaggedFields = []byte{0}
+	}
+	header, err := Encode(&ResponseHeader{
+		Length:        int32(4 + len(headerTaggedFields) + len(body)),
+		CorrelationID: correlationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	frame := make([]byte, 0, len(header)+len(headerTaggedFields)+len(body))
+	frame = append(frame, header...)
+	frame = append(frame, headerTaggedFields...)
+	return append(frame, body...), nil
+}
+
+// fillUnsupportedVersion sets every error code of the kmsg response struct
+// resp to UNSUPPORTED_VERSION and answers each element of the same-named
+// request arrays of req, which may be the zero Value. Key fields are copied
+// from req for array elements only; top-level fields of the same name mean
+// different things.
+func fillUnsupportedVersion(resp, req reflect.Value, element bool) {
+	for i := 0; i < resp.NumField(); i++ {
+		field := resp.Field(i)
+		name := resp.Type().Field(i).Name
+		if !field.CanSet() {
+			continue
+		}
+		switch {
+		case name == "ErrorCode" && field.Kind() == reflect.Int16:
+			field.SetInt(int64(ErrUnsupportedVersion))
+		case name == "ErrorMessage" && field.Type() == reflect.TypeOf((*string)(nil)):
+			message := ErrUnsupportedVersion.Error()
+			field.Set(reflect.ValueOf(&message))
+		case !req.IsValid():
+			continue
+		case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Struct:
+			requestElements := req.FieldByName(name)
+			if !requestElements.IsValid() || requestElements.Kind() != reflect.Slice {
+				continue
+			}
+			elements := reflect.MakeSlice(field.Type(), requestElements.Len(), requestElements.Len())
+			for j := 0; j < requestElements.Len(); j++ {
+				e := elements.Index(j)
+				if d, ok := e.Addr().Interface().(interface{ Default() }); ok {
+					d.Default()
+				}
+				if r := requestElements.Index(j); r.Kind() == reflect.Struct {
+					fillUnsupportedVersion(e, r, true)
+				} else {
+					// Requests listing bare names, like DescribeGroups,
+					// answer each in its first key field
+					setUnsupportedVersionKey(e, r)
+				}
+			}
+			field.Set(elements)
+		default:
+			if _, ok := unsupportedVersionKeyFields[name]; !ok || !element {
+				continue
+			}
+			if r := req.FieldByName(name); r.IsValid() && r.Type() == field.Type() {
+				field.Set(r)
+			}
+		}
+	}
+}
+
+// setUnsupportedVersionKey answers the bare request value key with element,
+// storing key in the first key field of its type.
+func setUnsupportedVersionKey(element, key reflect.Value) {
+	for i := 0; i < element.NumField(); i++ {
+		field := element.Field(i)
+		if _, ok := unsupportedVersionKeyFields[element.Type().Field(i).Name]; ok && field.Type() == key.Type() {
+			field.Set(key)
+			break
+		}
+	}
+	fillUnsupportedVersion(element, reflect.Value{}, true)
+}
@@ -0,0 +1,115 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// unsupportedVersionRequest encodes req as it follows api key and version on
+// the wire, starting at correlation id 7.
+func unsupportedVersionRequest(req kmsg.Request) []byte {
+	request := binary.BigEndian.AppendUint32(nil, 7)
+	request = binary.BigEndian.AppendUint16(request, 4)
+	request = append(request, "test"...)
+	if req.IsFlexible() {
+		request = append(request, 0)
+	}
+	return req.AppendTo(request)
+}
+
+// decodeUnsupportedVersionResponse checks the frame header of a locally built
+// response and decodes its body into resp.
+func decodeUnsupportedVersionResponse(t *testing.T, frame []byte, resp kmsg.Response) {
+	t.Helper()
+	require.GreaterOrEqual(t, len(frame), 8)
+	assert.Equal(t, uint32(len(frame)-4), binary.BigEndian.Uint32(frame[0:4]))
+	assert.Equal(t, uint32(7), binary.BigEndian.Uint32(frame[4:8]))
+
+	body := frame[8:]
+	if resp.IsFlexible() {
+		require.Equal(t, byte(0), body[0], "empty header tagged fields")
+		body = body[1:]
+	}
+	require.NoError(t, resp.ReadFrom(body))
+}
+
+func TestHasSchema(t *testing.T) {
+	maxFetch := int16(len(fetchRequestSchemas) - 1)
+	assert.True(t, HasSchema(apiKeyFetch, 0))
+	assert.True(t, HasSchema(apiKeyFetch, maxFetch))
+	assert.False(t, HasSchema(apiKeyFetch, maxFetch+1))
+	assert.False(t, HasSchema(apiKeyFetch, -1))
+
+	// ApiVersions and keys Bifrost does not rewrite pass through at any version
+	assert.True(t, HasSchema(apiKeyApiVersions, 99))
+	assert.True(t, HasSchema(50, 99))
+}
+
+func TestNewUnsupportedVersionResponse_Fetch(t *testing.T) {
+	version := int16(len(fetchRequestSchemas))
+	topicID := [16]byte{1, 2, 3}
+	req := kmsg.NewPtrFetchRequest()
+	req.Version = version
+	topic := kmsg.NewFetchRequestTopic()
+	topic.TopicID = topicID
+	for _, p := range []int32{0, 3} {
+		partition := kmsg.NewFetchRequestTopicPartition()
+		partition.Partition = p
+		topic.Partitions = append(topic.Partitions, partition)
+	}
+	req.Topics = append(req.Topics, topic)
+
+	frame, err := NewUnsupportedVersionResponse(apiKeyFetch, version, unsupportedVersionRequest(req))
+	require.NoError(t, err)
+
+	resp := kmsg.NewPtrFetchResponse()
+	resp.Version = version
+	decodeUnsupportedVersionResponse(t, frame, resp)
+	assert.Equal(t, int16(ErrUnsupportedVersion), resp.ErrorCode)
+	require.Len(t, resp.Topics, 1)
+	assert.Equal(t, topicID, resp.Topics[0].TopicID)
+	require.Len(t, resp.Topics[0].Partitions, 2)
+	for i, p := range []int32{0, 3} {
+		assert.Equal(t, p, resp.Topics[0].Partitions[i].Partition)
+		assert.Equal(t, int16(ErrUnsupportedVersion), resp.Topics[0].Partitions[i].ErrorCode)
+	}
+}
+
+func TestNewUnsupportedVersionResponse_DescribeGroups(t *testing.T) {
+	version := int16(len(describeGroupsRequestSchemas))
+	req := kmsg.NewPtrDescribeGroupsRequest()
+	req.Version = version
+	req.Groups = []string{"orders", "payments"}
+
+	frame, err := NewUnsupportedVersionResponse(apiKeyDescribeGroups, version, unsupportedVersionRequest(req))
+	require.NoError(t, err)
+
+	resp := kmsg.NewPtrDescribeGroupsResponse()
+	resp.Version = version
+	decodeUnsupportedVersionResponse(t, frame, resp)
+	require.Len(t, resp.Groups, 2)
+	for i, group := range req.Groups {
+		assert.Equal(t, group, resp.Groups[i].Group)
+		assert.Equal(t, int16(ErrUnsupportedVersion), resp.Groups[i].ErrorCode)
+	}
+}
+
+func TestNewUnsupportedVersionResponse_ProduceAcks0(t *testing.T) {
+	version := int16(len(produceRequestSchemas))
+	req := kmsg.NewPtrProduceRequest()
+	req.Version = version
+	req.Acks = 0
+
+	frame, err := NewUnsupportedVersionResponse(apiKeyProduce, version, unsupportedVersionRequest(req))
+	require.NoError(t, err)
+	assert.Nil(t, frame)
+}
+
+func TestNewUnsupportedVersionResponse_UnknownToKmsg(t *testing.T) {
+	_, err := NewUnsupportedVersionResponse(apiKeyFetch, 99, binary.BigEndian.AppendUint32(nil, 7))
+	assert.Error(t, err)
+}
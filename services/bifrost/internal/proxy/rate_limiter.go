@@ -0,0 +1,151 @@
+// services/bifrost/internal/proxy/rate_limiter.go
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+)
+
+// bucketIdleTimeout is how long an unused token bucket is kept. Buckets
+// refill within a second, and one that has refilled is dropped without loss:
+// it is recreated full on next use.
+const bucketIdleTimeout = time.Minute
+
+// VirtualClusterRateLimiter applies a token bucket per virtual cluster to
+// client requests, so a single noisy tenant cannot saturate the shared proxy.
+// Budgets come from VirtualClusterConfig (MaxRequestsPerSec, MaxBytesPerSec)
+// and are shared by every connection of a virtual cluster. Tenants over budget
+// are throttled by delaying forwarding; connections are never dropped.
+type VirtualClusterRateLimiter struct {
+	vcStore *config.VirtualClusterStore
+	metrics *metrics.Collector
+
+	mu        sync.Mutex
+	buckets   map[string]*vcBuckets
+	lastSweep time.Time
+}
+
+// vcBuckets holds the token buckets for one virtual cluster.
+type vcBuckets struct {
+	requests *rate.Limiter
+	bytes    *rate.Limiter
+	lastUsed time.Time
+}
+
+// NewVirtualClusterRateLimiter creates a rate limiter that reads per-tenant
+// budgets from vcStore and records throttling in metricsCollector.
+func NewVirtualClusterRateLimiter(vcStore *config.VirtualClusterStore, metricsCollector *metrics.Collector) *VirtualClusterRateLimiter {
+	return &VirtualClusterRateLimiter{
+		vcStore: vcStore,
+		metrics: metricsCollector,
+		buckets: make(map[string]*vcBuckets),
+	}
+}
+
+// Wait blocks until the virtual cluster's budget admits a request of
+// requestBytes bytes. It returns immediately when the virtual cluster has no
+// limits configured.
+func (l *VirtualClusterRateLimiter) Wait(vcID string, requestBytes int) {
+	delay := l.reserve(vcID, requestBytes, time.Now())
+	if delay <= 0 {
+		return
+	}
+	if l.metrics != nil {
+		l.metrics.RecordThrottle(vcID, delay.Seconds())
+	}
+	time.Sleep(delay)
+}
+
+// reserve takes one request and requestBytes bytes from the virtual cluster's
+// buckets and returns how long forwarding must be delayed to stay in budget.
+func (l *VirtualClusterRateLimiter) reserve(vcID string, requestBytes int, now time.Time) time.Duration {
+	vc, ok := l.vcStore.Get(vcID)
+	if !ok || (vc.MaxRequestsPerSec <= 0 && vc.MaxBytesPerSec <= 0) {
+		return 0
+	}
+
+	b := l.bucketsFor(vcID, int64(vc.MaxRequestsPerSec), vc.MaxBytesPerSec, now)
+
+	var delay time.Duration
+	if vc.MaxRequestsPerSec > 0 {
+		delay = b.requests.ReserveN(now, 1).DelayFrom(now)
+	}
+	if vc.MaxBytesPerSec > 0 {
+		// A request larger than the burst could never be admitted, so it
+		// consumes a full second of budget instead.
+		n := requestBytes
+		if burst := b.bytes.Burst(); n > burst {
+			n = burst
+		}
+		if d := b.bytes.ReserveN(now, n).DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// bucketsFor returns the buckets for a virtual cluster, creating them or
+// applying updated limits as needed. Each bucket holds one second of budget.
+// Buckets of virtual clusters that went idle, for example because they were
+// deleted or lost their limits, are dropped along the way.
+func (l *VirtualClusterRateLimiter) bucketsFor(vcID string, requestsPerSec, bytesPerSec int64, now time.Time) *vcBuckets {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= bucketIdleTimeout {
+		l.lastSweep = now
+		for id, b := range l.buckets {
+			if bucketIdle(b.requests, b.lastUsed, now) && bucketIdle(b.bytes, b.lastUsed, now) {
+				delete(l.buckets, id)
+			}
+		}
+	}
+
+	b, ok := l.buckets[vcID]
+	if !ok {
+		b = &vcBuckets{
+			requests: newBucket(requestsPerSec),
+			bytes:    newBucket(bytesPerSec),
+		}
+		l.buckets[vcID] = b
+	} else {
+		updateBucket(b.requests, requestsPerSec)
+		updateBucket(b.bytes, bytesPerSec)
+	}
+	b.lastUsed = now
+	return b
+}
+
+func newBucket(perSec int64) *rate.Limiter {
+	if perSec <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(perSec), int(perSec))
+}
+
+// bucketIdle reports whether a bucket last used at lastUsed can be dropped:
+// it has been unused for bucketIdleTimeout and has refilled.
+func bucketIdle(lim *rate.Limiter, lastUsed, now time.Time) bool {
+	if now.Sub(lastUsed) < bucketIdleTimeout {
+		return false
+	}
+	return lim.Limit() == rate.Inf || lim.TokensAt(now) >= float64(lim.Burst())
+}
+
+func updateBucket(lim *rate.Limiter, perSec int64) {
+	if perSec <= 0 {
+		if lim.Limit() != rate.Inf {
+			lim.SetLimit(rate.Inf)
+		}
+		return
+	}
+	if lim.Limit() != rate.Limit(perSec) || lim.Burst() != int(perSec) {
+		lim.SetLimit(rate.Limit(perSec))
+		lim.SetBurst(int(perSec))
+	}
+}
@@ -0,0 +1,136 @@
+// services/bifrost/internal/proxy/rate_limiter_test.go
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/metrics"
+)
+
+func newRateLimiterTestStore(vcs ...*gatewayv1.VirtualClusterConfig) *config.VirtualClusterStore {
+	vcStore := config.NewVirtualClusterStore()
+	for _, vc := range vcs {
+		vcStore.Upsert(vc)
+	}
+	return vcStore
+}
+
+// totalDelay reserves n requests of size bytes at the same instant and
+// returns the delay imposed on the last one.
+func totalDelay(l *VirtualClusterRateLimiter, vcID string, n, size int, now time.Time) time.Duration {
+	var delay time.Duration
+	for i := 0; i < n; i++ {
+		delay = l.reserve(vcID, size, now)
+	}
+	return delay
+}
+
+func TestVirtualClusterRateLimiter_ThrottlesOnlyNoisyTenant(t *testing.T) {
+	vcStore := newRateLimiterTestStore(
+		&gatewayv1.VirtualClusterConfig{Id: "vc-noisy", MaxRequestsPerSec: 10},
+		&gatewayv1.VirtualClusterConfig{Id: "vc-quiet", MaxRequestsPerSec: 1000},
+	)
+	limiter := NewVirtualClusterRateLimiter(vcStore, nil)
+	now := time.Now()
+
+	// 20 requests at once: the first 10 fit vc-noisy's burst, the rest wait
+	noisy := totalDelay(limiter, "vc-noisy", 20, 100, now)
+	assert.InDelta(t, time.Second.Seconds(), noisy.Seconds(), 0.01)
+
+	// The same load stays within vc-quiet's budget
+	quiet := totalDelay(limiter, "vc-quiet", 20, 100, now)
+	assert.Zero(t, quiet)
+}
+
+func TestVirtualClusterRateLimiter_ThrottlesBytes(t *testing.T) {
+	vcStore := newRateLimiterTestStore(
+		&gatewayv1.VirtualClusterConfig{Id: "vc-a", MaxBytesPerSec: 1000},
+	)
+	limiter := NewVirtualClusterRateLimiter(vcStore, nil)
+	now := time.Now()
+
+	assert.Zero(t, limiter.reserve("vc-a", 1000, now))
+	assert.InDelta(t, 0.5, limiter.reserve("vc-a", 500, now).Seconds(), 0.01)
+
+	// Requests larger than the burst are admitted after a full second of budget
+	assert.InDelta(t, 1.5, limiter.reserve("vc-a", 5000, now).Seconds(), 0.01)
+}
+
+func TestVirtualClusterRateLimiter_NoLimits(t *testing.T) {
+	vcStore := newRateLimiterTestStore(&gatewayv1.VirtualClusterConfig{Id: "vc-a"})
+	limiter := NewVirtualClusterRateLimiter(vcStore, nil)
+	now := time.Now()
+
+	assert.Zero(t, totalDelay(limiter, "vc-a", 1000, 1<<20, now))
+	assert.Zero(t, totalDelay(limiter, "vc-unknown", 1000, 1<<20, now))
+}
+
+func TestVirtualClusterRateLimiter_AppliesUpdatedLimits(t *testing.T) {
+	vcStore := newRateLimiterTestStore(
+		&gatewayv1.VirtualClusterConfig{Id: "vc-a", MaxRequestsPerSec: 10},
+	)
+	limiter := NewVirtualClusterRateLimiter(vcStore, nil)
+	now := time.Now()
+
+	assert.Positive(t, totalDelay(limiter, "vc-a", 20, 1, now))
+
+	// Lifting the limit stops throttling immediately
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-a"})
+	assert.Zero(t, totalDelay(limiter, "vc-a", 20, 1, now))
+}
+
+func TestVirtualClusterRateLimiter_DropsIdleBuckets(t *testing.T) {
+	vcStore := newRateLimiterTestStore(
+		&gatewayv1.VirtualClusterConfig{Id: "vc-a", MaxRequestsPerSec: 10},
+		&gatewayv1.VirtualClusterConfig{Id: "vc-b", MaxBytesPerSec: 1000},
+		&gatewayv1.VirtualClusterConfig{Id: "vc-c", MaxRequestsPerSec: 1},
+	)
+	limiter := NewVirtualClusterRateLimiter(vcStore, nil)
+	now := time.Now()
+
+	limiter.reserve("vc-a", 1, now)
+	limiter.reserve("vc-b", 1000, now)
+	// vc-c runs far over budget, so its bucket is still refilling later
+	totalDelay(limiter, "vc-c", 1000, 1, now)
+	require.Len(t, limiter.buckets, 3)
+
+	vcStore.Delete("vc-b")
+	later := now.Add(2 * bucketIdleTimeout)
+	limiter.reserve("vc-a", 1, later)
+
+	assert.Contains(t, limiter.buckets, "vc-a")
+	assert.NotContains(t, limiter.buckets, "vc-b", "an idle, refilled bucket is dropped")
+	assert.Contains(t, limiter.buckets, "vc-c", "a bucket still paying off its debt is kept")
+	assert.Positive(t, limiter.reserve("vc-c", 1, later))
+}
+
+func TestVirtualClusterRateLimiter_WaitRecordsThrottleMetrics(t *testing.T) {
+	vcStore := newRateLimiterTestStore(
+		&gatewayv1.VirtualClusterConfig{Id: "vc-noisy", MaxRequestsPerSec: 50},
+		&gatewayv1.VirtualClusterConfig{Id: "vc-quiet"},
+	)
+	collector := metrics.NewCollector()
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collector)
+	limiter := NewVirtualClusterRateLimiter(vcStore, collector)
+
+	start := time.Now()
+	for i := 0; i < 55; i++ {
+		limiter.Wait("vc-noisy", 10)
+		limiter.Wait("vc-quiet", 10)
+	}
+	// 5 requests over vc-noisy's burst at 50 req/s take roughly 100ms
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	count, err := testutil.GatherAndCount(reg, "bifrost_throttled_requests_total")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "only vc-noisy should have a throttle series")
+}
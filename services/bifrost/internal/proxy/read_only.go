@@ -0,0 +1,133 @@
+// services/bifrost/internal/proxy/read_only.go
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+const readOnlyErrorMessage = "virtual cluster is read-only"
+
+// readOnlyDeniedApiKeys are the client-facing API keys that change cluster
+// state. They are rejected with CLUSTER_AUTHORIZATION_FAILED while a virtual
+// cluster is read-only; reads (Fetch, Metadata, ListOffsets, ...) and group
+// membership pass through.
+var readOnlyDeniedApiKeys = map[int16]struct{}{
+	0:  {}, // Produce
+	8:  {}, // OffsetCommit
+	19: {}, // CreateTopics
+	20: {}, // DeleteTopics
+	21: {}, // DeleteRecords
+	22: {}, // InitProducerId
+	24: {}, // AddPartitionsToTxn
+	25: {}, // AddOffsetsToTxn
+	26: {}, // EndTxn
+	28: {}, // TxnOffsetCommit
+	30: {}, // CreateAcls
+	31: {}, // DeleteAcls
+	33: {}, // AlterConfigs
+	37: {}, // CreatePartitions
+	42: {}, // DeleteGroups
+	43: {}, // ElectLeaders
+	44: {}, // IncrementalAlterConfigs
+	45: {}, // AlterPartitionReassignments
+	47: {}, // OffsetDelete
+	49: {}, // AlterClientQuotas
+	51: {}, // AlterUserScramCredentials
+}
+
+// errLocalResponseTimeout is returned when in-flight broker responses do not
+// drain in time for a locally generated response to be written in order.
+var errLocalResponseTimeout = errors.New("timed out waiting for in-flight responses before writing local response")
+
+// rejectReadOnlyRequest consumes a mutating request without forwarding it and
+// answers it with CLUSTER_AUTHORIZATION_FAILED. Requests Bifrost cannot build
+// an error response for close the connection instead.
+func rejectReadOnlyRequest(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext) (readErr bool, err error) {
+	return rejectRequest(src, requestKeyVersion, ctx, protocol.ErrClusterAuthorizationFailed, readOnlyErrorMessage)
+}
+
+// rejectRequest consumes a request without forwarding it and answers it with
+// kerr. Requests Bifrost cannot build an error response for close the
+// connection instead.
+func rejectRequest(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext, kerr protocol.KError, message string) (readErr bool, err error) {
+	apiKey, apiVersion := requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion
+	if !protocol.CanBuildErrorResponse(apiKey, apiVersion) {
+		return true, fmt.Errorf("api key %d version %d rejected: %s", apiKey, apiVersion, message)
+	}
+	return answerRequest(src, requestKeyVersion, ctx, message, func(request []byte) ([]byte, error) {
+		return protocol.NewErrorResponse(apiKey, apiVersion, request, kerr, message)
+	})
+}
+
+// answerRequest consumes a request without forwarding it and answers it with
+// the response build returns for the request after api key and version. A
+// nil response answers nothing, for requests expecting none.
+func answerRequest(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext, message string, build func(request []byte) ([]byte, error)) (readErr bool, err error) {
+	apiKey, apiVersion := requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion
+	if requestKeyVersion.Length > protocol.MaxRequestSize {
+		return true, protocol.PacketDecodingError{Info: fmt.Sprintf("request of length %d too large", requestKeyVersion.Length)}
+	}
+
+	if err = src.SetReadDeadline(time.Now().Add(ctx.timeout)); err != nil {
+		return true, err
+	}
+	request := make([]byte, requestKeyVersion.Length-4) // 4 = ApiKey(2) + ApiVersion(2)
+	if _, err = io.ReadFull(src, request); err != nil {
+		return true, err
+	}
+
+	response, err := build(request)
+	if err != nil {
+		return true, err
+	}
+	logrus.Debugf("Rejected request key=%d version=%d: %s", apiKey, apiVersion, message)
+
+	if response != nil {
+		if err = ctx.writeLocalResponse(src, response); err != nil {
+			return false, err
+		}
+	}
+	if err = src.SetDeadline(time.Time{}); err != nil {
+		return false, err
+	}
+	// handled locally, so no response handler is enqueued
+	return false, ctx.putNextRequestHandler(defaultRequestHandler)
+}
+
+// writeLocalResponse writes a response generated by Bifrost to the client once
+// every request forwarded before it has been answered, so the client receives
+// responses in request order.
+func (ctx *RequestsLoopContext) writeLocalResponse(dst DeadlineWriter, response []byte) error {
+	deadline := time.Now().Add(ctx.timeout)
+	for {
+		if ctx.clientWriteLock != nil {
+			ctx.clientWriteLock.Lock()
+		}
+		if len(ctx.openRequestsChannel) == 0 {
+			break
+		}
+		if ctx.clientWriteLock != nil {
+			ctx.clientWriteLock.Unlock()
+		}
+		if time.Now().After(deadline) {
+			return errLocalResponseTimeout
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if ctx.clientWriteLock != nil {
+		defer ctx.clientWriteLock.Unlock()
+	}
+
+	if err := dst.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	_, err := dst.Write(response)
+	return err
+}
@@ -0,0 +1,207 @@
+// services/bifrost/internal/proxy/read_only_test.go
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+// readOnlyTestClient is a client connection that records what the proxy
+// writes back to it.
+type readOnlyTestClient struct {
+	reader  *bytes.Buffer
+	written bytes.Buffer
+}
+
+func (c *readOnlyTestClient) Read(p []byte) (int, error)       { return c.reader.Read(p) }
+func (c *readOnlyTestClient) Write(p []byte) (int, error)      { return c.written.Write(p) }
+func (c *readOnlyTestClient) SetReadDeadline(time.Time) error  { return nil }
+func (c *readOnlyTestClient) SetWriteDeadline(time.Time) error { return nil }
+func (c *readOnlyTestClient) SetDeadline(time.Time) error      { return nil }
+
+// kafkaRequest encodes a request with a v1 header (correlation id 42, null
+// client id) followed by body fields, which may be int8, int16, int32, int64,
+// bool or string.
+func kafkaRequest(apiKey, apiVersion int16, body ...interface{}) []byte {
+	var buf bytes.Buffer
+	for _, v := range []interface{}{apiKey, apiVersion, int32(42), int16(-1)} {
+		_ = binary.Write(&buf, binary.BigEndian, v)
+	}
+	for _, v := range body {
+		if s, ok := v.(string); ok {
+			_ = binary.Write(&buf, binary.BigEndian, int16(len(s)))
+			buf.WriteString(s)
+			continue
+		}
+		_ = binary.Write(&buf, binary.BigEndian, v)
+	}
+	frame := make([]byte, 4, 4+buf.Len())
+	binary.BigEndian.PutUint32(frame, uint32(buf.Len()))
+	return append(frame, buf.Bytes()...)
+}
+
+func newReadOnlyTestContext(readOnly bool) *RequestsLoopContext {
+	return &RequestsLoopContext{
+		openRequestsChannel:        make(chan protocol.RequestKeyVersion, 16),
+		nextRequestHandlerChannel:  make(chan RequestHandler, 1),
+		nextResponseHandlerChannel: make(chan ResponseHandler, 16),
+		timeout:                    time.Second,
+		buf:                        make([]byte, defaultRequestBufferSize),
+		readOnly:                   func() bool { return readOnly },
+	}
+}
+
+func TestHandleRequest_ReadOnly(t *testing.T) {
+	const emptyArray = int32(0)
+
+	tt := []struct {
+		name    string
+		request []byte
+		// allowed requests are forwarded to the broker
+		allowed bool
+		// unanswerable denied requests close the connection
+		closesConnection bool
+	}{
+		{name: "Produce", request: kafkaRequest(0, 3, int16(-1), int16(-1), int32(30000), int32(1), "orders", int32(1), int32(0), int32(0))},
+		{name: "Fetch", request: kafkaRequest(1, 4), allowed: true},
+		{name: "ListOffsets", request: kafkaRequest(2, 1), allowed: true},
+		{name: "Metadata", request: kafkaRequest(3, 1), allowed: true},
+		{name: "OffsetCommit", request: kafkaRequest(8, 2, "group", int32(1), "member", int64(-1), emptyArray)},
+		{name: "OffsetFetch", request: kafkaRequest(9, 1), allowed: true},
+		{name: "FindCoordinator", request: kafkaRequest(10, 1), allowed: true},
+		{name: "JoinGroup", request: kafkaRequest(11, 2), allowed: true},
+		{name: "Heartbeat", request: kafkaRequest(12, 1), allowed: true},
+		{name: "LeaveGroup", request: kafkaRequest(13, 1), allowed: true},
+		{name: "SyncGroup", request: kafkaRequest(14, 1), allowed: true},
+		{name: "DescribeGroups", request: kafkaRequest(15, 1), allowed: true},
+		{name: "ListGroups", request: kafkaRequest(16, 1), allowed: true},
+		{name: "ApiVersions", request: kafkaRequest(18, 1), allowed: true},
+		{name: "CreateTopics", request: kafkaRequest(19, 1, emptyArray, int32(30000), false)},
+		{name: "DeleteTopics", request: kafkaRequest(20, 1, int32(1), "orders", int32(30000))},
+		{name: "DeleteRecords", request: kafkaRequest(21, 1, emptyArray, int32(30000)), closesConnection: true},
+		{name: "InitProducerId", request: kafkaRequest(22, 1, int16(-1), int32(60000))},
+		{name: "AddPartitionsToTxn", request: kafkaRequest(24, 1, "txn", int64(1), int16(0), emptyArray)},
+		{name: "AddOffsetsToTxn", request: kafkaRequest(25, 1, "txn", int64(1), int16(0), "group")},
+		{name: "EndTxn", request: kafkaRequest(26, 1, "txn", int64(1), int16(0), true)},
+		{name: "TxnOffsetCommit", request: kafkaRequest(28, 1, "txn", "group", int64(1), int16(0), emptyArray)},
+		{name: "CreateAcls", request: kafkaRequest(30, 1, emptyArray), closesConnection: true},
+		{name: "DescribeConfigs", request: kafkaRequest(32, 1), allowed: true},
+		{name: "AlterConfigs", request: kafkaRequest(33, 1, emptyArray, false)},
+		{name: "CreatePartitions", request: kafkaRequest(37, 1, emptyArray, int32(30000), false)},
+		{name: "DeleteGroups", request: kafkaRequest(42, 1, int32(1), "group")},
+		{name: "IncrementalAlterConfigs", request: kafkaRequest(44, 1, emptyArray, false), closesConnection: true},
+		{name: "OffsetDelete", request: kafkaRequest(47, 0, "group", emptyArray)},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newReadOnlyTestContext(true)
+			client := &readOnlyTestClient{reader: bytes.NewBuffer(tc.request)}
+			broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+			_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+
+			switch {
+			case tc.allowed:
+				require.NoError(t, err)
+				assert.Equal(t, tc.request, broker.Bytes(), "request should be forwarded unchanged")
+				assert.Zero(t, client.written.Len())
+			case tc.closesConnection:
+				assert.Error(t, err)
+				assert.Zero(t, broker.Len(), "request must not reach the broker")
+				assert.Zero(t, client.written.Len())
+			default:
+				require.NoError(t, err)
+				assert.Zero(t, broker.Len(), "request must not reach the broker")
+
+				response := client.written.Bytes()
+				require.GreaterOrEqual(t, len(response), 8)
+				assert.Equal(t, uint32(len(response)-4), binary.BigEndian.Uint32(response[0:4]), "size prefix")
+				assert.Equal(t, uint32(42), binary.BigEndian.Uint32(response[4:8]), "correlation id")
+				assert.Empty(t, ctx.openRequestsChannel, "no broker response is awaited")
+				assert.Len(t, ctx.nextRequestHandlerChannel, 1)
+			}
+		})
+	}
+}
+
+func TestHandleRequest_ReadOnly_ProduceError(t *testing.T) {
+	ctx := newReadOnlyTestContext(true)
+	request := kafkaRequest(0, 2, int16(-1), int32(30000), int32(1), "orders", int32(1), int32(3), int32(0))
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+
+	// size, correlation id, responses[1]{"orders", partitions[1]{3, CLUSTER_AUTHORIZATION_FAILED, ...}}
+	response := client.written.Bytes()
+	body := response[8:]
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(body[0:4]))
+	assert.Equal(t, "orders", string(body[6:12]))
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(body[12:16]))
+	assert.Equal(t, uint32(3), binary.BigEndian.Uint32(body[16:20]), "partition index")
+	assert.Equal(t, uint16(protocol.ErrClusterAuthorizationFailed), binary.BigEndian.Uint16(body[20:22]))
+}
+
+func TestHandleRequest_ReadOnly_ProduceAcks0(t *testing.T) {
+	ctx := newReadOnlyTestContext(true)
+	request := kafkaRequest(0, 2, int16(0), int32(30000), int32(1), "orders", int32(1), int32(0), int32(0))
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+	assert.Zero(t, broker.Len())
+	assert.Zero(t, client.written.Len(), "acks=0 produce expects no response")
+}
+
+func TestHandleRequest_ReadOnlyDisabled(t *testing.T) {
+	ctx := newReadOnlyTestContext(false)
+	request := kafkaRequest(19, 1, int32(0), int32(30000), false)
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, request, broker.Bytes())
+	assert.Zero(t, client.written.Len())
+}
+
+func TestWriteLocalResponse_WaitsForInFlightResponses(t *testing.T) {
+	openRequests := make(chan protocol.RequestKeyVersion, 1)
+	openRequests <- protocol.RequestKeyVersion{ApiKey: 1}
+	ctx := &RequestsLoopContext{
+		openRequestsChannel: openRequests,
+		timeout:             time.Second,
+		clientWriteLock:     &sync.Mutex{},
+	}
+	client := &readOnlyTestClient{reader: new(bytes.Buffer)}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- ctx.writeLocalResponse(client, []byte("local"))
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("local response was written before the in-flight response")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// The responses loop answers the earlier request
+	ctx.clientWriteLock.Lock()
+	<-openRequests
+	ctx.clientWriteLock.Unlock()
+
+	require.NoError(t, <-done)
+	assert.Equal(t, "local", client.written.String())
+}
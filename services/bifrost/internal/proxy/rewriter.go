@@ -3,77 +3,103 @@ package proxy
 
 import (
 	"strings"
+	"sync/atomic"
 
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 )
 
 // Rewriter handles topic/group/transactionID prefix operations.
 // It transparently rewrites Kafka protocol messages for multi-tenant isolation.
+// Prefixes can be replaced while the connection is open; each call uses the
+// prefixes current at that moment.
 type Rewriter struct {
-	ctx *auth.ConnectionContext
+	prefixes atomic.Pointer[rewritePrefixes]
+}
+
+// rewritePrefixes are the tenant prefixes a Rewriter applies.
+type rewritePrefixes struct {
+	topic string
+	group string
+	txnID string
 }
 
 // NewRewriter creates a rewriter for a connection context.
 func NewRewriter(ctx *auth.ConnectionContext) *Rewriter {
-	return &Rewriter{ctx: ctx}
+	r := &Rewriter{}
+	r.SetPrefixes(ctx.TopicPrefix, ctx.GroupPrefix, ctx.TxnIDPrefix)
+	return r
+}
+
+// SetPrefixes replaces the tenant prefixes and reports whether they changed.
+func (r *Rewriter) SetPrefixes(topicPrefix, groupPrefix, txnIDPrefix string) bool {
+	next := &rewritePrefixes{topic: topicPrefix, group: groupPrefix, txnID: txnIDPrefix}
+	prev := r.prefixes.Swap(next)
+	return prev == nil || *prev != *next
+}
+
+func (r *Rewriter) load() *rewritePrefixes {
+	return r.prefixes.Load()
 }
 
 // PrefixTopic adds the tenant prefix to a topic name.
 // Used when processing client requests (e.g., Produce, Fetch).
 func (r *Rewriter) PrefixTopic(topic string) string {
-	return r.ctx.TopicPrefix + topic
+	return r.load().topic + topic
 }
 
 // UnprefixTopic removes the tenant prefix from a topic name.
 // Returns false if the topic doesn't have our prefix (belongs to another tenant).
 // Used when processing broker responses (e.g., Metadata).
 func (r *Rewriter) UnprefixTopic(topic string) (string, bool) {
+	prefix := r.load().topic
 	// Empty prefix matches everything (no multi-tenancy)
-	if r.ctx.TopicPrefix == "" {
+	if prefix == "" {
 		return topic, true
 	}
-	if !strings.HasPrefix(topic, r.ctx.TopicPrefix) {
+	if !strings.HasPrefix(topic, prefix) {
 		return "", false
 	}
-	return strings.TrimPrefix(topic, r.ctx.TopicPrefix), true
+	return strings.TrimPrefix(topic, prefix), true
 }
 
 // PrefixGroup adds the tenant prefix to a consumer group ID.
 // Used when processing client requests (e.g., JoinGroup, SyncGroup).
 func (r *Rewriter) PrefixGroup(group string) string {
-	return r.ctx.GroupPrefix + group
+	return r.load().group + group
 }
 
 // UnprefixGroup removes the tenant prefix from a consumer group ID.
 // Used when processing broker responses.
 func (r *Rewriter) UnprefixGroup(group string) (string, bool) {
+	prefix := r.load().group
 	// Empty prefix matches everything (no multi-tenancy)
-	if r.ctx.GroupPrefix == "" {
+	if prefix == "" {
 		return group, true
 	}
-	if !strings.HasPrefix(group, r.ctx.GroupPrefix) {
+	if !strings.HasPrefix(group, prefix) {
 		return "", false
 	}
-	return strings.TrimPrefix(group, r.ctx.GroupPrefix), true
+	return strings.TrimPrefix(group, prefix), true
 }
 
 // PrefixTransactionID adds the tenant prefix to a transaction ID.
 // Used when processing client requests (e.g., InitProducerId).
 func (r *Rewriter) PrefixTransactionID(txnID string) string {
-	return r.ctx.TxnIDPrefix + txnID
+	return r.load().txnID + txnID
 }
 
 // UnprefixTransactionID removes the tenant prefix from a transaction ID.
 // Used when processing broker responses.
 func (r *Rewriter) UnprefixTransactionID(txnID string) (string, bool) {
+	prefix := r.load().txnID
 	// Empty prefix matches everything (no multi-tenancy)
-	if r.ctx.TxnIDPrefix == "" {
+	if prefix == "" {
 		return txnID, true
 	}
-	if !strings.HasPrefix(txnID, r.ctx.TxnIDPrefix) {
+	if !strings.HasPrefix(txnID, prefix) {
 		return "", false
 	}
-	return strings.TrimPrefix(txnID, r.ctx.TxnIDPrefix), true
+	return strings.TrimPrefix(txnID, prefix), true
 }
 
 // FilterTopics filters a list of topics to only those belonging to this tenant.
@@ -92,31 +118,57 @@ func (r *Rewriter) FilterTopics(topics []string) []string {
 // HasTopicPrefix checks if we have a topic prefix configured.
 // Useful for determining if topic rewriting is enabled.
 func (r *Rewriter) HasTopicPrefix() bool {
-	return r.ctx.TopicPrefix != ""
+	return r.load().topic != ""
+}
+
+// HasTransactionIDPrefix checks if we have a transaction ID prefix configured.
+func (r *Rewriter) HasTransactionIDPrefix() bool {
+	return r.load().txnID != ""
+}
+
+// IsPassthrough reports whether the rewriter leaves every name unchanged.
+// A passthrough connection needs no topic, group, or transaction ID rewriting,
+// so its requests and responses can be forwarded without decoding.
+func (r *Rewriter) IsPassthrough() bool {
+	p := r.load()
+	return p.topic == "" && p.group == "" && p.txnID == ""
 }
 
 // TopicBelongsToTenant checks if a topic belongs to this tenant.
 // Returns true if the topic has the tenant's prefix or if no prefix is configured.
 func (r *Rewriter) TopicBelongsToTenant(topic string) bool {
+	prefix := r.load().topic
+	// Empty prefix matches everything (no multi-tenancy)
+	if prefix == "" {
+		return true
+	}
+	return strings.HasPrefix(topic, prefix)
+}
+
+// TransactionIDBelongsToTenant checks if a transaction ID belongs to this tenant.
+// Returns true if the ID has the tenant's prefix or if no prefix is configured.
+func (r *Rewriter) TransactionIDBelongsToTenant(txnID string) bool {
+	prefix := r.load().txnID
 	// Empty prefix matches everything (no multi-tenancy)
-	if r.ctx.TopicPrefix == "" {
+	if prefix == "" {
 		return true
 	}
-	return strings.HasPrefix(topic, r.ctx.TopicPrefix)
+	return strings.HasPrefix(txnID, prefix)
 }
 
 // HasGroupPrefix checks if we have a group prefix configured.
 // Useful for determining if group rewriting is enabled.
 func (r *Rewriter) HasGroupPrefix() bool {
-	return r.ctx.GroupPrefix != ""
+	return r.load().group != ""
 }
 
 // GroupBelongsToTenant checks if a consumer group belongs to this tenant.
 // Returns true if the group has the tenant's prefix or if no prefix is configured.
 func (r *Rewriter) GroupBelongsToTenant(group string) bool {
+	prefix := r.load().group
 	// Empty prefix matches everything (no multi-tenancy)
-	if r.ctx.GroupPrefix == "" {
+	if prefix == "" {
 		return true
 	}
-	return strings.HasPrefix(group, r.ctx.GroupPrefix)
+	return strings.HasPrefix(group, prefix)
 }
@@ -203,3 +203,37 @@ func TestRewriter_GroupBelongsToTenant_EmptyPrefix(t *testing.T) {
 	assert.True(t, r.GroupBelongsToTenant("myapp-dev-my-consumers"))
 	assert.True(t, r.GroupBelongsToTenant(""))
 }
+
+func TestRewriter_TransactionIDBelongsToTenant(t *testing.T) {
+	r := NewRewriter(&auth.ConnectionContext{TxnIDPrefix: "myapp-dev-"})
+	assert.True(t, r.TransactionIDBelongsToTenant("myapp-dev-orders-txn"))
+	assert.False(t, r.TransactionIDBelongsToTenant("other-app-txn"))
+
+	// When no prefix is configured, all transaction IDs belong to tenant
+	assert.True(t, NewRewriter(&auth.ConnectionContext{}).TransactionIDBelongsToTenant("other-app-txn"))
+}
+
+func TestRewriter_IsPassthrough(t *testing.T) {
+	assert.True(t, NewRewriter(&auth.ConnectionContext{}).IsPassthrough())
+
+	for _, ctx := range []*auth.ConnectionContext{
+		{TopicPrefix: "myapp-dev-"},
+		{GroupPrefix: "myapp-dev-"},
+		{TxnIDPrefix: "myapp-dev-"},
+	} {
+		assert.False(t, NewRewriter(ctx).IsPassthrough())
+	}
+}
+
+func TestRewriter_SetPrefixes(t *testing.T) {
+	r := NewRewriter(&auth.ConnectionContext{TopicPrefix: "old-", GroupPrefix: "old-"})
+
+	assert.False(t, r.SetPrefixes("old-", "old-", ""), "unchanged prefixes")
+	assert.True(t, r.SetPrefixes("new-", "new-", "new-"))
+
+	assert.Equal(t, "new-orders", r.PrefixTopic("orders"))
+	assert.Equal(t, "new-consumers", r.PrefixGroup("consumers"))
+	assert.Equal(t, "new-txn", r.PrefixTransactionID("txn"))
+	_, ok := r.UnprefixTopic("old-orders")
+	assert.False(t, ok, "topics under the previous prefix no longer belong to the tenant")
+}
@@ -6,11 +6,21 @@ import (
 )
 
 // CreateLocalSaslForBifrost creates a LocalSasl configured for Bifrost authentication.
-// This uses SASL/PLAIN mechanism with our BifrostAuthenticator.
-func CreateLocalSaslForBifrost(authenticator *BifrostAuthenticator, timeout time.Duration) *LocalSasl {
-	return NewLocalSasl(LocalSaslParams{
+// This uses the SASL/PLAIN mechanism with our BifrostAuthenticator, plus
+// SCRAM-SHA-256 and SCRAM-SHA-512 when the underlying handler supports them.
+// A non-zero sessionLifetime is advertised to clients so they re-authenticate
+// mid-session. A non-empty mechanisms list limits the offered mechanisms to
+// those named.
+func CreateLocalSaslForBifrost(authenticator *BifrostAuthenticator, timeout, sessionLifetime time.Duration, mechanisms []string) *LocalSasl {
+	params := LocalSaslParams{
 		enabled:               true,
 		timeout:               timeout,
+		sessionLifetime:       sessionLifetime,
 		passwordAuthenticator: authenticator,
-	})
+		mechanisms:            mechanisms,
+	}
+	if authenticator.SupportsScram() {
+		params.scramVerifier = authenticator
+	}
+	return NewLocalSasl(params)
 }
@@ -2,12 +2,18 @@
 package proxy
 
 import (
+	"io"
+	"net"
 	"testing"
 	"time"
 
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
 	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+	"github.com/drewpayment/orbit/services/bifrost/internal/config"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xdg-go/scram"
 )
 
 func TestCreateLocalSaslForBifrost_CreatesEnabledLocalSasl(t *testing.T) {
@@ -18,7 +24,7 @@ func TestCreateLocalSaslForBifrost_CreatesEnabledLocalSasl(t *testing.T) {
 	handler := &mockSASLHandler{ctx: ctx}
 	authenticator := NewBifrostAuthenticator(handler)
 
-	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second)
+	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, 0, nil)
 
 	require.NotNil(t, localSasl)
 	assert.True(t, localSasl.enabled)
@@ -32,7 +38,7 @@ func TestCreateLocalSaslForBifrost_ConfiguresTimeout(t *testing.T) {
 	authenticator := NewBifrostAuthenticator(handler)
 
 	timeout := 45 * time.Second
-	localSasl := CreateLocalSaslForBifrost(authenticator, timeout)
+	localSasl := CreateLocalSaslForBifrost(authenticator, timeout, 0, nil)
 
 	require.NotNil(t, localSasl)
 	assert.Equal(t, timeout, localSasl.timeout)
@@ -45,10 +51,161 @@ func TestCreateLocalSaslForBifrost_RegistersPlainMechanism(t *testing.T) {
 	handler := &mockSASLHandler{ctx: ctx}
 	authenticator := NewBifrostAuthenticator(handler)
 
-	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second)
+	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, 0, nil)
 
 	require.NotNil(t, localSasl)
 	// The localAuthenticators map should have PLAIN mechanism registered
 	assert.NotNil(t, localSasl.localAuthenticators)
 	assert.Contains(t, localSasl.localAuthenticators, SASLPlain)
 }
+
+func TestCreateLocalSaslForBifrost_ConfiguresSessionLifetime(t *testing.T) {
+	handler := &mockSASLHandler{ctx: &auth.ConnectionContext{VirtualClusterID: "vc-123"}}
+	authenticator := NewBifrostAuthenticator(handler)
+
+	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, 15*time.Minute, nil)
+
+	require.NotNil(t, localSasl)
+	assert.Equal(t, int64(900000), localSasl.sessionLifetimeMs())
+}
+
+// newScramSASLHandler returns a handler with a single SCRAM-SHA-256 credential
+// for user "alice" with password "secret".
+func newScramSASLHandler(t *testing.T) *auth.SASLHandler {
+	t.Helper()
+	vcStore := config.NewVirtualClusterStore()
+	vcStore.Upsert(&gatewayv1.VirtualClusterConfig{Id: "vc-1", TopicPrefix: "tenant-a:"})
+
+	client, err := scram.SHA256.NewClient("alice", "secret", "")
+	require.NoError(t, err)
+	kf := scram.KeyFactors{Salt: "salt", Iters: 4096}
+	stored := client.GetStoredCredentials(kf)
+
+	credStore := auth.NewCredentialStore()
+	credStore.Upsert(&gatewayv1.CredentialConfig{
+		Id:               "cred-1",
+		VirtualClusterId: "vc-1",
+		Username:         "alice",
+		Mechanism:        gatewayv1.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_256,
+		Scram: &gatewayv1.ScramCredential{
+			Salt:       []byte(kf.Salt),
+			Iterations: int32(kf.Iters),
+			StoredKey:  stored.StoredKey,
+			ServerKey:  stored.ServerKey,
+		},
+	})
+	return auth.NewSASLHandler(credStore, vcStore)
+}
+
+func TestCreateLocalSaslForBifrost_RegistersScramMechanisms(t *testing.T) {
+	authenticator := NewBifrostAuthenticator(newScramSASLHandler(t))
+
+	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, 0, nil)
+
+	assert.Equal(t, []string{SASLPlain, SASLSCRAM256, SASLSCRAM512}, localSasl.enabledMechanisms())
+}
+
+func TestCreateLocalSaslForBifrost_SkipsScramWithoutSupport(t *testing.T) {
+	authenticator := NewBifrostAuthenticator(&mockSASLHandler{})
+
+	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, 0, nil)
+
+	assert.Equal(t, []string{SASLPlain}, localSasl.enabledMechanisms())
+}
+
+func TestCreateLocalSaslForBifrost_RestrictsMechanisms(t *testing.T) {
+	authenticator := NewBifrostAuthenticator(newScramSASLHandler(t))
+
+	localSasl := CreateLocalSaslForBifrost(authenticator, 30*time.Second, 0, []string{SASLSCRAM512})
+
+	assert.Equal(t, []string{SASLSCRAM512}, localSasl.enabledMechanisms())
+}
+
+func TestCreateLocalSaslForBifrost_ScramHandshake(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  protocol.KError
+	}{
+		{name: "valid password", password: "secret", wantErr: protocol.ErrNoError},
+		{name: "invalid password", password: "wrong", wantErr: protocol.ErrSASLAuthenticationFailed},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authenticator := NewBifrostAuthenticator(newScramSASLHandler(t))
+			localSasl := CreateLocalSaslForBifrost(authenticator, 5*time.Second, time.Minute, nil)
+
+			serverConn, clientConn := net.Pipe()
+			defer clientConn.Close()
+			serverErr := make(chan error, 1)
+			go func() {
+				defer serverConn.Close()
+				keyVersionBuf := make([]byte, 8)
+				if _, err := io.ReadFull(serverConn, keyVersionBuf); err != nil {
+					serverErr <- err
+					return
+				}
+				serverErr <- localSasl.receiveAndSendSASLAuthV1(serverConn, keyVersionBuf)
+			}()
+
+			handshake, err := protocol.Encode(&protocol.Request{
+				CorrelationID: 1,
+				ClientID:      "test-client",
+				Body:          &protocol.SaslHandshakeRequestV0orV1{Version: 1, Mechanism: SASLSCRAM256},
+			})
+			require.NoError(t, err)
+			require.NoError(t, writeFrame(clientConn, handshake))
+
+			resp, err := readFrame(clientConn)
+			require.NoError(t, err)
+			handshakeResp := &protocol.SaslHandshakeResponseV0orV1{}
+			require.NoError(t, protocol.Decode(resp[4:], handshakeResp))
+			require.Equal(t, protocol.ErrNoError, handshakeResp.Err)
+			assert.Equal(t, []string{SASLPlain, SASLSCRAM256, SASLSCRAM512}, handshakeResp.EnabledMechanisms)
+
+			client, err := scram.SHA256.NewClient("alice", tc.password, "")
+			require.NoError(t, err)
+			conv := client.NewConversation()
+			clientMsg, err := conv.Step("")
+			require.NoError(t, err)
+
+			var authResp *protocol.SaslAuthenticateResponseV1
+			for correlationID := int32(2); ; correlationID++ {
+				authenticate, err := protocol.Encode(&protocol.Request{
+					CorrelationID: correlationID,
+					ClientID:      "test-client",
+					Body:          &protocol.SaslAuthenticateRequestV1{SaslAuthBytes: []byte(clientMsg)},
+				})
+				require.NoError(t, err)
+				require.NoError(t, writeFrame(clientConn, authenticate))
+
+				resp, err = readFrame(clientConn)
+				require.NoError(t, err)
+				authResp = &protocol.SaslAuthenticateResponseV1{}
+				require.NoError(t, protocol.Decode(resp[4:], authResp))
+				if authResp.Err != protocol.ErrNoError {
+					break
+				}
+				clientMsg, err = conv.Step(string(authResp.SaslAuthBytes))
+				require.NoError(t, err)
+				if conv.Done() {
+					break
+				}
+			}
+
+			require.Equal(t, tc.wantErr, authResp.Err)
+			if tc.wantErr != protocol.ErrNoError {
+				assert.Error(t, <-serverErr)
+				assert.Nil(t, authenticator.GetContext())
+				return
+			}
+
+			require.NoError(t, <-serverErr)
+			assert.True(t, conv.Valid(), "client should verify the server signature")
+			assert.Equal(t, int64(60000), authResp.SessionLifetimeMs)
+			require.NotNil(t, authenticator.GetContext())
+			assert.Equal(t, "cred-1", authenticator.GetContext().CredentialID)
+			assert.Equal(t, "tenant-a:", authenticator.GetContext().TopicPrefix)
+		})
+	}
+}
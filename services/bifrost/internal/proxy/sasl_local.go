@@ -8,6 +8,7 @@ import (
 	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
 	"github.com/pkg/errors"
 	"io"
+	"sort"
 	"time"
 )
 
@@ -15,13 +16,21 @@ type LocalSasl struct {
 	enabled             bool
 	timeout             time.Duration
 	localAuthenticators map[string]LocalSaslAuth
+	// sessionLifetime is advertised to clients in SaslAuthenticate v1+ responses
+	// so they re-authenticate before it elapses. Zero disables re-authentication.
+	sessionLifetime time.Duration
 }
 
 type LocalSaslParams struct {
 	enabled               bool
 	timeout               time.Duration
+	sessionLifetime       time.Duration
 	passwordAuthenticator apis.PasswordAuthenticator
 	tokenAuthenticator    apis.TokenInfo
+	scramVerifier         ScramVerifier
+	// mechanisms restricts the offered mechanisms to those listed. Empty
+	// offers every mechanism an authenticator is configured for.
+	mechanisms []string
 }
 
 func NewLocalSasl(params LocalSaslParams) *LocalSasl {
@@ -33,11 +42,52 @@ func NewLocalSasl(params LocalSaslParams) *LocalSasl {
 	if params.tokenAuthenticator != nil {
 		localAuthenticators[SASLOAuthBearer] = NewLocalSaslOauth(params.tokenAuthenticator)
 	}
+
+	if params.scramVerifier != nil {
+		localAuthenticators[SASLSCRAM256] = NewLocalSaslScram(SASLSCRAM256, params.scramVerifier)
+		localAuthenticators[SASLSCRAM512] = NewLocalSaslScram(SASLSCRAM512, params.scramVerifier)
+	}
+
+	if len(params.mechanisms) > 0 {
+		enabled := make(map[string]bool, len(params.mechanisms))
+		for _, mechanism := range params.mechanisms {
+			enabled[mechanism] = true
+		}
+		for mechanism := range localAuthenticators {
+			if !enabled[mechanism] {
+				delete(localAuthenticators, mechanism)
+			}
+		}
+	}
 	return &LocalSasl{
 		enabled:             params.enabled,
 		timeout:             params.timeout,
 		localAuthenticators: localAuthenticators,
+		sessionLifetime:     params.sessionLifetime,
+	}
+}
+
+// enabledMechanisms returns the configured mechanisms in a stable order.
+func (p *LocalSasl) enabledMechanisms() []string {
+	mechanisms := make([]string, 0, len(p.localAuthenticators))
+	for mechanism := range p.localAuthenticators {
+		mechanisms = append(mechanisms, mechanism)
+	}
+	sort.Strings(mechanisms)
+	return mechanisms
+}
+
+func (p *LocalSasl) sessionLifetimeMs() int64 {
+	return p.sessionLifetime.Milliseconds()
+}
+
+// completedSessionLifetimeMs returns the session lifetime once an exchange is
+// done; intermediate steps of a multi-step exchange advertise none.
+func (p *LocalSasl) completedSessionLifetimeMs(done bool) int64 {
+	if !done {
+		return 0
 	}
+	return p.sessionLifetimeMs()
 }
 
 func (p *LocalSasl) receiveAndSendSASLAuthV1(conn DeadlineReaderWriter, readKeyVersionBuf []byte) (err error) {
@@ -100,16 +150,13 @@ func (p *LocalSasl) receiveAndSendSaslV0orV1(conn DeadlineReaderWriter, keyVersi
 	var saslResult error
 	saslErr := protocol.ErrNoError
 	localSaslAuth = p.localAuthenticators[saslReqV0orV1.Mechanism]
+	mechanisms := p.enabledMechanisms()
 	if localSaslAuth == nil {
-		mechanisms := make([]string, 0)
-		for mechanism := range p.localAuthenticators {
-			mechanisms = append(mechanisms, mechanism)
-		}
-		saslResult = fmt.Errorf("PLAIN or OAUTHBEARER mechanism expected, %v are configured, but got %s", mechanisms, saslReqV0orV1.Mechanism)
+		saslResult = fmt.Errorf("one of %v mechanisms expected, but got %s", mechanisms, saslReqV0orV1.Mechanism)
 		saslErr = protocol.ErrUnsupportedSASLMechanism
 	}
 
-	saslResV0 := &protocol.SaslHandshakeResponseV0orV1{Err: saslErr, EnabledMechanisms: []string{saslReqV0orV1.Mechanism}}
+	saslResV0 := &protocol.SaslHandshakeResponseV0orV1{Err: saslErr, EnabledMechanisms: mechanisms}
 	newResponseBuf, err := protocol.Encode(saslResV0)
 	if err != nil {
 		return nil, err
@@ -128,31 +175,46 @@ func (p *LocalSasl) receiveAndSendSaslV0orV1(conn DeadlineReaderWriter, keyVersi
 }
 
 func (p *LocalSasl) receiveAndSendAuthV1(conn DeadlineReaderWriter, localSaslAuth LocalSaslAuth) (err error) {
+	if localSaslAuth == nil {
+		return errors.New("localSaslAuth is nil")
+	}
+	exchange := newLocalSaslExchange(localSaslAuth)
+	for {
+		done, err := p.receiveAndSendAuthV1Step(conn, exchange)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// receiveAndSendAuthV1Step answers a single SaslAuthenticate request. done is
+// true once the exchange has completed successfully.
+func (p *LocalSasl) receiveAndSendAuthV1Step(conn DeadlineReaderWriter, exchange localSaslExchange) (done bool, err error) {
 	requestDeadline := time.Now().Add(p.timeout)
 	err = conn.SetDeadline(requestDeadline)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	keyVersionBuf := make([]byte, 8) // Size => int32 + ApiKey => int16 + ApiVersion => int16
 	if _, err = io.ReadFull(conn, keyVersionBuf); err != nil {
-		return err
+		return false, err
 	}
 	requestKeyVersion := &protocol.RequestKeyVersion{}
 	if err = protocol.Decode(keyVersionBuf, requestKeyVersion); err != nil {
-		return err
+		return false, err
 	}
 	if requestKeyVersion.ApiKey != 36 {
-		return errors.Errorf("SaslAuthenticate is expected, but got apiKey %d", requestKeyVersion.ApiKey)
+		return false, errors.Errorf("SaslAuthenticate is expected, but got apiKey %d", requestKeyVersion.ApiKey)
 	}
 
 	if requestKeyVersion.Length > protocol.MaxRequestSize {
-		return protocol.PacketDecodingError{Info: fmt.Sprintf("sasl authenticate message of length %d too large", requestKeyVersion.Length)}
+		return false, protocol.PacketDecodingError{Info: fmt.Sprintf("sasl authenticate message of length %d too large", requestKeyVersion.Length)}
 	}
 
 	resp := make([]byte, int(requestKeyVersion.Length-4))
 	if _, err = io.ReadFull(conn, resp); err != nil {
-		return err
+		return false, err
 	}
 	payload := bytes.Join([][]byte{keyVersionBuf[4:], resp}, nil)
 
@@ -161,141 +223,157 @@ func (p *LocalSasl) receiveAndSendAuthV1(conn DeadlineReaderWriter, localSaslAut
 		saslAuthReqV0 := &protocol.SaslAuthenticateRequestV0{}
 		req := &protocol.Request{Body: saslAuthReqV0}
 		if err = protocol.Decode(payload, req); err != nil {
-			return err
+			return false, err
 		}
 
-		authErr := localSaslAuth.doLocalAuth(saslAuthReqV0.SaslAuthBytes)
+		challenge, done, authErr := exchange.step(saslAuthReqV0.SaslAuthBytes)
+		if challenge == nil {
+			challenge = make([]byte, 0)
+		}
 
 		var saslAuthResV0 *protocol.SaslAuthenticateResponseV0
 		if authErr == nil {
 			// Length of SaslAuthBytes !=0 for OAUTHBEARER causes that java SaslClientAuthenticator in INTERMEDIATE state will sent SaslAuthenticate(36) second time
-			saslAuthResV0 = &protocol.SaslAuthenticateResponseV0{Err: protocol.ErrNoError, SaslAuthBytes: make([]byte, 0)}
+			saslAuthResV0 = &protocol.SaslAuthenticateResponseV0{Err: protocol.ErrNoError, SaslAuthBytes: challenge}
 		} else {
 			errMsg := authErr.Error()
 			saslAuthResV0 = &protocol.SaslAuthenticateResponseV0{Err: protocol.ErrSASLAuthenticationFailed, ErrMsg: &errMsg, SaslAuthBytes: make([]byte, 0)}
 		}
 		newResponseBuf, err := protocol.Encode(saslAuthResV0)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		newHeaderBuf, err := protocol.Encode(&protocol.ResponseHeader{Length: int32(len(newResponseBuf) + 4), CorrelationID: req.CorrelationID})
 		if err != nil {
-			return err
+			return false, err
 		}
 		if _, err := conn.Write(newHeaderBuf); err != nil {
-			return err
+			return false, err
 		}
 		if _, err := conn.Write(newResponseBuf); err != nil {
-			return err
+			return false, err
 		}
-		return authErr
+		return done, authErr
 	case 1:
 		saslAuthReqV1 := &protocol.SaslAuthenticateRequestV1{}
 		req := &protocol.Request{Body: saslAuthReqV1}
 		if err = protocol.Decode(payload, req); err != nil {
-			return err
+			return false, err
 		}
 
-		authErr := localSaslAuth.doLocalAuth(saslAuthReqV1.SaslAuthBytes)
+		challenge, done, authErr := exchange.step(saslAuthReqV1.SaslAuthBytes)
+		if challenge == nil {
+			challenge = make([]byte, 0)
+		}
 
 		var saslAuthResV1 *protocol.SaslAuthenticateResponseV1
 		if authErr == nil {
 			// Length of SaslAuthBytes !=0 for OAUTHBEARER causes that java SaslClientAuthenticator in INTERMEDIATE state will sent SaslAuthenticate(36) second time
-			saslAuthResV1 = &protocol.SaslAuthenticateResponseV1{Err: protocol.ErrNoError, SaslAuthBytes: make([]byte, 0), SessionLifetimeMs: 0}
+			saslAuthResV1 = &protocol.SaslAuthenticateResponseV1{Err: protocol.ErrNoError, SaslAuthBytes: challenge, SessionLifetimeMs: p.completedSessionLifetimeMs(done)}
 		} else {
 			errMsg := authErr.Error()
 			saslAuthResV1 = &protocol.SaslAuthenticateResponseV1{Err: protocol.ErrSASLAuthenticationFailed, ErrMsg: &errMsg, SaslAuthBytes: make([]byte, 0), SessionLifetimeMs: 0}
 		}
 		newResponseBuf, err := protocol.Encode(saslAuthResV1)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		newHeaderBuf, err := protocol.Encode(&protocol.ResponseHeader{Length: int32(len(newResponseBuf) + 4), CorrelationID: req.CorrelationID})
 		if err != nil {
-			return err
+			return false, err
 		}
 		if _, err := conn.Write(newHeaderBuf); err != nil {
-			return err
+			return false, err
 		}
 		if _, err := conn.Write(newResponseBuf); err != nil {
-			return err
+			return false, err
 		}
-		return authErr
+		return done, authErr
 	case 2:
 		saslAuthReqV2 := &protocol.SaslAuthenticateRequestV2{}
 		req := &protocol.RequestV2{Body: saslAuthReqV2}
 		if err = protocol.Decode(payload, req); err != nil {
-			return err
+			return false, err
 		}
 
-		authErr := localSaslAuth.doLocalAuth(saslAuthReqV2.SaslAuthBytes)
+		challenge, done, authErr := exchange.step(saslAuthReqV2.SaslAuthBytes)
+		if challenge == nil {
+			challenge = make([]byte, 0)
+		}
 
 		var saslAuthResV2 *protocol.SaslAuthenticateResponseV2
 		if authErr == nil {
 			// Length of SaslAuthBytes !=0 for OAUTHBEARER causes that java SaslClientAuthenticator in INTERMEDIATE state will sent SaslAuthenticate(36) second time
-			saslAuthResV2 = &protocol.SaslAuthenticateResponseV2{Err: protocol.ErrNoError, SaslAuthBytes: make([]byte, 0), SessionLifetimeMs: 0}
+			saslAuthResV2 = &protocol.SaslAuthenticateResponseV2{Err: protocol.ErrNoError, SaslAuthBytes: challenge, SessionLifetimeMs: p.completedSessionLifetimeMs(done)}
 		} else {
 			errMsg := authErr.Error()
 			saslAuthResV2 = &protocol.SaslAuthenticateResponseV2{Err: protocol.ErrSASLAuthenticationFailed, ErrMsg: &errMsg, SaslAuthBytes: make([]byte, 0), SessionLifetimeMs: 0}
 		}
 		newResponseBuf, err := protocol.Encode(saslAuthResV2)
 		if err != nil {
-			return err
+			return false, err
 		}
 		// 2 (Length) + 2 (CorrelationID) + 1 (empty TaggedFields)
 		newHeaderBuf, err := protocol.Encode(&protocol.ResponseHeaderV1{Length: int32(len(newResponseBuf) + 5), CorrelationID: req.CorrelationID})
 		if err != nil {
-			return err
+			return false, err
 		}
 		if _, err := conn.Write(newHeaderBuf); err != nil {
-			return err
+			return false, err
 		}
 		if _, err := conn.Write(newResponseBuf); err != nil {
-			return err
+			return false, err
 		}
-		return authErr
+		return done, authErr
 	default:
-		return errors.Errorf("SaslAuthenticate version 0,1 or 2 is expected, apiVersion %d", requestKeyVersion.ApiVersion)
+		return false, errors.Errorf("SaslAuthenticate version 0,1 or 2 is expected, apiVersion %d", requestKeyVersion.ApiVersion)
 	}
 }
 
 func (p *LocalSasl) receiveAndSendAuthV0(conn DeadlineReaderWriter, localSaslAuth LocalSaslAuth) (err error) {
-	requestDeadline := time.Now().Add(p.timeout)
-	err = conn.SetDeadline(requestDeadline)
-	if err != nil {
-		return err
-	}
-
-	sizeBuf := make([]byte, 4) // Size => int32
-	if _, err = io.ReadFull(conn, sizeBuf); err != nil {
-		return err
+	if localSaslAuth == nil {
+		return errors.New("localSaslAuth is nil")
 	}
+	exchange := newLocalSaslExchange(localSaslAuth)
+	for {
+		requestDeadline := time.Now().Add(p.timeout)
+		err = conn.SetDeadline(requestDeadline)
+		if err != nil {
+			return err
+		}
 
-	length := binary.BigEndian.Uint32(sizeBuf)
-	if int32(length) > protocol.MaxRequestSize {
-		return protocol.PacketDecodingError{Info: fmt.Sprintf("auth message of length %d too large", length)}
-	}
+		sizeBuf := make([]byte, 4) // Size => int32
+		if _, err = io.ReadFull(conn, sizeBuf); err != nil {
+			return err
+		}
 
-	saslAuthBytes := make([]byte, length)
-	_, err = io.ReadFull(conn, saslAuthBytes)
-	if err != nil {
-		return err
-	}
+		length := binary.BigEndian.Uint32(sizeBuf)
+		if int32(length) > protocol.MaxRequestSize {
+			return protocol.PacketDecodingError{Info: fmt.Sprintf("auth message of length %d too large", length)}
+		}
 
-	if localSaslAuth == nil {
-		return errors.New("localSaslAuth is nil")
-	}
+		saslAuthBytes := make([]byte, length)
+		_, err = io.ReadFull(conn, saslAuthBytes)
+		if err != nil {
+			return err
+		}
 
-	if err = localSaslAuth.doLocalAuth(saslAuthBytes); err != nil {
-		return err
-	}
-	// If the credentials are valid, we would write a 4 byte response filled with null characters.
-	// Otherwise, the closes the connection i.e. return error
-	header := make([]byte, 4)
-	if _, err := conn.Write(header); err != nil {
-		return err
+		challenge, done, err := exchange.step(saslAuthBytes)
+		if err != nil {
+			return err
+		}
+		// Each server token is size-prefixed. For single round trip mechanisms
+		// this is a 4 byte response filled with null characters when the
+		// credentials are valid. Otherwise, the connection is closed i.e. return error
+		header := make([]byte, 4)
+		binary.BigEndian.PutUint32(header, uint32(len(challenge)))
+		if _, err := conn.Write(append(header, challenge...)); err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
 	}
-	return nil
 }
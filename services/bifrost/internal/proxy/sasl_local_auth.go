@@ -2,7 +2,9 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
 	"github.com/drewpayment/orbit/services/bifrost/internal/pkg/apis"
 	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
 	"strconv"
@@ -84,3 +86,113 @@ func (p *LocalSaslOauth) doLocalAuth(saslAuthBytes []byte) (err error) {
 	}
 	return nil
 }
+
+// localSaslExchange is a single authentication attempt. Each step consumes the
+// client's SaslAuthenticate bytes and returns the bytes to send back; done
+// reports that no further client message is expected.
+type localSaslExchange interface {
+	step(saslAuthBytes []byte) (challenge []byte, done bool, err error)
+}
+
+// LocalSaslMultiStepAuth is implemented by mechanisms that need more than one
+// SaslAuthenticate round trip, such as SCRAM.
+type LocalSaslMultiStepAuth interface {
+	LocalSaslAuth
+	newExchange() localSaslExchange
+}
+
+// singleStepExchange adapts a single round trip LocalSaslAuth to localSaslExchange.
+type singleStepExchange struct {
+	localSaslAuth LocalSaslAuth
+}
+
+func (e singleStepExchange) step(saslAuthBytes []byte) ([]byte, bool, error) {
+	return nil, true, e.localSaslAuth.doLocalAuth(saslAuthBytes)
+}
+
+func newLocalSaslExchange(localSaslAuth LocalSaslAuth) localSaslExchange {
+	if multiStep, ok := localSaslAuth.(LocalSaslMultiStepAuth); ok {
+		return multiStep.newExchange()
+	}
+	return singleStepExchange{localSaslAuth: localSaslAuth}
+}
+
+// ScramVerifier starts SCRAM conversations and resolves the outcome of
+// completed ones. It returns (true, 0, nil) on success and (false, status, nil)
+// on authentication failure, mirroring apis.PasswordAuthenticator.
+type ScramVerifier interface {
+	NewScramConversation(mechanism string) (*auth.ScramConversation, error)
+	VerifyScram(conv *auth.ScramConversation) (bool, int32, error)
+}
+
+type LocalSaslScram struct {
+	mechanism string
+	verifier  ScramVerifier
+}
+
+func NewLocalSaslScram(mechanism string, verifier ScramVerifier) *LocalSaslScram {
+	return &LocalSaslScram{
+		mechanism: mechanism,
+		verifier:  verifier,
+	}
+}
+
+// implements LocalSaslAuth
+func (p *LocalSaslScram) doLocalAuth(saslAuthBytes []byte) (err error) {
+	return fmt.Errorf("SASL/%s requires a multi-step exchange", p.mechanism)
+}
+
+// implements LocalSaslMultiStepAuth
+func (p *LocalSaslScram) newExchange() localSaslExchange {
+	return &scramExchange{LocalSaslScram: p}
+}
+
+type scramExchange struct {
+	*LocalSaslScram
+	conv *auth.ScramConversation
+}
+
+func (e *scramExchange) step(saslAuthBytes []byte) ([]byte, bool, error) {
+	if e.conv == nil {
+		conv, err := e.verifier.NewScramConversation(e.mechanism)
+		if err != nil {
+			return nil, true, err
+		}
+		e.conv = conv
+	}
+
+	serverMsg, err := e.conv.Step(string(saslAuthBytes))
+	if err != nil {
+		status := int32(1)
+		if errors.Is(err, auth.ErrUnknownUser) {
+			status = 2
+		}
+		proxyLocalAuthTotal.WithLabelValues("false", strconv.Itoa(int(status))).Inc()
+		return nil, true, errLocalAuthFailed{user: scramUsername(saslAuthBytes)}
+	}
+	if !e.conv.Done() {
+		return []byte(serverMsg), false, nil
+	}
+
+	ok, status, err := e.verifier.VerifyScram(e.conv)
+	if err != nil {
+		proxyLocalAuthTotal.WithLabelValues("error", "1").Inc()
+		return nil, true, err
+	}
+	proxyLocalAuthTotal.WithLabelValues(strconv.FormatBool(ok), strconv.Itoa(int(status))).Inc()
+	if !ok {
+		return nil, true, errLocalAuthFailed{user: e.conv.Username()}
+	}
+	return []byte(serverMsg), true, nil
+}
+
+// scramUsername extracts the username from a SCRAM client-first message for
+// error reporting. It returns an empty string for any other message.
+func scramUsername(clientMsg []byte) string {
+	for _, attr := range strings.Split(string(clientMsg), ",") {
+		if strings.HasPrefix(attr, "n=") {
+			return strings.TrimPrefix(attr, "n=")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+// services/bifrost/internal/proxy/sasl_reauth.go
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+)
+
+var (
+	// errSaslSessionExpired is returned when a client keeps using a connection
+	// after its SASL session lifetime elapsed without re-authenticating.
+	errSaslSessionExpired = errors.New("SASL session expired, re-authentication required")
+)
+
+// SaslReauthenticator handles SASL re-authentication (KIP-368) on a connection
+// that has already completed its initial handshake. Clients are told the
+// session lifetime in the SaslAuthenticate response and send a new
+// SaslHandshake/SaslAuthenticate pair before it elapses; each successful
+// re-authentication resets the session timer.
+type SaslReauthenticator struct {
+	localSasl       *LocalSasl
+	sessionLifetime time.Duration
+
+	// writeMu serializes locally generated SASL responses with proxied broker
+	// responses, since both are written to the same client connection.
+	writeMu sync.Mutex
+
+	mu        sync.RWMutex
+	expiresAt time.Time
+}
+
+// NewSaslReauthenticator creates a re-authenticator for a connection that was
+// authenticated as original. Re-authentication is validated against handler
+// and must resolve to the same credential and virtual cluster. The session
+// timer starts immediately; a zero sessionLifetime means the session never expires.
+// A non-empty mechanisms list limits the mechanisms clients may re-authenticate with.
+func NewSaslReauthenticator(handler SASLAuthenticator, original *auth.ConnectionContext, timeout, sessionLifetime time.Duration, mechanisms []string) *SaslReauthenticator {
+	reauth := &reauthPasswordAuthenticator{handler: handler, original: original}
+	params := LocalSaslParams{
+		enabled:               true,
+		timeout:               timeout,
+		sessionLifetime:       sessionLifetime,
+		passwordAuthenticator: reauth,
+		mechanisms:            mechanisms,
+	}
+	if _, ok := handler.(SCRAMAuthenticator); ok {
+		params.scramVerifier = reauth
+	}
+	r := &SaslReauthenticator{
+		localSasl:       NewLocalSasl(params),
+		sessionLifetime: sessionLifetime,
+	}
+	r.resetSession()
+	return r
+}
+
+func (r *SaslReauthenticator) resetSession() {
+	if r.sessionLifetime <= 0 {
+		return
+	}
+	r.mu.Lock()
+	r.expiresAt = time.Now().Add(r.sessionLifetime)
+	r.mu.Unlock()
+}
+
+// expired reports whether the session lifetime has elapsed.
+func (r *SaslReauthenticator) expired() bool {
+	if r.sessionLifetime <= 0 {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return time.Now().After(r.expiresAt)
+}
+
+// reauthenticate answers a mid-session SaslHandshake (whose header is in
+// keyVersionBuf) and the SaslAuthenticate that follows it, then resets the
+// session timer. Only SaslHandshake v1 can be used for re-authentication
+// because v0 exchanges raw, unframed SASL tokens.
+func (r *SaslReauthenticator) reauthenticate(conn DeadlineReaderWriter, keyVersionBuf []byte, apiVersion int16) error {
+	if apiVersion != 1 {
+		return fmt.Errorf("re-authentication requires SaslHandshake version 1, got version %d", apiVersion)
+	}
+
+	reauthConn := &reauthConn{DeadlineReaderWriter: conn, writeMu: &r.writeMu}
+	err := r.localSasl.receiveAndSendSASLAuthV1(reauthConn, keyVersionBuf)
+	if flushErr := reauthConn.flush(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		return fmt.Errorf("SASL re-authentication failed: %w", err)
+	}
+	r.resetSession()
+
+	logrus.Debugf("SASL re-authentication succeeded, session extended by %v", r.sessionLifetime)
+	return nil
+}
+
+// reauthConn buffers the responses written during re-authentication and
+// writes each one to the client under writeMu before the next read, so
+// proxied broker responses are not interleaved with them and are not held up
+// while the client prepares its next SASL request.
+type reauthConn struct {
+	DeadlineReaderWriter
+	writeMu *sync.Mutex
+	pending bytes.Buffer
+}
+
+func (c *reauthConn) Write(b []byte) (int, error) {
+	return c.pending.Write(b)
+}
+
+func (c *reauthConn) Read(b []byte) (int, error) {
+	if err := c.flush(); err != nil {
+		return 0, err
+	}
+	return c.DeadlineReaderWriter.Read(b)
+}
+
+// flush writes the buffered response to the client while holding writeMu.
+func (c *reauthConn) flush() error {
+	if c.pending.Len() == 0 {
+		return nil
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := c.DeadlineReaderWriter.Write(c.pending.Bytes())
+	c.pending.Reset()
+	return err
+}
+
+// reauthPasswordAuthenticator re-validates credentials and rejects a
+// re-authentication that would switch the connection to a different principal.
+type reauthPasswordAuthenticator struct {
+	handler  SASLAuthenticator
+	original *auth.ConnectionContext
+}
+
+// Authenticate implements apis.PasswordAuthenticator.
+func (a *reauthPasswordAuthenticator) Authenticate(username, password string) (bool, int32, error) {
+	ctx, err := a.handler.Authenticate(username, password)
+	if err != nil {
+		switch err {
+		case auth.ErrAuthFailed:
+			return false, 1, nil
+		case auth.ErrUnknownUser:
+			return false, 2, nil
+		case auth.ErrInvalidCluster:
+			return false, 3, nil
+		default:
+			return false, 0, err
+		}
+	}
+
+	return a.samePrincipal(ctx)
+}
+
+// samePrincipal rejects a re-authentication that resolved to a different
+// credential or virtual cluster than the connection was opened with.
+func (a *reauthPasswordAuthenticator) samePrincipal(ctx *auth.ConnectionContext) (bool, int32, error) {
+	if ctx.CredentialID != a.original.CredentialID || ctx.VirtualClusterID != a.original.VirtualClusterID {
+		logrus.Warnf("SASL re-authentication rejected: user %s attempted to change principal", ctx.Username)
+		return false, 4, nil
+	}
+	return true, 0, nil
+}
+
+// NewScramConversation implements ScramVerifier.
+func (a *reauthPasswordAuthenticator) NewScramConversation(mechanism string) (*auth.ScramConversation, error) {
+	scramHandler, ok := a.handler.(SCRAMAuthenticator)
+	if !ok {
+		return nil, auth.ErrUnsupportedMechanism
+	}
+	return scramHandler.NewScramConversation(mechanism)
+}
+
+// VerifyScram implements ScramVerifier.
+func (a *reauthPasswordAuthenticator) VerifyScram(conv *auth.ScramConversation) (bool, int32, error) {
+	ctx, err := conv.Context()
+	if err != nil {
+		return scramFailure(err)
+	}
+	return a.samePrincipal(ctx)
+}
@@ -0,0 +1,229 @@
+package proxy
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/auth"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+// fakeProduceBroker answers Produce v3 requests and reports the (physical)
+// topic name of every produce it receives.
+func fakeProduceBroker(t *testing.T) (string, <-chan string) {
+	produced := make(chan string, 10)
+	addr := fakeBroker(t, func(apiKey int16, req []byte) []byte {
+		if apiKey != 0 {
+			return nil
+		}
+		off := 8
+		off += 2 + int(binary.BigEndian.Uint16(req[off:])) // client_id
+		off += 2 + 2 + 4 + 4                               // transactional_id(null), acks, timeout, topic_data length
+		nameLen := int(binary.BigEndian.Uint16(req[off:]))
+		topic := string(req[off+2 : off+2+nameLen])
+		produced <- topic
+
+		var resp []byte
+		resp = append(resp, 0, 0, 0, 1) // responses
+		resp = appendString(resp, topic)
+		resp = append(resp, 0, 0, 0, 1)             // partition_responses
+		resp = append(resp, 0, 0, 0, 0)             // index
+		resp = append(resp, 0, 0)                   // error_code
+		resp = append(resp, 0, 0, 0, 0, 0, 0, 0, 5) // base_offset
+		resp = append(resp, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff)
+		return append(resp, 0, 0, 0, 0) // throttle_time_ms
+	})
+	return addr, produced
+}
+
+// saslAuthenticate performs a SaslHandshake v1 + SaslAuthenticate v1 exchange
+// and returns the SaslAuthenticate response.
+func saslAuthenticate(t *testing.T, conn net.Conn, correlationID int32, username, password string) *protocol.SaslAuthenticateResponseV1 {
+	t.Helper()
+	handshake, err := protocol.Encode(&protocol.Request{
+		CorrelationID: correlationID,
+		ClientID:      "test-client",
+		Body:          &protocol.SaslHandshakeRequestV0orV1{Version: 1, Mechanism: SASLPlain},
+	})
+	require.NoError(t, err)
+	require.NoError(t, writeFrame(conn, handshake))
+
+	resp, err := readFrame(conn)
+	require.NoError(t, err)
+	handshakeResp := &protocol.SaslHandshakeResponseV0orV1{}
+	require.NoError(t, protocol.Decode(resp[4:], handshakeResp))
+	require.Equal(t, protocol.ErrNoError, handshakeResp.Err)
+
+	authenticate, err := protocol.Encode(&protocol.Request{
+		CorrelationID: correlationID + 1,
+		ClientID:      "test-client",
+		Body:          &protocol.SaslAuthenticateRequestV1{SaslAuthBytes: []byte("\x00" + username + "\x00" + password)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, writeFrame(conn, authenticate))
+
+	resp, err = readFrame(conn)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(correlationID+1), binary.BigEndian.Uint32(resp[:4]))
+	authResp := &protocol.SaslAuthenticateResponseV1{}
+	require.NoError(t, protocol.Decode(resp[4:], authResp))
+	return authResp
+}
+
+// produce sends a Produce v3 request for topic and returns the raw response body
+func produce(conn net.Conn, correlationID int32, topic string) ([]byte, error) {
+	var req []byte
+	req = append(req, 0, 0, 0, 3) // api key, api version
+	req = binary.BigEndian.AppendUint32(req, uint32(correlationID))
+	req = appendString(req, "test-client")
+	req = append(req, 0xff, 0xff)       // transactional_id: null
+	req = append(req, 0, 1)             // acks
+	req = append(req, 0, 0, 0x75, 0x30) // timeout_ms
+	req = append(req, 0, 0, 0, 1)       // topic_data
+	req = appendString(req, topic)
+	req = append(req, 0, 0, 0, 1) // partition_data
+	req = append(req, 0, 0, 0, 0) // index
+	req = append(req, 0, 0, 0, 3)
+	req = append(req, "abc"...)
+
+	if err := writeFrame(conn, req); err != nil {
+		return nil, err
+	}
+	return readFrame(conn)
+}
+
+func TestBifrostProxy_ReauthenticateMidSession(t *testing.T) {
+	brokerAddr, produced := fakeProduceBroker(t)
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, time.Minute)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Equal(t, protocol.ErrNoError, authResp.Err)
+	assert.Equal(t, int64(60000), authResp.SessionLifetimeMs)
+
+	resp, err := produce(conn, 3, "orders")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), binary.BigEndian.Uint32(resp[:4]))
+	assert.Equal(t, "tenant-a:orders", <-produced)
+
+	// Re-authenticate on the same connection; it must be answered by Bifrost
+	// rather than forwarded to the broker
+	authResp = saslAuthenticate(t, conn, 4, "alice", "secret")
+	require.Equal(t, protocol.ErrNoError, authResp.Err)
+	assert.Equal(t, int64(60000), authResp.SessionLifetimeMs)
+
+	resp, err = produce(conn, 6, "orders")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(6), binary.BigEndian.Uint32(resp[:4]))
+	assert.Equal(t, "tenant-a:orders", <-produced)
+}
+
+func TestBifrostProxy_ExpiredSessionClosesConnection(t *testing.T) {
+	brokerAddr, produced := fakeProduceBroker(t)
+	proxyAddr := newBrokerBackedTestProxy(t, brokerAddr, 200*time.Millisecond)
+
+	conn, err := net.Dial("tcp", proxyAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	authResp := saslAuthenticate(t, conn, 1, "alice", "secret")
+	require.Equal(t, protocol.ErrNoError, authResp.Err)
+
+	_, err = produce(conn, 3, "orders")
+	require.NoError(t, err)
+	<-produced
+
+	time.Sleep(300 * time.Millisecond)
+
+	_, err = produce(conn, 4, "orders")
+	assert.Error(t, err, "connection should be closed once the session expires")
+}
+
+func TestReauthPasswordAuthenticator_RejectsPrincipalChange(t *testing.T) {
+	original := &auth.ConnectionContext{CredentialID: "cred-1", VirtualClusterID: "vc-1"}
+	handler := &mockSASLHandler{ctx: original}
+	authenticator := &reauthPasswordAuthenticator{handler: handler, original: original}
+
+	ok, _, err := authenticator.Authenticate("alice", "secret")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	handler.ctx = &auth.ConnectionContext{CredentialID: "cred-2", VirtualClusterID: "vc-2"}
+	ok, status, err := authenticator.Authenticate("bob", "secret")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, int32(4), status)
+
+	handler.err = auth.ErrAuthFailed
+	ok, status, err = authenticator.Authenticate("alice", "revoked")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, int32(1), status)
+}
+
+func TestSaslReauthenticator_ReleasesWriteLockWhileWaitingForClient(t *testing.T) {
+	original := &auth.ConnectionContext{CredentialID: "cred-1", VirtualClusterID: "vc-1"}
+	r := NewSaslReauthenticator(&mockSASLHandler{ctx: original}, original, 5*time.Second, time.Minute, nil)
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	handshake, err := protocol.Encode(&protocol.Request{
+		CorrelationID: 7,
+		ClientID:      "test-client",
+		Body:          &protocol.SaslHandshakeRequestV0orV1{Version: 1, Mechanism: SASLPlain},
+	})
+	require.NoError(t, err)
+	frame := binary.BigEndian.AppendUint32(nil, uint32(len(handshake)))
+	frame = append(frame, handshake...)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.reauthenticate(server, frame[:8], 1)
+	}()
+	_, err = client.Write(frame[8:])
+	require.NoError(t, err)
+
+	resp, err := readFrame(client)
+	require.NoError(t, err)
+	handshakeResp := &protocol.SaslHandshakeResponseV0orV1{}
+	require.NoError(t, protocol.Decode(resp[4:], handshakeResp))
+	require.Equal(t, protocol.ErrNoError, handshakeResp.Err)
+
+	// The proxy is now waiting for SaslAuthenticate; broker responses must
+	// still be able to reach the client in the meantime
+	assert.Eventually(t, func() bool {
+		if !r.writeMu.TryLock() {
+			return false
+		}
+		r.writeMu.Unlock()
+		return true
+	}, time.Second, 10*time.Millisecond)
+
+	authenticate, err := protocol.Encode(&protocol.Request{
+		CorrelationID: 8,
+		ClientID:      "test-client",
+		Body:          &protocol.SaslAuthenticateRequestV1{SaslAuthBytes: []byte("\x00alice\x00secret")},
+	})
+	require.NoError(t, err)
+	require.NoError(t, writeFrame(client, authenticate))
+
+	resp, err = readFrame(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), binary.BigEndian.Uint32(resp[:4]))
+	authResp := &protocol.SaslAuthenticateResponseV1{}
+	require.NoError(t, protocol.Decode(resp[4:], authResp))
+	assert.Equal(t, protocol.ErrNoError, authResp.Err)
+	require.NoError(t, <-done)
+}
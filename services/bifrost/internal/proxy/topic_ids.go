@@ -0,0 +1,105 @@
+// services/bifrost/internal/proxy/topic_ids.go
+package proxy
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// maxTopicIDsPerCluster bounds the topic IDs a TopicIDRegistry keeps per
+// upstream cluster. Evicting an ID is safe: Fetch responses for it are
+// withheld until the client's next Metadata request records it again.
+const maxTopicIDsPerCluster = 65536
+
+// TopicIDRegistry maps topic IDs to the physical (prefixed) topic names seen
+// in Metadata responses, per upstream cluster. Fetch v13+ responses identify
+// topics only by ID, so this is how they are attributed to a tenant. It is
+// shared by every connection because clients commonly fetch metadata on one
+// connection and fetch records on another.
+type TopicIDRegistry struct {
+	mu            sync.RWMutex
+	clusters      map[string]*clusterTopicIDs
+	maxPerCluster int
+}
+
+// clusterTopicIDs holds the topic IDs of one upstream cluster, indexed both
+// ways so a topic recreated under a new ID replaces its old one.
+type clusterTopicIDs struct {
+	names map[uuid.UUID]string
+	ids   map[string]uuid.UUID
+}
+
+// NewTopicIDRegistry creates an empty topic ID registry.
+func NewTopicIDRegistry() *TopicIDRegistry {
+	return &TopicIDRegistry{
+		clusters:      make(map[string]*clusterTopicIDs),
+		maxPerCluster: maxTopicIDsPerCluster,
+	}
+}
+
+// Record stores the physical topic name for a topic ID on an upstream cluster.
+// An ID previously recorded for the same topic belonged to a deleted topic and
+// is dropped.
+func (r *TopicIDRegistry) Record(cluster string, topicID uuid.UUID, topic string) {
+	r.mu.RLock()
+	current, ok := r.lookup(cluster, topicID)
+	r.mu.RUnlock()
+	if ok && current == topic {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.clusters[cluster]
+	if !ok {
+		c = &clusterTopicIDs{names: make(map[uuid.UUID]string), ids: make(map[string]uuid.UUID)}
+		r.clusters[cluster] = c
+	}
+	if oldID, ok := c.ids[topic]; ok {
+		delete(c.names, oldID)
+	}
+	if oldName, ok := c.names[topicID]; ok {
+		delete(c.ids, oldName)
+	}
+	for id := range c.names {
+		if len(c.names) < r.maxPerCluster {
+			break
+		}
+		delete(c.ids, c.names[id])
+		delete(c.names, id)
+	}
+	c.names[topicID] = topic
+	c.ids[topic] = topicID
+}
+
+// Forget drops a topic, for example after it was deleted.
+func (r *TopicIDRegistry) Forget(cluster string, topic string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.clusters[cluster]
+	if !ok {
+		return
+	}
+	if id, ok := c.ids[topic]; ok {
+		delete(c.names, id)
+		delete(c.ids, topic)
+	}
+}
+
+// Lookup returns the physical topic name for a topic ID on an upstream
+// cluster, or false if it has not been seen.
+func (r *TopicIDRegistry) Lookup(cluster string, topicID uuid.UUID) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lookup(cluster, topicID)
+}
+
+func (r *TopicIDRegistry) lookup(cluster string, topicID uuid.UUID) (string, bool) {
+	c, ok := r.clusters[cluster]
+	if !ok {
+		return "", false
+	}
+	topic, ok := c.names[topicID]
+	return topic, ok
+}
@@ -0,0 +1,49 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicIDRegistry(t *testing.T) {
+	r := NewTopicIDRegistry()
+	ordersID := uuid.New()
+	r.Record("cluster-a", ordersID, "tenant-a:orders")
+
+	topic, ok := r.Lookup("cluster-a", ordersID)
+	assert.True(t, ok)
+	assert.Equal(t, "tenant-a:orders", topic)
+	_, ok = r.Lookup("cluster-b", ordersID)
+	assert.False(t, ok, "topic IDs are per cluster")
+
+	// A recreated topic replaces the old ID
+	recreatedID := uuid.New()
+	r.Record("cluster-a", recreatedID, "tenant-a:orders")
+	_, ok = r.Lookup("cluster-a", ordersID)
+	assert.False(t, ok)
+	topic, ok = r.Lookup("cluster-a", recreatedID)
+	assert.True(t, ok)
+	assert.Equal(t, "tenant-a:orders", topic)
+
+	r.Forget("cluster-a", "tenant-a:orders")
+	_, ok = r.Lookup("cluster-a", recreatedID)
+	assert.False(t, ok)
+	r.Forget("cluster-c", "tenant-a:orders")
+}
+
+func TestTopicIDRegistry_BoundedPerCluster(t *testing.T) {
+	r := NewTopicIDRegistry()
+	r.maxPerCluster = 3
+	ids := make([]uuid.UUID, 10)
+	for i := range ids {
+		ids[i] = uuid.New()
+		r.Record("cluster-a", ids[i], uuid.NewString())
+	}
+
+	assert.Len(t, r.clusters["cluster-a"].names, 3)
+	assert.Len(t, r.clusters["cluster-a"].ids, 3)
+	_, ok := r.Lookup("cluster-a", ids[9])
+	assert.True(t, ok, "the newest ID is kept")
+}
@@ -0,0 +1,160 @@
+// services/bifrost/internal/proxy/topic_policy.go
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+const topicPolicyErrorMessage = "topic is not allowed on this virtual cluster"
+
+// TopicPolicy scopes a virtual cluster to a subset of its topics with glob
+// patterns over client-facing (unprefixed) topic names. A topic is allowed if
+// it matches no denied pattern and, when allowed patterns are set, at least
+// one of them.
+type TopicPolicy struct {
+	allowed []string
+	denied  []string
+}
+
+// NewTopicPolicy returns the topic policy configured on a virtual cluster, or
+// nil if it allows every topic.
+func NewTopicPolicy(vc *gatewayv1.VirtualClusterConfig) *TopicPolicy {
+	if len(vc.GetAllowedTopics()) == 0 && len(vc.GetDeniedTopics()) == 0 {
+		return nil
+	}
+	return &TopicPolicy{allowed: vc.GetAllowedTopics(), denied: vc.GetDeniedTopics()}
+}
+
+// Allows reports whether the policy permits access to topic. Malformed
+// patterns fail closed: a bad denied pattern denies every topic and a bad
+// allowed pattern allows none.
+func (p *TopicPolicy) Allows(topic string) bool {
+	for _, pattern := range p.denied {
+		if matched, err := path.Match(pattern, topic); matched || err != nil {
+			return false
+		}
+	}
+	if len(p.allowed) == 0 {
+		return true
+	}
+	for _, pattern := range p.allowed {
+		if matched, err := path.Match(pattern, topic); matched && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// prefetchedRequest replays the part of a request already read from the
+// client before reading the rest from the connection.
+type prefetchedRequest struct {
+	DeadlineReaderWriter
+	body []byte
+}
+
+func (r *prefetchedRequest) Read(p []byte) (int, error) {
+	if len(r.body) > 0 {
+		n := copy(p, r.body)
+		r.body = r.body[n:]
+		return n, nil
+	}
+	return r.DeadlineReaderWriter.Read(p)
+}
+
+// prefetchRequest reads the rest of a request from the client and returns a
+// reader that replays it for forwarding. A request already prefetched, for
+// instance by the topic policy, is not read again.
+func prefetchRequest(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext) (prefetched *prefetchedRequest, readErr bool, err error) {
+	if p, ok := src.(*prefetchedRequest); ok && len(p.body) == int(requestKeyVersion.Length)-4 {
+		return p, false, nil
+	}
+	if requestKeyVersion.Length > protocol.MaxRequestSize {
+		return nil, true, protocol.PacketDecodingError{Info: fmt.Sprintf("request of length %d too large", requestKeyVersion.Length)}
+	}
+
+	if err = src.SetReadDeadline(time.Now().Add(ctx.timeout)); err != nil {
+		return nil, true, err
+	}
+	request := make([]byte, requestKeyVersion.Length-4) // 4 = ApiKey(2) + ApiVersion(2)
+	if _, err = io.ReadFull(src, request); err != nil {
+		return nil, true, err
+	}
+	return &prefetchedRequest{DeadlineReaderWriter: src, body: request}, false, nil
+}
+
+// enforceTopicPolicy reads a Produce, Fetch or Metadata request and checks the
+// topics it names against policy. Topics addressed by ID are resolved with the
+// connection's topic ID resolver, and IDs that do not resolve are denied. If
+// any topic is denied, the request is answered with TOPIC_AUTHORIZATION_FAILED
+// without being forwarded and handled is true. Otherwise the returned reader
+// replays the request for forwarding. A request whose topics cannot be read
+// closes the connection, as it cannot be checked.
+func enforceTopicPolicy(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext, policy *TopicPolicy) (next DeadlineReaderWriter, handled bool, readErr bool, err error) {
+	apiKey, apiVersion := requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion
+	prefetched, readErr, err := prefetchRequest(src, requestKeyVersion, ctx)
+	if err != nil {
+		return nil, false, readErr, err
+	}
+	next, request := prefetched, prefetched.body
+
+	topics, topicIDs, err := protocol.RequestTopics(apiKey, apiVersion, request)
+	if err != nil {
+		return nil, false, true, fmt.Errorf("read topics of request key %d version %d for the topic policy: %w", apiKey, apiVersion, err)
+	}
+	denied := ""
+	for _, topic := range topics {
+		if !policy.Allows(topic) {
+			denied = topic
+			break
+		}
+	}
+	if denied == "" {
+		denied = deniedTopicID(topicIDs, ctx.topicIDResolver, policy)
+	}
+	if denied == "" {
+		return next, false, false, nil
+	}
+
+	if !protocol.CanBuildErrorResponse(apiKey, apiVersion) {
+		return nil, false, true, fmt.Errorf("api key %d version %d addresses topic %q which is not allowed", apiKey, apiVersion, denied)
+	}
+	response, err := protocol.NewErrorResponse(apiKey, apiVersion, request, protocol.ErrTopicAuthorizationFailed, topicPolicyErrorMessage)
+	if err != nil {
+		return nil, false, true, err
+	}
+	logrus.Debugf("Rejected request key=%d version=%d addressing topic %q not allowed by the virtual cluster", apiKey, apiVersion, denied)
+
+	if response != nil {
+		if err = ctx.writeLocalResponse(src, response); err != nil {
+			return nil, true, false, err
+		}
+	}
+	if err = src.SetDeadline(time.Time{}); err != nil {
+		return nil, true, false, err
+	}
+	// handled locally, so no response handler is enqueued
+	return nil, true, false, ctx.putNextRequestHandler(defaultRequestHandler)
+}
+
+// deniedTopicID returns the first of topicIDs that policy denies, or that
+// resolve cannot attribute to a topic, or "" if it allows all of them.
+func deniedTopicID(topicIDs []uuid.UUID, resolve func(topicID uuid.UUID) (string, bool), policy *TopicPolicy) string {
+	for _, topicID := range topicIDs {
+		if resolve == nil {
+			return topicID.String()
+		}
+		if topic, ok := resolve(topicID); !ok || !policy.Allows(topic) {
+			return topicID.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,245 @@
+// services/bifrost/internal/proxy/topic_policy_test.go
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	gatewayv1 "github.com/drewpayment/orbit/proto/gen/go/idp/gateway/v1"
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+func TestTopicPolicy_Allows(t *testing.T) {
+	tt := []struct {
+		name    string
+		allowed []string
+		denied  []string
+		topics  map[string]bool
+	}{
+		{
+			name:    "allow only",
+			allowed: []string{"orders-*", "payments"},
+			topics: map[string]bool{
+				"orders-eu":   true,
+				"payments":    true,
+				"payments-v2": false,
+				"inventory":   false,
+			},
+		},
+		{
+			name:   "deny only",
+			denied: []string{"*-internal", "audit"},
+			topics: map[string]bool{
+				"orders":          true,
+				"orders-internal": false,
+				"audit":           false,
+				"audit-log":       true,
+			},
+		},
+		{
+			name:    "deny takes precedence",
+			allowed: []string{"orders-*"},
+			denied:  []string{"orders-pii-*"},
+			topics: map[string]bool{
+				"orders-eu":     true,
+				"orders-pii-eu": false,
+				"payments":      false,
+			},
+		},
+		{
+			name:    "malformed allowed pattern allows nothing",
+			allowed: []string{"orders-["},
+			topics:  map[string]bool{"orders-[": false, "orders": false},
+		},
+		{
+			name:   "malformed denied pattern denies everything",
+			denied: []string{"orders-["},
+			topics: map[string]bool{"payments": false},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := NewTopicPolicy(&gatewayv1.VirtualClusterConfig{AllowedTopics: tc.allowed, DeniedTopics: tc.denied})
+			require.NotNil(t, policy)
+			for topic, allowed := range tc.topics {
+				assert.Equal(t, allowed, policy.Allows(topic), topic)
+			}
+		})
+	}
+
+	assert.Nil(t, NewTopicPolicy(&gatewayv1.VirtualClusterConfig{}), "no patterns means no policy")
+}
+
+func TestHandleRequest_TopicPolicy(t *testing.T) {
+	policy := NewTopicPolicy(&gatewayv1.VirtualClusterConfig{
+		AllowedTopics: []string{"orders-*"},
+		DeniedTopics:  []string{"orders-pii"},
+	})
+
+	produce := func(topic string) []byte {
+		return kafkaRequest(0, 2, int16(-1), int32(30000), int32(1), topic, int32(1), int32(3), int32(0))
+	}
+	fetch := func(topic string) []byte {
+		return kafkaRequest(1, 4, int32(-1), int32(500), int32(1), int32(1<<20), int8(0),
+			int32(1), topic, int32(1), int32(3), int64(0), int32(1<<20))
+	}
+	metadata := func(topics ...string) []byte {
+		body := []interface{}{int32(len(topics))}
+		for _, topic := range topics {
+			body = append(body, topic)
+		}
+		return kafkaRequest(3, 1, body...)
+	}
+
+	tt := []struct {
+		name    string
+		request []byte
+		allowed bool
+	}{
+		{name: "Produce allowed", request: produce("orders-eu"), allowed: true},
+		{name: "Produce not allowed", request: produce("payments")},
+		{name: "Produce denied", request: produce("orders-pii")},
+		{name: "Fetch allowed", request: fetch("orders-eu"), allowed: true},
+		{name: "Fetch denied", request: fetch("orders-pii")},
+		{name: "Metadata allowed", request: metadata("orders-eu", "orders-us"), allowed: true},
+		{name: "Metadata with one denied topic", request: metadata("orders-eu", "orders-pii")},
+		{name: "Metadata for all topics", request: kafkaRequest(3, 1, int32(-1)), allowed: true},
+		{name: "other API keys are not checked", request: kafkaRequest(19, 1, int32(0), int32(30000), false), allowed: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newReadOnlyTestContext(false)
+			ctx.topicPolicy = func() *TopicPolicy { return policy }
+			client := &readOnlyTestClient{reader: bytes.NewBuffer(tc.request)}
+			broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+			_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+			require.NoError(t, err)
+
+			if tc.allowed {
+				assert.Equal(t, tc.request, broker.Bytes(), "request should be forwarded unchanged")
+				assert.Zero(t, client.written.Len())
+				return
+			}
+			assert.Zero(t, broker.Len(), "request must not reach the broker")
+			response := client.written.Bytes()
+			require.GreaterOrEqual(t, len(response), 8)
+			assert.Equal(t, uint32(len(response)-4), binary.BigEndian.Uint32(response[0:4]), "size prefix")
+			assert.Equal(t, uint32(42), binary.BigEndian.Uint32(response[4:8]), "correlation id")
+			assert.Empty(t, ctx.openRequestsChannel, "no broker response is awaited")
+		})
+	}
+}
+
+func TestHandleRequest_TopicPolicy_FetchError(t *testing.T) {
+	ctx := newReadOnlyTestContext(false)
+	ctx.topicPolicy = func() *TopicPolicy {
+		return NewTopicPolicy(&gatewayv1.VirtualClusterConfig{DeniedTopics: []string{"payments"}})
+	}
+	request := kafkaRequest(1, 4, int32(-1), int32(500), int32(1), int32(1<<20), int8(0),
+		int32(1), "payments", int32(1), int32(3), int64(0), int32(1<<20))
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+
+	// size, correlation id, throttle_time_ms, responses[1]{"payments", partitions[1]{3, TOPIC_AUTHORIZATION_FAILED, -1, -1, ...}}
+	body := client.written.Bytes()[8:]
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(body[4:8]))
+	assert.Equal(t, "payments", string(body[10:18]))
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(body[18:22]))
+	assert.Equal(t, uint32(3), binary.BigEndian.Uint32(body[22:26]), "partition index")
+	assert.Equal(t, uint16(protocol.ErrTopicAuthorizationFailed), binary.BigEndian.Uint16(body[26:28]))
+	assert.Equal(t, int64(-1), int64(binary.BigEndian.Uint64(body[28:36])), "high watermark")
+}
+
+func TestHandleRequest_TopicPolicyRemoved(t *testing.T) {
+	ctx := newReadOnlyTestContext(false)
+	ctx.topicPolicy = func() *TopicPolicy { return nil }
+	request := kafkaRequest(0, 2, int16(-1), int32(30000), int32(1), "payments", int32(1), int32(3), int32(0))
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, request, broker.Bytes())
+}
+
+func TestHandleRequest_TopicPolicy_TopicIDs(t *testing.T) {
+	policy := NewTopicPolicy(&gatewayv1.VirtualClusterConfig{DeniedTopics: []string{"payments"}})
+	ordersID := uuid.UUID{1}
+
+	tt := []struct {
+		name     string
+		resolver func(topicID uuid.UUID) (string, bool)
+		allowed  bool
+	}{
+		{name: "allowed", resolver: func(uuid.UUID) (string, bool) { return "orders", true }, allowed: true},
+		{name: "denied", resolver: func(uuid.UUID) (string, bool) { return "payments", true }},
+		{name: "unresolved", resolver: func(uuid.UUID) (string, bool) { return "", false }},
+		{name: "no resolver"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newReadOnlyTestContext(false)
+			ctx.topicPolicy = func() *TopicPolicy { return policy }
+			var resolved []uuid.UUID
+			if tc.resolver != nil {
+				ctx.topicIDResolver = func(topicID uuid.UUID) (string, bool) {
+					resolved = append(resolved, topicID)
+					return tc.resolver(topicID)
+				}
+			}
+			request := fetchRequestFrame(13)
+			client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+			broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+			_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+			require.NoError(t, err)
+			if tc.resolver != nil {
+				assert.Equal(t, []uuid.UUID{ordersID}, resolved)
+			}
+
+			if tc.allowed {
+				assert.Equal(t, request, broker.Bytes(), "request should be forwarded unchanged")
+				assert.Zero(t, client.written.Len())
+				return
+			}
+			assert.Zero(t, broker.Len(), "request must not reach the broker")
+			response := client.written.Bytes()
+			require.Greater(t, len(response), 9)
+			resp := kmsg.NewPtrFetchResponse()
+			resp.Version = 13
+			require.NoError(t, resp.ReadFrom(response[9:]))
+			require.Len(t, resp.Topics, 1)
+			require.Len(t, resp.Topics[0].Partitions, 1)
+			assert.Equal(t, int16(protocol.ErrTopicAuthorizationFailed), resp.Topics[0].Partitions[0].ErrorCode)
+		})
+	}
+}
+
+func TestHandleRequest_TopicPolicy_UndecodableRequest(t *testing.T) {
+	ctx := newReadOnlyTestContext(false)
+	ctx.topicPolicy = func() *TopicPolicy {
+		return NewTopicPolicy(&gatewayv1.VirtualClusterConfig{DeniedTopics: []string{"payments"}})
+	}
+	// A Produce request cut off after its topic count
+	request := kafkaRequest(0, 2, int16(-1), int32(30000), int32(1))
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	readErr, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	assert.Error(t, err, "the connection is closed")
+	assert.True(t, readErr)
+	assert.Zero(t, broker.Len(), "request must not reach the broker")
+	assert.Zero(t, client.written.Len())
+}
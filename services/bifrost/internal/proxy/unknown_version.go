@@ -0,0 +1,72 @@
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+// UnknownVersionPolicy decides what happens to requests of an API version
+// Bifrost has no schema for, typically from clients that ignore the versions
+// advertised in ApiVersions.
+type UnknownVersionPolicy int
+
+const (
+	// UnknownVersionForward forwards such requests and their responses
+	// without rewriting them, logging a warning, but only on passthrough
+	// connections (virtual clusters without prefixes). The topic policy and
+	// produce quotas cannot be applied to them. Rewriting connections reject
+	// such requests as under UnknownVersionReject, since forwarding them
+	// unprefixed would reach other tenants' topics and groups.
+	UnknownVersionForward UnknownVersionPolicy = iota
+	// UnknownVersionReject answers such requests with UNSUPPORTED_VERSION
+	// instead of forwarding them. Versions too new to answer close the
+	// connection, as a broker would.
+	UnknownVersionReject
+)
+
+const unsupportedVersionErrorMessage = "request version is not supported by the gateway"
+
+// unknownVersionPolicies maps the policies accepted by
+// ParseUnknownVersionPolicy to their UnknownVersionPolicy.
+var unknownVersionPolicies = map[string]UnknownVersionPolicy{
+	"forward": UnknownVersionForward,
+	"reject":  UnknownVersionReject,
+}
+
+// ParseUnknownVersionPolicy parses an unknown version policy: "forward" or
+// "reject". An empty policy is "reject".
+func ParseUnknownVersionPolicy(policy string) (UnknownVersionPolicy, error) {
+	if policy == "" {
+		return UnknownVersionReject, nil
+	}
+	p, ok := unknownVersionPolicies[policy]
+	if !ok {
+		return UnknownVersionReject, fmt.Errorf("unknown version policy %q", policy)
+	}
+	return p, nil
+}
+
+// handleUnknownVersion applies the connection's unknown version policy to a
+// request Bifrost has no schema for. handled reports that the request was
+// answered locally and must not be forwarded.
+func handleUnknownVersion(src DeadlineReaderWriter, requestKeyVersion *protocol.RequestKeyVersion, ctx *RequestsLoopContext) (handled bool, readErr bool, err error) {
+	apiKey, apiVersion := requestKeyVersion.ApiKey, requestKeyVersion.ApiVersion
+	proxyUnknownVersionRequestsTotal.WithLabelValues(ctx.brokerAddress, strconv.Itoa(int(apiKey)), strconv.Itoa(int(apiVersion))).Inc()
+
+	if ctx.unknownVersionPolicy == UnknownVersionForward {
+		// Only connections that rewrite nothing can be forwarded to safely
+		if ctx.requestModifierConfig == nil {
+			logrus.Warnf("No schema for request key=%d version=%d, forwarding without rewriting", apiKey, apiVersion)
+			return false, false, nil
+		}
+		logrus.Warnf("No schema for request key=%d version=%d on a rewriting connection, rejecting", apiKey, apiVersion)
+	}
+	readErr, err = answerRequest(src, requestKeyVersion, ctx, unsupportedVersionErrorMessage, func(request []byte) ([]byte, error) {
+		return protocol.NewUnsupportedVersionResponse(apiKey, apiVersion, request)
+	})
+	return true, readErr, err
+}
@@ -0,0 +1,141 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"github.com/drewpayment/orbit/services/bifrost/internal/proxy/protocol"
+)
+
+// fetchRequestFrame encodes a Fetch request of the given version for topic
+// "orders", correlation id 42. Fetch v17 is newer than Bifrost's schemas.
+func fetchRequestFrame(version int16) []byte {
+	req := kmsg.NewPtrFetchRequest()
+	req.Version = version
+	topic := kmsg.NewFetchRequestTopic()
+	topic.Topic = "orders"
+	topic.TopicID = [16]byte{1}
+	topic.Partitions = append(topic.Partitions, kmsg.NewFetchRequestTopicPartition())
+	req.Topics = append(req.Topics, topic)
+
+	frame := binary.BigEndian.AppendUint16(nil, uint16(req.Key()))
+	frame = binary.BigEndian.AppendUint16(frame, uint16(version))
+	frame = binary.BigEndian.AppendUint32(frame, 42)
+	frame = appendString(frame, "test-client")
+	frame = append(frame, 0) // header tagged fields
+	frame = req.AppendTo(frame)
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(frame))), frame...)
+}
+
+func newUnknownVersionTestContext(policy UnknownVersionPolicy) (*RequestsLoopContext, chan protocol.RequestKeyVersion) {
+	ctx := newReadOnlyTestContext(false)
+	openRequests := make(chan protocol.RequestKeyVersion, 1)
+	ctx.openRequestsChannel = openRequests
+	ctx.unknownVersionPolicy = policy
+	ctx.requestModifierConfig = &protocol.RequestModifierConfig{
+		TopicPrefixer: func(topic string) string { return "tenant-a:" + topic },
+	}
+	return ctx, openRequests
+}
+
+func TestParseUnknownVersionPolicy(t *testing.T) {
+	for policy, want := range map[string]UnknownVersionPolicy{
+		"":        UnknownVersionReject,
+		"reject":  UnknownVersionReject,
+		"forward": UnknownVersionForward,
+	} {
+		got, err := ParseUnknownVersionPolicy(policy)
+		require.NoError(t, err, policy)
+		assert.Equal(t, want, got, policy)
+	}
+	_, err := ParseUnknownVersionPolicy("drop")
+	assert.Error(t, err)
+}
+
+func TestHandleRequest_UnknownVersionForward(t *testing.T) {
+	ctx, openRequests := newUnknownVersionTestContext(UnknownVersionForward)
+	ctx.requestModifierConfig = nil // passthrough connection
+	unknownVersions := proxyUnknownVersionRequestsTotal.WithLabelValues("", "1", "17")
+	before := testutil.ToFloat64(unknownVersions)
+	request := fetchRequestFrame(17)
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(request)}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+	assert.Equal(t, request, broker.Bytes(), "request should be forwarded without prefixing")
+	assert.Zero(t, client.written.Len())
+	require.Len(t, openRequests, 1)
+	assert.Equal(t, before+1, testutil.ToFloat64(unknownVersions))
+
+	// The broker's response is passed back without rewriting
+	response := []byte{0, 0, 0, 9, 0, 0, 0, 42, 0, 1, 2, 3, 4}
+	responseCtx := &ResponsesLoopContext{
+		openRequestsChannel: openRequests,
+		timeout:             time.Second,
+		buf:                 make([]byte, 1024),
+		responseModifierConfig: &protocol.ResponseModifierConfig{
+			TopicUnprefixer: func(topic string) string { return topic },
+		},
+	}
+	out := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+	_, err = defaultResponseHandler.handleResponse(out, &TestDeadlineReader{Buffer: bytes.NewBuffer(response)}, responseCtx)
+	require.NoError(t, err)
+	assert.Equal(t, response, out.Bytes())
+}
+
+func TestHandleRequest_UnknownVersionReject(t *testing.T) {
+	for name, policy := range map[string]UnknownVersionPolicy{
+		"reject policy": UnknownVersionReject,
+		// Forwarding would skip prefixing on a rewriting connection
+		"forward policy on a rewriting connection": UnknownVersionForward,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testUnknownVersionRejected(t, policy)
+		})
+	}
+}
+
+func testUnknownVersionRejected(t *testing.T, policy UnknownVersionPolicy) {
+	ctx, openRequests := newUnknownVersionTestContext(policy)
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(fetchRequestFrame(17))}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	require.NoError(t, err)
+	assert.Zero(t, broker.Len(), "request must not reach the broker")
+	assert.Empty(t, openRequests, "no broker response is awaited")
+	assert.Len(t, ctx.nextRequestHandlerChannel, 1)
+
+	response := client.written.Bytes()
+	require.GreaterOrEqual(t, len(response), 9)
+	assert.Equal(t, uint32(len(response)-4), binary.BigEndian.Uint32(response[0:4]), "size prefix")
+	assert.Equal(t, uint32(42), binary.BigEndian.Uint32(response[4:8]), "correlation id")
+	resp := kmsg.NewPtrFetchResponse()
+	resp.Version = 17
+	require.NoError(t, resp.ReadFrom(response[9:]))
+	assert.Equal(t, int16(protocol.ErrUnsupportedVersion), resp.ErrorCode)
+	require.Len(t, resp.Topics, 1)
+	require.Len(t, resp.Topics[0].Partitions, 1)
+	assert.Equal(t, int16(protocol.ErrUnsupportedVersion), resp.Topics[0].Partitions[0].ErrorCode)
+}
+
+func TestHandleRequest_UnknownVersionRejectTooNew(t *testing.T) {
+	// Versions too new to answer close the connection, as a broker would
+	ctx, openRequests := newUnknownVersionTestContext(UnknownVersionReject)
+	client := &readOnlyTestClient{reader: bytes.NewBuffer(kafkaRequest(1, 99))}
+	broker := &TestDeadlineWriter{Buffer: new(bytes.Buffer)}
+
+	_, err := defaultRequestHandler.handleRequest(broker, client, ctx)
+	assert.Error(t, err)
+	assert.Zero(t, broker.Len(), "request must not reach the broker")
+	assert.Zero(t, client.written.Len())
+	assert.Empty(t, openRequests)
+}
@@ -4,13 +4,18 @@ import (
 	"log"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	buildv1 "github.com/drewpayment/orbit/proto/gen/go/idp/build/v1"
 	"github.com/drewpayment/orbit/services/build-service/internal/grpc/build"
+	"github.com/drewpayment/orbit/services/build-service/internal/grpc/interceptors"
 )
 
 func main() {
@@ -26,15 +31,47 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	metricsPort := os.Getenv("BUILD_SERVICE_METRICS_PORT")
+	if metricsPort == "" {
+		metricsPort = "9090"
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	metrics := interceptors.NewMetrics(registry)
+
+	// Recovery runs innermost so a panic is logged and counted as an
+	// Internal error like any other failed request
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.UnaryLogging(logger),
+			metrics.UnaryServerInterceptor(),
+			interceptors.UnaryRecovery(logger),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.StreamLogging(logger),
+			metrics.StreamServerInterceptor(),
+			interceptors.StreamRecovery(logger),
+		),
+	)
 
 	// Create and register build service
 	buildService := build.NewBuildServer(logger)
 	buildv1.RegisterBuildServiceServer(grpcServer, buildService)
+	registry.MustRegister(buildService.Collectors()...)
 
 	// Enable reflection for grpcurl/grpcui
 	reflection.Register(grpcServer)
 
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	go func() {
+		logger.Info("Metrics server starting", "port", metricsPort)
+		if err := http.ListenAndServe(":"+metricsPort, mux); err != nil {
+			logger.Error("Metrics server failed", "error", err)
+		}
+	}()
+
 	logger.Info("Build service starting", "port", port)
 
 	if err := grpcServer.Serve(lis); err != nil {
@@ -40,13 +40,15 @@ type BuildRequest struct {
 	StartCommand      string
 	BuildEnv          map[string]string
 	Registry          RegistryConfig
-	ImageTag          string // Optional - auto-generated if empty
-	PackageManager    string // "npm", "yarn", "pnpm", "bun", or "" for auto
+	ImageTag          string  // Optional - auto-generated if empty
+	PackageManager    string  // "npm", "yarn", "pnpm", "bun", or "" for auto
+	Logs              LogSink // Optional - receives build output as it is produced
 }
 
 // BuildResult contains the results of a build
 type BuildResult struct {
 	Success     bool
+	Cancelled   bool
 	ImageURL    string
 	ImageDigest string
 	Error       string
@@ -78,7 +80,8 @@ func NewBuilder(logger *slog.Logger, workDir string) *Builder {
 	}
 }
 
-// Build builds and pushes a container image
+// Build builds and pushes a container image. Cancelling ctx kills the running
+// subprocess and the result is reported as cancelled.
 func (b *Builder) Build(ctx context.Context, req *BuildRequest) (*BuildResult, error) {
 	// Validate input
 	if err := b.validateRequest(req); err != nil {
@@ -96,9 +99,7 @@ func (b *Builder) Build(ctx context.Context, req *BuildRequest) (*BuildResult, e
 	// Step 1: Clone repository
 	result.Steps = append(result.Steps, BuildStep{Name: "clone", Status: "running"})
 	if err := b.cloneRepo(ctx, req, buildDir); err != nil {
-		result.Steps[len(result.Steps)-1].Status = "failed"
-		result.Steps[len(result.Steps)-1].Message = err.Error()
-		result.Error = fmt.Sprintf("failed to clone repository: %v", err)
+		failStep(ctx, req, result, "failed to clone repository", err)
 		return result, nil
 	}
 	result.Steps[len(result.Steps)-1].Status = "completed"
@@ -108,9 +109,7 @@ func (b *Builder) Build(ctx context.Context, req *BuildRequest) (*BuildResult, e
 	imageURL := generateImageTag(req)
 	digest, err := b.buildImage(ctx, req, buildDir, imageURL)
 	if err != nil {
-		result.Steps[len(result.Steps)-1].Status = "failed"
-		result.Steps[len(result.Steps)-1].Message = err.Error()
-		result.Error = fmt.Sprintf("failed to build image: %v", err)
+		failStep(ctx, req, result, "failed to build image", err)
 		return result, nil
 	}
 	result.Steps[len(result.Steps)-1].Status = "completed"
@@ -118,12 +117,11 @@ func (b *Builder) Build(ctx context.Context, req *BuildRequest) (*BuildResult, e
 	// Step 3: Push image to registry
 	result.Steps = append(result.Steps, BuildStep{Name: "push", Status: "running"})
 	if err := b.pushImage(ctx, req, imageURL); err != nil {
-		result.Steps[len(result.Steps)-1].Status = "failed"
-		result.Steps[len(result.Steps)-1].Message = err.Error()
-		result.Error = fmt.Sprintf("failed to push image: %v", err)
+		failStep(ctx, req, result, "failed to push image", err)
 		return result, nil
 	}
 	result.Steps[len(result.Steps)-1].Status = "completed"
+	req.log("push", LogLevelInfo, "Pushed "+imageURL)
 
 	result.Success = true
 	result.ImageURL = imageURL
@@ -132,6 +130,23 @@ func (b *Builder) Build(ctx context.Context, req *BuildRequest) (*BuildResult, e
 	return result, nil
 }
 
+// failStep marks the running step as failed, or as cancelled when ctx was
+// cancelled, since the step's error is then just the killed subprocess
+func failStep(ctx context.Context, req *BuildRequest, result *BuildResult, action string, err error) {
+	step := &result.Steps[len(result.Steps)-1]
+	if ctx.Err() != nil {
+		step.Status = "cancelled"
+		step.Message = ctx.Err().Error()
+		result.Cancelled = true
+		result.Error = "build cancelled"
+	} else {
+		step.Status = "failed"
+		step.Message = err.Error()
+		result.Error = fmt.Sprintf("%s: %v", action, err)
+	}
+	req.log(step.Name, LogLevelError, result.Error)
+}
+
 func (b *Builder) validateRequest(req *BuildRequest) error {
 	if req.RequestID == "" {
 		return fmt.Errorf("request_id is required")
@@ -150,6 +165,7 @@ func (b *Builder) validateRequest(req *BuildRequest) error {
 
 func (b *Builder) cloneRepo(ctx context.Context, req *BuildRequest, buildDir string) error {
 	b.logger.Info("Cloning repository", "url", req.RepoURL, "ref", req.Ref)
+	req.log("clone", LogLevelInfo, fmt.Sprintf("Cloning %s", req.RepoURL))
 
 	// Determine clone URL - embed credentials for private repos
 	cloneURL := req.RepoURL
@@ -176,6 +192,7 @@ func (b *Builder) cloneRepo(ctx context.Context, req *BuildRequest, buildDir str
 	}
 
 	b.logger.Info("Repository cloned successfully")
+	req.log("clone", LogLevelInfo, "Repository cloned")
 	return nil
 }
 
@@ -213,9 +230,8 @@ func (b *Builder) buildImage(ctx context.Context, req *BuildRequest, buildDir, i
 		cmd.Env = append(cmd.Env, fmt.Sprintf("RAILPACK_PACKAGE_MANAGER=%s", req.PackageManager))
 	}
 
-	output, err := cmd.CombinedOutput()
+	outputStr, err := runStreamed(cmd, req, "build")
 	if err != nil {
-		outputStr := string(output)
 		b.logger.Error("Railpack build failed", "error", err, "output", outputStr)
 		return "", fmt.Errorf("railpack build failed: %s", extractBuildErrorSummary(outputStr))
 	}
@@ -233,9 +249,8 @@ func (b *Builder) buildWithDocker(ctx context.Context, req *BuildRequest, buildD
 	}
 
 	cmd := exec.CommandContext(ctx, "docker", "build", "-t", imageURL, buildDir)
-	output, err := cmd.CombinedOutput()
+	outputStr, err := runStreamed(cmd, req, "build")
 	if err != nil {
-		outputStr := string(output)
 		b.logger.Error("Docker build failed", "error", err, "output", outputStr)
 		// Include relevant parts of output in error for frontend parsing
 		return "", fmt.Errorf("docker build failed: %s", extractBuildErrorSummary(outputStr))
@@ -258,9 +273,9 @@ func (b *Builder) pushImage(ctx context.Context, req *BuildRequest, imageURL str
 
 	// Push image
 	cmd := exec.CommandContext(ctx, "docker", "push", imageURL)
-	output, err := cmd.CombinedOutput()
+	output, err := runStreamed(cmd, req, "push")
 	if err != nil {
-		b.logger.Error("Docker push failed", "error", err, "output", string(output))
+		b.logger.Error("Docker push failed", "error", err, "output", output)
 		return fmt.Errorf("docker push failed: %w", err)
 	}
 
@@ -3,8 +3,10 @@ package builder
 import (
 	"context"
 	"log/slog"
+	"os/exec"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -404,3 +406,26 @@ npm ERR! Missing script: "build"`,
 		})
 	}
 }
+
+type recordedLine struct{ step, level, message string }
+
+type recordingSink struct{ lines []recordedLine }
+
+func (s *recordingSink) Log(step, level, message string) {
+	s.lines = append(s.lines, recordedLine{step, level, message})
+}
+
+func TestRunStreamed_ForwardsOutputLines(t *testing.T) {
+	sink := &recordingSink{}
+	req := &BuildRequest{Logs: sink}
+
+	output, err := runStreamed(exec.Command("sh", "-c", "echo first; echo second >&2; printf 'no newline'"), req, "build")
+
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\nno newline", output)
+	assert.Equal(t, []recordedLine{
+		{"build", LogLevelInfo, "first"},
+		{"build", LogLevelInfo, "second"},
+		{"build", LogLevelInfo, "no newline"},
+	}, sink.lines)
+}
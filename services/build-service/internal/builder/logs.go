@@ -0,0 +1,70 @@
+package builder
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// LogSink receives build output line by line as it is produced
+type LogSink interface {
+	Log(step, level, message string)
+}
+
+// Log levels used for build output
+const (
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// log forwards a line to the request's sink, if it has one
+func (req *BuildRequest) log(step, level, message string) {
+	if req.Logs != nil {
+		req.Logs.Log(step, level, message)
+	}
+}
+
+// runStreamed runs cmd, forwarding each line of its combined output to the
+// request's sink as it is written, and returns the full output
+func runStreamed(cmd *exec.Cmd, req *BuildRequest, step string) (string, error) {
+	w := &lineWriter{emit: func(line string) { req.log(step, LogLevelInfo, line) }}
+	cmd.Stdout = w
+	cmd.Stderr = w
+	// Don't hang on a cancelled build whose children still hold the output pipe
+	cmd.WaitDelay = 10 * time.Second
+	err := cmd.Run()
+	w.flush()
+	return w.output.String(), err
+}
+
+// lineWriter splits written bytes into lines. Passing the same writer as
+// Stdout and Stderr makes exec.Cmd serialize writes to it.
+type lineWriter struct {
+	output  bytes.Buffer
+	partial []byte
+	emit    func(line string)
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.output.Write(p)
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.emit(strings.TrimRight(string(w.partial[:i]), "\r"))
+		w.partial = w.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// flush emits a trailing line that was not newline terminated
+func (w *lineWriter) flush() {
+	if len(w.partial) > 0 {
+		w.emit(strings.TrimRight(string(w.partial), "\r"))
+		w.partial = nil
+	}
+}
@@ -0,0 +1,161 @@
+// Package buildlog keeps the output of in-flight and recently finished builds
+// so clients can tail it live and resume after reconnecting.
+package buildlog
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/drewpayment/orbit/services/build-service/internal/builder"
+)
+
+// DefaultRetention is how long a finished build's log stays available
+const DefaultRetention = 10 * time.Minute
+
+// Build states recorded on each line
+const (
+	StateQueued  = "queued"
+	StateRunning = "running"
+)
+
+// Line is a single line of build output
+type Line struct {
+	Sequence  int64
+	Timestamp time.Time
+	State     string // State of the build when the line was logged
+	Level     string
+	Step      string
+	Message   string
+}
+
+// Log is the append-only output of one build. Lines are numbered from 1 so a
+// reader can resume after the last sequence it received.
+type Log struct {
+	mu      sync.Mutex
+	lines   []Line
+	state   string
+	result  *builder.BuildResult
+	changed chan struct{}
+	now     func() time.Time
+}
+
+func newLog(now func() time.Time) *Log {
+	return &Log{state: StateRunning, changed: make(chan struct{}), now: now}
+}
+
+// Log appends a line. It implements builder.LogSink and is a no-op once the
+// build has finished.
+func (l *Log) Log(step, level, message string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.result != nil {
+		return
+	}
+	l.lines = append(l.lines, Line{
+		Sequence:  int64(len(l.lines)) + 1,
+		Timestamp: l.now(),
+		State:     l.state,
+		Level:     level,
+		Step:      step,
+		Message:   message,
+	})
+	l.notify()
+}
+
+// SetState changes the state recorded on subsequent lines
+func (l *Log) SetState(state string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.state = state
+}
+
+// finish records the build result and wakes any waiting readers
+func (l *Log) finish(result *builder.BuildResult) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.result != nil {
+		return
+	}
+	l.result = result
+	l.notify()
+}
+
+// notify must be called with mu held
+func (l *Log) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// Wait returns the lines after the given sequence number, blocking until at
+// least one is available or the build finishes. Once the build has finished
+// it returns all remaining lines together with the build result.
+func (l *Log) Wait(ctx context.Context, after int64) ([]Line, *builder.BuildResult, error) {
+	for {
+		l.mu.Lock()
+		var lines []Line
+		if after < int64(len(l.lines)) {
+			lines = append(lines, l.lines[max(after, 0):]...)
+		}
+		result, changed := l.result, l.changed
+		l.mu.Unlock()
+
+		if len(lines) > 0 || result != nil {
+			return lines, result, nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// Store holds the logs of builds by request ID
+type Store struct {
+	mu        sync.Mutex
+	logs      map[string]*Log
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewStore creates a Store that drops finished logs after retention
+func NewStore(retention time.Duration) *Store {
+	return &Store{
+		logs:      make(map[string]*Log),
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Start creates the log for a build, replacing any earlier log with the same
+// request ID
+func (s *Store) Start(requestID string) *Log {
+	log := newLog(s.now)
+	s.mu.Lock()
+	s.logs[requestID] = log
+	s.mu.Unlock()
+	return log
+}
+
+// Get returns the log for a build if it is running or recently finished
+func (s *Store) Get(requestID string) (*Log, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	log, ok := s.logs[requestID]
+	return log, ok
+}
+
+// Finish records the result of a build and schedules its log for removal
+func (s *Store) Finish(requestID string, log *Log, result *builder.BuildResult) {
+	log.finish(result)
+	time.AfterFunc(s.retention, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// A retried build may have replaced the log in the meantime
+		if s.logs[requestID] == log {
+			delete(s.logs, requestID)
+		}
+	})
+}
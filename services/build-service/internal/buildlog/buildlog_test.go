@@ -0,0 +1,63 @@
+package buildlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/build-service/internal/builder"
+)
+
+func TestLog_WaitBlocksUntilOutput(t *testing.T) {
+	store := NewStore(time.Minute)
+	log := store.Start("build-1")
+	log.Log("clone", builder.LogLevelInfo, "Cloning")
+
+	lines, result, err := log.Wait(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	require.Len(t, lines, 1)
+	assert.Equal(t, int64(1), lines[0].Sequence)
+
+	waited := make(chan []Line)
+	go func() {
+		lines, _, _ := log.Wait(context.Background(), 1)
+		waited <- lines
+	}()
+	log.Log("build", builder.LogLevelWarn, "deprecated base image")
+
+	select {
+	case lines := <-waited:
+		require.Len(t, lines, 1)
+		assert.Equal(t, Line{Sequence: 2, Timestamp: lines[0].Timestamp, State: StateRunning, Level: "warn", Step: "build", Message: "deprecated base image"}, lines[0])
+	case <-time.After(5 * time.Second):
+		t.Fatal("Wait did not return after a line was logged")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = log.Wait(ctx, 2)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStore_FinishedLogsExpire(t *testing.T) {
+	store := NewStore(10 * time.Millisecond)
+	log := store.Start("build-1")
+	log.Log("build", builder.LogLevelInfo, "done")
+	store.Finish("build-1", log, &builder.BuildResult{Success: true})
+
+	// Output after the build finished is dropped
+	log.Log("build", builder.LogLevelInfo, "late")
+	lines, result, err := log.Wait(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Len(t, lines, 1)
+	assert.True(t, result.Success)
+
+	assert.Eventually(t, func() bool {
+		_, ok := store.Get("build-1")
+		return !ok
+	}, time.Second, 5*time.Millisecond)
+}
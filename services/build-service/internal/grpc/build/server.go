@@ -7,14 +7,18 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/drewpayment/orbit/services/build-service/internal/builder"
+	"github.com/drewpayment/orbit/services/build-service/internal/buildlog"
 	"github.com/drewpayment/orbit/services/build-service/internal/payload"
 	"github.com/drewpayment/orbit/services/build-service/internal/railpack"
 	"github.com/drewpayment/orbit/services/build-service/internal/registry"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 
 	buildv1 "github.com/drewpayment/orbit/proto/gen/go/idp/build/v1"
 	"google.golang.org/grpc/codes"
@@ -31,8 +35,20 @@ type BuildServer struct {
 	registryClient *registry.Client
 	payloadClient  *payload.RegistryClient
 	cleaner        *registry.Cleaner
+	logs           *buildlog.Store
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc // Cancels in-progress builds by request ID
+
+	// slots bounds concurrent builds; excess BuildImage calls queue for one
+	slots        chan struct{}
+	activeBuilds prometheus.Gauge
+	queuedBuilds prometheus.Gauge
 }
 
+// defaultMaxConcurrentBuilds is used when MAX_CONCURRENT_BUILDS is unset
+const defaultMaxConcurrentBuilds = 4
+
 // NewBuildServer creates a new BuildServer instance
 func NewBuildServer(logger *slog.Logger) *BuildServer {
 	workDir := os.Getenv("BUILD_WORK_DIR")
@@ -74,6 +90,15 @@ func NewBuildServerWithWorkDir(logger *slog.Logger, workDir string) *BuildServer
 	// Initialize cleaner with both clients
 	cleaner := registry.NewCleaner(registryClient, payloadClient, logger)
 
+	maxConcurrentBuilds := defaultMaxConcurrentBuilds
+	if v := os.Getenv("MAX_CONCURRENT_BUILDS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			maxConcurrentBuilds = n
+		} else {
+			logger.Warn("Ignoring invalid MAX_CONCURRENT_BUILDS", "value", v, "default", defaultMaxConcurrentBuilds)
+		}
+	}
+
 	return &BuildServer{
 		logger:         logger,
 		workDir:        workDir,
@@ -82,9 +107,25 @@ func NewBuildServerWithWorkDir(logger *slog.Logger, workDir string) *BuildServer
 		registryClient: registryClient,
 		payloadClient:  payloadClient,
 		cleaner:        cleaner,
+		logs:           buildlog.NewStore(buildlog.DefaultRetention),
+		running:        make(map[string]context.CancelFunc),
+		slots:          make(chan struct{}, maxConcurrentBuilds),
+		activeBuilds: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "build_service_builds_active",
+			Help: "Builds currently running",
+		}),
+		queuedBuilds: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "build_service_builds_queued",
+			Help: "Builds waiting for a free build slot",
+		}),
 	}
 }
 
+// Collectors returns the build queue metrics for registration
+func (s *BuildServer) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{s.activeBuilds, s.queuedBuilds}
+}
+
 // AnalyzeRepository analyzes a repository to detect build configuration
 func (s *BuildServer) AnalyzeRepository(ctx context.Context, req *buildv1.AnalyzeRepositoryRequest) (*buildv1.AnalyzeRepositoryResponse, error) {
 	s.logger.Info("AnalyzeRepository called",
@@ -243,17 +284,67 @@ func (s *BuildServer) BuildImage(
 		}
 	}
 
+	// Record output for StreamBuildLogs subscribers
+	buildLog := s.logs.Start(req.RequestId)
+	buildReq.Logs = buildLog
+
+	// Let CancelBuild stop the build; the caller going away stops it too
+	buildCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	s.trackRunning(req.RequestId, cancel)
+	defer s.untrackRunning(req.RequestId)
+
+	if err := s.acquireBuildSlot(buildCtx, buildLog); err != nil {
+		result := &builder.BuildResult{Cancelled: true, Error: "build cancelled while queued"}
+		s.logs.Finish(req.RequestId, buildLog, result)
+		return buildResultToProto(result), nil
+	}
+	defer s.releaseBuildSlot()
+
 	// Call builder
-	result, err := s.builder.Build(ctx, buildReq)
+	result, err := s.builder.Build(buildCtx, buildReq)
 	if err != nil {
 		s.logger.Error("Build failed", "error", err)
-		return &buildv1.BuildImageResponse{
-			Success: false,
-			Error:   fmt.Sprintf("build failed: %v", err),
-		}, nil
+		result = &builder.BuildResult{Error: fmt.Sprintf("build failed: %v", err)}
+	}
+	s.logs.Finish(req.RequestId, buildLog, result)
+
+	return buildResultToProto(result), nil
+}
+
+// acquireBuildSlot blocks until fewer than MAX_CONCURRENT_BUILDS builds are
+// running. While it waits the build is reported as queued.
+func (s *BuildServer) acquireBuildSlot(ctx context.Context, buildLog *buildlog.Log) error {
+	select {
+	case s.slots <- struct{}{}:
+		s.activeBuilds.Inc()
+		return nil
+	default:
 	}
 
-	// Convert result to proto response
+	s.queuedBuilds.Inc()
+	defer s.queuedBuilds.Dec()
+	buildLog.SetState(buildlog.StateQueued)
+	buildLog.Log("queue", builder.LogLevelInfo, "Waiting for a free build slot")
+
+	select {
+	case s.slots <- struct{}{}:
+		s.activeBuilds.Inc()
+		buildLog.SetState(buildlog.StateRunning)
+		buildLog.Log("queue", builder.LogLevelInfo, "Build slot acquired")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *BuildServer) releaseBuildSlot() {
+	s.activeBuilds.Dec()
+	<-s.slots
+}
+
+// buildResultToProto converts a builder result to its proto response
+func buildResultToProto(result *builder.BuildResult) *buildv1.BuildImageResponse {
 	response := &buildv1.BuildImageResponse{
 		Success:     result.Success,
 		ImageUrl:    result.ImageURL,
@@ -262,6 +353,15 @@ func (s *BuildServer) BuildImage(
 		Steps:       make([]*buildv1.BuildStep, len(result.Steps)),
 	}
 
+	switch {
+	case result.Success:
+		response.Status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_COMPLETED
+	case result.Cancelled:
+		response.Status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_CANCELLED
+	default:
+		response.Status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_FAILED
+	}
+
 	// Convert build steps
 	for i, step := range result.Steps {
 		var status buildv1.BuildStepStatus
@@ -274,6 +374,8 @@ func (s *BuildServer) BuildImage(
 			status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_COMPLETED
 		case "failed":
 			status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_FAILED
+		case "cancelled":
+			status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_CANCELLED
 		default:
 			status = buildv1.BuildStepStatus_BUILD_STEP_STATUS_UNSPECIFIED
 		}
@@ -286,20 +388,99 @@ func (s *BuildServer) BuildImage(
 		}
 	}
 
-	return response, nil
+	return response
 }
 
-// StreamBuildLogs streams build logs in real-time
+// StreamBuildLogs streams build logs in real-time. Lines after
+// from_sequence are replayed first, so a reconnecting client picks up where
+// it left off, and the stream ends with a message carrying the build result.
 func (s *BuildServer) StreamBuildLogs(
 	req *buildv1.StreamBuildLogsRequest,
 	stream buildv1.BuildService_StreamBuildLogsServer,
 ) error {
 	s.logger.Info("StreamBuildLogs called",
 		"request_id", req.RequestId,
+		"from_sequence", req.FromSequence,
+	)
+
+	if req.RequestId == "" {
+		return status.Error(codes.InvalidArgument, "request_id is required")
+	}
+	buildLog, ok := s.logs.Get(req.RequestId)
+	if !ok {
+		return status.Errorf(codes.NotFound, "no build logs for request %s", req.RequestId)
+	}
+
+	next := req.FromSequence
+	for {
+		lines, result, err := buildLog.Wait(stream.Context(), next)
+		if err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		for _, line := range lines {
+			lineStatus := buildv1.BuildStepStatus_BUILD_STEP_STATUS_RUNNING
+			if line.State == buildlog.StateQueued {
+				lineStatus = buildv1.BuildStepStatus_BUILD_STEP_STATUS_QUEUED
+			}
+			if err := stream.Send(&buildv1.StreamBuildLogsResponse{
+				Timestamp: line.Timestamp.UnixMilli(),
+				Level:     line.Level,
+				Message:   line.Message,
+				Step:      line.Step,
+				Sequence:  line.Sequence,
+				Status:    lineStatus,
+			}); err != nil {
+				return err
+			}
+			next = line.Sequence
+		}
+
+		if result != nil {
+			response := buildResultToProto(result)
+			return stream.Send(&buildv1.StreamBuildLogsResponse{
+				Timestamp: time.Now().UnixMilli(),
+				Sequence:  next,
+				Result:    response,
+				Status:    response.Status,
+			})
+		}
+	}
+}
+
+// CancelBuild stops an in-progress build. The build's subprocess is killed,
+// its working directory removed, and BuildImage and StreamBuildLogs report
+// it as cancelled.
+func (s *BuildServer) CancelBuild(ctx context.Context, req *buildv1.CancelBuildRequest) (*buildv1.CancelBuildResponse, error) {
+	s.logger.Info("CancelBuild called",
+		"request_id", req.RequestId,
 	)
 
-	// TODO: Implement log streaming
-	return status.Error(codes.Unimplemented, "StreamBuildLogs not yet implemented")
+	if req.RequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "request_id is required")
+	}
+
+	s.mu.Lock()
+	cancel, ok := s.running[req.RequestId]
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no running build for request %s", req.RequestId)
+	}
+
+	cancel()
+	return &buildv1.CancelBuildResponse{Cancelled: true}, nil
+}
+
+func (s *BuildServer) trackRunning(requestID string, cancel context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running[requestID] = cancel
+}
+
+func (s *BuildServer) untrackRunning(requestID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, requestID)
 }
 
 // CheckQuotaAndCleanup checks workspace quota and cleans up old images if needed
@@ -3,15 +3,30 @@ package build
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 
 	buildv1 "github.com/drewpayment/orbit/proto/gen/go/idp/build/v1"
+	"github.com/drewpayment/orbit/services/build-service/internal/builder"
+	"github.com/drewpayment/orbit/services/build-service/internal/buildlog"
 )
 
 func TestNewBuildServer_WiresAnalyzerAndBuilder(t *testing.T) {
@@ -101,3 +116,258 @@ func TestBuildImage_HandlesUnsupportedRegistryType(t *testing.T) {
 	assert.False(t, resp.Success)
 	assert.Equal(t, "unsupported registry type", resp.Error)
 }
+
+func startBuildServiceClient(t *testing.T, server *BuildServer) buildv1.BuildServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	buildv1.RegisterBuildServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return buildv1.NewBuildServiceClient(conn)
+}
+
+// receiveBuildLogs reads a log stream to the end
+func receiveBuildLogs(t *testing.T, stream grpc.ServerStreamingClient[buildv1.StreamBuildLogsResponse]) []*buildv1.StreamBuildLogsResponse {
+	t.Helper()
+	var messages []*buildv1.StreamBuildLogsResponse
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return messages
+		}
+		require.NoError(t, err)
+		messages = append(messages, msg)
+	}
+}
+
+func TestStreamBuildLogs_DeliversLinesInOrderAndResumes(t *testing.T) {
+	server := NewBuildServerWithWorkDir(slog.Default(), t.TempDir())
+	client := startBuildServiceClient(t, server)
+	ctx := context.Background()
+	const lineCount = 50
+
+	// A fake build: the client subscribes before any output is produced
+	buildLog := server.logs.Start("build-1")
+	stream, err := client.StreamBuildLogs(ctx, &buildv1.StreamBuildLogsRequest{RequestId: "build-1"})
+	require.NoError(t, err)
+
+	go func() {
+		for i := 1; i <= lineCount; i++ {
+			buildLog.Log("build", builder.LogLevelInfo, fmt.Sprintf("line %d", i))
+		}
+		server.logs.Finish("build-1", buildLog, &builder.BuildResult{Success: true, ImageURL: "registry.example.com/org/app:abc1234"})
+	}()
+
+	messages := receiveBuildLogs(t, stream)
+	require.Len(t, messages, lineCount+1)
+	for i, msg := range messages[:lineCount] {
+		assert.Equal(t, int64(i+1), msg.Sequence)
+		assert.Equal(t, fmt.Sprintf("line %d", i+1), msg.Message)
+		assert.Equal(t, "build", msg.Step)
+		assert.Equal(t, buildv1.BuildStepStatus_BUILD_STEP_STATUS_RUNNING, msg.Status)
+		assert.NotZero(t, msg.Timestamp)
+		assert.Nil(t, msg.Result)
+	}
+	final := messages[lineCount]
+	require.NotNil(t, final.Result)
+	assert.True(t, final.Result.Success)
+	assert.Equal(t, buildv1.BuildStepStatus_BUILD_STEP_STATUS_COMPLETED, final.Status)
+	assert.Equal(t, "registry.example.com/org/app:abc1234", final.Result.ImageUrl)
+
+	// A reconnecting client only receives the lines it missed
+	stream, err = client.StreamBuildLogs(ctx, &buildv1.StreamBuildLogsRequest{RequestId: "build-1", FromSequence: 30})
+	require.NoError(t, err)
+	messages = receiveBuildLogs(t, stream)
+	require.Len(t, messages, lineCount-30+1)
+	assert.Equal(t, int64(31), messages[0].Sequence)
+	assert.Equal(t, "line 31", messages[0].Message)
+	assert.Equal(t, int64(lineCount), messages[lineCount-30-1].Sequence)
+	assert.True(t, messages[lineCount-30].Result.Success)
+}
+
+func TestStreamBuildLogs_UnknownBuild(t *testing.T) {
+	server := NewBuildServerWithWorkDir(slog.Default(), t.TempDir())
+	client := startBuildServiceClient(t, server)
+
+	stream, err := client.StreamBuildLogs(context.Background(), &buildv1.StreamBuildLogsRequest{RequestId: "missing"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// fakeGitScript stands in for git: it creates the clone directory, records
+// its PID and then hangs like a very slow clone
+const fakeGitScript = `#!/bin/sh
+for last; do :; done
+mkdir -p "$last"
+echo $$ > "$FAKE_GIT_PID_FILE"
+exec sleep 60
+`
+
+// installFakeGit puts a git script first on PATH for the duration of the test
+func installFakeGit(t *testing.T, script string) {
+	t.Helper()
+	binDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "git"), []byte(script), 0o755))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func testBuildImageRequest(requestID string) *buildv1.BuildImageRequest {
+	return &buildv1.BuildImageRequest{
+		RequestId: requestID,
+		RepoUrl:   "https://github.com/test/app",
+		Registry: &buildv1.RegistryConfig{
+			Type:       buildv1.RegistryType_REGISTRY_TYPE_ORBIT,
+			Url:        "registry.example.com",
+			Repository: "org/app",
+		},
+	}
+}
+
+func TestCancelBuild_KillsRunningBuild(t *testing.T) {
+	installFakeGit(t, fakeGitScript)
+	pidFile := filepath.Join(t.TempDir(), "git.pid")
+	t.Setenv("FAKE_GIT_PID_FILE", pidFile)
+
+	workDir := t.TempDir()
+	server := NewBuildServerWithWorkDir(slog.Default(), workDir)
+	client := startBuildServiceClient(t, server)
+	ctx := context.Background()
+
+	type buildOutcome struct {
+		resp *buildv1.BuildImageResponse
+		err  error
+	}
+	done := make(chan buildOutcome, 1)
+	go func() {
+		resp, err := client.BuildImage(ctx, testBuildImageRequest("build-1"))
+		done <- buildOutcome{resp, err}
+	}()
+
+	var pid int
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(pidFile)
+		if err != nil {
+			return false
+		}
+		pid, err = strconv.Atoi(strings.TrimSpace(string(data)))
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond, "fake git never started")
+	require.DirExists(t, filepath.Join(workDir, "build-1"))
+
+	stream, err := client.StreamBuildLogs(ctx, &buildv1.StreamBuildLogsRequest{RequestId: "build-1"})
+	require.NoError(t, err)
+
+	cancelResp, err := client.CancelBuild(ctx, &buildv1.CancelBuildRequest{RequestId: "build-1"})
+	require.NoError(t, err)
+	assert.True(t, cancelResp.Cancelled)
+
+	var outcome buildOutcome
+	select {
+	case outcome = <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("build did not stop after CancelBuild")
+	}
+	require.NoError(t, outcome.err)
+	assert.Equal(t, buildv1.BuildStepStatus_BUILD_STEP_STATUS_CANCELLED, outcome.resp.Status)
+	assert.False(t, outcome.resp.Success)
+	require.Len(t, outcome.resp.Steps, 1)
+	assert.Equal(t, buildv1.BuildStepStatus_BUILD_STEP_STATUS_CANCELLED, outcome.resp.Steps[0].Status)
+
+	// The subprocess is gone and the build directory was cleaned up
+	assert.ErrorIs(t, syscall.Kill(pid, 0), syscall.ESRCH)
+	assert.NoDirExists(t, filepath.Join(workDir, "build-1"))
+
+	messages := receiveBuildLogs(t, stream)
+	require.NotEmpty(t, messages)
+	final := messages[len(messages)-1]
+	require.NotNil(t, final.Result)
+	assert.Equal(t, buildv1.BuildStepStatus_BUILD_STEP_STATUS_CANCELLED, final.Result.Status)
+
+	// Once finished there is nothing left to cancel
+	_, err = client.CancelBuild(ctx, &buildv1.CancelBuildRequest{RequestId: "build-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// blockingGitScript stands in for git: it records itself as running until the
+// release file appears, then fails the clone
+const blockingGitScript = `#!/bin/sh
+touch "$FAKE_GIT_RUN_DIR/$$" "$FAKE_GIT_STARTED_DIR/$$"
+while [ ! -f "$FAKE_GIT_RELEASE" ]; do sleep 0.02; done
+rm "$FAKE_GIT_RUN_DIR/$$"
+exit 1
+`
+
+func countFiles(t *testing.T, dir string) int {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	return len(entries)
+}
+
+func TestBuildImage_QueuesBuildsBeyondConcurrencyLimit(t *testing.T) {
+	installFakeGit(t, blockingGitScript)
+	runDir, startedDir := t.TempDir(), t.TempDir()
+	release := filepath.Join(t.TempDir(), "release")
+	t.Setenv("FAKE_GIT_RUN_DIR", runDir)
+	t.Setenv("FAKE_GIT_STARTED_DIR", startedDir)
+	t.Setenv("FAKE_GIT_RELEASE", release)
+	t.Setenv("MAX_CONCURRENT_BUILDS", "2")
+
+	server := NewBuildServerWithWorkDir(slog.Default(), t.TempDir())
+	const buildCount = 5
+
+	responses := make(chan *buildv1.BuildImageResponse, buildCount)
+	for i := 0; i < buildCount; i++ {
+		go func() {
+			resp, err := server.BuildImage(context.Background(), testBuildImageRequest(fmt.Sprintf("build-%d", i)))
+			assert.NoError(t, err)
+			responses <- resp
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return countFiles(t, runDir) == 2 && testutil.ToFloat64(server.queuedBuilds) == buildCount-2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The limit holds: nothing else starts while both slots are busy
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, 2, countFiles(t, runDir))
+	assert.Equal(t, 2, countFiles(t, startedDir))
+	assert.Equal(t, float64(2), testutil.ToFloat64(server.activeBuilds))
+
+	// Waiting builds report QUEUED on their log stream
+	queued := 0
+	for i := 0; i < buildCount; i++ {
+		buildLog, ok := server.logs.Get(fmt.Sprintf("build-%d", i))
+		require.True(t, ok)
+		lines, _, err := buildLog.Wait(context.Background(), 0)
+		require.NoError(t, err)
+		if lines[len(lines)-1].State == buildlog.StateQueued {
+			queued++
+		}
+	}
+	assert.Equal(t, buildCount-2, queued)
+
+	require.NoError(t, os.WriteFile(release, nil, 0o644))
+	for i := 0; i < buildCount; i++ {
+		select {
+		case resp := <-responses:
+			assert.Equal(t, buildv1.BuildStepStatus_BUILD_STEP_STATUS_FAILED, resp.Status)
+		case <-time.After(10 * time.Second):
+			t.Fatal("queued builds did not run after slots freed")
+		}
+	}
+	assert.Equal(t, buildCount, countFiles(t, startedDir))
+	assert.Equal(t, float64(0), testutil.ToFloat64(server.activeBuilds))
+	assert.Equal(t, float64(0), testutil.ToFloat64(server.queuedBuilds))
+}
@@ -0,0 +1,136 @@
+// Package interceptors provides the gRPC server interceptors for the build
+// service: structured request logging, Prometheus metrics and panic recovery.
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryLogging logs the method, duration and error of every unary call
+func UnaryLogging(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, logger, info.FullMethod, time.Since(start), err)
+		return resp, err
+	}
+}
+
+// StreamLogging logs the method, duration and error of every stream
+func StreamLogging(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(ss.Context(), logger, info.FullMethod, time.Since(start), err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, logger *slog.Logger, method string, duration time.Duration, err error) {
+	level := slog.LevelInfo
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Duration("duration", duration),
+		slog.String("code", status.Code(err).String()),
+	}
+	if err != nil {
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logger.LogAttrs(ctx, level, "grpc request", attrs...)
+}
+
+// Metrics records per-method request counts and latencies
+type Metrics struct {
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+// NewMetrics creates the request metrics and registers them with reg
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requestsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "build_service_grpc_requests_total",
+				Help: "Total gRPC requests handled, by method and status code",
+			},
+			[]string{"method", "code"},
+		),
+		requestDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "build_service_grpc_request_duration_seconds",
+				Help:    "gRPC request duration, by method",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"method"},
+		),
+	}
+	reg.MustRegister(m.requestsTotal, m.requestDuration)
+	return m
+}
+
+// UnaryServerInterceptor records metrics for unary calls
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observe(info.FullMethod, time.Since(start), err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records metrics for streams
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observe(info.FullMethod, time.Since(start), err)
+		return err
+	}
+}
+
+func (m *Metrics) observe(method string, duration time.Duration, err error) {
+	m.requestsTotal.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.requestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// UnaryRecovery turns a panicking handler into a codes.Internal error so one
+// bad request cannot take the server down
+func UnaryRecovery(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, logger, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery is the streaming counterpart of UnaryRecovery
+func StreamRecovery(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), logger, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(ctx context.Context, logger *slog.Logger, method string, r any) error {
+	logger.ErrorContext(ctx, "grpc handler panicked",
+		"method", method,
+		"panic", r,
+		"stack", string(debug.Stack()))
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -0,0 +1,138 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// testServiceDesc describes a hand-written service whose Panic method and
+// PanicStream stream panic and whose Ok method succeeds
+var testServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.Probe",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Panic", Handler: unaryTestHandler("/test.Probe/Panic", func() { panic("boom") })},
+		{MethodName: "Ok", Handler: unaryTestHandler("/test.Probe/Ok", func() {})},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PanicStream",
+			ServerStreams: true,
+			Handler:       func(srv any, stream grpc.ServerStream) error { panic("stream boom") },
+		},
+	},
+}
+
+func unaryTestHandler(fullMethod string, body func()) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		in := new(emptypb.Empty)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		handler := func(ctx context.Context, req any) (any, error) {
+			body()
+			return &emptypb.Empty{}, nil
+		}
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+		return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}, handler)
+	}
+}
+
+func startTestServer(t *testing.T, logger *slog.Logger, metrics *Metrics) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(UnaryLogging(logger), metrics.UnaryServerInterceptor(), UnaryRecovery(logger)),
+		grpc.ChainStreamInterceptor(StreamLogging(logger), metrics.StreamServerInterceptor(), StreamRecovery(logger)),
+	)
+	server.RegisterService(&testServiceDesc, struct{}{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestInterceptors_PanicReturnsInternalAndServerStaysUp(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	registry := prometheus.NewRegistry()
+	metrics := NewMetrics(registry)
+	conn := startTestServer(t, logger, metrics)
+	ctx := context.Background()
+
+	err := conn.Invoke(ctx, "/test.Probe/Panic", &emptypb.Empty{}, &emptypb.Empty{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The server survived and keeps serving
+	require.NoError(t, conn.Invoke(ctx, "/test.Probe/Ok", &emptypb.Empty{}, &emptypb.Empty{}))
+
+	stream, err := conn.NewStream(ctx, &testServiceDesc.Streams[0], "/test.Probe/PanicStream")
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+	require.NoError(t, stream.CloseSend())
+	err = stream.RecvMsg(&emptypb.Empty{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	require.NoError(t, conn.Invoke(ctx, "/test.Probe/Ok", &emptypb.Empty{}, &emptypb.Empty{}))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.requestsTotal.WithLabelValues("/test.Probe/Panic", "Internal")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.requestsTotal.WithLabelValues("/test.Probe/Ok", "OK")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.requestsTotal.WithLabelValues("/test.Probe/PanicStream", "Internal")))
+
+	assert.Contains(t, logs.String(), `"msg":"grpc handler panicked"`)
+	assert.Contains(t, logs.String(), `"panic":"boom"`)
+	assert.Contains(t, logs.String(), `"method":"/test.Probe/Ok","duration"`)
+}
+
+func TestUnaryLogging_LogsErrors(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	interceptor := UnaryLogging(logger)
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/idp.build.v1.BuildService/BuildImage"},
+		func(ctx context.Context, req any) (any, error) {
+			return nil, status.Error(codes.InvalidArgument, "app_id is required")
+		})
+
+	require.Error(t, err)
+	line := logs.String()
+	assert.True(t, strings.HasPrefix(line, "time="))
+	assert.Contains(t, line, "level=ERROR")
+	assert.Contains(t, line, "method=/idp.build.v1.BuildService/BuildImage")
+	assert.Contains(t, line, "code=InvalidArgument")
+	assert.Contains(t, line, `error="rpc error: code = InvalidArgument desc = app_id is required"`)
+}
+
+func TestUnaryRecovery_PassesThroughErrors(t *testing.T) {
+	interceptor := UnaryRecovery(slog.Default())
+	want := errors.New("plain failure")
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Probe/Fail"},
+		func(ctx context.Context, req any) (any, error) { return nil, want })
+
+	assert.Equal(t, want, err)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/drewpayment/orbit/proto/pkg/svcauth"
 	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
 	"github.com/drewpayment/orbit/services/kafka/internal/adapters/apache"
+	"github.com/drewpayment/orbit/services/kafka/internal/adapters/schema"
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
 	kafkagrpc "github.com/drewpayment/orbit/services/kafka/internal/grpc"
 	"github.com/drewpayment/orbit/services/kafka/internal/repository/postgres"
@@ -199,5 +200,9 @@ func (f *kafkaAdapterFactory) CreateKafkaAdapter(cluster *domain.KafkaCluster, c
 }
 
 func (f *kafkaAdapterFactory) CreateSchemaRegistryAdapter(registry *domain.SchemaRegistry, credentials map[string]string) (adapters.SchemaRegistryAdapter, error) {
-	return nil, fmt.Errorf("schema registry adapter not configured")
+	if registry == nil || registry.URL == "" {
+		return nil, domain.ErrSchemaRegistryNotFound
+	}
+
+	return schema.NewClientFromRegistry(registry, credentials)
 }
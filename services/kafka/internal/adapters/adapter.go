@@ -24,6 +24,10 @@ type KafkaAdapter interface {
 	DeleteACL(ctx context.Context, acl ACLSpec) error
 	ListACLs(ctx context.Context) ([]ACLInfo, error)
 
+	// Credential operations
+	UpsertCredential(ctx context.Context, cred CredentialSpec) error
+	DeleteCredential(ctx context.Context, username, mechanism string) error
+
 	// Metrics (optional - check capabilities first)
 	GetTopicMetrics(ctx context.Context, topicName string) (*TopicMetrics, error)
 	GetConsumerGroupLag(ctx context.Context, groupID string) (*ConsumerGroupLag, error)
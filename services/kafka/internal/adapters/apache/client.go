@@ -316,12 +316,24 @@ func (c *Client) DescribeTopic(ctx context.Context, topicName string) (*adapters
 		replicationFactor = len(topic.Partitions[0].Replicas)
 	}
 
+	partitions := topic.Partitions.Sorted()
+	details := make([]adapters.PartitionInfo, len(partitions))
+	for i, p := range partitions {
+		details[i] = adapters.PartitionInfo{
+			ID:       p.Partition,
+			Leader:   p.Leader,
+			Replicas: p.Replicas,
+			ISR:      p.ISR,
+		}
+	}
+
 	return &adapters.TopicInfo{
 		Name:              topicName,
 		Partitions:        len(topic.Partitions),
 		ReplicationFactor: replicationFactor,
 		Config:            configMap,
 		Internal:          topic.IsInternal,
+		PartitionDetails:  details,
 	}, nil
 }
 
@@ -551,6 +563,71 @@ func (c *Client) ListACLs(ctx context.Context) ([]adapters.ACLInfo, error) {
 	return acls, nil
 }
 
+// scramIterations is the iteration count used for new SCRAM credentials
+const scramIterations = 8192
+
+// UpsertCredential creates or replaces the user's SCRAM credential for a mechanism
+func (c *Client) UpsertCredential(ctx context.Context, cred adapters.CredentialSpec) error {
+	mechanism, err := mapScramMechanism(cred.Mechanism)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.newKgoClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kafka client: %w", err)
+	}
+	defer client.Close()
+
+	adminClient := kadm.NewClient(client)
+	results, err := adminClient.AlterUserSCRAMs(ctx, nil, []kadm.UpsertSCRAM{{
+		User:       cred.Username,
+		Mechanism:  mechanism,
+		Iterations: scramIterations,
+		Password:   cred.Password,
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to upsert credential: %w", err)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			return fmt.Errorf("failed to upsert credential for %s: %w", r.User, r.Err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteCredential deletes the user's SCRAM credential for a mechanism
+func (c *Client) DeleteCredential(ctx context.Context, username, mechanism string) error {
+	scramMechanism, err := mapScramMechanism(mechanism)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.newKgoClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kafka client: %w", err)
+	}
+	defer client.Close()
+
+	adminClient := kadm.NewClient(client)
+	results, err := adminClient.AlterUserSCRAMs(ctx, []kadm.DeleteSCRAM{{
+		User:      username,
+		Mechanism: scramMechanism,
+	}}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			return fmt.Errorf("failed to delete credential for %s: %w", r.User, r.Err)
+		}
+	}
+
+	return nil
+}
+
 // GetTopicMetrics returns metrics for a topic
 // Note: This returns structural metrics only (partitions, replicas).
 // Throughput metrics (bytes/sec, messages/sec) require JMX or external monitoring.
@@ -1,7 +1,10 @@
 package apache
 
 import (
+	"strings"
+
 	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
+	"github.com/twmb/franz-go/pkg/kadm"
 	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
@@ -137,3 +140,15 @@ func mapPermissionTypeFromKmsg(pt kmsg.ACLPermissionType) adapters.ACLPermission
 		return adapters.ACLPermissionDeny
 	}
 }
+
+// mapScramMechanism converts a SCRAM mechanism name to kadm.ScramMechanism
+func mapScramMechanism(mechanism string) (kadm.ScramMechanism, error) {
+	switch strings.ToUpper(mechanism) {
+	case "SCRAM-SHA-256":
+		return kadm.ScramSha256, nil
+	case "SCRAM-SHA-512":
+		return kadm.ScramSha512, nil
+	default:
+		return 0, ErrUnsupportedSASLMechanism
+	}
+}
@@ -1,9 +1,11 @@
 package apache
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
+	"github.com/twmb/franz-go/pkg/kadm"
 	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
@@ -180,3 +182,30 @@ func TestMapPermissionTypeFromKmsg(t *testing.T) {
 		})
 	}
 }
+
+func TestMapScramMechanism(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected kadm.ScramMechanism
+	}{
+		{"SCRAM-SHA-256", kadm.ScramSha256},
+		{"SCRAM-SHA-512", kadm.ScramSha512},
+		{"scram-sha-512", kadm.ScramSha512},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := mapScramMechanism(tt.input)
+			if err != nil {
+				t.Fatalf("mapScramMechanism(%s) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("mapScramMechanism(%s) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := mapScramMechanism("PLAIN"); !errors.Is(err, ErrUnsupportedSASLMechanism) {
+		t.Errorf("mapScramMechanism(PLAIN) error = %v, want %v", err, ErrUnsupportedSASLMechanism)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
@@ -59,6 +60,20 @@ func NewClient(config Config) (*Client, error) {
 	}, nil
 }
 
+// NewClientFromRegistry creates a client from a domain schema registry and
+// credentials. Basic auth is used when credentials contain a username.
+func NewClientFromRegistry(registry *domain.SchemaRegistry, credentials map[string]string) (*Client, error) {
+	if registry == nil {
+		return nil, errors.New("schema registry required")
+	}
+
+	return NewClient(Config{
+		URL:      strings.TrimSuffix(registry.URL, "/"),
+		Username: credentials["username"],
+		Password: credentials["password"],
+	})
+}
+
 // GenerateSubject creates a subject name from the naming template
 func GenerateSubject(environment, workspace, topic, schemaType string) string {
 	return fmt.Sprintf("%s.%s.%s-%s", environment, workspace, topic, schemaType)
@@ -66,11 +81,7 @@ func GenerateSubject(environment, workspace, topic, schemaType string) string {
 
 // RegisterSchema registers a new schema
 func (c *Client) RegisterSchema(ctx context.Context, subject string, schema adapters.SchemaSpec) (adapters.SchemaResult, error) {
-	reqBody := map[string]interface{}{
-		"schema":     schema.Schema,
-		"schemaType": schema.SchemaType,
-	}
-
+	reqBody := schemaRequestBody(schema)
 	if len(schema.References) > 0 {
 		refs := make([]map[string]interface{}, len(schema.References))
 		for i, ref := range schema.References {
@@ -106,8 +117,9 @@ func (c *Client) RegisterSchema(ctx context.Context, subject string, schema adap
 		return adapters.SchemaResult{}, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Get the version
-	version, err := c.getLatestVersion(ctx, subject)
+	// Look up the version of the schema just registered; the latest version
+	// may belong to a concurrent registration
+	version, err := c.lookupVersion(ctx, subject, body)
 	if err != nil {
 		return adapters.SchemaResult{}, err
 	}
@@ -216,12 +228,7 @@ func (c *Client) ListVersions(ctx context.Context, subject string) ([]int, error
 
 // CheckCompatibility checks if a schema is compatible
 func (c *Client) CheckCompatibility(ctx context.Context, subject string, schema adapters.SchemaSpec) (bool, error) {
-	reqBody := map[string]interface{}{
-		"schema":     schema.Schema,
-		"schemaType": schema.SchemaType,
-	}
-
-	body, err := json.Marshal(reqBody)
+	body, err := json.Marshal(schemaRequestBody(schema))
 	if err != nil {
 		return false, fmt.Errorf("failed to marshal request: %w", err)
 	}
@@ -378,19 +385,30 @@ func (c *Client) parseError(resp *http.Response) error {
 	return fmt.Errorf("schema registry error %d: %s", errResp.ErrorCode, errResp.Message)
 }
 
-func (c *Client) getLatestVersion(ctx context.Context, subject string) (int, error) {
-	versions, err := c.ListVersions(ctx, subject)
+// lookupVersion returns the version under which the schema in body is
+// registered for subject
+func (c *Client) lookupVersion(ctx context.Context, subject string, body []byte) (int, error) {
+	reqURL := fmt.Sprintf("%s/subjects/%s", c.baseURL, url.PathEscape(subject))
+	resp, err := c.doRequest(ctx, "POST", reqURL, body)
 	if err != nil {
-		// If subject doesn't exist yet, return version 1
-		if errors.Is(err, adapters.ErrSchemaNotFound) {
-			return 1, nil
-		}
 		return 0, err
 	}
-	if len(versions) == 0 {
-		return 1, nil
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, adapters.ErrSchemaNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, c.parseError(resp)
+	}
+
+	var result struct {
+		Version int `json:"version"`
 	}
-	return versions[len(versions)-1], nil
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return result.Version, nil
 }
 
 func (c *Client) getGlobalCompatibility(ctx context.Context) (domain.SchemaCompatibility, error) {
@@ -415,6 +433,18 @@ func (c *Client) getGlobalCompatibility(ctx context.Context) (domain.SchemaCompa
 	return mapCompatibility(result.CompatibilityLevel), nil
 }
 
+// schemaRequestBody builds the register/compatibility request body. The
+// registry expects upper-case schema types and treats a missing type as AVRO.
+func schemaRequestBody(schema adapters.SchemaSpec) map[string]interface{} {
+	reqBody := map[string]interface{}{
+		"schema": schema.Schema,
+	}
+	if schemaType := strings.ToUpper(schema.SchemaType); schemaType != "" && schemaType != "AVRO" {
+		reqBody["schemaType"] = schemaType
+	}
+	return reqBody
+}
+
 func mapCompatibility(level string) domain.SchemaCompatibility {
 	switch level {
 	case "BACKWARD", "BACKWARD_TRANSITIVE":
@@ -355,3 +355,167 @@ func TestClient_BasicAuth(t *testing.T) {
 		t.Errorf("expected Basic auth, got %s", receivedAuth[:6])
 	}
 }
+
+func TestNewClientFromRegistry(t *testing.T) {
+	var user, pass string
+	var ok bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok = r.BasicAuth()
+		w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+		json.NewEncoder(w).Encode([]string{})
+	}))
+	defer server.Close()
+
+	client, err := NewClientFromRegistry(&domain.SchemaRegistry{URL: server.URL + "/"}, map[string]string{
+		"username": "sr-user",
+		"password": "sr-pass",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.ListSubjects(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ok || user != "sr-user" || pass != "sr-pass" {
+		t.Errorf("expected basic auth sr-user/sr-pass, got %q/%q (ok=%v)", user, pass, ok)
+	}
+
+	if _, err := NewClientFromRegistry(nil, nil); err == nil {
+		t.Error("expected error for nil registry")
+	}
+}
+
+func TestClient_RegisterSchema(t *testing.T) {
+	tests := []struct {
+		name           string
+		schemaType     string
+		wantSchemaType string
+	}{
+		{name: "avro omits schemaType", schemaType: "avro", wantSchemaType: ""},
+		{name: "protobuf is upper-cased", schemaType: "protobuf", wantSchemaType: "PROTOBUF"},
+		{name: "json is upper-cased", schemaType: "json", wantSchemaType: "JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var registered, looked bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+					return
+				}
+				schemaType, _ := body["schemaType"].(string)
+				if schemaType != tt.wantSchemaType {
+					t.Errorf("expected schemaType %q, got %q", tt.wantSchemaType, schemaType)
+				}
+
+				w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+				switch {
+				case r.Method == "POST" && r.URL.Path == "/subjects/dev.ws.orders-value/versions":
+					registered = true
+					json.NewEncoder(w).Encode(map[string]int{"id": 42})
+				case r.Method == "POST" && r.URL.Path == "/subjects/dev.ws.orders-value":
+					looked = true
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"subject": "dev.ws.orders-value",
+						"id":      42,
+						"version": 2,
+						"schema":  body["schema"],
+					})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(Config{URL: server.URL})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result, err := client.RegisterSchema(context.Background(), "dev.ws.orders-value", adapters.SchemaSpec{
+				Schema:     `{"type":"string"}`,
+				SchemaType: tt.schemaType,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !registered || !looked {
+				t.Errorf("expected register and lookup calls, got register=%v lookup=%v", registered, looked)
+			}
+			if result.ID != 42 {
+				t.Errorf("expected ID 42, got %d", result.ID)
+			}
+			if result.Version != 2 {
+				t.Errorf("expected version 2, got %d", result.Version)
+			}
+		})
+	}
+}
+
+func TestClient_RegisterSchema_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error_code": 409,
+			"message":    "Schema being registered is incompatible with an earlier schema",
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{URL: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.RegisterSchema(context.Background(), "test", adapters.SchemaSpec{Schema: `{"type":"string"}`})
+	if err == nil {
+		t.Fatal("expected error for incompatible schema")
+	}
+	if err.Error() != "schema registry error 409: Schema being registered is incompatible with an earlier schema" {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestClient_GetSchema(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+		switch r.URL.Path {
+		case "/subjects/test-subject/versions/2":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"subject": "test-subject",
+				"version": 2,
+				"id":      7,
+				"schema":  `"string"`,
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error_code": 40402,
+				"message":    "Version not found",
+			})
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{URL: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schema, err := client.GetSchema(context.Background(), "test-subject", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.Version != 2 || schema.ID != 7 {
+		t.Errorf("expected version 2 id 7, got version %d id %d", schema.Version, schema.ID)
+	}
+
+	_, err = client.GetSchema(context.Background(), "test-subject", 9)
+	if err != adapters.ErrSchemaNotFound {
+		t.Errorf("expected ErrSchemaNotFound, got %v", err)
+	}
+}
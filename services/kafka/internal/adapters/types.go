@@ -28,6 +28,15 @@ type TopicInfo struct {
 	ReplicationFactor int
 	Config            map[string]string
 	Internal          bool
+	PartitionDetails  []PartitionInfo // ordered by partition ID
+}
+
+// PartitionInfo contains the live leadership and replica state of a partition
+type PartitionInfo struct {
+	ID       int32
+	Leader   int32 // -1 when the partition has no leader
+	Replicas []int32
+	ISR      []int32
 }
 
 // ACLSpec defines an ACL entry
@@ -95,6 +104,13 @@ type ACLInfo struct {
 	PermissionType ACLPermissionType
 }
 
+// CredentialSpec defines a SCRAM credential for a user
+type CredentialSpec struct {
+	Username  string
+	Password  string
+	Mechanism string // SCRAM-SHA-256 or SCRAM-SHA-512
+}
+
 // TopicMetrics contains usage metrics for a topic
 type TopicMetrics struct {
 	TopicName        string
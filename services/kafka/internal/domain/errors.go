@@ -1,6 +1,10 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // Cluster errors
 var (
@@ -22,23 +26,50 @@ var (
 	ErrTopicAlreadyExists      = errors.New("topic already exists")
 	ErrTopicCannotBeDeleted    = errors.New("topic cannot be deleted in current state")
 	ErrTopicPendingApproval    = errors.New("topic is pending approval")
+	ErrTopicConfigInvalid      = errors.New("topic config is invalid")
+)
+
+// Pagination errors
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
 )
 
 // Schema errors
 var (
-	ErrSchemaNotFound           = errors.New("schema not found")
-	ErrSchemaContentRequired    = errors.New("schema content is required")
-	ErrSchemaInvalidFormat      = errors.New("invalid schema format")
-	ErrSchemaIncompatible       = errors.New("schema is incompatible with existing version")
-	ErrSchemaRegistrationFailed = errors.New("schema registration failed")
+	ErrSchemaNotFound             = errors.New("schema not found")
+	ErrSchemaContentRequired      = errors.New("schema content is required")
+	ErrSchemaInvalidFormat        = errors.New("invalid schema format")
+	ErrSchemaIncompatible         = errors.New("schema is incompatible with existing version")
+	ErrSchemaRegistrationFailed   = errors.New("schema registration failed")
+	ErrSchemaRegistryNotFound     = errors.New("schema registry not configured for cluster")
+	ErrSchemaInvalidCompatibility = errors.New("invalid schema compatibility mode")
 )
 
+// SchemaCompatibilityError lists why a new schema version violates the
+// subject's compatibility mode. It matches ErrSchemaIncompatible with errors.Is.
+type SchemaCompatibilityError struct {
+	Compatibility     SchemaCompatibility
+	Incompatibilities []string
+}
+
+func (e *SchemaCompatibilityError) Error() string {
+	if len(e.Incompatibilities) == 0 {
+		return fmt.Sprintf("%s (%s)", ErrSchemaIncompatible, e.Compatibility)
+	}
+	return fmt.Sprintf("%s (%s): %s", ErrSchemaIncompatible, e.Compatibility, strings.Join(e.Incompatibilities, "; "))
+}
+
+func (e *SchemaCompatibilityError) Unwrap() error {
+	return ErrSchemaIncompatible
+}
+
 // Service account errors
 var (
 	ErrServiceAccountNotFound          = errors.New("service account not found")
 	ErrServiceAccountNameRequired      = errors.New("service account name is required")
 	ErrServiceAccountWorkspaceRequired = errors.New("service account workspace is required")
 	ErrServiceAccountRevoked           = errors.New("service account has been revoked")
+	ErrCredentialNotFound              = errors.New("service account credential not found")
 )
 
 // Share errors
@@ -49,8 +80,33 @@ var (
 	ErrShareNotApproved      = errors.New("share is not approved")
 	ErrShareExpired          = errors.New("share has expired")
 	ErrShareSelfShare        = errors.New("cannot share topic with owning workspace")
+	ErrShareInvalidTransition = errors.New("invalid share status transition")
+	ErrShareNotAuthorized    = errors.New("not authorized to act on share")
 )
 
+// ShareTransitionError reports a share status change the lifecycle does not
+// allow. It matches ErrShareInvalidTransition with errors.Is, and also
+// ErrShareNotPending or ErrShareNotApproved when the share was not in the
+// status the change requires.
+type ShareTransitionError struct {
+	From ShareStatus
+	To   ShareStatus
+}
+
+func (e *ShareTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrShareInvalidTransition, e.From, e.To)
+}
+
+func (e *ShareTransitionError) Unwrap() []error {
+	switch {
+	case e.To == ShareStatusRevoked && e.From != ShareStatusApproved:
+		return []error{ErrShareInvalidTransition, ErrShareNotApproved}
+	case e.To != ShareStatusRevoked && e.From != ShareStatusPendingRequest:
+		return []error{ErrShareInvalidTransition, ErrShareNotPending}
+	}
+	return []error{ErrShareInvalidTransition}
+}
+
 // Policy errors
 var (
 	ErrPolicyNotFound       = errors.New("policy not found")
@@ -58,10 +114,66 @@ var (
 	ErrPolicyNamingViolation = errors.New("topic name violates naming policy")
 	ErrPolicyPartitionLimit = errors.New("partition count exceeds policy limit")
 	ErrPolicyRetentionLimit = errors.New("retention period exceeds policy limit")
+	ErrPolicyReplicationLimit = errors.New("replication factor exceeds policy limit")
+	ErrPolicyConfigLocked = errors.New("topic config is locked by policy")
 	ErrPolicySchemaRequired = errors.New("schema is required by policy")
 	ErrPolicyApprovalRequired = errors.New("approval is required by policy")
 )
 
+// Topic policy rules reported by PolicyViolationError
+const (
+	PolicyRuleNamePrefix  = "name-prefix"
+	PolicyRuleNameLength  = "max-name-length"
+	PolicyRuleNamePattern = "naming-pattern"
+	PolicyRulePartitions  = "partition-limits"
+	PolicyRuleReplication = "replication-limits"
+	PolicyRuleRetention   = "retention-limits"
+)
+
+// policyRuleErrors maps each rule onto the sentinel error callers match
+var policyRuleErrors = map[string]error{
+	PolicyRuleNamePrefix:  ErrPolicyNamingViolation,
+	PolicyRuleNameLength:  ErrPolicyNamingViolation,
+	PolicyRuleNamePattern: ErrPolicyNamingViolation,
+	PolicyRulePartitions:  ErrPolicyPartitionLimit,
+	PolicyRuleReplication: ErrPolicyReplicationLimit,
+	PolicyRuleRetention:   ErrPolicyRetentionLimit,
+}
+
+// PolicyViolationError names the topic policy rule a request breaks. It
+// matches ErrPolicyViolation and the rule's own sentinel (for example
+// ErrPolicyNamingViolation) with errors.Is.
+type PolicyViolationError struct {
+	Rule    string
+	Message string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%s [%s]: %s", ErrPolicyViolation, e.Rule, e.Message)
+}
+
+func (e *PolicyViolationError) Unwrap() []error {
+	if sentinel, ok := policyRuleErrors[e.Rule]; ok {
+		return []error{ErrPolicyViolation, sentinel}
+	}
+	return []error{ErrPolicyViolation}
+}
+
+// LockedConfigError lists the topic configs a request tried to override
+// that the policy locks. It matches ErrPolicyViolation and
+// ErrPolicyConfigLocked with errors.Is.
+type LockedConfigError struct {
+	Keys []string
+}
+
+func (e *LockedConfigError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPolicyConfigLocked, strings.Join(e.Keys, ", "))
+}
+
+func (e *LockedConfigError) Unwrap() []error {
+	return []error{ErrPolicyViolation, ErrPolicyConfigLocked}
+}
+
 // Environment mapping errors
 var (
 	ErrEnvironmentMappingNotFound = errors.New("environment mapping not found")
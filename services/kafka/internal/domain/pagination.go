@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PageCursor is the sort key of the last item on a page. Lists are ordered
+// newest first by (CreatedAt, ID), so rows inserted while a client is paging
+// sort ahead of the cursor and never shift later pages.
+type PageCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// Token encodes the cursor as an opaque page token
+func (c PageCursor) Token() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParsePageToken decodes a page token. An empty token yields a nil cursor,
+// meaning the first page.
+func ParsePageToken(token string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c PageCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return nil, ErrInvalidPageToken
+	}
+	return &c, nil
+}
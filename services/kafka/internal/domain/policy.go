@@ -1,7 +1,12 @@
 package domain
 
 import (
+	"fmt"
+	"maps"
 	"regexp"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -39,6 +44,12 @@ type PartitionLimits struct {
 	Max int `json:"max"`
 }
 
+// ReplicationLimits defines min/max replication factor
+type ReplicationLimits struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
 // RetentionLimits defines min/max retention in milliseconds
 type RetentionLimits struct {
 	MinMs int64 `json:"minMs"`
@@ -47,18 +58,23 @@ type RetentionLimits struct {
 
 // KafkaTopicPolicy represents guardrails for topic creation
 type KafkaTopicPolicy struct {
-	ID                  uuid.UUID        `json:"id"`
-	Scope               PolicyScope      `json:"scope"`
-	WorkspaceID         *uuid.UUID       `json:"workspaceId"`
-	Environment         string           `json:"environment"`
-	NamingPattern       string           `json:"namingPattern"`
-	AutoApprovePatterns []string         `json:"autoApprovePatterns"`
-	PartitionLimits     *PartitionLimits `json:"partitionLimits"`
-	RetentionLimits     *RetentionLimits `json:"retentionLimits"`
-	RequireSchema       bool             `json:"requireSchema"`
-	RequireApprovalFor  []string         `json:"requireApprovalFor"`
-	CreatedAt           time.Time        `json:"createdAt"`
-	UpdatedAt           time.Time        `json:"updatedAt"`
+	ID                  uuid.UUID          `json:"id"`
+	Scope               PolicyScope        `json:"scope"`
+	WorkspaceID         *uuid.UUID         `json:"workspaceId"`
+	Environment         string             `json:"environment"`
+	NamingPattern       string             `json:"namingPattern"`
+	NamePrefix          string             `json:"namePrefix"`
+	MaxNameLength       int                `json:"maxNameLength"` // 0 means no limit
+	AutoApprovePatterns []string           `json:"autoApprovePatterns"`
+	PartitionLimits     *PartitionLimits   `json:"partitionLimits"`
+	ReplicationLimits   *ReplicationLimits `json:"replicationLimits"`
+	RetentionLimits     *RetentionLimits   `json:"retentionLimits"`
+	DefaultConfigs      map[string]string  `json:"defaultConfigs"` // applied when a request leaves the key unset
+	LockedConfigs       []string           `json:"lockedConfigs"`  // keys tenants may not override
+	RequireSchema       bool               `json:"requireSchema"`
+	RequireApprovalFor  []string           `json:"requireApprovalFor"`
+	CreatedAt           time.Time          `json:"createdAt"`
+	UpdatedAt           time.Time          `json:"updatedAt"`
 }
 
 // NewPlatformPolicy creates a new platform-wide policy
@@ -118,6 +134,106 @@ func (p *KafkaTopicPolicy) ValidateRetention(retentionMs int64) bool {
 	return retentionMs >= p.RetentionLimits.MinMs && retentionMs <= p.RetentionLimits.MaxMs
 }
 
+// ValidateTopicNaming checks a topic name against the policy's prefix,
+// maximum length and naming pattern, returning a *PolicyViolationError for
+// the first rule it breaks
+func (p *KafkaTopicPolicy) ValidateTopicNaming(name string) error {
+	if p.NamePrefix != "" && !strings.HasPrefix(name, p.NamePrefix) {
+		return &PolicyViolationError{
+			Rule:    PolicyRuleNamePrefix,
+			Message: fmt.Sprintf("topic name %q must start with %q", name, p.NamePrefix),
+		}
+	}
+	if p.MaxNameLength > 0 && len(name) > p.MaxNameLength {
+		return &PolicyViolationError{
+			Rule:    PolicyRuleNameLength,
+			Message: fmt.Sprintf("topic name is %d characters, the limit is %d", len(name), p.MaxNameLength),
+		}
+	}
+	if !p.ValidateTopicName(name) {
+		return &PolicyViolationError{
+			Rule:    PolicyRuleNamePattern,
+			Message: fmt.Sprintf("topic name %q does not match pattern %q", name, p.NamingPattern),
+		}
+	}
+	return nil
+}
+
+// ApplyPartitionLimits returns the partition count to use under the policy.
+// An explicitly requested count outside the limits is a violation; a default
+// count is clamped into range instead.
+func (p *KafkaTopicPolicy) ApplyPartitionLimits(partitions int, requested bool) (int, error) {
+	if p.PartitionLimits == nil {
+		return partitions, nil
+	}
+	return applyLimits(partitions, p.PartitionLimits.Min, p.PartitionLimits.Max, requested, PolicyRulePartitions, "partition count")
+}
+
+// ApplyReplicationLimits returns the replication factor to use under the
+// policy, rejecting requested values and clamping defaults like ApplyPartitionLimits
+func (p *KafkaTopicPolicy) ApplyReplicationLimits(replicationFactor int, requested bool) (int, error) {
+	if p.ReplicationLimits == nil {
+		return replicationFactor, nil
+	}
+	return applyLimits(replicationFactor, p.ReplicationLimits.Min, p.ReplicationLimits.Max, requested, PolicyRuleReplication, "replication factor")
+}
+
+// ApplyRetentionLimits returns the retention to use under the policy,
+// rejecting requested values and clamping defaults like ApplyPartitionLimits
+func (p *KafkaTopicPolicy) ApplyRetentionLimits(retentionMs int64, requested bool) (int64, error) {
+	if p.RetentionLimits == nil {
+		return retentionMs, nil
+	}
+	return applyLimits(retentionMs, p.RetentionLimits.MinMs, p.RetentionLimits.MaxMs, requested, PolicyRuleRetention, "retention (ms)")
+}
+
+// applyLimits bounds value to [min, max]; a max of 0 leaves it unbounded above
+func applyLimits[T int | int64](value, min, max T, requested bool, rule, noun string) (T, error) {
+	inRange := value >= min && (max <= 0 || value <= max)
+	if inRange {
+		return value, nil
+	}
+	if requested {
+		limit := fmt.Sprintf("at least %d", min)
+		if max > 0 {
+			limit = fmt.Sprintf("between %d and %d", min, max)
+		}
+		return 0, &PolicyViolationError{
+			Rule:    rule,
+			Message: fmt.Sprintf("%s %d must be %s", noun, value, limit),
+		}
+	}
+	if value < min {
+		return min, nil
+	}
+	return max, nil
+}
+
+// ResolveTopicConfig merges the policy's default configs under the requested
+// configs. Requested values for locked keys are rejected with a
+// *LockedConfigError unless they match the policy default.
+func (p *KafkaTopicPolicy) ResolveTopicConfig(requested map[string]string) (map[string]string, error) {
+	var rejected []string
+	for key, value := range requested {
+		if !slices.Contains(p.LockedConfigs, key) {
+			continue
+		}
+		if def, ok := p.DefaultConfigs[key]; ok && def == value {
+			continue
+		}
+		rejected = append(rejected, key)
+	}
+	if len(rejected) > 0 {
+		sort.Strings(rejected)
+		return nil, &LockedConfigError{Keys: rejected}
+	}
+
+	resolved := make(map[string]string, len(p.DefaultConfigs)+len(requested))
+	maps.Copy(resolved, p.DefaultConfigs)
+	maps.Copy(resolved, requested)
+	return resolved, nil
+}
+
 // RequiresApproval checks if this environment requires approval
 func (p *KafkaTopicPolicy) RequiresApproval(environment string) bool {
 	for _, env := range p.RequireApprovalFor {
@@ -163,6 +279,7 @@ type KafkaTopicSharePolicy struct {
 	DefaultPermission    SharePermission   `json:"defaultPermission"`
 	RequireJustification bool              `json:"requireJustification"`
 	AccessTTLDays        int               `json:"accessTtlDays"`
+	Approvers            []uuid.UUID       `json:"approvers"` // users who may approve requests; empty defers to the owning workspace
 	CreatedAt            time.Time         `json:"createdAt"`
 	UpdatedAt            time.Time         `json:"updatedAt"`
 }
@@ -201,6 +318,11 @@ func (p *KafkaTopicSharePolicy) MatchesTopic(topicID uuid.UUID, topicName string
 	}
 }
 
+// CanApprove reports whether the policy names userID as a share approver
+func (p *KafkaTopicSharePolicy) CanApprove(userID uuid.UUID) bool {
+	return slices.Contains(p.Approvers, userID)
+}
+
 // ShouldAutoApprove checks if a share request should be auto-approved
 func (p *KafkaTopicSharePolicy) ShouldAutoApprove(requestingWorkspaceID uuid.UUID, environment string, permission SharePermission) bool {
 	if p.AutoApprove == nil {
@@ -25,6 +25,16 @@ const (
 	SchemaCompatibilityNone     SchemaCompatibility = "none"
 )
 
+// Valid reports whether c is a known compatibility mode
+func (c SchemaCompatibility) Valid() bool {
+	switch c {
+	case SchemaCompatibilityBackward, SchemaCompatibilityForward, SchemaCompatibilityFull, SchemaCompatibilityNone:
+		return true
+	default:
+		return false
+	}
+}
+
 // SchemaType represents whether this is a key or value schema
 type SchemaType string
 
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,14 +28,16 @@ const (
 
 // KafkaServiceAccount represents a service account for Kafka access
 type KafkaServiceAccount struct {
-	ID          uuid.UUID            `json:"id"`
-	WorkspaceID uuid.UUID            `json:"workspaceId"`
-	Name        string               `json:"name"`
-	Type        ServiceAccountType   `json:"type"`
-	Status      ServiceAccountStatus `json:"status"`
-	CreatedBy   uuid.UUID            `json:"createdBy"`
-	CreatedAt   time.Time            `json:"createdAt"`
-	UpdatedAt   time.Time            `json:"updatedAt"`
+	ID                  uuid.UUID            `json:"id"`
+	WorkspaceID         uuid.UUID            `json:"workspaceId"`
+	Name                string               `json:"name"`
+	Type                ServiceAccountType   `json:"type"`
+	Status              ServiceAccountStatus `json:"status"`
+	CurrentCredentialID *uuid.UUID           `json:"currentCredentialId"`
+	CredentialRotatedAt *time.Time           `json:"credentialRotatedAt"`
+	CreatedBy           uuid.UUID            `json:"createdBy"`
+	CreatedAt           time.Time            `json:"createdAt"`
+	UpdatedAt           time.Time            `json:"updatedAt"`
 }
 
 // NewKafkaServiceAccount creates a new service account
@@ -68,6 +72,13 @@ func (s *KafkaServiceAccount) Revoke() {
 	s.UpdatedAt = time.Now()
 }
 
+// UseCredential makes cred the account's current credential
+func (s *KafkaServiceAccount) UseCredential(cred *ServiceAccountCredential) {
+	s.CurrentCredentialID = &cred.ID
+	s.CredentialRotatedAt = &cred.CreatedAt
+	s.UpdatedAt = cred.CreatedAt
+}
+
 // IsActive returns true if the account is active
 func (s *KafkaServiceAccount) IsActive() bool {
 	return s.Status == ServiceAccountStatusActive
@@ -86,3 +97,83 @@ func (s *KafkaServiceAccount) CanConsume() bool {
 		s.Type == ServiceAccountTypeProducerConsumer ||
 		s.Type == ServiceAccountTypeAdmin)
 }
+
+// SCRAM mechanisms used for service account credentials
+const (
+	CredentialMechanismScramSHA256 = "SCRAM-SHA-256"
+	CredentialMechanismScramSHA512 = "SCRAM-SHA-512"
+)
+
+// CredentialStatus represents the credential lifecycle
+type CredentialStatus string
+
+const (
+	CredentialStatusActive     CredentialStatus = "active"
+	CredentialStatusSuperseded CredentialStatus = "superseded" // replaced, valid until RevokeAfter
+	CredentialStatusRevoked    CredentialStatus = "revoked"
+)
+
+// credentialSecretBytes is the amount of randomness in a generated secret
+const credentialSecretBytes = 32
+
+// ServiceAccountCredential is a SCRAM credential provisioned on a cluster for
+// a service account. The account's principal never changes; consecutive
+// credentials alternate SCRAM mechanisms so the superseded secret stays valid
+// alongside its replacement until it is revoked.
+type ServiceAccountCredential struct {
+	ID               uuid.UUID        `json:"id"`
+	ServiceAccountID uuid.UUID        `json:"serviceAccountId"`
+	ClusterID        uuid.UUID        `json:"clusterId"`
+	Username         string           `json:"username"`
+	Mechanism        string           `json:"mechanism"`
+	Secret           string           `json:"-"` // only set when the credential is issued; never stored
+	Status           CredentialStatus `json:"status"`
+	RevokeAfter      *time.Time       `json:"revokeAfter"`
+	RevokedAt        *time.Time       `json:"revokedAt"`
+	CreatedAt        time.Time        `json:"createdAt"`
+}
+
+// NewServiceAccountCredential issues a credential with a random secret for
+// the account on a cluster. It uses the SCRAM mechanism previous does not,
+// or SCRAM-SHA-512 when there is no previous credential on the cluster.
+func NewServiceAccountCredential(account *KafkaServiceAccount, clusterID uuid.UUID, previous *ServiceAccountCredential) (*ServiceAccountCredential, error) {
+	secret := make([]byte, credentialSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	mechanism := CredentialMechanismScramSHA512
+	if previous != nil && previous.ClusterID == clusterID && previous.Mechanism == CredentialMechanismScramSHA512 {
+		mechanism = CredentialMechanismScramSHA256
+	}
+
+	return &ServiceAccountCredential{
+		ID:               uuid.New(),
+		ServiceAccountID: account.ID,
+		ClusterID:        clusterID,
+		Username:         account.Name,
+		Mechanism:        mechanism,
+		Secret:           base64.RawURLEncoding.EncodeToString(secret),
+		Status:           CredentialStatusActive,
+		CreatedAt:        time.Now(),
+	}, nil
+}
+
+// Supersede marks the credential as replaced, to be revoked after revokeAfter
+func (c *ServiceAccountCredential) Supersede(revokeAfter time.Time) {
+	c.Status = CredentialStatusSuperseded
+	c.RevokeAfter = &revokeAfter
+}
+
+// Revoke marks the credential as revoked
+func (c *ServiceAccountCredential) Revoke() {
+	now := time.Now()
+	c.Status = CredentialStatusRevoked
+	c.RevokedAt = &now
+}
+
+// DueForRevocation reports whether a superseded credential's grace period has
+// ended at now
+func (c *ServiceAccountCredential) DueForRevocation(now time.Time) bool {
+	return c.Status == CredentialStatusSuperseded && c.RevokeAfter != nil && !now.Before(*c.RevokeAfter)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,8 +24,26 @@ const (
 	ShareStatusApproved       ShareStatus = "approved"
 	ShareStatusRejected       ShareStatus = "rejected"
 	ShareStatusRevoked        ShareStatus = "revoked"
+	ShareStatusCancelled      ShareStatus = "cancelled"
 )
 
+// shareTransitions lists the statuses each status may move to. Rejected,
+// revoked and cancelled shares are terminal.
+var shareTransitions = map[ShareStatus][]ShareStatus{
+	ShareStatusPendingRequest: {ShareStatusApproved, ShareStatusRejected, ShareStatusCancelled},
+	ShareStatusApproved:       {ShareStatusRevoked},
+}
+
+// CanTransitionTo reports whether a share may move from s to next
+func (s ShareStatus) CanTransitionTo(next ShareStatus) bool {
+	return slices.Contains(shareTransitions[s], next)
+}
+
+// IsTerminal reports whether no further transitions are possible from s
+func (s ShareStatus) IsTerminal() bool {
+	return len(shareTransitions[s]) == 0
+}
+
 // ShareWithType represents what entity the topic is shared with
 type ShareWithType string
 
@@ -70,29 +89,73 @@ func NewTopicShareRequest(topicID, requestingWorkspaceID, requestedBy uuid.UUID,
 	}
 }
 
+// ShareTransition records a share moving from one status to another
+type ShareTransition struct {
+	ID         uuid.UUID   `json:"id"`
+	ShareID    uuid.UUID   `json:"shareId"`
+	FromStatus ShareStatus `json:"fromStatus"` // empty for the initial request
+	ToStatus   ShareStatus `json:"toStatus"`
+	ActorID    uuid.UUID   `json:"actorId"`
+	Reason     string      `json:"reason"`
+	CreatedAt  time.Time   `json:"createdAt"`
+}
+
+// NewShareTransition creates a transition record for a share
+func NewShareTransition(shareID uuid.UUID, from, to ShareStatus, actorID uuid.UUID, reason string) *ShareTransition {
+	return &ShareTransition{
+		ID:         uuid.New(),
+		ShareID:    shareID,
+		FromStatus: from,
+		ToStatus:   to,
+		ActorID:    actorID,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// transition moves the share to the given status, returning a
+// *ShareTransitionError if the lifecycle does not allow it
+func (s *KafkaTopicShare) transition(to ShareStatus, actorID uuid.UUID, reason string) (*ShareTransition, error) {
+	if !s.Status.CanTransitionTo(to) {
+		return nil, &ShareTransitionError{From: s.Status, To: to}
+	}
+	t := NewShareTransition(s.ID, s.Status, to, actorID, reason)
+	s.Status = to
+	s.UpdatedAt = t.CreatedAt
+	return t, nil
+}
+
 // Approve approves the share request
-func (s *KafkaTopicShare) Approve(approvedBy uuid.UUID, expiresAt *time.Time) {
-	now := time.Now()
-	s.Status = ShareStatusApproved
+func (s *KafkaTopicShare) Approve(approvedBy uuid.UUID, expiresAt *time.Time) (*ShareTransition, error) {
+	t, err := s.transition(ShareStatusApproved, approvedBy, "")
+	if err != nil {
+		return nil, err
+	}
 	s.ApprovedBy = &approvedBy
-	s.ApprovedAt = &now
+	s.ApprovedAt = &t.CreatedAt
 	s.ExpiresAt = expiresAt
-	s.UpdatedAt = now
+	return t, nil
 }
 
 // Reject rejects the share request
-func (s *KafkaTopicShare) Reject(rejectedBy uuid.UUID) {
-	now := time.Now()
-	s.Status = ShareStatusRejected
+func (s *KafkaTopicShare) Reject(rejectedBy uuid.UUID, reason string) (*ShareTransition, error) {
+	t, err := s.transition(ShareStatusRejected, rejectedBy, reason)
+	if err != nil {
+		return nil, err
+	}
 	s.ApprovedBy = &rejectedBy // Using ApprovedBy to track who rejected
-	s.ApprovedAt = &now
-	s.UpdatedAt = now
+	s.ApprovedAt = &t.CreatedAt
+	return t, nil
+}
+
+// Cancel withdraws a pending share request
+func (s *KafkaTopicShare) Cancel(cancelledBy uuid.UUID) (*ShareTransition, error) {
+	return s.transition(ShareStatusCancelled, cancelledBy, "")
 }
 
-// Revoke revokes an approved share
-func (s *KafkaTopicShare) Revoke() {
-	s.Status = ShareStatusRevoked
-	s.UpdatedAt = time.Now()
+// Revoke revokes an approved share. Revocation is terminal.
+func (s *KafkaTopicShare) Revoke(revokedBy uuid.UUID, reason string) (*ShareTransition, error) {
+	return s.transition(ShareStatusRevoked, revokedBy, reason)
 }
 
 // IsActive returns true if the share is active and not expired
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -37,6 +39,13 @@ const (
 	CompressionZstd   CompressionType = "zstd"
 )
 
+// Kafka config keys backed by KafkaTopic fields
+const (
+	TopicConfigRetentionMs   = "retention.ms"
+	TopicConfigCleanupPolicy = "cleanup.policy"
+	TopicConfigCompression   = "compression.type"
+)
+
 // KafkaTopic represents a Kafka topic owned by a workspace
 type KafkaTopic struct {
 	ID                uuid.UUID         `json:"id"`
@@ -80,6 +89,29 @@ func NewKafkaTopic(workspaceID uuid.UUID, name, environment string) *KafkaTopic
 	}
 }
 
+// ApplyConfig replaces the topic's configs, moving keys backed by typed
+// fields (retention, cleanup policy, compression) onto those fields
+func (t *KafkaTopic) ApplyConfig(config map[string]string) error {
+	t.Config = make(map[string]string, len(config))
+	for key, value := range config {
+		switch key {
+		case TopicConfigRetentionMs:
+			ms, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%w: %s=%q", ErrTopicConfigInvalid, key, value)
+			}
+			t.RetentionMs = ms
+		case TopicConfigCleanupPolicy:
+			t.CleanupPolicy = CleanupPolicy(value)
+		case TopicConfigCompression:
+			t.Compression = CompressionType(value)
+		default:
+			t.Config[key] = value
+		}
+	}
+	return nil
+}
+
 // Validate checks topic invariants
 func (t *KafkaTopic) Validate() error {
 	if t.Name == "" {
@@ -82,6 +82,10 @@ func (s *KafkaServer) GetTopic(ctx context.Context, req *kafkav1.GetTopicRequest
 	return s.topicHandler.GetTopic(ctx, req)
 }
 
+func (s *KafkaServer) DescribeTopic(ctx context.Context, req *kafkav1.DescribeTopicRequest) (*kafkav1.DescribeTopicResponse, error) {
+	return s.topicHandler.DescribeTopic(ctx, req)
+}
+
 func (s *KafkaServer) ListTopics(ctx context.Context, req *kafkav1.ListTopicsRequest) (*kafkav1.ListTopicsResponse, error) {
 	return s.topicHandler.ListTopics(ctx, req)
 }
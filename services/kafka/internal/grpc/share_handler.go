@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	kafkav1 "github.com/drewpayment/orbit/proto/gen/go/idp/kafka/v1"
 	"github.com/drewpayment/orbit/proto/pkg/svcauth"
@@ -30,6 +31,23 @@ func callerUserID(ctx context.Context) (uuid.UUID, error) {
 	return userID, nil
 }
 
+// callerShareActor returns the verified caller as a share actor. The
+// workspace is the token's authorized workspace, or uuid.Nil when the token
+// carries none, so workspace-based share guards fail closed.
+func callerShareActor(ctx context.Context) (service.ShareActor, error) {
+	userID, err := callerUserID(ctx)
+	if err != nil {
+		return service.ShareActor{}, err
+	}
+	actor := service.ShareActor{UserID: userID}
+	if id, _ := svcauth.IdentityFromContext(ctx); id.WorkspaceID != "" {
+		if actor.WorkspaceID, err = uuid.Parse(id.WorkspaceID); err != nil {
+			return service.ShareActor{}, status.Errorf(codes.Unauthenticated, "verified identity has invalid workspace id: %v", err)
+		}
+	}
+	return actor, nil
+}
+
 // ShareHandler handles share-related gRPC calls
 type ShareHandler struct {
 	shareService *service.ShareService
@@ -92,13 +110,16 @@ func (h *ShareHandler) ApproveTopicAccess(ctx context.Context, req *kafkav1.Appr
 
 	// GO-H1: the approver is the verified caller. req.ApprovedBy is ignored — a
 	// caller must not be able to attribute an approval to an arbitrary user.
-	approverID, err := callerUserID(ctx)
+	actor, err := callerShareActor(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	share, err := h.shareService.ApproveTopicAccess(ctx, shareID, approverID)
+	share, err := h.shareService.ApproveTopicAccess(ctx, shareID, actor)
 	if err != nil {
+		if errors.Is(err, domain.ErrShareNotAuthorized) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return &kafkav1.ApproveTopicAccessResponse{
 			Error: err.Error(),
 		}, nil
@@ -116,25 +137,21 @@ func (h *ShareHandler) RevokeTopicAccess(ctx context.Context, req *kafkav1.Revok
 		return nil, status.Errorf(codes.InvalidArgument, "invalid share ID: %v", err)
 	}
 
-	// GO-H2: enforce the share's workspace against the caller's wid before
-	// revoking. A share's tenant is the workspace it was shared with.
-	share, err := h.shareService.GetShare(ctx, shareID)
+	// GO-H2: the service only lets the caller's wid revoke when it is the
+	// topic's owning workspace or the workspace the topic was shared with.
+	actor, err := callerShareActor(ctx)
 	if err != nil {
-		if err == domain.ErrShareNotFound {
-			return nil, status.Errorf(codes.NotFound, "share not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to load share: %v", err)
-	}
-	shareWorkspace := ""
-	if share.SharedWithWorkspaceID != nil {
-		shareWorkspace = share.SharedWithWorkspaceID.String()
-	}
-	if err := svcauth.EnforceWorkspace(ctx, shareWorkspace); err != nil {
 		return nil, err
 	}
 
-	_, err = h.shareService.RevokeTopicAccess(ctx, shareID)
+	_, err = h.shareService.RevokeTopicAccess(ctx, shareID, actor, "")
 	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrShareNotFound):
+			return nil, status.Errorf(codes.NotFound, "share not found")
+		case errors.Is(err, domain.ErrShareNotAuthorized):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return &kafkav1.RevokeTopicAccessResponse{
 			Success: false,
 			Error:   err.Error(),
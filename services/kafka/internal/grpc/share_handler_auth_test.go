@@ -22,6 +22,7 @@ import (
 // fakeServiceAccountRepo is an in-memory ServiceAccountRepository so the
 // regression test can assert the persisted CreatedBy without a database.
 type fakeServiceAccountRepo struct {
+	service.ServiceAccountRepository
 	created []*domain.KafkaServiceAccount
 }
 
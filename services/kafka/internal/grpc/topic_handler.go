@@ -5,6 +5,7 @@ import (
 
 	kafkav1 "github.com/drewpayment/orbit/proto/gen/go/idp/kafka/v1"
 	"github.com/drewpayment/orbit/proto/pkg/svcauth"
+	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
 	"github.com/drewpayment/orbit/services/kafka/internal/service"
 	"github.com/google/uuid"
@@ -98,6 +99,43 @@ func (h *TopicHandler) GetTopic(ctx context.Context, req *kafkav1.GetTopicReques
 	}, nil
 }
 
+// DescribeTopic returns a topic's declared state alongside its live state on
+// the cluster, flagging drift between the two
+func (h *TopicHandler) DescribeTopic(ctx context.Context, req *kafkav1.DescribeTopicRequest) (*kafkav1.DescribeTopicResponse, error) {
+	topicID, err := uuid.Parse(req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid topic ID: %v", err)
+	}
+
+	// GO-H2: enforce the topic's workspace against the caller's wid.
+	if _, err := h.authorizeTopicAccess(ctx, topicID); err != nil {
+		return nil, err
+	}
+
+	desc, err := h.topicService.DescribeTopic(ctx, topicID, req.Credentials)
+	if err != nil {
+		return &kafkav1.DescribeTopicResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	resp := &kafkav1.DescribeTopicResponse{
+		Topic:   topicToProto(desc.Topic),
+		Drifted: desc.Drifted(),
+	}
+	if desc.Provisioned {
+		resp.BrokerState = brokerStateToProto(desc.Live)
+	}
+	for _, d := range desc.Drift {
+		resp.Drift = append(resp.Drift, &kafkav1.TopicDrift{
+			Field:    d.Field,
+			Declared: d.Declared,
+			Actual:   d.Actual,
+		})
+	}
+	return resp, nil
+}
+
 // ListTopics returns topics for a workspace
 func (h *TopicHandler) ListTopics(ctx context.Context, req *kafkav1.ListTopicsRequest) (*kafkav1.ListTopicsResponse, error) {
 	workspaceID, err := uuid.Parse(req.WorkspaceId)
@@ -290,6 +328,29 @@ func topicToProto(t *domain.KafkaTopic) *kafkav1.KafkaTopic {
 	return pb
 }
 
+// brokerStateToProto converts live topic metadata; nil means the topic is
+// missing from the cluster
+func brokerStateToProto(info *adapters.TopicInfo) *kafkav1.TopicBrokerState {
+	if info == nil {
+		return &kafkav1.TopicBrokerState{Exists: false}
+	}
+	pb := &kafkav1.TopicBrokerState{
+		Exists:            true,
+		Partitions:        int32(info.Partitions),
+		ReplicationFactor: int32(info.ReplicationFactor),
+		Config:            info.Config,
+	}
+	for _, p := range info.PartitionDetails {
+		pb.PartitionStates = append(pb.PartitionStates, &kafkav1.TopicPartitionState{
+			Partition: p.ID,
+			Leader:    p.Leader,
+			Replicas:  p.Replicas,
+			Isr:       p.ISR,
+		})
+	}
+	return pb
+}
+
 func topicStatusToProto(s domain.TopicStatus) kafkav1.TopicStatus {
 	switch s {
 	case domain.TopicStatusPendingApproval:
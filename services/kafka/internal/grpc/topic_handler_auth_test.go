@@ -36,6 +36,9 @@ func (f *fakeTopicRepo) GetByName(context.Context, uuid.UUID, string, string) (*
 func (f *fakeTopicRepo) List(context.Context, uuid.UUID, string) ([]*domain.KafkaTopic, error) {
 	return nil, nil
 }
+func (f *fakeTopicRepo) ListPage(context.Context, uuid.UUID, string, *domain.PageCursor, int) ([]*domain.KafkaTopic, error) {
+	return nil, nil
+}
 func (f *fakeTopicRepo) Update(_ context.Context, t *domain.KafkaTopic) error {
 	if t.Status == domain.TopicStatusDeleting {
 		f.deleted = true
@@ -23,8 +23,9 @@ func NewPolicyRepository(db DBTX) *PolicyRepository {
 func (r *PolicyRepository) GetEffectivePolicy(ctx context.Context, workspaceID uuid.UUID, environment string) (*domain.KafkaTopicPolicy, error) {
 	// Try workspace-scoped policy first
 	row := r.db.QueryRow(ctx,
-		`SELECT id, scope, workspace_id, environment, naming_pattern, auto_approve_patterns,
-			partition_limits, retention_limits, require_schema, require_approval_for, created_at, updated_at
+		`SELECT id, scope, workspace_id, environment, naming_pattern, name_prefix, max_name_length,
+			auto_approve_patterns, partition_limits, replication_limits, retention_limits,
+			default_configs, locked_configs, require_schema, require_approval_for, created_at, updated_at
 		 FROM kafka_topic_policies
 		 WHERE workspace_id = $1 AND environment = $2
 		 LIMIT 1`, workspaceID, environment)
@@ -39,8 +40,9 @@ func (r *PolicyRepository) GetEffectivePolicy(ctx context.Context, workspaceID u
 
 	// Fall back to platform policy
 	row = r.db.QueryRow(ctx,
-		`SELECT id, scope, workspace_id, environment, naming_pattern, auto_approve_patterns,
-			partition_limits, retention_limits, require_schema, require_approval_for, created_at, updated_at
+		`SELECT id, scope, workspace_id, environment, naming_pattern, name_prefix, max_name_length,
+			auto_approve_patterns, partition_limits, replication_limits, retention_limits,
+			default_configs, locked_configs, require_schema, require_approval_for, created_at, updated_at
 		 FROM kafka_topic_policies
 		 WHERE scope = 'platform' AND environment = $1
 		 LIMIT 1`, environment)
@@ -58,10 +60,11 @@ func (r *PolicyRepository) GetEffectivePolicy(ctx context.Context, workspaceID u
 func scanPolicy(s scanner) (*domain.KafkaTopicPolicy, error) {
 	var p domain.KafkaTopicPolicy
 	var scope string
-	var autoApproveJSON, partitionLimitsJSON, retentionLimitsJSON, requireApprovalJSON []byte
-	err := s.Scan(&p.ID, &scope, &p.WorkspaceID, &p.Environment, &p.NamingPattern,
-		&autoApproveJSON, &partitionLimitsJSON, &retentionLimitsJSON,
-		&p.RequireSchema, &requireApprovalJSON, &p.CreatedAt, &p.UpdatedAt)
+	var autoApproveJSON, partitionLimitsJSON, replicationLimitsJSON, retentionLimitsJSON []byte
+	var defaultConfigsJSON, lockedConfigsJSON, requireApprovalJSON []byte
+	err := s.Scan(&p.ID, &scope, &p.WorkspaceID, &p.Environment, &p.NamingPattern, &p.NamePrefix, &p.MaxNameLength,
+		&autoApproveJSON, &partitionLimitsJSON, &replicationLimitsJSON, &retentionLimitsJSON,
+		&defaultConfigsJSON, &lockedConfigsJSON, &p.RequireSchema, &requireApprovalJSON, &p.CreatedAt, &p.UpdatedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
 	}
@@ -78,12 +81,24 @@ func scanPolicy(s scanner) (*domain.KafkaTopicPolicy, error) {
 			return nil, err
 		}
 	}
+	if replicationLimitsJSON != nil {
+		p.ReplicationLimits = &domain.ReplicationLimits{}
+		if err := json.Unmarshal(replicationLimitsJSON, p.ReplicationLimits); err != nil {
+			return nil, err
+		}
+	}
 	if retentionLimitsJSON != nil {
 		p.RetentionLimits = &domain.RetentionLimits{}
 		if err := json.Unmarshal(retentionLimitsJSON, p.RetentionLimits); err != nil {
 			return nil, err
 		}
 	}
+	if err := json.Unmarshal(defaultConfigsJSON, &p.DefaultConfigs); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(lockedConfigsJSON, &p.LockedConfigs); err != nil {
+		return nil, err
+	}
 	if err := json.Unmarshal(requireApprovalJSON, &p.RequireApprovalFor); err != nil {
 		return nil, err
 	}
@@ -18,22 +18,29 @@ import (
 func insertPolicy(t *testing.T, tx postgres.DBTX, policy *domain.KafkaTopicPolicy) {
 	t.Helper()
 	autoApproveJSON, _ := json.Marshal(policy.AutoApprovePatterns)
-	var partLimJSON, retLimJSON []byte
+	var partLimJSON, replLimJSON, retLimJSON []byte
 	if policy.PartitionLimits != nil {
 		partLimJSON, _ = json.Marshal(policy.PartitionLimits)
 	}
+	if policy.ReplicationLimits != nil {
+		replLimJSON, _ = json.Marshal(policy.ReplicationLimits)
+	}
 	if policy.RetentionLimits != nil {
 		retLimJSON, _ = json.Marshal(policy.RetentionLimits)
 	}
+	defaultConfigsJSON, _ := json.Marshal(policy.DefaultConfigs)
+	lockedConfigsJSON, _ := json.Marshal(policy.LockedConfigs)
 	requireApprovalJSON, _ := json.Marshal(policy.RequireApprovalFor)
 	_, err := tx.Exec(context.Background(),
 		`INSERT INTO kafka_topic_policies (id, scope, workspace_id, environment, naming_pattern,
-			auto_approve_patterns, partition_limits, retention_limits, require_schema, require_approval_for,
+			name_prefix, max_name_length, auto_approve_patterns, partition_limits, replication_limits,
+			retention_limits, default_configs, locked_configs, require_schema, require_approval_for,
 			created_at, updated_at)
-		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`,
+		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17)`,
 		policy.ID, string(policy.Scope), policy.WorkspaceID, policy.Environment, policy.NamingPattern,
-		autoApproveJSON, partLimJSON, retLimJSON,
-		policy.RequireSchema, requireApprovalJSON, policy.CreatedAt, policy.UpdatedAt)
+		policy.NamePrefix, policy.MaxNameLength, autoApproveJSON, partLimJSON, replLimJSON,
+		retLimJSON, defaultConfigsJSON, lockedConfigsJSON, policy.RequireSchema, requireApprovalJSON,
+		policy.CreatedAt, policy.UpdatedAt)
 	require.NoError(t, err)
 }
 
@@ -93,14 +100,26 @@ func TestPolicyRepository_GetEffectivePolicy_WithLimits(t *testing.T) {
 	wsID := uuid.New()
 	policy := domain.NewWorkspacePolicy(wsID, "dev")
 	policy.PartitionLimits = &domain.PartitionLimits{Min: 1, Max: 12}
+	policy.ReplicationLimits = &domain.ReplicationLimits{Min: 2, Max: 3}
 	policy.RetentionLimits = &domain.RetentionLimits{MinMs: 3600000, MaxMs: 604800000}
+	policy.NamePrefix = "orders."
+	policy.MaxNameLength = 64
+	policy.DefaultConfigs = map[string]string{"retention.ms": "86400000"}
+	policy.LockedConfigs = []string{"retention.ms"}
 	insertPolicy(t, tx, policy)
 
 	got, err := repo.GetEffectivePolicy(ctx, wsID, "dev")
 	require.NoError(t, err)
 	require.NotNil(t, got.PartitionLimits)
+	require.NotNil(t, got.ReplicationLimits)
 	require.NotNil(t, got.RetentionLimits)
 
+	assert.Equal(t, "orders.", got.NamePrefix)
+	assert.Equal(t, 64, got.MaxNameLength)
+	assert.Equal(t, domain.ReplicationLimits{Min: 2, Max: 3}, *got.ReplicationLimits)
+	assert.Equal(t, map[string]string{"retention.ms": "86400000"}, got.DefaultConfigs)
+	assert.Equal(t, []string{"retention.ms"}, got.LockedConfigs)
+
 	assert.Equal(t, 1, got.PartitionLimits.Min)
 	assert.Equal(t, 12, got.PartitionLimits.Max)
 	assert.Equal(t, int64(3600000), got.RetentionLimits.MinMs)
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
 	"github.com/google/uuid"
@@ -18,7 +19,8 @@ func NewServiceAccountRepository(db DBTX) *ServiceAccountRepository {
 	return &ServiceAccountRepository{db: db}
 }
 
-const saColumns = `id, workspace_id, name, type, status, created_by, created_at, updated_at`
+const saColumns = `id, workspace_id, name, type, status, current_credential_id, credential_rotated_at,
+	created_by, created_at, updated_at`
 
 func (r *ServiceAccountRepository) Create(ctx context.Context, account *domain.KafkaServiceAccount) error {
 	_, err := r.db.Exec(ctx,
@@ -56,10 +58,12 @@ func (r *ServiceAccountRepository) List(ctx context.Context, workspaceID uuid.UU
 
 func (r *ServiceAccountRepository) Update(ctx context.Context, account *domain.KafkaServiceAccount) error {
 	tag, err := r.db.Exec(ctx,
-		`UPDATE kafka_service_accounts SET workspace_id=$2, name=$3, type=$4, status=$5, updated_at=$6
+		`UPDATE kafka_service_accounts SET workspace_id=$2, name=$3, type=$4, status=$5,
+		 current_credential_id=$6, credential_rotated_at=$7, updated_at=$8
 		 WHERE id=$1`,
 		account.ID, account.WorkspaceID, account.Name,
-		string(account.Type), string(account.Status), account.UpdatedAt)
+		string(account.Type), string(account.Status),
+		account.CurrentCredentialID, account.CredentialRotatedAt, account.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -69,10 +73,84 @@ func (r *ServiceAccountRepository) Update(ctx context.Context, account *domain.K
 	return nil
 }
 
+const credentialColumns = `id, service_account_id, cluster_id, username, mechanism, status,
+	revoke_after, revoked_at, created_at`
+
+// CreateCredential stores an issued credential. The secret is not stored.
+func (r *ServiceAccountRepository) CreateCredential(ctx context.Context, cred *domain.ServiceAccountCredential) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO kafka_service_account_credentials (`+credentialColumns+`)
+		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`,
+		cred.ID, cred.ServiceAccountID, cred.ClusterID, cred.Username, cred.Mechanism,
+		string(cred.Status), cred.RevokeAfter, cred.RevokedAt, cred.CreatedAt)
+	return err
+}
+
+// GetCredential returns a credential by ID, or nil if it does not exist
+func (r *ServiceAccountRepository) GetCredential(ctx context.Context, id uuid.UUID) (*domain.ServiceAccountCredential, error) {
+	row := r.db.QueryRow(ctx, `SELECT `+credentialColumns+` FROM kafka_service_account_credentials WHERE id = $1`, id)
+	return scanCredential(row)
+}
+
+// UpdateCredential updates a credential's status and revocation times
+func (r *ServiceAccountRepository) UpdateCredential(ctx context.Context, cred *domain.ServiceAccountCredential) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE kafka_service_account_credentials SET status=$2, revoke_after=$3, revoked_at=$4
+		 WHERE id=$1`,
+		cred.ID, string(cred.Status), cred.RevokeAfter, cred.RevokedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrCredentialNotFound
+	}
+	return nil
+}
+
+// ListCredentialsDueForRevocation returns the cluster's superseded
+// credentials whose grace period ended at or before the given time
+func (r *ServiceAccountRepository) ListCredentialsDueForRevocation(ctx context.Context, clusterID uuid.UUID, before time.Time) ([]*domain.ServiceAccountCredential, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+credentialColumns+` FROM kafka_service_account_credentials
+		 WHERE cluster_id = $1 AND status = $2 AND revoke_after <= $3
+		 ORDER BY revoke_after`,
+		clusterID, string(domain.CredentialStatusSuperseded), before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var creds []*domain.ServiceAccountCredential
+	for rows.Next() {
+		c, err := scanCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, c)
+	}
+	return creds, rows.Err()
+}
+
+func scanCredential(s scanner) (*domain.ServiceAccountCredential, error) {
+	var c domain.ServiceAccountCredential
+	var status string
+	err := s.Scan(&c.ID, &c.ServiceAccountID, &c.ClusterID, &c.Username, &c.Mechanism, &status,
+		&c.RevokeAfter, &c.RevokedAt, &c.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.Status = domain.CredentialStatus(status)
+	return &c, nil
+}
+
 func scanServiceAccount(s scanner) (*domain.KafkaServiceAccount, error) {
 	var a domain.KafkaServiceAccount
 	var accountType, status string
 	err := s.Scan(&a.ID, &a.WorkspaceID, &a.Name, &accountType, &status,
+		&a.CurrentCredentialID, &a.CredentialRotatedAt,
 		&a.CreatedBy, &a.CreatedAt, &a.UpdatedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
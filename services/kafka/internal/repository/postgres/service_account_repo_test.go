@@ -5,6 +5,7 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
 	"github.com/drewpayment/orbit/services/kafka/internal/repository/postgres"
@@ -81,3 +82,49 @@ func TestServiceAccountRepository_GetByID_NotFound(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, got)
 }
+
+func TestServiceAccountRepository_Credentials(t *testing.T) {
+	tx := setupTestTx(t)
+	repo := postgres.NewServiceAccountRepository(tx)
+	ctx := context.Background()
+
+	cluster := createTestCluster(t, tx)
+	account := domain.NewKafkaServiceAccount(uuid.New(), "rotating", domain.ServiceAccountTypeProducer, uuid.New())
+	require.NoError(t, repo.Create(ctx, account))
+
+	old, err := domain.NewServiceAccountCredential(account, cluster.ID, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateCredential(ctx, old))
+	current, err := domain.NewServiceAccountCredential(account, cluster.ID, old)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateCredential(ctx, current))
+
+	revokeAfter := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+	old.Supersede(revokeAfter)
+	require.NoError(t, repo.UpdateCredential(ctx, old))
+
+	account.UseCredential(current)
+	require.NoError(t, repo.Update(ctx, account))
+
+	gotAccount, err := repo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	require.NotNil(t, gotAccount.CurrentCredentialID)
+	assert.Equal(t, current.ID, *gotAccount.CurrentCredentialID)
+
+	got, err := repo.GetCredential(ctx, current.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "rotating", got.Username)
+	assert.Equal(t, domain.CredentialMechanismScramSHA256, got.Mechanism)
+	assert.Empty(t, got.Secret, "secrets are not stored")
+
+	due, err := repo.ListCredentialsDueForRevocation(ctx, cluster.ID, time.Now())
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, old.ID, due[0].ID)
+	assert.True(t, revokeAfter.Equal(*due[0].RevokeAfter))
+
+	due, err = repo.ListCredentialsDueForRevocation(ctx, cluster.ID, revokeAfter.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Empty(t, due)
+}
@@ -24,7 +24,7 @@ func (r *SharePolicyRepository) GetEffectivePolicy(ctx context.Context, workspac
 	// Try specific topic first
 	row := r.db.QueryRow(ctx,
 		`SELECT id, workspace_id, scope, topic_pattern, topic_id, environment, visibility,
-			auto_approve, default_permission, require_justification, access_ttl_days, created_at, updated_at
+			auto_approve, default_permission, require_justification, access_ttl_days, approvers, created_at, updated_at
 		 FROM kafka_topic_share_policies
 		 WHERE workspace_id = $1 AND topic_id = $2 AND scope = 'specific-topic'
 		 LIMIT 1`, workspaceID, topicID)
@@ -40,7 +40,7 @@ func (r *SharePolicyRepository) GetEffectivePolicy(ctx context.Context, workspac
 	// Fall back to all-topics scope
 	row = r.db.QueryRow(ctx,
 		`SELECT id, workspace_id, scope, topic_pattern, topic_id, environment, visibility,
-			auto_approve, default_permission, require_justification, access_ttl_days, created_at, updated_at
+			auto_approve, default_permission, require_justification, access_ttl_days, approvers, created_at, updated_at
 		 FROM kafka_topic_share_policies
 		 WHERE workspace_id = $1 AND scope = 'all-topics'
 		 LIMIT 1`, workspaceID)
@@ -58,10 +58,10 @@ func (r *SharePolicyRepository) GetEffectivePolicy(ctx context.Context, workspac
 func scanSharePolicy(s scanner) (*domain.KafkaTopicSharePolicy, error) {
 	var p domain.KafkaTopicSharePolicy
 	var scope, visibility, defaultPerm string
-	var autoApproveJSON []byte
+	var autoApproveJSON, approversJSON []byte
 	err := s.Scan(&p.ID, &p.WorkspaceID, &scope, &p.TopicPattern, &p.TopicID,
 		&p.Environment, &visibility, &autoApproveJSON,
-		&defaultPerm, &p.RequireJustification, &p.AccessTTLDays,
+		&defaultPerm, &p.RequireJustification, &p.AccessTTLDays, &approversJSON,
 		&p.CreatedAt, &p.UpdatedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
@@ -78,5 +78,8 @@ func scanSharePolicy(s scanner) (*domain.KafkaTopicSharePolicy, error) {
 			return nil, err
 		}
 	}
+	if err := json.Unmarshal(approversJSON, &p.Approvers); err != nil {
+		return nil, err
+	}
 	return &p, nil
 }
@@ -20,13 +20,15 @@ func insertSharePolicy(t *testing.T, tx postgres.DBTX, p *domain.KafkaTopicShare
 	if p.AutoApprove != nil {
 		autoApproveJSON, _ = json.Marshal(p.AutoApprove)
 	}
+	approversJSON, _ := json.Marshal(p.Approvers)
 	_, err := tx.Exec(context.Background(),
 		`INSERT INTO kafka_topic_share_policies (id, workspace_id, scope, topic_pattern, topic_id, environment,
-			visibility, auto_approve, default_permission, require_justification, access_ttl_days, created_at, updated_at)
-		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)`,
+			visibility, auto_approve, default_permission, require_justification, access_ttl_days, approvers,
+			created_at, updated_at)
+		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)`,
 		p.ID, p.WorkspaceID, string(p.Scope), p.TopicPattern, p.TopicID, p.Environment,
 		string(p.Visibility), autoApproveJSON, string(p.DefaultPermission),
-		p.RequireJustification, p.AccessTTLDays, p.CreatedAt, p.UpdatedAt)
+		p.RequireJustification, p.AccessTTLDays, approversJSON, p.CreatedAt, p.UpdatedAt)
 	require.NoError(t, err)
 }
 
@@ -42,6 +44,7 @@ func TestSharePolicyRepository_GetEffectivePolicy_SpecificTopic(t *testing.T) {
 	policy.Visibility = domain.TopicVisibilityDiscoverable
 	policy.DefaultPermission = domain.SharePermissionRead
 	policy.RequireJustification = true
+	policy.Approvers = []uuid.UUID{uuid.New()}
 	insertSharePolicy(t, tx, policy)
 
 	got, err := repo.GetEffectivePolicy(ctx, wsID, topic.ID)
@@ -52,6 +55,7 @@ func TestSharePolicyRepository_GetEffectivePolicy_SpecificTopic(t *testing.T) {
 	assert.Equal(t, domain.SharePolicyScopeSpecificTopic, got.Scope)
 	assert.Equal(t, domain.TopicVisibilityDiscoverable, got.Visibility)
 	assert.True(t, got.RequireJustification)
+	assert.Equal(t, policy.Approvers, got.Approvers)
 }
 
 func TestSharePolicyRepository_GetEffectivePolicy_AllTopicsFallback(t *testing.T) {
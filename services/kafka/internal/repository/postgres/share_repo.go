@@ -131,6 +131,41 @@ func (r *ShareRepository) GetExisting(ctx context.Context, topicID, workspaceID
 	return s, nil
 }
 
+// RecordTransition appends a status transition to the share's history
+func (r *ShareRepository) RecordTransition(ctx context.Context, t *domain.ShareTransition) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO kafka_topic_share_transitions (id, share_id, from_status, to_status, actor_id, reason, created_at)
+		 VALUES ($1,$2,$3,$4,$5,$6,$7)`,
+		t.ID, t.ShareID, string(t.FromStatus), string(t.ToStatus), t.ActorID, t.Reason, t.CreatedAt)
+	return err
+}
+
+// ListTransitions returns a share's status history, oldest first
+func (r *ShareRepository) ListTransitions(ctx context.Context, shareID uuid.UUID) ([]*domain.ShareTransition, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, share_id, from_status, to_status, actor_id, reason, created_at
+		 FROM kafka_topic_share_transitions
+		 WHERE share_id = $1
+		 ORDER BY created_at, id`, shareID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []*domain.ShareTransition
+	for rows.Next() {
+		var t domain.ShareTransition
+		var from, to string
+		if err := rows.Scan(&t.ID, &t.ShareID, &from, &to, &t.ActorID, &t.Reason, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		t.FromStatus = domain.ShareStatus(from)
+		t.ToStatus = domain.ShareStatus(to)
+		transitions = append(transitions, &t)
+	}
+	return transitions, rows.Err()
+}
+
 func scanShare(s scanner) (*domain.KafkaTopicShare, error) {
 	var sh domain.KafkaTopicShare
 	var sharedWithType, permission, status string
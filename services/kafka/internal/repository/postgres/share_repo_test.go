@@ -5,6 +5,7 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
 	"github.com/drewpayment/orbit/services/kafka/internal/repository/postgres"
@@ -107,8 +108,9 @@ func TestShareRepository_Update(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, share))
 
 	approver := uuid.New()
-	share.Approve(approver, nil)
-	err := repo.Update(ctx, share)
+	_, err := share.Approve(approver, nil)
+	require.NoError(t, err)
+	err = repo.Update(ctx, share)
 	require.NoError(t, err)
 
 	got, err := repo.GetByID(ctx, share.ID)
@@ -133,3 +135,30 @@ func TestShareRepository_Delete(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, got)
 }
+
+func TestShareRepository_Transitions(t *testing.T) {
+	tx := setupTestTx(t)
+	topic := createTestTopic(t, tx)
+	repo := postgres.NewShareRepository(tx)
+	ctx := context.Background()
+
+	requester := uuid.New()
+	share := domain.NewTopicShareRequest(topic.ID, uuid.New(), requester, domain.SharePermissionRead, "reason")
+	require.NoError(t, repo.Create(ctx, share))
+	requested := domain.NewShareTransition(share.ID, "", share.Status, requester, "reason")
+	require.NoError(t, repo.RecordTransition(ctx, requested))
+
+	approved, err := share.Approve(uuid.New(), nil)
+	require.NoError(t, err)
+	approved.CreatedAt = requested.CreatedAt.Add(time.Second)
+	require.NoError(t, repo.RecordTransition(ctx, approved))
+
+	got, err := repo.ListTransitions(ctx, share.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, domain.ShareStatus(""), got[0].FromStatus)
+	assert.Equal(t, domain.ShareStatusPendingRequest, got[0].ToStatus)
+	assert.Equal(t, requester, got[0].ActorID)
+	assert.Equal(t, domain.ShareStatusPendingRequest, got[1].FromStatus)
+	assert.Equal(t, domain.ShareStatusApproved, got[1].ToStatus)
+}
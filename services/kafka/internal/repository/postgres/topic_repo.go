@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
 	"github.com/google/uuid"
@@ -81,6 +82,35 @@ func (r *TopicRepository) List(ctx context.Context, workspaceID uuid.UUID, envir
 	return topics, rows.Err()
 }
 
+// ListPage returns up to limit topics ordered by (created_at, id) descending,
+// starting after the given cursor, or from the newest topic when it is nil.
+func (r *TopicRepository) ListPage(ctx context.Context, workspaceID uuid.UUID, environment string, after *domain.PageCursor, limit int) ([]*domain.KafkaTopic, error) {
+	query := `SELECT ` + topicColumns + ` FROM kafka_topics WHERE workspace_id = $1 AND environment = $2`
+	args := []any{workspaceID, environment}
+	if after != nil {
+		query += ` AND (created_at, id) < ($3, $4)`
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(` ORDER BY created_at DESC, id DESC LIMIT $%d`, len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var topics []*domain.KafkaTopic
+	for rows.Next() {
+		t, err := scanTopic(rows)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, t)
+	}
+	return topics, rows.Err()
+}
+
 func (r *TopicRepository) Update(ctx context.Context, topic *domain.KafkaTopic) error {
 	configJSON, err := json.Marshal(topic.Config)
 	if err != nil {
@@ -4,6 +4,7 @@ package postgres_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
@@ -95,6 +96,35 @@ func TestTopicRepository_List(t *testing.T) {
 	assert.Len(t, topics, 2)
 }
 
+func TestTopicRepository_ListPage(t *testing.T) {
+	tx := setupTestTx(t)
+	cluster := createTestCluster(t, tx)
+	repo := postgres.NewTopicRepository(tx)
+	ctx := context.Background()
+
+	wsID := uuid.New()
+	for i := 0; i < 5; i++ {
+		topic := domain.NewKafkaTopic(wsID, fmt.Sprintf("page-topic-%d", i), "dev")
+		topic.ClusterID = cluster.ID
+		require.NoError(t, repo.Create(ctx, topic))
+	}
+
+	first, err := repo.ListPage(ctx, wsID, "dev", nil, 3)
+	require.NoError(t, err)
+	require.Len(t, first, 3)
+
+	last := first[len(first)-1]
+	rest, err := repo.ListPage(ctx, wsID, "dev", &domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID}, 3)
+	require.NoError(t, err)
+	require.Len(t, rest, 2)
+
+	seen := map[uuid.UUID]bool{}
+	for _, topic := range append(first, rest...) {
+		assert.False(t, seen[topic.ID], "topic %s listed twice", topic.Name)
+		seen[topic.ID] = true
+	}
+}
+
 func TestTopicRepository_Update(t *testing.T) {
 	tx := setupTestTx(t)
 	cluster := createTestCluster(t, tx)
@@ -194,6 +194,28 @@ type CreateTopicDirectRequest struct {
 	Credentials       map[string]string
 }
 
+// GetCluster retrieves a cluster by ID
+func (s *ClusterService) GetCluster(ctx context.Context, clusterID uuid.UUID) (*domain.KafkaCluster, error) {
+	cluster, err := s.clusterRepo.GetByID(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, domain.ErrClusterNotFound
+	}
+	return cluster, nil
+}
+
+// clusterAdapter returns a Kafka adapter for a registered cluster. The caller
+// must close it.
+func (s *ClusterService) clusterAdapter(ctx context.Context, clusterID uuid.UUID, credentials map[string]string) (adapters.KafkaAdapter, error) {
+	cluster, err := s.GetCluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.adapterFactory.CreateKafkaAdapter(cluster, credentials)
+}
+
 // ListClusters returns all registered clusters
 func (s *ClusterService) ListClusters(ctx context.Context) ([]*domain.KafkaCluster, error) {
 	return s.clusterRepo.List(ctx)
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
+	"github.com/drewpayment/orbit/services/kafka/internal/domain"
+	"github.com/google/uuid"
+)
+
+// DefaultCredentialGracePeriod is how long a superseded credential stays
+// valid after rotation
+const DefaultCredentialGracePeriod = 24 * time.Hour
+
+// RotateCredentialRequest contains parameters for credential rotation
+type RotateCredentialRequest struct {
+	ServiceAccountID uuid.UUID
+	ClusterID        uuid.UUID
+	Credentials      map[string]string // admin credentials for the cluster
+	GracePeriod      time.Duration     // zero uses DefaultCredentialGracePeriod
+}
+
+// CredentialRotation is the result of rotating a service account's credential.
+// Consumers should move to Current before Previous is revoked.
+type CredentialRotation struct {
+	Account  *domain.KafkaServiceAccount
+	Current  *domain.ServiceAccountCredential // carries the new secret
+	Previous *domain.ServiceAccountCredential // nil on the account's first rotation
+}
+
+// CredentialRotationHook is called after a rotation has been persisted
+type CredentialRotationHook func(ctx context.Context, rotation *CredentialRotation)
+
+// SetCredentialRotationHook registers a callback run after each rotation
+func (s *ShareService) SetCredentialRotationHook(hook CredentialRotationHook) {
+	s.rotationHook = hook
+}
+
+// RotateCredential issues a new secret for a service account on a cluster and
+// makes it the account's current credential. The previous credential stays
+// valid for the grace period and is then revoked by RevokeSupersededCredentials.
+func (s *ShareService) RotateCredential(ctx context.Context, req RotateCredentialRequest) (*CredentialRotation, error) {
+	account, err := s.GetServiceAccount(ctx, req.ServiceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IsActive() {
+		return nil, domain.ErrServiceAccountRevoked
+	}
+
+	var previous *domain.ServiceAccountCredential
+	if account.CurrentCredentialID != nil {
+		previous, err = s.serviceAccountRepo.GetCredential(ctx, *account.CurrentCredentialID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := domain.NewServiceAccountCredential(account, req.ClusterID, previous)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate credential: %w", err)
+	}
+	current.CreatedAt = s.now()
+
+	adapter, err := s.topicService.clusterService.clusterAdapter(ctx, req.ClusterID, req.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	defer adapter.Close()
+
+	if err := adapter.UpsertCredential(ctx, adapters.CredentialSpec{
+		Username:  current.Username,
+		Password:  current.Secret,
+		Mechanism: current.Mechanism,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.serviceAccountRepo.CreateCredential(ctx, current); err != nil {
+		return nil, err
+	}
+
+	if previous != nil {
+		gracePeriod := req.GracePeriod
+		if gracePeriod <= 0 {
+			gracePeriod = DefaultCredentialGracePeriod
+		}
+		previous.Supersede(current.CreatedAt.Add(gracePeriod))
+		if err := s.serviceAccountRepo.UpdateCredential(ctx, previous); err != nil {
+			return nil, err
+		}
+	}
+
+	account.UseCredential(current)
+	if err := s.serviceAccountRepo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+
+	rotation := &CredentialRotation{Account: account, Current: current, Previous: previous}
+	if s.rotationHook != nil {
+		s.rotationHook(ctx, rotation)
+	}
+	return rotation, nil
+}
+
+// RevokeSupersededCredentials deletes superseded credentials whose grace
+// period has ended from the cluster and marks them revoked. It returns the
+// credentials it revoked; a failure on one credential does not stop the rest.
+func (s *ShareService) RevokeSupersededCredentials(ctx context.Context, clusterID uuid.UUID, credentials map[string]string) ([]*domain.ServiceAccountCredential, error) {
+	due, err := s.serviceAccountRepo.ListCredentialsDueForRevocation(ctx, clusterID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if len(due) == 0 {
+		return nil, nil
+	}
+
+	adapter, err := s.topicService.clusterService.clusterAdapter(ctx, clusterID, credentials)
+	if err != nil {
+		return nil, err
+	}
+	defer adapter.Close()
+
+	var revoked []*domain.ServiceAccountCredential
+	var errs []error
+	for _, cred := range due {
+		if err := adapter.DeleteCredential(ctx, cred.Username, cred.Mechanism); err != nil {
+			errs = append(errs, fmt.Errorf("credential %s: %w", cred.ID, err))
+			continue
+		}
+		cred.Revoke()
+		if err := s.serviceAccountRepo.UpdateCredential(ctx, cred); err != nil {
+			errs = append(errs, fmt.Errorf("credential %s: %w", cred.ID, err))
+			continue
+		}
+		revoked = append(revoked, cred)
+	}
+	return revoked, errors.Join(errs...)
+}
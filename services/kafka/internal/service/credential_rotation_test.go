@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
+	"github.com/drewpayment/orbit/services/kafka/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryServiceAccountRepo keeps service accounts and credentials in memory
+type memoryServiceAccountRepo struct {
+	ServiceAccountRepository
+	accounts    map[uuid.UUID]*domain.KafkaServiceAccount
+	credentials map[uuid.UUID]*domain.ServiceAccountCredential
+}
+
+func (r *memoryServiceAccountRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.KafkaServiceAccount, error) {
+	return r.accounts[id], nil
+}
+
+func (r *memoryServiceAccountRepo) Update(_ context.Context, account *domain.KafkaServiceAccount) error {
+	r.accounts[account.ID] = account
+	return nil
+}
+
+func (r *memoryServiceAccountRepo) CreateCredential(_ context.Context, cred *domain.ServiceAccountCredential) error {
+	stored := *cred
+	stored.Secret = ""
+	r.credentials[cred.ID] = &stored
+	return nil
+}
+
+func (r *memoryServiceAccountRepo) GetCredential(_ context.Context, id uuid.UUID) (*domain.ServiceAccountCredential, error) {
+	return r.credentials[id], nil
+}
+
+func (r *memoryServiceAccountRepo) UpdateCredential(_ context.Context, cred *domain.ServiceAccountCredential) error {
+	r.credentials[cred.ID] = cred
+	return nil
+}
+
+func (r *memoryServiceAccountRepo) ListCredentialsDueForRevocation(_ context.Context, clusterID uuid.UUID, before time.Time) ([]*domain.ServiceAccountCredential, error) {
+	var due []*domain.ServiceAccountCredential
+	for _, c := range r.credentials {
+		if c.ClusterID == clusterID && c.DueForRevocation(before) {
+			due = append(due, c)
+		}
+	}
+	return due, nil
+}
+
+// scramAdapter tracks SCRAM credentials the way a broker stores them: one
+// password per user and mechanism
+type scramAdapter struct {
+	adapters.KafkaAdapter
+	passwords map[[2]string]string
+}
+
+func (a *scramAdapter) UpsertCredential(_ context.Context, cred adapters.CredentialSpec) error {
+	a.passwords[[2]string{cred.Username, cred.Mechanism}] = cred.Password
+	return nil
+}
+
+func (a *scramAdapter) DeleteCredential(_ context.Context, username, mechanism string) error {
+	delete(a.passwords, [2]string{username, mechanism})
+	return nil
+}
+
+func (a *scramAdapter) Close() error { return nil }
+
+// authenticates reports whether the broker would accept the credential
+func (a *scramAdapter) authenticates(cred *domain.ServiceAccountCredential, secret string) bool {
+	return a.passwords[[2]string{cred.Username, cred.Mechanism}] == secret
+}
+
+type rotationFixture struct {
+	svc     *ShareService
+	repo    *memoryServiceAccountRepo
+	adapter *scramAdapter
+	account *domain.KafkaServiceAccount
+	cluster *domain.KafkaCluster
+	now     time.Time
+}
+
+func newRotationFixture() *rotationFixture {
+	cluster := &domain.KafkaCluster{ID: uuid.New()}
+	account := domain.NewKafkaServiceAccount(uuid.New(), "orders-producer", domain.ServiceAccountTypeProducer, uuid.New())
+	f := &rotationFixture{
+		repo: &memoryServiceAccountRepo{
+			accounts:    map[uuid.UUID]*domain.KafkaServiceAccount{account.ID: account},
+			credentials: make(map[uuid.UUID]*domain.ServiceAccountCredential),
+		},
+		adapter: &scramAdapter{passwords: make(map[[2]string]string)},
+		account: account,
+		cluster: cluster,
+		now:     time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+	factory := stubAdapterFactory{adapter: f.adapter}
+	clusters := NewClusterService(memoryClusterRepo{clusters: map[uuid.UUID]*domain.KafkaCluster{cluster.ID: cluster}}, nil, nil, factory)
+	f.svc = NewShareService(nil, nil, f.repo, NewTopicService(nil, nil, clusters, factory))
+	f.svc.now = func() time.Time { return f.now }
+	return f
+}
+
+func (f *rotationFixture) rotate(t *testing.T, gracePeriod time.Duration) *CredentialRotation {
+	t.Helper()
+	rotation, err := f.svc.RotateCredential(context.Background(), RotateCredentialRequest{
+		ServiceAccountID: f.account.ID,
+		ClusterID:        f.cluster.ID,
+		GracePeriod:      gracePeriod,
+	})
+	require.NoError(t, err)
+	return rotation
+}
+
+func TestShareService_RotateCredential_OverlapWindow(t *testing.T) {
+	f := newRotationFixture()
+	var notified []*CredentialRotation
+	f.svc.SetCredentialRotationHook(func(_ context.Context, rotation *CredentialRotation) {
+		notified = append(notified, rotation)
+	})
+
+	first := f.rotate(t, 0)
+	assert.Nil(t, first.Previous)
+	require.NotEmpty(t, first.Current.Secret)
+	assert.Equal(t, domain.CredentialMechanismScramSHA512, first.Current.Mechanism)
+	assert.Equal(t, "orders-producer", first.Current.Username)
+	assert.True(t, f.adapter.authenticates(first.Current, first.Current.Secret))
+	assert.Empty(t, f.repo.credentials[first.Current.ID].Secret, "secrets are not persisted")
+
+	f.now = f.now.Add(time.Hour)
+	second := f.rotate(t, 2*time.Hour)
+	require.NotNil(t, second.Previous)
+	assert.Equal(t, first.Current.ID, second.Previous.ID)
+	assert.NotEqual(t, first.Current.Secret, second.Current.Secret)
+	assert.Equal(t, domain.CredentialMechanismScramSHA256, second.Current.Mechanism)
+	assert.Equal(t, domain.CredentialStatusSuperseded, second.Previous.Status)
+	assert.Equal(t, f.now.Add(2*time.Hour), *second.Previous.RevokeAfter)
+
+	// Both secrets authenticate during the overlap window
+	assert.True(t, f.adapter.authenticates(first.Current, first.Current.Secret))
+	assert.True(t, f.adapter.authenticates(second.Current, second.Current.Secret))
+
+	account := f.repo.accounts[f.account.ID]
+	require.NotNil(t, account.CurrentCredentialID)
+	assert.Equal(t, second.Current.ID, *account.CurrentCredentialID)
+	assert.Equal(t, f.now, *account.CredentialRotatedAt)
+
+	require.Len(t, notified, 2)
+	assert.Same(t, second, notified[1])
+}
+
+func TestShareService_RevokeSupersededCredentials(t *testing.T) {
+	ctx := context.Background()
+	f := newRotationFixture()
+
+	first := f.rotate(t, 0)
+	second := f.rotate(t, time.Hour)
+
+	f.now = f.now.Add(30 * time.Minute)
+	revoked, err := f.svc.RevokeSupersededCredentials(ctx, f.cluster.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, revoked, "grace period has not ended")
+	assert.True(t, f.adapter.authenticates(first.Current, first.Current.Secret))
+
+	f.now = f.now.Add(30 * time.Minute)
+	revoked, err = f.svc.RevokeSupersededCredentials(ctx, f.cluster.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, revoked, 1)
+	assert.Equal(t, first.Current.ID, revoked[0].ID)
+	assert.Equal(t, domain.CredentialStatusRevoked, f.repo.credentials[first.Current.ID].Status)
+	assert.NotNil(t, f.repo.credentials[first.Current.ID].RevokedAt)
+
+	assert.False(t, f.adapter.authenticates(first.Current, first.Current.Secret), "superseded secret no longer works")
+	assert.True(t, f.adapter.authenticates(second.Current, second.Current.Secret))
+	assert.Equal(t, domain.CredentialStatusActive, f.repo.credentials[second.Current.ID].Status)
+
+	// A third rotation reuses the freed mechanism
+	third := f.rotate(t, 0)
+	assert.Equal(t, domain.CredentialMechanismScramSHA512, third.Current.Mechanism)
+}
+
+func TestShareService_RotateCredential_RevokedAccount(t *testing.T) {
+	f := newRotationFixture()
+	f.account.Revoke()
+
+	_, err := f.svc.RotateCredential(context.Background(), RotateCredentialRequest{
+		ServiceAccountID: f.account.ID,
+		ClusterID:        f.cluster.ID,
+	})
+	assert.ErrorIs(t, err, domain.ErrServiceAccountRevoked)
+	assert.Empty(t, f.adapter.passwords)
+}
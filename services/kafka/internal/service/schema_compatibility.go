@@ -0,0 +1,357 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/drewpayment/orbit/services/kafka/internal/domain"
+)
+
+// checkSchemaCompatibility validates a new schema version against the
+// previous one under the given compatibility mode. Only Avro schemas are
+// checked locally; other formats are left to the schema registry. It returns
+// a *domain.SchemaCompatibilityError when the new version is rejected.
+func checkSchemaCompatibility(format domain.SchemaFormat, compatibility domain.SchemaCompatibility, previous, next string) error {
+	if format != domain.SchemaFormatAvro || compatibility == domain.SchemaCompatibilityNone || previous == "" {
+		return nil
+	}
+
+	prev, err := parseAvroSchema(previous)
+	if err != nil {
+		return fmt.Errorf("parse previous schema: %w", err)
+	}
+	curr, err := parseAvroSchema(next)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrSchemaInvalidFormat, err)
+	}
+
+	var problems []string
+	if compatibility == domain.SchemaCompatibilityBackward || compatibility == domain.SchemaCompatibilityFull {
+		// Consumers using the new schema must read data written with the previous one
+		problems = append(problems, newAvroResolver(curr, prev).check(curr.root, prev.root, "")...)
+	}
+	if compatibility == domain.SchemaCompatibilityForward || compatibility == domain.SchemaCompatibilityFull {
+		// Consumers still using the previous schema must read data written with the new one
+		problems = append(problems, newAvroResolver(prev, curr).check(prev.root, curr.root, "")...)
+	}
+	if len(problems) > 0 {
+		return &domain.SchemaCompatibilityError{
+			Compatibility:     compatibility,
+			Incompatibilities: problems,
+		}
+	}
+	return nil
+}
+
+// avroType is a parsed Avro schema node
+type avroType struct {
+	kind     string // primitive name, record, enum, array, map, fixed, union or ref
+	name     string // full name of named types and refs
+	fields   []avroField
+	symbols  []string
+	items    *avroType
+	values   *avroType
+	branches []*avroType
+	size     int
+}
+
+type avroField struct {
+	name       string
+	aliases    []string
+	typ        *avroType
+	hasDefault bool
+}
+
+type avroSchema struct {
+	root  *avroType
+	named map[string]*avroType
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true,
+	"float": true, "double": true, "bytes": true, "string": true,
+}
+
+func parseAvroSchema(content string) (*avroSchema, error) {
+	var raw interface{}
+	if err := json.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, err
+	}
+	s := &avroSchema{named: map[string]*avroType{}}
+	root, err := s.parse(raw, "")
+	if err != nil {
+		return nil, err
+	}
+	s.root = root
+	return s, nil
+}
+
+func (s *avroSchema) parse(raw interface{}, namespace string) (*avroType, error) {
+	switch v := raw.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return &avroType{kind: v}, nil
+		}
+		return &avroType{kind: "ref", name: avroFullName(v, namespace)}, nil
+	case []interface{}:
+		union := &avroType{kind: "union"}
+		for _, b := range v {
+			branch, err := s.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.branches = append(union.branches, branch)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return s.parseObject(v, namespace)
+	default:
+		return nil, fmt.Errorf("unexpected schema node %v", raw)
+	}
+}
+
+func (s *avroSchema) parseObject(v map[string]interface{}, namespace string) (*avroType, error) {
+	kind, _ := v["type"].(string)
+	if kind == "" {
+		// {"type": {...}} or {"type": [...]} wraps another schema
+		if inner, ok := v["type"]; ok {
+			return s.parse(inner, namespace)
+		}
+		return nil, fmt.Errorf("schema object without type")
+	}
+
+	t := &avroType{kind: kind}
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		if kind == "error" {
+			t.kind = "record"
+		}
+		name, _ := v["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("%s without name", kind)
+		}
+		if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		t.name = avroFullName(name, namespace)
+		if i := strings.LastIndex(t.name, "."); i >= 0 {
+			namespace = t.name[:i]
+		}
+		s.named[t.name] = t
+	}
+
+	switch t.kind {
+	case "record":
+		fields, _ := v["fields"].([]interface{})
+		for _, f := range fields {
+			fm, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("record %s has invalid field", t.name)
+			}
+			name, _ := fm["name"].(string)
+			typ, err := s.parse(fm["type"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+			_, hasDefault := fm["default"]
+			field := avroField{name: name, typ: typ, hasDefault: hasDefault}
+			if aliases, ok := fm["aliases"].([]interface{}); ok {
+				for _, a := range aliases {
+					if alias, ok := a.(string); ok {
+						field.aliases = append(field.aliases, alias)
+					}
+				}
+			}
+			t.fields = append(t.fields, field)
+		}
+	case "enum":
+		symbols, _ := v["symbols"].([]interface{})
+		for _, sym := range symbols {
+			if str, ok := sym.(string); ok {
+				t.symbols = append(t.symbols, str)
+			}
+		}
+	case "fixed":
+		size, _ := v["size"].(float64)
+		t.size = int(size)
+	case "array":
+		items, err := s.parse(v["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		t.items = items
+	case "map":
+		values, err := s.parse(v["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		t.values = values
+	default:
+		// Primitives with attributes such as logicalType
+		if !avroPrimitives[t.kind] {
+			return nil, fmt.Errorf("unknown type %q", t.kind)
+		}
+	}
+	return t, nil
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// avroResolver applies the Avro schema resolution rules for a reader schema
+// reading data written with a writer schema.
+type avroResolver struct {
+	reader, writer *avroSchema
+	// visited breaks recursion through recursive named types
+	visited map[string]bool
+}
+
+func newAvroResolver(reader, writer *avroSchema) *avroResolver {
+	return &avroResolver{reader: reader, writer: writer, visited: map[string]bool{}}
+}
+
+func (r *avroResolver) check(reader, writer *avroType, path string) []string {
+	reader = r.resolve(reader, r.reader)
+	writer = r.resolve(writer, r.writer)
+	if reader == nil || writer == nil {
+		return []string{fmt.Sprintf("%s: unresolved named type", avroPath(path))}
+	}
+
+	if writer.kind == "union" {
+		var problems []string
+		for _, branch := range writer.branches {
+			problems = append(problems, r.check(reader, branch, path)...)
+		}
+		return problems
+	}
+	if reader.kind == "union" {
+		for _, branch := range reader.branches {
+			// A failed branch must not leave records marked as checked
+			visited := make(map[string]bool, len(r.visited))
+			for k, v := range r.visited {
+				visited[k] = v
+			}
+			if len(r.check(branch, writer, path)) == 0 {
+				return nil
+			}
+			r.visited = visited
+		}
+		return []string{fmt.Sprintf("%s: reader union has no branch for writer type %s", avroPath(path), writer.describe())}
+	}
+
+	if !avroPromotable(writer.kind, reader.kind) {
+		return []string{fmt.Sprintf("%s: type changed from %s to %s", avroPath(path), writer.describe(), reader.describe())}
+	}
+
+	switch reader.kind {
+	case "record":
+		key := reader.name + "<-" + writer.name
+		if r.visited[key] {
+			return nil
+		}
+		r.visited[key] = true
+		return r.checkRecord(reader, writer, path)
+	case "enum":
+		var problems []string
+		for _, sym := range writer.symbols {
+			if !containsString(reader.symbols, sym) {
+				problems = append(problems, fmt.Sprintf("%s: enum symbol %q was removed", avroPath(path), sym))
+			}
+		}
+		return problems
+	case "fixed":
+		if reader.size != writer.size {
+			return []string{fmt.Sprintf("%s: fixed size changed from %d to %d", avroPath(path), writer.size, reader.size)}
+		}
+	case "array":
+		return r.check(reader.items, writer.items, path+"[]")
+	case "map":
+		return r.check(reader.values, writer.values, path+"{}")
+	}
+	return nil
+}
+
+func (r *avroResolver) checkRecord(reader, writer *avroType, path string) []string {
+	var problems []string
+	for _, rf := range reader.fields {
+		fieldPath := rf.name
+		if path != "" {
+			fieldPath = path + "." + rf.name
+		}
+		wf := findAvroField(writer, rf)
+		if wf == nil {
+			if !rf.hasDefault {
+				problems = append(problems, fmt.Sprintf("%s: field has no default and is missing from the other version", fieldPath))
+			}
+			continue
+		}
+		problems = append(problems, r.check(rf.typ, wf.typ, fieldPath)...)
+	}
+	return problems
+}
+
+func (r *avroResolver) resolve(t *avroType, s *avroSchema) *avroType {
+	if t != nil && t.kind == "ref" {
+		return s.named[t.name]
+	}
+	return t
+}
+
+func (t *avroType) describe() string {
+	if t.name != "" {
+		return t.kind + " " + t.name
+	}
+	return t.kind
+}
+
+func findAvroField(record *avroType, rf avroField) *avroField {
+	for i := range record.fields {
+		wf := &record.fields[i]
+		if wf.name == rf.name || containsString(rf.aliases, wf.name) {
+			return wf
+		}
+	}
+	return nil
+}
+
+// avroPromotable reports whether data of the writer type can be read as the
+// reader type
+func avroPromotable(writer, reader string) bool {
+	if writer == reader {
+		return true
+	}
+	switch writer {
+	case "int":
+		return reader == "long" || reader == "float" || reader == "double"
+	case "long":
+		return reader == "float" || reader == "double"
+	case "float":
+		return reader == "double"
+	case "string":
+		return reader == "bytes"
+	case "bytes":
+		return reader == "string"
+	}
+	return false
+}
+
+func avroPath(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+	return path
+}
+
+func containsString(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/drewpayment/orbit/services/kafka/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userV1 = `{"type":"record","name":"User","namespace":"com.example","fields":[
+		{"name":"id","type":"string"},
+		{"name":"email","type":"string"}
+	]}`
+	// adds a field with a default
+	userAddDefaulted = `{"type":"record","name":"User","namespace":"com.example","fields":[
+		{"name":"id","type":"string"},
+		{"name":"email","type":"string"},
+		{"name":"age","type":"int","default":0}
+	]}`
+	// adds a field without a default
+	userAddRequired = `{"type":"record","name":"User","namespace":"com.example","fields":[
+		{"name":"id","type":"string"},
+		{"name":"email","type":"string"},
+		{"name":"age","type":"int"}
+	]}`
+	// removes a field that has no default
+	userRemoveEmail = `{"type":"record","name":"User","namespace":"com.example","fields":[
+		{"name":"id","type":"string"}
+	]}`
+)
+
+func TestCheckSchemaCompatibility_Modes(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       domain.SchemaCompatibility
+		previous   string
+		next       string
+		compatible bool
+		problem    string
+	}{
+		{"backward: add field with default", domain.SchemaCompatibilityBackward, userV1, userAddDefaulted, true, ""},
+		{"backward: add field without default", domain.SchemaCompatibilityBackward, userV1, userAddRequired, false, "age: field has no default"},
+		{"backward: remove field", domain.SchemaCompatibilityBackward, userV1, userRemoveEmail, true, ""},
+
+		{"forward: add field without default", domain.SchemaCompatibilityForward, userV1, userAddRequired, true, ""},
+		{"forward: remove field without default", domain.SchemaCompatibilityForward, userV1, userRemoveEmail, false, "email: field has no default"},
+		{"forward: remove field with default", domain.SchemaCompatibilityForward, userAddDefaulted, userV1, true, ""},
+
+		{"full: add field with default", domain.SchemaCompatibilityFull, userV1, userAddDefaulted, true, ""},
+		{"full: add field without default", domain.SchemaCompatibilityFull, userV1, userAddRequired, false, "age: field has no default"},
+		{"full: remove field without default", domain.SchemaCompatibilityFull, userV1, userRemoveEmail, false, "email: field has no default"},
+
+		{"none: add field without default", domain.SchemaCompatibilityNone, userV1, userAddRequired, true, ""},
+		{"none: remove field", domain.SchemaCompatibilityNone, userV1, userRemoveEmail, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSchemaCompatibility(domain.SchemaFormatAvro, tt.mode, tt.previous, tt.next)
+			if tt.compatible {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, domain.ErrSchemaIncompatible))
+
+			var compatErr *domain.SchemaCompatibilityError
+			require.True(t, errors.As(err, &compatErr))
+			assert.Equal(t, tt.mode, compatErr.Compatibility)
+			require.NotEmpty(t, compatErr.Incompatibilities)
+			assert.Contains(t, compatErr.Incompatibilities[0], tt.problem)
+		})
+	}
+}
+
+func TestCheckSchemaCompatibility_TypeChanges(t *testing.T) {
+	record := func(fieldType string) string {
+		return `{"type":"record","name":"Event","fields":[{"name":"value","type":` + fieldType + `}]}`
+	}
+
+	tests := []struct {
+		name       string
+		previous   string
+		next       string
+		compatible bool
+	}{
+		{"int promoted to long", record(`"int"`), record(`"long"`), true},
+		{"long narrowed to int", record(`"long"`), record(`"int"`), false},
+		{"string to bytes", record(`"string"`), record(`"bytes"`), true},
+		{"made nullable", record(`"string"`), record(`["null","string"]`), true},
+		{"nullable made required", record(`["null","string"]`), record(`"string"`), false},
+		{"enum symbol added", record(`{"type":"enum","name":"Color","symbols":["RED"]}`), record(`{"type":"enum","name":"Color","symbols":["RED","BLUE"]}`), true},
+		{"enum symbol removed", record(`{"type":"enum","name":"Color","symbols":["RED","BLUE"]}`), record(`{"type":"enum","name":"Color","symbols":["RED"]}`), false},
+		{"nested record field added without default", record(`{"type":"record","name":"Inner","fields":[]}`), record(`{"type":"record","name":"Inner","fields":[{"name":"x","type":"int"}]}`), false},
+		{"array items promoted", record(`{"type":"array","items":"int"}`), record(`{"type":"array","items":"double"}`), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSchemaCompatibility(domain.SchemaFormatAvro, domain.SchemaCompatibilityBackward, tt.previous, tt.next)
+			if tt.compatible {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, domain.ErrSchemaIncompatible)
+			}
+		})
+	}
+}
+
+func TestCheckSchemaCompatibility_RecursiveType(t *testing.T) {
+	node := `{"type":"record","name":"Node","fields":[
+		{"name":"value","type":"int"},
+		{"name":"next","type":["null","Node"],"default":null}
+	]}`
+	assert.NoError(t, checkSchemaCompatibility(domain.SchemaFormatAvro, domain.SchemaCompatibilityFull, node, node))
+}
+
+func TestCheckSchemaCompatibility_SkipsOtherFormats(t *testing.T) {
+	err := checkSchemaCompatibility(domain.SchemaFormatProtobuf, domain.SchemaCompatibilityFull, "syntax = \"proto3\";", "not checked")
+	assert.NoError(t, err)
+}
+
+func TestCheckSchemaCompatibility_InvalidSchema(t *testing.T) {
+	err := checkSchemaCompatibility(domain.SchemaFormatAvro, domain.SchemaCompatibilityBackward, userV1, `{"type":`)
+	assert.ErrorIs(t, err, domain.ErrSchemaInvalidFormat)
+}
+
+// fakeSchemaRepo is an in-memory SchemaRepository keyed by topic and type.
+type fakeSchemaRepo struct {
+	schemas map[uuid.UUID]*domain.KafkaSchema
+	writes  int
+}
+
+func (f *fakeSchemaRepo) Create(_ context.Context, s *domain.KafkaSchema) error {
+	f.writes++
+	f.schemas[s.ID] = s
+	return nil
+}
+func (f *fakeSchemaRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.KafkaSchema, error) {
+	return f.schemas[id], nil
+}
+func (f *fakeSchemaRepo) GetBySubject(_ context.Context, topicID uuid.UUID, schemaType string) (*domain.KafkaSchema, error) {
+	for _, s := range f.schemas {
+		if s.TopicID == topicID && string(s.Type) == schemaType {
+			return s, nil
+		}
+	}
+	return nil, domain.ErrSchemaNotFound
+}
+func (f *fakeSchemaRepo) List(context.Context, uuid.UUID) ([]*domain.KafkaSchema, error) {
+	return nil, nil
+}
+func (f *fakeSchemaRepo) Update(_ context.Context, s *domain.KafkaSchema) error {
+	f.writes++
+	f.schemas[s.ID] = s
+	return nil
+}
+func (f *fakeSchemaRepo) Delete(context.Context, uuid.UUID) error { return nil }
+
+// fakeTopicRepo returns a single preconfigured topic.
+type fakeTopicRepo struct {
+	topic *domain.KafkaTopic
+}
+
+func (f *fakeTopicRepo) Create(context.Context, *domain.KafkaTopic) error { return nil }
+func (f *fakeTopicRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.KafkaTopic, error) {
+	if f.topic != nil && f.topic.ID == id {
+		return f.topic, nil
+	}
+	return nil, nil
+}
+func (f *fakeTopicRepo) GetByName(context.Context, uuid.UUID, string, string) (*domain.KafkaTopic, error) {
+	return nil, nil
+}
+func (f *fakeTopicRepo) List(context.Context, uuid.UUID, string) ([]*domain.KafkaTopic, error) {
+	return nil, nil
+}
+func (f *fakeTopicRepo) ListPage(context.Context, uuid.UUID, string, *domain.PageCursor, int) ([]*domain.KafkaTopic, error) {
+	return nil, nil
+}
+func (f *fakeTopicRepo) Update(context.Context, *domain.KafkaTopic) error { return nil }
+func (f *fakeTopicRepo) Delete(context.Context, uuid.UUID) error          { return nil }
+
+func TestSchemaService_RegisterSchema_RejectsIncompatibleVersion(t *testing.T) {
+	topic := &domain.KafkaTopic{ID: uuid.New(), WorkspaceID: uuid.New(), Environment: "dev", Name: "users"}
+	schemaRepo := &fakeSchemaRepo{schemas: map[uuid.UUID]*domain.KafkaSchema{}}
+	svc := NewSchemaService(schemaRepo, nil, NewTopicService(&fakeTopicRepo{topic: topic}, nil, nil, nil), nil)
+	ctx := context.Background()
+
+	first, err := svc.RegisterSchema(ctx, RegisterSchemaRequest{
+		TopicID:       topic.ID,
+		Type:          "value",
+		Format:        domain.SchemaFormatAvro,
+		Content:       userV1,
+		Compatibility: domain.SchemaCompatibilityFull,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SchemaCompatibilityFull, first.Compatibility)
+	writes := schemaRepo.writes
+
+	// The subject's FULL mode applies when the caller does not specify one
+	_, err = svc.RegisterSchema(ctx, RegisterSchemaRequest{
+		TopicID: topic.ID,
+		Type:    "value",
+		Format:  domain.SchemaFormatAvro,
+		Content: userRemoveEmail,
+	})
+	var compatErr *domain.SchemaCompatibilityError
+	require.True(t, errors.As(err, &compatErr), "expected SchemaCompatibilityError, got %v", err)
+	assert.Equal(t, domain.SchemaCompatibilityFull, compatErr.Compatibility)
+	assert.Equal(t, writes, schemaRepo.writes, "incompatible version must not be written")
+	assert.Equal(t, userV1, schemaRepo.schemas[first.ID].Content)
+
+	// A compatible evolution is stored
+	_, err = svc.RegisterSchema(ctx, RegisterSchemaRequest{
+		TopicID: topic.ID,
+		Type:    "value",
+		Format:  domain.SchemaFormatAvro,
+		Content: userAddDefaulted,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, userAddDefaulted, schemaRepo.schemas[first.ID].Content)
+}
+
+func TestSchemaService_RegisterSchema_InvalidCompatibility(t *testing.T) {
+	topic := &domain.KafkaTopic{ID: uuid.New(), WorkspaceID: uuid.New(), Environment: "dev", Name: "users"}
+	schemaRepo := &fakeSchemaRepo{schemas: map[uuid.UUID]*domain.KafkaSchema{}}
+	svc := NewSchemaService(schemaRepo, nil, NewTopicService(&fakeTopicRepo{topic: topic}, nil, nil, nil), nil)
+
+	_, err := svc.RegisterSchema(context.Background(), RegisterSchemaRequest{
+		TopicID:       topic.ID,
+		Type:          "value",
+		Format:        domain.SchemaFormatAvro,
+		Content:       userV1,
+		Compatibility: "sideways",
+	})
+	assert.ErrorIs(t, err, domain.ErrSchemaInvalidCompatibility)
+	assert.Zero(t, schemaRepo.writes)
+}
@@ -60,6 +60,31 @@ func (s *SchemaService) RegisterSchema(ctx context.Context, req RegisterSchemaRe
 		return nil, err
 	}
 
+	// Look up the cluster's schema registry, if any
+	var registry *domain.SchemaRegistry
+	if topic.ClusterID != uuid.Nil {
+		registry, err = s.registryRepo.GetByClusterID(ctx, topic.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Resolve the compatibility mode: requested, then the subject's current
+	// mode, then the registry default
+	compatibility := req.Compatibility
+	if compatibility == "" && existing != nil {
+		compatibility = existing.Compatibility
+	}
+	if compatibility == "" && registry != nil {
+		compatibility = registry.DefaultCompatibility
+	}
+	if compatibility == "" {
+		compatibility = domain.SchemaCompatibilityBackward
+	}
+	if !compatibility.Valid() {
+		return nil, domain.ErrSchemaInvalidCompatibility
+	}
+
 	// Generate subject name: {env}.{workspace}.{topic}-{type}
 	subject := generateSubjectName(topic, req.Type)
 
@@ -71,7 +96,7 @@ func (s *SchemaService) RegisterSchema(ctx context.Context, req RegisterSchemaRe
 		Subject:       subject,
 		Format:        req.Format,
 		Content:       req.Content,
-		Compatibility: req.Compatibility,
+		Compatibility: compatibility,
 		Status:        domain.SchemaStatusPending,
 	}
 
@@ -86,6 +111,13 @@ func (s *SchemaService) RegisterSchema(ctx context.Context, req RegisterSchemaRe
 		return nil, domain.ErrSchemaContentRequired
 	}
 
+	// Reject a new version that breaks the compatibility mode before writing
+	if existing != nil && existing.Status != domain.SchemaStatusFailed && existing.Format == schema.Format {
+		if err := checkSchemaCompatibility(schema.Format, compatibility, existing.Content, schema.Content); err != nil {
+			return nil, err
+		}
+	}
+
 	// Store schema (initially pending)
 	if existing == nil {
 		if err := s.schemaRepo.Create(ctx, schema); err != nil {
@@ -97,7 +129,16 @@ func (s *SchemaService) RegisterSchema(ctx context.Context, req RegisterSchemaRe
 		}
 	}
 
-	return schema, nil
+	// Register with the cluster's schema registry when one is configured;
+	// otherwise the schema stays pending until SyncSchema is called
+	if registry == nil {
+		return schema, nil
+	}
+	if err := s.SyncSchema(ctx, schema.ID, req.Credentials); err != nil {
+		return nil, err
+	}
+
+	return s.schemaRepo.GetByID(ctx, schema.ID)
 }
 
 // SyncSchema registers a schema with the schema registry
@@ -124,6 +165,9 @@ func (s *SchemaService) SyncSchema(ctx context.Context, schemaID uuid.UUID, cred
 	if err != nil {
 		return err
 	}
+	if registry == nil {
+		return domain.ErrSchemaRegistryNotFound
+	}
 
 	// Create schema registry adapter
 	adapter, err := s.adapterFactory.CreateSchemaRegistryAdapter(registry, credentials)
@@ -133,6 +177,15 @@ func (s *SchemaService) SyncSchema(ctx context.Context, schemaID uuid.UUID, cred
 		return err
 	}
 
+	// Apply the requested compatibility level before checking against it
+	if schema.Compatibility != "" {
+		if err := adapter.SetCompatibility(ctx, schema.Subject, schema.Compatibility); err != nil {
+			schema.Status = domain.SchemaStatusFailed
+			s.schemaRepo.Update(ctx, schema)
+			return err
+		}
+	}
+
 	// Check compatibility first
 	compatible, err := adapter.CheckCompatibility(ctx, schema.Subject, adapters.SchemaSpec{
 		Schema:     schema.Content,
@@ -144,7 +197,7 @@ func (s *SchemaService) SyncSchema(ctx context.Context, schemaID uuid.UUID, cred
 	if !compatible {
 		schema.Status = domain.SchemaStatusFailed
 		s.schemaRepo.Update(ctx, schema)
-		return domain.ErrSchemaIncompatible
+		return &domain.SchemaCompatibilityError{Compatibility: schema.Compatibility}
 	}
 
 	// Register schema
@@ -202,6 +255,10 @@ func (s *SchemaService) CheckSchemaCompatibility(ctx context.Context, req CheckC
 	if err != nil {
 		return false, err
 	}
+	if registry == nil {
+		// No registry yet, assume compatible
+		return true, nil
+	}
 
 	adapter, err := s.adapterFactory.CreateSchemaRegistryAdapter(registry, req.Credentials)
 	if err != nil {
@@ -227,6 +284,8 @@ type RegisterSchemaRequest struct {
 	Format        domain.SchemaFormat
 	Content       string
 	Compatibility domain.SchemaCompatibility
+	// Credentials authenticate to the schema registry, if it requires them
+	Credentials map[string]string
 }
 
 // CheckCompatibilityRequest contains parameters for compatibility check
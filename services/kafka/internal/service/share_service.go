@@ -16,6 +16,8 @@ type ShareRepository interface {
 	Update(ctx context.Context, share *domain.KafkaTopicShare) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetExisting(ctx context.Context, topicID, workspaceID uuid.UUID) (*domain.KafkaTopicShare, error)
+	RecordTransition(ctx context.Context, transition *domain.ShareTransition) error
+	ListTransitions(ctx context.Context, shareID uuid.UUID) ([]*domain.ShareTransition, error)
 }
 
 // SharePolicyRepository defines persistence for share policies
@@ -29,6 +31,10 @@ type ServiceAccountRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.KafkaServiceAccount, error)
 	List(ctx context.Context, workspaceID uuid.UUID) ([]*domain.KafkaServiceAccount, error)
 	Update(ctx context.Context, account *domain.KafkaServiceAccount) error
+	CreateCredential(ctx context.Context, cred *domain.ServiceAccountCredential) error
+	GetCredential(ctx context.Context, id uuid.UUID) (*domain.ServiceAccountCredential, error)
+	UpdateCredential(ctx context.Context, cred *domain.ServiceAccountCredential) error
+	ListCredentialsDueForRevocation(ctx context.Context, clusterID uuid.UUID, before time.Time) ([]*domain.ServiceAccountCredential, error)
 }
 
 // ShareService handles topic sharing operations
@@ -37,6 +43,8 @@ type ShareService struct {
 	policyRepo         SharePolicyRepository
 	serviceAccountRepo ServiceAccountRepository
 	topicService       *TopicService
+	rotationHook       CredentialRotationHook
+	now                func() time.Time
 }
 
 // NewShareService creates a new ShareService
@@ -51,6 +59,7 @@ func NewShareService(
 		policyRepo:         policyRepo,
 		serviceAccountRepo: serviceAccountRepo,
 		topicService:       topicService,
+		now:                time.Now,
 	}
 }
 
@@ -92,85 +101,147 @@ func (s *ShareService) RequestTopicAccess(ctx context.Context, req RequestAccess
 		expiresAt = &expires
 	}
 
+	transitions := []*domain.ShareTransition{
+		domain.NewShareTransition(share.ID, "", share.Status, req.RequestedBy, req.Reason),
+	}
+
 	// Check if auto-approval applies
 	if policy != nil && policy.ShouldAutoApprove(topic.WorkspaceID, req.TargetWorkspaceID.String(), req.Permission) {
-		share.Approve(req.RequestedBy, expiresAt)
+		approved, err := share.Approve(req.RequestedBy, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		approved.Reason = "auto-approved by share policy"
+		transitions = append(transitions, approved)
 	}
 
 	if err := s.shareRepo.Create(ctx, share); err != nil {
 		return nil, err
 	}
+	for _, t := range transitions {
+		if err := s.shareRepo.RecordTransition(ctx, t); err != nil {
+			return nil, err
+		}
+	}
 
 	return share, nil
 }
 
-// ApproveTopicAccess approves a pending share request
-func (s *ShareService) ApproveTopicAccess(ctx context.Context, shareID uuid.UUID, approverID uuid.UUID) (*domain.KafkaTopicShare, error) {
-	share, err := s.shareRepo.GetByID(ctx, shareID)
+// ApproveTopicAccess approves a pending share request. The actor must be a
+// named approver in the topic's share policy or, when the policy names none,
+// act for the topic's owning workspace.
+func (s *ShareService) ApproveTopicAccess(ctx context.Context, shareID uuid.UUID, actor ShareActor) (*domain.KafkaTopicShare, error) {
+	share, topic, err := s.loadShare(ctx, shareID)
 	if err != nil {
 		return nil, err
 	}
-	if share == nil {
-		return nil, domain.ErrShareNotFound
+	policy, err := s.policyRepo.GetEffectivePolicy(ctx, topic.WorkspaceID, topic.ID)
+	if err != nil && err != domain.ErrPolicyNotFound {
+		return nil, err
 	}
-
-	if share.Status != domain.ShareStatusPendingRequest {
-		return nil, domain.ErrShareNotPending
+	if !canApproveShare(policy, topic, actor) {
+		return nil, domain.ErrShareNotAuthorized
 	}
 
-	share.Approve(approverID, nil)
-
-	if err := s.shareRepo.Update(ctx, share); err != nil {
+	transition, err := share.Approve(actor.UserID, nil)
+	if err != nil {
 		return nil, err
 	}
-
-	return share, nil
+	return share, s.saveTransition(ctx, share, transition)
 }
 
-// RejectTopicAccess rejects a pending share request
-func (s *ShareService) RejectTopicAccess(ctx context.Context, shareID uuid.UUID, rejecterID uuid.UUID, reason string) (*domain.KafkaTopicShare, error) {
-	share, err := s.shareRepo.GetByID(ctx, shareID)
+// RejectTopicAccess rejects a pending share request. The same actors that
+// may approve a request may reject it.
+func (s *ShareService) RejectTopicAccess(ctx context.Context, shareID uuid.UUID, actor ShareActor, reason string) (*domain.KafkaTopicShare, error) {
+	share, topic, err := s.loadShare(ctx, shareID)
 	if err != nil {
 		return nil, err
 	}
-	if share == nil {
-		return nil, domain.ErrShareNotFound
+	policy, err := s.policyRepo.GetEffectivePolicy(ctx, topic.WorkspaceID, topic.ID)
+	if err != nil && err != domain.ErrPolicyNotFound {
+		return nil, err
 	}
-
-	if share.Status != domain.ShareStatusPendingRequest {
-		return nil, domain.ErrShareNotPending
+	if !canApproveShare(policy, topic, actor) {
+		return nil, domain.ErrShareNotAuthorized
 	}
 
-	share.Reject(rejecterID)
+	transition, err := share.Reject(actor.UserID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return share, s.saveTransition(ctx, share, transition)
+}
 
-	if err := s.shareRepo.Update(ctx, share); err != nil {
+// CancelTopicAccess withdraws a pending share request. Only the requester or
+// the topic's owning workspace may cancel.
+func (s *ShareService) CancelTopicAccess(ctx context.Context, shareID uuid.UUID, actor ShareActor) (*domain.KafkaTopicShare, error) {
+	share, topic, err := s.loadShare(ctx, shareID)
+	if err != nil {
 		return nil, err
 	}
+	if actor.UserID != share.RequestedBy && actor.WorkspaceID != topic.WorkspaceID {
+		return nil, domain.ErrShareNotAuthorized
+	}
 
-	return share, nil
+	transition, err := share.Cancel(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return share, s.saveTransition(ctx, share, transition)
 }
 
-// RevokeTopicAccess revokes an approved share
-func (s *ShareService) RevokeTopicAccess(ctx context.Context, shareID uuid.UUID) (*domain.KafkaTopicShare, error) {
-	share, err := s.shareRepo.GetByID(ctx, shareID)
+// RevokeTopicAccess revokes an approved share. The topic's owning workspace
+// or the workspace it was shared with may revoke; revocation is terminal.
+func (s *ShareService) RevokeTopicAccess(ctx context.Context, shareID uuid.UUID, actor ShareActor, reason string) (*domain.KafkaTopicShare, error) {
+	share, topic, err := s.loadShare(ctx, shareID)
 	if err != nil {
 		return nil, err
 	}
-	if share == nil {
-		return nil, domain.ErrShareNotFound
+	sharedWith := share.SharedWithWorkspaceID != nil && *share.SharedWithWorkspaceID == actor.WorkspaceID
+	if actor.WorkspaceID != topic.WorkspaceID && !sharedWith {
+		return nil, domain.ErrShareNotAuthorized
 	}
 
-	if share.Status != domain.ShareStatusApproved {
-		return nil, domain.ErrShareNotApproved
+	transition, err := share.Revoke(actor.UserID, reason)
+	if err != nil {
+		return nil, err
 	}
+	return share, s.saveTransition(ctx, share, transition)
+}
 
-	share.Revoke()
+// GetShareHistory returns a share's status transitions, oldest first
+func (s *ShareService) GetShareHistory(ctx context.Context, shareID uuid.UUID) ([]*domain.ShareTransition, error) {
+	return s.shareRepo.ListTransitions(ctx, shareID)
+}
 
+// loadShare returns the share and the topic it grants access to
+func (s *ShareService) loadShare(ctx context.Context, shareID uuid.UUID) (*domain.KafkaTopicShare, *domain.KafkaTopic, error) {
+	share, err := s.GetShare(ctx, shareID)
+	if err != nil {
+		return nil, nil, err
+	}
+	topic, err := s.topicService.GetTopic(ctx, share.TopicID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return share, topic, nil
+}
+
+// saveTransition persists the share's new status and records the transition
+func (s *ShareService) saveTransition(ctx context.Context, share *domain.KafkaTopicShare, transition *domain.ShareTransition) error {
 	if err := s.shareRepo.Update(ctx, share); err != nil {
-		return nil, err
+		return err
 	}
+	return s.shareRepo.RecordTransition(ctx, transition)
+}
 
-	return share, nil
+// canApproveShare reports whether the actor may approve or reject requests
+// for the topic
+func canApproveShare(policy *domain.KafkaTopicSharePolicy, topic *domain.KafkaTopic, actor ShareActor) bool {
+	if policy != nil && len(policy.Approvers) > 0 {
+		return policy.CanApprove(actor.UserID)
+	}
+	return actor.WorkspaceID != uuid.Nil && actor.WorkspaceID == topic.WorkspaceID
 }
 
 // ListTopicShares returns topic shares based on filter
@@ -265,6 +336,12 @@ type ShareFilter struct {
 	Status      *domain.ShareStatus
 }
 
+// ShareActor identifies the user acting on a share and the workspace they act for
+type ShareActor struct {
+	UserID      uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
 // RequestAccessRequest contains parameters for access request
 type RequestAccessRequest struct {
 	TopicID           uuid.UUID
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drewpayment/orbit/services/kafka/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryShareRepo keeps shares and their transition history in memory
+type memoryShareRepo struct {
+	ShareRepository
+	shares      map[uuid.UUID]*domain.KafkaTopicShare
+	transitions []*domain.ShareTransition
+}
+
+func (r *memoryShareRepo) Create(_ context.Context, share *domain.KafkaTopicShare) error {
+	r.shares[share.ID] = share
+	return nil
+}
+
+func (r *memoryShareRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.KafkaTopicShare, error) {
+	share, ok := r.shares[id]
+	if !ok {
+		return nil, domain.ErrShareNotFound
+	}
+	return share, nil
+}
+
+func (r *memoryShareRepo) GetExisting(context.Context, uuid.UUID, uuid.UUID) (*domain.KafkaTopicShare, error) {
+	return nil, domain.ErrShareNotFound
+}
+
+func (r *memoryShareRepo) Update(_ context.Context, share *domain.KafkaTopicShare) error {
+	r.shares[share.ID] = share
+	return nil
+}
+
+func (r *memoryShareRepo) RecordTransition(_ context.Context, transition *domain.ShareTransition) error {
+	r.transitions = append(r.transitions, transition)
+	return nil
+}
+
+func (r *memoryShareRepo) ListTransitions(_ context.Context, shareID uuid.UUID) ([]*domain.ShareTransition, error) {
+	var history []*domain.ShareTransition
+	for _, t := range r.transitions {
+		if t.ShareID == shareID {
+			history = append(history, t)
+		}
+	}
+	return history, nil
+}
+
+// staticSharePolicyRepo returns the same share policy for every topic
+type staticSharePolicyRepo struct {
+	policy *domain.KafkaTopicSharePolicy
+}
+
+func (r staticSharePolicyRepo) GetEffectivePolicy(context.Context, uuid.UUID, uuid.UUID) (*domain.KafkaTopicSharePolicy, error) {
+	if r.policy == nil {
+		return nil, domain.ErrPolicyNotFound
+	}
+	return r.policy, nil
+}
+
+type shareFixture struct {
+	svc       *ShareService
+	repo      *memoryShareRepo
+	topic     *domain.KafkaTopic
+	owner     ShareActor // acts for the topic's workspace
+	requester ShareActor // acts for the requesting workspace
+}
+
+func newShareFixture(t *testing.T, policy *domain.KafkaTopicSharePolicy) *shareFixture {
+	t.Helper()
+	topic := domain.NewKafkaTopic(uuid.New(), "orders", "production")
+	repo := &memoryShareRepo{shares: make(map[uuid.UUID]*domain.KafkaTopicShare)}
+	topics := NewTopicService(&fakeTopicByIDRepo{topic: topic}, nil, nil, nil)
+	return &shareFixture{
+		svc:       NewShareService(repo, staticSharePolicyRepo{policy: policy}, nil, topics),
+		repo:      repo,
+		topic:     topic,
+		owner:     ShareActor{UserID: uuid.New(), WorkspaceID: topic.WorkspaceID},
+		requester: ShareActor{UserID: uuid.New(), WorkspaceID: uuid.New()},
+	}
+}
+
+func (f *shareFixture) request(t *testing.T) *domain.KafkaTopicShare {
+	t.Helper()
+	share, err := f.svc.RequestTopicAccess(context.Background(), RequestAccessRequest{
+		TopicID:           f.topic.ID,
+		TargetWorkspaceID: f.requester.WorkspaceID,
+		Permission:        domain.SharePermissionRead,
+		RequestedBy:       f.requester.UserID,
+		Reason:            "analytics pipeline",
+	})
+	require.NoError(t, err)
+	require.Equal(t, domain.ShareStatusPendingRequest, share.Status)
+	return share
+}
+
+func (f *shareFixture) history(t *testing.T, shareID uuid.UUID) [][2]domain.ShareStatus {
+	t.Helper()
+	transitions, err := f.svc.GetShareHistory(context.Background(), shareID)
+	require.NoError(t, err)
+	steps := make([][2]domain.ShareStatus, len(transitions))
+	for i, tr := range transitions {
+		steps[i] = [2]domain.ShareStatus{tr.FromStatus, tr.ToStatus}
+	}
+	return steps
+}
+
+func TestShareService_ValidTransitions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("approve then revoke", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+
+		approved, err := f.svc.ApproveTopicAccess(ctx, share.ID, f.owner)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ShareStatusApproved, approved.Status)
+		assert.Equal(t, f.owner.UserID, *approved.ApprovedBy)
+
+		revoked, err := f.svc.RevokeTopicAccess(ctx, share.ID, f.requester, "no longer needed")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ShareStatusRevoked, revoked.Status)
+		assert.True(t, revoked.Status.IsTerminal())
+
+		assert.Equal(t, [][2]domain.ShareStatus{
+			{"", domain.ShareStatusPendingRequest},
+			{domain.ShareStatusPendingRequest, domain.ShareStatusApproved},
+			{domain.ShareStatusApproved, domain.ShareStatusRevoked},
+		}, f.history(t, share.ID))
+		assert.Equal(t, "no longer needed", f.repo.transitions[2].Reason)
+		assert.Equal(t, f.requester.UserID, f.repo.transitions[2].ActorID)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+
+		rejected, err := f.svc.RejectTopicAccess(ctx, share.ID, f.owner, "not approved for PII")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ShareStatusRejected, rejected.Status)
+		assert.Equal(t, [][2]domain.ShareStatus{
+			{"", domain.ShareStatusPendingRequest},
+			{domain.ShareStatusPendingRequest, domain.ShareStatusRejected},
+		}, f.history(t, share.ID))
+	})
+
+	t.Run("cancel by requester", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+
+		cancelled, err := f.svc.CancelTopicAccess(ctx, share.ID, ShareActor{UserID: f.requester.UserID})
+		require.NoError(t, err)
+		assert.Equal(t, domain.ShareStatusCancelled, cancelled.Status)
+	})
+
+	t.Run("cancel by owner", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+
+		_, err := f.svc.CancelTopicAccess(ctx, share.ID, f.owner)
+		require.NoError(t, err)
+	})
+
+	t.Run("auto approval is recorded", func(t *testing.T) {
+		policy := domain.NewTopicSharePolicy(uuid.New(), domain.SharePolicyScopeAllTopics)
+		policy.AutoApprove = &domain.AutoApproveConfig{Permissions: []domain.SharePermission{domain.SharePermissionRead}}
+		f := newShareFixture(t, policy)
+
+		share, err := f.svc.RequestTopicAccess(ctx, RequestAccessRequest{
+			TopicID:           f.topic.ID,
+			TargetWorkspaceID: f.requester.WorkspaceID,
+			Permission:        domain.SharePermissionRead,
+			RequestedBy:       f.requester.UserID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, domain.ShareStatusApproved, share.Status)
+		assert.Equal(t, [][2]domain.ShareStatus{
+			{"", domain.ShareStatusPendingRequest},
+			{domain.ShareStatusPendingRequest, domain.ShareStatusApproved},
+		}, f.history(t, share.ID))
+	})
+}
+
+func TestShareService_RejectsIllegalTransitions(t *testing.T) {
+	ctx := context.Background()
+	f := newShareFixture(t, nil)
+	share := f.request(t)
+
+	_, err := f.svc.RevokeTopicAccess(ctx, share.ID, f.owner, "")
+	assert.ErrorIs(t, err, domain.ErrShareInvalidTransition)
+	assert.ErrorIs(t, err, domain.ErrShareNotApproved, "a pending share cannot be revoked")
+
+	_, err = f.svc.ApproveTopicAccess(ctx, share.ID, f.owner)
+	require.NoError(t, err)
+	_, err = f.svc.RevokeTopicAccess(ctx, share.ID, f.owner, "")
+	require.NoError(t, err)
+
+	_, err = f.svc.ApproveTopicAccess(ctx, share.ID, f.owner)
+	assert.ErrorIs(t, err, domain.ErrShareInvalidTransition)
+	assert.ErrorIs(t, err, domain.ErrShareNotPending)
+
+	_, err = f.svc.RejectTopicAccess(ctx, share.ID, f.owner, "")
+	assert.ErrorIs(t, err, domain.ErrShareInvalidTransition)
+
+	_, err = f.svc.CancelTopicAccess(ctx, share.ID, f.requester)
+	assert.ErrorIs(t, err, domain.ErrShareInvalidTransition)
+
+	_, err = f.svc.RevokeTopicAccess(ctx, share.ID, f.owner, "")
+	assert.ErrorIs(t, err, domain.ErrShareInvalidTransition, "revocation is terminal")
+
+	assert.Equal(t, domain.ShareStatusRevoked, f.repo.shares[share.ID].Status)
+	assert.Len(t, f.history(t, share.ID), 3, "rejected transitions are not recorded")
+}
+
+func TestShareService_GuardsActors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requesting workspace cannot approve its own request", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+
+		_, err := f.svc.ApproveTopicAccess(ctx, share.ID, f.requester)
+		assert.ErrorIs(t, err, domain.ErrShareNotAuthorized)
+		_, err = f.svc.RejectTopicAccess(ctx, share.ID, f.requester, "")
+		assert.ErrorIs(t, err, domain.ErrShareNotAuthorized)
+		assert.Equal(t, domain.ShareStatusPendingRequest, f.repo.shares[share.ID].Status)
+	})
+
+	t.Run("policy approvers replace workspace membership", func(t *testing.T) {
+		approver := uuid.New()
+		policy := domain.NewTopicSharePolicy(uuid.New(), domain.SharePolicyScopeAllTopics)
+		policy.Approvers = []uuid.UUID{approver}
+		f := newShareFixture(t, policy)
+		share := f.request(t)
+
+		_, err := f.svc.ApproveTopicAccess(ctx, share.ID, f.owner)
+		assert.ErrorIs(t, err, domain.ErrShareNotAuthorized, "owner is not a named approver")
+
+		approved, err := f.svc.ApproveTopicAccess(ctx, share.ID, ShareActor{UserID: approver})
+		require.NoError(t, err)
+		assert.Equal(t, approver, *approved.ApprovedBy)
+	})
+
+	t.Run("only requester or owner can cancel", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+
+		_, err := f.svc.CancelTopicAccess(ctx, share.ID, ShareActor{UserID: uuid.New(), WorkspaceID: f.requester.WorkspaceID})
+		assert.ErrorIs(t, err, domain.ErrShareNotAuthorized)
+	})
+
+	t.Run("unrelated workspace cannot revoke", func(t *testing.T) {
+		f := newShareFixture(t, nil)
+		share := f.request(t)
+		_, err := f.svc.ApproveTopicAccess(ctx, share.ID, f.owner)
+		require.NoError(t, err)
+
+		_, err = f.svc.RevokeTopicAccess(ctx, share.ID, ShareActor{UserID: uuid.New(), WorkspaceID: uuid.New()}, "")
+		assert.ErrorIs(t, err, domain.ErrShareNotAuthorized)
+		assert.Equal(t, domain.ShareStatusApproved, f.repo.shares[share.ID].Status)
+	})
+}
@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 
 	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
 	"github.com/drewpayment/orbit/services/kafka/internal/domain"
@@ -15,6 +19,9 @@ type TopicRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.KafkaTopic, error)
 	GetByName(ctx context.Context, workspaceID uuid.UUID, environment, name string) (*domain.KafkaTopic, error)
 	List(ctx context.Context, workspaceID uuid.UUID, environment string) ([]*domain.KafkaTopic, error)
+	// ListPage returns up to limit topics ordered by (CreatedAt, ID) descending,
+	// starting after the cursor, or from the newest topic when it is nil
+	ListPage(ctx context.Context, workspaceID uuid.UUID, environment string, after *domain.PageCursor, limit int) ([]*domain.KafkaTopic, error)
 	Update(ctx context.Context, topic *domain.KafkaTopic) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -75,30 +82,33 @@ func (s *TopicService) CreateTopic(ctx context.Context, req CreateTopicRequest)
 	if req.ReplicationFactor > 0 {
 		topic.ReplicationFactor = req.ReplicationFactor
 	}
-	if req.RetentionMs > 0 {
-		topic.RetentionMs = req.RetentionMs
-	}
-	if req.CleanupPolicy != "" {
-		topic.CleanupPolicy = domain.CleanupPolicy(req.CleanupPolicy)
-	}
-	if req.Compression != "" {
-		topic.Compression = domain.CompressionType(req.Compression)
+	topic.ApprovalRequired = true
+
+	// Merge policy default configs, rejecting overrides of locked keys
+	requested := req.requestedConfig()
+	config := requested
+	if policy != nil {
+		if config, err = policy.ResolveTopicConfig(requested); err != nil {
+			return nil, err
+		}
 	}
-	if req.Config != nil {
-		topic.Config = req.Config
+	if err := topic.ApplyConfig(config); err != nil {
+		return nil, err
 	}
-	topic.ApprovalRequired = true
 
-	// Validate against policy
+	// Enforce policy: requested values must comply, defaults are clamped into range
 	if policy != nil {
-		if !policy.ValidateTopicName(topic.Name) {
-			return nil, domain.ErrPolicyNamingViolation
+		if err := policy.ValidateTopicNaming(topic.Name); err != nil {
+			return nil, err
 		}
-		if !policy.ValidatePartitions(topic.Partitions) {
-			return nil, domain.ErrPolicyPartitionLimit
+		if topic.Partitions, err = policy.ApplyPartitionLimits(topic.Partitions, req.Partitions > 0); err != nil {
+			return nil, err
 		}
-		if !policy.ValidateRetention(topic.RetentionMs) {
-			return nil, domain.ErrPolicyRetentionLimit
+		if topic.ReplicationFactor, err = policy.ApplyReplicationLimits(topic.ReplicationFactor, req.ReplicationFactor > 0); err != nil {
+			return nil, err
+		}
+		if topic.RetentionMs, err = policy.ApplyRetentionLimits(topic.RetentionMs, requested[domain.TopicConfigRetentionMs] != ""); err != nil {
+			return nil, err
 		}
 		topic.ApprovalRequired = policy.RequiresApproval(topic.Environment)
 
@@ -142,6 +152,38 @@ func (s *TopicService) ListTopics(ctx context.Context, workspaceID uuid.UUID, en
 	return s.topicRepo.List(ctx, workspaceID, environment)
 }
 
+// ListTopicsPage returns one page of topics for a workspace, newest first.
+// Pages are keyed on the last topic returned rather than an offset, so
+// topics created while a client is paging do not cause duplicates or skips.
+func (s *TopicService) ListTopicsPage(ctx context.Context, req ListTopicsRequest) (*ListTopicsPage, error) {
+	after, err := domain.ParsePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultTopicPageSize
+	}
+	if pageSize > MaxTopicPageSize {
+		pageSize = MaxTopicPageSize
+	}
+
+	// Fetch one extra topic to learn whether another page follows
+	topics, err := s.topicRepo.ListPage(ctx, req.WorkspaceID, req.Environment, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ListTopicsPage{Topics: topics}
+	if len(topics) > pageSize {
+		page.Topics = topics[:pageSize]
+		last := page.Topics[pageSize-1]
+		page.NextPageToken = domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID}.Token()
+	}
+	return page, nil
+}
+
 // UpdateTopic updates topic configuration
 func (s *TopicService) UpdateTopic(ctx context.Context, topicID uuid.UUID, req UpdateTopicRequest) (*domain.KafkaTopic, error) {
 	topic, err := s.topicRepo.GetByID(ctx, topicID)
@@ -240,18 +282,15 @@ func (s *TopicService) ProvisionTopic(ctx context.Context, topicID uuid.UUID, cr
 	}
 	defer adapter.Close()
 
-	// Build full topic name with namespace
-	fullTopicName := fmt.Sprintf("%s.%s.%s", topic.Environment, topic.WorkspaceID.String()[:8], topic.Name)
-
 	// Create topic on cluster
 	spec := adapters.TopicSpec{
-		Name:              fullTopicName,
+		Name:              clusterTopicName(topic),
 		Partitions:        topic.Partitions,
 		ReplicationFactor: topic.ReplicationFactor,
 		Config: map[string]string{
-			"retention.ms":     fmt.Sprintf("%d", topic.RetentionMs),
-			"cleanup.policy":   string(topic.CleanupPolicy),
-			"compression.type": string(topic.Compression),
+			domain.TopicConfigRetentionMs:   fmt.Sprintf("%d", topic.RetentionMs),
+			domain.TopicConfigCleanupPolicy: string(topic.CleanupPolicy),
+			domain.TopicConfigCompression:   string(topic.Compression),
 		},
 	}
 
@@ -275,6 +314,102 @@ func (s *TopicService) ProvisionTopic(ctx context.Context, topicID uuid.UUID, cr
 	return nil
 }
 
+// DescribeTopic returns a topic's declared configuration together with its
+// live state on the cluster, recording where the two have drifted apart.
+// Topics that have not been provisioned have no live state.
+func (s *TopicService) DescribeTopic(ctx context.Context, topicID uuid.UUID, credentials map[string]string) (*TopicDescription, error) {
+	topic, err := s.topicRepo.GetByID(ctx, topicID)
+	if err != nil {
+		return nil, err
+	}
+	if topic == nil {
+		return nil, domain.ErrTopicNotFound
+	}
+
+	desc := &TopicDescription{Topic: topic}
+	if topic.ClusterID == uuid.Nil {
+		return desc, nil
+	}
+	desc.Provisioned = true
+
+	cluster, err := s.clusterService.GetCluster(ctx, topic.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	adapter, err := s.adapterFactory.CreateKafkaAdapter(cluster, credentials)
+	if err != nil {
+		return nil, err
+	}
+	defer adapter.Close()
+
+	live, err := adapter.DescribeTopic(ctx, clusterTopicName(topic))
+	if errors.Is(err, adapters.ErrTopicNotFound) {
+		desc.Drift = []TopicDrift{{Field: "exists", Declared: "true", Actual: "false"}}
+		return desc, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	desc.Live = live
+	desc.Drift = topicDrift(topic, live)
+	return desc, nil
+}
+
+// topicDrift compares the declared topic with its live state. Config keys
+// the broker did not report are not treated as drift.
+func topicDrift(topic *domain.KafkaTopic, live *adapters.TopicInfo) []TopicDrift {
+	var drift []TopicDrift
+	if topic.Partitions != live.Partitions {
+		drift = append(drift, TopicDrift{Field: "partitions", Declared: strconv.Itoa(topic.Partitions), Actual: strconv.Itoa(live.Partitions)})
+	}
+	if topic.ReplicationFactor != live.ReplicationFactor {
+		drift = append(drift, TopicDrift{Field: "replication_factor", Declared: strconv.Itoa(topic.ReplicationFactor), Actual: strconv.Itoa(live.ReplicationFactor)})
+	}
+
+	declared := map[string]string{
+		domain.TopicConfigRetentionMs:   strconv.FormatInt(topic.RetentionMs, 10),
+		domain.TopicConfigCleanupPolicy: string(topic.CleanupPolicy),
+		domain.TopicConfigCompression:   string(topic.Compression),
+	}
+	maps.Copy(declared, topic.Config)
+	for _, key := range slices.Sorted(maps.Keys(declared)) {
+		actual, ok := live.Config[key]
+		if ok && actual != declared[key] {
+			drift = append(drift, TopicDrift{Field: key, Declared: declared[key], Actual: actual})
+		}
+	}
+	return drift
+}
+
+// clusterTopicName is the topic's name on the cluster, namespaced by
+// environment and workspace
+func clusterTopicName(topic *domain.KafkaTopic) string {
+	return fmt.Sprintf("%s.%s.%s", topic.Environment, topic.WorkspaceID.String()[:8], topic.Name)
+}
+
+// TopicDescription pairs a topic's declared configuration with its live
+// state on the cluster
+type TopicDescription struct {
+	Topic       *domain.KafkaTopic
+	Provisioned bool                // the topic has been assigned a cluster
+	Live        *adapters.TopicInfo // nil when the topic is not on the cluster
+	Drift       []TopicDrift
+}
+
+// Drifted reports whether the live state differs from the declared state
+func (d *TopicDescription) Drifted() bool {
+	return len(d.Drift) > 0
+}
+
+// TopicDrift is a setting whose live value differs from the declared one
+type TopicDrift struct {
+	Field    string
+	Declared string
+	Actual   string
+}
+
 // CreateTopicRequest contains parameters for topic creation
 type CreateTopicRequest struct {
 	WorkspaceID       uuid.UUID
@@ -289,6 +424,43 @@ type CreateTopicRequest struct {
 	Config            map[string]string
 }
 
+// requestedConfig returns the configs the request sets explicitly, with the
+// typed fields under their Kafka config keys
+func (r CreateTopicRequest) requestedConfig() map[string]string {
+	config := make(map[string]string, len(r.Config)+3)
+	maps.Copy(config, r.Config)
+	if r.RetentionMs > 0 {
+		config[domain.TopicConfigRetentionMs] = strconv.FormatInt(r.RetentionMs, 10)
+	}
+	if r.CleanupPolicy != "" {
+		config[domain.TopicConfigCleanupPolicy] = r.CleanupPolicy
+	}
+	if r.Compression != "" {
+		config[domain.TopicConfigCompression] = r.Compression
+	}
+	return config
+}
+
+// Page sizes for ListTopicsPage
+const (
+	DefaultTopicPageSize = 50
+	MaxTopicPageSize     = 500
+)
+
+// ListTopicsRequest contains parameters for listing a page of topics
+type ListTopicsRequest struct {
+	WorkspaceID uuid.UUID
+	Environment string
+	PageSize    int
+	PageToken   string
+}
+
+// ListTopicsPage is one page of topics. NextPageToken is empty on the last page.
+type ListTopicsPage struct {
+	Topics        []*domain.KafkaTopic
+	NextPageToken string
+}
+
 // UpdateTopicRequest contains parameters for topic updates
 type UpdateTopicRequest struct {
 	Description *string
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/drewpayment/orbit/services/kafka/internal/adapters"
+	"github.com/drewpayment/orbit/services/kafka/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTopicRepo is a concurrency-safe TopicRepository whose ListPage follows
+// the same keyset ordering as the postgres implementation.
+type memoryTopicRepo struct {
+	TopicRepository
+	mu     sync.Mutex
+	topics []*domain.KafkaTopic
+}
+
+func (r *memoryTopicRepo) Create(_ context.Context, topic *domain.KafkaTopic) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.topics = append(r.topics, topic)
+	return nil
+}
+
+func (r *memoryTopicRepo) ListPage(_ context.Context, workspaceID uuid.UUID, environment string, after *domain.PageCursor, limit int) ([]*domain.KafkaTopic, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []*domain.KafkaTopic
+	for _, t := range r.topics {
+		if t.WorkspaceID != workspaceID || t.Environment != environment {
+			continue
+		}
+		if after != nil && !topicBefore(after, t) {
+			continue
+		}
+		matched = append(matched, t)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return topicBefore(&domain.PageCursor{CreatedAt: matched[i].CreatedAt, ID: matched[i].ID}, matched[j])
+	})
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
+
+func (r *memoryTopicRepo) GetByName(_ context.Context, workspaceID uuid.UUID, environment, name string) (*domain.KafkaTopic, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.topics {
+		if t.WorkspaceID == workspaceID && t.Environment == environment && t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, domain.ErrTopicNotFound
+}
+
+// staticPolicyRepo returns the same policy for every workspace and environment
+type staticPolicyRepo struct {
+	policy *domain.KafkaTopicPolicy
+}
+
+func (r staticPolicyRepo) GetEffectivePolicy(context.Context, uuid.UUID, string) (*domain.KafkaTopicPolicy, error) {
+	if r.policy == nil {
+		return nil, domain.ErrPolicyNotFound
+	}
+	return r.policy, nil
+}
+
+// topicBefore reports whether t sorts after c in (CreatedAt, ID) descending order
+func topicBefore(c *domain.PageCursor, t *domain.KafkaTopic) bool {
+	if !t.CreatedAt.Equal(c.CreatedAt) {
+		return t.CreatedAt.Before(c.CreatedAt)
+	}
+	return t.ID.String() < c.ID.String()
+}
+
+func TestTopicService_ListTopicsPage_StableUnderConcurrentInserts(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	repo := &memoryTopicRepo{}
+	svc := NewTopicService(repo, nil, nil, nil)
+
+	// Several topics share a timestamp so the ID tiebreak is exercised
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	existing := make(map[uuid.UUID]bool)
+	for i := 0; i < 95; i++ {
+		topic := domain.NewKafkaTopic(workspaceID, "topic", "development")
+		topic.CreatedAt = base.Add(time.Duration(i/3) * time.Minute)
+		require.NoError(t, repo.Create(ctx, topic))
+		existing[topic.ID] = true
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 500; i++ {
+			select {
+			case <-done:
+				return
+			default:
+				_ = repo.Create(ctx, domain.NewKafkaTopic(workspaceID, "late", "development"))
+			}
+		}
+	}()
+
+	seen := make(map[uuid.UUID]int)
+	token := ""
+	pages := 0
+	for {
+		page, err := svc.ListTopicsPage(ctx, ListTopicsRequest{
+			WorkspaceID: workspaceID,
+			Environment: "development",
+			PageSize:    10,
+			PageToken:   token,
+		})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page.Topics), 10)
+		for _, topic := range page.Topics {
+			seen[topic.ID]++
+		}
+		pages++
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	close(done)
+	wg.Wait()
+
+	for id, count := range seen {
+		assert.Equal(t, 1, count, "topic %s returned more than once", id)
+	}
+	for id := range existing {
+		assert.Contains(t, seen, id, "topic %s was skipped", id)
+	}
+	assert.GreaterOrEqual(t, pages, 10)
+}
+
+func TestTopicService_ListTopicsPage_PageSize(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	repo := &memoryTopicRepo{}
+	svc := NewTopicService(repo, nil, nil, nil)
+
+	for i := 0; i < DefaultTopicPageSize+1; i++ {
+		require.NoError(t, repo.Create(ctx, domain.NewKafkaTopic(workspaceID, "topic", "development")))
+	}
+
+	page, err := svc.ListTopicsPage(ctx, ListTopicsRequest{WorkspaceID: workspaceID, Environment: "development"})
+	require.NoError(t, err)
+	assert.Len(t, page.Topics, DefaultTopicPageSize)
+	require.NotEmpty(t, page.NextPageToken)
+
+	page, err = svc.ListTopicsPage(ctx, ListTopicsRequest{WorkspaceID: workspaceID, Environment: "development", PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	assert.Len(t, page.Topics, 1)
+	assert.Empty(t, page.NextPageToken)
+
+	// Exactly one full page leaves no next token
+	page, err = svc.ListTopicsPage(ctx, ListTopicsRequest{WorkspaceID: workspaceID, Environment: "development", PageSize: DefaultTopicPageSize + 1})
+	require.NoError(t, err)
+	assert.Len(t, page.Topics, DefaultTopicPageSize+1)
+	assert.Empty(t, page.NextPageToken)
+}
+
+func TestTopicService_ListTopicsPage_InvalidToken(t *testing.T) {
+	svc := NewTopicService(&memoryTopicRepo{}, nil, nil, nil)
+
+	for _, token := range []string{"not base64!", "e30", "eyJpIjoieCJ9"} {
+		_, err := svc.ListTopicsPage(context.Background(), ListTopicsRequest{WorkspaceID: uuid.New(), PageToken: token})
+		assert.ErrorIs(t, err, domain.ErrInvalidPageToken, token)
+	}
+}
+
+func newPolicyTestTopicService() (*TopicService, *memoryTopicRepo) {
+	repo := &memoryTopicRepo{}
+	policy := &domain.KafkaTopicPolicy{
+		ID:                uuid.New(),
+		NamingPattern:     `^orders\.[a-z0-9-]+$`,
+		NamePrefix:        "orders.",
+		MaxNameLength:     32,
+		PartitionLimits:   &domain.PartitionLimits{Min: 1, Max: 12},
+		ReplicationLimits: &domain.ReplicationLimits{Min: 2, Max: 2},
+	}
+	return NewTopicService(repo, staticPolicyRepo{policy: policy}, nil, nil), repo
+}
+
+func TestTopicService_CreateTopic_RejectsNamingViolations(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newPolicyTestTopicService()
+
+	tests := []struct {
+		name string
+		rule string
+	}{
+		{name: "payments.events", rule: domain.PolicyRuleNamePrefix},
+		{name: "orders.this-name-is-far-too-long-for-policy", rule: domain.PolicyRuleNameLength},
+		{name: "orders.Created_Events", rule: domain.PolicyRuleNamePattern},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			_, err := svc.CreateTopic(ctx, CreateTopicRequest{WorkspaceID: uuid.New(), Name: tt.name, Environment: "development"})
+			var violation *domain.PolicyViolationError
+			require.True(t, errors.As(err, &violation), "got %v", err)
+			assert.Equal(t, tt.rule, violation.Rule)
+			assert.ErrorIs(t, err, domain.ErrPolicyViolation)
+			assert.ErrorIs(t, err, domain.ErrPolicyNamingViolation)
+		})
+	}
+	assert.Empty(t, repo.topics)
+}
+
+func TestTopicService_CreateTopic_RejectsTooManyPartitions(t *testing.T) {
+	svc, repo := newPolicyTestTopicService()
+
+	_, err := svc.CreateTopic(context.Background(), CreateTopicRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "orders.created",
+		Environment: "development",
+		Partitions:  24,
+	})
+	var violation *domain.PolicyViolationError
+	require.True(t, errors.As(err, &violation), "got %v", err)
+	assert.Equal(t, domain.PolicyRulePartitions, violation.Rule)
+	assert.ErrorIs(t, err, domain.ErrPolicyPartitionLimit)
+	assert.Empty(t, repo.topics)
+}
+
+func TestTopicService_CreateTopic_CompliantTopic(t *testing.T) {
+	svc, repo := newPolicyTestTopicService()
+
+	topic, err := svc.CreateTopic(context.Background(), CreateTopicRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "orders.created",
+		Environment: "development",
+		Partitions:  6,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 6, topic.Partitions)
+	assert.Equal(t, 2, topic.ReplicationFactor, "default replication factor is clamped to the policy maximum")
+	assert.Len(t, repo.topics, 1)
+}
+
+func TestTopicService_CreateTopic_RejectsLockedConfigOverrides(t *testing.T) {
+	repo := &memoryTopicRepo{}
+	policy := &domain.KafkaTopicPolicy{
+		DefaultConfigs: map[string]string{domain.TopicConfigRetentionMs: "86400000"},
+		LockedConfigs:  []string{domain.TopicConfigRetentionMs, "min.insync.replicas"},
+	}
+	svc := NewTopicService(repo, staticPolicyRepo{policy: policy}, nil, nil)
+
+	_, err := svc.CreateTopic(context.Background(), CreateTopicRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "orders",
+		Environment: "development",
+		RetentionMs: 30 * 86400000,
+		Config:      map[string]string{"min.insync.replicas": "1", "segment.ms": "3600000"},
+	})
+	var locked *domain.LockedConfigError
+	require.True(t, errors.As(err, &locked), "got %v", err)
+	assert.Equal(t, []string{"min.insync.replicas", domain.TopicConfigRetentionMs}, locked.Keys)
+	assert.ErrorIs(t, err, domain.ErrPolicyViolation)
+	assert.Empty(t, repo.topics)
+
+	// Restating the locked default is not an override
+	topic, err := svc.CreateTopic(context.Background(), CreateTopicRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "orders",
+		Environment: "development",
+		Config:      map[string]string{domain.TopicConfigRetentionMs: "86400000"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(86400000), topic.RetentionMs)
+}
+
+func TestTopicService_CreateTopic_InjectsPolicyDefaults(t *testing.T) {
+	policy := &domain.KafkaTopicPolicy{
+		DefaultConfigs: map[string]string{
+			domain.TopicConfigRetentionMs:   "86400000",
+			domain.TopicConfigCleanupPolicy: string(domain.CleanupPolicyCompact),
+			"min.insync.replicas":           "2",
+		},
+	}
+	svc := NewTopicService(&memoryTopicRepo{}, staticPolicyRepo{policy: policy}, nil, nil)
+
+	topic, err := svc.CreateTopic(context.Background(), CreateTopicRequest{
+		WorkspaceID:   uuid.New(),
+		Name:          "orders",
+		Environment:   "development",
+		CleanupPolicy: string(domain.CleanupPolicyDelete),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(86400000), topic.RetentionMs, "default injected for unset retention")
+	assert.Equal(t, domain.CleanupPolicyDelete, topic.CleanupPolicy, "unlocked default yields to the request")
+	assert.Equal(t, map[string]string{"min.insync.replicas": "2"}, topic.Config)
+}
+
+// memoryClusterRepo serves clusters by ID
+type memoryClusterRepo struct {
+	ClusterRepository
+	clusters map[uuid.UUID]*domain.KafkaCluster
+}
+
+func (r memoryClusterRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.KafkaCluster, error) {
+	return r.clusters[id], nil
+}
+
+// stubKafkaAdapter describes topics from a fixed map of live metadata
+type stubKafkaAdapter struct {
+	adapters.KafkaAdapter
+	topics map[string]*adapters.TopicInfo
+}
+
+func (a stubKafkaAdapter) DescribeTopic(_ context.Context, name string) (*adapters.TopicInfo, error) {
+	info, ok := a.topics[name]
+	if !ok {
+		return nil, adapters.ErrTopicNotFound
+	}
+	return info, nil
+}
+
+func (a stubKafkaAdapter) Close() error { return nil }
+
+type stubAdapterFactory struct {
+	adapters.AdapterFactory
+	adapter adapters.KafkaAdapter
+}
+
+func (f stubAdapterFactory) CreateKafkaAdapter(*domain.KafkaCluster, map[string]string) (adapters.KafkaAdapter, error) {
+	return f.adapter, nil
+}
+
+func newDescribeTestTopicService(topic *domain.KafkaTopic, live map[string]*adapters.TopicInfo) *TopicService {
+	repo := &fakeTopicByIDRepo{topic: topic}
+	cluster := &domain.KafkaCluster{ID: topic.ClusterID}
+	factory := stubAdapterFactory{adapter: stubKafkaAdapter{topics: live}}
+	clusters := NewClusterService(memoryClusterRepo{clusters: map[uuid.UUID]*domain.KafkaCluster{cluster.ID: cluster}}, nil, nil, factory)
+	return NewTopicService(repo, nil, clusters, factory)
+}
+
+// fakeTopicByIDRepo serves a single topic by ID
+type fakeTopicByIDRepo struct {
+	TopicRepository
+	topic *domain.KafkaTopic
+}
+
+func (r *fakeTopicByIDRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.KafkaTopic, error) {
+	if r.topic.ID != id {
+		return nil, domain.ErrTopicNotFound
+	}
+	return r.topic, nil
+}
+
+func TestTopicService_DescribeTopic_FlagsPartitionDrift(t *testing.T) {
+	topic := domain.NewKafkaTopic(uuid.New(), "orders", "production")
+	topic.ClusterID = uuid.New()
+	topic.Status = domain.TopicStatusActive
+	live := &adapters.TopicInfo{
+		Name:              clusterTopicName(topic),
+		Partitions:        6, // increased out-of-band from the declared 3
+		ReplicationFactor: 3,
+		Config:            map[string]string{domain.TopicConfigRetentionMs: "604800000", domain.TopicConfigCleanupPolicy: "delete"},
+		PartitionDetails: []adapters.PartitionInfo{
+			{ID: 0, Leader: 1, Replicas: []int32{1, 2, 3}, ISR: []int32{1, 2}},
+		},
+	}
+	svc := newDescribeTestTopicService(topic, map[string]*adapters.TopicInfo{live.Name: live})
+
+	desc, err := svc.DescribeTopic(context.Background(), topic.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, desc.Drifted())
+	assert.Equal(t, []TopicDrift{{Field: "partitions", Declared: "3", Actual: "6"}}, desc.Drift)
+	require.NotNil(t, desc.Live)
+	assert.Equal(t, []int32{1, 2}, desc.Live.PartitionDetails[0].ISR)
+}
+
+func TestTopicService_DescribeTopic_MissingOnCluster(t *testing.T) {
+	topic := domain.NewKafkaTopic(uuid.New(), "orders", "production")
+	topic.ClusterID = uuid.New()
+	svc := newDescribeTestTopicService(topic, nil)
+
+	desc, err := svc.DescribeTopic(context.Background(), topic.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, desc.Provisioned)
+	assert.Nil(t, desc.Live)
+	assert.Equal(t, []TopicDrift{{Field: "exists", Declared: "true", Actual: "false"}}, desc.Drift)
+}
+
+func TestTopicService_DescribeTopic_NotProvisioned(t *testing.T) {
+	topic := domain.NewKafkaTopic(uuid.New(), "orders", "production")
+	svc := NewTopicService(&fakeTopicByIDRepo{topic: topic}, nil, nil, nil)
+
+	desc, err := svc.DescribeTopic(context.Background(), topic.ID, nil)
+	require.NoError(t, err)
+	assert.False(t, desc.Provisioned)
+	assert.False(t, desc.Drifted())
+}
@@ -0,0 +1,4 @@
+ALTER TABLE kafka_topic_policies
+    DROP COLUMN IF EXISTS replication_limits,
+    DROP COLUMN IF EXISTS max_name_length,
+    DROP COLUMN IF EXISTS name_prefix;
@@ -0,0 +1,5 @@
+-- Topic naming conventions and replication bounds for topic policies
+ALTER TABLE kafka_topic_policies
+    ADD COLUMN name_prefix TEXT NOT NULL DEFAULT '',
+    ADD COLUMN max_name_length INT NOT NULL DEFAULT 0,
+    ADD COLUMN replication_limits JSONB;
@@ -0,0 +1,3 @@
+ALTER TABLE kafka_topic_policies
+    DROP COLUMN IF EXISTS locked_configs,
+    DROP COLUMN IF EXISTS default_configs;
@@ -0,0 +1,4 @@
+-- Default and locked topic configs for topic policies
+ALTER TABLE kafka_topic_policies
+    ADD COLUMN default_configs JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN locked_configs JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS kafka_topic_share_transitions;
+
+ALTER TABLE kafka_topic_share_policies
+    DROP COLUMN IF EXISTS approvers;
@@ -0,0 +1,16 @@
+-- Named approvers for share policies
+ALTER TABLE kafka_topic_share_policies
+    ADD COLUMN approvers JSONB NOT NULL DEFAULT '[]';
+
+-- Share status transition history
+CREATE TABLE kafka_topic_share_transitions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    share_id UUID NOT NULL REFERENCES kafka_topic_shares(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    actor_id UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_share_transitions_share ON kafka_topic_share_transitions (share_id, created_at);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS kafka_service_account_credentials;
+
+ALTER TABLE kafka_service_accounts
+    DROP COLUMN IF EXISTS current_credential_id,
+    DROP COLUMN IF EXISTS credential_rotated_at;
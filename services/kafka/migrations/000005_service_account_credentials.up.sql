@@ -0,0 +1,21 @@
+-- Current credential for each service account
+ALTER TABLE kafka_service_accounts
+    ADD COLUMN current_credential_id UUID,
+    ADD COLUMN credential_rotated_at TIMESTAMPTZ;
+
+-- SCRAM credentials issued to service accounts. Secrets are never stored.
+CREATE TABLE kafka_service_account_credentials (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    service_account_id UUID NOT NULL REFERENCES kafka_service_accounts(id) ON DELETE CASCADE,
+    cluster_id UUID NOT NULL REFERENCES kafka_clusters(id) ON DELETE CASCADE,
+    username TEXT NOT NULL,
+    mechanism TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'active',
+    revoke_after TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sa_credentials_account ON kafka_service_account_credentials (service_account_id);
+CREATE INDEX idx_sa_credentials_pending_revocation ON kafka_service_account_credentials (cluster_id, revoke_after)
+    WHERE status = 'superseded';
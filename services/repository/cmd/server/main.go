@@ -2,15 +2,21 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -25,6 +31,7 @@ import (
 	templatev1 "github.com/drewpayment/orbit/proto/gen/go/idp/template/v1"
 	"github.com/drewpayment/orbit/proto/gen/go/idp/template/v1/templatev1connect"
 	grpcserver "github.com/drewpayment/orbit/services/repository/internal/grpc"
+	"github.com/drewpayment/orbit/services/repository/internal/payload"
 	"github.com/drewpayment/orbit/temporal-workflows/pkg/types"
 )
 
@@ -35,6 +42,12 @@ type Config struct {
 	TemporalHost string
 	AuthSecret   []byte
 	AuthEnforce  bool
+
+	OrbitAPIURL         string
+	OrbitInternalAPIKey string
+
+	// ShutdownGracePeriod bounds how long shutdown waits for in-flight calls
+	ShutdownGracePeriod time.Duration
 }
 
 func loadConfig() *Config {
@@ -61,18 +74,42 @@ func loadConfig() *Config {
 		log.Fatalf("FATAL: %v (set ORBIT_SVC_AUTH_SECRET; generate with `openssl rand -base64 48`)", err)
 	}
 
+	// Default stays under the 30s Kubernetes terminationGracePeriodSeconds
+	shutdownGracePeriod := 25 * time.Second
+	if p := os.Getenv("SHUTDOWN_GRACE_PERIOD"); p != "" {
+		d, err := time.ParseDuration(p)
+		if err != nil || d <= 0 {
+			log.Printf("Warning: invalid SHUTDOWN_GRACE_PERIOD %q, using %v", p, shutdownGracePeriod)
+		} else {
+			shutdownGracePeriod = d
+		}
+	}
+
+	orbitAPIURL := os.Getenv("ORBIT_API_URL")
+	if orbitAPIURL == "" {
+		orbitAPIURL = "http://orbit-www:3000"
+	}
+
 	return &Config{
 		GRPCPort:     grpcPort,
 		HTTPPort:     httpPort,
 		TemporalHost: temporalHost,
 		AuthSecret:   authSecret,
 		AuthEnforce:  os.Getenv("ORBIT_SVC_AUTH_ENFORCE") != "false",
+
+		OrbitAPIURL:         orbitAPIURL,
+		OrbitInternalAPIKey: os.Getenv("ORBIT_INTERNAL_API_KEY"),
+
+		ShutdownGracePeriod: shutdownGracePeriod,
 	}
 }
 
 // TemporalClient wraps the Temporal SDK client
 type TemporalClient struct {
 	client client.Client
+
+	// abandoned tracks the cleanup of starts whose caller went away
+	abandoned sync.WaitGroup
 }
 
 // NewTemporalClient creates a new Temporal client wrapper with retry logic
@@ -112,11 +149,93 @@ func NewTemporalClientWithRetry(hostPort string, maxRetries int, initialBackoff
 	return nil, fmt.Errorf("failed to connect to Temporal after %d attempts: %w", maxRetries, lastErr)
 }
 
-// Close closes the Temporal client
+// Close waits for abandoned workflow starts to be cleaned up and closes the
+// Temporal client
 func (tc *TemporalClient) Close() {
+	tc.abandoned.Wait()
 	tc.client.Close()
 }
 
+const (
+	// workflowStartTimeout bounds a workflow start. Starts run detached from
+	// the caller so that their outcome is known even if the caller leaves.
+	workflowStartTimeout = 10 * time.Second
+
+	// workflowStartCleanupTimeout bounds the cancel sent for a workflow whose
+	// caller went away while it was being started
+	workflowStartCleanupTimeout = 5 * time.Second
+)
+
+// workflowStart is the outcome of a workflow start
+type workflowStart struct {
+	run client.WorkflowRun
+	err error
+}
+
+// executeWorkflow starts a workflow within the caller's deadline and returns
+// its ID. Nothing is started for a caller that has already gone away, and a
+// confirmed start returns its ID even if the caller's deadline passes right
+// after it. If the caller goes away while the start is in flight, it gets an
+// error straight away and the run the start produces, if any, is canceled.
+func (tc *TemporalClient) executeWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow string, args ...interface{}) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("workflow %s not started: %w", options.ID, err)
+	}
+
+	// A deduplicated ID is shared with retries that reattach to its run.
+	// Other starts must not be handed a run begun by another caller, or an
+	// abandoned start could cancel it.
+	deduplicated := options.WorkflowIDReusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	options.WorkflowExecutionErrorWhenAlreadyStarted = !deduplicated
+
+	started := make(chan workflowStart, 1)
+	go func() {
+		startCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), workflowStartTimeout)
+		defer cancel()
+		run, err := tc.client.ExecuteWorkflow(startCtx, options, workflow, args...)
+		started <- workflowStart{run: run, err: err}
+	}()
+
+	select {
+	case start := <-started:
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(start.err, &alreadyStarted) {
+			// The workflow is already running for an earlier caller
+			return options.ID, nil
+		}
+		if start.err != nil {
+			return "", start.err
+		}
+		return start.run.GetID(), nil
+	case <-ctx.Done():
+		if !deduplicated {
+			tc.abandoned.Add(1)
+			go func() {
+				defer tc.abandoned.Done()
+				tc.cancelAbandonedWorkflow(ctx, <-started)
+			}()
+		}
+		return "", fmt.Errorf("workflow %s start abandoned: %w", options.ID, ctx.Err())
+	}
+}
+
+// cancelAbandonedWorkflow cancels the run started for a caller that went
+// away. A start that failed, including one that found the workflow already
+// running for someone else, has nothing to cancel.
+func (tc *TemporalClient) cancelAbandonedWorkflow(ctx context.Context, start workflowStart) {
+	if start.err != nil {
+		return
+	}
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), workflowStartCleanupTimeout)
+	defer cancel()
+
+	err := tc.client.CancelWorkflow(cleanupCtx, start.run.GetID(), start.run.GetRunID())
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		log.Printf("Warning: failed to cancel workflow %s abandoned by its caller: %v", start.run.GetID(), err)
+	}
+}
+
 // StartTemplateWorkflow starts a template instantiation workflow
 func (tc *TemporalClient) StartTemplateWorkflow(ctx context.Context, input interface{}) (string, error) {
 	req, ok := input.(*templatev1.StartInstantiationRequest)
@@ -142,18 +261,32 @@ func (tc *TemporalClient) StartTemplateWorkflow(ctx context.Context, input inter
 		InstallationID:   req.GithubInstallationId,
 	}
 
-	workflowID := fmt.Sprintf("template-instantiation-%s-%d", req.RepositoryName, time.Now().Unix())
-
-	we, err := tc.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
-		ID:        workflowID,
+	options := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("template-instantiation-%s-%d", req.RepositoryName, time.Now().Unix()),
 		TaskQueue: "orbit-workflows",
-	}, "TemplateInstantiationWorkflow", workflowInput)
+	}
+	if req.IdempotencyKey != "" {
+		// Retries with the same key reuse the workflow ID, and Temporal hands
+		// back the existing run instead of starting another, even after it
+		// has finished
+		options.ID = templateWorkflowID(req.WorkspaceId, req.RepositoryName, req.IdempotencyKey)
+		options.WorkflowIDReusePolicy = enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	}
 
+	startedID, err := tc.executeWorkflow(ctx, options, "TemplateInstantiationWorkflow", workflowInput)
 	if err != nil {
 		return "", fmt.Errorf("failed to start workflow: %w", err)
 	}
 
-	return we.GetID(), nil
+	return startedID, nil
+}
+
+// templateWorkflowID derives the workflow ID for a keyed template
+// instantiation. The key is hashed so that any client-chosen string yields a
+// valid ID, and scoped to the workspace so tenants cannot collide.
+func templateWorkflowID(workspaceID, repositoryName, idempotencyKey string) string {
+	sum := sha256.Sum256([]byte(workspaceID + "\x00" + idempotencyKey))
+	return fmt.Sprintf("template-instantiation-%s-%s", repositoryName, hex.EncodeToString(sum[:8]))
 }
 
 // QueryWorkflow queries a workflow for progress
@@ -171,7 +304,7 @@ func (tc *TemporalClient) QueryWorkflow(ctx context.Context, workflowID, queryTy
 	// Convert to map for the server to parse
 	return map[string]interface{}{
 		"currentStep":     progress.CurrentStep,
-		"progressPercent": int32(progress.StepsCurrent * 100 / max(progress.StepsTotal, 1)),
+		"progressPercent": int32(progress.ProgressPercent),
 		"status":          getStatusString(progress),
 		"message":         progress.Message,
 	}, nil
@@ -203,16 +336,15 @@ func (tc *TemporalClient) CancelWorkflow(ctx context.Context, workflowID string)
 func (tc *TemporalClient) StartDeploymentWorkflow(ctx context.Context, input *types.DeploymentWorkflowInput) (string, error) {
 	workflowID := fmt.Sprintf("deployment-%s", input.DeploymentID)
 
-	we, err := tc.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+	startedID, err := tc.executeWorkflow(ctx, client.StartWorkflowOptions{
 		ID:        workflowID,
 		TaskQueue: "orbit-workflows",
 	}, "DeploymentWorkflow", input)
-
 	if err != nil {
 		return "", fmt.Errorf("failed to start deployment workflow: %w", err)
 	}
 
-	return we.GetID(), nil
+	return startedID, nil
 }
 
 // QueryDeploymentWorkflow queries a deployment workflow for progress
@@ -237,16 +369,15 @@ func (tc *TemporalClient) StartBuildWorkflow(ctx context.Context, input *types.B
 	// Generate a unique request ID
 	input.RequestID = workflowID
 
-	we, err := tc.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+	startedID, err := tc.executeWorkflow(ctx, client.StartWorkflowOptions{
 		ID:        workflowID,
 		TaskQueue: "orbit-workflows",
 	}, "BuildWorkflow", *input)
-
 	if err != nil {
 		return "", fmt.Errorf("failed to start build workflow: %w", err)
 	}
 
-	return we.GetID(), nil
+	return startedID, nil
 }
 
 // QueryBuildWorkflow queries a build workflow for progress
@@ -282,16 +413,15 @@ func (tc *TemporalClient) StartLaunchWorkflow(ctx context.Context, input *grpcse
 		LaunchedBy:        input.LaunchedBy,
 	}
 
-	we, err := tc.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+	startedID, err := tc.executeWorkflow(ctx, client.StartWorkflowOptions{
 		ID:        workflowID,
 		TaskQueue: "orbit-workflows",
 	}, "LaunchWorkflow", workflowInput)
-
 	if err != nil {
 		return "", fmt.Errorf("failed to start launch workflow: %w", err)
 	}
 
-	return we.GetID(), nil
+	return startedID, nil
 }
 
 // QueryLaunchProgress queries a launch workflow for progress
@@ -360,34 +490,15 @@ func (tc *TemporalClient) StartDeployToLaunchWorkflow(ctx context.Context, input
 		BuildEnv:        input.BuildEnv,
 	}
 
-	we, err := tc.client.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+	startedID, err := tc.executeWorkflow(ctx, client.StartWorkflowOptions{
 		ID:        workflowID,
 		TaskQueue: taskQueue,
 	}, "DeployToLaunchWorkflow", workflowInput)
-
 	if err != nil {
 		return "", fmt.Errorf("failed to start deploy-to-launch workflow: %w", err)
 	}
 
-	return we.GetID(), nil
-}
-
-// StubPayloadClient is a placeholder for Payload CMS operations
-// TODO: Implement real Payload client
-type StubPayloadClient struct{}
-
-func (s *StubPayloadClient) GetTemplate(ctx context.Context, templateID string) (*grpcserver.TemplateData, error) {
-	// TODO: Implement Payload API call
-	return &grpcserver.TemplateData{
-		ID:          templateID,
-		Name:        "Template",
-		Description: "A template",
-	}, nil
-}
-
-func (s *StubPayloadClient) ListWorkspaceInstallations(ctx context.Context, workspaceID string) ([]*grpcserver.InstallationData, error) {
-	// TODO: Implement Payload API call
-	return []*grpcserver.InstallationData{}, nil
+	return startedID, nil
 }
 
 func main() {
@@ -406,13 +517,22 @@ func main() {
 		log.Println("Connected to Temporal")
 	}
 
-	// Create stub Payload client (TODO: implement real client)
-	payloadClient := &StubPayloadClient{}
+	// Payload CMS client for template and GitHub installation lookups
+	payloadClient := payload.NewClient(cfg.OrbitAPIURL, cfg.OrbitInternalAPIKey)
+	if cfg.OrbitInternalAPIKey == "" {
+		log.Println("Warning: ORBIT_INTERNAL_API_KEY not set, Payload lookups will be rejected")
+	}
+
+	// Tracks in-flight calls so shutdown can wait for them. It runs before
+	// service auth so calls arriving during shutdown are rejected with
+	// Unavailable before doing any work.
+	drainer := grpcserver.NewDrainer()
 
 	// Service-auth interceptor applied to every Connect handler. It verifies the
 	// bearer token minted by orbit-www and injects the caller identity; exempt
 	// procedures (health) pass through. Default deny (GO-H1/H2).
 	authInterceptor := connect.WithInterceptors(
+		drainer.Interceptor(),
 		svcauth.NewConnectInterceptor(cfg.AuthSecret, cfg.AuthEnforce),
 	)
 
@@ -440,16 +560,22 @@ func main() {
 		log.Println("DeploymentService not registered (Temporal client unavailable)")
 	}
 
-	// Register HealthService (Connect handler)
+	// Readiness pings the Temporal frontend; it backs both /ready and
+	// HealthService.Check
+	var temporalHealth grpcserver.TemporalHealthChecker
+	var healthWorkflowClient grpcserver.WorkflowClient
 	if temporalClient != nil {
-		temporalWorkflowClient := grpcserver.NewTemporalWorkflowClient(temporalClient.client)
-		healthService := grpcserver.NewHealthService(temporalWorkflowClient)
-		healthPath, healthHandler := healthv1connect.NewHealthServiceHandler(healthService, authInterceptor)
-		mux.Handle(healthPath, healthHandler)
-		log.Println("HealthService registered (Connect)")
-	} else {
-		log.Println("HealthService not registered (Temporal client unavailable)")
+		temporalHealth = temporalClient.client
+		healthWorkflowClient = grpcserver.NewTemporalWorkflowClient(temporalClient.client)
 	}
+	readiness := grpcserver.NewReadinessChecker(temporalHealth, grpcserver.DefaultReadinessCacheTTL)
+
+	// Register HealthService (Connect handler). It is registered even without
+	// Temporal so Check can report NOT_SERVING.
+	healthService := grpcserver.NewHealthService(healthWorkflowClient, readiness)
+	healthPath, healthHandler := healthv1connect.NewHealthServiceHandler(healthService, authInterceptor)
+	mux.Handle(healthPath, healthHandler)
+	log.Println("HealthService registered (Connect)")
 
 	// Register BuildService (Connect handler)
 	if temporalClient != nil {
@@ -485,7 +611,7 @@ func main() {
 	}
 
 	// Start HTTP health server on separate port
-	go startHTTPServer(cfg.HTTPPort)
+	go startHTTPServer(cfg.HTTPPort, readiness)
 
 	// Create HTTP server with h2c support (HTTP/2 cleartext for gRPC compatibility)
 	srv := &http.Server{
@@ -506,14 +632,20 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("Shutting down server...")
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	log.Printf("Shutting down server, waiting up to %v for in-flight requests...", cfg.ShutdownGracePeriod)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownGracePeriod)
 	defer cancel()
-	srv.Shutdown(ctx)
+	if err := drainer.Drain(ctx); err != nil {
+		log.Printf("Warning: in-flight requests did not finish within the grace period: %v", err)
+	}
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Warning: forcing server close: %v", err)
+		srv.Close()
+	}
 	log.Println("Server stopped")
 }
 
-func startHTTPServer(port int) {
+func startHTTPServer(port int, readiness http.Handler) {
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -521,10 +653,7 @@ func startHTTPServer(port int) {
 		w.Write([]byte("OK"))
 	})
 
-	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("READY"))
-	})
+	mux.Handle("/ready", readiness)
 
 	log.Printf("HTTP server listening on :%d", port)
 	if err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux); err != nil {
@@ -1,9 +1,20 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/mocks"
+
+	templatev1 "github.com/drewpayment/orbit/proto/gen/go/idp/template/v1"
+	"github.com/drewpayment/orbit/temporal-workflows/pkg/types"
 )
 
 func TestMain(t *testing.T) {
@@ -15,4 +26,200 @@ func TestServerConfiguration(t *testing.T) {
 	// Test server configuration loading
 	// This will be implemented when we create the server
 	t.Skip("Server implementation pending")
-}
\ No newline at end of file
+}
+
+func startedRun(workflowID, runID string) *mocks.WorkflowRun {
+	run := &mocks.WorkflowRun{}
+	run.On("GetID").Return(workflowID).Maybe()
+	run.On("GetRunID").Return(runID).Maybe()
+	return run
+}
+
+func TestStartWorkflow_CanceledBeforeStartStartsNothing(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	workflowID, err := tc.StartTemplateWorkflow(ctx, &templatev1.StartInstantiationRequest{RepositoryName: "svc"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, workflowID)
+
+	workflowID, err = tc.StartDeploymentWorkflow(ctx, &types.DeploymentWorkflowInput{DeploymentID: "d1"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, workflowID)
+
+	temporal.AssertNotCalled(t, "ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	temporal.AssertNotCalled(t, "CancelWorkflow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// leaveDuringStart makes ExecuteWorkflow cancel the caller's context and
+// hold its result until the returned release func is called
+func leaveDuringStart(t *testing.T, call *mock.Call, cancel context.CancelFunc) (release func()) {
+	released := make(chan struct{})
+	call.Run(func(args mock.Arguments) {
+		cancel()
+		<-released
+		// The start must not inherit the caller's cancellation
+		assert.NoError(t, args.Get(0).(context.Context).Err())
+	})
+	return func() { close(released) }
+}
+
+func TestStartWorkflow_CallerGoneDuringStartCancelsWorkflow(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := leaveDuringStart(t, temporal.On("ExecuteWorkflow", mock.Anything, mock.Anything, "DeploymentWorkflow", mock.Anything).
+		Return(startedRun("deployment-d1", "run-1"), nil), cancel)
+	temporal.On("CancelWorkflow", mock.Anything, "deployment-d1", "run-1").
+		Run(func(args mock.Arguments) {
+			// The cleanup must not inherit the caller's cancellation
+			assert.NoError(t, args.Get(0).(context.Context).Err())
+		}).
+		Return(nil)
+
+	workflowID, err := tc.StartDeploymentWorkflow(ctx, &types.DeploymentWorkflowInput{DeploymentID: "d1"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, workflowID, "an abandoned start must not return a workflow ID")
+
+	release()
+	tc.abandoned.Wait()
+	temporal.AssertExpectations(t)
+}
+
+func TestStartWorkflow_ConfirmedStartOutlivesCaller(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	temporal.On("ExecuteWorkflow", mock.Anything, mock.Anything, "DeploymentWorkflow", mock.Anything).
+		Return(startedRun("deployment-d1", "run-1"), nil)
+
+	workflowID, err := tc.StartDeploymentWorkflow(ctx, &types.DeploymentWorkflowInput{DeploymentID: "d1"})
+	// The caller goes away just after Temporal confirmed the start
+	cancel()
+	require.NoError(t, err)
+	assert.Equal(t, "deployment-d1", workflowID)
+
+	tc.abandoned.Wait()
+	temporal.AssertNotCalled(t, "CancelWorkflow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStartWorkflow_AlreadyStartedReturnsExistingWorkflow(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+
+	temporal.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(options client.StartWorkflowOptions) bool {
+		return options.WorkflowExecutionErrorWhenAlreadyStarted
+	}), "DeploymentWorkflow", mock.Anything).
+		Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("running", "", "run-0"))
+
+	workflowID, err := tc.StartDeploymentWorkflow(context.Background(), &types.DeploymentWorkflowInput{DeploymentID: "d1"})
+	require.NoError(t, err)
+	assert.Equal(t, "deployment-d1", workflowID)
+	temporal.AssertNotCalled(t, "CancelWorkflow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStartWorkflow_AbandonedStartDoesNotCancelAnotherCallersRun(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Another caller started deployment-d1 first, so this start finds it
+	// running and is not handed its run
+	release := leaveDuringStart(t, temporal.On("ExecuteWorkflow", mock.Anything, mock.Anything, "DeploymentWorkflow", mock.Anything).
+		Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("running", "", "run-0")), cancel)
+
+	workflowID, err := tc.StartDeploymentWorkflow(ctx, &types.DeploymentWorkflowInput{DeploymentID: "d1"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, workflowID)
+
+	release()
+	tc.abandoned.Wait()
+	temporal.AssertNotCalled(t, "CancelWorkflow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStartWorkflow_ReturnsStartedWorkflowID(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+
+	temporal.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(options client.StartWorkflowOptions) bool {
+		return options.ID == "deployment-d1" && options.TaskQueue == "orbit-workflows"
+	}), "DeploymentWorkflow", mock.Anything).Return(startedRun("deployment-d1", "run-1"), nil)
+
+	workflowID, err := tc.StartDeploymentWorkflow(context.Background(), &types.DeploymentWorkflowInput{DeploymentID: "d1"})
+	require.NoError(t, err)
+	assert.Equal(t, "deployment-d1", workflowID)
+	temporal.AssertNotCalled(t, "CancelWorkflow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStartTemplateWorkflow_IdempotencyKeyReusesRun(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+
+	// Mimic Temporal's ID deduplication: a second start of a known ID gets
+	// the existing run back
+	runs := map[string]*mocks.WorkflowRun{}
+	temporal.On("ExecuteWorkflow", mock.Anything, mock.Anything, "TemplateInstantiationWorkflow", mock.Anything).
+		Return(func(_ context.Context, options client.StartWorkflowOptions, _ interface{}, _ ...interface{}) client.WorkflowRun {
+			assert.Equal(t, enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE, options.WorkflowIDReusePolicy)
+			if _, ok := runs[options.ID]; !ok {
+				runs[options.ID] = startedRun(options.ID, fmt.Sprintf("run-%d", len(runs)+1))
+			}
+			return runs[options.ID]
+		}, nil)
+
+	req := &templatev1.StartInstantiationRequest{
+		TemplateId:     "tmpl-1",
+		WorkspaceId:    "ws-1",
+		RepositoryName: "payments",
+		IdempotencyKey: "submit-7f3a",
+	}
+	first, err := tc.StartTemplateWorkflow(context.Background(), req)
+	require.NoError(t, err)
+	second, err := tc.StartTemplateWorkflow(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Len(t, runs, 1, "retries must not start a second workflow")
+	temporal.AssertNumberOfCalls(t, "ExecuteWorkflow", 2)
+
+	// Other keys and other workspaces get their own workflows
+	otherKey, err := tc.StartTemplateWorkflow(context.Background(), &templatev1.StartInstantiationRequest{
+		WorkspaceId: "ws-1", RepositoryName: "payments", IdempotencyKey: "submit-9c21",
+	})
+	require.NoError(t, err)
+	otherWorkspace, err := tc.StartTemplateWorkflow(context.Background(), &templatev1.StartInstantiationRequest{
+		WorkspaceId: "ws-2", RepositoryName: "payments", IdempotencyKey: "submit-7f3a",
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, first, otherKey)
+	assert.NotEqual(t, first, otherWorkspace)
+	assert.Len(t, runs, 3)
+}
+
+func TestStartTemplateWorkflow_AbandonedKeyedStartIsNotCanceled(t *testing.T) {
+	temporal := &mocks.Client{}
+	tc := &TemporalClient{client: temporal}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := leaveDuringStart(t, temporal.On("ExecuteWorkflow", mock.Anything, mock.Anything, "TemplateInstantiationWorkflow", mock.Anything).
+		Return(startedRun("template-instantiation-payments-1", "run-1"), nil), cancel)
+
+	workflowID, err := tc.StartTemplateWorkflow(ctx, &templatev1.StartInstantiationRequest{
+		WorkspaceId: "ws-1", RepositoryName: "payments", IdempotencyKey: "submit-7f3a",
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, workflowID)
+
+	// A retry with the same key reattaches to the run
+	release()
+	tc.abandoned.Wait()
+	temporal.AssertNotCalled(t, "CancelWorkflow", mock.Anything, mock.Anything, mock.Anything)
+}
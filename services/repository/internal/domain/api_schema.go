@@ -67,6 +67,7 @@ type APISchemaVersion struct {
 	ID                 uuid.UUID  `json:"id" db:"id"`
 	SchemaID           uuid.UUID  `json:"schema_id" db:"schema_id"`
 	Version            string     `json:"version" db:"version"`
+	VersionSortKey     string     `json:"-" db:"version_sort_key"` // SemanticVersion.SortKey of Version
 	Content            string     `json:"content" db:"content"`
 	ContentHash        string     `json:"content_hash" db:"content_hash"`
 	ContentSize        int64      `json:"content_size" db:"content_size"`
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SemanticVersion is a parsed Semantic Versioning 2.0.0 version
+type SemanticVersion struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      string
+}
+
+// ParseSemanticVersion parses MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]. A
+// leading "v" is accepted.
+func ParseSemanticVersion(version string) (SemanticVersion, error) {
+	var v SemanticVersion
+	s := strings.TrimPrefix(version, "v")
+
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		v.Build = s[i+1:]
+		s = s[:i]
+		if !validSemverIdentifiers(v.Build, false) {
+			return SemanticVersion{}, fmt.Errorf("invalid build metadata in version %q", version)
+		}
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		pre := s[i+1:]
+		s = s[:i]
+		if !validSemverIdentifiers(pre, true) {
+			return SemanticVersion{}, fmt.Errorf("invalid prerelease in version %q", version)
+		}
+		v.Prerelease = strings.Split(pre, ".")
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return SemanticVersion{}, fmt.Errorf("version %q must have the form MAJOR.MINOR.PATCH", version)
+	}
+	numbers := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := parseSemverNumber(part)
+		if err != nil {
+			return SemanticVersion{}, fmt.Errorf("invalid version %q: %w", version, err)
+		}
+		*numbers[i] = n
+	}
+	return v, nil
+}
+
+// String returns the canonical form of the version
+func (v SemanticVersion) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 as v has lower, equal or higher precedence
+// than other. Build metadata does not affect precedence.
+func (v SemanticVersion) Compare(other SemanticVersion) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A release has higher precedence than any of its prereleases
+	switch {
+	case len(v.Prerelease) == 0 && len(other.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(other.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(other.Prerelease); i++ {
+		if c := compareSemverIdentifier(v.Prerelease[i], other.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.Prerelease) < len(other.Prerelease):
+		return -1
+	case len(v.Prerelease) > len(other.Prerelease):
+		return 1
+	}
+	return 0
+}
+
+// SortKey returns a string whose byte-wise ordering matches version
+// precedence, for storing alongside the version and ordering in queries.
+// Numbers are zero padded, numeric prerelease identifiers sort before
+// alphanumeric ones and a release sorts after its prereleases.
+func (v SemanticVersion) SortKey() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%020d.%020d.%020d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) == 0 {
+		b.WriteString("~")
+		return b.String()
+	}
+	b.WriteString("-")
+	for i, id := range v.Prerelease {
+		if i > 0 {
+			// Lower than any identifier character so shorter prereleases sort first
+			b.WriteString(" ")
+		}
+		if n, err := strconv.ParseUint(id, 10, 64); err == nil {
+			fmt.Fprintf(&b, "0%020d", n)
+		} else {
+			b.WriteString("1" + id)
+		}
+	}
+	return b.String()
+}
+
+func parseSemverNumber(s string) (uint64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty version number")
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return 0, fmt.Errorf("version number %q has a leading zero", s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// validSemverIdentifiers checks dot-separated prerelease or build identifiers
+func validSemverIdentifiers(s string, prerelease bool) bool {
+	if s == "" {
+		return false
+	}
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		numeric := true
+		for _, r := range id {
+			switch {
+			case r >= '0' && r <= '9':
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if prerelease && numeric {
+			if _, err := parseSemverNumber(id); err != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func compareSemverIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+		return 0
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -144,6 +144,9 @@ func getDeploymentStatusString(progress *types.DeploymentProgress) string {
 	if progress.CurrentStep == "failed" {
 		return "failed"
 	}
+	if progress.CurrentStep == "rolling_back" {
+		return "rolling_back"
+	}
 	if progress.CurrentStep == "initializing" || progress.CurrentStep == "" {
 		return "pending"
 	}
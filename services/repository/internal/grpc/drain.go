@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"connectrpc.com/connect"
+)
+
+// Drainer tracks in-flight unary calls so shutdown can wait for them. The
+// Connect server runs over h2c, whose hijacked connections http.Server.Shutdown
+// does not wait for, so a deploy started just before SIGTERM would otherwise be
+// cut off mid-flight.
+//
+// Streams are not waited for: the agent chat streams are long-lived and would
+// hold shutdown for the whole grace period. New streams are still rejected
+// while draining.
+type Drainer struct {
+	mu       sync.Mutex
+	draining bool
+	inFlight sync.WaitGroup
+}
+
+// NewDrainer creates a Drainer. Its Interceptor should run before any other
+// interceptor so rejected calls do no work.
+func NewDrainer() *Drainer {
+	return &Drainer{}
+}
+
+var errDraining = errors.New("server is shutting down")
+
+// begin registers a new call, failing with Unavailable once draining started
+func (d *Drainer) begin(track bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return connect.NewError(connect.CodeUnavailable, errDraining)
+	}
+	if track {
+		d.inFlight.Add(1)
+	}
+	return nil
+}
+
+// Drain rejects new calls and waits for in-flight unary calls to finish. It
+// returns ctx's error if the grace period runs out first.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	d.draining = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Interceptor returns the Connect interceptor that tracks calls
+func (d *Drainer) Interceptor() connect.Interceptor {
+	return drainInterceptor{d}
+}
+
+type drainInterceptor struct {
+	drainer *Drainer
+}
+
+func (i drainInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.drainer.begin(true); err != nil {
+			return nil, err
+		}
+		defer i.drainer.inFlight.Done()
+		return next(ctx, req)
+	}
+}
+
+func (i drainInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.drainer.begin(false); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// WrapStreamingClient is a no-op: only inbound calls are tracked
+func (i drainInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
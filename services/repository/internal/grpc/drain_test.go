@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+
+	healthv1 "github.com/drewpayment/orbit/proto/gen/go/idp/health/v1"
+	"github.com/drewpayment/orbit/proto/gen/go/idp/health/v1/healthv1connect"
+)
+
+// blockingHealthChecker makes HealthService.Check a slow handler: it signals
+// started and then waits for release
+type blockingHealthChecker struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingHealthChecker) CheckHealth(ctx context.Context, _ *client.CheckHealthRequest) (*client.CheckHealthResponse, error) {
+	close(b.started)
+	<-b.release
+	return &client.CheckHealthResponse{}, nil
+}
+
+func startDrainTestServer(t *testing.T, drainer *Drainer, checker TemporalHealthChecker) healthv1connect.HealthServiceClient {
+	t.Helper()
+	service := NewHealthService(nil, NewReadinessChecker(checker, DefaultReadinessCacheTTL))
+	path, handler := healthv1connect.NewHealthServiceHandler(service, connect.WithInterceptors(drainer.Interceptor()))
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return healthv1connect.NewHealthServiceClient(server.Client(), server.URL)
+}
+
+func TestDrainer_WaitsForInFlightCallAndRejectsNewOnes(t *testing.T) {
+	drainer := NewDrainer()
+	checker := &blockingHealthChecker{started: make(chan struct{}), release: make(chan struct{})}
+	healthClient := startDrainTestServer(t, drainer, checker)
+
+	type result struct {
+		resp *connect.Response[healthv1.CheckResponse]
+		err  error
+	}
+	inFlight := make(chan result, 1)
+	go func() {
+		resp, err := healthClient.Check(context.Background(), connect.NewRequest(&healthv1.CheckRequest{}))
+		inFlight <- result{resp, err}
+	}()
+	<-checker.started
+
+	drained := make(chan error, 1)
+	go func() { drained <- drainer.Drain(context.Background()) }()
+
+	// New calls are turned away while the slow call is still running
+	require.Eventually(t, func() bool {
+		_, err := healthClient.Check(context.Background(), connect.NewRequest(&healthv1.CheckRequest{}))
+		return connect.CodeOf(err) == connect.CodeUnavailable
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case err := <-drained:
+		t.Fatalf("drain finished before the in-flight call: %v", err)
+	default:
+	}
+
+	close(checker.release)
+
+	got := <-inFlight
+	require.NoError(t, got.err)
+	assert.Equal(t, healthv1.ServingStatus_SERVING_STATUS_SERVING, got.resp.Msg.Status)
+	assert.NoError(t, <-drained)
+}
+
+func TestDrainer_GracePeriodExpires(t *testing.T) {
+	drainer := NewDrainer()
+	checker := &blockingHealthChecker{started: make(chan struct{}), release: make(chan struct{})}
+	healthClient := startDrainTestServer(t, drainer, checker)
+	defer close(checker.release)
+
+	go healthClient.Check(context.Background(), connect.NewRequest(&healthv1.CheckRequest{}))
+	<-checker.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, drainer.Drain(ctx), context.DeadlineExceeded)
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -95,21 +96,49 @@ func (c *TemporalWorkflowClient) TerminateHealthCheckWorkflow(ctx context.Contex
 type HealthService struct {
 	healthv1connect.UnimplementedHealthServiceHandler
 	workflowClient WorkflowClient
+	readiness      *ReadinessChecker
 }
 
-// NewHealthService creates a new HealthService
-func NewHealthService(workflowClient WorkflowClient) *HealthService {
+// NewHealthService creates a new HealthService. workflowClient is nil when
+// Temporal is unavailable, in which case only Check is served.
+func NewHealthService(workflowClient WorkflowClient, readiness *ReadinessChecker) *HealthService {
 	return &HealthService{
 		workflowClient: workflowClient,
+		readiness:      readiness,
 	}
 }
 
+// Check reports NOT_SERVING when the Temporal frontend is unreachable
+func (s *HealthService) Check(ctx context.Context, req *connect.Request[healthv1.CheckRequest]) (*connect.Response[healthv1.CheckResponse], error) {
+	if s.readiness == nil {
+		return connect.NewResponse(&healthv1.CheckResponse{
+			Status:  healthv1.ServingStatus_SERVING_STATUS_NOT_SERVING,
+			Message: "readiness check not configured",
+		}), nil
+	}
+
+	if err := s.readiness.Check(ctx); err != nil {
+		return connect.NewResponse(&healthv1.CheckResponse{
+			Status:  healthv1.ServingStatus_SERVING_STATUS_NOT_SERVING,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&healthv1.CheckResponse{
+		Status: healthv1.ServingStatus_SERVING_STATUS_SERVING,
+	}), nil
+}
+
 // ManageSchedule starts or terminates a health check workflow
 // Note: The method is named ManageSchedule for API compatibility, but now manages workflows
 func (s *HealthService) ManageSchedule(ctx context.Context, req *connect.Request[healthv1.ManageScheduleRequest]) (*connect.Response[healthv1.ManageScheduleResponse], error) {
 	msg := req.Msg
 	log.Printf("ManageSchedule called for appId=%s, hasConfig=%v", msg.AppId, msg.HealthConfig != nil)
 
+	if s.workflowClient == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("temporal client unavailable"))
+	}
+
 	if msg.HealthConfig == nil || msg.HealthConfig.Url == "" {
 		// No health config - terminate workflow if running
 		err := s.workflowClient.TerminateHealthCheckWorkflow(ctx, msg.AppId)
@@ -167,6 +196,10 @@ func (s *HealthService) DeleteSchedule(ctx context.Context, req *connect.Request
 	msg := req.Msg
 	log.Printf("DeleteSchedule called for appId=%s", msg.AppId)
 
+	if s.workflowClient == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("temporal client unavailable"))
+	}
+
 	err := s.workflowClient.TerminateHealthCheckWorkflow(ctx, msg.AppId)
 	if err != nil {
 		log.Printf("Warning: Failed to terminate workflow (may not have been running): %v", err)
@@ -30,7 +30,7 @@ func (m *mockScheduleClient) TerminateHealthCheckWorkflow(_ context.Context, _ s
 
 func TestManageSchedule_Create(t *testing.T) {
 	mockClient := &mockScheduleClient{}
-	service := NewHealthService(mockClient)
+	service := NewHealthService(mockClient, nil)
 
 	resp, err := service.ManageSchedule(context.Background(), connect.NewRequest(&healthv1.ManageScheduleRequest{
 		AppId: "test-app",
@@ -51,7 +51,7 @@ func TestManageSchedule_Create(t *testing.T) {
 
 func TestManageSchedule_Delete(t *testing.T) {
 	mockClient := &mockScheduleClient{}
-	service := NewHealthService(mockClient)
+	service := NewHealthService(mockClient, nil)
 
 	resp, err := service.ManageSchedule(context.Background(), connect.NewRequest(&healthv1.ManageScheduleRequest{
 		AppId:        "test-app",
@@ -65,7 +65,7 @@ func TestManageSchedule_Delete(t *testing.T) {
 
 func TestManageSchedule_NilConfig(t *testing.T) {
 	mockClient := &mockScheduleClient{}
-	service := NewHealthService(mockClient)
+	service := NewHealthService(mockClient, nil)
 
 	resp, err := service.ManageSchedule(context.Background(), connect.NewRequest(&healthv1.ManageScheduleRequest{
 		AppId:        "test-app",
@@ -79,7 +79,7 @@ func TestManageSchedule_NilConfig(t *testing.T) {
 
 func TestDeleteSchedule(t *testing.T) {
 	mockClient := &mockScheduleClient{}
-	service := NewHealthService(mockClient)
+	service := NewHealthService(mockClient, nil)
 
 	resp, err := service.DeleteSchedule(context.Background(), connect.NewRequest(&healthv1.DeleteScheduleRequest{
 		AppId: "test-app",
@@ -92,7 +92,7 @@ func TestDeleteSchedule(t *testing.T) {
 
 func TestManageSchedule_DefaultInterval(t *testing.T) {
 	mockClient := &mockScheduleClient{}
-	service := NewHealthService(mockClient)
+	service := NewHealthService(mockClient, nil)
 
 	resp, err := service.ManageSchedule(context.Background(), connect.NewRequest(&healthv1.ManageScheduleRequest{
 		AppId: "test-app",
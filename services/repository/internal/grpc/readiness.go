@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+)
+
+const (
+	// DefaultReadinessCacheTTL is how long a readiness result is reused before
+	// Temporal is pinged again
+	DefaultReadinessCacheTTL = 5 * time.Second
+
+	readinessCheckTimeout = 3 * time.Second
+)
+
+// TemporalHealthChecker pings the Temporal frontend. client.Client satisfies it.
+type TemporalHealthChecker interface {
+	CheckHealth(ctx context.Context, request *client.CheckHealthRequest) (*client.CheckHealthResponse, error)
+}
+
+// ReadinessChecker reports whether the service can reach Temporal. Results are
+// cached for a short TTL so probes do not hammer the Temporal frontend.
+type ReadinessChecker struct {
+	temporal TemporalHealthChecker
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	lastErr   error
+}
+
+// NewReadinessChecker creates a ReadinessChecker. A nil temporal checker means
+// the Temporal client could not be created, and the service is never ready.
+func NewReadinessChecker(temporal TemporalHealthChecker, ttl time.Duration) *ReadinessChecker {
+	return &ReadinessChecker{
+		temporal: temporal,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// Check returns nil when Temporal is reachable
+func (r *ReadinessChecker) Check(ctx context.Context) error {
+	if r.temporal == nil {
+		return errors.New("temporal client unavailable")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.checkedAt.IsZero() && r.now().Sub(r.checkedAt) < r.ttl {
+		return r.lastErr
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+	_, err := r.temporal.CheckHealth(ctx, &client.CheckHealthRequest{})
+
+	r.checkedAt = r.now()
+	r.lastErr = err
+	return err
+}
+
+// ServeHTTP implements the /ready endpoint, answering 503 when Temporal is
+// unreachable
+func (r *ReadinessChecker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if err := r.Check(req.Context()); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("NOT READY: " + err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("READY"))
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+
+	healthv1 "github.com/drewpayment/orbit/proto/gen/go/idp/health/v1"
+)
+
+type countingHealthChecker struct {
+	calls int
+	err   error
+}
+
+func (c *countingHealthChecker) CheckHealth(context.Context, *client.CheckHealthRequest) (*client.CheckHealthResponse, error) {
+	c.calls++
+	return &client.CheckHealthResponse{}, c.err
+}
+
+// deadTemporalAddress returns an address nothing is listening on
+func deadTemporalAddress(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return addr
+}
+
+func TestHealthServiceCheck_TemporalUnreachable(t *testing.T) {
+	temporalClient, err := client.NewLazyClient(client.Options{HostPort: deadTemporalAddress(t)})
+	require.NoError(t, err)
+	defer temporalClient.Close()
+
+	readiness := NewReadinessChecker(temporalClient, DefaultReadinessCacheTTL)
+	service := NewHealthService(nil, readiness)
+
+	resp, err := service.Check(context.Background(), connect.NewRequest(&healthv1.CheckRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, healthv1.ServingStatus_SERVING_STATUS_NOT_SERVING, resp.Msg.Status)
+	assert.NotEmpty(t, resp.Msg.Message)
+
+	rec := httptest.NewRecorder()
+	readiness.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestHealthServiceCheck_NoTemporalClient(t *testing.T) {
+	service := NewHealthService(nil, NewReadinessChecker(nil, DefaultReadinessCacheTTL))
+
+	resp, err := service.Check(context.Background(), connect.NewRequest(&healthv1.CheckRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, healthv1.ServingStatus_SERVING_STATUS_NOT_SERVING, resp.Msg.Status)
+
+	_, err = service.ManageSchedule(context.Background(), connect.NewRequest(&healthv1.ManageScheduleRequest{AppId: "app"}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+}
+
+func TestReadinessChecker_CachesResult(t *testing.T) {
+	checker := &countingHealthChecker{}
+	readiness := NewReadinessChecker(checker, time.Minute)
+	now := time.Now()
+	readiness.now = func() time.Time { return now }
+
+	service := NewHealthService(nil, readiness)
+	for i := 0; i < 3; i++ {
+		resp, err := service.Check(context.Background(), connect.NewRequest(&healthv1.CheckRequest{}))
+		require.NoError(t, err)
+		assert.Equal(t, healthv1.ServingStatus_SERVING_STATUS_SERVING, resp.Msg.Status)
+	}
+	assert.Equal(t, 1, checker.calls)
+
+	// Once the TTL expires Temporal is pinged again and a failure is reported
+	checker.err = errors.New("connection refused")
+	now = now.Add(time.Minute)
+	rec := httptest.NewRecorder()
+	readiness.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, 2, checker.calls)
+}
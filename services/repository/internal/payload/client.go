@@ -0,0 +1,131 @@
+// Package payload reads template and GitHub installation data from Payload
+// CMS through orbit-www's internal API.
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	grpcserver "github.com/drewpayment/orbit/services/repository/internal/grpc"
+)
+
+var (
+	// ErrNotFound is returned when the requested document does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is returned when orbit-www rejects the internal API key
+	ErrUnauthorized = errors.New("payload rejected the internal API key")
+)
+
+// Client implements grpcserver.PayloadClientInterface over HTTP
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client for the orbit-www instance at baseURL,
+// authenticating with the internal API key
+func NewClient(baseURL, apiKey string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type templateDocument struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	RepoURL     string `json:"repoUrl"`
+}
+
+// GetTemplate fetches a template by ID. A missing template yields an error
+// wrapping ErrNotFound.
+func (c *Client) GetTemplate(ctx context.Context, templateID string) (*grpcserver.TemplateData, error) {
+	if templateID == "" {
+		return nil, fmt.Errorf("templateID is required")
+	}
+
+	var doc templateDocument
+	if err := c.get(ctx, "/api/internal/templates/"+url.PathEscape(templateID), &doc); err != nil {
+		return nil, fmt.Errorf("failed to get template %s: %w", templateID, err)
+	}
+
+	return &grpcserver.TemplateData{
+		ID:          doc.ID,
+		Name:        doc.Name,
+		Description: doc.Description,
+		RepoURL:     doc.RepoURL,
+	}, nil
+}
+
+type installationDocument struct {
+	InstallationID   json.Number `json:"installationId"`
+	AccountLogin     string      `json:"accountLogin"`
+	AccountAvatarURL string      `json:"accountAvatarUrl"`
+}
+
+// ListWorkspaceInstallations lists the active GitHub App installations the
+// workspace is allowed to use
+func (c *Client) ListWorkspaceInstallations(ctx context.Context, workspaceID string) ([]*grpcserver.InstallationData, error) {
+	if workspaceID == "" {
+		return nil, fmt.Errorf("workspaceID is required")
+	}
+
+	var result struct {
+		Docs []installationDocument `json:"docs"`
+	}
+	path := "/api/internal/workspaces/" + url.PathEscape(workspaceID) + "/github-installations"
+	if err := c.get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to list installations for workspace %s: %w", workspaceID, err)
+	}
+
+	installations := make([]*grpcserver.InstallationData, 0, len(result.Docs))
+	for _, doc := range result.Docs {
+		installations = append(installations, &grpcserver.InstallationData{
+			OrgName:        doc.AccountLogin,
+			AvatarURL:      doc.AccountAvatarURL,
+			InstallationID: doc.InstallationID.String(),
+		})
+	}
+	return installations, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	grpcserver "github.com/drewpayment/orbit/services/repository/internal/grpc"
+)
+
+const testAPIKey = "test-internal-key"
+
+// newMockPayload serves the internal API routes the client uses, rejecting
+// requests that do not carry testAPIKey
+func newMockPayload(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/internal/templates/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "tmpl-1" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error": "Template not found", "code": "NOT_FOUND"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"id":          "tmpl-1",
+			"name":        "Go Service",
+			"description": "A Go microservice template",
+			"repoUrl":     "https://github.com/acme/go-service-template",
+			"slug":        "go-service",
+		})
+	})
+	mux.HandleFunc("GET /api/internal/workspaces/{id}/github-installations", func(w http.ResponseWriter, r *http.Request) {
+		docs := []map[string]any{}
+		if r.PathValue("id") == "ws-1" {
+			docs = append(docs, map[string]any{
+				"installationId":   12345678,
+				"accountLogin":     "acme",
+				"accountAvatarUrl": "https://avatars.githubusercontent.com/u/1",
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"docs": docs})
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != testAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "Unauthorized"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_GetTemplate(t *testing.T) {
+	server := newMockPayload(t)
+	client := NewClient(server.URL, testAPIKey)
+
+	template, err := client.GetTemplate(context.Background(), "tmpl-1")
+	require.NoError(t, err)
+	assert.Equal(t, &grpcserver.TemplateData{
+		ID:          "tmpl-1",
+		Name:        "Go Service",
+		Description: "A Go microservice template",
+		RepoURL:     "https://github.com/acme/go-service-template",
+	}, template)
+}
+
+func TestClient_GetTemplate_NotFound(t *testing.T) {
+	server := newMockPayload(t)
+	client := NewClient(server.URL, testAPIKey)
+
+	_, err := client.GetTemplate(context.Background(), "missing")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestClient_RejectedAPIKey(t *testing.T) {
+	server := newMockPayload(t)
+	client := NewClient(server.URL, "wrong-key")
+
+	_, err := client.GetTemplate(context.Background(), "tmpl-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = client.ListWorkspaceInstallations(context.Background(), "ws-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestClient_TransportErrorIsNotNotFound(t *testing.T) {
+	server := newMockPayload(t)
+	client := NewClient(server.URL, testAPIKey)
+	server.Close()
+
+	_, err := client.GetTemplate(context.Background(), "tmpl-1")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestClient_ListWorkspaceInstallations(t *testing.T) {
+	server := newMockPayload(t)
+	client := NewClient(server.URL, testAPIKey)
+
+	installations, err := client.ListWorkspaceInstallations(context.Background(), "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*grpcserver.InstallationData{{
+		OrgName:        "acme",
+		AvatarURL:      "https://avatars.githubusercontent.com/u/1",
+		InstallationID: "12345678",
+	}}, installations)
+
+	installations, err = client.ListWorkspaceInstallations(context.Background(), "ws-without-installations")
+	require.NoError(t, err)
+	assert.Empty(t, installations)
+}
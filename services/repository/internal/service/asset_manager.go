@@ -0,0 +1,641 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"log/slog"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetStore persists asset metadata and binary content
+type AssetStore interface {
+	Save(ctx context.Context, asset *Asset, data []byte) error
+	Update(ctx context.Context, asset *Asset) error
+	Get(ctx context.Context, id uuid.UUID) (*Asset, error)
+	GetData(ctx context.Context, id uuid.UUID) ([]byte, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, workspaceID uuid.UUID, filters AssetFilters) ([]*Asset, error)
+}
+
+// ImageEncoder re-encodes raster images into another format. StdImageEncoder
+// covers JPEG, PNG and lossless WebP; AVIF needs an implementation wrapping
+// libavif, libvips or a remote image service.
+type ImageEncoder interface {
+	Encode(ctx context.Context, data []byte, sourceMimeType string, targetFormat ImageFormat, quality int) ([]byte, error)
+	Supports(format ImageFormat) bool
+}
+
+// ImageFormat represents an image encoding format
+type ImageFormat string
+
+const (
+	ImageFormatJPEG ImageFormat = "jpeg"
+	ImageFormatPNG  ImageFormat = "png"
+	ImageFormatGIF  ImageFormat = "gif"
+	ImageFormatWebP ImageFormat = "webp"
+	ImageFormatAVIF ImageFormat = "avif"
+)
+
+// MimeType returns the MIME type for the image format
+func (f ImageFormat) MimeType() string {
+	return "image/" + string(f)
+}
+
+// Extension returns the file extension for the image format
+func (f ImageFormat) Extension() string {
+	if f == ImageFormatJPEG {
+		return ".jpg"
+	}
+	return "." + string(f)
+}
+
+// AssetVariant describes an alternate encoding of an asset, used by the page
+// renderer to emit <picture> sources
+type AssetVariant struct {
+	AssetID  uuid.UUID   `json:"asset_id"`
+	Format   ImageFormat `json:"format"`
+	MimeType string      `json:"mime_type"`
+	URL      string      `json:"url"`
+	Size     int64       `json:"size"`
+}
+
+// AssetManagerConfig configures the default asset manager
+type AssetManagerConfig struct {
+	BaseURL          string        `json:"base_url"`
+	VariantFormats   []ImageFormat `json:"variant_formats"`    // modern formats generated on upload
+	VariantQuality   int           `json:"variant_quality"`    // 1-100
+	MaxUploadSize    int64         `json:"max_upload_size"`    // bytes
+	AllowedMimeTypes []string      `json:"allowed_mime_types"` // accepted upload content types
+}
+
+// DefaultAssetManagerConfig returns the default asset manager configuration
+func DefaultAssetManagerConfig() AssetManagerConfig {
+	return AssetManagerConfig{
+		BaseURL:        "/assets",
+		VariantFormats: []ImageFormat{ImageFormatWebP},
+		VariantQuality: 80,
+		MaxUploadSize:  25 << 20,
+		AllowedMimeTypes: []string{
+			"image/jpeg", "image/png", "image/gif", "image/webp", "image/avif", "image/svg+xml",
+			"video/mp4", "video/webm",
+			"font/woff", "font/woff2", "font/ttf", "font/otf",
+			"text/css", "text/javascript", "application/javascript",
+			"text/plain", "text/markdown", "application/pdf",
+		},
+	}
+}
+
+// maxThumbnailDimension bounds the width and height of generated thumbnails
+const maxThumbnailDimension = 2048
+
+// variantSourceMimeTypes lists raster formats that can be transcoded into
+// modern variants. Vector (SVG) and already-modern formats are skipped.
+var variantSourceMimeTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/jpg":  true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// decodableMimeTypes lists the formats the standard library can decode for
+// resizing and thumbnails
+var decodableMimeTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// sniffedMimeTypes lists declared types that must match the uploaded bytes
+var sniffedMimeTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// DefaultAssetManager implements AssetManager on top of an AssetStore
+type DefaultAssetManager struct {
+	store   AssetStore
+	encoder ImageEncoder
+	config  AssetManagerConfig
+	logger  *slog.Logger
+}
+
+// NewDefaultAssetManager creates a new asset manager instance
+func NewDefaultAssetManager(store AssetStore, encoder ImageEncoder, config AssetManagerConfig, logger *slog.Logger) *DefaultAssetManager {
+	defaults := DefaultAssetManagerConfig()
+	if config.VariantQuality <= 0 || config.VariantQuality > 100 {
+		config.VariantQuality = defaults.VariantQuality
+	}
+	if config.MaxUploadSize <= 0 {
+		config.MaxUploadSize = defaults.MaxUploadSize
+	}
+	if len(config.AllowedMimeTypes) == 0 {
+		config.AllowedMimeTypes = defaults.AllowedMimeTypes
+	}
+	return &DefaultAssetManager{
+		store:   store,
+		encoder: encoder,
+		config:  config,
+		logger:  logger.With("component", "asset_manager"),
+	}
+}
+
+// UploadAsset stores an asset and generates modern-format variants for raster images
+func (m *DefaultAssetManager) UploadAsset(ctx context.Context, req *AssetUploadRequest) (*Asset, error) {
+	if req.WorkspaceID == uuid.Nil {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if len(req.Data) == 0 {
+		return nil, ErrAssetUploadFailed
+	}
+	mimeType, err := m.validateUpload(req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	asset := &Asset{
+		ID:          uuid.New(),
+		WorkspaceID: req.WorkspaceID,
+		Name:        req.Name,
+		Filename:    req.Filename,
+		MimeType:    mimeType,
+		Size:        int64(len(req.Data)),
+		Type:        assetTypeForMimeType(req.MimeType),
+		Alt:         req.Alt,
+		Title:       req.Title,
+		Description: req.Description,
+		Tags:        req.Tags,
+		Metadata:    req.Metadata,
+		Hash:        contentHash(req.Data),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		UploadedBy:  req.UploadedBy,
+	}
+	asset.URL = m.assetURL(asset.WorkspaceID, asset.ID, path.Ext(req.Filename))
+	if decodableMimeTypes[mimeType] {
+		if config, _, err := image.DecodeConfig(bytes.NewReader(req.Data)); err == nil {
+			asset.Metadata = withImageDimensions(asset.Metadata, config.Width, config.Height)
+		}
+	}
+
+	if asset.Type == AssetTypeImage && m.canTranscode(mimeType) {
+		if err := m.checkVariantFormats(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.store.Save(ctx, asset, req.Data); err != nil {
+		return nil, fmt.Errorf("failed to save asset: %w", err)
+	}
+
+	if asset.Type == AssetTypeImage {
+		if err := m.generateVariants(ctx, asset, req.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	m.logger.InfoContext(ctx, "Asset uploaded",
+		"asset_id", asset.ID, "mime_type", asset.MimeType, "variants", len(asset.Variants))
+
+	return asset, nil
+}
+
+// GetAsset retrieves an asset by ID
+func (m *DefaultAssetManager) GetAsset(ctx context.Context, id uuid.UUID) (*Asset, error) {
+	return m.store.Get(ctx, id)
+}
+
+// DeleteAsset deletes an asset along with its generated variants
+func (m *DefaultAssetManager) DeleteAsset(ctx context.Context, id uuid.UUID) error {
+	asset, err := m.store.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get asset: %w", err)
+	}
+
+	for _, variant := range asset.Variants {
+		if err := m.store.Delete(ctx, variant.AssetID); err != nil {
+			m.logger.WarnContext(ctx, "Failed to delete asset variant", "asset_id", variant.AssetID, "error", err)
+		}
+	}
+
+	return m.store.Delete(ctx, id)
+}
+
+// ListAssets lists assets in a workspace
+func (m *DefaultAssetManager) ListAssets(ctx context.Context, workspaceID uuid.UUID, filters AssetFilters) ([]*Asset, error) {
+	return m.store.List(ctx, workspaceID, filters)
+}
+
+// OptimizeImage re-encodes an image asset and refreshes its modern-format variants
+func (m *DefaultAssetManager) OptimizeImage(ctx context.Context, assetID uuid.UUID, options ImageOptimizationOptions) (*Asset, error) {
+	asset, err := m.store.Get(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset: %w", err)
+	}
+	if asset.Type != AssetTypeImage {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedImage, asset.MimeType)
+	}
+
+	data, err := m.store.GetData(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read asset data: %w", err)
+	}
+
+	quality := options.Quality
+	if quality <= 0 || quality > 100 {
+		quality = m.config.VariantQuality
+	}
+
+	if options.Format != "" && m.canTranscode(asset.MimeType) {
+		format := ImageFormat(options.Format)
+		if m.encoder == nil || !m.encoder.Supports(format) {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedImageFormat, options.Format)
+		}
+		sourceMimeType := asset.MimeType
+		if options.MaxWidth > 0 || options.MaxHeight > 0 {
+			if data, sourceMimeType, err = downscaleImage(data, options.MaxWidth, options.MaxHeight); err != nil {
+				return nil, err
+			}
+		}
+		optimized, err := m.encoder.Encode(ctx, data, sourceMimeType, format, quality)
+		if err != nil {
+			return nil, fmt.Errorf("failed to optimize image: %w", err)
+		}
+		return m.storeVariant(ctx, asset, format, optimized)
+	}
+
+	if m.canTranscode(asset.MimeType) {
+		if err := m.checkVariantFormats(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Drop stale variants before regenerating them
+	for _, variant := range asset.Variants {
+		if err := m.store.Delete(ctx, variant.AssetID); err != nil {
+			m.logger.WarnContext(ctx, "Failed to delete asset variant", "asset_id", variant.AssetID, "error", err)
+		}
+	}
+	asset.Variants = nil
+
+	if err := m.generateVariants(ctx, asset, data); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+// GenerateThumbnail scales a raster image asset to fit size, or to fill it
+// exactly when size.Crop is set, stores the result as a derived asset and
+// records its URL as the original's ThumbnailURL
+func (m *DefaultAssetManager) GenerateThumbnail(ctx context.Context, assetID uuid.UUID, size ThumbnailSize) (*Asset, error) {
+	if size.Width < 0 || size.Height < 0 || size.Width > maxThumbnailDimension || size.Height > maxThumbnailDimension ||
+		(size.Width == 0 && size.Height == 0) || (size.Crop && (size.Width == 0 || size.Height == 0)) {
+		return nil, fmt.Errorf("%w: %dx%d", ErrInvalidThumbnailSize, size.Width, size.Height)
+	}
+
+	asset, err := m.store.Get(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset: %w", err)
+	}
+	if !decodableMimeTypes[asset.MimeType] {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedImage, asset.MimeType)
+	}
+	format, err := m.thumbnailFormat(asset.MimeType, size.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := m.store.GetData(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read asset data: %w", err)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+
+	region := src.Bounds()
+	width, height := fitDimensions(region.Dx(), region.Dy(), size.Width, size.Height)
+	if size.Crop {
+		region = coverCrop(region, size.Width, size.Height)
+		width, height = fitDimensions(size.Width, size.Height, region.Dx(), region.Dy())
+	}
+
+	encoded, err := m.encodeImage(ctx, resizeImage(src, region, width, height), format)
+	if err != nil {
+		return nil, err
+	}
+
+	base := strings.TrimSuffix(asset.Filename, path.Ext(asset.Filename))
+	thumbnail, err := m.newDerivedAsset(ctx, asset, fmt.Sprintf("%s-%dx%d%s", base, width, height, format.Extension()), format, encoded)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail.Metadata = withImageDimensions(map[string]interface{}{"thumbnail": true}, width, height)
+	if err := m.store.Update(ctx, thumbnail); err != nil {
+		return nil, fmt.Errorf("failed to save thumbnail: %w", err)
+	}
+
+	asset.ThumbnailURL = thumbnail.URL
+	asset.UpdatedAt = time.Now()
+	if err := m.store.Update(ctx, asset); err != nil {
+		return nil, fmt.Errorf("failed to link thumbnail: %w", err)
+	}
+
+	m.logger.InfoContext(ctx, "Thumbnail generated",
+		"asset_id", asset.ID, "thumbnail_id", thumbnail.ID, "width", width, "height", height)
+
+	return thumbnail, nil
+}
+
+// validateUpload checks an upload's size and content type and returns the
+// normalized MIME type
+func (m *DefaultAssetManager) validateUpload(req *AssetUploadRequest) (string, error) {
+	if size := int64(len(req.Data)); size > m.config.MaxUploadSize {
+		return "", fmt.Errorf("%w: %d bytes exceeds the %d byte limit", ErrAssetTooLarge, size, m.config.MaxUploadSize)
+	}
+
+	mimeType := normalizeMimeType(req.MimeType)
+	if !slices.Contains(m.config.AllowedMimeTypes, mimeType) {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedAssetType, mimeType)
+	}
+	if sniffedMimeTypes[mimeType] {
+		if detected := normalizeMimeType(http.DetectContentType(req.Data)); detected != mimeType {
+			return "", fmt.Errorf("%w: content is %s, not %s", ErrUnsupportedAssetType, detected, mimeType)
+		}
+	}
+	return mimeType, nil
+}
+
+// thumbnailFormat picks the encoding for a thumbnail: the requested format,
+// otherwise JPEG for JPEG sources and PNG for everything else
+func (m *DefaultAssetManager) thumbnailFormat(sourceMimeType, requested string) (ImageFormat, error) {
+	switch format := ImageFormat(strings.ToLower(requested)); format {
+	case "":
+		if sourceMimeType == ImageFormatJPEG.MimeType() {
+			return ImageFormatJPEG, nil
+		}
+		return ImageFormatPNG, nil
+	case "jpg", ImageFormatJPEG:
+		return ImageFormatJPEG, nil
+	case ImageFormatPNG:
+		return ImageFormatPNG, nil
+	default:
+		if m.encoder == nil || !m.encoder.Supports(format) {
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedImageFormat, requested)
+		}
+		return format, nil
+	}
+}
+
+// encodeImage encodes img as JPEG or PNG with the standard library, or as a
+// modern format through the configured ImageEncoder
+func (m *DefaultAssetManager) encodeImage(ctx context.Context, img image.Image, format ImageFormat) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case ImageFormatJPEG:
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: m.config.VariantQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		return buf.Bytes(), nil
+	case ImageFormatPNG:
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	encoded, err := m.encoder.Encode(ctx, buf.Bytes(), ImageFormatPNG.MimeType(), format, m.config.VariantQuality)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return encoded, nil
+}
+
+// generateVariants encodes the configured modern formats for a raster image
+// and links them to the original asset. A configured format that cannot be
+// encoded fails the call rather than leaving the asset without it.
+func (m *DefaultAssetManager) generateVariants(ctx context.Context, asset *Asset, data []byte) error {
+	if !m.canTranscode(asset.MimeType) {
+		m.logger.DebugContext(ctx, "Skipping variant generation", "asset_id", asset.ID, "mime_type", asset.MimeType)
+		return nil
+	}
+	if _, _, err := image.DecodeConfig(bytes.NewReader(data)); err != nil {
+		m.logger.WarnContext(ctx, "Skipping variant generation for undecodable image", "asset_id", asset.ID, "error", err)
+		return nil
+	}
+	if err := m.checkVariantFormats(); err != nil {
+		return err
+	}
+
+	for _, format := range m.config.VariantFormats {
+		if asset.MimeType == format.MimeType() {
+			continue
+		}
+
+		encoded, err := m.encoder.Encode(ctx, data, asset.MimeType, format, m.config.VariantQuality)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s variant: %w", format, err)
+		}
+
+		variant, err := m.newVariantAsset(ctx, asset, format, encoded)
+		if err != nil {
+			return err
+		}
+		asset.Variants = append(asset.Variants, AssetVariant{
+			AssetID:  variant.ID,
+			Format:   format,
+			MimeType: variant.MimeType,
+			URL:      variant.URL,
+			Size:     variant.Size,
+		})
+	}
+
+	if len(asset.Variants) == 0 {
+		return nil
+	}
+
+	asset.UpdatedAt = time.Now()
+	if err := m.store.Update(ctx, asset); err != nil {
+		return fmt.Errorf("failed to link asset variants: %w", err)
+	}
+
+	return nil
+}
+
+// checkVariantFormats reports a configured variant format the encoder cannot
+// produce
+func (m *DefaultAssetManager) checkVariantFormats() error {
+	for _, format := range m.config.VariantFormats {
+		if !m.encoder.Supports(format) {
+			return fmt.Errorf("%w: no encoder for %s variants", ErrUnsupportedImageFormat, format)
+		}
+	}
+	return nil
+}
+
+// storeVariant stores a single encoded variant and links it to the original asset
+func (m *DefaultAssetManager) storeVariant(ctx context.Context, asset *Asset, format ImageFormat, data []byte) (*Asset, error) {
+	variant, err := m.newVariantAsset(ctx, asset, format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := asset.Variants[:0]
+	for _, existing := range asset.Variants {
+		if existing.Format == format {
+			if err := m.store.Delete(ctx, existing.AssetID); err != nil {
+				m.logger.WarnContext(ctx, "Failed to delete asset variant", "asset_id", existing.AssetID, "error", err)
+			}
+			continue
+		}
+		kept = append(kept, existing)
+	}
+	asset.Variants = append(kept, AssetVariant{
+		AssetID:  variant.ID,
+		Format:   format,
+		MimeType: variant.MimeType,
+		URL:      variant.URL,
+		Size:     variant.Size,
+	})
+
+	asset.UpdatedAt = time.Now()
+	if err := m.store.Update(ctx, asset); err != nil {
+		return nil, fmt.Errorf("failed to link asset variant: %w", err)
+	}
+
+	return variant, nil
+}
+
+// newVariantAsset persists an encoded variant as an asset related to its original
+func (m *DefaultAssetManager) newVariantAsset(ctx context.Context, original *Asset, format ImageFormat, data []byte) (*Asset, error) {
+	filename := strings.TrimSuffix(original.Filename, path.Ext(original.Filename)) + format.Extension()
+	return m.newDerivedAsset(ctx, original, filename, format, data)
+}
+
+// newDerivedAsset persists image data generated from original as a related asset
+func (m *DefaultAssetManager) newDerivedAsset(ctx context.Context, original *Asset, filename string, format ImageFormat, data []byte) (*Asset, error) {
+	now := time.Now()
+	parentID := original.ID
+	variant := &Asset{
+		ID:            uuid.New(),
+		WorkspaceID:   original.WorkspaceID,
+		ParentAssetID: &parentID,
+		Name:          original.Name,
+		Filename:      filename,
+		MimeType:      format.MimeType(),
+		Size:          int64(len(data)),
+		Type:          AssetTypeImage,
+		Alt:           original.Alt,
+		Title:         original.Title,
+		Tags:          original.Tags,
+		Hash:          contentHash(data),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		UploadedBy:    original.UploadedBy,
+	}
+	variant.URL = m.assetURL(variant.WorkspaceID, variant.ID, format.Extension())
+
+	if err := m.store.Save(ctx, variant, data); err != nil {
+		return nil, fmt.Errorf("failed to save %s variant: %w", format, err)
+	}
+
+	return variant, nil
+}
+
+// canTranscode reports whether variants can be generated for the MIME type
+func (m *DefaultAssetManager) canTranscode(mimeType string) bool {
+	return m.encoder != nil && variantSourceMimeTypes[strings.ToLower(mimeType)]
+}
+
+// assetURL builds the public URL for an asset
+func (m *DefaultAssetManager) assetURL(workspaceID, assetID uuid.UUID, ext string) string {
+	return fmt.Sprintf("%s/%s/%s%s", strings.TrimSuffix(m.config.BaseURL, "/"), workspaceID, assetID, ext)
+}
+
+// assetTypeForMimeType maps a MIME type onto an asset type
+func assetTypeForMimeType(mimeType string) AssetType {
+	mimeType = strings.ToLower(mimeType)
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return AssetTypeImage
+	case strings.HasPrefix(mimeType, "video/"):
+		return AssetTypeVideo
+	case strings.HasPrefix(mimeType, "font/"):
+		return AssetTypeFont
+	case mimeType == "text/css":
+		return AssetTypeCSS
+	case mimeType == "application/javascript", mimeType == "text/javascript":
+		return AssetTypeJS
+	case mimeType == "application/pdf", strings.HasPrefix(mimeType, "text/"):
+		return AssetTypeDocument
+	default:
+		return AssetTypeOther
+	}
+}
+
+// downscaleImage shrinks JPEG, PNG or GIF data to fit within maxWidth x
+// maxHeight, returning PNG data. Data that already fits is returned as is.
+func downscaleImage(data []byte, maxWidth, maxHeight int) ([]byte, string, error) {
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	bounds := src.Bounds()
+	width, height := fitDimensions(bounds.Dx(), bounds.Dy(), maxWidth, maxHeight)
+	if width == bounds.Dx() && height == bounds.Dy() {
+		return data, "image/" + format, nil
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, resizeImage(src, bounds, width, height)); err != nil {
+		return nil, "", fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), ImageFormatPNG.MimeType(), nil
+}
+
+// withImageDimensions records pixel dimensions in asset metadata
+func withImageDimensions(metadata map[string]interface{}, width, height int) map[string]interface{} {
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["width"] = width
+	metadata["height"] = height
+	return metadata
+}
+
+// normalizeMimeType lower-cases a MIME type and strips its parameters
+func normalizeMimeType(mimeType string) string {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if mimeType == "image/jpg" {
+		return "image/jpeg"
+	}
+	return mimeType
+}
+
+// contentHash returns the hex-encoded SHA-256 of the data
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,431 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+type memoryAssetStore struct {
+	assets map[uuid.UUID]*Asset
+	data   map[uuid.UUID][]byte
+}
+
+func newMemoryAssetStore() *memoryAssetStore {
+	return &memoryAssetStore{
+		assets: make(map[uuid.UUID]*Asset),
+		data:   make(map[uuid.UUID][]byte),
+	}
+}
+
+func (s *memoryAssetStore) Save(_ context.Context, asset *Asset, data []byte) error {
+	s.assets[asset.ID] = asset
+	s.data[asset.ID] = data
+	return nil
+}
+
+func (s *memoryAssetStore) Update(_ context.Context, asset *Asset) error {
+	s.assets[asset.ID] = asset
+	return nil
+}
+
+func (s *memoryAssetStore) Get(_ context.Context, id uuid.UUID) (*Asset, error) {
+	asset, ok := s.assets[id]
+	if !ok {
+		return nil, fmt.Errorf("asset %s not found", id)
+	}
+	return asset, nil
+}
+
+func (s *memoryAssetStore) GetData(_ context.Context, id uuid.UUID) ([]byte, error) {
+	data, ok := s.data[id]
+	if !ok {
+		return nil, fmt.Errorf("asset %s not found", id)
+	}
+	return data, nil
+}
+
+func (s *memoryAssetStore) Delete(_ context.Context, id uuid.UUID) error {
+	delete(s.assets, id)
+	delete(s.data, id)
+	return nil
+}
+
+func (s *memoryAssetStore) List(_ context.Context, workspaceID uuid.UUID, _ AssetFilters) ([]*Asset, error) {
+	var assets []*Asset
+	for _, asset := range s.assets {
+		if asset.WorkspaceID == workspaceID {
+			assets = append(assets, asset)
+		}
+	}
+	return assets, nil
+}
+
+type fakeImageEncoder struct {
+	calls []ImageFormat
+}
+
+func (e *fakeImageEncoder) Encode(_ context.Context, data []byte, _ string, format ImageFormat, _ int) ([]byte, error) {
+	e.calls = append(e.calls, format)
+	return append([]byte(string(format)+":"), data[:8]...), nil
+}
+
+func (e *fakeImageEncoder) Supports(format ImageFormat) bool {
+	return format == ImageFormatWebP || format == ImageFormatAVIF
+}
+
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+func newTestAssetManager(store AssetStore, encoder ImageEncoder) *DefaultAssetManager {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewDefaultAssetManager(store, encoder, DefaultAssetManagerConfig(), logger)
+}
+
+func TestAssetManager_UploadAsset_GeneratesModernVariantsForJPEG(t *testing.T) {
+	store := newMemoryAssetStore()
+	encoder := &fakeImageEncoder{}
+	manager := newTestAssetManager(store, encoder)
+
+	asset, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "hero",
+		Filename:    "hero.jpg",
+		MimeType:    "image/jpeg",
+		Data:        testJPEG(t),
+		UploadedBy:  uuid.New(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, AssetTypeImage, asset.Type)
+	assert.Equal(t, []ImageFormat{ImageFormatWebP}, encoder.calls)
+	require.Len(t, asset.Variants, 1)
+	assert.Len(t, store.assets, 2)
+
+	for _, variant := range asset.Variants {
+		stored, err := store.Get(context.Background(), variant.AssetID)
+		require.NoError(t, err)
+		require.NotNil(t, stored.ParentAssetID)
+		assert.Equal(t, asset.ID, *stored.ParentAssetID)
+		assert.Equal(t, variant.Format.MimeType(), stored.MimeType)
+		assert.Equal(t, "hero"+variant.Format.Extension(), stored.Filename)
+	}
+}
+
+func TestAssetManager_UploadAsset_SkipsSVG(t *testing.T) {
+	store := newMemoryAssetStore()
+	encoder := &fakeImageEncoder{}
+	manager := newTestAssetManager(store, encoder)
+
+	asset, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "logo",
+		Filename:    "logo.svg",
+		MimeType:    "image/svg+xml",
+		Data:        []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`),
+		UploadedBy:  uuid.New(),
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, asset.Variants)
+	assert.Empty(t, encoder.calls)
+	assert.Len(t, store.assets, 1)
+}
+
+func TestAssetManager_DeleteAsset_RemovesVariants(t *testing.T) {
+	store := newMemoryAssetStore()
+	manager := newTestAssetManager(store, &fakeImageEncoder{})
+
+	asset, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Name:        "hero",
+		Filename:    "hero.jpg",
+		MimeType:    "image/jpeg",
+		Data:        testJPEG(t),
+		UploadedBy:  uuid.New(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, manager.DeleteAsset(context.Background(), asset.ID))
+	assert.Empty(t, store.assets)
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// Left half red, right half blue
+			c := color.NRGBA{B: 255, A: 255}
+			if x < width/2 {
+				c = color.NRGBA{R: 255, A: 255}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func uploadTestAsset(t *testing.T, manager *DefaultAssetManager, filename, mimeType string, data []byte) *Asset {
+	t.Helper()
+	asset, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Name:        filename,
+		Filename:    filename,
+		MimeType:    mimeType,
+		Data:        data,
+		UploadedBy:  uuid.New(),
+	})
+	require.NoError(t, err)
+	return asset
+}
+
+func TestAssetManager_UploadAsset_RejectsOversizedUpload(t *testing.T) {
+	store := newMemoryAssetStore()
+	config := DefaultAssetManagerConfig()
+	config.MaxUploadSize = 1024
+	manager := NewDefaultAssetManager(store, nil, config, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	_, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Filename:    "notes.txt",
+		MimeType:    "text/plain",
+		Data:        bytes.Repeat([]byte("a"), 1025),
+	})
+	assert.ErrorIs(t, err, ErrAssetTooLarge)
+	assert.Empty(t, store.assets)
+}
+
+func TestAssetManager_UploadAsset_ValidatesContentType(t *testing.T) {
+	store := newMemoryAssetStore()
+	manager := newTestAssetManager(store, nil)
+
+	_, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Filename:    "setup.exe",
+		MimeType:    "application/x-msdownload",
+		Data:        []byte("MZ\x90\x00"),
+	})
+	assert.ErrorIs(t, err, ErrUnsupportedAssetType)
+
+	// Declared type must match the uploaded bytes
+	_, err = manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Filename:    "photo.png",
+		MimeType:    "image/png",
+		Data:        []byte("<html><script>alert(1)</script></html>"),
+	})
+	assert.ErrorIs(t, err, ErrUnsupportedAssetType)
+	assert.Empty(t, store.assets)
+
+	asset := uploadTestAsset(t, manager, "photo.jpg", "image/JPG", testJPEG(t))
+	assert.Equal(t, "image/jpeg", asset.MimeType)
+	assert.Equal(t, map[string]interface{}{"width": 4, "height": 4}, asset.Metadata)
+}
+
+func TestAssetManager_GenerateThumbnail_RejectsNonImage(t *testing.T) {
+	store := newMemoryAssetStore()
+	manager := newTestAssetManager(store, &fakeImageEncoder{})
+
+	doc := uploadTestAsset(t, manager, "guide.pdf", "application/pdf", []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n"))
+	_, err := manager.GenerateThumbnail(context.Background(), doc.ID, ThumbnailSize{Width: 64, Height: 64})
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+
+	svg := uploadTestAsset(t, manager, "logo.svg", "image/svg+xml", []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+	_, err = manager.GenerateThumbnail(context.Background(), svg.ID, ThumbnailSize{Width: 64})
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+
+	_, err = manager.GenerateThumbnail(context.Background(), svg.ID, ThumbnailSize{})
+	assert.ErrorIs(t, err, ErrInvalidThumbnailSize)
+}
+
+func TestAssetManager_GenerateThumbnail_ResizesImage(t *testing.T) {
+	store := newMemoryAssetStore()
+	manager := newTestAssetManager(store, &fakeImageEncoder{})
+	original := uploadTestAsset(t, manager, "banner.png", "image/png", testPNG(t, 400, 200))
+
+	thumbnail, err := manager.GenerateThumbnail(context.Background(), original.ID, ThumbnailSize{Width: 100, Height: 100})
+	require.NoError(t, err)
+
+	assert.Equal(t, "banner-100x50.png", thumbnail.Filename)
+	assert.Equal(t, "image/png", thumbnail.MimeType)
+	require.NotNil(t, thumbnail.ParentAssetID)
+	assert.Equal(t, original.ID, *thumbnail.ParentAssetID)
+	assert.Equal(t, map[string]interface{}{"thumbnail": true, "width": 100, "height": 50}, thumbnail.Metadata)
+	assert.Equal(t, thumbnail.URL, store.assets[original.ID].ThumbnailURL)
+
+	data, err := store.GetData(context.Background(), thumbnail.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), thumbnail.Size)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), img.Bounds())
+	assert.Equal(t, color.NRGBA{R: 255, A: 255}, color.NRGBAModel.Convert(img.At(10, 25)))
+	assert.Equal(t, color.NRGBA{B: 255, A: 255}, color.NRGBAModel.Convert(img.At(90, 25)))
+
+	// Cropping fills the box from the centre of the image
+	cropped, err := manager.GenerateThumbnail(context.Background(), original.ID, ThumbnailSize{Width: 50, Height: 50, Crop: true, Format: "jpg"})
+	require.NoError(t, err)
+	assert.Equal(t, "banner-50x50.jpg", cropped.Filename)
+	data, err = store.GetData(context.Background(), cropped.ID)
+	require.NoError(t, err)
+	config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 50, config.Width)
+	assert.Equal(t, 50, config.Height)
+}
+
+func TestAssetManager_OptimizeImage_DownscalesBeforeEncoding(t *testing.T) {
+	store := newMemoryAssetStore()
+	encoder := &recordingImageEncoder{}
+	manager := newTestAssetManager(store, encoder)
+	original := uploadTestAsset(t, manager, "hero.png", "image/png", testPNG(t, 400, 200))
+	encoder.inputs = nil
+
+	variant, err := manager.OptimizeImage(context.Background(), original.ID, ImageOptimizationOptions{Format: "webp", MaxWidth: 200})
+	require.NoError(t, err)
+	assert.Equal(t, "image/webp", variant.MimeType)
+
+	require.Len(t, encoder.inputs, 1)
+	config, err := png.DecodeConfig(bytes.NewReader(encoder.inputs[0]))
+	require.NoError(t, err)
+	assert.Equal(t, 200, config.Width)
+	assert.Equal(t, 100, config.Height)
+}
+
+// recordingImageEncoder records the source data it is asked to encode
+type recordingImageEncoder struct {
+	inputs [][]byte
+}
+
+func (e *recordingImageEncoder) Encode(_ context.Context, data []byte, _ string, format ImageFormat, _ int) ([]byte, error) {
+	e.inputs = append(e.inputs, data)
+	return []byte(format), nil
+}
+
+func (e *recordingImageEncoder) Supports(format ImageFormat) bool {
+	return format == ImageFormatWebP
+}
+
+func TestStdImageEncoder_Encode(t *testing.T) {
+	encoder := NewStdImageEncoder()
+	assert.True(t, encoder.Supports(ImageFormatJPEG))
+	assert.True(t, encoder.Supports(ImageFormatPNG))
+	assert.True(t, encoder.Supports(ImageFormatWebP))
+	assert.False(t, encoder.Supports(ImageFormatAVIF))
+
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	encoded, err := encoder.Encode(context.Background(), buf.Bytes(), "image/png", ImageFormatJPEG, 90)
+	require.NoError(t, err)
+	decoded, err := jpeg.Decode(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 4, 4), decoded.Bounds())
+	r, g, b, _ := decoded.At(3, 3).RGBA()
+	assert.Greater(t, r>>8, uint32(240), "transparent pixels are flattened onto white")
+	assert.Greater(t, g>>8, uint32(240))
+	assert.Greater(t, b>>8, uint32(240))
+
+	encoded, err = encoder.Encode(context.Background(), testJPEG(t), "image/jpeg", ImageFormatPNG, 0)
+	require.NoError(t, err)
+	_, err = png.Decode(bytes.NewReader(encoded))
+	require.NoError(t, err)
+
+	_, err = encoder.Encode(context.Background(), []byte("not an image"), "image/png", ImageFormatJPEG, 80)
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+	_, err = encoder.Encode(context.Background(), testJPEG(t), "image/jpeg", ImageFormatAVIF, 80)
+	assert.ErrorIs(t, err, ErrUnsupportedImageFormat)
+}
+
+func TestAssetManager_UploadAsset_StdImageEncoderVariants(t *testing.T) {
+	store := newMemoryAssetStore()
+	config := DefaultAssetManagerConfig()
+	config.VariantFormats = []ImageFormat{ImageFormatPNG, ImageFormatWebP}
+	manager := NewDefaultAssetManager(store, NewStdImageEncoder(), config, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	asset := uploadTestAsset(t, manager, "banner.png", "image/png", testPNG(t, 40, 20))
+	require.Len(t, asset.Variants, 1, "PNG sources get no PNG variant")
+	assert.Equal(t, ImageFormatWebP, asset.Variants[0].Format)
+
+	data, err := store.GetData(context.Background(), asset.Variants[0].AssetID)
+	require.NoError(t, err)
+	img, err := webp.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 40, 20), img.Bounds())
+	assert.Equal(t, color.NRGBA{R: 255, A: 255}, color.NRGBAModel.Convert(img.At(5, 10)))
+	assert.Equal(t, color.NRGBA{B: 255, A: 255}, color.NRGBAModel.Convert(img.At(35, 10)))
+}
+
+func TestAssetManager_UploadAsset_FailsOnUnencodableVariantFormat(t *testing.T) {
+	store := newMemoryAssetStore()
+	config := DefaultAssetManagerConfig()
+	config.VariantFormats = []ImageFormat{ImageFormatWebP, ImageFormatAVIF}
+	manager := NewDefaultAssetManager(store, NewStdImageEncoder(), config, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	_, err := manager.UploadAsset(context.Background(), &AssetUploadRequest{
+		WorkspaceID: uuid.New(),
+		Filename:    "hero.jpg",
+		MimeType:    "image/jpeg",
+		Data:        testJPEG(t),
+	})
+	assert.ErrorIs(t, err, ErrUnsupportedImageFormat)
+	assert.Empty(t, store.assets)
+}
+
+func TestAssetManager_OptimizeImage_StdImageEncoder(t *testing.T) {
+	store := newMemoryAssetStore()
+	manager := newTestAssetManager(store, NewStdImageEncoder())
+	original := uploadTestAsset(t, manager, "hero.png", "image/png", testPNG(t, 400, 200))
+	require.Len(t, original.Variants, 1)
+	stale := original.Variants[0].AssetID
+
+	variant, err := manager.OptimizeImage(context.Background(), original.ID, ImageOptimizationOptions{Format: "webp", MaxWidth: 200})
+	require.NoError(t, err)
+	assert.Equal(t, "image/webp", variant.MimeType)
+	assert.NotContains(t, store.assets, stale, "the previous WebP variant is replaced")
+
+	data, err := store.GetData(context.Background(), variant.ID)
+	require.NoError(t, err)
+	img, err := webp.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 200, 100), img.Bounds())
+	assert.Equal(t, color.NRGBA{R: 255, A: 255}, color.NRGBAModel.Convert(img.At(10, 50)))
+	assert.Equal(t, color.NRGBA{B: 255, A: 255}, color.NRGBAModel.Convert(img.At(190, 50)))
+
+	// Without a format the configured variants are regenerated at full size
+	optimized, err := manager.OptimizeImage(context.Background(), original.ID, ImageOptimizationOptions{})
+	require.NoError(t, err)
+	require.Len(t, optimized.Variants, 1)
+	data, err = store.GetData(context.Background(), optimized.Variants[0].AssetID)
+	require.NoError(t, err)
+	config, err := webp.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 400, config.Width)
+	assert.Equal(t, 200, config.Height)
+
+	_, err = manager.OptimizeImage(context.Background(), original.ID, ImageOptimizationOptions{Format: "avif"})
+	assert.ErrorIs(t, err, ErrUnsupportedImageFormat)
+}
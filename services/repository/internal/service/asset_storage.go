@@ -0,0 +1,434 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// ErrBlobNotFound is returned by BlobStore implementations for missing keys
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores opaque objects by key
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Asset storage backends
+const (
+	AssetStorageLocal = "local"
+	AssetStorageS3    = "s3"
+)
+
+// AssetStorageConfig selects and configures the backend that holds asset data
+type AssetStorageConfig struct {
+	Backend   string `json:"backend"`    // local or s3
+	LocalPath string `json:"local_path"` // root directory for the local backend
+
+	// S3-compatible backend (AWS S3, MinIO, R2, ...)
+	Endpoint  string `json:"endpoint"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+	Bucket    string `json:"bucket"`
+	UseSSL    bool   `json:"use_ssl"`
+}
+
+// NewBlobStore creates the blob store selected by config
+func NewBlobStore(config AssetStorageConfig) (BlobStore, error) {
+	switch config.Backend {
+	case "", AssetStorageLocal:
+		return NewLocalBlobStore(config.LocalPath)
+	case AssetStorageS3:
+		return NewS3BlobStore(config.Endpoint, config.AccessKey, config.SecretKey, config.Bucket, config.UseSSL)
+	default:
+		return nil, fmt.Errorf("unknown asset storage backend: %s", config.Backend)
+	}
+}
+
+// LocalBlobStore stores blobs as files below a root directory
+type LocalBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore creates a blob store rooted at dir, creating it if needed
+func NewLocalBlobStore(dir string) (*LocalBlobStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("local asset storage path is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create asset storage directory: %w", err)
+	}
+	return &LocalBlobStore{root: dir}, nil
+}
+
+// Put writes a blob atomically by renaming a temporary file into place
+func (s *LocalBlobStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get reads a blob
+func (s *LocalBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	return data, err
+}
+
+// Delete removes a blob; deleting a missing blob is not an error
+func (s *LocalBlobStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List returns the keys that start with prefix, in lexical order
+func (s *LocalBlobStore) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blobs: %w", err)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// path maps a key onto a file below the root, rejecting keys that escape it
+func (s *LocalBlobStore) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// S3BlobStore stores blobs in an S3-compatible bucket
+type S3BlobStore struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3BlobStore creates a blob store for an S3-compatible endpoint
+func NewS3BlobStore(endpoint, accessKey, secretKey, bucket string, useSSL bool) (*S3BlobStore, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("asset storage endpoint is required")
+	}
+	if bucket == "" {
+		return nil, fmt.Errorf("asset storage bucket is required")
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	return &S3BlobStore{
+		client: client,
+		bucket: bucket,
+	}, nil
+}
+
+// Put uploads a blob
+func (s *S3BlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload blob: %w", err)
+	}
+	return nil
+}
+
+// Get downloads a blob
+func (s *S3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download blob: %w", err)
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(object)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("failed to download blob: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes a blob
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+// List returns the keys that start with prefix
+func (s *S3BlobStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("failed to list blobs: %w", object.Err)
+		}
+		keys = append(keys, object.Key)
+	}
+	return keys, nil
+}
+
+// BlobAssetStore implements AssetStore on a BlobStore. Each asset is kept
+// under its workspace's prefix as two objects, its content at
+// assets/<workspace>/<id>/data and its record as JSON at
+// assets/<workspace>/<id>/asset.json, so listing a workspace only touches
+// that workspace's objects. A third object, asset-workspaces/<id>, holds the
+// workspace ID so assets can still be found by ID alone.
+type BlobAssetStore struct {
+	blobs BlobStore
+}
+
+// NewBlobAssetStore creates an asset store backed by blobs
+func NewBlobAssetStore(blobs BlobStore) *BlobAssetStore {
+	return &BlobAssetStore{blobs: blobs}
+}
+
+const (
+	assetBlobPrefix      = "assets/"
+	assetWorkspacePrefix = "asset-workspaces/"
+	assetRecordName      = "asset.json"
+	assetDataName        = "data"
+)
+
+func assetWorkspaceBlobPrefix(workspaceID uuid.UUID) string {
+	return assetBlobPrefix + workspaceID.String() + "/"
+}
+
+func assetBlobKey(workspaceID, id uuid.UUID, name string) string {
+	return assetWorkspaceBlobPrefix(workspaceID) + id.String() + "/" + name
+}
+
+func assetWorkspaceKey(id uuid.UUID) string {
+	return assetWorkspacePrefix + id.String()
+}
+
+// Save stores an asset's content and record. The workspace pointer is
+// written first so an asset is never stored without a way to find it.
+func (s *BlobAssetStore) Save(ctx context.Context, asset *Asset, data []byte) error {
+	if err := s.blobs.Put(ctx, assetWorkspaceKey(asset.ID), []byte(asset.WorkspaceID.String()), "text/plain"); err != nil {
+		return err
+	}
+	if err := s.blobs.Put(ctx, assetBlobKey(asset.WorkspaceID, asset.ID, assetDataName), data, asset.MimeType); err != nil {
+		return err
+	}
+	return s.Update(ctx, asset)
+}
+
+// Update rewrites an asset's record
+func (s *BlobAssetStore) Update(ctx context.Context, asset *Asset) error {
+	record, err := json.Marshal(asset)
+	if err != nil {
+		return fmt.Errorf("failed to marshal asset: %w", err)
+	}
+	return s.blobs.Put(ctx, assetBlobKey(asset.WorkspaceID, asset.ID, assetRecordName), record, "application/json")
+}
+
+// Get loads an asset's record
+func (s *BlobAssetStore) Get(ctx context.Context, id uuid.UUID) (*Asset, error) {
+	workspaceID, err := s.workspaceOf(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.getRecord(ctx, assetBlobKey(workspaceID, id, assetRecordName))
+}
+
+// GetData loads an asset's content
+func (s *BlobAssetStore) GetData(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	workspaceID, err := s.workspaceOf(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.blobs.Get(ctx, assetBlobKey(workspaceID, id, assetDataName))
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil, fmt.Errorf("asset %s not found", id)
+	}
+	return data, err
+}
+
+// Delete removes an asset's content and record, then its workspace pointer
+func (s *BlobAssetStore) Delete(ctx context.Context, id uuid.UUID) error {
+	workspaceID, err := s.workspaceOf(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := s.blobs.Delete(ctx, assetBlobKey(workspaceID, id, assetDataName)); err != nil {
+		return err
+	}
+	if err := s.blobs.Delete(ctx, assetBlobKey(workspaceID, id, assetRecordName)); err != nil {
+		return err
+	}
+	return s.blobs.Delete(ctx, assetWorkspaceKey(id))
+}
+
+// List returns a workspace's assets matching filters, newest first. Only
+// the workspace's own prefix is listed and read.
+func (s *BlobAssetStore) List(ctx context.Context, workspaceID uuid.UUID, filters AssetFilters) ([]*Asset, error) {
+	keys, err := s.blobs.List(ctx, assetWorkspaceBlobPrefix(workspaceID))
+	if err != nil {
+		return nil, err
+	}
+
+	var assets []*Asset
+	for _, key := range keys {
+		if !strings.HasSuffix(key, "/"+assetRecordName) {
+			continue
+		}
+		asset, err := s.getRecord(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if assetMatchesFilters(asset, filters) {
+			assets = append(assets, asset)
+		}
+	}
+
+	sort.Slice(assets, func(i, j int) bool { return assets[i].CreatedAt.After(assets[j].CreatedAt) })
+	if filters.Offset > 0 {
+		if filters.Offset >= len(assets) {
+			return nil, nil
+		}
+		assets = assets[filters.Offset:]
+	}
+	if filters.Limit > 0 && len(assets) > filters.Limit {
+		assets = assets[:filters.Limit]
+	}
+	return assets, nil
+}
+
+// workspaceOf reads the workspace an asset was saved under. It wraps
+// ErrBlobNotFound when the asset does not exist.
+func (s *BlobAssetStore) workspaceOf(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	pointer, err := s.blobs.Get(ctx, assetWorkspaceKey(id))
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return uuid.Nil, fmt.Errorf("asset %s not found: %w", id, err)
+		}
+		return uuid.Nil, err
+	}
+	workspaceID, err := uuid.ParseBytes(pointer)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid workspace for asset %s: %w", id, err)
+	}
+	return workspaceID, nil
+}
+
+// getRecord reads and decodes the asset record stored at key
+func (s *BlobAssetStore) getRecord(ctx context.Context, key string) (*Asset, error) {
+	record, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return nil, fmt.Errorf("asset record %s not found", key)
+		}
+		return nil, err
+	}
+	var asset Asset
+	if err := json.Unmarshal(record, &asset); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal asset record %s: %w", key, err)
+	}
+	return &asset, nil
+}
+
+// assetMatchesFilters applies AssetFilters to a single asset
+func assetMatchesFilters(asset *Asset, filters AssetFilters) bool {
+	if len(filters.Type) > 0 && !slices.Contains(filters.Type, asset.Type) {
+		return false
+	}
+	if len(filters.MimeTypes) > 0 && !slices.Contains(filters.MimeTypes, asset.MimeType) {
+		return false
+	}
+	if filters.MinSize != nil && asset.Size < *filters.MinSize {
+		return false
+	}
+	if filters.MaxSize != nil && asset.Size > *filters.MaxSize {
+		return false
+	}
+	if filters.UploadedBy != nil && asset.UploadedBy != *filters.UploadedBy {
+		return false
+	}
+	if filters.UploadedAfter != nil && !asset.CreatedAt.After(*filters.UploadedAfter) {
+		return false
+	}
+	if filters.UploadedBefore != nil && !asset.CreatedAt.Before(*filters.UploadedBefore) {
+		return false
+	}
+	for _, tag := range filters.Tags {
+		if !slices.Contains(asset.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
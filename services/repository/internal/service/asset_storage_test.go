@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "assets/a/data", []byte("hello"), "text/plain"))
+	require.NoError(t, store.Put(ctx, "assets/b/data", []byte("world"), "text/plain"))
+	require.NoError(t, store.Put(ctx, "other/c", []byte("!"), "text/plain"))
+
+	data, err := store.Get(ctx, "assets/a/data")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), data)
+
+	keys, err := store.List(ctx, "assets/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"assets/a/data", "assets/b/data"}, keys)
+
+	require.NoError(t, store.Delete(ctx, "assets/a/data"))
+	require.NoError(t, store.Delete(ctx, "assets/a/data"))
+	_, err = store.Get(ctx, "assets/a/data")
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+
+	assert.Error(t, store.Put(ctx, "../escape", []byte("x"), "text/plain"))
+	_, err = store.Get(ctx, "/etc/passwd")
+	assert.Error(t, err)
+}
+
+func TestNewBlobStore_RejectsUnknownBackend(t *testing.T) {
+	_, err := NewBlobStore(AssetStorageConfig{Backend: "ftp"})
+	assert.Error(t, err)
+
+	_, err = NewBlobStore(AssetStorageConfig{Backend: AssetStorageS3, Endpoint: "localhost:9000"})
+	assert.Error(t, err, "bucket is required")
+}
+
+func TestBlobAssetStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	store := NewBlobAssetStore(blobs)
+
+	workspaceID := uuid.New()
+	older := &Asset{ID: uuid.New(), WorkspaceID: workspaceID, Name: "logo", MimeType: "image/png", Type: AssetTypeImage, Size: 3, CreatedAt: time.Now().Add(-time.Hour)}
+	newer := &Asset{ID: uuid.New(), WorkspaceID: workspaceID, Name: "guide", MimeType: "application/pdf", Type: AssetTypeDocument, Size: 4, CreatedAt: time.Now()}
+	elsewhere := &Asset{ID: uuid.New(), WorkspaceID: uuid.New(), Name: "other", Type: AssetTypeImage, CreatedAt: time.Now()}
+	require.NoError(t, store.Save(ctx, older, []byte("png")))
+	require.NoError(t, store.Save(ctx, newer, []byte("%PDF")))
+	require.NoError(t, store.Save(ctx, elsewhere, []byte("x")))
+
+	got, err := store.Get(ctx, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "logo", got.Name)
+	data, err := store.GetData(ctx, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png"), data)
+
+	assets, err := store.List(ctx, workspaceID, AssetFilters{})
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+	assert.Equal(t, newer.ID, assets[0].ID)
+
+	assets, err = store.List(ctx, workspaceID, AssetFilters{Type: []AssetType{AssetTypeImage}})
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	assert.Equal(t, older.ID, assets[0].ID)
+
+	older.ThumbnailURL = "/assets/thumb.png"
+	require.NoError(t, store.Update(ctx, older))
+	got, err = store.Get(ctx, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "/assets/thumb.png", got.ThumbnailURL)
+
+	require.NoError(t, store.Delete(ctx, older.ID))
+	_, err = store.Get(ctx, older.ID)
+	assert.Error(t, err)
+	_, err = store.GetData(ctx, older.ID)
+	assert.Error(t, err)
+}
+
+// recordingBlobStore records the keys and prefixes it is asked for
+type recordingBlobStore struct {
+	BlobStore
+	gets     []string
+	prefixes []string
+}
+
+func (s *recordingBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.gets = append(s.gets, key)
+	return s.BlobStore.Get(ctx, key)
+}
+
+func (s *recordingBlobStore) List(ctx context.Context, prefix string) ([]string, error) {
+	s.prefixes = append(s.prefixes, prefix)
+	return s.BlobStore.List(ctx, prefix)
+}
+
+func TestBlobAssetStore_ListReadsOnlyTheWorkspace(t *testing.T) {
+	ctx := context.Background()
+	local, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	blobs := &recordingBlobStore{BlobStore: local}
+	store := NewBlobAssetStore(blobs)
+
+	workspaceID, otherID := uuid.New(), uuid.New()
+	mine := &Asset{ID: uuid.New(), WorkspaceID: workspaceID, Name: "logo", Type: AssetTypeImage, CreatedAt: time.Now()}
+	require.NoError(t, store.Save(ctx, mine, []byte("png")))
+	for i := 0; i < 3; i++ {
+		theirs := &Asset{ID: uuid.New(), WorkspaceID: otherID, Name: "other", Type: AssetTypeImage, CreatedAt: time.Now()}
+		require.NoError(t, store.Save(ctx, theirs, []byte("x")))
+	}
+
+	assets, err := store.List(ctx, workspaceID, AssetFilters{})
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	assert.Equal(t, mine.ID, assets[0].ID)
+
+	prefix := "assets/" + workspaceID.String() + "/"
+	assert.Equal(t, []string{prefix}, blobs.prefixes)
+	assert.Equal(t, []string{prefix + mine.ID.String() + "/asset.json"}, blobs.gets,
+		"only the workspace's own records are read")
+
+	assets, err = store.List(ctx, uuid.New(), AssetFilters{})
+	require.NoError(t, err)
+	assert.Empty(t, assets)
+
+	require.NoError(t, store.Delete(ctx, mine.ID))
+	require.NoError(t, store.Delete(ctx, mine.ID), "deleting a missing asset is not an error")
+	keys, err := local.List(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, keys, 9, "only the other workspace's objects remain")
+}
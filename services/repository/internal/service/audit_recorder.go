@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction identifies the kind of mutation recorded in an audit event
+type AuditAction string
+
+const (
+	AuditActionCreated   AuditAction = "created"
+	AuditActionUpdated   AuditAction = "updated"
+	AuditActionPublished AuditAction = "published"
+	AuditActionDeleted   AuditAction = "deleted"
+)
+
+// AuditTargetType identifies the kind of resource an audit event refers to
+type AuditTargetType string
+
+const (
+	AuditTargetSchema        AuditTargetType = "schema"
+	AuditTargetSchemaVersion AuditTargetType = "schema_version"
+	AuditTargetPage          AuditTargetType = "page"
+	AuditTargetPageTemplate  AuditTargetType = "page_template"
+)
+
+// redactedValue replaces the value of sensitive fields in audit diffs
+const redactedValue = "[REDACTED]"
+
+// AuditEvent records a single mutation performed within a workspace
+type AuditEvent struct {
+	ID          uuid.UUID          `json:"id"`
+	WorkspaceID uuid.UUID          `json:"workspace_id"`
+	ActorID     uuid.UUID          `json:"actor_id"`
+	Action      AuditAction        `json:"action"`
+	TargetType  AuditTargetType    `json:"target_type"`
+	TargetID    uuid.UUID          `json:"target_id"`
+	Changes     []AuditFieldChange `json:"changes"`
+	Timestamp   time.Time          `json:"timestamp"`
+}
+
+// AuditFieldChange describes how a single field changed
+type AuditFieldChange struct {
+	Field    string      `json:"field"`
+	OldValue interface{} `json:"old_value"`
+	NewValue interface{} `json:"new_value"`
+}
+
+// AuditRecord contains the data needed to record an audit event. Before and
+// After are snapshots of the target; either may be nil for creations and
+// deletions.
+type AuditRecord struct {
+	WorkspaceID uuid.UUID
+	ActorID     uuid.UUID
+	Action      AuditAction
+	TargetType  AuditTargetType
+	TargetID    uuid.UUID
+	Before      interface{}
+	After       interface{}
+}
+
+// AuditFilters contains filtering options for audit trail queries
+type AuditFilters struct {
+	Actions     []AuditAction     `json:"actions"`
+	ActorIDs    []uuid.UUID       `json:"actor_ids"`
+	TargetTypes []AuditTargetType `json:"target_types"`
+	TargetID    *uuid.UUID        `json:"target_id"`
+	Since       *time.Time        `json:"since"`
+	Until       *time.Time        `json:"until"`
+	Limit       int               `json:"limit"`
+}
+
+// AuditStore persists audit events
+type AuditStore interface {
+	Append(ctx context.Context, event *AuditEvent) error
+	ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]*AuditEvent, error)
+}
+
+// AuditRecorder records and queries the workspace audit trail
+type AuditRecorder interface {
+	Record(ctx context.Context, record AuditRecord) (*AuditEvent, error)
+	GetAuditTrail(ctx context.Context, workspaceID uuid.UUID, filters AuditFilters) ([]*AuditEvent, error)
+}
+
+// DefaultAuditRecorder implements AuditRecorder on top of an AuditStore
+type DefaultAuditRecorder struct {
+	store  AuditStore
+	now    func() time.Time
+	logger *slog.Logger
+}
+
+// NewDefaultAuditRecorder creates a new audit recorder instance
+func NewDefaultAuditRecorder(store AuditStore, logger *slog.Logger) *DefaultAuditRecorder {
+	return &DefaultAuditRecorder{
+		store:  store,
+		now:    time.Now,
+		logger: logger.With("component", "audit_recorder"),
+	}
+}
+
+// Record computes a redacted field-level diff between the before and after
+// snapshots and appends the resulting event to the store
+func (r *DefaultAuditRecorder) Record(ctx context.Context, record AuditRecord) (*AuditEvent, error) {
+	changes, err := diffAuditSnapshots(record.Before, record.After)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff audit snapshots: %w", err)
+	}
+
+	event := &AuditEvent{
+		ID:          uuid.New(),
+		WorkspaceID: record.WorkspaceID,
+		ActorID:     record.ActorID,
+		Action:      record.Action,
+		TargetType:  record.TargetType,
+		TargetID:    record.TargetID,
+		Changes:     changes,
+		Timestamp:   r.now(),
+	}
+
+	if err := r.store.Append(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to store audit event: %w", err)
+	}
+
+	r.logger.DebugContext(ctx, "Audit event recorded",
+		"workspace_id", event.WorkspaceID, "actor_id", event.ActorID, "action", event.Action,
+		"target_type", event.TargetType, "target_id", event.TargetID, "changes", len(event.Changes))
+
+	return event, nil
+}
+
+// GetAuditTrail returns the workspace's audit events matching filters, newest first
+func (r *DefaultAuditRecorder) GetAuditTrail(ctx context.Context, workspaceID uuid.UUID, filters AuditFilters) ([]*AuditEvent, error) {
+	events, err := r.store.ListByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	trail := make([]*AuditEvent, 0, len(events))
+	for _, event := range events {
+		if filters.matches(event) {
+			trail = append(trail, event)
+		}
+	}
+
+	sort.SliceStable(trail, func(i, j int) bool {
+		return trail[i].Timestamp.After(trail[j].Timestamp)
+	})
+
+	if filters.Limit > 0 && len(trail) > filters.Limit {
+		trail = trail[:filters.Limit]
+	}
+
+	return trail, nil
+}
+
+// matches reports whether event satisfies every filter that is set
+func (f AuditFilters) matches(event *AuditEvent) bool {
+	if len(f.Actions) > 0 && !slices.Contains(f.Actions, event.Action) {
+		return false
+	}
+	if len(f.ActorIDs) > 0 && !slices.Contains(f.ActorIDs, event.ActorID) {
+		return false
+	}
+	if len(f.TargetTypes) > 0 && !slices.Contains(f.TargetTypes, event.TargetType) {
+		return false
+	}
+	if f.TargetID != nil && *f.TargetID != event.TargetID {
+		return false
+	}
+	if f.Since != nil && event.Timestamp.Before(*f.Since) {
+		return false
+	}
+	if f.Until != nil && event.Timestamp.After(*f.Until) {
+		return false
+	}
+	return true
+}
+
+// diffAuditSnapshots compares the JSON representation of two snapshots and
+// returns the changed top-level fields sorted by name
+func diffAuditSnapshots(before, after interface{}) ([]AuditFieldChange, error) {
+	oldFields, err := auditSnapshotFields(before)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := auditSnapshotFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(oldFields)+len(newFields))
+	for name := range oldFields {
+		names[name] = true
+	}
+	for name := range newFields {
+		names[name] = true
+	}
+
+	var changes []AuditFieldChange
+	for name := range names {
+		oldValue, newValue := oldFields[name], newFields[name]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		if isSensitiveAuditField(name) {
+			if oldValue != nil {
+				oldValue = redactedValue
+			}
+			if newValue != nil {
+				newValue = redactedValue
+			}
+		}
+		changes = append(changes, AuditFieldChange{Field: name, OldValue: oldValue, NewValue: newValue})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+// auditSnapshotFields flattens a snapshot into its top-level JSON fields
+func auditSnapshotFields(snapshot interface{}) (map[string]interface{}, error) {
+	if snapshot == nil || (reflect.ValueOf(snapshot).Kind() == reflect.Ptr && reflect.ValueOf(snapshot).IsNil()) {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// isSensitiveAuditField reports whether a field's values must not be written
+// to the audit trail
+func isSensitiveAuditField(name string) bool {
+	name = strings.ToLower(name)
+	for _, marker := range []string{"secret", "password", "token", "credential", "api_key", "metadata"} {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
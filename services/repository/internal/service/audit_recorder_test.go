@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryAuditStore struct {
+	events []*AuditEvent
+}
+
+func (s *memoryAuditStore) Append(_ context.Context, event *AuditEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memoryAuditStore) ListByWorkspace(_ context.Context, workspaceID uuid.UUID) ([]*AuditEvent, error) {
+	var events []*AuditEvent
+	for _, event := range s.events {
+		if event.WorkspaceID == workspaceID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func newTestAuditRecorder(store AuditStore) *DefaultAuditRecorder {
+	recorder := NewDefaultAuditRecorder(store, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	// Advance the clock on every event so ordering is deterministic
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	recorder.now = func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	}
+	return recorder
+}
+
+type auditedIntegration struct {
+	Name     string                 `json:"name"`
+	APIToken string                 `json:"api_token"`
+	Metadata map[string]interface{} `json:"metadata"`
+	Enabled  bool                   `json:"enabled"`
+}
+
+func TestAuditRecorder_Record_DiffsAndRedactsFields(t *testing.T) {
+	store := &memoryAuditStore{}
+	recorder := newTestAuditRecorder(store)
+
+	before := &auditedIntegration{Name: "github", APIToken: "ghp_old", Metadata: map[string]interface{}{"owner": "a"}, Enabled: true}
+	after := &auditedIntegration{Name: "github-enterprise", APIToken: "ghp_new", Metadata: map[string]interface{}{"owner": "b"}, Enabled: true}
+
+	event, err := recorder.Record(context.Background(), AuditRecord{
+		WorkspaceID: uuid.New(),
+		ActorID:     uuid.New(),
+		Action:      AuditActionUpdated,
+		TargetType:  AuditTargetPage,
+		TargetID:    uuid.New(),
+		Before:      before,
+		After:       after,
+	})
+	require.NoError(t, err)
+	require.Len(t, store.events, 1)
+
+	assert.Equal(t, []AuditFieldChange{
+		{Field: "api_token", OldValue: redactedValue, NewValue: redactedValue},
+		{Field: "metadata", OldValue: redactedValue, NewValue: redactedValue},
+		{Field: "name", OldValue: "github", NewValue: "github-enterprise"},
+	}, event.Changes)
+}
+
+func TestAuditRecorder_Record_CreationDiffsAgainstNothing(t *testing.T) {
+	recorder := newTestAuditRecorder(&memoryAuditStore{})
+
+	event, err := recorder.Record(context.Background(), AuditRecord{
+		Action: AuditActionCreated,
+		After:  &auditedIntegration{Name: "github", APIToken: "ghp_secret"},
+	})
+	require.NoError(t, err)
+
+	changes := make(map[string]AuditFieldChange)
+	for _, change := range event.Changes {
+		changes[change.Field] = change
+	}
+	assert.Equal(t, AuditFieldChange{Field: "name", NewValue: "github"}, changes["name"])
+	assert.Equal(t, AuditFieldChange{Field: "api_token", NewValue: redactedValue}, changes["api_token"])
+	assert.Equal(t, AuditFieldChange{Field: "enabled", NewValue: false}, changes["enabled"])
+}
+
+func TestAuditRecorder_GetAuditTrail_FiltersByActionAndActor(t *testing.T) {
+	store := &memoryAuditStore{}
+	recorder := newTestAuditRecorder(store)
+	ctx := context.Background()
+
+	workspaceID := uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+	record := func(workspaceID, actor uuid.UUID, action AuditAction) *AuditEvent {
+		event, err := recorder.Record(ctx, AuditRecord{
+			WorkspaceID: workspaceID,
+			ActorID:     actor,
+			Action:      action,
+			TargetType:  AuditTargetSchema,
+			TargetID:    uuid.New(),
+		})
+		require.NoError(t, err)
+		return event
+	}
+
+	aliceCreated := record(workspaceID, alice, AuditActionCreated)
+	bobCreated := record(workspaceID, bob, AuditActionCreated)
+	aliceUpdated := record(workspaceID, alice, AuditActionUpdated)
+	record(uuid.New(), alice, AuditActionUpdated) // another workspace
+
+	trail, err := recorder.GetAuditTrail(ctx, workspaceID, AuditFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []*AuditEvent{aliceUpdated, bobCreated, aliceCreated}, trail, "newest first")
+
+	trail, err = recorder.GetAuditTrail(ctx, workspaceID, AuditFilters{Actions: []AuditAction{AuditActionCreated}})
+	require.NoError(t, err)
+	assert.Equal(t, []*AuditEvent{bobCreated, aliceCreated}, trail)
+
+	trail, err = recorder.GetAuditTrail(ctx, workspaceID, AuditFilters{ActorIDs: []uuid.UUID{alice}})
+	require.NoError(t, err)
+	assert.Equal(t, []*AuditEvent{aliceUpdated, aliceCreated}, trail)
+
+	trail, err = recorder.GetAuditTrail(ctx, workspaceID, AuditFilters{
+		Actions:  []AuditAction{AuditActionCreated},
+		ActorIDs: []uuid.UUID{alice},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*AuditEvent{aliceCreated}, trail)
+
+	trail, err = recorder.GetAuditTrail(ctx, workspaceID, AuditFilters{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []*AuditEvent{aliceUpdated}, trail)
+}
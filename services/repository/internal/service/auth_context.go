@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drewpayment/orbit/proto/pkg/svcauth"
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+	"github.com/google/uuid"
+)
+
+// authenticatedUserID returns the verified caller's user ID, which the
+// svcauth interceptor stores on the request context. A missing identity or
+// a user ID that is not a UUID is an authentication failure, never a
+// default user.
+func authenticatedUserID(ctx context.Context) (uuid.UUID, error) {
+	identity, ok := svcauth.IdentityFromContext(ctx)
+	if !ok {
+		return uuid.Nil, ErrUnauthenticated
+	}
+	userID, err := uuid.Parse(identity.UserID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid user id: %v", ErrUnauthenticated, err)
+	}
+	return userID, nil
+}
+
+// Authentication errors
+var (
+	ErrUnauthenticated = domain.NewDomainError("UNAUTHENTICATED", "No authenticated user in context")
+)
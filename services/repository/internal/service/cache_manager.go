@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCacheMiss is returned by MemoryCacheManager.Get for absent or expired keys
+var ErrCacheMiss = errors.New("cache miss")
+
+// MemoryCacheManager is an in-process CacheManager with per-key expiry and
+// glob-based pattern deletion
+type MemoryCacheManager struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+	now     func() time.Time
+}
+
+type memoryCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time // zero when the entry never expires
+}
+
+// NewMemoryCacheManager creates a new in-memory cache manager
+func NewMemoryCacheManager() *MemoryCacheManager {
+	return &MemoryCacheManager{
+		entries: make(map[string]memoryCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Set stores value under key. A non-positive ttl keeps it until deleted.
+func (c *MemoryCacheManager) Set(_ context.Context, key string, value interface{}, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := memoryCacheEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = c.now().Add(ttl)
+	}
+	c.entries[key] = entry
+	return nil
+}
+
+// Get returns the value stored under key, or ErrCacheMiss
+func (c *MemoryCacheManager) Get(_ context.Context, key string) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, ErrCacheMiss
+	}
+	return entry.value, nil
+}
+
+// Delete removes key
+func (c *MemoryCacheManager) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+	return nil
+}
+
+// DeleteByPattern removes every key matching pattern
+func (c *MemoryCacheManager) DeleteByPattern(_ context.Context, pattern string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if matchCachePattern(pattern, key) {
+			delete(c.entries, key)
+		}
+	}
+	return nil
+}
+
+// matchCachePattern reports whether key matches a glob pattern in which "*"
+// matches any run of characters and every other character matches itself
+func matchCachePattern(pattern, key string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == key
+	}
+
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(key, first) {
+		return false
+	}
+	key = key[len(first):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(key, part)
+		if i < 0 {
+			return false
+		}
+		key = key[i+len(part):]
+	}
+	return strings.HasSuffix(key, last)
+}
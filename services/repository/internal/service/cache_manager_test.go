@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchCachePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		key     string
+		want    bool
+	}{
+		{"schemas:list:ws1", "schemas:list:ws1", true},
+		{"schemas:list:ws1", "schemas:list:ws2", false},
+		{"schemas:list:*", "schemas:list:ws1:user:abc", true},
+		{"schemas:list:*", "schemas:lists", false},
+		{"schemas:list:ws1:*", "schemas:list:ws2:user:abc", false},
+		{"*:stats", "schema:stats", true},
+		{"*:stats", "schema:stats:x", false},
+		{"api_*:id:*", "api_schema:id:123", true},
+		{"a*b*b", "abb", true},
+		{"a*bc*c", "abc", false},
+		{"*", "", true},
+		{"schema[1]:*", "schema[1]:x", true},
+		{"schema?:*", "schema1:x", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, matchCachePattern(tt.pattern, tt.key), "%q ~ %q", tt.pattern, tt.key)
+	}
+}
+
+func TestMemoryCacheManager(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCacheManager()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	require.NoError(t, cache.Set(ctx, "schemas:list:ws1:a", 1, time.Minute))
+	require.NoError(t, cache.Set(ctx, "schemas:list:ws1:b", 2, 0))
+	require.NoError(t, cache.Set(ctx, "schemas:list:ws2:a", 3, time.Hour))
+
+	value, err := cache.Get(ctx, "schemas:list:ws1:a")
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+
+	now = now.Add(time.Minute)
+	_, err = cache.Get(ctx, "schemas:list:ws1:a")
+	assert.ErrorIs(t, err, ErrCacheMiss, "expired entries are misses")
+	_, err = cache.Get(ctx, "schemas:list:ws1:b")
+	assert.NoError(t, err, "entries without a TTL do not expire")
+
+	require.NoError(t, cache.Set(ctx, "schemas:list:ws1:a", 1, time.Minute))
+	require.NoError(t, cache.DeleteByPattern(ctx, "schemas:list:ws1:*"))
+	_, err = cache.Get(ctx, "schemas:list:ws1:a")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	_, err = cache.Get(ctx, "schemas:list:ws1:b")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	_, err = cache.Get(ctx, "schemas:list:ws2:a")
+	assert.NoError(t, err)
+
+	require.NoError(t, cache.Delete(ctx, "schemas:list:ws2:a"))
+	_, err = cache.Get(ctx, "schemas:list:ws2:a")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Changelog formats produced by DefaultDocumentationGenerator
+const (
+	ChangelogFormatMarkdown = "markdown"
+	ChangelogFormatJSON     = "json"
+)
+
+// changelogSections groups schema changes the way changelogs present them.
+// A breaking change is listed only under breaking, whatever its type.
+type changelogSections struct {
+	Breaking   []SchemaChange `json:"breaking"`
+	Added      []SchemaChange `json:"added"`
+	Changed    []SchemaChange `json:"changed"`
+	Deprecated []SchemaChange `json:"deprecated"`
+}
+
+func newChangelogSections(changes []SchemaChange) *changelogSections {
+	sections := &changelogSections{
+		Breaking:   []SchemaChange{},
+		Added:      []SchemaChange{},
+		Changed:    []SchemaChange{},
+		Deprecated: []SchemaChange{},
+	}
+	for _, change := range changes {
+		switch {
+		case change.IsBreaking:
+			sections.Breaking = append(sections.Breaking, change)
+		case change.Type == SchemaChangeAdded:
+			sections.Added = append(sections.Added, change)
+		case change.Type == SchemaChangeDeprecated:
+			sections.Deprecated = append(sections.Deprecated, change)
+		default:
+			sections.Changed = append(sections.Changed, change)
+		}
+	}
+	return sections
+}
+
+func (s *changelogSections) summary() ChangeSummary {
+	return ChangeSummary{
+		TotalChanges:    len(s.Breaking) + len(s.Added) + len(s.Changed) + len(s.Deprecated),
+		BreakingChanges: len(s.Breaking),
+		NewFeatures:     len(s.Added),
+		Improvements:    len(s.Changed),
+		Deprecations:    len(s.Deprecated),
+	}
+}
+
+// markdown renders the changelog with one section per non-empty group
+func (s *changelogSections) markdown(name, from, to string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Changelog: %s %s → %s\n", name, from, to)
+
+	groups := []struct {
+		title   string
+		changes []SchemaChange
+	}{
+		{"⚠️ Breaking changes", s.Breaking},
+		{"Added", s.Added},
+		{"Changed", s.Changed},
+		{"Deprecated", s.Deprecated},
+	}
+	empty := true
+	for _, group := range groups {
+		if len(group.changes) == 0 {
+			continue
+		}
+		empty = false
+		fmt.Fprintf(&b, "\n## %s\n\n", group.title)
+		for _, change := range group.changes {
+			description := change.Description
+			if description == "" {
+				description = fmt.Sprintf("%s %s", change.Type, change.Category)
+			}
+			fmt.Fprintf(&b, "- %s (`%s`)\n", description, change.Path)
+			if change.IsBreaking && change.Suggestion != "" {
+				fmt.Fprintf(&b, "  - Suggestion: %s\n", change.Suggestion)
+			}
+		}
+	}
+	if empty {
+		b.WriteString("\nNo schema changes detected.\n")
+	}
+	return b.String()
+}
+
+// json renders the changelog as a JSON document of its sections
+func (s *changelogSections) json(from, to string, summary ChangeSummary) (string, error) {
+	out, err := json.MarshalIndent(struct {
+		From     string             `json:"from"`
+		To       string             `json:"to"`
+		Summary  ChangeSummary      `json:"summary"`
+		Sections *changelogSections `json:"sections"`
+	}{from, to, summary, s}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
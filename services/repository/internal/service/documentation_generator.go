@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+	"github.com/google/uuid"
+)
+
+// DefaultDocumentationGenerator implements DocumentationGenerator for OpenAPI
+// schemas, reading schema content from the schema repository and writing
+// rendered documentation to a blob store
+type DefaultDocumentationGenerator struct {
+	schemaRepo APISchemaRepository
+	store      BlobStore
+	logger     *slog.Logger
+}
+
+// NewDefaultDocumentationGenerator creates a new documentation generator
+func NewDefaultDocumentationGenerator(schemaRepo APISchemaRepository, store BlobStore, logger *slog.Logger) *DefaultDocumentationGenerator {
+	return &DefaultDocumentationGenerator{
+		schemaRepo: schemaRepo,
+		store:      store,
+		logger:     logger.With("component", "documentation_generator"),
+	}
+}
+
+// GenerateDocumentation renders an OpenAPI 3.x schema version as HTML or
+// Markdown and stores it under req.OutputPath, or docs/<schema>/<version>
+// when no output path is given. The latest version is used when req.Version
+// is empty.
+func (g *DefaultDocumentationGenerator) GenerateDocumentation(ctx context.Context, req *DocumentationRequest) (*DocumentationResult, error) {
+	start := time.Now()
+
+	var fileName, contentType string
+	switch req.Format {
+	case DocumentationFormatHTML:
+		fileName, contentType = "index.html", "text/html; charset=utf-8"
+	case DocumentationFormatMarkdown:
+		fileName, contentType = "index.md", "text/markdown; charset=utf-8"
+	default:
+		return nil, fmt.Errorf("%w: %s documentation is not supported", ErrDocumentationGenerationFailed, req.Format)
+	}
+	if g.store == nil {
+		return nil, fmt.Errorf("%w: no documentation store configured", ErrDocumentationGenerationFailed)
+	}
+
+	schema, version, err := g.loadVersion(ctx, req.SchemaID, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if SchemaFormat(schema.Format) != SchemaFormatOpenAPI {
+		return nil, fmt.Errorf("%w: documentation for %s schemas is not supported", ErrDocumentationGenerationFailed, schema.Format)
+	}
+	doc, err := parseOpenAPI3(version.Content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDocumentationGenerationFailed, err)
+	}
+
+	apiDoc := newAPIDocument(doc, req.Options)
+	var content string
+	if req.Format == DocumentationFormatHTML {
+		content = apiDoc.html(req.Theme)
+	} else {
+		content = apiDoc.markdown()
+	}
+
+	prefix := strings.Trim(req.OutputPath, "/")
+	if prefix == "" {
+		prefix = fmt.Sprintf("docs/%s/%s", schema.ID, version.Version)
+	}
+	key := prefix + "/" + fileName
+	if err := g.store.Put(ctx, key, []byte(content), contentType); err != nil {
+		return nil, fmt.Errorf("failed to store documentation: %w", err)
+	}
+
+	result := &DocumentationResult{
+		Success:          true,
+		DocumentationURL: "/" + key,
+		Artifacts: []DocumentationArtifact{{
+			Type:        string(req.Format),
+			Path:        key,
+			URL:         "/" + key,
+			Size:        int64(len(content)),
+			ContentType: contentType,
+		}},
+		Errors:      []DocumentationError{},
+		Warnings:    []DocumentationWarning{},
+		GeneratedAt: time.Now(),
+	}
+	result.Duration = time.Since(start)
+
+	g.logger.InfoContext(ctx, "Documentation generated",
+		"schema_id", schema.ID, "version", version.Version, "format", req.Format, "path", key, "size", len(content))
+
+	return result, nil
+}
+
+// GenerateChangelog describes the changes between two versions of an
+// OpenAPI schema as Markdown
+func (g *DefaultDocumentationGenerator) GenerateChangelog(ctx context.Context, oldVersion, newVersion *domain.APISchemaVersion) (*ChangelogResult, error) {
+	return g.GenerateChangelogAs(ctx, oldVersion, newVersion, ChangelogFormatMarkdown)
+}
+
+// GenerateChangelogAs describes the changes between two versions of an
+// OpenAPI schema in the given format. Changes come from the compatibility
+// diff and are grouped into breaking, added, changed and deprecated sections.
+func (g *DefaultDocumentationGenerator) GenerateChangelogAs(ctx context.Context, oldVersion, newVersion *domain.APISchemaVersion, format string) (*ChangelogResult, error) {
+	if format != ChangelogFormatMarkdown && format != ChangelogFormatJSON {
+		return nil, fmt.Errorf("%w: %q changelogs are not supported", ErrDocumentationGenerationFailed, format)
+	}
+
+	schema, err := g.schemaRepo.GetByID(ctx, newVersion.SchemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+	if SchemaFormat(schema.Format) != SchemaFormatOpenAPI {
+		return nil, fmt.Errorf("%w: changelogs for %s schemas are not supported", ErrDocumentationGenerationFailed, schema.Format)
+	}
+
+	diff, err := diffOpenAPI(ctx, oldVersion.Content, newVersion.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff schema versions: %w", err)
+	}
+
+	sections := newChangelogSections(diff.Changes)
+	result := &ChangelogResult{
+		Success:     true,
+		Format:      format,
+		Changes:     diff.Changes,
+		Summary:     sections.summary(),
+		GeneratedAt: time.Now(),
+	}
+	if format == ChangelogFormatJSON {
+		result.Changelog, err = sections.json(oldVersion.Version, newVersion.Version, result.Summary)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode changelog: %w", err)
+		}
+	} else {
+		result.Changelog = sections.markdown(schema.Name, oldVersion.Version, newVersion.Version)
+	}
+
+	g.logger.DebugContext(ctx, "Changelog generated",
+		"schema_id", schema.ID, "from", oldVersion.Version, "to", newVersion.Version,
+		"format", format, "changes", result.Summary.TotalChanges, "breaking", result.Summary.BreakingChanges)
+
+	return result, nil
+}
+
+// PreviewDocumentation renders documentation for unsaved schema content
+func (g *DefaultDocumentationGenerator) PreviewDocumentation(ctx context.Context, schema string, format SchemaFormat, theme string) (*PreviewResult, error) {
+	return nil, fmt.Errorf("%w: documentation preview for %s is not supported", ErrDocumentationGenerationFailed, format)
+}
+
+// GenerateSDK generates a typed client for an OpenAPI 3.x schema version in
+// Go or TypeScript. The latest version is used when req.Version is empty.
+// Constructs the client cannot express are reported as SDKErrors: those
+// with severity "warning" are approximated with an untyped value, while
+// operations with an "error" are left out of the client and fail the result.
+func (g *DefaultDocumentationGenerator) GenerateSDK(ctx context.Context, req *SDKGenerationRequest) (*SDKResult, error) {
+	start := time.Now()
+
+	language := strings.ToLower(req.Language)
+	if language != SDKLanguageGo && language != SDKLanguageTypeScript {
+		return nil, fmt.Errorf("%w: SDK generation for %q is not supported", ErrDocumentationGenerationFailed, req.Language)
+	}
+
+	schema, version, err := g.loadVersion(ctx, req.SchemaID, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if SchemaFormat(schema.Format) != SchemaFormatOpenAPI {
+		return nil, fmt.Errorf("%w: SDK generation for %s schemas is not supported", ErrDocumentationGenerationFailed, schema.Format)
+	}
+
+	doc, err := parseOpenAPI3(version.Content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDocumentationGenerationFailed, err)
+	}
+
+	api := newSDKBuilder(doc, req.Options.Authentication).build()
+	packageName := req.PackageName
+	if packageName == "" {
+		packageName = schema.Slug
+	}
+	if packageName == "" {
+		packageName = schema.Name
+	}
+
+	var files []SDKFile
+	switch language {
+	case SDKLanguageGo:
+		files, err = newGoSDKEmitter(api, packageName, req.Options).files()
+	case SDKLanguageTypeScript:
+		files = newTypeScriptSDKEmitter(api, packageName, req.Options).files()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDocumentationGenerationFailed, err)
+	}
+	for i := range files {
+		files[i].Language = language
+		files[i].Size = int64(len(files[i].Content))
+	}
+
+	result := &SDKResult{
+		Success:     true,
+		Language:    language,
+		Framework:   req.Framework,
+		PackageName: packageName,
+		Files:       files,
+		Errors:      api.errors,
+		Warnings:    []SDKWarning{},
+		GeneratedAt: time.Now(),
+	}
+	for _, sdkErr := range api.errors {
+		if sdkErr.Severity == "error" {
+			result.Success = false
+		}
+	}
+	result.Duration = time.Since(start)
+
+	g.logger.InfoContext(ctx, "SDK generated",
+		"schema_id", req.SchemaID, "version", version.Version, "language", language,
+		"files", len(files), "errors", len(api.errors), "success", result.Success)
+
+	return result, nil
+}
+
+// loadVersion returns a schema and the requested version of it, or its
+// latest version when version is empty
+func (g *DefaultDocumentationGenerator) loadVersion(ctx context.Context, schemaID uuid.UUID, version string) (*domain.APISchema, *domain.APISchemaVersion, error) {
+	schema, err := g.schemaRepo.GetByID(ctx, schemaID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	var schemaVersion *domain.APISchemaVersion
+	if version != "" {
+		schemaVersion, err = g.schemaRepo.GetVersion(ctx, schemaID, version)
+	} else {
+		schemaVersion, err = g.schemaRepo.GetLatestVersion(ctx, schemaID)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return schema, schemaVersion, nil
+}
+
+// parseOpenAPI3 parses JSON or YAML schema content that must be an
+// OpenAPI 3.x document
+func parseOpenAPI3(content string) (map[string]interface{}, error) {
+	parsed, _, err := parseSchemaDocument(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	doc, ok := parsed.(map[string]interface{})
+	if openapi, _ := doc["openapi"].(string); !ok || !strings.HasPrefix(openapi, "3.") {
+		return nil, fmt.Errorf("only OpenAPI 3.x documents are supported")
+	}
+	return doc, nil
+}
@@ -0,0 +1,525 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+const sdkPetstoreSpec = `openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List all pets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            format: int32
+        - name: status
+          in: query
+          schema:
+            type: array
+            items:
+              $ref: '#/components/schemas/PetStatus'
+        - name: X-Request-Id
+          in: header
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: A page of pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      operationId: createPet
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/NewPet'
+      responses:
+        '201':
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: integer
+          format: int64
+    get:
+      operationId: getPet
+      responses:
+        '200':
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    delete:
+      operationId: deletePet
+      deprecated: true
+      responses:
+        '204':
+          description: Deleted
+  /pets/{petId}/photo:
+    put:
+      operationId: uploadPhoto
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: integer
+      requestBody:
+        content:
+          image/png:
+            schema:
+              type: string
+              format: binary
+      responses:
+        '204':
+          description: Uploaded
+components:
+  securitySchemes:
+    bearerAuth:
+      type: http
+      scheme: bearer
+  schemas:
+    Pet:
+      allOf:
+        - $ref: '#/components/schemas/NewPet'
+        - type: object
+          required: [id]
+          properties:
+            id:
+              type: integer
+              format: int64
+    NewPet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+          description: The pet's name
+        status:
+          $ref: '#/components/schemas/PetStatus'
+        owner:
+          type: object
+          properties:
+            email:
+              type: string
+        tags:
+          type: array
+          items:
+            type: string
+        shape:
+          oneOf:
+            - type: string
+            - type: integer
+    PetStatus:
+      type: string
+      enum: [available, sold]
+`
+
+func newTestSDKGenerator(t *testing.T, content string) (*DefaultDocumentationGenerator, uuid.UUID) {
+	t.Helper()
+	generator, schemaID, _ := newTestDocumentationGenerator(t, content)
+	return generator, schemaID
+}
+
+// newTestDocumentationGenerator registers content as version 1.0.0 of a
+// "Petstore" OpenAPI schema, writing documentation to a temporary blob store
+func newTestDocumentationGenerator(t *testing.T, content string) (*DefaultDocumentationGenerator, uuid.UUID, BlobStore) {
+	t.Helper()
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	schema := &domain.APISchema{ID: uuid.New(), Name: "Petstore", Slug: "petstore", Format: string(SchemaFormatOpenAPI)}
+	repo := newMemorySchemaRepository()
+	repo.schemas[schema.ID] = schema
+	repo.versions[schema.ID] = []*domain.APISchemaVersion{{SchemaID: schema.ID, Version: "1.0.0", Content: content}}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewDefaultDocumentationGenerator(repo, store, logger), schema.ID, store
+}
+
+func sdkFile(t *testing.T, result *SDKResult, path string) string {
+	t.Helper()
+	for _, file := range result.Files {
+		if file.Path == path {
+			return file.Content
+		}
+	}
+	t.Fatalf("no generated file %s", path)
+	return ""
+}
+
+func TestDocumentationGenerator_GenerateSDK_Go(t *testing.T) {
+	generator, schemaID := newTestSDKGenerator(t, sdkPetstoreSpec)
+
+	result, err := generator.GenerateSDK(context.Background(), &SDKGenerationRequest{
+		SchemaID: schemaID,
+		Language: "go",
+		Options:  SDKOptions{IncludeTests: true, Authentication: []string{SDKAuthBearer, SDKAuthAPIKey}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "petstore", result.PackageName)
+
+	fset := token.NewFileSet()
+	for _, file := range result.Files {
+		if !strings.HasSuffix(file.Path, ".go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file.Path, file.Content, parser.AllErrors)
+		require.NoError(t, err, "generated %s does not parse", file.Path)
+		assert.Equal(t, "petstore", parsed.Name.Name)
+		assert.Equal(t, int64(len(file.Content)), file.Size)
+	}
+
+	client := sdkFile(t, result, "client.go")
+	assert.Contains(t, client, "func (c *Client) ListPets(ctx context.Context, params *ListPetsParams) ([]Pet, error)")
+	assert.Contains(t, client, "func (c *Client) CreatePet(ctx context.Context, body *NewPet) (*Pet, error)")
+	assert.Contains(t, client, "func (c *Client) GetPet(ctx context.Context, petID int64) (*Pet, error)")
+	assert.Contains(t, client, "func (c *Client) DeletePet(ctx context.Context, petID int64) error")
+	assert.Contains(t, client, "Deprecated:")
+	assert.Contains(t, client, "func WithBearerToken(token string) Option")
+	assert.Contains(t, client, "func WithAPIKey(key string) Option")
+	assert.NotContains(t, client, "WithBasicAuth")
+
+	models := sdkFile(t, result, "models.go")
+	assert.Contains(t, models, "type PetStatus string")
+	assert.Contains(t, models, `PetStatusAvailable PetStatus = "available"`)
+	assert.Regexp(t, "(?m)^\tID +int64 +`json:\"id\"`$", models, "allOf branches are flattened")
+	assert.Equal(t, 1, strings.Count(models, "type NewPetOwner struct"), "inline objects are hoisted once")
+
+	tests := sdkFile(t, result, "client_test.go")
+	assert.Contains(t, tests, "func TestClient_GetPet(t *testing.T)")
+}
+
+func TestDocumentationGenerator_GenerateSDK_TypeScript(t *testing.T) {
+	generator, schemaID := newTestSDKGenerator(t, sdkPetstoreSpec)
+
+	result, err := generator.GenerateSDK(context.Background(), &SDKGenerationRequest{
+		SchemaID: schemaID,
+		Language: "TypeScript",
+		Options:  SDKOptions{Authentication: []string{SDKAuthBasic}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, SDKLanguageTypeScript, result.Language)
+
+	client := sdkFile(t, result, "src/client.ts")
+	assert.Contains(t, client, "import type { NewPet, Pet, PetStatus } from './models';")
+	assert.Contains(t, client, "async listPets(params: ListPetsParams): Promise<Pet[]>")
+	assert.Contains(t, client, "async createPet(body: NewPet): Promise<Pet>")
+	assert.Contains(t, client, "async getPet(petId: number): Promise<Pet>")
+	assert.Contains(t, client, "async deletePet(petId: number): Promise<void>")
+	assert.Contains(t, client, "'X-Request-Id': string;")
+	assert.Contains(t, client, "username?: string;")
+	assert.NotContains(t, client, "token?: string;")
+
+	models := sdkFile(t, result, "src/models.ts")
+	assert.Contains(t, models, "export type PetStatus = 'available' | 'sold';")
+	assert.Contains(t, models, "export interface Pet {")
+	assert.Contains(t, models, "  id: number;")
+
+	for _, file := range result.Files {
+		assert.NotEqual(t, "src/client.test.ts", file.Path, "tests are only generated on request")
+	}
+}
+
+func TestDocumentationGenerator_GenerateSDK_ReportsUnsupportedConstructs(t *testing.T) {
+	generator, schemaID := newTestSDKGenerator(t, sdkPetstoreSpec)
+
+	result, err := generator.GenerateSDK(context.Background(), &SDKGenerationRequest{
+		SchemaID: schemaID,
+		Language: "go",
+		Options:  SDKOptions{Authentication: []string{"kerberos"}},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+
+	bySeverity := map[string][]string{}
+	for _, sdkErr := range result.Errors {
+		bySeverity[sdkErr.Severity] = append(bySeverity[sdkErr.Severity], sdkErr.Message)
+	}
+	require.Len(t, bySeverity["warning"], 1)
+	assert.Contains(t, bySeverity["warning"][0], "/components/schemas/NewPet/properties/shape: oneOf")
+	require.Len(t, bySeverity["error"], 2)
+	assert.Contains(t, bySeverity["error"][0], "operation UploadPhoto is omitted")
+	assert.Contains(t, bySeverity["error"][1], `"kerberos"`)
+
+	assert.NotContains(t, sdkFile(t, result, "client.go"), "UploadPhoto")
+}
+
+func TestDocumentationGenerator_GenerateSDK_Unsupported(t *testing.T) {
+	generator, schemaID := newTestSDKGenerator(t, sdkPetstoreSpec)
+
+	_, err := generator.GenerateSDK(context.Background(), &SDKGenerationRequest{SchemaID: schemaID, Language: "cobol"})
+	assert.ErrorIs(t, err, ErrDocumentationGenerationFailed)
+
+	generator, schemaID = newTestSDKGenerator(t, `{"swagger": "2.0"}`)
+	_, err = generator.GenerateSDK(context.Background(), &SDKGenerationRequest{SchemaID: schemaID, Language: "go"})
+	assert.ErrorIs(t, err, ErrDocumentationGenerationFailed)
+}
+
+const changelogPetsV1 = `openapi: 3.0.3
+info: {title: Pets, version: 1.0.0}
+paths:
+  /pets:
+    get:
+      parameters:
+        - {name: legacy, in: query, schema: {type: string}}
+      responses:
+        '200': {description: OK}
+  /pets/{id}:
+    delete:
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string}}
+      responses:
+        '204': {description: Deleted}
+`
+
+const changelogPetsV2 = `openapi: 3.0.3
+info: {title: Pets, version: 2.0.0}
+paths:
+  /pets:
+    get:
+      deprecated: true
+      parameters:
+        - {name: limit, in: query, schema: {type: integer}}
+      responses:
+        '200': {description: OK}
+  /owners:
+    get:
+      responses:
+        '200': {description: OK}
+`
+
+func newTestChangelogVersions(t *testing.T) (*DefaultDocumentationGenerator, *domain.APISchemaVersion, *domain.APISchemaVersion) {
+	t.Helper()
+	generator, schemaID := newTestSDKGenerator(t, changelogPetsV1)
+	oldVersion := &domain.APISchemaVersion{SchemaID: schemaID, Version: "1.0.0", Content: changelogPetsV1}
+	newVersion := &domain.APISchemaVersion{SchemaID: schemaID, Version: "2.0.0", Content: changelogPetsV2}
+	return generator, oldVersion, newVersion
+}
+
+func TestDocumentationGenerator_GenerateChangelog(t *testing.T) {
+	generator, oldVersion, newVersion := newTestChangelogVersions(t)
+
+	result, err := generator.GenerateChangelog(context.Background(), oldVersion, newVersion)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, ChangelogFormatMarkdown, result.Format)
+	assert.Equal(t, ChangeSummary{TotalChanges: 5, BreakingChanges: 1, NewFeatures: 2, Improvements: 1, Deprecations: 1}, result.Summary)
+	assert.Len(t, result.Changes, 5)
+
+	changelog := result.Changelog
+	assert.True(t, strings.HasPrefix(changelog, "# Changelog: Petstore 1.0.0 → 2.0.0\n"))
+	sections := map[string]string{}
+	for _, section := range strings.Split(changelog, "\n## ")[1:] {
+		title, body, _ := strings.Cut(section, "\n")
+		sections[title] = body
+	}
+	require.Len(t, sections, 4)
+	assert.Contains(t, sections["⚠️ Breaking changes"], "- endpoint DELETE /pets/{id} was removed (`/paths/~1pets~1{id}/delete`)")
+	assert.Contains(t, sections["⚠️ Breaking changes"], "Suggestion: Deprecate the endpoint")
+	assert.Contains(t, sections["Added"], "- endpoint GET /owners was added")
+	assert.Contains(t, sections["Added"], `optional query parameter "limit" was added to GET /pets`)
+	assert.Contains(t, sections["Changed"], `query parameter "legacy" was removed from GET /pets`)
+	assert.Contains(t, sections["Deprecated"], "- endpoint GET /pets was deprecated")
+	assert.NotContains(t, sections["Added"], "DELETE", "breaking changes are only listed as breaking")
+}
+
+func TestDocumentationGenerator_GenerateChangelog_JSON(t *testing.T) {
+	generator, oldVersion, newVersion := newTestChangelogVersions(t)
+
+	result, err := generator.GenerateChangelogAs(context.Background(), oldVersion, newVersion, ChangelogFormatJSON)
+	require.NoError(t, err)
+	assert.Equal(t, ChangelogFormatJSON, result.Format)
+
+	var decoded struct {
+		From     string                    `json:"from"`
+		To       string                    `json:"to"`
+		Summary  ChangeSummary             `json:"summary"`
+		Sections map[string][]SchemaChange `json:"sections"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Changelog), &decoded))
+	assert.Equal(t, "1.0.0", decoded.From)
+	assert.Equal(t, "2.0.0", decoded.To)
+	assert.Equal(t, result.Summary, decoded.Summary)
+	require.Len(t, decoded.Sections["breaking"], 1)
+	assert.Equal(t, SchemaChangeRemoved, decoded.Sections["breaking"][0].Type)
+	require.Len(t, decoded.Sections["deprecated"], 1)
+	assert.Equal(t, "/paths/~1pets/get", decoded.Sections["deprecated"][0].Path)
+	assert.Len(t, decoded.Sections["added"], 2)
+	assert.Len(t, decoded.Sections["changed"], 1)
+}
+
+func TestDocumentationGenerator_GenerateChangelog_NoChanges(t *testing.T) {
+	generator, oldVersion, _ := newTestChangelogVersions(t)
+
+	result, err := generator.GenerateChangelog(context.Background(), oldVersion, oldVersion)
+	require.NoError(t, err)
+	assert.Zero(t, result.Summary.TotalChanges)
+	assert.Contains(t, result.Changelog, "No schema changes detected.")
+
+	_, err = generator.GenerateChangelogAs(context.Background(), oldVersion, oldVersion, "html")
+	assert.ErrorIs(t, err, ErrDocumentationGenerationFailed)
+}
+
+// generateDocumentation renders the documentation testdata spec and returns
+// the stored artifact's content
+func generateDocumentation(t *testing.T, req DocumentationRequest) (*DocumentationResult, string) {
+	t.Helper()
+	spec, err := os.ReadFile(filepath.Join("testdata", "documentation", "petstore.yaml"))
+	require.NoError(t, err)
+	generator, schemaID, store := newTestDocumentationGenerator(t, string(spec))
+
+	req.SchemaID = schemaID
+	result, err := generator.GenerateDocumentation(context.Background(), &req)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Len(t, result.Artifacts, 1)
+
+	content, err := store.Get(context.Background(), result.Artifacts[0].Path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), result.Artifacts[0].Size)
+	return result, string(content)
+}
+
+func TestDocumentationGenerator_GenerateDocumentation_Golden(t *testing.T) {
+	options := DocumentationOptions{
+		IncludeExamples: true,
+		IncludeSchemas:  true,
+		IncludeHeaders:  true,
+		IncludeTOC:      true,
+		GroupByTags:     true,
+		ShowDeprecated:  true,
+	}
+	tests := []struct {
+		format DocumentationFormat
+		golden string
+	}{
+		{DocumentationFormatMarkdown, "petstore.md"},
+		{DocumentationFormatHTML, "petstore.html"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			_, content := generateDocumentation(t, DocumentationRequest{Format: tt.format, Theme: "dark", Options: options})
+			_, again := generateDocumentation(t, DocumentationRequest{Format: tt.format, Theme: "dark", Options: options})
+			assert.Equal(t, content, again, "output should be stable")
+
+			golden := filepath.Join("testdata", "documentation", tt.golden)
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(golden, []byte(content), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), content)
+		})
+	}
+}
+
+func TestDocumentationGenerator_GenerateDocumentation_Markdown(t *testing.T) {
+	result, content := generateDocumentation(t, DocumentationRequest{
+		Format:  DocumentationFormatMarkdown,
+		Options: DocumentationOptions{IncludeTOC: true, IncludeSchemas: true, Title: "Pet API"},
+	})
+
+	artifact := result.Artifacts[0]
+	assert.Regexp(t, `^docs/[0-9a-f-]+/1\.0\.0/index\.md$`, artifact.Path)
+	assert.Equal(t, "markdown", artifact.Type)
+	assert.Equal(t, "/"+artifact.Path, result.DocumentationURL)
+
+	assert.True(t, strings.HasPrefix(content, "# Pet API\n"))
+
+	// Table of contents links every endpoint and model
+	assert.Contains(t, content, "## Contents\n")
+	assert.Contains(t, content, "- [GET /pets](#get-pets) — List pets\n")
+	assert.Contains(t, content, "- [GET /pets/{petId}](#get-pets-petid) — Get a pet\n")
+	assert.Contains(t, content, "  - [Pet](#model-pet)\n")
+	assert.Contains(t, content, "  - [PetStatus](#enum-petstatus)\n")
+
+	// Endpoints
+	assert.Contains(t, content, "<a id=\"post-pets\"></a>\n\n### POST /pets\n")
+	assert.Contains(t, content, "| `petId` | path | string | yes | The pet's ID |")
+	assert.Contains(t, content, "| 200 | A page of pets | `application/json`: array of [Pet](#model-pet) |")
+	assert.Contains(t, content, "**Authentication:** `bearerAuth` (http:bearer)")
+
+	// Models
+	assert.Contains(t, content, "<a id=\"model-pet\"></a>\n\n### Pet\n")
+	assert.Contains(t, content, "| `name` | string | yes | Min length 1; max length 64. |")
+	assert.Contains(t, content, "| `status` | [PetStatus](#enum-petstatus) | no |  |")
+
+	// Deprecated operations and models are hidden unless requested, as are
+	// examples, headers and tag groups
+	assert.NotContains(t, content, "DELETE /pets/{petId}")
+	assert.NotContains(t, content, "LegacyPet")
+	assert.NotContains(t, content, "```json")
+	assert.NotContains(t, content, "X-Next-Page")
+	assert.NotContains(t, content, "### pets")
+}
+
+func TestDocumentationGenerator_GenerateDocumentation_Options(t *testing.T) {
+	result, content := generateDocumentation(t, DocumentationRequest{
+		Format:     DocumentationFormatHTML,
+		OutputPath: "/public/petstore/",
+		Options: DocumentationOptions{
+			ShowDeprecated: true,
+			CustomCSS:      "body { color: red; } </style><script>",
+			Logo:           "javascript:alert(1)",
+		},
+	})
+
+	assert.Equal(t, "public/petstore/index.html", result.Artifacts[0].Path)
+	assert.Equal(t, "text/html; charset=utf-8", result.Artifacts[0].ContentType)
+
+	assert.NotContains(t, content, "<nav class=\"toc\">")
+	assert.NotContains(t, content, "id=\"models\"")
+	assert.Contains(t, content, "<article class=\"endpoint deprecated\" id=\"delete-pets-petid\">")
+	assert.Contains(t, content, `body { color: red; } <\/style><script>`)
+	assert.NotContains(t, content, "<img")
+}
+
+func TestDocumentationGenerator_GenerateDocumentation_Unsupported(t *testing.T) {
+	generator, schemaID, _ := newTestDocumentationGenerator(t, sdkPetstoreSpec)
+
+	_, err := generator.GenerateDocumentation(context.Background(), &DocumentationRequest{SchemaID: schemaID, Format: DocumentationFormatPDF})
+	assert.ErrorIs(t, err, ErrDocumentationGenerationFailed)
+
+	generator, schemaID, _ = newTestDocumentationGenerator(t, `{"swagger": "2.0"}`)
+	_, err = generator.GenerateDocumentation(context.Background(), &DocumentationRequest{SchemaID: schemaID, Format: DocumentationFormatMarkdown})
+	assert.ErrorIs(t, err, ErrDocumentationGenerationFailed)
+}
@@ -0,0 +1,878 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// apiDocument is the format-neutral view of an OpenAPI document shared by
+// the Markdown and HTML documentation renderers. Everything in it is sorted
+// so that rendering the same document twice gives byte-identical output.
+type apiDocument struct {
+	title       string
+	version     string
+	description string
+	contact     ContactInfo
+	license     LicenseInfo
+	groups      []apiDocGroup
+	models      []ComponentModel
+	enums       []ComponentEnum
+	// anchors maps documented model and enum names to their anchors, so
+	// types can link to them
+	anchors  map[string]string
+	examples map[string]interface{} // component schema examples by name
+	options  DocumentationOptions
+}
+
+// apiDocGroup is a set of endpoints documented together: one per tag when
+// grouping by tags, otherwise a single group holding every endpoint
+type apiDocGroup struct {
+	name        string
+	description string
+	anchor      string
+	endpoints   []apiDocEndpoint
+}
+
+type apiDocEndpoint struct {
+	ComponentEndpoint
+	anchor     string
+	deprecated bool
+}
+
+// newAPIDocument builds the documentation view of an OpenAPI 3.x document.
+// Title, description and version in options override the document's info.
+func newAPIDocument(doc map[string]interface{}, options DocumentationOptions) *apiDocument {
+	components := newOpenAPIComponentExtractor(doc).extract()
+	info, _ := doc["info"].(map[string]interface{})
+
+	d := &apiDocument{
+		title:       firstNonEmpty(options.Title, schemaString(info, "title"), "API Reference"),
+		version:     firstNonEmpty(options.Version, schemaString(info, "version")),
+		description: firstNonEmpty(options.Description, schemaString(info, "description")),
+		contact:     options.ContactInfo,
+		license:     options.LicenseInfo,
+		anchors:     map[string]string{},
+		examples:    map[string]interface{}{},
+		options:     options,
+	}
+	if d.contact == (ContactInfo{}) {
+		contact, _ := info["contact"].(map[string]interface{})
+		d.contact = ContactInfo{Name: schemaString(contact, "name"), Email: schemaString(contact, "email"), URL: schemaString(contact, "url")}
+	}
+	if d.license == (LicenseInfo{}) {
+		license, _ := info["license"].(map[string]interface{})
+		d.license = LicenseInfo{Name: schemaString(license, "name"), URL: schemaString(license, "url")}
+	}
+
+	used := map[string]int{}
+	anchor := func(base string) string {
+		n := used[base]
+		used[base]++
+		if n > 0 {
+			return fmt.Sprintf("%s-%d", base, n+1)
+		}
+		return base
+	}
+
+	if options.IncludeSchemas {
+		for _, model := range components.Models {
+			if deprecated, _ := model.Metadata["deprecated"].(bool); deprecated && !options.ShowDeprecated {
+				continue
+			}
+			d.models = append(d.models, model)
+			d.anchors[model.Name] = anchor("model-" + docSlug(model.Name))
+		}
+		for _, enum := range components.Enums {
+			d.enums = append(d.enums, enum)
+			d.anchors[enum.Name] = anchor("enum-" + docSlug(enum.Name))
+		}
+	}
+	for name, schema := range componentSchemas(doc) {
+		if m, ok := schema.(map[string]interface{}); ok && m["example"] != nil {
+			d.examples[name] = m["example"]
+		}
+	}
+
+	var endpoints []apiDocEndpoint
+	for _, endpoint := range components.Endpoints {
+		deprecated, _ := endpoint.Metadata["deprecated"].(bool)
+		if deprecated && !options.ShowDeprecated {
+			continue
+		}
+		endpoints = append(endpoints, apiDocEndpoint{
+			ComponentEndpoint: endpoint,
+			anchor:            anchor(docSlug(endpoint.Method + " " + endpoint.Path)),
+			deprecated:        deprecated,
+		})
+	}
+	if options.GroupByTags {
+		d.groups = tagGroups(doc, endpoints, anchor)
+	} else if len(endpoints) > 0 {
+		d.groups = []apiDocGroup{{name: "Endpoints", endpoints: endpoints}}
+	}
+	return d
+}
+
+// tagGroups groups endpoints by their first tag. Groups follow the order of
+// the document's tags list, then any other tags alphabetically, with
+// untagged endpoints last under "Other".
+func tagGroups(doc map[string]interface{}, endpoints []apiDocEndpoint, anchor func(string) string) []apiDocGroup {
+	byTag := map[string][]apiDocEndpoint{}
+	for _, endpoint := range endpoints {
+		tag := ""
+		if len(endpoint.Tags) > 0 {
+			tag = endpoint.Tags[0]
+		}
+		byTag[tag] = append(byTag[tag], endpoint)
+	}
+
+	var order []string
+	descriptions := map[string]string{}
+	declared, _ := doc["tags"].([]interface{})
+	for _, raw := range declared {
+		tag, _ := raw.(map[string]interface{})
+		name := schemaString(tag, "name")
+		_, seen := descriptions[name]
+		if _, ok := byTag[name]; ok && name != "" && !seen {
+			order = append(order, name)
+			descriptions[name] = schemaString(tag, "description")
+		}
+	}
+	var undeclared []string
+	for name := range byTag {
+		if _, ok := descriptions[name]; !ok && name != "" {
+			undeclared = append(undeclared, name)
+		}
+	}
+	sort.Strings(undeclared)
+	order = append(order, undeclared...)
+
+	groups := make([]apiDocGroup, 0, len(byTag))
+	for _, name := range order {
+		groups = append(groups, apiDocGroup{
+			name:        name,
+			description: descriptions[name],
+			anchor:      anchor("tag-" + docSlug(name)),
+			endpoints:   byTag[name],
+		})
+	}
+	if untagged := byTag[""]; len(untagged) > 0 {
+		groups = append(groups, apiDocGroup{name: "Other", anchor: anchor("tag-other"), endpoints: untagged})
+	}
+	return groups
+}
+
+// preferredMedia picks the media type documented for a body: JSON when
+// offered, otherwise the first media type alphabetically
+func preferredMedia(content map[string]ComponentMedia) (string, ComponentMedia, bool) {
+	if len(content) == 0 {
+		return "", ComponentMedia{}, false
+	}
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+	for _, mediaType := range mediaTypes {
+		if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+			return mediaType, content[mediaType], true
+		}
+	}
+	return mediaTypes[0], content[mediaTypes[0]], true
+}
+
+// mediaExample returns the example for a media type: its own example, the
+// value of its first named example, or the example of the component schema
+// it references
+func (d *apiDocument) mediaExample(media ComponentMedia) interface{} {
+	if media.Example != nil {
+		return media.Example
+	}
+	for _, name := range sortedKeys(media.Examples) {
+		if example, ok := media.Examples[name].(map[string]interface{}); ok && example["value"] != nil {
+			return example["value"]
+		}
+	}
+	schema, _ := media.Schema.(map[string]interface{})
+	if schema["example"] != nil {
+		return schema["example"]
+	}
+	if ref, ok := schema["$ref"].(string); ok && strings.HasPrefix(ref, "#/components/schemas/") {
+		return d.examples[refName(ref)]
+	}
+	return nil
+}
+
+// mediaTypeName names the type of a media type's schema
+func mediaTypeName(media ComponentMedia) string {
+	schema, _ := media.Schema.(map[string]interface{})
+	return schemaTypeName(schema)
+}
+
+// formatExample renders an example as indented JSON
+func formatExample(example interface{}) string {
+	encoded, err := json.MarshalIndent(example, "", "  ")
+	if err != nil {
+		return fmt.Sprint(example)
+	}
+	return string(encoded)
+}
+
+// constraintNotes describes a property's default and validation keywords
+func constraintNotes(prop ComponentProperty) []string {
+	var notes []string
+	c := prop.Constraints
+	if c.MinLength != nil {
+		notes = append(notes, fmt.Sprintf("min length %d", *c.MinLength))
+	}
+	if c.MaxLength != nil {
+		notes = append(notes, fmt.Sprintf("max length %d", *c.MaxLength))
+	}
+	if c.Minimum != nil {
+		notes = append(notes, fmt.Sprintf("minimum %v", *c.Minimum))
+	}
+	if c.Maximum != nil {
+		notes = append(notes, fmt.Sprintf("maximum %v", *c.Maximum))
+	}
+	if c.Pattern != "" {
+		notes = append(notes, "pattern `"+c.Pattern+"`")
+	}
+	if len(c.Enum) > 0 {
+		notes = append(notes, "one of `"+strings.Join(c.Enum, "`, `")+"`")
+	}
+	if prop.Default != nil {
+		encoded, _ := json.Marshal(prop.Default)
+		notes = append(notes, "default `"+string(encoded)+"`")
+	}
+	return notes
+}
+
+// propertyDescription joins a property's description with its constraint notes
+func propertyDescription(prop ComponentProperty) string {
+	notes := constraintNotes(prop)
+	if len(notes) == 0 {
+		return prop.Description
+	}
+	suffix := strings.ToUpper(notes[0][:1]) + notes[0][1:]
+	if len(notes) > 1 {
+		suffix += "; " + strings.Join(notes[1:], "; ")
+	}
+	return strings.TrimSpace(prop.Description + " " + suffix + ".")
+}
+
+// securityLabel describes an authentication requirement
+func securityLabel(security ComponentSecurity) string {
+	label := "`" + security.Name + "` (" + security.Type + ")"
+	if len(security.Scopes) > 0 {
+		label += " with scopes `" + strings.Join(security.Scopes, "`, `") + "`"
+	}
+	return label
+}
+
+func sortedHeaderNames(headers map[string]ComponentHeader) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// docSlug turns text into a lowercase anchor of letters, digits and dashes
+func docSlug(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	if b.Len() == 0 {
+		return "section"
+	}
+	return b.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// markdown renders the document as CommonMark with GitHub-style tables.
+// Explicit anchors precede headings so links do not depend on how a
+// particular viewer derives heading IDs.
+func (d *apiDocument) markdown() string {
+	var b strings.Builder
+	level := "###"
+	if d.options.GroupByTags {
+		level = "####"
+	}
+
+	b.WriteString("# " + d.title + "\n\n")
+	if d.version != "" {
+		b.WriteString("**Version:** " + d.version + "\n\n")
+	}
+	if d.description != "" {
+		b.WriteString(strings.TrimSpace(d.description) + "\n\n")
+	}
+	if contact := d.markdownContact(); contact != "" {
+		b.WriteString("**Contact:** " + contact + "\n\n")
+	}
+	if d.license.Name != "" {
+		b.WriteString("**License:** " + markdownLink(d.license.Name, d.license.URL) + "\n\n")
+	}
+
+	if d.options.IncludeTOC {
+		b.WriteString("## Contents\n\n")
+		if len(d.groups) > 0 {
+			b.WriteString("- [Endpoints](#endpoints)\n")
+			for _, group := range d.groups {
+				indent := "  "
+				if d.options.GroupByTags {
+					b.WriteString("  - [" + group.name + "](#" + group.anchor + ")\n")
+					indent = "    "
+				}
+				for _, endpoint := range group.endpoints {
+					b.WriteString(indent + "- [" + endpoint.Method + " " + endpoint.Path + "](#" + endpoint.anchor + ")")
+					if endpoint.Summary != "" {
+						b.WriteString(" — " + endpoint.Summary)
+					}
+					b.WriteString("\n")
+				}
+			}
+		}
+		if len(d.models) > 0 {
+			b.WriteString("- [Models](#models)\n")
+			for _, model := range d.models {
+				b.WriteString("  - [" + model.Name + "](#" + d.anchors[model.Name] + ")\n")
+			}
+		}
+		if len(d.enums) > 0 {
+			b.WriteString("- [Enums](#enums)\n")
+			for _, enum := range d.enums {
+				b.WriteString("  - [" + enum.Name + "](#" + d.anchors[enum.Name] + ")\n")
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(d.groups) > 0 {
+		b.WriteString("<a id=\"endpoints\"></a>\n\n## Endpoints\n\n")
+	}
+	for _, group := range d.groups {
+		if d.options.GroupByTags {
+			b.WriteString("<a id=\"" + group.anchor + "\"></a>\n\n### " + group.name + "\n\n")
+			if group.description != "" {
+				b.WriteString(strings.TrimSpace(group.description) + "\n\n")
+			}
+		}
+		for _, endpoint := range group.endpoints {
+			d.markdownEndpoint(&b, level, endpoint)
+		}
+	}
+
+	if len(d.models) > 0 {
+		b.WriteString("<a id=\"models\"></a>\n\n## Models\n\n")
+		for _, model := range d.models {
+			d.markdownModel(&b, model)
+		}
+	}
+	if len(d.enums) > 0 {
+		b.WriteString("<a id=\"enums\"></a>\n\n## Enums\n\n")
+		for _, enum := range d.enums {
+			b.WriteString("<a id=\"" + d.anchors[enum.Name] + "\"></a>\n\n### " + enum.Name + "\n\n")
+			if enum.Description != "" {
+				b.WriteString(strings.TrimSpace(enum.Description) + "\n\n")
+			}
+			for _, value := range enum.Values {
+				b.WriteString("- `" + value + "`\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func (d *apiDocument) markdownEndpoint(b *strings.Builder, level string, endpoint apiDocEndpoint) {
+	b.WriteString("<a id=\"" + endpoint.anchor + "\"></a>\n\n")
+	b.WriteString(level + " " + endpoint.Method + " " + endpoint.Path + "\n\n")
+	if endpoint.deprecated {
+		b.WriteString("> **Deprecated:** this operation may be removed in a future version.\n\n")
+	}
+	if endpoint.Summary != "" {
+		b.WriteString(endpoint.Summary + "\n\n")
+	}
+	if endpoint.Description != "" {
+		b.WriteString(strings.TrimSpace(endpoint.Description) + "\n\n")
+	}
+	if len(endpoint.Security) > 0 {
+		labels := make([]string, len(endpoint.Security))
+		for i, security := range endpoint.Security {
+			labels[i] = securityLabel(security)
+		}
+		b.WriteString("**Authentication:** " + strings.Join(labels, ", ") + "\n\n")
+	}
+
+	if len(endpoint.Parameters) > 0 {
+		b.WriteString("**Parameters**\n\n| Name | In | Type | Required | Description |\n| --- | --- | --- | --- | --- |\n")
+		for _, param := range endpoint.Parameters {
+			fmt.Fprintf(b, "| `%s` | %s | %s | %s | %s |\n",
+				param.Name, param.In, d.markdownType(param.Type), yesNo(param.Required), markdownCell(param.Description))
+		}
+		b.WriteString("\n")
+	}
+
+	if body := endpoint.RequestBody; body != nil {
+		b.WriteString("**Request body**")
+		if body.Required {
+			b.WriteString(" (required)")
+		}
+		b.WriteString("\n\n")
+		if body.Description != "" {
+			b.WriteString(strings.TrimSpace(body.Description) + "\n\n")
+		}
+		for _, name := range sortedMediaTypes(body.Content) {
+			b.WriteString("- `" + name + "`: " + d.markdownType(mediaTypeName(body.Content[name])) + "\n")
+		}
+		b.WriteString("\n")
+		if d.options.IncludeExamples {
+			if _, media, ok := preferredMedia(body.Content); ok {
+				if example := d.mediaExample(media); example != nil {
+					b.WriteString("Example request:\n\n" + markdownCode(example))
+				}
+			}
+		}
+	}
+
+	if len(endpoint.Responses) > 0 {
+		b.WriteString("**Responses**\n\n| Status | Description | Content |\n| --- | --- | --- |\n")
+		for _, response := range endpoint.Responses {
+			var content []string
+			for _, name := range sortedMediaTypes(response.Content) {
+				content = append(content, "`"+name+"`: "+d.markdownType(mediaTypeName(response.Content[name])))
+			}
+			fmt.Fprintf(b, "| %s | %s | %s |\n", response.Code, markdownCell(response.Description), strings.Join(content, ", "))
+		}
+		b.WriteString("\n")
+		for _, response := range endpoint.Responses {
+			if d.options.IncludeHeaders && len(response.Headers) > 0 {
+				b.WriteString("Headers for `" + response.Code + "`:\n\n| Header | Type | Required | Description |\n| --- | --- | --- | --- |\n")
+				for _, name := range sortedHeaderNames(response.Headers) {
+					header := response.Headers[name]
+					fmt.Fprintf(b, "| `%s` | %s | %s | %s |\n", name, d.markdownType(header.Type), yesNo(header.Required), markdownCell(header.Description))
+				}
+				b.WriteString("\n")
+			}
+			if d.options.IncludeExamples {
+				if _, media, ok := preferredMedia(response.Content); ok {
+					if example := d.mediaExample(media); example != nil {
+						b.WriteString("Example `" + response.Code + "` response:\n\n" + markdownCode(example))
+					}
+				}
+			}
+		}
+	}
+}
+
+func (d *apiDocument) markdownModel(b *strings.Builder, model ComponentModel) {
+	b.WriteString("<a id=\"" + d.anchors[model.Name] + "\"></a>\n\n### " + model.Name + "\n\n")
+	if deprecated, _ := model.Metadata["deprecated"].(bool); deprecated {
+		b.WriteString("> **Deprecated**\n\n")
+	}
+	if model.Description != "" {
+		b.WriteString(strings.TrimSpace(model.Description) + "\n\n")
+	}
+	if keyword, ok := model.Metadata["composition"].(string); ok {
+		b.WriteString("Composed with `" + keyword + "`.\n\n")
+	}
+	if len(model.Properties) > 0 {
+		b.WriteString("| Property | Type | Required | Description |\n| --- | --- | --- | --- |\n")
+		for _, prop := range model.Properties {
+			fmt.Fprintf(b, "| `%s` | %s | %s | %s |\n",
+				prop.Name, d.markdownType(propertyType(prop)), yesNo(prop.Required), markdownCell(propertyDescription(prop)))
+		}
+		b.WriteString("\n")
+	} else if model.Type != "object" {
+		b.WriteString("**Type:** " + d.markdownType(model.Type) + "\n\n")
+	}
+	if d.options.IncludeExamples && model.Example != nil {
+		b.WriteString("Example:\n\n" + markdownCode(model.Example))
+	}
+}
+
+// markdownType renders a type name, linking documented models and enums and
+// spelling out arrays so element types can be linked too
+func (d *apiDocument) markdownType(name string) string {
+	if inner, ok := arrayElementType(name); ok {
+		return "array of " + d.markdownType(inner)
+	}
+	if anchor, ok := d.anchors[name]; ok {
+		return "[" + name + "](#" + anchor + ")"
+	}
+	return name
+}
+
+func (d *apiDocument) markdownContact() string {
+	var parts []string
+	if d.contact.Name != "" {
+		parts = append(parts, markdownLink(d.contact.Name, d.contact.URL))
+	} else if d.contact.URL != "" {
+		parts = append(parts, markdownLink(d.contact.URL, d.contact.URL))
+	}
+	if d.contact.Email != "" {
+		parts = append(parts, markdownLink(d.contact.Email, "mailto:"+d.contact.Email))
+	}
+	return strings.Join(parts, " · ")
+}
+
+func markdownLink(text, target string) string {
+	if target == "" || !isSafeLinkTarget(target) {
+		return text
+	}
+	return "[" + text + "](" + target + ")"
+}
+
+// markdownCell keeps text on one table row and escapes column separators
+func markdownCell(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+func markdownCode(example interface{}) string {
+	return "```json\n" + formatExample(example) + "\n```\n\n"
+}
+
+// html renders the document as a standalone HTML page. Theme adds a
+// theme-<name> class to the body; CustomCSS and Logo from the options are
+// applied after the built-in styles and above the title.
+func (d *apiDocument) html(theme string) string {
+	var b strings.Builder
+	heading := "h3"
+	if d.options.GroupByTags {
+		heading = "h4"
+	}
+
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	b.WriteString("<title>" + html.EscapeString(d.title) + "</title>\n")
+	b.WriteString("<style>\n" + documentationCSS + "</style>\n")
+	if css := strings.TrimSpace(d.options.CustomCSS); css != "" {
+		// Keep custom styles from closing the style element early
+		b.WriteString("<style>\n" + strings.ReplaceAll(css, "</", `<\/`) + "\n</style>\n")
+	}
+	b.WriteString("</head>\n")
+	if theme != "" {
+		b.WriteString("<body class=\"theme-" + docSlug(theme) + "\">\n")
+	} else {
+		b.WriteString("<body>\n")
+	}
+
+	b.WriteString("<header>\n")
+	if d.options.Logo != "" && isSafeLinkTarget(d.options.Logo) {
+		b.WriteString("<img class=\"logo\" src=\"" + html.EscapeString(d.options.Logo) + "\" alt=\"\">\n")
+	}
+	b.WriteString("<h1>" + html.EscapeString(d.title) + "</h1>\n")
+	if d.version != "" {
+		b.WriteString("<p class=\"version\">Version " + html.EscapeString(d.version) + "</p>\n")
+	}
+	if d.description != "" {
+		b.WriteString(renderMarkdown(d.description))
+	}
+	if contact := d.markdownContact(); contact != "" {
+		b.WriteString("<p class=\"contact\">Contact: " + renderInlineMarkdown(contact) + "</p>\n")
+	}
+	if d.license.Name != "" {
+		b.WriteString("<p class=\"license\">License: " + renderInlineMarkdown(markdownLink(d.license.Name, d.license.URL)) + "</p>\n")
+	}
+	b.WriteString("</header>\n")
+
+	if d.options.IncludeTOC {
+		b.WriteString("<nav class=\"toc\">\n<h2>Contents</h2>\n<ul>\n")
+		if len(d.groups) > 0 {
+			b.WriteString("<li><a href=\"#endpoints\">Endpoints</a>\n<ul>\n")
+			for _, group := range d.groups {
+				if d.options.GroupByTags {
+					b.WriteString("<li><a href=\"#" + group.anchor + "\">" + html.EscapeString(group.name) + "</a>\n<ul>\n")
+				}
+				for _, endpoint := range group.endpoints {
+					b.WriteString("<li><a href=\"#" + endpoint.anchor + "\">" + endpoint.Method + " " + html.EscapeString(endpoint.Path) + "</a>")
+					if endpoint.Summary != "" {
+						b.WriteString(" — " + html.EscapeString(endpoint.Summary))
+					}
+					b.WriteString("</li>\n")
+				}
+				if d.options.GroupByTags {
+					b.WriteString("</ul>\n</li>\n")
+				}
+			}
+			b.WriteString("</ul>\n</li>\n")
+		}
+		for _, section := range []struct {
+			title, anchor string
+			names         []string
+		}{
+			{"Models", "models", modelNames(d.models)},
+			{"Enums", "enums", enumNames(d.enums)},
+		} {
+			if len(section.names) == 0 {
+				continue
+			}
+			b.WriteString("<li><a href=\"#" + section.anchor + "\">" + section.title + "</a>\n<ul>\n")
+			for _, name := range section.names {
+				b.WriteString("<li><a href=\"#" + d.anchors[name] + "\">" + html.EscapeString(name) + "</a></li>\n")
+			}
+			b.WriteString("</ul>\n</li>\n")
+		}
+		b.WriteString("</ul>\n</nav>\n")
+	}
+
+	b.WriteString("<main>\n")
+	if len(d.groups) > 0 {
+		b.WriteString("<section id=\"endpoints\">\n<h2>Endpoints</h2>\n")
+		for _, group := range d.groups {
+			if d.options.GroupByTags {
+				b.WriteString("<section class=\"tag\" id=\"" + group.anchor + "\">\n<h3>" + html.EscapeString(group.name) + "</h3>\n")
+				if group.description != "" {
+					b.WriteString(renderMarkdown(group.description))
+				}
+			}
+			for _, endpoint := range group.endpoints {
+				d.htmlEndpoint(&b, heading, endpoint)
+			}
+			if d.options.GroupByTags {
+				b.WriteString("</section>\n")
+			}
+		}
+		b.WriteString("</section>\n")
+	}
+
+	if len(d.models) > 0 {
+		b.WriteString("<section id=\"models\">\n<h2>Models</h2>\n")
+		for _, model := range d.models {
+			d.htmlModel(&b, model)
+		}
+		b.WriteString("</section>\n")
+	}
+	if len(d.enums) > 0 {
+		b.WriteString("<section id=\"enums\">\n<h2>Enums</h2>\n")
+		for _, enum := range d.enums {
+			b.WriteString("<article class=\"enum\" id=\"" + d.anchors[enum.Name] + "\">\n<h3>" + html.EscapeString(enum.Name) + "</h3>\n")
+			if enum.Description != "" {
+				b.WriteString(renderMarkdown(enum.Description))
+			}
+			b.WriteString("<ul>\n")
+			for _, value := range enum.Values {
+				b.WriteString("<li><code>" + html.EscapeString(value) + "</code></li>\n")
+			}
+			b.WriteString("</ul>\n</article>\n")
+		}
+		b.WriteString("</section>\n")
+	}
+	b.WriteString("</main>\n</body>\n</html>\n")
+	return b.String()
+}
+
+func (d *apiDocument) htmlEndpoint(b *strings.Builder, heading string, endpoint apiDocEndpoint) {
+	class := "endpoint"
+	if endpoint.deprecated {
+		class += " deprecated"
+	}
+	b.WriteString("<article class=\"" + class + "\" id=\"" + endpoint.anchor + "\">\n")
+	fmt.Fprintf(b, "<%s><span class=\"method method-%s\">%s</span> <code>%s</code></%s>\n",
+		heading, strings.ToLower(endpoint.Method), endpoint.Method, html.EscapeString(endpoint.Path), heading)
+	if endpoint.deprecated {
+		b.WriteString("<p class=\"deprecation\"><strong>Deprecated:</strong> this operation may be removed in a future version.</p>\n")
+	}
+	if endpoint.Summary != "" {
+		b.WriteString("<p class=\"summary\">" + html.EscapeString(endpoint.Summary) + "</p>\n")
+	}
+	if endpoint.Description != "" {
+		b.WriteString(renderMarkdown(endpoint.Description))
+	}
+	if len(endpoint.Security) > 0 {
+		labels := make([]string, len(endpoint.Security))
+		for i, security := range endpoint.Security {
+			labels[i] = renderInlineMarkdown(securityLabel(security))
+		}
+		b.WriteString("<p class=\"security\"><strong>Authentication:</strong> " + strings.Join(labels, ", ") + "</p>\n")
+	}
+
+	if len(endpoint.Parameters) > 0 {
+		b.WriteString("<h5>Parameters</h5>\n<table>\n<thead><tr><th>Name</th><th>In</th><th>Type</th><th>Required</th><th>Description</th></tr></thead>\n<tbody>\n")
+		for _, param := range endpoint.Parameters {
+			fmt.Fprintf(b, "<tr><td><code>%s</code></td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(param.Name), html.EscapeString(param.In), d.htmlType(param.Type), yesNo(param.Required), renderInlineMarkdown(param.Description))
+		}
+		b.WriteString("</tbody>\n</table>\n")
+	}
+
+	if body := endpoint.RequestBody; body != nil {
+		b.WriteString("<h5>Request body")
+		if body.Required {
+			b.WriteString(" <span class=\"required\">(required)</span>")
+		}
+		b.WriteString("</h5>\n")
+		if body.Description != "" {
+			b.WriteString(renderMarkdown(body.Description))
+		}
+		b.WriteString("<ul class=\"media\">\n")
+		for _, name := range sortedMediaTypes(body.Content) {
+			b.WriteString("<li><code>" + html.EscapeString(name) + "</code>: " + d.htmlType(mediaTypeName(body.Content[name])) + "</li>\n")
+		}
+		b.WriteString("</ul>\n")
+		if d.options.IncludeExamples {
+			if _, media, ok := preferredMedia(body.Content); ok {
+				if example := d.mediaExample(media); example != nil {
+					b.WriteString("<p>Example request:</p>\n" + htmlCode(example))
+				}
+			}
+		}
+	}
+
+	if len(endpoint.Responses) > 0 {
+		b.WriteString("<h5>Responses</h5>\n<table>\n<thead><tr><th>Status</th><th>Description</th><th>Content</th></tr></thead>\n<tbody>\n")
+		for _, response := range endpoint.Responses {
+			var content []string
+			for _, name := range sortedMediaTypes(response.Content) {
+				content = append(content, "<code>"+html.EscapeString(name)+"</code>: "+d.htmlType(mediaTypeName(response.Content[name])))
+			}
+			fmt.Fprintf(b, "<tr><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(response.Code), renderInlineMarkdown(response.Description), strings.Join(content, ", "))
+		}
+		b.WriteString("</tbody>\n</table>\n")
+		for _, response := range endpoint.Responses {
+			if d.options.IncludeHeaders && len(response.Headers) > 0 {
+				b.WriteString("<p>Headers for <code>" + html.EscapeString(response.Code) + "</code>:</p>\n")
+				b.WriteString("<table>\n<thead><tr><th>Header</th><th>Type</th><th>Required</th><th>Description</th></tr></thead>\n<tbody>\n")
+				for _, name := range sortedHeaderNames(response.Headers) {
+					header := response.Headers[name]
+					fmt.Fprintf(b, "<tr><td><code>%s</code></td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+						html.EscapeString(name), d.htmlType(header.Type), yesNo(header.Required), renderInlineMarkdown(header.Description))
+				}
+				b.WriteString("</tbody>\n</table>\n")
+			}
+			if d.options.IncludeExamples {
+				if _, media, ok := preferredMedia(response.Content); ok {
+					if example := d.mediaExample(media); example != nil {
+						b.WriteString("<p>Example <code>" + html.EscapeString(response.Code) + "</code> response:</p>\n" + htmlCode(example))
+					}
+				}
+			}
+		}
+	}
+	b.WriteString("</article>\n")
+}
+
+func (d *apiDocument) htmlModel(b *strings.Builder, model ComponentModel) {
+	b.WriteString("<article class=\"model\" id=\"" + d.anchors[model.Name] + "\">\n<h3>" + html.EscapeString(model.Name) + "</h3>\n")
+	if deprecated, _ := model.Metadata["deprecated"].(bool); deprecated {
+		b.WriteString("<p class=\"deprecation\"><strong>Deprecated</strong></p>\n")
+	}
+	if model.Description != "" {
+		b.WriteString(renderMarkdown(model.Description))
+	}
+	if keyword, ok := model.Metadata["composition"].(string); ok {
+		b.WriteString("<p>Composed with <code>" + html.EscapeString(keyword) + "</code>.</p>\n")
+	}
+	if len(model.Properties) > 0 {
+		b.WriteString("<table>\n<thead><tr><th>Property</th><th>Type</th><th>Required</th><th>Description</th></tr></thead>\n<tbody>\n")
+		for _, prop := range model.Properties {
+			fmt.Fprintf(b, "<tr><td><code>%s</code></td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(prop.Name), d.htmlType(propertyType(prop)), yesNo(prop.Required), renderInlineMarkdown(propertyDescription(prop)))
+		}
+		b.WriteString("</tbody>\n</table>\n")
+	} else if model.Type != "object" {
+		b.WriteString("<p><strong>Type:</strong> " + d.htmlType(model.Type) + "</p>\n")
+	}
+	if d.options.IncludeExamples && model.Example != nil {
+		b.WriteString("<p>Example:</p>\n" + htmlCode(model.Example))
+	}
+	b.WriteString("</article>\n")
+}
+
+func (d *apiDocument) htmlType(name string) string {
+	if inner, ok := arrayElementType(name); ok {
+		return "array of " + d.htmlType(inner)
+	}
+	if anchor, ok := d.anchors[name]; ok {
+		return "<a href=\"#" + anchor + "\">" + html.EscapeString(name) + "</a>"
+	}
+	return html.EscapeString(name)
+}
+
+func htmlCode(example interface{}) string {
+	return "<pre><code class=\"language-json\">" + html.EscapeString(formatExample(example)) + "</code></pre>\n"
+}
+
+// propertyType names a property's type with its format, as in "string (date-time)"
+func propertyType(prop ComponentProperty) string {
+	if prop.Format != "" {
+		return prop.Type + " (" + prop.Format + ")"
+	}
+	return prop.Type
+}
+
+// arrayElementType unwraps the array<T> names produced by schemaTypeName
+func arrayElementType(name string) (string, bool) {
+	if strings.HasPrefix(name, "array<") && strings.HasSuffix(name, ">") {
+		return name[len("array<") : len(name)-1], true
+	}
+	return "", false
+}
+
+func sortedMediaTypes(content map[string]ComponentMedia) []string {
+	names := make([]string, 0, len(content))
+	for name := range content {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func modelNames(models []ComponentModel) []string {
+	names := make([]string, len(models))
+	for i, model := range models {
+		names[i] = model.Name
+	}
+	return names
+}
+
+func enumNames(enums []ComponentEnum) []string {
+	names := make([]string, len(enums))
+	for i, enum := range enums {
+		names[i] = enum.Name
+	}
+	return names
+}
+
+// documentationCSS is the built-in stylesheet for HTML documentation
+const documentationCSS = `body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; line-height: 1.5; color: #1f2328; max-width: 960px; margin: 0 auto; padding: 2rem; }
+header { border-bottom: 1px solid #d0d7de; margin-bottom: 2rem; }
+.logo { max-height: 48px; }
+.version { color: #59636e; }
+nav.toc ul { list-style: none; padding-left: 1rem; }
+article { border: 1px solid #d0d7de; border-radius: 6px; padding: 1rem 1.5rem; margin: 1.5rem 0; }
+article.deprecated { opacity: 0.75; }
+.deprecation { color: #9a6700; }
+.method { display: inline-block; min-width: 4rem; padding: 0 0.5rem; border-radius: 4px; color: #fff; background: #59636e; text-align: center; font-size: 0.85em; }
+.method-get { background: #0969da; }
+.method-post { background: #1a7f37; }
+.method-put, .method-patch { background: #9a6700; }
+.method-delete { background: #cf222e; }
+table { border-collapse: collapse; width: 100%; margin: 0.5rem 0 1rem; }
+th, td { border: 1px solid #d0d7de; padding: 0.35rem 0.6rem; text-align: left; vertical-align: top; }
+pre { background: #f6f8fa; border-radius: 6px; padding: 1rem; overflow-x: auto; }
+code { font-family: ui-monospace, SFMono-Regular, Menlo, monospace; font-size: 0.9em; }
+`
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// FormatValidator implements SchemaValidator by dispatching each call to the
+// validator registered for the schema's format. Content in a format without
+// a validator is accepted with a warning that it was not validated.
+type FormatValidator struct {
+	validators map[SchemaFormat]SchemaValidator
+}
+
+// NewFormatValidator creates a FormatValidator over validators, keyed by the
+// format each one handles
+func NewFormatValidator(validators map[SchemaFormat]SchemaValidator) *FormatValidator {
+	return &FormatValidator{validators: validators}
+}
+
+// NewDefaultFormatValidator creates a FormatValidator for every format with
+// a built-in validator: OpenAPI, GraphQL and JSON Schema. Refs to other JSON
+// Schema documents are not fetched.
+func NewDefaultFormatValidator(logger *slog.Logger) *FormatValidator {
+	return NewFormatValidator(map[SchemaFormat]SchemaValidator{
+		SchemaFormatOpenAPI:    NewOpenAPIValidator(logger),
+		SchemaFormatGraphQL:    NewGraphQLValidator(logger),
+		SchemaFormatJSONSchema: NewJSONSchemaValidator(nil, logger),
+	})
+}
+
+// ValidateSchema validates content with the validator for format
+func (v *FormatValidator) ValidateSchema(ctx context.Context, schemaContent string, format SchemaFormat) (*ValidationResult, error) {
+	if validator, ok := v.validators[format]; ok {
+		return validator.ValidateSchema(ctx, schemaContent, format)
+	}
+	result := &ValidationResult{
+		IsValid: true,
+		Errors:  []SchemaValidationError{},
+		Warnings: []SchemaValidationWarning{{
+			Code:    "VALIDATION_UNAVAILABLE",
+			Message: fmt.Sprintf("content validation is not available for %s schemas", format),
+		}},
+		Metrics:     ValidationMetrics{TotalLines: countLines(schemaContent)},
+		ValidatedAt: time.Now(),
+	}
+	result.Metrics.QualityScore = qualityScore(result)
+	return result, nil
+}
+
+// ValidateCompatibility checks compatibility with the validator for format
+func (v *FormatValidator) ValidateCompatibility(ctx context.Context, oldSchema, newSchema string, format SchemaFormat) (*CompatibilityResult, error) {
+	if validator, ok := v.validators[format]; ok {
+		return validator.ValidateCompatibility(ctx, oldSchema, newSchema, format)
+	}
+	return nil, fmt.Errorf("%w: compatibility checking for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// ValidateAgainstContract validates a schema with the validator for format
+func (v *FormatValidator) ValidateAgainstContract(ctx context.Context, schema, contract string, format SchemaFormat) (*ContractValidationResult, error) {
+	if validator, ok := v.validators[format]; ok {
+		return validator.ValidateAgainstContract(ctx, schema, contract, format)
+	}
+	return nil, fmt.Errorf("%w: contract validation for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// GetValidationRules returns the rules of the validator for format
+func (v *FormatValidator) GetValidationRules(ctx context.Context, format SchemaFormat) ([]*ValidationRule, error) {
+	if validator, ok := v.validators[format]; ok {
+		return validator.GetValidationRules(ctx, format)
+	}
+	return []*ValidationRule{}, nil
+}
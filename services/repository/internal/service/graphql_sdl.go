@@ -0,0 +1,558 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sdlPos is the 1-based line and column of a token in GraphQL SDL
+type sdlPos struct {
+	line   int
+	column int
+}
+
+// sdlSyntaxError is a GraphQL SDL parse failure at a position
+type sdlSyntaxError struct {
+	pos     sdlPos
+	message string
+}
+
+func (e *sdlSyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.pos.line, e.pos.column, e.message)
+}
+
+// GraphQL type system definition kinds, as spelled by their keyword
+const (
+	sdlKindSchema    = "schema"
+	sdlKindScalar    = "scalar"
+	sdlKindObject    = "type"
+	sdlKindInterface = "interface"
+	sdlKindUnion     = "union"
+	sdlKindEnum      = "enum"
+	sdlKindInput     = "input"
+	sdlKindDirective = "directive"
+)
+
+// sdlDefinition is a type system definition or extension
+type sdlDefinition struct {
+	kind        string
+	extend      bool
+	name        string // empty for schema definitions
+	pos         sdlPos
+	description string
+	interfaces  []sdlName
+	directives  []sdlName
+	fields      []*sdlField // fields, input fields, directive arguments or root operation types
+	values      []sdlName   // enum values
+	members     []sdlName   // union members
+	locations   []sdlName   // directive locations
+}
+
+// sdlField is a field, argument, input field or root operation type
+type sdlField struct {
+	name        string
+	pos         sdlPos
+	description string
+	typ         *sdlType
+	args        []*sdlField
+	directives  []sdlName
+}
+
+// sdlType is a reference to a named, list or non-null type
+type sdlType struct {
+	name    string // empty for list types
+	elem    *sdlType
+	nonNull bool
+	pos     sdlPos
+}
+
+// namedType returns the named type at the core of a list or non-null type
+func (t *sdlType) namedType() *sdlType {
+	for t.elem != nil {
+		t = t.elem
+	}
+	return t
+}
+
+// sdlName is a name and where it appears
+type sdlName struct {
+	name string
+	pos  sdlPos
+}
+
+type sdlTokenKind int
+
+const (
+	sdlTokenEOF sdlTokenKind = iota
+	sdlTokenPunct
+	sdlTokenName
+	sdlTokenInt
+	sdlTokenFloat
+	sdlTokenString
+)
+
+type sdlToken struct {
+	kind  sdlTokenKind
+	value string
+	pos   sdlPos
+}
+
+// sdlLexer splits GraphQL source into tokens. Whitespace, commas and
+// comments are insignificant and skipped.
+type sdlLexer struct {
+	src    []rune
+	offset int
+	line   int
+	column int
+}
+
+func (l *sdlLexer) next() sdlToken {
+	l.skipIgnored()
+	pos := sdlPos{line: l.line, column: l.column}
+	if l.offset >= len(l.src) {
+		return sdlToken{kind: sdlTokenEOF, pos: pos}
+	}
+
+	r := l.src[l.offset]
+	switch {
+	case strings.ContainsRune("!$&()=:@[]{}|", r):
+		l.advance(1)
+		return sdlToken{kind: sdlTokenPunct, value: string(r), pos: pos}
+	case r == '.':
+		if l.peekString("...") {
+			l.advance(3)
+			return sdlToken{kind: sdlTokenPunct, value: "...", pos: pos}
+		}
+	case r == '"':
+		return l.string(pos)
+	case r == '_' || isASCIILetter(r):
+		start := l.offset
+		for l.offset < len(l.src) && (l.src[l.offset] == '_' || isASCIILetter(l.src[l.offset]) || isASCIIDigit(l.src[l.offset])) {
+			l.advance(1)
+		}
+		return sdlToken{kind: sdlTokenName, value: string(l.src[start:l.offset]), pos: pos}
+	case r == '-' || isASCIIDigit(r):
+		return l.number(pos)
+	}
+	panic(&sdlSyntaxError{pos: pos, message: fmt.Sprintf("unexpected character %q", r)})
+}
+
+func (l *sdlLexer) skipIgnored() {
+	for l.offset < len(l.src) {
+		switch r := l.src[l.offset]; {
+		case r == ' ', r == '\t', r == '\n', r == '\r', r == ',', r == '\uFEFF':
+			l.advance(1)
+		case r == '#':
+			for l.offset < len(l.src) && l.src[l.offset] != '\n' && l.src[l.offset] != '\r' {
+				l.advance(1)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *sdlLexer) number(pos sdlPos) sdlToken {
+	start := l.offset
+	kind := sdlTokenInt
+	if l.src[l.offset] == '-' {
+		l.advance(1)
+	}
+	if !l.digits() {
+		panic(&sdlSyntaxError{pos: pos, message: "invalid number"})
+	}
+	if l.offset < len(l.src) && l.src[l.offset] == '.' {
+		kind = sdlTokenFloat
+		l.advance(1)
+		if !l.digits() {
+			panic(&sdlSyntaxError{pos: pos, message: "invalid number"})
+		}
+	}
+	if l.offset < len(l.src) && (l.src[l.offset] == 'e' || l.src[l.offset] == 'E') {
+		kind = sdlTokenFloat
+		l.advance(1)
+		if l.offset < len(l.src) && (l.src[l.offset] == '+' || l.src[l.offset] == '-') {
+			l.advance(1)
+		}
+		if !l.digits() {
+			panic(&sdlSyntaxError{pos: pos, message: "invalid number"})
+		}
+	}
+	return sdlToken{kind: kind, value: string(l.src[start:l.offset]), pos: pos}
+}
+
+func (l *sdlLexer) digits() bool {
+	start := l.offset
+	for l.offset < len(l.src) && isASCIIDigit(l.src[l.offset]) {
+		l.advance(1)
+	}
+	return l.offset > start
+}
+
+// string reads a quoted or block string. Escapes are kept as written; only
+// descriptions use string values and they are not interpreted.
+func (l *sdlLexer) string(pos sdlPos) sdlToken {
+	if l.peekString(`"""`) {
+		l.advance(3)
+		start := l.offset
+		for l.offset < len(l.src) {
+			if l.peekString(`\"""`) {
+				l.advance(4)
+				continue
+			}
+			if l.peekString(`"""`) {
+				value := string(l.src[start:l.offset])
+				l.advance(3)
+				return sdlToken{kind: sdlTokenString, value: strings.TrimSpace(value), pos: pos}
+			}
+			l.advance(1)
+		}
+		panic(&sdlSyntaxError{pos: pos, message: "unterminated block string"})
+	}
+
+	l.advance(1)
+	start := l.offset
+	for l.offset < len(l.src) {
+		switch l.src[l.offset] {
+		case '\\':
+			l.advance(2)
+		case '"':
+			value := string(l.src[start:l.offset])
+			l.advance(1)
+			return sdlToken{kind: sdlTokenString, value: value, pos: pos}
+		case '\n', '\r':
+			panic(&sdlSyntaxError{pos: pos, message: "unterminated string"})
+		default:
+			l.advance(1)
+		}
+	}
+	panic(&sdlSyntaxError{pos: pos, message: "unterminated string"})
+}
+
+func (l *sdlLexer) peekString(s string) bool {
+	return strings.HasPrefix(string(l.src[l.offset:min(l.offset+len(s), len(l.src))]), s)
+}
+
+// advance moves n runes forward, tracking the line and column
+func (l *sdlLexer) advance(n int) {
+	for ; n > 0 && l.offset < len(l.src); n-- {
+		r := l.src[l.offset]
+		l.offset++
+		switch {
+		case r == '\n', r == '\r' && (l.offset >= len(l.src) || l.src[l.offset] != '\n'):
+			l.line++
+			l.column = 1
+		case r == '\r':
+			// The following \n ends the line
+		default:
+			l.column++
+		}
+	}
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// sdlParser is a recursive descent parser for GraphQL type system
+// documents. Syntax errors are raised as *sdlSyntaxError panics and
+// recovered by parseGraphQLSDL.
+type sdlParser struct {
+	lexer *sdlLexer
+	tok   sdlToken
+}
+
+// parseGraphQLSDL parses a GraphQL schema document into its definitions
+func parseGraphQLSDL(content string) (defs []*sdlDefinition, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			syntaxErr, ok := r.(*sdlSyntaxError)
+			if !ok {
+				panic(r)
+			}
+			defs, err = nil, syntaxErr
+		}
+	}()
+
+	p := &sdlParser{lexer: &sdlLexer{src: []rune(content), line: 1, column: 1}}
+	p.tok = p.lexer.next()
+	if p.tok.kind == sdlTokenEOF {
+		return nil, &sdlSyntaxError{pos: p.tok.pos, message: "document is empty"}
+	}
+	for p.tok.kind != sdlTokenEOF {
+		defs = append(defs, p.definition())
+	}
+	return defs, nil
+}
+
+func (p *sdlParser) advance() sdlToken {
+	tok := p.tok
+	p.tok = p.lexer.next()
+	return tok
+}
+
+func (p *sdlParser) peek(value string) bool {
+	return p.tok.kind == sdlTokenPunct && p.tok.value == value
+}
+
+func (p *sdlParser) peekKeyword(keyword string) bool {
+	return p.tok.kind == sdlTokenName && p.tok.value == keyword
+}
+
+func (p *sdlParser) skip(value string) bool {
+	if p.peek(value) {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+func (p *sdlParser) expect(value string) {
+	if !p.skip(value) {
+		p.unexpected(fmt.Sprintf("%q", value))
+	}
+}
+
+func (p *sdlParser) expectKeyword(keyword string) {
+	if !p.peekKeyword(keyword) {
+		p.unexpected(fmt.Sprintf("%q", keyword))
+	}
+	p.advance()
+}
+
+func (p *sdlParser) name() sdlName {
+	if p.tok.kind != sdlTokenName {
+		p.unexpected("a name")
+	}
+	tok := p.advance()
+	return sdlName{name: tok.value, pos: tok.pos}
+}
+
+func (p *sdlParser) unexpected(expected string) {
+	found := "end of document"
+	if p.tok.kind != sdlTokenEOF {
+		found = fmt.Sprintf("%q", p.tok.value)
+		if p.tok.kind == sdlTokenString {
+			found = "string"
+		}
+	}
+	panic(&sdlSyntaxError{pos: p.tok.pos, message: fmt.Sprintf("expected %s, found %s", expected, found)})
+}
+
+func (p *sdlParser) description() string {
+	if p.tok.kind == sdlTokenString {
+		return p.advance().value
+	}
+	return ""
+}
+
+func (p *sdlParser) definition() *sdlDefinition {
+	description := p.description()
+	start := p.tok.pos
+	def := &sdlDefinition{description: description, pos: start}
+	if p.peekKeyword("extend") {
+		if description != "" {
+			p.unexpected("a definition after the description")
+		}
+		p.advance()
+		def.extend = true
+	}
+
+	if p.tok.kind != sdlTokenName {
+		if p.peek("{") {
+			panic(&sdlSyntaxError{pos: start, message: "operations are not allowed in a schema document"})
+		}
+		p.unexpected("a definition")
+	}
+	def.kind = p.tok.value
+	switch def.kind {
+	case sdlKindSchema:
+		p.advance()
+		def.directives = p.directives()
+		if p.skip("{") {
+			for !p.skip("}") {
+				operation := p.name()
+				p.expect(":")
+				def.fields = append(def.fields, &sdlField{name: operation.name, pos: operation.pos, typ: p.namedTypeRef()})
+			}
+		} else if !def.extend {
+			p.unexpected(`"{"`)
+		}
+	case sdlKindScalar:
+		p.advance()
+		p.named(def)
+		def.directives = p.directives()
+	case sdlKindObject, sdlKindInterface:
+		p.advance()
+		p.named(def)
+		if p.peekKeyword("implements") {
+			p.advance()
+			p.skip("&")
+			def.interfaces = append(def.interfaces, p.name())
+			for p.skip("&") {
+				def.interfaces = append(def.interfaces, p.name())
+			}
+		}
+		def.directives = p.directives()
+		if p.skip("{") {
+			for !p.skip("}") {
+				def.fields = append(def.fields, p.fieldDefinition())
+			}
+		}
+	case sdlKindUnion:
+		p.advance()
+		p.named(def)
+		def.directives = p.directives()
+		if p.skip("=") {
+			p.skip("|")
+			def.members = append(def.members, p.name())
+			for p.skip("|") {
+				def.members = append(def.members, p.name())
+			}
+		}
+	case sdlKindEnum:
+		p.advance()
+		p.named(def)
+		def.directives = p.directives()
+		if p.skip("{") {
+			for !p.skip("}") {
+				p.description()
+				def.values = append(def.values, p.name())
+				p.directives()
+			}
+		}
+	case sdlKindInput:
+		p.advance()
+		p.named(def)
+		def.directives = p.directives()
+		if p.skip("{") {
+			for !p.skip("}") {
+				def.fields = append(def.fields, p.inputValueDefinition())
+			}
+		}
+	case sdlKindDirective:
+		if def.extend {
+			p.unexpected("a type after extend")
+		}
+		p.advance()
+		p.expect("@")
+		p.named(def)
+		def.fields = p.argumentsDefinition()
+		if p.peekKeyword("repeatable") {
+			p.advance()
+		}
+		p.expectKeyword("on")
+		p.skip("|")
+		def.locations = append(def.locations, p.name())
+		for p.skip("|") {
+			def.locations = append(def.locations, p.name())
+		}
+	case "query", "mutation", "subscription", "fragment":
+		panic(&sdlSyntaxError{pos: start, message: "operations are not allowed in a schema document"})
+	default:
+		p.unexpected("a definition")
+	}
+	return def
+}
+
+// named reads the name of a definition, which is positioned at its name
+func (p *sdlParser) named(def *sdlDefinition) {
+	name := p.name()
+	def.name = name.name
+	def.pos = name.pos
+}
+
+func (p *sdlParser) fieldDefinition() *sdlField {
+	description := p.description()
+	name := p.name()
+	field := &sdlField{name: name.name, pos: name.pos, description: description}
+	field.args = p.argumentsDefinition()
+	p.expect(":")
+	field.typ = p.typeRef()
+	field.directives = p.directives()
+	return field
+}
+
+func (p *sdlParser) argumentsDefinition() []*sdlField {
+	var args []*sdlField
+	if p.skip("(") {
+		for !p.skip(")") {
+			args = append(args, p.inputValueDefinition())
+		}
+	}
+	return args
+}
+
+func (p *sdlParser) inputValueDefinition() *sdlField {
+	description := p.description()
+	name := p.name()
+	field := &sdlField{name: name.name, pos: name.pos, description: description}
+	p.expect(":")
+	field.typ = p.typeRef()
+	if p.skip("=") {
+		p.value()
+	}
+	field.directives = p.directives()
+	return field
+}
+
+func (p *sdlParser) typeRef() *sdlType {
+	var t *sdlType
+	pos := p.tok.pos
+	if p.skip("[") {
+		t = &sdlType{elem: p.typeRef(), pos: pos}
+		p.expect("]")
+	} else {
+		t = p.namedTypeRef()
+	}
+	t.nonNull = p.skip("!")
+	return t
+}
+
+func (p *sdlParser) namedTypeRef() *sdlType {
+	name := p.name()
+	return &sdlType{name: name.name, pos: name.pos}
+}
+
+// directives reads directive applications, returning their names
+func (p *sdlParser) directives() []sdlName {
+	var names []sdlName
+	for p.peek("@") {
+		pos := p.advance().pos
+		names = append(names, sdlName{name: p.name().name, pos: pos})
+		if p.skip("(") {
+			for !p.skip(")") {
+				p.name()
+				p.expect(":")
+				p.value()
+			}
+		}
+	}
+	return names
+}
+
+// value reads and discards a constant or variable value
+func (p *sdlParser) value() {
+	switch {
+	case p.skip("$"):
+		p.name()
+	case p.skip("["):
+		for !p.skip("]") {
+			p.value()
+		}
+	case p.skip("{"):
+		for !p.skip("}") {
+			p.name()
+			p.expect(":")
+			p.value()
+		}
+	case p.tok.kind == sdlTokenName, p.tok.kind == sdlTokenInt, p.tok.kind == sdlTokenFloat, p.tok.kind == sdlTokenString:
+		p.advance()
+	default:
+		p.unexpected("a value")
+	}
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// GraphQL validation rule IDs reported in SchemaValidationError.Rule
+const (
+	GraphQLRuleSyntax        = "syntax"
+	GraphQLRuleUniqueNames   = "unique-names"
+	GraphQLRuleTypeReference = "type-references"
+	GraphQLRuleTypeSystem    = "type-system"
+	GraphQLRuleRootTypes     = "root-operation-types"
+	GraphQLRuleDirectives    = "directives"
+)
+
+// graphQLValidationRules describes the checks performed by GraphQLValidator
+var graphQLValidationRules = []*ValidationRule{
+	{ID: GraphQLRuleSyntax, Name: "Syntax", Description: "Document must be well-formed GraphQL SDL without operations", Category: "syntax", Severity: "error"},
+	{ID: GraphQLRuleUniqueNames, Name: "Unique names", Description: "Types, directives, fields, arguments and enum values must be defined once", Category: "naming", Severity: "error"},
+	{ID: GraphQLRuleTypeReference, Name: "Type references", Description: "Every referenced type must be defined", Category: "structure", Severity: "error"},
+	{ID: GraphQLRuleTypeSystem, Name: "Type system", Description: "Fields, arguments, interfaces and union members must use types of the right kind", Category: "structure", Severity: "error"},
+	{ID: GraphQLRuleRootTypes, Name: "Root operation types", Description: "A query root type must exist and root types must be object types", Category: "structure", Severity: "error"},
+	{ID: GraphQLRuleDirectives, Name: "Directives", Description: "Applied directives must be defined", Category: "structure", Severity: "error"},
+}
+
+// graphQLBuiltinScalars are the scalars every GraphQL schema has
+var graphQLBuiltinScalars = []string{"Int", "Float", "String", "Boolean", "ID"}
+
+// graphQLBuiltinDirectives are the directives every GraphQL schema has
+var graphQLBuiltinDirectives = map[string]bool{
+	"skip": true, "include": true, "deprecated": true, "specifiedBy": true, "oneOf": true,
+}
+
+// GraphQLValidator implements SchemaValidator for GraphQL schemas written in
+// SDL. It checks the document against the GraphQL type system rules and
+// reports errors with the line and column of the offending definition and
+// a schema coordinate (such as Query.pets) as the path.
+type GraphQLValidator struct {
+	logger *slog.Logger
+}
+
+// NewGraphQLValidator creates a new GraphQL validator
+func NewGraphQLValidator(logger *slog.Logger) *GraphQLValidator {
+	return &GraphQLValidator{
+		logger: logger.With("component", "graphql_validator"),
+	}
+}
+
+// ValidateSchema parses and validates schema content
+func (v *GraphQLValidator) ValidateSchema(ctx context.Context, schemaContent string, format SchemaFormat) (*ValidationResult, error) {
+	start := time.Now()
+	result := &ValidationResult{
+		Errors:   []SchemaValidationError{},
+		Warnings: []SchemaValidationWarning{},
+	}
+	result.Metrics.TotalLines = countLines(schemaContent)
+
+	if format != SchemaFormatGraphQL {
+		result.Warnings = append(result.Warnings, SchemaValidationWarning{
+			Code:    "VALIDATION_UNAVAILABLE",
+			Message: fmt.Sprintf("content validation is not available for %s schemas", format),
+		})
+	} else if defs, err := parseGraphQLSDL(schemaContent); err != nil {
+		result.Errors = append(result.Errors, graphQLSyntaxError(err))
+	} else {
+		c := &graphQLChecker{result: result, types: make(map[string]*graphQLType)}
+		c.check(defs)
+	}
+
+	result.IsValid = len(result.Errors) == 0
+	result.Metrics.QualityScore = qualityScore(result)
+	result.ValidatedAt = time.Now()
+	result.Duration = time.Since(start)
+
+	v.logger.DebugContext(ctx, "Schema validated",
+		"format", format, "valid", result.IsValid, "errors", len(result.Errors), "warnings", len(result.Warnings))
+
+	return result, nil
+}
+
+// ValidateCompatibility is not supported for GraphQL schemas
+func (v *GraphQLValidator) ValidateCompatibility(ctx context.Context, oldSchema, newSchema string, format SchemaFormat) (*CompatibilityResult, error) {
+	return nil, fmt.Errorf("%w: compatibility checking for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// ValidateAgainstContract validates a schema against a contract
+func (v *GraphQLValidator) ValidateAgainstContract(ctx context.Context, schema, contract string, format SchemaFormat) (*ContractValidationResult, error) {
+	return nil, fmt.Errorf("%w: contract validation for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// GetValidationRules returns the rules applied to the given format
+func (v *GraphQLValidator) GetValidationRules(ctx context.Context, format SchemaFormat) ([]*ValidationRule, error) {
+	if format != SchemaFormatGraphQL {
+		return []*ValidationRule{}, nil
+	}
+	rules := make([]*ValidationRule, len(graphQLValidationRules))
+	for i, rule := range graphQLValidationRules {
+		copied := *rule
+		copied.Enabled = true
+		rules[i] = &copied
+	}
+	return rules, nil
+}
+
+// graphQLSyntaxError converts a parse failure into a validation error
+func graphQLSyntaxError(err error) SchemaValidationError {
+	var syntaxErr *sdlSyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return SchemaValidationError{Code: "SYNTAX_ERROR", Message: err.Error(), Severity: "error", Rule: GraphQLRuleSyntax}
+	}
+	return SchemaValidationError{
+		Code:     "SYNTAX_ERROR",
+		Message:  syntaxErr.message,
+		Line:     syntaxErr.pos.line,
+		Column:   syntaxErr.pos.column,
+		Severity: "error",
+		Rule:     GraphQLRuleSyntax,
+	}
+}
+
+// graphQLType is a named type with its extensions merged in
+type graphQLType struct {
+	def        *sdlDefinition
+	builtin    bool
+	interfaces []sdlName
+	fields     []*sdlField
+	values     []sdlName
+	members    []sdlName
+}
+
+// graphQLChecker validates parsed SDL definitions collecting problems and metrics
+type graphQLChecker struct {
+	result     *ValidationResult
+	types      map[string]*graphQLType
+	order      []*graphQLType // user-defined types in document order
+	directives map[string]*sdlDefinition
+	schema     *sdlDefinition
+	rootFields []*sdlField // root operation types of the schema definition and its extensions
+}
+
+func (c *graphQLChecker) check(defs []*sdlDefinition) {
+	c.directives = make(map[string]*sdlDefinition)
+	for _, name := range graphQLBuiltinScalars {
+		c.types[name] = &graphQLType{def: &sdlDefinition{kind: sdlKindScalar, name: name}, builtin: true}
+	}
+
+	c.collectDefinitions(defs)
+	c.applyExtensions(defs)
+
+	for _, t := range c.order {
+		c.checkType(t)
+	}
+	for _, def := range defs {
+		if def.kind == sdlKindDirective {
+			c.checkArguments(def.fields, "@"+def.name)
+		}
+		c.checkDirectives(def.directives)
+	}
+	c.checkRootTypes()
+}
+
+// collectDefinitions registers every type and directive definition,
+// reporting names defined more than once
+func (c *graphQLChecker) collectDefinitions(defs []*sdlDefinition) {
+	for _, def := range defs {
+		if def.extend {
+			continue
+		}
+		switch def.kind {
+		case sdlKindSchema:
+			if c.schema != nil {
+				c.error("DUPLICATE_SCHEMA", fmt.Sprintf("schema is already defined at line %d", c.schema.pos.line), "schema", def.pos, GraphQLRuleUniqueNames)
+				continue
+			}
+			c.schema = def
+			c.rootFields = append(c.rootFields, def.fields...)
+		case sdlKindDirective:
+			if previous, ok := c.directives[def.name]; ok {
+				c.error("DUPLICATE_DIRECTIVE", fmt.Sprintf("directive @%s is already defined at line %d", def.name, previous.pos.line),
+					"@"+def.name, def.pos, GraphQLRuleUniqueNames)
+				continue
+			}
+			c.directives[def.name] = def
+		default:
+			if strings.HasPrefix(def.name, "__") {
+				c.error("RESERVED_NAME", fmt.Sprintf("type name %q is reserved for introspection", def.name), def.name, def.pos, GraphQLRuleTypeSystem)
+			}
+			if previous, ok := c.types[def.name]; ok {
+				message := fmt.Sprintf("type %q is already defined at line %d", def.name, previous.def.pos.line)
+				if previous.builtin {
+					message = fmt.Sprintf("type %q is a built-in scalar", def.name)
+				}
+				c.error("DUPLICATE_TYPE", message, def.name, def.pos, GraphQLRuleUniqueNames)
+				continue
+			}
+			t := &graphQLType{def: def, interfaces: def.interfaces, fields: def.fields, values: def.values, members: def.members}
+			c.types[def.name] = t
+			c.order = append(c.order, t)
+		}
+	}
+}
+
+// applyExtensions merges extensions into the types they extend
+func (c *graphQLChecker) applyExtensions(defs []*sdlDefinition) {
+	for _, def := range defs {
+		if !def.extend {
+			continue
+		}
+		if def.kind == sdlKindSchema {
+			if c.schema == nil {
+				c.error("UNDEFINED_SCHEMA", "schema extension has no schema definition to extend", "schema", def.pos, GraphQLRuleTypeReference)
+			}
+			c.rootFields = append(c.rootFields, def.fields...)
+			continue
+		}
+		t, ok := c.types[def.name]
+		switch {
+		case !ok:
+			c.error("UNDEFINED_TYPE", fmt.Sprintf("cannot extend undefined type %q", def.name), def.name, def.pos, GraphQLRuleTypeReference)
+			continue
+		case t.def.kind != def.kind:
+			c.error("INVALID_EXTENSION", fmt.Sprintf("cannot extend %s %q as %s", t.def.kind, def.name, def.kind), def.name, def.pos, GraphQLRuleTypeSystem)
+			continue
+		}
+		t.interfaces = append(t.interfaces, def.interfaces...)
+		t.fields = append(t.fields, def.fields...)
+		t.values = append(t.values, def.values...)
+		t.members = append(t.members, def.members...)
+	}
+}
+
+func (c *graphQLChecker) checkType(t *graphQLType) {
+	def := t.def
+	c.result.Metrics.TotalModels++
+
+	switch def.kind {
+	case sdlKindObject, sdlKindInterface:
+		if len(t.fields) == 0 {
+			c.error("EMPTY_TYPE", fmt.Sprintf("%s %q must define at least one field", def.kind, def.name), def.name, def.pos, GraphQLRuleTypeSystem)
+		}
+		c.checkFields(t, false)
+		c.checkInterfaces(t)
+	case sdlKindInput:
+		if len(t.fields) == 0 {
+			c.error("EMPTY_TYPE", fmt.Sprintf("input %q must define at least one field", def.name), def.name, def.pos, GraphQLRuleTypeSystem)
+		}
+		c.checkFields(t, true)
+	case sdlKindEnum:
+		if len(t.values) == 0 {
+			c.error("EMPTY_TYPE", fmt.Sprintf("enum %q must define at least one value", def.name), def.name, def.pos, GraphQLRuleTypeSystem)
+		}
+		seen := make(map[string]bool)
+		for _, value := range t.values {
+			coordinate := def.name + "." + value.name
+			switch {
+			case seen[value.name]:
+				c.error("DUPLICATE_ENUM_VALUE", fmt.Sprintf("enum value %q is already defined", coordinate), coordinate, value.pos, GraphQLRuleUniqueNames)
+			case value.name == "true" || value.name == "false" || value.name == "null":
+				c.error("RESERVED_NAME", fmt.Sprintf("enum value %q cannot be named %s", coordinate, value.name), coordinate, value.pos, GraphQLRuleTypeSystem)
+			}
+			seen[value.name] = true
+		}
+	case sdlKindUnion:
+		if len(t.members) == 0 {
+			c.error("EMPTY_TYPE", fmt.Sprintf("union %q must have at least one member", def.name), def.name, def.pos, GraphQLRuleTypeSystem)
+		}
+		c.result.Metrics.ComplexityScore += 2 * len(t.members)
+		seen := make(map[string]bool)
+		for _, member := range t.members {
+			if seen[member.name] {
+				c.error("DUPLICATE_MEMBER", fmt.Sprintf("%q is already a member of union %q", member.name, def.name), def.name, member.pos, GraphQLRuleUniqueNames)
+			}
+			seen[member.name] = true
+			if target := c.reference(member.name, def.name, member.pos); target != nil && target.def.kind != sdlKindObject {
+				c.error("INVALID_UNION_MEMBER", fmt.Sprintf("union %q member %q must be an object type", def.name, member.name), def.name, member.pos, GraphQLRuleTypeSystem)
+			}
+		}
+	}
+}
+
+// checkFields validates the fields of an object, interface or input type
+func (c *graphQLChecker) checkFields(t *graphQLType, input bool) {
+	seen := make(map[string]bool)
+	for _, field := range t.fields {
+		coordinate := t.def.name + "." + field.name
+		c.result.Metrics.TotalFields++
+		c.result.Metrics.ComplexityScore += 1 + len(field.args)
+
+		if seen[field.name] {
+			c.error("DUPLICATE_FIELD", fmt.Sprintf("field %q is already defined", coordinate), coordinate, field.pos, GraphQLRuleUniqueNames)
+		}
+		seen[field.name] = true
+
+		if input {
+			c.checkInputType(field.typ, coordinate)
+		} else {
+			named := field.typ.namedType()
+			if target := c.reference(named.name, coordinate, named.pos); target != nil && target.def.kind == sdlKindInput {
+				c.error("INVALID_OUTPUT_TYPE", fmt.Sprintf("field %q cannot return input type %q", coordinate, named.name), coordinate, named.pos, GraphQLRuleTypeSystem)
+			}
+			c.checkArguments(field.args, coordinate)
+		}
+		c.checkDirectives(field.directives)
+	}
+}
+
+// checkArguments validates the arguments of a field or directive
+func (c *graphQLChecker) checkArguments(args []*sdlField, owner string) {
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		coordinate := fmt.Sprintf("%s(%s:)", owner, arg.name)
+		if seen[arg.name] {
+			c.error("DUPLICATE_ARGUMENT", fmt.Sprintf("argument %q is already defined", coordinate), coordinate, arg.pos, GraphQLRuleUniqueNames)
+		}
+		seen[arg.name] = true
+		c.checkInputType(arg.typ, coordinate)
+		c.checkDirectives(arg.directives)
+	}
+}
+
+// checkInputType reports types that cannot be used for arguments or input fields
+func (c *graphQLChecker) checkInputType(typ *sdlType, coordinate string) {
+	named := typ.namedType()
+	target := c.reference(named.name, coordinate, named.pos)
+	if target == nil {
+		return
+	}
+	switch target.def.kind {
+	case sdlKindScalar, sdlKindEnum, sdlKindInput:
+	default:
+		c.error("INVALID_INPUT_TYPE", fmt.Sprintf("%q cannot use %s %q as an input type", coordinate, target.def.kind, named.name),
+			coordinate, named.pos, GraphQLRuleTypeSystem)
+	}
+}
+
+// checkInterfaces checks that a type implements interfaces that exist and
+// declares every field they require
+func (c *graphQLChecker) checkInterfaces(t *graphQLType) {
+	fields := make(map[string]*sdlField, len(t.fields))
+	for _, field := range t.fields {
+		fields[field.name] = field
+	}
+	c.result.Metrics.ComplexityScore += 2 * len(t.interfaces)
+
+	for _, iface := range t.interfaces {
+		target := c.reference(iface.name, t.def.name, iface.pos)
+		switch {
+		case target == nil:
+			continue
+		case target.def.kind != sdlKindInterface:
+			c.error("INVALID_INTERFACE", fmt.Sprintf("%q implements %q, which is not an interface", t.def.name, iface.name), t.def.name, iface.pos, GraphQLRuleTypeSystem)
+			continue
+		case iface.name == t.def.name:
+			c.error("INVALID_INTERFACE", fmt.Sprintf("interface %q cannot implement itself", iface.name), t.def.name, iface.pos, GraphQLRuleTypeSystem)
+			continue
+		}
+		for _, required := range target.fields {
+			if _, ok := fields[required.name]; !ok {
+				c.error("MISSING_INTERFACE_FIELD", fmt.Sprintf("%q must define field %q required by interface %q", t.def.name, required.name, iface.name),
+					t.def.name, t.def.pos, GraphQLRuleTypeSystem)
+			}
+		}
+	}
+}
+
+// checkRootTypes checks the query, mutation and subscription root types
+func (c *graphQLChecker) checkRootTypes() {
+	roots := make(map[string]*sdlField)
+	if c.schema == nil {
+		// Without a schema definition the root types are found by name
+		for operation, name := range map[string]string{"query": "Query", "mutation": "Mutation", "subscription": "Subscription"} {
+			if _, ok := c.types[name]; ok {
+				roots[operation] = &sdlField{name: operation, typ: &sdlType{name: name}}
+			}
+		}
+	}
+	for _, field := range c.rootFields {
+		switch {
+		case field.name != "query" && field.name != "mutation" && field.name != "subscription":
+			c.error("INVALID_OPERATION_TYPE", fmt.Sprintf("%q is not an operation type", field.name), "schema", field.pos, GraphQLRuleRootTypes)
+			continue
+		case roots[field.name] != nil:
+			c.error("DUPLICATE_OPERATION_TYPE", fmt.Sprintf("%s root type is already defined", field.name), "schema", field.pos, GraphQLRuleUniqueNames)
+			continue
+		}
+		roots[field.name] = field
+		if target := c.reference(field.typ.name, "schema", field.typ.pos); target != nil && target.def.kind != sdlKindObject {
+			c.error("INVALID_ROOT_TYPE", fmt.Sprintf("%s root type %q must be an object type", field.name, field.typ.name), "schema", field.typ.pos, GraphQLRuleRootTypes)
+		}
+	}
+
+	if roots["query"] == nil {
+		pos := sdlPos{line: 1, column: 1}
+		if c.schema != nil {
+			pos = c.schema.pos
+		}
+		c.error("MISSING_QUERY_TYPE", "schema must define a query root type", "schema", pos, GraphQLRuleRootTypes)
+	}
+	for _, root := range roots {
+		if t, ok := c.types[root.typ.name]; ok {
+			c.result.Metrics.TotalEndpoints += len(t.fields)
+		}
+	}
+}
+
+// checkDirectives reports applied directives that are not defined
+func (c *graphQLChecker) checkDirectives(applied []sdlName) {
+	for _, directive := range applied {
+		if _, ok := c.directives[directive.name]; !ok && !graphQLBuiltinDirectives[directive.name] {
+			c.error("UNDEFINED_DIRECTIVE", fmt.Sprintf("directive @%s is not defined", directive.name), "@"+directive.name, directive.pos, GraphQLRuleDirectives)
+		}
+	}
+}
+
+// reference looks up a referenced type, reporting it when it is not defined
+func (c *graphQLChecker) reference(name, coordinate string, pos sdlPos) *graphQLType {
+	t, ok := c.types[name]
+	if !ok {
+		c.error("UNDEFINED_TYPE", fmt.Sprintf("type %q referenced by %s is not defined", name, coordinate), coordinate, pos, GraphQLRuleTypeReference)
+		return nil
+	}
+	return t
+}
+
+func (c *graphQLChecker) error(code, message, path string, pos sdlPos, rule string) {
+	c.result.Errors = append(c.result.Errors, SchemaValidationError{
+		Code:     code,
+		Message:  message,
+		Path:     path,
+		Line:     pos.line,
+		Column:   pos.column,
+		Severity: "error",
+		Rule:     rule,
+	})
+}
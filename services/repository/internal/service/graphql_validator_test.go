@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validPetsSDL = `"""
+A pet store
+"""
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+directive @auth(role: Role = VIEWER) on FIELD_DEFINITION
+
+type Query {
+  pets(limit: Int = 10, filter: PetFilter): [Pet!]!
+  pet(id: ID!): Pet @auth
+  search(term: String!): [SearchResult!]!
+}
+
+type Mutation {
+  adoptPet(id: ID!): Pet @auth(role: ADMIN)
+}
+
+interface Node {
+  id: ID!
+}
+
+type Pet implements Node {
+  id: ID!
+  "The pet's name"
+  name: String!
+  status: PetStatus @deprecated(reason: "Use adoption")
+}
+
+type Owner implements Node {
+  id: ID!
+  pets: [Pet!]!
+}
+
+extend type Owner {
+  name: String
+}
+
+union SearchResult = Pet | Owner
+
+enum PetStatus {
+  AVAILABLE
+  ADOPTED
+}
+
+enum Role {
+  VIEWER
+  ADMIN
+}
+
+input PetFilter {
+  status: PetStatus
+  namePrefix: String
+}
+
+scalar DateTime
+`
+
+func newTestGraphQLValidator() *GraphQLValidator {
+	return NewGraphQLValidator(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestGraphQLValidator_ValidSchema(t *testing.T) {
+	result, err := newTestGraphQLValidator().ValidateSchema(context.Background(), validPetsSDL, SchemaFormatGraphQL)
+	require.NoError(t, err)
+
+	assert.True(t, result.IsValid, "errors: %v", result.Errors)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 10, result.Metrics.TotalModels)
+	assert.Equal(t, 13, result.Metrics.TotalFields)
+	assert.Equal(t, 4, result.Metrics.TotalEndpoints)
+	assert.Equal(t, 100, result.Metrics.QualityScore)
+}
+
+func TestGraphQLValidator_UndefinedType(t *testing.T) {
+	sdl := `type Query {
+  pets: [Pet!]!
+  owner(filter: OwnerFilter): String
+}
+`
+	result, err := newTestGraphQLValidator().ValidateSchema(context.Background(), sdl, SchemaFormatGraphQL)
+	require.NoError(t, err)
+
+	assert.False(t, result.IsValid)
+	require.Len(t, result.Errors, 2)
+
+	pet := result.Errors[0]
+	assert.Equal(t, "UNDEFINED_TYPE", pet.Code)
+	assert.Equal(t, GraphQLRuleTypeReference, pet.Rule)
+	assert.Equal(t, "Query.pets", pet.Path)
+	assert.Equal(t, 2, pet.Line)
+	assert.Equal(t, 10, pet.Column)
+	assert.Contains(t, pet.Message, `"Pet"`)
+
+	filter := result.Errors[1]
+	assert.Equal(t, "UNDEFINED_TYPE", filter.Code)
+	assert.Equal(t, "Query.owner(filter:)", filter.Path)
+	assert.Equal(t, 3, filter.Line)
+}
+
+func TestGraphQLValidator_DuplicateType(t *testing.T) {
+	sdl := `type Query {
+  pet: Pet
+}
+
+type Pet {
+  name: String
+}
+
+type Pet {
+  name: String
+  name: String
+}
+
+scalar String
+`
+	result, err := newTestGraphQLValidator().ValidateSchema(context.Background(), sdl, SchemaFormatGraphQL)
+	require.NoError(t, err)
+
+	assert.False(t, result.IsValid)
+	require.Len(t, result.Errors, 2)
+
+	duplicate := result.Errors[0]
+	assert.Equal(t, "DUPLICATE_TYPE", duplicate.Code)
+	assert.Equal(t, GraphQLRuleUniqueNames, duplicate.Rule)
+	assert.Equal(t, "Pet", duplicate.Path)
+	assert.Equal(t, 9, duplicate.Line)
+	assert.Equal(t, 6, duplicate.Column)
+	assert.Contains(t, duplicate.Message, "already defined at line 5")
+
+	builtin := result.Errors[1]
+	assert.Equal(t, "DUPLICATE_TYPE", builtin.Code)
+	assert.Contains(t, builtin.Message, "built-in scalar")
+}
+
+func TestGraphQLValidator_SyntaxErrors(t *testing.T) {
+	tt := []struct {
+		name    string
+		sdl     string
+		line    int
+		column  int
+		message string
+	}{
+		{name: "empty", sdl: "  \n", line: 2, column: 1, message: "document is empty"},
+		{name: "missing type", sdl: "type Query {\n  pets:\n}\n", line: 3, column: 1, message: `expected a name, found "}"`},
+		{name: "unterminated", sdl: "type Query {\n  pets: String\n", line: 3, column: 1, message: "expected a name, found end of document"},
+		{name: "operation", sdl: "query { pets }", line: 1, column: 1, message: "operations are not allowed"},
+		{name: "bad character", sdl: "type Query {\n  pets: String%\n}", line: 2, column: 15, message: `unexpected character '%'`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := newTestGraphQLValidator().ValidateSchema(context.Background(), tc.sdl, SchemaFormatGraphQL)
+			require.NoError(t, err)
+
+			assert.False(t, result.IsValid)
+			require.Len(t, result.Errors, 1)
+			assert.Equal(t, "SYNTAX_ERROR", result.Errors[0].Code)
+			assert.Equal(t, tc.line, result.Errors[0].Line)
+			assert.Equal(t, tc.column, result.Errors[0].Column)
+			assert.Contains(t, result.Errors[0].Message, tc.message)
+		})
+	}
+}
+
+func TestGraphQLValidator_TypeSystemErrors(t *testing.T) {
+	sdl := `type Query {
+  pet(input: Pet): PetInput
+  search: Result @cached
+}
+
+interface Node {
+  id: ID!
+}
+
+type Pet implements Node & PetInput {
+  name: String
+}
+
+input PetInput {
+  name: String
+}
+
+union Result = Pet | PetInput
+
+extend type Missing {
+  id: ID
+}
+
+type Empty {}
+`
+	result, err := newTestGraphQLValidator().ValidateSchema(context.Background(), sdl, SchemaFormatGraphQL)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{
+		"INVALID_INPUT_TYPE",      // Query.pet(input:)
+		"INVALID_OUTPUT_TYPE",     // Query.pet
+		"UNDEFINED_DIRECTIVE",     // @cached
+		"INVALID_INTERFACE",       // PetInput
+		"MISSING_INTERFACE_FIELD", // Node.id
+		"INVALID_UNION_MEMBER",    // PetInput
+		"UNDEFINED_TYPE",          // extend type Missing
+		"EMPTY_TYPE",              // Empty
+	}, errorCodes(result))
+}
+
+func TestGraphQLValidator_MissingQueryType(t *testing.T) {
+	result, err := newTestGraphQLValidator().ValidateSchema(context.Background(), "type Pet { name: String }", SchemaFormatGraphQL)
+	require.NoError(t, err)
+
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "MISSING_QUERY_TYPE", result.Errors[0].Code)
+}
+
+func TestFormatValidator_DispatchesByFormat(t *testing.T) {
+	ctx := context.Background()
+	v := NewDefaultFormatValidator(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	result, err := v.ValidateSchema(ctx, "type Query { pets: [Pet] }", SchemaFormatGraphQL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"UNDEFINED_TYPE"}, errorCodes(result))
+
+	result, err = v.ValidateSchema(ctx, validPetsSpec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	assert.True(t, result.IsValid)
+
+	result, err = v.ValidateSchema(ctx, `syntax = "proto3";`, SchemaFormatGRPC)
+	require.NoError(t, err)
+	assert.True(t, result.IsValid)
+	require.Len(t, result.Warnings, 1)
+	assert.Equal(t, "VALIDATION_UNAVAILABLE", result.Warnings[0].Code)
+
+	rules, err := v.GetValidationRules(ctx, SchemaFormatGraphQL)
+	require.NoError(t, err)
+	assert.Len(t, rules, len(graphQLValidationRules))
+}
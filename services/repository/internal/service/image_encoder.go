@@ -0,0 +1,67 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+)
+
+// StdImageEncoder implements ImageEncoder for JPEG and PNG with the standard
+// library, and for lossless WebP with a built-in VP8L encoder. It decodes
+// JPEG, PNG and GIF sources.
+type StdImageEncoder struct{}
+
+// NewStdImageEncoder creates a standard library image encoder
+func NewStdImageEncoder() *StdImageEncoder {
+	return &StdImageEncoder{}
+}
+
+// Supports reports whether the encoder can produce the format
+func (e *StdImageEncoder) Supports(format ImageFormat) bool {
+	return format == ImageFormatJPEG || format == ImageFormatPNG || format == ImageFormatWebP
+}
+
+// Encode re-encodes data as JPEG, PNG or WebP. Transparent pixels are
+// composited onto white for JPEG, which has no alpha channel. WebP output is
+// lossless, so quality only applies to JPEG.
+func (e *StdImageEncoder) Encode(_ context.Context, data []byte, sourceMimeType string, targetFormat ImageFormat, quality int) ([]byte, error) {
+	if !e.Supports(targetFormat) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedImageFormat, targetFormat)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrUnsupportedImage, sourceMimeType, err)
+	}
+
+	var buf bytes.Buffer
+	switch targetFormat {
+	case ImageFormatWebP:
+		encoded, err := encodeWebP(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		return encoded, nil
+	case ImageFormatPNG:
+		if err := png.Encode(&buf, src); err != nil {
+			return nil, fmt.Errorf("failed to encode image: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	bounds := src.Bounds()
+	flattened := image.NewRGBA(bounds)
+	draw.Draw(flattened, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flattened, bounds, src, bounds.Min, draw.Over)
+	if quality <= 0 || quality > 100 {
+		quality = jpeg.DefaultQuality
+	}
+	if err := jpeg.Encode(&buf, flattened, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// fitDimensions returns the largest size with the source aspect ratio that
+// fits within maxWidth x maxHeight (0 leaves that side unbounded). Images are
+// never enlarged.
+func fitDimensions(width, height, maxWidth, maxHeight int) (int, int) {
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+	if maxHeight > 0 && height > maxHeight {
+		if s := float64(maxHeight) / float64(height); s < scale {
+			scale = s
+		}
+	}
+	return max(1, int(float64(width)*scale+0.5)), max(1, int(float64(height)*scale+0.5))
+}
+
+// coverCrop returns the centred region of bounds with the aspect ratio of
+// width x height, so scaling it to that size fills the box without distortion
+func coverCrop(bounds image.Rectangle, width, height int) image.Rectangle {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW*height > srcH*width {
+		cropW := srcH * width / height
+		x := bounds.Min.X + (srcW-cropW)/2
+		return image.Rect(x, bounds.Min.Y, x+cropW, bounds.Max.Y)
+	}
+	cropH := srcW * height / width
+	y := bounds.Min.Y + (srcH-cropH)/2
+	return image.Rect(bounds.Min.X, y, bounds.Max.X, y+cropH)
+}
+
+// resizeImage scales the src region to width x height. Each destination pixel
+// is the average of the source pixels it covers (a box filter), which gives
+// clean downscales for thumbnails without an external imaging library.
+func resizeImage(src image.Image, region image.Rectangle, width, height int) *image.NRGBA {
+	rgba := image.NewNRGBA(image.Rect(0, 0, region.Dx(), region.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, region.Min, draw.Src)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	srcW, srcH := region.Dx(), region.Dy()
+	for y := 0; y < height; y++ {
+		y0 := y * srcH / height
+		y1 := max(y0+1, (y+1)*srcH/height)
+		for x := 0; x < width; x++ {
+			x0 := x * srcW / width
+			x1 := max(x0+1, (x+1)*srcW/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := rgba.NRGBAAt(sx, sy)
+					// Weight colour by alpha so transparent pixels don't darken edges
+					r += uint64(c.R) * uint64(c.A)
+					g += uint64(c.G) * uint64(c.A)
+					b += uint64(c.B) * uint64(c.A)
+					a += uint64(c.A)
+					n++
+				}
+			}
+			if a == 0 {
+				continue
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{
+				R: uint8(r / a),
+				G: uint8(g / a),
+				B: uint8(b / a),
+				A: uint8(a / n),
+			})
+		}
+	}
+	return dst
+}
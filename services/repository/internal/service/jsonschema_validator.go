@@ -0,0 +1,396 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JSON Schema validation rule IDs reported in SchemaValidationError.Rule
+const (
+	JSONSchemaRuleSyntax    = "syntax"
+	JSONSchemaRuleReference = "reference-resolution"
+	JSONSchemaRuleCycle     = "reference-cycles"
+)
+
+// jsonSchemaValidationRules describes the checks performed by JSONSchemaValidator
+var jsonSchemaValidationRules = []*ValidationRule{
+	{ID: JSONSchemaRuleSyntax, Name: "Syntax", Description: "Document must be a well-formed JSON or YAML schema object", Category: "syntax", Severity: "error"},
+	{ID: JSONSchemaRuleReference, Name: "References", Description: "Every $ref must resolve, including refs to other documents", Category: "structure", Severity: "error"},
+	{ID: JSONSchemaRuleCycle, Name: "Reference cycles", Description: "A $ref must not lead back to itself through references alone", Category: "structure", Severity: "error"},
+}
+
+// JSONSchemaValidator implements SchemaValidator for JSON Schema documents
+// written in JSON or YAML. It follows every $ref, within the document and,
+// when a fetcher is configured, into the documents it references, and
+// reports references that do not resolve or that only lead to themselves.
+// Schemas that recurse through properties or items are valid and are walked
+// once.
+type JSONSchemaValidator struct {
+	fetcher SchemaDocumentFetcher
+	logger  *slog.Logger
+}
+
+// NewJSONSchemaValidator creates a new JSON Schema validator. The fetcher is
+// optional; without it refs to other documents are reported as unchecked.
+func NewJSONSchemaValidator(fetcher SchemaDocumentFetcher, logger *slog.Logger) *JSONSchemaValidator {
+	return &JSONSchemaValidator{
+		fetcher: fetcher,
+		logger:  logger.With("component", "jsonschema_validator"),
+	}
+}
+
+// ValidateSchema parses and validates schema content
+func (v *JSONSchemaValidator) ValidateSchema(ctx context.Context, schemaContent string, format SchemaFormat) (*ValidationResult, error) {
+	start := time.Now()
+	result := &ValidationResult{
+		Errors:   []SchemaValidationError{},
+		Warnings: []SchemaValidationWarning{},
+	}
+	result.Metrics.TotalLines = countLines(schemaContent)
+
+	if format != SchemaFormatJSONSchema {
+		result.Warnings = append(result.Warnings, SchemaValidationWarning{
+			Code:    "VALIDATION_UNAVAILABLE",
+			Message: fmt.Sprintf("content validation is not available for %s schemas", format),
+		})
+	} else {
+		v.validateJSONSchema(ctx, schemaContent, result)
+	}
+
+	result.IsValid = len(result.Errors) == 0
+	result.Metrics.QualityScore = qualityScore(result)
+	result.ValidatedAt = time.Now()
+	result.Duration = time.Since(start)
+
+	v.logger.DebugContext(ctx, "Schema validated",
+		"format", format, "valid", result.IsValid, "errors", len(result.Errors), "warnings", len(result.Warnings))
+
+	return result, nil
+}
+
+// ValidateCompatibility is not supported for JSON Schema documents
+func (v *JSONSchemaValidator) ValidateCompatibility(ctx context.Context, oldSchema, newSchema string, format SchemaFormat) (*CompatibilityResult, error) {
+	return nil, fmt.Errorf("%w: compatibility checking for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// ValidateAgainstContract validates a schema against a contract
+func (v *JSONSchemaValidator) ValidateAgainstContract(ctx context.Context, schema, contract string, format SchemaFormat) (*ContractValidationResult, error) {
+	return nil, fmt.Errorf("%w: contract validation for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// GetValidationRules returns the rules applied to the given format
+func (v *JSONSchemaValidator) GetValidationRules(ctx context.Context, format SchemaFormat) ([]*ValidationRule, error) {
+	if format != SchemaFormatJSONSchema {
+		return []*ValidationRule{}, nil
+	}
+	rules := make([]*ValidationRule, len(jsonSchemaValidationRules))
+	for i, rule := range jsonSchemaValidationRules {
+		copied := *rule
+		copied.Enabled = true
+		rules[i] = &copied
+	}
+	return rules, nil
+}
+
+// validateJSONSchema parses the document and records problems and metrics on result
+func (v *JSONSchemaValidator) validateJSONSchema(ctx context.Context, content string, result *ValidationResult) {
+	root, err := parseOpenAPINode(content)
+	if err != nil {
+		result.Errors = append(result.Errors, syntaxError(content, err))
+		return
+	}
+	doc, _, err := parseSchemaDocument(content)
+	if err != nil {
+		result.Errors = append(result.Errors, syntaxError(content, err))
+		return
+	}
+
+	switch d := doc.(type) {
+	case bool:
+		// true and false are complete schemas
+		return
+	case map[string]interface{}:
+		for _, key := range []string{"$defs", "definitions"} {
+			if defs, ok := d[key].(map[string]interface{}); ok {
+				result.Metrics.TotalModels += len(defs)
+			}
+		}
+	default:
+		result.Errors = append(result.Errors, SchemaValidationError{
+			Code:     "INVALID_DOCUMENT",
+			Message:  "JSON Schema document must be an object or a boolean",
+			Path:     "/",
+			Line:     root.Line,
+			Column:   root.Column,
+			Severity: "error",
+			Rule:     JSONSchemaRuleSyntax,
+		})
+		return
+	}
+	result.Metrics.ComplexityScore = complexityScore(root)
+
+	// Relative refs resolve against the root schema's $id
+	base := ""
+	if id, ok := doc.(map[string]interface{})["$id"].(string); ok {
+		base, _, _ = strings.Cut(id, "#")
+	}
+
+	c := &jsonSchemaRefChecker{
+		ctx:     ctx,
+		fetcher: v.fetcher,
+		result:  result,
+		root:    root,
+		rootURI: base,
+		docs:    map[string]interface{}{base: doc},
+		walked:  make(map[string]bool),
+	}
+	c.checkDocument(base, doc)
+}
+
+// jsonSchemaRefChecker resolves the $refs of a schema and of every document
+// it references. Each document is walked once and each reference is
+// followed only until it reaches a schema or revisits a reference, so
+// recursive schemas terminate.
+type jsonSchemaRefChecker struct {
+	ctx     context.Context
+	fetcher SchemaDocumentFetcher
+	result  *ValidationResult
+	root    *yaml.Node // node tree of the root document, for error positions
+	rootURI string
+	docs    map[string]interface{}
+	walked  map[string]bool
+	pending []string // fetched documents not yet walked
+}
+
+// jsonSchemaRef is a resolved reference target
+type jsonSchemaRef struct {
+	uri      string // document the target lives in
+	location string // JSON pointer of the target within the document
+	node     interface{}
+}
+
+func (r jsonSchemaRef) key() string {
+	return r.uri + "#" + r.location
+}
+
+func (c *jsonSchemaRefChecker) checkDocument(uri string, doc interface{}) {
+	c.walked[uri] = true
+	c.walk(uri, doc, "", "")
+	for len(c.pending) > 0 {
+		next := c.pending[0]
+		c.pending = c.pending[1:]
+		if !c.walked[next] {
+			c.walked[next] = true
+			c.walk(next, c.docs[next], "", "")
+		}
+	}
+}
+
+// walk checks every $ref in node, which lives in document uri at location
+// under the keyword parentKey
+func (c *jsonSchemaRefChecker) walk(uri string, node interface{}, location, parentKey string) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		// Under these keywords the keys are names, not keywords
+		named := parentKey == "properties" || parentKey == "patternProperties" || parentKey == "$defs" || parentKey == "definitions"
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			childLocation := location + "/" + escapeJSONPointer(k)
+			if ref, ok := v[k].(string); ok && k == "$ref" && !named {
+				c.checkRef(uri, childLocation, ref)
+				continue
+			}
+			if !named && (k == "enum" || k == "const" || k == "examples" || k == "default") {
+				// Instance values, not schemas
+				continue
+			}
+			c.walk(uri, v[k], childLocation, k)
+		}
+	case []interface{}:
+		for i, child := range v {
+			c.walk(uri, child, fmt.Sprintf("%s/%d", location, i), parentKey)
+		}
+	}
+}
+
+// checkRef resolves the $ref at location and follows the chain of
+// references it starts until it reaches a schema
+func (c *jsonSchemaRefChecker) checkRef(uri, location, ref string) {
+	target, external, err := c.resolve(uri, ref)
+	switch {
+	case external:
+		c.warning("UNCHECKED_REF", fmt.Sprintf("reference %q points to another document and was not checked", ref), uri, location,
+			"Configure a document fetcher to resolve references to other documents")
+		return
+	case err != nil:
+		c.error("UNRESOLVED_REF", fmt.Sprintf("reference %q does not resolve: %v", ref, err), uri, location, JSONSchemaRuleReference)
+		return
+	}
+
+	chain := []string{c.display(uri, strings.TrimSuffix(location, "/$ref"))}
+	seen := map[string]bool{uri + "#" + strings.TrimSuffix(location, "/$ref"): true}
+	for {
+		if target.uri != c.rootURI && !c.walked[target.uri] {
+			c.pending = append(c.pending, target.uri)
+		}
+		if seen[target.key()] {
+			chain = append(chain, c.display(target.uri, target.location))
+			c.error("CYCLIC_REF", fmt.Sprintf("reference %q never reaches a schema: %s", ref, strings.Join(chain, " -> ")),
+				uri, location, JSONSchemaRuleCycle)
+			return
+		}
+		seen[target.key()] = true
+
+		schema, _ := target.node.(map[string]interface{})
+		next, ok := schema["$ref"].(string)
+		if !ok {
+			return
+		}
+		chain = append(chain, c.display(target.uri, target.location))
+		// Unresolvable refs further along the chain are reported where they appear
+		if target, external, err = c.resolve(target.uri, next); external || err != nil {
+			return
+		}
+	}
+}
+
+// resolve finds the target of ref as seen from document uri. external is
+// true when ref points to another document and no fetcher is configured.
+func (c *jsonSchemaRefChecker) resolve(uri, ref string) (target jsonSchemaRef, external bool, err error) {
+	refDoc, fragment, _ := strings.Cut(ref, "#")
+	target.uri = uri
+	if refDoc != "" {
+		if target.uri, err = resolveDocumentURI(uri, refDoc); err != nil {
+			return target, false, fmt.Errorf("invalid reference: %w", err)
+		}
+	}
+
+	doc, ok := c.docs[target.uri]
+	if !ok {
+		if c.fetcher == nil {
+			return target, true, nil
+		}
+		data, err := c.fetcher.Fetch(c.ctx, target.uri)
+		if err != nil {
+			return target, false, err
+		}
+		if doc, _, err = parseSchemaDocument(string(data)); err != nil {
+			return target, false, fmt.Errorf("failed to parse %s: %w", target.uri, err)
+		}
+		c.docs[target.uri] = doc
+	}
+
+	if fragment != "" && !strings.HasPrefix(fragment, "/") {
+		// Plain name fragments refer to an $anchor (or a draft-07 "$id": "#name")
+		location, found := findJSONSchemaAnchor(doc, fragment, "")
+		if !found {
+			return target, false, fmt.Errorf("anchor %q not found", fragment)
+		}
+		fragment = location
+	}
+	target.location = fragment
+	if target.node, err = lookupJSONPointer(doc, fragment); err != nil {
+		return target, false, err
+	}
+	return target, false, nil
+}
+
+// findJSONSchemaAnchor returns the location of the schema declaring anchor
+func findJSONSchemaAnchor(node interface{}, anchor, location string) (string, bool) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if name, _ := v["$anchor"].(string); name == anchor {
+			return location, true
+		}
+		if id, _ := v["$id"].(string); id == "#"+anchor {
+			return location, true
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if found, ok := findJSONSchemaAnchor(v[k], anchor, location+"/"+escapeJSONPointer(k)); ok {
+				return found, true
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			if found, ok := findJSONSchemaAnchor(child, anchor, fmt.Sprintf("%s/%d", location, i)); ok {
+				return found, true
+			}
+		}
+	}
+	return "", false
+}
+
+// display names a location for messages, qualified by its document when
+// it is not the root document
+func (c *jsonSchemaRefChecker) display(uri, location string) string {
+	if location == "" {
+		location = "/"
+	}
+	if uri == c.rootURI {
+		return "#" + location
+	}
+	return uri + "#" + location
+}
+
+// position returns the line and column of location in the root document
+func (c *jsonSchemaRefChecker) position(uri, location string) (int, int) {
+	if uri != c.rootURI {
+		return 0, 0
+	}
+	node := lookupNodePointer(c.root, location)
+	if node == nil {
+		return 0, 0
+	}
+	return node.Line, node.Column
+}
+
+// path is the reported path of location: a JSON pointer in the root
+// document, or a URI with a pointer fragment in another document
+func (c *jsonSchemaRefChecker) path(uri, location string) string {
+	if uri == c.rootURI {
+		return location
+	}
+	return uri + "#" + location
+}
+
+func (c *jsonSchemaRefChecker) error(code, message, uri, location, rule string) {
+	line, column := c.position(uri, location)
+	c.result.Errors = append(c.result.Errors, SchemaValidationError{
+		Code:     code,
+		Message:  message,
+		Path:     c.path(uri, location),
+		Line:     line,
+		Column:   column,
+		Severity: "error",
+		Rule:     rule,
+	})
+}
+
+func (c *jsonSchemaRefChecker) warning(code, message, uri, location, suggestion string) {
+	line, column := c.position(uri, location)
+	c.result.Warnings = append(c.result.Warnings, SchemaValidationWarning{
+		Code:       code,
+		Message:    message,
+		Path:       c.path(uri, location),
+		Line:       line,
+		Column:     column,
+		Rule:       JSONSchemaRuleReference,
+		Suggestion: suggestion,
+	})
+}
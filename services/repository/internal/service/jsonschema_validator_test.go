@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJSONSchemaValidator(fetcher SchemaDocumentFetcher) *JSONSchemaValidator {
+	return NewJSONSchemaValidator(fetcher, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestJSONSchemaValidator_ResolvesNestedRefs(t *testing.T) {
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "owner": {"$ref": "#/$defs/Owner"},
+    "$ref": {"type": "string"},
+    "tags": {"type": "array", "items": {"$ref": "#tag"}}
+  },
+  "$defs": {
+    "Owner": {
+      "type": "object",
+      "properties": {
+        "address": {"$ref": "#/$defs/Address"}
+      }
+    },
+    "Address": {"$ref": "#/$defs/PostalAddress"},
+    "PostalAddress": {"type": "object", "properties": {"city": {"type": "string"}}},
+    "Tag": {"$anchor": "tag", "type": "string", "enum": [{"$ref": "#/nowhere"}]}
+  }
+}`
+	result, err := newTestJSONSchemaValidator(nil).ValidateSchema(context.Background(), schema, SchemaFormatJSONSchema)
+	require.NoError(t, err)
+
+	assert.True(t, result.IsValid, "errors: %v", result.Errors)
+	assert.Empty(t, result.Warnings)
+	assert.Equal(t, 4, result.Metrics.TotalModels)
+}
+
+func TestJSONSchemaValidator_UnresolvableRef(t *testing.T) {
+	schema := `type: object
+properties:
+  owner:
+    $ref: "#/definitions/Owner"
+  pet:
+    $ref: "#/definitions/Pet"
+definitions:
+  Pet:
+    type: object
+`
+	result, err := newTestJSONSchemaValidator(nil).ValidateSchema(context.Background(), schema, SchemaFormatJSONSchema)
+	require.NoError(t, err)
+
+	assert.False(t, result.IsValid)
+	require.Len(t, result.Errors, 1)
+	unresolved := result.Errors[0]
+	assert.Equal(t, "UNRESOLVED_REF", unresolved.Code)
+	assert.Equal(t, JSONSchemaRuleReference, unresolved.Rule)
+	assert.Equal(t, "/properties/owner/$ref", unresolved.Path)
+	assert.Equal(t, 4, unresolved.Line)
+	assert.Equal(t, 11, unresolved.Column)
+	assert.Contains(t, unresolved.Message, "#/definitions/Owner")
+}
+
+func TestJSONSchemaValidator_Cycles(t *testing.T) {
+	tt := []struct {
+		name   string
+		schema string
+		paths  []string
+	}{
+		{
+			name: "recursive schema is valid",
+			schema: `{
+  "type": "object",
+  "properties": {
+    "value": {"type": "integer"},
+    "children": {"type": "array", "items": {"$ref": "#"}},
+    "parent": {"$ref": "#/$defs/Node"}
+  },
+  "$defs": {"Node": {"$ref": "#"}}
+}`,
+		},
+		{
+			name:   "self reference",
+			schema: `{"$ref": "#"}`,
+			paths:  []string{"/$ref"},
+		},
+		{
+			name: "reference loop",
+			schema: `{
+  "properties": {"pet": {"$ref": "#/$defs/A"}},
+  "$defs": {
+    "A": {"$ref": "#/$defs/B"},
+    "B": {"$ref": "#/$defs/A", "description": "siblings do not break the loop"}
+  }
+}`,
+			paths: []string{"/$defs/A/$ref", "/$defs/B/$ref", "/properties/pet/$ref"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			done := make(chan *ValidationResult, 1)
+			go func() {
+				result, err := newTestJSONSchemaValidator(nil).ValidateSchema(context.Background(), tc.schema, SchemaFormatJSONSchema)
+				assert.NoError(t, err)
+				done <- result
+			}()
+
+			var result *ValidationResult
+			select {
+			case result = <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("validation did not terminate")
+			}
+
+			var paths []string
+			for _, e := range result.Errors {
+				assert.Equal(t, "CYCLIC_REF", e.Code)
+				assert.Equal(t, JSONSchemaRuleCycle, e.Rule)
+				paths = append(paths, e.Path)
+			}
+			assert.Equal(t, tc.paths, paths)
+		})
+	}
+}
+
+func TestJSONSchemaValidator_ExternalRefs(t *testing.T) {
+	schema := `{
+  "$id": "https://schemas.example.com/pet.json",
+  "properties": {
+    "owner": {"$ref": "owner.json#/$defs/Owner"},
+    "vet": {"$ref": "vet.json"}
+  }
+}`
+	fetcher := mapDocumentFetcher{
+		"https://schemas.example.com/owner.json": `{
+  "$defs": {
+    "Owner": {"properties": {"pet": {"$ref": "pet.json"}, "home": {"$ref": "#/$defs/Home"}}}
+  }
+}`,
+	}
+
+	result, err := newTestJSONSchemaValidator(fetcher).ValidateSchema(context.Background(), schema, SchemaFormatJSONSchema)
+	require.NoError(t, err)
+
+	require.Len(t, result.Errors, 2)
+	assert.Equal(t, "https://schemas.example.com/owner.json#/$defs/Owner/properties/home/$ref", result.Errors[1].Path)
+	assert.Equal(t, "/properties/vet/$ref", result.Errors[0].Path)
+	for _, e := range result.Errors {
+		assert.Equal(t, "UNRESOLVED_REF", e.Code)
+	}
+
+	// Without a fetcher other documents are not checked
+	result, err = newTestJSONSchemaValidator(nil).ValidateSchema(context.Background(), schema, SchemaFormatJSONSchema)
+	require.NoError(t, err)
+	assert.True(t, result.IsValid)
+	require.Len(t, result.Warnings, 2)
+	assert.Equal(t, "UNCHECKED_REF", result.Warnings[0].Code)
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// splitFrontmatter separates a leading YAML frontmatter block delimited by
+// "---" lines from the Markdown body. It returns the parsed frontmatter, the
+// body and the number of source lines the frontmatter occupied.
+func splitFrontmatter(source string) (map[string]interface{}, string, int, error) {
+	if !strings.HasPrefix(source, "---\n") && !strings.HasPrefix(source, "---\r\n") {
+		return nil, source, 0, nil
+	}
+
+	lines := strings.SplitAfter(source, "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimRight(lines[i], "\r\n") != "---" {
+			continue
+		}
+		frontmatter := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[1:i], "")), &frontmatter); err != nil {
+			return nil, "", 0, fmt.Errorf("invalid frontmatter: %w", err)
+		}
+		return frontmatter, strings.Join(lines[i+1:], ""), i + 1, nil
+	}
+	return nil, "", 0, fmt.Errorf("invalid frontmatter: missing closing ---")
+}
+
+var (
+	mdHeading      = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdRule         = regexp.MustCompile(`^\s{0,3}([-*_])(\s*[-*_]){2,}\s*$`)
+	mdUnorderedRow = regexp.MustCompile(`^\s{0,3}[-*+]\s+(.*)$`)
+	mdOrderedRow   = regexp.MustCompile(`^\s{0,3}\d+[.)]\s+(.*)$`)
+	mdFence        = regexp.MustCompile("^\\s{0,3}```\\s*([\\w+-]*)\\s*$")
+
+	mdLink   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	mdStrong = regexp.MustCompile(`\*\*(.+?)\*\*|__(.+?)__`)
+	mdEm     = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+)
+
+// renderMarkdown converts the Markdown subset pages use (headings, paragraphs,
+// lists, blockquotes, fenced code, rules, emphasis, code spans and links) to
+// HTML. Raw HTML in the source is escaped rather than passed through.
+func renderMarkdown(source string) string {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	var out strings.Builder
+	var paragraph []string
+
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			out.WriteString("<p>" + renderInlineMarkdown(strings.Join(paragraph, "\n")) + "</p>\n")
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			flushParagraph()
+
+		case mdFence.MatchString(line):
+			flushParagraph()
+			lang := mdFence.FindStringSubmatch(line)[1]
+			var code []string
+			for i++; i < len(lines) && !mdFence.MatchString(lines[i]); i++ {
+				code = append(code, lines[i])
+			}
+			if lang != "" {
+				out.WriteString(`<pre><code class="language-` + html.EscapeString(lang) + `">`)
+			} else {
+				out.WriteString("<pre><code>")
+			}
+			out.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			if len(code) > 0 {
+				out.WriteString("\n")
+			}
+			out.WriteString("</code></pre>\n")
+
+		case mdHeading.MatchString(line):
+			flushParagraph()
+			m := mdHeading.FindStringSubmatch(line)
+			fmt.Fprintf(&out, "<h%d>%s</h%d>\n", len(m[1]), renderInlineMarkdown(m[2]), len(m[1]))
+
+		case mdRule.MatchString(line):
+			flushParagraph()
+			out.WriteString("<hr>\n")
+
+		case strings.HasPrefix(strings.TrimLeft(line, " "), ">"):
+			flushParagraph()
+			var quoted []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimLeft(lines[i], " "), ">"); i++ {
+				text := strings.TrimPrefix(strings.TrimLeft(lines[i], " "), ">")
+				quoted = append(quoted, strings.TrimPrefix(text, " "))
+			}
+			i--
+			out.WriteString("<blockquote>\n" + renderMarkdown(strings.Join(quoted, "\n")) + "</blockquote>\n")
+
+		case mdUnorderedRow.MatchString(line), mdOrderedRow.MatchString(line):
+			flushParagraph()
+			row, tag := mdUnorderedRow, "ul"
+			if !mdUnorderedRow.MatchString(line) {
+				row, tag = mdOrderedRow, "ol"
+			}
+			out.WriteString("<" + tag + ">\n")
+			for ; i < len(lines) && row.MatchString(lines[i]); i++ {
+				out.WriteString("<li>" + renderInlineMarkdown(row.FindStringSubmatch(lines[i])[1]) + "</li>\n")
+			}
+			i--
+			out.WriteString("</" + tag + ">\n")
+
+		default:
+			paragraph = append(paragraph, strings.TrimSpace(line))
+		}
+	}
+	flushParagraph()
+	return out.String()
+}
+
+// renderInlineMarkdown escapes text and applies code spans, links and emphasis
+func renderInlineMarkdown(text string) string {
+	var out strings.Builder
+	// Odd segments are code spans, which get no further formatting
+	segments := strings.Split(text, "`")
+	if len(segments)%2 == 0 {
+		// Unbalanced backtick: treat the last one literally
+		last := len(segments) - 1
+		segments[last-1] += "`" + segments[last]
+		segments = segments[:last]
+	}
+	for i, segment := range segments {
+		if i%2 == 1 {
+			out.WriteString("<code>" + html.EscapeString(segment) + "</code>")
+			continue
+		}
+		s := html.EscapeString(segment)
+		s = mdLink.ReplaceAllStringFunc(s, func(m string) string {
+			parts := mdLink.FindStringSubmatch(m)
+			if !isSafeLinkTarget(html.UnescapeString(parts[2])) {
+				return parts[1]
+			}
+			return `<a href="` + parts[2] + `">` + parts[1] + `</a>`
+		})
+		s = mdStrong.ReplaceAllString(s, "<strong>$1$2</strong>")
+		s = mdEm.ReplaceAllString(s, "<em>$1$2</em>")
+		out.WriteString(s)
+	}
+	return out.String()
+}
+
+// isSafeLinkTarget rejects script-capable URL schemes such as javascript:
+func isSafeLinkTarget(target string) bool {
+	scheme, _, found := strings.Cut(target, ":")
+	if !found || strings.ContainsAny(scheme, "/?#") {
+		return true // relative URL
+	}
+	switch strings.ToLower(scheme) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
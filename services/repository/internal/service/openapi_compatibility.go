@@ -0,0 +1,590 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Schema change types and categories reported by the OpenAPI diff
+const (
+	SchemaChangeAdded      = "added"
+	SchemaChangeRemoved    = "removed"
+	SchemaChangeModified   = "modified"
+	SchemaChangeDeprecated = "deprecated"
+
+	SchemaChangeCategoryEndpoint  = "endpoint"
+	SchemaChangeCategoryParameter = "parameter"
+	SchemaChangeCategoryModel     = "model"
+	SchemaChangeCategoryProperty  = "property"
+	SchemaChangeCategoryEnum      = "enum"
+	SchemaChangeCategoryResponse  = "response"
+)
+
+// maxSchemaDiffDepth bounds recursion through deeply nested or cyclic schemas
+const maxSchemaDiffDepth = 32
+
+// schemaDirection says whether a schema describes data sent by clients or
+// returned to them, which decides whether a change is breaking
+type schemaDirection int
+
+const (
+	requestDirection schemaDirection = iota
+	responseDirection
+)
+
+// diffOpenAPI compares two OpenAPI documents. Changes that can break
+// existing clients are flagged: removed endpoints or success responses,
+// added required request parameters or properties, removed required
+// response fields and narrowed request types. Additive changes are
+// backward-compatible.
+func diffOpenAPI(ctx context.Context, oldContent, newContent string) (*CompatibilityResult, error) {
+	oldDoc, err := parseOpenAPIForDiff(ctx, oldContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse previous schema: %w", err)
+	}
+	newDoc, err := parseOpenAPIForDiff(ctx, newContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new schema: %w", err)
+	}
+
+	d := &openAPIDiff{}
+	d.diffPaths(oldDoc, newDoc)
+	d.diffModels(oldDoc, newDoc)
+
+	result := &CompatibilityResult{
+		Changes:         d.changes,
+		BreakingChanges: []SchemaChange{},
+	}
+	if result.Changes == nil {
+		result.Changes = []SchemaChange{}
+	}
+	for _, change := range result.Changes {
+		switch change.Type {
+		case SchemaChangeAdded:
+			result.Summary.AddedCount++
+		case SchemaChangeRemoved:
+			result.Summary.RemovedCount++
+		default:
+			result.Summary.ModifiedCount++
+		}
+		if change.IsBreaking {
+			result.BreakingChanges = append(result.BreakingChanges, change)
+		}
+	}
+	result.Summary.TotalChanges = len(result.Changes)
+	result.Summary.BreakingCount = len(result.BreakingChanges)
+	result.Summary.CompatibilityScore = max(100-20*result.Summary.BreakingCount, 0)
+
+	switch {
+	case result.Summary.BreakingCount > 0:
+		result.Compatibility = CompatibilityLevelBreaking
+	case result.Summary.TotalChanges > 0:
+		result.Compatibility = CompatibilityLevelBackward
+	default:
+		result.Compatibility = CompatibilityLevelFull
+	}
+	result.IsCompatible = result.Summary.BreakingCount == 0
+	return result, nil
+}
+
+// parseOpenAPIForDiff parses content and inlines local $refs so schemas can
+// be compared structurally
+func parseOpenAPIForDiff(ctx context.Context, content string) (map[string]interface{}, error) {
+	parsed, _, err := parseSchemaDocument(content)
+	if err != nil {
+		return nil, err
+	}
+	doc, ok := parsed.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("OpenAPI document must be an object")
+	}
+	dereferenced, _ := newRefResolver(ctx, nil).dereference(doc).(map[string]interface{})
+	return dereferenced, nil
+}
+
+// openAPIDiff accumulates the changes between two documents
+type openAPIDiff struct {
+	changes []SchemaChange
+}
+
+func (d *openAPIDiff) add(change SchemaChange) {
+	if change.Impact == "" {
+		change.Impact = "low"
+		if change.IsBreaking {
+			change.Impact = "high"
+		}
+	}
+	d.changes = append(d.changes, change)
+}
+
+func (d *openAPIDiff) diffPaths(oldDoc, newDoc map[string]interface{}) {
+	oldPaths, _ := oldDoc["paths"].(map[string]interface{})
+	newPaths, _ := newDoc["paths"].(map[string]interface{})
+
+	for _, path := range unionKeys(oldPaths, newPaths) {
+		oldItem, _ := oldPaths[path].(map[string]interface{})
+		newItem, _ := newPaths[path].(map[string]interface{})
+
+		for _, method := range openAPIOperationMethods {
+			oldOp, hadOp := oldItem[method].(map[string]interface{})
+			newOp, hasOp := newItem[method].(map[string]interface{})
+			endpoint := strings.ToUpper(method) + " " + path
+			location := "/paths/" + escapeJSONPointer(path) + "/" + method
+
+			switch {
+			case hadOp && !hasOp:
+				d.add(SchemaChange{
+					Type:        SchemaChangeRemoved,
+					Category:    SchemaChangeCategoryEndpoint,
+					Path:        location,
+					OldValue:    endpoint,
+					IsBreaking:  true,
+					Description: fmt.Sprintf("endpoint %s was removed", endpoint),
+					Suggestion:  "Deprecate the endpoint before removing it in a major version",
+				})
+			case !hadOp && hasOp:
+				d.add(SchemaChange{
+					Type:        SchemaChangeAdded,
+					Category:    SchemaChangeCategoryEndpoint,
+					Path:        location,
+					NewValue:    endpoint,
+					Description: fmt.Sprintf("endpoint %s was added", endpoint),
+				})
+			case hadOp && hasOp:
+				d.diffDeprecation(oldOp, newOp, location, SchemaChangeCategoryEndpoint, "endpoint "+endpoint)
+				d.diffOperation(location, endpoint,
+					operationParameters(oldItem, oldOp), operationParameters(newItem, newOp), oldOp, newOp)
+			}
+		}
+	}
+}
+
+func (d *openAPIDiff) diffOperation(location, endpoint string, oldParams, newParams map[string]map[string]interface{}, oldOp, newOp map[string]interface{}) {
+	for _, key := range unionParamKeys(oldParams, newParams) {
+		oldParam, hadParam := oldParams[key]
+		newParam, hasParam := newParams[key]
+		in, name, _ := strings.Cut(key, ":")
+		paramPath := location + "/parameters/" + escapeJSONPointer(key)
+		oldRequired, _ := oldParam["required"].(bool)
+		newRequired, _ := newParam["required"].(bool)
+
+		switch {
+		case !hadParam:
+			d.add(SchemaChange{
+				Type:        SchemaChangeAdded,
+				Category:    SchemaChangeCategoryParameter,
+				Path:        paramPath,
+				NewValue:    name,
+				IsBreaking:  newRequired,
+				Description: fmt.Sprintf("%s %s parameter %q was added to %s", requiredLabel(newRequired), in, name, endpoint),
+				Suggestion:  suggestIf(newRequired, "Make new parameters optional or give them a default"),
+			})
+		case !hasParam:
+			d.add(SchemaChange{
+				Type:        SchemaChangeRemoved,
+				Category:    SchemaChangeCategoryParameter,
+				Path:        paramPath,
+				OldValue:    name,
+				Impact:      "medium",
+				Description: fmt.Sprintf("%s parameter %q was removed from %s", in, name, endpoint),
+			})
+		default:
+			if !oldRequired && newRequired {
+				d.add(SchemaChange{
+					Type:        SchemaChangeModified,
+					Category:    SchemaChangeCategoryParameter,
+					Path:        paramPath,
+					OldValue:    "optional",
+					NewValue:    "required",
+					IsBreaking:  true,
+					Description: fmt.Sprintf("%s parameter %q of %s became required", in, name, endpoint),
+					Suggestion:  "Keep the parameter optional or give it a default",
+				})
+			}
+			d.diffDeprecation(oldParam, newParam, paramPath, SchemaChangeCategoryParameter,
+				fmt.Sprintf("%s parameter %q of %s", in, name, endpoint))
+			oldSchema, _ := oldParam["schema"].(map[string]interface{})
+			newSchema, _ := newParam["schema"].(map[string]interface{})
+			d.diffSchema(oldSchema, newSchema, paramPath+"/schema", requestDirection, 0)
+		}
+	}
+
+	d.diffRequestBody(location, endpoint, oldOp, newOp)
+	d.diffResponses(location, endpoint, oldOp, newOp)
+}
+
+func (d *openAPIDiff) diffRequestBody(location, endpoint string, oldOp, newOp map[string]interface{}) {
+	oldBody, hadBody := oldOp["requestBody"].(map[string]interface{})
+	newBody, hasBody := newOp["requestBody"].(map[string]interface{})
+	bodyPath := location + "/requestBody"
+	oldRequired, _ := oldBody["required"].(bool)
+	newRequired, _ := newBody["required"].(bool)
+
+	switch {
+	case !hadBody && hasBody:
+		d.add(SchemaChange{
+			Type:        SchemaChangeAdded,
+			Category:    SchemaChangeCategoryParameter,
+			Path:        bodyPath,
+			IsBreaking:  newRequired,
+			Description: fmt.Sprintf("%s request body was added to %s", requiredLabel(newRequired), endpoint),
+			Suggestion:  suggestIf(newRequired, "Make the request body optional"),
+		})
+		return
+	case hadBody && !hasBody:
+		d.add(SchemaChange{
+			Type:        SchemaChangeRemoved,
+			Category:    SchemaChangeCategoryParameter,
+			Path:        bodyPath,
+			Impact:      "medium",
+			Description: fmt.Sprintf("request body was removed from %s", endpoint),
+		})
+		return
+	case !hadBody:
+		return
+	}
+
+	if !oldRequired && newRequired {
+		d.add(SchemaChange{
+			Type:        SchemaChangeModified,
+			Category:    SchemaChangeCategoryParameter,
+			Path:        bodyPath,
+			OldValue:    "optional",
+			NewValue:    "required",
+			IsBreaking:  true,
+			Description: fmt.Sprintf("request body of %s became required", endpoint),
+			Suggestion:  "Keep the request body optional",
+		})
+	}
+	oldSchema, _ := jsonMediaSchema(oldBody)
+	newSchema, _ := jsonMediaSchema(newBody)
+	d.diffSchema(oldSchema, newSchema, bodyPath+"/content/application~1json/schema", requestDirection, 0)
+}
+
+func (d *openAPIDiff) diffResponses(location, endpoint string, oldOp, newOp map[string]interface{}) {
+	oldResponses, _ := oldOp["responses"].(map[string]interface{})
+	newResponses, _ := newOp["responses"].(map[string]interface{})
+
+	for _, status := range unionKeys(oldResponses, newResponses) {
+		oldResponse, hadResponse := oldResponses[status].(map[string]interface{})
+		newResponse, hasResponse := newResponses[status].(map[string]interface{})
+		responsePath := location + "/responses/" + escapeJSONPointer(status)
+
+		switch {
+		case hadResponse && !hasResponse:
+			success := strings.HasPrefix(status, "2")
+			d.add(SchemaChange{
+				Type:        SchemaChangeRemoved,
+				Category:    SchemaChangeCategoryResponse,
+				Path:        responsePath,
+				OldValue:    status,
+				IsBreaking:  success,
+				Description: fmt.Sprintf("%s response was removed from %s", status, endpoint),
+				Suggestion:  suggestIf(success, "Keep returning the documented success response"),
+			})
+		case !hadResponse && hasResponse:
+			d.add(SchemaChange{
+				Type:        SchemaChangeAdded,
+				Category:    SchemaChangeCategoryResponse,
+				Path:        responsePath,
+				NewValue:    status,
+				Description: fmt.Sprintf("%s response was added to %s", status, endpoint),
+			})
+		case hadResponse && hasResponse:
+			oldSchema, _ := jsonMediaSchema(oldResponse)
+			newSchema, _ := jsonMediaSchema(newResponse)
+			d.diffSchema(oldSchema, newSchema, responsePath+"/content/application~1json/schema", responseDirection, 0)
+		}
+	}
+}
+
+// diffSchema compares two schemas. In requests, the new schema must accept
+// everything the old one did; in responses, it must not return anything the
+// old one did not promise.
+func (d *openAPIDiff) diffSchema(oldSchema, newSchema map[string]interface{}, path string, direction schemaDirection, depth int) {
+	if oldSchema == nil || newSchema == nil || depth > maxSchemaDiffDepth {
+		return
+	}
+	// Cyclic references are left in place by the resolver
+	if _, ok := oldSchema["$ref"]; ok {
+		return
+	}
+	if _, ok := newSchema["$ref"]; ok {
+		return
+	}
+
+	oldType, newType := schemaType(oldSchema), schemaType(newSchema)
+	if oldType != "" && newType != "" && oldType != newType {
+		widened := oldType == "integer" && newType == "number"
+		narrowed := oldType == "number" && newType == "integer"
+		breaking := (direction == requestDirection && !widened) || (direction == responseDirection && !narrowed)
+		d.add(SchemaChange{
+			Type:        SchemaChangeModified,
+			Category:    SchemaChangeCategoryProperty,
+			Path:        path,
+			OldValue:    oldType,
+			NewValue:    newType,
+			IsBreaking:  breaking,
+			Description: fmt.Sprintf("type changed from %s to %s", oldType, newType),
+			Suggestion:  suggestIf(breaking, "Introduce a new field instead of changing the type"),
+		})
+		return
+	}
+
+	d.diffEnum(oldSchema, newSchema, path, direction)
+
+	switch oldType {
+	case "array":
+		oldItems, _ := oldSchema["items"].(map[string]interface{})
+		newItems, _ := newSchema["items"].(map[string]interface{})
+		d.diffSchema(oldItems, newItems, path+"/items", direction, depth+1)
+	case "object":
+		d.diffProperties(oldSchema, newSchema, path, direction, depth)
+	}
+}
+
+func (d *openAPIDiff) diffProperties(oldSchema, newSchema map[string]interface{}, path string, direction schemaDirection, depth int) {
+	oldProps, _ := oldSchema["properties"].(map[string]interface{})
+	newProps, _ := newSchema["properties"].(map[string]interface{})
+	oldRequired, newRequired := requiredSet(oldSchema), requiredSet(newSchema)
+
+	for _, name := range unionKeys(oldProps, newProps) {
+		oldProp, hadProp := oldProps[name].(map[string]interface{})
+		newProp, hasProp := newProps[name].(map[string]interface{})
+		propPath := path + "/properties/" + escapeJSONPointer(name)
+
+		switch {
+		case !hadProp && hasProp:
+			// Clients cannot send a new required field
+			breaking := direction == requestDirection && newRequired[name]
+			d.add(SchemaChange{
+				Type:        SchemaChangeAdded,
+				Category:    SchemaChangeCategoryProperty,
+				Path:        propPath,
+				NewValue:    name,
+				IsBreaking:  breaking,
+				Description: fmt.Sprintf("%s property %q was added", requiredLabel(newRequired[name]), name),
+				Suggestion:  suggestIf(breaking, "Make new request properties optional"),
+			})
+		case hadProp && !hasProp:
+			// Clients rely on required response fields being present
+			breaking := direction == responseDirection && oldRequired[name]
+			d.add(SchemaChange{
+				Type:        SchemaChangeRemoved,
+				Category:    SchemaChangeCategoryProperty,
+				Path:        propPath,
+				OldValue:    name,
+				IsBreaking:  breaking,
+				Description: fmt.Sprintf("%s property %q was removed", requiredLabel(oldRequired[name]), name),
+				Suggestion:  suggestIf(breaking, "Deprecate the field and keep returning it"),
+			})
+		case hadProp && hasProp:
+			if oldRequired[name] != newRequired[name] {
+				// Requests may not start requiring a field; responses may not stop guaranteeing one
+				breaking := (direction == requestDirection && newRequired[name]) ||
+					(direction == responseDirection && oldRequired[name])
+				d.add(SchemaChange{
+					Type:        SchemaChangeModified,
+					Category:    SchemaChangeCategoryProperty,
+					Path:        propPath,
+					OldValue:    requiredLabel(oldRequired[name]),
+					NewValue:    requiredLabel(newRequired[name]),
+					IsBreaking:  breaking,
+					Description: fmt.Sprintf("property %q changed from %s to %s", name, requiredLabel(oldRequired[name]), requiredLabel(newRequired[name])),
+				})
+			}
+			d.diffDeprecation(oldProp, newProp, propPath, SchemaChangeCategoryProperty, fmt.Sprintf("property %q", name))
+			d.diffSchema(oldProp, newProp, propPath, direction, depth+1)
+		}
+	}
+}
+
+func (d *openAPIDiff) diffEnum(oldSchema, newSchema map[string]interface{}, path string, direction schemaDirection) {
+	oldValues, hadEnum := oldSchema["enum"].([]interface{})
+	newValues, hasEnum := newSchema["enum"].([]interface{})
+	if !hadEnum && !hasEnum {
+		return
+	}
+	if !hadEnum {
+		// Restricting a request value to an enum narrows it
+		d.add(SchemaChange{
+			Type:        SchemaChangeModified,
+			Category:    SchemaChangeCategoryEnum,
+			Path:        path,
+			NewValue:    newValues,
+			IsBreaking:  direction == requestDirection,
+			Description: "values were restricted to an enum",
+		})
+		return
+	}
+	if !hasEnum {
+		d.add(SchemaChange{
+			Type:        SchemaChangeModified,
+			Category:    SchemaChangeCategoryEnum,
+			Path:        path,
+			OldValue:    oldValues,
+			IsBreaking:  direction == responseDirection,
+			Description: "enum restriction was removed",
+		})
+		return
+	}
+
+	oldSet, newSet := enumSet(oldValues), enumSet(newValues)
+	for _, value := range sortedSetKeys(oldSet) {
+		if !newSet[value] {
+			breaking := direction == requestDirection
+			d.add(SchemaChange{
+				Type:        SchemaChangeRemoved,
+				Category:    SchemaChangeCategoryEnum,
+				Path:        path,
+				OldValue:    value,
+				IsBreaking:  breaking,
+				Description: fmt.Sprintf("enum value %q was removed", value),
+				Suggestion:  suggestIf(breaking, "Keep accepting the value and document it as deprecated"),
+			})
+		}
+	}
+	for _, value := range sortedSetKeys(newSet) {
+		if !oldSet[value] {
+			breaking := direction == responseDirection
+			d.add(SchemaChange{
+				Type:        SchemaChangeAdded,
+				Category:    SchemaChangeCategoryEnum,
+				Path:        path,
+				NewValue:    value,
+				IsBreaking:  breaking,
+				Description: fmt.Sprintf("enum value %q was added", value),
+				Suggestion:  suggestIf(breaking, "Clients with exhaustive handling may reject the new value"),
+			})
+		}
+	}
+}
+
+// diffDeprecation reports an element the new document newly marks as
+// deprecated. Deprecation warns clients without breaking them.
+func (d *openAPIDiff) diffDeprecation(oldNode, newNode map[string]interface{}, path, category, what string) {
+	wasDeprecated, _ := oldNode["deprecated"].(bool)
+	isDeprecated, _ := newNode["deprecated"].(bool)
+	if isDeprecated && !wasDeprecated {
+		d.add(SchemaChange{
+			Type:        SchemaChangeDeprecated,
+			Category:    category,
+			Path:        path,
+			Description: fmt.Sprintf("%s was deprecated", what),
+			Suggestion:  "Migrate clients before the element is removed",
+		})
+	}
+}
+
+// diffModels reports added and removed component schemas. Removing a model
+// is not breaking by itself; operations that used it report their own changes.
+func (d *openAPIDiff) diffModels(oldDoc, newDoc map[string]interface{}) {
+	oldModels, newModels := componentSchemas(oldDoc), componentSchemas(newDoc)
+	for _, name := range unionKeys(oldModels, newModels) {
+		oldModel, had := oldModels[name]
+		newModel, has := newModels[name]
+		path := "/components/schemas/" + escapeJSONPointer(name)
+		switch {
+		case had && has:
+			oldSchema, _ := oldModel.(map[string]interface{})
+			newSchema, _ := newModel.(map[string]interface{})
+			d.diffDeprecation(oldSchema, newSchema, path, SchemaChangeCategoryModel, fmt.Sprintf("model %q", name))
+		case had && !has:
+			d.add(SchemaChange{Type: SchemaChangeRemoved, Category: SchemaChangeCategoryModel, Path: path, OldValue: name,
+				Description: fmt.Sprintf("model %q was removed", name)})
+		case !had && has:
+			d.add(SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryModel, Path: path, NewValue: name,
+				Description: fmt.Sprintf("model %q was added", name)})
+		}
+	}
+}
+
+// operationParameters returns path-level and operation parameters keyed by
+// "in:name", with operation parameters overriding path-level ones
+func operationParameters(item, operation map[string]interface{}) map[string]map[string]interface{} {
+	params := map[string]map[string]interface{}{}
+	for _, source := range []interface{}{item["parameters"], operation["parameters"]} {
+		list, _ := source.([]interface{})
+		for _, p := range list {
+			param, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			params[fmt.Sprintf("%v:%v", param["in"], param["name"])] = param
+		}
+	}
+	return params
+}
+
+func componentSchemas(doc map[string]interface{}) map[string]interface{} {
+	components, _ := doc["components"].(map[string]interface{})
+	schemas, _ := components["schemas"].(map[string]interface{})
+	return schemas
+}
+
+func requiredSet(schema map[string]interface{}) map[string]bool {
+	set := map[string]bool{}
+	list, _ := schema["required"].([]interface{})
+	for _, r := range list {
+		if name, ok := r.(string); ok {
+			set[name] = true
+		}
+	}
+	return set
+}
+
+func enumSet(values []interface{}) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[fmt.Sprint(v)] = true
+	}
+	return set
+}
+
+func sortedSetKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// unionKeys returns the keys of both maps in sorted order
+func unionKeys(a, b map[string]interface{}) []string {
+	seen := map[string]bool{}
+	for k := range a {
+		seen[k] = true
+	}
+	for k := range b {
+		seen[k] = true
+	}
+	return sortedSetKeys(seen)
+}
+
+func unionParamKeys(a, b map[string]map[string]interface{}) []string {
+	seen := map[string]bool{}
+	for k := range a {
+		seen[k] = true
+	}
+	for k := range b {
+		seen[k] = true
+	}
+	return sortedSetKeys(seen)
+}
+
+func requiredLabel(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
+
+func suggestIf(condition bool, suggestion string) string {
+	if condition {
+		return suggestion
+	}
+	return ""
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+const compatibilityBaseSpec = `
+openapi: 3.0.3
+info: {title: Pets, version: 1.0.0}
+paths:
+  /pets:
+    get:
+      parameters:
+        - {name: limit, in: query, schema: {type: integer}}
+      responses:
+        "200":
+          description: Pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items: {$ref: "#/components/schemas/Pet"}
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema: {$ref: "#/components/schemas/NewPet"}
+      responses:
+        "201": {description: Created}
+  /pets/{id}:
+    delete:
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string}}
+      responses:
+        "204": {description: Deleted}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id: {type: string}
+        name: {type: string}
+        tag: {type: string}
+    NewPet:
+      type: object
+      required: [name]
+      properties:
+        name: {type: string}
+        age: {type: integer}
+        weight: {type: number}
+        status: {type: string, enum: [available, sold]}
+`
+
+// editSpec returns the base spec as JSON after applying edit to its decoded form
+func editSpec(t *testing.T, edit func(doc map[string]interface{})) string {
+	t.Helper()
+	parsed, _, err := parseSchemaDocument(compatibilityBaseSpec)
+	require.NoError(t, err)
+	doc := parsed.(map[string]interface{})
+	edit(doc)
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return string(out)
+}
+
+// node returns the object at a JSON pointer in doc
+func node(t *testing.T, doc map[string]interface{}, pointer string) map[string]interface{} {
+	t.Helper()
+	target, err := lookupJSONPointer(doc, pointer)
+	require.NoError(t, err)
+	return target.(map[string]interface{})
+}
+
+func TestOpenAPIValidator_ValidateCompatibility(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(t *testing.T, doc map[string]interface{})
+		breaking bool
+		change   SchemaChange // expected Type, Category and Path
+	}{
+		{
+			name: "removed endpoint",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				delete(node(t, doc, "/paths/~1pets~1{id}"), "delete")
+			},
+			breaking: true,
+			change:   SchemaChange{Type: SchemaChangeRemoved, Category: SchemaChangeCategoryEndpoint, Path: "/paths/~1pets~1{id}/delete"},
+		},
+		{
+			name: "removed required response field",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				delete(node(t, doc, "/components/schemas/Pet/properties"), "name")
+			},
+			breaking: true,
+			change: SchemaChange{Type: SchemaChangeRemoved, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/get/responses/200/content/application~1json/schema/items/properties/name"},
+		},
+		{
+			name: "response field made optional",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas/Pet")["required"] = []interface{}{"id"}
+			},
+			breaking: true,
+			change: SchemaChange{Type: SchemaChangeModified, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/get/responses/200/content/application~1json/schema/items/properties/name"},
+		},
+		{
+			name: "added required request parameter",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				get := node(t, doc, "/paths/~1pets/get")
+				get["parameters"] = append(get["parameters"].([]interface{}),
+					map[string]interface{}{"name": "owner", "in": "query", "required": true, "schema": map[string]interface{}{"type": "string"}})
+			},
+			breaking: true,
+			change:   SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryParameter, Path: "/paths/~1pets/get/parameters/query:owner"},
+		},
+		{
+			name: "added required request property",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				newPet := node(t, doc, "/components/schemas/NewPet")
+				newPet["properties"].(map[string]interface{})["owner"] = map[string]interface{}{"type": "string"}
+				newPet["required"] = []interface{}{"name", "owner"}
+			},
+			breaking: true,
+			change: SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/post/requestBody/content/application~1json/schema/properties/owner"},
+		},
+		{
+			name: "narrowed request type",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas/NewPet/properties/weight")["type"] = "integer"
+			},
+			breaking: true,
+			change: SchemaChange{Type: SchemaChangeModified, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/post/requestBody/content/application~1json/schema/properties/weight"},
+		},
+		{
+			name: "removed request enum value",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas/NewPet/properties/status")["enum"] = []interface{}{"available"}
+			},
+			breaking: true,
+			change: SchemaChange{Type: SchemaChangeRemoved, Category: SchemaChangeCategoryEnum,
+				Path: "/paths/~1pets/post/requestBody/content/application~1json/schema/properties/status"},
+		},
+		{
+			name: "added endpoint",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/paths")["/owners"] = map[string]interface{}{
+					"get": map[string]interface{}{"responses": map[string]interface{}{"200": map[string]interface{}{"description": "Owners"}}},
+				}
+			},
+			change: SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryEndpoint, Path: "/paths/~1owners/get"},
+		},
+		{
+			name: "added optional request parameter",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				get := node(t, doc, "/paths/~1pets/get")
+				get["parameters"] = append(get["parameters"].([]interface{}),
+					map[string]interface{}{"name": "tag", "in": "query", "schema": map[string]interface{}{"type": "string"}})
+			},
+			change: SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryParameter, Path: "/paths/~1pets/get/parameters/query:tag"},
+		},
+		{
+			name: "added response field",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas/Pet/properties")["color"] = map[string]interface{}{"type": "string"}
+			},
+			change: SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/get/responses/200/content/application~1json/schema/items/properties/color"},
+		},
+		{
+			name: "removed optional response field",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				delete(node(t, doc, "/components/schemas/Pet/properties"), "tag")
+			},
+			change: SchemaChange{Type: SchemaChangeRemoved, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/get/responses/200/content/application~1json/schema/items/properties/tag"},
+		},
+		{
+			name: "widened request type",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas/NewPet/properties/age")["type"] = "number"
+			},
+			change: SchemaChange{Type: SchemaChangeModified, Category: SchemaChangeCategoryProperty,
+				Path: "/paths/~1pets/post/requestBody/content/application~1json/schema/properties/age"},
+		},
+		{
+			name: "added request enum value",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas/NewPet/properties/status")["enum"] = []interface{}{"available", "pending", "sold"}
+			},
+			change: SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryEnum,
+				Path: "/paths/~1pets/post/requestBody/content/application~1json/schema/properties/status"},
+		},
+		{
+			name: "added model",
+			edit: func(t *testing.T, doc map[string]interface{}) {
+				node(t, doc, "/components/schemas")["Owner"] = map[string]interface{}{"type": "object"}
+			},
+			change: SchemaChange{Type: SchemaChangeAdded, Category: SchemaChangeCategoryModel, Path: "/components/schemas/Owner"},
+		},
+	}
+
+	validator := newTestOpenAPIValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := editSpec(t, func(doc map[string]interface{}) { tt.edit(t, doc) })
+
+			result, err := validator.ValidateCompatibility(context.Background(), compatibilityBaseSpec, next, SchemaFormatOpenAPI)
+			require.NoError(t, err)
+
+			var found *SchemaChange
+			for i := range result.Changes {
+				c := result.Changes[i]
+				if c.Type == tt.change.Type && c.Category == tt.change.Category && c.Path == tt.change.Path {
+					found = &result.Changes[i]
+				}
+			}
+			require.NotNil(t, found, "change not reported; got %+v", result.Changes)
+			assert.Equal(t, tt.breaking, found.IsBreaking)
+			assert.NotEmpty(t, found.Description)
+
+			if tt.breaking {
+				assert.False(t, result.IsCompatible)
+				assert.Equal(t, CompatibilityLevelBreaking, result.Compatibility)
+				assert.Contains(t, result.BreakingChanges, *found)
+			} else {
+				assert.True(t, result.IsCompatible, "unexpected breaking changes: %+v", result.BreakingChanges)
+				assert.Equal(t, CompatibilityLevelBackward, result.Compatibility)
+				assert.Empty(t, result.BreakingChanges)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidator_ValidateCompatibility_Summary(t *testing.T) {
+	validator := newTestOpenAPIValidator()
+
+	unchanged, err := validator.ValidateCompatibility(context.Background(), compatibilityBaseSpec, compatibilityBaseSpec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	assert.True(t, unchanged.IsCompatible)
+	assert.Equal(t, CompatibilityLevelFull, unchanged.Compatibility)
+	assert.Empty(t, unchanged.Changes)
+	assert.Equal(t, 100, unchanged.Summary.CompatibilityScore)
+
+	next := editSpec(t, func(doc map[string]interface{}) {
+		delete(node(t, doc, "/paths/~1pets~1{id}"), "delete")                                                  // removed, breaking
+		node(t, doc, "/components/schemas/Pet/properties")["color"] = map[string]interface{}{"type": "string"} // added
+		node(t, doc, "/components/schemas/NewPet/properties/weight")["type"] = "integer"                       // modified, breaking
+	})
+	result, err := validator.ValidateCompatibility(context.Background(), compatibilityBaseSpec, next, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+
+	assert.Equal(t, CompatibilitySummary{
+		TotalChanges:       3,
+		AddedCount:         1,
+		RemovedCount:       1,
+		ModifiedCount:      1,
+		BreakingCount:      2,
+		CompatibilityScore: 60,
+	}, result.Summary)
+	assert.Len(t, result.BreakingChanges, 2)
+	assert.False(t, result.CheckedAt.IsZero())
+}
+
+func TestOpenAPIValidator_ValidateCompatibility_UnsupportedFormat(t *testing.T) {
+	_, err := newTestOpenAPIValidator().ValidateCompatibility(context.Background(), "a", "b", SchemaFormatGraphQL)
+	assert.ErrorIs(t, err, ErrSchemaValidationFailed)
+}
+
+func TestSchemaService_CreateSchemaVersion_BlocksUndeclaredBreakingChanges(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+
+	// No validator given: the service checks OpenAPI compatibility with OpenAPIValidator
+	service := NewSchemaService(newMemorySchemaRepository(), nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		nil, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     compatibilityBaseSpec,
+		Version:     "1.0.0",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	removed := editSpec(t, func(doc map[string]interface{}) {
+		delete(node(t, doc, "/paths/~1pets~1{id}"), "delete")
+	})
+	_, err = service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID:  schema.ID,
+		Version:   "1.1.0",
+		Content:   removed,
+		CreatedBy: actor,
+	})
+	assert.ErrorIs(t, err, ErrBreakingChangesNotAllowed)
+
+	version, err := service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID:   schema.ID,
+		Version:    "2.0.0",
+		Content:    removed,
+		IsBreaking: true,
+		CreatedBy:  actor,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", version.Version)
+}
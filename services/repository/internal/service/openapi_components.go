@@ -0,0 +1,441 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxLocalRefDepth bounds how many chained local $refs are followed
+const maxLocalRefDepth = 16
+
+// openAPIComponentExtractor collects the models, enums, endpoints, references
+// and extensions of an OpenAPI 3.x document. Parameters, request bodies and
+// responses given as local $refs are resolved; schemas are reported as
+// written, with $refs in place.
+type openAPIComponentExtractor struct {
+	doc map[string]interface{}
+}
+
+func newOpenAPIComponentExtractor(doc map[string]interface{}) *openAPIComponentExtractor {
+	return &openAPIComponentExtractor{doc: doc}
+}
+
+func (e *openAPIComponentExtractor) extract() SchemaComponents {
+	components := SchemaComponents{
+		Models:     []ComponentModel{},
+		Endpoints:  []ComponentEndpoint{},
+		Enums:      []ComponentEnum{},
+		References: []ComponentReference{},
+		Extensions: []ComponentExtension{},
+	}
+
+	schemas := componentSchemas(e.doc)
+	for _, name := range sortedKeys(schemas) {
+		schema, ok := schemas[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if values, ok := schema["enum"].([]interface{}); ok {
+			components.Enums = append(components.Enums, ComponentEnum{
+				Name:        name,
+				Type:        schemaType(schema),
+				Values:      enumStrings(values),
+				Description: schemaString(schema, "description", "title"),
+			})
+			continue
+		}
+		components.Models = append(components.Models, e.model(name, schema))
+	}
+
+	paths, _ := e.doc["paths"].(map[string]interface{})
+	for _, path := range sortedKeys(paths) {
+		item := e.resolve(paths[path])
+		for _, method := range openAPIOperationMethods {
+			if operation, ok := item[method].(map[string]interface{}); ok {
+				components.Endpoints = append(components.Endpoints, e.endpoint(path, method, item, operation))
+			}
+		}
+	}
+
+	components.References = e.references()
+
+	for _, key := range sortedKeys(e.doc) {
+		if strings.HasPrefix(key, "x-") {
+			components.Extensions = append(components.Extensions, ComponentExtension{Name: key, Value: e.doc[key]})
+		}
+	}
+	return components
+}
+
+// model describes a component schema
+func (e *openAPIComponentExtractor) model(name string, schema map[string]interface{}) ComponentModel {
+	model := ComponentModel{
+		Name:        name,
+		Type:        schemaTypeName(schema),
+		Properties:  []ComponentProperty{},
+		Required:    []string{},
+		Description: schemaString(schema, "description", "title"),
+		Example:     schema["example"],
+		Metadata:    map[string]interface{}{},
+	}
+
+	required := requiredSet(schema)
+	model.Required = sortedSetKeys(required)
+	properties, _ := schema["properties"].(map[string]interface{})
+	for _, propName := range sortedKeys(properties) {
+		prop, _ := properties[propName].(map[string]interface{})
+		model.Properties = append(model.Properties, ComponentProperty{
+			Name:        propName,
+			Type:        schemaTypeName(prop),
+			Format:      schemaString(prop, "format"),
+			Description: schemaString(prop, "description", "title"),
+			Required:    required[propName],
+			Default:     prop["default"],
+			Example:     prop["example"],
+			Constraints: propertyConstraints(prop),
+		})
+	}
+
+	if branches, keyword := compositionBranches(schema); branches != nil {
+		model.Metadata["composition"] = keyword
+	}
+	for _, key := range []string{"deprecated", "nullable", "readOnly", "writeOnly"} {
+		if flag, ok := schema[key].(bool); ok && flag {
+			model.Metadata[key] = true
+		}
+	}
+	return model
+}
+
+// endpoint describes an operation together with the parameters inherited
+// from its path item
+func (e *openAPIComponentExtractor) endpoint(path, method string, item, operation map[string]interface{}) ComponentEndpoint {
+	endpoint := ComponentEndpoint{
+		Path:        path,
+		Method:      strings.ToUpper(method),
+		OperationID: schemaString(operation, "operationId"),
+		Summary:     schemaString(operation, "summary"),
+		Description: schemaString(operation, "description"),
+		Parameters:  e.parameters(item, operation),
+		Responses:   []ComponentResponse{},
+		Tags:        []string{},
+		Security:    e.security(operation),
+		Metadata:    map[string]interface{}{},
+	}
+
+	if body := e.resolve(operation["requestBody"]); body != nil {
+		required, _ := body["required"].(bool)
+		endpoint.RequestBody = &ComponentRequestBody{
+			Description: schemaString(body, "description"),
+			Required:    required,
+			Content:     mediaContent(body),
+		}
+	}
+
+	responses, _ := operation["responses"].(map[string]interface{})
+	for _, code := range sortedKeys(responses) {
+		response := e.resolve(responses[code])
+		if response == nil {
+			continue
+		}
+		endpoint.Responses = append(endpoint.Responses, ComponentResponse{
+			Code:        code,
+			Description: schemaString(response, "description"),
+			Headers:     e.headers(response),
+			Content:     mediaContent(response),
+		})
+	}
+
+	tags, _ := operation["tags"].([]interface{})
+	for _, tag := range tags {
+		if name, ok := tag.(string); ok {
+			endpoint.Tags = append(endpoint.Tags, name)
+		}
+	}
+	if deprecated, _ := operation["deprecated"].(bool); deprecated {
+		endpoint.Metadata["deprecated"] = true
+	}
+	return endpoint
+}
+
+// parameters merges path item and operation parameters, the operation's
+// taking precedence, ordered by location and name
+func (e *openAPIComponentExtractor) parameters(item, operation map[string]interface{}) []ComponentParameter {
+	byKey := make(map[string]map[string]interface{})
+	for _, source := range []interface{}{item["parameters"], operation["parameters"]} {
+		list, _ := source.([]interface{})
+		for _, raw := range list {
+			if param := e.resolve(raw); param != nil {
+				byKey[fmt.Sprintf("%v:%v", param["in"], param["name"])] = param
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(byKey))
+	for key := range byKey {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		left, right := byKey[keys[i]], byKey[keys[j]]
+		if li, ri := parameterOrder(left), parameterOrder(right); li != ri {
+			return li < ri
+		}
+		return keys[i] < keys[j]
+	})
+
+	params := make([]ComponentParameter, 0, len(keys))
+	for _, key := range keys {
+		param := byKey[key]
+		schema, _ := param["schema"].(map[string]interface{})
+		required, _ := param["required"].(bool)
+		example := param["example"]
+		if example == nil && schema != nil {
+			example = schema["example"]
+		}
+		params = append(params, ComponentParameter{
+			Name:        schemaString(param, "name"),
+			In:          schemaString(param, "in"),
+			Type:        schemaTypeName(schema),
+			Required:    required,
+			Description: schemaString(param, "description"),
+			Example:     example,
+			Schema:      param["schema"],
+		})
+	}
+	return params
+}
+
+// parameterOrder sorts path parameters first, then query, header and cookie
+func parameterOrder(param map[string]interface{}) int {
+	switch param["in"] {
+	case "path":
+		return 0
+	case "query":
+		return 1
+	case "header":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// headers describes the headers of a response
+func (e *openAPIComponentExtractor) headers(response map[string]interface{}) map[string]ComponentHeader {
+	headers := map[string]ComponentHeader{}
+	raw, _ := response["headers"].(map[string]interface{})
+	for name, value := range raw {
+		header := e.resolve(value)
+		if header == nil {
+			continue
+		}
+		schema, _ := header["schema"].(map[string]interface{})
+		required, _ := header["required"].(bool)
+		headers[name] = ComponentHeader{
+			Type:        schemaTypeName(schema),
+			Description: schemaString(header, "description"),
+			Required:    required,
+			Example:     header["example"],
+		}
+	}
+	return headers
+}
+
+// security returns the schemes an operation requires: its own requirements
+// or, when it declares none, the document's. An empty list opts out.
+func (e *openAPIComponentExtractor) security(operation map[string]interface{}) []ComponentSecurity {
+	requirements, ok := operation["security"].([]interface{})
+	if !ok {
+		requirements, _ = e.doc["security"].([]interface{})
+	}
+	components, _ := e.doc["components"].(map[string]interface{})
+	schemes, _ := components["securitySchemes"].(map[string]interface{})
+
+	security := []ComponentSecurity{}
+	for _, raw := range requirements {
+		requirement, _ := raw.(map[string]interface{})
+		for _, name := range sortedKeys(requirement) {
+			scheme := e.resolve(schemes[name])
+			schemeType := schemaString(scheme, "type")
+			if httpScheme := schemaString(scheme, "scheme"); schemeType == "http" && httpScheme != "" {
+				schemeType = "http:" + strings.ToLower(httpScheme)
+			}
+			scopes := []string{}
+			list, _ := requirement[name].([]interface{})
+			for _, scope := range list {
+				if s, ok := scope.(string); ok {
+					scopes = append(scopes, s)
+				}
+			}
+			security = append(security, ComponentSecurity{Type: schemeType, Name: name, Scopes: scopes})
+		}
+	}
+	return security
+}
+
+// references lists every $ref target once, in sorted order, with the
+// number of places it is referenced from
+func (e *openAPIComponentExtractor) references() []ComponentReference {
+	counts := make(map[string]int)
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				counts[ref]++
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(e.doc)
+
+	refs := make([]string, 0, len(counts))
+	for ref := range counts {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	references := make([]ComponentReference, 0, len(refs))
+	for _, ref := range refs {
+		docURI, pointer, _ := strings.Cut(ref, "#")
+		refType := "external"
+		if docURI == "" {
+			// #/components/<section>/<name>
+			refType = "local"
+			if parts := strings.Split(pointer, "/"); len(parts) == 4 && parts[1] == "components" {
+				refType = parts[2]
+			}
+		}
+		references = append(references, ComponentReference{
+			Name:        refName(ref),
+			Type:        refType,
+			Target:      ref,
+			Description: fmt.Sprintf("referenced %d time(s)", counts[ref]),
+		})
+	}
+	return references
+}
+
+// resolve follows local $refs, returning the target object
+func (e *openAPIComponentExtractor) resolve(node interface{}) map[string]interface{} {
+	m, _ := node.(map[string]interface{})
+	for depth := 0; m != nil && depth < maxLocalRefDepth; depth++ {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		pointer, ok := strings.CutPrefix(ref, "#")
+		if !ok {
+			return nil
+		}
+		target, err := lookupJSONPointer(e.doc, pointer)
+		if err != nil {
+			return nil
+		}
+		m, _ = target.(map[string]interface{})
+	}
+	return m
+}
+
+// mediaContent describes the content of a request body or response by media type
+func mediaContent(node map[string]interface{}) map[string]ComponentMedia {
+	content := map[string]ComponentMedia{}
+	raw, _ := node["content"].(map[string]interface{})
+	for mediaType, value := range raw {
+		media, _ := value.(map[string]interface{})
+		examples, _ := media["examples"].(map[string]interface{})
+		content[mediaType] = ComponentMedia{
+			Schema:   media["schema"],
+			Example:  media["example"],
+			Examples: examples,
+		}
+	}
+	return content
+}
+
+// schemaTypeName names the type a schema describes: the referenced
+// component for $refs, array<item> for arrays, the composition keyword for
+// oneOf and anyOf, and "any" when nothing constrains the type
+func schemaTypeName(schema map[string]interface{}) string {
+	if schema == nil {
+		return "any"
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		return refName(ref)
+	}
+	t := schemaType(schema)
+	switch {
+	case t == "array":
+		items, _ := schema["items"].(map[string]interface{})
+		return "array<" + schemaTypeName(items) + ">"
+	case t != "":
+		return t
+	}
+	if _, keyword := compositionBranches(schema); keyword != "" {
+		return keyword
+	}
+	return "any"
+}
+
+// refName is the last token of a $ref's JSON pointer, or the document
+// name for refs to a whole document
+func refName(ref string) string {
+	docURI, pointer, _ := strings.Cut(ref, "#")
+	if pointer == "" || pointer == "/" {
+		return docURI[strings.LastIndex(docURI, "/")+1:]
+	}
+	return unescapeJSONPointer(pointer[strings.LastIndex(pointer, "/")+1:])
+}
+
+// propertyConstraints collects the validation keywords of a property schema
+func propertyConstraints(schema map[string]interface{}) PropertyConstraints {
+	var constraints PropertyConstraints
+	if v, ok := schemaNumber(schema["minLength"]); ok {
+		n := int(v)
+		constraints.MinLength = &n
+	}
+	if v, ok := schemaNumber(schema["maxLength"]); ok {
+		n := int(v)
+		constraints.MaxLength = &n
+	}
+	if v, ok := schemaNumber(schema["minimum"]); ok {
+		constraints.Minimum = &v
+	}
+	if v, ok := schemaNumber(schema["maximum"]); ok {
+		constraints.Maximum = &v
+	}
+	constraints.Pattern = schemaString(schema, "pattern")
+	if values, ok := schema["enum"].([]interface{}); ok {
+		constraints.Enum = enumStrings(values)
+	}
+	return constraints
+}
+
+// schemaNumber reads a number decoded from either JSON or YAML
+func schemaNumber(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func enumStrings(values []interface{}) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, fmt.Sprint(v))
+	}
+	return out
+}
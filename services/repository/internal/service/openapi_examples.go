@@ -0,0 +1,323 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxExampleSchemaDepth bounds how deep an example is checked, so recursive
+// schemas cannot loop forever
+const maxExampleSchemaDepth = 32
+
+// exampleMismatch is a place where an example value disagrees with its schema
+type exampleMismatch struct {
+	path    string // JSON pointer into the example value
+	node    *yaml.Node
+	message string
+}
+
+// checkExamples reports examples that do not conform to the schema they
+// illustrate. Media types, parameters and headers carry their examples next
+// to a schema; schema objects may carry an example of their own.
+func (c *openAPIChecker) checkExamples(node *yaml.Node, path string) {
+	node = resolveAlias(node)
+	switch node.Kind {
+	case yaml.MappingNode:
+		if schema := mappingValue(node, "schema"); schema != nil {
+			if example := mappingValue(node, "example"); example != nil {
+				c.checkExample(schema, example, path+"/example")
+			}
+			if examples := mappingValue(node, "examples"); examples != nil && examples.Kind == yaml.MappingNode {
+				forEachMapping(examples, func(name, example *yaml.Node) {
+					examplePath := path + "/examples/" + escapeJSONPointer(name.Value)
+					if value := mappingValue(c.resolveNodeRef(example), "value"); value != nil {
+						c.checkExample(schema, value, examplePath+"/value")
+					}
+				})
+			}
+			c.checkSchemaExamples(schema, path+"/schema", 0)
+		}
+
+		forEachMapping(node, func(key, value *yaml.Node) {
+			switch {
+			case key.Value == "schema", key.Value == "example", key.Value == "examples", strings.HasPrefix(key.Value, "x-"):
+				// Example values and extensions are data, not document structure
+			case path == "/components" && key.Value == "schemas" && value.Kind == yaml.MappingNode:
+				forEachMapping(value, func(name, schema *yaml.Node) {
+					c.checkSchemaExamples(schema, "/components/schemas/"+escapeJSONPointer(name.Value), 0)
+				})
+			default:
+				c.checkExamples(value, path+"/"+escapeJSONPointer(key.Value))
+			}
+		})
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			c.checkExamples(child, fmt.Sprintf("%s/%d", path, i))
+		}
+	}
+}
+
+// checkSchemaExamples checks the example of a schema object and of the
+// schemas nested in it. Referenced schemas are checked where they are defined.
+func (c *openAPIChecker) checkSchemaExamples(schema *yaml.Node, path string, depth int) {
+	schema = resolveAlias(schema)
+	if schema.Kind != yaml.MappingNode || mappingValue(schema, "$ref") != nil || depth > maxExampleSchemaDepth {
+		return
+	}
+
+	if example := mappingValue(schema, "example"); example != nil {
+		c.checkExample(schema, example, path+"/example")
+	}
+	// OpenAPI 3.1 schemas list their examples as a JSON Schema array
+	if examples := mappingValue(schema, "examples"); examples != nil && examples.Kind == yaml.SequenceNode {
+		for i, example := range examples.Content {
+			c.checkExample(schema, example, fmt.Sprintf("%s/examples/%d", path, i))
+		}
+	}
+
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		if child := mappingValue(schema, key); child != nil {
+			c.checkSchemaExamples(child, path+"/"+key, depth+1)
+		}
+	}
+	if properties := mappingValue(schema, "properties"); properties != nil && properties.Kind == yaml.MappingNode {
+		forEachMapping(properties, func(name, child *yaml.Node) {
+			c.checkSchemaExamples(child, path+"/properties/"+escapeJSONPointer(name.Value), depth+1)
+		})
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		if branches := mappingValue(schema, key); branches != nil && branches.Kind == yaml.SequenceNode {
+			for i, child := range branches.Content {
+				c.checkSchemaExamples(child, fmt.Sprintf("%s/%s/%d", path, key, i), depth+1)
+			}
+		}
+	}
+}
+
+// checkExample warns about every place value disagrees with schema
+func (c *openAPIChecker) checkExample(schema, value *yaml.Node, path string) {
+	for _, m := range c.matchExample(schema, value, "", 0) {
+		message := "example does not match its schema: " + m.message
+		if m.path != "" {
+			message = fmt.Sprintf("example does not match its schema at %s: %s", m.path, m.message)
+		}
+		c.warning("INVALID_EXAMPLE", message, path+m.path, m.node, OpenAPIRuleExamples,
+			"Update the example or the schema so that they agree")
+	}
+}
+
+// matchExample validates value against schema, returning the mismatches
+// found. It covers the keywords that describe the shape of a value: type,
+// nullable, enum, required, properties, additionalProperties, items and the
+// composition keywords. oneOf is treated like anyOf, since loosely written
+// alternatives often overlap.
+func (c *openAPIChecker) matchExample(schema, value *yaml.Node, path string, depth int) []exampleMismatch {
+	schema, value = resolveAlias(schema), resolveAlias(value)
+	if depth > maxExampleSchemaDepth {
+		return nil
+	}
+	if mappingValue(schema, "$ref") != nil {
+		target := c.resolveNodeRef(schema)
+		if target == schema {
+			// Unresolved references are reported by the reference rule
+			return nil
+		}
+		return c.matchExample(target, value, path, depth+1)
+	}
+	if schema.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	actual := exampleType(value)
+	if types := schemaTypes(schema); len(types) > 0 && !typeAllowed(types, actual) {
+		return []exampleMismatch{{path, value, fmt.Sprintf("expected %s, got %s", strings.Join(types, " or "), actual)}}
+	}
+
+	if enum := mappingValue(schema, "enum"); enum != nil && enum.Kind == yaml.SequenceNode && value.Kind == yaml.ScalarNode {
+		allowed := false
+		for _, option := range enum.Content {
+			option = resolveAlias(option)
+			allowed = allowed || (option.Kind == yaml.ScalarNode && option.Value == value.Value && exampleType(option) == actual)
+		}
+		if !allowed {
+			return []exampleMismatch{{path, value, fmt.Sprintf("value %q is not one of the allowed values", value.Value)}}
+		}
+	}
+
+	var mismatches []exampleMismatch
+	switch value.Kind {
+	case yaml.MappingNode:
+		mismatches = append(mismatches, c.matchObject(schema, value, path, depth)...)
+	case yaml.SequenceNode:
+		if items := mappingValue(schema, "items"); items != nil {
+			for i, item := range value.Content {
+				mismatches = append(mismatches, c.matchExample(items, item, fmt.Sprintf("%s/%d", path, i), depth+1)...)
+			}
+		}
+	}
+
+	if allOf := mappingValue(schema, "allOf"); allOf != nil && allOf.Kind == yaml.SequenceNode {
+		for _, branch := range allOf.Content {
+			mismatches = append(mismatches, c.matchExample(branch, value, path, depth+1)...)
+		}
+	}
+	for _, key := range []string{"anyOf", "oneOf"} {
+		branches := mappingValue(schema, key)
+		if branches == nil || branches.Kind != yaml.SequenceNode || len(branches.Content) == 0 {
+			continue
+		}
+		matched := false
+		for _, branch := range branches.Content {
+			if len(c.matchExample(branch, value, path, depth+1)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			mismatches = append(mismatches, exampleMismatch{path, value, fmt.Sprintf("value matches none of the %s schemas", key)})
+		}
+	}
+	return mismatches
+}
+
+// matchObject checks the required and declared properties of an object value
+func (c *openAPIChecker) matchObject(schema, value *yaml.Node, path string, depth int) []exampleMismatch {
+	var mismatches []exampleMismatch
+	if required := mappingValue(schema, "required"); required != nil && required.Kind == yaml.SequenceNode {
+		for _, name := range required.Content {
+			if mappingValue(value, name.Value) == nil {
+				mismatches = append(mismatches, exampleMismatch{path, value, fmt.Sprintf("missing required property %q", name.Value)})
+			}
+		}
+	}
+
+	properties := mappingValue(schema, "properties")
+	additional := resolveAlias(mappingValue(schema, "additionalProperties"))
+	forEachMapping(value, func(key, child *yaml.Node) {
+		childPath := path + "/" + escapeJSONPointer(key.Value)
+		if property := mappingValue(properties, key.Value); property != nil {
+			mismatches = append(mismatches, c.matchExample(property, child, childPath, depth+1)...)
+			return
+		}
+		switch {
+		case additional == nil:
+		case additional.Kind == yaml.ScalarNode && additional.Value == "false":
+			mismatches = append(mismatches, exampleMismatch{childPath, key, fmt.Sprintf("property %q is not allowed", key.Value)})
+		case additional.Kind == yaml.MappingNode:
+			mismatches = append(mismatches, c.matchExample(additional, child, childPath, depth+1)...)
+		}
+	})
+	return mismatches
+}
+
+// resolveNodeRef follows a local $ref to the node it points at. Nodes without
+// a resolvable local $ref are returned unchanged.
+func (c *openAPIChecker) resolveNodeRef(node *yaml.Node) *yaml.Node {
+	ref := mappingValue(node, "$ref")
+	if ref == nil {
+		return node
+	}
+	pointer, ok := strings.CutPrefix(ref.Value, "#")
+	if !ok {
+		return node
+	}
+	if target := lookupNodePointer(c.root, pointer); target != nil {
+		return target
+	}
+	return node
+}
+
+// lookupNodePointer resolves a JSON pointer against a YAML node tree
+func lookupNodePointer(root *yaml.Node, pointer string) *yaml.Node {
+	if pointer == "" || pointer == "/" {
+		return root
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil
+	}
+	current := root
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = unescapeJSONPointer(token)
+		current = resolveAlias(current)
+		switch current.Kind {
+		case yaml.MappingNode:
+			current = mappingValue(current, token)
+		case yaml.SequenceNode:
+			var index int
+			if _, err := fmt.Sscanf(token, "%d", &index); err != nil || index < 0 || index >= len(current.Content) {
+				return nil
+			}
+			current = current.Content[index]
+		default:
+			return nil
+		}
+		if current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+// schemaTypes returns the types a schema allows. OpenAPI 3.0 marks nullable
+// schemas with nullable: true; 3.1 lists "null" among the types.
+func schemaTypes(schema *yaml.Node) []string {
+	node := mappingValue(schema, "type")
+	if node == nil {
+		return nil
+	}
+	var types []string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		types = []string{node.Value}
+	case yaml.SequenceNode:
+		for _, t := range node.Content {
+			types = append(types, t.Value)
+		}
+	}
+	if nullable := mappingValue(schema, "nullable"); nullable != nil && nullable.Value == "true" {
+		types = append(types, "null")
+	}
+	return types
+}
+
+// typeAllowed reports whether a value of type actual satisfies one of types
+func typeAllowed(types []string, actual string) bool {
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// exampleType returns the JSON Schema type of a YAML value
+func exampleType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	switch node.ShortTag() {
+	case "!!null":
+		return "null"
+	case "!!bool":
+		return "boolean"
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+// resolveAlias follows a YAML alias to the node it names
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
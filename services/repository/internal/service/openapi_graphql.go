@@ -0,0 +1,714 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	graphQLKindObject = "type"
+	graphQLKindInput  = "input"
+	graphQLKindEnum   = "enum"
+	graphQLKindUnion  = "union"
+
+	// graphQLScalarJSON stands in for schemas GraphQL cannot describe
+	graphQLScalarJSON = "JSON"
+
+	componentSchemaPrefix = "#/components/schemas/"
+)
+
+var graphQLNameInvalidChars = regexp.MustCompile(`[^_0-9A-Za-z]`)
+
+// graphQLKindOrder orders definitions of each kind in the generated SDL
+var graphQLKindOrder = map[string]int{
+	graphQLKindObject: 0,
+	graphQLKindInput:  1,
+	graphQLKindEnum:   2,
+	graphQLKindUnion:  3,
+}
+
+// graphQLDefinition is a named type in the generated schema
+type graphQLDefinition struct {
+	kind        string
+	name        string
+	description string
+	fields      []graphQLField
+	values      []string // enum values
+	members     []string // union members
+}
+
+// graphQLField is a field of an object or input type, or a root operation
+type graphQLField struct {
+	name        string
+	description string
+	args        []graphQLField
+	typ         string
+}
+
+// openAPIToGraphQL converts an OpenAPI 3.x document into GraphQL SDL. GET
+// operations become Query fields, other operations become Mutation fields and
+// components/schemas become object, input, enum and union types. Constructs
+// without a GraphQL equivalent fall back to a JSON scalar and are reported as
+// warnings.
+type openAPIToGraphQL struct {
+	doc      map[string]interface{}
+	schemas  map[string]interface{}
+	defs     map[string]*graphQLDefinition
+	refTypes map[string]string // resolved type per component, keyed by input/output and name
+	warnings []ConversionWarning
+	usesJSON bool
+}
+
+func newOpenAPIToGraphQL(doc map[string]interface{}) *openAPIToGraphQL {
+	c := &openAPIToGraphQL{
+		doc:      doc,
+		schemas:  map[string]interface{}{},
+		defs:     map[string]*graphQLDefinition{},
+		refTypes: map[string]string{},
+	}
+	if components, ok := doc["components"].(map[string]interface{}); ok {
+		if schemas, ok := components["schemas"].(map[string]interface{}); ok {
+			c.schemas = schemas
+		}
+	}
+	return c
+}
+
+// convert returns the generated SDL
+func (c *openAPIToGraphQL) convert() string {
+	var queries, mutations []graphQLField
+
+	paths, _ := c.doc["paths"].(map[string]interface{})
+	for _, path := range sortedKeys(paths) {
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		itemPath := "/paths/" + escapeJSONPointer(path)
+		pathParams := c.parameters(item["parameters"])
+
+		for _, method := range openAPIOperationMethods {
+			operation, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			opPath := itemPath + "/" + method
+			switch method {
+			case "head", "options", "trace":
+				c.warn(opPath, fmt.Sprintf("%s operations have no GraphQL equivalent and were skipped", strings.ToUpper(method)),
+					"Expose the behaviour through a GET or POST operation")
+				continue
+			}
+
+			field := c.operation(path, method, opPath, operation, pathParams)
+			if method == "get" {
+				queries = append(queries, field)
+			} else {
+				mutations = append(mutations, field)
+			}
+		}
+	}
+
+	if len(queries) == 0 {
+		// A GraphQL schema must define a query root
+		c.warn("/paths", "the document has no GET operations; Query contains a placeholder field",
+			"Add at least one GET operation")
+		queries = append(queries, graphQLField{name: "_empty", typ: "Boolean"})
+	}
+
+	return c.render(queries, mutations)
+}
+
+// operation converts an OpenAPI operation into a root field
+func (c *openAPIToGraphQL) operation(path, method, opPath string, operation map[string]interface{}, pathParams []map[string]interface{}) graphQLField {
+	name := operationFieldName(path, method, operation)
+	field := graphQLField{name: name, description: schemaString(operation, "summary", "description")}
+	typeHint := pascalCase(name)
+
+	// Operation parameters override path-level ones with the same name and location
+	params := map[string]map[string]interface{}{}
+	var order []string
+	for _, param := range append(pathParams, c.parameters(operation["parameters"])...) {
+		key := fmt.Sprint(param["in"]) + ":" + fmt.Sprint(param["name"])
+		if _, seen := params[key]; !seen {
+			order = append(order, key)
+		}
+		params[key] = param
+	}
+	for _, key := range order {
+		param := params[key]
+		paramName, _ := param["name"].(string)
+		in, _ := param["in"].(string)
+		if in != "path" && in != "query" {
+			c.warn(opPath+"/parameters", fmt.Sprintf("%s parameter %q is not mapped to an argument", in, paramName),
+				"Pass the value through the GraphQL transport instead")
+			continue
+		}
+		schema, _ := param["schema"].(map[string]interface{})
+		argType := c.typeFor(schema, opPath+"/parameters/"+escapeJSONPointer(paramName), typeHint+pascalCase(paramName), true)
+		if required, _ := param["required"].(bool); required {
+			argType += "!"
+		}
+		field.args = append(field.args, graphQLField{
+			name:        graphQLFieldName(paramName),
+			description: schemaString(param, "description"),
+			typ:         argType,
+		})
+	}
+
+	if body := c.resolveLocal(operation["requestBody"]); body != nil {
+		if schema, ok := jsonMediaSchema(body); ok {
+			argType := c.typeFor(schema, opPath+"/requestBody", typeHint+"Input", true)
+			if required, _ := body["required"].(bool); required {
+				argType += "!"
+			}
+			field.args = append(field.args, graphQLField{
+				name:        "input",
+				description: schemaString(body, "description"),
+				typ:         argType,
+			})
+		} else {
+			c.warn(opPath+"/requestBody", "request body has no application/json schema and was skipped",
+				"Describe the body with an application/json media type")
+		}
+	}
+
+	field.typ = c.responseType(opPath, operation, typeHint)
+	return field
+}
+
+// responseType returns the GraphQL type of the first successful response
+func (c *openAPIToGraphQL) responseType(opPath string, operation map[string]interface{}, typeHint string) string {
+	responses, _ := operation["responses"].(map[string]interface{})
+	codes := sortedKeys(responses)
+
+	status := ""
+	for _, code := range codes {
+		if strings.HasPrefix(code, "2") {
+			status = code
+			break
+		}
+	}
+	if status == "" {
+		if _, ok := responses["default"]; !ok {
+			c.warn(opPath+"/responses", "operation has no successful response; returning Boolean", "Declare a 2xx response")
+			return "Boolean"
+		}
+		status = "default"
+	}
+
+	response := c.resolveLocal(responses[status])
+	if response == nil {
+		return "Boolean"
+	}
+	if _, hasContent := response["content"]; !hasContent {
+		// e.g. 204 No Content: report success only
+		return "Boolean"
+	}
+	schema, ok := jsonMediaSchema(response)
+	if !ok {
+		c.warn(opPath+"/responses/"+escapeJSONPointer(status), "response has no application/json schema; returning JSON",
+			"Describe the response with an application/json media type")
+		return c.jsonScalar()
+	}
+	return c.typeFor(schema, opPath+"/responses/"+escapeJSONPointer(status), typeHint+"Response", false)
+}
+
+// typeFor returns the nullable GraphQL type reference for schema, defining
+// any named types it needs. Input positions produce input types.
+func (c *openAPIToGraphQL) typeFor(schema map[string]interface{}, path, nameHint string, input bool) string {
+	if schema == nil {
+		return c.jsonScalar()
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		return c.refType(ref, path, input)
+	}
+
+	if branches, key := compositionBranches(schema); key != "" {
+		return c.unionType(branches, key, path, nameHint, input)
+	}
+
+	typ := schemaType(schema)
+	if _, ok := schema["enum"]; ok {
+		if typ == "" || typ == "string" {
+			return c.enumType(schema, path, nameHint)
+		}
+		c.warn(path, fmt.Sprintf("%s enums have no GraphQL equivalent; mapped to the underlying scalar", typ),
+			"Use string enums")
+	}
+
+	switch typ {
+	case "string":
+		return "String"
+	case "integer":
+		if format, _ := schema["format"].(string); format == "int64" {
+			c.warn(path, "int64 values may overflow GraphQL Int; mapped to Float", "Use a custom scalar for 64-bit integers")
+			return "Float"
+		}
+		return "Int"
+	case "number":
+		return "Float"
+	case "boolean":
+		return "Boolean"
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		item := c.typeFor(items, path+"/items", nameHint+"Item", input)
+		if nullable, _ := items["nullable"].(bool); !nullable {
+			item += "!"
+		}
+		return "[" + item + "]"
+	case "object":
+		properties, _ := schema["properties"].(map[string]interface{})
+		if len(properties) == 0 {
+			// Free-form objects and maps (additionalProperties) have no GraphQL shape
+			return c.jsonScalar()
+		}
+		return c.objectType(schema, path, nameHint, input)
+	default:
+		return c.jsonScalar()
+	}
+}
+
+// refType resolves a $ref to a component schema
+func (c *openAPIToGraphQL) refType(ref, path string, input bool) string {
+	name, ok := strings.CutPrefix(ref, componentSchemaPrefix)
+	if !ok {
+		c.warn(path, fmt.Sprintf("reference %q is not a local component schema; mapped to JSON", ref),
+			"Dereference external schemas before converting")
+		return c.jsonScalar()
+	}
+	name = unescapeJSONPointer(name)
+	key := fmt.Sprintf("%t:%s", input, name)
+	if typ, ok := c.refTypes[key]; ok {
+		return typ
+	}
+
+	schema, ok := c.schemas[name].(map[string]interface{})
+	if !ok {
+		c.warn(path, fmt.Sprintf("reference %q does not resolve; mapped to JSON", ref), "")
+		return c.jsonScalar()
+	}
+
+	typeName := graphQLTypeName(name)
+	// Reserve the name before converting so recursive schemas refer back to it
+	if isObjectSchema(schema) {
+		if input {
+			typeName += "Input"
+		}
+		c.refTypes[key] = typeName
+	}
+	typ := c.typeFor(schema, componentSchemaPrefix[1:]+escapeJSONPointer(name), graphQLTypeName(name), input)
+	c.refTypes[key] = typ
+	return typ
+}
+
+// objectType defines an object or input type for an object schema,
+// flattening allOf compositions
+func (c *openAPIToGraphQL) objectType(schema map[string]interface{}, path, nameHint string, input bool) string {
+	kind := graphQLKindObject
+	name := nameHint
+	if input {
+		kind = graphQLKindInput
+		if !strings.HasSuffix(name, "Input") {
+			name += "Input"
+		}
+	}
+	if existing, ok := c.defs[name]; ok && existing.kind == kind && existing.fields != nil {
+		return name
+	}
+	def := &graphQLDefinition{kind: kind, name: name, description: schemaString(schema, "description"), fields: []graphQLField{}}
+	c.defs[name] = def
+
+	properties, required := c.collectProperties(schema, path)
+	for _, prop := range sortedKeys(properties) {
+		propSchema, _ := properties[prop].(map[string]interface{})
+		typ := c.typeFor(propSchema, path+"/properties/"+escapeJSONPointer(prop), nameHint+pascalCase(prop), input)
+		if required[prop] {
+			typ += "!"
+		}
+		fieldName := graphQLFieldName(prop)
+		if fieldName != prop {
+			c.warn(path+"/properties/"+escapeJSONPointer(prop),
+				fmt.Sprintf("property %q renamed to %q", prop, fieldName), "Use property names that are valid GraphQL names")
+		}
+		def.fields = append(def.fields, graphQLField{
+			name:        fieldName,
+			description: schemaString(propSchema, "description"),
+			typ:         typ,
+		})
+	}
+	return name
+}
+
+// collectProperties merges the properties of schema and its allOf members
+func (c *openAPIToGraphQL) collectProperties(schema map[string]interface{}, path string) (map[string]interface{}, map[string]bool) {
+	properties := map[string]interface{}{}
+	required := map[string]bool{}
+
+	var collect func(s map[string]interface{}, depth int)
+	collect = func(s map[string]interface{}, depth int) {
+		if s == nil || depth > 32 {
+			return
+		}
+		if ref, ok := s["$ref"].(string); ok {
+			if name, ok := strings.CutPrefix(ref, componentSchemaPrefix); ok {
+				target, _ := c.schemas[unescapeJSONPointer(name)].(map[string]interface{})
+				collect(target, depth+1)
+			}
+			return
+		}
+		if props, ok := s["properties"].(map[string]interface{}); ok {
+			for k, v := range props {
+				properties[k] = v
+			}
+		}
+		if req, ok := s["required"].([]interface{}); ok {
+			for _, r := range req {
+				if name, ok := r.(string); ok {
+					required[name] = true
+				}
+			}
+		}
+		if members, ok := s["allOf"].([]interface{}); ok {
+			for _, m := range members {
+				member, _ := m.(map[string]interface{})
+				collect(member, depth+1)
+			}
+		}
+	}
+	collect(schema, 0)
+	return properties, required
+}
+
+// enumType defines an enum for a string enum schema
+func (c *openAPIToGraphQL) enumType(schema map[string]interface{}, path, nameHint string) string {
+	if existing, ok := c.defs[nameHint]; ok && existing.kind == graphQLKindEnum {
+		return nameHint
+	}
+	def := &graphQLDefinition{kind: graphQLKindEnum, name: nameHint, description: schemaString(schema, "description")}
+	values, _ := schema["enum"].([]interface{})
+	seen := map[string]bool{}
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		raw := fmt.Sprint(v)
+		value := graphQLEnumValue(raw)
+		if value != raw {
+			c.warn(path, fmt.Sprintf("enum value %q renamed to %q", raw, value), "Use enum values that are valid GraphQL names")
+		}
+		if !seen[value] {
+			seen[value] = true
+			def.values = append(def.values, value)
+		}
+	}
+	c.defs[nameHint] = def
+	return nameHint
+}
+
+// unionType converts oneOf/anyOf. Output positions whose branches are all
+// object component schemas become unions; anything else maps to JSON.
+func (c *openAPIToGraphQL) unionType(branches []interface{}, keyword, path, nameHint string, input bool) string {
+	if keyword == "allOf" {
+		return c.objectType(map[string]interface{}{"allOf": branches}, path, nameHint, input)
+	}
+
+	var members []string
+	for _, b := range branches {
+		branch, _ := b.(map[string]interface{})
+		ref, _ := branch["$ref"].(string)
+		name, ok := strings.CutPrefix(ref, componentSchemaPrefix)
+		target, _ := c.schemas[unescapeJSONPointer(name)].(map[string]interface{})
+		if !ok || !isObjectSchema(target) {
+			members = nil
+			break
+		}
+		members = append(members, c.refType(ref, path, false))
+	}
+
+	if input || len(members) == 0 {
+		message := fmt.Sprintf("%s with branches that are not object component schemas cannot be converted; mapped to JSON", keyword)
+		if input {
+			message = fmt.Sprintf("%s in an input position cannot be converted to a union; mapped to JSON", keyword)
+		}
+		c.warn(path+"/"+keyword, message, "Model the alternatives as separate optional fields")
+		return c.jsonScalar()
+	}
+
+	if _, ok := c.defs[nameHint]; !ok {
+		c.defs[nameHint] = &graphQLDefinition{kind: graphQLKindUnion, name: nameHint, members: members}
+	}
+	return nameHint
+}
+
+// parameters resolves a parameter list, following local $refs
+func (c *openAPIToGraphQL) parameters(raw interface{}) []map[string]interface{} {
+	list, _ := raw.([]interface{})
+	params := make([]map[string]interface{}, 0, len(list))
+	for _, p := range list {
+		if param := c.resolveLocal(p); param != nil {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// resolveLocal follows a local $ref, returning the target object
+func (c *openAPIToGraphQL) resolveLocal(node interface{}) map[string]interface{} {
+	m, _ := node.(map[string]interface{})
+	for depth := 0; m != nil && depth < 16; depth++ {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		pointer, ok := strings.CutPrefix(ref, "#")
+		if !ok {
+			return nil
+		}
+		target, err := lookupJSONPointer(c.doc, pointer)
+		if err != nil {
+			return nil
+		}
+		m, _ = target.(map[string]interface{})
+	}
+	return m
+}
+
+func (c *openAPIToGraphQL) jsonScalar() string {
+	c.usesJSON = true
+	return graphQLScalarJSON
+}
+
+func (c *openAPIToGraphQL) warn(path, message, suggestion string) {
+	c.warnings = append(c.warnings, ConversionWarning{Message: message, Path: path, Suggestion: suggestion})
+}
+
+// render writes the SDL with the root types first and the remaining
+// definitions grouped by kind and sorted by name
+func (c *openAPIToGraphQL) render(queries, mutations []graphQLField) string {
+	var b strings.Builder
+	if c.usesJSON {
+		b.WriteString("\"Arbitrary JSON value\"\nscalar JSON\n\n")
+	}
+
+	writeFields := func(kind, name string, fields []graphQLField) {
+		fmt.Fprintf(&b, "%s %s {\n", kind, name)
+		for _, f := range fields {
+			writeDescription(&b, f.description, "  ")
+			b.WriteString("  " + f.name)
+			if len(f.args) > 0 {
+				args := make([]string, len(f.args))
+				for i, a := range f.args {
+					args[i] = a.name + ": " + a.typ
+				}
+				b.WriteString("(" + strings.Join(args, ", ") + ")")
+			}
+			b.WriteString(": " + f.typ + "\n")
+		}
+		b.WriteString("}\n\n")
+	}
+
+	writeFields(graphQLKindObject, "Query", queries)
+	if len(mutations) > 0 {
+		writeFields(graphQLKindObject, "Mutation", mutations)
+	}
+
+	defs := make([]*graphQLDefinition, 0, len(c.defs))
+	for _, def := range c.defs {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		if graphQLKindOrder[defs[i].kind] != graphQLKindOrder[defs[j].kind] {
+			return graphQLKindOrder[defs[i].kind] < graphQLKindOrder[defs[j].kind]
+		}
+		return defs[i].name < defs[j].name
+	})
+
+	for _, def := range defs {
+		writeDescription(&b, def.description, "")
+		switch def.kind {
+		case graphQLKindEnum:
+			fmt.Fprintf(&b, "enum %s {\n", def.name)
+			for _, v := range def.values {
+				b.WriteString("  " + v + "\n")
+			}
+			b.WriteString("}\n\n")
+		case graphQLKindUnion:
+			fmt.Fprintf(&b, "union %s = %s\n\n", def.name, strings.Join(def.members, " | "))
+		default:
+			fields := def.fields
+			if len(fields) == 0 {
+				// Object types must declare at least one field
+				fields = []graphQLField{{name: "_empty", typ: "Boolean"}}
+			}
+			writeFields(def.kind, def.name, fields)
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func writeDescription(b *strings.Builder, description, indent string) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return
+	}
+	if strings.Contains(description, "\n") {
+		b.WriteString(indent + `"""` + "\n")
+		for _, line := range strings.Split(strings.ReplaceAll(description, `"""`, `\"""`), "\n") {
+			b.WriteString(indent + line + "\n")
+		}
+		b.WriteString(indent + `"""` + "\n")
+		return
+	}
+	escaped := strings.ReplaceAll(strings.ReplaceAll(description, `\`, `\\`), `"`, `\"`)
+	b.WriteString(indent + `"` + escaped + `"` + "\n")
+}
+
+// operationFieldName returns the operationId, or a name derived from the
+// method and path such as getPetsByPetId
+func operationFieldName(path, method string, operation map[string]interface{}) string {
+	if id, ok := operation["operationId"].(string); ok && id != "" {
+		return graphQLFieldName(id)
+	}
+	name := method
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name += "By" + pascalCase(segment[1:len(segment)-1])
+		} else {
+			name += pascalCase(segment)
+		}
+	}
+	return graphQLFieldName(name)
+}
+
+// compositionBranches returns the branches of a oneOf, anyOf or allOf schema
+func compositionBranches(schema map[string]interface{}) ([]interface{}, string) {
+	for _, key := range []string{"oneOf", "anyOf", "allOf"} {
+		if branches, ok := schema[key].([]interface{}); ok {
+			return branches, key
+		}
+	}
+	return nil, ""
+}
+
+// jsonMediaSchema returns the application/json schema of a request body or response
+func jsonMediaSchema(node map[string]interface{}) (map[string]interface{}, bool) {
+	content, _ := node["content"].(map[string]interface{})
+	for _, mediaType := range sortedKeys(content) {
+		if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+			continue
+		}
+		media, _ := content[mediaType].(map[string]interface{})
+		if schema, ok := media["schema"].(map[string]interface{}); ok {
+			return schema, true
+		}
+	}
+	return nil, false
+}
+
+// schemaType returns the schema's type, taking the first non-null entry of
+// an OpenAPI 3.1 type array and inferring object for schemas with properties
+func schemaType(schema map[string]interface{}) string {
+	switch t := schema["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+	if _, ok := schema["properties"]; ok {
+		return "object"
+	}
+	if _, ok := schema["allOf"]; ok {
+		return "object"
+	}
+	return ""
+}
+
+// isObjectSchema reports whether schema converts to an object type
+func isObjectSchema(schema map[string]interface{}) bool {
+	if schema == nil {
+		return false
+	}
+	if _, ok := schema["allOf"]; ok {
+		return true
+	}
+	properties, _ := schema["properties"].(map[string]interface{})
+	return schemaType(schema) == "object" && len(properties) > 0
+}
+
+// schemaString returns the first non-empty string value among keys
+func schemaString(node map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := node[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// graphQLName replaces characters that are not allowed in GraphQL names
+func graphQLName(name string) string {
+	name = graphQLNameInvalidChars.ReplaceAllString(name, "_")
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+	return name
+}
+
+func graphQLTypeName(name string) string {
+	return graphQLName(pascalCase(name))
+}
+
+func graphQLFieldName(name string) string {
+	return graphQLName(name)
+}
+
+// graphQLEnumValue converts an enum value into a GraphQL name; true, false
+// and null are reserved
+func graphQLEnumValue(value string) string {
+	value = graphQLName(value)
+	switch value {
+	case "true", "false", "null":
+		return strings.ToUpper(value)
+	}
+	return value
+}
+
+// pascalCase joins the words of s, capitalising the first letter of each
+func pascalCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func warningMessages(warnings []ConversionWarning) []string {
+	messages := make([]string, len(warnings))
+	for i, w := range warnings {
+		messages[i] = w.Path + ": " + w.Message
+	}
+	return messages
+}
+
+func TestSchemaTransformer_ConvertOpenAPIToGraphQL_Golden(t *testing.T) {
+	tests := []struct {
+		spec     string
+		warnings []string
+	}{
+		{
+			spec:     "petstore.yaml",
+			warnings: []string{},
+		},
+		{
+			spec: "composition.yaml",
+			warnings: []string{
+				"/paths/~1search/post/requestBody/properties/query/anyOf: anyOf in an input position cannot be converted to a union; mapped to JSON",
+				"/paths/~1search/post/responses/200/properties/value/oneOf: oneOf with branches that are not object component schemas cannot be converted; mapped to JSON",
+			},
+		},
+		{
+			spec: "mutations_only.json",
+			warnings: []string{
+				"/paths/~1events/options: OPTIONS operations have no GraphQL equivalent and were skipped",
+				`/paths/~1events/post/parameters: header parameter "X-Request-ID" is not mapped to an argument`,
+				`/paths/~1events/post/requestBody/properties/created-at: property "created-at" renamed to "created_at"`,
+				"/paths/~1events/post/requestBody/properties/sequence: int64 values may overflow GraphQL Int; mapped to Float",
+				"/paths: the document has no GET operations; Query contains a placeholder field",
+			},
+		},
+	}
+
+	transformer := newTestSchemaTransformer(nil)
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			dir := filepath.Join("testdata", "openapi_graphql")
+			spec, err := os.ReadFile(filepath.Join(dir, tt.spec))
+			require.NoError(t, err)
+
+			result, err := transformer.ConvertFormat(context.Background(), string(spec), SchemaFormatOpenAPI, SchemaFormatGraphQL)
+			require.NoError(t, err)
+			require.True(t, result.Success, "errors: %v", result.Errors)
+			assert.Equal(t, SchemaFormatOpenAPI, result.FromFormat)
+			assert.Equal(t, SchemaFormatGraphQL, result.ToFormat)
+			assert.ElementsMatch(t, tt.warnings, warningMessages(result.Warnings))
+
+			golden := filepath.Join(dir, strings.TrimSuffix(tt.spec, filepath.Ext(tt.spec))+".graphql")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(golden, []byte(result.ConvertedContent), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), result.ConvertedContent)
+		})
+	}
+}
+
+func TestSchemaTransformer_ConvertFormat_RejectsNonOpenAPI3(t *testing.T) {
+	transformer := newTestSchemaTransformer(nil)
+
+	result, err := transformer.ConvertFormat(context.Background(), `{"swagger": "2.0", "paths": {}}`, SchemaFormatOpenAPI, SchemaFormatGraphQL)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "UNSUPPORTED_DOCUMENT", result.Errors[0].Code)
+	assert.Empty(t, result.ConvertedContent)
+}
+
+func TestSchemaTransformer_ConvertFormat_UnsupportedPair(t *testing.T) {
+	_, err := newTestSchemaTransformer(nil).ConvertFormat(context.Background(), "{}", SchemaFormatGraphQL, SchemaFormatOpenAPI)
+	assert.ErrorIs(t, err, ErrSchemaTransformationFailed)
+}
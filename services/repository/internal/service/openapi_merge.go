@@ -0,0 +1,211 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Merge conflict types reported in MergeConflict.Type
+const (
+	MergeConflictNaming    = "naming"    // two schemas define a component with the same name
+	MergeConflictStructure = "structure" // two schemas define the same path differently
+)
+
+// openAPIMerger combines OpenAPI 3.x documents into one. Paths are merged per
+// operation and components per name. An entry defined differently by two
+// documents is a conflict: under MergeStrategyUnion conflicts leave the merge
+// unresolved, under MergeStrategyOverride the later document wins.
+type openAPIMerger struct {
+	strategy  MergeStrategy
+	merged    map[string]interface{}
+	sources   map[string]int // schema index that provided the merged value at a path, when not the first
+	conflicts []*MergeConflict
+	byPath    map[string]*MergeConflict
+}
+
+func newOpenAPIMerger(strategy MergeStrategy) *openAPIMerger {
+	return &openAPIMerger{
+		strategy: strategy,
+		sources:  make(map[string]int),
+		byPath:   make(map[string]*MergeConflict),
+	}
+}
+
+// merge folds docs into one document in order
+func (m *openAPIMerger) merge(docs []map[string]interface{}) map[string]interface{} {
+	m.merged = docs[0]
+	for i, doc := range docs[1:] {
+		index := i + 1
+		for _, key := range sortedKeys(doc) {
+			value := doc[key]
+			switch key {
+			case "paths", "webhooks":
+				m.mergeEntries(m.section(key), value, "/"+key, index, MergeConflictStructure, 2)
+			case "components":
+				m.mergeEntries(m.section(key), value, "/"+key, index, MergeConflictNaming, 2)
+			case "servers", "security":
+				m.merged[key] = appendUnique(m.merged[key], value, jsonEqual)
+			case "tags":
+				m.merged[key] = appendUnique(m.merged[key], value, sameTag)
+			default:
+				// openapi, info and other document-level fields come from the
+				// first schema, or the last one when later schemas override
+				if _, ok := m.merged[key]; !ok || m.strategy == MergeStrategyOverride {
+					m.merged[key] = value
+				}
+			}
+		}
+	}
+	return m.merged
+}
+
+// section returns the merged object stored under key, creating it if needed
+func (m *openAPIMerger) section(key string) map[string]interface{} {
+	entries, ok := m.merged[key].(map[string]interface{})
+	if !ok {
+		entries = make(map[string]interface{})
+		m.merged[key] = entries
+	}
+	return entries
+}
+
+// mergeEntries merges the entries of value into target. Entries depth
+// levels below path are compared whole: operations under a path item and
+// components under their section.
+func (m *openAPIMerger) mergeEntries(target map[string]interface{}, value interface{}, path string, index int, conflictType string, depth int) {
+	entries, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, key := range sortedKeys(entries) {
+		entry := entries[key]
+		entryPath := path + "/" + escapeJSONPointer(key)
+
+		existing, present := target[key]
+		if !present {
+			target[key] = entry
+			m.claimAll(entry, entryPath, index, depth-1)
+			continue
+		}
+		if depth > 1 {
+			if child, ok := existing.(map[string]interface{}); ok {
+				m.mergeEntries(child, entry, entryPath, index, conflictType, depth-1)
+				continue
+			}
+		}
+		if jsonEqual(existing, entry) {
+			continue
+		}
+
+		m.conflict(entryPath, conflictType, existing, entry, index)
+		if m.strategy == MergeStrategyOverride {
+			target[key] = entry
+			m.sources[entryPath] = index
+		}
+	}
+}
+
+// claimAll records schema index as the source of value and, depth levels
+// down, of its entries
+func (m *openAPIMerger) claimAll(value interface{}, path string, index, depth int) {
+	m.sources[path] = index
+	if entries, ok := value.(map[string]interface{}); ok && depth > 0 {
+		for key, entry := range entries {
+			m.claimAll(entry, path+"/"+escapeJSONPointer(key), index, depth-1)
+		}
+	}
+}
+
+// conflict records that schema index defines path differently from the
+// merged document so far
+func (m *openAPIMerger) conflict(path, conflictType string, existing, value interface{}, index int) {
+	c, ok := m.byPath[path]
+	if !ok {
+		what := "path"
+		if conflictType == MergeConflictNaming {
+			what = "component"
+		}
+		c = &MergeConflict{
+			Path:        path,
+			Type:        conflictType,
+			Description: fmt.Sprintf("%s %s is defined differently by more than one schema", what, path),
+		}
+		m.byPath[path] = c
+		m.conflicts = append(m.conflicts, c)
+		c.Options = append(c.Options, mergeOption(m.sources[path], existing))
+	}
+	c.Options = append(c.Options, mergeOption(index, value))
+	if m.strategy == MergeStrategyOverride {
+		for i := range c.Options {
+			c.Options[i].Recommended = i == len(c.Options)-1
+		}
+	}
+}
+
+// resolutions describes how each conflict was settled by the strategy
+func (m *openAPIMerger) resolutions() []ConflictResolution {
+	resolutions := []ConflictResolution{}
+	if m.strategy != MergeStrategyOverride {
+		return resolutions
+	}
+	for _, c := range m.conflicts {
+		resolutions = append(resolutions, ConflictResolution{
+			ConflictPath: c.Path,
+			Resolution:   string(MergeStrategyOverride),
+			ChosenOption: c.Options[len(c.Options)-1].ID,
+			Reason:       "later schemas override earlier ones",
+		})
+	}
+	return resolutions
+}
+
+func mergeOption(index int, value interface{}) MergeOption {
+	return MergeOption{
+		ID:          fmt.Sprintf("schema-%d", index+1),
+		Description: fmt.Sprintf("Use the definition from schema %d", index+1),
+		Value:       value,
+	}
+}
+
+// appendUnique appends the elements of the list extra to the list base,
+// skipping elements the same as one base already contains
+func appendUnique(base, extra interface{}, same func(a, b interface{}) bool) interface{} {
+	baseList, _ := base.([]interface{})
+	extraList, ok := extra.([]interface{})
+	if !ok {
+		return base
+	}
+	out := append([]interface{}{}, baseList...)
+	for _, item := range extraList {
+		duplicate := false
+		for _, existing := range out {
+			duplicate = duplicate || same(existing, item)
+		}
+		if !duplicate {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// sameTag reports whether two tag objects have the same name
+func sameTag(a, b interface{}) bool {
+	left, _ := a.(map[string]interface{})
+	right, _ := b.(map[string]interface{})
+	return left["name"] != nil && left["name"] == right["name"]
+}
+
+// jsonEqual compares decoded documents by their JSON encoding, so numbers
+// decoded from JSON and YAML compare equal
+func jsonEqual(a, b interface{}) bool {
+	left, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	right, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(left, right)
+}
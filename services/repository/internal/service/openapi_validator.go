@@ -0,0 +1,594 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPI validation rule IDs reported in SchemaValidationError.Rule and
+// SchemaValidationWarning.Rule
+const (
+	OpenAPIRuleSyntax            = "syntax"
+	OpenAPIRuleVersion           = "openapi-version"
+	OpenAPIRuleInfo              = "info-object"
+	OpenAPIRulePaths             = "paths-object"
+	OpenAPIRuleOperation         = "operation-responses"
+	OpenAPIRuleOperationID       = "operation-id"
+	OpenAPIRuleParameter         = "parameter-object"
+	OpenAPIRulePathParameter     = "path-parameters"
+	OpenAPIRuleReference         = "reference-resolution"
+	OpenAPIRuleOperationDocument = "operation-description"
+	OpenAPIRuleSecurity          = "security-defined"
+	OpenAPIRuleExamples          = "example-schema"
+)
+
+var (
+	openAPIVersionPattern = regexp.MustCompile(`^3\.\d+\.\d+(-.+)?$`)
+	yamlErrorLinePattern  = regexp.MustCompile(`line (\d+)`)
+	pathTemplatePattern   = regexp.MustCompile(`\{([^{}]+)\}`)
+
+	openAPIOperationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+	openAPIParameterIn      = map[string]bool{"query": true, "header": true, "path": true, "cookie": true}
+)
+
+// openAPIValidationRules describes the checks performed by OpenAPIValidator
+var openAPIValidationRules = []*ValidationRule{
+	{ID: OpenAPIRuleSyntax, Name: "Syntax", Description: "Document must be well-formed JSON or YAML", Category: "syntax", Severity: "error"},
+	{ID: OpenAPIRuleVersion, Name: "OpenAPI version", Description: "The openapi field must declare a 3.x version", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRuleInfo, Name: "Info object", Description: "info.title and info.version are required", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRulePaths, Name: "Paths object", Description: "Paths are required and must start with a forward slash", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRuleOperation, Name: "Operation responses", Description: "Every operation must declare at least one response", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRuleOperationID, Name: "Operation IDs", Description: "Operation IDs should be present and must be unique", Category: "naming", Severity: "warning"},
+	{ID: OpenAPIRuleParameter, Name: "Parameter objects", Description: "Parameters must have a name and a valid location; path parameters must be required", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRulePathParameter, Name: "Path parameters", Description: "Every path template variable must be declared as a path parameter", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRuleReference, Name: "References", Description: "Local $refs must point to an existing location in the document", Category: "structure", Severity: "error"},
+	{ID: OpenAPIRuleOperationDocument, Name: "Operation documentation", Description: "Operations should have a summary or description", Category: "documentation", Severity: "warning"},
+	{ID: OpenAPIRuleSecurity, Name: "Security", Description: "Operations should be covered by a security requirement", Category: "security", Severity: "warning"},
+	{ID: OpenAPIRuleExamples, Name: "Examples", Description: "Examples should conform to the schema they illustrate", Category: "documentation", Severity: "warning"},
+}
+
+// OpenAPIValidator implements SchemaValidator for OpenAPI 3.x documents
+// written in JSON or YAML. Errors and warnings carry the line and column of
+// the offending node. Other formats are accepted with a warning that they
+// were not validated. Rules may be switched off with SetRuleEnabled.
+type OpenAPIValidator struct {
+	logger *slog.Logger
+
+	mu       sync.RWMutex
+	disabled map[string]bool
+}
+
+// NewOpenAPIValidator creates a new OpenAPI validator
+func NewOpenAPIValidator(logger *slog.Logger) *OpenAPIValidator {
+	return &OpenAPIValidator{
+		logger:   logger.With("component", "openapi_validator"),
+		disabled: make(map[string]bool),
+	}
+}
+
+// SetRuleEnabled switches the rule with the given ID on or off. Problems
+// found by a disabled rule are not reported.
+func (v *OpenAPIValidator) SetRuleEnabled(id string, enabled bool) error {
+	known := false
+	for _, rule := range openAPIValidationRules {
+		known = known || rule.ID == id
+	}
+	if !known {
+		return fmt.Errorf("%w: unknown validation rule %q", ErrSchemaValidationFailed, id)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if enabled {
+		delete(v.disabled, id)
+	} else {
+		v.disabled[id] = true
+	}
+	return nil
+}
+
+// ValidateSchema parses and validates schema content
+func (v *OpenAPIValidator) ValidateSchema(ctx context.Context, schemaContent string, format SchemaFormat) (*ValidationResult, error) {
+	start := time.Now()
+	result := &ValidationResult{
+		Errors:   []SchemaValidationError{},
+		Warnings: []SchemaValidationWarning{},
+	}
+	result.Metrics.TotalLines = countLines(schemaContent)
+
+	if format != SchemaFormatOpenAPI {
+		result.Warnings = append(result.Warnings, SchemaValidationWarning{
+			Code:    "VALIDATION_UNAVAILABLE",
+			Message: fmt.Sprintf("content validation is not available for %s schemas", format),
+		})
+	} else {
+		v.validateOpenAPI(schemaContent, result)
+	}
+
+	result.IsValid = len(result.Errors) == 0
+	result.Metrics.QualityScore = qualityScore(result)
+	result.ValidatedAt = time.Now()
+	result.Duration = time.Since(start)
+
+	v.logger.DebugContext(ctx, "Schema validated",
+		"format", format, "valid", result.IsValid, "errors", len(result.Errors), "warnings", len(result.Warnings))
+
+	return result, nil
+}
+
+// ValidateCompatibility diffs two versions of an OpenAPI schema and reports
+// the changes that would break existing clients
+func (v *OpenAPIValidator) ValidateCompatibility(ctx context.Context, oldSchema, newSchema string, format SchemaFormat) (*CompatibilityResult, error) {
+	if format != SchemaFormatOpenAPI {
+		return nil, fmt.Errorf("%w: compatibility checking for %s is not supported", ErrSchemaValidationFailed, format)
+	}
+
+	result, err := diffOpenAPI(ctx, oldSchema, newSchema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSchemaValidationFailed, err)
+	}
+	result.CheckedAt = time.Now()
+
+	v.logger.DebugContext(ctx, "Schema compatibility checked",
+		"compatibility", result.Compatibility, "changes", result.Summary.TotalChanges, "breaking", result.Summary.BreakingCount)
+
+	return result, nil
+}
+
+// ValidateAgainstContract validates a schema against a contract
+func (v *OpenAPIValidator) ValidateAgainstContract(ctx context.Context, schema, contract string, format SchemaFormat) (*ContractValidationResult, error) {
+	return nil, fmt.Errorf("%w: contract validation for %s is not supported", ErrSchemaValidationFailed, format)
+}
+
+// GetValidationRules returns the rules applied to the given format
+func (v *OpenAPIValidator) GetValidationRules(ctx context.Context, format SchemaFormat) ([]*ValidationRule, error) {
+	if format != SchemaFormatOpenAPI {
+		return []*ValidationRule{}, nil
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	rules := make([]*ValidationRule, len(openAPIValidationRules))
+	for i, rule := range openAPIValidationRules {
+		copied := *rule
+		copied.Enabled = !v.disabled[rule.ID]
+		rules[i] = &copied
+	}
+	return rules, nil
+}
+
+// validateOpenAPI parses the document and records problems and metrics on result
+func (v *OpenAPIValidator) validateOpenAPI(content string, result *ValidationResult) {
+	root, err := parseOpenAPINode(content)
+	if err != nil {
+		result.Errors = append(result.Errors, syntaxError(content, err))
+		return
+	}
+
+	doc, _, err := parseSchemaDocument(content)
+	if err != nil {
+		result.Errors = append(result.Errors, syntaxError(content, err))
+		return
+	}
+
+	v.mu.RLock()
+	disabled := maps.Clone(v.disabled)
+	v.mu.RUnlock()
+
+	c := &openAPIChecker{result: result, root: root, doc: doc, disabled: disabled, operationIDs: make(map[string]string)}
+	c.check(root)
+}
+
+// parseOpenAPINode decodes content into a YAML node tree, which keeps the
+// position of every key and value. JSON is checked with encoding/json first
+// so syntax errors report the exact offset.
+func parseOpenAPINode(content string) (*yaml.Node, error) {
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return nil, fmt.Errorf("document is empty")
+	}
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var probe interface{}
+		if err := json.Unmarshal([]byte(content), &probe); err != nil {
+			return nil, err
+		}
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &node); err != nil {
+		return nil, err
+	}
+	if len(node.Content) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+	return node.Content[0], nil
+}
+
+// syntaxError converts a JSON or YAML decoding error into a validation error
+// positioned at the failure where the decoder reports it
+func syntaxError(content string, err error) SchemaValidationError {
+	line, column := 0, 0
+	var jsonErr *json.SyntaxError
+	switch {
+	case errors.As(err, &jsonErr):
+		// Offset counts the bytes read, including the offending one
+		line, column = offsetToPosition(content, max(jsonErr.Offset-1, 0))
+	default:
+		if m := yamlErrorLinePattern.FindStringSubmatch(err.Error()); m != nil {
+			line, _ = strconv.Atoi(m[1])
+		}
+	}
+	return SchemaValidationError{
+		Code:     "SYNTAX_ERROR",
+		Message:  err.Error(),
+		Line:     line,
+		Column:   column,
+		Severity: "error",
+		Rule:     OpenAPIRuleSyntax,
+	}
+}
+
+// offsetToPosition converts a byte offset into a 1-based line and column
+func offsetToPosition(content string, offset int64) (int, int) {
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	line, column := 1, 1
+	for _, r := range content[:offset] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return line, column
+}
+
+// openAPIChecker walks a parsed OpenAPI document collecting problems and metrics
+type openAPIChecker struct {
+	result       *ValidationResult
+	root         *yaml.Node
+	doc          interface{} // decoded document used to resolve local $refs
+	disabled     map[string]bool
+	operationIDs map[string]string
+	operations   int
+	secured      int
+}
+
+func (c *openAPIChecker) check(root *yaml.Node) {
+	if root.Kind != yaml.MappingNode {
+		c.error("INVALID_DOCUMENT", "OpenAPI document must be an object", "", root, OpenAPIRuleSyntax)
+		return
+	}
+
+	c.checkVersion(root)
+	c.checkInfo(root)
+
+	globalSecurity := mappingValue(root, "security")
+	hasGlobalSecurity := globalSecurity != nil && globalSecurity.Kind == yaml.SequenceNode && len(globalSecurity.Content) > 0
+
+	paths := mappingValue(root, "paths")
+	switch {
+	case paths == nil:
+		// OpenAPI 3.1 allows documents with only webhooks or components
+		if mappingValue(root, "webhooks") == nil && mappingValue(root, "components") == nil {
+			c.error("MISSING_FIELD", "paths is required", "/paths", root, OpenAPIRulePaths)
+		}
+	case paths.Kind != yaml.MappingNode:
+		c.error("INVALID_TYPE", "paths must be an object", "/paths", paths, OpenAPIRulePaths)
+	default:
+		forEachMapping(paths, func(key, item *yaml.Node) {
+			c.checkPathItem(key, item, hasGlobalSecurity)
+		})
+	}
+
+	if components := mappingValue(root, "components"); components != nil {
+		if schemas := mappingValue(components, "schemas"); schemas != nil && schemas.Kind == yaml.MappingNode {
+			c.result.Metrics.TotalModels = len(schemas.Content) / 2
+		}
+	}
+
+	c.checkRefs(root, "")
+	if !c.disabled[OpenAPIRuleExamples] {
+		c.checkExamples(root, "")
+	}
+
+	c.result.Metrics.TotalEndpoints = c.operations
+	c.result.Metrics.ComplexityScore = complexityScore(root)
+	if c.operations > 0 {
+		c.result.Metrics.SecurityScore = c.secured * 100 / c.operations
+		if c.secured < c.operations {
+			c.warning("UNSECURED_OPERATIONS",
+				fmt.Sprintf("%d of %d operations have no security requirement", c.operations-c.secured, c.operations),
+				"/security", root, OpenAPIRuleSecurity, "Declare a global security requirement or one per operation")
+		}
+	}
+}
+
+func (c *openAPIChecker) checkVersion(root *yaml.Node) {
+	version := mappingValue(root, "openapi")
+	if version == nil {
+		if swagger := mappingValue(root, "swagger"); swagger != nil {
+			c.error("UNSUPPORTED_VERSION", fmt.Sprintf("Swagger %s documents are not supported; convert to OpenAPI 3.x", swagger.Value),
+				"/swagger", swagger, OpenAPIRuleVersion)
+			return
+		}
+		c.error("MISSING_FIELD", "openapi is required", "/openapi", root, OpenAPIRuleVersion)
+		return
+	}
+	if version.Kind != yaml.ScalarNode || !openAPIVersionPattern.MatchString(version.Value) {
+		c.error("UNSUPPORTED_VERSION", fmt.Sprintf("openapi version %q is not a supported 3.x version", version.Value),
+			"/openapi", version, OpenAPIRuleVersion)
+	}
+}
+
+func (c *openAPIChecker) checkInfo(root *yaml.Node) {
+	info := mappingValue(root, "info")
+	if info == nil {
+		c.error("MISSING_FIELD", "info is required", "/info", root, OpenAPIRuleInfo)
+		return
+	}
+	if info.Kind != yaml.MappingNode {
+		c.error("INVALID_TYPE", "info must be an object", "/info", info, OpenAPIRuleInfo)
+		return
+	}
+	for _, field := range []string{"title", "version"} {
+		if value := mappingValue(info, field); value == nil || strings.TrimSpace(value.Value) == "" {
+			c.error("MISSING_FIELD", fmt.Sprintf("info.%s is required", field), "/info/"+field, info, OpenAPIRuleInfo)
+		}
+	}
+}
+
+func (c *openAPIChecker) checkPathItem(key, item *yaml.Node, hasGlobalSecurity bool) {
+	path := "/paths/" + escapeJSONPointer(key.Value)
+	if !strings.HasPrefix(key.Value, "/") {
+		c.error("INVALID_PATH", fmt.Sprintf("path %q must begin with a forward slash", key.Value), path, key, OpenAPIRulePaths)
+	}
+	if item.Kind != yaml.MappingNode {
+		c.error("INVALID_TYPE", "path item must be an object", path, item, OpenAPIRulePaths)
+		return
+	}
+
+	pathParams, pathHasRefs := c.checkParameters(mappingValue(item, "parameters"), path+"/parameters")
+
+	for _, method := range openAPIOperationMethods {
+		operation := mappingValue(item, method)
+		if operation == nil {
+			continue
+		}
+		opPath := path + "/" + method
+		if operation.Kind != yaml.MappingNode {
+			c.error("INVALID_TYPE", "operation must be an object", opPath, operation, OpenAPIRuleOperation)
+			continue
+		}
+		c.operations++
+
+		params, opHasRefs := c.checkParameters(mappingValue(operation, "parameters"), opPath+"/parameters")
+		if !pathHasRefs && !opHasRefs {
+			// Path variables may only be checked when every parameter is declared inline
+			for _, m := range pathTemplatePattern.FindAllStringSubmatch(key.Value, -1) {
+				if !pathParams[m[1]] && !params[m[1]] {
+					c.error("MISSING_PATH_PARAMETER",
+						fmt.Sprintf("path parameter %q is not declared for %s %s", m[1], strings.ToUpper(method), key.Value),
+						opPath, operation, OpenAPIRulePathParameter)
+				}
+			}
+		}
+
+		responses := mappingValue(operation, "responses")
+		if responses == nil || responses.Kind != yaml.MappingNode || len(responses.Content) == 0 {
+			c.error("MISSING_RESPONSES", "operation must declare at least one response", opPath+"/responses", operation, OpenAPIRuleOperation)
+		}
+
+		if id := mappingValue(operation, "operationId"); id == nil || id.Value == "" {
+			c.warning("MISSING_OPERATION_ID", fmt.Sprintf("%s %s has no operationId", strings.ToUpper(method), key.Value),
+				opPath, operation, OpenAPIRuleOperationID, "Add a unique operationId so clients can generate stable method names")
+		} else if previous, ok := c.operationIDs[id.Value]; ok {
+			c.error("DUPLICATE_OPERATION_ID", fmt.Sprintf("operationId %q is already used by %s", id.Value, previous),
+				opPath+"/operationId", id, OpenAPIRuleOperationID)
+		} else {
+			c.operationIDs[id.Value] = opPath
+		}
+
+		if mappingValue(operation, "summary") == nil && mappingValue(operation, "description") == nil {
+			c.warning("MISSING_DESCRIPTION", fmt.Sprintf("%s %s has no summary or description", strings.ToUpper(method), key.Value),
+				opPath, operation, OpenAPIRuleOperationDocument, "Describe what the operation does")
+		}
+
+		if security := mappingValue(operation, "security"); security != nil {
+			// An explicit empty list opts the operation out of security
+			if security.Kind == yaml.SequenceNode && len(security.Content) > 0 {
+				c.secured++
+			}
+		} else if hasGlobalSecurity {
+			c.secured++
+		}
+	}
+}
+
+// checkParameters validates a parameter list and returns the names of the
+// path parameters it declares and whether any parameter is a $ref
+func (c *openAPIChecker) checkParameters(params *yaml.Node, path string) (map[string]bool, bool) {
+	declared := make(map[string]bool)
+	if params == nil {
+		return declared, false
+	}
+	if params.Kind != yaml.SequenceNode {
+		c.error("INVALID_TYPE", "parameters must be an array", path, params, OpenAPIRuleParameter)
+		return declared, false
+	}
+
+	hasRefs := false
+	for i, param := range params.Content {
+		paramPath := fmt.Sprintf("%s/%d", path, i)
+		if param.Kind != yaml.MappingNode {
+			c.error("INVALID_TYPE", "parameter must be an object", paramPath, param, OpenAPIRuleParameter)
+			continue
+		}
+		if mappingValue(param, "$ref") != nil {
+			hasRefs = true
+			continue
+		}
+
+		name := mappingValue(param, "name")
+		if name == nil || name.Value == "" {
+			c.error("MISSING_FIELD", "parameter name is required", paramPath+"/name", param, OpenAPIRuleParameter)
+		}
+		in := mappingValue(param, "in")
+		switch {
+		case in == nil:
+			c.error("MISSING_FIELD", "parameter location (in) is required", paramPath+"/in", param, OpenAPIRuleParameter)
+		case !openAPIParameterIn[in.Value]:
+			c.error("INVALID_PARAMETER_LOCATION", fmt.Sprintf("parameter location %q must be one of query, header, path or cookie", in.Value),
+				paramPath+"/in", in, OpenAPIRuleParameter)
+		case in.Value == "path":
+			if required := mappingValue(param, "required"); required == nil || required.Value != "true" {
+				c.error("PATH_PARAMETER_NOT_REQUIRED", "path parameters must set required: true", paramPath, param, OpenAPIRuleParameter)
+			}
+			if name != nil {
+				declared[name.Value] = true
+			}
+		}
+	}
+	return declared, hasRefs
+}
+
+// checkRefs reports local $refs whose target does not exist
+func (c *openAPIChecker) checkRefs(node *yaml.Node, path string) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		forEachMapping(node, func(key, value *yaml.Node) {
+			if key.Value == "$ref" && value.Kind == yaml.ScalarNode {
+				ref := value.Value
+				if pointer, ok := strings.CutPrefix(ref, "#"); ok {
+					if _, err := lookupJSONPointer(c.doc, pointer); err != nil {
+						c.error("UNRESOLVED_REF", fmt.Sprintf("reference %q does not resolve", ref), path+"/$ref", value, OpenAPIRuleReference)
+					}
+				}
+				return
+			}
+			c.checkRefs(value, path+"/"+escapeJSONPointer(key.Value))
+		})
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			c.checkRefs(child, fmt.Sprintf("%s/%d", path, i))
+		}
+	}
+}
+
+func (c *openAPIChecker) error(code, message, path string, node *yaml.Node, rule string) {
+	if c.disabled[rule] {
+		return
+	}
+	c.result.Errors = append(c.result.Errors, SchemaValidationError{
+		Code:     code,
+		Message:  message,
+		Path:     path,
+		Line:     node.Line,
+		Column:   node.Column,
+		Severity: "error",
+		Rule:     rule,
+	})
+}
+
+func (c *openAPIChecker) warning(code, message, path string, node *yaml.Node, rule, suggestion string) {
+	if c.disabled[rule] {
+		return
+	}
+	c.result.Warnings = append(c.result.Warnings, SchemaValidationWarning{
+		Code:       code,
+		Message:    message,
+		Path:       path,
+		Line:       node.Line,
+		Column:     node.Column,
+		Rule:       rule,
+		Suggestion: suggestion,
+	})
+}
+
+// mappingValue returns the value stored under key in a mapping node
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// forEachMapping calls fn for each key/value pair of a mapping node in document order
+func forEachMapping(node *yaml.Node, fn func(key, value *yaml.Node)) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i], node.Content[i+1])
+	}
+}
+
+// complexityScore counts the structural elements of a document that make
+// it harder to consume: operations, parameters, responses, schema
+// properties and composition keywords
+func complexityScore(root *yaml.Node) int {
+	weights := map[string]int{
+		"parameters": 1, "responses": 1, "properties": 1,
+		"allOf": 2, "oneOf": 2, "anyOf": 2,
+	}
+	score := 0
+	var walk func(node *yaml.Node, parentKey string)
+	walk = func(node *yaml.Node, parentKey string) {
+		switch node.Kind {
+		case yaml.MappingNode:
+			if parentKey == "paths" {
+				score += len(node.Content) / 2
+			}
+			forEachMapping(node, func(key, value *yaml.Node) {
+				if w, ok := weights[key.Value]; ok {
+					score += w * max(len(value.Content)/entrySize(value), 1)
+				}
+				walk(value, key.Value)
+			})
+		case yaml.SequenceNode:
+			for _, child := range node.Content {
+				walk(child, parentKey)
+			}
+		}
+	}
+	walk(root, "")
+	return score
+}
+
+// entrySize is the number of child nodes per entry of a collection node
+func entrySize(node *yaml.Node) int {
+	if node.Kind == yaml.MappingNode {
+		return 2
+	}
+	return 1
+}
+
+// qualityScore derives a 0-100 score from the errors and warnings found
+func qualityScore(result *ValidationResult) int {
+	score := 100 - 20*len(result.Errors) - 5*len(result.Warnings)
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+func countLines(content string) int {
+	if content == "" {
+		return 0
+	}
+	return strings.Count(strings.TrimRight(content, "\n"), "\n") + 1
+}
@@ -0,0 +1,464 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+const validPetsSpec = `openapi: 3.0.3
+info:
+  title: Pets API
+  version: 1.0.0
+security:
+  - apiKey: []
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List pets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: A list of pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "#/components/schemas/Pet"
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: getPet
+      summary: Get a pet
+      responses:
+        "200":
+          description: A pet
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Pet"
+components:
+  securitySchemes:
+    apiKey:
+      type: apiKey
+      in: header
+      name: X-API-Key
+  schemas:
+    Pet:
+      type: object
+      properties:
+        id:
+          type: string
+        name:
+          type: string
+    Error:
+      type: object
+      properties:
+        message:
+          type: string
+`
+
+func newTestOpenAPIValidator() *OpenAPIValidator {
+	return NewOpenAPIValidator(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func errorCodes(result *ValidationResult) []string {
+	codes := make([]string, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		codes = append(codes, e.Code)
+	}
+	return codes
+}
+
+func TestOpenAPIValidator_ValidSpec(t *testing.T) {
+	result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), validPetsSpec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+
+	assert.True(t, result.IsValid, "unexpected errors: %v", result.Errors)
+	assert.Empty(t, result.Warnings)
+	assert.Equal(t, 2, result.Metrics.TotalEndpoints)
+	assert.Equal(t, 2, result.Metrics.TotalModels)
+	assert.Equal(t, 61, result.Metrics.TotalLines)
+	assert.Positive(t, result.Metrics.ComplexityScore)
+	assert.Equal(t, 100, result.Metrics.QualityScore)
+	assert.Equal(t, 100, result.Metrics.SecurityScore)
+}
+
+func TestOpenAPIValidator_ValidJSONSpec(t *testing.T) {
+	spec := `{
+  "openapi": "3.1.0",
+  "info": {"title": "Pets API", "version": "1.0.0"},
+  "paths": {
+    "/pets": {
+      "post": {"responses": {"201": {"description": "Created"}}}
+    }
+  }
+}`
+	result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), spec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+
+	assert.True(t, result.IsValid)
+	assert.Equal(t, 1, result.Metrics.TotalEndpoints)
+
+	require.NotEmpty(t, result.Warnings)
+	assert.Equal(t, "MISSING_OPERATION_ID", result.Warnings[0].Code)
+	assert.Equal(t, "/paths/~1pets/post", result.Warnings[0].Path)
+	assert.Equal(t, 6, result.Warnings[0].Line)
+	assert.Equal(t, 15, result.Warnings[0].Column)
+}
+
+func TestOpenAPIValidator_SyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   string
+		line   int
+		column int
+	}{
+		{
+			name:   "json missing comma",
+			spec:   "{\n  \"openapi\": \"3.0.3\"\n  \"info\": {}\n}",
+			line:   3,
+			column: 3,
+		},
+		{
+			name: "yaml bad indentation",
+			spec: "openapi: 3.0.3\ninfo:\n  title: Pets\n version: 1.0.0\n",
+			line: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), tt.spec, SchemaFormatOpenAPI)
+			require.NoError(t, err)
+
+			assert.False(t, result.IsValid)
+			require.Len(t, result.Errors, 1)
+			assert.Equal(t, "SYNTAX_ERROR", result.Errors[0].Code)
+			assert.Equal(t, OpenAPIRuleSyntax, result.Errors[0].Rule)
+			assert.Equal(t, tt.line, result.Errors[0].Line)
+			if tt.column > 0 {
+				assert.Equal(t, tt.column, result.Errors[0].Column)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidator_SemanticErrors(t *testing.T) {
+	spec := `swagger: "2.0"
+info:
+  title: Pets API
+paths:
+  pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: OK
+  /pets/{petId}:
+    get:
+      operationId: listPets
+      parameters:
+        - name: verbose
+          in: body
+      responses: {}
+  /owners:
+    get:
+      operationId: listOwners
+      responses:
+        "200":
+          $ref: "#/components/responses/Missing"
+`
+	result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), spec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+
+	assert.False(t, result.IsValid)
+	assert.ElementsMatch(t, []string{
+		"UNSUPPORTED_VERSION",
+		"MISSING_FIELD", // info.version
+		"INVALID_PATH",
+		"INVALID_PARAMETER_LOCATION",
+		"MISSING_PATH_PARAMETER",
+		"MISSING_RESPONSES",
+		"DUPLICATE_OPERATION_ID",
+		"UNRESOLVED_REF",
+	}, errorCodes(result))
+
+	byCode := make(map[string]SchemaValidationError)
+	for _, e := range result.Errors {
+		byCode[e.Code] = e
+	}
+
+	assert.Equal(t, 1, byCode["UNSUPPORTED_VERSION"].Line)
+	assert.Equal(t, "/info/version", byCode["MISSING_FIELD"].Path)
+	assert.Equal(t, 3, byCode["MISSING_FIELD"].Line)
+
+	invalidPath := byCode["INVALID_PATH"]
+	assert.Equal(t, "/paths/pets", invalidPath.Path)
+	assert.Equal(t, 5, invalidPath.Line)
+	assert.Equal(t, 3, invalidPath.Column)
+
+	location := byCode["INVALID_PARAMETER_LOCATION"]
+	assert.Equal(t, "/paths/~1pets~1{petId}/get/parameters/0/in", location.Path)
+	assert.Equal(t, 16, location.Line)
+	assert.Equal(t, 15, location.Column)
+
+	assert.Equal(t, 13, byCode["DUPLICATE_OPERATION_ID"].Line)
+	assert.Equal(t, "/paths/~1owners/get/responses/200/$ref", byCode["UNRESOLVED_REF"].Path)
+	assert.Equal(t, 23, byCode["UNRESOLVED_REF"].Line)
+
+	assert.Equal(t, 3, result.Metrics.TotalEndpoints)
+	assert.Zero(t, result.Metrics.SecurityScore)
+	assert.Less(t, result.Metrics.QualityScore, 100)
+}
+
+func TestOpenAPIValidator_OtherFormatsAreNotValidated(t *testing.T) {
+	result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), "type Query { pets: [String] }", SchemaFormatGraphQL)
+	require.NoError(t, err)
+
+	assert.True(t, result.IsValid)
+	require.Len(t, result.Warnings, 1)
+	assert.Equal(t, "VALIDATION_UNAVAILABLE", result.Warnings[0].Code)
+}
+
+func TestOpenAPIValidator_GetValidationRules(t *testing.T) {
+	rules, err := newTestOpenAPIValidator().GetValidationRules(context.Background(), SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	require.Len(t, rules, len(openAPIValidationRules))
+	for _, rule := range rules {
+		assert.True(t, rule.Enabled, rule.ID)
+	}
+}
+
+// examplesSpec builds a document whose Pet schema requires id and name,
+// with the given example on the listPets response
+func examplesSpec(example string) string {
+	return `openapi: 3.0.3
+info:
+  title: Pets API
+  version: 1.0.0
+security:
+  - apiKey: []
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List pets
+      responses:
+        "200":
+          description: A list of pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "#/components/schemas/Pet"
+              example:
+` + example + `
+components:
+  securitySchemes:
+    apiKey:
+      type: apiKey
+      in: header
+      name: X-API-Key
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+`
+}
+
+func TestOpenAPIValidator_Examples(t *testing.T) {
+	tt := []struct {
+		name     string
+		example  string
+		path     string
+		line     int
+		contains string
+	}{
+		{
+			name: "valid example",
+			example: `                - id: 1
+                  name: Rex`,
+		},
+		{
+			name: "type mismatch",
+			example: `                - id: "one"
+                  name: Rex`,
+			path:     "/paths/~1pets/get/responses/200/content/application~1json/example/0/id",
+			line:     22,
+			contains: "expected integer, got string",
+		},
+		{
+			name: "missing required field",
+			example: `                - id: 1
+                  tag: dog`,
+			path:     "/paths/~1pets/get/responses/200/content/application~1json/example/0",
+			line:     22,
+			contains: `missing required property "name"`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), examplesSpec(tc.example), SchemaFormatOpenAPI)
+			require.NoError(t, err)
+			assert.True(t, result.IsValid, "example mismatches are warnings")
+
+			if tc.path == "" {
+				assert.Empty(t, result.Warnings)
+				return
+			}
+			require.Len(t, result.Warnings, 1)
+			warning := result.Warnings[0]
+			assert.Equal(t, "INVALID_EXAMPLE", warning.Code)
+			assert.Equal(t, OpenAPIRuleExamples, warning.Rule)
+			assert.Equal(t, tc.path, warning.Path)
+			assert.Equal(t, tc.line, warning.Line)
+			assert.Contains(t, warning.Message, tc.contains)
+		})
+	}
+}
+
+func TestOpenAPIValidator_SchemaAndNamedExamples(t *testing.T) {
+	spec := `openapi: 3.0.3
+info:
+  title: Pets API
+  version: 1.0.0
+paths:
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      summary: Get a pet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+          schema:
+            type: integer
+          example: abc
+      responses:
+        "200":
+          description: A pet
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Pet"
+              examples:
+                rex:
+                  $ref: "#/components/examples/Rex"
+components:
+  examples:
+    Rex:
+      value:
+        status: lost
+  schemas:
+    Pet:
+      type: object
+      properties:
+        status:
+          type: string
+          enum: [available, sold]
+          example: sold
+        age:
+          type: integer
+          nullable: true
+          example: null
+      example:
+        age: two
+`
+	result, err := newTestOpenAPIValidator().ValidateSchema(context.Background(), spec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+
+	paths := make(map[string]string)
+	for _, w := range result.Warnings {
+		if w.Code == "INVALID_EXAMPLE" {
+			paths[w.Path] = w.Message
+		}
+	}
+	assert.Len(t, paths, 3)
+	assert.Contains(t, paths["/paths/~1pets~1{petId}/get/parameters/0/example"], "expected integer, got string")
+	assert.Contains(t, paths["/paths/~1pets~1{petId}/get/responses/200/content/application~1json/examples/rex/value/status"], "not one of the allowed values")
+	assert.Contains(t, paths["/components/schemas/Pet/example/age"], "expected integer or null, got string")
+}
+
+func TestOpenAPIValidator_ExampleRuleCanBeDisabled(t *testing.T) {
+	ctx := context.Background()
+	v := newTestOpenAPIValidator()
+	require.NoError(t, v.SetRuleEnabled(OpenAPIRuleExamples, false))
+	assert.Error(t, v.SetRuleEnabled("no-such-rule", false))
+
+	rules, err := v.GetValidationRules(ctx, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	for _, rule := range rules {
+		assert.Equal(t, rule.ID != OpenAPIRuleExamples, rule.Enabled, rule.ID)
+	}
+
+	result, err := v.ValidateSchema(ctx, examplesSpec(`                - id: "one"`), SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+
+	require.NoError(t, v.SetRuleEnabled(OpenAPIRuleExamples, true))
+	result, err = v.ValidateSchema(ctx, examplesSpec(`                - id: "one"`), SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	assert.Len(t, result.Warnings, 2) // id has the wrong type and name is missing
+}
+
+func TestSchemaService_CreateSchema_RejectsInvalidOpenAPI(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	repo := newMemorySchemaRepository()
+
+	// No validator given: the service validates OpenAPI with OpenAPIValidator
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		nil, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	_, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     `{"openapi": "3.0.3", "paths": {}}`,
+		Version:     "1.0.0",
+		CreatedBy:   actor,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "info is required")
+	assert.Empty(t, repo.schemas)
+
+	schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     validPetsSpec,
+		Version:     "1.0.0",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, repo.schemas, schema.ID)
+}
@@ -18,9 +18,11 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/drewpayment/orbit/services/repository/internal/domain"
@@ -32,6 +34,10 @@ type PageRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, page *domain.Page) error
 	Update(ctx context.Context, page *domain.Page) error
+	// UpdateIfUnmodified writes page only while the stored row still has
+	// updated_at = expectedUpdatedAt (UPDATE ... WHERE id = $1 AND
+	// updated_at = $2) and returns ErrPageConflict when no row matched
+	UpdateIfUnmodified(ctx context.Context, page *domain.Page, expectedUpdatedAt time.Time) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Page, error)
 	GetBySlug(ctx context.Context, workspaceID uuid.UUID, slug string) (*domain.Page, error)
@@ -53,6 +59,9 @@ type PageRepository interface {
 	GetPageStats(ctx context.Context, pageID uuid.UUID) (*PageStats, error)
 	GetWorkspacePageStats(ctx context.Context, workspaceID uuid.UUID) (*WorkspacePageStats, error)
 	RecordPageView(ctx context.Context, pageID, userID uuid.UUID, metadata map[string]interface{}) error
+	IncrementViewRollup(ctx context.Context, workspaceID, pageID uuid.UUID, day time.Time) error
+	GetViewRollups(ctx context.Context, pageID uuid.UUID, from, to time.Time) ([]ViewHistoryPoint, error)
+	GetWorkspaceViewRollups(ctx context.Context, workspaceID uuid.UUID, from, to time.Time) ([]ViewHistoryPoint, error)
 }
 
 // TemplateEngine defines the interface for template rendering operations
@@ -237,6 +246,27 @@ type UpdatePageRequest struct {
 	Settings    *PageSettings          `json:"settings,omitempty"`
 	PublishAt   *time.Time             `json:"publish_at,omitempty"`
 	UpdatedBy   uuid.UUID              `json:"updated_by" validate:"required"`
+
+	// ExpectedUpdatedAt is the UpdatedAt of the page the caller edited.
+	// When set, the update is rejected with a PageConflictError if the
+	// page has changed since.
+	ExpectedUpdatedAt *time.Time `json:"expected_updated_at,omitempty"`
+}
+
+// PageConflictError is returned when a page changed after the caller read
+// it. Current holds the stored page so the caller can merge its edits and
+// retry. It matches ErrPageConflict with errors.Is.
+type PageConflictError struct {
+	Current *domain.Page `json:"current"`
+}
+
+func (e *PageConflictError) Error() string {
+	return fmt.Sprintf("%s: updated at %s by %s", ErrPageConflict.Message,
+		e.Current.UpdatedAt.Format(time.RFC3339Nano), e.Current.UpdatedBy)
+}
+
+func (e *PageConflictError) Unwrap() error {
+	return ErrPageConflict
 }
 
 // SEOSettings contains SEO-related settings for a page
@@ -358,12 +388,13 @@ type HelperParameter struct {
 
 // RenderRequest contains data for template rendering
 type RenderRequest struct {
-	TemplateID *uuid.UUID             `json:"template_id,omitempty"`
-	Template   string                 `json:"template,omitempty"`
-	Format     TemplateFormat         `json:"format" validate:"required"`
-	Variables  map[string]interface{} `json:"variables"`
-	Context    RenderContext          `json:"context"`
-	Options    RenderOptions          `json:"options"`
+	TemplateID    *uuid.UUID             `json:"template_id,omitempty"`
+	Template      string                 `json:"template,omitempty"`
+	Format        TemplateFormat         `json:"format" validate:"required"`
+	ContentFormat PageFormat             `json:"content_format"` // html (default) or markdown
+	Variables     map[string]interface{} `json:"variables"`
+	Context       RenderContext          `json:"context"`
+	Options       RenderOptions          `json:"options"`
 }
 
 // RenderContext provides context for template rendering
@@ -627,6 +658,10 @@ type Asset struct {
 	UpdatedAt    time.Time              `json:"updated_at"`
 	UploadedBy   uuid.UUID              `json:"uploaded_by"`
 
+	// Related assets
+	ParentAssetID *uuid.UUID     `json:"parent_asset_id,omitempty"` // set on generated variants
+	Variants      []AssetVariant `json:"variants,omitempty"`        // modern-format encodings of this asset
+
 	// Computed fields
 	Uploader *domain.User `json:"uploader,omitempty"`
 }
@@ -662,6 +697,8 @@ type PageStats struct {
 	PerformanceScore  int                `json:"performance_score"`
 	LastViewedAt      *time.Time         `json:"last_viewed_at"`
 	PopularityScore   float64            `json:"popularity_score"`
+	ViewsToday        int64              `json:"views_today"`
+	ViewsThisWeek     int64              `json:"views_this_week"` // last 7 days including today
 	ViewHistory       []ViewHistoryPoint `json:"view_history"`
 	ReferrerBreakdown map[string]int64   `json:"referrer_breakdown"`
 	DeviceBreakdown   map[string]int64   `json:"device_breakdown"`
@@ -675,6 +712,9 @@ type WorkspacePageStats struct {
 	DraftPages         int                         `json:"draft_pages"`
 	TotalViews         int64                       `json:"total_views"`
 	UniqueVisitors     int64                       `json:"unique_visitors"`
+	ViewsToday         int64                       `json:"views_today"`
+	ViewsThisWeek      int64                       `json:"views_this_week"` // last 7 days including today
+	ViewHistory        []ViewHistoryPoint          `json:"view_history"`
 	PagesByType        map[PageType]int            `json:"pages_by_type"`
 	PagesByFormat      map[PageFormat]int          `json:"pages_by_format"`
 	TopPages           []*domain.Page              `json:"top_pages"`
@@ -719,7 +759,11 @@ type PageService struct {
 	assetManager   AssetManager
 	eventPub       EventPublisher
 	cache          CacheManager
+	auditor        AuditRecorder
 	logger         *slog.Logger
+
+	viewDebounceWindow time.Duration
+	now                func() time.Time
 }
 
 // NewPageService creates a new page service instance
@@ -732,6 +776,7 @@ func NewPageService(
 	assetManager AssetManager,
 	eventPub EventPublisher,
 	cache CacheManager,
+	auditor AuditRecorder,
 	logger *slog.Logger,
 ) *PageService {
 	return &PageService{
@@ -743,7 +788,11 @@ func NewPageService(
 		assetManager:   assetManager,
 		eventPub:       eventPub,
 		cache:          cache,
+		auditor:        auditor,
 		logger:         logger.With("service", "page"),
+
+		viewDebounceWindow: DefaultPageViewDebounceWindow,
+		now:                time.Now,
 	}
 }
 
@@ -821,6 +870,15 @@ func (s *PageService) CreatePage(ctx context.Context, req CreatePageRequest) (*d
 	// Clear relevant caches
 	s.clearPageListCaches(ctx, req.WorkspaceID)
 
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: page.WorkspaceID,
+		ActorID:     req.CreatedBy,
+		Action:      AuditActionCreated,
+		TargetType:  AuditTargetPage,
+		TargetID:    page.ID,
+		After:       page,
+	})
+
 	// TODO: Publish page created event when EventPublisher is updated
 
 	s.logger.InfoContext(ctx, "Page created successfully",
@@ -829,6 +887,110 @@ func (s *PageService) CreatePage(ctx context.Context, req CreatePageRequest) (*d
 	return page, nil
 }
 
+// UpdatePage applies the non-nil fields of req to a page. When
+// req.ExpectedUpdatedAt is set and the stored page was updated at a
+// different time, nothing is written and a *PageConflictError carrying the
+// stored page is returned. The write itself is conditional on the page
+// being unchanged since it was read, so an update that lands in between is
+// reported the same way instead of being overwritten.
+func (s *PageService) UpdatePage(ctx context.Context, req UpdatePageRequest) (*domain.Page, error) {
+	s.logger.InfoContext(ctx, "Updating page", "page_id", req.ID, "updated_by", req.UpdatedBy)
+
+	current, err := s.pageRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, current.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	if !s.canUserModifyPage(ctx, current, workspace, req.UpdatedBy) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	if req.ExpectedUpdatedAt != nil && !current.UpdatedAt.Equal(*req.ExpectedUpdatedAt) {
+		s.logger.InfoContext(ctx, "Page update conflicts with a newer change",
+			"page_id", current.ID, "expected_updated_at", *req.ExpectedUpdatedAt, "updated_at", current.UpdatedAt)
+		return nil, &PageConflictError{Current: current}
+	}
+
+	// Work on a copy so a rejected update leaves the stored page untouched
+	page := *current
+	if req.Title != nil {
+		if strings.TrimSpace(*req.Title) == "" {
+			return nil, fmt.Errorf("validation failed: %w", ErrInvalidPageTitle)
+		}
+		page.Title = *req.Title
+	}
+	if req.Content != nil {
+		page.Content = *req.Content
+	}
+	if req.Format != nil {
+		page.Format = string(*req.Format)
+	}
+	if req.Status != nil {
+		page.Status = string(*req.Status)
+	}
+	if req.Description != nil {
+		page.Description = *req.Description
+	}
+	if req.Keywords != nil {
+		page.Keywords = req.Keywords
+	}
+	if req.Tags != nil {
+		page.Tags = req.Tags
+	}
+	if req.SEO != nil {
+		page.SEOTitle = req.SEO.Title
+		page.SEODescription = req.SEO.Description
+		page.SEOKeywords = req.SEO.Keywords
+		page.CanonicalURL = req.SEO.CanonicalURL
+		page.MetaRobots = req.SEO.MetaRobots
+	}
+	if req.Settings != nil {
+		page.IsIndexable = req.Settings.IsIndexable
+		page.RequireAuth = req.Settings.RequireAuth
+		page.CacheEnabled = req.Settings.CacheEnabled
+		page.CacheDuration = req.Settings.CacheDuration
+	}
+	if req.PublishAt != nil {
+		page.PublishAt = req.PublishAt
+	}
+	page.UpdatedAt = time.Now()
+	page.UpdatedBy = req.UpdatedBy
+
+	if err := s.pageRepo.UpdateIfUnmodified(ctx, &page, current.UpdatedAt); err != nil {
+		if !errors.Is(err, ErrPageConflict) {
+			return nil, fmt.Errorf("failed to update page: %w", err)
+		}
+		latest, getErr := s.pageRepo.GetByID(ctx, req.ID)
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get page: %w", getErr)
+		}
+		s.logger.InfoContext(ctx, "Page changed while it was being updated",
+			"page_id", latest.ID, "read_updated_at", current.UpdatedAt, "updated_at", latest.UpdatedAt)
+		return nil, &PageConflictError{Current: latest}
+	}
+
+	s.clearPageCaches(ctx, &page)
+
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: page.WorkspaceID,
+		ActorID:     req.UpdatedBy,
+		Action:      AuditActionUpdated,
+		TargetType:  AuditTargetPage,
+		TargetID:    page.ID,
+		Before:      current,
+		After:       &page,
+	})
+
+	s.logger.InfoContext(ctx, "Page updated successfully", "page_id", page.ID, "title", page.Title)
+
+	return &page, nil
+}
+
 // RenderPage renders a page using the template engine
 func (s *PageService) RenderPage(ctx context.Context, pageID uuid.UUID, context RenderContext, options RenderOptions) (*RenderResult, error) {
 	s.logger.DebugContext(ctx, "Rendering page", "page_id", pageID, "user_id", context.UserID)
@@ -862,8 +1024,9 @@ func (s *PageService) RenderPage(ctx context.Context, pageID uuid.UUID, context
 
 	// Prepare render request
 	renderReq := &RenderRequest{
-		Template: page.Content,
-		Format:   TemplateFormatHandlebars, // Default format
+		Template:      page.Content,
+		Format:        TemplateFormatGo,
+		ContentFormat: PageFormat(page.Format),
 		Variables: map[string]interface{}{
 			"page":      page,
 			"workspace": workspace,
@@ -894,10 +1057,16 @@ func (s *PageService) RenderPage(ctx context.Context, pageID uuid.UUID, context
 	}
 
 	// Record page view
-	if err := s.pageRepo.RecordPageView(ctx, pageID, context.UserID, map[string]interface{}{
-		"path":       context.RequestPath,
-		"referrer":   context.Metadata["referrer"],
-		"user_agent": context.Metadata["user_agent"],
+	sessionID, _ := context.Metadata["session_id"].(string)
+	referrer, _ := context.Metadata["referrer"].(string)
+	userAgent, _ := context.Metadata["user_agent"].(string)
+	if _, err := s.recordView(ctx, page, PageViewRequest{
+		PageID:    pageID,
+		UserID:    context.UserID,
+		SessionID: sessionID,
+		Path:      context.RequestPath,
+		Referrer:  referrer,
+		UserAgent: userAgent,
 	}); err != nil {
 		s.logger.WarnContext(ctx, "Failed to record page view", "error", err)
 	}
@@ -933,9 +1102,16 @@ func (s *PageService) CreateTemplate(ctx context.Context, req CreateTemplateRequ
 	}
 
 	// Validate template content
-	if _, err := s.templateEngine.ValidateTemplate(ctx, req.Content, req.Format); err != nil {
+	validation, err := s.templateEngine.ValidateTemplate(ctx, req.Content, req.Format)
+	if err != nil {
 		return nil, fmt.Errorf("template validation failed: %w", err)
 	}
+	if !validation.IsValid {
+		if len(validation.Errors) > 0 {
+			return nil, fmt.Errorf("template validation failed: line %d: %s", validation.Errors[0].Line, validation.Errors[0].Message)
+		}
+		return nil, errors.New("template validation failed")
+	}
 
 	// Create the template domain object
 	now := time.Now()
@@ -964,6 +1140,15 @@ func (s *PageService) CreateTemplate(ctx context.Context, req CreateTemplateRequ
 	// Clear relevant caches
 	s.clearTemplateListCaches(ctx, req.WorkspaceID)
 
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: template.WorkspaceID,
+		ActorID:     req.CreatedBy,
+		Action:      AuditActionCreated,
+		TargetType:  AuditTargetPageTemplate,
+		TargetID:    template.ID,
+		After:       template,
+	})
+
 	// TODO: Publish template created event when EventPublisher is updated
 
 	s.logger.InfoContext(ctx, "Page template created successfully",
@@ -972,18 +1157,22 @@ func (s *PageService) CreateTemplate(ctx context.Context, req CreateTemplateRequ
 	return template, nil
 }
 
-// OptimizeSEO optimizes a page for SEO
+// OptimizeSEO optimizes a page for SEO on behalf of the authenticated user
+// in ctx
 func (s *PageService) OptimizeSEO(ctx context.Context, pageID uuid.UUID, options SEOOptimizationOptions) (*SEOOptimizationResult, error) {
 	s.logger.InfoContext(ctx, "Optimizing page SEO", "page_id", pageID)
 
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the page
 	page, err := s.pageRepo.GetByID(ctx, pageID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get page: %w", err)
 	}
 
-	// Check permissions (simplified - get user ID from context)
-	userID := uuid.New() // This would come from authentication context
 	workspace, err := s.workspaceRepo.GetByID(ctx, page.WorkspaceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workspace: %w", err)
@@ -1142,6 +1331,18 @@ func (s *PageService) calculateContextHash(context RenderContext) string {
 	return fmt.Sprintf("ctx-%x", context.UserID)
 }
 
+// recordAudit appends an event to the workspace audit trail. Failures are
+// logged rather than returned because the mutation has already been persisted.
+func (s *PageService) recordAudit(ctx context.Context, record AuditRecord) {
+	if s.auditor == nil {
+		return
+	}
+	if _, err := s.auditor.Record(ctx, record); err != nil {
+		s.logger.WarnContext(ctx, "Failed to record audit event",
+			"action", record.Action, "target_type", record.TargetType, "target_id", record.TargetID, "error", err)
+	}
+}
+
 // Permission checking methods
 
 func (s *PageService) canUserCreatePage(ctx context.Context, workspace *domain.Workspace, userID uuid.UUID) bool {
@@ -1225,6 +1426,17 @@ func (s *PageService) clearPageListCaches(ctx context.Context, workspaceID uuid.
 	}
 }
 
+// clearPageCaches drops a page's cached renders along with the lists that
+// embed it
+func (s *PageService) clearPageCaches(ctx context.Context, page *domain.Page) {
+	pattern := fmt.Sprintf("page:rendered:%s:*", page.ID.String())
+	if err := s.cache.DeleteByPattern(ctx, pattern); err != nil {
+		s.logger.WarnContext(ctx, "Failed to clear cache", "pattern", pattern, "error", err)
+	}
+
+	s.clearPageListCaches(ctx, page.WorkspaceID)
+}
+
 func (s *PageService) clearTemplateListCaches(ctx context.Context, workspaceID uuid.UUID) {
 	patterns := []string{
 		fmt.Sprintf("workspace:templates:%s", workspaceID.String()),
@@ -1251,9 +1463,15 @@ var (
 	ErrInvalidTemplateType    = domain.NewDomainError("INVALID_TEMPLATE_TYPE", "Template type is invalid")
 	ErrPageExists             = domain.NewDomainError("PAGE_EXISTS", "Page already exists")
 	ErrPageNotFound           = domain.NewDomainError("PAGE_NOT_FOUND", "Page not found")
+	ErrPageConflict           = domain.NewDomainError("PAGE_CONFLICT", "Page was modified by another update")
 	ErrTemplateExists         = domain.NewDomainError("TEMPLATE_EXISTS", "Template already exists")
 	ErrPageTemplateNotFound   = domain.NewDomainError("PAGE_TEMPLATE_NOT_FOUND", "Page template not found")
 	ErrRenderingFailed        = domain.NewDomainError("RENDERING_FAILED", "Page rendering failed")
 	ErrSEOOptimizationFailed  = domain.NewDomainError("SEO_OPTIMIZATION_FAILED", "SEO optimization failed")
 	ErrAssetUploadFailed      = domain.NewDomainError("ASSET_UPLOAD_FAILED", "Asset upload failed")
+	ErrAssetTooLarge          = domain.NewDomainError("ASSET_TOO_LARGE", "Asset exceeds the maximum upload size")
+	ErrUnsupportedAssetType   = domain.NewDomainError("UNSUPPORTED_ASSET_TYPE", "Asset content type is not allowed")
+	ErrUnsupportedImage       = domain.NewDomainError("UNSUPPORTED_IMAGE", "Asset is not a supported raster image")
+	ErrUnsupportedImageFormat = domain.NewDomainError("UNSUPPORTED_IMAGE_FORMAT", "Image format cannot be encoded")
+	ErrInvalidThumbnailSize   = domain.NewDomainError("INVALID_THUMBNAIL_SIZE", "Thumbnail size is invalid")
 )
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+type memoryPageRepository struct {
+	PageRepository
+	pages     map[uuid.UUID]*domain.Page
+	templates map[uuid.UUID]*domain.PageTemplate
+}
+
+func newMemoryPageRepository() *memoryPageRepository {
+	return &memoryPageRepository{
+		pages:     make(map[uuid.UUID]*domain.Page),
+		templates: make(map[uuid.UUID]*domain.PageTemplate),
+	}
+}
+
+func (r *memoryPageRepository) Create(_ context.Context, page *domain.Page) error {
+	r.pages[page.ID] = page
+	return nil
+}
+
+func (r *memoryPageRepository) Update(_ context.Context, page *domain.Page) error {
+	r.pages[page.ID] = page
+	return nil
+}
+
+func (r *memoryPageRepository) UpdateIfUnmodified(_ context.Context, page *domain.Page, expectedUpdatedAt time.Time) error {
+	stored, ok := r.pages[page.ID]
+	if !ok || !stored.UpdatedAt.Equal(expectedUpdatedAt) {
+		return ErrPageConflict
+	}
+	r.pages[page.ID] = page
+	return nil
+}
+
+func (r *memoryPageRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.Page, error) {
+	if page, ok := r.pages[id]; ok {
+		return page, nil
+	}
+	return nil, ErrPageNotFound
+}
+
+func (r *memoryPageRepository) GetBySlug(_ context.Context, workspaceID uuid.UUID, slug string) (*domain.Page, error) {
+	for _, page := range r.pages {
+		if page.WorkspaceID == workspaceID && page.Slug == slug {
+			return page, nil
+		}
+	}
+	return nil, fmt.Errorf("page %s not found", slug)
+}
+
+func (r *memoryPageRepository) GetByPath(_ context.Context, workspaceID uuid.UUID, path string) (*domain.Page, error) {
+	for _, page := range r.pages {
+		if page.WorkspaceID == workspaceID && page.Path == path {
+			return page, nil
+		}
+	}
+	return nil, fmt.Errorf("page %s not found", path)
+}
+
+func (r *memoryPageRepository) CreateTemplate(_ context.Context, template *domain.PageTemplate) error {
+	r.templates[template.ID] = template
+	return nil
+}
+
+func (r *memoryPageRepository) GetTemplateByName(_ context.Context, workspaceID uuid.UUID, name string) (*domain.PageTemplate, error) {
+	for _, template := range r.templates {
+		if template.WorkspaceID == workspaceID && template.Name == name {
+			return template, nil
+		}
+	}
+	return nil, fmt.Errorf("template %s not found", name)
+}
+
+// acceptingTemplateEngine reports every template as valid
+type acceptingTemplateEngine struct {
+	TemplateEngine
+}
+
+func (acceptingTemplateEngine) ValidateTemplate(_ context.Context, _ string, _ TemplateFormat) (*ValidationResult, error) {
+	return &ValidationResult{IsValid: true}, nil
+}
+
+func TestPageService_MutationsRecordAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleAdmin)
+	recorder := newTestAuditRecorder(&memoryAuditStore{})
+
+	service := NewPageService(newMemoryPageRepository(), singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingTemplateEngine{}, nil, nil, nil, noopCache{}, recorder, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	page, err := service.CreatePage(ctx, CreatePageRequest{
+		WorkspaceID: workspace.ID,
+		Title:       "Getting Started",
+		Slug:        "getting-started",
+		Path:        "/docs/getting-started",
+		Content:     "# Getting Started",
+		Format:      PageFormatMarkdown,
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	template, err := service.CreateTemplate(ctx, CreateTemplateRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Docs Layout",
+		Slug:        "docs-layout",
+		Type:        TemplateTypePage,
+		Format:      TemplateFormatHandlebars,
+		Content:     "<main>{{content}}</main>",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	trail, err := recorder.GetAuditTrail(ctx, workspace.ID, AuditFilters{
+		Actions:  []AuditAction{AuditActionCreated},
+		ActorIDs: []uuid.UUID{actor},
+	})
+	require.NoError(t, err)
+	require.Len(t, trail, 2)
+
+	assert.Equal(t, AuditTargetPageTemplate, trail[0].TargetType)
+	assert.Equal(t, template.ID, trail[0].TargetID)
+	assert.Contains(t, trail[0].Changes, AuditFieldChange{Field: "name", NewValue: "Docs Layout"})
+
+	assert.Equal(t, AuditTargetPage, trail[1].TargetType)
+	assert.Equal(t, page.ID, trail[1].TargetID)
+	assert.Contains(t, trail[1].Changes, AuditFieldChange{Field: "path", NewValue: "/docs/getting-started"})
+}
+
+func TestPageService_UpdatePage_RejectsStaleWrite(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	workspace := newTestWorkspace(alice, domain.WorkspaceRoleAdmin)
+	workspace.Members = append(workspace.Members, domain.WorkspaceMember{
+		WorkspaceID: workspace.ID, UserID: bob, Role: domain.WorkspaceRoleAdmin, IsActive: true,
+	})
+	recorder := newTestAuditRecorder(&memoryAuditStore{})
+	repo := newMemoryPageRepository()
+
+	service := NewPageService(repo, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingTemplateEngine{}, nil, nil, nil, noopCache{}, recorder, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	page, err := service.CreatePage(ctx, CreatePageRequest{
+		WorkspaceID: workspace.ID,
+		Title:       "Getting Started",
+		Slug:        "getting-started",
+		Path:        "/docs/getting-started",
+		Content:     "# Getting Started",
+		Format:      PageFormatMarkdown,
+		CreatedBy:   alice,
+	})
+	require.NoError(t, err)
+	readAt := page.UpdatedAt
+
+	// Alice and Bob both opened the page at readAt; Alice saves first
+	aliceContent := "# Getting Started\n\nInstall the CLI."
+	saved, err := service.UpdatePage(ctx, UpdatePageRequest{
+		ID:                page.ID,
+		Content:           &aliceContent,
+		UpdatedBy:         alice,
+		ExpectedUpdatedAt: &readAt,
+	})
+	require.NoError(t, err)
+
+	bobContent := "# Getting Started\n\nSign up first."
+	_, err = service.UpdatePage(ctx, UpdatePageRequest{
+		ID:                page.ID,
+		Content:           &bobContent,
+		UpdatedBy:         bob,
+		ExpectedUpdatedAt: &readAt,
+	})
+	require.ErrorIs(t, err, ErrPageConflict)
+	var conflict *PageConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, aliceContent, conflict.Current.Content)
+	assert.Equal(t, alice, conflict.Current.UpdatedBy)
+	assert.Equal(t, saved.UpdatedAt, conflict.Current.UpdatedAt)
+
+	stored, err := repo.GetByID(ctx, page.ID)
+	require.NoError(t, err)
+	assert.Equal(t, aliceContent, stored.Content, "the rejected update must not be written")
+
+	trail, err := recorder.GetAuditTrail(ctx, workspace.ID, AuditFilters{Actions: []AuditAction{AuditActionUpdated}})
+	require.NoError(t, err)
+	require.Len(t, trail, 1)
+	assert.Equal(t, alice, trail[0].ActorID)
+
+	// Without a precondition the last write wins, as before
+	_, err = service.UpdatePage(ctx, UpdatePageRequest{ID: page.ID, Content: &bobContent, UpdatedBy: bob})
+	require.NoError(t, err)
+}
+
+// interleavingPageRepository runs beforeWrite once, just before the
+// service's conditional write, to stand in for a concurrent update
+type interleavingPageRepository struct {
+	*memoryPageRepository
+	beforeWrite func()
+}
+
+func (r *interleavingPageRepository) UpdateIfUnmodified(ctx context.Context, page *domain.Page, expectedUpdatedAt time.Time) error {
+	if hook := r.beforeWrite; hook != nil {
+		r.beforeWrite = nil
+		hook()
+	}
+	return r.memoryPageRepository.UpdateIfUnmodified(ctx, page, expectedUpdatedAt)
+}
+
+func TestPageService_UpdatePage_ConflictsWithWriteAfterRead(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	workspace := newTestWorkspace(alice, domain.WorkspaceRoleAdmin)
+	workspace.Members = append(workspace.Members, domain.WorkspaceMember{
+		WorkspaceID: workspace.ID, UserID: bob, Role: domain.WorkspaceRoleAdmin, IsActive: true,
+	})
+	recorder := newTestAuditRecorder(&memoryAuditStore{})
+	repo := &interleavingPageRepository{memoryPageRepository: newMemoryPageRepository()}
+
+	service := NewPageService(repo, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingTemplateEngine{}, nil, nil, nil, noopCache{}, recorder, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	page, err := service.CreatePage(ctx, CreatePageRequest{
+		WorkspaceID: workspace.ID,
+		Title:       "Getting Started",
+		Slug:        "getting-started",
+		Path:        "/docs/getting-started",
+		Content:     "# Getting Started",
+		Format:      PageFormatMarkdown,
+		CreatedBy:   alice,
+	})
+	require.NoError(t, err)
+	readAt := page.UpdatedAt
+
+	// Alice's save lands after Bob's UpdatePage has read and checked the
+	// page but before it writes
+	aliceContent := "# Getting Started\n\nInstall the CLI."
+	aliceAt := readAt.Add(time.Second)
+	repo.beforeWrite = func() {
+		saved := *page
+		saved.Content = aliceContent
+		saved.UpdatedBy = alice
+		saved.UpdatedAt = aliceAt
+		require.NoError(t, repo.Update(ctx, &saved))
+	}
+
+	bobContent := "# Getting Started\n\nSign up first."
+	_, err = service.UpdatePage(ctx, UpdatePageRequest{
+		ID:                page.ID,
+		Content:           &bobContent,
+		UpdatedBy:         bob,
+		ExpectedUpdatedAt: &readAt,
+	})
+	require.ErrorIs(t, err, ErrPageConflict)
+	var conflict *PageConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, aliceContent, conflict.Current.Content)
+	assert.Equal(t, aliceAt, conflict.Current.UpdatedAt)
+
+	stored, err := repo.GetByID(ctx, page.ID)
+	require.NoError(t, err)
+	assert.Equal(t, aliceContent, stored.Content, "the conflicting update must not be written")
+
+	trail, err := recorder.GetAuditTrail(ctx, workspace.ID, AuditFilters{Actions: []AuditAction{AuditActionUpdated}})
+	require.NoError(t, err)
+	assert.Empty(t, trail)
+}
+
+func TestPageService_OptimizeSEO_UsesAuthenticatedUser(t *testing.T) {
+	admin, viewer := uuid.New(), uuid.New()
+	workspace := newTestWorkspace(admin, domain.WorkspaceRoleAdmin)
+	workspace.Members = append(workspace.Members, domain.WorkspaceMember{
+		WorkspaceID: workspace.ID, UserID: viewer, Role: domain.WorkspaceRoleViewer, IsActive: true,
+	})
+	repo := newMemoryPageRepository()
+	page := &domain.Page{
+		ID:          uuid.New(),
+		WorkspaceID: workspace.ID,
+		Title:       "Getting Started",
+		Description: "Install the CLI and create your first workspace in a few minutes.",
+		Slug:        "getting-started",
+		Path:        "/docs/getting-started",
+		Content:     "# Getting Started",
+		CreatedBy:   uuid.New(),
+	}
+	repo.pages[page.ID] = page
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	optimizer := NewDefaultSEOOptimizer(repo, SEOOptimizerConfig{SiteName: "Orbit Docs"}, logger)
+	service := NewPageService(repo, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingTemplateEngine{}, optimizer, nil, nil, noopCache{}, nil, logger)
+	options := SEOOptimizationOptions{GenerateMetaTags: true}
+
+	result, err := service.OptimizeSEO(withTestIdentity(admin), page.ID, options)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.NotNil(t, result.MetaTags)
+	assert.Equal(t, "Getting Started", result.MetaTags.Title)
+
+	_, err = service.OptimizeSEO(withTestIdentity(viewer), page.ID, options)
+	assert.ErrorIs(t, err, domain.ErrInsufficientPermission)
+
+	_, err = service.OptimizeSEO(context.Background(), page.ID, options)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+// DefaultPageViewDebounceWindow is how long repeat views of a page by the
+// same viewer are ignored
+const DefaultPageViewDebounceWindow = 30 * time.Minute
+
+// pageViewRollupDays is the number of daily rollups surfaced in stats
+const pageViewRollupDays = 7
+
+// PageViewRequest describes a single page view
+type PageViewRequest struct {
+	PageID    uuid.UUID `json:"page_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id"`    // uuid.Nil for anonymous viewers
+	SessionID string    `json:"session_id"` // identifies anonymous viewers
+	Path      string    `json:"path"`
+	Referrer  string    `json:"referrer"`
+	UserAgent string    `json:"user_agent"`
+}
+
+// SetViewDebounceWindow sets how long repeat views by the same viewer are
+// ignored. A zero window counts every view.
+func (s *PageService) SetViewDebounceWindow(window time.Duration) {
+	s.viewDebounceWindow = window
+}
+
+// RecordView records a page view and updates the page's daily rollup.
+// Repeat views of the page by the same user, or the same session for
+// anonymous viewers, within the debounce window are ignored. It reports
+// whether the view was counted.
+func (s *PageService) RecordView(ctx context.Context, req PageViewRequest) (bool, error) {
+	page, err := s.pageRepo.GetByID(ctx, req.PageID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get page: %w", err)
+	}
+	return s.recordView(ctx, page, req)
+}
+
+func (s *PageService) recordView(ctx context.Context, page *domain.Page, req PageViewRequest) (bool, error) {
+	viewer := pageViewer(req)
+	var debounceKey string
+	if viewer != "" && s.viewDebounceWindow > 0 {
+		debounceKey = fmt.Sprintf("page:view:%s:%s", page.ID, viewer)
+		if seen, err := s.cache.Get(ctx, debounceKey); err == nil && seen != nil {
+			s.logger.DebugContext(ctx, "Ignoring repeat page view", "page_id", page.ID, "viewer", viewer)
+			return false, nil
+		}
+	}
+
+	now := s.now()
+	if err := s.pageRepo.RecordPageView(ctx, page.ID, req.UserID, map[string]interface{}{
+		"path":       req.Path,
+		"referrer":   req.Referrer,
+		"user_agent": req.UserAgent,
+		"session_id": req.SessionID,
+		"viewed_at":  now,
+	}); err != nil {
+		return false, fmt.Errorf("failed to record page view: %w", err)
+	}
+	if err := s.pageRepo.IncrementViewRollup(ctx, page.WorkspaceID, page.ID, rollupDay(now)); err != nil {
+		return false, fmt.Errorf("failed to update page view rollup: %w", err)
+	}
+
+	if debounceKey != "" {
+		if err := s.cache.Set(ctx, debounceKey, now, s.viewDebounceWindow); err != nil {
+			s.logger.WarnContext(ctx, "Failed to store page view debounce marker", "page_id", page.ID, "error", err)
+		}
+	}
+
+	return true, nil
+}
+
+// GetPageStats returns a page's statistics with its daily and weekly view counts
+func (s *PageService) GetPageStats(ctx context.Context, pageID uuid.UUID) (*PageStats, error) {
+	stats, err := s.pageRepo.GetPageStats(ctx, pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page stats: %w", err)
+	}
+
+	from, to := s.rollupRange()
+	points, err := s.pageRepo.GetViewRollups(ctx, pageID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page view rollups: %w", err)
+	}
+	stats.ViewHistory, stats.ViewsToday, stats.ViewsThisWeek = summarizeViewRollups(points, from)
+
+	return stats, nil
+}
+
+// GetWorkspacePageStats returns a workspace's page statistics with its daily
+// and weekly view counts
+func (s *PageService) GetWorkspacePageStats(ctx context.Context, workspaceID uuid.UUID) (*WorkspacePageStats, error) {
+	stats, err := s.pageRepo.GetWorkspacePageStats(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace page stats: %w", err)
+	}
+
+	from, to := s.rollupRange()
+	points, err := s.pageRepo.GetWorkspaceViewRollups(ctx, workspaceID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace view rollups: %w", err)
+	}
+	stats.ViewHistory, stats.ViewsToday, stats.ViewsThisWeek = summarizeViewRollups(points, from)
+
+	return stats, nil
+}
+
+// rollupRange returns the first and last day of the weekly rollup window
+func (s *PageService) rollupRange() (time.Time, time.Time) {
+	today := rollupDay(s.now())
+	return today.AddDate(0, 0, -(pageViewRollupDays - 1)), today
+}
+
+// summarizeViewRollups fills in days without views and totals today's and
+// the week's views
+func summarizeViewRollups(points []ViewHistoryPoint, from time.Time) ([]ViewHistoryPoint, int64, int64) {
+	byDay := make(map[time.Time]int64, len(points))
+	for _, point := range points {
+		byDay[rollupDay(point.Date)] += point.ViewCount
+	}
+
+	history := make([]ViewHistoryPoint, pageViewRollupDays)
+	var week int64
+	for i := range history {
+		day := from.AddDate(0, 0, i)
+		history[i] = ViewHistoryPoint{Date: day, ViewCount: byDay[day]}
+		week += byDay[day]
+	}
+	return history, history[len(history)-1].ViewCount, week
+}
+
+// rollupDay truncates t to the start of its UTC day
+func rollupDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// pageViewer identifies the viewer for debouncing: the user when signed in,
+// otherwise the session. Empty means the viewer can't be identified.
+func pageViewer(req PageViewRequest) string {
+	if req.UserID != uuid.Nil {
+		return "user:" + req.UserID.String()
+	}
+	if req.SessionID != "" {
+		return "session:" + req.SessionID
+	}
+	return ""
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+type pageView struct {
+	pageID   uuid.UUID
+	userID   uuid.UUID
+	metadata map[string]interface{}
+}
+
+type pageViewRollupKey struct {
+	workspaceID uuid.UUID
+	pageID      uuid.UUID
+	day         time.Time
+}
+
+// viewTrackingPageRepository records raw views and daily rollups in memory
+type viewTrackingPageRepository struct {
+	*memoryPageRepository
+	views   []pageView
+	rollups map[pageViewRollupKey]int64
+}
+
+func newViewTrackingPageRepository(pages ...*domain.Page) *viewTrackingPageRepository {
+	repo := &viewTrackingPageRepository{
+		memoryPageRepository: newMemoryPageRepository(),
+		rollups:              make(map[pageViewRollupKey]int64),
+	}
+	for _, page := range pages {
+		repo.pages[page.ID] = page
+	}
+	return repo
+}
+
+func (r *viewTrackingPageRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.Page, error) {
+	page, ok := r.pages[id]
+	if !ok {
+		return nil, fmt.Errorf("page %s not found", id)
+	}
+	return page, nil
+}
+
+func (r *viewTrackingPageRepository) RecordPageView(_ context.Context, pageID, userID uuid.UUID, metadata map[string]interface{}) error {
+	r.views = append(r.views, pageView{pageID: pageID, userID: userID, metadata: metadata})
+	return nil
+}
+
+func (r *viewTrackingPageRepository) IncrementViewRollup(_ context.Context, workspaceID, pageID uuid.UUID, day time.Time) error {
+	r.rollups[pageViewRollupKey{workspaceID, pageID, day}]++
+	return nil
+}
+
+func (r *viewTrackingPageRepository) GetViewRollups(_ context.Context, pageID uuid.UUID, from, to time.Time) ([]ViewHistoryPoint, error) {
+	return r.rollupPoints(func(key pageViewRollupKey) bool { return key.pageID == pageID }, from, to), nil
+}
+
+func (r *viewTrackingPageRepository) GetWorkspaceViewRollups(_ context.Context, workspaceID uuid.UUID, from, to time.Time) ([]ViewHistoryPoint, error) {
+	return r.rollupPoints(func(key pageViewRollupKey) bool { return key.workspaceID == workspaceID }, from, to), nil
+}
+
+func (r *viewTrackingPageRepository) rollupPoints(match func(pageViewRollupKey) bool, from, to time.Time) []ViewHistoryPoint {
+	var points []ViewHistoryPoint
+	for key, count := range r.rollups {
+		if match(key) && !key.day.Before(from) && !key.day.After(to) {
+			points = append(points, ViewHistoryPoint{Date: key.day, ViewCount: count})
+		}
+	}
+	return points
+}
+
+func (r *viewTrackingPageRepository) GetPageStats(_ context.Context, pageID uuid.UUID) (*PageStats, error) {
+	var stats PageStats
+	for _, view := range r.views {
+		if view.pageID == pageID {
+			stats.ViewCount++
+		}
+	}
+	return &stats, nil
+}
+
+func (r *viewTrackingPageRepository) GetWorkspacePageStats(_ context.Context, _ uuid.UUID) (*WorkspacePageStats, error) {
+	return &WorkspacePageStats{TotalViews: int64(len(r.views))}, nil
+}
+
+// clockCache is a CacheManager whose entries expire against a settable clock
+type clockCache struct {
+	now     *time.Time
+	entries map[string]clockCacheEntry
+}
+
+type clockCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func (c *clockCache) Set(_ context.Context, key string, value interface{}, ttl time.Duration) error {
+	c.entries[key] = clockCacheEntry{value: value, expiresAt: c.now.Add(ttl)}
+	return nil
+}
+
+func (c *clockCache) Get(_ context.Context, key string) (interface{}, error) {
+	entry, ok := c.entries[key]
+	if !ok || !c.now.Before(entry.expiresAt) {
+		return nil, fmt.Errorf("cache miss")
+	}
+	return entry.value, nil
+}
+
+func (c *clockCache) Delete(_ context.Context, key string) error {
+	delete(c.entries, key)
+	return nil
+}
+
+func (c *clockCache) DeleteByPattern(context.Context, string) error { return nil }
+
+func newViewTestPageService(repo PageRepository, now *time.Time) *PageService {
+	service := NewPageService(repo, nil, nil, nil, nil, nil, nil,
+		&clockCache{now: now, entries: make(map[string]clockCacheEntry)}, nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	service.now = func() time.Time { return *now }
+	return service
+}
+
+func TestPageService_RecordView_DebouncesRepeatViews(t *testing.T) {
+	ctx := context.Background()
+	page := &domain.Page{ID: uuid.New(), WorkspaceID: uuid.New()}
+	repo := newViewTrackingPageRepository(page)
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	service := newViewTestPageService(repo, &now)
+	service.SetViewDebounceWindow(10 * time.Minute)
+
+	alice, bob := uuid.New(), uuid.New()
+	view := PageViewRequest{PageID: page.ID, UserID: alice, Referrer: "https://google.com", UserAgent: "Mozilla/5.0"}
+
+	counted, err := service.RecordView(ctx, view)
+	require.NoError(t, err)
+	assert.True(t, counted)
+
+	now = now.Add(5 * time.Minute)
+	counted, err = service.RecordView(ctx, view)
+	require.NoError(t, err)
+	assert.False(t, counted, "repeat view within the window counts once")
+
+	counted, err = service.RecordView(ctx, PageViewRequest{PageID: page.ID, UserID: bob})
+	require.NoError(t, err)
+	assert.True(t, counted, "a different user counts separately")
+
+	now = now.Add(10 * time.Minute)
+	counted, err = service.RecordView(ctx, view)
+	require.NoError(t, err)
+	assert.True(t, counted, "view after the window counts again")
+
+	require.Len(t, repo.views, 3)
+	assert.Equal(t, alice, repo.views[0].userID)
+	assert.Equal(t, "https://google.com", repo.views[0].metadata["referrer"])
+	assert.Equal(t, "Mozilla/5.0", repo.views[0].metadata["user_agent"])
+	assert.Equal(t, bob, repo.views[1].userID)
+}
+
+func TestPageService_RecordView_DebouncesAnonymousSessions(t *testing.T) {
+	ctx := context.Background()
+	page := &domain.Page{ID: uuid.New(), WorkspaceID: uuid.New()}
+	repo := newViewTrackingPageRepository(page)
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	service := newViewTestPageService(repo, &now)
+
+	for _, session := range []string{"s1", "s1", "s2", ""} {
+		_, err := service.RecordView(ctx, PageViewRequest{PageID: page.ID, SessionID: session})
+		require.NoError(t, err)
+	}
+	// Views without a user or session can't be attributed, so every one counts
+	_, err := service.RecordView(ctx, PageViewRequest{PageID: page.ID})
+	require.NoError(t, err)
+
+	assert.Len(t, repo.views, 4)
+}
+
+func TestPageService_StatsIncludeDailyAndWeeklyRollups(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := uuid.New()
+	docs := &domain.Page{ID: uuid.New(), WorkspaceID: workspaceID}
+	blog := &domain.Page{ID: uuid.New(), WorkspaceID: workspaceID}
+	repo := newViewTrackingPageRepository(docs, blog)
+	now := time.Date(2024, 5, 10, 23, 30, 0, 0, time.UTC)
+	service := newViewTestPageService(repo, &now)
+
+	record := func(page *domain.Page, viewers int) {
+		for i := 0; i < viewers; i++ {
+			_, err := service.RecordView(ctx, PageViewRequest{PageID: page.ID, UserID: uuid.New()})
+			require.NoError(t, err)
+		}
+	}
+
+	now = now.AddDate(0, 0, -8) // outside the weekly window
+	record(docs, 5)
+	now = now.AddDate(0, 0, 6)
+	record(docs, 2)
+	now = now.AddDate(0, 0, 2) // today
+	record(docs, 3)
+	record(blog, 1)
+
+	stats, err := service.GetPageStats(ctx, docs.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), stats.ViewCount)
+	assert.Equal(t, int64(3), stats.ViewsToday)
+	assert.Equal(t, int64(5), stats.ViewsThisWeek)
+	require.Len(t, stats.ViewHistory, 7)
+	assert.Equal(t, time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC), stats.ViewHistory[0].Date)
+	assert.Equal(t, ViewHistoryPoint{Date: time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC), ViewCount: 2}, stats.ViewHistory[4])
+	assert.Equal(t, int64(0), stats.ViewHistory[5].ViewCount)
+
+	workspaceStats, err := service.GetWorkspacePageStats(ctx, workspaceID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), workspaceStats.ViewsToday)
+	assert.Equal(t, int64(6), workspaceStats.ViewsThisWeek)
+}
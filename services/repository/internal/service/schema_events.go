@@ -0,0 +1,196 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+	"github.com/google/uuid"
+)
+
+// SchemaEventType identifies a schema lifecycle change
+type SchemaEventType string
+
+const (
+	SchemaEventCreated           SchemaEventType = "schema.created"
+	SchemaEventVersionCreated    SchemaEventType = "schema.version.created"
+	SchemaEventVersionPublished  SchemaEventType = "schema.version.published"
+	SchemaEventVersionDeprecated SchemaEventType = "schema.version.deprecated"
+)
+
+// SchemaEvent is the payload emitted for a schema lifecycle change. Events
+// may be delivered more than once; consumers should deduplicate on ID.
+type SchemaEvent struct {
+	ID          uuid.UUID       `json:"id"`
+	Type        SchemaEventType `json:"type"`
+	WorkspaceID uuid.UUID       `json:"workspace_id"`
+	SchemaID    uuid.UUID       `json:"schema_id"`
+	SchemaName  string          `json:"schema_name"`
+	SchemaSlug  string          `json:"schema_slug"`
+	Format      string          `json:"format"`
+	Status      string          `json:"status"`
+	VersionID   uuid.UUID       `json:"version_id"`
+	Version     string          `json:"version"`
+	IsBreaking  bool            `json:"is_breaking"`
+	ActorID     uuid.UUID       `json:"actor_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
+
+// newSchemaEvent builds the event for a change to schema and one of its versions
+func newSchemaEvent(eventType SchemaEventType, schema *domain.APISchema, version *domain.APISchemaVersion, actorID uuid.UUID) *SchemaEvent {
+	return &SchemaEvent{
+		ID:          uuid.New(),
+		Type:        eventType,
+		WorkspaceID: schema.WorkspaceID,
+		SchemaID:    schema.ID,
+		SchemaName:  schema.Name,
+		SchemaSlug:  schema.Slug,
+		Format:      schema.Format,
+		Status:      schema.Status,
+		VersionID:   version.ID,
+		Version:     version.Version,
+		IsBreaking:  version.IsBreaking,
+		ActorID:     actorID,
+		OccurredAt:  time.Now(),
+	}
+}
+
+// SchemaEventSink delivers schema events to a consumer, such as a webhook
+type SchemaEventSink interface {
+	Deliver(ctx context.Context, event *SchemaEvent) error
+}
+
+// SchemaEventOutbox durably queues schema events until they are delivered
+type SchemaEventOutbox interface {
+	Append(ctx context.Context, event *SchemaEvent) error
+	// Pending returns undelivered events, oldest first
+	Pending(ctx context.Context) ([]*SchemaEvent, error)
+	MarkDelivered(ctx context.Context, eventID uuid.UUID) error
+}
+
+// SchemaEventDispatcher delivers schema events with at-least-once semantics:
+// every event is queued in the outbox before delivery and stays there until
+// the sink accepts it. Delivery stops at the first failure so events reach
+// the sink in order; DeliverPending retries whatever is left.
+type SchemaEventDispatcher struct {
+	outbox SchemaEventOutbox
+	sink   SchemaEventSink
+	logger *slog.Logger
+}
+
+// NewSchemaEventDispatcher creates a new schema event dispatcher
+func NewSchemaEventDispatcher(outbox SchemaEventOutbox, sink SchemaEventSink, logger *slog.Logger) *SchemaEventDispatcher {
+	return &SchemaEventDispatcher{
+		outbox: outbox,
+		sink:   sink,
+		logger: logger.With("component", "schema_event_dispatcher"),
+	}
+}
+
+// PublishSchemaEvent queues the event and attempts delivery. It only fails
+// when the event could not be queued; delivery failures leave the event
+// pending for a later DeliverPending.
+func (d *SchemaEventDispatcher) PublishSchemaEvent(ctx context.Context, event *SchemaEvent) error {
+	if err := d.outbox.Append(ctx, event); err != nil {
+		return fmt.Errorf("failed to queue schema event: %w", err)
+	}
+	if _, err := d.DeliverPending(ctx); err != nil {
+		d.logger.WarnContext(ctx, "Schema event delivery deferred", "event_id", event.ID, "type", event.Type, "error", err)
+	}
+	return nil
+}
+
+// DeliverPending sends queued events to the sink, oldest first, and returns
+// how many were delivered. It stops at the first delivery failure.
+func (d *SchemaEventDispatcher) DeliverPending(ctx context.Context) (int, error) {
+	pending, err := d.outbox.Pending(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pending schema events: %w", err)
+	}
+
+	delivered := 0
+	for _, event := range pending {
+		if err := d.sink.Deliver(ctx, event); err != nil {
+			return delivered, fmt.Errorf("failed to deliver schema event %s: %w", event.ID, err)
+		}
+		// An event that was delivered but not marked is sent again later,
+		// which consumers tolerate by deduplicating on the event ID
+		if err := d.outbox.MarkDelivered(ctx, event.ID); err != nil {
+			d.logger.WarnContext(ctx, "Failed to mark schema event delivered", "event_id", event.ID, "error", err)
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+// Webhook delivery headers
+const (
+	SchemaWebhookEventHeader     = "X-Orbit-Event"
+	SchemaWebhookDeliveryHeader  = "X-Orbit-Delivery"
+	SchemaWebhookSignatureHeader = "X-Orbit-Signature"
+)
+
+// WebhookSchemaEventSink delivers schema events as JSON POST requests. When
+// a secret is set, the body is signed with HMAC-SHA256 and the signature
+// sent as "sha256=<hex>" in the X-Orbit-Signature header.
+type WebhookSchemaEventSink struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookSchemaEventSink creates a webhook sink posting to url. A nil
+// client uses one with a 10 second timeout.
+func NewWebhookSchemaEventSink(url, secret string, client *http.Client) *WebhookSchemaEventSink {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSchemaEventSink{url: url, secret: secret, client: client}
+}
+
+// Deliver posts the event and succeeds on any 2xx response
+func (s *WebhookSchemaEventSink) Deliver(ctx context.Context, event *SchemaEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode schema event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SchemaWebhookEventHeader, string(event.Type))
+	req.Header.Set(SchemaWebhookDeliveryHeader, event.ID.String())
+	if s.secret != "" {
+		req.Header.Set(SchemaWebhookSignatureHeader, signWebhookPayload(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// signWebhookPayload computes the X-Orbit-Signature value for a body
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
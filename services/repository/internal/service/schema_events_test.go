@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+func TestSchemaService_EmitsLifecycleEvents(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	repo := newMemorySchemaRepository()
+	events := &capturingEventPublisher{}
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, events, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     `{"openapi": "3.0.3"}`,
+		Version:     "1.0.0",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	version, err := service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID:   schema.ID,
+		Version:    "2.0.0",
+		Content:    `{"openapi": "3.0.3", "paths": {}}`,
+		IsBreaking: true,
+		CreatedBy:  actor,
+	})
+	require.NoError(t, err)
+
+	_, err = service.PublishVersion(ctx, PublishVersionRequest{SchemaID: schema.ID, Version: "2.0.0", PublishedBy: actor})
+	require.NoError(t, err)
+
+	_, err = service.DeprecateVersion(ctx, DeprecateVersionRequest{SchemaID: schema.ID, Version: "1.0.0", DeprecatedBy: actor})
+	require.NoError(t, err)
+
+	require.Len(t, events.events, 4)
+	initial, err := repo.GetVersion(ctx, schema.ID, "1.0.0")
+	require.NoError(t, err)
+
+	tests := []struct {
+		eventType  SchemaEventType
+		versionID  uuid.UUID
+		version    string
+		status     SchemaStatus
+		isBreaking bool
+	}{
+		{SchemaEventCreated, initial.ID, "1.0.0", SchemaStatusDraft, false},
+		{SchemaEventVersionCreated, version.ID, "2.0.0", SchemaStatusDraft, true},
+		{SchemaEventVersionPublished, version.ID, "2.0.0", SchemaStatusPublished, true},
+		{SchemaEventVersionDeprecated, initial.ID, "1.0.0", SchemaStatusPublished, false},
+	}
+	ids := map[uuid.UUID]bool{}
+	for i, tt := range tests {
+		event := events.events[i]
+		assert.Equal(t, tt.eventType, event.Type)
+		assert.Equal(t, tt.versionID, event.VersionID, tt.eventType)
+		assert.Equal(t, tt.version, event.Version, tt.eventType)
+		assert.Equal(t, string(tt.status), event.Status, tt.eventType)
+		assert.Equal(t, tt.isBreaking, event.IsBreaking, tt.eventType)
+		assert.Equal(t, workspace.ID, event.WorkspaceID)
+		assert.Equal(t, schema.ID, event.SchemaID)
+		assert.Equal(t, "Pets API", event.SchemaName)
+		assert.Equal(t, "pets-api", event.SchemaSlug)
+		assert.Equal(t, string(SchemaFormatOpenAPI), event.Format)
+		assert.Equal(t, actor, event.ActorID)
+		assert.False(t, event.OccurredAt.IsZero())
+		ids[event.ID] = true
+	}
+	assert.Len(t, ids, 4, "event IDs should be unique")
+
+	_, err = service.DeprecateVersion(ctx, DeprecateVersionRequest{SchemaID: schema.ID, Version: "1.0.0", DeprecatedBy: actor})
+	assert.ErrorIs(t, err, ErrSchemaVersionDeprecated)
+	assert.Len(t, events.events, 4)
+}
+
+// memorySchemaEventOutbox is an in-memory SchemaEventOutbox. markErr makes
+// MarkDelivered fail, as if the outbox became unavailable after delivery.
+type memorySchemaEventOutbox struct {
+	pending []*SchemaEvent
+	markErr error
+}
+
+func (o *memorySchemaEventOutbox) Append(_ context.Context, event *SchemaEvent) error {
+	o.pending = append(o.pending, event)
+	return nil
+}
+
+func (o *memorySchemaEventOutbox) Pending(context.Context) ([]*SchemaEvent, error) {
+	return append([]*SchemaEvent(nil), o.pending...), nil
+}
+
+func (o *memorySchemaEventOutbox) MarkDelivered(_ context.Context, eventID uuid.UUID) error {
+	if o.markErr != nil {
+		return o.markErr
+	}
+	for i, event := range o.pending {
+		if event.ID == eventID {
+			o.pending = append(o.pending[:i], o.pending[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// flakySchemaEventSink fails while down and records what it accepts
+type flakySchemaEventSink struct {
+	down      bool
+	delivered []uuid.UUID
+}
+
+func (s *flakySchemaEventSink) Deliver(_ context.Context, event *SchemaEvent) error {
+	if s.down {
+		return fmt.Errorf("sink unavailable")
+	}
+	s.delivered = append(s.delivered, event.ID)
+	return nil
+}
+
+func newTestSchemaEvent(eventType SchemaEventType) *SchemaEvent {
+	return &SchemaEvent{ID: uuid.New(), Type: eventType, SchemaID: uuid.New()}
+}
+
+func TestSchemaEventDispatcher_RetriesUndeliveredEventsInOrder(t *testing.T) {
+	ctx := context.Background()
+	outbox := &memorySchemaEventOutbox{}
+	sink := &flakySchemaEventSink{down: true}
+	dispatcher := NewSchemaEventDispatcher(outbox, sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	first := newTestSchemaEvent(SchemaEventCreated)
+	second := newTestSchemaEvent(SchemaEventVersionCreated)
+	require.NoError(t, dispatcher.PublishSchemaEvent(ctx, first))
+	require.NoError(t, dispatcher.PublishSchemaEvent(ctx, second))
+	assert.Empty(t, sink.delivered)
+	assert.Len(t, outbox.pending, 2)
+
+	_, err := dispatcher.DeliverPending(ctx)
+	assert.Error(t, err)
+
+	sink.down = false
+	delivered, err := dispatcher.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, delivered)
+	assert.Equal(t, []uuid.UUID{first.ID, second.ID}, sink.delivered)
+	assert.Empty(t, outbox.pending)
+
+	third := newTestSchemaEvent(SchemaEventVersionPublished)
+	require.NoError(t, dispatcher.PublishSchemaEvent(ctx, third))
+	assert.Equal(t, []uuid.UUID{first.ID, second.ID, third.ID}, sink.delivered)
+}
+
+func TestSchemaEventDispatcher_RedeliversUnacknowledgedEvents(t *testing.T) {
+	ctx := context.Background()
+	outbox := &memorySchemaEventOutbox{markErr: fmt.Errorf("outbox unavailable")}
+	sink := &flakySchemaEventSink{}
+	dispatcher := NewSchemaEventDispatcher(outbox, sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	event := newTestSchemaEvent(SchemaEventCreated)
+	require.NoError(t, dispatcher.PublishSchemaEvent(ctx, event))
+
+	outbox.markErr = nil
+	delivered, err := dispatcher.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, []uuid.UUID{event.ID, event.ID}, sink.delivered, "delivery is at-least-once with a stable ID")
+	assert.Empty(t, outbox.pending)
+}
+
+func TestWebhookSchemaEventSink(t *testing.T) {
+	event := newTestSchemaEvent(SchemaEventVersionPublished)
+	event.Version = "1.2.0"
+
+	status := http.StatusAccepted
+	var received SchemaEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, string(SchemaEventVersionPublished), r.Header.Get(SchemaWebhookEventHeader))
+		assert.Equal(t, event.ID.String(), r.Header.Get(SchemaWebhookDeliveryHeader))
+		assert.Equal(t, signWebhookPayload("s3cret", body), r.Header.Get(SchemaWebhookSignatureHeader))
+		assert.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSchemaEventSink(server.URL, "s3cret", server.Client())
+	require.NoError(t, sink.Deliver(context.Background(), event))
+	assert.Equal(t, event.ID, received.ID)
+	assert.Equal(t, "1.2.0", received.Version)
+
+	status = http.StatusInternalServerError
+	assert.Error(t, sink.Deliver(context.Background(), event))
+}
@@ -18,8 +18,16 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/drewpayment/orbit/services/repository/internal/domain"
@@ -31,6 +39,10 @@ type APISchemaRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, schema *domain.APISchema) error
 	Update(ctx context.Context, schema *domain.APISchema) error
+	// UpdateIfUnmodified writes schema only while the stored row still has
+	// updated_at = expectedUpdatedAt (UPDATE ... WHERE id = $1 AND
+	// updated_at = $2) and returns ErrSchemaConflict when no row matched
+	UpdateIfUnmodified(ctx context.Context, schema *domain.APISchema, expectedUpdatedAt time.Time) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.APISchema, error)
 	GetByName(ctx context.Context, workspaceID uuid.UUID, name string) (*domain.APISchema, error)
@@ -38,11 +50,15 @@ type APISchemaRepository interface {
 
 	// Version management
 	GetVersion(ctx context.Context, schemaID uuid.UUID, version string) (*domain.APISchemaVersion, error)
+	UpdateVersion(ctx context.Context, version *domain.APISchemaVersion) error
+	// GetLatestVersion and ListVersions order versions by VersionSortKey
 	GetLatestVersion(ctx context.Context, schemaID uuid.UUID) (*domain.APISchemaVersion, error)
 	ListVersions(ctx context.Context, schemaID uuid.UUID) ([]*domain.APISchemaVersion, error)
 	CreateVersion(ctx context.Context, version *domain.APISchemaVersion) error
 
 	// Listing and search
+	// ListByWorkspace orders by (CreatedAt, ID) descending when filters.After
+	// is set, returning only schemas that sort after that cursor
 	ListByWorkspace(ctx context.Context, workspaceID uuid.UUID, filters SchemaFilters) ([]*domain.APISchema, error)
 	SearchSchemas(ctx context.Context, workspaceID uuid.UUID, query string, filters SchemaFilters) ([]*domain.APISchema, error)
 
@@ -138,8 +154,34 @@ type SchemaFilters struct {
 	Offset        int                `json:"offset"`
 	SortBy        string             `json:"sort_by"`    // name, created_at, updated_at, version_count
 	SortOrder     string             `json:"sort_order"` // asc, desc
+	PageToken     string             `json:"page_token"`
+	After         *SchemaCursor      `json:"-"` // decoded from PageToken
 }
 
+// SchemaCursor is the sort key of the last schema on a page. Cursor pages are
+// ordered newest first by (CreatedAt, ID), so schemas created while a client
+// is paging sort ahead of the cursor and never shift later pages.
+type SchemaCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// SchemaPage is one page of schemas. NextPageToken is empty on the last page.
+type SchemaPage struct {
+	Schemas       []*domain.APISchema `json:"schemas"`
+	NextPageToken string              `json:"next_page_token,omitempty"`
+}
+
+// Page sizes for ListSchemas
+const (
+	DefaultSchemaPageSize = 50
+	MaxSchemaPageSize     = 500
+)
+
+// schemaListCacheTTL bounds how long a cached schema list can outlive a
+// membership or permission change that was not made through this service
+const schemaListCacheTTL = time.Minute
+
 // CreateSchemaRequest contains data for creating a new API schema
 type CreateSchemaRequest struct {
 	WorkspaceID uuid.UUID              `json:"workspace_id" validate:"required"`
@@ -168,6 +210,27 @@ type UpdateSchemaRequest struct {
 	Categories  []string               `json:"categories,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 	UpdatedBy   uuid.UUID              `json:"updated_by" validate:"required"`
+
+	// ExpectedUpdatedAt is the UpdatedAt of the schema the caller edited.
+	// When set, the update is rejected with a SchemaConflictError if the
+	// schema has changed since.
+	ExpectedUpdatedAt *time.Time `json:"expected_updated_at,omitempty"`
+}
+
+// SchemaConflictError is returned when a schema changed after the caller
+// read it. Current holds the stored schema so the caller can merge its
+// edits and retry. It matches ErrSchemaConflict with errors.Is.
+type SchemaConflictError struct {
+	Current *domain.APISchema `json:"current"`
+}
+
+func (e *SchemaConflictError) Error() string {
+	return fmt.Sprintf("%s: updated at %s by %s", ErrSchemaConflict.Message,
+		e.Current.UpdatedAt.Format(time.RFC3339Nano), e.Current.UpdatedBy)
+}
+
+func (e *SchemaConflictError) Unwrap() error {
+	return ErrSchemaConflict
 }
 
 // CreateVersionRequest contains data for creating a new schema version
@@ -182,6 +245,54 @@ type CreateVersionRequest struct {
 	CreatedBy   uuid.UUID              `json:"created_by" validate:"required"`
 }
 
+// PublishVersionRequest contains data for publishing a schema version
+type PublishVersionRequest struct {
+	SchemaID    uuid.UUID `json:"schema_id" validate:"required"`
+	Version     string    `json:"version" validate:"required"`
+	PublishedBy uuid.UUID `json:"published_by" validate:"required"`
+}
+
+// DeprecateVersionRequest contains data for deprecating a schema version
+type DeprecateVersionRequest struct {
+	SchemaID     uuid.UUID `json:"schema_id" validate:"required"`
+	Version      string    `json:"version" validate:"required"`
+	DeprecatedBy uuid.UUID `json:"deprecated_by" validate:"required"`
+}
+
+// Publish blocker codes
+const (
+	PublishBlockerDraft                 = "DRAFT"
+	PublishBlockerValidationFailed      = "VALIDATION_FAILED"
+	PublishBlockerDependencyUnpinned    = "DEPENDENCY_UNPINNED"
+	PublishBlockerDependencyNotFound    = "DEPENDENCY_VERSION_NOT_FOUND"
+	PublishBlockerDependencyUnpublished = "DEPENDENCY_UNPUBLISHED"
+)
+
+// PublishBlocker is one reason a schema version cannot be published
+type PublishBlocker struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Path    string `json:"path,omitempty"`
+}
+
+// PublishBlockedError is returned when a schema version fails one or more
+// publish gates. It matches ErrSchemaNotPublishable with errors.Is.
+type PublishBlockedError struct {
+	Blockers []PublishBlocker `json:"blockers"`
+}
+
+func (e *PublishBlockedError) Error() string {
+	messages := make([]string, len(e.Blockers))
+	for i, blocker := range e.Blockers {
+		messages[i] = blocker.Message
+	}
+	return fmt.Sprintf("%s: %s", ErrSchemaNotPublishable.Message, strings.Join(messages, "; "))
+}
+
+func (e *PublishBlockedError) Unwrap() error {
+	return ErrSchemaNotPublishable
+}
+
 // ValidationRequest contains data for schema validation
 type ValidationRequest struct {
 	Content  string                 `json:"content" validate:"required"`
@@ -249,6 +360,7 @@ type ValidationMetrics struct {
 	TotalLines      int `json:"total_lines"`
 	TotalEndpoints  int `json:"total_endpoints"`
 	TotalModels     int `json:"total_models"`
+	TotalFields     int `json:"total_fields"`
 	ComplexityScore int `json:"complexity_score"`
 	QualityScore    int `json:"quality_score"`
 	SecurityScore   int `json:"security_score"`
@@ -730,6 +842,26 @@ type ChangeSummary struct {
 	Deprecations    int `json:"deprecations"`
 }
 
+// FullChangelogResult contains a changelog spanning every version of a schema
+type FullChangelogResult struct {
+	SchemaID    uuid.UUID          `json:"schema_id"`
+	Changelog   string             `json:"changelog"`
+	Format      string             `json:"format"` // markdown
+	Versions    []VersionChangelog `json:"versions"`
+	GeneratedAt time.Time          `json:"generated_at"`
+}
+
+// VersionChangelog describes the changes introduced by a single schema version
+// relative to its predecessor
+type VersionChangelog struct {
+	Version     string         `json:"version"`
+	ChangeNotes string         `json:"change_notes"`
+	IsBreaking  bool           `json:"is_breaking"`
+	Changes     []SchemaChange `json:"changes"`
+	Summary     ChangeSummary  `json:"summary"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
 // SDKGenerationRequest contains data for SDK generation
 type SDKGenerationRequest struct {
 	SchemaID    uuid.UUID              `json:"schema_id" validate:"required"`
@@ -894,10 +1026,13 @@ type SchemaService struct {
 	docGenerator   DocumentationGenerator
 	eventPub       EventPublisher
 	cache          CacheManager
+	auditor        AuditRecorder
+	quota          atomic.Pointer[WorkspaceQuota]
 	logger         *slog.Logger
 }
 
-// NewSchemaService creates a new schema service instance
+// NewSchemaService creates a new schema service instance. Schema content is
+// validated with an OpenAPIValidator unless another validator is given.
 func NewSchemaService(
 	schemaRepo APISchemaRepository,
 	repositoryRepo RepositoryRepository,
@@ -908,9 +1043,13 @@ func NewSchemaService(
 	docGenerator DocumentationGenerator,
 	eventPub EventPublisher,
 	cache CacheManager,
+	auditor AuditRecorder,
 	logger *slog.Logger,
 ) *SchemaService {
-	return &SchemaService{
+	if validator == nil {
+		validator = NewDefaultFormatValidator(logger)
+	}
+	s := &SchemaService{
 		schemaRepo:     schemaRepo,
 		repositoryRepo: repositoryRepo,
 		workspaceRepo:  workspaceRepo,
@@ -920,8 +1059,18 @@ func NewSchemaService(
 		docGenerator:   docGenerator,
 		eventPub:       eventPub,
 		cache:          cache,
+		auditor:        auditor,
 		logger:         logger.With("service", "schema"),
 	}
+	s.quota.Store(NewWorkspaceQuota(DefaultQuotaLimits()))
+	return s
+}
+
+// SetQuotaLimits replaces the per-workspace limits on validation,
+// transformation and SDK generation. Usage counted so far is discarded.
+// It is safe to call while requests are being served.
+func (s *SchemaService) SetQuotaLimits(limits QuotaLimits) {
+	s.quota.Store(NewWorkspaceQuota(limits))
 }
 
 // CreateSchema creates a new API schema with validation
@@ -934,6 +1083,11 @@ func (s *SchemaService) CreateSchema(ctx context.Context, req CreateSchemaReques
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	initialSemver, err := domain.ParseSemanticVersion(req.Version)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchemaVersion, err)
+	}
+
 	// Check workspace exists and user has permission
 	workspace, err := s.workspaceRepo.GetByID(ctx, req.WorkspaceID)
 	if err != nil {
@@ -993,6 +1147,7 @@ func (s *SchemaService) CreateSchema(ctx context.Context, req CreateSchemaReques
 		ID:              uuid.New(),
 		SchemaID:        schema.ID,
 		Version:         req.Version,
+		VersionSortKey:  initialSemver.SortKey(),
 		Content:         req.Content,
 		ContentHash:     s.calculateContentHash(req.Content),
 		ChangeNotes:     "Initial version",
@@ -1027,7 +1182,16 @@ func (s *SchemaService) CreateSchema(ctx context.Context, req CreateSchemaReques
 	// Clear relevant caches
 	s.clearSchemaListCaches(ctx, req.WorkspaceID)
 
-	// TODO: Publish schema created event when EventPublisher is updated
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: schema.WorkspaceID,
+		ActorID:     req.CreatedBy,
+		Action:      AuditActionCreated,
+		TargetType:  AuditTargetSchema,
+		TargetID:    schema.ID,
+		After:       schema,
+	})
+
+	s.publishSchemaEvent(ctx, newSchemaEvent(SchemaEventCreated, schema, initialVersion, req.CreatedBy))
 
 	s.logger.InfoContext(ctx, "API schema created successfully",
 		"schema_id", schema.ID, "name", schema.Name, "version", req.Version)
@@ -1067,6 +1231,158 @@ func (s *SchemaService) GetSchema(ctx context.Context, id uuid.UUID, userID uuid
 	return schema, nil
 }
 
+// UpdateSchema applies the non-nil fields of req to a schema. When
+// req.ExpectedUpdatedAt is set and the stored schema was updated at a
+// different time, nothing is written and a *SchemaConflictError carrying
+// the stored schema is returned. The write itself is conditional on the
+// schema being unchanged since it was read, so an update that lands in
+// between is reported the same way instead of being overwritten.
+func (s *SchemaService) UpdateSchema(ctx context.Context, req UpdateSchemaRequest) (*domain.APISchema, error) {
+	s.logger.InfoContext(ctx, "Updating API schema", "schema_id", req.ID, "updated_by", req.UpdatedBy)
+
+	current, err := s.schemaRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	if !s.canUserModifySchema(ctx, current, req.UpdatedBy) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	if req.ExpectedUpdatedAt != nil && !current.UpdatedAt.Equal(*req.ExpectedUpdatedAt) {
+		s.logger.InfoContext(ctx, "Schema update conflicts with a newer change",
+			"schema_id", current.ID, "expected_updated_at", *req.ExpectedUpdatedAt, "updated_at", current.UpdatedAt)
+		return nil, &SchemaConflictError{Current: current}
+	}
+
+	// Work on a copy so a rejected update leaves the stored schema untouched
+	schema := *current
+	if req.Name != nil && *req.Name != schema.Name {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("validation failed: %w", ErrInvalidSchemaName)
+		}
+		if existing, err := s.schemaRepo.GetByName(ctx, schema.WorkspaceID, name); err == nil && existing != nil && existing.ID != schema.ID {
+			return nil, ErrSchemaExists
+		}
+		schema.Name = name
+	}
+	if req.Description != nil {
+		schema.Description = *req.Description
+	}
+	if req.Status != nil {
+		schema.Status = string(*req.Status)
+	}
+	if req.Visibility != nil {
+		schema.Visibility = string(*req.Visibility)
+	}
+	if req.Tags != nil {
+		schema.Tags = req.Tags
+	}
+	if req.Categories != nil {
+		schema.Categories = req.Categories
+	}
+	schema.UpdatedAt = time.Now()
+	schema.UpdatedBy = req.UpdatedBy
+
+	if err := s.schemaRepo.UpdateIfUnmodified(ctx, &schema, current.UpdatedAt); err != nil {
+		if !errors.Is(err, ErrSchemaConflict) {
+			return nil, fmt.Errorf("failed to update schema: %w", err)
+		}
+		latest, getErr := s.schemaRepo.GetByID(ctx, req.ID)
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get schema: %w", getErr)
+		}
+		s.logger.InfoContext(ctx, "Schema changed while it was being updated",
+			"schema_id", latest.ID, "read_updated_at", current.UpdatedAt, "updated_at", latest.UpdatedAt)
+		return nil, &SchemaConflictError{Current: latest}
+	}
+
+	// Clear the entries for the old name as well as the new one
+	s.clearSchemaCaches(ctx, current)
+	s.clearSchemaCaches(ctx, &schema)
+
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: schema.WorkspaceID,
+		ActorID:     req.UpdatedBy,
+		Action:      AuditActionUpdated,
+		TargetType:  AuditTargetSchema,
+		TargetID:    schema.ID,
+		Before:      current,
+		After:       &schema,
+	})
+
+	s.logger.InfoContext(ctx, "API schema updated successfully", "schema_id", schema.ID, "name", schema.Name)
+
+	return &schema, nil
+}
+
+// ListSchemas returns one page of the workspace's schemas visible to the
+// user, newest first. Pass the previous page's NextPageToken as
+// filters.PageToken to continue; Offset and sorting options are ignored.
+func (s *SchemaService) ListSchemas(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID, filters SchemaFilters) (*SchemaPage, error) {
+	s.logger.DebugContext(ctx, "Listing API schemas", "workspace_id", workspaceID, "user_id", userID)
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	if !workspace.HasMember(userID) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	cacheKey := schemaListCacheKey(workspaceID, userID, filters)
+	if cached, err := s.cache.Get(ctx, cacheKey); err == nil {
+		if page, ok := cached.(*SchemaPage); ok {
+			return page, nil
+		}
+	}
+
+	after, err := decodeSchemaPageToken(filters.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := filters.Limit
+	if pageSize <= 0 {
+		pageSize = DefaultSchemaPageSize
+	}
+	if pageSize > MaxSchemaPageSize {
+		pageSize = MaxSchemaPageSize
+	}
+
+	// Fetch one extra schema to learn whether another page follows
+	filters.After = after
+	filters.Limit = pageSize + 1
+	filters.Offset = 0
+	filters.SortBy = "created_at"
+	filters.SortOrder = "desc"
+	schemas, err := s.schemaRepo.ListByWorkspace(ctx, workspaceID, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schemas: %w", err)
+	}
+
+	page := &SchemaPage{}
+	if len(schemas) > pageSize {
+		schemas = schemas[:pageSize]
+		// The cursor is the last schema fetched, visible or not, so
+		// filtering below cannot cause the next page to skip schemas
+		last := schemas[pageSize-1]
+		page.NextPageToken = encodeSchemaPageToken(SchemaCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	page.Schemas = make([]*domain.APISchema, 0, len(schemas))
+	for _, schema := range schemas {
+		if s.canUserAccessSchema(ctx, schema, userID) {
+			page.Schemas = append(page.Schemas, schema)
+		}
+	}
+
+	s.cache.Set(ctx, cacheKey, page, schemaListCacheTTL)
+
+	return page, nil
+}
+
 // CreateSchemaVersion creates a new version of an existing schema
 func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersionRequest) (*domain.APISchemaVersion, error) {
 	s.logger.InfoContext(ctx, "Creating schema version",
@@ -1083,6 +1399,22 @@ func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersi
 		return nil, domain.ErrInsufficientPermission
 	}
 
+	semver, err := domain.ParseSemanticVersion(req.Version)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchemaVersion, err)
+	}
+
+	// Published versions must move forward from the current one
+	if !req.IsDraft && schema.CurrentVersion != "" {
+		current, err := domain.ParseSemanticVersion(schema.CurrentVersion)
+		if err != nil {
+			s.logger.WarnContext(ctx, "Current schema version is not a semantic version",
+				"schema_id", schema.ID, "current_version", schema.CurrentVersion)
+		} else if semver.Compare(current) <= 0 {
+			return nil, fmt.Errorf("%w: %s is not greater than %s", ErrSchemaVersionNotIncreasing, req.Version, schema.CurrentVersion)
+		}
+	}
+
 	// Validate schema content
 	validationResult, err := s.validator.ValidateSchema(ctx, req.Content, SchemaFormat(schema.Format))
 	if err != nil {
@@ -1093,17 +1425,26 @@ func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersi
 		return nil, fmt.Errorf("schema content validation failed: %v", validationResult.Errors)
 	}
 
+	contentHash := s.calculateContentHash(req.Content)
+	latestVersion, err := s.schemaRepo.GetLatestVersion(ctx, req.SchemaID)
+	if err != nil {
+		// Nothing to compare against, e.g. the schema has no versions yet
+		latestVersion = nil
+	}
+
+	// Reject versions that do not change the content
+	if latestVersion != nil && latestVersion.ContentHash == contentHash {
+		return nil, ErrSchemaVersionUnchanged
+	}
+
 	// Check for compatibility with previous version if not a draft
-	if !req.IsDraft {
-		latestVersion, err := s.schemaRepo.GetLatestVersion(ctx, req.SchemaID)
-		if err == nil && latestVersion != nil {
-			compatibility, err := s.validator.ValidateCompatibility(ctx, latestVersion.Content, req.Content, SchemaFormat(schema.Format))
-			if err != nil {
-				s.logger.WarnContext(ctx, "Failed to check compatibility", "error", err)
-			} else {
-				if compatibility.Compatibility == CompatibilityLevelBreaking && !req.IsBreaking {
-					return nil, ErrBreakingChangesNotAllowed
-				}
+	if !req.IsDraft && latestVersion != nil {
+		compatibility, err := s.validator.ValidateCompatibility(ctx, latestVersion.Content, req.Content, SchemaFormat(schema.Format))
+		if err != nil {
+			s.logger.WarnContext(ctx, "Failed to check compatibility", "error", err)
+		} else {
+			if compatibility.Compatibility == CompatibilityLevelBreaking && !req.IsBreaking {
+				return nil, ErrBreakingChangesNotAllowed
 			}
 		}
 	}
@@ -1114,8 +1455,9 @@ func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersi
 		ID:              uuid.New(),
 		SchemaID:        req.SchemaID,
 		Version:         req.Version,
+		VersionSortKey:  semver.SortKey(),
 		Content:         req.Content,
-		ContentHash:     s.calculateContentHash(req.Content),
+		ContentHash:     contentHash,
 		ChangeNotes:     req.ChangeNotes,
 		IsPublished:     false,
 		IsDraft:         req.IsDraft,
@@ -1135,6 +1477,7 @@ func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersi
 	}
 
 	// Update schema with new version info
+	before := *schema
 	schema.CurrentVersion = req.Version
 	schema.LatestVersionID = &version.ID
 	schema.UpdatedAt = now
@@ -1146,7 +1489,17 @@ func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersi
 	// Clear caches
 	s.clearSchemaCaches(ctx, schema)
 
-	// TODO: Publish schema version created event when EventPublisher is updated
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: schema.WorkspaceID,
+		ActorID:     req.CreatedBy,
+		Action:      AuditActionUpdated,
+		TargetType:  AuditTargetSchema,
+		TargetID:    schema.ID,
+		Before:      &before,
+		After:       schema,
+	})
+
+	s.publishSchemaEvent(ctx, newSchemaEvent(SchemaEventVersionCreated, schema, version, req.CreatedBy))
 
 	s.logger.InfoContext(ctx, "Schema version created successfully",
 		"version_id", version.ID, "schema_id", req.SchemaID, "version", req.Version)
@@ -1154,10 +1507,227 @@ func (s *SchemaService) CreateSchemaVersion(ctx context.Context, req CreateVersi
 	return version, nil
 }
 
-// ValidateSchema validates a schema without creating it
+// PublishVersion publishes a schema version once it passes every publish
+// gate: the version must not be a draft, its content must validate, and
+// each of the schema's dependencies must be pinned to a published version.
+// A version failing any gate is rejected with a *PublishBlockedError that
+// lists every reason. On success the schema moves to SchemaStatusPublished.
+func (s *SchemaService) PublishVersion(ctx context.Context, req PublishVersionRequest) (*domain.APISchemaVersion, error) {
+	s.logger.InfoContext(ctx, "Publishing schema version",
+		"schema_id", req.SchemaID, "version", req.Version, "published_by", req.PublishedBy)
+
+	schema, err := s.schemaRepo.GetByID(ctx, req.SchemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	if !s.canUserModifySchema(ctx, schema, req.PublishedBy) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	version, err := s.schemaRepo.GetVersion(ctx, req.SchemaID, req.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	if version.IsPublished {
+		return nil, ErrSchemaVersionPublished
+	}
+
+	blockers, err := s.publishBlockers(ctx, schema, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockers) > 0 {
+		s.logger.InfoContext(ctx, "Schema version publish blocked",
+			"schema_id", schema.ID, "version", version.Version, "blockers", len(blockers))
+		return nil, &PublishBlockedError{Blockers: blockers}
+	}
+
+	now := time.Now()
+	version.IsPublished = true
+	version.PublishedAt = &now
+	version.ValidationStatus = "valid"
+	version.ValidatedAt = &now
+	version.UpdatedAt = now
+	if err := s.schemaRepo.UpdateVersion(ctx, version); err != nil {
+		return nil, fmt.Errorf("failed to publish schema version: %w", err)
+	}
+
+	before := *schema
+	schema.Status = string(SchemaStatusPublished)
+	schema.PublishedVersions++
+	schema.LastPublishedAt = &now
+	schema.LastValidatedAt = &now
+	schema.UpdatedAt = now
+	schema.UpdatedBy = req.PublishedBy
+	if err := s.schemaRepo.Update(ctx, schema); err != nil {
+		s.logger.WarnContext(ctx, "Failed to update schema after publishing version", "error", err)
+	}
+
+	s.clearSchemaCaches(ctx, schema)
+
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: schema.WorkspaceID,
+		ActorID:     req.PublishedBy,
+		Action:      AuditActionPublished,
+		TargetType:  AuditTargetSchema,
+		TargetID:    schema.ID,
+		Before:      &before,
+		After:       schema,
+	})
+
+	s.publishSchemaEvent(ctx, newSchemaEvent(SchemaEventVersionPublished, schema, version, req.PublishedBy))
+
+	s.logger.InfoContext(ctx, "Schema version published successfully",
+		"version_id", version.ID, "schema_id", schema.ID, "version", version.Version)
+
+	return version, nil
+}
+
+// DeprecateVersion marks a schema version as deprecated
+func (s *SchemaService) DeprecateVersion(ctx context.Context, req DeprecateVersionRequest) (*domain.APISchemaVersion, error) {
+	s.logger.InfoContext(ctx, "Deprecating schema version",
+		"schema_id", req.SchemaID, "version", req.Version, "deprecated_by", req.DeprecatedBy)
+
+	schema, err := s.schemaRepo.GetByID(ctx, req.SchemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	if !s.canUserModifySchema(ctx, schema, req.DeprecatedBy) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	version, err := s.schemaRepo.GetVersion(ctx, req.SchemaID, req.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	if version.IsDeprecated {
+		return nil, ErrSchemaVersionDeprecated
+	}
+
+	before := *version
+	now := time.Now()
+	version.IsDeprecated = true
+	version.DeprecatedAt = &now
+	version.UpdatedAt = now
+	if err := s.schemaRepo.UpdateVersion(ctx, version); err != nil {
+		return nil, fmt.Errorf("failed to deprecate schema version: %w", err)
+	}
+
+	s.clearSchemaCaches(ctx, schema)
+
+	s.recordAudit(ctx, AuditRecord{
+		WorkspaceID: schema.WorkspaceID,
+		ActorID:     req.DeprecatedBy,
+		Action:      AuditActionUpdated,
+		TargetType:  AuditTargetSchemaVersion,
+		TargetID:    version.ID,
+		Before:      &before,
+		After:       version,
+	})
+
+	s.publishSchemaEvent(ctx, newSchemaEvent(SchemaEventVersionDeprecated, schema, version, req.DeprecatedBy))
+
+	s.logger.InfoContext(ctx, "Schema version deprecated successfully",
+		"version_id", version.ID, "schema_id", schema.ID, "version", version.Version)
+
+	return version, nil
+}
+
+// publishBlockers collects every reason a version cannot be published
+func (s *SchemaService) publishBlockers(ctx context.Context, schema *domain.APISchema, version *domain.APISchemaVersion) ([]PublishBlocker, error) {
+	var blockers []PublishBlocker
+
+	if version.IsDraft {
+		blockers = append(blockers, PublishBlocker{
+			Code:    PublishBlockerDraft,
+			Message: fmt.Sprintf("version %s is a draft", version.Version),
+		})
+	}
+
+	validation, err := s.validator.ValidateSchema(ctx, version.Content, SchemaFormat(schema.Format))
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate schema content: %w", err)
+	}
+	if !validation.IsValid {
+		for _, validationErr := range validation.Errors {
+			blockers = append(blockers, PublishBlocker{
+				Code:    PublishBlockerValidationFailed,
+				Message: validationErr.Message,
+				Path:    validationErr.Path,
+			})
+		}
+		if len(validation.Errors) == 0 {
+			blockers = append(blockers, PublishBlocker{Code: PublishBlockerValidationFailed, Message: "schema content is invalid"})
+		}
+	}
+
+	dependencies, err := s.schemaRepo.GetDependencies(ctx, schema.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema dependencies: %w", err)
+	}
+	for _, dependency := range dependencies {
+		if _, err := domain.ParseSemanticVersion(dependency.Version); err != nil {
+			version := dependency.Version
+			if version == "" {
+				version = "no version"
+			}
+			blockers = append(blockers, PublishBlocker{
+				Code:    PublishBlockerDependencyUnpinned,
+				Message: fmt.Sprintf("dependency %s is not pinned to an exact version (%s)", dependency.DependsOnID, version),
+				Path:    dependency.Path,
+			})
+			continue
+		}
+
+		depVersion, err := s.schemaRepo.GetVersion(ctx, dependency.DependsOnID, dependency.Version)
+		switch {
+		case errors.Is(err, ErrSchemaVersionNotFound):
+			blockers = append(blockers, PublishBlocker{
+				Code:    PublishBlockerDependencyNotFound,
+				Message: fmt.Sprintf("dependency %s has no version %s", dependency.DependsOnID, dependency.Version),
+				Path:    dependency.Path,
+			})
+		case err != nil:
+			return nil, fmt.Errorf("failed to get dependency version: %w", err)
+		case !depVersion.IsPublished:
+			blockers = append(blockers, PublishBlocker{
+				Code:    PublishBlockerDependencyUnpublished,
+				Message: fmt.Sprintf("dependency %s version %s is not published", dependency.DependsOnID, dependency.Version),
+				Path:    dependency.Path,
+			})
+		}
+	}
+
+	return blockers, nil
+}
+
+// ValidateSchema validates a schema without creating it. When the request
+// names a workspace, the authenticated user in ctx must be a member of it.
 func (s *SchemaService) ValidateSchema(ctx context.Context, req ValidationRequest) (*ValidationResult, error) {
 	s.logger.DebugContext(ctx, "Validating schema", "format", req.Format)
 
+	if req.Context.WorkspaceID != uuid.Nil {
+		userID, err := authenticatedUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		workspace, err := s.workspaceRepo.GetByID(ctx, req.Context.WorkspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workspace: %w", err)
+		}
+
+		if !workspace.HasMember(userID) {
+			return nil, domain.ErrInsufficientPermission
+		}
+	}
+
+	if err := s.checkQuota(ctx, req.Context.WorkspaceID, QuotaSchemaValidation); err != nil {
+		return nil, err
+	}
+
 	// Validate schema content
 	result, err := s.validator.ValidateSchema(ctx, req.Content, req.Format)
 	if err != nil {
@@ -1167,10 +1737,16 @@ func (s *SchemaService) ValidateSchema(ctx context.Context, req ValidationReques
 	return result, nil
 }
 
-// TransformSchema transforms a schema using specified transformations
+// TransformSchema transforms a schema using specified transformations on
+// behalf of the authenticated user in ctx
 func (s *SchemaService) TransformSchema(ctx context.Context, req TransformationRequest) (*TransformationResult, error) {
 	s.logger.InfoContext(ctx, "Transforming schema", "format", req.Format, "transformations", len(req.Transformations))
 
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check workspace permissions if context provided
 	if req.Context.WorkspaceID != uuid.Nil {
 		workspace, err := s.workspaceRepo.GetByID(ctx, req.Context.WorkspaceID)
@@ -1178,13 +1754,27 @@ func (s *SchemaService) TransformSchema(ctx context.Context, req TransformationR
 			return nil, fmt.Errorf("failed to get workspace: %w", err)
 		}
 
-		// Get user ID from context (simplified - in production, extract from JWT/session)
-		userID := uuid.New() // This would come from authentication context
 		if !s.canUserModifySchemas(ctx, workspace, userID) {
 			return nil, domain.ErrInsufficientPermission
 		}
 	}
 
+	// A stored schema's content is only transformed for users who can see it
+	if req.Context.SchemaID != nil {
+		schema, err := s.schemaRepo.GetByID(ctx, *req.Context.SchemaID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema: %w", err)
+		}
+
+		if !s.canUserAccessSchema(ctx, schema, userID) {
+			return nil, domain.ErrInsufficientPermission
+		}
+	}
+
+	if err := s.checkQuota(ctx, req.Context.WorkspaceID, QuotaSchemaTransformation); err != nil {
+		return nil, err
+	}
+
 	// Perform transformation
 	result, err := s.transformer.TransformSchema(ctx, &req)
 	if err != nil {
@@ -1194,18 +1784,22 @@ func (s *SchemaService) TransformSchema(ctx context.Context, req TransformationR
 	return result, nil
 }
 
-// GenerateDocumentation generates documentation for a schema
+// GenerateDocumentation generates documentation for a schema on behalf of
+// the authenticated user in ctx
 func (s *SchemaService) GenerateDocumentation(ctx context.Context, req DocumentationRequest) (*DocumentationResult, error) {
 	s.logger.InfoContext(ctx, "Generating documentation", "schema_id", req.SchemaID, "format", req.Format)
 
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the schema
 	schema, err := s.schemaRepo.GetByID(ctx, req.SchemaID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get schema: %w", err)
 	}
 
-	// Check permissions (simplified - get user ID from context)
-	userID := uuid.New() // This would come from authentication context
 	if !s.canUserAccessSchema(ctx, schema, userID) {
 		return nil, domain.ErrInsufficientPermission
 	}
@@ -1219,6 +1813,104 @@ func (s *SchemaService) GenerateDocumentation(ctx context.Context, req Documenta
 	return result, nil
 }
 
+// GenerateSDK generates a client SDK for a schema on behalf of the
+// authenticated user in ctx
+func (s *SchemaService) GenerateSDK(ctx context.Context, req SDKGenerationRequest) (*SDKResult, error) {
+	s.logger.InfoContext(ctx, "Generating SDK", "schema_id", req.SchemaID, "language", req.Language)
+
+	userID, err := authenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := s.schemaRepo.GetByID(ctx, req.SchemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	if !s.canUserAccessSchema(ctx, schema, userID) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	if err := s.checkQuota(ctx, schema.WorkspaceID, QuotaSDKGeneration); err != nil {
+		return nil, err
+	}
+
+	result, err := s.docGenerator.GenerateSDK(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate SDK: %w", err)
+	}
+
+	return result, nil
+}
+
+// GenerateFullChangelog builds a consolidated Markdown changelog for a schema by
+// diffing every version against its predecessor, newest version first
+func (s *SchemaService) GenerateFullChangelog(ctx context.Context, schemaID uuid.UUID, userID uuid.UUID) (*FullChangelogResult, error) {
+	s.logger.InfoContext(ctx, "Generating full changelog", "schema_id", schemaID, "user_id", userID)
+
+	// Get the schema
+	schema, err := s.schemaRepo.GetByID(ctx, schemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+
+	// Check access permissions
+	if !s.canUserAccessSchema(ctx, schema, userID) {
+		return nil, domain.ErrInsufficientPermission
+	}
+
+	versions, err := s.schemaRepo.ListVersions(ctx, schemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, ErrSchemaVersionNotFound
+	}
+
+	// Walk versions in the order they were created
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt.Before(versions[j].CreatedAt)
+	})
+
+	entries := make([]VersionChangelog, 0, len(versions))
+	for i, version := range versions {
+		entry := VersionChangelog{
+			Version:     version.Version,
+			ChangeNotes: version.ChangeNotes,
+			IsBreaking:  version.IsBreaking,
+			CreatedAt:   version.CreatedAt,
+		}
+
+		if i > 0 {
+			diff, err := s.docGenerator.GenerateChangelog(ctx, versions[i-1], version)
+			if err != nil {
+				return nil, fmt.Errorf("failed to diff version %s against %s: %w", version.Version, versions[i-1].Version, err)
+			}
+			entry.Changes = diff.Changes
+			entry.Summary = diff.Summary
+			if diff.Summary.BreakingChanges > 0 {
+				entry.IsBreaking = true
+			}
+			for _, change := range diff.Changes {
+				if change.IsBreaking {
+					entry.IsBreaking = true
+				}
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &FullChangelogResult{
+		SchemaID:    schemaID,
+		Changelog:   renderFullChangelog(schema, entries),
+		Format:      "markdown",
+		Versions:    entries,
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
 // Helper methods
 
 // validateCreateSchemaRequest validates a create schema request
@@ -1283,10 +1975,136 @@ func (s *SchemaService) checkSchemaExists(ctx context.Context, workspaceID uuid.
 	return false, nil
 }
 
-// calculateContentHash calculates a hash of the schema content
+// calculateContentHash returns the hex SHA-256 digest of the schema content.
+// Line endings and trailing whitespace are normalized first so that edits
+// which only change them hash the same.
 func (s *SchemaService) calculateContentHash(content string) string {
-	// Simplified hash calculation - use SHA256 in production
-	return fmt.Sprintf("sha256-%x", len(content))
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	normalized := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeSchemaPageToken encodes a cursor as an opaque page token
+func encodeSchemaPageToken(cursor SchemaCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeSchemaPageToken decodes a page token; an empty token means the first page
+func decodeSchemaPageToken(token string) (*SchemaCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var cursor SchemaCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == uuid.Nil || cursor.CreatedAt.IsZero() {
+		return nil, ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
+
+// renderFullChangelog renders per-version changelog entries as Markdown, newest first
+func renderFullChangelog(schema *domain.APISchema, entries []VersionChangelog) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Changelog: %s\n", schema.Name)
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		fmt.Fprintf(&b, "\n## %s (%s)\n\n", entry.Version, entry.CreatedAt.Format("2006-01-02"))
+
+		if entry.IsBreaking {
+			breaking := 0
+			for _, change := range entry.Changes {
+				if change.IsBreaking {
+					breaking++
+				}
+			}
+			if breaking > 0 {
+				fmt.Fprintf(&b, "> **⚠️ Breaking changes:** this version contains %d breaking change(s).\n\n", breaking)
+			} else {
+				b.WriteString("> **⚠️ Breaking changes:** this version is marked as breaking.\n\n")
+			}
+		}
+
+		if entry.ChangeNotes != "" {
+			b.WriteString(entry.ChangeNotes + "\n\n")
+		}
+
+		// The first version has no predecessor to diff against
+		if i == 0 {
+			if entry.ChangeNotes == "" {
+				b.WriteString("Initial version\n")
+			}
+			continue
+		}
+		if len(entry.Changes) == 0 {
+			b.WriteString("- No schema changes detected\n")
+			continue
+		}
+		for _, change := range entry.Changes {
+			description := change.Description
+			if description == "" {
+				description = fmt.Sprintf("%s %s `%s`", change.Type, change.Category, change.Path)
+			}
+			if change.IsBreaking {
+				description = "**BREAKING:** " + description
+			}
+			fmt.Fprintf(&b, "- %s\n", description)
+		}
+	}
+
+	return b.String()
+}
+
+// checkQuota charges one call of operation to the caller's workspace. A
+// workspaceID from the request must already have been authorized.
+func (s *SchemaService) checkQuota(ctx context.Context, workspaceID uuid.UUID, operation QuotaOperation) error {
+	quota := s.quota.Load()
+	if quota == nil {
+		return nil
+	}
+	workspaceID = quotaWorkspaceID(ctx, workspaceID)
+	if err := quota.Allow(workspaceID, operation); err != nil {
+		s.logger.WarnContext(ctx, "Workspace quota exceeded",
+			"workspace_id", workspaceID, "operation", operation, "error", err)
+		return err
+	}
+	return nil
+}
+
+// recordAudit appends an event to the workspace audit trail. Failures are
+// logged rather than returned because the mutation has already been persisted.
+func (s *SchemaService) recordAudit(ctx context.Context, record AuditRecord) {
+	if s.auditor == nil {
+		return
+	}
+	if _, err := s.auditor.Record(ctx, record); err != nil {
+		s.logger.WarnContext(ctx, "Failed to record audit event",
+			"action", record.Action, "target_type", record.TargetType, "target_id", record.TargetID, "error", err)
+	}
+}
+
+// publishSchemaEvent emits a lifecycle event. Failures are logged rather
+// than returned because the mutation has already been persisted.
+func (s *SchemaService) publishSchemaEvent(ctx context.Context, event *SchemaEvent) {
+	if s.eventPub == nil {
+		return
+	}
+	if err := s.eventPub.PublishSchemaEvent(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "Failed to publish schema event",
+			"event_id", event.ID, "type", event.Type, "schema_id", event.SchemaID, "error", err)
+	}
 }
 
 // Permission checking methods
@@ -1366,7 +2184,6 @@ func (s *SchemaService) clearSchemaCaches(ctx context.Context, schema *domain.AP
 		fmt.Sprintf("api_schema:id:%s", schema.ID.String()),
 		fmt.Sprintf("api_schema:name:%s:%s", schema.WorkspaceID.String(), schema.Name),
 		fmt.Sprintf("api_schema:slug:%s:%s", schema.WorkspaceID.String(), schema.Slug),
-		fmt.Sprintf("workspace:schemas:%s", schema.WorkspaceID.String()),
 	}
 
 	for _, pattern := range patterns {
@@ -1374,12 +2191,30 @@ func (s *SchemaService) clearSchemaCaches(ctx context.Context, schema *domain.AP
 			s.logger.WarnContext(ctx, "Failed to clear cache", "pattern", pattern, "error", err)
 		}
 	}
+
+	// Lists and stats embed the schema, so they are stale too
+	s.clearSchemaListCaches(ctx, schema.WorkspaceID)
+}
+
+// schemaListCacheKey identifies one user's page of a workspace's schema
+// list. Keys share the schemas:list:<workspace>: prefix so that
+// clearSchemaListCaches can drop every page of a workspace at once.
+func schemaListCacheKey(workspaceID, userID uuid.UUID, filters SchemaFilters) string {
+	encoded, _ := json.Marshal(filters)
+	sum := sha256.Sum256(encoded)
+	return fmt.Sprintf("schemas:list:%s:%s:%s", workspaceID.String(), userID.String(), hex.EncodeToString(sum[:8]))
+}
+
+// userSchemaListCachePattern matches every cached page of a workspace's
+// schema list built for one user
+func userSchemaListCachePattern(workspaceID, userID uuid.UUID) string {
+	return fmt.Sprintf("schemas:list:%s:%s:*", workspaceID.String(), userID.String())
 }
 
 func (s *SchemaService) clearSchemaListCaches(ctx context.Context, workspaceID uuid.UUID) {
 	patterns := []string{
 		fmt.Sprintf("workspace:schemas:%s", workspaceID.String()),
-		"schemas:list:*",
+		fmt.Sprintf("schemas:list:%s:*", workspaceID.String()),
 		"schema_stats:*",
 	}
 
@@ -1401,9 +2236,16 @@ var (
 	ErrSchemaNotFound                = domain.NewDomainError("SCHEMA_NOT_FOUND", "Schema not found")
 	ErrSchemaVersionExists           = domain.NewDomainError("SCHEMA_VERSION_EXISTS", "Schema version already exists")
 	ErrSchemaVersionNotFound         = domain.NewDomainError("SCHEMA_VERSION_NOT_FOUND", "Schema version not found")
+	ErrSchemaVersionUnchanged        = domain.NewDomainError("SCHEMA_VERSION_UNCHANGED", "Schema version content is unchanged")
+	ErrSchemaVersionNotIncreasing    = domain.NewDomainError("SCHEMA_VERSION_NOT_INCREASING", "Schema version must be greater than the current version")
+	ErrInvalidPageToken              = domain.NewDomainError("INVALID_PAGE_TOKEN", "Page token is invalid")
 	ErrBreakingChangesNotAllowed     = domain.NewDomainError("BREAKING_CHANGES_NOT_ALLOWED", "Breaking changes not allowed")
 	ErrSchemaValidationFailed        = domain.NewDomainError("SCHEMA_VALIDATION_FAILED", "Schema validation failed")
 	ErrSchemaTransformationFailed    = domain.NewDomainError("SCHEMA_TRANSFORMATION_FAILED", "Schema transformation failed")
 	ErrDocumentationGenerationFailed = domain.NewDomainError("DOCUMENTATION_GENERATION_FAILED", "Documentation generation failed")
 	ErrIncompatibleSchemaVersion     = domain.NewDomainError("INCOMPATIBLE_SCHEMA_VERSION", "Incompatible schema version")
+	ErrSchemaNotPublishable          = domain.NewDomainError("SCHEMA_NOT_PUBLISHABLE", "Schema version is not ready to publish")
+	ErrSchemaVersionPublished        = domain.NewDomainError("SCHEMA_VERSION_PUBLISHED", "Schema version is already published")
+	ErrSchemaVersionDeprecated       = domain.NewDomainError("SCHEMA_VERSION_DEPRECATED", "Schema version is already deprecated")
+	ErrSchemaConflict                = domain.NewDomainError("SCHEMA_CONFLICT", "Schema was modified by another update")
 )
@@ -0,0 +1,1223 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/drewpayment/orbit/proto/pkg/svcauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+type memorySchemaRepository struct {
+	APISchemaRepository
+	schemas      map[uuid.UUID]*domain.APISchema
+	versions     map[uuid.UUID][]*domain.APISchemaVersion
+	dependencies map[uuid.UUID][]*SchemaDependency
+}
+
+func newMemorySchemaRepository() *memorySchemaRepository {
+	return &memorySchemaRepository{
+		schemas:      make(map[uuid.UUID]*domain.APISchema),
+		versions:     make(map[uuid.UUID][]*domain.APISchemaVersion),
+		dependencies: make(map[uuid.UUID][]*SchemaDependency),
+	}
+}
+
+func (r *memorySchemaRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.APISchema, error) {
+	schema, ok := r.schemas[id]
+	if !ok {
+		return nil, ErrSchemaNotFound
+	}
+	return schema, nil
+}
+
+func (r *memorySchemaRepository) GetByName(_ context.Context, workspaceID uuid.UUID, name string) (*domain.APISchema, error) {
+	for _, schema := range r.schemas {
+		if schema.WorkspaceID == workspaceID && schema.Name == name {
+			return schema, nil
+		}
+	}
+	return nil, ErrSchemaNotFound
+}
+
+func (r *memorySchemaRepository) GetBySlug(_ context.Context, workspaceID uuid.UUID, slug string) (*domain.APISchema, error) {
+	for _, schema := range r.schemas {
+		if schema.WorkspaceID == workspaceID && schema.Slug == slug {
+			return schema, nil
+		}
+	}
+	return nil, ErrSchemaNotFound
+}
+
+func (r *memorySchemaRepository) Create(_ context.Context, schema *domain.APISchema) error {
+	r.schemas[schema.ID] = schema
+	return nil
+}
+
+func (r *memorySchemaRepository) Update(_ context.Context, schema *domain.APISchema) error {
+	r.schemas[schema.ID] = schema
+	return nil
+}
+
+func (r *memorySchemaRepository) UpdateIfUnmodified(_ context.Context, schema *domain.APISchema, expectedUpdatedAt time.Time) error {
+	stored, ok := r.schemas[schema.ID]
+	if !ok || !stored.UpdatedAt.Equal(expectedUpdatedAt) {
+		return ErrSchemaConflict
+	}
+	r.schemas[schema.ID] = schema
+	return nil
+}
+
+// ListByWorkspace returns the workspace's schemas newest first, ignoring
+// every filter but Limit
+func (r *memorySchemaRepository) ListByWorkspace(_ context.Context, workspaceID uuid.UUID, filters SchemaFilters) ([]*domain.APISchema, error) {
+	var schemas []*domain.APISchema
+	for _, schema := range r.schemas {
+		if schema.WorkspaceID == workspaceID {
+			schemas = append(schemas, schema)
+		}
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		if !schemas[i].CreatedAt.Equal(schemas[j].CreatedAt) {
+			return schemas[i].CreatedAt.After(schemas[j].CreatedAt)
+		}
+		return schemas[i].ID.String() > schemas[j].ID.String()
+	})
+	if filters.Limit > 0 && len(schemas) > filters.Limit {
+		schemas = schemas[:filters.Limit]
+	}
+	return schemas, nil
+}
+
+func (r *memorySchemaRepository) CreateVersion(_ context.Context, version *domain.APISchemaVersion) error {
+	versions := append(r.versions[version.SchemaID], version)
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].VersionSortKey < versions[j].VersionSortKey
+	})
+	r.versions[version.SchemaID] = versions
+	return nil
+}
+
+func (r *memorySchemaRepository) GetVersion(_ context.Context, schemaID uuid.UUID, version string) (*domain.APISchemaVersion, error) {
+	for _, v := range r.versions[schemaID] {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return nil, ErrSchemaVersionNotFound
+}
+
+func (r *memorySchemaRepository) UpdateVersion(_ context.Context, version *domain.APISchemaVersion) error {
+	for i, v := range r.versions[version.SchemaID] {
+		if v.ID == version.ID {
+			r.versions[version.SchemaID][i] = version
+			return nil
+		}
+	}
+	return ErrSchemaVersionNotFound
+}
+
+func (r *memorySchemaRepository) GetDependencies(_ context.Context, schemaID uuid.UUID) ([]*SchemaDependency, error) {
+	return r.dependencies[schemaID], nil
+}
+
+func (r *memorySchemaRepository) GetLatestVersion(_ context.Context, schemaID uuid.UUID) (*domain.APISchemaVersion, error) {
+	versions := r.versions[schemaID]
+	if len(versions) == 0 {
+		return nil, ErrSchemaVersionNotFound
+	}
+	return versions[len(versions)-1], nil
+}
+
+func (r *memorySchemaRepository) ListVersions(_ context.Context, schemaID uuid.UUID) ([]*domain.APISchemaVersion, error) {
+	return append([]*domain.APISchemaVersion(nil), r.versions[schemaID]...), nil
+}
+
+// stubChangelogGenerator returns canned changes keyed by "old->new" version
+type stubChangelogGenerator struct {
+	DocumentationGenerator
+	changes map[string][]SchemaChange
+	calls   []string
+}
+
+func (g *stubChangelogGenerator) GenerateChangelog(_ context.Context, oldVersion, newVersion *domain.APISchemaVersion) (*ChangelogResult, error) {
+	key := oldVersion.Version + "->" + newVersion.Version
+	g.calls = append(g.calls, key)
+
+	result := &ChangelogResult{Success: true, Format: "markdown", Changes: g.changes[key]}
+	for _, change := range result.Changes {
+		result.Summary.TotalChanges++
+		if change.IsBreaking {
+			result.Summary.BreakingChanges++
+		}
+	}
+	return result, nil
+}
+
+func newTestSchemaService(repo APISchemaRepository, docGenerator DocumentationGenerator) *SchemaService {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewSchemaService(repo, nil, nil, nil, nil, nil, docGenerator, nil, nil, nil, logger)
+}
+
+func TestSchemaService_GenerateFullChangelog(t *testing.T) {
+	owner := uuid.New()
+	schema := &domain.APISchema{ID: uuid.New(), Name: "Pets API", CreatedBy: owner}
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := newMemorySchemaRepository()
+	repo.schemas[schema.ID] = schema
+	// Stored out of order; the changelog must follow creation order
+	repo.versions[schema.ID] = []*domain.APISchemaVersion{
+		{Version: "1.1.0", ChangeNotes: "Add owners", CreatedAt: base.AddDate(0, 1, 0)},
+		{Version: "2.0.0", ChangeNotes: "Drop legacy endpoints", CreatedAt: base.AddDate(0, 2, 0)},
+		{Version: "1.0.0", ChangeNotes: "Initial version", CreatedAt: base},
+		{Version: "2.0.1", IsBreaking: true, CreatedAt: base.AddDate(0, 3, 0)},
+	}
+
+	generator := &stubChangelogGenerator{changes: map[string][]SchemaChange{
+		"1.0.0->1.1.0": {
+			{Type: "added", Category: "endpoint", Path: "/owners", Description: "Added GET /owners"},
+		},
+		"1.1.0->2.0.0": {
+			{Type: "removed", Category: "endpoint", Path: "/pets/legacy", Description: "Removed GET /pets/legacy", IsBreaking: true},
+			{Type: "added", Category: "property", Path: "Pet.tag"},
+		},
+	}}
+
+	result, err := newTestSchemaService(repo, generator).GenerateFullChangelog(context.Background(), schema.ID, owner)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1.0.0->1.1.0", "1.1.0->2.0.0", "2.0.0->2.0.1"}, generator.calls)
+	require.Len(t, result.Versions, 4)
+	assert.False(t, result.Versions[0].IsBreaking)
+	assert.False(t, result.Versions[1].IsBreaking)
+	assert.True(t, result.Versions[2].IsBreaking)
+	assert.Equal(t, 1, result.Versions[2].Summary.BreakingChanges)
+	assert.True(t, result.Versions[3].IsBreaking, "versions flagged as breaking keep the flag without breaking diffs")
+
+	changelog := result.Changelog
+	assert.True(t, strings.HasPrefix(changelog, "# Changelog: Pets API\n"))
+
+	// One section per version, newest first
+	var positions []int
+	for _, version := range []string{"2.0.1", "2.0.0", "1.1.0", "1.0.0"} {
+		idx := strings.Index(changelog, "\n## "+version+" (")
+		require.NotEqual(t, -1, idx, "missing section for %s", version)
+		positions = append(positions, idx)
+	}
+	assert.IsIncreasing(t, positions)
+
+	sections := splitChangelogSections(changelog)
+	assert.Contains(t, sections["2.0.1"], "this version is marked as breaking")
+	assert.Contains(t, sections["2.0.1"], "- No schema changes detected")
+	assert.Contains(t, sections["2.0.0"], "contains 1 breaking change(s)")
+	assert.Contains(t, sections["2.0.0"], "- **BREAKING:** Removed GET /pets/legacy")
+	assert.Contains(t, sections["2.0.0"], "- added property `Pet.tag`")
+	assert.NotContains(t, sections["1.1.0"], "Breaking changes")
+	assert.Contains(t, sections["1.1.0"], "- Added GET /owners")
+	assert.NotContains(t, sections["1.0.0"], "Breaking changes")
+	assert.Equal(t, 1, strings.Count(sections["1.0.0"], "Initial version"))
+}
+
+func TestSchemaService_GenerateFullChangelog_RequiresAccess(t *testing.T) {
+	schema := &domain.APISchema{ID: uuid.New(), Name: "Pets API", CreatedBy: uuid.New()}
+	repo := newMemorySchemaRepository()
+	repo.schemas[schema.ID] = schema
+
+	service := newTestSchemaService(repo, &stubChangelogGenerator{})
+	service.workspaceRepo = failingWorkspaceRepository{}
+
+	_, err := service.GenerateFullChangelog(context.Background(), schema.ID, uuid.New())
+	assert.ErrorIs(t, err, domain.ErrInsufficientPermission)
+}
+
+func TestSchemaService_GenerateFullChangelog_NoVersions(t *testing.T) {
+	owner := uuid.New()
+	schema := &domain.APISchema{ID: uuid.New(), Name: "Pets API", CreatedBy: owner}
+	repo := newMemorySchemaRepository()
+	repo.schemas[schema.ID] = schema
+
+	_, err := newTestSchemaService(repo, &stubChangelogGenerator{}).GenerateFullChangelog(context.Background(), schema.ID, owner)
+	assert.ErrorIs(t, err, ErrSchemaVersionNotFound)
+}
+
+// acceptingSchemaValidator reports every schema as valid and compatible
+type acceptingSchemaValidator struct {
+	SchemaValidator
+}
+
+func (acceptingSchemaValidator) ValidateSchema(_ context.Context, _ string, _ SchemaFormat) (*ValidationResult, error) {
+	return &ValidationResult{IsValid: true}, nil
+}
+
+func (acceptingSchemaValidator) ValidateCompatibility(_ context.Context, _, _ string, _ SchemaFormat) (*CompatibilityResult, error) {
+	return &CompatibilityResult{IsCompatible: true, Compatibility: CompatibilityLevelFull}, nil
+}
+
+// singleWorkspaceRepository serves one workspace
+type singleWorkspaceRepository struct {
+	WorkspaceRepository
+	workspace *domain.Workspace
+}
+
+func (r singleWorkspaceRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.Workspace, error) {
+	if id != r.workspace.ID {
+		return nil, fmt.Errorf("workspace %s not found", id)
+	}
+	return r.workspace, nil
+}
+
+func newTestWorkspace(memberID uuid.UUID, role domain.WorkspaceRole) *domain.Workspace {
+	workspaceID := uuid.New()
+	return &domain.Workspace{
+		ID: workspaceID,
+		Members: []domain.WorkspaceMember{
+			{WorkspaceID: workspaceID, UserID: memberID, Role: role, IsActive: true},
+		},
+	}
+}
+
+type noopCache struct{}
+
+func (noopCache) Set(context.Context, string, interface{}, time.Duration) error { return nil }
+func (noopCache) Get(context.Context, string) (interface{}, error) {
+	return nil, fmt.Errorf("cache miss")
+}
+func (noopCache) Delete(context.Context, string) error          { return nil }
+func (noopCache) DeleteByPattern(context.Context, string) error { return nil }
+
+func TestSchemaService_MutationsRecordAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	store := &memoryAuditStore{}
+	recorder := newTestAuditRecorder(store)
+
+	service := NewSchemaService(newMemorySchemaRepository(), nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, recorder, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     `{"openapi": "3.0.3"}`,
+		Version:     "1.0.0",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	_, err = service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID:  schema.ID,
+		Version:   "1.1.0",
+		Content:   `{"openapi": "3.0.3", "paths": {}}`,
+		CreatedBy: actor,
+	})
+	require.NoError(t, err)
+
+	trail, err := recorder.GetAuditTrail(ctx, workspace.ID, AuditFilters{TargetID: &schema.ID})
+	require.NoError(t, err)
+	require.Len(t, trail, 2)
+
+	updated, created := trail[0], trail[1]
+	assert.Equal(t, AuditActionCreated, created.Action)
+	assert.Equal(t, AuditTargetSchema, created.TargetType)
+	assert.Equal(t, actor, created.ActorID)
+	assert.Contains(t, created.Changes, AuditFieldChange{Field: "name", NewValue: "Pets API"})
+
+	assert.Equal(t, AuditActionUpdated, updated.Action)
+	assert.Equal(t, actor, updated.ActorID)
+	assert.Contains(t, updated.Changes, AuditFieldChange{Field: "current_version", OldValue: "1.0.0", NewValue: "1.1.0"})
+	for _, change := range updated.Changes {
+		assert.NotEqual(t, "name", change.Field, "unchanged fields must not appear in the diff")
+	}
+}
+
+type failingWorkspaceRepository struct {
+	WorkspaceRepository
+}
+
+func (failingWorkspaceRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.Workspace, error) {
+	return nil, fmt.Errorf("workspace %s not found", id)
+}
+
+// splitChangelogSections maps each "## <version>" heading to its section body
+func splitChangelogSections(changelog string) map[string]string {
+	sections := make(map[string]string)
+	for _, part := range strings.Split(changelog, "\n## ")[1:] {
+		version, _, _ := strings.Cut(part, " ")
+		sections[version] = part
+	}
+	return sections
+}
+
+func TestSchemaService_CalculateContentHash(t *testing.T) {
+	service := newTestSchemaService(newMemorySchemaRepository(), nil)
+
+	a := service.calculateContentHash(`{"openapi": "3.0.3", "x": 1}`)
+	b := service.calculateContentHash(`{"openapi": "3.0.3", "x": 2}`)
+	assert.NotEqual(t, a, b, "content of equal length must not collide")
+	assert.Len(t, a, 64)
+
+	assert.Equal(t,
+		service.calculateContentHash("openapi: 3.0.3\ninfo:\n  title: Pets\n"),
+		service.calculateContentHash("openapi: 3.0.3  \r\ninfo:\r\n  title: Pets\r\n\r\n"),
+		"line endings and trailing whitespace are normalized")
+	assert.NotEqual(t,
+		service.calculateContentHash("info:\n  title: Pets"),
+		service.calculateContentHash("info:\n title: Pets"),
+		"leading whitespace is significant")
+}
+
+func TestSchemaService_CreateSchemaVersion_RejectsUnchangedContent(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	repo := newMemorySchemaRepository()
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     "openapi: 3.0.3\npaths: {}\n",
+		Version:     "1.0.0",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	_, err = service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID:  schema.ID,
+		Version:   "1.0.1",
+		Content:   "openapi: 3.0.3\r\npaths: {}\r\n",
+		CreatedBy: actor,
+	})
+	assert.ErrorIs(t, err, ErrSchemaVersionUnchanged)
+	assert.Len(t, repo.versions[schema.ID], 1)
+
+	version, err := service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID:  schema.ID,
+		Version:   "1.1.0",
+		Content:   "openapi: 3.1.0\npaths: {}\n",
+		CreatedBy: actor,
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, repo.versions[schema.ID][0].ContentHash, version.ContentHash)
+}
+
+func TestSemanticVersionPrecedence(t *testing.T) {
+	// Ordered from lowest to highest precedence, per semver.org
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.9.0", "1.10.0", "9.0.0", "10.0.0",
+	}
+	for i := 1; i < len(ordered); i++ {
+		lower, err := domain.ParseSemanticVersion(ordered[i-1])
+		require.NoError(t, err)
+		higher, err := domain.ParseSemanticVersion(ordered[i])
+		require.NoError(t, err)
+
+		assert.Equal(t, -1, lower.Compare(higher), "%s < %s", ordered[i-1], ordered[i])
+		assert.Equal(t, 1, higher.Compare(lower), "%s > %s", ordered[i], ordered[i-1])
+		assert.Less(t, lower.SortKey(), higher.SortKey(), "sort key %s < %s", ordered[i-1], ordered[i])
+	}
+
+	a, _ := domain.ParseSemanticVersion("v1.2.3+build.5")
+	b, _ := domain.ParseSemanticVersion("1.2.3")
+	assert.Zero(t, a.Compare(b), "build metadata does not affect precedence")
+
+	for _, invalid := range []string{"", "10", "1.0", "1.0.0.0", "01.0.0", "1.0.0-", "1.0.0-01", "1.0.0-alpha..1", "1.0.0+", "1.x.0"} {
+		_, err := domain.ParseSemanticVersion(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSchemaService_CreateSchemaVersion_SemanticVersions(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	repo := newMemorySchemaRepository()
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     "openapi: 3.0.3\ninfo: {version: 9.0.0}\n",
+		Version:     "9.0.0",
+		CreatedBy:   actor,
+	})
+	require.NoError(t, err)
+
+	createVersion := func(version string, draft bool) error {
+		_, err := service.CreateSchemaVersion(ctx, CreateVersionRequest{
+			SchemaID:  schema.ID,
+			Version:   version,
+			Content:   "openapi: 3.0.3\ninfo: {version: " + version + "}\n",
+			IsDraft:   draft,
+			CreatedBy: actor,
+		})
+		return err
+	}
+
+	assert.ErrorIs(t, createVersion("10", false), ErrInvalidSchemaVersion)
+	assert.ErrorIs(t, createVersion("next", true), ErrInvalidSchemaVersion)
+
+	// Out-of-order submissions are rejected
+	assert.ErrorIs(t, createVersion("8.5.0", false), ErrSchemaVersionNotIncreasing)
+	assert.ErrorIs(t, createVersion("9.0.0", false), ErrSchemaVersionNotIncreasing)
+	assert.ErrorIs(t, createVersion("9.0.0-rc.1", false), ErrSchemaVersionNotIncreasing)
+
+	require.NoError(t, createVersion("10.0.0-rc.1", true))
+	require.NoError(t, createVersion("10.0.0-rc.2", false))
+	require.NoError(t, createVersion("10.0.0-rc.10", false))
+	require.NoError(t, createVersion("10.0.0", false))
+
+	latest, err := repo.GetLatestVersion(ctx, schema.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0", latest.Version, "10.0.0 sorts after 9.0.0 and its prereleases")
+
+	versions, err := repo.ListVersions(ctx, schema.ID)
+	require.NoError(t, err)
+	got := make([]string, len(versions))
+	for i, v := range versions {
+		got[i] = v.Version
+	}
+	assert.Equal(t, []string{"9.0.0", "10.0.0-rc.1", "10.0.0-rc.2", "10.0.0-rc.10", "10.0.0"}, got)
+}
+
+func TestSchemaService_CreateSchema_RejectsInvalidVersion(t *testing.T) {
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	service := NewSchemaService(newMemorySchemaRepository(), nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	_, err := service.CreateSchema(context.Background(), CreateSchemaRequest{
+		WorkspaceID: workspace.ID,
+		Name:        "Pets API",
+		Slug:        "pets-api",
+		Format:      SchemaFormatOpenAPI,
+		Content:     "openapi: 3.0.3\n",
+		Version:     "v1",
+		CreatedBy:   actor,
+	})
+	assert.ErrorIs(t, err, ErrInvalidSchemaVersion)
+}
+
+// pagedSchemaRepository is a concurrency-safe APISchemaRepository whose
+// ListByWorkspace honours the keyset cursor contract
+type pagedSchemaRepository struct {
+	APISchemaRepository
+	mu      sync.Mutex
+	schemas []*domain.APISchema
+}
+
+func (r *pagedSchemaRepository) Create(_ context.Context, schema *domain.APISchema) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schemas = append(r.schemas, schema)
+	return nil
+}
+
+func (r *pagedSchemaRepository) ListByWorkspace(_ context.Context, workspaceID uuid.UUID, filters SchemaFilters) ([]*domain.APISchema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// newer reports whether a sorts ahead of b in (CreatedAt, ID) descending order
+	newer := func(a, b *domain.APISchema) bool {
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID.String() > b.ID.String()
+	}
+
+	var matched []*domain.APISchema
+	for _, schema := range r.schemas {
+		if schema.WorkspaceID != workspaceID {
+			continue
+		}
+		if filters.After != nil && !newer(&domain.APISchema{CreatedAt: filters.After.CreatedAt, ID: filters.After.ID}, schema) {
+			continue
+		}
+		matched = append(matched, schema)
+	}
+	sort.Slice(matched, func(i, j int) bool { return newer(matched[i], matched[j]) })
+	if filters.Limit > 0 && len(matched) > filters.Limit {
+		matched = matched[:filters.Limit]
+	}
+	return matched, nil
+}
+
+func TestSchemaService_ListSchemas_StableUnderConcurrentInserts(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleViewer)
+	repo := &pagedSchemaRepository{}
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	// Several schemas share a timestamp so the ID tiebreak is exercised
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	existing := make(map[uuid.UUID]bool)
+	for i := 0; i < 95; i++ {
+		schema := &domain.APISchema{
+			ID:          uuid.New(),
+			WorkspaceID: workspace.ID,
+			Visibility:  string(SchemaVisibilityInternal),
+			CreatedAt:   base.Add(time.Duration(i/3) * time.Minute),
+		}
+		require.NoError(t, repo.Create(ctx, schema))
+		existing[schema.ID] = true
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 500; i++ {
+			select {
+			case <-done:
+				return
+			default:
+				_ = repo.Create(ctx, &domain.APISchema{
+					ID:          uuid.New(),
+					WorkspaceID: workspace.ID,
+					Visibility:  string(SchemaVisibilityInternal),
+					CreatedAt:   time.Now(),
+				})
+			}
+		}
+	}()
+
+	seen := make(map[uuid.UUID]int)
+	filters := SchemaFilters{Limit: 10}
+	for {
+		page, err := service.ListSchemas(ctx, workspace.ID, actor, filters)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page.Schemas), 10)
+		for _, schema := range page.Schemas {
+			seen[schema.ID]++
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		filters.PageToken = page.NextPageToken
+	}
+	close(done)
+	wg.Wait()
+
+	for id, count := range seen {
+		assert.Equal(t, 1, count, "schema %s returned more than once", id)
+	}
+	for id := range existing {
+		assert.Contains(t, seen, id, "schema %s was skipped", id)
+	}
+}
+
+func TestSchemaService_ListSchemas_CursorSkipsHiddenSchemas(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleViewer)
+	repo := &pagedSchemaRepository{}
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	// Newest first: visible, private to someone else, visible
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	visibility := []SchemaVisibility{SchemaVisibilityInternal, SchemaVisibilityPrivate, SchemaVisibilityInternal}
+	for i, v := range visibility {
+		require.NoError(t, repo.Create(ctx, &domain.APISchema{
+			ID:          uuid.New(),
+			WorkspaceID: workspace.ID,
+			Visibility:  string(v),
+			CreatedBy:   uuid.New(),
+			CreatedAt:   base.Add(-time.Duration(i) * time.Hour),
+		}))
+	}
+
+	first, err := service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, first.Schemas, 1)
+	require.NotEmpty(t, first.NextPageToken)
+
+	second, err := service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{Limit: 2, PageToken: first.NextPageToken})
+	require.NoError(t, err)
+	assert.Len(t, second.Schemas, 1)
+	assert.Empty(t, second.NextPageToken)
+}
+
+func TestSchemaService_ListSchemas_Errors(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleViewer)
+
+	service := NewSchemaService(&pagedSchemaRepository{}, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	_, err := service.ListSchemas(ctx, workspace.ID, uuid.New(), SchemaFilters{})
+	assert.ErrorIs(t, err, domain.ErrInsufficientPermission)
+
+	for _, token := range []string{"not base64!", "e30", "eyJpIjoieCJ9"} {
+		_, err := service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{PageToken: token})
+		assert.ErrorIs(t, err, ErrInvalidPageToken, token)
+	}
+}
+
+// capturingEventPublisher records the schema events it is given
+type capturingEventPublisher struct {
+	EventPublisher
+	events []*SchemaEvent
+}
+
+func (p *capturingEventPublisher) PublishSchemaEvent(_ context.Context, event *SchemaEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+const (
+	publishableSchema = `{"openapi": "3.0.3", "info": {"title": "Pets", "version": "1.0.0"}, "paths": {}}`
+	invalidSchema     = `{"openapi": "3.0.3"}`
+)
+
+// newPublishTestService registers an OpenAPI schema owned by actor with a
+// single 1.0.0 version holding content
+func newPublishTestService(t *testing.T, actor uuid.UUID, content string, draft bool) (*SchemaService, *memorySchemaRepository, *capturingEventPublisher, *domain.APISchema) {
+	t.Helper()
+	repo := newMemorySchemaRepository()
+	schema := &domain.APISchema{
+		ID:        uuid.New(),
+		Name:      "Pets API",
+		Format:    string(SchemaFormatOpenAPI),
+		Status:    string(SchemaStatusDraft),
+		CreatedBy: actor,
+	}
+	repo.schemas[schema.ID] = schema
+	require.NoError(t, repo.CreateVersion(context.Background(), &domain.APISchemaVersion{
+		ID: uuid.New(), SchemaID: schema.ID, Version: "1.0.0", Content: content, IsDraft: draft,
+	}))
+
+	events := &capturingEventPublisher{}
+	service := NewSchemaService(repo, nil, nil, nil, nil, nil, nil, events, noopCache{},
+		nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return service, repo, events, schema
+}
+
+// addDependency makes schema depend on a new schema with a 2.0.0 version,
+// pinned to pin
+func addDependency(repo *memorySchemaRepository, schema *domain.APISchema, pin string, published bool) {
+	dependency := &domain.APISchema{ID: uuid.New(), Name: "Shared Types", Format: string(SchemaFormatOpenAPI)}
+	repo.schemas[dependency.ID] = dependency
+	repo.versions[dependency.ID] = []*domain.APISchemaVersion{{
+		ID: uuid.New(), SchemaID: dependency.ID, Version: "2.0.0", Content: publishableSchema, IsPublished: published,
+	}}
+	repo.dependencies[schema.ID] = append(repo.dependencies[schema.ID], &SchemaDependency{
+		ID: uuid.New(), SchemaID: schema.ID, DependsOnID: dependency.ID, Version: pin, Path: "#/components/schemas/Shared",
+	})
+}
+
+func publishBlockerCodes(t *testing.T, err error) []string {
+	t.Helper()
+	assert.ErrorIs(t, err, ErrSchemaNotPublishable)
+	var blocked *PublishBlockedError
+	require.ErrorAs(t, err, &blocked)
+	codes := make([]string, len(blocked.Blockers))
+	for i, blocker := range blocked.Blockers {
+		codes[i] = blocker.Code
+	}
+	return codes
+}
+
+func TestSchemaService_PublishVersion(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	service, repo, events, schema := newPublishTestService(t, actor, publishableSchema, false)
+	addDependency(repo, schema, "2.0.0", true)
+
+	version, err := service.PublishVersion(ctx, PublishVersionRequest{SchemaID: schema.ID, Version: "1.0.0", PublishedBy: actor})
+	require.NoError(t, err)
+
+	assert.True(t, version.IsPublished)
+	require.NotNil(t, version.PublishedAt)
+	assert.Equal(t, "valid", version.ValidationStatus)
+	stored, err := repo.GetVersion(ctx, schema.ID, "1.0.0")
+	require.NoError(t, err)
+	assert.True(t, stored.IsPublished)
+
+	assert.Equal(t, string(SchemaStatusPublished), repo.schemas[schema.ID].Status)
+	assert.Equal(t, 1, repo.schemas[schema.ID].PublishedVersions)
+	assert.NotNil(t, repo.schemas[schema.ID].LastPublishedAt)
+	require.Len(t, events.events, 1)
+	assert.Equal(t, SchemaEventVersionPublished, events.events[0].Type)
+	assert.Equal(t, version.ID, events.events[0].VersionID)
+	assert.Equal(t, string(SchemaStatusPublished), events.events[0].Status)
+
+	_, err = service.PublishVersion(ctx, PublishVersionRequest{SchemaID: schema.ID, Version: "1.0.0", PublishedBy: actor})
+	assert.ErrorIs(t, err, ErrSchemaVersionPublished)
+	assert.Len(t, events.events, 1)
+}
+
+func TestSchemaService_PublishVersion_Gates(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		draft   bool
+		setup   func(repo *memorySchemaRepository, schema *domain.APISchema)
+		want    []string
+	}{
+		{
+			name:    "invalid content",
+			content: invalidSchema, // missing info and paths
+			want:    []string{PublishBlockerValidationFailed, PublishBlockerValidationFailed},
+		},
+		{
+			name:    "draft",
+			content: publishableSchema,
+			draft:   true,
+			want:    []string{PublishBlockerDraft},
+		},
+		{
+			name:    "unpinned dependency",
+			content: publishableSchema,
+			setup: func(repo *memorySchemaRepository, schema *domain.APISchema) {
+				addDependency(repo, schema, "", true)
+				addDependency(repo, schema, "^2.0.0", true)
+			},
+			want: []string{PublishBlockerDependencyUnpinned, PublishBlockerDependencyUnpinned},
+		},
+		{
+			name:    "unpublished dependency",
+			content: publishableSchema,
+			setup: func(repo *memorySchemaRepository, schema *domain.APISchema) {
+				addDependency(repo, schema, "2.0.0", false)
+			},
+			want: []string{PublishBlockerDependencyUnpublished},
+		},
+		{
+			name:    "missing dependency version",
+			content: publishableSchema,
+			setup: func(repo *memorySchemaRepository, schema *domain.APISchema) {
+				addDependency(repo, schema, "3.0.0", true)
+			},
+			want: []string{PublishBlockerDependencyNotFound},
+		},
+		{
+			name:    "every failing gate is reported",
+			content: publishableSchema,
+			draft:   true,
+			setup: func(repo *memorySchemaRepository, schema *domain.APISchema) {
+				addDependency(repo, schema, "latest", true)
+			},
+			want: []string{PublishBlockerDraft, PublishBlockerDependencyUnpinned},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			actor := uuid.New()
+			service, repo, events, schema := newPublishTestService(t, actor, tt.content, tt.draft)
+			if tt.setup != nil {
+				tt.setup(repo, schema)
+			}
+
+			_, err := service.PublishVersion(ctx, PublishVersionRequest{SchemaID: schema.ID, Version: "1.0.0", PublishedBy: actor})
+			codes := publishBlockerCodes(t, err)
+			assert.Equal(t, tt.want, codes)
+
+			version, err := repo.GetVersion(ctx, schema.ID, "1.0.0")
+			require.NoError(t, err)
+			assert.False(t, version.IsPublished)
+			assert.Equal(t, string(SchemaStatusDraft), repo.schemas[schema.ID].Status)
+			assert.Empty(t, events.events)
+		})
+	}
+}
+
+func TestSchemaService_PublishVersion_RequiresPermission(t *testing.T) {
+	service, _, _, schema := newPublishTestService(t, uuid.New(), publishableSchema, false)
+	service.workspaceRepo = failingWorkspaceRepository{}
+
+	_, err := service.PublishVersion(context.Background(), PublishVersionRequest{SchemaID: schema.ID, Version: "1.0.0", PublishedBy: uuid.New()})
+	assert.ErrorIs(t, err, domain.ErrInsufficientPermission)
+}
+
+func TestSchemaService_ListSchemas_CacheInvalidatedByMutations(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	workspace := newTestWorkspace(actor, domain.WorkspaceRoleDeveloper)
+	repo := newMemorySchemaRepository()
+	cache := NewMemoryCacheManager()
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, cache, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	create := func(name string) *domain.APISchema {
+		schema, err := service.CreateSchema(ctx, CreateSchemaRequest{
+			WorkspaceID: workspace.ID,
+			Name:        name,
+			Slug:        strings.ToLower(name),
+			Format:      SchemaFormatOpenAPI,
+			Content:     `{"openapi": "3.0.3"}`,
+			Version:     "1.0.0",
+			CreatedBy:   actor,
+		})
+		require.NoError(t, err)
+		return schema
+	}
+	names := func(page *SchemaPage) []string {
+		var names []string
+		for _, schema := range page.Schemas {
+			names = append(names, schema.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	create("Pets")
+	page, err := service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pets"}, names(page))
+
+	// Writes that bypass the service are not seen while the list is cached
+	require.NoError(t, repo.Create(ctx, &domain.APISchema{
+		ID: uuid.New(), WorkspaceID: workspace.ID, Name: "Orders", CreatedBy: actor, CreatedAt: time.Now(),
+	}))
+	page, err = service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pets"}, names(page))
+
+	create("Users")
+	page, err = service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Orders", "Pets", "Users"}, names(page))
+
+	// Updating a schema clears the list as well
+	require.NoError(t, repo.Create(ctx, &domain.APISchema{
+		ID: uuid.New(), WorkspaceID: workspace.ID, Name: "Billing", CreatedBy: actor, CreatedAt: time.Now(),
+	}))
+	pets, err := repo.GetByName(ctx, workspace.ID, "Pets")
+	require.NoError(t, err)
+	_, err = service.CreateSchemaVersion(ctx, CreateVersionRequest{
+		SchemaID: pets.ID, Version: "1.1.0", Content: `{"openapi": "3.0.3", "paths": {}}`, CreatedBy: actor,
+	})
+	require.NoError(t, err)
+	page, err = service.ListSchemas(ctx, workspace.ID, actor, SchemaFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Billing", "Orders", "Pets", "Users"}, names(page))
+}
+
+func TestSchemaService_ClearSchemaListCaches_ScopedToWorkspace(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCacheManager()
+	service := NewSchemaService(newMemorySchemaRepository(), nil, nil, nil, nil, nil, nil, nil, cache, nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	cleared, kept := uuid.New(), uuid.New()
+	clearedKey := schemaListCacheKey(cleared, uuid.New(), SchemaFilters{Limit: 10})
+	keptKey := schemaListCacheKey(kept, uuid.New(), SchemaFilters{Limit: 10})
+	require.NoError(t, cache.Set(ctx, clearedKey, &SchemaPage{}, time.Minute))
+	require.NoError(t, cache.Set(ctx, keptKey, &SchemaPage{}, time.Minute))
+
+	service.clearSchemaListCaches(ctx, cleared)
+
+	_, err := cache.Get(ctx, clearedKey)
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	_, err = cache.Get(ctx, keptKey)
+	assert.NoError(t, err)
+}
+
+func TestSchemaService_UpdateSchema_RejectsStaleWrite(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.New()
+	readAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := newMemorySchemaRepository()
+	schema := &domain.APISchema{
+		ID:          uuid.New(),
+		Name:        "Pets API",
+		Description: "Manage pets",
+		CreatedBy:   owner,
+		UpdatedAt:   readAt,
+	}
+	repo.schemas[schema.ID] = schema
+	service := NewSchemaService(repo, nil, nil, nil, nil, nil, nil, nil, noopCache{},
+		nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	// Two editors read the schema at readAt and save independently
+	firstDescription := "Manage pets and their owners"
+	first, err := service.UpdateSchema(ctx, UpdateSchemaRequest{
+		ID:                schema.ID,
+		Description:       &firstDescription,
+		UpdatedBy:         owner,
+		ExpectedUpdatedAt: &readAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, firstDescription, first.Description)
+	assert.True(t, first.UpdatedAt.After(readAt))
+
+	secondName := "Pet Store API"
+	_, err = service.UpdateSchema(ctx, UpdateSchemaRequest{
+		ID:                schema.ID,
+		Name:              &secondName,
+		UpdatedBy:         owner,
+		ExpectedUpdatedAt: &readAt,
+	})
+	require.ErrorIs(t, err, ErrSchemaConflict)
+	var conflict *SchemaConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, first.UpdatedAt, conflict.Current.UpdatedAt)
+	assert.Equal(t, firstDescription, conflict.Current.Description)
+
+	stored, err := repo.GetByID(ctx, schema.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Pets API", stored.Name, "the rejected update must not be written")
+	assert.Equal(t, firstDescription, stored.Description)
+
+	// Retrying against the current record succeeds
+	merged, err := service.UpdateSchema(ctx, UpdateSchemaRequest{
+		ID:                schema.ID,
+		Name:              &secondName,
+		UpdatedBy:         owner,
+		ExpectedUpdatedAt: &conflict.Current.UpdatedAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, secondName, merged.Name)
+	assert.Equal(t, firstDescription, merged.Description)
+}
+
+// interleavingSchemaRepository runs beforeWrite once, just before the
+// service's conditional write, to stand in for a concurrent update
+type interleavingSchemaRepository struct {
+	*memorySchemaRepository
+	beforeWrite func()
+}
+
+func (r *interleavingSchemaRepository) UpdateIfUnmodified(ctx context.Context, schema *domain.APISchema, expectedUpdatedAt time.Time) error {
+	if hook := r.beforeWrite; hook != nil {
+		r.beforeWrite = nil
+		hook()
+	}
+	return r.memorySchemaRepository.UpdateIfUnmodified(ctx, schema, expectedUpdatedAt)
+}
+
+func TestSchemaService_UpdateSchema_ConflictsWithWriteAfterRead(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.New()
+	readAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	theirsAt := readAt.Add(time.Second)
+	repo := &interleavingSchemaRepository{memorySchemaRepository: newMemorySchemaRepository()}
+	schema := &domain.APISchema{
+		ID:          uuid.New(),
+		Name:        "Pets API",
+		Description: "Manage pets",
+		CreatedBy:   owner,
+		UpdatedAt:   readAt,
+	}
+	repo.schemas[schema.ID] = schema
+	service := NewSchemaService(repo, nil, nil, nil, nil, nil, nil, nil, noopCache{},
+		nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	for name, expected := range map[string]*time.Time{"with precondition": &readAt, "without precondition": nil} {
+		t.Run(name, func(t *testing.T) {
+			repo.schemas[schema.ID] = schema
+			// Another editor's save lands after UpdateSchema has read and
+			// checked the schema but before it writes
+			repo.beforeWrite = func() {
+				theirs := *schema
+				theirs.Description = "Manage pets and their owners"
+				theirs.UpdatedAt = theirsAt
+				require.NoError(t, repo.Update(ctx, &theirs))
+			}
+
+			newName := "Pet Store API"
+			_, err := service.UpdateSchema(ctx, UpdateSchemaRequest{
+				ID:                schema.ID,
+				Name:              &newName,
+				UpdatedBy:         owner,
+				ExpectedUpdatedAt: expected,
+			})
+			require.ErrorIs(t, err, ErrSchemaConflict)
+			var conflict *SchemaConflictError
+			require.ErrorAs(t, err, &conflict)
+			assert.Equal(t, theirsAt, conflict.Current.UpdatedAt)
+
+			stored, err := repo.GetByID(ctx, schema.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Pets API", stored.Name, "the conflicting update must not be written")
+			assert.Equal(t, "Manage pets and their owners", stored.Description)
+		})
+	}
+}
+
+// stubSchemaTransformer returns an empty successful result
+type stubSchemaTransformer struct {
+	SchemaTransformer
+}
+
+func (stubSchemaTransformer) TransformSchema(context.Context, *TransformationRequest) (*TransformationResult, error) {
+	return &TransformationResult{Success: true}, nil
+}
+
+// stubDocumentationGenerator returns an empty successful result
+type stubDocumentationGenerator struct {
+	DocumentationGenerator
+}
+
+func (stubDocumentationGenerator) GenerateDocumentation(context.Context, *DocumentationRequest) (*DocumentationResult, error) {
+	return &DocumentationResult{Success: true}, nil
+}
+
+func (stubDocumentationGenerator) GenerateSDK(context.Context, *SDKGenerationRequest) (*SDKResult, error) {
+	return &SDKResult{Success: true}, nil
+}
+
+func withTestIdentity(userID uuid.UUID) context.Context {
+	return svcauth.WithIdentity(context.Background(), svcauth.Identity{UserID: userID.String()})
+}
+
+func TestSchemaService_TransformAndDocumentUseAuthenticatedUser(t *testing.T) {
+	owner, teammate := uuid.New(), uuid.New()
+	workspace := newTestWorkspace(owner, domain.WorkspaceRoleDeveloper)
+	workspace.Members = append(workspace.Members, domain.WorkspaceMember{
+		WorkspaceID: workspace.ID, UserID: teammate, Role: domain.WorkspaceRoleDeveloper, IsActive: true,
+	})
+	repo := newMemorySchemaRepository()
+	schema := &domain.APISchema{
+		ID:          uuid.New(),
+		WorkspaceID: workspace.ID,
+		Name:        "Payroll API",
+		Visibility:  string(SchemaVisibilityPrivate),
+		CreatedBy:   owner,
+	}
+	repo.schemas[schema.ID] = schema
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil, nil,
+		stubSchemaTransformer{}, stubDocumentationGenerator{}, nil, noopCache{}, nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	transform := TransformationRequest{
+		Content: `{"openapi": "3.0.3"}`,
+		Format:  SchemaFormatOpenAPI,
+		Context: TransformationContext{WorkspaceID: workspace.ID, SchemaID: &schema.ID},
+	}
+	document := DocumentationRequest{SchemaID: schema.ID, Format: DocumentationFormatHTML}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{"owner", withTestIdentity(owner), nil},
+		{"teammate cannot see private schema", withTestIdentity(teammate), domain.ErrInsufficientPermission},
+		{"outsider", withTestIdentity(uuid.New()), domain.ErrInsufficientPermission},
+		{"no identity", context.Background(), ErrUnauthenticated},
+		{"non-UUID identity", svcauth.WithIdentity(context.Background(), svcauth.Identity{UserID: "user_123"}), ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformed, err := service.TransformSchema(tt.ctx, transform)
+			documented, docErr := service.GenerateDocumentation(tt.ctx, document)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NoError(t, docErr)
+				assert.True(t, transformed.Success)
+				assert.True(t, documented.Success)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, docErr, tt.wantErr)
+		})
+	}
+}
+
+func TestSchemaService_EnforcesWorkspaceQuotas(t *testing.T) {
+	owner := uuid.New()
+	workspace := newTestWorkspace(owner, domain.WorkspaceRoleDeveloper)
+	repo := newMemorySchemaRepository()
+	schema := &domain.APISchema{ID: uuid.New(), WorkspaceID: workspace.ID, Name: "Pets API", CreatedBy: owner}
+	repo.schemas[schema.ID] = schema
+
+	service := NewSchemaService(repo, nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, stubDocumentationGenerator{}, nil, noopCache{}, nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	service.SetQuotaLimits(QuotaLimits{
+		QuotaSchemaValidation: {Limit: 2, Window: time.Minute},
+		QuotaSDKGeneration:    {Limit: 1, Window: time.Hour},
+	})
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	service.quota.Load().now = func() time.Time { return clock }
+	ctx := withTestIdentity(owner)
+
+	validation := ValidationRequest{
+		Content: publishableSchema,
+		Format:  SchemaFormatOpenAPI,
+		Context: ValidationContext{WorkspaceID: workspace.ID},
+	}
+	for i := 0; i < 2; i++ {
+		_, err := service.ValidateSchema(ctx, validation)
+		require.NoError(t, err, "validation %d", i+1)
+	}
+	_, err := service.ValidateSchema(ctx, validation)
+	var exceeded *QuotaExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, QuotaSchemaValidation, exceeded.Operation)
+	assert.Equal(t, time.Minute, exceeded.RetryAfter)
+
+	sdk := SDKGenerationRequest{SchemaID: schema.ID, Language: SDKLanguageGo}
+	_, err = service.GenerateSDK(ctx, sdk)
+	require.NoError(t, err)
+	_, err = service.GenerateSDK(ctx, sdk)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+
+	clock = clock.Add(time.Minute)
+	_, err = service.ValidateSchema(ctx, validation)
+	assert.NoError(t, err, "the validation quota resets after its window")
+	_, err = service.GenerateSDK(ctx, sdk)
+	assert.ErrorIs(t, err, ErrQuotaExceeded, "the SDK quota window is still open")
+}
+
+func TestSchemaService_ValidateSchemaRequiresWorkspaceMembership(t *testing.T) {
+	owner, outsider := uuid.New(), uuid.New()
+	workspace := newTestWorkspace(owner, domain.WorkspaceRoleViewer)
+	service := NewSchemaService(newMemorySchemaRepository(), nil, singleWorkspaceRepository{workspace: workspace}, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, noopCache{}, nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	service.SetQuotaLimits(QuotaLimits{QuotaSchemaValidation: {Limit: 1, Window: time.Minute}})
+
+	validation := ValidationRequest{
+		Content: publishableSchema,
+		Format:  SchemaFormatOpenAPI,
+		Context: ValidationContext{WorkspaceID: workspace.ID},
+	}
+
+	// A non-member naming the workspace can neither validate against it nor
+	// use up its quota
+	_, err := service.ValidateSchema(withTestIdentity(outsider), validation)
+	assert.ErrorIs(t, err, domain.ErrInsufficientPermission)
+	_, err = service.ValidateSchema(context.Background(), validation)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	_, err = service.ValidateSchema(withTestIdentity(owner), validation)
+	require.NoError(t, err)
+	_, err = service.ValidateSchema(withTestIdentity(owner), validation)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+}
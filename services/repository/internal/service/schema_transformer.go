@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Transformation types supported by DefaultSchemaTransformer
+const (
+	TransformationTypeDereference = "dereference" // inline every $ref into a self-contained document
+)
+
+// SchemaDocumentFetcher retrieves external documents referenced by $ref
+type SchemaDocumentFetcher interface {
+	Fetch(ctx context.Context, uri string) ([]byte, error)
+}
+
+// DefaultSchemaTransformer implements SchemaTransformer for JSON/YAML based
+// schema formats (OpenAPI, JSON Schema)
+type DefaultSchemaTransformer struct {
+	fetcher SchemaDocumentFetcher
+	logger  *slog.Logger
+}
+
+// NewDefaultSchemaTransformer creates a new schema transformer. The fetcher is
+// optional; without it remote $refs are reported as unresolvable.
+func NewDefaultSchemaTransformer(fetcher SchemaDocumentFetcher, logger *slog.Logger) *DefaultSchemaTransformer {
+	return &DefaultSchemaTransformer{
+		fetcher: fetcher,
+		logger:  logger.With("component", "schema_transformer"),
+	}
+}
+
+// TransformSchema applies the requested transformations in order
+func (t *DefaultSchemaTransformer) TransformSchema(ctx context.Context, req *TransformationRequest) (*TransformationResult, error) {
+	start := time.Now()
+
+	doc, isYAML, err := parseSchemaDocument(req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	result := &TransformationResult{
+		Success:  true,
+		Metadata: make(map[string]interface{}),
+	}
+
+	for _, transformation := range req.Transformations {
+		applied := AppliedTransformation{Transformation: transformation}
+
+		switch transformation.Type {
+		case TransformationTypeDereference:
+			resolver := newRefResolver(ctx, t.fetcher)
+			doc = resolver.dereference(doc)
+			applied.Success = true
+			applied.Changes = append(applied.Changes, fmt.Sprintf("inlined %d $ref(s)", resolver.inlined))
+			result.Warnings = append(result.Warnings, resolver.warnings...)
+			result.Metadata["inlined_refs"] = resolver.inlined
+		default:
+			applied.Error = fmt.Sprintf("unsupported transformation type %q", transformation.Type)
+			result.Success = false
+			result.Errors = append(result.Errors, TransformationError{
+				Transformation: transformation.Type,
+				Code:           "UNSUPPORTED_TRANSFORMATION",
+				Message:        applied.Error,
+				Path:           transformation.Target,
+			})
+		}
+
+		result.AppliedTransformations = append(result.AppliedTransformations, applied)
+	}
+
+	if !req.Options.DryRun {
+		content, err := encodeSchemaDocument(doc, isYAML)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode schema: %w", err)
+		}
+		result.TransformedContent = content
+	}
+
+	result.TransformedAt = time.Now()
+	result.Duration = time.Since(start)
+
+	t.logger.DebugContext(ctx, "Schema transformed",
+		"format", req.Format, "transformations", len(req.Transformations), "warnings", len(result.Warnings))
+
+	return result, nil
+}
+
+// ConvertFormat converts a schema between formats. Only OpenAPI to GraphQL
+// is supported.
+func (t *DefaultSchemaTransformer) ConvertFormat(ctx context.Context, schema string, from, to SchemaFormat) (*ConversionResult, error) {
+	if from != SchemaFormatOpenAPI || to != SchemaFormatGraphQL {
+		return nil, fmt.Errorf("%w: conversion from %s to %s is not supported", ErrSchemaTransformationFailed, from, to)
+	}
+
+	start := time.Now()
+	parsed, _, err := parseSchemaDocument(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	result := &ConversionResult{
+		FromFormat: from,
+		ToFormat:   to,
+		Errors:     []ConversionError{},
+		Warnings:   []ConversionWarning{},
+	}
+
+	doc, ok := parsed.(map[string]interface{})
+	if version, _ := doc["openapi"].(string); !ok || !strings.HasPrefix(version, "3.") {
+		result.Errors = append(result.Errors, ConversionError{
+			Code:     "UNSUPPORTED_DOCUMENT",
+			Message:  "only OpenAPI 3.x documents can be converted",
+			Path:     "/openapi",
+			Severity: "error",
+		})
+	} else {
+		converter := newOpenAPIToGraphQL(doc)
+		result.ConvertedContent = converter.convert()
+		result.Warnings = append(result.Warnings, converter.warnings...)
+		result.Success = true
+	}
+
+	result.ConvertedAt = time.Now()
+	result.Duration = time.Since(start)
+
+	t.logger.DebugContext(ctx, "Schema converted",
+		"from", from, "to", to, "success", result.Success, "warnings", len(result.Warnings))
+
+	return result, nil
+}
+
+// MergeSchemas merges OpenAPI 3.x schemas into one, in order. Paths and
+// components defined differently by two schemas are reported as conflicts.
+// With MergeStrategyUnion any conflict leaves the merge unresolved and no
+// content is produced; with MergeStrategyOverride later schemas win. The
+// merged content is YAML when the first schema is.
+func (t *DefaultSchemaTransformer) MergeSchemas(ctx context.Context, schemas []string, format SchemaFormat, strategy MergeStrategy) (*MergeResult, error) {
+	if format != SchemaFormatOpenAPI {
+		return nil, fmt.Errorf("%w: merging %s schemas is not supported", ErrSchemaTransformationFailed, format)
+	}
+	if strategy != MergeStrategyUnion && strategy != MergeStrategyOverride {
+		return nil, fmt.Errorf("%w: merge strategy %q is not supported", ErrSchemaTransformationFailed, strategy)
+	}
+	if len(schemas) == 0 {
+		return nil, fmt.Errorf("%w: no schemas to merge", ErrSchemaTransformationFailed)
+	}
+
+	start := time.Now()
+	docs := make([]map[string]interface{}, len(schemas))
+	asYAML := false
+	for i, schema := range schemas {
+		parsed, isYAML, err := parseSchemaDocument(schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schema %d: %w", i+1, err)
+		}
+		doc, ok := parsed.(map[string]interface{})
+		if version, _ := doc["openapi"].(string); !ok || !strings.HasPrefix(version, "3.") {
+			return nil, fmt.Errorf("%w: schema %d is not an OpenAPI 3.x document", ErrSchemaTransformationFailed, i+1)
+		}
+		if i == 0 {
+			asYAML = isYAML
+		}
+		docs[i] = doc
+	}
+
+	merger := newOpenAPIMerger(strategy)
+	merged := merger.merge(docs)
+
+	result := &MergeResult{
+		Strategy:    strategy,
+		Conflicts:   []MergeConflict{},
+		Resolutions: merger.resolutions(),
+	}
+	for _, conflict := range merger.conflicts {
+		result.Conflicts = append(result.Conflicts, *conflict)
+	}
+	if strategy == MergeStrategyOverride || len(result.Conflicts) == 0 {
+		content, err := encodeSchemaDocument(merged, asYAML)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode merged schema: %w", err)
+		}
+		result.MergedContent = content
+		result.Success = true
+	}
+
+	result.MergedAt = time.Now()
+	result.Duration = time.Since(start)
+
+	t.logger.DebugContext(ctx, "Schemas merged",
+		"format", format, "schemas", len(schemas), "strategy", strategy, "conflicts", len(result.Conflicts), "success", result.Success)
+
+	return result, nil
+}
+
+// ExtractComponents extracts the models, enums, endpoints, references and
+// extensions of an OpenAPI 3.x schema. Endpoints include their resolved
+// parameters, request body, responses and effective security requirements.
+func (t *DefaultSchemaTransformer) ExtractComponents(ctx context.Context, schema string, format SchemaFormat) (*ComponentsResult, error) {
+	if format != SchemaFormatOpenAPI {
+		return nil, fmt.Errorf("%w: component extraction for %s is not supported", ErrSchemaTransformationFailed, format)
+	}
+
+	parsed, _, err := parseSchemaDocument(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	doc, ok := parsed.(map[string]interface{})
+	if version, _ := doc["openapi"].(string); !ok || !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("%w: only OpenAPI 3.x documents are supported", ErrSchemaTransformationFailed)
+	}
+
+	components := newOpenAPIComponentExtractor(doc).extract()
+	info, _ := doc["info"].(map[string]interface{})
+	result := &ComponentsResult{
+		Success:    true,
+		Components: components,
+		Metadata: map[string]interface{}{
+			"openapi":    doc["openapi"],
+			"title":      schemaString(info, "title"),
+			"version":    schemaString(info, "version"),
+			"models":     len(components.Models),
+			"endpoints":  len(components.Endpoints),
+			"enums":      len(components.Enums),
+			"references": len(components.References),
+		},
+		ExtractedAt: time.Now(),
+	}
+
+	t.logger.DebugContext(ctx, "Components extracted",
+		"format", format, "models", len(components.Models), "endpoints", len(components.Endpoints),
+		"enums", len(components.Enums), "references", len(components.References))
+
+	return result, nil
+}
+
+// refResolver inlines $refs, tracking the documents and locations currently
+// being expanded so cyclic references terminate
+type refResolver struct {
+	ctx      context.Context
+	fetcher  SchemaDocumentFetcher
+	docs     map[string]interface{}
+	active   map[string]bool
+	warnings []TransformationWarning
+	inlined  int
+}
+
+func newRefResolver(ctx context.Context, fetcher SchemaDocumentFetcher) *refResolver {
+	return &refResolver{
+		ctx:     ctx,
+		fetcher: fetcher,
+		docs:    make(map[string]interface{}),
+		active:  make(map[string]bool),
+	}
+}
+
+// dereference returns a copy of the root document with all resolvable $refs inlined
+func (r *refResolver) dereference(root interface{}) interface{} {
+	r.docs[""] = root
+	return r.resolve(root, "", "")
+}
+
+// resolve walks node, which lives in document docURI at JSON pointer location
+func (r *refResolver) resolve(node interface{}, docURI, location string) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			return r.resolveRef(v, ref, docURI, location)
+		}
+
+		key := docURI + "#" + location
+		r.active[key] = true
+		defer delete(r.active, key)
+
+		out := make(map[string]interface{}, len(v))
+		for k, child := range v {
+			out[k] = r.resolve(child, docURI, location+"/"+escapeJSONPointer(k))
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, child := range v {
+			out[i] = r.resolve(child, docURI, fmt.Sprintf("%s/%d", location, i))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// resolveRef replaces a $ref node with the (recursively resolved) target
+func (r *refResolver) resolveRef(node map[string]interface{}, ref, docURI, location string) interface{} {
+	refDoc, pointer, _ := strings.Cut(ref, "#")
+
+	targetURI := docURI
+	if refDoc != "" {
+		resolved, err := resolveDocumentURI(docURI, refDoc)
+		if err != nil {
+			r.warn(location, ref, fmt.Sprintf("invalid reference: %v", err))
+			return node
+		}
+		targetURI = resolved
+	}
+
+	doc, err := r.document(targetURI)
+	if err != nil {
+		r.warn(location, ref, fmt.Sprintf("unresolvable reference: %v", err))
+		return node
+	}
+
+	key := targetURI + "#" + pointer
+	if r.isCyclic(key) {
+		r.warn(location, ref, "cyclic reference left in place")
+		return node
+	}
+
+	target, err := lookupJSONPointer(doc, pointer)
+	if err != nil {
+		r.warn(location, ref, fmt.Sprintf("unresolvable reference: %v", err))
+		return node
+	}
+
+	r.active[key] = true
+	resolved := r.resolve(target, targetURI, pointer)
+	delete(r.active, key)
+	r.inlined++
+
+	// Keep sibling keywords (allowed next to $ref in OpenAPI 3.1 / JSON Schema 2019-09+)
+	if len(node) > 1 {
+		if m, ok := resolved.(map[string]interface{}); ok {
+			for k, v := range node {
+				if k != "$ref" {
+					m[k] = r.resolve(v, docURI, location+"/"+escapeJSONPointer(k))
+				}
+			}
+		}
+	}
+
+	return resolved
+}
+
+// isCyclic reports whether key points at, or above, a location currently being expanded
+func (r *refResolver) isCyclic(key string) bool {
+	for active := range r.active {
+		if active == key || strings.HasPrefix(active, key+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// document returns the parsed document for uri, fetching it when needed
+func (r *refResolver) document(uri string) (interface{}, error) {
+	if doc, ok := r.docs[uri]; ok {
+		return doc, nil
+	}
+	if r.fetcher == nil {
+		return nil, fmt.Errorf("no fetcher configured for %s", uri)
+	}
+
+	data, err := r.fetcher.Fetch(r.ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	doc, _, err := parseSchemaDocument(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", uri, err)
+	}
+
+	r.docs[uri] = doc
+	return doc, nil
+}
+
+func (r *refResolver) warn(location, ref, message string) {
+	if location == "" {
+		location = "/"
+	}
+	r.warnings = append(r.warnings, TransformationWarning{
+		Transformation: TransformationTypeDereference,
+		Message:        fmt.Sprintf("%s: %s", ref, message),
+		Path:           location,
+		Suggestion:     "Ensure the referenced document is reachable and the JSON pointer exists",
+	})
+}
+
+// resolveDocumentURI resolves ref relative to the referencing document's URI
+func resolveDocumentURI(base, ref string) (string, error) {
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	if base == "" {
+		return refURL.String(), nil
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	return baseURL.ResolveReference(refURL).String(), nil
+}
+
+// lookupJSONPointer resolves an RFC 6901 JSON pointer against doc
+func lookupJSONPointer(doc interface{}, pointer string) (interface{}, error) {
+	if pointer == "" || pointer == "/" {
+		return doc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	current := doc
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = unescapeJSONPointer(token)
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("pointer %q not found", pointer)
+			}
+			current = next
+		case []interface{}:
+			var index int
+			if _, err := fmt.Sscanf(token, "%d", &index); err != nil || index < 0 || index >= len(v) {
+				return nil, fmt.Errorf("pointer %q not found", pointer)
+			}
+			current = v[index]
+		default:
+			return nil, fmt.Errorf("pointer %q not found", pointer)
+		}
+	}
+
+	return current, nil
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func unescapeJSONPointer(token string) string {
+	if decoded, err := url.PathUnescape(token); err == nil {
+		token = decoded
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+// parseSchemaDocument parses JSON or YAML schema content into generic values
+func parseSchemaDocument(content string) (interface{}, bool, error) {
+	var doc interface{}
+	trimmed := strings.TrimSpace(content)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &doc); err == nil {
+			return doc, false, nil
+		}
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, false, err
+	}
+	return normalizeYAML(doc), true, nil
+}
+
+// normalizeYAML converts YAML-decoded values into JSON-compatible values
+func normalizeYAML(node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = normalizeYAML(child)
+		}
+		return v
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, child := range v {
+			out[fmt.Sprint(k)] = normalizeYAML(child)
+		}
+		return out
+	case []interface{}:
+		for i, child := range v {
+			v[i] = normalizeYAML(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// encodeSchemaDocument serializes a document back to JSON or YAML
+func encodeSchemaDocument(doc interface{}, asYAML bool) (string, error) {
+	if asYAML {
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,470 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapDocumentFetcher map[string]string
+
+func (f mapDocumentFetcher) Fetch(_ context.Context, uri string) ([]byte, error) {
+	doc, ok := f[uri]
+	if !ok {
+		return nil, fmt.Errorf("document %s not found", uri)
+	}
+	return []byte(doc), nil
+}
+
+func newTestSchemaTransformer(fetcher SchemaDocumentFetcher) *DefaultSchemaTransformer {
+	return NewDefaultSchemaTransformer(fetcher, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func dereference(t *testing.T, transformer *DefaultSchemaTransformer, content string) (map[string]interface{}, *TransformationResult) {
+	t.Helper()
+	result, err := transformer.TransformSchema(context.Background(), &TransformationRequest{
+		Content:         content,
+		Format:          SchemaFormatOpenAPI,
+		Transformations: []Transformation{{Type: TransformationTypeDereference}},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result.TransformedContent), &doc))
+	return doc, result
+}
+
+func TestSchemaTransformer_Dereference_InlinesLocalRef(t *testing.T) {
+	content := `{
+		"openapi": "3.0.3",
+		"paths": {"/pets": {"get": {"responses": {"200": {"description": "ok",
+			"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}}}}},
+		"components": {"schemas": {"Pet": {"type": "object", "properties": {"name": {"type": "string"}}}}}
+	}`
+
+	doc, result := dereference(t, newTestSchemaTransformer(nil), content)
+
+	schema, err := lookupJSONPointer(doc, "/paths/~1pets/get/responses/200/content/application~1json/schema")
+	require.NoError(t, err)
+	assert.Equal(t, "object", schema.(map[string]interface{})["type"])
+	assert.NotContains(t, schema, "$ref")
+	assert.Empty(t, result.Warnings)
+	assert.Equal(t, 1, result.Metadata["inlined_refs"])
+}
+
+func TestSchemaTransformer_Dereference_CyclicRefTerminates(t *testing.T) {
+	content := `
+openapi: 3.0.3
+paths: {}
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+`
+	result, err := newTestSchemaTransformer(nil).TransformSchema(context.Background(), &TransformationRequest{
+		Content:         content,
+		Format:          SchemaFormatOpenAPI,
+		Transformations: []Transformation{{Type: TransformationTypeDereference}},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, result.TransformedContent, "$ref: '#/components/schemas/Node'")
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0].Message, "cyclic")
+	assert.Equal(t, "/components/schemas/Node/properties/children/items", result.Warnings[0].Path)
+}
+
+func TestSchemaTransformer_Dereference_RemoteRefs(t *testing.T) {
+	fetcher := mapDocumentFetcher{
+		"https://schemas.example.com/common.json": `{"definitions": {"Id": {"type": "string", "format": "uuid"}}}`,
+	}
+	content := `{
+		"type": "object",
+		"properties": {
+			"id": {"$ref": "https://schemas.example.com/common.json#/definitions/Id"},
+			"owner": {"$ref": "https://schemas.example.com/missing.json#/definitions/User"}
+		}
+	}`
+
+	doc, result := dereference(t, newTestSchemaTransformer(fetcher), content)
+
+	id, err := lookupJSONPointer(doc, "/properties/id")
+	require.NoError(t, err)
+	assert.Equal(t, "uuid", id.(map[string]interface{})["format"])
+
+	owner, err := lookupJSONPointer(doc, "/properties/owner")
+	require.NoError(t, err)
+	assert.Equal(t, "https://schemas.example.com/missing.json#/definitions/User", owner.(map[string]interface{})["$ref"])
+
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0].Message, "unresolvable reference")
+	assert.Equal(t, "/properties/owner", result.Warnings[0].Path)
+}
+
+const petsServiceSpec = `openapi: 3.0.3
+info:
+  title: Pets service
+  version: 1.0.0
+tags:
+  - name: pets
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: OK
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+const ownersServiceSpec = `{
+  "openapi": "3.0.3",
+  "info": {"title": "Owners service", "version": "2.0.0"},
+  "tags": [{"name": "owners"}, {"name": "pets"}],
+  "paths": {
+    "/pets": {
+      "post": {"operationId": "createPet", "responses": {"201": {"description": "Created"}}}
+    },
+    "/owners": {
+      "get": {"operationId": "listOwners", "responses": {"200": {"description": "OK"}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Pet": {"type": "object", "properties": {"name": {"type": "string"}}},
+      "Owner": {"type": "object"}
+    }
+  }
+}`
+
+func TestSchemaTransformer_MergeSchemas_Union(t *testing.T) {
+	result, err := newTestSchemaTransformer(nil).MergeSchemas(context.Background(),
+		[]string{petsServiceSpec, ownersServiceSpec}, SchemaFormatOpenAPI, MergeStrategyUnion)
+	require.NoError(t, err)
+
+	assert.True(t, result.Success)
+	assert.Empty(t, result.Conflicts)
+	assert.Equal(t, MergeStrategyUnion, result.Strategy)
+
+	doc, _, err := parseSchemaDocument(result.MergedContent)
+	require.NoError(t, err)
+	for _, pointer := range []string{"/paths/~1pets/get", "/paths/~1pets/post", "/paths/~1owners/get", "/components/schemas/Pet", "/components/schemas/Owner"} {
+		_, err := lookupJSONPointer(doc, pointer)
+		assert.NoError(t, err, pointer)
+	}
+	title, _ := lookupJSONPointer(doc, "/info/title")
+	assert.Equal(t, "Pets service", title, "the first schema's info is kept")
+	tags, _ := lookupJSONPointer(doc, "/tags")
+	assert.Len(t, tags, 2)
+
+	// The first schema is YAML, so the merged schema is too
+	assert.Contains(t, result.MergedContent, "openapi: 3.0.3")
+}
+
+func TestSchemaTransformer_MergeSchemas_Conflicts(t *testing.T) {
+	conflicting := `openapi: 3.0.3
+info:
+  title: Legacy pets
+  version: 0.1.0
+paths:
+  /pets:
+    get:
+      operationId: listAllPets
+      responses:
+        "200":
+          description: Every pet
+components:
+  schemas:
+    Pet:
+      type: string
+`
+	result, err := newTestSchemaTransformer(nil).MergeSchemas(context.Background(),
+		[]string{petsServiceSpec, conflicting}, SchemaFormatOpenAPI, MergeStrategyUnion)
+	require.NoError(t, err)
+
+	assert.False(t, result.Success)
+	assert.Empty(t, result.MergedContent)
+	assert.Empty(t, result.Resolutions)
+	require.Len(t, result.Conflicts, 2)
+
+	naming := result.Conflicts[0]
+	assert.Equal(t, "/components/schemas/Pet", naming.Path)
+	assert.Equal(t, MergeConflictNaming, naming.Type)
+
+	structure := result.Conflicts[1]
+	assert.Equal(t, "/paths/~1pets/get", structure.Path)
+	assert.Equal(t, MergeConflictStructure, structure.Type)
+	require.Len(t, structure.Options, 2)
+	assert.Equal(t, "schema-1", structure.Options[0].ID)
+	assert.Equal(t, "listPets", structure.Options[0].Value.(map[string]interface{})["operationId"])
+	assert.Equal(t, "schema-2", structure.Options[1].ID)
+	assert.Equal(t, "listAllPets", structure.Options[1].Value.(map[string]interface{})["operationId"])
+	for _, option := range structure.Options {
+		assert.False(t, option.Recommended)
+	}
+}
+
+func TestSchemaTransformer_MergeSchemas_Override(t *testing.T) {
+	v2 := `openapi: 3.0.3
+info:
+  title: Pets service
+  version: 2.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPetsV2
+      responses:
+        "200":
+          description: OK
+`
+	v3 := `openapi: 3.0.3
+info:
+  title: Pets service
+  version: 3.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPetsV3
+      responses:
+        "200":
+          description: OK
+`
+	result, err := newTestSchemaTransformer(nil).MergeSchemas(context.Background(),
+		[]string{petsServiceSpec, v2, v3}, SchemaFormatOpenAPI, MergeStrategyOverride)
+	require.NoError(t, err)
+
+	assert.True(t, result.Success)
+	require.Len(t, result.Conflicts, 1)
+	conflict := result.Conflicts[0]
+	assert.Equal(t, "/paths/~1pets/get", conflict.Path)
+	require.Len(t, conflict.Options, 3)
+	assert.True(t, conflict.Options[2].Recommended)
+	assert.False(t, conflict.Options[0].Recommended)
+
+	require.Len(t, result.Resolutions, 1)
+	assert.Equal(t, "schema-3", result.Resolutions[0].ChosenOption)
+
+	doc, _, err := parseSchemaDocument(result.MergedContent)
+	require.NoError(t, err)
+	operationID, _ := lookupJSONPointer(doc, "/paths/~1pets/get/operationId")
+	assert.Equal(t, "listPetsV3", operationID)
+	version, _ := lookupJSONPointer(doc, "/info/version")
+	assert.Equal(t, "3.0.0", version)
+	_, err = lookupJSONPointer(doc, "/components/schemas/Pet")
+	assert.NoError(t, err, "entries only one schema defines are kept")
+}
+
+func TestSchemaTransformer_MergeSchemas_Unsupported(t *testing.T) {
+	transformer := newTestSchemaTransformer(nil)
+
+	_, err := transformer.MergeSchemas(context.Background(), []string{petsServiceSpec}, SchemaFormatOpenAPI, MergeStrategyIntersect)
+	assert.ErrorIs(t, err, ErrSchemaTransformationFailed)
+
+	_, err = transformer.MergeSchemas(context.Background(), []string{"type Query { a: Int }"}, SchemaFormatGraphQL, MergeStrategyUnion)
+	assert.ErrorIs(t, err, ErrSchemaTransformationFailed)
+
+	_, err = transformer.MergeSchemas(context.Background(), []string{petsServiceSpec, `{"swagger": "2.0"}`}, SchemaFormatOpenAPI, MergeStrategyUnion)
+	assert.ErrorIs(t, err, ErrSchemaTransformationFailed)
+}
+
+const storeServiceSpec = `openapi: 3.0.3
+info:
+  title: Store Service
+  version: 1.2.0
+x-audience: public
+security:
+  - apiKey: []
+paths:
+  /pets/{petId}:
+    parameters:
+      - $ref: '#/components/parameters/PetId'
+      - name: X-Request-Id
+        in: header
+        schema:
+          type: string
+    get:
+      operationId: getPet
+      tags: [pets]
+      parameters:
+        - name: fields
+          in: query
+          description: Fields to include
+          schema:
+            type: array
+            items:
+              type: string
+      responses:
+        '200':
+          description: The pet
+          headers:
+            X-Rate-Limit:
+              schema:
+                type: integer
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+        '404':
+          $ref: '#/components/responses/NotFound'
+    put:
+      operationId: updatePet
+      security:
+        - oauth: [pets:write]
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '204':
+          description: Updated
+  /health:
+    get:
+      operationId: health
+      security: []
+      responses:
+        '200':
+          description: OK
+components:
+  parameters:
+    PetId:
+      name: petId
+      in: path
+      required: true
+      schema:
+        type: integer
+        format: int64
+  responses:
+    NotFound:
+      description: Pet not found
+  securitySchemes:
+    apiKey:
+      type: apiKey
+      name: X-API-Key
+      in: header
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://auth.example.com/token
+          scopes:
+            pets:write: Modify pets
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+          format: int64
+        name:
+          type: string
+          minLength: 1
+          maxLength: 64
+        status:
+          $ref: '#/components/schemas/PetStatus'
+        tags:
+          type: array
+          items:
+            type: string
+    PetStatus:
+      type: string
+      enum: [available, sold]
+`
+
+func TestSchemaTransformer_ExtractComponents(t *testing.T) {
+	transformer := newTestSchemaTransformer(nil)
+
+	result, err := transformer.ExtractComponents(context.Background(), storeServiceSpec, SchemaFormatOpenAPI)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, "Store Service", result.Metadata["title"])
+
+	components := result.Components
+	require.Len(t, components.Models, 1)
+	require.Len(t, components.Enums, 1)
+	require.Len(t, components.Endpoints, 3)
+	assert.Len(t, components.References, 4)
+	require.Len(t, components.Extensions, 1)
+	assert.Equal(t, "x-audience", components.Extensions[0].Name)
+
+	pet := components.Models[0]
+	assert.Equal(t, "Pet", pet.Name)
+	assert.Equal(t, []string{"id", "name"}, pet.Required)
+	require.Len(t, pet.Properties, 4)
+	name := pet.Properties[1]
+	assert.Equal(t, "name", name.Name)
+	assert.True(t, name.Required)
+	require.NotNil(t, name.Constraints.MaxLength)
+	assert.Equal(t, 64, *name.Constraints.MaxLength)
+	assert.Equal(t, "PetStatus", pet.Properties[2].Type)
+	assert.Equal(t, "array<string>", pet.Properties[3].Type)
+
+	assert.Equal(t, ComponentEnum{Name: "PetStatus", Type: "string", Values: []string{"available", "sold"}}, components.Enums[0])
+
+	assert.Equal(t, "/health", components.Endpoints[0].Path)
+	assert.Empty(t, components.Endpoints[0].Security, "an empty security list opts out of the global requirement")
+
+	getPet := components.Endpoints[1]
+	assert.Equal(t, "GET", getPet.Method)
+	assert.Equal(t, "getPet", getPet.OperationID)
+	assert.Equal(t, []string{"pets"}, getPet.Tags)
+	require.Len(t, getPet.Parameters, 3)
+	assert.Equal(t, ComponentParameter{Name: "petId", In: "path", Type: "integer", Required: true,
+		Schema: map[string]interface{}{"type": "integer", "format": "int64"}}, getPet.Parameters[0])
+	assert.Equal(t, "fields", getPet.Parameters[1].Name)
+	assert.Equal(t, "query", getPet.Parameters[1].In)
+	assert.Equal(t, "array<string>", getPet.Parameters[1].Type)
+	assert.Equal(t, "X-Request-Id", getPet.Parameters[2].Name)
+	assert.Equal(t, "header", getPet.Parameters[2].In)
+	assert.Nil(t, getPet.RequestBody)
+
+	require.Len(t, getPet.Responses, 2)
+	ok := getPet.Responses[0]
+	assert.Equal(t, "200", ok.Code)
+	assert.Equal(t, "integer", ok.Headers["X-Rate-Limit"].Type)
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/Pet"}, ok.Content["application/json"].Schema)
+	assert.Equal(t, "404", getPet.Responses[1].Code)
+	assert.Equal(t, "Pet not found", getPet.Responses[1].Description)
+	assert.Equal(t, []ComponentSecurity{{Type: "apiKey", Name: "apiKey", Scopes: []string{}}}, getPet.Security)
+
+	updatePet := components.Endpoints[2]
+	assert.Equal(t, "PUT", updatePet.Method)
+	require.NotNil(t, updatePet.RequestBody)
+	assert.True(t, updatePet.RequestBody.Required)
+	assert.Contains(t, updatePet.RequestBody.Content, "application/json")
+	assert.Equal(t, []ComponentSecurity{{Type: "oauth2", Name: "oauth", Scopes: []string{"pets:write"}}}, updatePet.Security)
+
+	petRef := components.References[3]
+	assert.Equal(t, ComponentReference{Name: "PetStatus", Type: "schemas", Target: "#/components/schemas/PetStatus",
+		Description: "referenced 1 time(s)"}, petRef)
+}
+
+func TestSchemaTransformer_ExtractComponents_Unsupported(t *testing.T) {
+	transformer := newTestSchemaTransformer(nil)
+
+	_, err := transformer.ExtractComponents(context.Background(), "type Query { a: Int }", SchemaFormatGraphQL)
+	assert.ErrorIs(t, err, ErrSchemaTransformationFailed)
+
+	_, err = transformer.ExtractComponents(context.Background(), `{"swagger": "2.0"}`, SchemaFormatOpenAPI)
+	assert.ErrorIs(t, err, ErrSchemaTransformationFailed)
+}
@@ -0,0 +1,626 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SDK languages supported by DefaultDocumentationGenerator.GenerateSDK
+const (
+	SDKLanguageGo         = "go"
+	SDKLanguageTypeScript = "typescript"
+)
+
+// SDK authentication methods accepted in SDKOptions.Authentication
+const (
+	SDKAuthBearer = "bearer"
+	SDKAuthBasic  = "basic"
+	SDKAuthAPIKey = "api_key"
+)
+
+// sdkKind classifies the types an SDK can express
+type sdkKind int
+
+const (
+	sdkAny sdkKind = iota
+	sdkString
+	sdkInteger
+	sdkNumber
+	sdkBoolean
+	sdkArray
+	sdkMap
+	sdkNamed
+)
+
+// sdkType is a language-neutral type: a scalar, a container of elem, or a
+// reference to a model by name
+type sdkType struct {
+	kind   sdkKind
+	format string
+	name   string
+	elem   *sdkType
+}
+
+// sdkModel is a named type: a struct of fields, a string enum, or an alias
+// of another type
+type sdkModel struct {
+	name        string
+	description string
+	fields      []sdkField
+	enum        []string
+	alias       *sdkType
+}
+
+func (m *sdkModel) isStruct() bool { return m.alias == nil && m.enum == nil }
+
+type sdkField struct {
+	name        string
+	description string
+	typ         sdkType
+	required    bool
+}
+
+type sdkParam struct {
+	name        string
+	description string
+	typ         sdkType
+	required    bool
+}
+
+// sdkOperation is an endpoint as a client method. Path and query parameters
+// are listed in declaration order; path parameters follow the path template.
+type sdkOperation struct {
+	name         string
+	method       string
+	path         string
+	summary      string
+	description  string
+	deprecated   bool
+	pathParams   []sdkParam
+	queryParams  []sdkParam
+	headerParams []sdkParam
+	body         *sdkType
+	bodyRequired bool
+	result       *sdkType
+}
+
+// sdkAuth records the authentication methods a client supports
+type sdkAuth struct {
+	bearer     bool
+	basic      bool
+	apiKey     bool
+	apiKeyName string
+	apiKeyIn   string // header or query
+}
+
+// sdkAPI is everything an SDK emitter needs to know about a document
+type sdkAPI struct {
+	title       string
+	version     string
+	description string
+	models      []*sdkModel
+	operations  []*sdkOperation
+	auth        sdkAuth
+	errors      []SDKError
+}
+
+// sdkBuilder translates an OpenAPI 3.x document into an sdkAPI. Component
+// schemas become models named after the component; inline object schemas
+// are hoisted into models named after where they appear.
+type sdkBuilder struct {
+	doc            map[string]interface{}
+	authentication []string
+	api            *sdkAPI
+	componentNames map[string]string // component schema name -> model name
+	usedNames      map[string]bool
+	fieldCache     map[string][]sdkField // object schema pointer -> its fields
+}
+
+func newSDKBuilder(doc map[string]interface{}, authentication []string) *sdkBuilder {
+	info, _ := doc["info"].(map[string]interface{})
+	return &sdkBuilder{
+		doc:            doc,
+		authentication: authentication,
+		api: &sdkAPI{
+			title:       schemaString(info, "title"),
+			version:     schemaString(info, "version"),
+			description: schemaString(info, "description"),
+			errors:      []SDKError{},
+		},
+		componentNames: make(map[string]string),
+		usedNames:      make(map[string]bool),
+		fieldCache:     make(map[string][]sdkField),
+	}
+}
+
+func (b *sdkBuilder) build() *sdkAPI {
+	schemas := componentSchemas(b.doc)
+	names := sortedKeys(schemas)
+	for _, name := range names {
+		b.componentNames[name] = b.uniqueName(pascalName(name))
+	}
+	for _, name := range names {
+		schema, _ := schemas[name].(map[string]interface{})
+		b.model(b.componentNames[name], schema, "/components/schemas/"+escapeJSONPointer(name))
+	}
+
+	extractor := newOpenAPIComponentExtractor(b.doc)
+	operationNames := make(map[string]bool)
+	for _, endpoint := range extractor.extract().Endpoints {
+		name := pascalName(endpoint.OperationID)
+		if endpoint.OperationID == "" {
+			name = pascalName(strings.ToLower(endpoint.Method) + " " + endpoint.Path)
+		}
+		for base, i := name, 2; operationNames[name]; i++ {
+			name = fmt.Sprintf("%s%d", base, i)
+		}
+		operationNames[name] = true
+
+		if operation := b.operation(name, endpoint); operation != nil {
+			b.api.operations = append(b.api.operations, operation)
+		}
+	}
+
+	b.auth()
+	return b.api
+}
+
+// model declares a model for a component or hoisted inline schema
+func (b *sdkBuilder) model(name string, schema map[string]interface{}, pointer string) {
+	model := &sdkModel{name: name, description: schemaString(schema, "description", "title")}
+	b.api.models = append(b.api.models, model)
+
+	if values, ok := schema["enum"].([]interface{}); ok && schemaType(schema) == "string" {
+		model.enum = enumStrings(values)
+		return
+	}
+	if b.isStruct(schema) {
+		model.fields = b.fields(name, schema, pointer, 0)
+		return
+	}
+	alias := b.typeOf(schema, name+"Value", pointer)
+	model.alias = &alias
+}
+
+// isStruct reports whether schema is an object with declared properties
+func (b *sdkBuilder) isStruct(schema map[string]interface{}) bool {
+	if _, ok := schema["properties"].(map[string]interface{}); ok {
+		return true
+	}
+	if _, ok := schema["allOf"].([]interface{}); ok {
+		return true
+	}
+	return false
+}
+
+// fields collects the properties of an object schema, flattening allOf.
+// Each schema is translated once, so components included by several allOfs
+// share the models hoisted from their properties.
+func (b *sdkBuilder) fields(model string, schema map[string]interface{}, pointer string, depth int) []sdkField {
+	if fields, ok := b.fieldCache[pointer]; ok || depth > maxLocalRefDepth {
+		return append([]sdkField(nil), fields...)
+	}
+	if name, ok := strings.CutPrefix(pointer, "/components/schemas/"); ok {
+		if component, ok := b.componentNames[unescapeJSONPointer(name)]; ok {
+			model = component
+		}
+	}
+	b.fieldCache[pointer] = nil
+
+	var fields []sdkField
+	if branches, ok := schema["allOf"].([]interface{}); ok {
+		for i, branch := range branches {
+			branchPointer := fmt.Sprintf("%s/allOf/%d", pointer, i)
+			target, targetPointer := b.resolveSchema(branch, branchPointer)
+			if target == nil {
+				continue
+			}
+			if !b.isStruct(target) {
+				b.unsupported("warning", targetPointer, "allOf branches without properties are not supported and are ignored")
+				continue
+			}
+			fields = mergeFields(fields, b.fields(model, target, targetPointer, depth+1))
+		}
+	}
+	if _, keyword := compositionBranches(schema); keyword == "oneOf" || keyword == "anyOf" {
+		b.unsupported("warning", pointer, keyword+" schemas are not supported; their properties are ignored")
+	}
+
+	required := requiredSet(schema)
+	properties, _ := schema["properties"].(map[string]interface{})
+	var own []sdkField
+	for _, name := range sortedKeys(properties) {
+		prop, _ := properties[name].(map[string]interface{})
+		own = append(own, sdkField{
+			name:        name,
+			description: schemaString(prop, "description", "title"),
+			typ:         b.typeOf(prop, model+pascalName(name), pointer+"/properties/"+escapeJSONPointer(name)),
+			required:    required[name],
+		})
+	}
+	fields = mergeFields(fields, own)
+	for i := range fields {
+		fields[i].required = fields[i].required || required[fields[i].name]
+	}
+	b.fieldCache[pointer] = fields
+	return append([]sdkField(nil), fields...)
+}
+
+// mergeFields appends extra to fields, later definitions replacing earlier ones
+func mergeFields(fields, extra []sdkField) []sdkField {
+	for _, field := range extra {
+		replaced := false
+		for i := range fields {
+			if fields[i].name == field.name {
+				field.required = field.required || fields[i].required
+				fields[i] = field
+				replaced = true
+			}
+		}
+		if !replaced {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// typeOf maps a schema to a type. hint names the model an inline object
+// schema is hoisted into.
+func (b *sdkBuilder) typeOf(schema map[string]interface{}, hint, pointer string) sdkType {
+	if schema == nil {
+		return sdkType{kind: sdkAny}
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		if name, ok := strings.CutPrefix(ref, "#/components/schemas/"); ok {
+			if model, ok := b.componentNames[unescapeJSONPointer(name)]; ok {
+				return sdkType{kind: sdkNamed, name: model}
+			}
+		}
+		target, targetPointer := b.resolveSchema(schema, pointer)
+		if target == nil {
+			return sdkType{kind: sdkAny}
+		}
+		return b.typeOf(target, hint, targetPointer)
+	}
+	if _, keyword := compositionBranches(schema); keyword == "oneOf" || keyword == "anyOf" {
+		b.unsupported("warning", pointer, keyword+" schemas are not supported and are typed as any value")
+		return sdkType{kind: sdkAny}
+	}
+
+	switch schemaType(schema) {
+	case "string":
+		return sdkType{kind: sdkString, format: schemaString(schema, "format")}
+	case "integer":
+		return sdkType{kind: sdkInteger, format: schemaString(schema, "format")}
+	case "number":
+		return sdkType{kind: sdkNumber, format: schemaString(schema, "format")}
+	case "boolean":
+		return sdkType{kind: sdkBoolean}
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		elem := b.typeOf(items, hint+"Item", pointer+"/items")
+		return sdkType{kind: sdkArray, elem: &elem}
+	case "object":
+		if b.isStruct(schema) {
+			name := b.uniqueName(hint)
+			b.model(name, schema, pointer)
+			return sdkType{kind: sdkNamed, name: name}
+		}
+		elem := sdkType{kind: sdkAny}
+		if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			elem = b.typeOf(additional, hint+"Value", pointer+"/additionalProperties")
+		}
+		return sdkType{kind: sdkMap, elem: &elem}
+	}
+	return sdkType{kind: sdkAny}
+}
+
+// resolveSchema follows a local $ref, reporting refs it cannot follow
+func (b *sdkBuilder) resolveSchema(node interface{}, pointer string) (map[string]interface{}, string) {
+	schema, _ := node.(map[string]interface{})
+	for depth := 0; schema != nil && depth < maxLocalRefDepth; depth++ {
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema, pointer
+		}
+		target, ok := strings.CutPrefix(ref, "#")
+		if !ok {
+			b.unsupported("warning", pointer, fmt.Sprintf("external reference %s is not supported and is typed as any value", ref))
+			return nil, pointer
+		}
+		resolved, err := lookupJSONPointer(b.doc, target)
+		if err != nil {
+			b.unsupported("warning", pointer, fmt.Sprintf("reference %s cannot be resolved and is typed as any value", ref))
+			return nil, pointer
+		}
+		schema, _ = resolved.(map[string]interface{})
+		pointer = target
+	}
+	return schema, pointer
+}
+
+// operation translates an endpoint into a client method, or returns nil
+// when the endpoint uses a construct the client cannot call
+func (b *sdkBuilder) operation(name string, endpoint ComponentEndpoint) *sdkOperation {
+	pointer := "/paths/" + escapeJSONPointer(endpoint.Path) + "/" + strings.ToLower(endpoint.Method)
+	deprecated, _ := endpoint.Metadata["deprecated"].(bool)
+	operation := &sdkOperation{
+		name:        name,
+		method:      endpoint.Method,
+		path:        endpoint.Path,
+		summary:     endpoint.Summary,
+		description: endpoint.Description,
+		deprecated:  deprecated,
+	}
+
+	pathParams := make(map[string]sdkParam)
+	for _, param := range endpoint.Parameters {
+		schema, _ := param.Schema.(map[string]interface{})
+		paramPointer := pointer + "/parameters/" + escapeJSONPointer(param.Name)
+		p := sdkParam{
+			name:        param.Name,
+			description: param.Description,
+			typ:         b.typeOf(schema, name+pascalName(param.Name), paramPointer),
+			required:    param.Required || param.In == "path",
+		}
+		if !b.isSimpleParam(p.typ) {
+			b.unsupported("error", paramPointer, fmt.Sprintf("%s parameter %q must be a scalar or an array of scalars; operation %s is omitted", param.In, param.Name, name))
+			return nil
+		}
+		switch param.In {
+		case "path":
+			pathParams[param.Name] = p
+		case "query":
+			operation.queryParams = append(operation.queryParams, p)
+		case "header":
+			operation.headerParams = append(operation.headerParams, p)
+		default:
+			b.unsupported("error", paramPointer, fmt.Sprintf("%s parameters are not supported; operation %s is omitted", param.In, name))
+			return nil
+		}
+	}
+	for _, segment := range pathTemplateParams(endpoint.Path) {
+		p, ok := pathParams[segment]
+		if !ok {
+			p = sdkParam{name: segment, typ: sdkType{kind: sdkString}, required: true}
+		}
+		operation.pathParams = append(operation.pathParams, p)
+	}
+
+	if body := endpoint.RequestBody; body != nil {
+		media, ok := jsonMedia(body.Content)
+		if !ok {
+			b.unsupported("error", pointer+"/requestBody", fmt.Sprintf("request bodies without a JSON media type are not supported; operation %s is omitted", name))
+			return nil
+		}
+		schema, _ := media.Schema.(map[string]interface{})
+		typ := b.typeOf(schema, name+"Request", pointer+"/requestBody")
+		operation.body = &typ
+		operation.bodyRequired = body.Required
+	}
+
+	for _, response := range endpoint.Responses {
+		if !strings.HasPrefix(response.Code, "2") {
+			continue
+		}
+		if len(response.Content) == 0 {
+			break
+		}
+		media, ok := jsonMedia(response.Content)
+		if !ok {
+			b.unsupported("error", pointer+"/responses/"+response.Code, fmt.Sprintf("responses without a JSON media type are not supported; operation %s is omitted", name))
+			return nil
+		}
+		schema, _ := media.Schema.(map[string]interface{})
+		typ := b.typeOf(schema, name+"Response", pointer+"/responses/"+response.Code)
+		operation.result = &typ
+		break
+	}
+	return operation
+}
+
+// isSimpleParam reports whether a parameter of type t can be written as a
+// path, query or header value
+func (b *sdkBuilder) isSimpleParam(t sdkType) bool {
+	switch t.kind {
+	case sdkString, sdkInteger, sdkNumber, sdkBoolean:
+		return true
+	case sdkArray:
+		return t.elem.kind != sdkArray && b.isSimpleParam(*t.elem)
+	case sdkNamed:
+		for _, model := range b.api.models {
+			if model.name == t.name {
+				return model.enum != nil || (model.alias != nil && model.alias.kind != sdkArray && b.isSimpleParam(*model.alias))
+			}
+		}
+	}
+	return false
+}
+
+// auth selects the authentication methods the client supports: those
+// requested, or else those the document's security schemes declare
+func (b *sdkBuilder) auth() {
+	components, _ := b.doc["components"].(map[string]interface{})
+	schemes, _ := components["securitySchemes"].(map[string]interface{})
+
+	auth := &b.api.auth
+	for _, name := range sortedKeys(schemes) {
+		scheme, _ := schemes[name].(map[string]interface{})
+		if schemaString(scheme, "type") != "apiKey" || auth.apiKeyName != "" {
+			continue
+		}
+		in := schemaString(scheme, "in")
+		if in != "header" && in != "query" {
+			b.unsupported("warning", "/components/securitySchemes/"+escapeJSONPointer(name), fmt.Sprintf("API keys sent in %s are not supported", in))
+			continue
+		}
+		auth.apiKeyName, auth.apiKeyIn = schemaString(scheme, "name"), in
+	}
+
+	methods := b.authentication
+	if len(methods) == 0 {
+		for _, name := range sortedKeys(schemes) {
+			scheme, _ := schemes[name].(map[string]interface{})
+			switch schemaString(scheme, "type") {
+			case "http":
+				if strings.EqualFold(schemaString(scheme, "scheme"), "basic") {
+					methods = append(methods, SDKAuthBasic)
+				} else {
+					methods = append(methods, SDKAuthBearer)
+				}
+			case "oauth2", "openIdConnect":
+				methods = append(methods, SDKAuthBearer)
+			case "apiKey":
+				methods = append(methods, SDKAuthAPIKey)
+			}
+		}
+	}
+	for _, method := range methods {
+		switch strings.ToLower(method) {
+		case SDKAuthBearer:
+			auth.bearer = true
+		case SDKAuthBasic:
+			auth.basic = true
+		case SDKAuthAPIKey:
+			auth.apiKey = true
+		default:
+			b.api.errors = append(b.api.errors, SDKError{
+				Code:     "UNSUPPORTED_AUTHENTICATION",
+				Message:  fmt.Sprintf("authentication method %q is not supported", method),
+				Severity: "error",
+			})
+		}
+	}
+	if auth.apiKey && auth.apiKeyName == "" {
+		auth.apiKeyName, auth.apiKeyIn = "X-API-Key", "header"
+	}
+}
+
+// unsupported records a construct the SDK cannot express
+func (b *sdkBuilder) unsupported(severity, pointer, message string) {
+	b.api.errors = append(b.api.errors, SDKError{
+		Code:     "UNSUPPORTED_CONSTRUCT",
+		Message:  fmt.Sprintf("%s: %s", pointer, message),
+		Severity: severity,
+	})
+}
+
+// uniqueName returns name, suffixed with a number if already taken
+func (b *sdkBuilder) uniqueName(name string) string {
+	unique := name
+	for i := 2; b.usedNames[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	b.usedNames[unique] = true
+	return unique
+}
+
+// jsonMedia returns the JSON media type of a request body or response
+func jsonMedia(content map[string]ComponentMedia) (ComponentMedia, bool) {
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+	for _, mediaType := range mediaTypes {
+		if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+			return content[mediaType], true
+		}
+	}
+	return ComponentMedia{}, false
+}
+
+// pathTemplateParams returns the names of the {parameters} in a path template
+func pathTemplateParams(path string) []string {
+	var names []string
+	for _, segment := range splitPathTemplate(path) {
+		if segment.param {
+			names = append(names, segment.text)
+		}
+	}
+	return names
+}
+
+type pathSegment struct {
+	text  string
+	param bool
+}
+
+// splitPathTemplate splits a path template into literal text and parameters
+func splitPathTemplate(path string) []pathSegment {
+	var segments []pathSegment
+	for path != "" {
+		open := strings.Index(path, "{")
+		end := strings.Index(path[open+1:], "}")
+		if open < 0 || end < 0 {
+			segments = append(segments, pathSegment{text: path})
+			break
+		}
+		if open > 0 {
+			segments = append(segments, pathSegment{text: path[:open]})
+		}
+		segments = append(segments, pathSegment{text: path[open+1 : open+1+end], param: true})
+		path = path[open+end+2:]
+	}
+	return segments
+}
+
+// nameWords splits an identifier or phrase into words at separators and
+// lower-to-upper case changes
+func nameWords(s string) []string {
+	var words []string
+	var current []rune
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+			continue
+		}
+		if len(current) > 0 && unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			words = append(words, string(current))
+			current = nil
+		}
+		current = append(current, r)
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+	return words
+}
+
+// pascalName converts s to PascalCase, prefixing names that would start
+// with a digit
+func pascalName(s string) string {
+	var sb strings.Builder
+	for _, word := range nameWords(s) {
+		runes := []rune(word)
+		sb.WriteRune(unicode.ToUpper(runes[0]))
+		sb.WriteString(string(runes[1:]))
+	}
+	name := sb.String()
+	if name == "" {
+		return "Value"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		return "N" + name
+	}
+	return name
+}
+
+// camelName converts s to camelCase
+func camelName(s string) string {
+	name := []rune(pascalName(s))
+	for i := 0; i < len(name) && unicode.IsUpper(name[i]); i++ {
+		// Lower a leading acronym but keep the first letter of the next word
+		if i > 0 && i+1 < len(name) && unicode.IsLower(name[i+1]) {
+			break
+		}
+		name[i] = unicode.ToLower(name[i])
+	}
+	return string(name)
+}
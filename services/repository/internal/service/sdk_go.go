@@ -0,0 +1,666 @@
+package service
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// goInitialisms are words written in upper case in Go identifiers
+var goInitialisms = map[string]bool{
+	"api": true, "html": true, "http": true, "https": true, "id": true, "ip": true, "json": true,
+	"sql": true, "tls": true, "ttl": true, "uid": true, "uri": true, "url": true, "uuid": true, "xml": true,
+}
+
+// goSDKEmitter writes a Go client package for an sdkAPI
+type goSDKEmitter struct {
+	api         *sdkAPI
+	packageName string
+	modulePath  string
+	options     SDKOptions
+	models      map[string]*sdkModel
+}
+
+func newGoSDKEmitter(api *sdkAPI, packageName string, options SDKOptions) *goSDKEmitter {
+	e := &goSDKEmitter{
+		api:         api,
+		packageName: goPackageName(packageName),
+		modulePath:  packageName,
+		options:     options,
+		models:      make(map[string]*sdkModel),
+	}
+	if strings.TrimLeft(packageName, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789._~/-") != "" {
+		e.modulePath = e.packageName
+	}
+	for _, model := range api.models {
+		e.models[model.name] = model
+	}
+	return e
+}
+
+// files renders the package, formatted with gofmt
+func (e *goSDKEmitter) files() ([]SDKFile, error) {
+	sources := []struct{ path, fileType, content string }{
+		{"go.mod", "source", fmt.Sprintf("module %s\n\ngo 1.21\n", e.modulePath)},
+		{"models.go", "source", e.modelsFile()},
+		{"client.go", "source", e.clientFile()},
+	}
+	if e.options.IncludeTests {
+		sources = append(sources, struct{ path, fileType, content string }{"client_test.go", "test", e.testFile()})
+	}
+	if e.options.IncludeDocs {
+		sources = append(sources, struct{ path, fileType, content string }{"README.md", "doc", e.readme()})
+	}
+
+	files := make([]SDKFile, 0, len(sources))
+	for _, source := range sources {
+		content := source.content
+		if strings.HasSuffix(source.path, ".go") {
+			formatted, err := format.Source([]byte(content))
+			if err != nil {
+				return nil, fmt.Errorf("generated %s is not valid Go: %w", source.path, err)
+			}
+			content = string(formatted)
+		}
+		files = append(files, SDKFile{Path: source.path, Content: content, Type: source.fileType})
+	}
+	return files, nil
+}
+
+func (e *goSDKEmitter) header(sb *strings.Builder) {
+	fmt.Fprintf(sb, "// Code generated by Orbit from %s %s. DO NOT EDIT.\n\n", e.api.title, e.api.version)
+	fmt.Fprintf(sb, "package %s\n\n", e.packageName)
+}
+
+func (e *goSDKEmitter) modelsFile() string {
+	var sb strings.Builder
+	e.header(&sb)
+
+	for _, model := range e.api.models {
+		name := goName(model.name)
+		fmt.Fprintf(&sb, "// %s is the %s schema.\n", name, model.name)
+		if model.description != "" {
+			sb.WriteString("//\n")
+			writeGoComment(&sb, "", model.description)
+		}
+		switch {
+		case model.enum != nil:
+			fmt.Fprintf(&sb, "type %s string\n\n", name)
+			fmt.Fprintf(&sb, "// Values of %s\nconst (\n", name)
+			used := make(map[string]bool)
+			for _, value := range model.enum {
+				constName := uniqueIdent(used, name+goName(value))
+				fmt.Fprintf(&sb, "\t%s %s = %s\n", constName, name, strconv.Quote(value))
+			}
+			sb.WriteString(")\n\n")
+		case model.alias != nil:
+			fmt.Fprintf(&sb, "type %s %s\n\n", name, e.goType(*model.alias))
+		default:
+			fmt.Fprintf(&sb, "type %s struct {\n", name)
+			used := make(map[string]bool)
+			for _, field := range model.fields {
+				fieldName := uniqueIdent(used, goName(field.name))
+				if field.description != "" {
+					writeGoComment(&sb, "\t", field.description)
+				}
+				tag := field.name
+				fieldType := e.goType(field.typ)
+				if !field.required {
+					tag += ",omitempty"
+					fieldType = e.optionalType(field.typ)
+				}
+				fmt.Fprintf(&sb, "\t%s %s `json:%s`\n", fieldName, fieldType, strconv.Quote(tag))
+			}
+			sb.WriteString("}\n\n")
+		}
+	}
+	return sb.String()
+}
+
+func (e *goSDKEmitter) clientFile() string {
+	var sb strings.Builder
+	e.header(&sb)
+	auth := e.api.auth
+
+	sb.WriteString(`import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+`)
+	fmt.Fprintf(&sb, "// Client calls the %s API\n", e.api.title)
+	sb.WriteString("type Client struct {\n\tbaseURL    string\n\thttpClient *http.Client\n")
+	if auth.bearer {
+		sb.WriteString("\ttoken string\n")
+	}
+	if auth.basic {
+		sb.WriteString("\tusername, password string\n")
+	}
+	if auth.apiKey {
+		sb.WriteString("\tapiKey string\n")
+	}
+	sb.WriteString(`}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+`)
+	if auth.bearer {
+		sb.WriteString(`// WithBearerToken sends token in the Authorization header of every request
+func WithBearerToken(token string) Option {
+	return func(c *Client) { c.token = token }
+}
+
+`)
+	}
+	if auth.basic {
+		sb.WriteString(`// WithBasicAuth authenticates every request with HTTP basic authentication
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) { c.username, c.password = username, password }
+}
+
+`)
+	}
+	if auth.apiKey {
+		fmt.Fprintf(&sb, `// WithAPIKey sends key in the %s %s of every request
+func WithAPIKey(key string) Option {
+	return func(c *Client) { c.apiKey = key }
+}
+
+`, auth.apiKeyName, auth.apiKeyIn)
+	}
+	sb.WriteString(`// NewClient creates a client for the API served at baseURL
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{baseURL: strings.TrimSuffix(baseURL, "/"), httpClient: http.DefaultClient}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// APIError is returned for responses with a non-2xx status
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+`)
+
+	for _, op := range e.api.operations {
+		e.writeOperation(&sb, op)
+	}
+
+	sb.WriteString(`// do sends a request, encoding body as JSON and decoding the response into out
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, header http.Header, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+`)
+	if auth.bearer {
+		sb.WriteString("\tif c.token != \"\" {\n\t\treq.Header.Set(\"Authorization\", \"Bearer \"+c.token)\n\t}\n")
+	}
+	if auth.basic {
+		sb.WriteString("\tif c.username != \"\" || c.password != \"\" {\n\t\treq.SetBasicAuth(c.username, c.password)\n\t}\n")
+	}
+	if auth.apiKey {
+		if auth.apiKeyIn == "query" {
+			fmt.Fprintf(&sb, "\tif c.apiKey != \"\" {\n\t\tq := req.URL.Query()\n\t\tq.Set(%q, c.apiKey)\n\t\treq.URL.RawQuery = q.Encode()\n\t}\n", auth.apiKeyName)
+		} else {
+			fmt.Fprintf(&sb, "\tif c.apiKey != \"\" {\n\t\treq.Header.Set(%q, c.apiKey)\n\t}\n", auth.apiKeyName)
+		}
+	}
+	sb.WriteString(`
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &APIError{StatusCode: resp.StatusCode, Body: data}
+	}
+	if out == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode response body: %w", err)
+	}
+	return nil
+}
+`)
+	return sb.String()
+}
+
+// goOperation holds the Go names of an operation's arguments
+type goOperation struct {
+	pathArgs   []string
+	paramsType string
+	params     []string // field names of paramsType, query parameters first
+}
+
+func (e *goSDKEmitter) names(op *sdkOperation) goOperation {
+	used := map[string]bool{"ctx": true, "c": true, "body": true, "params": true, "path": true, "query": true, "header": true, "out": true, "err": true, "payload": true}
+	var names goOperation
+	for _, param := range op.pathParams {
+		names.pathArgs = append(names.pathArgs, uniqueIdent(used, goLocalName(param.name)))
+	}
+	if len(op.queryParams)+len(op.headerParams) > 0 {
+		names.paramsType = goName(op.name) + "Params"
+		fields := make(map[string]bool)
+		for _, param := range append(append([]sdkParam{}, op.queryParams...), op.headerParams...) {
+			names.params = append(names.params, uniqueIdent(fields, goName(param.name)))
+		}
+	}
+	return names
+}
+
+func (e *goSDKEmitter) writeOperation(sb *strings.Builder, op *sdkOperation) {
+	name := goName(op.name)
+	names := e.names(op)
+
+	if names.paramsType != "" {
+		fmt.Fprintf(sb, "// %s holds the query and header parameters of %s\ntype %s struct {\n", names.paramsType, name, names.paramsType)
+		for i, param := range append(append([]sdkParam{}, op.queryParams...), op.headerParams...) {
+			if param.description != "" {
+				writeGoComment(sb, "\t", param.description)
+			}
+			fieldType := e.goType(param.typ)
+			if !param.required {
+				fieldType = e.optionalType(param.typ)
+			}
+			fmt.Fprintf(sb, "\t%s %s\n", names.params[i], fieldType)
+		}
+		sb.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(sb, "// %s calls %s %s.\n", name, op.method, op.path)
+	for _, text := range []string{op.summary, op.description} {
+		if text != "" {
+			sb.WriteString("//\n")
+			writeGoComment(sb, "", text)
+		}
+	}
+	if op.deprecated {
+		sb.WriteString("//\n// Deprecated: the API marks this operation as deprecated.\n")
+	}
+
+	args := []string{"ctx context.Context"}
+	for i, param := range op.pathParams {
+		args = append(args, names.pathArgs[i]+" "+e.goType(param.typ))
+	}
+	if op.body != nil {
+		args = append(args, "body "+e.optionalType(*op.body))
+	}
+	if names.paramsType != "" {
+		args = append(args, "params *"+names.paramsType)
+	}
+	results := "error"
+	if op.result != nil {
+		results = "(" + e.goType(*op.result) + ", error)"
+		if e.isStruct(*op.result) {
+			results = "(*" + e.goType(*op.result) + ", error)"
+		}
+	}
+	fmt.Fprintf(sb, "func (c *Client) %s(%s) %s {\n", name, strings.Join(args, ", "), results)
+
+	var path []string
+	pathArg := 0
+	for _, segment := range splitPathTemplate(op.path) {
+		if segment.param {
+			path = append(path, "url.PathEscape(fmt.Sprint("+names.pathArgs[pathArg]+"))")
+			pathArg++
+		} else {
+			path = append(path, strconv.Quote(segment.text))
+		}
+	}
+	if len(path) == 0 {
+		path = append(path, `"/"`)
+	}
+	fmt.Fprintf(sb, "\tpath := %s\n", strings.Join(path, " + "))
+
+	query, header := "nil", "nil"
+	if names.paramsType != "" {
+		if len(op.queryParams) > 0 {
+			sb.WriteString("\tquery := url.Values{}\n")
+			query = "query"
+		}
+		if len(op.headerParams) > 0 {
+			sb.WriteString("\theader := http.Header{}\n")
+			header = "header"
+		}
+		sb.WriteString("\tif params != nil {\n")
+		for i, param := range op.queryParams {
+			e.writeParamValue(sb, "query", param, "params."+names.params[i])
+		}
+		for i, param := range op.headerParams {
+			e.writeParamValue(sb, "header", param, "params."+names.params[len(op.queryParams)+i])
+		}
+		sb.WriteString("\t}\n")
+	}
+
+	body := "nil"
+	if op.body != nil {
+		sb.WriteString("\tvar payload interface{}\n\tif body != nil {\n\t\tpayload = body\n\t}\n")
+		body = "payload"
+	}
+
+	call := fmt.Sprintf("c.do(ctx, %q, path, %s, %s, %s, ", op.method, query, header, body)
+	switch {
+	case op.result == nil:
+		fmt.Fprintf(sb, "\treturn %snil)\n", call)
+	case e.isStruct(*op.result):
+		fmt.Fprintf(sb, "\tvar out %s\n\tif err := %s&out); err != nil {\n\t\treturn nil, err\n\t}\n\treturn &out, nil\n", e.goType(*op.result), call)
+	default:
+		fmt.Fprintf(sb, "\tvar out %s\n\terr := %s&out)\n\treturn out, err\n", e.goType(*op.result), call)
+	}
+	sb.WriteString("}\n\n")
+}
+
+// writeParamValue adds a query or header parameter to target when set
+func (e *goSDKEmitter) writeParamValue(sb *strings.Builder, target string, param sdkParam, field string) {
+	switch {
+	case param.typ.kind == sdkArray:
+		fmt.Fprintf(sb, "\t\tfor _, v := range %s {\n\t\t\t%s.Add(%q, fmt.Sprint(v))\n\t\t}\n", field, target, param.name)
+	case !param.required:
+		fmt.Fprintf(sb, "\t\tif %s != nil {\n\t\t\t%s.Set(%q, fmt.Sprint(*%s))\n\t\t}\n", field, target, param.name, field)
+	default:
+		fmt.Fprintf(sb, "\t\t%s.Set(%q, fmt.Sprint(%s))\n", target, param.name, field)
+	}
+}
+
+func (e *goSDKEmitter) testFile() string {
+	var sb strings.Builder
+	e.header(&sb)
+	sb.WriteString(`import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+`)
+	if len(e.api.operations) == 0 {
+		sb.WriteString("func TestNewClient(t *testing.T) {\n\tif NewClient(\"http://localhost/\").baseURL != \"http://localhost\" {\n\t\tt.Error(\"NewClient did not trim the base URL\")\n\t}\n}\n")
+		return sb.String()
+	}
+
+	for _, op := range e.api.operations {
+		name := goName(op.name)
+		names := e.names(op)
+
+		args := []string{"context.Background()"}
+		var path strings.Builder
+		pathArg := 0
+		for _, segment := range splitPathTemplate(op.path) {
+			if segment.param {
+				param := op.pathParams[pathArg]
+				args = append(args, e.zeroValue(param.typ))
+				path.WriteString(zeroText(e.underlying(param.typ)))
+				pathArg++
+			} else {
+				path.WriteString(segment.text)
+			}
+		}
+		if op.body != nil {
+			args = append(args, "nil")
+		}
+		if names.paramsType != "" {
+			args = append(args, "nil")
+		}
+		call := fmt.Sprintf("client.%s(%s)", name, strings.Join(args, ", "))
+		assign := "err"
+		if op.result != nil {
+			assign = "_, err"
+		}
+
+		fmt.Fprintf(&sb, `func TestClient_%s(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("null"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	if %s := %s; err != nil {
+		t.Fatalf("%s: %%v", err)
+	}
+	if method != %q || path != %q {
+		t.Errorf("%s sent %%s %%s, want %s %s", method, path)
+	}
+}
+
+`, name, assign, call, name, op.method, path.String(), name, op.method, path.String())
+	}
+	return sb.String()
+}
+
+func (e *goSDKEmitter) readme() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s Go client\n\n", e.api.title)
+	if e.api.description != "" {
+		fmt.Fprintf(&sb, "%s\n\n", e.api.description)
+	}
+	fmt.Fprintf(&sb, "Generated from version %s of the API.\n\n", e.api.version)
+	fmt.Fprintf(&sb, "```go\nclient := %s.NewClient(\"https://api.example.com\")\n```\n\n", e.packageName)
+	sb.WriteString("## Operations\n\n| Method | Request |\n| --- | --- |\n")
+	for _, op := range e.api.operations {
+		fmt.Fprintf(&sb, "| `%s` | `%s %s` |\n", goName(op.name), op.method, op.path)
+	}
+	return sb.String()
+}
+
+// goType is the Go type for t
+func (e *goSDKEmitter) goType(t sdkType) string {
+	switch t.kind {
+	case sdkString:
+		return "string"
+	case sdkInteger:
+		if t.format == "int32" {
+			return "int32"
+		}
+		return "int64"
+	case sdkNumber:
+		if t.format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case sdkBoolean:
+		return "bool"
+	case sdkArray:
+		return "[]" + e.goType(*t.elem)
+	case sdkMap:
+		return "map[string]" + e.goType(*t.elem)
+	case sdkNamed:
+		return goName(t.name)
+	}
+	return "interface{}"
+}
+
+// optionalType is the Go type for an optional value of t: a pointer unless
+// t already has a nil value
+func (e *goSDKEmitter) optionalType(t sdkType) string {
+	if e.nillable(t) {
+		return e.goType(t)
+	}
+	return "*" + e.goType(t)
+}
+
+// nillable reports whether Go values of t can be nil
+func (e *goSDKEmitter) nillable(t sdkType) bool {
+	switch e.underlying(t).kind {
+	case sdkArray, sdkMap, sdkAny:
+		return true
+	}
+	return false
+}
+
+func (e *goSDKEmitter) isStruct(t sdkType) bool {
+	model, ok := e.models[t.name]
+	return t.kind == sdkNamed && ok && model.isStruct()
+}
+
+// underlying resolves named aliases and enums to the type they name
+func (e *goSDKEmitter) underlying(t sdkType) sdkType {
+	for depth := 0; t.kind == sdkNamed && depth < maxLocalRefDepth; depth++ {
+		model, ok := e.models[t.name]
+		switch {
+		case !ok:
+			return sdkType{kind: sdkAny}
+		case model.enum != nil:
+			return sdkType{kind: sdkString}
+		case model.alias != nil:
+			t = *model.alias
+		default:
+			return t
+		}
+	}
+	return t
+}
+
+// zeroValue is a Go expression for the zero value of the scalar type t
+func (e *goSDKEmitter) zeroValue(t sdkType) string {
+	switch e.underlying(t).kind {
+	case sdkString:
+		return `""`
+	case sdkBoolean:
+		return "false"
+	}
+	return "0"
+}
+
+// zeroText is how fmt.Sprint formats the zero value of a scalar type
+func zeroText(t sdkType) string {
+	switch t.kind {
+	case sdkInteger, sdkNumber:
+		return "0"
+	case sdkBoolean:
+		return "false"
+	}
+	return ""
+}
+
+// goName converts s to an exported Go identifier
+func goName(s string) string {
+	var sb strings.Builder
+	for _, word := range nameWords(s) {
+		if goInitialisms[strings.ToLower(word)] {
+			sb.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		sb.WriteRune(unicode.ToUpper(runes[0]))
+		sb.WriteString(string(runes[1:]))
+	}
+	name := sb.String()
+	if name == "" {
+		return "Value"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		return "N" + name
+	}
+	return name
+}
+
+// goLocalName converts s to an unexported Go identifier
+func goLocalName(s string) string {
+	words := nameWords(s)
+	if len(words) == 0 {
+		return "value"
+	}
+	name := strings.ToLower(words[0])
+	if len(words) > 1 {
+		name += goName(strings.Join(words[1:], " "))
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "n" + name
+	}
+	if token.IsKeyword(name) {
+		name += "Param"
+	}
+	return name
+}
+
+// goPackageName converts s, a package or module path, to a package name
+func goPackageName(s string) string {
+	s = s[strings.LastIndex(s, "/")+1:]
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			sb.WriteRune(r)
+		}
+	}
+	name := sb.String()
+	switch {
+	case name == "":
+		return "client"
+	case unicode.IsDigit(rune(name[0])):
+		return "api" + name
+	case token.IsKeyword(name):
+		return name + "api"
+	}
+	return name
+}
+
+// uniqueIdent returns name, suffixed with a number if already in used
+func uniqueIdent(used map[string]bool, name string) string {
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	used[unique] = true
+	return unique
+}
+
+// writeGoComment writes text as a Go comment
+func writeGoComment(sb *strings.Builder, indent, text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fmt.Fprintf(sb, "%s// %s\n", indent, strings.TrimRightFunc(line, unicode.IsSpace))
+	}
+}
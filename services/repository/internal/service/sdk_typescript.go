@@ -0,0 +1,532 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// tsReserved are words that cannot name a TypeScript parameter
+var tsReserved = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true,
+	"debugger": true, "default": true, "delete": true, "do": true, "else": true, "enum": true,
+	"export": true, "extends": true, "false": true, "finally": true, "for": true, "function": true,
+	"if": true, "import": true, "in": true, "instanceof": true, "new": true, "null": true,
+	"return": true, "super": true, "switch": true, "this": true, "throw": true, "true": true,
+	"try": true, "typeof": true, "var": true, "void": true, "while": true, "with": true,
+	"body": true, "params": true,
+}
+
+// typeScriptSDKEmitter writes a TypeScript client package for an sdkAPI.
+// The client depends only on the fetch API.
+type typeScriptSDKEmitter struct {
+	api         *sdkAPI
+	packageName string
+	options     SDKOptions
+}
+
+func newTypeScriptSDKEmitter(api *sdkAPI, packageName string, options SDKOptions) *typeScriptSDKEmitter {
+	return &typeScriptSDKEmitter{api: api, packageName: npmPackageName(packageName), options: options}
+}
+
+func (e *typeScriptSDKEmitter) files() []SDKFile {
+	files := []SDKFile{
+		{Path: "package.json", Content: e.packageJSON(), Type: "source"},
+		{Path: "tsconfig.json", Content: tsConfig, Type: "source"},
+		{Path: "src/index.ts", Content: e.header() + "export * from './models';\nexport * from './client';\n", Type: "source"},
+		{Path: "src/models.ts", Content: e.modelsFile(), Type: "source"},
+		{Path: "src/client.ts", Content: e.clientFile(), Type: "source"},
+	}
+	if e.options.IncludeTests {
+		files = append(files, SDKFile{Path: "src/client.test.ts", Content: e.testFile(), Type: "test"})
+	}
+	if e.options.IncludeDocs {
+		files = append(files, SDKFile{Path: "README.md", Content: e.readme(), Type: "doc"})
+	}
+	return files
+}
+
+const tsConfig = `{
+  "compilerOptions": {
+    "target": "ES2020",
+    "module": "ES2020",
+    "moduleResolution": "node",
+    "lib": ["ES2020", "DOM"],
+    "declaration": true,
+    "strict": true,
+    "outDir": "dist"
+  },
+  "include": ["src"],
+  "exclude": ["src/**/*.test.ts"]
+}
+`
+
+func (e *typeScriptSDKEmitter) header() string {
+	return fmt.Sprintf("// Code generated by Orbit from %s %s. DO NOT EDIT.\n\n", e.api.title, e.api.version)
+}
+
+func (e *typeScriptSDKEmitter) packageJSON() string {
+	version := e.api.version
+	if _, err := domain.ParseSemanticVersion(version); err != nil {
+		version = "0.0.0"
+	}
+	scripts := map[string]string{"build": "tsc"}
+	devDependencies := map[string]string{"typescript": "^5.4.0"}
+	if e.options.IncludeTests {
+		scripts["test"] = "vitest run"
+		devDependencies["vitest"] = "^1.6.0"
+	}
+	pkg := struct {
+		Name            string            `json:"name"`
+		Version         string            `json:"version"`
+		Description     string            `json:"description"`
+		Main            string            `json:"main"`
+		Types           string            `json:"types"`
+		Scripts         map[string]string `json:"scripts"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}{
+		Name:            e.packageName,
+		Version:         version,
+		Description:     fmt.Sprintf("TypeScript client for the %s API", e.api.title),
+		Main:            "dist/index.js",
+		Types:           "dist/index.d.ts",
+		Scripts:         scripts,
+		DevDependencies: devDependencies,
+	}
+	out, _ := json.MarshalIndent(pkg, "", "  ")
+	return string(out) + "\n"
+}
+
+func (e *typeScriptSDKEmitter) modelsFile() string {
+	var sb strings.Builder
+	sb.WriteString(e.header())
+
+	for _, model := range e.api.models {
+		writeTSDoc(&sb, "", model.description)
+		switch {
+		case model.enum != nil:
+			values := make([]string, 0, len(model.enum))
+			for _, value := range model.enum {
+				values = append(values, tsString(value))
+			}
+			if len(values) == 0 {
+				values = append(values, "never")
+			}
+			fmt.Fprintf(&sb, "export type %s = %s;\n\n", model.name, strings.Join(values, " | "))
+		case model.alias != nil:
+			fmt.Fprintf(&sb, "export type %s = %s;\n\n", model.name, tsType(*model.alias))
+		default:
+			fmt.Fprintf(&sb, "export interface %s {\n", model.name)
+			for _, field := range model.fields {
+				writeTSDoc(&sb, "  ", field.description)
+				optional := ""
+				if !field.required {
+					optional = "?"
+				}
+				fmt.Fprintf(&sb, "  %s%s: %s;\n", tsKey(field.name), optional, tsType(field.typ))
+			}
+			sb.WriteString("}\n\n")
+		}
+	}
+	return sb.String()
+}
+
+func (e *typeScriptSDKEmitter) clientFile() string {
+	var sb strings.Builder
+	sb.WriteString(e.header())
+	auth := e.api.auth
+
+	var imports []string
+	for _, model := range e.api.models {
+		if e.usesModel(model.name) {
+			imports = append(imports, model.name)
+		}
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&sb, "import type { %s } from './models';\n\n", strings.Join(imports, ", "))
+	}
+
+	sb.WriteString("export interface ClientOptions {\n  /** Base URL of the API, such as https://api.example.com */\n  baseUrl: string;\n")
+	sb.WriteString("  /** fetch implementation; defaults to the global fetch */\n  fetch?: typeof fetch;\n")
+	if auth.bearer {
+		sb.WriteString("  /** Token sent in the Authorization header */\n  token?: string;\n")
+	}
+	if auth.basic {
+		sb.WriteString("  /** Credentials for HTTP basic authentication */\n  username?: string;\n  password?: string;\n")
+	}
+	if auth.apiKey {
+		fmt.Fprintf(&sb, "  /** API key sent in the %s %s */\n  apiKey?: string;\n", auth.apiKeyName, auth.apiKeyIn)
+	}
+	sb.WriteString(`}
+
+/** Error thrown for responses with a non-2xx status */
+export class ApiError extends Error {
+  constructor(readonly status: number, readonly body: string) {
+    super(` + "`request failed with status ${status}`" + `);
+    this.name = 'ApiError';
+  }
+}
+
+type ParamValue = string | number | boolean | Array<string | number | boolean> | undefined;
+
+`)
+
+	for _, op := range e.api.operations {
+		if len(op.queryParams)+len(op.headerParams) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "export interface %sParams {\n", op.name)
+		for _, param := range append(append([]sdkParam{}, op.queryParams...), op.headerParams...) {
+			writeTSDoc(&sb, "  ", param.description)
+			optional := "?"
+			if param.required {
+				optional = ""
+			}
+			fmt.Fprintf(&sb, "  %s%s: %s;\n", tsKey(param.name), optional, tsType(param.typ))
+		}
+		sb.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(&sb, "/** Client for the %s API */\nexport class Client {\n", e.api.title)
+	sb.WriteString(`  private readonly baseUrl: string;
+  private readonly fetchFn: typeof fetch;
+
+  constructor(private readonly options: ClientOptions) {
+    this.baseUrl = options.baseUrl.replace(/\/+$/, '');
+    this.fetchFn = options.fetch ?? globalThis.fetch.bind(globalThis);
+  }
+`)
+	for _, op := range e.api.operations {
+		e.writeOperation(&sb, op)
+	}
+
+	sb.WriteString(`
+  private async request<T>(
+    method: string,
+    path: string,
+    query: Record<string, ParamValue> = {},
+    headers: Record<string, ParamValue> = {},
+    body?: unknown,
+  ): Promise<T> {
+    const url = new URL(this.baseUrl + path);
+    for (const [key, value] of Object.entries(query)) {
+      if (value === undefined) continue;
+      for (const item of Array.isArray(value) ? value : [value]) {
+        url.searchParams.append(key, String(item));
+      }
+    }
+    const requestHeaders: Record<string, string> = { Accept: 'application/json' };
+    for (const [key, value] of Object.entries(headers)) {
+      if (value !== undefined) requestHeaders[key] = Array.isArray(value) ? value.join(',') : String(value);
+    }
+    if (body !== undefined) requestHeaders['Content-Type'] = 'application/json';
+`)
+	if auth.bearer {
+		sb.WriteString("    if (this.options.token) requestHeaders['Authorization'] = `Bearer ${this.options.token}`;\n")
+	}
+	if auth.basic {
+		sb.WriteString("    if (this.options.username !== undefined || this.options.password !== undefined) {\n" +
+			"      requestHeaders['Authorization'] = `Basic ${btoa(`${this.options.username ?? ''}:${this.options.password ?? ''}`)}`;\n    }\n")
+	}
+	if auth.apiKey {
+		if auth.apiKeyIn == "query" {
+			fmt.Fprintf(&sb, "    if (this.options.apiKey) url.searchParams.set(%s, this.options.apiKey);\n", tsString(auth.apiKeyName))
+		} else {
+			fmt.Fprintf(&sb, "    if (this.options.apiKey) requestHeaders[%s] = this.options.apiKey;\n", tsString(auth.apiKeyName))
+		}
+	}
+	sb.WriteString(`
+    const response = await this.fetchFn(url.toString(), {
+      method,
+      headers: requestHeaders,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+    const text = await response.text();
+    if (!response.ok) throw new ApiError(response.status, text);
+    return (text ? JSON.parse(text) : undefined) as T;
+  }
+}
+`)
+	return sb.String()
+}
+
+// tsOperation holds the TypeScript names of an operation's arguments
+type tsOperation struct {
+	pathArgs []string
+	args     []string // parameter declarations, required before optional
+}
+
+func (e *typeScriptSDKEmitter) names(op *sdkOperation) tsOperation {
+	used := make(map[string]bool)
+	var names tsOperation
+	for _, param := range op.pathParams {
+		name := camelName(param.name)
+		if tsReserved[name] {
+			name += "Param"
+		}
+		name = uniqueIdent(used, name)
+		names.pathArgs = append(names.pathArgs, name)
+		names.args = append(names.args, name+": "+tsType(param.typ))
+	}
+
+	var required, optional []string
+	if op.body != nil {
+		if op.bodyRequired {
+			required = append(required, "body: "+tsType(*op.body))
+		} else {
+			optional = append(optional, "body?: "+tsType(*op.body))
+		}
+	}
+	if len(op.queryParams)+len(op.headerParams) > 0 {
+		paramsRequired := false
+		for _, param := range append(append([]sdkParam{}, op.queryParams...), op.headerParams...) {
+			paramsRequired = paramsRequired || param.required
+		}
+		if paramsRequired {
+			required = append(required, "params: "+op.name+"Params")
+		} else {
+			optional = append(optional, "params?: "+op.name+"Params")
+		}
+	}
+	names.args = append(append(names.args, required...), optional...)
+	return names
+}
+
+func (e *typeScriptSDKEmitter) writeOperation(sb *strings.Builder, op *sdkOperation) {
+	names := e.names(op)
+
+	doc := op.method + " " + op.path
+	for _, text := range []string{op.summary, op.description} {
+		if text != "" {
+			doc += "\n\n" + text
+		}
+	}
+	if op.deprecated {
+		doc += "\n\n@deprecated"
+	}
+	sb.WriteString("\n")
+	writeTSDoc(sb, "  ", doc)
+
+	result := "void"
+	if op.result != nil {
+		result = tsType(*op.result)
+	}
+	fmt.Fprintf(sb, "  async %s(%s): Promise<%s> {\n", camelName(op.name), strings.Join(names.args, ", "), result)
+
+	var path strings.Builder
+	pathArg := 0
+	for _, segment := range splitPathTemplate(op.path) {
+		if segment.param {
+			fmt.Fprintf(&path, "${encodeURIComponent(String(%s))}", names.pathArgs[pathArg])
+			pathArg++
+		} else {
+			path.WriteString(strings.NewReplacer("`", "\\`", "$", "\\$").Replace(segment.text))
+		}
+	}
+	if path.Len() == 0 {
+		path.WriteString("/")
+	}
+
+	args := []string{tsString(op.method), "`" + path.String() + "`"}
+	query, headers := tsParamObject(op.queryParams), tsParamObject(op.headerParams)
+	if op.body != nil || headers != "{}" || query != "{}" {
+		args = append(args, query)
+	}
+	if op.body != nil || headers != "{}" {
+		args = append(args, headers)
+	}
+	if op.body != nil {
+		args = append(args, "body")
+	}
+	fmt.Fprintf(sb, "    return this.request<%s>(%s);\n  }\n", result, strings.Join(args, ", "))
+}
+
+// tsParamObject is an object literal reading params from the params argument
+func tsParamObject(params []sdkParam) string {
+	if len(params) == 0 {
+		return "{}"
+	}
+	entries := make([]string, 0, len(params))
+	for _, param := range params {
+		access := "params?." + param.name
+		if !tsIdentifier.MatchString(param.name) {
+			access = "params?.[" + tsString(param.name) + "]"
+		}
+		entries = append(entries, tsKey(param.name)+": "+access)
+	}
+	return "{ " + strings.Join(entries, ", ") + " }"
+}
+
+// usesModel reports whether the client file refers to the model by name
+func (e *typeScriptSDKEmitter) usesModel(name string) bool {
+	var uses func(t sdkType) bool
+	uses = func(t sdkType) bool {
+		if t.kind == sdkNamed {
+			return t.name == name
+		}
+		return t.elem != nil && uses(*t.elem)
+	}
+	for _, op := range e.api.operations {
+		for _, param := range append(append(append([]sdkParam{}, op.pathParams...), op.queryParams...), op.headerParams...) {
+			if uses(param.typ) {
+				return true
+			}
+		}
+		if (op.body != nil && uses(*op.body)) || (op.result != nil && uses(*op.result)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *typeScriptSDKEmitter) testFile() string {
+	var sb strings.Builder
+	sb.WriteString(e.header())
+	sb.WriteString(`import { describe, expect, it } from 'vitest';
+import { Client } from './client';
+
+function recordingClient() {
+  const calls: Array<{ url: string; method?: string }> = [];
+  const client = new Client({
+    baseUrl: 'https://api.example.com',
+    fetch: async (input, init) => {
+      calls.push({ url: String(input), method: init?.method });
+      return new Response('null', { status: 200, headers: { 'Content-Type': 'application/json' } });
+    },
+  });
+  return { client, calls };
+}
+
+`)
+	fmt.Fprintf(&sb, "describe('Client', () => {\n")
+	for _, op := range e.api.operations {
+		names := e.names(op)
+
+		var args []string
+		var path strings.Builder
+		pathArg := 0
+		for _, segment := range splitPathTemplate(op.path) {
+			if segment.param {
+				param := op.pathParams[pathArg]
+				switch param.typ.kind {
+				case sdkInteger, sdkNumber:
+					args = append(args, "0")
+					path.WriteString("0")
+				case sdkBoolean:
+					args = append(args, "false")
+					path.WriteString("false")
+				default:
+					args = append(args, "'' as never")
+				}
+				pathArg++
+			} else {
+				path.WriteString(segment.text)
+			}
+		}
+		for _, arg := range names.args[len(names.pathArgs):] {
+			if strings.HasPrefix(arg, "body:") || strings.HasPrefix(arg, "params:") {
+				args = append(args, "{} as never")
+			}
+		}
+
+		method := camelName(op.name)
+		fmt.Fprintf(&sb, "  it(%s, async () => {\n", tsString(fmt.Sprintf("%s sends %s %s", method, op.method, op.path)))
+		sb.WriteString("    const { client, calls } = recordingClient();\n")
+		fmt.Fprintf(&sb, "    await client.%s(%s);\n", method, strings.Join(args, ", "))
+		fmt.Fprintf(&sb, "    expect(calls).toHaveLength(1);\n    expect(calls[0].method).toBe(%s);\n", tsString(op.method))
+		fmt.Fprintf(&sb, "    expect(new URL(calls[0].url).pathname).toBe(%s);\n  });\n", tsString(path.String()))
+	}
+	if len(e.api.operations) == 0 {
+		sb.WriteString("  it('constructs', () => {\n    expect(recordingClient().client).toBeInstanceOf(Client);\n  });\n")
+	}
+	sb.WriteString("});\n")
+	return sb.String()
+}
+
+func (e *typeScriptSDKEmitter) readme() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s TypeScript client\n\n", e.api.title)
+	if e.api.description != "" {
+		fmt.Fprintf(&sb, "%s\n\n", e.api.description)
+	}
+	fmt.Fprintf(&sb, "Generated from version %s of the API.\n\n", e.api.version)
+	fmt.Fprintf(&sb, "```ts\nimport { Client } from '%s';\n\nconst client = new Client({ baseUrl: 'https://api.example.com' });\n```\n\n", e.packageName)
+	sb.WriteString("## Operations\n\n| Method | Request |\n| --- | --- |\n")
+	for _, op := range e.api.operations {
+		fmt.Fprintf(&sb, "| `%s` | `%s %s` |\n", camelName(op.name), op.method, op.path)
+	}
+	return sb.String()
+}
+
+// tsType is the TypeScript type for t
+func tsType(t sdkType) string {
+	switch t.kind {
+	case sdkString:
+		return "string"
+	case sdkInteger, sdkNumber:
+		return "number"
+	case sdkBoolean:
+		return "boolean"
+	case sdkArray:
+		return tsType(*t.elem) + "[]"
+	case sdkMap:
+		return "Record<string, " + tsType(*t.elem) + ">"
+	case sdkNamed:
+		return t.name
+	}
+	return "unknown"
+}
+
+// tsKey is s as an object key, quoted when it is not an identifier
+func tsKey(s string) string {
+	if tsIdentifier.MatchString(s) {
+		return s
+	}
+	return tsString(s)
+}
+
+// tsString is s as a single-quoted string literal
+func tsString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`, "\n", `\n`).Replace(s) + "'"
+}
+
+// writeTSDoc writes text as a JSDoc comment
+func writeTSDoc(sb *strings.Builder, indent, text string) {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "*/", "*\\/"))
+	if text == "" {
+		return
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(sb, "%s/** %s */\n", indent, lines[0])
+		return
+	}
+	fmt.Fprintf(sb, "%s/**\n", indent)
+	for _, line := range lines {
+		fmt.Fprintf(sb, "%s%s\n", indent, strings.TrimRight(" * "+line, " "))
+	}
+	fmt.Fprintf(sb, "%s */\n", indent)
+}
+
+// npmPackageName converts s to a valid npm package name
+func npmPackageName(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.', r == '_', r == '/', r == '@':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('-')
+		}
+	}
+	name := strings.Trim(sb.String(), "-._")
+	if name == "" {
+		return "api-client"
+	}
+	return name
+}
@@ -0,0 +1,568 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+// SEOPageSource loads pages for SEO generation. PageRepository satisfies it.
+type SEOPageSource interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Page, error)
+	ListPagesByWorkspace(ctx context.Context, workspaceID uuid.UUID, filters PageFilters) ([]*domain.Page, error)
+}
+
+// Recommended upper bounds for meta content; search engines truncate beyond these
+const (
+	MaxSEOTitleLength       = 60
+	MaxSEODescriptionLength = 160
+	MinSEODescriptionLength = 50
+	MaxSEOKeywords          = 10
+)
+
+// Sitemap protocol limits (https://www.sitemaps.org/protocol.html)
+const (
+	sitemapNamespace      = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	sitemapMaxURLs        = 50000
+	sitemapMaxURLLength   = 2048
+	sitemapPageBatchSize  = 500
+	defaultSitemapFreq    = "weekly"
+	defaultSitemapWeight  = 0.5
+	featuredSitemapWeight = 0.8
+)
+
+// SEO issue types reported by AnalyzeSEO
+const (
+	SEOIssueMissingTitle       = "missing_title"
+	SEOIssueTitleTooLong       = "title_too_long"
+	SEOIssueMissingDescription = "missing_description"
+	SEOIssueDescriptionLong    = "description_too_long"
+	SEOIssueDescriptionShort   = "description_too_short"
+	SEOIssueTooManyKeywords    = "too_many_keywords"
+	SEOIssueMissingH1          = "missing_h1"
+)
+
+// ErrSitemapBaseURLRequired is returned when a sitemap is requested without
+// the absolute site URL that sitemap <loc> entries require
+var ErrSitemapBaseURLRequired = errors.New("sitemap generation requires an absolute base URL")
+
+// SEOOptimizerConfig configures the default SEO optimizer
+type SEOOptimizerConfig struct {
+	BaseURL      string `json:"base_url"`      // absolute site URL pages are served under
+	SiteName     string `json:"site_name"`     // og:site_name and JSON-LD publisher
+	TwitterSite  string `json:"twitter_site"`  // @handle for twitter:site
+	DefaultImage string `json:"default_image"` // og:image / twitter:image fallback
+}
+
+// DefaultSEOOptimizer implements SEOOptimizer from page fields and content
+type DefaultSEOOptimizer struct {
+	pages  SEOPageSource
+	config SEOOptimizerConfig
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewDefaultSEOOptimizer creates a new SEO optimizer instance
+func NewDefaultSEOOptimizer(pages SEOPageSource, config SEOOptimizerConfig, logger *slog.Logger) *DefaultSEOOptimizer {
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	return &DefaultSEOOptimizer{
+		pages:  pages,
+		config: config,
+		logger: logger.With("component", "seo_optimizer"),
+		now:    time.Now,
+	}
+}
+
+// OptimizePage analyzes a page and generates the requested meta tags and
+// structured data. Content is returned unchanged.
+func (o *DefaultSEOOptimizer) OptimizePage(ctx context.Context, req *SEOOptimizationRequest) (*SEOOptimizationResult, error) {
+	page, err := o.pages.GetByID(ctx, req.PageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+
+	metadata := pageSEOMetadata(page)
+	for key, value := range req.Metadata {
+		if _, ok := metadata[key]; !ok {
+			metadata[key] = value
+		}
+	}
+	content := req.Content
+	if content == "" {
+		content = page.Content
+	}
+
+	analysis, err := o.AnalyzeSEO(ctx, content, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SEOOptimizationResult{
+		Success:          true,
+		OptimizedContent: content,
+		Recommendations:  analysis.Recommendations,
+		Issues:           analysis.Issues,
+		Score:            analysis.Score,
+		OptimizedAt:      o.now(),
+	}
+	if req.Options.GenerateMetaTags {
+		if result.MetaTags, err = o.GenerateMetaTags(ctx, page); err != nil {
+			return nil, err
+		}
+	}
+	if req.Options.GenerateStructuredData {
+		if result.StructuredData, err = o.GenerateStructuredData(ctx, page); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GenerateMetaTags derives standard, OpenGraph and Twitter meta tags for a page
+func (o *DefaultSEOOptimizer) GenerateMetaTags(ctx context.Context, page *domain.Page) (*MetaTags, error) {
+	title := firstNonEmpty(page.SEOTitle, page.Title)
+	description := firstNonEmpty(page.SEODescription, page.Description)
+	pageURL := o.pageURL(page)
+
+	robots := page.MetaRobots
+	if robots == "" {
+		robots = "index, follow"
+		if !page.IsIndexable || page.RequireAuth {
+			robots = "noindex, nofollow"
+		}
+	}
+
+	tags := &MetaTags{
+		Title:       title,
+		Description: description,
+		Keywords:    strings.Join(pageKeywords(page), ", "),
+		Canonical:   pageURL,
+		Robots:      robots,
+		Viewport:    "width=device-width, initial-scale=1",
+		OpenGraph: map[string]string{
+			"og:title": title,
+			"og:type":  "article",
+		},
+		TwitterCard: map[string]string{
+			"twitter:card":  "summary",
+			"twitter:title": title,
+		},
+		Custom: map[string]string{},
+	}
+	if page.Creator != nil {
+		tags.Author = firstNonEmpty(page.Creator.DisplayName, page.Creator.Username)
+	}
+
+	setIfPresent(tags.OpenGraph, "og:description", description)
+	setIfPresent(tags.OpenGraph, "og:url", pageURL)
+	setIfPresent(tags.OpenGraph, "og:site_name", o.config.SiteName)
+	setIfPresent(tags.OpenGraph, "og:image", o.config.DefaultImage)
+	if page.PublishedAt != nil {
+		tags.OpenGraph["article:published_time"] = page.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	if !page.UpdatedAt.IsZero() {
+		tags.OpenGraph["article:modified_time"] = page.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	setIfPresent(tags.TwitterCard, "twitter:description", description)
+	setIfPresent(tags.TwitterCard, "twitter:site", o.config.TwitterSite)
+	if o.config.DefaultImage != "" {
+		tags.TwitterCard["twitter:card"] = "summary_large_image"
+		tags.TwitterCard["twitter:image"] = o.config.DefaultImage
+	}
+
+	return tags, nil
+}
+
+// GenerateStructuredData builds schema.org JSON-LD describing a page
+func (o *DefaultSEOOptimizer) GenerateStructuredData(ctx context.Context, page *domain.Page) (*StructuredData, error) {
+	schemaType := "WebPage"
+	if page.Type == string(PageTypeStatic) || page.Type == string(PageTypeDynamic) {
+		schemaType = "Article"
+	}
+
+	properties := map[string]interface{}{
+		"@context": "https://schema.org",
+		"@type":    schemaType,
+		"headline": firstNonEmpty(page.SEOTitle, page.Title),
+		"name":     page.Title,
+	}
+	if description := firstNonEmpty(page.SEODescription, page.Description); description != "" {
+		properties["description"] = description
+	}
+	if pageURL := o.pageURL(page); pageURL != "" {
+		properties["url"] = pageURL
+		properties["mainEntityOfPage"] = pageURL
+	}
+	if keywords := pageKeywords(page); len(keywords) > 0 {
+		properties["keywords"] = strings.Join(keywords, ", ")
+	}
+	if page.PublishedAt != nil {
+		properties["datePublished"] = page.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	if !page.UpdatedAt.IsZero() {
+		properties["dateModified"] = page.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	if page.Creator != nil {
+		properties["author"] = map[string]interface{}{
+			"@type": "Person",
+			"name":  firstNonEmpty(page.Creator.DisplayName, page.Creator.Username),
+		}
+	}
+	if o.config.SiteName != "" {
+		properties["publisher"] = map[string]interface{}{
+			"@type": "Organization",
+			"name":  o.config.SiteName,
+		}
+	}
+	if o.config.DefaultImage != "" {
+		properties["image"] = o.config.DefaultImage
+	}
+
+	jsonLD, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal structured data: %w", err)
+	}
+
+	return &StructuredData{
+		Type:       schemaType,
+		Properties: properties,
+		JSONLD:     string(jsonLD),
+	}, nil
+}
+
+var (
+	htmlH1Pattern     = regexp.MustCompile(`(?i)<h1[\s>]`)
+	markdownH1Pattern = regexp.MustCompile(`(?m)^#\s+\S`)
+)
+
+// AnalyzeSEO checks page metadata ("title", "description", "keywords") and
+// content against common search engine guidelines
+func (o *DefaultSEOOptimizer) AnalyzeSEO(ctx context.Context, content string, metadata map[string]interface{}) (*SEOAnalysis, error) {
+	analysis := &SEOAnalysis{
+		Keywords:   KeywordAnalysis{Density: map[string]float64{}, Count: map[string]int{}},
+		AnalyzedAt: o.now(),
+	}
+	issue := func(issueType, severity, message, location, fix string) {
+		analysis.Issues = append(analysis.Issues, SEOIssue{
+			Type: issueType, Severity: severity, Message: message, Location: location, Fix: fix,
+		})
+	}
+
+	title := strings.TrimSpace(metadataString(metadata, "title"))
+	switch length := utf8.RuneCountInString(title); {
+	case length == 0:
+		issue(SEOIssueMissingTitle, "critical", "Page has no title", "title",
+			"Add a descriptive title of at most 60 characters")
+	case length > MaxSEOTitleLength:
+		issue(SEOIssueTitleTooLong, "warning",
+			fmt.Sprintf("Title is %d characters; search results truncate after %d", length, MaxSEOTitleLength),
+			"title", "Shorten the title")
+	}
+
+	description := strings.TrimSpace(metadataString(metadata, "description"))
+	switch length := utf8.RuneCountInString(description); {
+	case length == 0:
+		issue(SEOIssueMissingDescription, "critical", "Page has no meta description", "description",
+			"Add a summary of 50-160 characters")
+	case length > MaxSEODescriptionLength:
+		issue(SEOIssueDescriptionLong, "warning",
+			fmt.Sprintf("Description is %d characters; search results truncate after %d", length, MaxSEODescriptionLength),
+			"description", "Shorten the description")
+	case length < MinSEODescriptionLength:
+		issue(SEOIssueDescriptionShort, "info",
+			fmt.Sprintf("Description is %d characters; aim for at least %d", length, MinSEODescriptionLength),
+			"description", "Expand the description")
+	}
+
+	keywords := metadataStrings(metadata, "keywords")
+	if len(keywords) > MaxSEOKeywords {
+		issue(SEOIssueTooManyKeywords, "warning",
+			fmt.Sprintf("Page has %d keywords; keep it to %d or fewer", len(keywords), MaxSEOKeywords),
+			"keywords", "Remove less relevant keywords")
+	}
+	o.analyzeKeywords(content, keywords, &analysis.Keywords)
+
+	if content != "" && !htmlH1Pattern.MatchString(content) && !markdownH1Pattern.MatchString(content) {
+		analysis.Recommendations = append(analysis.Recommendations, SEORecommendation{
+			Type:       "headings",
+			Message:    "Content has no top-level heading",
+			Action:     "Start the page with a single H1 that matches the title",
+			Impact:     "medium",
+			Difficulty: "easy",
+		})
+		issue(SEOIssueMissingH1, "info", "Content has no top-level heading", "content", "Add an H1 heading")
+	}
+
+	for _, found := range analysis.Issues {
+		if found.Type == SEOIssueMissingH1 {
+			continue
+		}
+		analysis.Recommendations = append(analysis.Recommendations, SEORecommendation{
+			Type:       found.Location,
+			Message:    found.Message,
+			Action:     found.Fix,
+			Impact:     seoIssueImpact(found.Severity),
+			Difficulty: "easy",
+		})
+	}
+
+	analysis.Score = seoScore(analysis.Issues)
+	return analysis, nil
+}
+
+// GenerateSitemap builds sitemap XML for the published, indexable pages of a workspace
+func (o *DefaultSEOOptimizer) GenerateSitemap(ctx context.Context, workspaceID uuid.UUID) (*Sitemap, error) {
+	if !isAbsoluteURL(o.config.BaseURL) {
+		return nil, ErrSitemapBaseURLRequired
+	}
+
+	sitemap := &Sitemap{GeneratedAt: o.now()}
+	filters := PageFilters{
+		Status:    []PageStatus{PageStatusPublished},
+		Limit:     sitemapPageBatchSize,
+		SortBy:    "updated_at",
+		SortOrder: "desc",
+	}
+	for {
+		pages, err := o.pages.ListPagesByWorkspace(ctx, workspaceID, filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pages: %w", err)
+		}
+		for _, page := range pages {
+			if entry, ok := o.sitemapURL(page); ok {
+				sitemap.URLs = append(sitemap.URLs, entry)
+			}
+		}
+		if len(pages) < filters.Limit {
+			break
+		}
+		filters.Offset += len(pages)
+	}
+
+	if len(sitemap.URLs) > sitemapMaxURLs {
+		o.logger.WarnContext(ctx, "Sitemap truncated to protocol limit",
+			"workspace_id", workspaceID, "urls", len(sitemap.URLs), "limit", sitemapMaxURLs)
+		sitemap.URLs = sitemap.URLs[:sitemapMaxURLs]
+	}
+
+	content, err := marshalSitemap(sitemap.URLs)
+	if err != nil {
+		return nil, err
+	}
+	sitemap.XMLContent = content
+
+	o.logger.InfoContext(ctx, "Sitemap generated", "workspace_id", workspaceID, "urls", len(sitemap.URLs))
+	return sitemap, nil
+}
+
+// sitemapURL returns the sitemap entry for a page, or false when the page
+// should not be listed
+func (o *DefaultSEOOptimizer) sitemapURL(page *domain.Page) (SitemapURL, bool) {
+	if page.Status != string(PageStatusPublished) || !page.IsIndexable || page.RequireAuth {
+		return SitemapURL{}, false
+	}
+	if strings.Contains(strings.ToLower(page.MetaRobots), "noindex") {
+		return SitemapURL{}, false
+	}
+	location := o.pageURL(page)
+	if !isAbsoluteURL(location) || len(location) > sitemapMaxURLLength {
+		return SitemapURL{}, false
+	}
+
+	priority := defaultSitemapWeight
+	if page.IsFeatured || page.IsPinned {
+		priority = featuredSitemapWeight
+	}
+	if strings.Trim(page.Path, "/") == "" {
+		priority = 1.0
+	}
+
+	return SitemapURL{
+		Location:     location,
+		LastModified: page.UpdatedAt,
+		ChangeFreq:   defaultSitemapFreq,
+		Priority:     priority,
+	}, true
+}
+
+// pageURL returns the page's canonical URL, falling back to its path under the base URL
+func (o *DefaultSEOOptimizer) pageURL(page *domain.Page) string {
+	if page.CanonicalURL != "" {
+		return page.CanonicalURL
+	}
+	if o.config.BaseURL == "" {
+		return ""
+	}
+	pagePath := page.Path
+	if pagePath == "" {
+		pagePath = page.Slug
+	}
+	return o.config.BaseURL + "/" + strings.TrimLeft(pagePath, "/")
+}
+
+// analyzeKeywords counts target keyword occurrences in the content text
+func (o *DefaultSEOOptimizer) analyzeKeywords(content string, keywords []string, result *KeywordAnalysis) {
+	text := strings.ToLower(stripMarkup(content))
+	words := len(strings.Fields(text))
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" {
+			continue
+		}
+		count := strings.Count(text, keyword)
+		result.Count[keyword] = count
+		if words > 0 {
+			result.Density[keyword] = float64(count) / float64(words) * 100
+		}
+		if count == 0 {
+			result.Suggestions = append(result.Suggestions,
+				fmt.Sprintf("Keyword %q does not appear in the content", keyword))
+		}
+	}
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name          `xml:"urlset"`
+	Xmlns   string            `xml:"xmlns,attr"`
+	URLs    []sitemapURLEntry `xml:"url"`
+}
+
+type sitemapURLEntry struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod,omitempty"`
+	ChangeFreq string `xml:"changefreq,omitempty"`
+	Priority   string `xml:"priority,omitempty"`
+}
+
+// marshalSitemap renders URLs as a sitemaps.org 0.9 urlset document
+func marshalSitemap(urls []SitemapURL) (string, error) {
+	set := sitemapURLSet{Xmlns: sitemapNamespace, URLs: make([]sitemapURLEntry, 0, len(urls))}
+	for _, u := range urls {
+		entry := sitemapURLEntry{
+			Loc:        u.Location,
+			ChangeFreq: u.ChangeFreq,
+			Priority:   fmt.Sprintf("%.1f", u.Priority),
+		}
+		if !u.LastModified.IsZero() {
+			entry.LastMod = u.LastModified.UTC().Format(time.RFC3339)
+		}
+		set.URLs = append(set.URLs, entry)
+	}
+
+	content, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal sitemap: %w", err)
+	}
+	return xml.Header + string(content) + "\n", nil
+}
+
+// seoScore derives scores from the issues found; each critical issue costs
+// 25 points, each warning 10 and each informational issue 5
+func seoScore(issues []SEOIssue) SEOScore {
+	content := 100
+	for _, found := range issues {
+		switch found.Severity {
+		case "critical":
+			content -= 25
+		case "warning":
+			content -= 10
+		default:
+			content -= 5
+		}
+	}
+	if content < 0 {
+		content = 0
+	}
+	return SEOScore{Overall: content, Content: content}
+}
+
+func seoIssueImpact(severity string) string {
+	switch severity {
+	case "critical":
+		return "high"
+	case "warning":
+		return "medium"
+	}
+	return "low"
+}
+
+// pageSEOMetadata returns the metadata AnalyzeSEO checks, taken from a page
+func pageSEOMetadata(page *domain.Page) map[string]interface{} {
+	return map[string]interface{}{
+		"title":       firstNonEmpty(page.SEOTitle, page.Title),
+		"description": firstNonEmpty(page.SEODescription, page.Description),
+		"keywords":    pageKeywords(page),
+	}
+}
+
+func pageKeywords(page *domain.Page) []string {
+	if len(page.SEOKeywords) > 0 {
+		return page.SEOKeywords
+	}
+	return page.Keywords
+}
+
+func metadataString(metadata map[string]interface{}, key string) string {
+	value, _ := metadata[key].(string)
+	return value
+}
+
+func metadataStrings(metadata map[string]interface{}, key string) []string {
+	switch value := metadata[key].(type) {
+	case []string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	case string:
+		if value == "" {
+			return nil
+		}
+		return strings.Split(value, ",")
+	}
+	return nil
+}
+
+var markupTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+func stripMarkup(content string) string {
+	return markupTagPattern.ReplaceAllString(content, " ")
+}
+
+func isAbsoluteURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func setIfPresent(tags map[string]string, key, value string) {
+	if value != "" {
+		tags[key] = value
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+type memorySEOPageSource struct {
+	pages    []*domain.Page
+	requests []PageFilters
+}
+
+func (s *memorySEOPageSource) GetByID(_ context.Context, id uuid.UUID) (*domain.Page, error) {
+	for _, page := range s.pages {
+		if page.ID == id {
+			return page, nil
+		}
+	}
+	return nil, fmt.Errorf("page %s not found", id)
+}
+
+func (s *memorySEOPageSource) ListPagesByWorkspace(_ context.Context, workspaceID uuid.UUID, filters PageFilters) ([]*domain.Page, error) {
+	s.requests = append(s.requests, filters)
+	var matched []*domain.Page
+	for _, page := range s.pages {
+		if page.WorkspaceID != workspaceID {
+			continue
+		}
+		for _, status := range filters.Status {
+			if page.Status == string(status) {
+				matched = append(matched, page)
+			}
+		}
+	}
+	if filters.Offset >= len(matched) {
+		return nil, nil
+	}
+	matched = matched[filters.Offset:]
+	if filters.Limit > 0 && len(matched) > filters.Limit {
+		matched = matched[:filters.Limit]
+	}
+	return matched, nil
+}
+
+func newTestSEOOptimizer(pages ...*domain.Page) (*DefaultSEOOptimizer, *memorySEOPageSource) {
+	source := &memorySEOPageSource{pages: pages}
+	optimizer := NewDefaultSEOOptimizer(source, SEOOptimizerConfig{
+		BaseURL:     "https://docs.example.com/",
+		SiteName:    "Orbit Docs",
+		TwitterSite: "@orbit",
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return optimizer, source
+}
+
+func samplePage(workspaceID uuid.UUID, path string) *domain.Page {
+	published := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	return &domain.Page{
+		ID:          uuid.New(),
+		WorkspaceID: workspaceID,
+		Title:       "Getting Started",
+		Slug:        strings.Trim(path, "/"),
+		Path:        path,
+		Content:     "<h1>Getting Started</h1><p>Install the orbit CLI.</p>",
+		Type:        string(PageTypeStatic),
+		Status:      string(PageStatusPublished),
+		Description: "Install the Orbit CLI and create your first workspace in five minutes.",
+		Keywords:    []string{"orbit", "cli"},
+		IsIndexable: true,
+		PublishedAt: &published,
+		UpdatedAt:   time.Date(2024, 3, 2, 10, 30, 0, 0, time.FixedZone("EST", -5*3600)),
+		Creator:     &domain.User{Username: "jdoe", DisplayName: "Jordan Doe"},
+	}
+}
+
+func TestDefaultSEOOptimizer_GenerateMetaTags(t *testing.T) {
+	page := samplePage(uuid.New(), "/guides/getting-started")
+	page.SEOTitle = "Getting Started with Orbit"
+	optimizer, _ := newTestSEOOptimizer(page)
+
+	tags, err := optimizer.GenerateMetaTags(context.Background(), page)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Getting Started with Orbit", tags.Title)
+	assert.Equal(t, page.Description, tags.Description)
+	assert.Equal(t, "orbit, cli", tags.Keywords)
+	assert.Equal(t, "Jordan Doe", tags.Author)
+	assert.Equal(t, "https://docs.example.com/guides/getting-started", tags.Canonical)
+	assert.Equal(t, "index, follow", tags.Robots)
+	assert.Equal(t, map[string]string{
+		"og:title":               "Getting Started with Orbit",
+		"og:type":                "article",
+		"og:description":         page.Description,
+		"og:url":                 "https://docs.example.com/guides/getting-started",
+		"og:site_name":           "Orbit Docs",
+		"article:published_time": "2024-03-01T09:00:00Z",
+		"article:modified_time":  "2024-03-02T15:30:00Z",
+	}, tags.OpenGraph)
+	assert.Equal(t, map[string]string{
+		"twitter:card":        "summary",
+		"twitter:title":       "Getting Started with Orbit",
+		"twitter:description": page.Description,
+		"twitter:site":        "@orbit",
+	}, tags.TwitterCard)
+
+	page.IsIndexable = false
+	tags, err = optimizer.GenerateMetaTags(context.Background(), page)
+	require.NoError(t, err)
+	assert.Equal(t, "noindex, nofollow", tags.Robots)
+}
+
+func TestDefaultSEOOptimizer_GenerateStructuredData(t *testing.T) {
+	page := samplePage(uuid.New(), "/guides/getting-started")
+	optimizer, _ := newTestSEOOptimizer(page)
+
+	data, err := optimizer.GenerateStructuredData(context.Background(), page)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", data.Type)
+
+	var jsonLD map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(data.JSONLD), &jsonLD))
+	assert.Equal(t, "https://schema.org", jsonLD["@context"])
+	assert.Equal(t, "Article", jsonLD["@type"])
+	assert.Equal(t, "Getting Started", jsonLD["headline"])
+	assert.Equal(t, "https://docs.example.com/guides/getting-started", jsonLD["url"])
+	assert.Equal(t, "2024-03-02T15:30:00Z", jsonLD["dateModified"])
+	assert.Equal(t, map[string]interface{}{"@type": "Person", "name": "Jordan Doe"}, jsonLD["author"])
+	assert.Equal(t, map[string]interface{}{"@type": "Organization", "name": "Orbit Docs"}, jsonLD["publisher"])
+}
+
+func TestDefaultSEOOptimizer_AnalyzeSEO(t *testing.T) {
+	optimizer, _ := newTestSEOOptimizer()
+
+	analysis, err := optimizer.AnalyzeSEO(context.Background(), "<p>No heading here</p>", map[string]interface{}{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{SEOIssueMissingTitle, SEOIssueMissingDescription, SEOIssueMissingH1}, seoIssueTypes(analysis.Issues))
+	assert.Equal(t, 45, analysis.Score.Overall)
+
+	analysis, err = optimizer.AnalyzeSEO(context.Background(), "# Orbit\n\nOrbit is an internal developer portal.", map[string]interface{}{
+		"title":       strings.Repeat("Long title ", 7),
+		"description": strings.Repeat("A very long description. ", 8),
+		"keywords":    []interface{}{"orbit", "kafka"},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{SEOIssueTitleTooLong, SEOIssueDescriptionLong}, seoIssueTypes(analysis.Issues))
+	assert.Equal(t, 2, analysis.Keywords.Count["orbit"])
+	assert.Equal(t, []string{`Keyword "kafka" does not appear in the content`}, analysis.Keywords.Suggestions)
+	require.Len(t, analysis.Recommendations, 2)
+	assert.Equal(t, "medium", analysis.Recommendations[0].Impact)
+
+	analysis, err = optimizer.AnalyzeSEO(context.Background(), "<h1>Orbit</h1>", map[string]interface{}{
+		"title":       "Orbit",
+		"description": "Orbit is an internal developer portal for platform teams.",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, analysis.Issues)
+	assert.Equal(t, 100, analysis.Score.Overall)
+}
+
+func seoIssueTypes(issues []SEOIssue) []string {
+	types := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		types = append(types, issue.Type)
+	}
+	return types
+}
+
+func TestDefaultSEOOptimizer_GenerateSitemap(t *testing.T) {
+	workspaceID := uuid.New()
+	home := samplePage(workspaceID, "/")
+	guide := samplePage(workspaceID, "/guides/getting-started")
+	guide.IsFeatured = true
+	special := samplePage(workspaceID, "/search?q=a&b=<c>")
+	private := samplePage(workspaceID, "/internal")
+	private.RequireAuth = true
+	noindex := samplePage(workspaceID, "/drafts")
+	noindex.MetaRobots = "noindex"
+	draft := samplePage(workspaceID, "/wip")
+	draft.Status = string(PageStatusDraft)
+	other := samplePage(uuid.New(), "/elsewhere")
+
+	optimizer, _ := newTestSEOOptimizer(home, guide, special, private, noindex, draft, other)
+	sitemap, err := optimizer.GenerateSitemap(context.Background(), workspaceID)
+	require.NoError(t, err)
+
+	set := validateSitemapXML(t, sitemap.XMLContent)
+	require.Len(t, set.URLs, 3)
+	assert.Equal(t, "https://docs.example.com/", set.URLs[0].Loc)
+	assert.Equal(t, "1.0", set.URLs[0].Priority)
+	assert.Equal(t, "https://docs.example.com/guides/getting-started", set.URLs[1].Loc)
+	assert.Equal(t, "0.8", set.URLs[1].Priority)
+	assert.Equal(t, "2024-03-02T15:30:00Z", set.URLs[1].LastMod)
+	assert.Equal(t, "https://docs.example.com/search?q=a&b=<c>", set.URLs[2].Loc)
+	assert.Contains(t, sitemap.XMLContent, "<loc>https://docs.example.com/search?q=a&amp;b=&lt;c&gt;</loc>")
+
+	require.Len(t, sitemap.URLs, 3)
+	assert.True(t, sitemap.URLs[1].LastModified.Equal(guide.UpdatedAt))
+}
+
+func TestDefaultSEOOptimizer_GenerateSitemapPagesThroughWorkspace(t *testing.T) {
+	workspaceID := uuid.New()
+	var pages []*domain.Page
+	for i := 0; i < sitemapPageBatchSize+3; i++ {
+		pages = append(pages, samplePage(workspaceID, fmt.Sprintf("/page-%d", i)))
+	}
+	optimizer, source := newTestSEOOptimizer(pages...)
+
+	sitemap, err := optimizer.GenerateSitemap(context.Background(), workspaceID)
+	require.NoError(t, err)
+	assert.Len(t, validateSitemapXML(t, sitemap.XMLContent).URLs, sitemapPageBatchSize+3)
+	require.Len(t, source.requests, 2)
+	assert.Equal(t, sitemapPageBatchSize, source.requests[1].Offset)
+}
+
+func TestDefaultSEOOptimizer_GenerateSitemapRequiresBaseURL(t *testing.T) {
+	optimizer := NewDefaultSEOOptimizer(&memorySEOPageSource{}, SEOOptimizerConfig{BaseURL: "/docs"},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	_, err := optimizer.GenerateSitemap(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, ErrSitemapBaseURLRequired)
+}
+
+// validateSitemapXML checks a document against the constraints of the
+// sitemaps.org 0.9 schema (sitemap.xsd): a urlset root in the sitemap
+// namespace containing at most 50,000 url elements, each with an absolute
+// <loc> of at most 2,048 characters and optional W3C-datetime <lastmod>,
+// enumerated <changefreq> and 0.0-1.0 <priority>.
+func validateSitemapXML(t *testing.T, document string) sitemapURLSet {
+	t.Helper()
+	require.True(t, strings.HasPrefix(document, xml.Header), "missing XML declaration")
+
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	var set sitemapURLSet
+	var depth int
+	var path []string
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		switch el := token.(type) {
+		case xml.StartElement:
+			assert.Equal(t, sitemapNamespace, el.Name.Space, "element %s outside sitemap namespace", el.Name.Local)
+			path = append(path, el.Name.Local)
+			depth++
+			switch depth {
+			case 1:
+				require.Equal(t, "urlset", el.Name.Local)
+			case 2:
+				require.Equal(t, "url", el.Name.Local)
+			case 3:
+				require.Contains(t, []string{"loc", "lastmod", "changefreq", "priority"}, el.Name.Local)
+			default:
+				t.Fatalf("unexpected nesting at %s", strings.Join(path, "/"))
+			}
+		case xml.EndElement:
+			path = path[:len(path)-1]
+			depth--
+		}
+	}
+
+	require.NoError(t, xml.Unmarshal([]byte(document), &set))
+	require.LessOrEqual(t, len(set.URLs), sitemapMaxURLs)
+	changeFreqs := []string{"always", "hourly", "daily", "weekly", "monthly", "yearly", "never"}
+	for _, entry := range set.URLs {
+		loc, err := url.Parse(entry.Loc)
+		require.NoError(t, err)
+		assert.True(t, loc.IsAbs() && loc.Host != "", "loc %q is not absolute", entry.Loc)
+		assert.LessOrEqual(t, len(entry.Loc), sitemapMaxURLLength)
+		if entry.LastMod != "" {
+			_, err := time.Parse(time.RFC3339, entry.LastMod)
+			assert.NoError(t, err, "lastmod %q is not a W3C datetime", entry.LastMod)
+		}
+		if entry.ChangeFreq != "" {
+			assert.Contains(t, changeFreqs, entry.ChangeFreq)
+		}
+		if entry.Priority != "" {
+			priority, err := strconv.ParseFloat(entry.Priority, 64)
+			require.NoError(t, err)
+			assert.True(t, priority >= 0 && priority <= 1, "priority %v out of range", priority)
+		}
+	}
+	return set
+}
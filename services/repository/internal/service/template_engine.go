@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+// TemplateLoader loads stored page templates. PageRepository satisfies it.
+type TemplateLoader interface {
+	GetTemplateByID(ctx context.Context, id uuid.UUID) (*domain.PageTemplate, error)
+}
+
+// Render error codes reported in RenderResult.Errors
+const (
+	RenderErrorParse       = "TEMPLATE_PARSE_ERROR"
+	RenderErrorExecution   = "TEMPLATE_EXECUTION_ERROR"
+	RenderErrorFrontmatter = "INVALID_FRONTMATTER"
+)
+
+// Locations reported in RenderError.Location
+const (
+	renderLocationContent = "content"
+	renderLocationLayout  = "layout"
+)
+
+var helperNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// GoTemplateEngine implements TemplateEngine with Go templates
+// (TemplateFormatGo). HTML content is rendered with html/template, so
+// variables are escaped for their context. Markdown content may start with
+// YAML frontmatter; its body is expanded with text/template and then converted
+// to HTML. A request naming a TemplateID renders the content first and then
+// the stored template as a layout, with the rendered content in .content.
+type GoTemplateEngine struct {
+	loader TemplateLoader
+
+	mu       sync.RWMutex
+	helpers  template.FuncMap
+	partials map[string]string
+	layouts  map[uuid.UUID]*htmltemplate.Template
+
+	now func() time.Time
+}
+
+// NewGoTemplateEngine creates a GoTemplateEngine that loads layouts with loader
+func NewGoTemplateEngine(loader TemplateLoader) *GoTemplateEngine {
+	return &GoTemplateEngine{
+		loader:   loader,
+		helpers:  template.FuncMap{},
+		partials: map[string]string{},
+		layouts:  map[uuid.UUID]*htmltemplate.Template{},
+		now:      time.Now,
+	}
+}
+
+// templateError is a parse or execution failure, located in the source
+type templateError struct {
+	code     string
+	location string
+	err      error
+	line     int
+	column   int
+}
+
+func (e *templateError) Error() string { return e.err.Error() }
+
+// Go template errors look like "template: name:3:5: message", where the
+// column is only present for execution errors
+var templateErrorPattern = regexp.MustCompile(`^template: [^:]*:(\d+)(?::(\d+))?: `)
+
+func newTemplateError(code, location string, err error, lineOffset int) *templateError {
+	te := &templateError{code: code, location: location, err: err}
+	if m := templateErrorPattern.FindStringSubmatch(err.Error()); m != nil {
+		te.line, _ = strconv.Atoi(m[1])
+		te.line += lineOffset
+		if m[2] != "" {
+			te.column, _ = strconv.Atoi(m[2])
+		}
+	}
+	return te
+}
+
+// RegisterHelper makes helper callable from templates as a function
+func (e *GoTemplateEngine) RegisterHelper(name string, helper TemplateHelper) error {
+	if !helperNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid helper name %q", name)
+	}
+	if helper == nil {
+		return fmt.Errorf("helper %q is nil", name)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.helpers[name] = helper
+	// Functions are bound at parse time
+	clear(e.layouts)
+	return nil
+}
+
+// RegisterPartial makes a template includable as {{template "name" .}}
+func (e *GoTemplateEngine) RegisterPartial(name string, content string) error {
+	if name == "" {
+		return errors.New("partial name is required")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := template.New(name).Funcs(e.helpers).Parse(content); err != nil {
+		return fmt.Errorf("invalid partial %q: %w", name, err)
+	}
+	e.partials[name] = content
+	clear(e.layouts)
+	return nil
+}
+
+// ClearCache drops the compiled layout for a stored template
+func (e *GoTemplateEngine) ClearCache(ctx context.Context, templateID uuid.UUID) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.layouts, templateID)
+	return nil
+}
+
+// parseText parses content with text/template, with helpers and partials
+func (e *GoTemplateEngine) parseText(name, content string) (*template.Template, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	t := template.New(name).Funcs(e.helpers)
+	for partialName, partial := range e.partials {
+		if _, err := t.New(partialName).Parse(partial); err != nil {
+			return nil, err
+		}
+	}
+	return t.Parse(content)
+}
+
+// parseHTML parses content with html/template, with helpers and partials
+func (e *GoTemplateEngine) parseHTML(name, content string) (*htmltemplate.Template, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	t := htmltemplate.New(name).Funcs(htmltemplate.FuncMap(e.helpers))
+	for partialName, partial := range e.partials {
+		if _, err := t.New(partialName).Parse(partial); err != nil {
+			return nil, err
+		}
+	}
+	return t.Parse(content)
+}
+
+// layout returns the compiled stored template, loading it on first use
+func (e *GoTemplateEngine) layout(ctx context.Context, templateID uuid.UUID) (*htmltemplate.Template, error) {
+	e.mu.RLock()
+	cached, ok := e.layouts[templateID]
+	e.mu.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	if e.loader == nil {
+		return nil, errors.New("no template loader configured")
+	}
+	stored, err := e.loader.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template %s: %w", templateID, err)
+	}
+	if TemplateFormat(stored.Format) != TemplateFormatGo {
+		return nil, fmt.Errorf("template %s: unsupported template format %q", templateID, stored.Format)
+	}
+
+	compiled, err := e.parseHTML(renderLocationLayout, stored.Content)
+	if err != nil {
+		return nil, newTemplateError(RenderErrorParse, renderLocationLayout, err, 0)
+	}
+
+	e.mu.Lock()
+	e.layouts[templateID] = compiled
+	e.mu.Unlock()
+	return compiled, nil
+}
+
+// RenderTemplate renders req.Template as req.ContentFormat and, when
+// req.TemplateID is set, wraps it in the stored layout. Template errors are
+// reported in the result rather than returned.
+func (e *GoTemplateEngine) RenderTemplate(ctx context.Context, req *RenderRequest) (*RenderResult, error) {
+	start := e.now()
+	if req.Format != TemplateFormatGo {
+		return nil, fmt.Errorf("unsupported template format %q", req.Format)
+	}
+
+	result := &RenderResult{
+		ContentType:   "text/html; charset=utf-8",
+		Metadata:      map[string]interface{}{},
+		CacheKey:      renderCacheKey(req),
+		CacheDuration: req.Options.CacheDuration,
+	}
+	finish := func(err error) (*RenderResult, error) {
+		var te *templateError
+		if err != nil && !errors.As(err, &te) {
+			return nil, err
+		}
+		if te != nil {
+			result.Errors = append(result.Errors, RenderError{
+				Code:     te.code,
+				Message:  te.Error(),
+				Location: te.location,
+				Line:     te.line,
+				Column:   te.column,
+			})
+			result.RenderedContent = ""
+		}
+		result.Success = len(result.Errors) == 0
+		result.RenderedAt = e.now()
+		result.RenderTime = result.RenderedAt.Sub(start)
+		return result, nil
+	}
+
+	data := make(map[string]interface{}, len(req.Variables)+2)
+	for k, v := range req.Variables {
+		data[k] = v
+	}
+
+	var content string
+	switch req.ContentFormat {
+	case PageFormatHTML, "":
+		t, err := e.parseHTML(renderLocationContent, req.Template)
+		if err != nil {
+			return finish(newTemplateError(RenderErrorParse, renderLocationContent, err, 0))
+		}
+		var out strings.Builder
+		if err := t.Execute(&out, data); err != nil {
+			return finish(newTemplateError(RenderErrorExecution, renderLocationContent, err, 0))
+		}
+		content = out.String()
+
+	case PageFormatMarkdown:
+		frontmatter, body, offset, err := splitFrontmatter(req.Template)
+		if err != nil {
+			return finish(&templateError{code: RenderErrorFrontmatter, location: renderLocationContent, err: err, line: 1})
+		}
+		if frontmatter != nil {
+			data["frontmatter"] = frontmatter
+			result.Metadata["frontmatter"] = frontmatter
+		}
+		t, err := e.parseText(renderLocationContent, body)
+		if err != nil {
+			return finish(newTemplateError(RenderErrorParse, renderLocationContent, err, offset))
+		}
+		var out strings.Builder
+		if err := t.Execute(&out, data); err != nil {
+			return finish(newTemplateError(RenderErrorExecution, renderLocationContent, err, offset))
+		}
+		content = renderMarkdown(out.String())
+
+	default:
+		return nil, fmt.Errorf("unsupported page format %q", req.ContentFormat)
+	}
+
+	if req.TemplateID == nil {
+		result.RenderedContent = content
+		return finish(nil)
+	}
+
+	layout, err := e.layout(ctx, *req.TemplateID)
+	if err != nil {
+		return finish(err)
+	}
+	// The content was produced by html/template or the Markdown renderer,
+	// both of which escape their input
+	data["content"] = htmltemplate.HTML(content)
+	var out strings.Builder
+	if err := layout.Execute(&out, data); err != nil {
+		return finish(newTemplateError(RenderErrorExecution, renderLocationLayout, err, 0))
+	}
+	result.RenderedContent = out.String()
+	return finish(nil)
+}
+
+func renderCacheKey(req *RenderRequest) string {
+	h := sha256.New()
+	h.Write([]byte(string(req.Format) + "\x00" + string(req.ContentFormat) + "\x00"))
+	if req.TemplateID != nil {
+		h.Write([]byte(req.TemplateID.String()))
+	}
+	h.Write([]byte("\x00" + req.Template))
+	return "render:" + hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// CompileTemplate parses a template and reports the partials it includes
+func (e *GoTemplateEngine) CompileTemplate(ctx context.Context, content string, format TemplateFormat) (*CompiledTemplate, error) {
+	if format != TemplateFormatGo {
+		return nil, fmt.Errorf("unsupported template format %q", format)
+	}
+	t, err := e.parseText(renderLocationContent, content)
+	if err != nil {
+		return nil, newTemplateError(RenderErrorParse, renderLocationContent, err, 0)
+	}
+
+	sum := sha256.Sum256([]byte(content))
+	return &CompiledTemplate{
+		ID:              uuid.New(),
+		Format:          format,
+		CompiledContent: content,
+		Dependencies:    templateDependencies(t),
+		CacheKey:        "compiled:" + hex.EncodeToString(sum[:16]),
+		CompiledAt:      e.now(),
+	}, nil
+}
+
+// ValidateTemplate reports parse errors, with line numbers, as an invalid
+// result rather than an error
+func (e *GoTemplateEngine) ValidateTemplate(ctx context.Context, content string, format TemplateFormat) (*ValidationResult, error) {
+	start := e.now()
+	if format != TemplateFormatGo {
+		return nil, fmt.Errorf("unsupported template format %q", format)
+	}
+
+	result := &ValidationResult{IsValid: true}
+	t, err := e.parseText(renderLocationContent, content)
+	if err != nil {
+		te := newTemplateError(RenderErrorParse, renderLocationContent, err, 0)
+		result.IsValid = false
+		result.Errors = append(result.Errors, SchemaValidationError{
+			Code:     te.code,
+			Message:  te.Error(),
+			Line:     te.line,
+			Column:   te.column,
+			Severity: "error",
+		})
+	} else {
+		e.mu.RLock()
+		for _, name := range templateDependencies(t) {
+			if _, ok := e.partials[name]; !ok {
+				result.Warnings = append(result.Warnings, SchemaValidationWarning{
+					Code:       "UNKNOWN_PARTIAL",
+					Message:    fmt.Sprintf("partial %q is not registered", name),
+					Suggestion: "register the partial before rendering",
+				})
+			}
+		}
+		e.mu.RUnlock()
+	}
+
+	result.ValidatedAt = e.now()
+	result.Duration = result.ValidatedAt.Sub(start)
+	return result, nil
+}
+
+// templateDependencies lists the templates included with {{template "name"}}
+func templateDependencies(t *template.Template) []string {
+	seen := map[string]bool{}
+	var walk func(parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.TemplateNode:
+			seen[n.Name] = true
+		case *parse.IfNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.List)
+			walk(n.ElseList)
+		}
+	}
+	if t.Tree != nil {
+		walk(t.Tree.Root)
+	}
+
+	deps := make([]string, 0, len(seen))
+	for name := range seen {
+		deps = append(deps, name)
+	}
+	sort.Strings(deps)
+	return deps
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+func TestGoTemplateEngine_HelperInvocation(t *testing.T) {
+	engine := NewGoTemplateEngine(nil)
+	require.NoError(t, engine.RegisterHelper("shout", func(args ...interface{}) (interface{}, error) {
+		return strings.ToUpper(args[0].(string)) + "!", nil
+	}))
+
+	result, err := engine.RenderTemplate(context.Background(), &RenderRequest{
+		Template:      `<h1>{{shout .title}}</h1>`,
+		Format:        TemplateFormatGo,
+		ContentFormat: PageFormatHTML,
+		Variables:     map[string]interface{}{"title": "hello <world>"},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Success, "errors: %v", result.Errors)
+	assert.Equal(t, `<h1>HELLO &lt;WORLD&gt;!</h1>`, result.RenderedContent)
+	assert.Equal(t, "text/html; charset=utf-8", result.ContentType)
+}
+
+func TestGoTemplateEngine_PartialInclusion(t *testing.T) {
+	engine := NewGoTemplateEngine(nil)
+	require.NoError(t, engine.RegisterPartial("nav", `<nav>{{range .links}}<a href="{{.}}">{{.}}</a>{{end}}</nav>`))
+
+	result, err := engine.RenderTemplate(context.Background(), &RenderRequest{
+		Template:  `{{template "nav" .}}<main>body</main>`,
+		Format:    TemplateFormatGo,
+		Variables: map[string]interface{}{"links": []string{"/docs", "/blog"}},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Success, "errors: %v", result.Errors)
+	assert.Equal(t, `<nav><a href="/docs">/docs</a><a href="/blog">/blog</a></nav><main>body</main>`, result.RenderedContent)
+
+	compiled, err := engine.CompileTemplate(context.Background(), `{{template "nav" .}}{{if .x}}{{template "footer"}}{{end}}`, TemplateFormatGo)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"footer", "nav"}, compiled.Dependencies)
+
+	assert.Error(t, engine.RegisterPartial("broken", `{{if}}`))
+}
+
+func TestGoTemplateEngine_RuntimeErrorReportsLine(t *testing.T) {
+	engine := NewGoTemplateEngine(nil)
+	require.NoError(t, engine.RegisterHelper("fail", func(args ...interface{}) (interface{}, error) {
+		return nil, errors.New("helper exploded")
+	}))
+
+	result, err := engine.RenderTemplate(context.Background(), &RenderRequest{
+		Template:      "---\ntitle: Broken\n---\n# Heading\n\nText {{fail}}\n",
+		Format:        TemplateFormatGo,
+		ContentFormat: PageFormatMarkdown,
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Empty(t, result.RenderedContent)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, RenderErrorExecution, result.Errors[0].Code)
+	assert.Equal(t, "content", result.Errors[0].Location)
+	// Line numbers refer to the source including its frontmatter
+	assert.Equal(t, 6, result.Errors[0].Line)
+	assert.Contains(t, result.Errors[0].Message, "helper exploded")
+
+	result, err = engine.RenderTemplate(context.Background(), &RenderRequest{
+		Template: "<p>ok</p>\n<p>{{.missing</p>",
+		Format:   TemplateFormatGo,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, RenderErrorParse, result.Errors[0].Code)
+	assert.Equal(t, 2, result.Errors[0].Line)
+}
+
+func TestGoTemplateEngine_MarkdownWithFrontmatter(t *testing.T) {
+	engine := NewGoTemplateEngine(nil)
+
+	result, err := engine.RenderTemplate(context.Background(), &RenderRequest{
+		Template: strings.Join([]string{
+			"---",
+			"title: Getting Started",
+			"tags: [docs]",
+			"---",
+			"# {{.frontmatter.title}}",
+			"",
+			"Welcome to **{{.product}}**, see [the guide](/docs/guide) or `make <target>`.",
+			"",
+			"- one",
+			"- two",
+			"",
+			"```go",
+			"fmt.Println(\"<hi>\")",
+			"```",
+			"[bad](javascript:void)",
+		}, "\n"),
+		Format:        TemplateFormatGo,
+		ContentFormat: PageFormatMarkdown,
+		Variables:     map[string]interface{}{"product": "Orbit <beta>"},
+	})
+	require.NoError(t, err)
+	require.True(t, result.Success, "errors: %v", result.Errors)
+	assert.Equal(t, strings.Join([]string{
+		"<h1>Getting Started</h1>",
+		`<p>Welcome to <strong>Orbit &lt;beta&gt;</strong>, see <a href="/docs/guide">the guide</a> or <code>make &lt;target&gt;</code>.</p>`,
+		"<ul>",
+		"<li>one</li>",
+		"<li>two</li>",
+		"</ul>",
+		`<pre><code class="language-go">fmt.Println(&#34;&lt;hi&gt;&#34;)`,
+		"</code></pre>",
+		"<p>bad</p>",
+		"",
+	}, "\n"), result.RenderedContent)
+	assert.Equal(t, map[string]interface{}{"title": "Getting Started", "tags": []interface{}{"docs"}}, result.Metadata["frontmatter"])
+}
+
+type memoryTemplateLoader struct {
+	templates map[uuid.UUID]*domain.PageTemplate
+	loads     int
+}
+
+func (l *memoryTemplateLoader) GetTemplateByID(_ context.Context, id uuid.UUID) (*domain.PageTemplate, error) {
+	l.loads++
+	template, ok := l.templates[id]
+	if !ok {
+		return nil, errors.New("template not found")
+	}
+	return template, nil
+}
+
+func TestGoTemplateEngine_RendersContentIntoLayout(t *testing.T) {
+	layoutID := uuid.New()
+	loader := &memoryTemplateLoader{templates: map[uuid.UUID]*domain.PageTemplate{
+		layoutID: {ID: layoutID, Format: "go", Content: `<title>{{.title}}</title><main>{{.content}}</main>`},
+	}}
+	engine := NewGoTemplateEngine(loader)
+
+	req := &RenderRequest{
+		TemplateID:    &layoutID,
+		Template:      "Hello _{{.title}}_",
+		Format:        TemplateFormatGo,
+		ContentFormat: PageFormatMarkdown,
+		Variables:     map[string]interface{}{"title": "Docs & Guides"},
+	}
+	for i := 0; i < 2; i++ {
+		result, err := engine.RenderTemplate(context.Background(), req)
+		require.NoError(t, err)
+		require.True(t, result.Success, "errors: %v", result.Errors)
+		assert.Equal(t, "<title>Docs &amp; Guides</title><main><p>Hello <em>Docs &amp; Guides</em></p>\n</main>", result.RenderedContent)
+	}
+	assert.Equal(t, 1, loader.loads, "compiled layout is cached")
+
+	require.NoError(t, engine.ClearCache(context.Background(), layoutID))
+	_, err := engine.RenderTemplate(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, loader.loads)
+}
+
+func TestGoTemplateEngine_ValidateTemplate(t *testing.T) {
+	engine := NewGoTemplateEngine(nil)
+
+	result, err := engine.ValidateTemplate(context.Background(), "line one\n{{end}}", TemplateFormatGo)
+	require.NoError(t, err)
+	assert.False(t, result.IsValid)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 2, result.Errors[0].Line)
+
+	result, err = engine.ValidateTemplate(context.Background(), `{{template "header" .}}`, TemplateFormatGo)
+	require.NoError(t, err)
+	assert.True(t, result.IsValid)
+	require.Len(t, result.Warnings, 1)
+	assert.Equal(t, "UNKNOWN_PARTIAL", result.Warnings[0].Code)
+
+	_, err = engine.ValidateTemplate(context.Background(), "{{content}}", TemplateFormatHandlebars)
+	assert.Error(t, err)
+}
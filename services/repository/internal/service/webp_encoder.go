@@ -0,0 +1,443 @@
+package service
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/draw"
+	"math/bits"
+	"slices"
+	"sort"
+)
+
+// VP8L (lossless WebP) bitstream constants
+const (
+	vp8lSignature         = 0x2f
+	vp8lMaxDimension      = 1 << 14
+	vp8lNumLiteralCodes   = 256
+	vp8lNumLengthCodes    = 24
+	vp8lNumDistanceCodes  = 40
+	vp8lMaxCopyLength     = 4096
+	vp8lMinCopyLength     = 3
+	vp8lMaxCodeLength     = 15
+	vp8lMaxCodeLengthBits = 7
+	vp8lSubtractGreen     = 2
+
+	// Distance codes for the left and above neighbours in the VP8L
+	// two-dimensional distance map
+	vp8lDistanceCodeLeft  = 2
+	vp8lDistanceCodeAbove = 1
+)
+
+// vp8lCodeLengthCodeOrder is the order code length code lengths are written in
+var vp8lCodeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// encodeWebP encodes img as a lossless (VP8L) WebP image. It applies the
+// subtract-green transform and LZ77 backward references to the left and
+// above pixels, which covers the flat regions common in site imagery.
+func encodeWebP(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxDimension || height > vp8lMaxDimension {
+		return nil, fmt.Errorf("%w: %dx%d exceeds the WebP size limits", ErrUnsupportedImage, width, height)
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+
+	pixels := make([]uint32, width*height)
+	hasAlpha := false
+	for i := range pixels {
+		p := nrgba.Pix[i*4 : i*4+4]
+		r, g, b, a := uint32(p[0]), uint32(p[1]), uint32(p[2]), uint32(p[3])
+		if a != 0xff {
+			hasAlpha = true
+		}
+		// Subtract-green transform
+		r, b = (r-g)&0xff, (b-g)&0xff
+		pixels[i] = a<<24 | r<<16 | g<<8 | b
+	}
+
+	tokens := vp8lBackwardReferences(pixels, width)
+
+	var green [vp8lNumLiteralCodes + vp8lNumLengthCodes]int
+	var red, blue, alpha [vp8lNumLiteralCodes]int
+	var distance [vp8lNumDistanceCodes]int
+	for _, t := range tokens {
+		if t.length == 0 {
+			green[t.argb>>8&0xff]++
+			red[t.argb>>16&0xff]++
+			blue[t.argb&0xff]++
+			alpha[t.argb>>24]++
+			continue
+		}
+		lengthCode, _, _ := vp8lPrefixEncode(t.length)
+		distanceCode, _, _ := vp8lPrefixEncode(t.distanceCode)
+		green[vp8lNumLiteralCodes+lengthCode]++
+		distance[distanceCode]++
+	}
+	codes := [5]vp8lPrefixCode{
+		newVP8LPrefixCode(green[:], vp8lMaxCodeLength),
+		newVP8LPrefixCode(red[:], vp8lMaxCodeLength),
+		newVP8LPrefixCode(blue[:], vp8lMaxCodeLength),
+		newVP8LPrefixCode(alpha[:], vp8lMaxCodeLength),
+		newVP8LPrefixCode(distance[:], vp8lMaxCodeLength),
+	}
+
+	w := &vp8lBitWriter{}
+	w.writeBits(vp8lSignature, 8)
+	w.writeBits(uint32(width-1), 14)
+	w.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		w.writeBits(1, 1)
+	} else {
+		w.writeBits(0, 1)
+	}
+	w.writeBits(0, 3) // version
+
+	w.writeBits(1, 1) // transform present
+	w.writeBits(vp8lSubtractGreen, 2)
+	w.writeBits(0, 1) // no further transforms
+	w.writeBits(0, 1) // no color cache
+	w.writeBits(0, 1) // a single prefix code group
+
+	for _, code := range codes {
+		code.writeTo(w)
+	}
+
+	for _, t := range tokens {
+		if t.length == 0 {
+			codes[0].writeSymbol(w, int(t.argb>>8&0xff))
+			codes[1].writeSymbol(w, int(t.argb>>16&0xff))
+			codes[2].writeSymbol(w, int(t.argb&0xff))
+			codes[3].writeSymbol(w, int(t.argb>>24))
+			continue
+		}
+		lengthCode, lengthBits, lengthExtra := vp8lPrefixEncode(t.length)
+		codes[0].writeSymbol(w, vp8lNumLiteralCodes+lengthCode)
+		w.writeBits(uint32(lengthExtra), lengthBits)
+		distanceCode, distanceBits, distanceExtra := vp8lPrefixEncode(t.distanceCode)
+		codes[4].writeSymbol(w, distanceCode)
+		w.writeBits(uint32(distanceExtra), distanceBits)
+	}
+
+	return vp8lContainer(w.bytes()), nil
+}
+
+// vp8lContainer wraps a VP8L bitstream in a RIFF WebP container
+func vp8lContainer(data []byte) []byte {
+	chunkSize := len(data) + len(data)&1
+	out := make([]byte, 0, 20+chunkSize)
+	out = append(out, "RIFF"...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(12+chunkSize))
+	out = append(out, "WEBPVP8L"...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(data)))
+	out = append(out, data...)
+	if len(data)&1 == 1 {
+		out = append(out, 0)
+	}
+	return out
+}
+
+// vp8lToken is either a literal ARGB pixel or, when length is set, a copy
+// of length pixels from the neighbour identified by distanceCode
+type vp8lToken struct {
+	argb         uint32
+	length       int
+	distanceCode int
+}
+
+// vp8lBackwardReferences greedily replaces runs that repeat the left or
+// above pixel with backward references
+func vp8lBackwardReferences(pixels []uint32, width int) []vp8lToken {
+	var tokens []vp8lToken
+	for i := 0; i < len(pixels); {
+		length, distanceCode := 0, 0
+		if i >= 1 {
+			length, distanceCode = vp8lMatchLength(pixels, i, 1), vp8lDistanceCodeLeft
+		}
+		if i >= width {
+			if above := vp8lMatchLength(pixels, i, width); above > length {
+				length, distanceCode = above, vp8lDistanceCodeAbove
+			}
+		}
+		if length < vp8lMinCopyLength {
+			tokens = append(tokens, vp8lToken{argb: pixels[i]})
+			i++
+			continue
+		}
+		tokens = append(tokens, vp8lToken{length: length, distanceCode: distanceCode})
+		i += length
+	}
+	return tokens
+}
+
+// vp8lMatchLength counts the pixels from i that repeat those distance back
+func vp8lMatchLength(pixels []uint32, i, distance int) int {
+	n := 0
+	for i+n < len(pixels) && n < vp8lMaxCopyLength && pixels[i+n] == pixels[i+n-distance] {
+		n++
+	}
+	return n
+}
+
+// vp8lPrefixEncode splits a copy length or distance code into its prefix
+// symbol and extra bits
+func vp8lPrefixEncode(value int) (symbol int, extraBits uint, extra int) {
+	v := value - 1
+	if v < 4 {
+		return v, 0, 0
+	}
+	highest := bits.Len(uint(v)) - 1
+	second := (v >> (highest - 1)) & 1
+	extraBits = uint(highest - 1)
+	return 2*highest + second, extraBits, v & (1<<extraBits - 1)
+}
+
+// vp8lPrefixCode is a canonical Huffman code over one VP8L alphabet
+type vp8lPrefixCode struct {
+	lengths []uint8  // code lengths as written in the bitstream
+	codes   []uint16 // bit-reversed codes, ready to be written LSB first
+	symbols []int    // symbols with a non-zero frequency
+}
+
+// newVP8LPrefixCode builds a length-limited Huffman code from frequencies
+func newVP8LPrefixCode(freq []int, maxLength int) vp8lPrefixCode {
+	code := vp8lPrefixCode{lengths: huffmanCodeLengths(freq, maxLength)}
+	for symbol, length := range code.lengths {
+		if length > 0 {
+			code.symbols = append(code.symbols, symbol)
+		}
+	}
+	code.codes = canonicalHuffmanCodes(code.lengths)
+	return code
+}
+
+// writeSymbol writes symbol's code. Alphabets with a single symbol use zero
+// bits per symbol.
+func (c vp8lPrefixCode) writeSymbol(w *vp8lBitWriter, symbol int) {
+	if len(c.symbols) <= 1 {
+		return
+	}
+	w.writeBits(uint32(c.codes[symbol]), uint(c.lengths[symbol]))
+}
+
+// writeTo writes the code lengths, using the simple form for one or two
+// small symbols and the normal code length code otherwise
+func (c vp8lPrefixCode) writeTo(w *vp8lBitWriter) {
+	switch {
+	case len(c.symbols) == 0:
+		w.writeBits(1, 1) // simple code
+		w.writeBits(0, 1) // one symbol
+		w.writeBits(0, 1) // one bit wide
+		w.writeBits(0, 1) // symbol 0
+		return
+	case len(c.symbols) <= 2 && c.symbols[len(c.symbols)-1] < 256:
+		w.writeBits(1, 1)
+		w.writeBits(uint32(len(c.symbols)-1), 1)
+		if c.symbols[0] <= 1 {
+			w.writeBits(0, 1)
+			w.writeBits(uint32(c.symbols[0]), 1)
+		} else {
+			w.writeBits(1, 1)
+			w.writeBits(uint32(c.symbols[0]), 8)
+		}
+		if len(c.symbols) == 2 {
+			w.writeBits(uint32(c.symbols[1]), 8)
+		}
+		return
+	}
+
+	// Run-length encode the code lengths: 16 repeats the previous length
+	// 3-6 times, 17 and 18 emit runs of 3-10 and 11-138 zeros
+	type run struct {
+		symbol, extra int
+		extraBits     uint
+	}
+	var runs []run
+	lengths := c.lengths
+	for i := 0; i < len(lengths); {
+		value := int(lengths[i])
+		n := 1
+		for i+n < len(lengths) && int(lengths[i+n]) == value {
+			n++
+		}
+		i += n
+		if value == 0 {
+			for n >= 3 {
+				if n >= 11 {
+					k := min(n, 138)
+					runs = append(runs, run{18, k - 11, 7})
+					n -= k
+				} else {
+					runs = append(runs, run{17, n - 3, 3})
+					n = 0
+				}
+			}
+		} else {
+			runs = append(runs, run{symbol: value})
+			n--
+			for n >= 3 {
+				k := min(n, 6)
+				runs = append(runs, run{16, k - 3, 2})
+				n -= k
+			}
+		}
+		for ; n > 0; n-- {
+			runs = append(runs, run{symbol: value})
+		}
+	}
+
+	var freq [len(vp8lCodeLengthCodeOrder)]int
+	for _, r := range runs {
+		freq[r.symbol]++
+	}
+	lengthCode := newVP8LPrefixCode(freq[:], vp8lMaxCodeLengthBits)
+
+	count := 4
+	for i, symbol := range vp8lCodeLengthCodeOrder {
+		if lengthCode.lengths[symbol] > 0 {
+			count = max(count, i+1)
+		}
+	}
+	w.writeBits(0, 1) // normal code
+	w.writeBits(uint32(count-4), 4)
+	for _, symbol := range vp8lCodeLengthCodeOrder[:count] {
+		w.writeBits(uint32(lengthCode.lengths[symbol]), 3)
+	}
+	w.writeBits(0, 1) // code lengths cover the whole alphabet
+	for _, r := range runs {
+		lengthCode.writeSymbol(w, r.symbol)
+		w.writeBits(uint32(r.extra), r.extraBits)
+	}
+}
+
+// huffmanCodeLengths computes Huffman code lengths no longer than
+// maxLength. Frequencies are flattened until the tree fits, which keeps the
+// code complete as the format requires. A lone symbol gets length 1.
+func huffmanCodeLengths(freq []int, maxLength int) []uint8 {
+	lengths := make([]uint8, len(freq))
+	var symbols []int
+	for symbol, f := range freq {
+		if f > 0 {
+			symbols = append(symbols, symbol)
+		}
+	}
+	switch len(symbols) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[symbols[0]] = 1
+		return lengths
+	}
+
+	for floor := 1; ; floor *= 2 {
+		weights := make([]int, len(symbols))
+		for i, symbol := range symbols {
+			weights[i] = max(freq[symbol], floor)
+		}
+		depths := huffmanDepths(weights)
+		if slices.Max(depths) <= maxLength {
+			for i, symbol := range symbols {
+				lengths[symbol] = uint8(depths[i])
+			}
+			return lengths
+		}
+	}
+}
+
+// huffmanDepths returns the depth of each leaf in a Huffman tree built over
+// weights, using the two-queue construction
+func huffmanDepths(weights []int) []int {
+	leaves := make([]int, len(weights))
+	for i := range leaves {
+		leaves[i] = i
+	}
+	sort.SliceStable(leaves, func(a, b int) bool { return weights[leaves[a]] < weights[leaves[b]] })
+
+	// Nodes [0, n) are leaves in weight order; internal nodes follow in
+	// creation order, so every parent comes after its children
+	n := len(leaves)
+	weight := make([]int, n, 2*n-1)
+	parent := make([]int, 2*n-1)
+	for i, leaf := range leaves {
+		weight[i] = weights[leaf]
+	}
+	nextLeaf, nextInternal := 0, n
+	pick := func() int {
+		if nextLeaf < n && (nextInternal >= len(weight) || weight[nextLeaf] <= weight[nextInternal]) {
+			nextLeaf++
+			return nextLeaf - 1
+		}
+		nextInternal++
+		return nextInternal - 1
+	}
+	for len(weight) < 2*n-1 {
+		a, b := pick(), pick()
+		parent[a], parent[b] = len(weight), len(weight)
+		weight = append(weight, weight[a]+weight[b])
+	}
+
+	depth := make([]int, 2*n-1)
+	for node := 2*n - 3; node >= 0; node-- {
+		depth[node] = depth[parent[node]] + 1
+	}
+	depths := make([]int, n)
+	for i, leaf := range leaves {
+		depths[leaf] = depth[i]
+	}
+	return depths
+}
+
+// canonicalHuffmanCodes assigns canonical codes to code lengths and returns
+// them bit-reversed, as VP8L reads codes starting from their first bit
+func canonicalHuffmanCodes(lengths []uint8) []uint16 {
+	var count [vp8lMaxCodeLength + 1]int
+	for _, length := range lengths {
+		if length > 0 {
+			count[length]++
+		}
+	}
+	var next [vp8lMaxCodeLength + 1]int
+	code := 0
+	for length := 1; length <= vp8lMaxCodeLength; length++ {
+		code = (code + count[length-1]) << 1
+		next[length] = code
+	}
+
+	codes := make([]uint16, len(lengths))
+	for symbol, length := range lengths {
+		if length == 0 {
+			continue
+		}
+		codes[symbol] = bits.Reverse16(uint16(next[length])) >> (16 - length)
+		next[length]++
+	}
+	return codes
+}
+
+// vp8lBitWriter packs values least significant bit first
+type vp8lBitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (w *vp8lBitWriter) writeBits(value uint32, n uint) {
+	w.acc |= uint64(value) << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+// bytes flushes any partial byte and returns the written data
+func (w *vp8lBitWriter) bytes() []byte {
+	if w.nbits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nbits = 0, 0
+	}
+	return w.buf
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebP_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	fill := func(width, height int, pixel func(x, y int) color.NRGBA) *image.NRGBA {
+		img := image.NewNRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				img.SetNRGBA(x, y, pixel(x, y))
+			}
+		}
+		return img
+	}
+
+	tests := map[string]*image.NRGBA{
+		"single pixel": fill(1, 1, func(_, _ int) color.NRGBA { return color.NRGBA{R: 10, G: 20, B: 30, A: 255} }),
+		"flat":         fill(300, 40, func(_, _ int) color.NRGBA { return color.NRGBA{R: 255, A: 255} }),
+		"long runs":    fill(5000, 3, func(x, _ int) color.NRGBA { return color.NRGBA{B: uint8(x / 4500), A: 255} }),
+		"stripes": fill(64, 64, func(x, y int) color.NRGBA {
+			if (x/8+y/8)%2 == 0 {
+				return color.NRGBA{R: 200, G: 30, B: 90, A: 255}
+			}
+			return color.NRGBA{R: 5, G: 250, B: 140, A: 255}
+		}),
+		"gradient with alpha": fill(256, 17, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(x), G: uint8(y * 15), B: uint8(255 - x), A: uint8(x ^ y)}
+		}),
+		"noise": fill(97, 61, func(_, _ int) color.NRGBA {
+			return color.NRGBA{R: uint8(rng.Intn(256)), G: uint8(rng.Intn(256)), B: uint8(rng.Intn(256)), A: uint8(rng.Intn(256))}
+		}),
+		"skewed histogram": fill(120, 80, func(_, _ int) color.NRGBA {
+			// Geometric value frequencies force long codes before length limiting
+			v := 0
+			for v < 255 && rng.Intn(3) != 0 {
+				v++
+			}
+			return color.NRGBA{R: uint8(v), G: uint8(v * 7), B: uint8(v), A: 255}
+		}),
+	}
+
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			encoded, err := encodeWebP(src)
+			require.NoError(t, err)
+
+			decoded, err := webp.Decode(bytes.NewReader(encoded))
+			require.NoError(t, err)
+			require.Equal(t, src.Bounds(), decoded.Bounds())
+			for y := 0; y < src.Bounds().Dy(); y++ {
+				for x := 0; x < src.Bounds().Dx(); x++ {
+					want := src.NRGBAAt(x, y)
+					if got := color.NRGBAModel.Convert(decoded.At(x, y)); got != want {
+						t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeWebP_RejectsOversizedImages(t *testing.T) {
+	_, err := encodeWebP(image.NewNRGBA(image.Rect(0, 0, vp8lMaxDimension+1, 1)))
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+}
+
+func TestHuffmanCodeLengths_LimitsLength(t *testing.T) {
+	// Fibonacci frequencies produce the deepest possible Huffman tree
+	freq := make([]int, 30)
+	freq[0], freq[1] = 1, 1
+	for i := 2; i < len(freq); i++ {
+		freq[i] = freq[i-1] + freq[i-2]
+	}
+
+	lengths := huffmanCodeLengths(freq, 7)
+	kraft := 0.0
+	for _, length := range lengths {
+		require.NotZero(t, length)
+		assert.LessOrEqual(t, length, uint8(7))
+		kraft += 1 / float64(int(1)<<length)
+	}
+	assert.Equal(t, 1.0, kraft, "the code must stay complete")
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/drewpayment/orbit/proto/pkg/svcauth"
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+	"github.com/google/uuid"
+)
+
+// QuotaOperation names a CPU-intensive operation limited per workspace
+type QuotaOperation string
+
+const (
+	QuotaSchemaValidation     QuotaOperation = "schema_validation"
+	QuotaSchemaTransformation QuotaOperation = "schema_transformation"
+	QuotaSDKGeneration        QuotaOperation = "sdk_generation"
+)
+
+// QuotaLimit allows Limit calls per Window. A Limit or Window of zero or
+// less leaves the operation unlimited.
+type QuotaLimit struct {
+	Limit  int           `json:"limit"`
+	Window time.Duration `json:"window"`
+}
+
+// QuotaLimits holds the limit for each operation. Operations without an
+// entry are unlimited.
+type QuotaLimits map[QuotaOperation]QuotaLimit
+
+// DefaultQuotaLimits returns the limits used when none are configured
+func DefaultQuotaLimits() QuotaLimits {
+	return QuotaLimits{
+		QuotaSchemaValidation:     {Limit: 120, Window: time.Minute},
+		QuotaSchemaTransformation: {Limit: 60, Window: time.Minute},
+		QuotaSDKGeneration:        {Limit: 30, Window: time.Hour},
+	}
+}
+
+// QuotaExceededError is returned when a workspace has used its quota for
+// an operation. It matches ErrQuotaExceeded with errors.Is.
+type QuotaExceededError struct {
+	WorkspaceID uuid.UUID      `json:"workspace_id"`
+	Operation   QuotaOperation `json:"operation"`
+	Limit       int            `json:"limit"`
+	Window      time.Duration  `json:"window"`
+	// RetryAfter is how long until the current window ends
+	RetryAfter time.Duration `json:"retry_after"`
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s: %s is limited to %d per %s, retry after %s",
+		ErrQuotaExceeded.Message, e.Operation, e.Limit, e.Window, e.RetryAfter.Round(time.Second))
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return ErrQuotaExceeded
+}
+
+// WorkspaceQuota counts calls per workspace and operation in fixed windows.
+// Counts are kept in memory, so each service instance enforces its own
+// limits.
+type WorkspaceQuota struct {
+	limits QuotaLimits
+	now    func() time.Time
+
+	mu        sync.Mutex
+	windows   map[quotaKey]*quotaWindow
+	lastSweep time.Time
+}
+
+type quotaKey struct {
+	workspaceID uuid.UUID
+	operation   QuotaOperation
+}
+
+type quotaWindow struct {
+	start time.Time
+	count int
+}
+
+// NewWorkspaceQuota creates a quota enforcing limits. A nil limits map uses
+// DefaultQuotaLimits.
+func NewWorkspaceQuota(limits QuotaLimits) *WorkspaceQuota {
+	if limits == nil {
+		limits = DefaultQuotaLimits()
+	}
+	return &WorkspaceQuota{
+		limits:  limits,
+		now:     time.Now,
+		windows: make(map[quotaKey]*quotaWindow),
+	}
+}
+
+// Allow counts one call of operation for the workspace, or returns a
+// *QuotaExceededError without counting it when the window is used up
+func (q *WorkspaceQuota) Allow(workspaceID uuid.UUID, operation QuotaOperation) error {
+	limit, ok := q.limits[operation]
+	if !ok || limit.Limit <= 0 || limit.Window <= 0 {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	q.sweep(now)
+
+	key := quotaKey{workspaceID: workspaceID, operation: operation}
+	window, ok := q.windows[key]
+	if !ok || !now.Before(window.start.Add(limit.Window)) {
+		window = &quotaWindow{start: now}
+		q.windows[key] = window
+	}
+
+	if window.count >= limit.Limit {
+		return &QuotaExceededError{
+			WorkspaceID: workspaceID,
+			Operation:   operation,
+			Limit:       limit.Limit,
+			Window:      limit.Window,
+			RetryAfter:  window.start.Add(limit.Window).Sub(now),
+		}
+	}
+	window.count++
+	return nil
+}
+
+// sweep drops ended windows, at most once per the longest configured
+// window, so idle workspaces do not accumulate
+func (q *WorkspaceQuota) sweep(now time.Time) {
+	var longest time.Duration
+	for _, limit := range q.limits {
+		if limit.Window > longest {
+			longest = limit.Window
+		}
+	}
+	if now.Sub(q.lastSweep) < longest {
+		return
+	}
+	q.lastSweep = now
+
+	for key, window := range q.windows {
+		if !now.Before(window.start.Add(q.limits[key.operation].Window)) {
+			delete(q.windows, key)
+		}
+	}
+}
+
+// quotaWorkspaceID returns the workspace a call is charged to: the caller's
+// authorized workspace, else the workspace named by the request, which the
+// caller must have verified membership of. Calls with neither share the
+// uuid.Nil bucket.
+func quotaWorkspaceID(ctx context.Context, workspaceID uuid.UUID) uuid.UUID {
+	if identity, ok := svcauth.IdentityFromContext(ctx); ok {
+		if id, err := uuid.Parse(identity.WorkspaceID); err == nil {
+			return id
+		}
+	}
+	return workspaceID
+}
+
+// Quota errors
+var (
+	ErrQuotaExceeded = domain.NewDomainError("RESOURCE_EXHAUSTED", "Workspace quota exceeded")
+)
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/drewpayment/orbit/proto/pkg/svcauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceQuota_RejectsCallsOverLimitUntilWindowEnds(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	quota := NewWorkspaceQuota(QuotaLimits{
+		QuotaSDKGeneration: {Limit: 3, Window: time.Hour},
+	})
+	quota.now = func() time.Time { return clock }
+	workspace, other := uuid.New(), uuid.New()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, quota.Allow(workspace, QuotaSDKGeneration), "call %d", i+1)
+		clock = clock.Add(10 * time.Minute)
+	}
+
+	err := quota.Allow(workspace, QuotaSDKGeneration)
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+	var exceeded *QuotaExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, workspace, exceeded.WorkspaceID)
+	assert.Equal(t, QuotaSDKGeneration, exceeded.Operation)
+	assert.Equal(t, 3, exceeded.Limit)
+	assert.Equal(t, 30*time.Minute, exceeded.RetryAfter)
+
+	// Other workspaces and operations have their own budgets
+	assert.NoError(t, quota.Allow(other, QuotaSDKGeneration))
+	assert.NoError(t, quota.Allow(workspace, QuotaSchemaValidation), "operations without a limit are unlimited")
+
+	// Rejected calls are not counted, so the workspace recovers when the window ends
+	clock = clock.Add(29 * time.Minute)
+	assert.ErrorIs(t, quota.Allow(workspace, QuotaSDKGeneration), ErrQuotaExceeded)
+	clock = clock.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, quota.Allow(workspace, QuotaSDKGeneration), "call %d after reset", i+1)
+	}
+	assert.ErrorIs(t, quota.Allow(workspace, QuotaSDKGeneration), ErrQuotaExceeded)
+}
+
+func TestWorkspaceQuota_SweepsEndedWindows(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	quota := NewWorkspaceQuota(QuotaLimits{
+		QuotaSchemaValidation: {Limit: 1, Window: time.Minute},
+	})
+	quota.now = func() time.Time { return clock }
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, quota.Allow(uuid.New(), QuotaSchemaValidation))
+	}
+	assert.Len(t, quota.windows, 5)
+
+	clock = clock.Add(time.Minute)
+	require.NoError(t, quota.Allow(uuid.New(), QuotaSchemaValidation))
+	assert.Len(t, quota.windows, 1)
+}
+
+func TestQuotaWorkspaceID(t *testing.T) {
+	requested, authorized := uuid.New(), uuid.New()
+	withWorkspace := svcauth.WithIdentity(context.Background(), svcauth.Identity{
+		UserID: uuid.NewString(), WorkspaceID: authorized.String(),
+	})
+
+	withoutWorkspace := svcauth.WithIdentity(context.Background(), svcauth.Identity{UserID: uuid.NewString()})
+
+	assert.Equal(t, authorized, quotaWorkspaceID(withWorkspace, requested), "the authorized workspace wins over the request")
+	assert.Equal(t, authorized, quotaWorkspaceID(withWorkspace, uuid.Nil))
+	assert.Equal(t, requested, quotaWorkspaceID(withoutWorkspace, requested))
+	assert.Equal(t, uuid.Nil, quotaWorkspaceID(context.Background(), uuid.Nil))
+}
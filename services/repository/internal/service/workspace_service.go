@@ -74,6 +74,7 @@ type EventPublisher interface {
 	PublishMemberAdded(ctx context.Context, workspaceID uuid.UUID, member *domain.WorkspaceMember) error
 	PublishMemberRemoved(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) error
 	PublishMemberRoleUpdated(ctx context.Context, workspaceID uuid.UUID, member *domain.WorkspaceMember) error
+	PublishSchemaEvent(ctx context.Context, event *SchemaEvent) error
 }
 
 // CacheManager defines the interface for caching operations
@@ -81,6 +82,8 @@ type CacheManager interface {
 	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
 	Get(ctx context.Context, key string) (interface{}, error)
 	Delete(ctx context.Context, key string) error
+	// DeleteByPattern deletes every key matching a glob pattern in which
+	// "*" matches any run of characters, including ":"
 	DeleteByPattern(ctx context.Context, pattern string) error
 }
 
@@ -780,12 +783,14 @@ func (s *WorkspaceService) clearWorkspaceCaches(ctx context.Context, workspace *
 	}
 }
 
-// clearMemberCaches clears member-related caches
+// clearMemberCaches clears member-related caches, including the schema
+// lists built for the member under their previous permissions
 func (s *WorkspaceService) clearMemberCaches(ctx context.Context, workspaceID, userID uuid.UUID) {
 	patterns := []string{
 		fmt.Sprintf("workspace:members:%s", workspaceID.String()),
 		fmt.Sprintf("workspace:stats:%s", workspaceID.String()),
 		fmt.Sprintf("user:workspaces:%s", userID.String()),
+		userSchemaListCachePattern(workspaceID, userID),
 	}
 	
 	for _, pattern := range patterns {
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/drewpayment/orbit/services/repository/internal/domain"
+)
+
+// memberWorkspaceRepository persists member changes by keeping the
+// in-memory workspace the service already mutated
+type memberWorkspaceRepository struct {
+	singleWorkspaceRepository
+}
+
+func (memberWorkspaceRepository) UpdateMember(context.Context, *domain.WorkspaceMember) error {
+	return nil
+}
+
+func (memberWorkspaceRepository) RemoveMember(context.Context, uuid.UUID, uuid.UUID) error {
+	return nil
+}
+
+// noopMemberEventPublisher discards member events
+type noopMemberEventPublisher struct {
+	EventPublisher
+}
+
+func (noopMemberEventPublisher) PublishMemberRemoved(context.Context, uuid.UUID, uuid.UUID) error {
+	return nil
+}
+
+func (noopMemberEventPublisher) PublishMemberRoleUpdated(context.Context, uuid.UUID, *domain.WorkspaceMember) error {
+	return nil
+}
+
+func TestWorkspaceService_MemberChangesClearSchemaListCaches(t *testing.T) {
+	ctx := context.Background()
+	owner, developer, viewer := uuid.New(), uuid.New(), uuid.New()
+	workspace := newTestWorkspace(owner, domain.WorkspaceRoleOwner)
+	for userID, role := range map[uuid.UUID]domain.WorkspaceRole{
+		developer: domain.WorkspaceRoleDeveloper,
+		viewer:    domain.WorkspaceRoleViewer,
+	} {
+		workspace.Members = append(workspace.Members, domain.WorkspaceMember{
+			WorkspaceID: workspace.ID, UserID: userID, Role: role, IsActive: true,
+		})
+	}
+	repo := memberWorkspaceRepository{singleWorkspaceRepository{workspace: workspace}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cache := NewMemoryCacheManager()
+	schemas := NewSchemaService(newMemorySchemaRepository(), nil, repo, nil,
+		acceptingSchemaValidator{}, nil, nil, nil, cache, nil, logger)
+	workspaces := NewWorkspaceService(repo, nil, noopMemberEventPublisher{}, cache, logger)
+
+	cached := func(userID uuid.UUID) bool {
+		_, err := cache.Get(ctx, schemaListCacheKey(workspace.ID, userID, SchemaFilters{}))
+		return err == nil
+	}
+	for _, userID := range []uuid.UUID{owner, developer, viewer} {
+		_, err := schemas.ListSchemas(ctx, workspace.ID, userID, SchemaFilters{})
+		require.NoError(t, err)
+		require.True(t, cached(userID))
+	}
+
+	_, err := workspaces.UpdateMemberRole(ctx, UpdateMemberRoleRequest{
+		WorkspaceID: workspace.ID, UserID: developer, NewRole: domain.WorkspaceRoleViewer, UpdatedBy: owner,
+	})
+	require.NoError(t, err)
+	assert.False(t, cached(developer), "a role change drops the member's cached lists")
+
+	require.NoError(t, workspaces.RemoveMember(ctx, workspace.ID, viewer, owner))
+	assert.False(t, cached(viewer), "removal drops the member's cached lists")
+
+	assert.True(t, cached(owner), "other members' lists are unaffected")
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
 	"log/slog"
 	"os"
+	"strconv"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -56,6 +58,33 @@ func buildSandboxExecutor(logger *slog.Logger) (sandbox.SandboxExecutor, error)
 	}
 }
 
+// newGitHubAppClient builds the GitHub App client from GITHUB_APP_ID and the
+// private key in GITHUB_APP_PRIVATE_KEY_BASE64 or GITHUB_APP_PRIVATE_KEY_PATH,
+// the same variables orbit-www reads
+func newGitHubAppClient() (*services.GitHubAppClient, error) {
+	appID, err := strconv.ParseInt(os.Getenv("GITHUB_APP_ID"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("GITHUB_APP_ID must be set to the numeric app ID")
+	}
+
+	var privateKey []byte
+	if encoded := os.Getenv("GITHUB_APP_PRIVATE_KEY_BASE64"); encoded != "" {
+		privateKey, err = base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("GITHUB_APP_PRIVATE_KEY_BASE64 is not base64: %w", err)
+		}
+	} else if path := os.Getenv("GITHUB_APP_PRIVATE_KEY_PATH"); path != "" {
+		privateKey, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading GITHUB_APP_PRIVATE_KEY_PATH: %w", err)
+		}
+	} else {
+		return nil, errors.New("either GITHUB_APP_PRIVATE_KEY_BASE64 or GITHUB_APP_PRIVATE_KEY_PATH is required")
+	}
+
+	return services.NewGitHubAppClient(appID, privateKey, "")
+}
+
 // healthClientAdapter adapts services.PayloadHealthClientImpl to activities.PayloadHealthClient
 type healthClientAdapter struct {
 	impl *services.PayloadHealthClientImpl
@@ -210,19 +239,32 @@ func main() {
 	w.RegisterActivity(activityClients.UpdateInstallationStatusActivity)
 	w.RegisterActivity(activityClients.ReconcileGitHubInstallationsActivity)
 
-	// TODO: Implement PayloadClient, EncryptionService, and GitHubClient
-	// These will be created in later tasks
-	// For now, using nil to allow compilation
-	var payloadClient services.PayloadClient = nil         // TODO: Implement
-	var encryptionService services.EncryptionService = nil // TODO: Implement
-	var githubClient services.GitHubClient = nil           // TODO: Implement
+	// Create logger
+	logger := slog.Default()
+
+	// GitHub installation records live in Payload and their tokens are
+	// encrypted with the ENCRYPTION_KEY shared with orbit-www. Missing
+	// credentials leave the dependency unset so GitHub operations return a
+	// configuration error instead of failing the worker at startup.
+	payloadClient := internalClients.NewPayloadClient(orbitAPIURL, orbitInternalAPIKey, logger)
+
+	var encryptionService services.EncryptionService
+	if svc, err := services.NewAESGCMEncryptionService(os.Getenv("ENCRYPTION_KEY")); err != nil {
+		log.Println("Warning: ENCRYPTION_KEY not usable, GitHub installation tokens cannot be decrypted:", err)
+	} else {
+		encryptionService = svc
+	}
+
+	var githubClient services.GitHubClient
+	if appClient, err := newGitHubAppClient(); err != nil {
+		log.Println("Warning: GitHub App credentials not usable, repository creation will fail:", err)
+	} else {
+		githubClient = appClient
+	}
 
 	// Create GitHub service
 	githubService := services.NewGitHubService(payloadClient, encryptionService, githubClient)
 
-	// Create logger
-	logger := slog.Default()
-
 	// Create and register Git activities
 	gitActivities := activities.NewGitActivities(workDir, githubService, logger)
 	w.RegisterActivity(gitActivities.CloneTemplateActivity)
@@ -244,6 +286,7 @@ func main() {
 	w.RegisterActivity(templateActivities.CreateEmptyRepo)
 	w.RegisterActivity(templateActivities.CloneTemplateRepo)
 	w.RegisterActivity(templateActivities.ApplyTemplateVariables)
+	w.RegisterActivity(templateActivities.PreviewTemplateVariables)
 	w.RegisterActivity(templateActivities.PushToNewRepo)
 	w.RegisterActivity(templateActivities.CleanupWorkDir)
 	w.RegisterActivity(templateActivities.FinalizeInstantiation)
@@ -260,6 +303,8 @@ func main() {
 	w.RegisterActivity(deploymentActivities.ValidateDeploymentConfig)
 	w.RegisterActivity(deploymentActivities.PrepareGeneratorContext)
 	w.RegisterActivity(deploymentActivities.ExecuteGenerator)
+	w.RegisterActivity(deploymentActivities.RollbackGeneratorExecution)
+	w.RegisterActivity(deploymentActivities.RollbackGeneratorContext)
 	w.RegisterActivity(deploymentActivities.UpdateDeploymentStatus)
 	w.RegisterActivity(deploymentActivities.CommitToRepo)
 
@@ -348,6 +393,7 @@ func main() {
 	w.RegisterWorkflow(workflows.TopicCreatedSyncWorkflow)
 	w.RegisterWorkflow(workflows.TopicDeletedSyncWorkflow)
 	w.RegisterWorkflow(workflows.TopicConfigSyncWorkflow)
+	w.RegisterWorkflow(workflows.BifrostTopicSyncWorkflow)
 
 	// Create and register topic sync activities
 	topicSyncActivities := activities.NewTopicSyncActivities(kafkaPayloadClient, logger)
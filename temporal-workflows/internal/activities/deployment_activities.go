@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+
+	"go.temporal.io/sdk/temporal"
 )
 
 // EnvVarRef represents an environment variable reference (name only, no value)
@@ -170,6 +172,11 @@ type ExecuteGeneratorResult struct {
 	GeneratedFiles []GeneratedFile   `json:"generatedFiles,omitempty"` // For generate mode
 }
 
+type RollbackGeneratorContextInput struct {
+	DeploymentID string `json:"deploymentId"`
+	WorkDir      string `json:"workDir"`
+}
+
 type UpdateDeploymentStatusInput struct {
 	DeploymentID   string          `json:"deploymentId"`
 	Status         string          `json:"status"`
@@ -197,10 +204,22 @@ type CommitToRepoResult struct {
 	Error     string `json:"error,omitempty"`
 }
 
-// ValidateDeploymentConfig validates the deployment configuration
+// DeploymentInvalidConfigErrorType is the application error type of a
+// deployment config that fails validation. Such errors are not retried.
+const DeploymentInvalidConfigErrorType = "InvalidDeploymentConfig"
+
+// ValidateDeploymentConfig validates the deployment configuration. Invalid
+// configs fail with a non-retryable DeploymentInvalidConfigErrorType error.
 func (a *DeploymentActivities) ValidateDeploymentConfig(ctx context.Context, input ValidateDeploymentConfigInput) error {
 	a.logger.Info("Validating deployment config", "generatorType", input.GeneratorType)
 
+	if err := a.validateDeploymentConfig(input); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), DeploymentInvalidConfigErrorType, nil)
+	}
+	return nil
+}
+
+func (a *DeploymentActivities) validateDeploymentConfig(input ValidateDeploymentConfigInput) error {
 	var config map[string]interface{}
 	if err := json.Unmarshal(input.Config, &config); err != nil {
 		return fmt.Errorf("invalid JSON config: %w", err)
@@ -496,6 +515,63 @@ func (a *DeploymentActivities) executeDockerCompose(ctx context.Context, input E
 	}, nil
 }
 
+// RollbackGeneratorContext removes the work directory created by
+// PrepareGeneratorContext, along with any output the generator wrote there.
+// It compensates for that step when a later step fails and is safe to retry.
+func (a *DeploymentActivities) RollbackGeneratorContext(ctx context.Context, input RollbackGeneratorContextInput) error {
+	if input.WorkDir == "" {
+		return nil
+	}
+
+	// Only remove directories under the deployment work root
+	rel, err := filepath.Rel(a.workDir, input.WorkDir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("refusing to remove %s: not a deployment work directory", input.WorkDir)
+	}
+
+	a.logger.Info("Rolling back generator context",
+		"deploymentID", input.DeploymentID,
+		"workDir", input.WorkDir)
+
+	if err := os.RemoveAll(input.WorkDir); err != nil {
+		return fmt.Errorf("failed to remove work directory: %w", err)
+	}
+	return nil
+}
+
+// RollbackGeneratorExecution undoes a partially applied ExecuteGenerator. In
+// execute mode docker-compose services that were started are torn down;
+// generate mode only writes files, which RollbackGeneratorContext removes.
+func (a *DeploymentActivities) RollbackGeneratorExecution(ctx context.Context, input ExecuteGeneratorInput) error {
+	if input.Mode == "generate" || input.GeneratorType != "docker-compose" {
+		return nil
+	}
+
+	composeFilePath := filepath.Join(input.WorkDir, "docker-compose.yml")
+	if _, err := os.Stat(composeFilePath); os.IsNotExist(err) {
+		// Nothing could have been started without a compose file
+		return nil
+	}
+
+	a.logger.Info("Rolling back generator execution",
+		"deploymentID", input.DeploymentID,
+		"generatorType", input.GeneratorType)
+
+	args := []string{"compose", "-f", composeFilePath}
+	if input.Target.HostURL != "" {
+		args = append([]string{"-H", input.Target.HostURL}, args...)
+	}
+	args = append(args, "down", "--remove-orphans")
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		a.logger.Error("Docker compose down failed", "error", err, "output", string(output))
+		return fmt.Errorf("docker compose down failed: %s", string(output))
+	}
+	return nil
+}
+
 // UpdateDeploymentStatus updates the deployment status in Payload
 func (a *DeploymentActivities) UpdateDeploymentStatus(ctx context.Context, input UpdateDeploymentStatusInput) error {
 	a.logger.Info("Updating deployment status",
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
 )
 
 func TestValidateDeploymentConfig_DockerCompose_Valid(t *testing.T) {
@@ -119,6 +120,51 @@ func TestExecuteGenerator_UnsupportedType(t *testing.T) {
 	require.Contains(t, err.Error(), "not supported for generator type")
 }
 
+func TestRollbackGeneratorContext_RemovesWorkDir(t *testing.T) {
+	root := t.TempDir()
+	activities := NewDeploymentActivities(root, nil, nil, slog.Default())
+
+	workDir, err := activities.PrepareGeneratorContext(context.Background(), PrepareGeneratorContextInput{
+		DeploymentID:  "test-deployment-123",
+		GeneratorSlug: "docker-compose",
+		Config:        []byte(`{"serviceName":"test-service"}`),
+	})
+	require.NoError(t, err)
+	require.DirExists(t, workDir)
+
+	input := RollbackGeneratorContextInput{DeploymentID: "test-deployment-123", WorkDir: workDir}
+	require.NoError(t, activities.RollbackGeneratorContext(context.Background(), input))
+	require.NoDirExists(t, workDir)
+
+	// Retrying after the directory is gone is a no-op
+	require.NoError(t, activities.RollbackGeneratorContext(context.Background(), input))
+}
+
+func TestRollbackGeneratorContext_RefusesOutsideWorkRoot(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	activities := NewDeploymentActivities(root, nil, nil, slog.Default())
+
+	for _, dir := range []string{outside, root, root + "/../escape"} {
+		err := activities.RollbackGeneratorContext(context.Background(), RollbackGeneratorContextInput{WorkDir: dir})
+		require.Error(t, err, dir)
+	}
+	require.DirExists(t, outside)
+	require.DirExists(t, root)
+}
+
+func TestRollbackGeneratorExecution_NothingToUndo(t *testing.T) {
+	activities := NewDeploymentActivities("/tmp/test", nil, nil, slog.Default())
+
+	// Generate mode only writes files and a missing compose file means nothing started
+	for _, input := range []ExecuteGeneratorInput{
+		{DeploymentID: "test-deployment-123", GeneratorType: "helm", Mode: "generate", WorkDir: t.TempDir()},
+		{DeploymentID: "test-deployment-123", GeneratorType: "docker-compose", Mode: "execute", WorkDir: t.TempDir()},
+	} {
+		require.NoError(t, activities.RollbackGeneratorExecution(context.Background(), input))
+	}
+}
+
 func TestUpdateDeploymentStatus_WithoutClient(t *testing.T) {
 	activities := NewDeploymentActivities("/tmp/test", nil, nil, slog.Default())
 
@@ -278,3 +324,19 @@ func TestCommitToRepo_Success(t *testing.T) {
 	require.True(t, result.Success)
 	require.Equal(t, "abc123", result.CommitSHA)
 }
+
+func TestValidateDeploymentConfig_ErrorsAreNonRetryable(t *testing.T) {
+	activities := NewDeploymentActivities("/tmp/test", nil, nil, slog.Default())
+
+	err := activities.ValidateDeploymentConfig(context.Background(), ValidateDeploymentConfigInput{
+		GeneratorType: "helm",
+		Config:        []byte(`{}`),
+	})
+	require.Error(t, err)
+
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+	require.Equal(t, DeploymentInvalidConfigErrorType, appErr.Type())
+	require.Contains(t, err.Error(), "releaseName")
+}
@@ -38,6 +38,7 @@ func NewGitActivities(
 type CloneTemplateInput struct {
 	TemplateName string
 	RepositoryID string
+	Branch       string // Optional, empty string = template's default branch
 }
 
 // ApplyVariablesInput contains parameters for applying template variables
@@ -103,13 +104,21 @@ func (a *GitActivities) CloneTemplateActivity(ctx context.Context, input CloneTe
 	}
 
 	// Clone repository using git CLI
-	cmd := exec.CommandContext(ctx, "git", "clone", templateURL, repoPath)
+	args := []string{"clone"}
+	if input.Branch != "" {
+		args = append(args, "--branch", input.Branch, "--single-branch")
+	}
+	args = append(args, templateURL, repoPath)
+	cmd := exec.CommandContext(ctx, "git", args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
 	if err := cmd.Run(); err != nil {
 		// Clean up partial clone on failure
 		os.RemoveAll(repoPath)
+		if input.Branch != "" {
+			return fmt.Errorf("failed to clone branch %q of template %s: %w", input.Branch, input.TemplateName, err)
+		}
 		return fmt.Errorf("failed to clone template: %w", err)
 	}
 
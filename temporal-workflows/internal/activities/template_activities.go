@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/drewpayment/orbit/temporal-workflows/internal/services"
@@ -28,9 +29,14 @@ type TemplateInstantiationInput struct {
 	SourceRepoOwner  string            `json:"sourceRepoOwner"`  // Owner of the source template repo
 	SourceRepoName   string            `json:"sourceRepoName"`   // Name of the source template repo
 	SourceRepoURL    string            `json:"sourceRepoUrl"`    // Full URL of source repo (for non-GitHub templates)
+	SourceBranch     string            `json:"sourceBranch"`     // Branch to instantiate from; empty means the repo's default branch
+	ManifestPath     string            `json:"manifestPath"`     // Path of the variable manifest in the source repo; empty means orbit-template.yaml
 	Variables        map[string]string `json:"variables"`        // Template variables to substitute
 	UserID           string            `json:"userId"`           // ID of user initiating instantiation
 	InstallationID   string            `json:"installationId"`   // GitHub App installation ID for authentication
+	Provider         string            `json:"provider"`         // Git host of the template and new repo, see VCSProvider*; empty means GitHub
+	ConnectionID     string            `json:"connectionId"`     // Git connection whose credentials authenticate GitLab and Bitbucket
+	DryRun           bool              `json:"dryRun"`           // Render the template into a temp dir without creating or pushing a repo
 }
 
 // CreateRepoResult contains information about a created repository
@@ -45,11 +51,25 @@ type ApplyTemplateVariablesActivityInput struct {
 	Variables map[string]string
 }
 
+// TemplatePreview describes what a dry-run instantiation would produce
+type TemplatePreview struct {
+	Files   []TemplatePreviewFile
+	Summary string // Diff-style listing of the lines changed by variable substitution
+}
+
+// TemplatePreviewFile is a single file of the rendered template
+type TemplatePreviewFile struct {
+	Path          string // Relative to the repository root
+	Substitutions int    // Number of {{variable}} placeholders replaced
+}
+
 // PushToNewRepoActivityInput contains parameters for pushing to new repository
 type PushToNewRepoActivityInput struct {
 	WorkDir        string
 	RepoURL        string
 	InstallationID string // GitHub App installation ID for authentication
+	Provider       string // See VCSProvider*; empty means GitHub
+	ConnectionID   string // Git connection for providers other than GitHub
 }
 
 // FinalizeInstantiationActivityInput contains parameters for finalization
@@ -61,9 +81,10 @@ type FinalizeInstantiationActivityInput struct {
 	UserID      string
 }
 
-// TokenService defines the interface for fetching GitHub tokens
+// TokenService defines the interface for fetching git credentials
 type TokenService interface {
 	GetInstallationToken(ctx context.Context, installationID string) (string, error)
+	GetConnectionToken(ctx context.Context, connectionID string) (string, error)
 }
 
 // GitHubTemplateClient defines the interface for GitHub template operations
@@ -80,6 +101,9 @@ type TemplateActivities struct {
 	tokenService TokenService
 	workDir      string
 	logger       *slog.Logger
+	githubAPIURL string // Empty uses the public GitHub API
+	// newProvider creates the client for a git provider; tests substitute fakes
+	newProvider func(provider, token string) (VCSProvider, error)
 }
 
 // NewTemplateActivities creates a new instance of TemplateActivities
@@ -87,16 +111,24 @@ func NewTemplateActivities(tokenService TokenService, workDir string, logger *sl
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &TemplateActivities{
+	a := &TemplateActivities{
 		tokenService: tokenService,
 		workDir:      workDir,
 		logger:       logger,
 	}
+	a.newProvider = func(provider, token string) (VCSProvider, error) {
+		return newVCSProvider(provider, a.githubAPIURL, token)
+	}
+	return a
 }
 
 var repoNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
-// ValidateInstantiationInput validates required fields and repository name format
+// errDryRunRemoteCall guards the activities that change remote repositories
+var errDryRunRemoteCall = errors.New("refusing to modify the git provider during a dry run")
+
+// ValidateInstantiationInput validates required fields, repository name format and
+// the variables against the template's variable manifest
 func (a *TemplateActivities) ValidateInstantiationInput(ctx context.Context, input TemplateInstantiationInput) error {
 	if input.TemplateID == "" {
 		return errors.New("required field missing: TemplateID")
@@ -116,6 +148,19 @@ func (a *TemplateActivities) ValidateInstantiationInput(ctx context.Context, inp
 		return errors.New("invalid repository name: must contain only alphanumeric characters, hyphens, and underscores")
 	}
 
+	provider, err := normalizeVCSProvider(input.Provider)
+	if err != nil {
+		return err
+	}
+	if provider != VCSProviderGitHub {
+		if input.ConnectionID == "" {
+			return fmt.Errorf("required field missing: ConnectionID (for %s)", provider)
+		}
+		if input.IsGitHubTemplate {
+			return fmt.Errorf("GitHub template repositories cannot be instantiated on %s", provider)
+		}
+	}
+
 	// If GitHub template, validate source repo fields
 	if input.IsGitHubTemplate {
 		if input.SourceRepoOwner == "" {
@@ -131,7 +176,8 @@ func (a *TemplateActivities) ValidateInstantiationInput(ctx context.Context, inp
 		}
 	}
 
-	return nil
+	// Check variables against the template's schema before any clone happens
+	return a.checkTemplateVariables(ctx, input)
 }
 
 // CreateRepoFromTemplate creates a repository using GitHub's Template API
@@ -142,6 +188,10 @@ func (a *TemplateActivities) CreateRepoFromTemplate(ctx context.Context, input T
 		"targetOrg", input.TargetOrg,
 		"targetName", input.RepositoryName)
 
+	if input.DryRun {
+		return nil, errDryRunRemoteCall
+	}
+
 	// Fetch token for this installation
 	token, err := a.tokenService.GetInstallationToken(ctx, input.InstallationID)
 	if err != nil {
@@ -170,22 +220,28 @@ func (a *TemplateActivities) CreateRepoFromTemplate(ctx context.Context, input T
 	}, nil
 }
 
-// CreateEmptyRepo creates an empty GitHub repository
+// CreateEmptyRepo creates an empty repository on the input's git provider
 func (a *TemplateActivities) CreateEmptyRepo(ctx context.Context, input TemplateInstantiationInput) (*CreateRepoResult, error) {
 	a.logger.Info("Creating empty repository",
+		"provider", input.Provider,
 		"org", input.TargetOrg,
 		"name", input.RepositoryName)
 
-	// Fetch token for this installation
-	token, err := a.tokenService.GetInstallationToken(ctx, input.InstallationID)
+	if input.DryRun {
+		return nil, errDryRunRemoteCall
+	}
+
+	token, err := a.providerToken(ctx, input.Provider, input.InstallationID, input.ConnectionID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get GitHub token: %w", err)
+		return nil, fmt.Errorf("failed to get git provider token: %w", err)
 	}
 
-	// Create client with token
-	client := services.NewGitHubTemplateClient("", token)
+	provider, err := a.newProvider(input.Provider, token)
+	if err != nil {
+		return nil, err
+	}
 
-	repoURL, err := client.CreateRepository(
+	repoURL, err := provider.CreateRepository(
 		ctx,
 		input.TargetOrg,
 		input.RepositoryName,
@@ -204,34 +260,55 @@ func (a *TemplateActivities) CreateEmptyRepo(ctx context.Context, input Template
 
 // CloneTemplateRepo clones the template repository, removes .git directory, and returns the work directory path
 func (a *TemplateActivities) CloneTemplateRepo(ctx context.Context, input TemplateInstantiationInput) (string, error) {
-	a.logger.Info("Cloning template repository", "sourceURL", input.SourceRepoURL)
+	sourceURL := input.SourceRepoURL
+	isGitHub := input.Provider == "" || input.Provider == VCSProviderGitHub
+	if sourceURL == "" && isGitHub && input.SourceRepoOwner != "" && input.SourceRepoName != "" {
+		// GitHub templates only carry owner/name; a dry run still has to clone them
+		sourceURL = fmt.Sprintf("https://github.com/%s/%s.git", input.SourceRepoOwner, input.SourceRepoName)
+	}
+	a.logger.Info("Cloning template repository", "sourceURL", sourceURL, "dryRun", input.DryRun)
 
-	// Create unique work directory
-	workDir := filepath.Join(a.workDir, fmt.Sprintf("template-%s-%s", input.TemplateID, input.RepositoryName))
-	if err := os.MkdirAll(workDir, 0755); err != nil {
+	// Create unique work directory. Dry runs get a fresh temp dir so they
+	// never collide with a real instantiation of the same repository name.
+	workDir, err := a.createCloneWorkDir(input)
+	if err != nil {
 		return "", fmt.Errorf("failed to create work directory: %w", err)
 	}
 
-	// Build clone URL with authentication if we have an installation ID
-	cloneURL := input.SourceRepoURL
-	if input.InstallationID != "" {
-		token, err := a.tokenService.GetInstallationToken(ctx, input.InstallationID)
-		if err != nil {
-			a.logger.Warn("Failed to get token for clone, attempting unauthenticated", "error", err)
-		} else {
-			// Insert token into URL for authenticated clone
-			cloneURL = strings.Replace(cloneURL, "https://", fmt.Sprintf("https://x-access-token:%s@", token), 1)
-		}
+	// Build clone URL with the provider's credentials when we have them
+	token, err := a.providerToken(ctx, input.Provider, input.InstallationID, input.ConnectionID)
+	if err != nil {
+		a.logger.Warn("Failed to get token for clone, attempting unauthenticated", "error", err)
+		token = ""
+	}
+	provider, err := a.newProvider(input.Provider, token)
+	if err != nil {
+		_ = os.RemoveAll(workDir)
+		return "", err
+	}
+	cloneURL := provider.AuthenticatedURL(sourceURL)
+
+	branch := input.SourceBranch
+	if isGitHub {
+		branch = a.resolveSourceBranch(ctx, input, token)
 	}
 
 	// Clone the repository
-	cmd := exec.CommandContext(ctx, "git", "clone", cloneURL, workDir)
+	args := []string{"clone"}
+	if branch != "" {
+		args = append(args, "--branch", branch, "--single-branch")
+	}
+	args = append(args, cloneURL, workDir)
+	cmd := exec.CommandContext(ctx, "git", args...)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		// Clean up on failure
 		_ = os.RemoveAll(workDir)
 		// Sanitize output to remove any tokens
 		sanitizedOutput := sanitizeGitOutput(string(output))
+		if branch != "" && strings.Contains(sanitizedOutput, "not found in upstream") {
+			return "", fmt.Errorf("source branch %q does not exist in template repository %s", branch, sourceURL)
+		}
 		return "", fmt.Errorf("failed to clone repository: %w (output: %s)", err, sanitizedOutput)
 	}
 
@@ -247,6 +324,47 @@ func (a *TemplateActivities) CloneTemplateRepo(ctx context.Context, input Templa
 	return workDir, nil
 }
 
+// createCloneWorkDir creates the directory a template is cloned into
+func (a *TemplateActivities) createCloneWorkDir(input TemplateInstantiationInput) (string, error) {
+	if input.DryRun {
+		if err := os.MkdirAll(a.workDir, 0755); err != nil {
+			return "", err
+		}
+		return os.MkdirTemp(a.workDir, fmt.Sprintf("template-preview-%s-", input.RepositoryName))
+	}
+
+	workDir := filepath.Join(a.workDir, fmt.Sprintf("template-%s-%s", input.TemplateID, input.RepositoryName))
+	if err := os.MkdirAll(workDir, 0755); err != nil {
+		return "", err
+	}
+	return workDir, nil
+}
+
+// resolveSourceBranch returns the branch to clone. An explicit SourceBranch
+// wins; otherwise the template's default branch is looked up through the
+// GitHub API. An empty result lets git check out the remote's HEAD.
+func (a *TemplateActivities) resolveSourceBranch(ctx context.Context, input TemplateInstantiationInput, token string) string {
+	if input.SourceBranch != "" {
+		return input.SourceBranch
+	}
+	if input.SourceRepoOwner == "" || input.SourceRepoName == "" {
+		return ""
+	}
+
+	client := services.NewGitHubTemplateClient(a.githubAPIURL, token)
+	branch, err := client.GetDefaultBranch(ctx, input.SourceRepoOwner, input.SourceRepoName)
+	if err != nil {
+		a.logger.Warn("Failed to resolve default branch, using the remote HEAD",
+			"owner", input.SourceRepoOwner,
+			"repo", input.SourceRepoName,
+			"error", err)
+		return ""
+	}
+
+	a.logger.Info("Resolved template default branch", "branch", branch)
+	return branch
+}
+
 // ApplyTemplateVariables substitutes {{variable}} patterns in all text files
 func (a *TemplateActivities) ApplyTemplateVariables(ctx context.Context, input ApplyTemplateVariablesActivityInput) error {
 	a.logger.Info("Applying template variables", "workDir", input.WorkDir, "variableCount", len(input.Variables))
@@ -273,29 +391,17 @@ func (a *TemplateActivities) ApplyTemplateVariables(ctx context.Context, input A
 			return fmt.Errorf("failed to read file %s: %w", path, err)
 		}
 
-		// Skip binary files (heuristic: if file contains null bytes in first 512 bytes)
-		sampleSize := 512
-		if len(content) < sampleSize {
-			sampleSize = len(content)
-		}
-		if strings.Contains(string(content[:sampleSize]), "\x00") {
+		// Skip binary files
+		if isBinaryContent(content) {
 			a.logger.Debug("Skipping binary file", "path", path)
 			return nil
 		}
 
 		// Apply variable substitutions
-		contentStr := string(content)
-		modified := false
-		for key, value := range input.Variables {
-			placeholder := fmt.Sprintf("{{%s}}", key)
-			if strings.Contains(contentStr, placeholder) {
-				contentStr = strings.ReplaceAll(contentStr, placeholder, value)
-				modified = true
-			}
-		}
+		contentStr, substitutions := substituteTemplateVariables(string(content), input.Variables)
 
 		// Write back if modified
-		if modified {
+		if substitutions > 0 {
 			if err := os.WriteFile(path, []byte(contentStr), d.Type().Perm()); err != nil {
 				return fmt.Errorf("failed to write file %s: %w", path, err)
 			}
@@ -313,6 +419,106 @@ func (a *TemplateActivities) ApplyTemplateVariables(ctx context.Context, input A
 	return nil
 }
 
+// PreviewTemplateVariables lists the files in a cloned template and renders a
+// diff-style summary of what ApplyTemplateVariables will change. It only
+// reads the work directory, so it must run before the variables are applied.
+func (a *TemplateActivities) PreviewTemplateVariables(ctx context.Context, input ApplyTemplateVariablesActivityInput) (*TemplatePreview, error) {
+	a.logger.Info("Previewing template variables", "workDir", input.WorkDir, "variableCount", len(input.Variables))
+
+	preview := &TemplatePreview{Files: []TemplatePreviewFile{}}
+	var diff strings.Builder
+	changedFiles, totalSubstitutions := 0, 0
+
+	err := filepath.WalkDir(input.WorkDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(input.WorkDir, path)
+		if err != nil {
+			return fmt.Errorf("failed to resolve path %s: %w", path, err)
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", path, err)
+		}
+
+		file := TemplatePreviewFile{Path: relPath}
+		if !isBinaryContent(content) {
+			_, substitutions := substituteTemplateVariables(string(content), input.Variables)
+			file.Substitutions = substitutions
+			if substitutions > 0 {
+				changedFiles++
+				totalSubstitutions += substitutions
+				writeLineDiff(&diff, relPath, string(content), input.Variables)
+			}
+		}
+		preview.Files = append(preview.Files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview template variables: %w", err)
+	}
+
+	preview.Summary = fmt.Sprintf("%d files, %d changed, %d substitutions\n", len(preview.Files), changedFiles, totalSubstitutions) + diff.String()
+
+	a.logger.Info("Template preview rendered", "files", len(preview.Files), "changedFiles", changedFiles)
+	return preview, nil
+}
+
+// isBinaryContent reports whether content looks binary (heuristic: null
+// bytes in the first 512 bytes)
+func isBinaryContent(content []byte) bool {
+	sampleSize := 512
+	if len(content) < sampleSize {
+		sampleSize = len(content)
+	}
+	return strings.Contains(string(content[:sampleSize]), "\x00")
+}
+
+// substituteTemplateVariables replaces {{variable}} placeholders in key order
+// and returns the rendered content and the number of placeholders replaced
+func substituteTemplateVariables(content string, variables map[string]string) (string, int) {
+	keys := make([]string, 0, len(variables))
+	for key := range variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	substitutions := 0
+	for _, key := range keys {
+		placeholder := fmt.Sprintf("{{%s}}", key)
+		if n := strings.Count(content, placeholder); n > 0 {
+			content = strings.ReplaceAll(content, placeholder, variables[key])
+			substitutions += n
+		}
+	}
+	return content, substitutions
+}
+
+// writeLineDiff writes the lines of content that variable substitution changes
+// in unified diff style. Placeholders never span lines, so each line is
+// rendered on its own.
+func writeLineDiff(w *strings.Builder, path, content string, variables map[string]string) {
+	fmt.Fprintf(w, "--- a/%s\n+++ b/%s\n", path, path)
+	for i, line := range strings.Split(content, "\n") {
+		rendered, substitutions := substituteTemplateVariables(line, variables)
+		if substitutions == 0 {
+			continue
+		}
+		renderedLines := strings.Split(rendered, "\n")
+		fmt.Fprintf(w, "@@ -%d +%d,%d @@\n-%s\n", i+1, i+1, len(renderedLines), line)
+		for _, r := range renderedLines {
+			fmt.Fprintf(w, "+%s\n", r)
+		}
+	}
+}
+
 // PushToNewRepo initializes git, adds all files, commits, and pushes to the new repository
 func (a *TemplateActivities) PushToNewRepo(ctx context.Context, input PushToNewRepoActivityInput) error {
 	a.logger.Info("Pushing to new repository", "workDir", input.WorkDir, "repoURL", input.RepoURL)
@@ -336,15 +542,16 @@ func (a *TemplateActivities) PushToNewRepo(ctx context.Context, input PushToNewR
 		return fmt.Errorf("failed to commit: %w", err)
 	}
 
-	// Build remote URL with authentication if we have installation ID
-	remoteURL := input.RepoURL
-	if input.InstallationID != "" {
-		token, err := a.tokenService.GetInstallationToken(ctx, input.InstallationID)
-		if err != nil {
-			return fmt.Errorf("failed to get GitHub token for push: %w", err)
-		}
-		remoteURL = strings.Replace(remoteURL, "https://", fmt.Sprintf("https://x-access-token:%s@", token), 1)
+	// Build remote URL with the provider's credentials
+	token, err := a.providerToken(ctx, input.Provider, input.InstallationID, input.ConnectionID)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider token for push: %w", err)
+	}
+	provider, err := a.newProvider(input.Provider, token)
+	if err != nil {
+		return err
 	}
+	remoteURL := provider.AuthenticatedURL(input.RepoURL)
 
 	// Add remote
 	if err := a.runGitCommand(ctx, input.WorkDir, "remote", "add", "origin", remoteURL); err != nil {
@@ -352,8 +559,8 @@ func (a *TemplateActivities) PushToNewRepo(ctx context.Context, input PushToNewR
 		_ = a.runGitCommand(ctx, input.WorkDir, "remote", "set-url", "origin", remoteURL)
 	}
 
-	// Push to main branch
-	if err := a.runGitCommand(ctx, input.WorkDir, "push", "-u", "origin", "main"); err != nil {
+	// Push to main whatever git named the local branch
+	if err := a.runGitCommand(ctx, input.WorkDir, "push", "-u", "origin", "HEAD:main"); err != nil {
 		return fmt.Errorf("failed to push: %w", err)
 	}
 
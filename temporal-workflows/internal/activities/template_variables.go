@@ -0,0 +1,241 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.temporal.io/sdk/temporal"
+	"gopkg.in/yaml.v3"
+
+	"github.com/drewpayment/orbit/temporal-workflows/internal/services"
+)
+
+// defaultTemplateManifestPath is where templates declare their variables
+const defaultTemplateManifestPath = "orbit-template.yaml"
+
+// TemplateVariable is a variable declared in a template's manifest
+type TemplateVariable struct {
+	Key        string                      `yaml:"key"`
+	Type       string                      `yaml:"type"` // string, number, boolean, select or multiselect
+	Required   bool                        `yaml:"required"`
+	Default    interface{}                 `yaml:"default"`
+	Validation *TemplateVariableValidation `yaml:"validation"`
+	Options    []TemplateVariableOption    `yaml:"options"`
+}
+
+// TemplateVariableValidation holds the optional constraints on a variable
+type TemplateVariableValidation struct {
+	Pattern   string   `yaml:"pattern"`
+	MinLength *int     `yaml:"minLength"`
+	MaxLength *int     `yaml:"maxLength"`
+	Min       *float64 `yaml:"min"`
+	Max       *float64 `yaml:"max"`
+}
+
+// TemplateVariableOption is an allowed value of a select or multiselect variable
+type TemplateVariableOption struct {
+	Label string `yaml:"label"`
+	Value string `yaml:"value"`
+}
+
+// templateManifest is the part of orbit-template.yaml needed to check variables
+type templateManifest struct {
+	Variables []TemplateVariable `yaml:"variables"`
+}
+
+// Reasons reported in TemplateVariableViolation.Reason
+const (
+	VariableViolationMissing = "missing"
+	VariableViolationInvalid = "invalid"
+	VariableViolationUnknown = "unknown"
+)
+
+// TemplateVariableViolation describes one variable that does not satisfy the
+// template's variable schema
+type TemplateVariableViolation struct {
+	Key     string `json:"key"`
+	Reason  string `json:"reason"` // See VariableViolation*
+	Message string `json:"message"`
+}
+
+// TemplateVariablesError lists every variable that does not satisfy the
+// template's variable schema
+type TemplateVariablesError struct {
+	Violations []TemplateVariableViolation
+}
+
+func (e *TemplateVariablesError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Key + ": " + v.Message
+	}
+	return "invalid template variables: " + strings.Join(messages, "; ")
+}
+
+// checkTemplateVariables loads the template's variable manifest and checks
+// input.Variables against it, so bad input fails before anything is cloned or
+// created. Templates without a manifest accept any variables.
+func (a *TemplateActivities) checkTemplateVariables(ctx context.Context, input TemplateInstantiationInput) error {
+	if input.SourceRepoOwner == "" || input.SourceRepoName == "" {
+		a.logger.Info("Template source has no GitHub owner/name, skipping variable validation")
+		return nil
+	}
+	if input.Provider != "" && input.Provider != VCSProviderGitHub {
+		a.logger.Info("Template manifests are only read from GitHub, skipping variable validation", "provider", input.Provider)
+		return nil
+	}
+
+	var token string
+	if input.InstallationID != "" {
+		var err error
+		token, err = a.tokenService.GetInstallationToken(ctx, input.InstallationID)
+		if err != nil {
+			return fmt.Errorf("failed to get GitHub token: %w", err)
+		}
+	}
+
+	manifestPath := input.ManifestPath
+	if manifestPath == "" {
+		manifestPath = defaultTemplateManifestPath
+	}
+
+	client := services.NewGitHubTemplateClient(a.githubAPIURL, token)
+	content, err := client.GetFileContent(ctx, input.SourceRepoOwner, input.SourceRepoName, manifestPath, input.SourceBranch)
+	if errors.Is(err, services.ErrFileNotFound) {
+		a.logger.Info("Template has no variable manifest, skipping variable validation", "path", manifestPath)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load template manifest: %w", err)
+	}
+
+	var manifest templateManifest
+	if err := yaml.Unmarshal(content, &manifest); err != nil {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("invalid template manifest %s: %v", manifestPath, err), "InvalidTemplateManifest", err)
+	}
+
+	if violations := validateTemplateVariables(manifest.Variables, input.Variables); len(violations) > 0 {
+		verr := &TemplateVariablesError{Violations: violations}
+		return temporal.NewNonRetryableApplicationError(verr.Error(), "InvalidTemplateVariables", verr, violations)
+	}
+	return nil
+}
+
+// validateTemplateVariables checks values against the declared variables.
+// Violations follow declaration order, then unknown keys in sorted order.
+func validateTemplateVariables(declared []TemplateVariable, values map[string]string) []TemplateVariableViolation {
+	var violations []TemplateVariableViolation
+	known := make(map[string]bool, len(declared))
+
+	for _, variable := range declared {
+		known[variable.Key] = true
+
+		value, ok := values[variable.Key]
+		if !ok || value == "" {
+			if variable.Required && variable.Default == nil {
+				violations = append(violations, TemplateVariableViolation{
+					Key:     variable.Key,
+					Reason:  VariableViolationMissing,
+					Message: "required variable is missing",
+				})
+			}
+			continue
+		}
+
+		if message := checkTemplateVariableValue(variable, value); message != "" {
+			violations = append(violations, TemplateVariableViolation{
+				Key:     variable.Key,
+				Reason:  VariableViolationInvalid,
+				Message: message,
+			})
+		}
+	}
+
+	var unknown []string
+	for key := range values {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		violations = append(violations, TemplateVariableViolation{
+			Key:     key,
+			Reason:  VariableViolationUnknown,
+			Message: "variable is not declared by the template",
+		})
+	}
+
+	return violations
+}
+
+// checkTemplateVariableValue returns why value does not satisfy variable, or
+// "" if it does
+func checkTemplateVariableValue(variable TemplateVariable, value string) string {
+	rules := variable.Validation
+	if rules == nil {
+		rules = &TemplateVariableValidation{}
+	}
+
+	switch variable.Type {
+	case "number":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Sprintf("expected a number, got %q", value)
+		}
+		if rules.Min != nil && n < *rules.Min {
+			return fmt.Sprintf("must be at least %v", *rules.Min)
+		}
+		if rules.Max != nil && n > *rules.Max {
+			return fmt.Sprintf("must be at most %v", *rules.Max)
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Sprintf("expected a boolean, got %q", value)
+		}
+	case "select":
+		if !hasTemplateVariableOption(variable.Options, value) {
+			return fmt.Sprintf("%q is not one of the allowed values", value)
+		}
+	case "multiselect":
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); !hasTemplateVariableOption(variable.Options, item) {
+				return fmt.Sprintf("%q is not one of the allowed values", item)
+			}
+		}
+	case "string":
+		length := utf8.RuneCountInString(value)
+		if rules.MinLength != nil && length < *rules.MinLength {
+			return fmt.Sprintf("must be at least %d characters", *rules.MinLength)
+		}
+		if rules.MaxLength != nil && length > *rules.MaxLength {
+			return fmt.Sprintf("must be at most %d characters", *rules.MaxLength)
+		}
+		if rules.Pattern != "" {
+			pattern, err := regexp.Compile(rules.Pattern)
+			if err != nil {
+				return fmt.Sprintf("template declares an invalid pattern %q", rules.Pattern)
+			}
+			if !pattern.MatchString(value) {
+				return fmt.Sprintf("must match pattern %s", rules.Pattern)
+			}
+		}
+	}
+	return ""
+}
+
+func hasTemplateVariableOption(options []TemplateVariableOption, value string) bool {
+	for _, option := range options {
+		if option.Value == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+)
+
+const testTemplateManifest = `apiVersion: orbit/v1
+kind: Template
+metadata:
+  name: service-template
+  language: go
+  categories: [api-service]
+variables:
+  - key: service_name
+    type: string
+    required: true
+    validation:
+      pattern: "^[a-z][a-z0-9-]*$"
+      maxLength: 20
+  - key: port
+    type: number
+    required: true
+    default: 8080
+    validation:
+      min: 1
+      max: 65535
+  - key: enable_metrics
+    type: boolean
+    required: false
+  - key: database
+    type: select
+    required: true
+    options:
+      - label: PostgreSQL
+        value: postgres
+      - label: MySQL
+        value: mysql
+  - key: features
+    type: multiselect
+    required: false
+    options:
+      - label: Auth
+        value: auth
+      - label: Caching
+        value: cache
+`
+
+// newManifestServer serves manifest as the template's orbit-template.yaml
+func newManifestServer(t *testing.T, manifest string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/template-org/service-template/contents/orbit-template.yaml", r.URL.Path)
+		w.Write([]byte(manifest))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func validInstantiationInput(variables map[string]string) TemplateInstantiationInput {
+	return TemplateInstantiationInput{
+		TemplateID:       "template-123",
+		WorkspaceID:      "workspace-456",
+		TargetOrg:        "my-org",
+		RepositoryName:   "new-service",
+		IsGitHubTemplate: true,
+		SourceRepoOwner:  "template-org",
+		SourceRepoName:   "service-template",
+		Variables:        variables,
+	}
+}
+
+func TestValidateInstantiationInput_TemplateVariables(t *testing.T) {
+	tests := []struct {
+		name       string
+		variables  map[string]string
+		violations []TemplateVariableViolation
+	}{
+		{
+			name: "valid",
+			variables: map[string]string{
+				"service_name":   "billing",
+				"enable_metrics": "true",
+				"database":       "postgres",
+				"features":       "auth, cache",
+			},
+		},
+		{
+			name:      "missing required variables",
+			variables: map[string]string{"service_name": ""},
+			violations: []TemplateVariableViolation{
+				{Key: "service_name", Reason: VariableViolationMissing, Message: "required variable is missing"},
+				{Key: "database", Reason: VariableViolationMissing, Message: "required variable is missing"},
+			},
+		},
+		{
+			name: "type mismatches",
+			variables: map[string]string{
+				"service_name":   "billing",
+				"port":           "eighty",
+				"enable_metrics": "sometimes",
+				"database":       "oracle",
+				"features":       "auth,search",
+			},
+			violations: []TemplateVariableViolation{
+				{Key: "port", Reason: VariableViolationInvalid, Message: `expected a number, got "eighty"`},
+				{Key: "enable_metrics", Reason: VariableViolationInvalid, Message: `expected a boolean, got "sometimes"`},
+				{Key: "database", Reason: VariableViolationInvalid, Message: `"oracle" is not one of the allowed values`},
+				{Key: "features", Reason: VariableViolationInvalid, Message: `"search" is not one of the allowed values`},
+			},
+		},
+		{
+			name: "validation rules",
+			variables: map[string]string{
+				"service_name": "Billing_Service",
+				"port":         "70000",
+				"database":     "mysql",
+			},
+			violations: []TemplateVariableViolation{
+				{Key: "service_name", Reason: VariableViolationInvalid, Message: "must match pattern ^[a-z][a-z0-9-]*$"},
+				{Key: "port", Reason: VariableViolationInvalid, Message: "must be at most 65535"},
+			},
+		},
+		{
+			name: "unknown extra variables",
+			variables: map[string]string{
+				"service_name": "billing",
+				"database":     "postgres",
+				"team":         "payments",
+				"owner":        "alice",
+			},
+			violations: []TemplateVariableViolation{
+				{Key: "owner", Reason: VariableViolationUnknown, Message: "variable is not declared by the template"},
+				{Key: "team", Reason: VariableViolationUnknown, Message: "variable is not declared by the template"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activities := NewTemplateActivities(nil, "/tmp/work", nil)
+			activities.githubAPIURL = newManifestServer(t, testTemplateManifest).URL
+
+			err := activities.ValidateInstantiationInput(context.Background(), validInstantiationInput(tt.variables))
+			if tt.violations == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+
+			var verr *TemplateVariablesError
+			require.True(t, errors.As(err, &verr))
+			assert.Equal(t, tt.violations, verr.Violations)
+
+			// The violations travel with the non-retryable activity error
+			var appErr *temporal.ApplicationError
+			require.True(t, errors.As(err, &appErr))
+			assert.True(t, appErr.NonRetryable())
+			assert.Equal(t, "InvalidTemplateVariables", appErr.Type())
+			var details []TemplateVariableViolation
+			require.NoError(t, appErr.Details(&details))
+			assert.Equal(t, tt.violations, details)
+		})
+	}
+}
+
+func TestValidateInstantiationInput_CustomManifestPath(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/template-org/service-template/contents/.orbit/template.yaml", r.URL.Path)
+		assert.Equal(t, "release/1.0", r.URL.Query().Get("ref"))
+		w.Write([]byte(testTemplateManifest))
+	}))
+	defer api.Close()
+
+	activities := NewTemplateActivities(nil, "/tmp/work", nil)
+	activities.githubAPIURL = api.URL
+
+	input := validInstantiationInput(map[string]string{"service_name": "billing"})
+	input.ManifestPath = ".orbit/template.yaml"
+	input.SourceBranch = "release/1.0"
+
+	err := activities.ValidateInstantiationInput(context.Background(), input)
+	var verr *TemplateVariablesError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, []TemplateVariableViolation{
+		{Key: "database", Reason: VariableViolationMissing, Message: "required variable is missing"},
+	}, verr.Violations)
+}
+
+func TestValidateInstantiationInput_InvalidManifest(t *testing.T) {
+	activities := NewTemplateActivities(nil, "/tmp/work", nil)
+	activities.githubAPIURL = newManifestServer(t, "variables: [unterminated").URL
+
+	err := activities.ValidateInstantiationInput(context.Background(), validInstantiationInput(nil))
+	var appErr *temporal.ApplicationError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, "InvalidTemplateManifest", appErr.Type())
+}
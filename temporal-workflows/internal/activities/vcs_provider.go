@@ -0,0 +1,131 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/drewpayment/orbit/temporal-workflows/internal/services"
+)
+
+// Git hosting providers a template can be instantiated on. The tags match
+// git-connections.provider; an empty provider means GitHub.
+const (
+	VCSProviderGitHub    = "github"
+	VCSProviderGitLab    = "gitlab"
+	VCSProviderBitbucket = "bitbucket"
+)
+
+// VCSProvider creates repositories on a git host and authenticates git clone
+// and push against it
+type VCSProvider interface {
+	// CreateRepository creates an empty repository and returns its HTTPS URL
+	CreateRepository(ctx context.Context, owner, name, description string, private bool) (string, error)
+
+	// AuthenticatedURL returns repoURL with the provider's credentials added
+	// for git clone and push. Without a token repoURL is returned unchanged.
+	AuthenticatedURL(repoURL string) string
+}
+
+// normalizeVCSProvider returns the provider tag for an input, defaulting to GitHub
+func normalizeVCSProvider(provider string) (string, error) {
+	switch provider {
+	case "", VCSProviderGitHub:
+		return VCSProviderGitHub, nil
+	case VCSProviderGitLab, VCSProviderBitbucket:
+		return provider, nil
+	default:
+		return "", fmt.Errorf("unsupported git provider: %s", provider)
+	}
+}
+
+// newVCSProvider creates the provider client for a provider tag. GitHub uses
+// githubAPIURL when set; the other providers always use their public APIs.
+func newVCSProvider(provider, githubAPIURL, token string) (VCSProvider, error) {
+	provider, err := normalizeVCSProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	switch provider {
+	case VCSProviderGitLab:
+		return &gitlabProvider{client: services.NewGitLabClient("", token), token: token}, nil
+	case VCSProviderBitbucket:
+		return &bitbucketProvider{client: services.NewBitbucketClient("", token), token: token}, nil
+	default:
+		return &githubProvider{client: services.NewGitHubTemplateClient(githubAPIURL, token), token: token}, nil
+	}
+}
+
+// providerToken returns the credential for git operations against a
+// provider: a GitHub App installation token for GitHub, or the git
+// connection's credential for the others. GitHub without an installation
+// gets an empty token and works unauthenticated.
+func (a *TemplateActivities) providerToken(ctx context.Context, provider, installationID, connectionID string) (string, error) {
+	provider, err := normalizeVCSProvider(provider)
+	if err != nil {
+		return "", err
+	}
+	if provider == VCSProviderGitHub {
+		if installationID == "" {
+			return "", nil
+		}
+		return a.tokenService.GetInstallationToken(ctx, installationID)
+	}
+	if connectionID == "" {
+		return "", errors.New("a git connection is required for " + provider)
+	}
+	return a.tokenService.GetConnectionToken(ctx, connectionID)
+}
+
+// withCredentials adds username:token credentials to an HTTPS URL
+func withCredentials(repoURL, username, token string) string {
+	if token == "" {
+		return repoURL
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil || u.Scheme != "https" {
+		return repoURL
+	}
+	u.User = url.UserPassword(username, token)
+	return u.String()
+}
+
+type githubProvider struct {
+	client *services.GitHubTemplateClient
+	token  string
+}
+
+func (p *githubProvider) CreateRepository(ctx context.Context, owner, name, description string, private bool) (string, error) {
+	return p.client.CreateRepository(ctx, owner, name, description, private)
+}
+
+func (p *githubProvider) AuthenticatedURL(repoURL string) string {
+	return withCredentials(repoURL, "x-access-token", p.token)
+}
+
+type gitlabProvider struct {
+	client *services.GitLabClient
+	token  string
+}
+
+func (p *gitlabProvider) CreateRepository(ctx context.Context, owner, name, description string, private bool) (string, error) {
+	return p.client.CreateRepository(ctx, owner, name, description, private)
+}
+
+func (p *gitlabProvider) AuthenticatedURL(repoURL string) string {
+	return withCredentials(repoURL, "oauth2", p.token)
+}
+
+type bitbucketProvider struct {
+	client *services.BitbucketClient
+	token  string
+}
+
+func (p *bitbucketProvider) CreateRepository(ctx context.Context, owner, name, description string, private bool) (string, error) {
+	return p.client.CreateRepository(ctx, owner, name, description, private)
+}
+
+func (p *bitbucketProvider) AuthenticatedURL(repoURL string) string {
+	return withCredentials(repoURL, "x-token-auth", p.token)
+}
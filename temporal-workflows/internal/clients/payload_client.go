@@ -358,6 +358,27 @@ func (c *PayloadClient) addHeaders(req *http.Request) {
 	req.Header.Set("Accept", "application/json")
 }
 
+// FindDocuments returns the documents of a collection whose fields equal the
+// given values. Together with GetDocument and UpdateDocument it lets the
+// client back services.GitHubService.
+func (c *PayloadClient) FindDocuments(ctx context.Context, collection string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	q := NewQueryBuilder()
+	for field, value := range query {
+		q.WhereEquals(field, fmt.Sprint(value))
+	}
+	return c.Find(ctx, collection, q.Build())
+}
+
+// GetDocument retrieves a single document by ID from a collection.
+func (c *PayloadClient) GetDocument(ctx context.Context, collection string, id string) (map[string]interface{}, error) {
+	return c.Get(ctx, collection, id)
+}
+
+// UpdateDocument updates a document in a collection.
+func (c *PayloadClient) UpdateDocument(ctx context.Context, collection string, id string, data map[string]interface{}) error {
+	return c.Update(ctx, collection, id, data)
+}
+
 // QueryBuilder helps construct Payload query parameters.
 type QueryBuilder struct {
 	values url.Values
@@ -509,3 +509,23 @@ func TestPayloadClient_NoAPIKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, result)
 }
+
+func TestPayloadClient_FindDocuments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/github-installations", r.URL.Path)
+		assert.Equal(t, "active", r.URL.Query().Get("where[status][equals]"))
+
+		json.NewEncoder(w).Encode(PayloadResponse{
+			Docs: []map[string]any{{"id": "install-1", "installationId": 456}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewPayloadClient(server.URL, "test-api-key", slog.Default())
+
+	docs, err := client.FindDocuments(context.Background(), "github-installations", map[string]interface{}{"status": "active"})
+
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, float64(456), docs[0]["installationId"])
+}
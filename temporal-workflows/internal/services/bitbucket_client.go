@@ -0,0 +1,105 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// BitbucketClient handles Bitbucket Cloud API calls for template operations
+type BitbucketClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewBitbucketClient creates a new Bitbucket client authenticating with an
+// OAuth access token
+func NewBitbucketClient(baseURL, token string) *BitbucketClient {
+	if baseURL == "" {
+		baseURL = "https://api.bitbucket.org/2.0"
+	}
+	return &BitbucketClient{
+		baseURL:    baseURL,
+		token:      token,
+		httpClient: &http.Client{},
+	}
+}
+
+type bitbucketCreateRepoRequest struct {
+	SCM         string `json:"scm"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   bool   `json:"is_private"`
+}
+
+type bitbucketRepoResponse struct {
+	Links struct {
+		Clone []struct {
+			Name string `json:"name"`
+			Href string `json:"href"`
+		} `json:"clone"`
+	} `json:"links"`
+}
+
+// CreateRepository creates an empty repository in the workspace and returns
+// its HTTPS clone URL
+func (c *BitbucketClient) CreateRepository(
+	ctx context.Context,
+	workspace, name, description string,
+	private bool,
+) (string, error) {
+	endpoint := fmt.Sprintf("%s/repositories/%s/%s", c.baseURL, url.PathEscape(workspace), url.PathEscape(name))
+
+	jsonBody, err := json.Marshal(bitbucketCreateRepoRequest{
+		SCM:         "git",
+		Description: description,
+		IsPrivate:   private,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("Bitbucket API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result bitbucketRepoResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	for _, link := range result.Links.Clone {
+		if link.Name != "https" {
+			continue
+		}
+		// Bitbucket includes the API user in the URL; credentials are added
+		// separately for each git operation
+		cloneURL, err := url.Parse(link.Href)
+		if err != nil {
+			return "", fmt.Errorf("invalid clone URL in response: %w", err)
+		}
+		cloneURL.User = nil
+		return cloneURL.String(), nil
+	}
+	return "", fmt.Errorf("Bitbucket API response has no HTTPS clone URL")
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitbucketClient_CreateRepository_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/repositories/my-workspace/new-repo", r.URL.Path)
+		assert.Equal(t, "Bearer oauth-token", r.Header.Get("Authorization"))
+
+		var body bitbucketCreateRepoRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, bitbucketCreateRepoRequest{SCM: "git", Description: "Description", IsPrivate: true}, body)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"links": {"clone": [
+			{"name": "https", "href": "https://orbit-bot@bitbucket.org/my-workspace/new-repo.git"},
+			{"name": "ssh", "href": "git@bitbucket.org:my-workspace/new-repo.git"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := NewBitbucketClient(server.URL, "oauth-token")
+
+	url, err := client.CreateRepository(context.Background(), "my-workspace", "new-repo", "Description", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://bitbucket.org/my-workspace/new-repo.git", url)
+}
+
+func TestBitbucketClient_CreateRepository_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"message": "Repository with this Slug and Owner already exists."}}`))
+	}))
+	defer server.Close()
+
+	client := NewBitbucketClient(server.URL, "oauth-token")
+
+	_, err := client.CreateRepository(context.Background(), "my-workspace", "new-repo", "", false)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+}
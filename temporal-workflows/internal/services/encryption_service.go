@@ -0,0 +1,90 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// gcmIVSize matches the 16 byte IV orbit-www uses, so tokens encrypted by
+// either side can be read by the other
+const gcmIVSize = 16
+
+// AESGCMEncryptionService encrypts installation tokens at rest with
+// AES-256-GCM. Ciphertexts use orbit-www's format (src/lib/encryption):
+// hex(iv):hex(authTag):hex(data).
+type AESGCMEncryptionService struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCMEncryptionService creates an EncryptionService from the base64
+// encoded 32 byte key shared with orbit-www as ENCRYPTION_KEY
+func NewAESGCMEncryptionService(base64Key string) (*AESGCMEncryptionService, error) {
+	if base64Key == "" {
+		return nil, errors.New("encryption key is required")
+	}
+	key, err := base64.StdEncoding.DecodeString(base64Key)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key must be base64 encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes when base64 decoded, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCMWithNonceSize(block, gcmIVSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return &AESGCMEncryptionService{aead: aead}, nil
+}
+
+// Encrypt encrypts plaintext with a random IV
+func (s *AESGCMEncryptionService) Encrypt(plaintext string) (string, error) {
+	iv := make([]byte, gcmIVSize)
+	if _, err := rand.Read(iv); err != nil {
+		return "", fmt.Errorf("failed to generate IV: %w", err)
+	}
+
+	// Seal appends the auth tag to the encrypted data
+	sealed := s.aead.Seal(nil, iv, []byte(plaintext), nil)
+	data, tag := sealed[:len(sealed)-s.aead.Overhead()], sealed[len(sealed)-s.aead.Overhead():]
+
+	return hex.EncodeToString(iv) + ":" + hex.EncodeToString(tag) + ":" + hex.EncodeToString(data), nil
+}
+
+// Decrypt decrypts a value produced by Encrypt or by orbit-www
+func (s *AESGCMEncryptionService) Decrypt(ciphertext string) (string, error) {
+	parts := strings.Split(ciphertext, ":")
+	if len(parts) != 3 {
+		return "", errors.New("invalid encrypted text format")
+	}
+
+	iv, err := hex.DecodeString(parts[0])
+	if err != nil || len(iv) != gcmIVSize {
+		return "", errors.New("invalid encrypted text IV")
+	}
+	tag, err := hex.DecodeString(parts[1])
+	if err != nil || len(tag) != s.aead.Overhead() {
+		return "", errors.New("invalid encrypted text auth tag")
+	}
+	data, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.New("invalid encrypted text data")
+	}
+
+	plaintext, err := s.aead.Open(nil, iv, append(data, tag...), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEncryptionKey is the bytes 0x00..0x1f, base64 encoded
+const testEncryptionKey = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+
+func TestAESGCMEncryptionService_RoundTrip(t *testing.T) {
+	svc, err := NewAESGCMEncryptionService(testEncryptionKey)
+	require.NoError(t, err)
+
+	for _, plaintext := range []string{"ghs_exampleInstallationToken", "", "ünïcödé ✓"} {
+		ciphertext, err := svc.Encrypt(plaintext)
+		require.NoError(t, err)
+		assert.Len(t, strings.Split(ciphertext, ":"), 3)
+
+		decrypted, err := svc.Decrypt(ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	}
+
+	// A fresh IV per call means equal plaintexts encrypt differently
+	a, _ := svc.Encrypt("token")
+	b, _ := svc.Encrypt("token")
+	assert.NotEqual(t, a, b)
+}
+
+func TestAESGCMEncryptionService_DecryptsOrbitWWWFormat(t *testing.T) {
+	svc, err := NewAESGCMEncryptionService(testEncryptionKey)
+	require.NoError(t, err)
+
+	// Produced by orbit-www's encrypt() with the same key and an IV of 0x07 bytes
+	plaintext, err := svc.Decrypt("07070707070707070707070707070707:8940c172887f88625d74e455dfd4230f:af00a8a99dc6dc8e5eda8d9a229b8b18b576f70a207888c2cb289b59")
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_exampleInstallationToken", plaintext)
+}
+
+func TestAESGCMEncryptionService_DecryptRejectsTampering(t *testing.T) {
+	svc, err := NewAESGCMEncryptionService(testEncryptionKey)
+	require.NoError(t, err)
+
+	ciphertext, err := svc.Encrypt("ghs_exampleInstallationToken")
+	require.NoError(t, err)
+	parts := strings.Split(ciphertext, ":")
+
+	for name, value := range map[string]string{
+		"wrong format": "abc",
+		"bad iv":       "zz:" + parts[1] + ":" + parts[2],
+		"short tag":    parts[0] + ":00:" + parts[2],
+		"changed data": parts[0] + ":" + parts[1] + ":" + flipHexDigit(parts[2]),
+	} {
+		_, err := svc.Decrypt(value)
+		assert.Error(t, err, name)
+	}
+
+	// A different key cannot decrypt
+	otherKey := make([]byte, 32)
+	other, err := NewAESGCMEncryptionService(base64.StdEncoding.EncodeToString(otherKey))
+	require.NoError(t, err)
+	_, err = other.Decrypt(ciphertext)
+	assert.Error(t, err)
+}
+
+func TestNewAESGCMEncryptionService_InvalidKey(t *testing.T) {
+	for _, key := range []string{"", "not base64!", base64.StdEncoding.EncodeToString(make([]byte, 16))} {
+		_, err := NewAESGCMEncryptionService(key)
+		assert.Error(t, err, key)
+	}
+}
+
+// flipHexDigit changes the first hex digit of s to a different hex digit
+func flipHexDigit(s string) string {
+	replacement := "0"
+	if s[0] == '0' {
+		replacement = "1"
+	}
+	return replacement + s[1:]
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GitHubAppClient implements GitHubClient with GitHub App credentials. It
+// signs short-lived app JWTs to mint installation access tokens.
+type GitHubAppClient struct {
+	appID      int64
+	privateKey *rsa.PrivateKey
+	baseURL    string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewGitHubAppClient creates a GitHubAppClient from the app ID and its PEM
+// encoded private key. An empty baseURL uses the public GitHub API.
+func NewGitHubAppClient(appID int64, privateKeyPEM []byte, baseURL string) (*GitHubAppClient, error) {
+	if appID <= 0 {
+		return nil, errors.New("GitHub App ID is required")
+	}
+	privateKey, err := parseGitHubAppPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	if baseURL == "" {
+		baseURL = "https://api.github.com"
+	}
+	return &GitHubAppClient{
+		appID:      appID,
+		privateKey: privateKey,
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}, nil
+}
+
+// parseGitHubAppPrivateKey accepts the PKCS#1 keys GitHub issues as well as
+// PKCS#8 conversions of them
+func parseGitHubAppPrivateKey(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("GitHub App private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub App private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GitHub App private key must be an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// appJWT returns a JWT authenticating as the app itself. The issue time is
+// backdated a minute to allow for clock drift, and GitHub rejects
+// expirations more than ten minutes out.
+func (c *GitHubAppClient) appJWT() (string, error) {
+	now := c.now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(c.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign app JWT: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// CreateInstallationAccessToken mints a new installation access token
+func (c *GitHubAppClient) CreateInstallationAccessToken(ctx context.Context, installationID int64) (string, time.Time, error) {
+	jwt, err := c.appJWT()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", c.baseURL, installationID)
+	var result struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := c.do(ctx, "POST", url, jwt, nil, http.StatusCreated, &result); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create installation token: %w", err)
+	}
+	if result.Token == "" {
+		return "", time.Time{}, errors.New("failed to create installation token: empty token in response")
+	}
+
+	return result.Token, result.ExpiresAt, nil
+}
+
+// CreateRepository creates a repository in orgName and returns its clone URL
+func (c *GitHubAppClient) CreateRepository(ctx context.Context, token string, orgName string, repoName string, private bool) (string, error) {
+	url := fmt.Sprintf("%s/orgs/%s/repos", c.baseURL, orgName)
+	body := createRepoRequest{
+		Name:    repoName,
+		Private: private,
+	}
+	var result struct {
+		CloneURL string `json:"clone_url"`
+	}
+	if err := c.do(ctx, "POST", url, token, body, http.StatusCreated, &result); err != nil {
+		return "", err
+	}
+
+	return result.CloneURL, nil
+}
+
+func (c *GitHubAppClient) do(ctx context.Context, method, url, bearer string, body interface{}, wantStatus int, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != wantStatus {
+		return fmt.Errorf("GitHub API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
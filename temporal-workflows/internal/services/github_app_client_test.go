@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAppKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+// verifyAppJWT checks the RS256 signature of an app JWT and returns its claims
+func verifyAppJWT(t *testing.T, publicKey *rsa.PublicKey, jwt string) map[string]interface{} {
+	t.Helper()
+	parts := strings.Split(jwt, ".")
+	require.Len(t, parts, 3)
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.NoError(t, rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature))
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	return claims
+}
+
+func TestGitHubAppClient_CreateInstallationAccessToken(t *testing.T) {
+	key, keyPEM := newTestAppKey(t)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/app/installations/456/access_tokens", r.URL.Path)
+
+		jwt := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		claims := verifyAppJWT(t, &key.PublicKey, jwt)
+		assert.Equal(t, "12345", claims["iss"])
+		assert.Equal(t, float64(now.Add(-time.Minute).Unix()), claims["iat"])
+		assert.Equal(t, float64(now.Add(9*time.Minute).Unix()), claims["exp"])
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"token": "ghs_refreshed", "expires_at": "2026-03-01T13:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewGitHubAppClient(12345, keyPEM, server.URL)
+	require.NoError(t, err)
+	client.now = func() time.Time { return now }
+
+	token, expiresAt, err := client.CreateInstallationAccessToken(context.Background(), 456)
+
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_refreshed", token)
+	assert.Equal(t, time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC), expiresAt)
+}
+
+func TestGitHubAppClient_CreateInstallationAccessToken_Error(t *testing.T) {
+	_, keyPEM := newTestAppKey(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "A JSON web token could not be decoded"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewGitHubAppClient(12345, keyPEM, server.URL)
+	require.NoError(t, err)
+
+	_, _, err = client.CreateInstallationAccessToken(context.Background(), 456)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestGitHubAppClient_CreateRepository(t *testing.T) {
+	_, keyPEM := newTestAppKey(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/orgs/mycompany/repos", r.URL.Path)
+		assert.Equal(t, "Bearer ghs_installation", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "new-repo", "private": true, "auto_init": false}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"clone_url": "https://github.com/mycompany/new-repo.git"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewGitHubAppClient(12345, keyPEM, server.URL)
+	require.NoError(t, err)
+
+	gitURL, err := client.CreateRepository(context.Background(), "ghs_installation", "mycompany", "new-repo", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/mycompany/new-repo.git", gitURL)
+}
+
+func TestNewGitHubAppClient_InvalidCredentials(t *testing.T) {
+	key, keyPEM := newTestAppKey(t)
+
+	_, err := NewGitHubAppClient(0, keyPEM, "")
+	assert.Error(t, err)
+
+	_, err = NewGitHubAppClient(12345, []byte("not a key"), "")
+	assert.Error(t, err)
+
+	// PKCS#8 conversions of the app key are accepted
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	_, err = NewGitHubAppClient(12345, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), "")
+	assert.NoError(t, err)
+}
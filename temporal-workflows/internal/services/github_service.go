@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -116,6 +118,10 @@ func (s *gitHubService) FindInstallationForWorkspace(
 	workspaceID string,
 	installationID *string,
 ) (*Installation, error) {
+	if s.payloadClient == nil {
+		return nil, errors.New("GitHub integration is not configured: no Payload client")
+	}
+
 	// Query Payload for installations
 	query := map[string]interface{}{
 		"status": "active",
@@ -131,12 +137,9 @@ func (s *gitHubService) FindInstallationForWorkspace(
 	// Convert docs to Installation structs
 	var installations []*Installation
 	for _, doc := range docs {
-		inst := &Installation{
-			ID:             doc["id"].(string),
-			InstallationID: doc["installationId"].(int64),
-			AccountLogin:   doc["accountLogin"].(string),
-			EncryptedToken: doc["installationToken"].(string),
-			TokenExpiresAt: doc["tokenExpiresAt"].(time.Time),
+		inst, err := installationFromDocument(doc)
+		if err != nil {
+			return nil, err
 		}
 		installations = append(installations, inst)
 	}
@@ -175,6 +178,43 @@ Available installations for this workspace:
 	return installations[0], nil
 }
 
+// installationFromDocument converts a github-installations document. Fields
+// decoded from JSON arrive as float64 and RFC 3339 strings.
+func installationFromDocument(doc map[string]interface{}) (*Installation, error) {
+	inst := &Installation{}
+	inst.ID, _ = doc["id"].(string)
+	inst.AccountLogin, _ = doc["accountLogin"].(string)
+	inst.EncryptedToken, _ = doc["installationToken"].(string)
+
+	switch v := doc["installationId"].(type) {
+	case int64:
+		inst.InstallationID = v
+	case float64:
+		inst.InstallationID = int64(v)
+	case json.Number:
+		id, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("installation %s has an invalid installationId: %w", inst.ID, err)
+		}
+		inst.InstallationID = id
+	default:
+		return nil, fmt.Errorf("installation %s has no installationId", inst.ID)
+	}
+
+	switch v := doc["tokenExpiresAt"].(type) {
+	case time.Time:
+		inst.TokenExpiresAt = v
+	case string:
+		expiresAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("installation %s has an invalid tokenExpiresAt: %w", inst.ID, err)
+		}
+		inst.TokenExpiresAt = expiresAt
+	}
+
+	return inst, nil
+}
+
 // GetInstallationToken decrypts and returns the access token
 func (s *gitHubService) GetInstallationToken(
 	ctx context.Context,
@@ -192,6 +232,10 @@ If this error persists:
 3. Check workflow logs for refresh failures`, installation.TokenExpiresAt.Format(time.RFC3339), installation.InstallationID)
 	}
 
+	if s.encryptionService == nil {
+		return "", errors.New("GitHub integration is not configured: no encryption service (set ENCRYPTION_KEY)")
+	}
+
 	// Decrypt the token
 	token, err := s.encryptionService.Decrypt(installation.EncryptedToken)
 	if err != nil {
@@ -209,6 +253,10 @@ func (s *gitHubService) CreateRepository(
 	repoName string,
 	private bool,
 ) (string, error) {
+	if s.githubClient == nil {
+		return "", errors.New("GitHub integration is not configured: no GitHub App client (set GITHUB_APP_ID and GITHUB_APP_PRIVATE_KEY_BASE64)")
+	}
+
 	gitURL, err := s.githubClient.CreateRepository(ctx, token, orgName, repoName, private)
 	if err != nil {
 		return "", fmt.Errorf("failed to create repository: %w", err)
@@ -477,3 +477,56 @@ func TestPrepareRemote_ValidationMissingRepoName(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "repository_name required")
 }
+
+// jsonPayloadClient returns documents shaped like decoded Payload JSON
+type jsonPayloadClient struct {
+	MockPayloadClient
+	Docs []map[string]interface{}
+}
+
+func (m *jsonPayloadClient) FindDocuments(ctx context.Context, collection string, query map[string]interface{}) ([]map[string]interface{}, error) {
+	return m.Docs, nil
+}
+
+// Test: PrepareRemote - Payload JSON documents with an AES-GCM encrypted token
+func TestPrepareRemote_DecodedPayloadDocument(t *testing.T) {
+	encryption, err := services.NewAESGCMEncryptionService("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+	require.NoError(t, err)
+	encryptedToken, err := encryption.Encrypt("ghs_installation")
+	require.NoError(t, err)
+
+	payload := &jsonPayloadClient{Docs: []map[string]interface{}{{
+		"id":                "install-123",
+		"installationId":    float64(456),
+		"accountLogin":      "mycompany",
+		"installationToken": encryptedToken,
+		"tokenExpiresAt":    time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+	}}}
+
+	svc := services.NewGitHubService(payload, encryption, &MockGitHubClient{})
+
+	output, err := svc.PrepareRemote(context.Background(), services.PrepareRemoteInput{
+		WorkspaceID:    "workspace-1",
+		RepositoryName: "new-repo",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_installation", output.AccessToken)
+	assert.Equal(t, "https://github.com/mycompany/new-repo.git", output.GitURL)
+	assert.Equal(t, "mycompany", output.InstallationOrgName)
+}
+
+// Test: GitHubService - unconfigured dependencies return errors instead of panicking
+func TestGitHubService_Unconfigured(t *testing.T) {
+	svc := services.NewGitHubService(nil, nil, nil)
+	ctx := context.Background()
+
+	_, err := svc.FindInstallationForWorkspace(ctx, "workspace-1", nil)
+	assert.ErrorContains(t, err, "not configured")
+
+	_, err = svc.GetInstallationToken(ctx, &services.Installation{TokenExpiresAt: time.Now().Add(time.Hour)})
+	assert.ErrorContains(t, err, "ENCRYPTION_KEY")
+
+	_, err = svc.CreateRepository(ctx, "ghs_token", "mycompany", "new-repo", true)
+	assert.ErrorContains(t, err, "GITHUB_APP_ID")
+}
@@ -100,3 +100,70 @@ func TestGitHubTemplateClient_DefaultBaseURL(t *testing.T) {
 	assert.NotNil(t, client)
 	// Can't easily test the baseURL is set correctly without exposing it
 }
+
+func TestGitHubTemplateClient_GetDefaultBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/template-org/template-repo", r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		w.Write([]byte(`{"name": "template-repo", "default_branch": "master"}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubTemplateClient(server.URL, "test-token")
+
+	branch, err := client.GetDefaultBranch(context.Background(), "template-org", "template-repo")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "master", branch)
+}
+
+func TestGitHubTemplateClient_GetDefaultBranch_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubTemplateClient(server.URL, "")
+
+	_, err := client.GetDefaultBranch(context.Background(), "template-org", "missing")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
+
+func TestGitHubTemplateClient_GetFileContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/repos/template-org/template-repo/contents/orbit-template.yaml", r.URL.Path)
+		assert.Equal(t, "release/1.0", r.URL.Query().Get("ref"))
+		assert.Equal(t, "application/vnd.github.raw+json", r.Header.Get("Accept"))
+
+		w.Write([]byte("apiVersion: orbit/v1\n"))
+	}))
+	defer server.Close()
+
+	client := NewGitHubTemplateClient(server.URL, "test-token")
+
+	content, err := client.GetFileContent(context.Background(), "template-org", "template-repo", "orbit-template.yaml", "release/1.0")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "apiVersion: orbit/v1\n", string(content))
+}
+
+func TestGitHubTemplateClient_GetFileContent_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubTemplateClient(server.URL, "")
+
+	_, err := client.GetFileContent(context.Background(), "template-org", "template-repo", "orbit-template.yaml", "")
+
+	assert.ErrorIs(t, err, ErrFileNotFound)
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GitLabClient handles GitLab API calls for template operations
+type GitLabClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewGitLabClient creates a new GitLab client. The token may be a personal
+// access token or an OAuth token; GitLab accepts both as a bearer token.
+func NewGitLabClient(baseURL, token string) *GitLabClient {
+	if baseURL == "" {
+		baseURL = "https://gitlab.com"
+	}
+	return &GitLabClient{
+		baseURL:    baseURL,
+		token:      token,
+		httpClient: &http.Client{},
+	}
+}
+
+type gitlabCreateProjectRequest struct {
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	NamespaceID int64  `json:"namespace_id"`
+	Description string `json:"description,omitempty"`
+	Visibility  string `json:"visibility"`
+}
+
+// CreateRepository creates an empty project in the namespace (a group or
+// user path) and returns its HTTPS clone URL
+func (c *GitLabClient) CreateRepository(
+	ctx context.Context,
+	namespace, name, description string,
+	private bool,
+) (string, error) {
+	namespaceID, err := c.namespaceID(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	visibility := "public"
+	if private {
+		visibility = "private"
+	}
+	body := gitlabCreateProjectRequest{
+		Name:        name,
+		Path:        name,
+		NamespaceID: namespaceID,
+		Description: description,
+		Visibility:  visibility,
+	}
+
+	var result struct {
+		HTTPURLToRepo string `json:"http_url_to_repo"`
+	}
+	if err := c.do(ctx, "POST", "/api/v4/projects", body, http.StatusCreated, &result); err != nil {
+		return "", err
+	}
+	return result.HTTPURLToRepo, nil
+}
+
+// namespaceID looks up the numeric ID GitLab needs to create a project in a
+// group or user namespace
+func (c *GitLabClient) namespaceID(ctx context.Context, namespace string) (int64, error) {
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.do(ctx, "GET", "/api/v4/namespaces/"+url.PathEscape(namespace), nil, http.StatusOK, &result); err != nil {
+		return 0, fmt.Errorf("failed to look up namespace %s: %w", namespace, err)
+	}
+	return result.ID, nil
+}
+
+func (c *GitLabClient) do(ctx context.Context, method, path string, body any, wantStatus int, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != wantStatus {
+		return fmt.Errorf("GitLab API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabClient_CreateRepository_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer glpat-token", r.Header.Get("Authorization"))
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /api/v4/namespaces/platform%2Fservices":
+			w.Write([]byte(`{"id": 42, "full_path": "platform/services"}`))
+		case "POST /api/v4/projects":
+			var body gitlabCreateProjectRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, gitlabCreateProjectRequest{
+				Name:        "new-repo",
+				Path:        "new-repo",
+				NamespaceID: 42,
+				Description: "Description",
+				Visibility:  "private",
+			}, body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"http_url_to_repo": "https://gitlab.com/platform/services/new-repo.git"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitLabClient(server.URL, "glpat-token")
+
+	url, err := client.CreateRepository(context.Background(), "platform/services", "new-repo", "Description", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/platform/services/new-repo.git", url)
+}
+
+func TestGitLabClient_CreateRepository_NamespaceNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "404 Namespace Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := NewGitLabClient(server.URL, "glpat-token")
+
+	_, err := client.CreateRepository(context.Background(), "missing", "new-repo", "", false)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "namespace missing")
+	assert.Contains(t, err.Error(), "404")
+}
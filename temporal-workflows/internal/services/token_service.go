@@ -7,20 +7,49 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 )
 
-// TokenService defines the interface for fetching GitHub installation tokens
+// TokenService defines the interface for fetching git credentials
 type TokenService interface {
 	// GetInstallationToken fetches a GitHub token for the given installation ID
 	GetInstallationToken(ctx context.Context, installationID string) (string, error)
+	// GetConnectionToken fetches the credential of a git connection, used
+	// for providers other than GitHub (a GitLab PAT, a Bitbucket OAuth token)
+	GetConnectionToken(ctx context.Context, connectionID string) (string, error)
 }
 
-// PayloadTokenService fetches tokens from the Payload API
+// tokenRefreshMargin is how long before expiry a cached token is refreshed.
+// It exceeds the API's own one minute buffer so a cached token is never
+// handed out after the API would have stopped serving it.
+const tokenRefreshMargin = 5 * time.Minute
+
+// PayloadTokenService fetches tokens from the Payload API. Tokens are cached
+// per installation until shortly before they expire, and concurrent callers
+// share a single request while a token is being fetched.
 type PayloadTokenService struct {
 	orbitAPIURL string
 	apiKey      string
 	httpClient  *http.Client
+	now         func() time.Time
+
+	mu       sync.Mutex
+	cache    map[string]cachedToken
+	inflight map[string]*tokenFetch
+}
+
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// tokenFetch is a request in progress; token and err are set before done
+// is closed
+type tokenFetch struct {
+	done  chan struct{}
+	token cachedToken
+	err   error
 }
 
 // NewPayloadTokenService creates a new token service
@@ -31,6 +60,9 @@ func NewPayloadTokenService(orbitAPIURL, apiKey string) *PayloadTokenService {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		now:      time.Now,
+		cache:    make(map[string]cachedToken),
+		inflight: make(map[string]*tokenFetch),
 	}
 }
 
@@ -48,11 +80,118 @@ type errorResponse struct {
 	Code  string `json:"code"`
 }
 
-// GetInstallationToken fetches a GitHub token for the given installation ID
+// GetInstallationToken returns a GitHub token for the given installation ID,
+// from the cache when it is not close to expiry
 func (s *PayloadTokenService) GetInstallationToken(ctx context.Context, installationID string) (string, error) {
+	s.mu.Lock()
+	if cached, ok := s.cache[installationID]; ok && s.now().Before(cached.expiresAt.Add(-tokenRefreshMargin)) {
+		s.mu.Unlock()
+		return cached.token, nil
+	}
+	fetch, ok := s.inflight[installationID]
+	if !ok {
+		fetch = &tokenFetch{done: make(chan struct{})}
+		s.inflight[installationID] = fetch
+		// Detached from ctx so one caller going away does not fail the
+		// others waiting on the same fetch; the client timeout bounds it
+		go s.refreshInstallationToken(context.WithoutCancel(ctx), installationID, fetch)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-fetch.done:
+		return fetch.token.token, fetch.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// refreshInstallationToken completes fetch and caches its token
+func (s *PayloadTokenService) refreshInstallationToken(ctx context.Context, installationID string, fetch *tokenFetch) {
+	fetch.token, fetch.err = s.fetchInstallationToken(ctx, installationID)
+
+	s.mu.Lock()
+	delete(s.inflight, installationID)
+	if fetch.err == nil && !fetch.token.expiresAt.IsZero() {
+		s.cache[installationID] = fetch.token
+	} else {
+		delete(s.cache, installationID)
+	}
+	s.mu.Unlock()
+
+	close(fetch.done)
+}
+
+// fetchInstallationToken requests a token from the API. The expiry is zero
+// when the API did not report a parseable one.
+func (s *PayloadTokenService) fetchInstallationToken(ctx context.Context, installationID string) (cachedToken, error) {
 	url := fmt.Sprintf("%s/api/internal/github/token", s.orbitAPIURL)
 
 	reqBody, err := json.Marshal(tokenRequest{InstallationID: installationID})
+	if err != nil {
+		return cachedToken{}, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(reqBody))
+	if err != nil {
+		return cachedToken{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", s.apiKey)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return cachedToken{}, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cachedToken{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var tokenResp tokenResponse
+		if err := json.Unmarshal(body, &tokenResp); err != nil {
+			return cachedToken{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		expiresAt, _ := time.Parse(time.RFC3339, tokenResp.ExpiresAt)
+		return cachedToken{token: tokenResp.Token, expiresAt: expiresAt}, nil
+
+	case http.StatusUnauthorized:
+		return cachedToken{}, fmt.Errorf("unauthorized: invalid API key")
+
+	case http.StatusNotFound:
+		return cachedToken{}, fmt.Errorf("installation not found: %s", installationID)
+
+	case http.StatusGone:
+		return cachedToken{}, fmt.Errorf("token expired for installation %s, refresh workflow may be stalled", installationID)
+
+	default:
+		var errResp errorResponse
+		if err := json.Unmarshal(body, &errResp); err != nil {
+			return cachedToken{}, fmt.Errorf("API error (status %d): failed to parse error response", resp.StatusCode)
+		}
+		return cachedToken{}, fmt.Errorf("API error (status %d): %s", resp.StatusCode, errResp.Error)
+	}
+}
+
+type connectionTokenRequest struct {
+	ConnectionID string `json:"connectionId"`
+}
+
+type connectionTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// GetConnectionToken fetches the decrypted credential of a git connection.
+// Connection credentials are read on every call and never cached.
+func (s *PayloadTokenService) GetConnectionToken(ctx context.Context, connectionID string) (string, error) {
+	url := fmt.Sprintf("%s/api/internal/git-connections/token", s.orbitAPIURL)
+
+	reqBody, err := json.Marshal(connectionTokenRequest{ConnectionID: connectionID})
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
@@ -76,28 +215,28 @@ func (s *PayloadTokenService) GetInstallationToken(ctx context.Context, installa
 		return "", fmt.Errorf("failed to read response: %w", err)
 	}
 
+	// The body may carry the credential, so it is never echoed into errors
 	switch resp.StatusCode {
 	case http.StatusOK:
-		var tokenResp tokenResponse
+		var tokenResp connectionTokenResponse
 		if err := json.Unmarshal(body, &tokenResp); err != nil {
 			return "", fmt.Errorf("failed to parse response: %w", err)
 		}
+		if tokenResp.Token == "" {
+			return "", fmt.Errorf("git connection %s returned an empty token", connectionID)
+		}
 		return tokenResp.Token, nil
 
 	case http.StatusUnauthorized:
 		return "", fmt.Errorf("unauthorized: invalid API key")
 
 	case http.StatusNotFound:
-		return "", fmt.Errorf("installation not found: %s", installationID)
+		return "", fmt.Errorf("git connection not found: %s", connectionID)
 
 	case http.StatusGone:
-		return "", fmt.Errorf("token expired for installation %s, refresh workflow may be stalled", installationID)
+		return "", fmt.Errorf("git connection %s has no usable credentials", connectionID)
 
 	default:
-		var errResp errorResponse
-		if err := json.Unmarshal(body, &errResp); err != nil {
-			return "", fmt.Errorf("API error (status %d): failed to parse error response", resp.StatusCode)
-		}
-		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, errResp.Error)
+		return "", fmt.Errorf("API error (status %d)", resp.StatusCode)
 	}
 }
@@ -2,9 +2,14 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,3 +78,131 @@ func TestPayloadTokenService_GetInstallationToken_Expired(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "expired")
 }
+
+func TestPayloadTokenService_GetInstallationToken_ReusesCachedToken(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"token": "ghs_token_%d", "expiresAt": "2026-01-28T12:00:00Z"}`, n)
+	}))
+	defer server.Close()
+
+	svc := NewPayloadTokenService(server.URL, "test-api-key")
+	svc.now = func() time.Time { return time.Date(2026, 1, 28, 11, 0, 0, 0, time.UTC) }
+
+	for i := 0; i < 3; i++ {
+		token, err := svc.GetInstallationToken(context.Background(), "12345")
+		require.NoError(t, err)
+		assert.Equal(t, "ghs_token_1", token)
+	}
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Installations are cached independently
+	token, err := svc.GetInstallationToken(context.Background(), "67890")
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token_2", token)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestPayloadTokenService_GetInstallationToken_RefreshesNearExpiryOnce(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		if n > 1 {
+			// Hold the refresh so concurrent callers pile up behind it
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"token": "ghs_token_%d", "expiresAt": "2026-01-28T12:00:00Z"}`, n)
+	}))
+	defer server.Close()
+
+	svc := NewPayloadTokenService(server.URL, "test-api-key")
+	now := time.Date(2026, 1, 28, 11, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	token, err := svc.GetInstallationToken(context.Background(), "12345")
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_token_1", token)
+
+	// Within the refresh margin of the expiry
+	now = time.Date(2026, 1, 28, 11, 58, 0, 0, time.UTC)
+
+	const callers = 20
+	tokens := make([]string, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			token, err := svc.GetInstallationToken(context.Background(), "12345")
+			assert.NoError(t, err)
+			tokens[i] = token
+		}(i)
+	}
+
+	// Let every caller reach the in-flight fetch before it completes
+	require.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(2), requests.Load(), "concurrent callers should share one refresh")
+	for _, token := range tokens {
+		assert.Equal(t, "ghs_token_2", token)
+	}
+}
+
+func TestPayloadTokenService_GetInstallationToken_DoesNotCacheFailures(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusGone)
+		w.Write([]byte(`{"error": "Token expired", "code": "EXPIRED"}`))
+	}))
+	defer server.Close()
+
+	svc := NewPayloadTokenService(server.URL, "test-api-key")
+
+	for i := 0; i < 2; i++ {
+		_, err := svc.GetInstallationToken(context.Background(), "12345")
+		require.Error(t, err)
+	}
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestPayloadTokenService_GetConnectionToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/internal/git-connections/token", r.URL.Path)
+		assert.Equal(t, "test-api-key", r.Header.Get("X-API-Key"))
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		switch body["connectionId"] {
+		case "connection-1":
+			w.Write([]byte(`{"provider": "gitlab", "authMode": "basic-pat", "token": "glpat-secret"}`))
+		case "unconfigured":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"error": "Connection has no credentials", "code": "NOT_CONFIGURED"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "Connection not found", "code": "NOT_FOUND"}`))
+		}
+	}))
+	defer server.Close()
+
+	svc := NewPayloadTokenService(server.URL, "test-api-key")
+
+	token, err := svc.GetConnectionToken(context.Background(), "connection-1")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-secret", token)
+
+	_, err = svc.GetConnectionToken(context.Background(), "unconfigured")
+	assert.ErrorContains(t, err, "no usable credentials")
+
+	_, err = svc.GetConnectionToken(context.Background(), "missing")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,65 @@
+// temporal-workflows/internal/workflows/bifrost_topic_callback_dispatcher.go
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.temporal.io/sdk/client"
+)
+
+// BifrostTopicSyncTaskQueue is the task queue the worker runs BifrostTopicSyncWorkflow on.
+const BifrostTopicSyncTaskQueue = "orbit-workflows"
+
+// TemporalTopicCallbackDispatcher forwards topic callbacks received by
+// BifrostCallbackService to the topic's BifrostTopicSyncWorkflow, starting the
+// workflow if it is not already running.
+type TemporalTopicCallbackDispatcher struct {
+	client client.Client
+	logger *slog.Logger
+}
+
+// NewTemporalTopicCallbackDispatcher wraps a Temporal client for topic callbacks.
+func NewTemporalTopicCallbackDispatcher(c client.Client, logger *slog.Logger) *TemporalTopicCallbackDispatcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &TemporalTopicCallbackDispatcher{client: c, logger: logger}
+}
+
+// Dispatch signals callback to the sync workflow for its topic.
+func (d *TemporalTopicCallbackDispatcher) Dispatch(ctx context.Context, callback BifrostTopicCallback) error {
+	switch callback.Type {
+	case BifrostTopicCallbackCreated, BifrostTopicCallbackDeleted, BifrostTopicCallbackConfigUpdated:
+	default:
+		return fmt.Errorf("unknown topic callback type %q", callback.Type)
+	}
+	if callback.VirtualClusterID == "" || callback.VirtualName == "" {
+		return fmt.Errorf("topic callback requires virtual cluster ID and virtual name")
+	}
+
+	workflowID := BifrostTopicSyncWorkflowID(callback.VirtualClusterID, callback.VirtualName)
+	run, err := d.client.SignalWithStartWorkflow(ctx, workflowID, SignalBifrostTopicCallback, callback,
+		client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: BifrostTopicSyncTaskQueue,
+		},
+		BifrostTopicSyncWorkflow,
+		BifrostTopicSyncInput{
+			VirtualClusterID: callback.VirtualClusterID,
+			VirtualName:      callback.VirtualName,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("signal topic sync workflow %s: %w", workflowID, err)
+	}
+
+	d.logger.Info("Dispatched topic callback",
+		slog.String("workflowId", workflowID),
+		slog.String("runId", run.GetRunID()),
+		slog.String("eventId", callback.EventID),
+		slog.String("type", callback.Type),
+	)
+	return nil
+}
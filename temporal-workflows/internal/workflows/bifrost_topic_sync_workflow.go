@@ -0,0 +1,200 @@
+// temporal-workflows/internal/workflows/bifrost_topic_sync_workflow.go
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/drewpayment/orbit/temporal-workflows/internal/activities"
+)
+
+const (
+	// SignalBifrostTopicCallback delivers a BifrostTopicCallback to the topic's sync workflow
+	SignalBifrostTopicCallback = "bifrost-topic-callback"
+
+	// BifrostTopicCallbackCreated is sent when a topic is created through the gateway
+	BifrostTopicCallbackCreated = "created"
+	// BifrostTopicCallbackDeleted is sent when a topic is deleted through the gateway
+	BifrostTopicCallbackDeleted = "deleted"
+	// BifrostTopicCallbackConfigUpdated is sent when a topic's config is changed through the gateway
+	BifrostTopicCallbackConfigUpdated = "config_updated"
+
+	// Activity names registered by the worker from TopicSyncActivitiesImpl
+	ActivityCreateTopicRecord = "CreateTopicRecord"
+	ActivityMarkTopicDeleted  = "MarkTopicDeleted"
+	ActivityUpdateTopicConfig = "UpdateTopicConfig"
+
+	// bifrostTopicSyncIdleTimeout is how long the workflow waits for another
+	// callback before completing. The next callback starts a new run.
+	bifrostTopicSyncIdleTimeout = 24 * time.Hour
+	// maxBifrostTopicCallbacksPerRun bounds history before continuing as new
+	maxBifrostTopicCallbacksPerRun = 500
+	// maxRememberedBifrostTopicCallbacks bounds the event IDs kept for deduplication
+	maxRememberedBifrostTopicCallbacks = 100
+)
+
+// BifrostTopicCallback is a topic event reported by Bifrost through BifrostCallbackService
+type BifrostTopicCallback struct {
+	// EventID identifies the callback; redelivered callbacks carry the same ID
+	EventID           string            `json:"eventId"`
+	Type              string            `json:"type"`
+	VirtualClusterID  string            `json:"virtualClusterId"`
+	VirtualName       string            `json:"virtualName"`
+	PhysicalName      string            `json:"physicalName,omitempty"`
+	Partitions        int               `json:"partitions,omitempty"`
+	ReplicationFactor int               `json:"replicationFactor,omitempty"`
+	Config            map[string]string `json:"config,omitempty"`
+	CredentialID      string            `json:"credentialId,omitempty"`
+}
+
+// BifrostTopicSyncInput is the input for BifrostTopicSyncWorkflow
+type BifrostTopicSyncInput struct {
+	VirtualClusterID string `json:"virtualClusterId"`
+	VirtualName      string `json:"virtualName"`
+	// ProcessedEventIDs carries deduplication state across continue-as-new
+	ProcessedEventIDs []string `json:"processedEventIds,omitempty"`
+}
+
+// BifrostTopicSyncResult is the result of BifrostTopicSyncWorkflow
+type BifrostTopicSyncResult struct {
+	Processed  int `json:"processed"`
+	Duplicates int `json:"duplicates"`
+	Failed     int `json:"failed"`
+}
+
+// BifrostTopicSyncWorkflowID returns the ID of the sync workflow for a topic.
+// Callbacks for the same topic are signalled to one workflow so they are
+// applied in the order Bifrost reported them.
+func BifrostTopicSyncWorkflowID(virtualClusterID, virtualName string) string {
+	return fmt.Sprintf("bifrost-topic-sync-%s-%s", virtualClusterID, virtualName)
+}
+
+// BifrostTopicSyncWorkflow records topic create, delete and config update
+// callbacks from Bifrost in Orbit's topic repository. It is started with
+// SignalWithStart and processes SignalBifrostTopicCallback signals one at a
+// time, skipping callbacks whose EventID it has already processed.
+func BifrostTopicSyncWorkflow(ctx workflow.Context, input BifrostTopicSyncInput) (*BifrostTopicSyncResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting BifrostTopicSyncWorkflow",
+		"virtualClusterId", input.VirtualClusterID,
+		"virtualName", input.VirtualName)
+
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	processed := make(map[string]bool, len(input.ProcessedEventIDs))
+	for _, id := range input.ProcessedEventIDs {
+		processed[id] = true
+	}
+	processedOrder := append([]string(nil), input.ProcessedEventIDs...)
+
+	result := &BifrostTopicSyncResult{}
+	handle := func(callback BifrostTopicCallback) {
+		if callback.EventID != "" && processed[callback.EventID] {
+			logger.Info("Skipping duplicate topic callback", "eventId", callback.EventID, "type", callback.Type)
+			result.Duplicates++
+			return
+		}
+		if err := applyBifrostTopicCallback(ctx, callback); err != nil {
+			logger.Error("Failed to apply topic callback",
+				"eventId", callback.EventID,
+				"type", callback.Type,
+				"error", err)
+			result.Failed++
+			return
+		}
+		result.Processed++
+
+		if callback.EventID != "" {
+			processed[callback.EventID] = true
+			processedOrder = append(processedOrder, callback.EventID)
+			if len(processedOrder) > maxRememberedBifrostTopicCallbacks {
+				delete(processed, processedOrder[0])
+				processedOrder = processedOrder[1:]
+			}
+		}
+	}
+
+	callbackCh := workflow.GetSignalChannel(ctx, SignalBifrostTopicCallback)
+	for received := 0; received < maxBifrostTopicCallbacksPerRun; received++ {
+		var callback BifrostTopicCallback
+		idle := false
+
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(callbackCh, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, &callback)
+		})
+		selector.AddFuture(workflow.NewTimer(ctx, bifrostTopicSyncIdleTimeout), func(f workflow.Future) {
+			idle = true
+		})
+		selector.Select(ctx)
+
+		// A signal may race with the idle timer; only complete once none is pending
+		if idle && !callbackCh.ReceiveAsync(&callback) {
+			logger.Info("BifrostTopicSyncWorkflow idle, completing",
+				"processed", result.Processed,
+				"duplicates", result.Duplicates)
+			return result, nil
+		}
+		handle(callback)
+	}
+
+	// Apply signals that arrived during the last callback so none are lost
+	for {
+		var callback BifrostTopicCallback
+		if !callbackCh.ReceiveAsync(&callback) {
+			break
+		}
+		handle(callback)
+	}
+
+	logger.Info("Continuing BifrostTopicSyncWorkflow as new", "processed", result.Processed)
+	return nil, workflow.NewContinueAsNewError(ctx, BifrostTopicSyncWorkflow, BifrostTopicSyncInput{
+		VirtualClusterID:  input.VirtualClusterID,
+		VirtualName:       input.VirtualName,
+		ProcessedEventIDs: processedOrder,
+	})
+}
+
+// applyBifrostTopicCallback runs the topic sync activity matching the callback type
+func applyBifrostTopicCallback(ctx workflow.Context, callback BifrostTopicCallback) error {
+	switch callback.Type {
+	case BifrostTopicCallbackCreated:
+		return workflow.ExecuteActivity(ctx, ActivityCreateTopicRecord, activities.CreateTopicRecordInput{
+			VirtualClusterID:      callback.VirtualClusterID,
+			VirtualName:           callback.VirtualName,
+			PhysicalName:          callback.PhysicalName,
+			Partitions:            callback.Partitions,
+			ReplicationFactor:     callback.ReplicationFactor,
+			Config:                callback.Config,
+			CreatedByCredentialID: callback.CredentialID,
+		}).Get(ctx, nil)
+	case BifrostTopicCallbackDeleted:
+		return workflow.ExecuteActivity(ctx, ActivityMarkTopicDeleted, activities.MarkTopicDeletedInput{
+			VirtualClusterID:      callback.VirtualClusterID,
+			VirtualName:           callback.VirtualName,
+			PhysicalName:          callback.PhysicalName,
+			DeletedByCredentialID: callback.CredentialID,
+		}).Get(ctx, nil)
+	case BifrostTopicCallbackConfigUpdated:
+		return workflow.ExecuteActivity(ctx, ActivityUpdateTopicConfig, activities.UpdateTopicConfigInput{
+			VirtualClusterID:      callback.VirtualClusterID,
+			VirtualName:           callback.VirtualName,
+			Config:                callback.Config,
+			UpdatedByCredentialID: callback.CredentialID,
+		}).Get(ctx, nil)
+	default:
+		return fmt.Errorf("unknown topic callback type %q", callback.Type)
+	}
+}
@@ -0,0 +1,175 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	"github.com/drewpayment/orbit/temporal-workflows/internal/activities"
+)
+
+// Stub activity functions for bifrost topic sync testing
+
+func stubCreateTopicRecord(ctx context.Context, input activities.CreateTopicRecordInput) (*activities.CreateTopicRecordOutput, error) {
+	return &activities.CreateTopicRecordOutput{}, nil
+}
+
+func stubMarkTopicDeleted(ctx context.Context, input activities.MarkTopicDeletedInput) error {
+	return nil
+}
+
+func stubUpdateTopicConfig(ctx context.Context, input activities.UpdateTopicConfigInput) error {
+	return nil
+}
+
+func newBifrostTopicSyncTestEnv(t *testing.T) *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	// Register stub activities with names matching workflow constants
+	env.RegisterActivityWithOptions(stubCreateTopicRecord, activity.RegisterOptions{
+		Name: ActivityCreateTopicRecord,
+	})
+	env.RegisterActivityWithOptions(stubMarkTopicDeleted, activity.RegisterOptions{
+		Name: ActivityMarkTopicDeleted,
+	})
+	env.RegisterActivityWithOptions(stubUpdateTopicConfig, activity.RegisterOptions{
+		Name: ActivityUpdateTopicConfig,
+	})
+	return env
+}
+
+func signalTopicCallback(env *testsuite.TestWorkflowEnvironment, delay time.Duration, callback BifrostTopicCallback) {
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(SignalBifrostTopicCallback, callback)
+	}, delay)
+}
+
+func TestBifrostTopicSyncWorkflow_AppliesEachCallbackType(t *testing.T) {
+	env := newBifrostTopicSyncTestEnv(t)
+
+	env.OnActivity(ActivityCreateTopicRecord, mock.Anything, mock.MatchedBy(func(in activities.CreateTopicRecordInput) bool {
+		return in.VirtualClusterID == "vc-123" &&
+			in.VirtualName == "orders" &&
+			in.PhysicalName == "vc123_orders" &&
+			in.Partitions == 3 &&
+			in.CreatedByCredentialID == "cred-1"
+	})).Return(&activities.CreateTopicRecordOutput{TopicID: "topic-1", Status: "active"}, nil).Once()
+	env.OnActivity(ActivityUpdateTopicConfig, mock.Anything, mock.MatchedBy(func(in activities.UpdateTopicConfigInput) bool {
+		return in.Config["retention.ms"] == "1000" && in.UpdatedByCredentialID == "cred-1"
+	})).Return(nil).Once()
+	env.OnActivity(ActivityMarkTopicDeleted, mock.Anything, mock.MatchedBy(func(in activities.MarkTopicDeletedInput) bool {
+		return in.PhysicalName == "vc123_orders"
+	})).Return(nil).Once()
+
+	base := BifrostTopicCallback{VirtualClusterID: "vc-123", VirtualName: "orders", CredentialID: "cred-1"}
+	created := base
+	created.EventID, created.Type = "evt-1", BifrostTopicCallbackCreated
+	created.PhysicalName, created.Partitions, created.ReplicationFactor = "vc123_orders", 3, 2
+	updated := base
+	updated.EventID, updated.Type = "evt-2", BifrostTopicCallbackConfigUpdated
+	updated.Config = map[string]string{"retention.ms": "1000"}
+	deleted := base
+	deleted.EventID, deleted.Type, deleted.PhysicalName = "evt-3", BifrostTopicCallbackDeleted, "vc123_orders"
+
+	signalTopicCallback(env, time.Minute, created)
+	signalTopicCallback(env, 2*time.Minute, updated)
+	signalTopicCallback(env, 3*time.Minute, deleted)
+
+	env.ExecuteWorkflow(BifrostTopicSyncWorkflow, BifrostTopicSyncInput{VirtualClusterID: "vc-123", VirtualName: "orders"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result BifrostTopicSyncResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, 3, result.Processed)
+	require.Zero(t, result.Duplicates)
+	env.AssertExpectations(t)
+}
+
+func TestBifrostTopicSyncWorkflow_DuplicateCallbackIsIdempotent(t *testing.T) {
+	env := newBifrostTopicSyncTestEnv(t)
+
+	env.OnActivity(ActivityCreateTopicRecord, mock.Anything, mock.Anything).
+		Return(&activities.CreateTopicRecordOutput{TopicID: "topic-1", Status: "active"}, nil).Once()
+
+	callback := BifrostTopicCallback{
+		EventID:          "evt-1",
+		Type:             BifrostTopicCallbackCreated,
+		VirtualClusterID: "vc-123",
+		VirtualName:      "orders",
+		PhysicalName:     "vc123_orders",
+		Partitions:       3,
+	}
+	// Bifrost retries the callback after a lost acknowledgement
+	signalTopicCallback(env, time.Minute, callback)
+	signalTopicCallback(env, 2*time.Minute, callback)
+
+	env.ExecuteWorkflow(BifrostTopicSyncWorkflow, BifrostTopicSyncInput{VirtualClusterID: "vc-123", VirtualName: "orders"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result BifrostTopicSyncResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, 1, result.Processed)
+	require.Equal(t, 1, result.Duplicates)
+	env.AssertNumberOfCalls(t, ActivityCreateTopicRecord, 1)
+}
+
+func TestBifrostTopicSyncWorkflow_RedeliveryAfterContinueAsNew(t *testing.T) {
+	env := newBifrostTopicSyncTestEnv(t)
+
+	// The event was processed by a previous run and carried over
+	signalTopicCallback(env, time.Minute, BifrostTopicCallback{
+		EventID:          "evt-1",
+		Type:             BifrostTopicCallbackDeleted,
+		VirtualClusterID: "vc-123",
+		VirtualName:      "orders",
+	})
+
+	env.ExecuteWorkflow(BifrostTopicSyncWorkflow, BifrostTopicSyncInput{
+		VirtualClusterID:  "vc-123",
+		VirtualName:       "orders",
+		ProcessedEventIDs: []string{"evt-1"},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result BifrostTopicSyncResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Zero(t, result.Processed)
+	require.Equal(t, 1, result.Duplicates)
+	env.AssertNotCalled(t, ActivityMarkTopicDeleted, mock.Anything, mock.Anything)
+}
+
+func TestBifrostTopicSyncWorkflow_UnknownCallbackType(t *testing.T) {
+	env := newBifrostTopicSyncTestEnv(t)
+
+	signalTopicCallback(env, time.Minute, BifrostTopicCallback{
+		EventID:          "evt-1",
+		Type:             "renamed",
+		VirtualClusterID: "vc-123",
+		VirtualName:      "orders",
+	})
+
+	env.ExecuteWorkflow(BifrostTopicSyncWorkflow, BifrostTopicSyncInput{VirtualClusterID: "vc-123", VirtualName: "orders"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result BifrostTopicSyncResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, 1, result.Failed)
+}
+
+func TestBifrostTopicSyncWorkflowID(t *testing.T) {
+	require.Equal(t, "bifrost-topic-sync-vc-123-orders", BifrostTopicSyncWorkflowID("vc-123", "orders"))
+}
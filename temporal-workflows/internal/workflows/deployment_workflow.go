@@ -3,6 +3,7 @@ package workflows
 import (
 	"time"
 
+	"github.com/drewpayment/orbit/temporal-workflows/internal/activities"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
@@ -18,6 +19,47 @@ type DeploymentWorkflowInput struct {
 	Config        []byte                `json:"config"`
 	Target        DeploymentTargetInput `json:"target"`
 	Mode          string                `json:"mode"` // "generate" or "execute", defaults to "execute"
+	// RetryPolicies overrides the retry policy of individual activities,
+	// keyed by activity name (see the Activity* constants)
+	RetryPolicies map[string]ActivityRetryPolicy `json:"retryPolicies,omitempty"`
+}
+
+// ActivityRetryPolicy tunes how an activity is retried. Zero fields keep the
+// default. NonRetryableErrorTypes adds to the default list rather than
+// replacing it, so validation errors are never retried.
+type ActivityRetryPolicy struct {
+	MaximumAttempts        int32         `json:"maximumAttempts,omitempty"`
+	InitialInterval        time.Duration `json:"initialInterval,omitempty"`
+	BackoffCoefficient     float64       `json:"backoffCoefficient,omitempty"`
+	MaximumInterval        time.Duration `json:"maximumInterval,omitempty"`
+	NonRetryableErrorTypes []string      `json:"nonRetryableErrorTypes,omitempty"`
+}
+
+// deploymentRetryPolicy returns the retry policy for an activity: three
+// attempts with the SDK's default backoff, adjusted by any override
+func deploymentRetryPolicy(overrides map[string]ActivityRetryPolicy, activity string) temporal.RetryPolicy {
+	policy := temporal.RetryPolicy{
+		MaximumAttempts:        3,
+		NonRetryableErrorTypes: []string{activities.DeploymentInvalidConfigErrorType},
+	}
+	override, ok := overrides[activity]
+	if !ok {
+		return policy
+	}
+	if override.MaximumAttempts > 0 {
+		policy.MaximumAttempts = override.MaximumAttempts
+	}
+	if override.InitialInterval > 0 {
+		policy.InitialInterval = override.InitialInterval
+	}
+	if override.BackoffCoefficient > 0 {
+		policy.BackoffCoefficient = override.BackoffCoefficient
+	}
+	if override.MaximumInterval > 0 {
+		policy.MaximumInterval = override.MaximumInterval
+	}
+	policy.NonRetryableErrorTypes = append(policy.NonRetryableErrorTypes, override.NonRetryableErrorTypes...)
+	return policy
 }
 
 // DeploymentTargetInput contains deployment target information
@@ -82,6 +124,9 @@ const (
 	ActivityExecuteGenerator         = "ExecuteGenerator"
 	ActivityUpdateDeploymentStatus   = "UpdateDeploymentStatus"
 	ActivityCommitToRepo             = "CommitToRepo"
+	// Compensations run when a deployment fails after preparing its context
+	ActivityRollbackGeneratorContext   = "RollbackGeneratorContext"
+	ActivityRollbackGeneratorExecution = "RollbackGeneratorExecution"
 	// ActivityCleanupWorkDir is already defined in template_instantiation_workflow.go
 )
 
@@ -114,12 +159,16 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 	// Activity options
 	activityOptions := workflow.ActivityOptions{
 		StartToCloseTimeout: 15 * time.Minute,
-		RetryPolicy: &temporal.RetryPolicy{
-			MaximumAttempts: 3,
-		},
 	}
 	ctx = workflow.WithActivityOptions(ctx, activityOptions)
 
+	// Every activity runs under its own retry policy so callers can tune,
+	// say, a flaky generator without retrying status updates as hard
+	executeActivity := func(ctx workflow.Context, activity string, arg interface{}) workflow.Future {
+		ctx = workflow.WithRetryPolicy(ctx, deploymentRetryPolicy(input.RetryPolicies, activity))
+		return workflow.ExecuteActivity(ctx, activity, arg)
+	}
+
 	// Helper to update status on failure
 	updateStatusOnFailure := func(errMsg string) {
 		statusInput := UpdateDeploymentStatusInput{
@@ -127,7 +176,24 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 			Status:       "failed",
 			ErrorMessage: errMsg,
 		}
-		_ = workflow.ExecuteActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
+		_ = executeActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
+	}
+
+	// Saga: each completed step that leaves artifacts behind registers a
+	// compensation. On failure they run in reverse order before the
+	// deployment is marked failed.
+	var compensations []func(ctx workflow.Context) error
+	rollback := func() {
+		progress.CurrentStep = "rolling_back"
+		progress.Message = "Rolling back partial deployment"
+
+		// Disconnected so rollback still runs if the workflow was cancelled
+		rollbackCtx, _ := workflow.NewDisconnectedContext(ctx)
+		for i := len(compensations) - 1; i >= 0; i-- {
+			if err := compensations[i](rollbackCtx); err != nil {
+				logger.Error("Compensation failed", "error", err)
+			}
+		}
 	}
 
 	// Step 1: Update status to deploying
@@ -139,7 +205,7 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 		DeploymentID: input.DeploymentID,
 		Status:       "deploying",
 	}
-	err = workflow.ExecuteActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
+	err = executeActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
 	if err != nil {
 		logger.Error("Failed to update deployment status", "error", err)
 		return &DeploymentWorkflowResult{
@@ -157,7 +223,7 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 		GeneratorType: input.GeneratorType,
 		Config:        input.Config,
 	}
-	err = workflow.ExecuteActivity(ctx, ActivityValidateDeploymentConfig, validateInput).Get(ctx, nil)
+	err = executeActivity(ctx, ActivityValidateDeploymentConfig, validateInput).Get(ctx, nil)
 	if err != nil {
 		logger.Error("Validation failed", "error", err)
 		updateStatusOnFailure("validation failed: " + err.Error())
@@ -179,7 +245,7 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 		Config:        input.Config,
 	}
 	var workDir string
-	err = workflow.ExecuteActivity(ctx, ActivityPrepareGeneratorContext, prepareInput).Get(ctx, &workDir)
+	err = executeActivity(ctx, ActivityPrepareGeneratorContext, prepareInput).Get(ctx, &workDir)
 	if err != nil {
 		logger.Error("Failed to prepare context", "error", err)
 		updateStatusOnFailure("failed to prepare deployment: " + err.Error())
@@ -188,6 +254,13 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 			Error:  "failed to prepare deployment: " + err.Error(),
 		}, nil
 	}
+	compensations = append(compensations, func(ctx workflow.Context) error {
+		rollbackInput := RollbackGeneratorContextInput{
+			DeploymentID: input.DeploymentID,
+			WorkDir:      workDir,
+		}
+		return executeActivity(ctx, ActivityRollbackGeneratorContext, rollbackInput).Get(ctx, nil)
+	})
 
 	// Step 4: Execute generator
 	progress.CurrentStep = "deploying"
@@ -208,11 +281,13 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 		Target:        input.Target,
 		Mode:          mode,
 	}
-	var executeResult ExecuteGeneratorResult
-	err = workflow.ExecuteActivity(ctx, ActivityExecuteGenerator, executeInput).Get(ctx, &executeResult)
+	// Registered before running: the generator can fail after starting services
+	compensations = append(compensations, func(ctx workflow.Context) error {
+		return executeActivity(ctx, ActivityRollbackGeneratorExecution, executeInput).Get(ctx, nil)
+	})
 
-	// Cleanup work dir regardless of result
-	_ = workflow.ExecuteActivity(ctx, ActivityCleanupWorkDir, workDir).Get(ctx, nil)
+	var executeResult ExecuteGeneratorResult
+	err = executeActivity(ctx, ActivityExecuteGenerator, executeInput).Get(ctx, &executeResult)
 
 	if err != nil || !executeResult.Success {
 		errMsg := "deployment execution failed"
@@ -221,14 +296,21 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 		} else if executeResult.Error != "" {
 			errMsg = executeResult.Error
 		}
-		logger.Error("Deployment failed", "error", errMsg)
+		logger.Error("Deployment failed, rolling back", "error", errMsg)
+		rollback()
 		updateStatusOnFailure(errMsg)
+
+		progress.CurrentStep = "failed"
+		progress.Message = "Deployment failed and was rolled back: " + errMsg
 		return &DeploymentWorkflowResult{
 			Status: "failed",
 			Error:  errMsg,
 		}, nil
 	}
 
+	// The generator succeeded; the work dir is no longer needed
+	_ = executeActivity(ctx, ActivityCleanupWorkDir, workDir).Get(ctx, nil)
+
 	// Step 4b: If generate mode, store files for user to review/commit later
 	if mode == "generate" && len(executeResult.GeneratedFiles) > 0 {
 		progress.CurrentStep = "storing"
@@ -242,7 +324,7 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 			Status:         "generated",
 			GeneratedFiles: executeResult.GeneratedFiles,
 		}
-		err = workflow.ExecuteActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
+		err = executeActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
 		if err != nil {
 			logger.Error("Failed to store generated files", "error", err)
 			updateStatusOnFailure("failed to store generated files: " + err.Error())
@@ -277,7 +359,7 @@ func DeploymentWorkflow(ctx workflow.Context, input DeploymentWorkflowInput) (*D
 		Status:        "deployed",
 		DeploymentURL: executeResult.DeploymentURL,
 	}
-	err = workflow.ExecuteActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
+	err = executeActivity(ctx, ActivityUpdateDeploymentStatus, statusInput).Get(ctx, nil)
 	if err != nil {
 		logger.Error("Failed to update final status", "error", err)
 	}
@@ -317,6 +399,11 @@ type ExecuteGeneratorInput struct {
 	Mode          string                `json:"mode"`
 }
 
+type RollbackGeneratorContextInput struct {
+	DeploymentID string `json:"deploymentId"`
+	WorkDir      string `json:"workDir"`
+}
+
 type UpdateDeploymentStatusInput struct {
 	DeploymentID   string          `json:"deploymentId"`
 	Status         string          `json:"status"`
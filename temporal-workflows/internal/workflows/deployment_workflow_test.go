@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/drewpayment/orbit/temporal-workflows/internal/activities"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 )
 
@@ -127,3 +130,206 @@ func TestDeploymentWorkflow_ValidationFailure(t *testing.T) {
 	require.Equal(t, "failed", result.Status)
 	require.Contains(t, result.Error, "validation failed")
 }
+
+func stubRollbackGeneratorContext(ctx context.Context, input RollbackGeneratorContextInput) error {
+	return nil
+}
+
+func stubRollbackGeneratorExecution(ctx context.Context, input ExecuteGeneratorInput) error {
+	return nil
+}
+
+func TestDeploymentWorkflow_GeneratorFailureRollsBack(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.RegisterActivityWithOptions(stubValidateDeploymentConfig, activity.RegisterOptions{
+		Name: ActivityValidateDeploymentConfig,
+	})
+	env.RegisterActivityWithOptions(stubPrepareGeneratorContext, activity.RegisterOptions{
+		Name: ActivityPrepareGeneratorContext,
+	})
+	env.RegisterActivityWithOptions(stubExecuteGenerator, activity.RegisterOptions{
+		Name: ActivityExecuteGenerator,
+	})
+	env.RegisterActivityWithOptions(stubUpdateDeploymentStatus, activity.RegisterOptions{
+		Name: ActivityUpdateDeploymentStatus,
+	})
+	env.RegisterActivityWithOptions(stubCleanupWorkDir, activity.RegisterOptions{
+		Name: ActivityCleanupWorkDir,
+	})
+	env.RegisterActivityWithOptions(stubRollbackGeneratorContext, activity.RegisterOptions{
+		Name: ActivityRollbackGeneratorContext,
+	})
+	env.RegisterActivityWithOptions(stubRollbackGeneratorExecution, activity.RegisterOptions{
+		Name: ActivityRollbackGeneratorExecution,
+	})
+
+	input := DeploymentWorkflowInput{
+		DeploymentID:  "deploy-123",
+		AppID:         "app-456",
+		WorkspaceID:   "ws-789",
+		UserID:        "user-001",
+		GeneratorType: "docker-compose",
+		GeneratorSlug: "docker-compose-basic",
+		Config:        []byte(`{"hostUrl":"unix:///var/run/docker.sock","serviceName":"my-app","port":3000}`),
+		Target: DeploymentTargetInput{
+			Type:    "docker-host",
+			HostURL: "unix:///var/run/docker.sock",
+		},
+	}
+
+	var statuses []string
+	var compensations []string
+	var rollbackStep string
+
+	env.OnActivity(stubUpdateDeploymentStatus, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			statuses = append(statuses, args.Get(1).(UpdateDeploymentStatusInput).Status)
+		}).Return(nil)
+	env.OnActivity(stubValidateDeploymentConfig, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(stubPrepareGeneratorContext, mock.Anything, mock.Anything).Return("/tmp/deploy-123", nil)
+	env.OnActivity(stubExecuteGenerator, mock.Anything, mock.Anything).
+		Return(&ExecuteGeneratorResult{Success: false, Error: "docker compose failed: port in use"}, nil)
+	env.OnActivity(stubRollbackGeneratorExecution, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			executeInput := args.Get(1).(ExecuteGeneratorInput)
+			require.Equal(t, "/tmp/deploy-123", executeInput.WorkDir)
+			compensations = append(compensations, ActivityRollbackGeneratorExecution)
+
+			value, err := env.QueryWorkflow("progress")
+			require.NoError(t, err)
+			var progress DeploymentProgress
+			require.NoError(t, value.Get(&progress))
+			rollbackStep = progress.CurrentStep
+		}).Return(nil)
+	env.OnActivity(stubRollbackGeneratorContext, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			require.Equal(t, "/tmp/deploy-123", args.Get(1).(RollbackGeneratorContextInput).WorkDir)
+			compensations = append(compensations, ActivityRollbackGeneratorContext)
+		}).Return(nil)
+	env.OnActivity(stubCleanupWorkDir, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(DeploymentWorkflow, input)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result DeploymentWorkflowResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, "failed", result.Status)
+	require.Contains(t, result.Error, "port in use")
+
+	// Compensations run in reverse order, before the deployment is marked failed
+	require.Equal(t, []string{ActivityRollbackGeneratorExecution, ActivityRollbackGeneratorContext}, compensations)
+	require.Equal(t, "rolling_back", rollbackStep)
+	require.Equal(t, []string{"deploying", "failed"}, statuses)
+	env.AssertNotCalled(t, ActivityCleanupWorkDir, mock.Anything, mock.Anything)
+
+	value, err := env.QueryWorkflow("progress")
+	require.NoError(t, err)
+	var progress DeploymentProgress
+	require.NoError(t, value.Get(&progress))
+	require.Equal(t, "failed", progress.CurrentStep)
+}
+
+func TestDeploymentWorkflow_RetryPolicies(t *testing.T) {
+	input := DeploymentWorkflowInput{
+		DeploymentID:  "deploy-123",
+		AppID:         "app-456",
+		WorkspaceID:   "ws-789",
+		UserID:        "user-001",
+		GeneratorType: "docker-compose",
+		GeneratorSlug: "docker-compose-basic",
+		Config:        []byte(`{"serviceName":"my-app"}`),
+		Target:        DeploymentTargetInput{Type: "docker-host"},
+		RetryPolicies: map[string]ActivityRetryPolicy{
+			ActivityExecuteGenerator: {MaximumAttempts: 5, InitialInterval: time.Second},
+		},
+	}
+
+	newEnv := func() *testsuite.TestWorkflowEnvironment {
+		testSuite := &testsuite.WorkflowTestSuite{}
+		env := testSuite.NewTestWorkflowEnvironment()
+		env.RegisterActivityWithOptions(stubValidateDeploymentConfig, activity.RegisterOptions{
+			Name: ActivityValidateDeploymentConfig,
+		})
+		env.RegisterActivityWithOptions(stubPrepareGeneratorContext, activity.RegisterOptions{
+			Name: ActivityPrepareGeneratorContext,
+		})
+		env.RegisterActivityWithOptions(stubExecuteGenerator, activity.RegisterOptions{
+			Name: ActivityExecuteGenerator,
+		})
+		env.RegisterActivityWithOptions(stubUpdateDeploymentStatus, activity.RegisterOptions{
+			Name: ActivityUpdateDeploymentStatus,
+		})
+		env.RegisterActivityWithOptions(stubCleanupWorkDir, activity.RegisterOptions{
+			Name: ActivityCleanupWorkDir,
+		})
+		env.OnActivity(stubUpdateDeploymentStatus, mock.Anything, mock.Anything).Return(nil)
+		return env
+	}
+
+	t.Run("validation errors are not retried", func(t *testing.T) {
+		env := newEnv()
+		validateAttempts := 0
+		env.OnActivity(stubValidateDeploymentConfig, mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { validateAttempts++ }).
+			Return(temporal.NewApplicationError("missing required fields: serviceName", activities.DeploymentInvalidConfigErrorType))
+
+		env.ExecuteWorkflow(DeploymentWorkflow, input)
+
+		require.True(t, env.IsWorkflowCompleted())
+		var result DeploymentWorkflowResult
+		require.NoError(t, env.GetWorkflowResult(&result))
+		require.Equal(t, "failed", result.Status)
+		require.Contains(t, result.Error, "serviceName")
+		require.Equal(t, 1, validateAttempts)
+	})
+
+	t.Run("transient generator errors are retried under the override", func(t *testing.T) {
+		env := newEnv()
+		env.OnActivity(stubValidateDeploymentConfig, mock.Anything, mock.Anything).Return(nil)
+		env.OnActivity(stubPrepareGeneratorContext, mock.Anything, mock.Anything).Return("/tmp/deploy-123", nil)
+		generatorAttempts := 0
+		env.OnActivity(stubExecuteGenerator, mock.Anything, mock.Anything).Return(
+			func(context.Context, ExecuteGeneratorInput) (*ExecuteGeneratorResult, error) {
+				generatorAttempts++
+				if generatorAttempts < 4 {
+					return nil, fmt.Errorf("docker daemon unavailable")
+				}
+				return &ExecuteGeneratorResult{Success: true, DeploymentURL: "http://localhost:3000"}, nil
+			})
+		env.OnActivity(stubCleanupWorkDir, mock.Anything, mock.Anything).Return(nil)
+
+		env.ExecuteWorkflow(DeploymentWorkflow, input)
+
+		require.True(t, env.IsWorkflowCompleted())
+		require.NoError(t, env.GetWorkflowError())
+		var result DeploymentWorkflowResult
+		require.NoError(t, env.GetWorkflowResult(&result))
+		require.Equal(t, "completed", result.Status)
+		// More attempts than the default policy allows
+		require.Equal(t, 4, generatorAttempts)
+	})
+}
+
+func TestDeploymentRetryPolicy(t *testing.T) {
+	policy := deploymentRetryPolicy(nil, ActivityExecuteGenerator)
+	require.Equal(t, int32(3), policy.MaximumAttempts)
+	require.Equal(t, []string{activities.DeploymentInvalidConfigErrorType}, policy.NonRetryableErrorTypes)
+
+	policy = deploymentRetryPolicy(map[string]ActivityRetryPolicy{
+		ActivityExecuteGenerator: {
+			MaximumAttempts:        10,
+			BackoffCoefficient:     1.5,
+			MaximumInterval:        time.Minute,
+			NonRetryableErrorTypes: []string{"GeneratorNotFound"},
+		},
+	}, ActivityExecuteGenerator)
+	require.Equal(t, int32(10), policy.MaximumAttempts)
+	require.Equal(t, 1.5, policy.BackoffCoefficient)
+	require.Equal(t, time.Minute, policy.MaximumInterval)
+	require.Zero(t, policy.InitialInterval)
+	require.Equal(t, []string{activities.DeploymentInvalidConfigErrorType, "GeneratorNotFound"}, policy.NonRetryableErrorTypes)
+}
@@ -34,6 +34,7 @@ type RepositoryWorkflowInput struct {
 	RepositoryID         string
 	GitHubInstallationID string            // OPTIONAL - override default installation
 	TemplateName         string
+	SourceBranch         string            // OPTIONAL - template branch, empty = default branch
 	Variables            map[string]string
 	GitURL               string            // OPTIONAL - if empty, create repo in GitHub
 	RepositoryName       string            // REQUIRED if creating repo (GitURL empty)
@@ -72,6 +73,7 @@ func RepositoryWorkflow(ctx workflow.Context, input RepositoryWorkflowInput) (Re
 	cloneInput := activities.CloneTemplateInput{
 		TemplateName: input.TemplateName,
 		RepositoryID: input.RepositoryID,
+		Branch:       input.SourceBranch,
 	}
 	err := workflow.ExecuteActivity(ctx, cloneTemplateActivityStub, cloneInput).Get(ctx, nil)
 	if err != nil {
@@ -1,6 +1,8 @@
 package workflows
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
@@ -20,17 +22,23 @@ type TemplateInstantiationInput struct {
 	SourceRepoOwner  string            `json:"sourceRepoOwner"`  // Owner of the source template repo
 	SourceRepoName   string            `json:"sourceRepoName"`   // Name of the source template repo
 	SourceRepoURL    string            `json:"sourceRepoUrl"`    // Full URL of source repo (for non-GitHub templates)
+	SourceBranch     string            `json:"sourceBranch"`     // Branch to instantiate from; empty means the repo's default branch
+	ManifestPath     string            `json:"manifestPath"`     // Path of the variable manifest in the source repo; empty means orbit-template.yaml
 	Variables        map[string]string `json:"variables"`        // Template variables to substitute
 	UserID           string            `json:"userId"`           // ID of user initiating instantiation
 	InstallationID   string            `json:"installationId"`   // GitHub App installation ID for authentication
+	Provider         string            `json:"provider"`         // Git host of the template and new repo: "github" (default), "gitlab" or "bitbucket"
+	ConnectionID     string            `json:"connectionId"`     // Git connection whose credentials authenticate GitLab and Bitbucket
+	DryRun           bool              `json:"dryRun"`           // Render the template into a temp dir without creating or pushing a repo
 }
 
 // TemplateInstantiationResult contains the workflow result
 type TemplateInstantiationResult struct {
-	Status   string // "completed", "failed"
-	RepoURL  string // URL of the created repository
-	RepoName string // Name of the created repository
-	Error    string // Error message if failed
+	Status         string // "completed", "failed"
+	RepoURL        string // URL of the created repository
+	RepoName       string // Name of the created repository
+	Error          string // Error message if failed
+	CleanupWarning bool   // True if the repository was created but the work directory could not be removed
 }
 
 // InstantiationProgress tracks workflow progress for query handler
@@ -38,7 +46,102 @@ type InstantiationProgress struct {
 	CurrentStep  string
 	StepsTotal   int
 	StepsCurrent int
-	Message      string
+	// ProgressPercent is set at known milestones and never decreases. Clients
+	// should report it rather than derive a percentage from the step counts,
+	// which change independently as the workflow picks a path.
+	ProgressPercent int
+	Message         string
+	Status          string // Set once the workflow completes, see InstantiationStatus*
+}
+
+// Final statuses reported in InstantiationProgress.Status
+const (
+	InstantiationStatusCompleted                   = "completed"
+	InstantiationStatusCompletedWithCleanupWarning = "completed_with_cleanup_warning"
+	InstantiationStatusDryRunCompleted             = "dry_run_completed"
+)
+
+// InstantiationLogEvent is a single log line of an instantiation, served by
+// the "logs" query. Sequence numbers start at 1 and increase by one per
+// event, so a client can tail the log by passing the last sequence it saw.
+type InstantiationLogEvent struct {
+	Sequence int64
+	Time     time.Time
+	Step     string // InstantiationProgress.CurrentStep when the event was logged
+	Level    string // See InstantiationLogLevel*
+	Message  string
+}
+
+// Levels reported in InstantiationLogEvent.Level
+const (
+	InstantiationLogLevelInfo  = "info"
+	InstantiationLogLevelWarn  = "warn"
+	InstantiationLogLevelError = "error"
+)
+
+// InstantiationLogPage is the result of the "logs" query
+type InstantiationLogPage struct {
+	Events []InstantiationLogEvent
+	// NextSequence is the sequence to pass to the next query; it is the
+	// sequence of the last event returned, or the argument when none were
+	NextSequence int64
+	// Truncated is true when events after the requested sequence were
+	// dropped from the buffer before they could be returned
+	Truncated bool
+}
+
+// MaxInstantiationLogEvents caps the events kept by a workflow. Older events
+// are dropped once the cap is reached so the workflow state stays bounded.
+const MaxInstantiationLogEvents = 500
+
+// instantiationLog is a capped, append-only buffer of log events
+type instantiationLog struct {
+	events []InstantiationLogEvent
+	last   int64
+}
+
+// append assigns the event the next sequence number and drops the oldest
+// event when the buffer is full
+func (l *instantiationLog) append(event InstantiationLogEvent) {
+	l.last++
+	event.Sequence = l.last
+	if len(l.events) >= MaxInstantiationLogEvents {
+		copy(l.events, l.events[1:])
+		l.events = l.events[:len(l.events)-1]
+	}
+	l.events = append(l.events, event)
+}
+
+// since returns the events logged after sequence, oldest first
+func (l *instantiationLog) since(sequence int64) InstantiationLogPage {
+	if sequence < 0 {
+		sequence = 0
+	}
+	page := InstantiationLogPage{NextSequence: sequence}
+	if len(l.events) == 0 || sequence >= l.last {
+		return page
+	}
+	first := l.events[0].Sequence
+	if sequence < first-1 {
+		page.Truncated = true
+		sequence = first - 1
+	}
+	page.Events = append([]InstantiationLogEvent(nil), l.events[sequence-first+1:]...)
+	page.NextSequence = l.last
+	return page
+}
+
+// TemplatePreview describes what a dry-run instantiation would produce.
+// It is served by the "dry_run_preview" query.
+type TemplatePreview struct {
+	Files   []TemplatePreviewFile
+	Summary string // Diff-style listing of the lines changed by variable substitution
+}
+
+// TemplatePreviewFile is a single file of the rendered template
+type TemplatePreviewFile struct {
+	Path          string // Relative to the repository root
+	Substitutions int    // Number of {{variable}} placeholders replaced
 }
 
 // CreateRepoResult contains information about a created repository
@@ -70,8 +173,11 @@ type ApplyTemplateVariablesActivityInput struct {
 }
 
 type PushToNewRepoActivityInput struct {
-	WorkDir string
-	RepoURL string
+	WorkDir        string
+	RepoURL        string
+	InstallationID string
+	Provider       string
+	ConnectionID   string
 }
 
 type ActivityCleanupWorkDirInput struct {
@@ -93,6 +199,7 @@ const (
 	ActivityCreateEmptyRepo            = "CreateEmptyRepo"
 	ActivityCloneTemplateRepo          = "CloneTemplateRepo"
 	ActivityApplyTemplateVariables     = "ApplyTemplateVariables"
+	ActivityPreviewTemplateVariables   = "PreviewTemplateVariables"
 	ActivityPushToNewRepo              = "PushToNewRepo"
 	ActivityCleanupWorkDir             = "CleanupWorkDir"
 	ActivityFinalizeInstantiation      = "FinalizeInstantiation"
@@ -126,6 +233,41 @@ func TemplateInstantiationWorkflow(ctx workflow.Context, input TemplateInstantia
 		}, err
 	}
 
+	var logs instantiationLog
+	logEvent := func(level, message string) {
+		logs.append(InstantiationLogEvent{
+			Time:    workflow.Now(ctx),
+			Step:    progress.CurrentStep,
+			Level:   level,
+			Message: message,
+		})
+	}
+	err = workflow.SetQueryHandler(ctx, "logs", func(afterSequence int64) (InstantiationLogPage, error) {
+		return logs.since(afterSequence), nil
+	})
+	if err != nil {
+		logger.Error("Failed to set query handler", "error", err)
+		return &TemplateInstantiationResult{
+			Status: "failed",
+			Error:  "failed to set up log streaming: " + err.Error(),
+		}, err
+	}
+
+	var preview *TemplatePreview
+	err = workflow.SetQueryHandler(ctx, "dry_run_preview", func() (*TemplatePreview, error) {
+		if preview == nil {
+			return nil, errors.New("no dry-run preview is available")
+		}
+		return preview, nil
+	})
+	if err != nil {
+		logger.Error("Failed to set query handler", "error", err)
+		return &TemplateInstantiationResult{
+			Status: "failed",
+			Error:  "failed to set up dry-run preview: " + err.Error(),
+		}, err
+	}
+
 	// Activity options
 	activityOptions := workflow.ActivityOptions{
 		StartToCloseTimeout: 10 * time.Minute,
@@ -135,20 +277,126 @@ func TemplateInstantiationWorkflow(ctx workflow.Context, input TemplateInstantia
 	}
 	ctx = workflow.WithActivityOptions(ctx, activityOptions)
 
+	// Cleanup is best effort: it retries on its own schedule and a failure
+	// never fails an instantiation whose repository already exists
+	cleanupCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+	cleanupFailed := false
+	cleanupWorkDir := func(workDir string) {
+		if err := workflow.ExecuteActivity(cleanupCtx, ActivityCleanupWorkDir, workDir).Get(cleanupCtx, nil); err != nil {
+			logger.Warn("Failed to clean up work directory", "workDir", workDir, "error", err)
+			logEvent(InstantiationLogLevelWarn, "Failed to clean up work directory: "+err.Error())
+			cleanupFailed = true
+			return
+		}
+		logEvent(InstantiationLogLevelInfo, "Cleaned up work directory")
+	}
+
 	// Step 1: Validate input
 	progress.CurrentStep = "validating input"
 	progress.StepsCurrent = 1
+	progress.ProgressPercent = 5
 	progress.Message = "Validating template instantiation parameters"
+	logEvent(InstantiationLogLevelInfo, progress.Message)
 
 	err = workflow.ExecuteActivity(ctx, ActivityValidateInstantiationInput, input).Get(ctx, nil)
 	if err != nil {
 		logger.Error("Input validation failed", "error", err)
+		logEvent(InstantiationLogLevelError, "input validation failed: "+err.Error())
 		return &TemplateInstantiationResult{
 			Status: "failed",
 			Error:  "input validation failed: " + err.Error(),
 		}, err
 	}
 
+	// A dry run renders the template into a temp dir for the dry_run_preview
+	// query and never creates or pushes a repository
+	if input.DryRun {
+		progress.StepsTotal = 4
+
+		progress.CurrentStep = "cloning template"
+		progress.StepsCurrent = 2
+		progress.ProgressPercent = 20
+		progress.Message = "Cloning template repository for preview"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
+
+		var workDir string
+		err = workflow.ExecuteActivity(ctx, ActivityCloneTemplateRepo, input).Get(ctx, &workDir)
+		if err != nil {
+			logger.Error("Failed to clone template", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to clone template repository: "+err.Error())
+			return &TemplateInstantiationResult{
+				Status: "failed",
+				Error:  "failed to clone template repository: " + err.Error(),
+			}, err
+		}
+		logEvent(InstantiationLogLevelInfo, "Cloned template into "+workDir)
+
+		applyInput := ApplyTemplateVariablesActivityInput{
+			WorkDir:   workDir,
+			Variables: input.Variables,
+		}
+
+		progress.CurrentStep = "previewing variables"
+		progress.StepsCurrent = 3
+		progress.ProgressPercent = 50
+		progress.Message = "Rendering template preview"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
+
+		err = workflow.ExecuteActivity(ctx, ActivityPreviewTemplateVariables, applyInput).Get(ctx, &preview)
+		if err != nil {
+			logger.Error("Failed to preview variables", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to preview template variables: "+err.Error())
+			cleanupWorkDir(workDir)
+			return &TemplateInstantiationResult{
+				Status: "failed",
+				Error:  "failed to preview template variables: " + err.Error(),
+			}, err
+		}
+		logEvent(InstantiationLogLevelInfo, fmt.Sprintf("Rendered preview of %d files", len(preview.Files)))
+
+		progress.CurrentStep = "applying variables"
+		progress.StepsCurrent = 4
+		progress.ProgressPercent = 75
+		progress.Message = "Applying template variables"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
+
+		err = workflow.ExecuteActivity(ctx, ActivityApplyTemplateVariables, applyInput).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to apply variables", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to apply template variables: "+err.Error())
+			cleanupWorkDir(workDir)
+			return &TemplateInstantiationResult{
+				Status: "failed",
+				Error:  "failed to apply template variables: " + err.Error(),
+			}, err
+		}
+		logEvent(InstantiationLogLevelInfo, "Applied template variables")
+
+		cleanupWorkDir(workDir)
+
+		progress.CurrentStep = "completed"
+		progress.ProgressPercent = 100
+		progress.Status = InstantiationStatusDryRunCompleted
+		progress.Message = "Dry run completed; no repository was created"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
+
+		logger.Info("Template instantiation dry run completed", "files", len(preview.Files))
+
+		return &TemplateInstantiationResult{
+			Status:         "completed",
+			RepoName:       input.RepositoryName,
+			CleanupWarning: cleanupFailed,
+		}, nil
+	}
+
 	var repoResult *CreateRepoResult
 
 	// Branch based on whether this is a GitHub template
@@ -156,53 +404,68 @@ func TemplateInstantiationWorkflow(ctx workflow.Context, input TemplateInstantia
 		// GitHub Template API path (faster)
 		progress.CurrentStep = "creating from template"
 		progress.StepsCurrent = 2
+		progress.ProgressPercent = 20
 		progress.Message = "Creating repository from GitHub template"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
 
 		err = workflow.ExecuteActivity(ctx, ActivityCreateRepoFromTemplate, input).Get(ctx, &repoResult)
 		if err != nil {
 			logger.Error("Failed to create repo from template", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to create repository from template: "+err.Error())
 			return &TemplateInstantiationResult{
 				Status: "failed",
 				Error:  "failed to create repository from template: " + err.Error(),
 			}, err
 		}
+		logEvent(InstantiationLogLevelInfo, "Created repository "+repoResult.RepoURL)
 
 		progress.StepsCurrent = 5 // Skip clone/push steps
+		progress.ProgressPercent = 80
 	} else {
 		// Clone fallback path (for non-template repos)
 		progress.CurrentStep = "creating empty repository"
 		progress.StepsCurrent = 2
+		progress.ProgressPercent = 20
 		progress.Message = "Creating empty repository"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
 
 		// Step 2: Create empty repository
 		err = workflow.ExecuteActivity(ctx, ActivityCreateEmptyRepo, input).Get(ctx, &repoResult)
 		if err != nil {
 			logger.Error("Failed to create empty repo", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to create empty repository: "+err.Error())
 			return &TemplateInstantiationResult{
 				Status: "failed",
 				Error:  "failed to create empty repository: " + err.Error(),
 			}, err
 		}
+		logEvent(InstantiationLogLevelInfo, "Created repository "+repoResult.RepoURL)
 
 		// Step 3: Clone template repository
 		progress.CurrentStep = "cloning template"
 		progress.StepsCurrent = 3
+		progress.ProgressPercent = 35
 		progress.Message = "Cloning template repository"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
 
 		var workDir string
 		err = workflow.ExecuteActivity(ctx, ActivityCloneTemplateRepo, input).Get(ctx, &workDir)
 		if err != nil {
 			logger.Error("Failed to clone template", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to clone template repository: "+err.Error())
 			return &TemplateInstantiationResult{
 				Status: "failed",
 				Error:  "failed to clone template repository: " + err.Error(),
 			}, err
 		}
+		logEvent(InstantiationLogLevelInfo, "Cloned template into "+workDir)
 
 		// Step 4: Apply template variables
 		progress.CurrentStep = "applying variables"
 		progress.StepsCurrent = 4
+		progress.ProgressPercent = 55
 		progress.Message = "Applying template variables"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
 
 		applyInput := ApplyTemplateVariablesActivityInput{
 			WorkDir:   workDir,
@@ -211,41 +474,52 @@ func TemplateInstantiationWorkflow(ctx workflow.Context, input TemplateInstantia
 		err = workflow.ExecuteActivity(ctx, ActivityApplyTemplateVariables, applyInput).Get(ctx, nil)
 		if err != nil {
 			logger.Error("Failed to apply variables", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to apply template variables: "+err.Error())
 			// Clean up work directory
-			_ = workflow.ExecuteActivity(ctx, ActivityCleanupWorkDir, workDir).Get(ctx, nil)
+			cleanupWorkDir(workDir)
 			return &TemplateInstantiationResult{
 				Status: "failed",
 				Error:  "failed to apply template variables: " + err.Error(),
 			}, err
 		}
+		logEvent(InstantiationLogLevelInfo, "Applied template variables")
 
 		// Step 5: Push to new repository
 		progress.CurrentStep = "pushing to repository"
 		progress.StepsCurrent = 5
+		progress.ProgressPercent = 75
 		progress.Message = "Pushing code to new repository"
+		logEvent(InstantiationLogLevelInfo, progress.Message)
 
 		pushInput := PushToNewRepoActivityInput{
-			WorkDir: workDir,
-			RepoURL: repoResult.RepoURL,
+			WorkDir:        workDir,
+			RepoURL:        repoResult.RepoURL,
+			InstallationID: input.InstallationID,
+			Provider:       input.Provider,
+			ConnectionID:   input.ConnectionID,
 		}
 		err = workflow.ExecuteActivity(ctx, ActivityPushToNewRepo, pushInput).Get(ctx, nil)
 		if err != nil {
 			logger.Error("Failed to push to repo", "error", err)
+			logEvent(InstantiationLogLevelError, "failed to push to new repository: "+err.Error())
 			// Clean up work directory
-			_ = workflow.ExecuteActivity(ctx, ActivityCleanupWorkDir, workDir).Get(ctx, nil)
+			cleanupWorkDir(workDir)
 			return &TemplateInstantiationResult{
 				Status: "failed",
 				Error:  "failed to push to new repository: " + err.Error(),
 			}, err
 		}
+		logEvent(InstantiationLogLevelInfo, "Pushed code to "+repoResult.RepoURL)
 
 		// Clean up work directory
-		_ = workflow.ExecuteActivity(ctx, ActivityCleanupWorkDir, workDir).Get(ctx, nil)
+		cleanupWorkDir(workDir)
 	}
 
 	// Final step: Finalize instantiation (record in database, send notifications, etc.)
 	progress.CurrentStep = "finalizing"
+	progress.ProgressPercent = 90
 	progress.Message = "Finalizing template instantiation"
+	logEvent(InstantiationLogLevelInfo, progress.Message)
 
 	finalizeInput := FinalizeInstantiationActivityInput{
 		TemplateID:  input.TemplateID,
@@ -257,6 +531,7 @@ func TemplateInstantiationWorkflow(ctx workflow.Context, input TemplateInstantia
 	err = workflow.ExecuteActivity(ctx, ActivityFinalizeInstantiation, finalizeInput).Get(ctx, nil)
 	if err != nil {
 		logger.Error("Failed to finalize instantiation", "error", err)
+		logEvent(InstantiationLogLevelError, "failed to finalize instantiation: "+err.Error())
 		return &TemplateInstantiationResult{
 			Status: "failed",
 			Error:  "failed to finalize instantiation: " + err.Error(),
@@ -264,15 +539,26 @@ func TemplateInstantiationWorkflow(ctx workflow.Context, input TemplateInstantia
 	}
 
 	progress.CurrentStep = "completed"
+	progress.ProgressPercent = 100
+	progress.Status = InstantiationStatusCompleted
 	progress.Message = "Template instantiation completed successfully"
+	if cleanupFailed {
+		progress.Status = InstantiationStatusCompletedWithCleanupWarning
+		progress.Message = "Template instantiation completed, but the working directory could not be cleaned up"
+		logEvent(InstantiationLogLevelWarn, progress.Message)
+	} else {
+		logEvent(InstantiationLogLevelInfo, progress.Message)
+	}
 
 	logger.Info("Template instantiation workflow completed",
 		"repoURL", repoResult.RepoURL,
-		"repoName", repoResult.RepoName)
+		"repoName", repoResult.RepoName,
+		"cleanupWarning", cleanupFailed)
 
 	return &TemplateInstantiationResult{
-		Status:   "completed",
-		RepoURL:  repoResult.RepoURL,
-		RepoName: repoResult.RepoName,
+		Status:         "completed",
+		RepoURL:        repoResult.RepoURL,
+		RepoName:       repoResult.RepoName,
+		CleanupWarning: cleanupFailed,
 	}, nil
 }
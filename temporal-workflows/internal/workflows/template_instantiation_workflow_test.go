@@ -2,11 +2,16 @@ package workflows
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/testsuite"
 )
 
@@ -31,6 +36,10 @@ func stubApplyTemplateVariables(ctx context.Context, input ApplyTemplateVariable
 	return nil
 }
 
+func stubPreviewTemplateVariables(ctx context.Context, input ApplyTemplateVariablesActivityInput) (*TemplatePreview, error) {
+	return &TemplatePreview{}, nil
+}
+
 func stubPushToNewRepo(ctx context.Context, input PushToNewRepoActivityInput) error {
 	return nil
 }
@@ -64,6 +73,9 @@ func (s *TemplateInstantiationWorkflowTestSuite) SetupTest() {
 	s.env.RegisterActivityWithOptions(stubApplyTemplateVariables, activity.RegisterOptions{
 		Name: ActivityApplyTemplateVariables,
 	})
+	s.env.RegisterActivityWithOptions(stubPreviewTemplateVariables, activity.RegisterOptions{
+		Name: ActivityPreviewTemplateVariables,
+	})
 	s.env.RegisterActivityWithOptions(stubPushToNewRepo, activity.RegisterOptions{
 		Name: ActivityPushToNewRepo,
 	})
@@ -151,6 +163,287 @@ func (s *TemplateInstantiationWorkflowTestSuite) TestTemplateInstantiation_Clone
 	s.Equal("completed", result.Status)
 }
 
+func (s *TemplateInstantiationWorkflowTestSuite) TestTemplateInstantiation_CleanupFailure_CompletesWithWarning() {
+	input := TemplateInstantiationInput{
+		TemplateID:       "template-123",
+		WorkspaceID:      "workspace-456",
+		TargetOrg:        "my-org",
+		RepositoryName:   "new-service",
+		IsGitHubTemplate: false,
+		SourceRepoOwner:  "template-org",
+		SourceRepoName:   "service-template",
+		SourceRepoURL:    "https://github.com/template-org/service-template",
+		UserID:           "user-789",
+	}
+
+	s.env.OnActivity(stubValidateInstantiationInput, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCreateEmptyRepo, mock.Anything, mock.Anything).Return(&CreateRepoResult{
+		RepoURL:  "https://github.com/my-org/new-service",
+		RepoName: "new-service",
+	}, nil)
+	s.env.OnActivity(stubCloneTemplateRepo, mock.Anything, mock.Anything).Return("/tmp/work/new-service", nil)
+	s.env.OnActivity(stubApplyTemplateVariables, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubPushToNewRepo, mock.Anything, mock.Anything).Return(nil)
+	cleanupAttempts := 0
+	s.env.OnActivity(stubCleanupWorkDir, mock.Anything, "/tmp/work/new-service").
+		Run(func(mock.Arguments) { cleanupAttempts++ }).
+		Return(errors.New("device or resource busy"))
+	s.env.OnActivity(stubFinalizeInstantiation, mock.Anything, mock.Anything).Return(nil)
+
+	s.env.ExecuteWorkflow(TemplateInstantiationWorkflow, input)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var result TemplateInstantiationResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal("completed", result.Status)
+	s.Equal("https://github.com/my-org/new-service", result.RepoURL)
+	s.True(result.CleanupWarning)
+
+	// Cleanup retried under its own policy before giving up
+	s.Equal(5, cleanupAttempts)
+
+	value, err := s.env.QueryWorkflow("progress")
+	s.NoError(err)
+	var progress InstantiationProgress
+	s.NoError(value.Get(&progress))
+	s.Equal("completed", progress.CurrentStep)
+	s.Equal(InstantiationStatusCompletedWithCleanupWarning, progress.Status)
+}
+
+func (s *TemplateInstantiationWorkflowTestSuite) TestTemplateInstantiation_DryRun_SkipsRemoteSteps() {
+	input := TemplateInstantiationInput{
+		TemplateID:       "template-123",
+		WorkspaceID:      "workspace-456",
+		TargetOrg:        "my-org",
+		RepositoryName:   "new-service",
+		IsGitHubTemplate: true,
+		SourceRepoOwner:  "template-org",
+		SourceRepoName:   "service-template",
+		Variables:        map[string]string{"service_name": "new-service"},
+		UserID:           "user-789",
+		DryRun:           true,
+	}
+	preview := &TemplatePreview{
+		Files:   []TemplatePreviewFile{{Path: "README.md", Substitutions: 1}},
+		Summary: "1 files, 1 changed, 1 substitutions\n",
+	}
+
+	s.env.OnActivity(stubValidateInstantiationInput, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCloneTemplateRepo, mock.Anything, input).Return("/tmp/work/preview", nil)
+	applyInput := ApplyTemplateVariablesActivityInput{WorkDir: "/tmp/work/preview", Variables: input.Variables}
+	s.env.OnActivity(stubPreviewTemplateVariables, mock.Anything, applyInput).Return(preview, nil)
+	s.env.OnActivity(stubApplyTemplateVariables, mock.Anything, applyInput).Return(nil)
+	s.env.OnActivity(stubCleanupWorkDir, mock.Anything, "/tmp/work/preview").Return(nil)
+	s.env.OnActivity(stubCreateRepoFromTemplate, mock.Anything, mock.Anything).Never()
+	s.env.OnActivity(stubCreateEmptyRepo, mock.Anything, mock.Anything).Never()
+	s.env.OnActivity(stubPushToNewRepo, mock.Anything, mock.Anything).Never()
+	s.env.OnActivity(stubFinalizeInstantiation, mock.Anything, mock.Anything).Never()
+
+	s.env.ExecuteWorkflow(TemplateInstantiationWorkflow, input)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var result TemplateInstantiationResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal("completed", result.Status)
+	s.Empty(result.RepoURL)
+
+	value, err := s.env.QueryWorkflow("dry_run_preview")
+	s.NoError(err)
+	var got TemplatePreview
+	s.NoError(value.Get(&got))
+	s.Equal(*preview, got)
+
+	value, err = s.env.QueryWorkflow("progress")
+	s.NoError(err)
+	var progress InstantiationProgress
+	s.NoError(value.Get(&progress))
+	s.Equal(InstantiationStatusDryRunCompleted, progress.Status)
+}
+
+func (s *TemplateInstantiationWorkflowTestSuite) TestTemplateInstantiation_ProgressNeverDecreases() {
+	input := TemplateInstantiationInput{
+		TemplateID:       "template-123",
+		WorkspaceID:      "workspace-456",
+		TargetOrg:        "my-org",
+		RepositoryName:   "new-service",
+		IsGitHubTemplate: false,
+		SourceRepoOwner:  "template-org",
+		SourceRepoName:   "service-template",
+		SourceRepoURL:    "https://github.com/template-org/service-template",
+		UserID:           "user-789",
+	}
+
+	s.env.OnActivity(stubValidateInstantiationInput, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCreateEmptyRepo, mock.Anything, mock.Anything).Return(&CreateRepoResult{
+		RepoURL:  "https://github.com/my-org/new-service",
+		RepoName: "new-service",
+	}, nil)
+	s.env.OnActivity(stubCloneTemplateRepo, mock.Anything, mock.Anything).Return("/tmp/work/new-service", nil)
+	s.env.OnActivity(stubApplyTemplateVariables, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubPushToNewRepo, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCleanupWorkDir, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubFinalizeInstantiation, mock.Anything, mock.Anything).Return(nil)
+
+	// Sample progress as every activity starts and completes
+	var percents []int
+	sample := func() {
+		value, err := s.env.QueryWorkflow("progress")
+		s.NoError(err)
+		var progress InstantiationProgress
+		s.NoError(value.Get(&progress))
+		percents = append(percents, progress.ProgressPercent)
+	}
+	s.env.SetOnActivityStartedListener(func(*activity.Info, context.Context, converter.EncodedValues) { sample() })
+	s.env.SetOnActivityCompletedListener(func(*activity.Info, converter.EncodedValue, error) { sample() })
+
+	s.env.ExecuteWorkflow(TemplateInstantiationWorkflow, input)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	sample()
+
+	s.Greater(len(percents), 10)
+	for i := 1; i < len(percents); i++ {
+		s.GreaterOrEqual(percents[i], percents[i-1], "progress went backwards: %v", percents)
+	}
+	s.Less(percents[len(percents)-2], 100, "progress reached 100%% before the workflow finished: %v", percents)
+	s.Equal(100, percents[len(percents)-1])
+}
+
+func (s *TemplateInstantiationWorkflowTestSuite) TestTemplateInstantiation_LogsStreamPerStep() {
+	input := TemplateInstantiationInput{
+		TemplateID:       "template-123",
+		WorkspaceID:      "workspace-456",
+		TargetOrg:        "my-org",
+		RepositoryName:   "new-service",
+		IsGitHubTemplate: false,
+		SourceRepoOwner:  "template-org",
+		SourceRepoName:   "service-template",
+		SourceRepoURL:    "https://github.com/template-org/service-template",
+		UserID:           "user-789",
+	}
+
+	s.env.OnActivity(stubValidateInstantiationInput, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCreateEmptyRepo, mock.Anything, mock.Anything).Return(&CreateRepoResult{
+		RepoURL:  "https://github.com/my-org/new-service",
+		RepoName: "new-service",
+	}, nil)
+	s.env.OnActivity(stubCloneTemplateRepo, mock.Anything, mock.Anything).Return("/tmp/work/new-service", nil)
+	s.env.OnActivity(stubApplyTemplateVariables, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubPushToNewRepo, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCleanupWorkDir, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubFinalizeInstantiation, mock.Anything, mock.Anything).Return(nil)
+
+	// Tail the log as a client would, passing the last sequence seen
+	var tailed []InstantiationLogEvent
+	var next int64
+	tail := func() {
+		value, err := s.env.QueryWorkflow("logs", next)
+		s.NoError(err)
+		var page InstantiationLogPage
+		s.NoError(value.Get(&page))
+		s.False(page.Truncated)
+		tailed = append(tailed, page.Events...)
+		next = page.NextSequence
+	}
+	s.env.SetOnActivityCompletedListener(func(*activity.Info, converter.EncodedValue, error) { tail() })
+
+	s.env.ExecuteWorkflow(TemplateInstantiationWorkflow, input)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	tail()
+
+	type line struct{ step, message string }
+	want := []line{
+		{"validating input", "Validating template instantiation parameters"},
+		{"creating empty repository", "Creating empty repository"},
+		{"creating empty repository", "Created repository https://github.com/my-org/new-service"},
+		{"cloning template", "Cloning template repository"},
+		{"cloning template", "Cloned template into /tmp/work/new-service"},
+		{"applying variables", "Applying template variables"},
+		{"applying variables", "Applied template variables"},
+		{"pushing to repository", "Pushing code to new repository"},
+		{"pushing to repository", "Pushed code to https://github.com/my-org/new-service"},
+		{"pushing to repository", "Cleaned up work directory"},
+		{"finalizing", "Finalizing template instantiation"},
+		{"completed", "Template instantiation completed successfully"},
+	}
+	s.Require().Len(tailed, len(want))
+	for i, event := range tailed {
+		s.Equal(int64(i+1), event.Sequence)
+		s.Equal(want[i], line{event.Step, event.Message})
+		s.Equal(InstantiationLogLevelInfo, event.Level)
+	}
+
+	value, err := s.env.QueryWorkflow("logs", int64(len(want)-2))
+	s.NoError(err)
+	var page InstantiationLogPage
+	s.NoError(value.Get(&page))
+	s.Equal(tailed[len(want)-2:], page.Events)
+	s.Equal(int64(len(want)), page.NextSequence)
+}
+
+func (s *TemplateInstantiationWorkflowTestSuite) TestTemplateInstantiation_LogsRecordFailure() {
+	input := TemplateInstantiationInput{
+		TemplateID:       "template-123",
+		WorkspaceID:      "workspace-456",
+		TargetOrg:        "my-org",
+		RepositoryName:   "new-service",
+		IsGitHubTemplate: true,
+		SourceRepoOwner:  "template-org",
+		SourceRepoName:   "service-template",
+		UserID:           "user-789",
+	}
+
+	s.env.OnActivity(stubValidateInstantiationInput, mock.Anything, mock.Anything).Return(nil)
+	s.env.OnActivity(stubCreateRepoFromTemplate, mock.Anything, mock.Anything).
+		Return(nil, errors.New("name already exists on this account"))
+
+	s.env.ExecuteWorkflow(TemplateInstantiationWorkflow, input)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+
+	value, err := s.env.QueryWorkflow("logs", int64(0))
+	s.NoError(err)
+	var page InstantiationLogPage
+	s.NoError(value.Get(&page))
+	s.Require().NotEmpty(page.Events)
+	last := page.Events[len(page.Events)-1]
+	s.Equal(InstantiationLogLevelError, last.Level)
+	s.Equal("creating from template", last.Step)
+	s.Contains(last.Message, "name already exists on this account")
+}
+
 func TestTemplateInstantiationWorkflowTestSuite(t *testing.T) {
 	suite.Run(t, new(TemplateInstantiationWorkflowTestSuite))
 }
+
+func TestInstantiationLog_DropsOldestEvents(t *testing.T) {
+	var logs instantiationLog
+	total := MaxInstantiationLogEvents + 10
+	for i := 0; i < total; i++ {
+		logs.append(InstantiationLogEvent{Message: fmt.Sprintf("event %d", i+1)})
+	}
+
+	page := logs.since(0)
+	assert.True(t, page.Truncated)
+	require.Len(t, page.Events, MaxInstantiationLogEvents)
+	assert.Equal(t, int64(11), page.Events[0].Sequence)
+	assert.Equal(t, "event 11", page.Events[0].Message)
+	assert.Equal(t, int64(total), page.NextSequence)
+
+	page = logs.since(int64(total - 2))
+	assert.False(t, page.Truncated)
+	require.Len(t, page.Events, 2)
+	assert.Equal(t, int64(total-1), page.Events[0].Sequence)
+
+	page = logs.since(int64(total))
+	assert.Empty(t, page.Events)
+	assert.Equal(t, int64(total), page.NextSequence)
+}
@@ -1,6 +1,8 @@
 // Package types provides shared types for workflows
 package types
 
+import "time"
+
 // TemplateInstantiationInput contains all parameters needed for template instantiation
 type TemplateInstantiationInput struct {
 	TemplateID       string            `json:"templateId"`       // ID of the template being instantiated
@@ -13,25 +15,63 @@ type TemplateInstantiationInput struct {
 	SourceRepoOwner  string            `json:"sourceRepoOwner"`  // Owner of the source template repo
 	SourceRepoName   string            `json:"sourceRepoName"`   // Name of the source template repo
 	SourceRepoURL    string            `json:"sourceRepoUrl"`    // Full URL of source repo (for non-GitHub templates)
+	SourceBranch     string            `json:"sourceBranch"`     // Branch to instantiate from; empty means the repo's default branch
+	ManifestPath     string            `json:"manifestPath"`     // Path of the variable manifest in the source repo; empty means orbit-template.yaml
 	Variables        map[string]string `json:"variables"`        // Template variables to substitute
 	UserID           string            `json:"userId"`           // ID of user initiating instantiation
 	InstallationID   string            `json:"installationId"`   // GitHub App installation ID for authentication
+	Provider         string            `json:"provider"`         // Git host of the template and new repo: "github" (default), "gitlab" or "bitbucket"
+	ConnectionID     string            `json:"connectionId"`     // Git connection whose credentials authenticate GitLab and Bitbucket
+	DryRun           bool              `json:"dryRun"`           // Render the template into a temp dir without creating or pushing a repo
 }
 
 // TemplateInstantiationResult contains the workflow result
 type TemplateInstantiationResult struct {
-	Status   string // "completed", "failed"
-	RepoURL  string // URL of the created repository
-	RepoName string // Name of the created repository
-	Error    string // Error message if failed
+	Status         string // "completed", "failed"
+	RepoURL        string // URL of the created repository
+	RepoName       string // Name of the created repository
+	Error          string // Error message if failed
+	CleanupWarning bool   // True if the repository was created but the work directory could not be removed
 }
 
 // InstantiationProgress tracks workflow progress for query handler
 type InstantiationProgress struct {
-	CurrentStep  string
-	StepsTotal   int
-	StepsCurrent int
-	Message      string
+	CurrentStep     string
+	StepsTotal      int
+	StepsCurrent    int
+	ProgressPercent int // Set by the workflow at known milestones; never decreases
+	Message         string
+	Status          string // "completed", "completed_with_cleanup_warning" or "dry_run_completed" once finished
+}
+
+// InstantiationLogEvent is a log line returned by the "logs" query. Sequence
+// numbers start at 1 and increase by one per event.
+type InstantiationLogEvent struct {
+	Sequence int64
+	Time     time.Time
+	Step     string // InstantiationProgress.CurrentStep when the event was logged
+	Level    string // "info", "warn" or "error"
+	Message  string
+}
+
+// InstantiationLogPage is returned by the "logs" query, which takes the last
+// sequence the caller has seen (0 for all retained events)
+type InstantiationLogPage struct {
+	Events       []InstantiationLogEvent
+	NextSequence int64 // Sequence to pass to the next query
+	Truncated    bool  // True if events after the requested sequence were dropped from the buffer
+}
+
+// TemplatePreview is returned by the "dry_run_preview" query of a dry-run instantiation
+type TemplatePreview struct {
+	Files   []TemplatePreviewFile
+	Summary string // Diff-style listing of the lines changed by variable substitution
+}
+
+// TemplatePreviewFile is a single file of the rendered template
+type TemplatePreviewFile struct {
+	Path          string // Relative to the repository root
+	Substitutions int    // Number of {{variable}} placeholders replaced
 }
 
 // DeploymentWorkflowInput contains all parameters for deployment
@@ -45,6 +85,18 @@ type DeploymentWorkflowInput struct {
 	Config        []byte                `json:"config"`
 	Target        DeploymentTargetInput `json:"target"`
 	Mode          string                `json:"mode"` // "generate" or "execute", defaults to "execute"
+	// RetryPolicies overrides the retry policy of individual activities, keyed by activity name
+	RetryPolicies map[string]ActivityRetryPolicy `json:"retryPolicies,omitempty"`
+}
+
+// ActivityRetryPolicy tunes how a deployment activity is retried. Zero fields
+// keep the workflow's default; NonRetryableErrorTypes adds to the defaults.
+type ActivityRetryPolicy struct {
+	MaximumAttempts        int32         `json:"maximumAttempts,omitempty"`
+	InitialInterval        time.Duration `json:"initialInterval,omitempty"`
+	BackoffCoefficient     float64       `json:"backoffCoefficient,omitempty"`
+	MaximumInterval        time.Duration `json:"maximumInterval,omitempty"`
+	NonRetryableErrorTypes []string      `json:"nonRetryableErrorTypes,omitempty"`
 }
 
 // DeploymentTargetInput contains deployment target information